@@ -5,14 +5,38 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/kgatilin/darwinflow-pub/internal/app"
+	"github.com/kgatilin/darwinflow-pub/internal/domain"
 	"github.com/kgatilin/darwinflow-pub/internal/infra"
 	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/claude_code"
 )
 
-func analyzeCmd(args []string) {
+func analyzeCmd(args []string, verbosity pluginsdk.Verbosity) {
+	if len(args) > 0 && args[0] == "export" {
+		analyzeExportCmd(args[1:])
+		return
+	}
+	if len(args) > 0 && args[0] == "rerun" {
+		analyzeRerunCmd(args[1:], verbosity)
+		return
+	}
+	if len(args) > 0 && args[0] == "show" {
+		analyzeShowCmd(args[1:])
+		return
+	}
+	if len(args) > 0 && args[0] == "delete" {
+		analyzeDeleteCmd(args[1:])
+		return
+	}
+	if len(args) > 0 && args[0] == "sandbox" {
+		analyzeSandboxCmd(args[1:], verbosity)
+		return
+	}
+
 	fs := flag.NewFlagSet("analyze", flag.ContinueOnError)
 	sessionID := fs.String("session-id", "", "Session ID to analyze")
 	last := fs.Bool("last", false, "Analyze the last session")
@@ -31,7 +55,7 @@ func analyzeCmd(args []string) {
 	if err := fs.Parse(args); err != nil {
 		if err != flag.ErrHelp {
 			fmt.Fprintf(os.Stderr, "Error parsing flags: %v\n", err)
-			os.Exit(1)
+			os.Exit(pluginsdk.ExitUsage)
 		}
 		return
 	}
@@ -85,7 +109,7 @@ func analyzeCmd(args []string) {
 			fmt.Fprintf(os.Stderr, "Error: Invalid model '%s'\n", *modelOverride)
 			fmt.Fprintf(os.Stderr, "Allowed models: sonnet, opus, haiku, or specific versions\n")
 			fmt.Fprintf(os.Stderr, "See .darwinflow.yaml for full list\n")
-			os.Exit(1)
+			os.Exit(pluginsdk.ExitUsage)
 		}
 		config.Analysis.Model = *modelOverride
 	}
@@ -106,6 +130,35 @@ func analyzeCmd(args []string) {
 		logger.Debug("Using enabled prompts from config: %v", selectedPrompts)
 	}
 
+	analysisService := newAnalysisService(repo, config, logger)
+
+	// Create command handler
+	handler := app.NewAnalyzeCommandHandler(analysisService, logger, os.Stdout)
+	handler.SetProgress(analysisProgress(verbosity))
+
+	// Build options
+	opts := app.AnalyzeOptions{
+		SessionID:     *sessionID,
+		Last:          *last,
+		ViewOnly:      *viewOnly,
+		AnalyzeAll:    *analyzeAll,
+		Refresh:       *refresh,
+		Limit:         *limit,
+		PromptNames:   selectedPrompts,
+		ModelOverride: *modelOverride,
+		TokenLimit:    *tokenLimit,
+	}
+
+	// Execute
+	if err := handler.Execute(ctx, opts); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(pluginsdk.ExitCode(err))
+	}
+}
+
+// newAnalysisService wires the analysis service with the Claude Code LLM, the error
+// logger and the claude_code session view
+func newAnalysisService(repo *infra.SQLiteEventRepository, config *domain.Config, logger *infra.Logger) *app.AnalysisService {
 	// Create error logger
 	logger.Debug("Creating error logger")
 	errorLogger, err := infra.NewErrorLogger(app.DefaultDBPath)
@@ -131,26 +184,379 @@ func analyzeCmd(args []string) {
 		return claude_code.NewSessionView(sessionID, events)
 	})
 
-	// Create command handler
-	handler := app.NewAnalyzeCommandHandler(analysisService, logger, os.Stdout)
+	return analysisService
+}
 
-	// Build options
-	opts := app.AnalyzeOptions{
-		SessionID:     *sessionID,
-		Last:          *last,
-		ViewOnly:      *viewOnly,
-		AnalyzeAll:    *analyzeAll,
-		Refresh:       *refresh,
-		Limit:         *limit,
-		PromptNames:   selectedPrompts,
-		ModelOverride: *modelOverride,
-		TokenLimit:    *tokenLimit,
+// analyzeRerunCmd re-analyzes a session with another model, keeping the existing analyses
+func analyzeRerunCmd(args []string, verbosity pluginsdk.Verbosity) {
+	fs := flag.NewFlagSet("analyze rerun", flag.ContinueOnError)
+	model := fs.String("model", "", "Model to re-analyze with (required, e.g. opus)")
+	promptName := fs.String("prompt", "", "Prompt name from config (default: prompt of the latest analysis)")
+	debug := fs.Bool("debug", false, "Enable debug logging")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: dw analyze rerun <session-id> --model <name> [--prompt <name>]")
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintln(os.Stderr, "Runs a fresh analysis of a session with another model and saves it next to")
+		fmt.Fprintln(os.Stderr, "the existing analyses instead of overwriting them. Analyses are kept per model:")
+		fmt.Fprintln(os.Stderr, "rerunning with a model the session was already analyzed with replaces that one.")
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintln(os.Stderr, "Flags:")
+		fs.PrintDefaults()
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintln(os.Stderr, "Examples:")
+		fmt.Fprintln(os.Stderr, "  dw analyze rerun <session-id> --model opus")
+		fmt.Fprintln(os.Stderr, "  dw analyze rerun <session-id> --model haiku --prompt session_summary")
 	}
 
-	// Execute
-	if err := handler.Execute(ctx, opts); err != nil {
+	// Accept the session ID before or after the flags
+	var sessionID string
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		sessionID = args[0]
+		args = args[1:]
+	}
+	if err := fs.Parse(args); err != nil {
+		if err != flag.ErrHelp {
+			os.Exit(pluginsdk.ExitUsage)
+		}
+		return
+	}
+	if sessionID == "" && fs.NArg() > 0 {
+		sessionID = fs.Arg(0)
+	}
+	if sessionID == "" || *model == "" {
+		fs.Usage()
+		os.Exit(pluginsdk.ExitUsage)
+	}
+
+	var logger *infra.Logger
+	if *debug {
+		logger = infra.NewDebugLogger()
+	} else {
+		logger = infra.NewDefaultLogger()
+	}
+
+	ctx := context.Background()
+
+	repo, err := infra.NewSQLiteEventRepository(app.DefaultDBPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to initialize repository: %v\n", err)
+		os.Exit(1)
+	}
+	defer repo.Close()
+
+	if err := repo.Initialize(ctx); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to initialize database schema: %v\n", err)
+		os.Exit(1)
+	}
+
+	config, err := infra.NewConfigLoader(logger).LoadConfig("")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load config: %v\n", err)
+		os.Exit(1)
+	}
+
+	handler := app.NewAnalyzeCommandHandler(newAnalysisService(repo, config, logger), logger, os.Stdout)
+	handler.SetProgress(analysisProgress(verbosity))
+	if err := handler.Rerun(ctx, sessionID, *promptName, *model); err != nil {
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(pluginsdk.ExitCode(err))
+	}
+}
+
+// analyzeSandboxCmd analyzes a copy of a session in a throwaway event store
+func analyzeSandboxCmd(args []string, verbosity pluginsdk.Verbosity) {
+	fs := flag.NewFlagSet("analyze sandbox", flag.ContinueOnError)
+	var opts app.AnalysisSandboxOptions
+	fs.StringVar(&opts.SessionID, "session", "", "Session ID to replay into the sandbox (required)")
+	promptName := fs.String("prompt", "", "Prompt name from config to use (default: enabled prompts)")
+	modelOverride := fs.String("model", "", "Override model from config")
+	fs.StringVar(&opts.KeepPath, "keep", "", "Keep the sandbox DB at this path instead of discarding it")
+	debug := fs.Bool("debug", false, "Enable debug logging")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: dw analyze sandbox --session <id> [--prompt <name>] [--model <name>] [--keep <path>]")
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintln(os.Stderr, "Copies the events of a session into a fresh temporary database, analyzes them")
+		fmt.Fprintln(os.Stderr, "there and prints the result. Nothing is written to the real event store, so")
+		fmt.Fprintln(os.Stderr, "prompts and models can be tried freely. The sandbox is deleted afterwards unless")
+		fmt.Fprintln(os.Stderr, "--keep names a new file to keep it in.")
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintln(os.Stderr, "Flags:")
+		fs.PrintDefaults()
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintln(os.Stderr, "Examples:")
+		fmt.Fprintln(os.Stderr, "  dw analyze sandbox --session <id> --prompt session_summary")
+		fmt.Fprintln(os.Stderr, "  dw analyze sandbox --session <id> --model opus --keep /tmp/sandbox.db")
+	}
+
+	if err := fs.Parse(args); err != nil {
+		if err != flag.ErrHelp {
+			os.Exit(pluginsdk.ExitUsage)
+		}
+		return
+	}
+	if opts.SessionID == "" {
+		fs.Usage()
+		os.Exit(pluginsdk.ExitUsage)
+	}
+
+	var logger *infra.Logger
+	if *debug {
+		logger = infra.NewDebugLogger()
+	} else {
+		logger = infra.NewDefaultLogger()
+	}
+
+	ctx := context.Background()
+
+	repo, err := infra.NewSQLiteEventRepository(app.DefaultDBPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to initialize repository: %v\n", err)
+		os.Exit(1)
+	}
+	defer repo.Close()
+
+	if err := repo.Initialize(ctx); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to initialize database schema: %v\n", err)
 		os.Exit(1)
 	}
+
+	config, err := infra.NewConfigLoader(logger).LoadConfig("")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load config: %v\n", err)
+		os.Exit(1)
+	}
+	if *modelOverride != "" {
+		if !infra.ValidateModelAlias(*modelOverride) {
+			fmt.Fprintf(os.Stderr, "Error: Invalid model '%s'\n", *modelOverride)
+			os.Exit(pluginsdk.ExitUsage)
+		}
+		config.Analysis.Model = *modelOverride
+	}
+	if *promptName != "" {
+		opts.PromptNames = []string{*promptName}
+	} else {
+		opts.PromptNames = config.Analysis.EnabledPrompts
+	}
+
+	openSandbox := func(ctx context.Context, dbPath string) (app.AnalysisSandboxStore, app.AnalysisServiceInterface, error) {
+		sandbox, err := infra.NewSQLiteEventRepository(dbPath)
+		if err != nil {
+			return nil, nil, err
+		}
+		if err := sandbox.Initialize(ctx); err != nil {
+			sandbox.Close()
+			return nil, nil, err
+		}
+		return sandbox, newAnalysisService(sandbox, config, logger), nil
+	}
+
+	handler := app.NewAnalysisSandboxHandler(repo, openSandbox, logger)
+	handler.SetProgress(analysisProgress(verbosity))
+	if err := handler.Run(ctx, opts, os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(pluginsdk.ExitCode(err))
+	}
 }
 
+// analyzeShowCmd prints a stored analysis and, with --with-events, its source events
+func analyzeShowCmd(args []string) {
+	fs := flag.NewFlagSet("analyze show", flag.ContinueOnError)
+	withEvents := fs.Bool("with-events", false, "List the events the analysis was computed from")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: dw analyze show <analysis-id> [--with-events]")
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintln(os.Stderr, "Prints a stored analysis. With --with-events, also lists the events that fed")
+		fmt.Fprintln(os.Stderr, "it. Analyses made before source events were recorded report")
+		fmt.Fprintln(os.Stderr, "\"source events not recorded\".")
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintln(os.Stderr, "Flags:")
+		fs.PrintDefaults()
+	}
+
+	// Accept the analysis ID before or after the flags
+	var analysisID string
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		analysisID = args[0]
+		args = args[1:]
+	}
+	if err := fs.Parse(args); err != nil {
+		if err != flag.ErrHelp {
+			os.Exit(pluginsdk.ExitUsage)
+		}
+		return
+	}
+	if analysisID == "" && fs.NArg() > 0 {
+		analysisID = fs.Arg(0)
+	}
+	if analysisID == "" {
+		fs.Usage()
+		os.Exit(pluginsdk.ExitUsage)
+	}
+
+	ctx := context.Background()
+
+	repo, err := infra.NewSQLiteEventRepository(app.DefaultDBPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to initialize repository: %v\n", err)
+		os.Exit(1)
+	}
+	defer repo.Close()
+
+	if err := repo.Initialize(ctx); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to initialize database schema: %v\n", err)
+		os.Exit(1)
+	}
+
+	handler := app.NewAnalysisShowHandler(repo, repo)
+	if err := handler.Show(ctx, analysisID, app.AnalysisShowOptions{WithEvents: *withEvents}, os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(pluginsdk.ExitCode(err))
+	}
+}
+
+// analyzeDeleteCmd removes a stored analysis, or the analyses of a session
+func analyzeDeleteCmd(args []string) {
+	fs := flag.NewFlagSet("analyze delete", flag.ContinueOnError)
+	var opts app.AnalysisDeleteOptions
+	fs.StringVar(&opts.SessionID, "session", "", "Delete the analyses of a session instead of one analysis")
+	fs.BoolVar(&opts.KeepLatest, "keep-latest", false, "With --session, keep the session's newest analysis")
+	fs.BoolVar(&opts.Force, "force", false, "Confirm deleting the analyses of a session")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: dw analyze delete <analysis-id>")
+		fmt.Fprintln(os.Stderr, "       dw analyze delete --session <id> [--keep-latest] --force")
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintln(os.Stderr, "Deletes stored analyses together with the links to their source events, in a")
+		fmt.Fprintln(os.Stderr, "single transaction. --session deletes every analysis of a session and requires")
+		fmt.Fprintln(os.Stderr, "--force; --keep-latest keeps its newest one, e.g. to clean up after reruns.")
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintln(os.Stderr, "Flags:")
+		fs.PrintDefaults()
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintln(os.Stderr, "Examples:")
+		fmt.Fprintln(os.Stderr, "  dw analyze delete <analysis-id>")
+		fmt.Fprintln(os.Stderr, "  dw analyze delete --session <id> --keep-latest --force")
+	}
+
+	// Accept the analysis ID before or after the flags
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		opts.AnalysisID = args[0]
+		args = args[1:]
+	}
+	if err := fs.Parse(args); err != nil {
+		if err != flag.ErrHelp {
+			os.Exit(pluginsdk.ExitUsage)
+		}
+		return
+	}
+	if opts.AnalysisID == "" && fs.NArg() > 0 {
+		opts.AnalysisID = fs.Arg(0)
+	}
+	if opts.AnalysisID == "" && opts.SessionID == "" {
+		fs.Usage()
+		os.Exit(pluginsdk.ExitUsage)
+	}
+
+	ctx := context.Background()
+
+	repo, err := infra.NewSQLiteEventRepository(app.DefaultDBPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to initialize repository: %v\n", err)
+		os.Exit(1)
+	}
+	defer repo.Close()
+
+	if err := repo.Initialize(ctx); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to initialize database schema: %v\n", err)
+		os.Exit(1)
+	}
+
+	handler := app.NewAnalysisDeleteHandler(repo)
+	if _, err := handler.Delete(ctx, opts, os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(pluginsdk.ExitCode(err))
+	}
+}
+
+// analyzeExportCmd renders stored analyses into a Markdown (or JSON) report
+func analyzeExportCmd(args []string) {
+	fs := flag.NewFlagSet("analyze export", flag.ContinueOnError)
+	var sessionID string
+	fs.StringVar(&sessionID, "session", "", "Export all analyses of a session (newest first)")
+	fs.StringVar(&sessionID, "session-id", "", "Alias for --session")
+	analysisType := fs.String("type", "", "Filter by analysis type (e.g. session_summary, or shorthand summary)")
+	since := fs.String("since", "", "Only analyses since a duration (24h, 7d) or date (2006-01-02)")
+	format := fs.String("format", app.AnalysisExportFormatMarkdown, "Output format: markdown or json")
+	output := fs.String("output", "", "Write the report to a file instead of stdout")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: dw analyze export [--session <id>] [--type <type>] [--since <when>] [--format markdown|json] [--output <file>]")
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintln(os.Stderr, "Renders stored analyses into a shareable report, one section per analysis.")
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintln(os.Stderr, "Flags:")
+		fs.PrintDefaults()
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintln(os.Stderr, "Examples:")
+		fmt.Fprintln(os.Stderr, "  dw analyze export --session <id> --output report.md")
+		fmt.Fprintln(os.Stderr, "  dw analyze export --type summary --since 7d")
+		fmt.Fprintln(os.Stderr, "  dw analyze export --format json --output analyses.json")
+	}
+
+	if err := fs.Parse(args); err != nil {
+		if err != flag.ErrHelp {
+			os.Exit(pluginsdk.ExitUsage)
+		}
+		return
+	}
+
+	if *format != app.AnalysisExportFormatMarkdown && *format != app.AnalysisExportFormatJSON {
+		fmt.Fprintf(os.Stderr, "Error: invalid format '%s'. Valid formats: markdown, json\n", *format)
+		os.Exit(pluginsdk.ExitUsage)
+	}
+
+	sinceTime, err := app.ParseSince(*since, time.Now())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(pluginsdk.ExitUsage)
+	}
+
+	ctx := context.Background()
+
+	repo, err := infra.NewSQLiteEventRepository(app.DefaultDBPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to initialize repository: %v\n", err)
+		os.Exit(1)
+	}
+	defer repo.Close()
+
+	if err := repo.Initialize(ctx); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to initialize database schema: %v\n", err)
+		os.Exit(1)
+	}
+
+	out := os.Stdout
+	if *output != "" {
+		file, err := os.Create(*output)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: failed to create output file: %v\n", err)
+			os.Exit(1)
+		}
+		defer file.Close()
+		out = file
+	}
+
+	handler := app.NewAnalysisExportHandler(repo)
+	count, err := handler.Export(ctx, app.AnalysisExportOptions{
+		SessionID: sessionID,
+		Type:      *analysisType,
+		Since:     sinceTime,
+		Format:    *format,
+	}, out)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(pluginsdk.ExitCode(err))
+	}
+
+	if *output != "" {
+		fmt.Printf("Exported %d analysis(es) to %s\n", count, *output)
+	}
+}
@@ -22,13 +22,27 @@ type AppServices struct {
 	SetupService    *app.SetupService
 	ConfigLoader    app.ConfigLoader
 	Logger          app.Logger
-	EventRepo       interface{} // EventRepository for plugin contexts (type from internal/domain)
+	EventRepo       interface{}           // EventRepository for plugin contexts (type from internal/domain)
+	ContextOptions  []app.ContextOption   // Options applied to every plugin command context
+	PluginErrors    []app.PluginLoadError // External plugins that failed to load (non-fatal)
+	DisabledPlugins []string              // External plugins with enabled: false in plugins.yaml
 	DBPath          string
 	WorkingDir      string
 }
 
 // InitializeApp creates all infrastructure and app services
 func InitializeApp(dbPath, configPath string, debugMode bool) (*AppServices, error) {
+	return initializeApp(dbPath, configPath, debugMode, true)
+}
+
+// InitializeAppWithoutSchema creates the services like InitializeApp, but opens the
+// events database without creating or migrating its schema. Used by commands that
+// must not change the database, such as 'refresh --dry-run' and 'refresh --hooks-only'.
+func InitializeAppWithoutSchema(dbPath, configPath string, debugMode bool) (*AppServices, error) {
+	return initializeApp(dbPath, configPath, debugMode, false)
+}
+
+func initializeApp(dbPath, configPath string, debugMode, initSchema bool) (*AppServices, error) {
 	// 1. Create logger
 	var logger *infra.Logger
 	if debugMode {
@@ -51,8 +65,10 @@ func InitializeApp(dbPath, configPath string, debugMode bool) (*AppServices, err
 
 	// 3b. Initialize database schema (including analyses table)
 	ctx := context.Background()
-	if err := repo.Initialize(ctx); err != nil {
-		return nil, fmt.Errorf("failed to initialize database: %w", err)
+	if initSchema {
+		if err := repo.Initialize(ctx); err != nil {
+			return nil, fmt.Errorf("failed to initialize database: %w", err)
+		}
 	}
 
 	// 4. Load config (keep internally, cmd doesn't need it)
@@ -138,12 +154,20 @@ func InitializeApp(dbPath, configPath string, debugMode bool) (*AppServices, err
 	// 12. Load external plugins from .darwinflow/plugins.yaml
 	// dbPath is .darwinflow/logs/events.db, so we need to go up two levels to .darwinflow
 	pluginsConfigPath := filepath.Join(filepath.Dir(filepath.Dir(dbPath)), "plugins.yaml")
+	var pluginErrors []app.PluginLoadError
+	var disabledPlugins []string
 	if _, err := os.Stat(pluginsConfigPath); err == nil {
 		loader := infra.NewPluginLoader(logger)
 		externalPlugins, err := loader.LoadFromConfig(pluginsConfigPath)
 		if err != nil {
 			logger.Warn("Failed to load plugins from config: %v", err)
+			pluginErrors = append(pluginErrors, app.PluginLoadError{Plugin: pluginsConfigPath, Error: err.Error()})
 		} else {
+			disabledPlugins = loader.Disabled()
+			for _, skipped := range loader.Skipped() {
+				pluginErrors = append(pluginErrors, app.PluginLoadError{Plugin: skipped.Name, Error: skipped.Reason})
+			}
+
 			// Initialize and register each plugin
 			ctx := context.Background()
 			successCount := 0
@@ -154,6 +178,7 @@ func InitializeApp(dbPath, configPath string, debugMode bool) (*AppServices, err
 				}); ok {
 					if err := initializer.Initialize(ctx, workingDir, nil); err != nil {
 						logger.Warn("Failed to initialize external plugin: %v", err)
+						pluginErrors = append(pluginErrors, app.PluginLoadError{Plugin: externalPluginName(plugin), Error: err.Error()})
 						continue
 					}
 				}
@@ -161,6 +186,7 @@ func InitializeApp(dbPath, configPath string, debugMode bool) (*AppServices, err
 				// Register the plugin
 				if err := pluginRegistry.RegisterPlugin(plugin); err != nil {
 					logger.Warn("Failed to register external plugin: %v", err)
+					pluginErrors = append(pluginErrors, app.PluginLoadError{Plugin: externalPluginName(plugin), Error: err.Error()})
 				} else {
 					successCount++
 				}
@@ -174,6 +200,9 @@ func InitializeApp(dbPath, configPath string, debugMode bool) (*AppServices, err
 	// 13. Create command registry
 	commandRegistry := app.NewCommandRegistry(pluginRegistry, logger)
 
+	// 14. Event enrichment and sampling for events emitted through command contexts
+	gitInfo := infra.NewGitInfoLookup()
+
 	return &AppServices{
 		PluginRegistry:  pluginRegistry,
 		CommandRegistry: commandRegistry,
@@ -183,7 +212,22 @@ func InitializeApp(dbPath, configPath string, debugMode bool) (*AppServices, err
 		ConfigLoader:    configLoader,
 		Logger:          logger,
 		EventRepo:       repo,
+		ContextOptions:  []app.ContextOption{app.WithGitInfo(gitInfo), app.WithEventSampling(config.Events)},
+		PluginErrors:    pluginErrors,
+		DisabledPlugins: disabledPlugins,
 		DBPath:          dbPath,
 		WorkingDir:      workingDir,
 	}, nil
 }
+
+// externalPluginName identifies an external plugin for error reporting.
+// Falls back to the executable path when the plugin has not reported its name yet.
+func externalPluginName(plugin pluginsdk.Plugin) string {
+	if name := plugin.GetInfo().Name; name != "" {
+		return name
+	}
+	if subprocess, ok := plugin.(interface{ ExecutablePath() string }); ok {
+		return subprocess.ExecutablePath()
+	}
+	return "unknown"
+}
@@ -1,6 +1,7 @@
 package main_test
 
 import (
+	"database/sql"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -67,6 +68,31 @@ func TestInitializeApp_Success(t *testing.T) {
 	}
 }
 
+func TestInitializeAppWithoutSchema_LeavesDatabaseUnmigrated(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "test.db")
+
+	services, err := main.InitializeAppWithoutSchema(dbPath, "", false)
+	if err != nil {
+		t.Fatalf("InitializeAppWithoutSchema() failed: %v", err)
+	}
+	if services.PluginRegistry == nil || len(services.PluginRegistry.GetAllPlugins()) == 0 {
+		t.Error("plugins should be registered without the schema")
+	}
+
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	defer db.Close()
+	var tables int
+	if err := db.QueryRow("SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = 'events'").Scan(&tables); err != nil {
+		t.Fatalf("failed to query schema: %v", err)
+	}
+	if tables != 0 {
+		t.Error("events table should not be created without the schema")
+	}
+}
+
 func TestInitializeApp_DebugMode(t *testing.T) {
 	// Create temporary directory for test database
 	tmpDir := t.TempDir()
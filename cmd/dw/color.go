@@ -0,0 +1,66 @@
+package main
+
+import (
+	"os"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+// NoColorFlag is the global flag that disables ANSI colors for all commands.
+// It may appear anywhere before a "--" separator.
+const NoColorFlag = "--no-color"
+
+// StripNoColorFlag removes the global --no-color flag from args.
+// Returns the remaining args and whether the flag was present.
+// Arguments after a "--" separator are passed through untouched.
+func StripNoColorFlag(args []string) ([]string, bool) {
+	rest := make([]string, 0, len(args))
+	found := false
+	for i, arg := range args {
+		if arg == "--" {
+			rest = append(rest, args[i:]...)
+			break
+		}
+		if arg == NoColorFlag {
+			found = true
+			continue
+		}
+		rest = append(rest, arg)
+	}
+	return rest, found
+}
+
+// ColorEnabled reports whether colored output should be written to out.
+// Colors are disabled when the NO_COLOR environment variable is set (see https://no-color.org)
+// or when out is not a terminal (e.g. piped to a file or another process).
+func ColorEnabled(out *os.File) bool {
+	if os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+	return isTerminal(out)
+}
+
+// isTerminal reports whether f is a terminal
+func isTerminal(f *os.File) bool {
+	if f == nil {
+		return false
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// ConfigureColor disables ANSI colors for the whole process when noColor is set
+// or out does not support color. It exports NO_COLOR so that external plugin
+// processes inherit the setting. Returns whether colors remain enabled.
+func ConfigureColor(noColor bool, out *os.File) bool {
+	if !noColor && ColorEnabled(out) {
+		return true
+	}
+	lipgloss.SetColorProfile(termenv.Ascii)
+	os.Setenv("NO_COLOR", "1")
+	return false
+}
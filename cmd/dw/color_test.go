@@ -0,0 +1,113 @@
+package main_test
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+
+	main "github.com/kgatilin/darwinflow-pub/cmd/dw"
+)
+
+func TestStripNoColorFlag(t *testing.T) {
+	tests := []struct {
+		name      string
+		args      []string
+		wantArgs  []string
+		wantFound bool
+	}{
+		{
+			name:      "no flag",
+			args:      []string{"logs", "--limit", "5"},
+			wantArgs:  []string{"logs", "--limit", "5"},
+			wantFound: false,
+		},
+		{
+			name:      "leading flag",
+			args:      []string{"--no-color", "task-manager", "ac", "list"},
+			wantArgs:  []string{"task-manager", "ac", "list"},
+			wantFound: true,
+		},
+		{
+			name:      "trailing flag",
+			args:      []string{"logs", "--no-color"},
+			wantArgs:  []string{"logs"},
+			wantFound: true,
+		},
+		{
+			name:      "flag after separator is kept",
+			args:      []string{"logs", "--", "--no-color"},
+			wantArgs:  []string{"logs", "--", "--no-color"},
+			wantFound: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotArgs, gotFound := main.StripNoColorFlag(tt.args)
+			if !reflect.DeepEqual(gotArgs, tt.wantArgs) {
+				t.Errorf("StripNoColorFlag() args = %v, want %v", gotArgs, tt.wantArgs)
+			}
+			if gotFound != tt.wantFound {
+				t.Errorf("StripNoColorFlag() found = %v, want %v", gotFound, tt.wantFound)
+			}
+		})
+	}
+}
+
+func TestColorEnabled_NonTTYAndNoColorEnv(t *testing.T) {
+	t.Setenv("NO_COLOR", "")
+
+	f, err := os.Create(filepath.Join(t.TempDir(), "out.txt"))
+	if err != nil {
+		t.Fatalf("failed to create file: %v", err)
+	}
+	defer f.Close()
+
+	if main.ColorEnabled(f) {
+		t.Error("expected color to be disabled for a regular file")
+	}
+
+	t.Setenv("NO_COLOR", "1")
+	if main.ColorEnabled(os.Stdout) {
+		t.Error("expected color to be disabled when NO_COLOR is set")
+	}
+}
+
+func TestConfigureColor_NoEscapeSequences(t *testing.T) {
+	t.Setenv("NO_COLOR", "")
+	originalProfile := lipgloss.ColorProfile()
+	t.Cleanup(func() { lipgloss.SetColorProfile(originalProfile) })
+
+	style := lipgloss.NewStyle().Foreground(lipgloss.Color("#FF0000")).Bold(true)
+
+	// Sanity check: with a color profile, styled output contains escape codes
+	lipgloss.SetColorProfile(termenv.TrueColor)
+	if !strings.Contains(style.Render("status"), "\x1b[") {
+		t.Fatal("expected escape sequences with a true color profile")
+	}
+
+	if main.ConfigureColor(true, os.Stdout) {
+		t.Error("expected ConfigureColor to report colors disabled")
+	}
+	if os.Getenv("NO_COLOR") == "" {
+		t.Error("expected NO_COLOR to be exported for plugin processes")
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintln(&buf, style.Render("status"))
+	buf.WriteString(captureStdout(main.PrintLogsHelp))
+
+	if strings.Contains(buf.String(), "\x1b[") {
+		t.Errorf("expected no escape sequences in output, got %q", buf.String())
+	}
+	if !strings.Contains(buf.String(), "status") {
+		t.Errorf("expected plain text to be preserved, got %q", buf.String())
+	}
+}
@@ -5,9 +5,11 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/kgatilin/darwinflow-pub/internal/app"
 	"github.com/kgatilin/darwinflow-pub/internal/infra"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
 )
 
 func configCmd(args []string) {
@@ -17,7 +19,9 @@ func configCmd(args []string) {
 		fmt.Fprintln(os.Stderr, "Subcommands:")
 		fmt.Fprintln(os.Stderr, "  init    Create a default .darwinflow.yaml config file")
 		fmt.Fprintln(os.Stderr, "  show    Display the current configuration")
-		os.Exit(1)
+		fmt.Fprintln(os.Stderr, "  get     Show effective values and their sources (dw config get <key> | --all)")
+		fmt.Fprintln(os.Stderr, "  set     Set a configuration value (dw config set <key> <value>)")
+		os.Exit(pluginsdk.ExitUsage)
 	}
 
 	subcommand := args[0]
@@ -28,9 +32,13 @@ func configCmd(args []string) {
 		configInitCmd(subArgs)
 	case "show":
 		configShowCmd(subArgs)
+	case "get":
+		configGetCmd(subArgs)
+	case "set":
+		configSetCmd(subArgs)
 	default:
 		fmt.Fprintf(os.Stderr, "Unknown config subcommand: %s\n", subcommand)
-		os.Exit(1)
+		os.Exit(pluginsdk.ExitUsage)
 	}
 }
 
@@ -43,7 +51,7 @@ func configInitCmd(args []string) {
 	if err := fs.Parse(args); err != nil {
 		if err != flag.ErrHelp {
 			fmt.Fprintf(os.Stderr, "Error parsing flags: %v\n", err)
-			os.Exit(1)
+			os.Exit(pluginsdk.ExitUsage)
 		}
 		return
 	}
@@ -74,7 +82,7 @@ func configInitCmd(args []string) {
 	if err := handler.Init(ctx, "", *force); err != nil {
 		logger.Error("Failed to create config: %v", err)
 		fmt.Fprintf(os.Stderr, "Failed to create config: %v\n", err)
-		os.Exit(1)
+		os.Exit(pluginsdk.ExitCode(err))
 	}
 }
 
@@ -86,7 +94,7 @@ func configShowCmd(args []string) {
 	if err := fs.Parse(args); err != nil {
 		if err != flag.ErrHelp {
 			fmt.Fprintf(os.Stderr, "Error parsing flags: %v\n", err)
-			os.Exit(1)
+			os.Exit(pluginsdk.ExitUsage)
 		}
 		return
 	}
@@ -109,6 +117,126 @@ func configShowCmd(args []string) {
 	if err := handler.Show(ctx); err != nil {
 		logger.Error("Failed to load config: %v", err)
 		fmt.Fprintf(os.Stderr, "Failed to load config: %v\n", err)
-		os.Exit(1)
+		os.Exit(pluginsdk.ExitCode(err))
+	}
+}
+
+func configGetCmd(args []string) {
+	fs := flag.NewFlagSet("config get", flag.ContinueOnError)
+	all := fs.Bool("all", false, "Show every known key")
+	jsonOutput := fs.Bool("json", false, "Output as JSON")
+	debug := fs.Bool("debug", false, "Enable debug logging")
+	debugShort := fs.Bool("d", false, "Enable debug logging (short flag)")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: dw config get <key> [--json]")
+		fmt.Fprintln(os.Stderr, "       dw config get --all [--json]")
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintln(os.Stderr, "Shows the effective value of config keys, including defaults, and where each")
+		fmt.Fprintln(os.Stderr, "value comes from: default, config (.darwinflow.yaml) or env. Keys accepted by")
+		fmt.Fprintln(os.Stderr, "'dw config set' can be overridden with DW_<KEY>, e.g. DW_LOGS_DEFAULT_LIMIT.")
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintln(os.Stderr, "Examples:")
+		fmt.Fprintln(os.Stderr, "  dw config get logs.default_limit")
+		fmt.Fprintln(os.Stderr, "  dw config get --all")
+		fmt.Fprintln(os.Stderr, "  dw config get --all --json")
+	}
+
+	if err := fs.Parse(args); err != nil {
+		if err != flag.ErrHelp {
+			fmt.Fprintf(os.Stderr, "Error parsing flags: %v\n", err)
+			os.Exit(pluginsdk.ExitUsage)
+		}
+		return
+	}
+	// Allow flags after the key (dw config get <key> --json)
+	key := fs.Arg(0)
+	if fs.NArg() > 0 {
+		if err := fs.Parse(fs.Args()[1:]); err != nil {
+			fmt.Fprintf(os.Stderr, "Error parsing flags: %v\n", err)
+			os.Exit(pluginsdk.ExitUsage)
+		}
+	}
+	if (key == "") == !*all || fs.NArg() > 0 {
+		fs.Usage()
+		os.Exit(pluginsdk.ExitUsage)
+	}
+
+	// Create logger
+	var logger *infra.Logger
+	if *debug || *debugShort {
+		logger = infra.NewDebugLogger()
+	} else {
+		logger = infra.NewDefaultLogger()
+	}
+
+	configLoader := infra.NewConfigLoader(logger)
+
+	// Create handler
+	handler := app.NewConfigCommandHandler(configLoader, logger, os.Stdout)
+
+	// Execute
+	ctx := context.Background()
+	var err error
+	if *all {
+		err = handler.GetAll(ctx, "", *jsonOutput)
+	} else {
+		err = handler.Get(ctx, "", key, *jsonOutput)
+	}
+	if err != nil {
+		logger.Error("Failed to get config: %v", err)
+		fmt.Fprintf(os.Stderr, "Failed to get config: %v\n", err)
+		os.Exit(pluginsdk.ExitCode(err))
+	}
+}
+
+func configSetCmd(args []string) {
+	fs := flag.NewFlagSet("config set", flag.ContinueOnError)
+	debug := fs.Bool("debug", false, "Enable debug logging")
+	debugShort := fs.Bool("d", false, "Enable debug logging (short flag)")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: dw config set <key> <value>")
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintln(os.Stderr, "Updates a value in .darwinflow.yaml, creating the file if needed.")
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintf(os.Stderr, "Keys: %s\n", strings.Join(app.ConfigSetKeys(), ", "))
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintln(os.Stderr, "Examples:")
+		fmt.Fprintln(os.Stderr, "  dw config set logs.default_limit 100")
+		fmt.Fprintln(os.Stderr, "  dw config set logs.default_limit 0     # dw logs shows all logs")
+		fmt.Fprintln(os.Stderr, "  dw config set events.sample.tool.result 0.1   # store ~10% of tool.result events")
+		fmt.Fprintln(os.Stderr, "  dw config set events.sample.tool.result 1     # store all tool.result events again")
+	}
+
+	if err := fs.Parse(args); err != nil {
+		if err != flag.ErrHelp {
+			fmt.Fprintf(os.Stderr, "Error parsing flags: %v\n", err)
+			os.Exit(pluginsdk.ExitUsage)
+		}
+		return
+	}
+	if fs.NArg() != 2 {
+		fs.Usage()
+		os.Exit(pluginsdk.ExitUsage)
+	}
+
+	// Create logger
+	var logger *infra.Logger
+	if *debug || *debugShort {
+		logger = infra.NewDebugLogger()
+	} else {
+		logger = infra.NewDefaultLogger()
+	}
+
+	configLoader := infra.NewConfigLoader(logger)
+
+	// Create handler
+	handler := app.NewConfigCommandHandler(configLoader, logger, os.Stdout)
+
+	// Execute
+	ctx := context.Background()
+	if err := handler.Set(ctx, "", fs.Arg(0), fs.Arg(1)); err != nil {
+		logger.Error("Failed to set config: %v", err)
+		fmt.Fprintf(os.Stderr, "Failed to set config: %v\n", err)
+		os.Exit(pluginsdk.ExitCode(err))
 	}
 }
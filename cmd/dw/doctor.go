@@ -0,0 +1,122 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/kgatilin/darwinflow-pub/internal/app"
+	"github.com/kgatilin/darwinflow-pub/internal/infra"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/claude_code"
+)
+
+// handleDoctor runs environment diagnostics and prints a checklist.
+// It runs before app initialization so that a missing or outdated database is
+// reported instead of being created or migrated. Exits nonzero if any check fails.
+func handleDoctor(args []string) {
+	for _, arg := range args {
+		if arg == "--help" || arg == "-h" {
+			fmt.Println("Usage: dw doctor")
+			fmt.Println()
+			fmt.Println("Checks the DarwinFlow setup in the current directory and prints a checklist")
+			fmt.Println("with remediation hints:")
+			fmt.Println("  - working directory contains .darwinflow")
+			fmt.Println("  - events database exists, has the current schema and is queryable")
+			fmt.Println("  - Claude Code hooks are installed and current")
+			fmt.Println("  - every plugin loads and passes its health check (including the active project)")
+			fmt.Println()
+			fmt.Println("Exits with status 1 if any check fails.")
+			return
+		}
+	}
+
+	ctx := context.Background()
+	report := &app.DoctorReport{}
+
+	// Working directory
+	workingDir, err := os.Getwd()
+	if err != nil {
+		workingDir = "."
+	}
+	darwinflowDir := filepath.Dir(filepath.Dir(app.DefaultDBPath))
+	if info, err := os.Stat(darwinflowDir); err == nil && info.IsDir() {
+		report.Pass("Working directory", workingDir)
+	} else {
+		report.Fail("Working directory", fmt.Sprintf("no %s directory in %s", darwinflowDir, workingDir),
+			"run dw from your project root, or run 'dw init' to set up this directory")
+	}
+
+	// Events database
+	dbExists := false
+	if _, err := os.Stat(app.DefaultDBPath); err == nil {
+		dbExists = true
+		report.Pass("Database", app.DefaultDBPath)
+	} else {
+		report.Fail("Database", fmt.Sprintf("not found at %s", app.DefaultDBPath), "run 'dw init'")
+	}
+
+	if dbExists {
+		repo, err := infra.NewSQLiteEventRepository(app.DefaultDBPath)
+		if err != nil {
+			report.Fail("Database schema", err.Error(), "check file permissions on "+app.DefaultDBPath)
+			report.Skip("Events table", "database could not be opened")
+		} else {
+			app.CheckEventsSchema(ctx, report, repo)
+			app.CheckEventsQueryable(ctx, report, repo)
+			repo.Close()
+		}
+	} else {
+		report.Skip("Database schema", "no database")
+		report.Skip("Events table", "no database")
+	}
+
+	// Claude Code hooks
+	checkHooks(report)
+
+	// Plugins (loading them initializes the database, so only when it already exists)
+	if dbExists {
+		services, err := InitializeApp(app.DefaultDBPath, "", false)
+		if err != nil {
+			report.Fail("Plugins", fmt.Sprintf("failed to initialize: %v", err), "run 'dw refresh'")
+		} else {
+			app.CheckPluginHealth(ctx, report, services.PluginRegistry.GetAllPlugins(), services.PluginErrors)
+		}
+	} else {
+		report.Skip("Plugins", "no database")
+	}
+
+	report.Print(os.Stdout)
+	if !report.Healthy() {
+		os.Exit(1)
+	}
+}
+
+// checkHooks verifies the DarwinFlow hooks are present in the Claude Code settings
+func checkHooks(report *app.DoctorReport) {
+	const name = "Claude Code hooks"
+	const hint = "run 'dw claude init' to install the hooks"
+
+	manager, err := claude_code.NewHookConfigManager()
+	if err != nil {
+		report.Fail(name, err.Error(), hint)
+		return
+	}
+	settingsPath := manager.GetSettingsPath()
+	if _, err := os.Stat(settingsPath); os.IsNotExist(err) {
+		report.Fail(name, fmt.Sprintf("not installed (%s not found)", settingsPath), hint)
+		return
+	}
+
+	missing, err := manager.MissingDarwinFlowHooks()
+	if err != nil {
+		report.Fail(name, err.Error(), "fix the JSON in "+settingsPath+", then "+hint)
+		return
+	}
+	if len(missing) > 0 {
+		report.Fail(name, "outdated, missing "+strings.Join(missing, ", "), hint)
+		return
+	}
+	report.Pass(name, "installed in "+settingsPath)
+}
@@ -2,21 +2,71 @@ package main
 
 import (
 	"context"
+	"errors"
+	"flag"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 
 	"github.com/kgatilin/darwinflow-pub/internal/app"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
 )
 
+// InitOptions holds the options of the init command
+type InitOptions struct {
+	Template      string
+	ListTemplates bool
+	Wizard        bool
+}
+
+// ParseInitFlags parses the flags of the init command. An unknown template is
+// rejected here, before anything is created.
+func ParseInitFlags(args []string) (*InitOptions, error) {
+	fs := flag.NewFlagSet("init", flag.ContinueOnError)
+	opts := &InitOptions{}
+
+	fs.StringVar(&opts.Template, "template", app.StarterTemplateNone, "Seed a starter roadmap from a template (see --list-templates)")
+	fs.BoolVar(&opts.ListTemplates, "list-templates", false, "List the available templates and exit")
+	fs.BoolVar(&opts.Wizard, "wizard", false, "Set up the roadmap, a first track and a first iteration interactively, then open 'dw ui'")
+
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if opts.Template != app.StarterTemplateNone {
+		if _, err := app.FindStarterTemplate(opts.Template); err != nil {
+			return nil, err
+		}
+		if opts.Wizard {
+			return nil, fmt.Errorf("%w: --wizard and --template cannot be combined", pluginsdk.ErrInvalidArgument)
+		}
+	}
+	return opts, nil
+}
+
 // handleInit orchestrates the initialization of DarwinFlow:
 // 1. Creates the event database
 // 2. Initializes framework infrastructure (database schema)
 // 3. Discovers and registers plugins
 // 4. Calls each plugin's init command (which handles plugin-specific setup like hooks)
+// 5. Seeds a starter roadmap when --template is given
+// 6. Runs the first-run wizard and opens the TUI when --wizard is given
 func handleInit(args []string) {
 	ctx := context.Background()
 
+	opts, err := ParseInitFlags(args)
+	if err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return
+		}
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(pluginsdk.ExitUsage)
+	}
+	if opts.ListTemplates {
+		app.FormatStarterTemplates(os.Stdout)
+		return
+	}
+
 	fmt.Println("Initializing DarwinFlow...")
 	fmt.Println()
 
@@ -60,7 +110,25 @@ func handleInit(args []string) {
 	for _, info := range pluginInfos {
 		if err := initializePlugin(ctx, services, info.Name); err != nil {
 			fmt.Fprintf(os.Stderr, "Error initializing plugin %s: %v\n", info.Name, err)
-			os.Exit(1)
+			os.Exit(pluginsdk.ExitCode(err))
+		}
+	}
+
+	// 6. Seed the starter roadmap
+	if opts.Template != app.StarterTemplateNone {
+		fmt.Println()
+		if err := seedStarterTemplate(ctx, services, opts.Template); err != nil {
+			fmt.Fprintf(os.Stderr, "Error seeding template %s: %v\n", opts.Template, err)
+			os.Exit(pluginsdk.ExitCode(err))
+		}
+	}
+
+	// 7. Walk through the first roadmap, track and iteration
+	if opts.Wizard {
+		fmt.Println()
+		if err := runFirstRunWizard(ctx, services); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(pluginsdk.ExitCode(err))
 		}
 	}
 
@@ -73,6 +141,12 @@ func handleInit(args []string) {
 	fmt.Println("  3. Use 'dw logs' to view logged events")
 	fmt.Println("  4. Use 'dw ui' to browse sessions interactively")
 	fmt.Println("  5. Use 'dw analyze' to analyze sessions")
+
+	if opts.Wizard && isTerminal(os.Stdin) {
+		fmt.Println()
+		fmt.Println("Opening 'dw ui'...")
+		uiCommand([]string{"--no-wizard"})
+	}
 }
 
 // createEventDatabase ensures the database directory exists and is writable
@@ -105,14 +179,14 @@ func initializePlugin(ctx context.Context, services *AppServices, pluginName str
 		services.EventRepo,
 		os.Stdout,
 		os.Stdin,
+		services.ContextOptions...,
 	)
 
 	// Try to execute the init command via the command registry
 	fmt.Printf("  → Running: dw %s init\n", pluginName)
 	if err := services.CommandRegistry.ExecuteCommand(ctx, pluginName, "init", []string{}, cmdCtx); err != nil {
-		// If the error is "command not found", the plugin doesn't have an init command
-		// This is fine - just skip silently
-		if err.Error() == fmt.Sprintf("command not found: %s init", pluginName) {
+		// If the plugin doesn't have an init command, that's fine - just skip silently
+		if errors.Is(err, app.ErrCommandNotFound) {
 			return nil
 		}
 		return fmt.Errorf("init command failed: %w", err)
@@ -120,3 +194,39 @@ func initializePlugin(ctx context.Context, services *AppServices, pluginName str
 
 	return nil
 }
+
+// seedStarterTemplate seeds the named starter template into the active project.
+// The task-manager commands it runs report to the seeder's summary only.
+func seedStarterTemplate(ctx context.Context, services *AppServices, name string) error {
+	tmpl, err := app.FindStarterTemplate(name)
+	if err != nil {
+		return err
+	}
+	cmdCtx := app.NewCommandContext(
+		services.Logger,
+		services.DBPath,
+		services.WorkingDir,
+		services.EventRepo,
+		io.Discard,
+		os.Stdin,
+		services.ContextOptions...,
+	)
+	_, err = app.NewStarterTemplateSeeder(services.CommandRegistry, os.Stdout).Seed(ctx, tmpl, cmdCtx)
+	return err
+}
+
+// runFirstRunWizard runs the first-run wizard on stdin. Like seeding, the
+// task-manager commands it runs report to the wizard's own output only.
+func runFirstRunWizard(ctx context.Context, services *AppServices) error {
+	cmdCtx := app.NewCommandContext(
+		services.Logger,
+		services.DBPath,
+		services.WorkingDir,
+		services.EventRepo,
+		io.Discard,
+		os.Stdin,
+		services.ContextOptions...,
+	)
+	_, err := app.NewFirstRunWizard(services.CommandRegistry, os.Stdin, os.Stdout).Run(ctx, cmdCtx)
+	return err
+}
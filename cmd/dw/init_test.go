@@ -0,0 +1,58 @@
+package main_test
+
+import (
+	"errors"
+	"testing"
+
+	main "github.com/kgatilin/darwinflow-pub/cmd/dw"
+	"github.com/kgatilin/darwinflow-pub/internal/app"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+func TestParseInitFlags(t *testing.T) {
+	opts, err := main.ParseInitFlags(nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if opts.Template != app.StarterTemplateNone || opts.ListTemplates {
+		t.Errorf("expected no template by default, got %+v", opts)
+	}
+
+	opts, err = main.ParseInitFlags([]string{"--template", "web-app"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if opts.Template != "web-app" {
+		t.Errorf("expected web-app, got %q", opts.Template)
+	}
+
+	opts, err = main.ParseInitFlags([]string{"--list-templates"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !opts.ListTemplates {
+		t.Error("expected ListTemplates to be set")
+	}
+}
+
+func TestParseInitFlags_UnknownTemplate(t *testing.T) {
+	_, err := main.ParseInitFlags([]string{"--template", "game"})
+	if !errors.Is(err, pluginsdk.ErrInvalidArgument) {
+		t.Fatalf("expected invalid argument error, got %v", err)
+	}
+}
+
+func TestParseInitFlags_Wizard(t *testing.T) {
+	opts, err := main.ParseInitFlags([]string{"--wizard"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !opts.Wizard {
+		t.Error("expected Wizard to be set")
+	}
+
+	_, err = main.ParseInitFlags([]string{"--wizard", "--template", "web-app"})
+	if !errors.Is(err, pluginsdk.ErrInvalidArgument) {
+		t.Fatalf("expected --wizard with --template to be rejected, got %v", err)
+	}
+}
@@ -2,12 +2,20 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
 
 	"github.com/kgatilin/darwinflow-pub/internal/app"
+	"github.com/kgatilin/darwinflow-pub/internal/domain"
 	"github.com/kgatilin/darwinflow-pub/internal/infra"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+	"golang.org/x/term"
 )
 
 // LogsOptions contains options for the logs command
@@ -16,22 +24,46 @@ type LogsOptions struct {
 	SessionLimit int
 	Query        string
 	SessionID    string
+	Source       string
+	Category     string
+	Labels       []string
 	Ordered      bool
 	Format       string
+	Search       string
+	In           string
+	Regex        bool
+	All          bool
+	Full         bool
+	Wrap         bool
 	Help         bool
 }
 
-// ParseLogsFlags parses command line flags for the logs command
+// ParseLogsFlags parses command line flags for the logs command using the built-in default limit
 func ParseLogsFlags(args []string) (*LogsOptions, error) {
+	return ParseLogsFlagsWithDefault(args, domain.DefaultLogsLimit)
+}
+
+// ParseLogsFlagsWithDefault parses command line flags for the logs command.
+// defaultLimit applies when --limit is not given; --all overrides any limit.
+func ParseLogsFlagsWithDefault(args []string, defaultLimit int) (*LogsOptions, error) {
 	fs := flag.NewFlagSet("logs", flag.ContinueOnError)
 	opts := &LogsOptions{}
 
-	fs.IntVar(&opts.Limit, "limit", 20, "Number of most recent logs to display")
+	fs.IntVar(&opts.Limit, "limit", defaultLimit, "Number of most recent logs to display (0 = all)")
+	fs.BoolVar(&opts.All, "all", false, "Show all logs (same as --limit 0)")
 	fs.IntVar(&opts.SessionLimit, "session-limit", 0, "Limit by number of sessions instead of logs (0 = use --limit)")
 	fs.StringVar(&opts.Query, "query", "", "Arbitrary SQL query to execute")
 	fs.StringVar(&opts.SessionID, "session-id", "", "Filter logs by session ID")
+	fs.StringVar(&opts.Source, "source", "", "Filter logs by event source (e.g. claude)")
+	fs.StringVar(&opts.Category, "category", "", "Filter logs by event category (e.g. tool, chat)")
+	fs.Var((*labelListFlag)(&opts.Labels), "label", "Filter logs by label (repeatable or comma-separated; any label matches)")
 	fs.BoolVar(&opts.Ordered, "ordered", false, "Order by timestamp ASC and session ID (chronological)")
 	fs.StringVar(&opts.Format, "format", "text", "Output format: text, csv, or markdown")
+	fs.StringVar(&opts.Search, "search", "", "Search logs for text (or a regex with --regex)")
+	fs.StringVar(&opts.In, "in", "", "Field to search: content (default), payload, or both")
+	fs.BoolVar(&opts.Regex, "regex", false, "Treat --search as a Go regular expression")
+	fs.BoolVar(&opts.Full, "full", false, "Show event content in full instead of truncating it")
+	fs.BoolVar(&opts.Wrap, "wrap", false, "Wrap event content to the terminal width instead of truncating it")
 	fs.BoolVar(&opts.Help, "help", false, "Show help and database schema")
 
 	fs.Usage = printLogsUsage
@@ -40,13 +72,104 @@ func ParseLogsFlags(args []string) (*LogsOptions, error) {
 		return nil, err
 	}
 
+	if opts.All {
+		opts.Limit = 0
+	}
+
 	return opts, nil
 }
 
+// LogsDefaultLimit returns the logs.default_limit setting from the config file
+// (empty path = .darwinflow.yaml in the current directory), falling back to
+// domain.DefaultLogsLimit when the config cannot be read
+func LogsDefaultLimit(configPath string) int {
+	config, err := infra.NewConfigLoader(nil).LoadConfig(configPath)
+	if err != nil {
+		return domain.DefaultLogsLimit
+	}
+	return config.Logs.EffectiveDefaultLimit()
+}
+
+// LogsContentWidth returns the logs.content_width setting from the config file
+// (empty path = .darwinflow.yaml in the current directory), falling back to
+// domain.DefaultLogsContentWidth when the config cannot be read
+func LogsContentWidth(configPath string) int {
+	config, err := infra.NewConfigLoader(nil).LoadConfig(configPath)
+	if err != nil {
+		return domain.DefaultLogsContentWidth
+	}
+	return config.Logs.EffectiveContentWidth()
+}
+
+// logsContentOptions turns --full and --wrap into content display options.
+// Wrapping shows the whole content, so it implies --full.
+func logsContentOptions(opts *LogsOptions, configuredWidth int) app.LogContentOptions {
+	content := app.LogContentOptions{Width: configuredWidth}
+	if opts.Full || opts.Wrap {
+		content.Width = 0
+	}
+	if opts.Wrap {
+		content.WrapWidth = terminalWidth(os.Stdout)
+	}
+	return content
+}
+
+// defaultTerminalWidth is used when the output is not a terminal
+const defaultTerminalWidth = 100
+
+// terminalWidth returns the width of the terminal f writes to, or defaultTerminalWidth
+func terminalWidth(f *os.File) int {
+	if width, _, err := term.GetSize(int(f.Fd())); err == nil && width > 0 {
+		return width
+	}
+	return defaultTerminalWidth
+}
+
 func handleLogs(args []string) {
-	opts, err := ParseLogsFlags(args)
+	if len(args) > 0 && args[0] == "sessions" {
+		handleLogsSessions(args[1:])
+		return
+	}
+	if len(args) > 0 && args[0] == "emit" {
+		handleLogsEmit(args[1:])
+		return
+	}
+	if len(args) > 0 && args[0] == "dedupe" {
+		handleLogsDedupe(args[1:])
+		return
+	}
+	if len(args) > 0 && args[0] == "export" {
+		handleLogsExport(args[1:])
+		return
+	}
+	if len(args) > 0 && args[0] == "watch" {
+		handleLogsWatch(args[1:])
+		return
+	}
+	if len(args) > 0 && args[0] == "merge-session" {
+		handleLogsMergeSession(args[1:])
+		return
+	}
+	if len(args) > 0 && args[0] == "delete-session" {
+		handleLogsDeleteSession(args[1:])
+		return
+	}
+	if len(args) > 0 && args[0] == "histogram" {
+		handleLogsHistogram(args[1:])
+		return
+	}
+	if len(args) > 0 && args[0] == "annotate" {
+		handleLogsAnnotate(args[1:])
+		return
+	}
+	if len(args) > 0 && args[0] == "labels" {
+		handleLogsLabels(args[1:])
+		return
+	}
+
+	opts, err := ParseLogsFlagsWithDefault(args, LogsDefaultLimit(""))
 	if err != nil {
-		os.Exit(1)
+		os.Exit(pluginsdk.ExitUsage)
 	}
 
 	// Show help if requested
@@ -55,6 +178,16 @@ func handleLogs(args []string) {
 		return
 	}
 
+	if err := domain.ValidateEventKindFilter(opts.Source, opts.Category); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(pluginsdk.ExitUsage)
+	}
+
+	if opts.Search == "" && (opts.In != "" || opts.Regex) {
+		fmt.Fprintf(os.Stderr, "Error: --in and --regex require --search\n")
+		os.Exit(pluginsdk.ExitUsage)
+	}
+
 	dbPath := app.DefaultDBPath
 
 	// Check if database exists
@@ -81,43 +214,991 @@ func handleLogs(args []string) {
 
 	service := app.NewLogsService(repo, repo)
 	handler := app.NewLogsCommandHandler(service, os.Stdout)
+	handler.SetErrorOutput(os.Stderr)
+	handler.SetContentOptions(logsContentOptions(opts, LogsContentWidth("")))
 
 	// Handle arbitrary SQL query
 	if opts.Query != "" {
 		if err := handler.ExecuteRawQuery(ctx, opts.Query); err != nil {
 			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-			os.Exit(1)
+			os.Exit(pluginsdk.ExitCode(err))
+		}
+		return
+	}
+
+	// Handle search
+	if opts.Search != "" {
+		searchOpts := app.LogSearchOptions{
+			Text:      opts.Search,
+			In:        opts.In,
+			Regex:     opts.Regex,
+			SessionID: opts.SessionID,
+			Source:    opts.Source,
+			Category:  opts.Category,
+			Labels:    opts.Labels,
+			Limit:     opts.Limit,
+		}
+		if err := handler.SearchLogs(ctx, searchOpts, opts.Format); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(pluginsdk.ExitCode(err))
 		}
 		return
 	}
 
 	// Handle standard log listing
-	if err := handler.ListLogs(ctx, opts.Limit, opts.SessionLimit, opts.SessionID, opts.Ordered, opts.Format); err != nil {
+	filter := app.LogFilter{SessionID: opts.SessionID, Source: opts.Source, Category: opts.Category, Labels: opts.Labels}
+	if err := handler.ListLogs(ctx, opts.Limit, opts.SessionLimit, filter, opts.Ordered, opts.Format); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(pluginsdk.ExitCode(err))
+	}
+}
+
+// ParseLogsSessionsFlags parses command line flags for the logs sessions command
+func ParseLogsSessionsFlags(args []string) (*app.SessionListOptions, error) {
+	fs := flag.NewFlagSet("logs sessions", flag.ContinueOnError)
+	opts := &app.SessionListOptions{}
+
+	fs.IntVar(&opts.Limit, "limit", 20, "Number of most recent sessions to display (0 = all)")
+	fs.BoolVar(&opts.Unanalyzed, "unanalyzed", false, "Only show sessions without an analysis")
+	fs.BoolVar(&opts.JSON, "json", false, "Output JSON for tooling")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: dw logs sessions [--limit N] [--unanalyzed] [--json]")
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintln(os.Stderr, "Lists sessions with first/last event time, event count and analysis status,")
+		fmt.Fprintln(os.Stderr, "most recent first.")
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintln(os.Stderr, "Flags:")
+		fs.PrintDefaults()
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintln(os.Stderr, "Examples:")
+		fmt.Fprintln(os.Stderr, "  dw logs sessions")
+		fmt.Fprintln(os.Stderr, "  dw logs sessions --unanalyzed --limit 0")
+		fmt.Fprintln(os.Stderr, "  dw logs sessions --json | jq '.sessions[].session_id'")
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+
+	return opts, nil
+}
+
+func handleLogsSessions(args []string) {
+	opts, err := ParseLogsSessionsFlags(args)
+	if err != nil {
+		if err == flag.ErrHelp {
+			return
+		}
+		os.Exit(pluginsdk.ExitUsage)
+	}
+
+	dbPath := app.DefaultDBPath
+	if _, err := os.Stat(dbPath); os.IsNotExist(err) {
+		fmt.Fprintf(os.Stderr, "Error: Database not found at %s\n", dbPath)
+		fmt.Fprintf(os.Stderr, "Run 'dw claude init' to initialize logging.\n")
+		os.Exit(1)
+	}
+
+	repo, err := infra.NewSQLiteEventRepository(dbPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: Failed to open database: %v\n", err)
+		os.Exit(1)
+	}
+	defer repo.Close()
+
+	ctx := context.Background()
+	if err := repo.Initialize(ctx); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: Failed to initialize database: %v\n", err)
+		os.Exit(1)
+	}
+
+	handler := app.NewSessionListHandler(repo)
+	if err := handler.List(ctx, *opts, os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(pluginsdk.ExitCode(err))
+	}
+}
+
+// LogsEmitOptions contains options for the logs emit command
+type LogsEmitOptions struct {
+	app.LogEmitOptions
+	DBPath string
+}
+
+// ParseLogsEmitFlags parses command line flags for the logs emit command
+func ParseLogsEmitFlags(args []string) (*LogsEmitOptions, error) {
+	fs := flag.NewFlagSet("logs emit", flag.ContinueOnError)
+	opts := &LogsEmitOptions{}
+
+	fs.StringVar(&opts.Type, "type", "", "Event type (required)")
+	fs.StringVar(&opts.SessionID, "session", "", "Session ID the event belongs to (required)")
+	fs.StringVar(&opts.Payload, "payload", "", "Event payload as a JSON object (default: {})")
+	fs.StringVar(&opts.Content, "content", "", "Searchable content (default: type and payload)")
+	fs.StringVar(&opts.DBPath, "db", app.DefaultDBPath, "Path to SQLite database")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: dw logs emit --type <type> --session <id> [--payload '{...}'] [--content TEXT] [--db PATH]")
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintln(os.Stderr, "Logs an event from a script, e.g. to mark a point in a session. The event")
+		fmt.Fprintln(os.Stderr, "gets a generated ID, the current time and version 1.0, and records the")
+		fmt.Fprintln(os.Stderr, "working directory and git state like events captured by hooks.")
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintln(os.Stderr, "Flags:")
+		fs.PrintDefaults()
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintln(os.Stderr, "Examples:")
+		fmt.Fprintln(os.Stderr, "  dw logs emit --type marker --session abc123 --content \"deploy started\"")
+		fmt.Fprintln(os.Stderr, "  dw logs emit --type build.failed --session abc123 --payload '{\"error\": \"exit 2\"}'")
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if fs.NArg() > 0 {
+		fmt.Fprintf(os.Stderr, "Error: unexpected argument %q\n", fs.Arg(0))
+		return nil, fmt.Errorf("unexpected argument %q", fs.Arg(0))
+	}
+
+	return opts, nil
+}
+
+func handleLogsEmit(args []string) {
+	opts, err := ParseLogsEmitFlags(args)
+	if err != nil {
+		if err == flag.ErrHelp {
+			return
+		}
+		os.Exit(pluginsdk.ExitUsage)
+	}
+
+	if _, err := os.Stat(opts.DBPath); os.IsNotExist(err) {
+		fmt.Fprintf(os.Stderr, "Error: Database not found at %s\n", opts.DBPath)
+		fmt.Fprintf(os.Stderr, "Run 'dw claude init' to initialize logging.\n")
+		os.Exit(1)
+	}
+
+	repo, err := infra.NewSQLiteEventRepository(opts.DBPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: Failed to open database: %v\n", err)
+		os.Exit(1)
+	}
+	defer repo.Close()
+
+	ctx := context.Background()
+	if err := repo.Initialize(ctx); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: Failed to initialize database: %v\n", err)
+		os.Exit(1)
+	}
+
+	workingDir, _ := os.Getwd()
+	handler := app.NewLogEmitHandler(repo, workingDir, infra.NewGitInfoLookup())
+	if _, err := handler.Emit(ctx, opts.LogEmitOptions, os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(pluginsdk.ExitCode(err))
+	}
+}
+
+// LogsDedupeOptions contains options for the logs dedupe command
+type LogsDedupeOptions struct {
+	app.LogDedupeOptions
+	DBPath string
+}
+
+// ParseLogsDedupeFlags parses command line flags for the logs dedupe command
+func ParseLogsDedupeFlags(args []string) (*LogsDedupeOptions, error) {
+	fs := flag.NewFlagSet("logs dedupe", flag.ContinueOnError)
+	opts := &LogsDedupeOptions{}
+
+	var key string
+	fs.StringVar(&key, "key", strings.Join(domain.DefaultDedupeKey, ","),
+		"Comma-separated fields events must share to be duplicates: "+strings.Join(domain.DedupeKeyFields, ", "))
+	fs.BoolVar(&opts.DryRun, "dry-run", false, "List duplicate groups without deleting")
+	fs.StringVar(&opts.DBPath, "db", app.DefaultDBPath, "Path to SQLite database")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: dw logs dedupe [--dry-run] [--key FIELDS] [--db PATH]")
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintln(os.Stderr, "Removes duplicate events, e.g. ones saved twice before saves were idempotent.")
+		fmt.Fprintln(os.Stderr, "Events that agree on every key field form a group; the earliest event of each")
+		fmt.Fprintln(os.Stderr, "group (lowest ID on equal timestamps) is kept and the others are deleted in a")
+		fmt.Fprintln(os.Stderr, "single transaction.")
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintln(os.Stderr, "Flags:")
+		fs.PrintDefaults()
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintln(os.Stderr, "Examples:")
+		fmt.Fprintln(os.Stderr, "  dw logs dedupe --dry-run")
+		fmt.Fprintln(os.Stderr, "  dw logs dedupe --key session,type,timestamp,payload")
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if fs.NArg() > 0 {
+		fmt.Fprintf(os.Stderr, "Error: unexpected argument %q\n", fs.Arg(0))
+		return nil, fmt.Errorf("unexpected argument %q", fs.Arg(0))
+	}
+
+	parsedKey, err := domain.ParseDedupeKey(key)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return nil, err
+	}
+	opts.Key = parsedKey
+
+	return opts, nil
+}
+
+func handleLogsDedupe(args []string) {
+	opts, err := ParseLogsDedupeFlags(args)
+	if err != nil {
+		if err == flag.ErrHelp {
+			return
+		}
+		os.Exit(pluginsdk.ExitUsage)
+	}
+
+	if _, err := os.Stat(opts.DBPath); os.IsNotExist(err) {
+		fmt.Fprintf(os.Stderr, "Error: Database not found at %s\n", opts.DBPath)
+		fmt.Fprintf(os.Stderr, "Run 'dw claude init' to initialize logging.\n")
+		os.Exit(1)
+	}
+
+	repo, err := infra.NewSQLiteEventRepository(opts.DBPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: Failed to open database: %v\n", err)
+		os.Exit(1)
+	}
+	defer repo.Close()
+
+	ctx := context.Background()
+	if err := repo.Initialize(ctx); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: Failed to initialize database: %v\n", err)
+		os.Exit(1)
+	}
+
+	handler := app.NewLogDedupeHandler(repo)
+	if _, err := handler.Dedupe(ctx, opts.LogDedupeOptions, os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(pluginsdk.ExitCode(err))
+	}
+}
+
+// LogsExportOptions contains options for the logs export command
+type LogsExportOptions struct {
+	app.LogExportOptions
+	DBPath string
+}
+
+// ParseLogsExportFlags parses command line flags for the logs export command
+func ParseLogsExportFlags(args []string) (*LogsExportOptions, error) {
+	fs := flag.NewFlagSet("logs export", flag.ContinueOnError)
+	opts := &LogsExportOptions{}
+
+	var analyzedOnly, unanalyzedOnly bool
+	fs.BoolVar(&analyzedOnly, "analyzed-only", false, "Only export events of sessions that have a matching analysis")
+	fs.BoolVar(&unanalyzedOnly, "unanalyzed-only", false, "Only export events of sessions without a matching analysis")
+	fs.StringVar(&opts.AnalysisType, "analysis-type", "", "Analysis type that counts, e.g. session_summary or the shorthand summary (default: any)")
+	fs.StringVar(&opts.Format, "format", app.LogExportFormatJSONL, "Output format: jsonl or csv")
+	fs.StringVar(&opts.DBPath, "db", app.DefaultDBPath, "Path to SQLite database")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: dw logs export [--analyzed-only | --unanalyzed-only] [--analysis-type TYPE] [--format jsonl|csv] [--db PATH]")
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintln(os.Stderr, "Streams events to stdout in chronological order, one JSON object per line by")
+		fmt.Fprintln(os.Stderr, "default. --analyzed-only keeps the events of sessions that have an analysis,")
+		fmt.Fprintln(os.Stderr, "--unanalyzed-only those of sessions that have none; --analysis-type narrows")
+		fmt.Fprintln(os.Stderr, "which analyses count. The number of exported events is printed to stderr.")
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintln(os.Stderr, "Flags:")
+		fs.PrintDefaults()
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintln(os.Stderr, "Examples:")
+		fmt.Fprintln(os.Stderr, "  dw logs export --analyzed-only --analysis-type summary > training.jsonl")
+		fmt.Fprintln(os.Stderr, "  dw logs export --unanalyzed-only --format csv > pending.csv")
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if fs.NArg() > 0 {
+		fmt.Fprintf(os.Stderr, "Error: unexpected argument %q\n", fs.Arg(0))
+		return nil, fmt.Errorf("unexpected argument %q", fs.Arg(0))
+	}
+
+	switch {
+	case analyzedOnly && unanalyzedOnly:
+		fmt.Fprintln(os.Stderr, "Error: --analyzed-only and --unanalyzed-only cannot be combined")
+		return nil, errors.New("--analyzed-only and --unanalyzed-only cannot be combined")
+	case analyzedOnly:
+		opts.Coverage = domain.AnalysisCoverageAnalyzed
+	case unanalyzedOnly:
+		opts.Coverage = domain.AnalysisCoverageUnanalyzed
+	case opts.AnalysisType != "":
+		fmt.Fprintln(os.Stderr, "Error: --analysis-type requires --analyzed-only or --unanalyzed-only")
+		return nil, errors.New("--analysis-type requires --analyzed-only or --unanalyzed-only")
+	}
+
+	if opts.Format != app.LogExportFormatJSONL && opts.Format != app.LogExportFormatCSV {
+		fmt.Fprintf(os.Stderr, "Error: invalid format %q (valid: jsonl, csv)\n", opts.Format)
+		return nil, fmt.Errorf("invalid format %q", opts.Format)
+	}
+
+	return opts, nil
+}
+
+func handleLogsExport(args []string) {
+	opts, err := ParseLogsExportFlags(args)
+	if err != nil {
+		if err == flag.ErrHelp {
+			return
+		}
+		os.Exit(pluginsdk.ExitUsage)
+	}
+
+	if _, err := os.Stat(opts.DBPath); os.IsNotExist(err) {
+		fmt.Fprintf(os.Stderr, "Error: Database not found at %s\n", opts.DBPath)
+		fmt.Fprintf(os.Stderr, "Run 'dw claude init' to initialize logging.\n")
+		os.Exit(1)
+	}
+
+	repo, err := infra.NewSQLiteEventRepository(opts.DBPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: Failed to open database: %v\n", err)
+		os.Exit(1)
+	}
+	defer repo.Close()
+
+	ctx := context.Background()
+	if err := repo.Initialize(ctx); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: Failed to initialize database: %v\n", err)
+		os.Exit(1)
+	}
+
+	handler := app.NewLogExportHandler(repo)
+	count, err := handler.Export(ctx, opts.LogExportOptions, os.Stdout)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(pluginsdk.ExitCode(err))
+	}
+	fmt.Fprintf(os.Stderr, "Exported %d events\n", count)
+}
+
+// LogsWatchOptions contains options for the logs watch command
+type LogsWatchOptions struct {
+	app.LogWatchOptions
+	Plugin string
+	DBPath string
+}
+
+// ParseLogsWatchFlags parses command line flags for the logs watch command
+func ParseLogsWatchFlags(args []string) (*LogsWatchOptions, error) {
+	fs := flag.NewFlagSet("logs watch", flag.ContinueOnError)
+	opts := &LogsWatchOptions{}
+
+	fs.StringVar(&opts.Plugin, "plugin", "", "Plugin to forward events to (required)")
+	fs.DurationVar(&opts.Interval, "interval", app.DefaultLogWatchInterval, "How often to check for new events")
+	fs.IntVar(&opts.Buffer, "buffer", app.DefaultLogWatchBuffer, "Events read ahead of a slow plugin before polling pauses")
+	fs.StringVar(&opts.DBPath, "db", app.DefaultDBPath, "Path to SQLite database")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: dw logs watch --plugin NAME [--interval DURATION] [--buffer N] [--db PATH]")
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintln(os.Stderr, "Forwards events logged from now on to a plugin, one at a time and in order,")
+		fmt.Fprintln(os.Stderr, "until interrupted with Ctrl+C. The plugin must declare the IEventHandler")
+		fmt.Fprintln(os.Stderr, "capability (RPC method handle_event). When it is slower than events arrive,")
+		fmt.Fprintln(os.Stderr, "up to --buffer events wait and reading pauses; no event is dropped. An event")
+		fmt.Fprintln(os.Stderr, "the plugin fails on is reported and the stream continues.")
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintln(os.Stderr, "Flags:")
+		fs.PrintDefaults()
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintln(os.Stderr, "Examples:")
+		fmt.Fprintln(os.Stderr, "  dw logs watch --plugin notifier")
+		fmt.Fprintln(os.Stderr, "  dw logs watch --plugin metrics --interval 200ms")
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if fs.NArg() > 0 {
+		fmt.Fprintf(os.Stderr, "Error: unexpected argument %q\n", fs.Arg(0))
+		return nil, fmt.Errorf("unexpected argument %q", fs.Arg(0))
+	}
+	if opts.Plugin == "" {
+		fmt.Fprintln(os.Stderr, "Error: --plugin is required")
+		return nil, fmt.Errorf("--plugin is required")
+	}
+	if opts.Interval <= 0 {
+		fmt.Fprintln(os.Stderr, "Error: --interval must be positive")
+		return nil, fmt.Errorf("--interval must be positive")
+	}
+	if opts.Buffer < 1 {
+		fmt.Fprintln(os.Stderr, "Error: --buffer must be at least 1")
+		return nil, fmt.Errorf("--buffer must be at least 1")
+	}
+
+	return opts, nil
+}
+
+func handleLogsWatch(args []string) {
+	opts, err := ParseLogsWatchFlags(args)
+	if err != nil {
+		if err == flag.ErrHelp {
+			return
+		}
+		os.Exit(pluginsdk.ExitUsage)
+	}
+
+	if _, err := os.Stat(opts.DBPath); os.IsNotExist(err) {
+		fmt.Fprintf(os.Stderr, "Error: Database not found at %s\n", opts.DBPath)
+		fmt.Fprintf(os.Stderr, "Run 'dw claude init' to initialize logging.\n")
+		os.Exit(1)
+	}
+
+	// Initialize app to load the plugin
+	services, err := InitializeApp(opts.DBPath, "", false)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error initializing app: %v\n", err)
+		os.Exit(1)
+	}
+	repo, ok := services.EventRepo.(domain.EventRepository)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "Error: event repository unavailable\n")
+		os.Exit(1)
+	}
+	handler, err := services.PluginRegistry.GetEventHandler(opts.Plugin)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(pluginsdk.ExitCode(err))
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	fmt.Fprintf(os.Stderr, "Forwarding new events to plugin %s (Ctrl+C to stop)...\n", opts.Plugin)
+	watcher := app.NewLogWatchHandler(repo, handler, os.Stderr)
+	result := watcher.Watch(ctx, opts.LogWatchOptions)
+	fmt.Fprintf(os.Stderr, "Forwarded %d events to plugin %s (%d failed)\n", result.Forwarded, opts.Plugin, result.Failed)
+}
+
+// LogsMergeSessionOptions contains options for the logs merge-session command
+type LogsMergeSessionOptions struct {
+	app.LogMergeSessionOptions
+	DBPath string
+}
+
+// ParseLogsMergeSessionFlags parses command line flags for the logs merge-session command
+func ParseLogsMergeSessionFlags(args []string) (*LogsMergeSessionOptions, error) {
+	fs := flag.NewFlagSet("logs merge-session", flag.ContinueOnError)
+	opts := &LogsMergeSessionOptions{}
+
+	fs.StringVar(&opts.From, "from", "", "Session whose events and analyses move (required)")
+	fs.StringVar(&opts.To, "to", "", "Session that receives them (required)")
+	fs.BoolVar(&opts.DeleteSource, "delete-source", false, "Also delete source analyses that could not move, with their event links, leaving nothing under --from")
+	fs.StringVar(&opts.DBPath, "db", app.DefaultDBPath, "Path to SQLite database")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: dw logs merge-session --from ID --to ID [--delete-source] [--db PATH]")
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintln(os.Stderr, "Consolidates a session that was split across two session IDs, e.g. by a hook")
+		fmt.Fprintln(os.Stderr, "restart. All events and analyses of --from are reassigned to --to in a single")
+		fmt.Fprintln(os.Stderr, "transaction. Both sessions must have events. Session analyses --to already has")
+		fmt.Fprintln(os.Stderr, "for the same type and model stay under --from unless --delete-source is given.")
+		fmt.Fprintln(os.Stderr, "A warning is printed when the sessions' time ranges overlap.")
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintln(os.Stderr, "Flags:")
+		fs.PrintDefaults()
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintln(os.Stderr, "Examples:")
+		fmt.Fprintln(os.Stderr, "  dw logs merge-session --from abc123 --to def456")
+		fmt.Fprintln(os.Stderr, "  dw logs merge-session --from abc123 --to def456 --delete-source")
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if fs.NArg() > 0 {
+		fmt.Fprintf(os.Stderr, "Error: unexpected argument %q\n", fs.Arg(0))
+		return nil, fmt.Errorf("unexpected argument %q", fs.Arg(0))
+	}
+	if opts.From == "" || opts.To == "" {
+		fmt.Fprintln(os.Stderr, "Error: --from and --to are required")
+		return nil, fmt.Errorf("--from and --to are required")
+	}
+	if opts.From == opts.To {
+		fmt.Fprintln(os.Stderr, "Error: --from and --to must be different sessions")
+		return nil, fmt.Errorf("--from and --to must be different sessions")
+	}
+
+	return opts, nil
+}
+
+func handleLogsMergeSession(args []string) {
+	opts, err := ParseLogsMergeSessionFlags(args)
+	if err != nil {
+		if err == flag.ErrHelp {
+			return
+		}
+		os.Exit(pluginsdk.ExitUsage)
+	}
+
+	if _, err := os.Stat(opts.DBPath); os.IsNotExist(err) {
+		fmt.Fprintf(os.Stderr, "Error: Database not found at %s\n", opts.DBPath)
+		fmt.Fprintf(os.Stderr, "Run 'dw claude init' to initialize logging.\n")
+		os.Exit(1)
+	}
+
+	repo, err := infra.NewSQLiteEventRepository(opts.DBPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: Failed to open database: %v\n", err)
+		os.Exit(1)
+	}
+	defer repo.Close()
+
+	ctx := context.Background()
+	if err := repo.Initialize(ctx); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: Failed to initialize database: %v\n", err)
+		os.Exit(1)
+	}
+
+	handler := app.NewLogMergeSessionHandler(repo)
+	if _, err := handler.Merge(ctx, opts.LogMergeSessionOptions, os.Stdout, os.Stderr); err != nil {
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(pluginsdk.ExitCode(err))
+	}
+}
+
+// LogsDeleteSessionOptions contains options for the logs delete-session command
+type LogsDeleteSessionOptions struct {
+	app.LogDeleteSessionOptions
+	DBPath string
+}
+
+// ParseLogsDeleteSessionFlags parses command line flags for the logs delete-session command
+func ParseLogsDeleteSessionFlags(args []string) (*LogsDeleteSessionOptions, error) {
+	fs := flag.NewFlagSet("logs delete-session", flag.ContinueOnError)
+	opts := &LogsDeleteSessionOptions{}
+
+	fs.BoolVar(&opts.DryRun, "dry-run", false, "Report what would be deleted without deleting it")
+	fs.BoolVar(&opts.Force, "force", false, "Confirm deleting the session")
+	fs.StringVar(&opts.DBPath, "db", app.DefaultDBPath, "Path to SQLite database")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: dw logs delete-session <session-id> [--dry-run] --force [--db PATH]")
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintln(os.Stderr, "Removes everything recorded for a session in a single transaction: its events,")
+		fmt.Fprintln(os.Stderr, "analyses and their event links, event labels, payload index entries and")
+		fmt.Fprintln(os.Stderr, "sampling counters, e.g. to drop a test session or honor a data-deletion request.")
+		fmt.Fprintln(os.Stderr, "Requires --force; --dry-run reports the counts without deleting anything.")
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintln(os.Stderr, "Flags:")
+		fs.PrintDefaults()
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintln(os.Stderr, "Examples:")
+		fmt.Fprintln(os.Stderr, "  dw logs delete-session abc123 --dry-run")
+		fmt.Fprintln(os.Stderr, "  dw logs delete-session abc123 --force")
+	}
+
+	// Accept the session ID before or after the flags
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		opts.SessionID = args[0]
+		args = args[1:]
+	}
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	rest := fs.Args()
+	if opts.SessionID == "" && len(rest) > 0 {
+		// The session ID came after some flags; parse the flags following it too
+		opts.SessionID = rest[0]
+		if err := fs.Parse(rest[1:]); err != nil {
+			return nil, err
+		}
+		rest = fs.Args()
+	}
+	if len(rest) > 0 {
+		fmt.Fprintf(os.Stderr, "Error: unexpected argument %q\n", rest[0])
+		return nil, fmt.Errorf("unexpected argument %q", rest[0])
+	}
+	if opts.SessionID == "" {
+		fmt.Fprintln(os.Stderr, "Error: session ID is required")
+		return nil, fmt.Errorf("session ID is required")
+	}
+
+	return opts, nil
+}
+
+func handleLogsDeleteSession(args []string) {
+	opts, err := ParseLogsDeleteSessionFlags(args)
+	if err != nil {
+		if err == flag.ErrHelp {
+			return
+		}
+		os.Exit(pluginsdk.ExitUsage)
+	}
+
+	if _, err := os.Stat(opts.DBPath); os.IsNotExist(err) {
+		fmt.Fprintf(os.Stderr, "Error: Database not found at %s\n", opts.DBPath)
+		fmt.Fprintf(os.Stderr, "Run 'dw claude init' to initialize logging.\n")
+		os.Exit(1)
+	}
+
+	repo, err := infra.NewSQLiteEventRepository(opts.DBPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: Failed to open database: %v\n", err)
+		os.Exit(1)
+	}
+	defer repo.Close()
+
+	ctx := context.Background()
+	if err := repo.Initialize(ctx); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: Failed to initialize database: %v\n", err)
 		os.Exit(1)
 	}
+
+	handler := app.NewLogDeleteSessionHandler(repo)
+	if _, err := handler.Delete(ctx, opts.LogDeleteSessionOptions, os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(pluginsdk.ExitCode(err))
+	}
+}
+
+// LogsHistogramOptions contains options for the logs histogram command
+type LogsHistogramOptions struct {
+	app.LogHistogramOptions
+	DBPath string
+}
+
+// ParseLogsHistogramFlags parses command line flags for the logs histogram command
+func ParseLogsHistogramFlags(args []string) (*LogsHistogramOptions, error) {
+	fs := flag.NewFlagSet("logs histogram", flag.ContinueOnError)
+	opts := &LogsHistogramOptions{}
+
+	var since, types string
+	var utc bool
+	fs.StringVar(&opts.Bucket, "bucket", app.LogHistogramBucketHour, "Bucket size: hour or day")
+	fs.StringVar(&since, "since", "", "Start of the range, e.g. 12h, 7d or 2006-01-02 (default: 24h for hours, 7d for days)")
+	fs.StringVar(&types, "type", "", "Only count these event types (comma-separated, exact match)")
+	fs.BoolVar(&utc, "utc", false, "Bucket by UTC hours and days instead of local time")
+	fs.BoolVar(&opts.JSON, "json", false, "Output the raw buckets as JSON")
+	fs.StringVar(&opts.DBPath, "db", app.DefaultDBPath, "Path to SQLite database")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: dw logs histogram [--bucket hour|day] [--since DURATION|DATE] [--type TYPES] [--utc] [--json] [--db PATH]")
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintln(os.Stderr, "Counts events per hour or day and draws a horizontal bar per bucket, up to")
+		fmt.Fprintln(os.Stderr, "now. Buckets without events are shown as 0 so gaps are visible. Hours and")
+		fmt.Fprintln(os.Stderr, "days are local time unless --utc is given.")
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintln(os.Stderr, "Flags:")
+		fs.PrintDefaults()
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintln(os.Stderr, "Examples:")
+		fmt.Fprintln(os.Stderr, "  dw logs histogram")
+		fmt.Fprintln(os.Stderr, "  dw logs histogram --bucket day --since 30d")
+		fmt.Fprintln(os.Stderr, "  dw logs histogram --type chat.message.user --since 48h --utc")
+		fmt.Fprintln(os.Stderr, "  dw logs histogram --bucket day --json | jq '.buckets[] | select(.count == 0)'")
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if fs.NArg() > 0 {
+		fmt.Fprintf(os.Stderr, "Error: unexpected argument %q\n", fs.Arg(0))
+		return nil, fmt.Errorf("unexpected argument %q", fs.Arg(0))
+	}
+	if opts.Bucket != app.LogHistogramBucketHour && opts.Bucket != app.LogHistogramBucketDay {
+		fmt.Fprintf(os.Stderr, "Error: invalid bucket %q (valid: hour, day)\n", opts.Bucket)
+		return nil, fmt.Errorf("invalid bucket %q", opts.Bucket)
+	}
+
+	opts.Loc = time.Local
+	if utc {
+		opts.Loc = time.UTC
+	}
+	sinceTime, err := app.ParseSince(since, time.Now().In(opts.Loc))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return nil, err
+	}
+	opts.Since = sinceTime
+	for _, eventType := range strings.Split(types, ",") {
+		if eventType = strings.TrimSpace(eventType); eventType != "" {
+			opts.Types = append(opts.Types, eventType)
+		}
+	}
+
+	return opts, nil
+}
+
+func handleLogsHistogram(args []string) {
+	opts, err := ParseLogsHistogramFlags(args)
+	if err != nil {
+		if err == flag.ErrHelp {
+			return
+		}
+		os.Exit(pluginsdk.ExitUsage)
+	}
+
+	if _, err := os.Stat(opts.DBPath); os.IsNotExist(err) {
+		fmt.Fprintf(os.Stderr, "Error: Database not found at %s\n", opts.DBPath)
+		fmt.Fprintf(os.Stderr, "Run 'dw claude init' to initialize logging.\n")
+		os.Exit(1)
+	}
+
+	repo, err := infra.NewSQLiteEventRepository(opts.DBPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: Failed to open database: %v\n", err)
+		os.Exit(1)
+	}
+	defer repo.Close()
+
+	ctx := context.Background()
+	if err := repo.Initialize(ctx); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: Failed to initialize database: %v\n", err)
+		os.Exit(1)
+	}
+
+	handler := app.NewLogHistogramHandler(repo)
+	if err := handler.Render(ctx, opts.LogHistogramOptions, os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(pluginsdk.ExitCode(err))
+	}
+}
+
+// labelListFlag collects the labels of a repeatable --label flag; each value may also
+// list several labels separated by commas
+type labelListFlag []string
+
+func (f *labelListFlag) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *labelListFlag) Set(value string) error {
+	for _, label := range strings.Split(value, ",") {
+		normalized, err := domain.NormalizeEventLabel(label)
+		if err != nil {
+			return err
+		}
+		*f = append(*f, normalized)
+	}
+	return nil
+}
+
+// LogsAnnotateOptions contains options for the logs annotate command
+type LogsAnnotateOptions struct {
+	app.LogAnnotateOptions
+	DBPath string
+}
+
+// ParseLogsAnnotateFlags parses command line flags for the logs annotate command.
+// Flags may come before or after the event ID.
+func ParseLogsAnnotateFlags(args []string) (*LogsAnnotateOptions, error) {
+	fs := flag.NewFlagSet("logs annotate", flag.ContinueOnError)
+	opts := &LogsAnnotateOptions{}
+
+	fs.Var((*labelListFlag)(&opts.Labels), "label", "Label to add or remove (repeatable or comma-separated, required)")
+	fs.BoolVar(&opts.Remove, "remove", false, "Remove the labels instead of adding them")
+	fs.StringVar(&opts.DBPath, "db", app.DefaultDBPath, "Path to SQLite database")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: dw logs annotate <event-id> --label NAME [--label NAME...] [--remove] [--db PATH]")
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintln(os.Stderr, "Tags an event with free-form labels, e.g. to mark events as interesting for")
+		fmt.Fprintln(os.Stderr, "later review. Labels are stored lower-case. 'dw logs --label NAME' lists the")
+		fmt.Fprintln(os.Stderr, "labeled events and 'dw logs labels' shows every label with its event count.")
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintln(os.Stderr, "Flags:")
+		fs.PrintDefaults()
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintln(os.Stderr, "Examples:")
+		fmt.Fprintln(os.Stderr, "  dw logs annotate 3f2a9c1e --label interesting")
+		fmt.Fprintln(os.Stderr, "  dw logs annotate 3f2a9c1e --label bug --label slow-tool")
+		fmt.Fprintln(os.Stderr, "  dw logs annotate 3f2a9c1e --label slow-tool --remove")
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	opts.EventID = fs.Arg(0)
+	if fs.NArg() > 0 {
+		if err := fs.Parse(fs.Args()[1:]); err != nil {
+			return nil, err
+		}
+	}
+	if opts.EventID == "" {
+		fmt.Fprintln(os.Stderr, "Error: event ID is required")
+		return nil, fmt.Errorf("event ID is required")
+	}
+	if fs.NArg() > 0 {
+		fmt.Fprintf(os.Stderr, "Error: unexpected argument %q\n", fs.Arg(0))
+		return nil, fmt.Errorf("unexpected argument %q", fs.Arg(0))
+	}
+	if len(opts.Labels) == 0 {
+		fmt.Fprintln(os.Stderr, "Error: at least one --label is required")
+		return nil, fmt.Errorf("at least one --label is required")
+	}
+
+	return opts, nil
+}
+
+func handleLogsAnnotate(args []string) {
+	opts, err := ParseLogsAnnotateFlags(args)
+	if err != nil {
+		if err == flag.ErrHelp {
+			return
+		}
+		os.Exit(pluginsdk.ExitUsage)
+	}
+
+	if _, err := os.Stat(opts.DBPath); os.IsNotExist(err) {
+		fmt.Fprintf(os.Stderr, "Error: Database not found at %s\n", opts.DBPath)
+		fmt.Fprintf(os.Stderr, "Run 'dw claude init' to initialize logging.\n")
+		os.Exit(1)
+	}
+
+	repo, err := infra.NewSQLiteEventRepository(opts.DBPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: Failed to open database: %v\n", err)
+		os.Exit(1)
+	}
+	defer repo.Close()
+
+	ctx := context.Background()
+	if err := repo.Initialize(ctx); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: Failed to initialize database: %v\n", err)
+		os.Exit(1)
+	}
+
+	handler := app.NewLogAnnotateHandler(repo)
+	if _, err := handler.Annotate(ctx, opts.LogAnnotateOptions, os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(pluginsdk.ExitCode(err))
+	}
+}
+
+// ParseLogsLabelsFlags parses command line flags for the logs labels command and
+// returns the database path
+func ParseLogsLabelsFlags(args []string) (string, error) {
+	fs := flag.NewFlagSet("logs labels", flag.ContinueOnError)
+
+	dbPath := fs.String("db", app.DefaultDBPath, "Path to SQLite database")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: dw logs labels [--db PATH]")
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintln(os.Stderr, "Lists every label attached with 'dw logs annotate' and the number of events")
+		fmt.Fprintln(os.Stderr, "it tags, by label.")
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintln(os.Stderr, "Flags:")
+		fs.PrintDefaults()
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return "", err
+	}
+	if fs.NArg() > 0 {
+		fmt.Fprintf(os.Stderr, "Error: unexpected argument %q\n", fs.Arg(0))
+		return "", fmt.Errorf("unexpected argument %q", fs.Arg(0))
+	}
+
+	return *dbPath, nil
+}
+
+func handleLogsLabels(args []string) {
+	dbPath, err := ParseLogsLabelsFlags(args)
+	if err != nil {
+		if err == flag.ErrHelp {
+			return
+		}
+		os.Exit(pluginsdk.ExitUsage)
+	}
+
+	if _, err := os.Stat(dbPath); os.IsNotExist(err) {
+		fmt.Fprintf(os.Stderr, "Error: Database not found at %s\n", dbPath)
+		fmt.Fprintf(os.Stderr, "Run 'dw claude init' to initialize logging.\n")
+		os.Exit(1)
+	}
+
+	repo, err := infra.NewSQLiteEventRepository(dbPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: Failed to open database: %v\n", err)
+		os.Exit(1)
+	}
+	defer repo.Close()
+
+	ctx := context.Background()
+	if err := repo.Initialize(ctx); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: Failed to initialize database: %v\n", err)
+		os.Exit(1)
+	}
+
+	handler := app.NewLogAnnotateHandler(repo)
+	if _, err := handler.ListLabels(ctx, os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(pluginsdk.ExitCode(err))
+	}
 }
 
 func printLogsUsage() {
 	fmt.Println("Usage: dw logs [flags]")
+	fmt.Println("       dw logs sessions [--limit N] [--unanalyzed] [--json]")
+	fmt.Println("       dw logs emit --type TYPE --session ID [--payload JSON] [--content TEXT] [--db PATH]")
+	fmt.Println("       dw logs dedupe [--dry-run] [--key FIELDS] [--db PATH]")
+	fmt.Println("       dw logs export [--analyzed-only | --unanalyzed-only] [--analysis-type TYPE] [--format jsonl|csv] [--db PATH]")
+	fmt.Println("       dw logs watch --plugin NAME [--interval DURATION] [--buffer N] [--db PATH]")
+	fmt.Println("       dw logs merge-session --from ID --to ID [--delete-source] [--db PATH]")
+	fmt.Println("       dw logs delete-session <session-id> [--dry-run] --force [--db PATH]")
+	fmt.Println("       dw logs histogram [--bucket hour|day] [--since DURATION|DATE] [--type TYPES] [--utc] [--json] [--db PATH]")
+	fmt.Println("       dw logs annotate <event-id> --label NAME [--label NAME...] [--remove] [--db PATH]")
+	fmt.Println("       dw logs labels [--db PATH]")
 	fmt.Println()
 	fmt.Println("Flags:")
-	fmt.Println("  --limit N            Number of most recent logs to display (default: 20)")
+	fmt.Println("  --limit N            Number of most recent logs to display (0 = all)")
+	fmt.Println("  --all                Show all logs (same as --limit 0)")
 	fmt.Println("  --session-limit N    Limit by number of sessions instead of logs (0 = use --limit)")
 	fmt.Println("  --session-id ID      Filter logs by session ID")
+	fmt.Println("  --source NAME        Filter by event source, the first part of prefixed types (e.g. claude)")
+	fmt.Println("  --category NAME      Filter by event category (e.g. tool matches claude.tool.* and tool.*)")
+	fmt.Println("  --label NAME         Filter by label from 'dw logs annotate' (repeatable; any label matches)")
 	fmt.Println("  --ordered            Order by timestamp ASC and session ID (chronological)")
 	fmt.Println("  --format FORMAT      Output format: text, csv, or markdown (default: text)")
+	fmt.Println("  --search TEXT        Search logs (plain content searches use the full-text index)")
+	fmt.Println("  --in FIELD           Field to search: content, payload, or both (default: content)")
+	fmt.Println("  --regex              Treat --search as a Go regular expression (scans rows)")
+	fmt.Println("  --full               Show event content in full instead of truncating it")
+	fmt.Println("  --wrap               Wrap event content to the terminal width instead of truncating it")
 	fmt.Println("  --query SQL          Execute an arbitrary SQL query")
 	fmt.Println("  --help               Show help and database schema")
 	fmt.Println()
+	fmt.Printf("Without --limit, dw logs shows the logs.default_limit most recent logs (default: %d).\n", domain.DefaultLogsLimit)
+	fmt.Println("Change it with 'dw config set logs.default_limit N' (0 = always show all).")
+	fmt.Println("The limit is applied after filters: --session-id and --search return at most N")
+	fmt.Println("matching logs. --session-limit replaces the limit and returns the matching logs")
+	fmt.Println("of the N most recent sessions that have one.")
+	fmt.Println("Unlimited text and CSV output is streamed; Markdown loads all logs to group them.")
+	fmt.Println()
+	fmt.Printf("Text output truncates event content after logs.content_width characters (default: %d),\n", domain.DefaultLogsContentWidth)
+	fmt.Println("marking cut content with an ellipsis. Change it with 'dw config set logs.content_width N'")
+	fmt.Println("(0 = never truncate), or use --full or --wrap for one listing.")
+	fmt.Println()
 	fmt.Println("Examples:")
-	fmt.Println("  dw logs                                          # Show 20 most recent logs")
+	fmt.Println("  dw logs                                          # Show the most recent logs (default limit)")
 	fmt.Println("  dw logs --limit 50                               # Show 50 most recent logs")
+	fmt.Println("  dw logs --all --format csv > events.csv          # Export every log as CSV")
 	fmt.Println("  dw logs --session-limit 3                        # Show all logs from 3 most recent sessions")
 	fmt.Println("  dw logs --session-id abc123                      # Show logs for session abc123")
 	fmt.Println("  dw logs --session-id abc123 --ordered            # Show session abc123 in chronological order")
 	fmt.Println("  dw logs --format csv --limit 100                 # Export 100 logs as CSV")
 	fmt.Println("  dw logs --format markdown --session-limit 5      # Export 5 most recent sessions as Markdown")
+	fmt.Println("  dw logs --category tool --limit 50               # Show the 50 most recent tool events")
+	fmt.Println("  dw logs --source claude --category chat          # Show Claude chat events")
+	fmt.Println("  dw logs --search sqlite                          # Search content for 'sqlite'")
+	fmt.Println("  dw logs --search 'panic: .*' --regex --in payload  # Regex search in payloads only")
+	fmt.Println("  dw logs --category tool --wrap                   # Show tool output wrapped, not truncated")
+	fmt.Println("  dw logs sessions --unanalyzed                    # List sessions that have no analysis yet")
+	fmt.Println("  dw logs emit --type marker --session abc123      # Log a manual marker event in session abc123")
+	fmt.Println("  dw logs dedupe --dry-run                         # List duplicate events without deleting them")
+	fmt.Println("  dw logs export --analyzed-only --analysis-type summary  # Events of sessions with a summary, as JSONL")
+	fmt.Println("  dw logs watch --plugin notifier                  # Forward new events to the notifier plugin")
+	fmt.Println("  dw logs merge-session --from abc123 --to def456  # Move session abc123's events into def456")
+	fmt.Println("  dw logs delete-session abc123 --dry-run          # Count what deleting session abc123 removes")
+	fmt.Println("  dw logs histogram --bucket day --since 14d       # Events per day over the last two weeks")
+	fmt.Println("  dw logs annotate 3f2a9c1e --label interesting    # Label an event for later review")
+	fmt.Println("  dw logs --label interesting                      # Show the events labeled interesting")
+	fmt.Println("  dw logs labels                                   # List labels with their event counts")
 	fmt.Println("  dw logs --query \"SELECT * FROM events\"           # Run custom SQL query")
 	fmt.Println()
 }
@@ -136,6 +1217,10 @@ func PrintLogsHelp() {
 	fmt.Println("    - session_id   TEXT                Claude Code session identifier")
 	fmt.Println("    - payload      TEXT NOT NULL       JSON payload with event-specific data")
 	fmt.Println("    - content      TEXT NOT NULL       Normalized searchable content")
+	fmt.Println("    - working_dir  TEXT                Directory the event was captured in")
+	fmt.Println("    - git_branch   TEXT                Git branch of working_dir at capture time")
+	fmt.Println("    - git_commit   TEXT                Git HEAD commit of working_dir at capture time")
+	fmt.Println("    - sampled      INTEGER             1 if the event type is stored at a sampling rate below 1")
 	fmt.Println()
 	fmt.Println("  Indexes:")
 	fmt.Println("    - idx_events_timestamp          ON events(timestamp)")
@@ -143,6 +1228,15 @@ func PrintLogsHelp() {
 	fmt.Println("    - idx_events_timestamp_type     ON events(timestamp, event_type)")
 	fmt.Println("    - idx_events_session_id         ON events(session_id)")
 	fmt.Println("    - idx_events_timestamp_session  ON events(timestamp, session_id)")
+	fmt.Println("    - idx_events_working_dir        ON events(working_dir)")
+	fmt.Println()
+	fmt.Println("Table: event_sample_drops")
+	fmt.Println("  Events discarded by sampling (see 'dw config set events.sample.<type> <rate>')")
+	fmt.Println("  Columns: session_id TEXT, event_type TEXT, dropped INTEGER")
+	fmt.Println()
+	fmt.Println("Table: event_labels")
+	fmt.Println("  Lower-case labels attached to events (see 'dw logs annotate')")
+	fmt.Println("  Columns: event_id TEXT, label TEXT")
 	fmt.Println()
 	fmt.Println("FTS5 Virtual Table: events_fts (if available)")
 	fmt.Println("  Full-text search on content field")
@@ -168,6 +1262,9 @@ func PrintLogsHelp() {
 	fmt.Println("  # Search content for specific text")
 	fmt.Printf("  dw logs --query \"SELECT * FROM events WHERE content LIKE '%%sqlite%%' LIMIT 10\"\n")
 	fmt.Println()
+	fmt.Println("  # Events captured in a repository, by branch")
+	fmt.Println("  dw logs --query \"SELECT git_branch, COUNT(*) FROM events WHERE working_dir = '/path/to/repo' GROUP BY git_branch\"")
+	fmt.Println()
 	fmt.Println("Database location:", app.DefaultDBPath)
 	fmt.Println()
 }
@@ -4,10 +4,14 @@ import (
 	"bytes"
 	"io"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	main "github.com/kgatilin/darwinflow-pub/cmd/dw"
+	"github.com/kgatilin/darwinflow-pub/internal/app"
+	"github.com/kgatilin/darwinflow-pub/internal/domain"
 )
 
 // Helper function to capture stdout
@@ -83,6 +87,11 @@ func TestParseLogsFlags(t *testing.T) {
 			args: []string{"--session-limit", "5", "--format", "markdown"},
 			want: main.LogsOptions{Limit: 20, SessionLimit: 5, Query: "", SessionID: "", Ordered: false, Format: "markdown", Help: false},
 		},
+		{
+			name: "source and category",
+			args: []string{"--source", "claude", "--category", "tool"},
+			want: main.LogsOptions{Limit: 20, Source: "claude", Category: "tool", Format: "text"},
+		},
 		{
 			name: "csv format",
 			args: []string{"--format", "csv"},
@@ -110,6 +119,9 @@ func TestParseLogsFlags(t *testing.T) {
 				if got.SessionID != tt.want.SessionID {
 					t.Errorf("SessionID = %q, want %q", got.SessionID, tt.want.SessionID)
 				}
+				if got.Source != tt.want.Source || got.Category != tt.want.Category {
+					t.Errorf("Source/Category = %q/%q, want %q/%q", got.Source, got.Category, tt.want.Source, tt.want.Category)
+				}
 				if got.Ordered != tt.want.Ordered {
 					t.Errorf("Ordered = %v, want %v", got.Ordered, tt.want.Ordered)
 				}
@@ -303,6 +315,18 @@ func TestParseLogsFlags_BooleanFlags(t *testing.T) {
 			checkFn:  func(o *main.LogsOptions) bool { return o.Ordered },
 			expected: false,
 		},
+		{
+			name:     "full flag true",
+			args:     []string{"--full"},
+			checkFn:  func(o *main.LogsOptions) bool { return o.Full },
+			expected: true,
+		},
+		{
+			name:     "wrap flag true",
+			args:     []string{"--wrap"},
+			checkFn:  func(o *main.LogsOptions) bool { return o.Wrap },
+			expected: true,
+		},
 		{
 			name:     "help flag true",
 			args:     []string{"--help"},
@@ -466,3 +490,343 @@ func TestParseLogsFlags_OrderIndependence(t *testing.T) {
 		t.Error("Ordered differs based on flag order")
 	}
 }
+
+func TestParseLogsFlags_All(t *testing.T) {
+	opts, err := main.ParseLogsFlags([]string{"--limit", "50", "--all"})
+	if err != nil {
+		t.Fatalf("ParseLogsFlags() error = %v", err)
+	}
+	if !opts.All || opts.Limit != 0 {
+		t.Errorf("--all should set Limit to 0, got All=%v Limit=%d", opts.All, opts.Limit)
+	}
+}
+
+func TestParseLogsFlagsWithDefault_ConfiguredLimit(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), ".darwinflow.yaml")
+	if err := os.WriteFile(configPath, []byte("logs:\n  default_limit: 5\n"), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+
+	defaultLimit := main.LogsDefaultLimit(configPath)
+	if defaultLimit != 5 {
+		t.Fatalf("LogsDefaultLimit() = %d, want 5", defaultLimit)
+	}
+
+	tests := []struct {
+		name string
+		args []string
+		want int
+	}{
+		{"no flag uses configured default", []string{}, 5},
+		{"no flag with filter uses configured default", []string{"--session-id", "abc"}, 5},
+		{"explicit limit wins", []string{"--limit", "7"}, 7},
+		{"explicit zero means all", []string{"--limit", "0"}, 0},
+		{"all overrides default", []string{"--all"}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts, err := main.ParseLogsFlagsWithDefault(tt.args, defaultLimit)
+			if err != nil {
+				t.Fatalf("ParseLogsFlagsWithDefault() error = %v", err)
+			}
+			if opts.Limit != tt.want {
+				t.Errorf("Limit = %d, want %d", opts.Limit, tt.want)
+			}
+		})
+	}
+}
+
+func TestLogsDefaultLimit_NoConfig(t *testing.T) {
+	if got := main.LogsDefaultLimit(filepath.Join(t.TempDir(), "missing.yaml")); got != 20 {
+		t.Errorf("LogsDefaultLimit() without config = %d, want 20", got)
+	}
+}
+
+func TestLogsContentWidth(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), ".darwinflow.yaml")
+	if err := os.WriteFile(configPath, []byte("logs:\n  content_width: 80\n"), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+	if got := main.LogsContentWidth(configPath); got != 80 {
+		t.Errorf("LogsContentWidth() = %d, want 80", got)
+	}
+	if got := main.LogsContentWidth(filepath.Join(t.TempDir(), "missing.yaml")); got != 200 {
+		t.Errorf("LogsContentWidth() without config = %d, want 200", got)
+	}
+}
+
+func TestParseLogsFlags_Search(t *testing.T) {
+	got, err := main.ParseLogsFlags([]string{"--search", "panic: .*", "--in", "payload", "--regex"})
+	if err != nil {
+		t.Fatalf("ParseLogsFlags() failed: %v", err)
+	}
+	if got.Search != "panic: .*" || got.In != "payload" || !got.Regex {
+		t.Errorf("unexpected search options: Search=%q In=%q Regex=%v", got.Search, got.In, got.Regex)
+	}
+}
+
+func TestParseLogsSessionsFlags(t *testing.T) {
+	got, err := main.ParseLogsSessionsFlags([]string{"--limit", "5", "--unanalyzed", "--json"})
+	if err != nil {
+		t.Fatalf("ParseLogsSessionsFlags() failed: %v", err)
+	}
+	if got.Limit != 5 || !got.Unanalyzed || !got.JSON {
+		t.Errorf("unexpected options: %+v", got)
+	}
+
+	got, err = main.ParseLogsSessionsFlags(nil)
+	if err != nil {
+		t.Fatalf("ParseLogsSessionsFlags() failed: %v", err)
+	}
+	if got.Limit != 20 || got.Unanalyzed || got.JSON {
+		t.Errorf("unexpected defaults: %+v", got)
+	}
+}
+
+func TestParseLogsEmitFlags(t *testing.T) {
+	got, err := main.ParseLogsEmitFlags([]string{"--type", "marker", "--session", "abc", "--payload", `{"a":1}`, "--content", "note", "--db", "/tmp/x.db"})
+	if err != nil {
+		t.Fatalf("ParseLogsEmitFlags() failed: %v", err)
+	}
+	if got.Type != "marker" || got.SessionID != "abc" || got.Payload != `{"a":1}` || got.Content != "note" || got.DBPath != "/tmp/x.db" {
+		t.Errorf("unexpected options: %+v", got)
+	}
+
+	got, err = main.ParseLogsEmitFlags([]string{"--type", "marker", "--session", "abc"})
+	if err != nil {
+		t.Fatalf("ParseLogsEmitFlags() failed: %v", err)
+	}
+	if got.DBPath != app.DefaultDBPath {
+		t.Errorf("expected default database path, got %q", got.DBPath)
+	}
+
+	if _, err := main.ParseLogsEmitFlags([]string{"--type", "marker", "stray"}); err == nil {
+		t.Error("expected error for unexpected argument")
+	}
+}
+
+func TestParseLogsDedupeFlags(t *testing.T) {
+	got, err := main.ParseLogsDedupeFlags(nil)
+	if err != nil {
+		t.Fatalf("ParseLogsDedupeFlags() failed: %v", err)
+	}
+	if got.DryRun || got.DBPath != app.DefaultDBPath || strings.Join(got.Key, ",") != "session,type,timestamp,content" {
+		t.Errorf("unexpected defaults: %+v", got)
+	}
+
+	got, err = main.ParseLogsDedupeFlags([]string{"--dry-run", "--key", "type, payload", "--db", "/tmp/x.db"})
+	if err != nil {
+		t.Fatalf("ParseLogsDedupeFlags() failed: %v", err)
+	}
+	if !got.DryRun || got.DBPath != "/tmp/x.db" || strings.Join(got.Key, ",") != "type,payload" {
+		t.Errorf("unexpected options: %+v", got)
+	}
+
+	if _, err := main.ParseLogsDedupeFlags([]string{"--key", "session,colour"}); err == nil {
+		t.Error("expected error for unknown key field")
+	}
+	if _, err := main.ParseLogsDedupeFlags([]string{"stray"}); err == nil {
+		t.Error("expected error for unexpected argument")
+	}
+}
+
+func TestParseLogsExportFlags(t *testing.T) {
+	got, err := main.ParseLogsExportFlags(nil)
+	if err != nil {
+		t.Fatalf("ParseLogsExportFlags() failed: %v", err)
+	}
+	if got.Coverage != domain.AnalysisCoverageAll || got.Format != app.LogExportFormatJSONL || got.DBPath != app.DefaultDBPath {
+		t.Errorf("unexpected defaults: %+v", got)
+	}
+
+	got, err = main.ParseLogsExportFlags([]string{"--analyzed-only", "--analysis-type", "summary", "--format", "csv"})
+	if err != nil {
+		t.Fatalf("ParseLogsExportFlags() failed: %v", err)
+	}
+	if got.Coverage != domain.AnalysisCoverageAnalyzed || got.AnalysisType != "summary" || got.Format != "csv" {
+		t.Errorf("unexpected options: %+v", got)
+	}
+
+	got, err = main.ParseLogsExportFlags([]string{"--unanalyzed-only"})
+	if err != nil {
+		t.Fatalf("ParseLogsExportFlags() failed: %v", err)
+	}
+	if got.Coverage != domain.AnalysisCoverageUnanalyzed {
+		t.Errorf("expected unanalyzed coverage, got %+v", got)
+	}
+
+	for _, args := range [][]string{
+		{"--analyzed-only", "--unanalyzed-only"},
+		{"--analysis-type", "summary"},
+		{"--format", "markdown"},
+		{"stray"},
+	} {
+		if _, err := main.ParseLogsExportFlags(args); err == nil {
+			t.Errorf("expected error for %v", args)
+		}
+	}
+}
+
+func TestParseLogsWatchFlags(t *testing.T) {
+	got, err := main.ParseLogsWatchFlags([]string{"--plugin", "notifier"})
+	if err != nil {
+		t.Fatalf("ParseLogsWatchFlags() failed: %v", err)
+	}
+	if got.Plugin != "notifier" || got.Interval != app.DefaultLogWatchInterval || got.Buffer != app.DefaultLogWatchBuffer || got.DBPath != app.DefaultDBPath {
+		t.Errorf("unexpected defaults: %+v", got)
+	}
+
+	got, err = main.ParseLogsWatchFlags([]string{"--plugin", "metrics", "--interval", "200ms", "--buffer", "10", "--db", "/tmp/x.db"})
+	if err != nil {
+		t.Fatalf("ParseLogsWatchFlags() failed: %v", err)
+	}
+	if got.Interval != 200*time.Millisecond || got.Buffer != 10 || got.DBPath != "/tmp/x.db" {
+		t.Errorf("unexpected options: %+v", got)
+	}
+
+	for _, args := range [][]string{
+		nil,
+		{"--plugin", "x", "--interval", "0s"},
+		{"--plugin", "x", "--buffer", "0"},
+		{"--plugin", "x", "stray"},
+	} {
+		if _, err := main.ParseLogsWatchFlags(args); err == nil {
+			t.Errorf("ParseLogsWatchFlags(%v): expected error", args)
+		}
+	}
+}
+
+func TestParseLogsMergeSessionFlags(t *testing.T) {
+	got, err := main.ParseLogsMergeSessionFlags([]string{"--from", "abc", "--to", "def"})
+	if err != nil {
+		t.Fatalf("ParseLogsMergeSessionFlags() failed: %v", err)
+	}
+	if got.From != "abc" || got.To != "def" || got.DeleteSource || got.DBPath != app.DefaultDBPath {
+		t.Errorf("unexpected options: %+v", got)
+	}
+
+	got, err = main.ParseLogsMergeSessionFlags([]string{"--from", "abc", "--to", "def", "--delete-source"})
+	if err != nil {
+		t.Fatalf("ParseLogsMergeSessionFlags() failed: %v", err)
+	}
+	if !got.DeleteSource {
+		t.Error("expected --delete-source to be set")
+	}
+
+	for _, args := range [][]string{
+		{"--from", "abc"},
+		{"--to", "def"},
+		{"--from", "abc", "--to", "abc"},
+		{"--from", "abc", "--to", "def", "stray"},
+	} {
+		if _, err := main.ParseLogsMergeSessionFlags(args); err == nil {
+			t.Errorf("expected error for %v", args)
+		}
+	}
+}
+
+func TestParseLogsDeleteSessionFlags(t *testing.T) {
+	got, err := main.ParseLogsDeleteSessionFlags([]string{"abc", "--force"})
+	if err != nil {
+		t.Fatalf("ParseLogsDeleteSessionFlags() failed: %v", err)
+	}
+	if got.SessionID != "abc" || !got.Force || got.DryRun || got.DBPath != app.DefaultDBPath {
+		t.Errorf("unexpected options: %+v", got)
+	}
+
+	got, err = main.ParseLogsDeleteSessionFlags([]string{"--dry-run", "abc", "--db", "other.db"})
+	if err != nil {
+		t.Fatalf("ParseLogsDeleteSessionFlags() failed: %v", err)
+	}
+	if got.SessionID != "abc" || !got.DryRun || got.DBPath != "other.db" {
+		t.Errorf("expected session abc with --dry-run and --db, got %+v", got)
+	}
+
+	for _, args := range [][]string{
+		{},
+		{"--force"},
+		{"abc", "def", "--force"},
+	} {
+		if _, err := main.ParseLogsDeleteSessionFlags(args); err == nil {
+			t.Errorf("expected error for %v", args)
+		}
+	}
+}
+
+func TestParseLogsHistogramFlags(t *testing.T) {
+	got, err := main.ParseLogsHistogramFlags(nil)
+	if err != nil {
+		t.Fatalf("ParseLogsHistogramFlags() failed: %v", err)
+	}
+	if got.Bucket != app.LogHistogramBucketHour || !got.Since.IsZero() || got.Types != nil || got.Loc != time.Local || got.JSON || got.DBPath != app.DefaultDBPath {
+		t.Errorf("unexpected defaults: %+v", got)
+	}
+
+	before := time.Now()
+	got, err = main.ParseLogsHistogramFlags([]string{"--bucket", "day", "--since", "7d", "--type", "tool.invoked, chat.message.user", "--utc", "--json"})
+	if err != nil {
+		t.Fatalf("ParseLogsHistogramFlags() failed: %v", err)
+	}
+	if got.Bucket != app.LogHistogramBucketDay || got.Loc != time.UTC || !got.JSON {
+		t.Errorf("unexpected options: %+v", got)
+	}
+	if want := before.AddDate(0, 0, -7); got.Since.Sub(want) < 0 || got.Since.Sub(want) > time.Minute {
+		t.Errorf("--since 7d = %v, want about %v", got.Since, want)
+	}
+	if len(got.Types) != 2 || got.Types[1] != "chat.message.user" {
+		t.Errorf("expected two trimmed types, got %q", got.Types)
+	}
+
+	for _, args := range [][]string{
+		{"--bucket", "week"},
+		{"--since", "yesterday"},
+		{"stray"},
+	} {
+		if _, err := main.ParseLogsHistogramFlags(args); err == nil {
+			t.Errorf("expected error for %v", args)
+		}
+	}
+}
+
+func TestParseLogsAnnotateFlags(t *testing.T) {
+	got, err := main.ParseLogsAnnotateFlags([]string{"evt-1", "--label", "Bug", "--label", "slow,interesting"})
+	if err != nil {
+		t.Fatalf("ParseLogsAnnotateFlags() failed: %v", err)
+	}
+	if got.EventID != "evt-1" || got.Remove || got.DBPath != app.DefaultDBPath {
+		t.Errorf("unexpected options: %+v", got)
+	}
+	if strings.Join(got.Labels, ",") != "bug,slow,interesting" {
+		t.Errorf("Labels = %q, want normalized bug, slow, interesting", got.Labels)
+	}
+
+	got, err = main.ParseLogsAnnotateFlags([]string{"--remove", "--label", "bug", "evt-1"})
+	if err != nil {
+		t.Fatalf("ParseLogsAnnotateFlags() failed: %v", err)
+	}
+	if got.EventID != "evt-1" || !got.Remove {
+		t.Errorf("expected flags before the event ID to be parsed, got %+v", got)
+	}
+
+	for _, args := range [][]string{
+		{"evt-1"},
+		{"--label", "bug"},
+		{"evt-1", "--label", " "},
+		{"evt-1", "evt-2", "--label", "bug"},
+	} {
+		if _, err := main.ParseLogsAnnotateFlags(args); err == nil {
+			t.Errorf("expected error for %v", args)
+		}
+	}
+}
+
+func TestParseLogsFlags_Label(t *testing.T) {
+	got, err := main.ParseLogsFlags([]string{"--label", "Bug", "--label", "slow"})
+	if err != nil {
+		t.Fatalf("ParseLogsFlags() failed: %v", err)
+	}
+	if strings.Join(got.Labels, ",") != "bug,slow" {
+		t.Errorf("Labels = %q, want [bug slow]", got.Labels)
+	}
+}
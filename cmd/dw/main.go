@@ -2,21 +2,40 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"strings"
 
 	"github.com/kgatilin/darwinflow-pub/internal/app"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
 )
 
 func main() {
-	if len(os.Args) < 2 {
+	cliArgs, noColor := StripNoColorFlag(os.Args[1:])
+	cliArgs, verbosity, err := StripVerbosityFlags(cliArgs)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(pluginsdk.ExitUsage)
+	}
+
+	// The TUI requires a terminal, so only an explicit --no-color applies to it.
+	// All other commands also drop colors when stdout is not a terminal or NO_COLOR is set.
+	if len(cliArgs) > 0 && cliArgs[0] == "ui" {
+		if noColor {
+			ConfigureColor(true, os.Stdout)
+		}
+	} else {
+		ConfigureColor(noColor, os.Stdout)
+	}
+
+	if len(cliArgs) < 1 {
 		printUsageWithPlugins()
-		os.Exit(1)
+		os.Exit(pluginsdk.ExitUsage)
 	}
 
-	command := os.Args[1]
-	args := os.Args[2:]
+	command := cliArgs[0]
+	args := cliArgs[1:]
 
 	// Handle help first
 	if command == "help" || command == "--help" || command == "-h" {
@@ -30,6 +49,19 @@ func main() {
 		return
 	}
 
+	// Handle doctor before initialization so it can report a missing or outdated database
+	if command == "doctor" {
+		handleDoctor(args)
+		return
+	}
+
+	// Handle refresh before initialization: initializing migrates the database, which
+	// refresh --dry-run and --hooks-only must not do
+	if command == "refresh" {
+		handleRefresh(args)
+		return
+	}
+
 	// Handle ui command specially - it has its own initialization with custom flags
 	if command == "ui" {
 		uiCommand(args)
@@ -38,11 +70,12 @@ func main() {
 
 	// Initialize app (includes plugin registration)
 	// Use default DB path, can be overridden by command flags
-	services, err := InitializeApp(app.DefaultDBPath, "", false)
+	services, err := InitializeApp(app.DefaultDBPath, "", verbosity == pluginsdk.VerbosityVerbose)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error initializing app: %v\n", err)
 		os.Exit(1)
 	}
+	services.ContextOptions = append(services.ContextOptions, app.WithVerbosity(verbosity))
 
 	ctx := context.Background()
 
@@ -51,9 +84,7 @@ func main() {
 	case "logs":
 		handleLogs(args)
 	case "analyze":
-		analyzeCmd(args)
-	case "refresh":
-		handleRefresh(args)
+		analyzeCmd(args, verbosity)
 	case "config":
 		configCmd(args)
 	case "plugin":
@@ -62,15 +93,15 @@ func main() {
 	case "claude":
 		// Backward compatibility: "dw claude <command>" -> "dw claude-code <command>"
 		if len(args) > 0 {
-			cmdCtx := app.NewCommandContext(services.Logger, services.DBPath, services.WorkingDir, services.EventRepo, os.Stdout, os.Stdin)
+			cmdCtx := app.NewCommandContext(services.Logger, services.DBPath, services.WorkingDir, services.EventRepo, os.Stdout, os.Stdin, services.ContextOptions...)
 			if err := services.CommandRegistry.ExecuteCommand(ctx, "claude-code", args[0], args[1:], cmdCtx); err != nil {
 				fmt.Fprintf(os.Stderr, "Error executing claude-code command: %v\n", err)
-				os.Exit(1)
+				os.Exit(pluginsdk.ExitCode(err))
 			}
 		} else {
 			fmt.Fprintf(os.Stderr, "Error: claude subcommand required\n")
 			fmt.Fprintf(os.Stderr, "Usage: dw claude <subcommand>\n")
-			os.Exit(1)
+			os.Exit(pluginsdk.ExitUsage)
 		}
 	default:
 		// Check if this is a plugin help request: dw <plugin> --help
@@ -87,36 +118,23 @@ func main() {
 		}
 
 		// Try plugin commands: dw <plugin-name> <command> [args]
-		cmdCtx := app.NewCommandContext(services.Logger, services.DBPath, services.WorkingDir, services.EventRepo, os.Stdout, os.Stdin)
-		if len(args) > 0 {
-			// Try multi-word commands first (e.g., "project create")
-			// Start from longest possible command and work backwards
-			for i := len(args); i >= 1; i-- {
-				cmdName := strings.Join(args[:i], " ")
-				cmdArgs := args[i:]
-
-				err := services.CommandRegistry.ExecuteCommand(ctx, command, cmdName, cmdArgs, cmdCtx)
-				if err == nil {
-					return
-				}
-
-				// If command was found but execution failed, show error
-				if !isPluginOrCommandNotFound(err) {
-					fmt.Fprintf(os.Stderr, "Error: %v\n\n", err)
-					printCommandHelp(services, command, cmdName)
-					os.Exit(1)
-				}
-				// Otherwise, try shorter command prefix
-			}
-		}
-		// Try as: dw <command> (single-word plugin command with no subcommand)
-		if err := services.CommandRegistry.ExecuteCommand(ctx, command, "", args, cmdCtx); err == nil {
+		cmdCtx := app.NewCommandContext(services.Logger, services.DBPath, services.WorkingDir, services.EventRepo, os.Stdout, os.Stdin, services.ContextOptions...)
+		cmdName, err := RoutePluginCommand(ctx, services.CommandRegistry, command, args, cmdCtx)
+		if err == nil {
 			return
 		}
+
+		// The command was found but execution failed: show the error and its help
+		if !IsRoutingMiss(err) {
+			fmt.Fprintf(os.Stderr, "Error: %v\n\n", err)
+			printCommandHelp(services, command, cmdName)
+			os.Exit(pluginsdk.ExitCode(err))
+		}
+
 		// Unknown command - show full help with loaded plugins
 		fmt.Fprintf(os.Stderr, "Unknown command: %s\n\n", command)
 		printFullUsage(services)
-		os.Exit(1)
+		os.Exit(pluginsdk.ExitUsage)
 	}
 }
 
@@ -139,21 +157,28 @@ func printBasicUsage() {
 	fmt.Println("  dw <plugin> <command> [args]   Run a plugin command")
 	fmt.Println()
 	fmt.Println("Built-in Commands:")
-	fmt.Println("  dw init              Initialize DarwinFlow and all plugins")
+	fmt.Println("  dw init              Initialize DarwinFlow and all plugins (--template <name> seeds a starter roadmap)")
 	fmt.Println("  dw logs              View logged events from the database")
 	fmt.Println("  dw analyze           Analyze sessions to identify tool gaps and inefficiencies")
 	fmt.Println("  dw ui                Interactive UI for browsing and analyzing sessions")
 	fmt.Println("  dw config            Manage DarwinFlow configuration")
 	fmt.Println("  dw refresh           Update database schema and hooks to latest version")
+	fmt.Println("  dw doctor            Diagnose setup problems (database, hooks, plugins)")
 	fmt.Println("  dw plugin            Manage plugins (list, reload)")
 	fmt.Println("  dw help              Show this help message")
 	fmt.Println()
+	fmt.Println("Global Flags:")
+	fmt.Println("  --no-color           Disable colored output (also: NO_COLOR env var)")
+	fmt.Println("  --quiet              Plugin commands: suppress success messages (errors and --json output still print)")
+	fmt.Println("  --verbose            Show debug diagnostics on stderr; plugin commands may print extra details")
+	fmt.Println()
 	fmt.Println("For command-specific help:")
 	fmt.Println("  dw logs --help       Show logs command help and database schema")
 	fmt.Println("  dw analyze --help    Show analyze command options")
 	fmt.Println("  dw config --help     Show config command options")
 	fmt.Println("  dw plugin --help     Show plugin command options")
 	fmt.Println()
+	printExitCodes()
 }
 
 // printFullUsage shows help with all registered plugin commands
@@ -164,15 +189,21 @@ func printFullUsage(services *AppServices) {
 	fmt.Println("  dw <plugin> <command> [args]   Run a plugin command")
 	fmt.Println()
 	fmt.Println("Built-in Commands:")
-	fmt.Println("  dw init              Initialize DarwinFlow and all plugins")
+	fmt.Println("  dw init              Initialize DarwinFlow and all plugins (--template <name> seeds a starter roadmap)")
 	fmt.Println("  dw logs              View logged events from the database")
 	fmt.Println("  dw analyze           Analyze sessions to identify tool gaps and inefficiencies")
 	fmt.Println("  dw ui                Interactive UI for browsing and analyzing sessions")
 	fmt.Println("  dw config            Manage DarwinFlow configuration")
 	fmt.Println("  dw refresh           Update database schema and hooks to latest version")
+	fmt.Println("  dw doctor            Diagnose setup problems (database, hooks, plugins)")
 	fmt.Println("  dw plugin            Manage plugins (list, reload)")
 	fmt.Println("  dw help              Show this help message")
 	fmt.Println()
+	fmt.Println("Global Flags:")
+	fmt.Println("  --no-color           Disable colored output (also: NO_COLOR env var)")
+	fmt.Println("  --quiet              Plugin commands: suppress success messages (errors and --json output still print)")
+	fmt.Println("  --verbose            Show debug diagnostics on stderr; plugin commands may print extra details")
+	fmt.Println()
 
 	// Dynamically list all plugin commands
 	fmt.Println("Plugin Commands:")
@@ -211,6 +242,20 @@ func printFullUsage(services *AppServices) {
 	fmt.Println()
 	fmt.Println("Environment Variables:")
 	fmt.Println("  DW_CONTEXT           Set the current context (e.g., project/myapp)")
+	fmt.Println("  NO_COLOR             Disable colored output when set to any value")
+	fmt.Println()
+	printExitCodes()
+}
+
+// printExitCodes lists dw's exit codes for the usage screens
+func printExitCodes() {
+	fmt.Println("Exit Codes:")
+	fmt.Println("  0                    Success")
+	fmt.Println("  1                    Error")
+	fmt.Println("  2                    Usage or validation error (unknown command, missing or invalid arguments)")
+	fmt.Println("  3                    Not found (the referenced entity does not exist)")
+	fmt.Println("  4                    Concurrent modification (the database stayed locked; retry later)")
+	fmt.Println("  5                    Plugin failure (a plugin crashed or failed to run the command)")
 	fmt.Println()
 }
 
@@ -259,12 +304,7 @@ func printCommandHelp(services *AppServices, pluginName, commandName string) {
 	cmd, err := services.CommandRegistry.GetCommand(pluginName, commandName)
 	if err == nil && cmd != nil {
 		// Show command-specific help
-		fmt.Printf("Command: dw %s %s\n\n", pluginName, commandName)
-		fmt.Printf("Description:\n  %s\n\n", cmd.GetDescription())
-		fmt.Printf("Usage:\n  %s\n\n", cmd.GetUsage())
-		if help := cmd.GetHelp(); help != "" {
-			fmt.Printf("%s\n", help)
-		}
+		app.WriteCommandHelp(os.Stdout, pluginName, cmd)
 		return
 	}
 
@@ -275,13 +315,28 @@ func printCommandHelp(services *AppServices, pluginName, commandName string) {
 	}
 }
 
-// isPluginOrCommandNotFound checks if error indicates missing plugin/command
-func isPluginOrCommandNotFound(err error) bool {
-	if err == nil {
-		return false
+// RoutePluginCommand runs `dw <plugin> <command...> [args]`. Multi-word commands are
+// tried longest first (e.g. "project create" before "project"), then the plugin's
+// unnamed command with all args. It returns the name of the command that ran and its
+// error; if nothing matched, the error satisfies IsRoutingMiss.
+func RoutePluginCommand(ctx context.Context, registry *app.CommandRegistry, pluginName string, args []string, cmdCtx pluginsdk.CommandContext) (string, error) {
+	for i := len(args); i >= 1; i-- {
+		cmdName := strings.Join(args[:i], " ")
+		err := registry.ExecuteCommand(ctx, pluginName, cmdName, args[i:], cmdCtx)
+		if !IsRoutingMiss(err) {
+			return cmdName, err
+		}
+		// Otherwise, try shorter command prefix
 	}
-	errMsg := err.Error()
-	return strings.Contains(errMsg, "plugin not found") ||
-		strings.Contains(errMsg, "command not found") ||
-		strings.Contains(errMsg, "does not provide commands")
+
+	// Try as: dw <command> (single-word plugin command with no subcommand)
+	return "", registry.ExecuteCommand(ctx, pluginName, "", args, cmdCtx)
+}
+
+// IsRoutingMiss reports whether err means no plugin command matched, as opposed
+// to a command that was found and failed
+func IsRoutingMiss(err error) bool {
+	return errors.Is(err, app.ErrPluginNotFound) ||
+		errors.Is(err, app.ErrCommandNotFound) ||
+		errors.Is(err, app.ErrPluginNoCommands)
 }
@@ -0,0 +1,129 @@
+package main_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	main "github.com/kgatilin/darwinflow-pub/cmd/dw"
+	"github.com/kgatilin/darwinflow-pub/internal/app"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// routeCommand records the args it was executed with and returns a fixed error
+type routeCommand struct {
+	name string
+	err  error
+	ran  *[]string
+}
+
+func (c *routeCommand) GetName() string        { return c.name }
+func (c *routeCommand) GetDescription() string { return "" }
+func (c *routeCommand) GetUsage() string       { return "" }
+func (c *routeCommand) GetHelp() string        { return "" }
+func (c *routeCommand) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	*c.ran = append(*c.ran, fmt.Sprintf("%s[%s]", c.name, strings.Join(args, " ")))
+	return c.err
+}
+
+// routePlugin is a plugin with a fixed set of commands
+type routePlugin struct {
+	name     string
+	commands []pluginsdk.Command
+}
+
+func (p *routePlugin) GetInfo() pluginsdk.PluginInfo { return pluginsdk.PluginInfo{Name: p.name} }
+func (p *routePlugin) GetCapabilities() []string     { return []string{"ICommandProvider"} }
+func (p *routePlugin) GetCommands() []pluginsdk.Command {
+	return p.commands
+}
+
+// routeNoCommandsPlugin is a plugin that does not provide commands
+type routeNoCommandsPlugin struct{}
+
+func (p *routeNoCommandsPlugin) GetInfo() pluginsdk.PluginInfo {
+	return pluginsdk.PluginInfo{Name: "passive"}
+}
+func (p *routeNoCommandsPlugin) GetCapabilities() []string { return nil }
+
+func newRouteRegistry(t *testing.T, ran *[]string, failure error) *app.CommandRegistry {
+	t.Helper()
+	logger := &app.NoOpLogger{}
+	plugins := app.NewPluginRegistry(logger)
+	if err := plugins.RegisterPlugin(&routePlugin{name: "tasks", commands: []pluginsdk.Command{
+		&routeCommand{name: "project create", ran: ran},
+		&routeCommand{name: "project", ran: ran},
+		&routeCommand{name: "broken", err: failure, ran: ran},
+	}}); err != nil {
+		t.Fatalf("RegisterPlugin failed: %v", err)
+	}
+	if err := plugins.RegisterPlugin(&routePlugin{name: "single", commands: []pluginsdk.Command{
+		&routeCommand{name: "", ran: ran},
+	}}); err != nil {
+		t.Fatalf("RegisterPlugin failed: %v", err)
+	}
+	if err := plugins.RegisterPlugin(&routeNoCommandsPlugin{}); err != nil {
+		t.Fatalf("RegisterPlugin failed: %v", err)
+	}
+	return app.NewCommandRegistry(plugins, logger)
+}
+
+func TestRoutePluginCommand(t *testing.T) {
+	// A failing command whose error happens to wrap a "not found" sentinel of
+	// its own must still be reported as a failure, not as a routing miss
+	failure := fmt.Errorf("task missing: %w", pluginsdk.ErrNotFound)
+
+	tests := []struct {
+		name     string
+		plugin   string
+		args     []string
+		wantCmd  string
+		wantRan  string
+		wantErr  error
+		wantMiss bool
+	}{
+		{name: "longest multi-word command wins", plugin: "tasks", args: []string{"project", "create", "x"},
+			wantCmd: "project create", wantRan: "project create[x]"},
+		{name: "falls back to shorter prefix", plugin: "tasks", args: []string{"project", "list"},
+			wantCmd: "project", wantRan: "project[list]"},
+		{name: "falls back to unnamed command", plugin: "single", args: []string{"a", "b"},
+			wantCmd: "", wantRan: "[a b]"},
+		{name: "execution failure is not a miss", plugin: "tasks", args: []string{"broken"},
+			wantCmd: "broken", wantRan: "broken[]", wantErr: failure},
+		{name: "unknown command", plugin: "tasks", args: []string{"nope"},
+			wantErr: app.ErrCommandNotFound, wantMiss: true},
+		{name: "unknown plugin", plugin: "ghost", args: []string{"init"},
+			wantErr: app.ErrPluginNotFound, wantMiss: true},
+		{name: "plugin without commands", plugin: "passive", args: []string{"init"},
+			wantErr: app.ErrPluginNoCommands, wantMiss: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ran []string
+			registry := newRouteRegistry(t, &ran, failure)
+			cmdName, err := main.RoutePluginCommand(context.Background(), registry, tt.plugin, tt.args, nil)
+
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Fatalf("RoutePluginCommand() error = %v, want %v", err, tt.wantErr)
+			}
+			if got := main.IsRoutingMiss(err); got != tt.wantMiss {
+				t.Errorf("IsRoutingMiss(%v) = %v, want %v", err, got, tt.wantMiss)
+			}
+			if tt.wantMiss {
+				if len(ran) != 0 {
+					t.Errorf("expected no command to run, ran %v", ran)
+				}
+				return
+			}
+			if cmdName != tt.wantCmd {
+				t.Errorf("command name = %q, want %q", cmdName, tt.wantCmd)
+			}
+			if len(ran) != 1 || ran[0] != tt.wantRan {
+				t.Errorf("ran %v, want [%s]", ran, tt.wantRan)
+			}
+		})
+	}
+}
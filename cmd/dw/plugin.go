@@ -1,19 +1,24 @@
 package main
 
 import (
+	"encoding/json"
+	"flag"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/kgatilin/darwinflow-pub/internal/app"
 	"github.com/kgatilin/darwinflow-pub/internal/infra"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
 )
 
 // PluginCmd handles the "dw plugin" command and its subcommands
 func PluginCmd(args []string) {
 	if len(args) == 0 {
 		printPluginCmdHelp()
-		os.Exit(1)
+		os.Exit(pluginsdk.ExitUsage)
 	}
 
 	subcommand := args[0]
@@ -24,12 +29,18 @@ func PluginCmd(args []string) {
 		handlePluginList(subArgs)
 	case "reload":
 		handlePluginReload(subArgs)
+	case "catalog":
+		handlePluginCatalog(subArgs)
+	case "entities":
+		handlePluginEntities(subArgs)
+	case "schema":
+		handlePluginSchema(subArgs)
 	case "--help", "-h", "help":
 		printPluginCmdHelp()
 	default:
 		fmt.Fprintf(os.Stderr, "Unknown plugin subcommand: %s\n\n", subcommand)
 		printPluginCmdHelp()
-		os.Exit(1)
+		os.Exit(pluginsdk.ExitUsage)
 	}
 }
 
@@ -40,6 +51,12 @@ func handlePluginList(args []string) {
 		printPluginListHelp()
 		return
 	}
+	format, err := ParsePluginListFormat(args)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n\n", err)
+		printPluginListHelp()
+		os.Exit(pluginsdk.ExitUsage)
+	}
 
 	// Initialize app to get plugin registry
 	services, err := InitializeApp(app.DefaultDBPath, "", false)
@@ -48,9 +65,29 @@ func handlePluginList(args []string) {
 		os.Exit(1)
 	}
 
+	if format != app.PluginListFormatText {
+		catalog := app.BuildPluginCatalog(services.PluginRegistry, services.CommandRegistry, services.PluginErrors)
+		entries := app.BuildPluginList(catalog, services.DisabledPlugins)
+		for i := range entries {
+			// Match the text output, which counts every built-in plugin as core
+			entries[i].IsCore = entries[i].IsCore || isBuiltInPlugin(entries[i].Name)
+		}
+
+		if format == app.PluginListFormatJSON {
+			err = app.FormatPluginListAsJSON(os.Stdout, entries)
+		} else {
+			err = app.FormatPluginListAsTable(os.Stdout, entries)
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	// Get all plugins
 	allPlugins := services.PluginRegistry.GetAllPlugins()
-	if len(allPlugins) == 0 {
+	if len(allPlugins) == 0 && len(services.PluginErrors) == 0 {
 		fmt.Println("No plugins registered.")
 		return
 	}
@@ -80,8 +117,39 @@ func handlePluginList(args []string) {
 		fmt.Printf("  ✓ %-20s (%s)   - %s\n", info.Name, pluginType, info.Description)
 	}
 
+	// External plugins that could not be loaded are listed rather than omitted
+	for _, loadErr := range services.PluginErrors {
+		fmt.Printf("  ✗ %-20s (%s)   - failed to load: %s\n", loadErr.Plugin, "external", loadErr.Error)
+	}
+	for _, name := range services.DisabledPlugins {
+		fmt.Printf("  - %-20s (%s)   - disabled in plugins.yaml\n", name, "external")
+	}
+
 	fmt.Println()
 	fmt.Printf("Total: %d plugin(s) (%d core, %d external)\n", len(allPlugins), coreCount, externalCount)
+	if len(services.PluginErrors) > 0 {
+		fmt.Printf("%d plugin(s) failed to load\n", len(services.PluginErrors))
+	}
+}
+
+// ParsePluginListFormat parses the flags of 'dw plugin list' and returns the output format
+func ParsePluginListFormat(args []string) (string, error) {
+	fs := flag.NewFlagSet("plugin list", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	format := fs.String("format", app.PluginListFormatText, "Output format: text, json or table")
+	if err := fs.Parse(args); err != nil {
+		return "", err
+	}
+	if fs.NArg() > 0 {
+		return "", fmt.Errorf("unexpected argument %q", fs.Arg(0))
+	}
+
+	switch *format {
+	case app.PluginListFormatText, app.PluginListFormatJSON, app.PluginListFormatTable:
+		return *format, nil
+	default:
+		return "", fmt.Errorf("invalid format %q (valid: text, json, table)", *format)
+	}
 }
 
 // handlePluginReload reloads external plugins from config
@@ -129,6 +197,125 @@ func handlePluginReload(args []string) {
 	fmt.Println("\nNote: Plugins will be active on next command execution.")
 }
 
+// handlePluginCatalog prints all plugins and their commands, optionally as JSON
+func handlePluginCatalog(args []string) {
+	asJSON := false
+	for _, arg := range args {
+		switch arg {
+		case "--json":
+			asJSON = true
+		case "--help", "-h":
+			printPluginCatalogHelp()
+			return
+		}
+	}
+
+	// Initialize app to get plugin and command registries
+	services, err := InitializeApp(app.DefaultDBPath, "", false)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error initializing app: %v\n", err)
+		os.Exit(1)
+	}
+
+	catalog := app.BuildPluginCatalog(services.PluginRegistry, services.CommandRegistry, services.PluginErrors)
+
+	if asJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(catalog); err != nil {
+			fmt.Fprintf(os.Stderr, "Error encoding catalog: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	for _, plugin := range catalog.Plugins {
+		fmt.Printf("%s (version %s)\n", plugin.Name, plugin.Version)
+		fmt.Printf("  %s\n", plugin.Description)
+		for _, cmd := range plugin.Commands {
+			fmt.Printf("    %-35s %s\n", cmd.Name, cmd.Description)
+		}
+		fmt.Println()
+	}
+	for _, loadErr := range catalog.Errors {
+		fmt.Printf("✗ %s: %s\n", loadErr.Plugin, loadErr.Error)
+	}
+}
+
+// handlePluginEntities lists the entity types provided by plugins
+func handlePluginEntities(args []string) {
+	if len(args) > 0 && (args[0] == "--help" || args[0] == "-h") {
+		printPluginEntitiesHelp()
+		return
+	}
+
+	services, err := InitializeApp(app.DefaultDBPath, "", false)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error initializing app: %v\n", err)
+		os.Exit(1)
+	}
+
+	PrintEntityTypes(os.Stdout, services.PluginRegistry.GetEntityTypeDisplays())
+}
+
+// handlePluginSchema prints the JSON Schema of a plugin's entity types
+func handlePluginSchema(args []string) {
+	if len(args) == 0 || args[0] == "--help" || args[0] == "-h" || len(args) > 2 {
+		printPluginSchemaHelp()
+		if len(args) == 0 || len(args) > 2 {
+			os.Exit(pluginsdk.ExitUsage)
+		}
+		return
+	}
+	pluginName := args[0]
+	entityType := ""
+	if len(args) == 2 {
+		entityType = args[1]
+	}
+
+	services, err := InitializeApp(app.DefaultDBPath, "", false)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error initializing app: %v\n", err)
+		os.Exit(1)
+	}
+
+	provider, err := services.PluginRegistry.GetEntitySchemaProvider(pluginName)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(pluginsdk.ExitCode(err))
+	}
+	schema, err := app.BuildEntityJSONSchema(provider, entityType)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(pluginsdk.ExitCode(err))
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(schema); err != nil {
+		fmt.Fprintf(os.Stderr, "Error encoding schema: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// PrintEntityTypes writes entity types with their plugin-provided icon and names and
+// the actions the host offers for each type
+func PrintEntityTypes(w io.Writer, displays []app.EntityTypeDisplay) {
+	if len(displays) == 0 {
+		fmt.Fprintln(w, "No entity types registered.")
+		return
+	}
+
+	fmt.Fprintln(w, "Entity Types:")
+	for _, display := range displays {
+		fmt.Fprintf(w, "  %s %-20s (%s)\n", display.Icon, display.DisplayNamePlural, display.QualifiedType)
+		if display.Description != "" {
+			fmt.Fprintf(w, "      %s\n", display.Description)
+		}
+		fmt.Fprintf(w, "      Actions: %s\n", strings.Join(display.Actions, ", "))
+	}
+}
+
 // isBuiltInPlugin returns true if the plugin is a built-in core plugin
 func isBuiltInPlugin(name string) bool {
 	builtInPlugins := []string{"claude-code", "task-manager"}
@@ -149,17 +336,23 @@ func printPluginCmdHelp() {
 	fmt.Println("Subcommands:")
 	fmt.Println("  list      List all registered plugins (core and external)")
 	fmt.Println("  reload    Reload external plugins from .darwinflow/plugins.yaml")
+	fmt.Println("  catalog   Show all plugins and commands (--json for docs generation)")
+	fmt.Println("  entities  List entity types provided by plugins")
+	fmt.Println("  schema    Print the JSON Schema of a plugin's entity types")
 	fmt.Println("  help      Show this help message")
 	fmt.Println()
 	fmt.Println("For subcommand-specific help:")
 	fmt.Println("  dw plugin list --help")
 	fmt.Println("  dw plugin reload --help")
+	fmt.Println("  dw plugin catalog --help")
+	fmt.Println("  dw plugin entities --help")
+	fmt.Println("  dw plugin schema --help")
 	fmt.Println()
 }
 
 // printPluginListHelp prints help for the plugin list command
 func printPluginListHelp() {
-	fmt.Println("Usage: dw plugin list")
+	fmt.Println("Usage: dw plugin list [--format text|json|table]")
 	fmt.Println()
 	fmt.Println("List all registered plugins (core and external)")
 	fmt.Println()
@@ -167,10 +360,20 @@ func printPluginListHelp() {
 	fmt.Println("  - Plugin name")
 	fmt.Println("  - Plugin type (core or external)")
 	fmt.Println("  - Plugin description")
+	fmt.Println("  - External plugins that failed to load, with the error")
 	fmt.Println("  - Total count by type")
 	fmt.Println()
-	fmt.Println("Example:")
+	fmt.Println("Flags:")
+	fmt.Println("  --format FORMAT   text (default), json or table")
+	fmt.Println()
+	fmt.Println("JSON fields per plugin: name, version, description, is_core, enabled,")
+	fmt.Println("capabilities, commands (command names) and error (only for plugins that")
+	fmt.Println("failed to load). Plugins disabled in plugins.yaml have enabled: false.")
+	fmt.Println()
+	fmt.Println("Examples:")
 	fmt.Println("  dw plugin list")
+	fmt.Println("  dw plugin list --format table")
+	fmt.Println("  dw plugin list --format json | jq '.[] | select(.error)'")
 	fmt.Println()
 }
 
@@ -191,3 +394,65 @@ func printPluginReloadHelp() {
 	fmt.Println("  dw plugin reload")
 	fmt.Println()
 }
+
+// printPluginCatalogHelp prints help for the plugin catalog command
+func printPluginCatalogHelp() {
+	fmt.Println("Usage: dw plugin catalog [--json]")
+	fmt.Println()
+	fmt.Println("Show every registered plugin with its commands")
+	fmt.Println()
+	fmt.Println("Flags:")
+	fmt.Println("  --json    Emit a machine-readable catalog for docs generation")
+	fmt.Println()
+	fmt.Println("JSON schema (schema_version 1):")
+	fmt.Println("  schema_version   Catalog schema version (bumped on breaking changes)")
+	fmt.Println("  plugins[]        name, version, description, is_core, capabilities,")
+	fmt.Println("                   commands[] (name, description, usage, help)")
+	fmt.Println("  errors[]         plugin, error - plugins that could not be loaded or introspected")
+	fmt.Println()
+	fmt.Println("Plugins and commands are sorted by name so output is stable.")
+	fmt.Println()
+	fmt.Println("Example:")
+	fmt.Println("  dw plugin catalog --json > catalog.json")
+	fmt.Println()
+}
+
+// printPluginEntitiesHelp prints help for the plugin entities command
+func printPluginEntitiesHelp() {
+	fmt.Println("Usage: dw plugin entities")
+	fmt.Println()
+	fmt.Println("List the entity types provided by plugins")
+	fmt.Println()
+	fmt.Println("Each type is shown with the icon and display names its plugin provides,")
+	fmt.Println("falling back to " + app.DefaultEntityIcon + " and the type identifier when the plugin omits them.")
+	fmt.Println("Actions are derived from what the type supports:")
+	fmt.Println("  view      Every entity type")
+	fmt.Println("  context   Types with the IHasContext capability")
+	fmt.Println("  edit      Types whose plugin implements IEntityUpdater")
+	fmt.Println()
+	fmt.Println("Types are shown qualified with their plugin (plugin:type). An entity type")
+	fmt.Println("belongs to one plugin: a plugin declaring a type another plugin already")
+	fmt.Println("provides is not loaded, and 'dw plugin list' shows the conflict.")
+	fmt.Println()
+	fmt.Println("Example:")
+	fmt.Println("  dw plugin entities")
+	fmt.Println()
+}
+
+// printPluginSchemaHelp prints help for the plugin schema command
+func printPluginSchemaHelp() {
+	fmt.Println("Usage: dw plugin schema <plugin> [<type>]")
+	fmt.Println()
+	fmt.Println("Print the JSON Schema (draft 2020-12) of a plugin's entity types")
+	fmt.Println()
+	fmt.Println("The schema is derived from the field definitions the plugin returns for")
+	fmt.Println("get_entity_schema, so it requires the IEntitySchemaProvider capability.")
+	fmt.Println("With a type, the schema of that type is printed; otherwise all types are")
+	fmt.Println("bundled under \"$defs\". Use it to validate query_entities results (without")
+	fmt.Println("a field projection) or to generate types for plugin data.")
+	fmt.Println()
+	fmt.Println("Examples:")
+	fmt.Println("  dw plugin schema notes-external")
+	fmt.Println("  dw plugin schema notes-external note")
+	fmt.Println()
+}
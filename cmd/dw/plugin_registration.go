@@ -36,7 +36,7 @@ type logsServiceAdapter struct {
 }
 
 func (a *logsServiceAdapter) ListRecentLogs(ctx context.Context, limit, offset int, sessionID string, ordered bool) ([]*claude_code.LogRecord, error) {
-	appLogs, err := a.inner.ListRecentLogs(ctx, limit, offset, sessionID, ordered)
+	appLogs, err := a.inner.ListRecentLogs(ctx, limit, offset, app.LogFilter{SessionID: sessionID}, ordered)
 	if err != nil {
 		return nil, err
 	}
@@ -5,13 +5,19 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	main "github.com/kgatilin/darwinflow-pub/cmd/dw"
+	"github.com/kgatilin/darwinflow-pub/internal/app"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
 )
 
 // TestPluginListCommand tests the 'dw plugin list' command
 func TestPluginListCommand(t *testing.T) {
+	// Keep the events database out of the source tree
+	t.Chdir(t.TempDir())
+
 	// Capture stdout
 	oldStdout := os.Stdout
 	r, w, _ := os.Pipe()
@@ -115,3 +121,46 @@ func TestPluginCommand_Help(t *testing.T) {
 		})
 	}
 }
+
+// TestPrintEntityTypes verifies that plugin-provided icons and names are shown
+func TestPrintEntityTypes(t *testing.T) {
+	var buf bytes.Buffer
+	main.PrintEntityTypes(&buf, []app.EntityTypeDisplay{
+		app.NewEntityTypeDisplay(pluginsdk.EntityTypeInfo{
+			Type: "note", DisplayName: "Note", DisplayNamePlural: "Notes", Icon: "📝", Description: "A text note",
+		}, "notes", true),
+		app.NewEntityTypeDisplay(pluginsdk.EntityTypeInfo{Type: "task"}, "tasks", false),
+	})
+	output := buf.String()
+
+	for _, want := range []string{"📝 Notes", "(notes:note)", "A text note", "Actions: view, edit", app.DefaultEntityIcon + " tasks", "Actions: view\n"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("output missing %q:\n%s", want, output)
+		}
+	}
+}
+
+func TestParsePluginListFormat(t *testing.T) {
+	tests := []struct {
+		args    []string
+		want    string
+		wantErr bool
+	}{
+		{nil, app.PluginListFormatText, false},
+		{[]string{"--format", "json"}, app.PluginListFormatJSON, false},
+		{[]string{"--format=table"}, app.PluginListFormatTable, false},
+		{[]string{"--format", "yaml"}, "", true},
+		{[]string{"extra"}, "", true},
+	}
+
+	for _, tt := range tests {
+		got, err := main.ParsePluginListFormat(tt.args)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParsePluginListFormat(%v) error = %v, wantErr %v", tt.args, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParsePluginListFormat(%v) = %q, want %q", tt.args, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/kgatilin/darwinflow-pub/internal/app"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// clearLine returns the cursor to the start of the line and erases it
+const clearLine = "\r\033[K"
+
+// SpinnerProgress draws a spinner with a label and the elapsed time on a single
+// terminal line, redrawing it in place until stopped. It implements app.AnalysisProgress.
+type SpinnerProgress struct {
+	out    io.Writer
+	frames []string
+	fps    time.Duration
+
+	mu   sync.Mutex
+	stop chan struct{}
+	done chan struct{}
+}
+
+// NewSpinnerProgress creates a spinner drawing to out
+func NewSpinnerProgress(out io.Writer) *SpinnerProgress {
+	return &SpinnerProgress{out: out, frames: spinner.MiniDot.Frames, fps: spinner.MiniDot.FPS}
+}
+
+// Start shows the spinner with label, replacing a running one
+func (s *SpinnerProgress) Start(label string) {
+	s.Stop()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stop = make(chan struct{})
+	s.done = make(chan struct{})
+	go s.run(label, time.Now(), s.stop, s.done)
+}
+
+// Stop removes the spinner and clears its line; it does nothing when none is running
+func (s *SpinnerProgress) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.stop == nil {
+		return
+	}
+	close(s.stop)
+	<-s.done
+	s.stop, s.done = nil, nil
+	fmt.Fprint(s.out, clearLine)
+}
+
+func (s *SpinnerProgress) run(label string, started time.Time, stop <-chan struct{}, done chan<- struct{}) {
+	defer close(done)
+	ticker := time.NewTicker(s.fps)
+	defer ticker.Stop()
+
+	for frame := 0; ; frame++ {
+		elapsed := time.Since(started).Truncate(time.Second)
+		fmt.Fprintf(s.out, "%s%s %s... %s", clearLine, s.frames[frame%len(s.frames)], label, elapsed)
+		select {
+		case <-ticker.C:
+		case <-stop:
+			return
+		}
+	}
+}
+
+// analysisProgress returns the spinner for analyze runs, or nil when it would garble
+// output: with --quiet, or when stderr, where it is drawn, is not a terminal
+func analysisProgress(verbosity pluginsdk.Verbosity) app.AnalysisProgress {
+	if verbosity == pluginsdk.VerbosityQuiet || !isTerminal(os.Stderr) {
+		return nil
+	}
+	return NewSpinnerProgress(os.Stderr)
+}
@@ -0,0 +1,39 @@
+package main_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	main "github.com/kgatilin/darwinflow-pub/cmd/dw"
+)
+
+func TestSpinnerProgress_ClearsLineOnStop(t *testing.T) {
+	var out bytes.Buffer
+	progress := main.NewSpinnerProgress(&out)
+
+	progress.Start("Analyzing session abc (1 of 2)")
+	time.Sleep(20 * time.Millisecond)
+	progress.Start("Analyzing session def (2 of 2)")
+	time.Sleep(20 * time.Millisecond)
+	progress.Stop()
+	progress.Stop() // stopping twice is harmless
+
+	output := out.String()
+	for _, want := range []string{"Analyzing session abc (1 of 2)... 0s", "Analyzing session def (2 of 2)... 0s"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("output %q does not show %q", output, want)
+		}
+	}
+	if !strings.HasSuffix(output, "\r\033[K") || strings.Count(output[strings.LastIndex(output, "def"):], "\r\033[K") != 1 {
+		t.Errorf("output %q must end with exactly one cleared line after the last frame", output)
+	}
+
+	// Nothing is drawn after Stop
+	size := out.Len()
+	time.Sleep(150 * time.Millisecond)
+	if out.Len() != size {
+		t.Errorf("spinner kept drawing after Stop: %q", out.String()[size:])
+	}
+}
@@ -2,23 +2,120 @@ package main
 
 import (
 	"context"
+	"errors"
+	"flag"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/kgatilin/darwinflow-pub/internal/app"
 	"github.com/kgatilin/darwinflow-pub/internal/infra"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/claude_code"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
 )
 
+// RefreshOptions holds the flags of the refresh command
+type RefreshOptions struct {
+	SchemaOnly bool // Run only the schema phase
+	HooksOnly  bool // Run only the hooks phase
+	DryRun     bool // Report what would change without changing it
+	Reindex    bool // Rebuild the event payload index (schema phase)
+}
+
+// RunSchema reports whether the schema phase runs
+func (o *RefreshOptions) RunSchema() bool {
+	return !o.HooksOnly
+}
+
+// RunHooks reports whether the hooks phase runs
+func (o *RefreshOptions) RunHooks() bool {
+	return !o.SchemaOnly
+}
+
+// Phases returns the names of the phases that run, in execution order
+func (o *RefreshOptions) Phases() []string {
+	var phases []string
+	if o.RunSchema() {
+		phases = append(phases, "schema")
+	}
+	if o.RunHooks() {
+		phases = append(phases, "hooks")
+	}
+	return phases
+}
+
+// ParseRefreshFlags parses command line flags for the refresh command
+func ParseRefreshFlags(args []string) (*RefreshOptions, error) {
+	fs := flag.NewFlagSet("refresh", flag.ContinueOnError)
+	opts := &RefreshOptions{}
+
+	fs.BoolVar(&opts.SchemaOnly, "schema-only", false, "Only migrate the database schema and configuration")
+	fs.BoolVar(&opts.HooksOnly, "hooks-only", false, "Only regenerate the Claude Code hooks")
+	fs.BoolVar(&opts.DryRun, "dry-run", false, "Report what would change without changing anything")
+	fs.BoolVar(&opts.Reindex, "reindex", false, "Rebuild the event payload index (run after changing indexed payload keys)")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: dw refresh [--schema-only | --hooks-only] [--dry-run] [--reindex]")
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintln(os.Stderr, "Brings a project up to date in two phases:")
+		fmt.Fprintln(os.Stderr, "  schema  Updates the database schema and configuration, then runs the")
+		fmt.Fprintln(os.Stderr, "          schema migrations of plugins that own tables")
+		fmt.Fprintln(os.Stderr, "  hooks   Installs DarwinFlow hooks missing from the Claude Code settings")
+		fmt.Fprintln(os.Stderr, "Both phases run unless one is selected.")
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintln(os.Stderr, "Flags:")
+		fs.PrintDefaults()
+		fmt.Fprintln(os.Stderr)
+		fmt.Fprintln(os.Stderr, "Examples:")
+		fmt.Fprintln(os.Stderr, "  dw refresh --hooks-only            # After editing hook config")
+		fmt.Fprintln(os.Stderr, "  dw refresh --schema-only --dry-run # Preview migrations")
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if fs.NArg() > 0 {
+		fmt.Fprintf(os.Stderr, "Error: unexpected argument %q\n", fs.Arg(0))
+		return nil, fmt.Errorf("unexpected argument %q", fs.Arg(0))
+	}
+	if opts.SchemaOnly && opts.HooksOnly {
+		fmt.Fprintln(os.Stderr, "Error: --schema-only and --hooks-only cannot be combined")
+		return nil, errors.New("--schema-only and --hooks-only cannot be combined")
+	}
+	if opts.Reindex && opts.HooksOnly {
+		fmt.Fprintln(os.Stderr, "Error: --reindex is part of the schema phase and cannot be combined with --hooks-only")
+		return nil, errors.New("--reindex cannot be combined with --hooks-only")
+	}
+
+	return opts, nil
+}
+
 // handleRefresh updates DarwinFlow framework to the latest version
 // This includes:
 // - Updating database schema (adding new columns, indexes, etc.)
 // - Updating configuration if needed
-// Plugin-specific refresh (hooks, etc.) is handled by plugin init commands
+// - Running schema migrations of plugins implementing pluginsdk.ISchemaMigrator
+// - Rebuilding the event payload index when --reindex is passed
+// - Installing Claude Code hooks missing from the settings file
+// --schema-only and --hooks-only select a single phase; --dry-run reports without changing.
 func handleRefresh(args []string) {
+	opts, err := ParseRefreshFlags(args)
+	if err != nil {
+		if err == flag.ErrHelp {
+			return
+		}
+		os.Exit(pluginsdk.ExitUsage)
+	}
+
 	dbPath := app.DefaultDBPath
+	ctx := context.Background()
 
-	// Initialize app to get plugin registry
-	services, err := InitializeApp(dbPath, "", false)
+	// Initialize app to get plugin registry. Initializing the repository applies the
+	// schema migrations, so modes that must not change the database skip it.
+	initialize := InitializeApp
+	if opts.DryRun || opts.HooksOnly {
+		initialize = InitializeAppWithoutSchema
+	}
+	services, err := initialize(dbPath, "", false)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error initializing app: %v\n", err)
 		os.Exit(1)
@@ -32,21 +129,72 @@ func handleRefresh(args []string) {
 		os.Exit(1)
 	}
 
-	logger := services.Logger
-	configLoader := services.ConfigLoader
-
 	// Create handler (framework-level refresh only)
 	handler := app.NewRefreshCommandHandler(
 		repo,
-		configLoader,
-		logger,
+		services.ConfigLoader,
+		services.Logger,
 		os.Stdout,
 	)
 
-	// Execute
-	ctx := context.Background()
+	if opts.RunSchema() {
+		if err := refreshSchema(ctx, handler, repo, services, dbPath, opts); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(pluginsdk.ExitCode(err))
+		}
+	}
+
+	if opts.RunHooks() {
+		if opts.RunSchema() {
+			fmt.Println()
+		}
+		hooks, err := claude_code.NewHookConfigManager()
+		if err == nil {
+			err = handler.RefreshHooks(hooks, opts.DryRun)
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	fmt.Println()
+	if opts.DryRun {
+		fmt.Printf("Dry run of phases: %s (nothing was changed)\n", strings.Join(opts.Phases(), ", "))
+	} else {
+		fmt.Printf("✓ Phases run: %s\n", strings.Join(opts.Phases(), ", "))
+	}
+}
+
+// refreshSchema runs the schema phase: framework schema and config, plugin schema
+// migrations and, with --reindex, the payload index rebuild
+func refreshSchema(ctx context.Context, handler *app.RefreshCommandHandler, repo *infra.SQLiteEventRepository, services *AppServices, dbPath string, opts *RefreshOptions) error {
+	plugins := services.PluginRegistry.GetAllPlugins()
+
+	if opts.DryRun {
+		if err := handler.PlanSchema(ctx, dbPath, plugins); err != nil {
+			return err
+		}
+		if opts.Reindex {
+			fmt.Println("  Would reindex event payloads")
+		}
+		return nil
+	}
+
 	if err := handler.Execute(ctx, dbPath); err != nil {
-		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-		os.Exit(1)
+		return err
+	}
+
+	fmt.Println()
+	if err := handler.MigratePlugins(ctx, repo.DB(), plugins); err != nil {
+		return err
+	}
+
+	if opts.Reindex {
+		fmt.Println()
+		if err := handler.ReindexPayloads(ctx); err != nil {
+			return err
+		}
 	}
+	return nil
 }
@@ -0,0 +1,49 @@
+package main_test
+
+import (
+	"strings"
+	"testing"
+
+	main "github.com/kgatilin/darwinflow-pub/cmd/dw"
+)
+
+func TestParseRefreshFlags(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       []string
+		wantPhases string
+		wantDryRun bool
+	}{
+		{"both phases by default", nil, "schema,hooks", false},
+		{"schema only", []string{"--schema-only"}, "schema", false},
+		{"hooks only dry run", []string{"--hooks-only", "--dry-run"}, "hooks", true},
+		{"reindex with schema", []string{"--schema-only", "--reindex"}, "schema", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts, err := main.ParseRefreshFlags(tt.args)
+			if err != nil {
+				t.Fatalf("ParseRefreshFlags() failed: %v", err)
+			}
+			if got := strings.Join(opts.Phases(), ","); got != tt.wantPhases {
+				t.Errorf("Phases() = %s, want %s", got, tt.wantPhases)
+			}
+			if opts.DryRun != tt.wantDryRun {
+				t.Errorf("DryRun = %v, want %v", opts.DryRun, tt.wantDryRun)
+			}
+		})
+	}
+}
+
+func TestParseRefreshFlags_Errors(t *testing.T) {
+	for _, args := range [][]string{
+		{"--schema-only", "--hooks-only"},
+		{"--hooks-only", "--reindex"},
+		{"stray"},
+	} {
+		if _, err := main.ParseRefreshFlags(args); err == nil {
+			t.Errorf("ParseRefreshFlags(%v) should fail", args)
+		}
+	}
+}
@@ -2,14 +2,18 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
 
 	"github.com/kgatilin/darwinflow-pub/internal/app"
 	"github.com/kgatilin/darwinflow-pub/internal/app/tui"
 	"github.com/kgatilin/darwinflow-pub/internal/infra"
 	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk/tuiguard"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/claude_code"
 )
 
@@ -18,13 +22,20 @@ func uiCommand(args []string) {
 	dbPath := fs.String("db", app.DefaultDBPath, "Path to SQLite database")
 	configPath := fs.String("config", "", "Path to config file (default: .darwinflow.yaml in current dir)")
 	debugMode := fs.Bool("debug", false, "Enable debug logging")
+	plain := fs.Bool("plain", false, "Accessibility mode for screen readers and recordings: text labels instead of icons, no colors or box borders")
+	noWizard := fs.Bool("no-wizard", false, "Don't offer the first-run wizard when the project has no roadmap")
 
 	if err := fs.Parse(args); err != nil {
 		if err == flag.ErrHelp {
 			return
 		}
 		fmt.Fprintf(os.Stderr, "Error parsing flags: %v\n", err)
-		os.Exit(1)
+		os.Exit(pluginsdk.ExitUsage)
+	}
+
+	// Plain mode drops colors, so selection is shown with a ">" marker instead
+	if *plain {
+		ConfigureColor(true, os.Stdout)
 	}
 
 	// Setup logger
@@ -101,12 +112,39 @@ func uiCommand(args []string) {
 		os.Exit(1)
 	}
 
+	// Offer the first-run wizard before the TUI takes over the terminal
+	if !*noWizard && config.UI.FirstRunWizardEnabled() && isTerminal(os.Stdin) {
+		commandRegistry := app.NewCommandRegistry(registry, logger)
+		cmdCtx := app.NewCommandContext(logger, *dbPath, workingDir, repo, io.Discard, os.Stdin)
+		wizard := app.NewFirstRunWizard(commandRegistry, os.Stdin, os.Stdout)
+		needsFirstRun, err := wizard.NeedsFirstRun(ctx, cmdCtx)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		if needsFirstRun {
+			fmt.Println("This project has no roadmap yet.")
+			fmt.Println("(Turn this off with --no-wizard or 'dw config set ui.first_run_wizard false'.)")
+			fmt.Println()
+			if _, err := wizard.Run(ctx, cmdCtx); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+		}
+	}
+
 	// Create event dispatcher for real-time event streaming
 	pluginCtx := app.NewPluginContext(logger, *dbPath, "", repo)
 	eventDispatcher := app.NewEventDispatcher(repo, logger, pluginCtx)
 
-	// Run TUI
-	if err := tui.Run(ctx, registry, analysisService, logsService, config, eventDispatcher); err != nil {
+	// Run TUI; crash logs go next to errors.log (.darwinflow/ for the default database)
+	crashDir := filepath.Dir(filepath.Dir(*dbPath))
+	if err := tui.Run(ctx, registry, analysisService, logsService, config, eventDispatcher, *plain, crashDir); err != nil {
+		var crash *tuiguard.CrashError
+		if errors.As(err, &crash) {
+			fmt.Fprintln(os.Stderr, crash)
+			os.Exit(1)
+		}
 		fmt.Fprintf(os.Stderr, "Error running UI: %v\n", err)
 		os.Exit(1)
 	}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// Global verbosity flags
+const (
+	QuietFlag   = "--quiet"
+	VerboseFlag = "--verbose"
+)
+
+// StripVerbosityFlags removes the global --quiet and --verbose flags from args.
+// Returns the remaining args and the requested verbosity; passing both flags is an error.
+// Arguments after a "--" separator are passed through untouched.
+func StripVerbosityFlags(args []string) ([]string, pluginsdk.Verbosity, error) {
+	rest := make([]string, 0, len(args))
+	quiet, verbose := false, false
+	for i, arg := range args {
+		if arg == "--" {
+			rest = append(rest, args[i:]...)
+			break
+		}
+		switch arg {
+		case QuietFlag:
+			quiet = true
+		case VerboseFlag:
+			verbose = true
+		default:
+			rest = append(rest, arg)
+		}
+	}
+
+	switch {
+	case quiet && verbose:
+		return nil, pluginsdk.VerbosityNormal, fmt.Errorf("%s and %s cannot be used together", QuietFlag, VerboseFlag)
+	case quiet:
+		return rest, pluginsdk.VerbosityQuiet, nil
+	case verbose:
+		return rest, pluginsdk.VerbosityVerbose, nil
+	}
+	return rest, pluginsdk.VerbosityNormal, nil
+}
@@ -0,0 +1,62 @@
+package main_test
+
+import (
+	"reflect"
+	"testing"
+
+	main "github.com/kgatilin/darwinflow-pub/cmd/dw"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+func TestStripVerbosityFlags(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          []string
+		wantArgs      []string
+		wantVerbosity pluginsdk.Verbosity
+	}{
+		{
+			name:          "no flag",
+			args:          []string{"task-manager", "ac", "list", "TM-task-1"},
+			wantArgs:      []string{"task-manager", "ac", "list", "TM-task-1"},
+			wantVerbosity: pluginsdk.VerbosityNormal,
+		},
+		{
+			name:          "leading quiet",
+			args:          []string{"--quiet", "task-manager", "ac", "verify", "TM-ac-1"},
+			wantArgs:      []string{"task-manager", "ac", "verify", "TM-ac-1"},
+			wantVerbosity: pluginsdk.VerbosityQuiet,
+		},
+		{
+			name:          "trailing verbose",
+			args:          []string{"task-manager", "roadmap", "full", "--verbose"},
+			wantArgs:      []string{"task-manager", "roadmap", "full"},
+			wantVerbosity: pluginsdk.VerbosityVerbose,
+		},
+		{
+			name:          "after separator",
+			args:          []string{"task-manager", "ac", "add", "--", "--quiet"},
+			wantArgs:      []string{"task-manager", "ac", "add", "--", "--quiet"},
+			wantVerbosity: pluginsdk.VerbosityNormal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotArgs, gotVerbosity, err := main.StripVerbosityFlags(tt.args)
+			if err != nil {
+				t.Fatalf("StripVerbosityFlags() error = %v", err)
+			}
+			if !reflect.DeepEqual(gotArgs, tt.wantArgs) {
+				t.Errorf("StripVerbosityFlags() args = %v, want %v", gotArgs, tt.wantArgs)
+			}
+			if gotVerbosity != tt.wantVerbosity {
+				t.Errorf("StripVerbosityFlags() verbosity = %q, want %q", gotVerbosity, tt.wantVerbosity)
+			}
+		})
+	}
+
+	if _, _, err := main.StripVerbosityFlags([]string{"--quiet", "logs", "--verbose"}); err == nil {
+		t.Error("expected error when both --quiet and --verbose are given")
+	}
+}
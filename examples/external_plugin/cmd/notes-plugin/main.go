@@ -93,6 +93,8 @@ func (p *NotesPlugin) handleRequest(req *pluginsdk.RPCRequest) {
 		p.handleGetCapabilities(req)
 	case pluginsdk.RPCMethodGetEntityTypes:
 		p.handleGetEntityTypes(req)
+	case pluginsdk.RPCMethodGetEntitySchema:
+		p.handleGetEntitySchema(req)
 	case pluginsdk.RPCMethodQueryEntities:
 		p.handleQueryEntities(req)
 	case pluginsdk.RPCMethodGetEntity:
@@ -133,7 +135,7 @@ func (p *NotesPlugin) handleGetInfo(req *pluginsdk.RPCRequest) {
 
 // handleGetCapabilities returns supported capabilities.
 func (p *NotesPlugin) handleGetCapabilities(req *pluginsdk.RPCRequest) {
-	capabilities := []string{"IEntityProvider", "IEntityUpdater", "IEventEmitter"}
+	capabilities := []string{"IEntityProvider", "IEntityUpdater", "IEntitySchemaProvider", "IEventEmitter"}
 	p.sendResult(req.ID, capabilities)
 }
 
@@ -152,6 +154,36 @@ func (p *NotesPlugin) handleGetEntityTypes(req *pluginsdk.RPCRequest) {
 	p.sendResult(req.ID, types)
 }
 
+// noteSchema describes the fields of Note.ToMap.
+var noteSchema = pluginsdk.EntitySchema{
+	Type:        "note",
+	Description: "A text note from external plugin",
+	Fields: []pluginsdk.EntityField{
+		{Name: "id", Type: pluginsdk.FieldTypeString, Required: true, Description: "Note ID"},
+		{Name: "type", Type: pluginsdk.FieldTypeString, Required: true, Description: "Always \"note\""},
+		{Name: "title", Type: pluginsdk.FieldTypeString, Required: true, Description: "Note title"},
+		{Name: "content", Type: pluginsdk.FieldTypeString, Required: true, Description: "Note body"},
+		{Name: "created_at", Type: pluginsdk.FieldTypeDateTime, Required: true, Description: "When the note was created"},
+		{Name: "updated_at", Type: pluginsdk.FieldTypeDateTime, Required: true, Description: "When the note was last updated"},
+		{Name: "capabilities", Type: pluginsdk.FieldTypeArray, Items: pluginsdk.FieldTypeString, Description: "Entity capabilities"},
+	},
+}
+
+// handleGetEntitySchema returns the field definitions of the note type.
+func (p *NotesPlugin) handleGetEntitySchema(req *pluginsdk.RPCRequest) {
+	var params pluginsdk.GetEntitySchemaParams
+	if err := json.Unmarshal(req.Params, &params); err != nil {
+		p.sendError(req.ID, pluginsdk.RPCErrorInvalidParams, "invalid params: "+err.Error())
+		return
+	}
+	if params.EntityType != noteSchema.Type {
+		p.sendError(req.ID, pluginsdk.RPCErrorNotFound, "unknown entity type: "+params.EntityType)
+		return
+	}
+
+	p.sendResult(req.ID, noteSchema)
+}
+
 // handleQueryEntities queries notes.
 func (p *NotesPlugin) handleQueryEntities(req *pluginsdk.RPCRequest) {
 	var query pluginsdk.EntityQuery
@@ -160,15 +192,19 @@ func (p *NotesPlugin) handleQueryEntities(req *pluginsdk.RPCRequest) {
 		return
 	}
 
+	p.sendResult(req.ID, p.queryNotes(query))
+}
+
+// queryNotes returns the notes matching query as maps, trimmed to query.Fields when given.
+func (p *NotesPlugin) queryNotes(query pluginsdk.EntityQuery) []map[string]interface{} {
+	notes := make([]map[string]interface{}, 0, len(p.notes))
 	if query.EntityType != "note" {
-		p.sendResult(req.ID, []interface{}{})
-		return
+		return notes
 	}
 
 	// Return all notes as maps
-	notes := make([]map[string]interface{}, 0, len(p.notes))
 	for _, note := range p.notes {
-		notes = append(notes, note.ToMap())
+		notes = append(notes, pluginsdk.ProjectFields(note.ToMap(), query.Fields))
 	}
 
 	// Apply limit if specified
@@ -176,7 +212,7 @@ func (p *NotesPlugin) handleQueryEntities(req *pluginsdk.RPCRequest) {
 		notes = notes[:query.Limit]
 	}
 
-	p.sendResult(req.ID, notes)
+	return notes
 }
 
 // handleGetEntity retrieves a specific note.
@@ -189,7 +225,7 @@ func (p *NotesPlugin) handleGetEntity(req *pluginsdk.RPCRequest) {
 
 	note, ok := p.notes[params.EntityID]
 	if !ok {
-		p.sendError(req.ID, -32000, "note not found")
+		p.sendError(req.ID, pluginsdk.RPCErrorNotFound, "note not found")
 		return
 	}
 
@@ -206,7 +242,7 @@ func (p *NotesPlugin) handleUpdateEntity(req *pluginsdk.RPCRequest) {
 
 	note, ok := p.notes[params.EntityID]
 	if !ok {
-		p.sendError(req.ID, -32000, "note not found")
+		p.sendError(req.ID, pluginsdk.RPCErrorNotFound, "note not found")
 		return
 	}
 
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+func TestNotesPlugin_QueryNotes_Fields(t *testing.T) {
+	plugin := &NotesPlugin{notes: map[string]*Note{
+		"note-1": {ID: "note-1", Title: "First", Content: "Body", CreatedAt: time.Now(), UpdatedAt: time.Now()},
+	}}
+
+	full := plugin.queryNotes(pluginsdk.EntityQuery{EntityType: "note"})
+	if len(full) != 1 || full[0]["content"] != "Body" {
+		t.Fatalf("full query = %v, want the whole note", full)
+	}
+
+	projected := plugin.queryNotes(pluginsdk.EntityQuery{EntityType: "note", Fields: []string{"title"}})
+	if len(projected) != 1 {
+		t.Fatalf("projected query returned %d notes, want 1", len(projected))
+	}
+	keys := make([]string, 0, len(projected[0]))
+	for key := range projected[0] {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	if !reflect.DeepEqual(keys, []string{"id", "title", "type"}) {
+		t.Errorf("projected keys = %v, want id, title and type", keys)
+	}
+	if projected[0]["id"] != "note-1" || projected[0]["title"] != "First" {
+		t.Errorf("projected note = %v", projected[0])
+	}
+}
+
+func TestNoteSchema_CoversToMap(t *testing.T) {
+	note := &Note{ID: "note-1", Title: "First", Content: "Body", CreatedAt: time.Now(), UpdatedAt: time.Now()}
+
+	properties := noteSchema.JSONSchema()["properties"].(map[string]interface{})
+	for key := range note.ToMap() {
+		if _, ok := properties[key]; !ok {
+			t.Errorf("note field %q is missing from the schema", key)
+		}
+	}
+	if len(properties) != len(note.ToMap()) {
+		t.Errorf("schema has %d properties, note has %d fields", len(properties), len(note.ToMap()))
+	}
+}
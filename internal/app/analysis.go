@@ -106,6 +106,43 @@ func (s *AnalysisService) AnalyzeSession(ctx context.Context, sessionID string)
 // AnalyzeSessionWithPrompt analyzes a specific session with a named prompt from config
 // This is now a wrapper around the view-based AnalyzeView method for backward compatibility
 func (s *AnalysisService) AnalyzeSessionWithPrompt(ctx context.Context, sessionID, promptName string) (*domain.SessionAnalysis, error) {
+	return s.analyzeSession(ctx, sessionID, promptName, nil)
+}
+
+// RerunSessionAnalysis analyzes a session again with another model. The result is saved
+// next to the session's existing analyses (analyses are kept per model), so
+// GetAnalysesBySessionID returns both for comparison. Rerunning with a model the
+// session was already analyzed with replaces that model's analysis. An empty promptName reuses the
+// prompt of the session's most recent analysis.
+func (s *AnalysisService) RerunSessionAnalysis(ctx context.Context, sessionID, promptName, model string) (*domain.SessionAnalysis, error) {
+	if model == "" {
+		return nil, fmt.Errorf("model is required")
+	}
+	if !domain.ValidateModel(model) {
+		return nil, fmt.Errorf("unknown model %q (allowed: %s)", model, strings.Join(domain.AllowedModelNames(), ", "))
+	}
+
+	if promptName == "" {
+		latest, err := s.analysisRepo.GetAnalysisBySessionID(ctx, sessionID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get analysis: %w", err)
+		}
+		if latest == nil {
+			return nil, fmt.Errorf("session %s has no analysis to rerun; analyze it first with 'dw analyze --session-id %s'", sessionID, sessionID)
+		}
+		promptName = latest.AnalysisType
+	}
+
+	s.logger.Debug("Re-analyzing session %s with model %s and prompt %s", sessionID, model, promptName)
+	return s.analyzeSession(ctx, sessionID, promptName, &AnalysisOptions{
+		ModelOverride: model,
+		LLMOptions:    &domain.LLMOptions{Model: model},
+	})
+}
+
+// analyzeSession analyzes a session with a named prompt and saves it as a SessionAnalysis.
+// Options are passed to AnalyzeViewWithOptions; nil uses AnalyzeView with the configured model.
+func (s *AnalysisService) analyzeSession(ctx context.Context, sessionID, promptName string, options *AnalysisOptions) (*domain.SessionAnalysis, error) {
 	// Get session events using FindByQuery
 	s.logger.Debug("Fetching events for session %s", sessionID)
 	query := pluginsdk.EventQuery{
@@ -170,8 +207,21 @@ func (s *AnalysisService) AnalyzeSessionWithPrompt(ctx context.Context, sessionI
 		return nil, fmt.Errorf("session view factory not configured")
 	}
 
+	// Record the session's events as the analysis' source events
+	eventIDs := make([]string, len(events))
+	for i, e := range events {
+		eventIDs[i] = e.ID
+	}
+
 	// Call the view-based analysis method
-	analysis, err := s.AnalyzeView(ctx, sessionView, promptName)
+	var analysis *domain.Analysis
+	if options != nil {
+		withEvents := *options
+		withEvents.EventIDs = eventIDs
+		analysis, err = s.AnalyzeViewWithOptions(ctx, sessionView, promptName, &withEvents)
+	} else {
+		analysis, err = s.analyzeView(ctx, sessionView, promptName, eventIDs)
+	}
 	if err != nil {
 		if s.errorLogger != nil {
 			s.errorLogger.LogError("ANALYSIS_VIEW_FAILED", map[string]interface{}{
@@ -200,7 +250,9 @@ func (s *AnalysisService) AnalyzeSessionWithPrompt(ctx context.Context, sessionI
 		analysis.PromptUsed,
 	)
 
-	// Preserve the created timestamp from generic analysis
+	// Preserve the ID and created timestamp from generic analysis, so the ID reported
+	// for the session analysis also finds the generic one (e.g. 'dw analyze show')
+	sessionAnalysis.ID = analysis.ID
 	sessionAnalysis.AnalyzedAt = analysis.Timestamp
 
 	// Save the SessionAnalysis for backward compatibility
@@ -224,6 +276,12 @@ func (s *AnalysisService) AnalyzeSessionWithPrompt(ctx context.Context, sessionI
 // This method provides a view-based interface for analysis that's plugin-agnostic.
 // Returns a generic Analysis type that works with any view from any plugin.
 func (s *AnalysisService) AnalyzeView(ctx context.Context, view pluginsdk.AnalysisView, promptName string) (*domain.Analysis, error) {
+	return s.analyzeView(ctx, view, promptName, nil)
+}
+
+// analyzeView implements AnalyzeView, saving eventIDs as the analysis' source events.
+// pluginsdk events carry no IDs, so callers that know them pass them in.
+func (s *AnalysisService) analyzeView(ctx context.Context, view pluginsdk.AnalysisView, promptName string, eventIDs []string) (*domain.Analysis, error) {
 	if view == nil {
 		return nil, fmt.Errorf("view is nil")
 	}
@@ -279,6 +337,7 @@ func (s *AnalysisService) AnalyzeView(ctx context.Context, view pluginsdk.Analys
 	if metadata := view.GetMetadata(); metadata != nil {
 		analysis.Metadata = metadata
 	}
+	analysis.EventIDs = eventIDs
 
 	// Save to database using generic method
 	if err := s.analysisRepo.SaveGenericAnalysis(ctx, analysis); err != nil {
@@ -296,6 +355,8 @@ type AnalysisOptions struct {
 	ModelOverride string
 	// Custom LLM options (e.g., temperature, max_tokens)
 	LLMOptions *domain.LLMOptions
+	// IDs of the events the view was built from, saved as the analysis' source events
+	EventIDs []string
 }
 
 // AnalyzeViewWithOptions analyzes a view with custom options
@@ -354,6 +415,7 @@ func (s *AnalysisService) AnalyzeViewWithOptions(ctx context.Context, view plugi
 	if metadata := view.GetMetadata(); metadata != nil {
 		analysis.Metadata = metadata
 	}
+	analysis.EventIDs = options.EventIDs
 
 	// Save to database using generic method
 	if err := s.analysisRepo.SaveGenericAnalysis(ctx, analysis); err != nil {
@@ -523,7 +585,7 @@ func (s *AnalysisService) AnalyzeSessionWithMultiplePrompts(ctx context.Context,
 // EstimateTokenCount estimates the token count for a session's logs
 // Uses a simple chars/4 heuristic (common approximation for Claude models)
 func (s *AnalysisService) EstimateTokenCount(ctx context.Context, sessionID string) (int, error) {
-	logs, err := s.logsService.ListRecentLogs(ctx, 0, 0, sessionID, true)
+	logs, err := s.logsService.ListRecentLogs(ctx, 0, 0, LogFilter{SessionID: sessionID}, true)
 	if err != nil {
 		return 0, fmt.Errorf("failed to get session logs: %w", err)
 	}
@@ -582,7 +644,7 @@ func (s *AnalysisService) SelectSessionsWithinTokenLimit(ctx context.Context, se
 
 // GetLastSession returns the ID of the most recent session
 func (s *AnalysisService) GetLastSession(ctx context.Context) (string, error) {
-	logs, err := s.logsService.ListRecentLogs(ctx, 1, 0, "", false)
+	logs, err := s.logsService.ListRecentLogs(ctx, 1, 0, LogFilter{}, false)
 	if err != nil {
 		return "", fmt.Errorf("failed to get last session: %w", err)
 	}
@@ -0,0 +1,63 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/kgatilin/darwinflow-pub/internal/domain"
+)
+
+// AnalysisDeleteOptions selects the analyses 'dw analyze delete' removes: one analysis
+// by ID, or the analyses of a session
+type AnalysisDeleteOptions struct {
+	AnalysisID string // Delete this analysis
+	SessionID  string // Delete the analyses of this session
+	KeepLatest bool   // With SessionID, keep the session's newest analysis
+	Force      bool   // Confirms deleting by session
+}
+
+// AnalysisDeleteHandler removes stored analyses
+type AnalysisDeleteHandler struct {
+	repo domain.AnalysisDeleter
+}
+
+// NewAnalysisDeleteHandler creates a new analysis delete handler
+func NewAnalysisDeleteHandler(repo domain.AnalysisDeleter) *AnalysisDeleteHandler {
+	return &AnalysisDeleteHandler{repo: repo}
+}
+
+// Delete removes the analyses opts selects and reports the counts to out. Deleting by
+// session removes many analyses at once, so it requires opts.Force.
+func (h *AnalysisDeleteHandler) Delete(ctx context.Context, opts AnalysisDeleteOptions, out io.Writer) (*domain.AnalysisDeleteResult, error) {
+	switch {
+	case opts.AnalysisID != "" && opts.SessionID != "":
+		return nil, fmt.Errorf("give either an analysis ID or --session, not both")
+	case opts.AnalysisID != "":
+		if opts.KeepLatest {
+			return nil, fmt.Errorf("--keep-latest requires --session")
+		}
+		result, err := h.repo.DeleteAnalysis(ctx, opts.AnalysisID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to delete analysis: %w", err)
+		}
+		fmt.Fprintf(out, "Deleted analysis %s (%d event links).\n", opts.AnalysisID, result.EventLinksDeleted)
+		return result, nil
+	case opts.SessionID != "":
+		if !opts.Force {
+			return nil, fmt.Errorf("deleting the analyses of a session requires --force")
+		}
+		result, err := h.repo.DeleteAnalysesBySessionID(ctx, opts.SessionID, opts.KeepLatest)
+		if err != nil {
+			return nil, fmt.Errorf("failed to delete analyses: %w", err)
+		}
+		fmt.Fprintf(out, "Deleted %d analyses of session %s (%d event links).\n",
+			result.AnalysesDeleted, opts.SessionID, result.EventLinksDeleted)
+		if result.AnalysesKept > 0 {
+			fmt.Fprintln(out, "Kept the newest analysis.")
+		}
+		return result, nil
+	default:
+		return nil, fmt.Errorf("an analysis ID or --session is required")
+	}
+}
@@ -0,0 +1,77 @@
+package app_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/kgatilin/darwinflow-pub/internal/app"
+	"github.com/kgatilin/darwinflow-pub/internal/domain"
+)
+
+// fakeAnalysisDeleter records the deletes it is asked for
+type fakeAnalysisDeleter struct {
+	deletedID      string
+	deletedSession string
+	keepLatest     bool
+}
+
+func (f *fakeAnalysisDeleter) DeleteAnalysis(ctx context.Context, analysisID string) (*domain.AnalysisDeleteResult, error) {
+	f.deletedID = analysisID
+	return &domain.AnalysisDeleteResult{AnalysesDeleted: 1, EventLinksDeleted: 3}, nil
+}
+
+func (f *fakeAnalysisDeleter) DeleteAnalysesBySessionID(ctx context.Context, sessionID string, keepLatest bool) (*domain.AnalysisDeleteResult, error) {
+	f.deletedSession = sessionID
+	f.keepLatest = keepLatest
+	return &domain.AnalysisDeleteResult{AnalysesDeleted: 2, EventLinksDeleted: 5, AnalysesKept: 1}, nil
+}
+
+func TestAnalysisDeleteHandler_Delete(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("by ID", func(t *testing.T) {
+		repo := &fakeAnalysisDeleter{}
+		var out bytes.Buffer
+		if _, err := app.NewAnalysisDeleteHandler(repo).Delete(ctx, app.AnalysisDeleteOptions{AnalysisID: "analysis-1"}, &out); err != nil {
+			t.Fatalf("Delete failed: %v", err)
+		}
+		if repo.deletedID != "analysis-1" || !strings.Contains(out.String(), "Deleted analysis analysis-1 (3 event links)") {
+			t.Errorf("unexpected delete %+v, output:\n%s", repo, out.String())
+		}
+	})
+
+	t.Run("by session requires force", func(t *testing.T) {
+		repo := &fakeAnalysisDeleter{}
+		_, err := app.NewAnalysisDeleteHandler(repo).Delete(ctx, app.AnalysisDeleteOptions{SessionID: "session-1"}, &bytes.Buffer{})
+		if err == nil || !strings.Contains(err.Error(), "--force") {
+			t.Errorf("expected a --force error, got %v", err)
+		}
+		if repo.deletedSession != "" {
+			t.Errorf("nothing should be deleted without --force")
+		}
+	})
+
+	t.Run("by session keeping the latest", func(t *testing.T) {
+		repo := &fakeAnalysisDeleter{}
+		var out bytes.Buffer
+		opts := app.AnalysisDeleteOptions{SessionID: "session-1", KeepLatest: true, Force: true}
+		if _, err := app.NewAnalysisDeleteHandler(repo).Delete(ctx, opts, &out); err != nil {
+			t.Fatalf("Delete failed: %v", err)
+		}
+		if repo.deletedSession != "session-1" || !repo.keepLatest {
+			t.Errorf("unexpected delete %+v", repo)
+		}
+		if !strings.Contains(out.String(), "Deleted 2 analyses of session session-1 (5 event links).") || !strings.Contains(out.String(), "Kept the newest") {
+			t.Errorf("unexpected output:\n%s", out.String())
+		}
+	})
+
+	t.Run("keep-latest without session", func(t *testing.T) {
+		_, err := app.NewAnalysisDeleteHandler(&fakeAnalysisDeleter{}).Delete(ctx, app.AnalysisDeleteOptions{AnalysisID: "a", KeepLatest: true}, &bytes.Buffer{})
+		if err == nil {
+			t.Error("expected an error for --keep-latest without --session")
+		}
+	})
+}
@@ -0,0 +1,338 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kgatilin/darwinflow-pub/internal/domain"
+)
+
+// Analysis export formats
+const (
+	AnalysisExportFormatMarkdown = "markdown"
+	AnalysisExportFormatJSON     = "json"
+)
+
+// AnalysisExportOptions selects which analyses are exported and how
+type AnalysisExportOptions struct {
+	SessionID string    // Export all analyses of one session (newest first)
+	Type      string    // Filter by analysis type, e.g. "session_summary" or the shorthand "summary"
+	Since     time.Time // Only analyses at or after this time (zero = no limit)
+	Format    string    // markdown (default) or json
+}
+
+// AnalysisExportHandler renders stored analyses into a shareable report
+type AnalysisExportHandler struct {
+	analysisRepo domain.AnalysisRepository
+}
+
+// NewAnalysisExportHandler creates a new analysis export handler
+func NewAnalysisExportHandler(analysisRepo domain.AnalysisRepository) *AnalysisExportHandler {
+	return &AnalysisExportHandler{analysisRepo: analysisRepo}
+}
+
+// Export writes the selected analyses to out and returns how many were exported
+func (h *AnalysisExportHandler) Export(ctx context.Context, opts AnalysisExportOptions, out io.Writer) (int, error) {
+	format := opts.Format
+	if format == "" {
+		format = AnalysisExportFormatMarkdown
+	}
+	if format != AnalysisExportFormatMarkdown && format != AnalysisExportFormatJSON {
+		return 0, fmt.Errorf("invalid format '%s'. Valid formats: markdown, json", format)
+	}
+
+	analyses, err := h.SelectAnalyses(ctx, opts)
+	if err != nil {
+		return 0, err
+	}
+
+	if format == AnalysisExportFormatJSON {
+		err = FormatAnalysesAsJSON(out, analyses)
+	} else {
+		err = FormatAnalysesAsMarkdown(out, analyses)
+	}
+	if err != nil {
+		return 0, err
+	}
+	return len(analyses), nil
+}
+
+// SelectAnalyses returns the analyses matching opts, newest first
+func (h *AnalysisExportHandler) SelectAnalyses(ctx context.Context, opts AnalysisExportOptions) ([]*domain.SessionAnalysis, error) {
+	var all []*domain.SessionAnalysis
+	var err error
+	if opts.SessionID != "" {
+		all, err = h.analysisRepo.GetAnalysesBySessionID(ctx, opts.SessionID)
+	} else {
+		all, err = h.analysisRepo.GetAllAnalyses(ctx, 0)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load analyses: %w", err)
+	}
+
+	selected := make([]*domain.SessionAnalysis, 0, len(all))
+	for _, analysis := range all {
+		if !opts.Since.IsZero() && analysis.AnalyzedAt.Before(opts.Since) {
+			continue
+		}
+		if opts.Type != "" && !matchesAnalysisType(analysis.AnalysisType, opts.Type) {
+			continue
+		}
+		selected = append(selected, analysis)
+	}
+	return selected, nil
+}
+
+// matchesAnalysisType reports whether analysisType matches the requested filter.
+// The filter matches exactly or as the last "_"-separated part ("summary" matches "session_summary").
+func matchesAnalysisType(analysisType, filter string) bool {
+	return analysisType == filter || strings.HasSuffix(analysisType, "_"+filter)
+}
+
+// ParseSince parses a --since value relative to now.
+// Accepts durations ("24h", "90m", "7d") and dates ("2006-01-02" or RFC3339).
+func ParseSince(value string, now time.Time) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	if days, ok := strings.CutSuffix(value, "d"); ok {
+		if n, err := strconv.Atoi(days); err == nil && n >= 0 {
+			return now.AddDate(0, 0, -n), nil
+		}
+	}
+	if d, err := time.ParseDuration(value); err == nil && d >= 0 {
+		return now.Add(-d), nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation("2006-01-02", value, now.Location()); err == nil {
+		return t, nil
+	}
+
+	return time.Time{}, fmt.Errorf("invalid --since value '%s' (use e.g. 24h, 7d, or 2006-01-02)", value)
+}
+
+// analysisExportRecord is the JSON representation of an exported analysis
+type analysisExportRecord struct {
+	ID              string    `json:"id"`
+	SessionID       string    `json:"session_id"`
+	AnalyzedAt      time.Time `json:"analyzed_at"`
+	AnalysisType    string    `json:"analysis_type"`
+	PromptName      string    `json:"prompt_name"`
+	ModelUsed       string    `json:"model_used"`
+	Result          string    `json:"result"`
+	PatternsSummary string    `json:"patterns_summary,omitempty"`
+}
+
+// FormatAnalysesAsJSON writes analyses as an indented JSON document
+func FormatAnalysesAsJSON(w io.Writer, analyses []*domain.SessionAnalysis) error {
+	records := make([]analysisExportRecord, 0, len(analyses))
+	for _, a := range analyses {
+		records = append(records, analysisExportRecord{
+			ID:              a.ID,
+			SessionID:       a.SessionID,
+			AnalyzedAt:      a.AnalyzedAt,
+			AnalysisType:    a.AnalysisType,
+			PromptName:      a.PromptName,
+			ModelUsed:       a.ModelUsed,
+			Result:          a.AnalysisResult,
+			PatternsSummary: a.PatternsSummary,
+		})
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(map[string]interface{}{"analyses": records}); err != nil {
+		return fmt.Errorf("failed to encode analyses: %w", err)
+	}
+	return nil
+}
+
+// FormatAnalysesAsMarkdown writes analyses as a Markdown report, one section per analysis.
+// Result bodies are embedded so that their own Markdown cannot break the report structure.
+func FormatAnalysesAsMarkdown(w io.Writer, analyses []*domain.SessionAnalysis) error {
+	fmt.Fprintln(w, "# Session Analyses")
+	fmt.Fprintln(w)
+	if len(analyses) == 0 {
+		fmt.Fprintln(w, "No analyses found.")
+		return nil
+	}
+	fmt.Fprintf(w, "This report contains %d analysis(es).\n", len(analyses))
+
+	for i, a := range analyses {
+		fmt.Fprintln(w)
+		fmt.Fprintf(w, "## %d. %s — %s\n\n", i+1, markdownInline(a.AnalysisType), a.AnalyzedAt.Format("2006-01-02 15:04:05"))
+		fmt.Fprintf(w, "- **Session ID**: %s\n", markdownCode(a.SessionID))
+		fmt.Fprintf(w, "- **Analyzed At**: %s\n", a.AnalyzedAt.Format(time.RFC3339))
+		fmt.Fprintf(w, "- **Model**: %s\n", markdownInline(a.ModelUsed))
+		fmt.Fprintf(w, "- **Prompt**: %s\n", markdownInline(a.PromptName))
+		fmt.Fprintln(w)
+
+		if strings.TrimSpace(a.PatternsSummary) != "" {
+			fmt.Fprintln(w, "### Patterns Summary")
+			fmt.Fprintln(w)
+			fmt.Fprintln(w, embedMarkdownBody(a.PatternsSummary, 3))
+			fmt.Fprintln(w)
+			fmt.Fprintln(w, "### Result")
+			fmt.Fprintln(w)
+		}
+		fmt.Fprintln(w, embedMarkdownBody(a.AnalysisResult, 3))
+
+		// Blank line before the rule so a trailing text line is not turned into a heading
+		fmt.Fprintln(w)
+		fmt.Fprintln(w, "---")
+	}
+	return nil
+}
+
+// markdownInlineEscaper escapes characters with inline Markdown meaning
+var markdownInlineEscaper = strings.NewReplacer(
+	`\`, `\\`, "`", "\\`", "*", `\*`, "_", `\_`,
+	"[", `\[`, "]", `\]`, "<", `\<`, ">", `\>`, "#", `\#`, "|", `\|`,
+)
+
+// markdownInline escapes a single-line value for use in headings and list items
+func markdownInline(s string) string {
+	if s == "" {
+		return "(none)"
+	}
+	s = strings.Join(strings.Fields(s), " ")
+	return markdownInlineEscaper.Replace(s)
+}
+
+// markdownCode renders s as an inline code span that tolerates backticks in s
+func markdownCode(s string) string {
+	if s == "" {
+		return "(none)"
+	}
+	fence := "`"
+	for strings.Contains(s, fence) {
+		fence += "`"
+	}
+	if strings.HasPrefix(s, "`") || strings.HasSuffix(s, "`") {
+		return fence + " " + s + " " + fence
+	}
+	return fence + s + fence
+}
+
+// embedMarkdownBody prepares a free-form Markdown body for embedding below a heading.
+// Headings outside code blocks (ATX and setext) are demoted so they never outrank
+// minLevel, an unterminated code fence is closed, and surrounding blank lines are trimmed.
+func embedMarkdownBody(body string, minLevel int) string {
+	lines := strings.Split(strings.ReplaceAll(strings.TrimSpace(body), "\r\n", "\n"), "\n")
+
+	result := make([]string, 0, len(lines)+1)
+	openFence := ""
+	prevParagraph := false // previous output line is plain paragraph text
+	for _, line := range lines {
+		trimmed := strings.TrimLeft(line, " ")
+		if fence := codeFenceMarker(trimmed); fence != "" {
+			if openFence == "" {
+				openFence = fence
+			} else if strings.HasPrefix(trimmed, openFence) && strings.TrimSpace(strings.TrimLeft(trimmed, openFence[:1])) == "" {
+				openFence = ""
+			}
+			result = append(result, line)
+			prevParagraph = false
+			continue
+		}
+		if openFence != "" {
+			result = append(result, line)
+			continue
+		}
+
+		// Setext underline: turn the preceding paragraph line into a demoted ATX heading
+		if level := setextLevel(trimmed); level > 0 && prevParagraph {
+			last := len(result) - 1
+			result[last] = demoteHeading(strings.Repeat("#", level)+" "+strings.TrimSpace(result[last]), minLevel)
+			prevParagraph = false
+			continue
+		}
+
+		line = demoteHeading(line, minLevel)
+		result = append(result, line)
+		prevParagraph = isParagraphLine(line)
+	}
+
+	if openFence != "" {
+		result = append(result, openFence)
+	}
+	return strings.Join(result, "\n")
+}
+
+// setextLevel returns 1 or 2 if line is a setext heading underline ("===" or "---"), else 0
+func setextLevel(line string) int {
+	line = strings.TrimSpace(line)
+	if line == "" {
+		return 0
+	}
+	switch {
+	case strings.Trim(line, "=") == "":
+		return 1
+	case strings.Trim(line, "-") == "":
+		return 2
+	default:
+		return 0
+	}
+}
+
+// isParagraphLine reports whether line is plain paragraph text that a setext
+// underline would turn into a heading (not blank, a heading, list item, quote, or table row)
+func isParagraphLine(line string) bool {
+	trimmed := strings.TrimSpace(line)
+	if trimmed == "" || len(line)-len(strings.TrimLeft(line, " ")) > 3 {
+		return false
+	}
+	switch trimmed[0] {
+	case '#', '>', '|', '-', '*', '+':
+		return false
+	}
+	if i := strings.IndexAny(trimmed, ".)"); i > 0 {
+		if _, err := strconv.Atoi(trimmed[:i]); err == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// codeFenceMarker returns the fence ("```" or "~~~", possibly longer) that line opens or closes
+func codeFenceMarker(line string) string {
+	for _, ch := range []string{"`", "~"} {
+		n := 0
+		for n < len(line) && line[n:n+1] == ch {
+			n++
+		}
+		if n >= 3 {
+			return line[:n]
+		}
+	}
+	return ""
+}
+
+// demoteHeading shifts an ATX heading so that its level is at least minLevel (max 6)
+func demoteHeading(line string, minLevel int) string {
+	trimmed := strings.TrimLeft(line, " ")
+	if len(line)-len(trimmed) > 3 {
+		return line // indented code block
+	}
+	level := 0
+	for level < len(trimmed) && trimmed[level] == '#' {
+		level++
+	}
+	if level == 0 || level > 6 || (level < len(trimmed) && trimmed[level] != ' ' && trimmed[level] != '\t') {
+		return line
+	}
+	newLevel := level + minLevel - 1
+	if newLevel > 6 {
+		newLevel = 6
+	}
+	return strings.Repeat("#", newLevel) + trimmed[level:]
+}
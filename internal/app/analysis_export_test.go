@@ -0,0 +1,200 @@
+package app_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kgatilin/darwinflow-pub/internal/app"
+	"github.com/kgatilin/darwinflow-pub/internal/domain"
+)
+
+func newExportAnalysis(sessionID, analysisType, result string, analyzedAt time.Time) *domain.SessionAnalysis {
+	analysis := domain.NewSessionAnalysisWithType(sessionID, result, "sonnet", "prompt", analysisType, analysisType)
+	analysis.AnalyzedAt = analyzedAt
+	return analysis
+}
+
+func TestAnalysisExportHandler_SelectAnalyses(t *testing.T) {
+	now := time.Date(2025, 6, 10, 12, 0, 0, 0, time.UTC)
+	repo := NewMockAnalysisRepository()
+	repo.SavedAnalyses = []*domain.SessionAnalysis{
+		newExportAnalysis("session-a", "session_summary", "A2", now),
+		newExportAnalysis("session-b", "tool_analysis", "B1", now.Add(-time.Hour)),
+		newExportAnalysis("session-a", "tool_analysis", "A1", now.Add(-48*time.Hour)),
+	}
+	handler := app.NewAnalysisExportHandler(repo)
+
+	tests := []struct {
+		name string
+		opts app.AnalysisExportOptions
+		want []string
+	}{
+		{"all", app.AnalysisExportOptions{}, []string{"A2", "B1", "A1"}},
+		{"session keeps history newest first", app.AnalysisExportOptions{SessionID: "session-a"}, []string{"A2", "A1"}},
+		{"type shorthand", app.AnalysisExportOptions{Type: "summary"}, []string{"A2"}},
+		{"exact type", app.AnalysisExportOptions{Type: "tool_analysis"}, []string{"B1", "A1"}},
+		{"since", app.AnalysisExportOptions{Since: now.Add(-24 * time.Hour)}, []string{"A2", "B1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := handler.SelectAnalyses(context.Background(), tt.opts)
+			if err != nil {
+				t.Fatalf("SelectAnalyses() failed: %v", err)
+			}
+			var results []string
+			for _, a := range got {
+				results = append(results, a.AnalysisResult)
+			}
+			if strings.Join(results, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("expected %v, got %v", tt.want, results)
+			}
+		})
+	}
+}
+
+func TestAnalysisExportHandler_Export_JSON(t *testing.T) {
+	repo := NewMockAnalysisRepository()
+	analysis := newExportAnalysis("session-a", "session_summary", "Result body", time.Now())
+	analysis.PatternsSummary = "patterns"
+	repo.SavedAnalyses = []*domain.SessionAnalysis{analysis}
+
+	var buf bytes.Buffer
+	count, err := app.NewAnalysisExportHandler(repo).Export(context.Background(), app.AnalysisExportOptions{Format: "json"}, &buf)
+	if err != nil {
+		t.Fatalf("Export() failed: %v", err)
+	}
+	if count != 1 {
+		t.Errorf("expected 1 exported analysis, got %d", count)
+	}
+
+	var doc struct {
+		Analyses []map[string]interface{} `json:"analyses"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatalf("output is not valid JSON: %v", err)
+	}
+	if len(doc.Analyses) != 1 {
+		t.Fatalf("expected 1 analysis in JSON, got %d", len(doc.Analyses))
+	}
+	for key, want := range map[string]string{
+		"session_id":       "session-a",
+		"analysis_type":    "session_summary",
+		"model_used":       "sonnet",
+		"result":           "Result body",
+		"patterns_summary": "patterns",
+	} {
+		if doc.Analyses[0][key] != want {
+			t.Errorf("expected %s=%q, got %v", key, want, doc.Analyses[0][key])
+		}
+	}
+}
+
+func TestAnalysisExportHandler_Export_InvalidFormat(t *testing.T) {
+	var buf bytes.Buffer
+	_, err := app.NewAnalysisExportHandler(NewMockAnalysisRepository()).Export(context.Background(), app.AnalysisExportOptions{Format: "html"}, &buf)
+	if err == nil {
+		t.Error("expected error for invalid format")
+	}
+}
+
+func TestFormatAnalysesAsMarkdown_ContainsBodyStructure(t *testing.T) {
+	body := strings.Join([]string{
+		"# Findings",
+		"Setext title",
+		"============",
+		"Some *emphasis* and a trailing line",
+		"```go",
+		"# not a heading inside code",
+		"func main() {}",
+	}, "\n") // unterminated code fence
+	analysis := newExportAnalysis("session_*1*", "tool_analysis", body, time.Now())
+	analysis.ModelUsed = "model_[x]"
+
+	var buf bytes.Buffer
+	if err := app.FormatAnalysesAsMarkdown(&buf, []*domain.SessionAnalysis{analysis, analysis}); err != nil {
+		t.Fatalf("FormatAnalysesAsMarkdown() failed: %v", err)
+	}
+	out := buf.String()
+
+	// Body headings are demoted below the analysis section
+	if !strings.Contains(out, "\n### Findings\n") {
+		t.Errorf("expected body ATX heading to be demoted, got:\n%s", out)
+	}
+	if !strings.Contains(out, "\n### Setext title\n") || strings.Contains(out, "============") {
+		t.Errorf("expected setext heading to be converted and demoted, got:\n%s", out)
+	}
+
+	// Only the report title is a level-1 heading (outside code), and sections stay level-2
+	for _, line := range strings.Split(out, "\n") {
+		if strings.HasPrefix(line, "# ") && line != "# Session Analyses" && line != "# not a heading inside code" {
+			t.Errorf("unexpected level-1 heading: %q", line)
+		}
+	}
+	if strings.Count(out, "\n## ") != 2 {
+		t.Errorf("expected 2 analysis sections, got:\n%s", out)
+	}
+
+	// Content inside code blocks is untouched and the fence is closed before the next section
+	if !strings.Contains(out, "# not a heading inside code") {
+		t.Errorf("expected code block content to be preserved, got:\n%s", out)
+	}
+	if strings.Count(out, "```") != 4 {
+		t.Errorf("expected each unterminated fence to be closed, got %d fences", strings.Count(out, "```"))
+	}
+	if !strings.Contains(out, "func main() {}\n```\n\n---\n") {
+		t.Errorf("expected closed fence and separator after body, got:\n%s", out)
+	}
+
+	// Metadata values are escaped
+	if !strings.Contains(out, "`session_*1*`") {
+		t.Errorf("expected session ID as code span, got:\n%s", out)
+	}
+	if !strings.Contains(out, `model\_\[x\]`) {
+		t.Errorf("expected model name to be escaped, got:\n%s", out)
+	}
+}
+
+func TestFormatAnalysesAsMarkdown_Empty(t *testing.T) {
+	var buf bytes.Buffer
+	if err := app.FormatAnalysesAsMarkdown(&buf, nil); err != nil {
+		t.Fatalf("FormatAnalysesAsMarkdown() failed: %v", err)
+	}
+	if !strings.Contains(buf.String(), "No analyses found.") {
+		t.Errorf("expected empty report message, got:\n%s", buf.String())
+	}
+}
+
+func TestParseSince(t *testing.T) {
+	now := time.Date(2025, 6, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		value   string
+		want    time.Time
+		wantErr bool
+	}{
+		{"", time.Time{}, false},
+		{"24h", now.Add(-24 * time.Hour), false},
+		{"7d", now.AddDate(0, 0, -7), false},
+		{"2025-06-01", time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC), false},
+		{"2025-06-01T08:00:00Z", time.Date(2025, 6, 1, 8, 0, 0, 0, time.UTC), false},
+		{"yesterday", time.Time{}, true},
+		{"-5d", time.Time{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := app.ParseSince(tt.value, now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseSince(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("ParseSince(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,176 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/kgatilin/darwinflow-pub/internal/domain"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// AnalysisSandboxOptions selects the session 'dw analyze sandbox' replays and the
+// prompts it analyzes the copy with
+type AnalysisSandboxOptions struct {
+	SessionID   string
+	PromptNames []string
+	KeepPath    string // Keep the sandbox DB at this path; empty discards it afterwards
+}
+
+// AnalysisSandboxStore is the event store a sandbox analysis runs against
+type AnalysisSandboxStore interface {
+	domain.EventBatchSaver
+	Close() error
+}
+
+// AnalysisSandboxOpener creates the sandbox event store at dbPath and an analysis
+// service that reads and saves through it
+type AnalysisSandboxOpener func(ctx context.Context, dbPath string) (AnalysisSandboxStore, AnalysisServiceInterface, error)
+
+// AnalysisSandboxHandler replays a session into a fresh event store and analyzes it
+// there, so prompts can be tried without writing analyses to the real store
+type AnalysisSandboxHandler struct {
+	source   domain.EventRepository
+	open     AnalysisSandboxOpener
+	logger   Logger
+	progress AnalysisProgress
+}
+
+// NewAnalysisSandboxHandler creates a new analysis sandbox handler that copies events
+// from source into sandboxes created with open
+func NewAnalysisSandboxHandler(source domain.EventRepository, open AnalysisSandboxOpener, logger Logger) *AnalysisSandboxHandler {
+	return &AnalysisSandboxHandler{
+		source:   source,
+		open:     open,
+		logger:   logger,
+		progress: noProgress{},
+	}
+}
+
+// SetProgress sets the indicator shown while analyses run
+func (h *AnalysisSandboxHandler) SetProgress(progress AnalysisProgress) {
+	if progress == nil {
+		progress = noProgress{}
+	}
+	h.progress = progress
+}
+
+// Run copies the events of opts.SessionID into a new sandbox DB, analyzes the copy
+// with opts.PromptNames and writes the results to out. The sandbox DB is removed
+// afterwards unless opts.KeepPath names where to keep it; an existing file there is
+// never overwritten.
+func (h *AnalysisSandboxHandler) Run(ctx context.Context, opts AnalysisSandboxOptions, out io.Writer) error {
+	if opts.SessionID == "" {
+		return fmt.Errorf("%w: session ID is required", pluginsdk.ErrInvalidArgument)
+	}
+	if len(opts.PromptNames) == 0 {
+		return fmt.Errorf("%w: no prompt selected; pass --prompt or enable prompts in the config", pluginsdk.ErrInvalidArgument)
+	}
+
+	events, err := h.source.FindByQuery(ctx, pluginsdk.EventQuery{
+		Metadata:    map[string]string{"session_id": opts.SessionID},
+		OrderByTime: true,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get session events: %w", err)
+	}
+	if len(events) == 0 {
+		return fmt.Errorf("%w: no events found for session %s", pluginsdk.ErrNotFound, opts.SessionID)
+	}
+
+	dbPath, cleanup, err := sandboxDBPath(opts.KeepPath)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	store, analysisService, err := h.open(ctx, dbPath)
+	if err != nil {
+		return fmt.Errorf("failed to create sandbox: %w", err)
+	}
+	defer store.Close()
+
+	h.logger.Debug("Copying %d events of session %s into sandbox %s", len(events), opts.SessionID, dbPath)
+	if err := store.SaveBatch(ctx, events); err != nil {
+		return fmt.Errorf("failed to copy events into sandbox: %w", err)
+	}
+	fmt.Fprintf(out, "Copied %d event(s) of session %s into sandbox %s\n", len(events), opts.SessionID, dbPath)
+
+	if err := h.analyze(ctx, analysisService, opts, out); err != nil {
+		return err
+	}
+
+	if opts.KeepPath != "" {
+		fmt.Fprintf(out, "\nSandbox kept at %s\n", opts.KeepPath)
+	} else {
+		fmt.Fprintln(out, "\nSandbox discarded; nothing was saved to the event store.")
+	}
+	return nil
+}
+
+// analyze runs the selected prompts against the sandbox and prints their results
+func (h *AnalysisSandboxHandler) analyze(ctx context.Context, analysisService AnalysisServiceInterface, opts AnalysisSandboxOptions, out io.Writer) error {
+	label := fmt.Sprintf("Analyzing session %s in sandbox", opts.SessionID)
+	if len(opts.PromptNames) == 1 {
+		fmt.Fprintf(out, "Analyzing with prompt '%s'...\n", opts.PromptNames[0])
+		h.progress.Start(label)
+		analysis, err := analysisService.AnalyzeSessionWithPrompt(ctx, opts.SessionID, opts.PromptNames[0])
+		h.progress.Stop()
+		if err != nil {
+			return fmt.Errorf("failed to analyze session: %w", err)
+		}
+		fmt.Fprintf(out, "\n=== Analysis Result (model: %s) ===\n", analysis.ModelUsed)
+		fmt.Fprintln(out, analysis.AnalysisResult)
+		return nil
+	}
+
+	fmt.Fprintf(out, "Analyzing with %d prompts in parallel: %v\n", len(opts.PromptNames), opts.PromptNames)
+	h.progress.Start(label)
+	analyses, errs := analysisService.AnalyzeSessionWithMultiplePrompts(ctx, opts.SessionID, opts.PromptNames)
+	h.progress.Stop()
+
+	if len(errs) > 0 {
+		fmt.Fprintln(out, "\nErrors during analysis:")
+		for _, err := range errs {
+			fmt.Fprintf(out, "  - %v\n", err)
+		}
+	}
+	if len(analyses) == 0 {
+		return fmt.Errorf("all analyses failed")
+	}
+	// Print in prompt order so repeated runs are easy to compare
+	for _, promptName := range opts.PromptNames {
+		analysis, ok := analyses[promptName]
+		if !ok {
+			continue
+		}
+		fmt.Fprintf(out, "\n=== Analysis: %s (model: %s) ===\n", promptName, analysis.ModelUsed)
+		fmt.Fprintln(out, analysis.AnalysisResult)
+	}
+	return nil
+}
+
+// sandboxDBPath returns where the sandbox DB is created and a function removing what
+// is not to be kept. keepPath must not exist yet; an empty keepPath creates the DB in
+// a new temporary directory that cleanup removes.
+func sandboxDBPath(keepPath string) (string, func(), error) {
+	if keepPath != "" {
+		if _, err := os.Stat(keepPath); err == nil {
+			return "", nil, fmt.Errorf("%w: %s; choose a new path for --keep", pluginsdk.ErrAlreadyExists, keepPath)
+		} else if !os.IsNotExist(err) {
+			return "", nil, fmt.Errorf("failed to check sandbox path: %w", err)
+		}
+		if err := os.MkdirAll(filepath.Dir(keepPath), 0755); err != nil {
+			return "", nil, fmt.Errorf("failed to create sandbox directory: %w", err)
+		}
+		return keepPath, func() {}, nil
+	}
+
+	dir, err := os.MkdirTemp("", "dw-sandbox-")
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to create sandbox directory: %w", err)
+	}
+	return filepath.Join(dir, "events.db"), func() { os.RemoveAll(dir) }, nil
+}
@@ -0,0 +1,116 @@
+package app_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kgatilin/darwinflow-pub/internal/app"
+	"github.com/kgatilin/darwinflow-pub/internal/domain"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// fakeSandboxStore records the events copied into a sandbox
+type fakeSandboxStore struct {
+	path   string
+	saved  []*domain.Event
+	closed bool
+}
+
+func (f *fakeSandboxStore) SaveBatch(ctx context.Context, events []*domain.Event) error {
+	f.saved = append(f.saved, events...)
+	// Create the DB file like a real store would, so cleanup can be checked
+	return os.WriteFile(f.path, []byte("sandbox"), 0644)
+}
+
+func (f *fakeSandboxStore) Close() error {
+	f.closed = true
+	return nil
+}
+
+func TestAnalysisSandboxHandler_Run(t *testing.T) {
+	ctx := context.Background()
+	source := &mockEventRepo{events: []*domain.Event{
+		domain.NewEvent("tool.invoked", "session-1", map[string]string{"tool": "Read"}, "read"),
+		domain.NewEvent("tool.result", "session-1", map[string]string{"tool": "Read"}, "ok"),
+	}}
+
+	newHandler := func(store *fakeSandboxStore, service app.AnalysisServiceInterface) *app.AnalysisSandboxHandler {
+		open := func(ctx context.Context, dbPath string) (app.AnalysisSandboxStore, app.AnalysisServiceInterface, error) {
+			store.path = dbPath
+			return store, service, nil
+		}
+		return app.NewAnalysisSandboxHandler(source, open, &mockLogger{})
+	}
+
+	t.Run("analyzes a copy and discards the sandbox", func(t *testing.T) {
+		store := &fakeSandboxStore{}
+		var out bytes.Buffer
+		opts := app.AnalysisSandboxOptions{SessionID: "session-1", PromptNames: []string{"session_summary"}}
+		if err := newHandler(store, &mockAnalysisService{}).Run(ctx, opts, &out); err != nil {
+			t.Fatalf("Run failed: %v", err)
+		}
+		if len(store.saved) != 2 || !store.closed {
+			t.Errorf("expected 2 events copied and the store closed, got %d events, closed %v", len(store.saved), store.closed)
+		}
+		if _, err := os.Stat(store.path); !os.IsNotExist(err) {
+			t.Errorf("sandbox DB %s should be removed, stat error: %v", store.path, err)
+		}
+		for _, want := range []string{"Copied 2 event(s) of session session-1", "Analysis for session_summary", "Sandbox discarded"} {
+			if !strings.Contains(out.String(), want) {
+				t.Errorf("output missing %q:\n%s", want, out.String())
+			}
+		}
+	})
+
+	t.Run("keeps the sandbox at the given path", func(t *testing.T) {
+		keepPath := filepath.Join(t.TempDir(), "nested", "sandbox.db")
+		store := &fakeSandboxStore{}
+		var out bytes.Buffer
+		opts := app.AnalysisSandboxOptions{SessionID: "session-1", PromptNames: []string{"a", "b"}, KeepPath: keepPath}
+		if err := newHandler(store, &mockAnalysisService{}).Run(ctx, opts, &out); err != nil {
+			t.Fatalf("Run failed: %v", err)
+		}
+		if store.path != keepPath {
+			t.Errorf("sandbox created at %s, want %s", store.path, keepPath)
+		}
+		if _, err := os.Stat(keepPath); err != nil {
+			t.Errorf("sandbox DB should be kept: %v", err)
+		}
+		if !strings.Contains(out.String(), "Sandbox kept at "+keepPath) {
+			t.Errorf("output should name the kept sandbox:\n%s", out.String())
+		}
+	})
+
+	t.Run("never overwrites an existing keep path", func(t *testing.T) {
+		keepPath := filepath.Join(t.TempDir(), "events.db")
+		if err := os.WriteFile(keepPath, []byte("production"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		store := &fakeSandboxStore{}
+		opts := app.AnalysisSandboxOptions{SessionID: "session-1", PromptNames: []string{"a"}, KeepPath: keepPath}
+		err := newHandler(store, &mockAnalysisService{}).Run(ctx, opts, &bytes.Buffer{})
+		if !errors.Is(err, pluginsdk.ErrAlreadyExists) {
+			t.Errorf("Run() error = %v, want ErrAlreadyExists", err)
+		}
+		if content, _ := os.ReadFile(keepPath); string(content) != "production" {
+			t.Errorf("existing file was modified: %q", content)
+		}
+	})
+
+	t.Run("unknown session", func(t *testing.T) {
+		open := func(ctx context.Context, dbPath string) (app.AnalysisSandboxStore, app.AnalysisServiceInterface, error) {
+			t.Fatal("no sandbox should be created for an unknown session")
+			return nil, nil, nil
+		}
+		handler := app.NewAnalysisSandboxHandler(&mockEventRepo{}, open, &mockLogger{})
+		err := handler.Run(ctx, app.AnalysisSandboxOptions{SessionID: "missing", PromptNames: []string{"a"}}, &bytes.Buffer{})
+		if !errors.Is(err, pluginsdk.ErrNotFound) {
+			t.Errorf("Run() error = %v, want ErrNotFound", err)
+		}
+	})
+}
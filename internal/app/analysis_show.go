@@ -0,0 +1,70 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/kgatilin/darwinflow-pub/internal/domain"
+)
+
+// AnalysisShowOptions selects what 'dw analyze show' prints besides the analysis
+type AnalysisShowOptions struct {
+	WithEvents bool // List the events the analysis was computed from
+}
+
+// AnalysisShowHandler prints a stored analysis by ID
+type AnalysisShowHandler struct {
+	analysisRepo domain.AnalysisRepository
+	eventFinder  domain.AnalysisEventFinder
+}
+
+// NewAnalysisShowHandler creates a new analysis show handler
+func NewAnalysisShowHandler(analysisRepo domain.AnalysisRepository, eventFinder domain.AnalysisEventFinder) *AnalysisShowHandler {
+	return &AnalysisShowHandler{analysisRepo: analysisRepo, eventFinder: eventFinder}
+}
+
+// Show writes the analysis with the given ID to out
+func (h *AnalysisShowHandler) Show(ctx context.Context, analysisID string, opts AnalysisShowOptions, out io.Writer) error {
+	analysis, err := h.analysisRepo.FindAnalysisById(ctx, analysisID)
+	if err != nil {
+		return fmt.Errorf("failed to get analysis: %w", err)
+	}
+	if analysis == nil {
+		return fmt.Errorf("analysis %s not found", analysisID)
+	}
+
+	fmt.Fprintf(out, "Analysis: %s\n", analysis.ID)
+	fmt.Fprintf(out, "View: %s %s\n", analysis.ViewType, analysis.ViewID)
+	fmt.Fprintf(out, "Analyzed at: %s\n", analysis.Timestamp.Format("2006-01-02 15:04:05"))
+	fmt.Fprintf(out, "Model: %s\n", analysis.ModelUsed)
+	fmt.Fprintf(out, "Prompt: %s\n\n", analysis.PromptUsed)
+	fmt.Fprintln(out, "=== Analysis Result ===")
+	fmt.Fprintln(out, analysis.Result)
+
+	if !opts.WithEvents {
+		return nil
+	}
+
+	fmt.Fprintln(out)
+	if len(analysis.EventIDs) == 0 {
+		// Analyses made before links were recorded, e.g. migrated legacy analyses
+		fmt.Fprintln(out, "=== Source Events ===")
+		fmt.Fprintln(out, "source events not recorded")
+		return nil
+	}
+
+	events, err := h.eventFinder.FindAnalysisEvents(ctx, analysis.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get source events: %w", err)
+	}
+
+	fmt.Fprintf(out, "=== Source Events (%d) ===\n", len(analysis.EventIDs))
+	for _, event := range events {
+		fmt.Fprintf(out, "%s  %-30s %s\n", event.Timestamp.Format("2006-01-02 15:04:05"), event.Type, event.ID)
+	}
+	if missing := len(analysis.EventIDs) - len(events); missing > 0 {
+		fmt.Fprintf(out, "(%d source event(s) no longer stored)\n", missing)
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package app_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kgatilin/darwinflow-pub/internal/app"
+	"github.com/kgatilin/darwinflow-pub/internal/domain"
+)
+
+// fakeShowRepository serves one analysis and the stored subset of its source events
+type fakeShowRepository struct {
+	*MockAnalysisRepository
+	analysis *domain.Analysis
+	events   []*domain.Event
+}
+
+func (f *fakeShowRepository) FindAnalysisById(ctx context.Context, id string) (*domain.Analysis, error) {
+	if f.analysis != nil && f.analysis.ID == id {
+		return f.analysis, nil
+	}
+	return nil, nil
+}
+
+func (f *fakeShowRepository) FindAnalysisEvents(ctx context.Context, analysisID string) ([]*domain.Event, error) {
+	return f.events, nil
+}
+
+func newShowAnalysis(eventIDs []string) *domain.Analysis {
+	analysis := domain.NewAnalysis("session-1", "session", "Use more tools", "sonnet", "tool_analysis")
+	analysis.ID = "analysis-1"
+	analysis.EventIDs = eventIDs
+	return analysis
+}
+
+func TestAnalysisShowHandler_Show(t *testing.T) {
+	ctx := context.Background()
+	event := domain.NewEvent("claude.tool.invoked", "session-1", nil, "")
+	event.ID = "ev-1"
+	event.Timestamp = time.Date(2026, 1, 2, 3, 4, 5, 0, time.Local)
+
+	t.Run("without events", func(t *testing.T) {
+		repo := &fakeShowRepository{MockAnalysisRepository: NewMockAnalysisRepository(), analysis: newShowAnalysis([]string{"ev-1"})}
+		var out bytes.Buffer
+		if err := app.NewAnalysisShowHandler(repo, repo).Show(ctx, "analysis-1", app.AnalysisShowOptions{}, &out); err != nil {
+			t.Fatalf("Show failed: %v", err)
+		}
+		if !strings.Contains(out.String(), "Analysis: analysis-1") || !strings.Contains(out.String(), "Use more tools") {
+			t.Errorf("expected analysis header and result, got:\n%s", out.String())
+		}
+		if strings.Contains(out.String(), "Source Events") {
+			t.Errorf("source events should only be listed with WithEvents, got:\n%s", out.String())
+		}
+	})
+
+	t.Run("with events, one no longer stored", func(t *testing.T) {
+		repo := &fakeShowRepository{
+			MockAnalysisRepository: NewMockAnalysisRepository(),
+			analysis:               newShowAnalysis([]string{"ev-1", "ev-gone"}),
+			events:                 []*domain.Event{event},
+		}
+		var out bytes.Buffer
+		if err := app.NewAnalysisShowHandler(repo, repo).Show(ctx, "analysis-1", app.AnalysisShowOptions{WithEvents: true}, &out); err != nil {
+			t.Fatalf("Show failed: %v", err)
+		}
+		for _, want := range []string{"=== Source Events (2) ===", "2026-01-02 03:04:05", "claude.tool.invoked", "ev-1", "(1 source event(s) no longer stored)"} {
+			if !strings.Contains(out.String(), want) {
+				t.Errorf("expected %q in output:\n%s", want, out.String())
+			}
+		}
+	})
+
+	t.Run("legacy analysis without links", func(t *testing.T) {
+		repo := &fakeShowRepository{MockAnalysisRepository: NewMockAnalysisRepository(), analysis: newShowAnalysis(nil)}
+		var out bytes.Buffer
+		if err := app.NewAnalysisShowHandler(repo, repo).Show(ctx, "analysis-1", app.AnalysisShowOptions{WithEvents: true}, &out); err != nil {
+			t.Fatalf("Show failed: %v", err)
+		}
+		if !strings.Contains(out.String(), "source events not recorded") {
+			t.Errorf("expected 'source events not recorded', got:\n%s", out.String())
+		}
+	})
+
+	t.Run("unknown analysis", func(t *testing.T) {
+		repo := &fakeShowRepository{MockAnalysisRepository: NewMockAnalysisRepository()}
+		err := app.NewAnalysisShowHandler(repo, repo).Show(ctx, "missing", app.AnalysisShowOptions{}, &bytes.Buffer{})
+		if err == nil || !strings.Contains(err.Error(), "not found") {
+			t.Errorf("expected not found error, got %v", err)
+		}
+	})
+}
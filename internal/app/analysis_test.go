@@ -3,6 +3,7 @@ package app_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/kgatilin/darwinflow-pub/internal/app"
@@ -31,10 +32,12 @@ type MockLLM struct {
 	TokenEst    int
 	QueryCalls  int
 	TokensCalls int
+	LastOptions *domain.LLMOptions
 }
 
 func (m *MockLLM) Query(ctx context.Context, prompt string, options *domain.LLMOptions) (string, error) {
 	m.QueryCalls++
+	m.LastOptions = options
 	if m.Error != nil {
 		return "", m.Error
 	}
@@ -58,13 +61,15 @@ func (m *MockLLM) GetModel() string {
 
 // MockAnalysisRepository is a mock for testing
 type MockAnalysisRepository struct {
-	SavedAnalyses      []*domain.SessionAnalysis
-	UnanalyzedIDs      []string
-	AnalysisByID       map[string]*domain.SessionAnalysis
-	AnalysesByViewID   []*domain.Analysis
-	SaveError          error
-	GetError           error
-	UnanalyzedError    error
+	SavedAnalyses    []*domain.SessionAnalysis
+	UnanalyzedIDs    []string
+	AnalysisByID     map[string]*domain.SessionAnalysis
+	AnalysesByViewID []*domain.Analysis
+	GenericAnalyses  []*domain.Analysis
+	SaveError        error
+	GetError         error
+	UnanalyzedError  error
+	SessionSummaries []*domain.SessionSummary
 }
 
 func NewMockAnalysisRepository() *MockAnalysisRepository {
@@ -112,6 +117,27 @@ func (m *MockAnalysisRepository) GetAllSessionIDs(ctx context.Context, limit int
 	return sessionIDs, nil
 }
 
+func (m *MockAnalysisRepository) GetSessionSummaries(ctx context.Context, sessionIDs []string, limit int) ([]*domain.SessionSummary, error) {
+	if m.GetError != nil {
+		return nil, m.GetError
+	}
+	wanted := make(map[string]bool)
+	for _, id := range sessionIDs {
+		wanted[id] = true
+	}
+	summaries := []*domain.SessionSummary{}
+	for _, summary := range m.SessionSummaries {
+		if sessionIDs != nil && !wanted[summary.SessionID] {
+			continue
+		}
+		summaries = append(summaries, summary)
+		if limit > 0 && len(summaries) >= limit {
+			break
+		}
+	}
+	return summaries, nil
+}
+
 func (m *MockAnalysisRepository) GetAnalysesBySessionID(ctx context.Context, sessionID string) ([]*domain.SessionAnalysis, error) {
 	if m.GetError != nil {
 		return nil, m.GetError
@@ -127,7 +153,11 @@ func (m *MockAnalysisRepository) GetAnalysesBySessionID(ctx context.Context, ses
 
 // Generic analysis methods (stubs for interface compliance)
 func (m *MockAnalysisRepository) SaveGenericAnalysis(ctx context.Context, analysis *domain.Analysis) error {
-	return m.SaveError
+	if m.SaveError != nil {
+		return m.SaveError
+	}
+	m.GenericAnalyses = append(m.GenericAnalyses, analysis)
+	return nil
 }
 
 func (m *MockAnalysisRepository) FindAnalysisByViewID(ctx context.Context, viewID string) ([]*domain.Analysis, error) {
@@ -268,6 +298,111 @@ func TestAnalysisService_AnalyzeSessionWithPrompt(t *testing.T) {
 	}
 }
 
+func TestAnalysisService_AnalyzeSessionWithPrompt_RecordsSourceEvents(t *testing.T) {
+	ctx := context.Background()
+
+	first := domain.NewEvent("claude.tool.invoked", "session-123", map[string]interface{}{}, "first")
+	second := domain.NewEvent("claude.tool.invoked", "session-123", map[string]interface{}{}, "second")
+	eventRepo := &MockEventRepository{
+		events: []*domain.Event{first, second},
+	}
+	analysisRepo := NewMockAnalysisRepository()
+	logsService := app.NewLogsService(eventRepo, eventRepo)
+	service := app.NewAnalysisService(eventRepo, analysisRepo, logsService, &MockLLM{Response: "analysis"}, &app.NoOpLogger{}, domain.DefaultConfig())
+	service.SetSessionViewFactory(mockSessionViewFactory)
+
+	analysis, err := service.AnalyzeSessionWithPrompt(ctx, "session-123", "tool_analysis")
+	if err != nil {
+		t.Fatalf("AnalyzeSessionWithPrompt failed: %v", err)
+	}
+
+	if len(analysisRepo.GenericAnalyses) != 1 {
+		t.Fatalf("Expected 1 saved generic analysis, got %d", len(analysisRepo.GenericAnalyses))
+	}
+	generic := analysisRepo.GenericAnalyses[0]
+	if len(generic.EventIDs) != 2 || generic.EventIDs[0] != first.ID || generic.EventIDs[1] != second.ID {
+		t.Errorf("Expected source events [%s %s], got %v", first.ID, second.ID, generic.EventIDs)
+	}
+	if analysis.ID != generic.ID {
+		t.Errorf("Expected session analysis to share the generic analysis ID %s, got %s", generic.ID, analysis.ID)
+	}
+
+	// Reruns go through AnalyzeViewWithOptions and record the events too
+	if _, err := service.RerunSessionAnalysis(ctx, "session-123", "tool_analysis", "opus"); err != nil {
+		t.Fatalf("RerunSessionAnalysis failed: %v", err)
+	}
+	if rerun := analysisRepo.GenericAnalyses[1]; len(rerun.EventIDs) != 2 {
+		t.Errorf("Expected rerun to record 2 source events, got %v", rerun.EventIDs)
+	}
+}
+
+func TestAnalysisService_RerunSessionAnalysis(t *testing.T) {
+	ctx := context.Background()
+
+	event := domain.NewEvent("claude.tool.invoked", "session-123", map[string]interface{}{}, "test")
+	eventRepo := &MockEventRepository{
+		events: []*domain.Event{event},
+	}
+	analysisRepo := NewMockAnalysisRepository()
+	logsService := app.NewLogsService(eventRepo, eventRepo)
+	llm := &MockLLM{Response: "opus analysis"}
+	config := domain.DefaultConfig()
+	config.Prompts["session_summary"] = "Summarize: "
+
+	service := app.NewAnalysisService(eventRepo, analysisRepo, logsService, llm, &app.NoOpLogger{}, config)
+	service.SetSessionViewFactory(mockSessionViewFactory)
+
+	// The session was analyzed with sonnet before
+	original := domain.NewSessionAnalysisWithType("session-123", "sonnet analysis", "sonnet", "Summarize: ", "session_summary", "session_summary")
+	analysisRepo.AnalysisByID["session-123"] = original
+
+	t.Run("reuses latest prompt with new model", func(t *testing.T) {
+		analysis, err := service.RerunSessionAnalysis(ctx, "session-123", "", "opus")
+		if err != nil {
+			t.Fatalf("RerunSessionAnalysis failed: %v", err)
+		}
+		if analysis.ID == original.ID {
+			t.Error("Expected rerun to create a new analysis")
+		}
+		if analysis.ModelUsed != "opus" {
+			t.Errorf("Expected model 'opus', got %s", analysis.ModelUsed)
+		}
+		if analysis.AnalysisType != "session_summary" {
+			t.Errorf("Expected prompt of latest analysis 'session_summary', got %s", analysis.AnalysisType)
+		}
+		if llm.LastOptions == nil || llm.LastOptions.Model != "opus" {
+			t.Errorf("Expected LLM to be queried with model 'opus', got %+v", llm.LastOptions)
+		}
+		if config.Analysis.Model != "sonnet" {
+			t.Errorf("Expected configured model to stay 'sonnet', got %s", config.Analysis.Model)
+		}
+	})
+
+	t.Run("explicit prompt", func(t *testing.T) {
+		analysis, err := service.RerunSessionAnalysis(ctx, "session-123", "tool_analysis", "haiku")
+		if err != nil {
+			t.Fatalf("RerunSessionAnalysis failed: %v", err)
+		}
+		if analysis.AnalysisType != "tool_analysis" {
+			t.Errorf("Expected prompt 'tool_analysis', got %s", analysis.AnalysisType)
+		}
+	})
+
+	t.Run("unknown model", func(t *testing.T) {
+		_, err := service.RerunSessionAnalysis(ctx, "session-123", "", "gpt-4")
+		if err == nil || !strings.Contains(err.Error(), `unknown model "gpt-4"`) || !strings.Contains(err.Error(), "opus") {
+			t.Errorf("Expected unknown model error listing allowed models, got %v", err)
+		}
+	})
+
+	t.Run("session without analysis", func(t *testing.T) {
+		_, err := service.RerunSessionAnalysis(ctx, "other-session", "", "opus")
+		if err == nil || !strings.Contains(err.Error(), "no analysis to rerun") {
+			t.Errorf("Expected no analysis error, got %v", err)
+		}
+	})
+}
+
 func TestAnalysisService_AnalyzeSessionWithPrompt_NoLogs(t *testing.T) {
 	ctx := context.Background()
 
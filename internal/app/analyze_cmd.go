@@ -29,13 +29,29 @@ type AnalysisServiceInterface interface {
 	GetUnanalyzedSessions(ctx context.Context) ([]string, error)
 	GetAllSessionIDs(ctx context.Context, limit int) ([]string, error)
 	AnalyzeSessionWithMultiplePrompts(ctx context.Context, sessionID string, promptNames []string) (map[string]*domain.SessionAnalysis, []error)
+	RerunSessionAnalysis(ctx context.Context, sessionID, promptName, model string) (*domain.SessionAnalysis, error)
 }
 
+// AnalysisProgress shows that an analysis is running while the handler waits on the
+// LLM. Start is called with a label such as "Analyzing session abc (2 of 5)" before
+// each analysis and Stop once it finished or failed, before anything else is printed.
+type AnalysisProgress interface {
+	Start(label string)
+	Stop()
+}
+
+// noProgress is the AnalysisProgress used when none is set
+type noProgress struct{}
+
+func (noProgress) Start(string) {}
+func (noProgress) Stop()        {}
+
 // AnalyzeCommandHandler handles the analyze command logic
 type AnalyzeCommandHandler struct {
 	analysisService AnalysisServiceInterface
 	logger          Logger
 	out             io.Writer
+	progress        AnalysisProgress
 }
 
 // NewAnalyzeCommandHandler creates a new analyze command handler
@@ -44,9 +60,18 @@ func NewAnalyzeCommandHandler(analysisService AnalysisServiceInterface, logger L
 		analysisService: analysisService,
 		logger:          logger,
 		out:             out,
+		progress:        noProgress{},
 	}
 }
 
+// SetProgress sets the indicator shown while analyses run
+func (h *AnalyzeCommandHandler) SetProgress(progress AnalysisProgress) {
+	if progress == nil {
+		progress = noProgress{}
+	}
+	h.progress = progress
+}
+
 // Execute runs the analyze command based on options
 func (h *AnalyzeCommandHandler) Execute(ctx context.Context, opts AnalyzeOptions) error {
 	// Handle different modes
@@ -82,6 +107,24 @@ func (h *AnalyzeCommandHandler) Execute(ctx context.Context, opts AnalyzeOptions
 	return h.analyzeSession(ctx, targetSessionID, opts.PromptNames)
 }
 
+// Rerun analyzes a session again with another model, keeping its existing analyses
+func (h *AnalyzeCommandHandler) Rerun(ctx context.Context, sessionID, promptName, model string) error {
+	if sessionID == "" {
+		return fmt.Errorf("session ID is required")
+	}
+
+	fmt.Fprintf(h.out, "Re-analyzing session %s with %s...\n", sessionID, model)
+	h.progress.Start(fmt.Sprintf("Re-analyzing session %s", sessionID))
+	analysis, err := h.analysisService.RerunSessionAnalysis(ctx, sessionID, promptName, model)
+	h.progress.Stop()
+	if err != nil {
+		return fmt.Errorf("failed to rerun analysis: %w", err)
+	}
+
+	fmt.Fprintf(h.out, "✓ Saved analysis %s (model: %s, prompt: %s)\n", analysis.ID, analysis.ModelUsed, analysis.PromptName)
+	return nil
+}
+
 // viewAnalysis displays an existing analysis
 func (h *AnalyzeCommandHandler) viewAnalysis(ctx context.Context, sessionID string) error {
 	analysis, err := h.analysisService.GetAnalysis(ctx, sessionID)
@@ -96,6 +139,7 @@ func (h *AnalyzeCommandHandler) viewAnalysis(ctx context.Context, sessionID stri
 	}
 
 	fmt.Fprintf(h.out, "Session: %s\n", analysis.SessionID)
+	fmt.Fprintf(h.out, "Analysis ID: %s\n", analysis.ID)
 	fmt.Fprintf(h.out, "Analyzed at: %s\n", analysis.AnalyzedAt.Format("2006-01-02 15:04:05"))
 	fmt.Fprintf(h.out, "Model: %s\n\n", analysis.ModelUsed)
 	fmt.Fprintln(h.out, "=== Analysis Result ===")
@@ -109,18 +153,23 @@ func (h *AnalyzeCommandHandler) analyzeSession(ctx context.Context, sessionID st
 	if len(promptNames) == 1 {
 		// Single prompt - use simple sequential analysis
 		fmt.Fprintf(h.out, "Analyzing session %s with prompt '%s'...\n", sessionID, promptNames[0])
+		h.progress.Start(fmt.Sprintf("Analyzing session %s", sessionID))
 		analysis, err := h.analysisService.AnalyzeSessionWithPrompt(ctx, sessionID, promptNames[0])
+		h.progress.Stop()
 		if err != nil {
 			return fmt.Errorf("failed to analyze session: %w", err)
 		}
 
-		fmt.Fprintf(h.out, "\nAnalysis completed at %s\n\n", analysis.AnalyzedAt.Format("2006-01-02 15:04:05"))
+		fmt.Fprintf(h.out, "\nAnalysis completed at %s\n", analysis.AnalyzedAt.Format("2006-01-02 15:04:05"))
+		fmt.Fprintf(h.out, "Analysis ID: %s (see 'dw analyze show %s --with-events')\n\n", analysis.ID, analysis.ID)
 		fmt.Fprintln(h.out, "=== Analysis Result ===")
 		fmt.Fprintln(h.out, analysis.AnalysisResult)
 	} else {
 		// Multiple prompts - use parallel analysis
 		fmt.Fprintf(h.out, "Analyzing session %s with %d prompts in parallel: %v\n", sessionID, len(promptNames), promptNames)
+		h.progress.Start(fmt.Sprintf("Analyzing session %s", sessionID))
 		analyses, errs := h.analysisService.AnalyzeSessionWithMultiplePrompts(ctx, sessionID, promptNames)
+		h.progress.Stop()
 
 		if len(errs) > 0 {
 			fmt.Fprintln(h.out, "\nErrors during analysis:")
@@ -169,10 +218,12 @@ func (h *AnalyzeCommandHandler) analyzeAllSessions(ctx context.Context, promptNa
 	for i, sessionID := range sessionIDs {
 		fmt.Fprintf(h.out, "[%d/%d] Analyzing session %s with %d prompt(s)...\n", i+1, len(sessionIDs), sessionID, len(promptNames))
 		h.logger.Debug("Starting analysis for session %s (%d/%d)", sessionID, i+1, len(sessionIDs))
+		h.progress.Start(fmt.Sprintf("Analyzing session %s (%d of %d)", sessionID, i+1, len(sessionIDs)))
 
 		if len(promptNames) == 1 {
 			// Single prompt - simple sequential
 			analysis, err := h.analysisService.AnalyzeSessionWithPrompt(ctx, sessionID, promptNames[0])
+			h.progress.Stop()
 			if err != nil {
 				fmt.Fprintf(h.out, "Failed to analyze session %s: %v\n", sessionID, err)
 				h.logger.Warn("Analysis failed for session %s: %v", sessionID, err)
@@ -184,6 +235,7 @@ func (h *AnalyzeCommandHandler) analyzeAllSessions(ctx context.Context, promptNa
 		} else {
 			// Multiple prompts - parallel
 			analyses, errs := h.analysisService.AnalyzeSessionWithMultiplePrompts(ctx, sessionID, promptNames)
+			h.progress.Stop()
 			if len(errs) > 0 {
 				h.logger.Warn("Some analyses failed for session %s: %v", sessionID, errs)
 			}
@@ -232,10 +284,12 @@ func (h *AnalyzeCommandHandler) refreshAnalyses(ctx context.Context, limit int,
 	for i, sessionID := range sessionIDs {
 		fmt.Fprintf(h.out, "[%d/%d] Re-analyzing session %s with %d prompt(s)...\n", i+1, len(sessionIDs), sessionID, len(promptNames))
 		h.logger.Debug("Starting re-analysis for session %s (%d/%d)", sessionID, i+1, len(sessionIDs))
+		h.progress.Start(fmt.Sprintf("Re-analyzing session %s (%d of %d)", sessionID, i+1, len(sessionIDs)))
 
 		if len(promptNames) == 1 {
 			// Single prompt - simple sequential
 			analysis, err := h.analysisService.AnalyzeSessionWithPrompt(ctx, sessionID, promptNames[0])
+			h.progress.Stop()
 			if err != nil {
 				fmt.Fprintf(h.out, "Failed to re-analyze session %s: %v\n", sessionID, err)
 				h.logger.Warn("Re-analysis failed for session %s: %v", sessionID, err)
@@ -247,6 +301,7 @@ func (h *AnalyzeCommandHandler) refreshAnalyses(ctx context.Context, limit int,
 		} else {
 			// Multiple prompts - parallel
 			analyses, errs := h.analysisService.AnalyzeSessionWithMultiplePrompts(ctx, sessionID, promptNames)
+			h.progress.Stop()
 			if len(errs) > 0 {
 				h.logger.Warn("Some re-analyses failed for session %s: %v", sessionID, errs)
 			}
@@ -3,6 +3,7 @@ package app_test
 import (
 	"bytes"
 	"context"
+	"errors"
 	"strings"
 	"testing"
 	"time"
@@ -21,12 +22,13 @@ func (m *mockLogger) Error(format string, args ...interface{}) {}
 
 // mockAnalysisService is a mock implementation of AnalysisService for testing
 type mockAnalysisService struct {
-	getLastSessionFunc            func(ctx context.Context) (string, error)
-	getAnalysisFunc               func(ctx context.Context, sessionID string) (*domain.SessionAnalysis, error)
-	analyzeSessionWithPromptFunc  func(ctx context.Context, sessionID string, promptName string) (*domain.SessionAnalysis, error)
-	getUnanalyzedSessionsFunc     func(ctx context.Context) ([]string, error)
-	getAllSessionIDsFunc          func(ctx context.Context, limit int) ([]string, error)
-	analyzeMultiplePromptsFunc    func(ctx context.Context, sessionID string, promptNames []string) (map[string]*domain.SessionAnalysis, []error)
+	getLastSessionFunc           func(ctx context.Context) (string, error)
+	getAnalysisFunc              func(ctx context.Context, sessionID string) (*domain.SessionAnalysis, error)
+	analyzeSessionWithPromptFunc func(ctx context.Context, sessionID string, promptName string) (*domain.SessionAnalysis, error)
+	getUnanalyzedSessionsFunc    func(ctx context.Context) ([]string, error)
+	getAllSessionIDsFunc         func(ctx context.Context, limit int) ([]string, error)
+	analyzeMultiplePromptsFunc   func(ctx context.Context, sessionID string, promptNames []string) (map[string]*domain.SessionAnalysis, []error)
+	rerunSessionAnalysisFunc     func(ctx context.Context, sessionID, promptName, model string) (*domain.SessionAnalysis, error)
 }
 
 func (m *mockAnalysisService) GetLastSession(ctx context.Context) (string, error) {
@@ -92,6 +94,20 @@ func (m *mockAnalysisService) AnalyzeSessionWithMultiplePrompts(ctx context.Cont
 	return results, nil
 }
 
+func (m *mockAnalysisService) RerunSessionAnalysis(ctx context.Context, sessionID, promptName, model string) (*domain.SessionAnalysis, error) {
+	if m.rerunSessionAnalysisFunc != nil {
+		return m.rerunSessionAnalysisFunc(ctx, sessionID, promptName, model)
+	}
+	return &domain.SessionAnalysis{
+		ID:             "analysis-rerun",
+		SessionID:      sessionID,
+		AnalyzedAt:     time.Now(),
+		ModelUsed:      model,
+		AnalysisResult: "Rerun analysis",
+		PromptName:     "tool_analysis",
+	}, nil
+}
+
 func TestAnalyzeCommandHandler_ViewAnalysis(t *testing.T) {
 	ctx := context.Background()
 	mockService := &mockAnalysisService{}
@@ -254,3 +270,77 @@ func TestAnalyzeCommandHandler_NoSessionSpecified(t *testing.T) {
 		t.Errorf("Error should indicate missing session specification, got: %v", err)
 	}
 }
+
+func TestAnalyzeCommandHandler_Rerun(t *testing.T) {
+	ctx := context.Background()
+	mockService := &mockAnalysisService{}
+	out := &bytes.Buffer{}
+	handler := app.NewAnalyzeCommandHandler(mockService, &mockLogger{}, out)
+
+	if err := handler.Rerun(ctx, "test-session-123", "", "opus"); err != nil {
+		t.Fatalf("Rerun failed: %v", err)
+	}
+
+	output := out.String()
+	if !strings.Contains(output, "analysis-rerun") || !strings.Contains(output, "model: opus") {
+		t.Errorf("Output should confirm new analysis ID and model, got: %s", output)
+	}
+
+	if err := handler.Rerun(ctx, "", "", "opus"); err == nil {
+		t.Error("Rerun should fail without a session ID")
+	}
+}
+
+// recordingProgress records the progress calls, failing on a Start without a Stop
+type recordingProgress struct {
+	t       *testing.T
+	running bool
+	labels  []string
+}
+
+func (p *recordingProgress) Start(label string) {
+	if p.running {
+		p.t.Errorf("Start(%q) while the previous progress is still running", label)
+	}
+	p.running = true
+	p.labels = append(p.labels, label)
+}
+
+func (p *recordingProgress) Stop() {
+	p.running = false
+}
+
+func TestAnalyzeCommandHandler_Progress(t *testing.T) {
+	ctx := context.Background()
+	mockService := &mockAnalysisService{
+		analyzeSessionWithPromptFunc: func(ctx context.Context, sessionID string, promptName string) (*domain.SessionAnalysis, error) {
+			if sessionID == "session-1" {
+				return nil, errors.New("LLM timed out")
+			}
+			return &domain.SessionAnalysis{SessionID: sessionID, AnalyzedAt: time.Now()}, nil
+		},
+	}
+	handler := app.NewAnalyzeCommandHandler(mockService, &mockLogger{}, &bytes.Buffer{})
+	progress := &recordingProgress{t: t}
+	handler.SetProgress(progress)
+
+	if err := handler.Execute(ctx, app.AnalyzeOptions{AnalyzeAll: true, PromptNames: []string{"test_prompt"}}); err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+	if progress.running {
+		t.Error("progress still running after the batch finished")
+	}
+	want := "Analyzing session session-1 (1 of 2),Analyzing session session-2 (2 of 2)"
+	if got := strings.Join(progress.labels, ","); got != want {
+		t.Errorf("progress labels = %s, want %s", got, want)
+	}
+
+	// A failed single-session analysis stops the progress before the error is returned
+	progress.labels = nil
+	if err := handler.Execute(ctx, app.AnalyzeOptions{SessionID: "session-1", PromptNames: []string{"test_prompt"}}); err == nil {
+		t.Fatal("expected the analysis error")
+	}
+	if progress.running || len(progress.labels) != 1 || progress.labels[0] != "Analyzing session session-1" {
+		t.Errorf("running = %v, labels = %v, want one stopped progress for session-1", progress.running, progress.labels)
+	}
+}
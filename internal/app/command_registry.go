@@ -2,13 +2,26 @@ package app
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"strings"
 	"sync"
 
 	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
 )
 
+// Routing errors returned by GetCommand and ExecuteCommand when no command matches.
+// They are wrapped with the plugin and command names; use errors.Is to test for them.
+var (
+	// ErrPluginNotFound means no plugin is registered under the requested name
+	ErrPluginNotFound = errors.New("plugin not found")
+	// ErrCommandNotFound means the plugin exists but has no command with the requested name
+	ErrCommandNotFound = errors.New("command not found")
+	// ErrPluginNoCommands means the plugin exists but does not implement ICommandProvider
+	ErrPluginNoCommands = errors.New("plugin does not provide commands")
+)
+
 // CommandRegistry manages command discovery and routing from plugins.
 // It discovers commands from plugins that implement ICommandProvider.
 type CommandRegistry struct {
@@ -37,18 +50,18 @@ func (r *CommandRegistry) GetCommand(pluginName, commandName string) (pluginsdk.
 		if cmd, exists := cached[commandName]; exists {
 			return cmd, nil
 		}
-		return nil, fmt.Errorf("command not found: %s %s", pluginName, commandName)
+		return nil, fmt.Errorf("%w: %s %s", ErrCommandNotFound, pluginName, commandName)
 	}
 
 	// Load commands from plugin
 	plugin, err := r.pluginRegistry.GetPlugin(pluginName)
 	if err != nil {
-		return nil, fmt.Errorf("plugin not found: %s", pluginName)
+		return nil, fmt.Errorf("%w: %s", ErrPluginNotFound, pluginName)
 	}
 
 	cmdProvider, ok := plugin.(pluginsdk.ICommandProvider)
 	if !ok {
-		return nil, fmt.Errorf("plugin %s does not provide commands", pluginName)
+		return nil, fmt.Errorf("%w: %s", ErrPluginNoCommands, pluginName)
 	}
 
 	// Cache commands for this plugin
@@ -66,7 +79,7 @@ func (r *CommandRegistry) GetCommand(pluginName, commandName string) (pluginsdk.
 	r.mu.RUnlock()
 
 	if !exists {
-		return nil, fmt.Errorf("command not found: %s %s", pluginName, commandName)
+		return nil, fmt.Errorf("%w: %s %s", ErrCommandNotFound, pluginName, commandName)
 	}
 
 	return cmd, nil
@@ -141,7 +154,9 @@ func (r *CommandRegistry) GetAllCommands() map[string][]pluginsdk.Command {
 	return result
 }
 
-// ExecuteCommand executes a command from a plugin
+// ExecuteCommand executes a command from a plugin.
+// If the command cannot be resolved the error wraps ErrPluginNotFound, ErrCommandNotFound
+// or ErrPluginNoCommands; any other error comes from the command itself.
 func (r *CommandRegistry) ExecuteCommand(ctx context.Context, pluginName, commandName string, args []string, cmdCtx pluginsdk.CommandContext) error {
 	cmd, err := r.GetCommand(pluginName, commandName)
 	if err != nil {
@@ -154,6 +169,13 @@ func (r *CommandRegistry) ExecuteCommand(ctx context.Context, pluginName, comman
 		return nil
 	}
 
+	// Commands that declare their arguments get them validated before running
+	if provider, ok := cmd.(pluginsdk.ArgSpecProvider); ok {
+		if err := provider.GetArgSpec().Validate(args); err != nil {
+			return err
+		}
+	}
+
 	r.logger.Debug("Executing command: %s %s", pluginName, commandName)
 	return cmd.Execute(ctx, cmdCtx, args)
 }
@@ -170,8 +192,14 @@ func containsHelp(args []string) bool {
 
 // printCommandHelp displays help for a command
 func (r *CommandRegistry) printCommandHelp(pluginName string, cmd pluginsdk.Command, cmdCtx pluginsdk.CommandContext) {
-	output := cmdCtx.GetStdout()
+	WriteCommandHelp(cmdCtx.GetStdout(), pluginName, cmd)
+}
 
+// WriteCommandHelp writes the help of a plugin command: header, description, usage
+// and the command's detailed help. For commands implementing pluginsdk.ArgSpecProvider
+// the argument and flag sections are generated from the spec, as is the usage line
+// if the command doesn't provide one.
+func WriteCommandHelp(output io.Writer, pluginName string, cmd pluginsdk.Command) {
 	// Command header
 	fmt.Fprintf(output, "Command: dw %s %s\n\n", pluginName, cmd.GetName())
 
@@ -179,7 +207,17 @@ func (r *CommandRegistry) printCommandHelp(pluginName string, cmd pluginsdk.Comm
 	fmt.Fprintf(output, "Description:\n  %s\n\n", cmd.GetDescription())
 
 	// Usage
-	fmt.Fprintf(output, "Usage:\n  %s\n\n", cmd.GetUsage())
+	provider, hasSpec := cmd.(pluginsdk.ArgSpecProvider)
+	usage := cmd.GetUsage()
+	if usage == "" && hasSpec {
+		usage = provider.GetArgSpec().Usage(fmt.Sprintf("dw %s %s", pluginName, cmd.GetName()))
+	}
+	fmt.Fprintf(output, "Usage:\n  %s\n\n", usage)
+
+	// Declared arguments and flags
+	if hasSpec {
+		provider.GetArgSpec().WriteHelp(output)
+	}
 
 	// Detailed help (if provided)
 	if help := cmd.GetHelp(); help != "" {
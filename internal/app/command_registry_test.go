@@ -140,31 +140,29 @@ func TestCommandRegistry_GetCommand(t *testing.T) {
 		name        string
 		pluginName  string
 		commandName string
-		wantErr     bool
+		wantErr     error
 	}{
 		{
 			name:        "existing command",
 			pluginName:  "test-plugin",
 			commandName: "init",
-			wantErr:     false,
 		},
 		{
 			name:        "another existing command",
 			pluginName:  "test-plugin",
 			commandName: "start",
-			wantErr:     false,
 		},
 		{
 			name:        "non-existent command",
 			pluginName:  "test-plugin",
 			commandName: "nonexistent",
-			wantErr:     true,
+			wantErr:     app.ErrCommandNotFound,
 		},
 		{
 			name:        "non-existent plugin",
 			pluginName:  "nonexistent-plugin",
 			commandName: "init",
-			wantErr:     true,
+			wantErr:     app.ErrPluginNotFound,
 		},
 	}
 
@@ -172,9 +170,9 @@ func TestCommandRegistry_GetCommand(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			cmd, err := registry.GetCommand(tt.pluginName, tt.commandName)
 
-			if tt.wantErr {
-				if err == nil {
-					t.Errorf("GetCommand() expected error, got nil")
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("GetCommand() error = %v, want %v", err, tt.wantErr)
 				}
 			} else {
 				if err != nil {
@@ -204,8 +202,8 @@ func TestCommandRegistry_GetCommand_NonCommandProvider(t *testing.T) {
 	registry := app.NewCommandRegistry(pluginRegistry, logger)
 
 	_, err := registry.GetCommand("no-commands", "init")
-	if err == nil {
-		t.Error("GetCommand() expected error for non-command-provider plugin, got nil")
+	if !errors.Is(err, app.ErrPluginNoCommands) {
+		t.Errorf("GetCommand() error = %v, want ErrPluginNoCommands", err)
 	}
 }
 
@@ -357,6 +355,40 @@ func TestCommandRegistry_ExecuteCommand_Error(t *testing.T) {
 	}
 }
 
+func TestCommandRegistry_ExecuteCommand_NotFound(t *testing.T) {
+	logger := &app.NoOpLogger{}
+	pluginRegistry := app.NewPluginRegistry(logger)
+	pluginRegistry.RegisterPlugin(&mockCommandProviderPlugin{
+		info:     pluginsdk.PluginInfo{Name: "test-plugin", Version: "1.0.0"},
+		commands: []pluginsdk.Command{&mockCommand{name: "init"}},
+	})
+	pluginRegistry.RegisterPlugin(&mockNonCommandPlugin{
+		info: pluginsdk.PluginInfo{Name: "no-commands", Version: "1.0.0"},
+	})
+
+	registry := app.NewCommandRegistry(pluginRegistry, logger)
+
+	tests := []struct {
+		name        string
+		pluginName  string
+		commandName string
+		wantErr     error
+	}{
+		{"unknown plugin", "missing", "init", app.ErrPluginNotFound},
+		{"unknown command", "test-plugin", "missing", app.ErrCommandNotFound},
+		{"plugin without commands", "no-commands", "init", app.ErrPluginNoCommands},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := registry.ExecuteCommand(context.Background(), tt.pluginName, tt.commandName, nil, nil)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ExecuteCommand() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestCommandRegistry_ListCommands(t *testing.T) {
 	logger := &app.NoOpLogger{}
 	pluginRegistry := app.NewPluginRegistry(logger)
@@ -516,3 +548,71 @@ type mockCommandWithHelp struct {
 func (m *mockCommandWithHelp) GetHelp() string {
 	return m.help
 }
+
+// mockCommandWithArgSpec extends mockCommand to declare its arguments
+type mockCommandWithArgSpec struct {
+	mockCommand
+	spec pluginsdk.CommandArgSpec
+}
+
+func (m *mockCommandWithArgSpec) GetArgSpec() pluginsdk.CommandArgSpec {
+	return m.spec
+}
+
+func TestCommandRegistry_ExecuteCommand_ArgSpec(t *testing.T) {
+	logger := &app.NoOpLogger{}
+	pluginRegistry := app.NewPluginRegistry(logger)
+
+	executed := false
+	cmd := &mockCommandWithArgSpec{
+		mockCommand: mockCommand{
+			name:        "add",
+			description: "Add an item",
+			executeFunc: func(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+				executed = true
+				return nil
+			},
+		},
+		spec: pluginsdk.CommandArgSpec{
+			Positionals: []pluginsdk.PositionalSpec{{Name: "item-id", Required: true, Description: "Item to add"}},
+			Flags:       []pluginsdk.FlagSpec{{Name: "description", Value: "desc", Required: true, Description: "Item description"}},
+		},
+	}
+	pluginRegistry.RegisterPlugin(&mockCommandProviderPlugin{
+		info:     pluginsdk.PluginInfo{Name: "test-plugin", Version: "1.0.0"},
+		commands: []pluginsdk.Command{cmd},
+	})
+	registry := app.NewCommandRegistry(pluginRegistry, logger)
+
+	// Invalid arguments are rejected before Execute
+	err := registry.ExecuteCommand(context.Background(), "test-plugin", "add", []string{"I-1"}, nil)
+	if !errors.Is(err, pluginsdk.ErrInvalidArgument) || !strings.Contains(err.Error(), "missing required flag --description") {
+		t.Fatalf("expected a missing flag error, got %v", err)
+	}
+	if executed {
+		t.Fatal("expected the command not to run with invalid arguments")
+	}
+
+	if err := registry.ExecuteCommand(context.Background(), "test-plugin", "add", []string{"I-1", "--description", "d"}, nil); err != nil {
+		t.Fatalf("ExecuteCommand: %v", err)
+	}
+	if !executed {
+		t.Error("expected the command to run with valid arguments")
+	}
+
+	// Help is generated from the spec, including the usage line
+	output := &strings.Builder{}
+	if err := registry.ExecuteCommand(context.Background(), "test-plugin", "add", []string{"--help"}, &mockCommandContext{stdout: output}); err != nil {
+		t.Fatalf("ExecuteCommand with --help: %v", err)
+	}
+	for _, want := range []string{
+		"dw test-plugin add <item-id> --description <desc>",
+		"<item-id>",
+		"Item to add (required)",
+		"--description <desc>",
+	} {
+		if !strings.Contains(output.String(), want) {
+			t.Errorf("expected help to contain %q, got:\n%s", want, output.String())
+		}
+	}
+}
@@ -2,10 +2,47 @@ package app
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/kgatilin/darwinflow-pub/internal/domain"
 )
 
+// ConfigSaver is implemented by config loaders that can write the config file back
+type ConfigSaver interface {
+	SaveConfig(config *domain.Config, path string) (string, error)
+}
+
+// FileConfigLoader is implemented by config loaders that can load the config file
+// without environment overrides, so that `dw config set` never writes them to the file
+type FileConfigLoader interface {
+	LoadFileConfig(path string) (*domain.Config, error)
+}
+
+// ConfigInspector is implemented by config loaders that can report which keys the
+// config file sets, and the path of that file
+type ConfigInspector interface {
+	ConfigFileKeys(path string) (map[string]bool, string, error)
+}
+
+// ConfigSetKeys returns the keys accepted by `dw config set`, sorted
+func ConfigSetKeys() []string {
+	return domain.ConfigSetKeys()
+}
+
+// ConfigValue is the effective value of a config key and where it comes from
+type ConfigValue struct {
+	Key    string      `json:"key"`
+	Value  interface{} `json:"value"`
+	Type   string      `json:"type"`
+	Source string      `json:"source"`            // default, config or env
+	EnvVar string      `json:"env_var,omitempty"` // Variable overriding the key, if any
+}
+
 // ConfigCommandHandler handles config command operations
 type ConfigCommandHandler struct {
 	configLoader ConfigLoader
@@ -57,7 +94,172 @@ func (h *ConfigCommandHandler) Show(ctx context.Context) error {
 	for name := range config.Prompts {
 		fmt.Fprintf(h.output, "  - %s\n", name)
 	}
+	fmt.Fprintf(h.output, "\nLogs default limit: %d (0 = unlimited)\n", config.Logs.EffectiveDefaultLimit())
+	if len(config.Events.Sample) == 0 {
+		fmt.Fprintln(h.output, "\nEvent sampling: off (all events stored)")
+	} else {
+		fmt.Fprintln(h.output, "\nEvent sampling (fraction stored):")
+		types := make([]string, 0, len(config.Events.Sample))
+		for eventType := range config.Events.Sample {
+			types = append(types, eventType)
+		}
+		sort.Strings(types)
+		for _, eventType := range types {
+			fmt.Fprintf(h.output, "  - %s: %g\n", eventType, config.Events.Sample[eventType])
+		}
+	}
 	fmt.Fprintln(h.output, "\nTo edit prompts, modify .darwinflow.yaml in your project root")
 
 	return nil
 }
+
+// Set updates a single configuration value and saves the config file,
+// creating it with defaults if it does not exist yet
+func (h *ConfigCommandHandler) Set(ctx context.Context, configPath, key, value string) error {
+	configKey, ok := domain.LookupConfigKey(key)
+	if !ok || !configKey.Settable() {
+		return fmt.Errorf("unknown config key '%s' (supported: %s)", key, strings.Join(ConfigSetKeys(), ", "))
+	}
+	saver, ok := h.configLoader.(ConfigSaver)
+	if !ok {
+		return fmt.Errorf("config loader cannot save configuration")
+	}
+
+	// Load the file without environment overrides so they are not persisted
+	load := h.configLoader.LoadConfig
+	if fileLoader, ok := h.configLoader.(FileConfigLoader); ok {
+		load = fileLoader.LoadFileConfig
+	}
+	config, err := load(configPath)
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	if err := configKey.Set(config, value); err != nil {
+		return fmt.Errorf("invalid value for %s: %w", key, err)
+	}
+
+	savedPath, err := saver.SaveConfig(config, configPath)
+	if err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	fmt.Fprintf(h.output, "Set %s = %s in %s\n", key, value, savedPath)
+	return nil
+}
+
+// Get displays the effective value of a config key and its source: an environment
+// override, the config file or the built-in default
+func (h *ConfigCommandHandler) Get(ctx context.Context, configPath, key string, jsonOutput bool) error {
+	configKey, ok := domain.LookupConfigKey(key)
+	if !ok {
+		return fmt.Errorf("unknown config key '%s' (run 'dw config get --all' to list keys)", key)
+	}
+	config, err := h.configLoader.LoadConfig(configPath)
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	values, err := h.effectiveValues(configPath, config, []domain.ConfigKey{configKey})
+	if err != nil {
+		return err
+	}
+	value := values[0]
+
+	if jsonOutput {
+		return writeConfigJSON(h.output, value)
+	}
+	fmt.Fprintf(h.output, "%s = %s (%s)\n", value.Key, formatConfigValue(value.Value), describeConfigSource(value))
+	return nil
+}
+
+// GetAll displays every known config key with its effective value and source,
+// including keys left at their defaults
+func (h *ConfigCommandHandler) GetAll(ctx context.Context, configPath string, jsonOutput bool) error {
+	config, err := h.configLoader.LoadConfig(configPath)
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	values, err := h.effectiveValues(configPath, config, domain.ConfigKeys(config))
+	if err != nil {
+		return err
+	}
+
+	if jsonOutput {
+		return writeConfigJSON(h.output, values)
+	}
+
+	width := 0
+	for _, value := range values {
+		if len(value.Key) > width {
+			width = len(value.Key)
+		}
+	}
+	for _, value := range values {
+		fmt.Fprintf(h.output, "%-*s  %s (%s)\n", width, value.Key, formatConfigValue(value.Value), describeConfigSource(value))
+	}
+	return nil
+}
+
+// effectiveValues resolves the values of keys in the effective config and their sources.
+// A key's source is env if a valid override is set, config if the config file sets it
+// and default otherwise.
+func (h *ConfigCommandHandler) effectiveValues(configPath string, config *domain.Config, keys []domain.ConfigKey) ([]ConfigValue, error) {
+	fileKeys := map[string]bool{}
+	if inspector, ok := h.configLoader.(ConfigInspector); ok {
+		var err error
+		fileKeys, _, err = inspector.ConfigFileKeys(configPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read config file: %w", err)
+		}
+	}
+
+	values := make([]ConfigValue, 0, len(keys))
+	for _, key := range keys {
+		value := ConfigValue{
+			Key:    key.Name,
+			Value:  key.Get(config),
+			Type:   key.Type,
+			Source: domain.ConfigSourceDefault,
+			EnvVar: key.EnvVar(),
+		}
+		if fileKeys[key.Name] {
+			value.Source = domain.ConfigSourceFile
+		}
+		if value.EnvVar != "" {
+			// Invalid overrides are ignored by the loader, so they are not the source
+			if env, ok := os.LookupEnv(value.EnvVar); ok && key.Set(domain.DefaultConfig(), env) == nil {
+				value.Source = domain.ConfigSourceEnv
+			}
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+// describeConfigSource names a value's source, with the variable for environment overrides
+func describeConfigSource(value ConfigValue) string {
+	if value.Source == domain.ConfigSourceEnv {
+		return value.Source + ": " + value.EnvVar
+	}
+	return value.Source
+}
+
+// formatConfigValue renders a typed config value for text output
+func formatConfigValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		if v == "" {
+			return `""`
+		}
+		return v
+	case []string:
+		return "[" + strings.Join(v, ", ") + "]"
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func writeConfigJSON(out io.Writer, v interface{}) error {
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
@@ -3,6 +3,7 @@ package app_test
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"testing"
 
@@ -39,6 +40,17 @@ func (m *MockConfigLoader) InitializeDefaultConfig(configPath string) (string, e
 	return configPath, nil
 }
 
+// savingConfigLoader is a MockConfigLoader that also implements app.ConfigSaver
+type savingConfigLoader struct {
+	MockConfigLoader
+	saved *domain.Config
+}
+
+func (m *savingConfigLoader) SaveConfig(config *domain.Config, configPath string) (string, error) {
+	m.saved = config
+	return ".darwinflow.yaml", nil
+}
+
 func TestNewConfigCommandHandler(t *testing.T) {
 	loader := &MockConfigLoader{}
 	logger := &app.NoOpLogger{}
@@ -151,3 +163,212 @@ func TestConfigCommandHandler_Show_WithLoadError(t *testing.T) {
 		t.Error("Expected error when load fails")
 	}
 }
+
+func TestConfigCommandHandler_Set(t *testing.T) {
+	ctx := context.Background()
+	loader := &savingConfigLoader{}
+	output := &bytes.Buffer{}
+	handler := app.NewConfigCommandHandler(loader, &app.NoOpLogger{}, output)
+
+	if err := handler.Set(ctx, "", "logs.default_limit", "100"); err != nil {
+		t.Fatalf("Set failed: %v", err)
+	}
+
+	if loader.saved == nil {
+		t.Fatal("Expected config to be saved")
+	}
+	if got := loader.saved.Logs.EffectiveDefaultLimit(); got != 100 {
+		t.Errorf("Expected logs default limit 100, got %d", got)
+	}
+	if !contains(output.String(), "Set logs.default_limit = 100") {
+		t.Errorf("Output should confirm the change, got: %s", output.String())
+	}
+}
+
+func TestConfigCommandHandler_SetEventSampleRate(t *testing.T) {
+	ctx := context.Background()
+	loader := &savingConfigLoader{}
+	handler := app.NewConfigCommandHandler(loader, &app.NoOpLogger{}, &bytes.Buffer{})
+
+	if err := handler.Set(ctx, "", "events.sample.tool.result", "0.1"); err != nil {
+		t.Fatalf("Set failed: %v", err)
+	}
+	if got := loader.saved.Events.SampleRate("tool.result"); got != 0.1 {
+		t.Errorf("Expected tool.result sample rate 0.1, got %v", got)
+	}
+
+	// A rate of 1 turns sampling off for the type
+	loader.config = loader.saved
+	if err := handler.Set(ctx, "", "events.sample.tool.result", "1"); err != nil {
+		t.Fatalf("Set failed: %v", err)
+	}
+	if _, ok := loader.saved.Events.Sample["tool.result"]; ok {
+		t.Error("Expected rate 1 to remove the tool.result sampling entry")
+	}
+}
+
+func TestConfigCommandHandler_SetInvalid(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name  string
+		key   string
+		value string
+	}{
+		{"unknown key", "logs.colour", "red"},
+		{"negative limit", "logs.default_limit", "-1"},
+		{"non-numeric limit", "logs.default_limit", "many"},
+		{"unknown model", "analysis.model", "gpt"},
+		{"sample rate above 1", "events.sample.tool.result", "1.5"},
+		{"non-numeric sample rate", "events.sample.tool.result", "half"},
+		{"sample without type", "events.sample.", "0.5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loader := &savingConfigLoader{}
+			handler := app.NewConfigCommandHandler(loader, &app.NoOpLogger{}, &bytes.Buffer{})
+
+			if err := handler.Set(ctx, "", tt.key, tt.value); err == nil {
+				t.Errorf("Expected error for %s=%s", tt.key, tt.value)
+			}
+			if loader.saved != nil {
+				t.Error("Config should not be saved on error")
+			}
+		})
+	}
+}
+
+func TestConfigCommandHandler_SetWithoutSaver(t *testing.T) {
+	handler := app.NewConfigCommandHandler(&MockConfigLoader{}, &app.NoOpLogger{}, &bytes.Buffer{})
+
+	if err := handler.Set(context.Background(), "", "logs.default_limit", "10"); err == nil {
+		t.Error("Expected error when the config loader cannot save")
+	}
+}
+
+// inspectingConfigLoader is a savingConfigLoader that reports the keys set in the config file
+type inspectingConfigLoader struct {
+	savingConfigLoader
+	fileKeys map[string]bool
+}
+
+func (m *inspectingConfigLoader) ConfigFileKeys(configPath string) (map[string]bool, string, error) {
+	return m.fileKeys, ".darwinflow.yaml", nil
+}
+
+func TestConfigCommandHandler_Get(t *testing.T) {
+	ctx := context.Background()
+	config := domain.DefaultConfig()
+	limit := 50
+	config.Logs.DefaultLimit = &limit
+	loader := &inspectingConfigLoader{
+		savingConfigLoader: savingConfigLoader{MockConfigLoader: MockConfigLoader{config: config}},
+		fileKeys:           map[string]bool{"logs.default_limit": true},
+	}
+
+	tests := []struct {
+		key  string
+		want string
+	}{
+		{"logs.default_limit", "logs.default_limit = 50 (config)\n"},
+		{"analysis.model", "analysis.model = sonnet (default)\n"},
+		{"analysis.enabled_prompts", "analysis.enabled_prompts = [tool_analysis] (default)\n"},
+		{"events.sample.tool.result", "events.sample.tool.result = 1 (default)\n"},
+	}
+	for _, tt := range tests {
+		output := &bytes.Buffer{}
+		handler := app.NewConfigCommandHandler(loader, &app.NoOpLogger{}, output)
+		if err := handler.Get(ctx, "", tt.key, false); err != nil {
+			t.Fatalf("Get(%s) failed: %v", tt.key, err)
+		}
+		if output.String() != tt.want {
+			t.Errorf("Get(%s) = %q, want %q", tt.key, output.String(), tt.want)
+		}
+	}
+
+	handler := app.NewConfigCommandHandler(loader, &app.NoOpLogger{}, &bytes.Buffer{})
+	if err := handler.Get(ctx, "", "logs.colour", false); err == nil {
+		t.Error("Expected error for unknown key")
+	}
+}
+
+func TestConfigCommandHandler_GetEnvSource(t *testing.T) {
+	t.Setenv("DW_ANALYSIS_MODEL", "opus")
+	config := domain.DefaultConfig()
+	config.Analysis.Model = "opus" // As applied by the loader
+	output := &bytes.Buffer{}
+	handler := app.NewConfigCommandHandler(&MockConfigLoader{config: config}, &app.NoOpLogger{}, output)
+
+	if err := handler.Get(context.Background(), "", "analysis.model", true); err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+
+	var value app.ConfigValue
+	if err := json.Unmarshal(output.Bytes(), &value); err != nil {
+		t.Fatalf("Invalid JSON output %q: %v", output.String(), err)
+	}
+	if value.Value != "opus" || value.Source != domain.ConfigSourceEnv || value.EnvVar != "DW_ANALYSIS_MODEL" {
+		t.Errorf("Unexpected value %+v", value)
+	}
+}
+
+func TestConfigCommandHandler_GetAllJSON(t *testing.T) {
+	config := domain.DefaultConfig()
+	config.Events.Sample = map[string]float64{"tool.result": 0.1}
+	loader := &inspectingConfigLoader{
+		savingConfigLoader: savingConfigLoader{MockConfigLoader: MockConfigLoader{config: config}},
+		fileKeys:           map[string]bool{"events.sample.tool.result": true},
+	}
+	output := &bytes.Buffer{}
+	handler := app.NewConfigCommandHandler(loader, &app.NoOpLogger{}, output)
+
+	if err := handler.GetAll(context.Background(), "", true); err != nil {
+		t.Fatalf("GetAll failed: %v", err)
+	}
+
+	var values []map[string]interface{}
+	if err := json.Unmarshal(output.Bytes(), &values); err != nil {
+		t.Fatalf("Invalid JSON output: %v", err)
+	}
+	byKey := map[string]map[string]interface{}{}
+	for _, value := range values {
+		byKey[value["key"].(string)] = value
+	}
+	if len(byKey) != len(domain.ConfigKeys(config)) {
+		t.Errorf("Expected %d keys, got %d", len(domain.ConfigKeys(config)), len(byKey))
+	}
+	// Values keep their types in JSON
+	if got := byKey["logs.default_limit"]; got["value"] != float64(domain.DefaultLogsLimit) || got["source"] != "default" {
+		t.Errorf("Unexpected logs.default_limit entry %v", got)
+	}
+	if got := byKey["analysis.auto_summary_enabled"]; got["value"] != false {
+		t.Errorf("Unexpected analysis.auto_summary_enabled entry %v", got)
+	}
+	if got := byKey["events.sample.tool.result"]; got["value"] != 0.1 || got["source"] != "config" {
+		t.Errorf("Unexpected events.sample.tool.result entry %v", got)
+	}
+}
+
+func TestConfigCommandHandler_SetIgnoresEnvOverrides(t *testing.T) {
+	fileConfig := domain.DefaultConfig()
+	loader := &fileConfigLoader{file: fileConfig}
+	handler := app.NewConfigCommandHandler(loader, &app.NoOpLogger{}, &bytes.Buffer{})
+
+	if err := handler.Set(context.Background(), "", "analysis.token_limit", "500"); err != nil {
+		t.Fatalf("Set failed: %v", err)
+	}
+	if loader.saved != fileConfig {
+		t.Error("Expected Set to save the config loaded without environment overrides")
+	}
+}
+
+// fileConfigLoader is a savingConfigLoader whose effective config differs from the file
+type fileConfigLoader struct {
+	savingConfigLoader
+	file *domain.Config
+}
+
+func (m *fileConfigLoader) LoadFileConfig(configPath string) (*domain.Config, error) {
+	return m.file, nil
+}
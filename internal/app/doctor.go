@@ -0,0 +1,143 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/kgatilin/darwinflow-pub/internal/domain"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// DoctorCheck is a single diagnostics result
+type DoctorCheck struct {
+	Name    string
+	OK      bool
+	Skipped bool
+	Detail  string // What was found
+	Hint    string // How to fix a failed check
+}
+
+// DoctorReport collects the results of `dw doctor`
+type DoctorReport struct {
+	Checks []DoctorCheck
+}
+
+// Pass records a successful check
+func (r *DoctorReport) Pass(name, detail string) {
+	r.Checks = append(r.Checks, DoctorCheck{Name: name, OK: true, Detail: detail})
+}
+
+// Fail records a failed check with a remediation hint
+func (r *DoctorReport) Fail(name, detail, hint string) {
+	r.Checks = append(r.Checks, DoctorCheck{Name: name, Detail: detail, Hint: hint})
+}
+
+// Skip records a check that could not run because an earlier check failed
+func (r *DoctorReport) Skip(name, reason string) {
+	r.Checks = append(r.Checks, DoctorCheck{Name: name, OK: true, Skipped: true, Detail: reason})
+}
+
+// Healthy reports whether every check passed
+func (r *DoctorReport) Healthy() bool {
+	for _, check := range r.Checks {
+		if !check.OK {
+			return false
+		}
+	}
+	return true
+}
+
+// Print writes the report as a ✓/✗ checklist followed by a summary line
+func (r *DoctorReport) Print(w io.Writer) {
+	fmt.Fprintln(w, "DarwinFlow Doctor")
+	fmt.Fprintln(w)
+
+	failed := 0
+	for _, check := range r.Checks {
+		mark := "✓"
+		switch {
+		case check.Skipped:
+			mark = "-"
+		case !check.OK:
+			mark = "✗"
+			failed++
+		}
+
+		line := fmt.Sprintf("%s %s", mark, check.Name)
+		if check.Detail != "" {
+			line += ": " + check.Detail
+		}
+		fmt.Fprintln(w, line)
+		if !check.OK && check.Hint != "" {
+			fmt.Fprintf(w, "    → %s\n", check.Hint)
+		}
+	}
+
+	fmt.Fprintln(w)
+	if failed == 0 {
+		fmt.Fprintln(w, "Everything looks good.")
+	} else {
+		fmt.Fprintf(w, "%d problem(s) found.\n", failed)
+	}
+}
+
+// CheckEventsSchema verifies the events database schema is current without migrating it
+func CheckEventsSchema(ctx context.Context, report *DoctorReport, checker domain.SchemaChecker) {
+	const name = "Database schema"
+	missing, err := checker.CheckSchema(ctx)
+	if err != nil {
+		report.Fail(name, err.Error(), "run 'dw refresh'")
+		return
+	}
+	if len(missing) > 0 {
+		report.Fail(name, "outdated, missing "+strings.Join(missing, ", "), "run 'dw refresh'")
+		return
+	}
+	report.Pass(name, "current")
+}
+
+// CheckEventsQueryable verifies the events table can be read
+func CheckEventsQueryable(ctx context.Context, report *DoctorReport, executor pluginsdk.RawQueryExecutor) {
+	const name = "Events table"
+	result, err := executor.ExecuteRawQuery(ctx, "SELECT COUNT(*) FROM events")
+	if err != nil {
+		report.Fail(name, fmt.Sprintf("not queryable: %v", err), "run 'dw refresh'; if it still fails, back up and remove the database, then run 'dw init'")
+		return
+	}
+	count := "0"
+	if len(result.Rows) > 0 && len(result.Rows[0]) > 0 {
+		count = FormatQueryValue(result.Rows[0][0])
+	}
+	report.Pass(name, fmt.Sprintf("queryable (%s events)", count))
+}
+
+// CheckPluginHealth pings every registered plugin and reports plugins that failed to load.
+// Plugins implementing pluginsdk.IHealthChecker run their own checks, whose errors already
+// carry remediation; others pass if they respond.
+func CheckPluginHealth(ctx context.Context, report *DoctorReport, plugins []pluginsdk.Plugin, loadErrors []PluginLoadError) {
+	for _, loadErr := range loadErrors {
+		report.Fail("Plugin "+loadErr.Plugin, "failed to load: "+loadErr.Error, "check .darwinflow/plugins.yaml and run 'dw plugin list'")
+	}
+
+	for _, plugin := range plugins {
+		info := plugin.GetInfo()
+		name := "Plugin " + info.Name
+		if info.Name == "" {
+			report.Fail("Plugin", "a registered plugin returned no name", "run 'dw plugin list' to identify it")
+			continue
+		}
+
+		checker, ok := plugin.(pluginsdk.IHealthChecker)
+		if !ok {
+			report.Pass(name, "responding")
+			continue
+		}
+		if err := checker.CheckHealth(ctx); err != nil {
+			report.Fail(name, err.Error(), "")
+			continue
+		}
+		report.Pass(name, "healthy")
+	}
+}
@@ -0,0 +1,146 @@
+package app_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/kgatilin/darwinflow-pub/internal/app"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// mockSchemaChecker returns a fixed CheckSchema result
+type mockSchemaChecker struct {
+	missing []string
+	err     error
+}
+
+func (m *mockSchemaChecker) CheckSchema(ctx context.Context) ([]string, error) {
+	return m.missing, m.err
+}
+
+// healthCheckPlugin is a MockPlugin that also implements pluginsdk.IHealthChecker
+type healthCheckPlugin struct {
+	*MockPlugin
+	healthErr error
+}
+
+func (p *healthCheckPlugin) CheckHealth(ctx context.Context) error {
+	return p.healthErr
+}
+
+func TestDoctorReport_Print(t *testing.T) {
+	report := &app.DoctorReport{}
+	report.Pass("Database", ".darwinflow/logs/events.db")
+	report.Fail("Claude Code hooks", "not installed", "run 'dw claude init'")
+	report.Skip("Plugins", "no database")
+
+	if report.Healthy() {
+		t.Error("Expected report with a failed check to be unhealthy")
+	}
+
+	var buf bytes.Buffer
+	report.Print(&buf)
+	output := buf.String()
+
+	for _, want := range []string{
+		"✓ Database: .darwinflow/logs/events.db",
+		"✗ Claude Code hooks: not installed",
+		"    → run 'dw claude init'",
+		"- Plugins: no database",
+		"1 problem(s) found.",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("Expected output to contain %q, got:\n%s", want, output)
+		}
+	}
+}
+
+func TestDoctorReport_HealthyWithSkips(t *testing.T) {
+	report := &app.DoctorReport{}
+	report.Pass("Database", "ok")
+	report.Skip("Plugins", "skipped")
+
+	if !report.Healthy() {
+		t.Error("Expected skipped checks not to make the report unhealthy")
+	}
+
+	var buf bytes.Buffer
+	report.Print(&buf)
+	if !strings.Contains(buf.String(), "Everything looks good.") {
+		t.Errorf("Expected success summary, got:\n%s", buf.String())
+	}
+}
+
+func TestCheckEventsSchema(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		checker  *mockSchemaChecker
+		wantOK   bool
+		wantText string
+	}{
+		{name: "current", checker: &mockSchemaChecker{}, wantOK: true, wantText: "current"},
+		{name: "outdated", checker: &mockSchemaChecker{missing: []string{"events.version", "bus_events"}}, wantText: "missing events.version, bus_events"},
+		{name: "error", checker: &mockSchemaChecker{err: errors.New("database is locked")}, wantText: "database is locked"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := &app.DoctorReport{}
+			app.CheckEventsSchema(ctx, report, tt.checker)
+
+			if len(report.Checks) != 1 {
+				t.Fatalf("Expected 1 check, got %d", len(report.Checks))
+			}
+			check := report.Checks[0]
+			if check.OK != tt.wantOK {
+				t.Errorf("Expected OK=%v, got %v", tt.wantOK, check.OK)
+			}
+			if !strings.Contains(check.Detail, tt.wantText) {
+				t.Errorf("Expected detail to contain %q, got %q", tt.wantText, check.Detail)
+			}
+			if !tt.wantOK && check.Hint == "" {
+				t.Error("Expected a remediation hint for a failed check")
+			}
+		})
+	}
+}
+
+func TestCheckPluginHealth(t *testing.T) {
+	ctx := context.Background()
+
+	plugins := []pluginsdk.Plugin{
+		NewMockPlugin("plain", nil),
+		&healthCheckPlugin{MockPlugin: NewMockPlugin("healthy", nil)},
+		&healthCheckPlugin{MockPlugin: NewMockPlugin("broken", nil), healthErr: errors.New("active project 'x' not found")},
+	}
+	loadErrors := []app.PluginLoadError{{Plugin: "external", Error: "command not found"}}
+
+	report := &app.DoctorReport{}
+	app.CheckPluginHealth(ctx, report, plugins, loadErrors)
+
+	checks := make(map[string]app.DoctorCheck)
+	for _, check := range report.Checks {
+		checks[check.Name] = check
+	}
+
+	if check := checks["Plugin external"]; check.OK || !strings.Contains(check.Detail, "command not found") {
+		t.Errorf("Expected load error to be reported as failure, got %+v", check)
+	}
+	if check := checks["Plugin plain"]; !check.OK || check.Detail != "responding" {
+		t.Errorf("Expected plugin without health check to respond, got %+v", check)
+	}
+	if check := checks["Plugin healthy"]; !check.OK || check.Detail != "healthy" {
+		t.Errorf("Expected healthy plugin to pass, got %+v", check)
+	}
+	if check := checks["Plugin broken"]; check.OK || check.Detail != "active project 'x' not found" {
+		t.Errorf("Expected health check error to be reported, got %+v", check)
+	}
+	if report.Healthy() {
+		t.Error("Expected report to be unhealthy")
+	}
+}
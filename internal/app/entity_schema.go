@@ -0,0 +1,44 @@
+package app
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// BuildEntityJSONSchema returns the JSON Schema of a plugin's entity types.
+// With an entityType, the document is the schema of that type; otherwise it bundles
+// the schema of every type the plugin provides under "$defs", keyed by type.
+func BuildEntityJSONSchema(provider pluginsdk.IEntitySchemaProvider, entityType string) (map[string]interface{}, error) {
+	if entityType != "" {
+		schema, err := provider.GetEntitySchema(entityType)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get schema of entity type %s: %w", entityType, err)
+		}
+		return schema.JSONSchema(), nil
+	}
+
+	types := []string{}
+	for _, info := range provider.GetEntityTypes() {
+		types = append(types, info.Type)
+	}
+	sort.Strings(types)
+
+	defs := make(map[string]interface{}, len(types))
+	for _, t := range types {
+		schema, err := provider.GetEntitySchema(t)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get schema of entity type %s: %w", t, err)
+		}
+		def := schema.JSONSchema()
+		delete(def, "$schema") // Declared once by the bundle
+		defs[t] = def
+	}
+
+	return map[string]interface{}{
+		"$schema": pluginsdk.JSONSchemaDialect,
+		"title":   provider.GetInfo().Name,
+		"$defs":   defs,
+	}, nil
+}
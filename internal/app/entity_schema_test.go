@@ -0,0 +1,86 @@
+package app_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/kgatilin/darwinflow-pub/internal/app"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// schemaPlugin is a MockPlugin that describes its entity types
+type schemaPlugin struct {
+	*MockPlugin
+}
+
+func (p *schemaPlugin) GetEntitySchema(entityType string) (*pluginsdk.EntitySchema, error) {
+	for _, info := range p.entityTypes {
+		if info.Type == entityType {
+			return &pluginsdk.EntitySchema{
+				Type:   entityType,
+				Fields: []pluginsdk.EntityField{{Name: "id", Type: pluginsdk.FieldTypeString, Required: true}},
+			}, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: entity type %s", pluginsdk.ErrNotFound, entityType)
+}
+
+func newSchemaRegistry(t *testing.T) *app.PluginRegistry {
+	t.Helper()
+	registry := app.NewPluginRegistry(&app.NoOpLogger{})
+
+	described := &schemaPlugin{NewMockPlugin("notes", []pluginsdk.EntityTypeInfo{{Type: "note"}, {Type: "folder"}})}
+	described.capabilities = append(described.capabilities, "IEntitySchemaProvider")
+	plain := NewMockPlugin("tasks", []pluginsdk.EntityTypeInfo{{Type: "task"}})
+	for _, p := range []pluginsdk.Plugin{described, plain} {
+		if err := registry.RegisterPlugin(p); err != nil {
+			t.Fatalf("RegisterPlugin failed: %v", err)
+		}
+	}
+	return registry
+}
+
+func TestBuildEntityJSONSchema(t *testing.T) {
+	registry := newSchemaRegistry(t)
+
+	provider, err := registry.GetEntitySchemaProvider("notes")
+	if err != nil {
+		t.Fatalf("GetEntitySchemaProvider failed: %v", err)
+	}
+
+	single, err := app.BuildEntityJSONSchema(provider, "note")
+	if err != nil {
+		t.Fatalf("BuildEntityJSONSchema failed: %v", err)
+	}
+	if single["title"] != "note" || single["$schema"] != pluginsdk.JSONSchemaDialect {
+		t.Errorf("unexpected single-type schema: %v", single)
+	}
+
+	bundle, err := app.BuildEntityJSONSchema(provider, "")
+	if err != nil {
+		t.Fatalf("BuildEntityJSONSchema failed: %v", err)
+	}
+	defs := bundle["$defs"].(map[string]interface{})
+	if len(defs) != 2 || defs["note"] == nil || defs["folder"] == nil {
+		t.Fatalf("expected note and folder in $defs, got %v", defs)
+	}
+	if _, ok := defs["note"].(map[string]interface{})["$schema"]; ok {
+		t.Error("expected $schema only at the top of the bundle")
+	}
+
+	if _, err := app.BuildEntityJSONSchema(provider, "ghost"); err == nil {
+		t.Error("expected error for unknown entity type")
+	}
+}
+
+func TestPluginRegistry_GetEntitySchemaProvider_Errors(t *testing.T) {
+	registry := newSchemaRegistry(t)
+
+	if _, err := registry.GetEntitySchemaProvider("tasks"); err == nil || !strings.Contains(err.Error(), "no IEntitySchemaProvider capability") {
+		t.Errorf("expected missing capability error, got %v", err)
+	}
+	if _, err := registry.GetEntitySchemaProvider("ghost"); err == nil || !strings.Contains(err.Error(), "plugin not found") {
+		t.Errorf("expected plugin not found error, got %v", err)
+	}
+}
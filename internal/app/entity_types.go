@@ -0,0 +1,123 @@
+package app
+
+import (
+	"sort"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// DefaultEntityIcon is shown for entity types whose plugin does not provide an icon
+const DefaultEntityIcon = "◆"
+
+// EntityTypeSeparator separates the plugin from the type in a qualified entity type
+const EntityTypeSeparator = ":"
+
+// QualifiedEntityType returns the namespaced form of an entity type, e.g. "notes:note".
+// Entity types are unique across plugins, so the plain type suffices for lookups; the
+// qualified form names the owning plugin explicitly and is accepted wherever a type is.
+func QualifiedEntityType(plugin, entityType string) string {
+	if plugin == "" {
+		return entityType
+	}
+	return plugin + EntityTypeSeparator + entityType
+}
+
+// Entity actions the host can offer for an entity type
+const (
+	EntityActionView    = "view"    // Query and display entities (every entity type)
+	EntityActionContext = "context" // Show related context (types with the IHasContext capability)
+	EntityActionEdit    = "edit"    // Update entity fields (types served by an IEntityUpdater)
+)
+
+// EntityTypeDisplay is how the host presents a plugin entity type: the plugin-provided
+// names and icon with fallbacks applied, and the actions the host offers for the type.
+// There is no delete operation in the plugin SDK, so deletion is never offered.
+type EntityTypeDisplay struct {
+	Type              string
+	Plugin            string // Name of the plugin providing the type; empty if unknown
+	QualifiedType     string // Type namespaced with its plugin, e.g. "notes:note"
+	DisplayName       string
+	DisplayNamePlural string
+	Icon              string
+	Description       string
+	Actions           []string
+}
+
+// NewEntityTypeDisplay applies the host's fallbacks to a plugin entity type:
+// a missing icon becomes DefaultEntityIcon, a missing display name the type
+// identifier and a missing plural the display name with an "s" appended.
+// updatable reports whether the providing plugin can update entities of the type.
+func NewEntityTypeDisplay(info pluginsdk.EntityTypeInfo, plugin string, updatable bool) EntityTypeDisplay {
+	display := EntityTypeDisplay{
+		Type:              info.Type,
+		Plugin:            plugin,
+		QualifiedType:     QualifiedEntityType(plugin, info.Type),
+		DisplayName:       info.DisplayName,
+		DisplayNamePlural: info.DisplayNamePlural,
+		Icon:              info.Icon,
+		Description:       info.Description,
+		Actions:           []string{EntityActionView},
+	}
+	if display.Icon == "" {
+		display.Icon = DefaultEntityIcon
+	}
+	if display.DisplayName == "" {
+		display.DisplayName = info.Type
+	}
+	if display.DisplayNamePlural == "" {
+		display.DisplayNamePlural = display.DisplayName + "s"
+	}
+
+	if contains(info.Capabilities, "IHasContext") {
+		display.Actions = append(display.Actions, EntityActionContext)
+	}
+	if updatable {
+		display.Actions = append(display.Actions, EntityActionEdit)
+	}
+	return display
+}
+
+// Title returns the header for a list of entities of this type, e.g. "📝 Notes"
+func (d EntityTypeDisplay) Title() string {
+	return d.Icon + " " + d.DisplayNamePlural
+}
+
+// CanEdit reports whether the host offers editing entities of this type
+func (d EntityTypeDisplay) CanEdit() bool {
+	return contains(d.Actions, EntityActionEdit)
+}
+
+// GetEntityTypeDisplays returns display metadata for all entity types of all plugins,
+// sorted by type identifier
+func (r *PluginRegistry) GetEntityTypeDisplays() []EntityTypeDisplay {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	displays := []EntityTypeDisplay{}
+	for name, plugin := range r.plugins {
+		entityProvider, ok := plugin.(pluginsdk.IEntityProvider)
+		if !ok {
+			continue
+		}
+		for _, info := range entityProvider.GetEntityTypes() {
+			displays = append(displays, NewEntityTypeDisplay(info, name, r.entityUpdaters[info.Type] != nil))
+		}
+	}
+
+	sort.Slice(displays, func(i, j int) bool {
+		return displays[i].Type < displays[j].Type
+	})
+	return displays
+}
+
+// GetEntityTypeDisplay returns display metadata for an entity type, given as is or
+// qualified with its plugin. Unknown types get the fallback display, so callers can
+// always render a header.
+func (r *PluginRegistry) GetEntityTypeDisplay(entityType string) EntityTypeDisplay {
+	for _, display := range r.GetEntityTypeDisplays() {
+		if display.Type == entityType || display.QualifiedType == entityType {
+			return display
+		}
+	}
+	return NewEntityTypeDisplay(pluginsdk.EntityTypeInfo{Type: entityType}, "", false)
+}
@@ -0,0 +1,80 @@
+package app_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kgatilin/darwinflow-pub/internal/app"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+func TestNewEntityTypeDisplay_Fallbacks(t *testing.T) {
+	display := app.NewEntityTypeDisplay(pluginsdk.EntityTypeInfo{Type: "note"}, "notes", false)
+
+	if display.Icon != app.DefaultEntityIcon {
+		t.Errorf("Icon = %q, want fallback %q", display.Icon, app.DefaultEntityIcon)
+	}
+	if display.DisplayName != "note" || display.DisplayNamePlural != "notes" {
+		t.Errorf("names = %q/%q, want note/notes", display.DisplayName, display.DisplayNamePlural)
+	}
+	if display.Title() != app.DefaultEntityIcon+" notes" {
+		t.Errorf("Title() = %q", display.Title())
+	}
+}
+
+func TestNewEntityTypeDisplay_Actions(t *testing.T) {
+	tests := []struct {
+		name      string
+		info      pluginsdk.EntityTypeInfo
+		updatable bool
+		want      []string
+	}{
+		{"read-only", pluginsdk.EntityTypeInfo{Type: "a"}, false, []string{"view"}},
+		{"with context", pluginsdk.EntityTypeInfo{Type: "b", Capabilities: []string{"IExtensible", "IHasContext"}}, false, []string{"view", "context"}},
+		{"updatable", pluginsdk.EntityTypeInfo{Type: "c"}, true, []string{"view", "edit"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			display := app.NewEntityTypeDisplay(tt.info, "p", tt.updatable)
+			if !reflect.DeepEqual(display.Actions, tt.want) {
+				t.Errorf("Actions = %v, want %v", display.Actions, tt.want)
+			}
+			if display.CanEdit() != tt.updatable {
+				t.Errorf("CanEdit() = %v, want %v", display.CanEdit(), tt.updatable)
+			}
+		})
+	}
+}
+
+func TestPluginRegistry_GetEntityTypeDisplays(t *testing.T) {
+	registry := app.NewPluginRegistry(&app.NoOpLogger{})
+
+	// MockPlugin declares IEntityUpdater, so its types are editable
+	updatable := NewMockPlugin("notes", []pluginsdk.EntityTypeInfo{
+		{Type: "note", DisplayName: "Note", DisplayNamePlural: "Notes", Icon: "📝"},
+	})
+	readOnly := NewMockPlugin("tasks", []pluginsdk.EntityTypeInfo{{Type: "task", DisplayName: "Task"}})
+	readOnly.capabilities = []string{"IEntityProvider"}
+	for _, p := range []*MockPlugin{updatable, readOnly} {
+		if err := registry.RegisterPlugin(p); err != nil {
+			t.Fatalf("RegisterPlugin failed: %v", err)
+		}
+	}
+
+	displays := registry.GetEntityTypeDisplays()
+	if len(displays) != 2 || displays[0].Type != "note" || displays[1].Type != "task" {
+		t.Fatalf("expected displays sorted by type [note task], got %+v", displays)
+	}
+	if displays[0].Icon != "📝" || displays[0].Plugin != "notes" || !displays[0].CanEdit() {
+		t.Errorf("unexpected note display: %+v", displays[0])
+	}
+	if displays[1].Icon != app.DefaultEntityIcon || displays[1].DisplayNamePlural != "Tasks" || displays[1].CanEdit() {
+		t.Errorf("unexpected task display: %+v", displays[1])
+	}
+
+	unknown := registry.GetEntityTypeDisplay("ghost")
+	if unknown.Type != "ghost" || unknown.Icon != app.DefaultEntityIcon || unknown.Plugin != "" {
+		t.Errorf("unexpected fallback display: %+v", unknown)
+	}
+}
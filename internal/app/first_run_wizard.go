@@ -0,0 +1,142 @@
+package app
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// firstRunSteps is the number of steps of the first-run wizard
+const firstRunSteps = 3
+
+// FirstRunWizard walks a new user through creating the roadmap, a first track and
+// a first iteration. Like StarterTemplateSeeder it creates them through the
+// task-manager plugin's commands, so the framework never touches the plugin's storage.
+type FirstRunWizard struct {
+	executor CommandExecutor
+	scanner  *bufio.Scanner
+	out      io.Writer
+	eof      bool
+}
+
+// NewFirstRunWizard creates a wizard reading answers from in and prompting on out
+func NewFirstRunWizard(executor CommandExecutor, in io.Reader, out io.Writer) *FirstRunWizard {
+	return &FirstRunWizard{executor: executor, scanner: bufio.NewScanner(in), out: out}
+}
+
+// NeedsFirstRun reports whether the project has no roadmap yet. Failing to
+// check is an error, not a first run.
+func (w *FirstRunWizard) NeedsFirstRun(ctx context.Context, cmdCtx pluginsdk.CommandContext) (bool, error) {
+	exists, err := roadmapExists(ctx, w.executor, cmdCtx)
+	if err != nil {
+		return false, err
+	}
+	return !exists, nil
+}
+
+// Run prompts for each step and creates what was answered. An empty answer skips
+// the current step and end of input skips the rest. An existing roadmap is never
+// replaced: its step is skipped. Plugin command output goes to cmdCtx; created
+// reports whether anything was created.
+func (w *FirstRunWizard) Run(ctx context.Context, cmdCtx pluginsdk.CommandContext) (created bool, err error) {
+	fmt.Fprintln(w.out, "Let's set up your project.")
+	fmt.Fprintln(w.out, "Press Enter on an empty answer to skip a step, or Ctrl+D to skip the rest.")
+
+	hasRoadmap, err := roadmapExists(ctx, w.executor, cmdCtx)
+	if err != nil {
+		return false, err
+	}
+	w.step(1, "Roadmap")
+	if hasRoadmap {
+		fmt.Fprintln(w.out, "  Roadmap already exists, skipped")
+	} else if vision := w.ask("Vision (what are you building, and why?)"); vision != "" {
+		criteria := w.ask("Success criteria (how will you know it worked?)")
+		if criteria == "" {
+			fmt.Fprintln(w.out, "  Success criteria are required, roadmap skipped")
+		} else {
+			if err := w.execute(ctx, cmdCtx, "roadmap init", "--vision", vision, "--success-criteria", criteria); err != nil {
+				return created, err
+			}
+			fmt.Fprintln(w.out, "  ✓ Created roadmap")
+			hasRoadmap, created = true, true
+		}
+	} else {
+		fmt.Fprintln(w.out, "  Skipped")
+	}
+
+	w.step(2, "First track (a major area of work, e.g. \"Backend API\")")
+	if !hasRoadmap {
+		fmt.Fprintln(w.out, "  Tracks belong to a roadmap, skipped")
+	} else if title := w.ask("Title"); title != "" {
+		args := []string{"--title", title}
+		if description := w.ask("Description (optional)"); description != "" {
+			args = append(args, "--description", description)
+		}
+		if err := w.execute(ctx, cmdCtx, "track create", args...); err != nil {
+			return created, err
+		}
+		fmt.Fprintf(w.out, "  ✓ Created track %q\n", title)
+		created = true
+	} else {
+		fmt.Fprintln(w.out, "  Skipped")
+	}
+
+	w.step(3, "First iteration (a time-boxed batch of tasks)")
+	if name := w.ask("Name (e.g. \"Sprint 1\")"); name != "" {
+		goal := w.ask("Goal")
+		deliverable := ""
+		if goal != "" {
+			deliverable = w.ask("Deliverable (what will exist at the end?)")
+		}
+		if goal == "" || deliverable == "" {
+			fmt.Fprintln(w.out, "  A goal and a deliverable are required, iteration skipped")
+		} else {
+			if err := w.execute(ctx, cmdCtx, "iteration create", "--name", name, "--goal", goal, "--deliverable", deliverable); err != nil {
+				return created, err
+			}
+			fmt.Fprintf(w.out, "  ✓ Created iteration %q\n", name)
+			created = true
+		}
+	} else {
+		fmt.Fprintln(w.out, "  Skipped")
+	}
+
+	fmt.Fprintln(w.out)
+	if created {
+		fmt.Fprintln(w.out, "✓ Project set up. Add tasks with 'dw task-manager task create --track <track-id> --title ...'")
+	} else {
+		fmt.Fprintln(w.out, "Nothing created. Run 'dw init --wizard' to start the wizard again.")
+	}
+	return created, nil
+}
+
+// step prints the heading of a wizard step
+func (w *FirstRunWizard) step(n int, title string) {
+	fmt.Fprintf(w.out, "\nStep %d/%d: %s\n", n, firstRunSteps, title)
+}
+
+// ask prompts for one answer. It returns "" once input has ended, so every
+// remaining step is skipped.
+func (w *FirstRunWizard) ask(label string) string {
+	if w.eof {
+		return ""
+	}
+	fmt.Fprintf(w.out, "  %s: ", label)
+	if !w.scanner.Scan() {
+		w.eof = true
+		fmt.Fprintln(w.out)
+		return ""
+	}
+	return strings.TrimSpace(w.scanner.Text())
+}
+
+func (w *FirstRunWizard) execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, command string, args ...string) error {
+	if err := w.executor.ExecuteCommand(ctx, starterTemplatePlugin, command, args, cmdCtx); err != nil {
+		return fmt.Errorf("first-run wizard failed (%s %s): %w", starterTemplatePlugin, command, err)
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+package app_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/kgatilin/darwinflow-pub/internal/app"
+)
+
+func TestFirstRunWizard_Run(t *testing.T) {
+	tests := []struct {
+		name          string
+		roadmapExists bool
+		input         string
+		wantCreated   bool
+		wantCommands  []string
+		wantArgs      [][]string
+		wantOutput    string
+	}{
+		{
+			name:         "all steps",
+			input:        "Ship it\nUsers are happy\nBackend\nAPI and storage\nSprint 1\nWalking skeleton\nDemo build\n",
+			wantCreated:  true,
+			wantCommands: []string{"task-manager roadmap init", "task-manager track create", "task-manager iteration create"},
+			wantArgs: [][]string{
+				{"--vision", "Ship it", "--success-criteria", "Users are happy"},
+				{"--title", "Backend", "--description", "API and storage"},
+				{"--name", "Sprint 1", "--goal", "Walking skeleton", "--deliverable", "Demo build"},
+			},
+			wantOutput: "✓ Project set up",
+		},
+		{
+			name:         "skip track and optional description",
+			input:        "Ship it\nUsers are happy\n\nSprint 1\nWalking skeleton\nDemo build\n",
+			wantCreated:  true,
+			wantCommands: []string{"task-manager roadmap init", "task-manager iteration create"},
+			wantArgs: [][]string{
+				{"--vision", "Ship it", "--success-criteria", "Users are happy"},
+				{"--name", "Sprint 1", "--goal", "Walking skeleton", "--deliverable", "Demo build"},
+			},
+		},
+		{
+			name:         "skipped roadmap skips the track",
+			input:        "\nSprint 1\nWalking skeleton\nDemo build\n",
+			wantCreated:  true,
+			wantCommands: []string{"task-manager iteration create"},
+			wantArgs:     [][]string{{"--name", "Sprint 1", "--goal", "Walking skeleton", "--deliverable", "Demo build"}},
+			wantOutput:   "Tracks belong to a roadmap, skipped",
+		},
+		{
+			name:          "existing roadmap is kept",
+			roadmapExists: true,
+			input:         "Backend\n\n",
+			wantCreated:   true,
+			wantCommands:  []string{"task-manager track create"},
+			wantArgs:      [][]string{{"--title", "Backend"}},
+			wantOutput:    "Roadmap already exists, skipped",
+		},
+		{
+			name:       "end of input skips the rest",
+			input:      "Ship it\n",
+			wantOutput: "Nothing created",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			executor := &recordingExecutor{roadmapExists: tt.roadmapExists}
+			var out bytes.Buffer
+
+			wizard := app.NewFirstRunWizard(executor, strings.NewReader(tt.input), &out)
+			created, err := wizard.Run(context.Background(), nil)
+			if err != nil {
+				t.Fatalf("Run: %v", err)
+			}
+
+			if created != tt.wantCreated {
+				t.Errorf("created = %v, want %v", created, tt.wantCreated)
+			}
+			if !reflect.DeepEqual(executor.commands, tt.wantCommands) {
+				t.Errorf("commands = %v, want %v", executor.commands, tt.wantCommands)
+			}
+			if tt.wantArgs != nil && !reflect.DeepEqual(executor.args, tt.wantArgs) {
+				t.Errorf("args = %v, want %v", executor.args, tt.wantArgs)
+			}
+			if !strings.Contains(out.String(), tt.wantOutput) {
+				t.Errorf("expected output to contain %q, got:\n%s", tt.wantOutput, out.String())
+			}
+		})
+	}
+}
+
+func TestFirstRunWizard_CommandFailure(t *testing.T) {
+	executor := &recordingExecutor{failOn: "track create"}
+	var out bytes.Buffer
+
+	wizard := app.NewFirstRunWizard(executor, strings.NewReader("Ship it\nUsers are happy\nBackend\n\n"), &out)
+	created, err := wizard.Run(context.Background(), nil)
+
+	if err == nil || !strings.Contains(err.Error(), "track create") {
+		t.Fatalf("expected the failing command in the error, got %v", err)
+	}
+	if !created {
+		t.Error("expected created to report the roadmap created before the failure")
+	}
+}
+
+func TestFirstRunWizard_NeedsFirstRun(t *testing.T) {
+	var stdout bytes.Buffer
+	cmdCtx := app.NewCommandContext(&mockPluginContextLogger{}, "/test/db", "/test/dir", &mockEventRepo{}, &stdout, strings.NewReader(""))
+
+	wizard := app.NewFirstRunWizard(&recordingExecutor{}, strings.NewReader(""), &bytes.Buffer{})
+	needsFirstRun, err := wizard.NeedsFirstRun(context.Background(), cmdCtx)
+	if err != nil || !needsFirstRun {
+		t.Errorf("expected a project without a roadmap to need the first run, got %v, %v", needsFirstRun, err)
+	}
+
+	wizard = app.NewFirstRunWizard(&recordingExecutor{roadmapExists: true}, strings.NewReader(""), &bytes.Buffer{})
+	needsFirstRun, err = wizard.NeedsFirstRun(context.Background(), cmdCtx)
+	if err != nil || needsFirstRun {
+		t.Errorf("expected a project with a roadmap not to need the first run, got %v, %v", needsFirstRun, err)
+	}
+	if stdout.Len() != 0 {
+		t.Errorf("expected the roadmap check to print nothing, got %q", stdout.String())
+	}
+}
+
+func TestFirstRunWizard_NeedsFirstRunError(t *testing.T) {
+	executor := &recordingExecutor{showErr: errors.New("database is locked")}
+	wizard := app.NewFirstRunWizard(executor, strings.NewReader(""), &bytes.Buffer{})
+
+	needsFirstRun, err := wizard.NeedsFirstRun(context.Background(), nil)
+	if err == nil || !strings.Contains(err.Error(), "database is locked") {
+		t.Fatalf("expected the check failure to be returned, got %v", err)
+	}
+	if needsFirstRun {
+		t.Error("expected a failed check not to report a first run")
+	}
+
+	if _, err := wizard.Run(context.Background(), nil); err == nil {
+		t.Error("expected Run to fail when the roadmap check fails")
+	}
+	if len(executor.commands) != 0 {
+		t.Errorf("expected nothing to be created, got %v", executor.commands)
+	}
+}
@@ -7,10 +7,14 @@ import (
 	"fmt"
 	"io"
 	"sort"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/kgatilin/darwinflow-pub/internal/domain"
 	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+	"github.com/muesli/reflow/wordwrap"
+	"github.com/muesli/reflow/wrap"
 )
 
 // LogRecord represents a formatted log entry for display
@@ -21,6 +25,31 @@ type LogRecord struct {
 	SessionID string
 	Payload   json.RawMessage
 	Content   string
+
+	WorkingDir string
+	GitBranch  string
+	GitCommit  string
+
+	Labels []string // Labels attached with 'dw logs annotate', sorted
+}
+
+// LogFilter narrows listed logs to a session, a kind of event (see domain.EventKind)
+// and/or labeled events
+type LogFilter struct {
+	SessionID string
+	Source    string   // Event type source, e.g. "claude"
+	Category  string   // Event type category, e.g. "tool"
+	Labels    []string // Events with any of these labels
+}
+
+// apply adds the filter's criteria to query
+func (f LogFilter) apply(query *pluginsdk.EventQuery) {
+	if f.SessionID != "" {
+		query.Metadata = map[string]string{"session_id": f.SessionID}
+	}
+	query.EventSource = f.Source
+	query.EventCategory = f.Category
+	query.Labels = f.Labels
 }
 
 // LogsService provides methods for querying and displaying logs
@@ -37,12 +66,88 @@ func NewLogsService(repo domain.EventRepository, rawExecutor pluginsdk.RawQueryE
 	}
 }
 
-// ListRecentLogs retrieves the most recent N logs, optionally filtered by session ID and ordered chronologically
-// If sessionLimit > 0, limits by number of sessions instead of number of events
-func (s *LogsService) ListRecentLogs(ctx context.Context, limit int, sessionLimit int, sessionID string, ordered bool) ([]*LogRecord, error) {
+// ListRecentLogs retrieves the most recent N logs matching filter, optionally ordered chronologically
+// If sessionLimit > 0, limits by number of sessions instead of number of events: the N most
+// recent sessions with a log matching filter
+func (s *LogsService) ListRecentLogs(ctx context.Context, limit int, sessionLimit int, filter LogFilter, ordered bool) ([]*LogRecord, error) {
 	// If sessionLimit is specified, we need to first find the N most recent sessions
 	// and then fetch all events for those sessions
-	if sessionLimit > 0 && sessionID == "" {
+	if sessionLimit > 0 && filter.SessionID == "" {
+		sessionIDs, err := s.recentSessionIDs(ctx, sessionLimit, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(sessionIDs) == 0 {
+			return []*LogRecord{}, nil
+		}
+
+		// Fetch all events for these sessions
+		return s.fetchEventsForSessions(ctx, sessionIDs, filter, ordered)
+	}
+
+	// Original behavior: limit by number of events
+	query := pluginsdk.EventQuery{
+		Limit:       limit,
+		OrderByTime: ordered,
+	}
+	filter.apply(&query)
+
+	events, err := s.repo.FindByQuery(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query logs: %w", err)
+	}
+
+	return s.convertEventsToRecords(ctx, events)
+}
+
+// logsStreamPageSize is the number of events fetched per page by StreamLogs
+const logsStreamPageSize = 500
+
+// StreamLogs passes every log matching filter to fn, one page at a time,
+// so unlimited listings don't hold the whole table in memory. The end time is pinned when
+// streaming starts so events logged meanwhile don't shift later pages.
+// Returns the number of records streamed.
+func (s *LogsService) StreamLogs(ctx context.Context, filter LogFilter, ordered bool, fn func(*LogRecord) error) (int, error) {
+	endTime := time.Now()
+	query := pluginsdk.EventQuery{
+		EndTime:     &endTime,
+		OrderByTime: ordered,
+		Limit:       logsStreamPageSize,
+	}
+	filter.apply(&query)
+
+	count := 0
+	for {
+		events, err := s.repo.FindByQuery(ctx, query)
+		if err != nil {
+			return count, fmt.Errorf("failed to query logs: %w", err)
+		}
+
+		records, err := s.convertEventsToRecords(ctx, events)
+		if err != nil {
+			return count, err
+		}
+		for _, record := range records {
+			if err := fn(record); err != nil {
+				return count, err
+			}
+			count++
+		}
+
+		if len(events) < logsStreamPageSize {
+			return count, nil
+		}
+		query.Offset += len(events)
+	}
+}
+
+// recentSessionIDs returns the IDs of the n sessions with the most recent events matching
+// filter, newest first. Without kind or label criteria one grouped query finds them;
+// otherwise matching events are scanned newest first until n sessions are seen, so
+// sessions without a matching event don't count towards the limit.
+func (s *LogsService) recentSessionIDs(ctx context.Context, n int, filter LogFilter) ([]string, error) {
+	if filter.Source == "" && filter.Category == "" && len(filter.Labels) == 0 {
 		// Use raw query executor to find recent session IDs
 		sessionQuery := fmt.Sprintf(`
 			SELECT session_id
@@ -51,7 +156,7 @@ func (s *LogsService) ListRecentLogs(ctx context.Context, limit int, sessionLimi
 			GROUP BY session_id
 			ORDER BY MAX(timestamp) DESC
 			LIMIT %d
-		`, sessionLimit)
+		`, n)
 
 		result, err := s.rawExecutor.ExecuteRawQuery(ctx, sessionQuery)
 		if err != nil {
@@ -67,51 +172,59 @@ func (s *LogsService) ListRecentLogs(ctx context.Context, limit int, sessionLimi
 				}
 			}
 		}
-
-		if len(sessionIDs) == 0 {
-			return []*LogRecord{}, nil
-		}
-
-		// Fetch all events for these sessions
-		return s.fetchEventsForSessions(ctx, sessionIDs, ordered)
+		return sessionIDs, nil
 	}
 
-	// Original behavior: limit by number of events
+	endTime := time.Now()
 	query := pluginsdk.EventQuery{
-		Limit:       limit,
-		OrderByTime: ordered,
+		EndTime: &endTime,
+		Limit:   logsStreamPageSize,
 	}
+	filter.apply(&query)
 
-	// Map sessionID to Metadata if provided
-	if sessionID != "" {
-		query.Metadata = map[string]string{"session_id": sessionID}
-	}
+	seen := make(map[string]bool)
+	var sessionIDs []string
+	for {
+		events, err := s.repo.FindByQuery(ctx, query)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get recent sessions: %w", err)
+		}
+		for _, event := range events {
+			if event.SessionID == "" || seen[event.SessionID] {
+				continue
+			}
+			seen[event.SessionID] = true
+			sessionIDs = append(sessionIDs, event.SessionID)
+			if len(sessionIDs) == n {
+				return sessionIDs, nil
+			}
+		}
 
-	events, err := s.repo.FindByQuery(ctx, query)
-	if err != nil {
-		return nil, fmt.Errorf("failed to query logs: %w", err)
+		if len(events) < logsStreamPageSize {
+			return sessionIDs, nil
+		}
+		query.Offset += len(events)
 	}
-
-	return s.convertEventsToRecords(events)
 }
 
-// fetchEventsForSessions fetches all events for the given session IDs
-func (s *LogsService) fetchEventsForSessions(ctx context.Context, sessionIDs []string, ordered bool) ([]*LogRecord, error) {
+// fetchEventsForSessions fetches all events for the given session IDs that match filter's event kind
+func (s *LogsService) fetchEventsForSessions(ctx context.Context, sessionIDs []string, filter LogFilter, ordered bool) ([]*LogRecord, error) {
 	allRecords := make([]*LogRecord, 0)
 
 	for _, sessionID := range sessionIDs {
 		query := pluginsdk.EventQuery{
 			OrderByTime: ordered,
 			Limit:       0, // No limit for individual sessions
-			Metadata:    map[string]string{"session_id": sessionID},
 		}
+		filter.SessionID = sessionID
+		filter.apply(&query)
 
 		events, err := s.repo.FindByQuery(ctx, query)
 		if err != nil {
 			return nil, fmt.Errorf("failed to query logs for session %s: %w", sessionID, err)
 		}
 
-		records, err := s.convertEventsToRecords(events)
+		records, err := s.convertEventsToRecords(ctx, events)
 		if err != nil {
 			return nil, err
 		}
@@ -133,8 +246,21 @@ func (s *LogsService) fetchEventsForSessions(ctx context.Context, sessionIDs []s
 	return allRecords, nil
 }
 
-// convertEventsToRecords converts domain events to log records
-func (s *LogsService) convertEventsToRecords(events []*domain.Event) ([]*LogRecord, error) {
+// convertEventsToRecords converts domain events to log records, with their labels when
+// the repository keeps labels
+func (s *LogsService) convertEventsToRecords(ctx context.Context, events []*domain.Event) ([]*LogRecord, error) {
+	var labels map[string][]string
+	if labeler, ok := s.repo.(domain.EventLabeler); ok && len(events) > 0 {
+		ids := make([]string, len(events))
+		for i, event := range events {
+			ids[i] = event.ID
+		}
+		var err error
+		if labels, err = labeler.EventLabels(ctx, ids); err != nil {
+			return nil, fmt.Errorf("failed to load event labels: %w", err)
+		}
+	}
+
 	records := make([]*LogRecord, len(events))
 	for i, event := range events {
 		payloadBytes, err := event.MarshalPayload()
@@ -149,6 +275,12 @@ func (s *LogsService) convertEventsToRecords(events []*domain.Event) ([]*LogReco
 			SessionID: event.SessionID,
 			Payload:   payloadBytes,
 			Content:   event.Content,
+
+			WorkingDir: event.WorkingDir,
+			GitBranch:  event.GitBranch,
+			GitCommit:  event.GitCommit,
+
+			Labels: labels[event.ID],
 		}
 	}
 
@@ -160,16 +292,50 @@ func (s *LogsService) ExecuteRawQuery(ctx context.Context, query string) (*plugi
 	return s.rawExecutor.ExecuteRawQuery(ctx, query)
 }
 
-// FormatLogRecord formats a single log record for display
+// LogContentOptions controls how FormatLogRecordWithContent shows event content
+type LogContentOptions struct {
+	// Width truncates content after this many characters (0 = never truncate)
+	Width int
+
+	// WrapWidth, if set, wraps content to this many terminal columns instead of
+	// truncating it
+	WrapWidth int
+}
+
+// DefaultLogContentOptions truncates content at domain.DefaultLogsContentWidth characters
+func DefaultLogContentOptions() LogContentOptions {
+	return LogContentOptions{Width: domain.DefaultLogsContentWidth}
+}
+
+// logContentIndent prefixes every content line after the first
+const logContentIndent = "             "
+
+// FormatLogRecord formats a single log record for display, truncating long content
 func FormatLogRecord(index int, record *LogRecord) string {
+	return FormatLogRecordWithContent(index, record, DefaultLogContentOptions())
+}
+
+// FormatLogRecordWithContent formats a single log record for display, showing its
+// content as content says
+func FormatLogRecordWithContent(index int, record *LogRecord, content LogContentOptions) string {
 	var output string
 
 	output += fmt.Sprintf("[%d] %s\n", index+1, record.Timestamp.Format("2006-01-02 15:04:05.000"))
-	output += fmt.Sprintf("    Event: %s\n", record.EventType)
+	if len(record.Labels) > 0 {
+		output += fmt.Sprintf("    Event: %s [%s]\n", record.EventType, strings.Join(record.Labels, ", "))
+	} else {
+		output += fmt.Sprintf("    Event: %s\n", record.EventType)
+	}
 	output += fmt.Sprintf("    ID: %s\n", record.ID)
 	if record.SessionID != "" {
 		output += fmt.Sprintf("    Session: %s\n", record.SessionID)
 	}
+	if record.WorkingDir != "" {
+		output += fmt.Sprintf("    Dir: %s\n", record.WorkingDir)
+	}
+	if git := formatGitRef(record.GitBranch, record.GitCommit); git != "" {
+		output += fmt.Sprintf("    Git: %s\n", git)
+	}
 
 	// Pretty print JSON payload with nested JSON expansion
 	var payload interface{}
@@ -183,18 +349,58 @@ func FormatLogRecord(index int, record *LogRecord) string {
 	}
 
 	if record.Content != "" {
-		// Truncate content if too long
-		content := record.Content
-		if len(content) > 200 {
-			content = content[:200] + "..."
-		}
-		output += fmt.Sprintf("    Content: %s\n", content)
+		output += fmt.Sprintf("    Content: %s\n", formatLogContent(record.Content, content))
 	}
 
 	output += "\n"
 	return output
 }
 
+// formatLogContent truncates or wraps content and indents its continuation lines
+// under the "Content:" label. Lengths count characters, not bytes, so multibyte
+// content is never cut inside a character.
+func formatLogContent(content string, opts LogContentOptions) string {
+	content = strings.TrimRight(content, "\n")
+
+	if opts.WrapWidth > 0 {
+		width := opts.WrapWidth - len(logContentIndent)
+		if width < 20 {
+			width = 20
+		}
+		content = wrap.String(wordwrap.String(content, width), width)
+	} else if opts.Width > 0 {
+		content = truncateLogContent(content, opts.Width)
+	}
+
+	return strings.ReplaceAll(content, "\n", "\n"+logContentIndent)
+}
+
+// truncateLogContent shortens content to width characters, ending it with an
+// ellipsis and the full length when anything was cut
+func truncateLogContent(content string, width int) string {
+	total := utf8.RuneCountInString(content)
+	if total <= width {
+		return content
+	}
+	runes := []rune(content)
+	return fmt.Sprintf("%s… (%d chars, --full to show all)", strings.TrimRight(string(runes[:width]), " \n"), total)
+}
+
+// formatGitRef renders branch and commit as "branch@shortsha", omitting unknown parts
+func formatGitRef(branch, commit string) string {
+	if len(commit) > 7 {
+		commit = commit[:7]
+	}
+	switch {
+	case branch != "" && commit != "":
+		return branch + "@" + commit
+	case commit != "":
+		return commit
+	default:
+		return branch
+	}
+}
+
 // expandNestedJSON recursively expands JSON strings within a data structure
 func expandNestedJSON(data interface{}) interface{} {
 	switch v := data.(type) {
@@ -270,35 +476,45 @@ func FormatQueryValue(val interface{}) string {
 	}
 }
 
+// csvLogHeader is the header row written by FormatLogsAsCSV
+var csvLogHeader = []string{"ID", "Timestamp", "EventType", "SessionID", "Payload", "Content"}
+
 // FormatLogsAsCSV writes log records as CSV to the provided writer
 func FormatLogsAsCSV(w io.Writer, records []*LogRecord) error {
 	csvWriter := csv.NewWriter(w)
 	defer csvWriter.Flush()
 
 	// Write header
-	header := []string{"ID", "Timestamp", "EventType", "SessionID", "Payload", "Content"}
-	if err := csvWriter.Write(header); err != nil {
+	if err := csvWriter.Write(csvLogHeader); err != nil {
 		return fmt.Errorf("failed to write CSV header: %w", err)
 	}
 
 	// Write records
 	for _, record := range records {
-		row := []string{
-			record.ID,
-			record.Timestamp.Format(time.RFC3339),
-			record.EventType,
-			record.SessionID,
-			string(record.Payload),
-			record.Content,
-		}
-		if err := csvWriter.Write(row); err != nil {
-			return fmt.Errorf("failed to write CSV row: %w", err)
+		if err := writeCSVLogRecord(csvWriter, record); err != nil {
+			return err
 		}
 	}
 
 	return nil
 }
 
+// writeCSVLogRecord writes a single log record as a CSV row
+func writeCSVLogRecord(csvWriter *csv.Writer, record *LogRecord) error {
+	row := []string{
+		record.ID,
+		record.Timestamp.Format(time.RFC3339),
+		record.EventType,
+		record.SessionID,
+		string(record.Payload),
+		record.Content,
+	}
+	if err := csvWriter.Write(row); err != nil {
+		return fmt.Errorf("failed to write CSV row: %w", err)
+	}
+	return nil
+}
+
 // FormatLogsAsMarkdown writes log records as Markdown to the provided writer
 // Groups events by session and orders chronologically for LLM-friendly reading
 func FormatLogsAsMarkdown(w io.Writer, records []*LogRecord) error {
@@ -0,0 +1,87 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/kgatilin/darwinflow-pub/internal/domain"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// LogAnnotateOptions selects the event 'dw logs annotate' labels and the labels it
+// adds or removes
+type LogAnnotateOptions struct {
+	EventID string
+	Labels  []string // Free-form labels, normalized with domain.NormalizeEventLabels
+	Remove  bool     // Remove Labels instead of adding them
+}
+
+// LogAnnotateHandler tags events with labels and lists the labels in use
+type LogAnnotateHandler struct {
+	repo domain.EventLabeler
+}
+
+// NewLogAnnotateHandler creates a new log annotate handler
+func NewLogAnnotateHandler(repo domain.EventLabeler) *LogAnnotateHandler {
+	return &LogAnnotateHandler{repo: repo}
+}
+
+// Annotate adds opts.Labels to the event, or removes them with opts.Remove, and
+// reports the labels the event has afterwards to out
+func (h *LogAnnotateHandler) Annotate(ctx context.Context, opts LogAnnotateOptions, out io.Writer) ([]string, error) {
+	labels, err := domain.NormalizeEventLabels(opts.Labels)
+	if err != nil {
+		return nil, err
+	}
+	if len(labels) == 0 {
+		return nil, fmt.Errorf("%w: at least one label is required", pluginsdk.ErrInvalidArgument)
+	}
+
+	if opts.Remove {
+		err = h.repo.RemoveEventLabels(ctx, opts.EventID, labels)
+	} else {
+		err = h.repo.AddEventLabels(ctx, opts.EventID, labels)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to annotate event: %w", err)
+	}
+
+	current, err := h.repo.EventLabels(ctx, []string{opts.EventID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load event labels: %w", err)
+	}
+	eventLabels := current[opts.EventID]
+	if len(eventLabels) == 0 {
+		fmt.Fprintf(out, "Event %s has no labels.\n", opts.EventID)
+	} else {
+		fmt.Fprintf(out, "Event %s labels: %s\n", opts.EventID, strings.Join(eventLabels, ", "))
+	}
+	return eventLabels, nil
+}
+
+// ListLabels writes every label with the number of events it tags to out, by label
+func (h *LogAnnotateHandler) ListLabels(ctx context.Context, out io.Writer) ([]domain.EventLabelCount, error) {
+	counts, err := h.repo.CountEventLabels(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list labels: %w", err)
+	}
+
+	if len(counts) == 0 {
+		fmt.Fprintln(out, "No labeled events. Add labels with 'dw logs annotate <event-id> --label NAME'.")
+		return counts, nil
+	}
+
+	width := len("LABEL")
+	for _, count := range counts {
+		if len(count.Label) > width {
+			width = len(count.Label)
+		}
+	}
+	fmt.Fprintf(out, "%-*s  %s\n", width, "LABEL", "EVENTS")
+	for _, count := range counts {
+		fmt.Fprintf(out, "%-*s  %d\n", width, count.Label, count.Count)
+	}
+	return counts, nil
+}
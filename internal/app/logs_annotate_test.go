@@ -0,0 +1,116 @@
+package app_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/kgatilin/darwinflow-pub/internal/app"
+	"github.com/kgatilin/darwinflow-pub/internal/domain"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// fakeLabeler keeps labels in memory for the events it knows
+type fakeLabeler struct {
+	labels map[string][]string
+	counts []domain.EventLabelCount
+}
+
+func (f *fakeLabeler) AddEventLabels(ctx context.Context, eventID string, labels []string) error {
+	current, ok := f.labels[eventID]
+	if !ok {
+		return pluginsdk.ErrNotFound
+	}
+	f.labels[eventID] = append(current, labels...)
+	return nil
+}
+
+func (f *fakeLabeler) RemoveEventLabels(ctx context.Context, eventID string, labels []string) error {
+	current, ok := f.labels[eventID]
+	if !ok {
+		return pluginsdk.ErrNotFound
+	}
+	remove := make(map[string]bool, len(labels))
+	for _, label := range labels {
+		remove[label] = true
+	}
+	kept := []string{}
+	for _, label := range current {
+		if !remove[label] {
+			kept = append(kept, label)
+		}
+	}
+	f.labels[eventID] = kept
+	return nil
+}
+
+func (f *fakeLabeler) EventLabels(ctx context.Context, eventIDs []string) (map[string][]string, error) {
+	result := make(map[string][]string)
+	for _, id := range eventIDs {
+		if len(f.labels[id]) > 0 {
+			result[id] = f.labels[id]
+		}
+	}
+	return result, nil
+}
+
+func (f *fakeLabeler) CountEventLabels(ctx context.Context) ([]domain.EventLabelCount, error) {
+	return f.counts, nil
+}
+
+func TestLogAnnotateHandler_Annotate(t *testing.T) {
+	repo := &fakeLabeler{labels: map[string][]string{"evt-1": {}}}
+	handler := app.NewLogAnnotateHandler(repo)
+	ctx := context.Background()
+	var out bytes.Buffer
+
+	labels, err := handler.Annotate(ctx, app.LogAnnotateOptions{EventID: "evt-1", Labels: []string{"Slow", "bug", "slow"}}, &out)
+	if err != nil {
+		t.Fatalf("Annotate() failed: %v", err)
+	}
+	if strings.Join(labels, ",") != "bug,slow" {
+		t.Errorf("labels = %v, want normalized [bug slow]", labels)
+	}
+	if !strings.Contains(out.String(), "Event evt-1 labels: bug, slow") {
+		t.Errorf("unexpected output:\n%s", out.String())
+	}
+
+	out.Reset()
+	if _, err := handler.Annotate(ctx, app.LogAnnotateOptions{EventID: "evt-1", Labels: []string{"BUG", "slow"}, Remove: true}, &out); err != nil {
+		t.Fatalf("Annotate(remove) failed: %v", err)
+	}
+	if !strings.Contains(out.String(), "Event evt-1 has no labels.") {
+		t.Errorf("unexpected output:\n%s", out.String())
+	}
+
+	if _, err := handler.Annotate(ctx, app.LogAnnotateOptions{EventID: "evt-missing", Labels: []string{"bug"}}, &out); !errors.Is(err, pluginsdk.ErrNotFound) {
+		t.Errorf("expected ErrNotFound for an unknown event, got %v", err)
+	}
+	if _, err := handler.Annotate(ctx, app.LogAnnotateOptions{EventID: "evt-1"}, &out); !errors.Is(err, pluginsdk.ErrInvalidArgument) {
+		t.Errorf("expected ErrInvalidArgument without labels, got %v", err)
+	}
+}
+
+func TestLogAnnotateHandler_ListLabels(t *testing.T) {
+	repo := &fakeLabeler{counts: []domain.EventLabelCount{{Label: "bug", Count: 3}, {Label: "interesting", Count: 1}}}
+	var out bytes.Buffer
+
+	if _, err := app.NewLogAnnotateHandler(repo).ListLabels(context.Background(), &out); err != nil {
+		t.Fatalf("ListLabels() failed: %v", err)
+	}
+	want := "LABEL        EVENTS\nbug          3\ninteresting  1\n"
+	if out.String() != want {
+		t.Errorf("output = %q, want %q", out.String(), want)
+	}
+
+	out.Reset()
+	repo.counts = nil
+	if _, err := app.NewLogAnnotateHandler(repo).ListLabels(context.Background(), &out); err != nil {
+		t.Fatalf("ListLabels() failed: %v", err)
+	}
+	if !strings.Contains(out.String(), "No labeled events") {
+		t.Errorf("unexpected output for no labels: %q", out.String())
+	}
+}
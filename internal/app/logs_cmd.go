@@ -2,6 +2,7 @@ package app
 
 import (
 	"context"
+	"encoding/csv"
 	"fmt"
 	"io"
 	"strings"
@@ -11,27 +12,50 @@ import (
 
 // LogsServiceInterface defines the interface for logs operations
 type LogsServiceInterface interface {
-	ListRecentLogs(ctx context.Context, limit, sessionLimit int, sessionID string, ordered bool) ([]*LogRecord, error)
+	ListRecentLogs(ctx context.Context, limit, sessionLimit int, filter LogFilter, ordered bool) ([]*LogRecord, error)
 	ExecuteRawQuery(ctx context.Context, query string) (*pluginsdk.QueryResult, error)
+	SearchLogs(ctx context.Context, opts LogSearchOptions) (*LogSearchResult, error)
+	StreamLogs(ctx context.Context, filter LogFilter, ordered bool, fn func(*LogRecord) error) (int, error)
 }
 
 // LogsCommandHandler handles the logs command presentation logic
 type LogsCommandHandler struct {
 	service LogsServiceInterface
 	out     io.Writer
+	errOut  io.Writer
+	content LogContentOptions
 }
 
-// NewLogsCommandHandler creates a new logs command handler
+// NewLogsCommandHandler creates a new logs command handler.
+// Warnings go to out until SetErrorOutput is called.
 func NewLogsCommandHandler(service LogsServiceInterface, out io.Writer) *LogsCommandHandler {
 	return &LogsCommandHandler{
 		service: service,
 		out:     out,
+		errOut:  out,
+		content: DefaultLogContentOptions(),
 	}
 }
 
-// ListLogs displays logs based on the provided options
-func (h *LogsCommandHandler) ListLogs(ctx context.Context, limit, sessionLimit int, sessionID string, ordered bool, format string) error {
-	records, err := h.service.ListRecentLogs(ctx, limit, sessionLimit, sessionID, ordered)
+// SetErrorOutput sets where warnings are written when the output format must stay clean
+func (h *LogsCommandHandler) SetErrorOutput(errOut io.Writer) {
+	h.errOut = errOut
+}
+
+// SetContentOptions sets how event content is truncated or wrapped in text output
+func (h *LogsCommandHandler) SetContentOptions(content LogContentOptions) {
+	h.content = content
+}
+
+// ListLogs displays logs based on the provided options.
+// A limit of 0 (without a session limit) lists every log; text and CSV output is
+// streamed page by page, Markdown needs all records to group them by session.
+func (h *LogsCommandHandler) ListLogs(ctx context.Context, limit, sessionLimit int, filter LogFilter, ordered bool, format string) error {
+	if limit == 0 && sessionLimit == 0 && (format == "text" || format == "" || format == "csv") {
+		return h.streamLogs(ctx, filter, ordered, format)
+	}
+
+	records, err := h.service.ListRecentLogs(ctx, limit, sessionLimit, filter, ordered)
 	if err != nil {
 		return err
 	}
@@ -58,8 +82,8 @@ func (h *LogsCommandHandler) ListLogs(ctx context.Context, limit, sessionLimit i
 	}
 
 	// Display logs in text format
-	if sessionID != "" {
-		fmt.Fprintf(h.out, "Showing %d logs for session %s:\n\n", len(records), sessionID)
+	if filter.SessionID != "" {
+		fmt.Fprintf(h.out, "Showing %d logs for session %s:\n\n", len(records), filter.SessionID)
 	} else if sessionLimit > 0 {
 		fmt.Fprintf(h.out, "Showing %d logs from %d most recent sessions:\n\n", len(records), sessionLimit)
 	} else {
@@ -67,7 +91,111 @@ func (h *LogsCommandHandler) ListLogs(ctx context.Context, limit, sessionLimit i
 	}
 
 	for i, record := range records {
-		fmt.Fprint(h.out, FormatLogRecord(i, record))
+		fmt.Fprint(h.out, FormatLogRecordWithContent(i, record, h.content))
+	}
+
+	return nil
+}
+
+// streamLogs writes every log as it is read instead of loading them all first
+func (h *LogsCommandHandler) streamLogs(ctx context.Context, filter LogFilter, ordered bool, format string) error {
+	var write func(index int, record *LogRecord) error
+	if format == "csv" {
+		csvWriter := csv.NewWriter(h.out)
+		defer csvWriter.Flush()
+		if err := csvWriter.Write(csvLogHeader); err != nil {
+			return fmt.Errorf("failed to write CSV header: %w", err)
+		}
+		write = func(_ int, record *LogRecord) error {
+			return writeCSVLogRecord(csvWriter, record)
+		}
+	} else {
+		write = func(index int, record *LogRecord) error {
+			if index == 0 {
+				if filter.SessionID != "" {
+					fmt.Fprintf(h.out, "Showing all logs for session %s:\n\n", filter.SessionID)
+				} else {
+					fmt.Fprintf(h.out, "Showing all logs:\n\n")
+				}
+			}
+			fmt.Fprint(h.out, FormatLogRecordWithContent(index, record, h.content))
+			return nil
+		}
+	}
+
+	index := 0
+	count, err := h.service.StreamLogs(ctx, filter, ordered, func(record *LogRecord) error {
+		if err := write(index, record); err != nil {
+			return err
+		}
+		index++
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if count == 0 && format != "csv" {
+		fmt.Fprintln(h.out, "No logs found.")
+		fmt.Fprintln(h.out, "Run 'dw init' or a plugin's init command to initialize logging.")
+	}
+	return nil
+}
+
+// SearchLogs displays logs matching the search options.
+// In text format each result shows which field matched.
+func (h *LogsCommandHandler) SearchLogs(ctx context.Context, opts LogSearchOptions, format string) error {
+	if format != "text" && format != "" && format != "csv" && format != "markdown" {
+		return fmt.Errorf("invalid format '%s'. Valid formats: text, csv, markdown", format)
+	}
+
+	result, err := h.service.SearchLogs(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	in := opts.In
+	if in == "" {
+		in = LogSearchInContent
+	}
+	mode := "text"
+	if opts.Regex {
+		mode = "regex"
+	}
+
+	// Keep CSV/Markdown output clean: warnings go to the error output for those formats
+	warnOut := h.out
+	if format == "csv" || format == "markdown" {
+		warnOut = h.errOut
+	}
+	if result.TimedOutRows > 0 {
+		timeout := opts.MatchTimeout
+		if timeout <= 0 {
+			timeout = DefaultLogSearchMatchTimeout
+		}
+		fmt.Fprintf(warnOut, "Warning: skipped %d row(s) where the regex exceeded the %s match timeout\n", result.TimedOutRows, timeout)
+	}
+
+	if len(result.Matches) == 0 {
+		fmt.Fprintf(h.out, "No logs found matching %s %q in %s.\n", mode, opts.Text, in)
+		return nil
+	}
+
+	if format == "csv" || format == "markdown" {
+		records := make([]*LogRecord, len(result.Matches))
+		for i, match := range result.Matches {
+			records[i] = match.Record
+		}
+		if format == "csv" {
+			return FormatLogsAsCSV(h.out, records)
+		}
+		return FormatLogsAsMarkdown(h.out, records)
+	}
+
+	fmt.Fprintf(h.out, "Showing %d logs matching %s %q in %s:\n\n", len(result.Matches), mode, opts.Text, in)
+	for i, match := range result.Matches {
+		fmt.Fprint(h.out, strings.TrimSuffix(FormatLogRecordWithContent(i, match.Record, h.content), "\n"))
+		fmt.Fprintf(h.out, "    Matched in: %s\n\n", strings.Join(match.Fields, ", "))
 	}
 
 	return nil
@@ -6,6 +6,7 @@ import (
 	"strings"
 	"testing"
 	"time"
+	"unicode/utf8"
 
 	"github.com/kgatilin/darwinflow-pub/internal/app"
 	"github.com/kgatilin/darwinflow-pub/internal/domain"
@@ -14,13 +15,15 @@ import (
 
 // mockLogsService is a mock implementation for testing
 type mockLogsService struct {
-	listRecentLogsFunc  func(ctx context.Context, limit, sessionLimit int, sessionID string, ordered bool) ([]*app.LogRecord, error)
+	listRecentLogsFunc  func(ctx context.Context, limit, sessionLimit int, filter app.LogFilter, ordered bool) ([]*app.LogRecord, error)
 	executeRawQueryFunc func(ctx context.Context, query string) (*pluginsdk.QueryResult, error)
+	searchLogsFunc      func(ctx context.Context, opts app.LogSearchOptions) (*app.LogSearchResult, error)
+	streamLogsFunc      func(ctx context.Context, filter app.LogFilter, ordered bool, fn func(*app.LogRecord) error) (int, error)
 }
 
-func (m *mockLogsService) ListRecentLogs(ctx context.Context, limit, sessionLimit int, sessionID string, ordered bool) ([]*app.LogRecord, error) {
+func (m *mockLogsService) ListRecentLogs(ctx context.Context, limit, sessionLimit int, filter app.LogFilter, ordered bool) ([]*app.LogRecord, error) {
 	if m.listRecentLogsFunc != nil {
-		return m.listRecentLogsFunc(ctx, limit, sessionLimit, sessionID, ordered)
+		return m.listRecentLogsFunc(ctx, limit, sessionLimit, filter, ordered)
 	}
 	return []*app.LogRecord{
 		{
@@ -53,13 +56,27 @@ func (m *mockLogsService) ExecuteRawQuery(ctx context.Context, query string) (*p
 	}, nil
 }
 
+func (m *mockLogsService) SearchLogs(ctx context.Context, opts app.LogSearchOptions) (*app.LogSearchResult, error) {
+	if m.searchLogsFunc != nil {
+		return m.searchLogsFunc(ctx, opts)
+	}
+	return &app.LogSearchResult{Matches: []*app.LogSearchMatch{}}, nil
+}
+
+func (m *mockLogsService) StreamLogs(ctx context.Context, filter app.LogFilter, ordered bool, fn func(*app.LogRecord) error) (int, error) {
+	if m.streamLogsFunc != nil {
+		return m.streamLogsFunc(ctx, filter, ordered, fn)
+	}
+	return 0, nil
+}
+
 func TestLogsCommandHandler_ListLogs(t *testing.T) {
 	ctx := context.Background()
 	mockService := &mockLogsService{}
 	out := &bytes.Buffer{}
 	handler := app.NewLogsCommandHandler(mockService, out)
 
-	err := handler.ListLogs(ctx, 20, 0, "", false, "text")
+	err := handler.ListLogs(ctx, 20, 0, app.LogFilter{}, false, "text")
 	if err != nil {
 		t.Fatalf("ListLogs failed: %v", err)
 	}
@@ -79,7 +96,7 @@ func TestLogsCommandHandler_ListLogsWithSessionID(t *testing.T) {
 	out := &bytes.Buffer{}
 	handler := app.NewLogsCommandHandler(mockService, out)
 
-	err := handler.ListLogs(ctx, 20, 0, "session-123", false, "text")
+	err := handler.ListLogs(ctx, 20, 0, app.LogFilter{SessionID: "session-123"}, false, "text")
 	if err != nil {
 		t.Fatalf("ListLogs failed: %v", err)
 	}
@@ -93,14 +110,14 @@ func TestLogsCommandHandler_ListLogsWithSessionID(t *testing.T) {
 func TestLogsCommandHandler_ListLogsNoResults(t *testing.T) {
 	ctx := context.Background()
 	mockService := &mockLogsService{
-		listRecentLogsFunc: func(ctx context.Context, limit, sessionLimit int, sessionID string, ordered bool) ([]*app.LogRecord, error) {
+		listRecentLogsFunc: func(ctx context.Context, limit, sessionLimit int, filter app.LogFilter, ordered bool) ([]*app.LogRecord, error) {
 			return []*app.LogRecord{}, nil
 		},
 	}
 	out := &bytes.Buffer{}
 	handler := app.NewLogsCommandHandler(mockService, out)
 
-	err := handler.ListLogs(ctx, 20, 0, "", false, "text")
+	err := handler.ListLogs(ctx, 20, 0, app.LogFilter{}, false, "text")
 	if err != nil {
 		t.Fatalf("ListLogs failed: %v", err)
 	}
@@ -117,7 +134,7 @@ func TestLogsCommandHandler_ListLogsCSVFormat(t *testing.T) {
 	out := &bytes.Buffer{}
 	handler := app.NewLogsCommandHandler(mockService, out)
 
-	err := handler.ListLogs(ctx, 20, 0, "", false, "csv")
+	err := handler.ListLogs(ctx, 20, 0, app.LogFilter{}, false, "csv")
 	if err != nil {
 		t.Fatalf("ListLogs failed: %v", err)
 	}
@@ -138,7 +155,7 @@ func TestLogsCommandHandler_ListLogsMarkdownFormat(t *testing.T) {
 	out := &bytes.Buffer{}
 	handler := app.NewLogsCommandHandler(mockService, out)
 
-	err := handler.ListLogs(ctx, 20, 0, "", false, "markdown")
+	err := handler.ListLogs(ctx, 20, 0, app.LogFilter{}, false, "markdown")
 	if err != nil {
 		t.Fatalf("ListLogs failed: %v", err)
 	}
@@ -159,7 +176,7 @@ func TestLogsCommandHandler_ListLogsInvalidFormat(t *testing.T) {
 	out := &bytes.Buffer{}
 	handler := app.NewLogsCommandHandler(mockService, out)
 
-	err := handler.ListLogs(ctx, 20, 0, "", false, "invalid")
+	err := handler.ListLogs(ctx, 20, 0, app.LogFilter{}, false, "invalid")
 	if err == nil {
 		t.Error("ListLogs should fail with invalid format")
 	}
@@ -168,6 +185,56 @@ func TestLogsCommandHandler_ListLogsInvalidFormat(t *testing.T) {
 	}
 }
 
+func TestLogsCommandHandler_ListLogsUnlimitedStreams(t *testing.T) {
+	ctx := context.Background()
+	mockService := &mockLogsService{
+		listRecentLogsFunc: func(ctx context.Context, limit, sessionLimit int, filter app.LogFilter, ordered bool) ([]*app.LogRecord, error) {
+			t.Error("unlimited text listing should stream instead of loading all logs")
+			return nil, nil
+		},
+		streamLogsFunc: func(ctx context.Context, filter app.LogFilter, ordered bool, fn func(*app.LogRecord) error) (int, error) {
+			for _, id := range []string{"event-1", "event-2", "event-3"} {
+				if err := fn(&app.LogRecord{ID: id, Timestamp: time.Now(), EventType: "tool.invoked"}); err != nil {
+					return 0, err
+				}
+			}
+			return 3, nil
+		},
+	}
+
+	for _, format := range []string{"text", "csv"} {
+		out := &bytes.Buffer{}
+		handler := app.NewLogsCommandHandler(mockService, out)
+
+		if err := handler.ListLogs(ctx, 0, 0, app.LogFilter{}, false, format); err != nil {
+			t.Fatalf("ListLogs(%s) failed: %v", format, err)
+		}
+
+		output := out.String()
+		if !strings.Contains(output, "event-1") || !strings.Contains(output, "event-3") {
+			t.Errorf("%s output should contain every streamed event, got: %s", format, output)
+		}
+		if format == "text" && !strings.Contains(output, "Showing all logs") {
+			t.Errorf("text output should announce an unlimited listing, got: %s", output)
+		}
+		if format == "csv" && strings.Count(output, "ID,Timestamp") != 1 {
+			t.Errorf("csv output should have exactly one header, got: %s", output)
+		}
+	}
+}
+
+func TestLogsCommandHandler_ListLogsUnlimitedNoResults(t *testing.T) {
+	out := &bytes.Buffer{}
+	handler := app.NewLogsCommandHandler(&mockLogsService{}, out)
+
+	if err := handler.ListLogs(context.Background(), 0, 0, app.LogFilter{}, false, "text"); err != nil {
+		t.Fatalf("ListLogs failed: %v", err)
+	}
+	if !strings.Contains(out.String(), "No logs found") {
+		t.Errorf("Expected no logs message, got: %s", out.String())
+	}
+}
+
 func TestLogsCommandHandler_ExecuteRawQuery(t *testing.T) {
 	ctx := context.Background()
 	mockService := &mockLogsService{}
@@ -194,7 +261,7 @@ func TestLogsCommandHandler_ListLogsWithSessionLimit(t *testing.T) {
 	out := &bytes.Buffer{}
 	handler := app.NewLogsCommandHandler(mockService, out)
 
-	err := handler.ListLogs(ctx, 0, 3, "", false, "text")
+	err := handler.ListLogs(ctx, 0, 3, app.LogFilter{}, false, "text")
 	if err != nil {
 		t.Fatalf("ListLogs failed: %v", err)
 	}
@@ -231,7 +298,7 @@ func TestLogsService_ListRecentLogs(t *testing.T) {
 
 	service := app.NewLogsService(eventRepo, eventRepo)
 
-	records, err := service.ListRecentLogs(ctx, 10, 0, "", false)
+	records, err := service.ListRecentLogs(ctx, 10, 0, app.LogFilter{}, false)
 	if err != nil {
 		t.Fatalf("ListRecentLogs failed: %v", err)
 	}
@@ -257,7 +324,7 @@ func TestLogsService_ListRecentLogs_WithSessionID(t *testing.T) {
 
 	service := app.NewLogsService(eventRepo, eventRepo)
 
-	records, err := service.ListRecentLogs(ctx, 10, 0, "session-123", false)
+	records, err := service.ListRecentLogs(ctx, 10, 0, app.LogFilter{SessionID: "session-123"}, false)
 	if err != nil {
 		t.Fatalf("ListRecentLogs failed: %v", err)
 	}
@@ -290,7 +357,7 @@ func TestLogsService_ListRecentLogs_WithSessionLimit(t *testing.T) {
 
 	service := app.NewLogsService(eventRepo, eventRepo)
 
-	records, err := service.ListRecentLogs(ctx, 0, 2, "", false)
+	records, err := service.ListRecentLogs(ctx, 0, 2, app.LogFilter{}, false)
 	if err != nil {
 		t.Fatalf("ListRecentLogs with session limit failed: %v", err)
 	}
@@ -300,6 +367,88 @@ func TestLogsService_ListRecentLogs_WithSessionLimit(t *testing.T) {
 	}
 }
 
+func TestLogsService_ListRecentLogs_WithEventKind(t *testing.T) {
+	ctx := context.Background()
+
+	eventRepo := &MockEventRepository{
+		queryResult: &pluginsdk.QueryResult{
+			Columns: []string{"session_id"},
+			Rows:    [][]interface{}{{"session-123"}},
+		},
+		events: []*domain.Event{
+			domain.NewEvent("tool.invoked", "session-123", map[string]interface{}{}, "test1"),
+			domain.NewEvent("tool.result", "session-123", map[string]interface{}{}, "test2"),
+			domain.NewEvent("chat.message.user", "session-123", map[string]interface{}{}, "test3"),
+			domain.NewEvent("claude.tool.invoked", "session-123", map[string]interface{}{}, "test4"),
+		},
+	}
+
+	service := app.NewLogsService(eventRepo, eventRepo)
+
+	records, err := service.ListRecentLogs(ctx, 10, 0, app.LogFilter{Category: "tool"}, false)
+	if err != nil {
+		t.Fatalf("ListRecentLogs failed: %v", err)
+	}
+	if len(records) != 3 {
+		t.Errorf("Expected 3 tool records, got %d", len(records))
+	}
+
+	// The kind filter also applies within the sessions of a session limit
+	records, err = service.ListRecentLogs(ctx, 0, 1, app.LogFilter{Source: "claude"}, false)
+	if err != nil {
+		t.Fatalf("ListRecentLogs with session limit failed: %v", err)
+	}
+	if len(records) != 1 || records[0].EventType != "claude.tool.invoked" {
+		t.Errorf("Expected only the claude event, got %d records", len(records))
+	}
+}
+
+func TestLogsService_ListRecentLogs_SessionLimitAfterFilter(t *testing.T) {
+	ctx := context.Background()
+
+	// session-new is the most recent session but has no tool events
+	eventRepo := &MockEventRepository{
+		queryResult: &pluginsdk.QueryResult{
+			Columns: []string{"session_id"},
+			Rows:    [][]interface{}{{"session-new"}},
+		},
+		events: []*domain.Event{
+			domain.NewEvent("claude.chat.message.user", "session-new", map[string]interface{}{}, "Hello"),
+			domain.NewEvent("claude.tool.invoked", "session-old", map[string]interface{}{}, "Read file"),
+			domain.NewEvent("claude.tool.result", "session-old", map[string]interface{}{}, "Contents"),
+		},
+	}
+	service := app.NewLogsService(eventRepo, eventRepo)
+
+	records, err := service.ListRecentLogs(ctx, 0, 1, app.LogFilter{Category: "tool"}, false)
+	if err != nil {
+		t.Fatalf("ListRecentLogs with session limit failed: %v", err)
+	}
+	if len(records) != 2 || records[0].SessionID != "session-old" {
+		t.Errorf("Expected the tool events of session-old, got %d records", len(records))
+	}
+}
+
+func TestLogsService_StreamLogs(t *testing.T) {
+	event1 := domain.NewEvent("claude.tool.invoked", "session-123", map[string]interface{}{}, "Read file")
+	event2 := domain.NewEvent("claude.chat.message.user", "session-456", map[string]interface{}{}, "Hello")
+	eventRepo := &MockEventRepository{events: []*domain.Event{event1, event2}}
+	service := app.NewLogsService(eventRepo, eventRepo)
+
+	var ids []string
+	count, err := service.StreamLogs(context.Background(), app.LogFilter{SessionID: "session-123"}, false, func(record *app.LogRecord) error {
+		ids = append(ids, record.ID)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("StreamLogs failed: %v", err)
+	}
+
+	if count != 1 || len(ids) != 1 || ids[0] != event1.ID {
+		t.Errorf("Expected only %s to be streamed, got count=%d ids=%v", event1.ID, count, ids)
+	}
+}
+
 func TestLogsService_ExecuteRawQuery(t *testing.T) {
 	ctx := context.Background()
 
@@ -519,11 +668,71 @@ func TestFormatLogRecord_LongContent(t *testing.T) {
 	output := app.FormatLogRecord(0, record)
 
 	// Content should be truncated
-	if !contains(output, "...") {
-		t.Error("Long content should be truncated with ...")
+	if !contains(output, "… (300 chars, --full to show all)") {
+		t.Error("Long content should be truncated with an ellipsis")
 	}
 }
 
+func TestFormatLogRecordWithContent(t *testing.T) {
+	record := &app.LogRecord{
+		ID:        "event-123",
+		Timestamp: time.Now(),
+		EventType: "test.event",
+		Payload:   []byte(`{}`),
+	}
+	contentLine := func(output string) string {
+		_, content, _ := strings.Cut(output, "    Content: ")
+		return strings.TrimSuffix(content, "\n\n")
+	}
+
+	t.Run("truncation counts characters, not bytes", func(t *testing.T) {
+		record.Content = strings.Repeat("é", 10)
+		got := contentLine(app.FormatLogRecordWithContent(0, record, app.LogContentOptions{Width: 4}))
+		if got != "éééé… (10 chars, --full to show all)" {
+			t.Errorf("content = %q", got)
+		}
+		if !utf8.ValidString(got) {
+			t.Error("truncated content is not valid UTF-8")
+		}
+	})
+
+	t.Run("short content is not marked", func(t *testing.T) {
+		record.Content = "ok"
+		if got := contentLine(app.FormatLogRecordWithContent(0, record, app.LogContentOptions{Width: 2})); got != "ok" {
+			t.Errorf("content = %q, want ok", got)
+		}
+	})
+
+	t.Run("full content keeps every line, indented", func(t *testing.T) {
+		record.Content = strings.Repeat("x", 300) + "\nsecond line\n"
+		got := contentLine(app.FormatLogRecordWithContent(0, record, app.LogContentOptions{}))
+		want := strings.Repeat("x", 300) + "\n             second line"
+		if got != want {
+			t.Errorf("content = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("wrap breaks long lines to the width", func(t *testing.T) {
+		record.Content = strings.Repeat("word ", 30)
+		output := app.FormatLogRecordWithContent(0, record, app.LogContentOptions{WrapWidth: 40})
+		lines := strings.Split(contentLine(output), "\n")
+		if len(lines) < 4 {
+			t.Fatalf("expected the content to be wrapped, got %q", lines)
+		}
+		for i, line := range lines {
+			if i == 0 {
+				line = "    Content: " + line
+			}
+			if utf8.RuneCountInString(line) > 40 {
+				t.Errorf("line %d is wider than 40 columns: %q", i, line)
+			}
+		}
+		if contains(output, "…") {
+			t.Error("wrapped content should not be truncated")
+		}
+	})
+}
+
 func TestFormatLogRecord_WithInvalidJSON(t *testing.T) {
 	record := &app.LogRecord{
 		ID:        "event-123",
@@ -756,3 +965,51 @@ func TestFormatLogsAsMarkdown_WithMetadataField(t *testing.T) {
 		t.Error("Output should contain data field")
 	}
 }
+
+func TestFormatLogRecord_CaptureContext(t *testing.T) {
+	record := &app.LogRecord{
+		ID:         "event-123",
+		Timestamp:  time.Now(),
+		EventType:  "test.event",
+		Payload:    []byte(`{}`),
+		WorkingDir: "/work/repo",
+		GitBranch:  "main",
+		GitCommit:  "0123456789abcdef",
+	}
+
+	output := app.FormatLogRecord(0, record)
+
+	if !contains(output, "Dir: /work/repo") {
+		t.Errorf("Output should contain working dir, got:\n%s", output)
+	}
+	if !contains(output, "Git: main@0123456") {
+		t.Errorf("Output should contain short git ref, got:\n%s", output)
+	}
+
+	record.WorkingDir, record.GitBranch, record.GitCommit = "", "", ""
+	output = app.FormatLogRecord(0, record)
+	if contains(output, "Dir:") || contains(output, "Git:") {
+		t.Errorf("Output should omit empty capture context, got:\n%s", output)
+	}
+}
+
+func TestFormatLogRecord_Labels(t *testing.T) {
+	record := &app.LogRecord{
+		ID:        "event-123",
+		Timestamp: time.Now(),
+		EventType: "test.event",
+		Payload:   []byte(`{}`),
+		Labels:    []string{"bug", "slow"},
+	}
+
+	output := app.FormatLogRecord(0, record)
+	if !contains(output, "Event: test.event [bug, slow]\n") {
+		t.Errorf("Output should show labels in brackets, got:\n%s", output)
+	}
+
+	record.Labels = nil
+	output = app.FormatLogRecord(0, record)
+	if !contains(output, "Event: test.event\n") {
+		t.Errorf("Output should omit brackets without labels, got:\n%s", output)
+	}
+}
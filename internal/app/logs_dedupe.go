@@ -0,0 +1,77 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/kgatilin/darwinflow-pub/internal/domain"
+)
+
+// LogDedupeOptions selects how duplicate events are found and whether they are removed
+type LogDedupeOptions struct {
+	Key    []string // Dedupe key fields (see domain.DedupeKeyFields); empty = domain.DefaultDedupeKey
+	DryRun bool     // List duplicate groups without deleting
+}
+
+// LogDedupeResult summarizes a dedupe run
+type LogDedupeResult struct {
+	Groups  int // Number of duplicate groups found
+	Removed int // Number of events removed (or that would be removed on a dry run)
+}
+
+// LogDedupeHandler removes duplicate events from the event store
+type LogDedupeHandler struct {
+	repo domain.EventDeduplicator
+}
+
+// NewLogDedupeHandler creates a new log dedupe handler
+func NewLogDedupeHandler(repo domain.EventDeduplicator) *LogDedupeHandler {
+	return &LogDedupeHandler{repo: repo}
+}
+
+// Dedupe finds duplicate events and, unless opts.DryRun is set, keeps only the
+// earliest event of each group. Dry runs list every group; both report counts to out.
+func (h *LogDedupeHandler) Dedupe(ctx context.Context, opts LogDedupeOptions, out io.Writer) (*LogDedupeResult, error) {
+	key := opts.Key
+	if len(key) == 0 {
+		key = domain.DefaultDedupeKey
+	}
+
+	var groups []*domain.DuplicateEventGroup
+	var err error
+	if opts.DryRun {
+		groups, err = h.repo.FindDuplicateEvents(ctx, key)
+	} else {
+		groups, err = h.repo.DeleteDuplicateEvents(ctx, key)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to dedupe events: %w", err)
+	}
+
+	result := &LogDedupeResult{Groups: len(groups)}
+	for _, group := range groups {
+		result.Removed += len(group.DuplicateIDs)
+	}
+
+	if len(groups) == 0 {
+		fmt.Fprintf(out, "No duplicate events found (key: %s).\n", strings.Join(key, ","))
+		return result, nil
+	}
+
+	if opts.DryRun {
+		for _, group := range groups {
+			fmt.Fprintf(out, "%s  %s  session %s: keep %s, remove %s\n",
+				group.Timestamp.Format("2006-01-02 15:04:05"), group.EventType, group.SessionID,
+				group.KeepID, strings.Join(group.DuplicateIDs, ", "))
+		}
+		fmt.Fprintf(out, "\nDry run: %d duplicate groups, %d events would be removed (key: %s).\n",
+			result.Groups, result.Removed, strings.Join(key, ","))
+		return result, nil
+	}
+
+	fmt.Fprintf(out, "Removed %d duplicate events from %d groups (key: %s).\n",
+		result.Removed, result.Groups, strings.Join(key, ","))
+	return result, nil
+}
@@ -0,0 +1,106 @@
+package app_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kgatilin/darwinflow-pub/internal/app"
+	"github.com/kgatilin/darwinflow-pub/internal/domain"
+)
+
+// fakeDeduplicator returns fixed groups and records which method was called
+type fakeDeduplicator struct {
+	groups  []*domain.DuplicateEventGroup
+	err     error
+	key     []string
+	deleted bool
+}
+
+func (f *fakeDeduplicator) FindDuplicateEvents(ctx context.Context, key []string) ([]*domain.DuplicateEventGroup, error) {
+	f.key = key
+	return f.groups, f.err
+}
+
+func (f *fakeDeduplicator) DeleteDuplicateEvents(ctx context.Context, key []string) ([]*domain.DuplicateEventGroup, error) {
+	f.key = key
+	f.deleted = true
+	return f.groups, f.err
+}
+
+func testDuplicateGroups() []*domain.DuplicateEventGroup {
+	ts := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	return []*domain.DuplicateEventGroup{
+		{SessionID: "s1", EventType: "tool.invoked", Timestamp: ts, KeepID: "evt-a", DuplicateIDs: []string{"evt-b", "evt-c"}},
+		{SessionID: "s2", EventType: "chat.message.user", Timestamp: ts, KeepID: "evt-d", DuplicateIDs: []string{"evt-e"}},
+	}
+}
+
+func TestLogDedupeHandler_Dedupe(t *testing.T) {
+	repo := &fakeDeduplicator{groups: testDuplicateGroups()}
+	var out bytes.Buffer
+
+	result, err := app.NewLogDedupeHandler(repo).Dedupe(context.Background(), app.LogDedupeOptions{}, &out)
+	if err != nil {
+		t.Fatalf("Dedupe() failed: %v", err)
+	}
+	if !repo.deleted {
+		t.Error("expected duplicates to be deleted")
+	}
+	if strings.Join(repo.key, ",") != "session,type,timestamp,content" {
+		t.Errorf("expected default key, got %v", repo.key)
+	}
+	if result.Groups != 2 || result.Removed != 3 {
+		t.Errorf("result = %+v, want 2 groups and 3 removed", result)
+	}
+	if !strings.Contains(out.String(), "Removed 3 duplicate events from 2 groups") {
+		t.Errorf("unexpected output: %q", out.String())
+	}
+}
+
+func TestLogDedupeHandler_DryRun(t *testing.T) {
+	repo := &fakeDeduplicator{groups: testDuplicateGroups()}
+	var out bytes.Buffer
+
+	result, err := app.NewLogDedupeHandler(repo).Dedupe(context.Background(), app.LogDedupeOptions{
+		Key:    []string{"type", "payload"},
+		DryRun: true,
+	}, &out)
+	if err != nil {
+		t.Fatalf("Dedupe() failed: %v", err)
+	}
+	if repo.deleted {
+		t.Error("dry run must not delete events")
+	}
+	if strings.Join(repo.key, ",") != "type,payload" {
+		t.Errorf("expected configured key, got %v", repo.key)
+	}
+	if result.Removed != 3 {
+		t.Errorf("result.Removed = %d, want 3", result.Removed)
+	}
+	output := out.String()
+	if !strings.Contains(output, "keep evt-a, remove evt-b, evt-c") || !strings.Contains(output, "3 events would be removed") {
+		t.Errorf("expected groups and counts in output, got %q", output)
+	}
+}
+
+func TestLogDedupeHandler_NoDuplicates(t *testing.T) {
+	var out bytes.Buffer
+	result, err := app.NewLogDedupeHandler(&fakeDeduplicator{}).Dedupe(context.Background(), app.LogDedupeOptions{}, &out)
+	if err != nil {
+		t.Fatalf("Dedupe() failed: %v", err)
+	}
+	if result.Groups != 0 || !strings.Contains(out.String(), "No duplicate events found") {
+		t.Errorf("unexpected result %+v, output %q", result, out.String())
+	}
+}
+
+func TestLogDedupeHandler_Error(t *testing.T) {
+	repo := &fakeDeduplicator{err: errors.New("disk full")}
+	if _, err := app.NewLogDedupeHandler(repo).Dedupe(context.Background(), app.LogDedupeOptions{}, &bytes.Buffer{}); err == nil {
+		t.Error("expected error")
+	}
+}
@@ -0,0 +1,65 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/kgatilin/darwinflow-pub/internal/domain"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// LogDeleteSessionOptions selects the session 'dw logs delete-session' removes
+type LogDeleteSessionOptions struct {
+	SessionID string
+	DryRun    bool // Only report what would be deleted
+	Force     bool // Confirms the delete; not needed for a dry run
+}
+
+// LogDeleteSessionHandler removes everything recorded for a session
+type LogDeleteSessionHandler struct {
+	repo domain.SessionDeleter
+}
+
+// NewLogDeleteSessionHandler creates a new log delete-session handler
+func NewLogDeleteSessionHandler(repo domain.SessionDeleter) *LogDeleteSessionHandler {
+	return &LogDeleteSessionHandler{repo: repo}
+}
+
+// Delete removes the session's events, analyses, event links, labels and sampling
+// counters and reports the counts per category to out. Deleting cannot be undone, so
+// it requires opts.Force unless opts.DryRun only reports what would be deleted.
+func (h *LogDeleteSessionHandler) Delete(ctx context.Context, opts LogDeleteSessionOptions, out io.Writer) (*domain.SessionDeleteResult, error) {
+	if opts.SessionID == "" {
+		return nil, fmt.Errorf("%w: session ID is required", pluginsdk.ErrInvalidArgument)
+	}
+	if !opts.DryRun && !opts.Force {
+		return nil, fmt.Errorf("%w: deleting a session cannot be undone; pass --force (or --dry-run to preview)", pluginsdk.ErrInvalidArgument)
+	}
+
+	result, err := h.repo.DeleteSession(ctx, opts.SessionID, opts.DryRun)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete session: %w", err)
+	}
+
+	if opts.DryRun {
+		fmt.Fprintf(out, "Would delete session %s (dry run, nothing deleted):\n", opts.SessionID)
+	} else {
+		fmt.Fprintf(out, "Deleted session %s:\n", opts.SessionID)
+	}
+	counts := []struct {
+		label string
+		count int
+	}{
+		{"Events", result.EventsDeleted},
+		{"Analyses", result.AnalysesDeleted},
+		{"Analysis event links", result.EventLinksDeleted},
+		{"Labels", result.LabelsDeleted},
+		{"Payload index keys", result.PayloadKeysDeleted},
+		{"Sampling counters", result.SampleDropsDeleted},
+	}
+	for _, c := range counts {
+		fmt.Fprintf(out, "  %-22s%d\n", c.label+":", c.count)
+	}
+	return result, nil
+}
@@ -0,0 +1,66 @@
+package app_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/kgatilin/darwinflow-pub/internal/app"
+	"github.com/kgatilin/darwinflow-pub/internal/domain"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// fakeSessionDeleter records the session deletes it is asked for
+type fakeSessionDeleter struct {
+	deleted []string
+	dryRun  bool
+}
+
+func (f *fakeSessionDeleter) DeleteSession(ctx context.Context, sessionID string, dryRun bool) (*domain.SessionDeleteResult, error) {
+	f.deleted = append(f.deleted, sessionID)
+	f.dryRun = dryRun
+	return &domain.SessionDeleteResult{EventsDeleted: 12, AnalysesDeleted: 2, EventLinksDeleted: 10, LabelsDeleted: 1}, nil
+}
+
+func TestLogDeleteSessionHandler_Delete(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("requires force", func(t *testing.T) {
+		repo := &fakeSessionDeleter{}
+		_, err := app.NewLogDeleteSessionHandler(repo).Delete(ctx, app.LogDeleteSessionOptions{SessionID: "session-1"}, &bytes.Buffer{})
+		if !errors.Is(err, pluginsdk.ErrInvalidArgument) || !strings.Contains(err.Error(), "--force") {
+			t.Errorf("expected a --force error, got %v", err)
+		}
+		if len(repo.deleted) != 0 {
+			t.Errorf("nothing should be deleted without --force")
+		}
+	})
+
+	t.Run("dry run reports without force", func(t *testing.T) {
+		repo := &fakeSessionDeleter{}
+		var out bytes.Buffer
+		opts := app.LogDeleteSessionOptions{SessionID: "session-1", DryRun: true}
+		if _, err := app.NewLogDeleteSessionHandler(repo).Delete(ctx, opts, &out); err != nil {
+			t.Fatalf("Delete failed: %v", err)
+		}
+		if !repo.dryRun || !strings.Contains(out.String(), "Would delete session session-1") {
+			t.Errorf("expected a dry run, output:\n%s", out.String())
+		}
+	})
+
+	t.Run("reports counts per category", func(t *testing.T) {
+		repo := &fakeSessionDeleter{}
+		var out bytes.Buffer
+		opts := app.LogDeleteSessionOptions{SessionID: "session-1", Force: true}
+		if _, err := app.NewLogDeleteSessionHandler(repo).Delete(ctx, opts, &out); err != nil {
+			t.Fatalf("Delete failed: %v", err)
+		}
+		for _, want := range []string{"Deleted session session-1:", "Events:               12", "Analysis event links: 10", "Labels:               1"} {
+			if !strings.Contains(out.String(), want) {
+				t.Errorf("output missing %q:\n%s", want, out.String())
+			}
+		}
+	})
+}
@@ -0,0 +1,96 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/kgatilin/darwinflow-pub/internal/domain"
+)
+
+// LogEmitOptions describes an event logged manually, e.g. from a script
+type LogEmitOptions struct {
+	Type      string // Event type (required)
+	SessionID string // Session the event belongs to (required)
+	Payload   string // JSON object; empty means {}
+	Content   string // Searchable content; derived from type and payload when empty
+}
+
+// LogEmitHandler saves manually emitted events to the event store
+type LogEmitHandler struct {
+	eventRepo  domain.EventRepository
+	workingDir string
+	gitInfo    GitInfoProvider
+}
+
+// NewLogEmitHandler creates a new log emit handler. Events are tagged with workingDir
+// and, when gitInfo is non-nil, its git branch and commit, like events emitted by plugins.
+func NewLogEmitHandler(eventRepo domain.EventRepository, workingDir string, gitInfo GitInfoProvider) *LogEmitHandler {
+	return &LogEmitHandler{
+		eventRepo:  eventRepo,
+		workingDir: workingDir,
+		gitInfo:    gitInfo,
+	}
+}
+
+// BuildEvent validates opts and constructs the event to store, with a generated ID,
+// the current time and version 1.0
+func (h *LogEmitHandler) BuildEvent(opts LogEmitOptions) (*domain.Event, error) {
+	eventType := strings.TrimSpace(opts.Type)
+	if eventType == "" {
+		return nil, fmt.Errorf("--type is required")
+	}
+	sessionID := strings.TrimSpace(opts.SessionID)
+	if sessionID == "" {
+		return nil, fmt.Errorf("--session is required")
+	}
+
+	payload := map[string]interface{}{}
+	if strings.TrimSpace(opts.Payload) != "" {
+		decoder := json.NewDecoder(strings.NewReader(opts.Payload))
+		decoder.UseNumber()
+		if err := decoder.Decode(&payload); err != nil {
+			return nil, fmt.Errorf("invalid --payload: must be a JSON object: %w", err)
+		}
+		if decoder.More() {
+			return nil, fmt.Errorf("invalid --payload: unexpected data after the JSON object")
+		}
+	}
+
+	content := opts.Content
+	if content == "" {
+		// Compact payload JSON keeps its values searchable
+		content = eventType
+		if len(payload) > 0 {
+			encoded, err := json.Marshal(payload)
+			if err != nil {
+				return nil, fmt.Errorf("failed to encode payload: %w", err)
+			}
+			content += " " + string(encoded)
+		}
+	}
+
+	event := domain.NewEvent(eventType, sessionID, payload, content)
+	event.WorkingDir = h.workingDir
+	if h.gitInfo != nil && h.workingDir != "" {
+		event.GitBranch, event.GitCommit = h.gitInfo.GitInfo(h.workingDir)
+	}
+	return event, nil
+}
+
+// Emit saves the event described by opts and writes its ID to out
+func (h *LogEmitHandler) Emit(ctx context.Context, opts LogEmitOptions, out io.Writer) (*domain.Event, error) {
+	event, err := h.BuildEvent(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.eventRepo.Save(ctx, event); err != nil {
+		return nil, fmt.Errorf("failed to save event: %w", err)
+	}
+
+	fmt.Fprintf(out, "Logged %s event %s in session %s\n", event.Type, event.ID, event.SessionID)
+	return event, nil
+}
@@ -0,0 +1,101 @@
+package app_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/kgatilin/darwinflow-pub/internal/app"
+)
+
+// staticGitInfo reports a fixed branch and commit for any directory
+type staticGitInfo struct{}
+
+func (staticGitInfo) GitInfo(dir string) (branch, commit string) { return "main", "abc123" }
+
+func TestLogEmitHandler_Emit(t *testing.T) {
+	repo := &MockEventRepository{}
+	handler := app.NewLogEmitHandler(repo, "/work/repo", staticGitInfo{})
+	var out bytes.Buffer
+
+	event, err := handler.Emit(context.Background(), app.LogEmitOptions{
+		Type:      "deploy.started",
+		SessionID: "session-1",
+		Payload:   `{"env": "staging", "attempt": 2}`,
+	}, &out)
+	if err != nil {
+		t.Fatalf("Emit() failed: %v", err)
+	}
+
+	if len(repo.savedEvents) != 1 || repo.savedEvents[0] != event {
+		t.Fatalf("expected the event to be saved, saved %v", repo.savedEvents)
+	}
+	if event.ID == "" || event.Timestamp.IsZero() || event.Version != "1.0" {
+		t.Errorf("expected generated ID, timestamp and version 1.0, got %+v", event)
+	}
+	if event.Type != "deploy.started" || event.SessionID != "session-1" {
+		t.Errorf("unexpected type or session: %+v", event)
+	}
+	if event.WorkingDir != "/work/repo" || event.GitBranch != "main" || event.GitCommit != "abc123" {
+		t.Errorf("expected capture context to be recorded, got %+v", event)
+	}
+
+	encoded, _ := json.Marshal(event.Payload)
+	if string(encoded) != `{"attempt":2,"env":"staging"}` {
+		t.Errorf("payload = %s", encoded)
+	}
+	if !strings.Contains(event.Content, "deploy.started") || !strings.Contains(event.Content, "staging") {
+		t.Errorf("expected derived content to include type and payload, got %q", event.Content)
+	}
+	if !strings.Contains(out.String(), event.ID) {
+		t.Errorf("expected output to include the event ID, got %q", out.String())
+	}
+}
+
+func TestLogEmitHandler_BuildEvent(t *testing.T) {
+	handler := app.NewLogEmitHandler(&MockEventRepository{}, "", nil)
+
+	event, err := handler.BuildEvent(app.LogEmitOptions{Type: "marker", SessionID: "s", Content: "bisect: good"})
+	if err != nil {
+		t.Fatalf("BuildEvent() failed: %v", err)
+	}
+	if event.Content != "bisect: good" {
+		t.Errorf("expected explicit content to be kept, got %q", event.Content)
+	}
+	if payload, ok := event.Payload.(map[string]interface{}); !ok || len(payload) != 0 {
+		t.Errorf("expected empty payload object, got %#v", event.Payload)
+	}
+
+	invalid := []struct {
+		name string
+		opts app.LogEmitOptions
+		want string
+	}{
+		{"missing type", app.LogEmitOptions{SessionID: "s"}, "--type"},
+		{"missing session", app.LogEmitOptions{Type: "marker"}, "--session"},
+		{"malformed payload", app.LogEmitOptions{Type: "marker", SessionID: "s", Payload: `{"a":`}, "--payload"},
+		{"non-object payload", app.LogEmitOptions{Type: "marker", SessionID: "s", Payload: `[1, 2]`}, "--payload"},
+		{"trailing data", app.LogEmitOptions{Type: "marker", SessionID: "s", Payload: `{} {}`}, "--payload"},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := handler.BuildEvent(tt.opts)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected error mentioning %s, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestLogEmitHandler_Emit_SaveError(t *testing.T) {
+	repo := &MockEventRepository{saveError: errors.New("disk full")}
+	handler := app.NewLogEmitHandler(repo, "", nil)
+
+	_, err := handler.Emit(context.Background(), app.LogEmitOptions{Type: "marker", SessionID: "s"}, &bytes.Buffer{})
+	if err == nil || !strings.Contains(err.Error(), "disk full") {
+		t.Errorf("expected save error, got %v", err)
+	}
+}
@@ -0,0 +1,114 @@
+package app
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/kgatilin/darwinflow-pub/internal/domain"
+)
+
+// Log export formats
+const (
+	LogExportFormatJSONL = "jsonl"
+	LogExportFormatCSV   = "csv"
+)
+
+// LogExportOptions selects which events are exported and how
+type LogExportOptions struct {
+	domain.AnalysisCoverageFilter
+	Format string // jsonl (default) or csv
+}
+
+// exportedEvent is the JSON object written per line by jsonl exports
+type exportedEvent struct {
+	ID         string          `json:"id"`
+	Timestamp  string          `json:"timestamp"`
+	Type       string          `json:"event_type"`
+	SessionID  string          `json:"session_id"`
+	Payload    json.RawMessage `json:"payload"`
+	Content    string          `json:"content"`
+	Version    string          `json:"version"`
+	WorkingDir string          `json:"working_dir,omitempty"`
+	GitBranch  string          `json:"git_branch,omitempty"`
+	GitCommit  string          `json:"git_commit,omitempty"`
+}
+
+// LogExportHandler streams events selected by analysis coverage, e.g. to collect
+// the sessions that have a reviewed analysis as training data
+type LogExportHandler struct {
+	repo domain.AnalysisCoverageStreamer
+}
+
+// NewLogExportHandler creates a new log export handler
+func NewLogExportHandler(repo domain.AnalysisCoverageStreamer) *LogExportHandler {
+	return &LogExportHandler{repo: repo}
+}
+
+// Export writes the selected events to out, oldest first, and returns how many
+// were exported
+func (h *LogExportHandler) Export(ctx context.Context, opts LogExportOptions, out io.Writer) (int, error) {
+	format := opts.Format
+	if format == "" {
+		format = LogExportFormatJSONL
+	}
+
+	var write func(event *domain.Event, payload json.RawMessage) error
+	var csvWriter *csv.Writer
+	switch format {
+	case LogExportFormatJSONL:
+		encoder := json.NewEncoder(out)
+		write = func(event *domain.Event, payload json.RawMessage) error {
+			return encoder.Encode(exportedEvent{
+				ID:         event.ID,
+				Timestamp:  event.Timestamp.Format(time.RFC3339Nano),
+				Type:       event.Type,
+				SessionID:  event.SessionID,
+				Payload:    payload,
+				Content:    event.Content,
+				Version:    event.Version,
+				WorkingDir: event.WorkingDir,
+				GitBranch:  event.GitBranch,
+				GitCommit:  event.GitCommit,
+			})
+		}
+	case LogExportFormatCSV:
+		csvWriter = csv.NewWriter(out)
+		if err := csvWriter.Write(csvLogHeader); err != nil {
+			return 0, fmt.Errorf("failed to write CSV header: %w", err)
+		}
+		write = func(event *domain.Event, payload json.RawMessage) error {
+			return writeCSVLogRecord(csvWriter, &LogRecord{
+				ID:        event.ID,
+				Timestamp: event.Timestamp,
+				EventType: event.Type,
+				SessionID: event.SessionID,
+				Payload:   payload,
+				Content:   event.Content,
+			})
+		}
+	default:
+		return 0, fmt.Errorf("invalid format '%s'. Valid formats: jsonl, csv", format)
+	}
+
+	count, err := h.repo.StreamEventsByAnalysisCoverage(ctx, opts.AnalysisCoverageFilter, func(event *domain.Event) error {
+		payload, err := event.MarshalPayload()
+		if err != nil {
+			return fmt.Errorf("failed to marshal payload of event %s: %w", event.ID, err)
+		}
+		return write(event, payload)
+	})
+	if csvWriter != nil {
+		csvWriter.Flush()
+		if flushErr := csvWriter.Error(); err == nil && flushErr != nil {
+			err = fmt.Errorf("failed to write CSV: %w", flushErr)
+		}
+	}
+	if err != nil {
+		return count, fmt.Errorf("failed to export events: %w", err)
+	}
+	return count, nil
+}
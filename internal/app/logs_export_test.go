@@ -0,0 +1,102 @@
+package app_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kgatilin/darwinflow-pub/internal/app"
+	"github.com/kgatilin/darwinflow-pub/internal/domain"
+)
+
+// fakeCoverageStreamer streams fixed events and records the filter it was given
+type fakeCoverageStreamer struct {
+	events []*domain.Event
+	filter domain.AnalysisCoverageFilter
+}
+
+func (f *fakeCoverageStreamer) StreamEventsByAnalysisCoverage(ctx context.Context, filter domain.AnalysisCoverageFilter, fn func(*domain.Event) error) (int, error) {
+	f.filter = filter
+	for i, event := range f.events {
+		if err := fn(event); err != nil {
+			return i, err
+		}
+	}
+	return len(f.events), nil
+}
+
+func testExportEvents() []*domain.Event {
+	ts := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	first := domain.NewEvent("tool.invoked", "s1", map[string]string{"tool": "Read"}, "Read main.go")
+	first.ID, first.Timestamp = "evt-1", ts
+	second := domain.NewEvent("chat.message.user", "s1", json.RawMessage(`{"text":"hi"}`), "hi")
+	second.ID, second.Timestamp = "evt-2", ts.Add(time.Second)
+	return []*domain.Event{first, second}
+}
+
+func TestLogExportHandler_ExportJSONL(t *testing.T) {
+	repo := &fakeCoverageStreamer{events: testExportEvents()}
+	var out bytes.Buffer
+
+	opts := app.LogExportOptions{AnalysisCoverageFilter: domain.AnalysisCoverageFilter{
+		Coverage: domain.AnalysisCoverageAnalyzed, AnalysisType: "summary",
+	}}
+	count, err := app.NewLogExportHandler(repo).Export(context.Background(), opts, &out)
+	if err != nil {
+		t.Fatalf("Export() failed: %v", err)
+	}
+	if count != 2 {
+		t.Errorf("count = %d, want 2", count)
+	}
+	if repo.filter != opts.AnalysisCoverageFilter {
+		t.Errorf("filter = %+v, want %+v", repo.filter, opts.AnalysisCoverageFilter)
+	}
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines, got %q", out.String())
+	}
+	var record map[string]interface{}
+	if err := json.Unmarshal([]byte(lines[0]), &record); err != nil {
+		t.Fatalf("line is not JSON: %v", err)
+	}
+	if record["id"] != "evt-1" || record["session_id"] != "s1" || record["timestamp"] != "2025-01-02T03:04:05Z" {
+		t.Errorf("unexpected record: %v", record)
+	}
+	if payload, ok := record["payload"].(map[string]interface{}); !ok || payload["tool"] != "Read" {
+		t.Errorf("payload should be embedded as JSON, got %v", record["payload"])
+	}
+	if !strings.Contains(lines[1], `"payload":{"text":"hi"}`) {
+		t.Errorf("raw payload should be kept as is: %s", lines[1])
+	}
+}
+
+func TestLogExportHandler_ExportCSV(t *testing.T) {
+	repo := &fakeCoverageStreamer{events: testExportEvents()}
+	var out bytes.Buffer
+
+	count, err := app.NewLogExportHandler(repo).Export(context.Background(), app.LogExportOptions{Format: app.LogExportFormatCSV}, &out)
+	if err != nil {
+		t.Fatalf("Export() failed: %v", err)
+	}
+	if count != 2 {
+		t.Errorf("count = %d, want 2", count)
+	}
+	output := out.String()
+	if !strings.HasPrefix(output, "ID,Timestamp,EventType,SessionID,Payload,Content\n") {
+		t.Errorf("missing CSV header: %q", output)
+	}
+	if !strings.Contains(output, "evt-2,2025-01-02T03:04:06Z,chat.message.user,s1,") {
+		t.Errorf("missing CSV row: %q", output)
+	}
+}
+
+func TestLogExportHandler_InvalidFormat(t *testing.T) {
+	_, err := app.NewLogExportHandler(&fakeCoverageStreamer{}).Export(context.Background(), app.LogExportOptions{Format: "xml"}, &bytes.Buffer{})
+	if err == nil || !strings.Contains(err.Error(), "invalid format") {
+		t.Errorf("expected invalid format error, got %v", err)
+	}
+}
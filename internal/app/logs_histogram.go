@@ -0,0 +1,212 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/kgatilin/darwinflow-pub/internal/domain"
+)
+
+// Histogram bucket sizes
+const (
+	LogHistogramBucketHour = "hour"
+	LogHistogramBucketDay  = "day"
+)
+
+// logHistogramBarWidth is the length of the bar of the busiest bucket
+const logHistogramBarWidth = 40
+
+// LogHistogramOptions selects the events of a histogram and how they are bucketed
+type LogHistogramOptions struct {
+	Bucket string         // hour or day
+	Since  time.Time      // Start of the range; zero = one day for hour buckets, one week for day buckets
+	Until  time.Time      // End of the range (zero = now)
+	Types  []string       // Only these exact event types (empty = all)
+	Loc    *time.Location // Zone bucket boundaries are computed in (nil = local time)
+	JSON   bool           // Output the raw buckets as JSON instead of a chart
+}
+
+// LogHistogramBucket is the number of events in one bucket
+type LogHistogramBucket struct {
+	Start time.Time
+	Count int
+}
+
+// LogHistogramHandler renders event counts per hour or day
+type LogHistogramHandler struct {
+	repo domain.EventActivityCounter
+}
+
+// NewLogHistogramHandler creates a new log histogram handler
+func NewLogHistogramHandler(repo domain.EventActivityCounter) *LogHistogramHandler {
+	return &LogHistogramHandler{repo: repo}
+}
+
+// Histogram returns one bucket per hour or day from the bucket containing
+// opts.Since through the one containing opts.Until, including empty buckets. The database
+// counts events per domain.EventActivitySlot; the slots are summed into buckets
+// in opts.Loc, so bucket boundaries follow local midnight and DST changes.
+func (h *LogHistogramHandler) Histogram(ctx context.Context, opts LogHistogramOptions) ([]LogHistogramBucket, error) {
+	loc := opts.Loc
+	if loc == nil {
+		loc = time.Local
+	}
+	until := opts.Until
+	if until.IsZero() {
+		until = time.Now()
+	}
+	until = until.In(loc)
+	since := opts.Since
+	if since.IsZero() {
+		since = defaultHistogramSince(opts.Bucket, until)
+	}
+	if since.After(until) {
+		return nil, fmt.Errorf("--since is in the future")
+	}
+
+	first := histogramBucketStart(opts.Bucket, since.In(loc))
+	slots, err := h.repo.CountEventsBySlot(ctx, domain.EventActivityFilter{Since: first, Types: opts.Types})
+	if err != nil {
+		return nil, err
+	}
+
+	var buckets []LogHistogramBucket
+	index := make(map[int64]int)
+	last := histogramBucketStart(opts.Bucket, until)
+	for start := first; !start.After(last); start = nextHistogramBucket(opts.Bucket, start) {
+		index[start.Unix()] = len(buckets)
+		buckets = append(buckets, LogHistogramBucket{Start: start})
+	}
+	for _, slot := range slots {
+		if i, ok := index[histogramBucketStart(opts.Bucket, slot.Start.In(loc)).Unix()]; ok {
+			buckets[i].Count += slot.Count
+		}
+	}
+	return buckets, nil
+}
+
+// Render writes the histogram to out as a bar chart, or as JSON
+func (h *LogHistogramHandler) Render(ctx context.Context, opts LogHistogramOptions, out io.Writer) error {
+	buckets, err := h.Histogram(ctx, opts)
+	if err != nil {
+		return err
+	}
+	if opts.JSON {
+		return FormatLogHistogramAsJSON(out, opts, buckets)
+	}
+	FormatLogHistogramAsChart(out, opts, buckets)
+	return nil
+}
+
+// FormatLogHistogramAsChart writes one labelled horizontal bar per bucket, scaled
+// to the busiest bucket. Non-empty buckets always get at least one block.
+func FormatLogHistogramAsChart(out io.Writer, opts LogHistogramOptions, buckets []LogHistogramBucket) {
+	total, max := 0, 0
+	for _, bucket := range buckets {
+		total += bucket.Count
+		if bucket.Count > max {
+			max = bucket.Count
+		}
+	}
+
+	zone := "local time"
+	if opts.Loc == time.UTC {
+		zone = "UTC"
+	}
+	filter := ""
+	if len(opts.Types) > 0 {
+		filter = " of type " + strings.Join(opts.Types, ", ")
+	}
+	fmt.Fprintf(out, "Events%s per %s (%s): %d total\n\n", filter, opts.Bucket, zone, total)
+
+	for _, bucket := range buckets {
+		width := 0
+		if bucket.Count > 0 {
+			width = bucket.Count * logHistogramBarWidth / max
+			if width == 0 {
+				width = 1
+			}
+		}
+		fmt.Fprintf(out, "%s │%s %d\n", histogramBucketLabel(opts.Bucket, bucket.Start), strings.Repeat("█", width), bucket.Count)
+	}
+}
+
+// logHistogramRecord is the JSON shape of a histogram
+type logHistogramRecord struct {
+	Bucket   string                     `json:"bucket"`
+	Timezone string                     `json:"timezone"`
+	Types    []string                   `json:"types,omitempty"`
+	Total    int                        `json:"total"`
+	Buckets  []logHistogramBucketRecord `json:"buckets"`
+}
+
+type logHistogramBucketRecord struct {
+	Start time.Time `json:"start"`
+	Count int       `json:"count"`
+}
+
+// FormatLogHistogramAsJSON writes the raw buckets as a JSON document
+func FormatLogHistogramAsJSON(out io.Writer, opts LogHistogramOptions, buckets []LogHistogramBucket) error {
+	record := logHistogramRecord{
+		Bucket:  opts.Bucket,
+		Types:   opts.Types,
+		Buckets: make([]logHistogramBucketRecord, 0, len(buckets)),
+	}
+	record.Timezone = time.Local.String()
+	if opts.Loc != nil {
+		record.Timezone = opts.Loc.String()
+	}
+	for _, bucket := range buckets {
+		record.Total += bucket.Count
+		record.Buckets = append(record.Buckets, logHistogramBucketRecord{Start: bucket.Start, Count: bucket.Count})
+	}
+
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(record)
+}
+
+// defaultHistogramSince covers the last day of hours or the last week of days
+func defaultHistogramSince(bucket string, until time.Time) time.Time {
+	if bucket == LogHistogramBucketDay {
+		return until.AddDate(0, 0, -6)
+	}
+	return until.Add(-23 * time.Hour)
+}
+
+// histogramBucketStart returns the start of the bucket containing t, in t's location.
+// Hours are truncated in absolute time, which stays unambiguous in the hour repeated
+// at the end of DST.
+func histogramBucketStart(bucket string, t time.Time) time.Time {
+	if bucket == LogHistogramBucketDay {
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	}
+	return t.Add(-time.Duration(t.Minute())*time.Minute - time.Duration(t.Second())*time.Second - time.Duration(t.Nanosecond()))
+}
+
+// nextHistogramBucket returns the start of the bucket after the one starting at start
+func nextHistogramBucket(bucket string, start time.Time) time.Time {
+	step := time.Hour
+	next := start.Add(step)
+	if bucket == LogHistogramBucketDay {
+		step = 24 * time.Hour
+		next = start.AddDate(0, 0, 1)
+	}
+	if next = histogramBucketStart(bucket, next); !next.After(start) {
+		// Zones shifting by less than an hour can truncate back; always make progress
+		next = start.Add(step)
+	}
+	return next
+}
+
+// histogramBucketLabel formats a bucket start for the chart
+func histogramBucketLabel(bucket string, start time.Time) string {
+	if bucket == LogHistogramBucketDay {
+		return start.Format("2006-01-02 Mon")
+	}
+	return start.Format("2006-01-02 15:04")
+}
@@ -0,0 +1,205 @@
+package app_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kgatilin/darwinflow-pub/internal/app"
+	"github.com/kgatilin/darwinflow-pub/internal/domain"
+)
+
+// slotCounter returns fixed slot counts and records the filter it was asked for
+type slotCounter struct {
+	slots  []domain.EventSlotCount
+	filter domain.EventActivityFilter
+}
+
+func (c *slotCounter) CountEventsBySlot(ctx context.Context, filter domain.EventActivityFilter) ([]domain.EventSlotCount, error) {
+	c.filter = filter
+	var slots []domain.EventSlotCount
+	for _, slot := range c.slots {
+		if !slot.Start.Before(filter.Since) {
+			slots = append(slots, slot)
+		}
+	}
+	return slots, nil
+}
+
+func loadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Skipf("time zone %s not available: %v", name, err)
+	}
+	return loc
+}
+
+func TestLogHistogramHandler_HourBucketsIncludeEmptyHours(t *testing.T) {
+	base := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+	counter := &slotCounter{slots: []domain.EventSlotCount{
+		{Start: base, Count: 2},
+		{Start: base.Add(45 * time.Minute), Count: 3},
+		{Start: base.Add(3 * time.Hour), Count: 1},
+	}}
+	handler := app.NewLogHistogramHandler(counter)
+
+	buckets, err := handler.Histogram(context.Background(), app.LogHistogramOptions{
+		Bucket: app.LogHistogramBucketHour,
+		Since:  base.Add(10 * time.Minute),
+		Until:  base.Add(3*time.Hour + 5*time.Minute),
+		Types:  []string{"tool.invoked"},
+		Loc:    time.UTC,
+	})
+	if err != nil {
+		t.Fatalf("Histogram: %v", err)
+	}
+
+	wantCounts := []int{5, 0, 0, 1}
+	if len(buckets) != len(wantCounts) {
+		t.Fatalf("got %d buckets, want %d: %+v", len(buckets), len(wantCounts), buckets)
+	}
+	for i, want := range wantCounts {
+		if !buckets[i].Start.Equal(base.Add(time.Duration(i) * time.Hour)) {
+			t.Errorf("bucket %d starts at %v", i, buckets[i].Start)
+		}
+		if buckets[i].Count != want {
+			t.Errorf("bucket %d count = %d, want %d", i, buckets[i].Count, want)
+		}
+	}
+	if !counter.filter.Since.Equal(base) || len(counter.filter.Types) != 1 {
+		t.Errorf("expected the query to start at the first bucket with the types, got %+v", counter.filter)
+	}
+}
+
+func TestLogHistogramHandler_LocalBoundaries(t *testing.T) {
+	// India is UTC+5:30: the UTC quarter hours 18:15 and 18:30 fall on different local days
+	kolkata := loadLocation(t, "Asia/Kolkata")
+	counter := &slotCounter{slots: []domain.EventSlotCount{
+		{Start: time.Date(2026, 3, 10, 18, 15, 0, 0, time.UTC), Count: 1},
+		{Start: time.Date(2026, 3, 10, 18, 30, 0, 0, time.UTC), Count: 4},
+	}}
+	handler := app.NewLogHistogramHandler(counter)
+
+	buckets, err := handler.Histogram(context.Background(), app.LogHistogramOptions{
+		Bucket: app.LogHistogramBucketDay,
+		Since:  time.Date(2026, 3, 10, 12, 0, 0, 0, kolkata),
+		Until:  time.Date(2026, 3, 11, 12, 0, 0, 0, kolkata),
+		Loc:    kolkata,
+	})
+	if err != nil {
+		t.Fatalf("Histogram: %v", err)
+	}
+	if len(buckets) != 2 || buckets[0].Count != 1 || buckets[1].Count != 4 {
+		t.Fatalf("expected 1 event on March 10 and 4 on March 11 local time, got %+v", buckets)
+	}
+	if got := buckets[1].Start.Format("2006-01-02 15:04"); got != "2026-03-11 00:00" {
+		t.Errorf("expected days to start at local midnight, got %s", got)
+	}
+
+	// In UTC both slots are on March 10
+	buckets, err = handler.Histogram(context.Background(), app.LogHistogramOptions{
+		Bucket: app.LogHistogramBucketDay,
+		Since:  time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC),
+		Until:  time.Date(2026, 3, 10, 23, 0, 0, 0, time.UTC),
+		Loc:    time.UTC,
+	})
+	if err != nil {
+		t.Fatalf("Histogram: %v", err)
+	}
+	if len(buckets) != 1 || buckets[0].Count != 5 {
+		t.Errorf("expected 5 events on one UTC day, got %+v", buckets)
+	}
+}
+
+func TestLogHistogramHandler_RepeatedDSTHour(t *testing.T) {
+	// New York falls back on 2026-11-01: 01:00-02:00 local happens twice
+	newYork := loadLocation(t, "America/New_York")
+	handler := app.NewLogHistogramHandler(&slotCounter{})
+
+	buckets, err := handler.Histogram(context.Background(), app.LogHistogramOptions{
+		Bucket: app.LogHistogramBucketHour,
+		Since:  time.Date(2026, 11, 1, 4, 0, 0, 0, time.UTC),  // 00:00 EDT
+		Until:  time.Date(2026, 11, 1, 7, 30, 0, 0, time.UTC), // 02:30 EST
+		Loc:    newYork,
+	})
+	if err != nil {
+		t.Fatalf("Histogram: %v", err)
+	}
+
+	var labels []string
+	for _, bucket := range buckets {
+		labels = append(labels, bucket.Start.Format("15:04 MST"))
+	}
+	want := "00:00 EDT,01:00 EDT,01:00 EST,02:00 EST"
+	if got := strings.Join(labels, ","); got != want {
+		t.Errorf("buckets = %s, want %s", got, want)
+	}
+}
+
+func TestLogHistogramHandler_SinceInTheFuture(t *testing.T) {
+	handler := app.NewLogHistogramHandler(&slotCounter{})
+	until := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+	_, err := handler.Histogram(context.Background(), app.LogHistogramOptions{
+		Bucket: app.LogHistogramBucketHour,
+		Since:  until.Add(time.Hour),
+		Until:  until,
+	})
+	if err == nil {
+		t.Fatal("expected an error for a range starting after it ends")
+	}
+}
+
+func TestLogHistogramHandler_Render(t *testing.T) {
+	base := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	counter := &slotCounter{slots: []domain.EventSlotCount{
+		{Start: base, Count: 100},
+		{Start: base.AddDate(0, 0, 2), Count: 1},
+	}}
+	handler := app.NewLogHistogramHandler(counter)
+	opts := app.LogHistogramOptions{
+		Bucket: app.LogHistogramBucketDay,
+		Since:  base,
+		Until:  base.AddDate(0, 0, 2),
+		Loc:    time.UTC,
+	}
+
+	var chart bytes.Buffer
+	if err := handler.Render(context.Background(), opts, &chart); err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+	for _, want := range []string{
+		"Events per day (UTC): 101 total",
+		"2026-03-10 Tue │" + strings.Repeat("█", 40) + " 100",
+		"2026-03-11 Wed │ 0",
+		"2026-03-12 Thu │█ 1",
+	} {
+		if !strings.Contains(chart.String(), want) {
+			t.Errorf("expected chart to contain %q, got:\n%s", want, chart.String())
+		}
+	}
+
+	opts.JSON = true
+	var raw bytes.Buffer
+	if err := handler.Render(context.Background(), opts, &raw); err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+	var doc struct {
+		Bucket   string `json:"bucket"`
+		Timezone string `json:"timezone"`
+		Total    int    `json:"total"`
+		Buckets  []struct {
+			Start time.Time `json:"start"`
+			Count int       `json:"count"`
+		} `json:"buckets"`
+	}
+	if err := json.Unmarshal(raw.Bytes(), &doc); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, raw.String())
+	}
+	if doc.Bucket != "day" || doc.Timezone != "UTC" || doc.Total != 101 || len(doc.Buckets) != 3 || doc.Buckets[1].Count != 0 {
+		t.Errorf("unexpected JSON: %+v", doc)
+	}
+}
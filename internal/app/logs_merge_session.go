@@ -0,0 +1,54 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/kgatilin/darwinflow-pub/internal/domain"
+)
+
+// LogMergeSessionOptions selects the sessions 'dw logs merge-session' consolidates
+type LogMergeSessionOptions struct {
+	From         string // Session whose events and analyses move
+	To           string // Session that receives them
+	DeleteSource bool   // Remove what could not be moved, so the source session disappears
+}
+
+// LogMergeSessionHandler consolidates a session that was split across two session IDs
+type LogMergeSessionHandler struct {
+	repo domain.SessionMerger
+}
+
+// NewLogMergeSessionHandler creates a new log merge-session handler
+func NewLogMergeSessionHandler(repo domain.SessionMerger) *LogMergeSessionHandler {
+	return &LogMergeSessionHandler{repo: repo}
+}
+
+// Merge moves everything recorded under opts.From to opts.To and reports the rows
+// moved to out. Overlapping time ranges are merged anyway, with a warning on errOut,
+// since the merged session then interleaves events recorded in parallel.
+func (h *LogMergeSessionHandler) Merge(ctx context.Context, opts LogMergeSessionOptions, out, errOut io.Writer) (*domain.SessionMergeResult, error) {
+	result, err := h.repo.MergeSessions(ctx, opts.From, opts.To, opts.DeleteSource)
+	if err != nil {
+		return nil, fmt.Errorf("failed to merge sessions: %w", err)
+	}
+
+	if result.Overlaps() {
+		fmt.Fprintf(errOut, "Warning: session %s (%s - %s) overlaps session %s (%s - %s); their events are now interleaved\n",
+			opts.From, result.SourceStart.Format("2006-01-02 15:04:05"), result.SourceEnd.Format("2006-01-02 15:04:05"),
+			opts.To, result.TargetStart.Format("2006-01-02 15:04:05"), result.TargetEnd.Format("2006-01-02 15:04:05"))
+	}
+
+	fmt.Fprintf(out, "Merged session %s into %s: %d events and %d analyses moved.\n",
+		opts.From, opts.To, result.EventsMoved, result.AnalysesMoved)
+	if result.AnalysesDeleted > 0 {
+		fmt.Fprintf(out, "Deleted %d source analyses the target already had for the same type and model, with %d event links.\n",
+			result.AnalysesDeleted, result.EventLinksDeleted)
+	}
+	if result.AnalysesKept > 0 {
+		fmt.Fprintf(out, "Kept %d analyses under %s because %s already has them for the same type and model (use --delete-source to remove them).\n",
+			result.AnalysesKept, opts.From, opts.To)
+	}
+	return result, nil
+}
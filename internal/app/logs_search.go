@@ -0,0 +1,225 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// Log search fields
+const (
+	LogSearchInContent = "content"
+	LogSearchInPayload = "payload"
+	LogSearchInBoth    = "both"
+)
+
+// DefaultLogSearchMatchTimeout is the time a regex may spend on a single row
+const DefaultLogSearchMatchTimeout = 100 * time.Millisecond
+
+// logSearchBatchSize is the number of events loaded per page when scanning
+const logSearchBatchSize = 500
+
+// maxConcurrentLogMatches bounds the regex goroutines a search keeps running,
+// including matches abandoned after a timeout
+const maxConcurrentLogMatches = 4
+
+// errLogMatchTimeout is returned when a regex exceeds the per-row match timeout
+var errLogMatchTimeout = errors.New("regex match timed out")
+
+// LogSearchOptions configures a log search
+type LogSearchOptions struct {
+	Text         string        // Search text, or a Go regular expression when Regex is set
+	In           string        // content (default), payload, or both
+	Regex        bool          // Treat Text as a regular expression
+	SessionID    string        // Optional session filter
+	Source       string        // Optional event source filter (see domain.EventKind)
+	Category     string        // Optional event category filter
+	Labels       []string      // Optional label filter: events with any of these labels
+	Limit        int           // Maximum number of matches (0 = no limit)
+	MatchTimeout time.Duration // Per-row regex timeout (0 = DefaultLogSearchMatchTimeout)
+}
+
+// LogSearchMatch is a log record that matched a search
+type LogSearchMatch struct {
+	Record *LogRecord
+	Fields []string // Fields that matched: "content" and/or "payload"
+}
+
+// LogSearchResult contains the matches of a log search
+type LogSearchResult struct {
+	Matches      []*LogSearchMatch
+	TimedOutRows int // Rows skipped because the regex exceeded the match timeout
+}
+
+// SearchLogs finds logs whose content and/or payload match the search text, newest first.
+// Plain content searches use the full-text index. Payload, combined and regex searches
+// scan events page by page: plain text matches case-insensitively as a substring, and
+// regexes are applied per field with a per-row timeout.
+func (s *LogsService) SearchLogs(ctx context.Context, opts LogSearchOptions) (*LogSearchResult, error) {
+	if opts.Text == "" {
+		return nil, fmt.Errorf("search text is required")
+	}
+	if opts.In == "" {
+		opts.In = LogSearchInContent
+	}
+	if opts.In != LogSearchInContent && opts.In != LogSearchInPayload && opts.In != LogSearchInBoth {
+		return nil, fmt.Errorf("invalid search field '%s'. Valid fields: content, payload, both", opts.In)
+	}
+	if opts.MatchTimeout <= 0 {
+		opts.MatchTimeout = DefaultLogSearchMatchTimeout
+	}
+
+	filter := LogFilter{SessionID: opts.SessionID, Source: opts.Source, Category: opts.Category, Labels: opts.Labels}
+
+	if opts.In == LogSearchInContent && !opts.Regex {
+		return s.searchContentIndex(ctx, opts, filter)
+	}
+
+	match, err := newLogMatcher(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &LogSearchResult{Matches: []*LogSearchMatch{}}
+	for offset := 0; ; offset += logSearchBatchSize {
+		query := pluginsdk.EventQuery{
+			Limit:  logSearchBatchSize,
+			Offset: offset,
+		}
+		filter.apply(&query)
+		events, err := s.repo.FindByQuery(ctx, query)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query logs: %w", err)
+		}
+
+		records, err := s.convertEventsToRecords(ctx, events)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, record := range records {
+			fields, timedOut := matchLogRecord(record, opts.In, match)
+			if timedOut {
+				result.TimedOutRows++
+			}
+			if len(fields) == 0 {
+				continue
+			}
+			result.Matches = append(result.Matches, &LogSearchMatch{Record: record, Fields: fields})
+			if opts.Limit > 0 && len(result.Matches) >= opts.Limit {
+				return result, nil
+			}
+		}
+
+		if len(events) < logSearchBatchSize {
+			return result, nil
+		}
+	}
+}
+
+// searchContentIndex runs a plain content search through the repository's full-text search
+func (s *LogsService) searchContentIndex(ctx context.Context, opts LogSearchOptions, filter LogFilter) (*LogSearchResult, error) {
+	query := pluginsdk.EventQuery{
+		SearchText: opts.Text,
+		Limit:      opts.Limit,
+	}
+	filter.apply(&query)
+	events, err := s.repo.FindByQuery(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search logs: %w", err)
+	}
+
+	records, err := s.convertEventsToRecords(ctx, events)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &LogSearchResult{Matches: make([]*LogSearchMatch, 0, len(records))}
+	for _, record := range records {
+		result.Matches = append(result.Matches, &LogSearchMatch{Record: record, Fields: []string{LogSearchInContent}})
+	}
+	return result, nil
+}
+
+// logMatcher reports whether a field value matches the search
+type logMatcher func(value string) (bool, error)
+
+// newLogMatcher builds the matcher for a scanning search
+func newLogMatcher(opts LogSearchOptions) (logMatcher, error) {
+	if !opts.Regex {
+		needle := strings.ToLower(opts.Text)
+		return func(value string) (bool, error) {
+			return strings.Contains(strings.ToLower(value), needle), nil
+		}, nil
+	}
+
+	re, err := regexp.Compile(opts.Text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid regex: %w", err)
+	}
+	slots := make(chan struct{}, maxConcurrentLogMatches)
+	return func(value string) (bool, error) {
+		return matchWithTimeout(re, value, opts.MatchTimeout, slots)
+	}, nil
+}
+
+// matchWithTimeout runs re against value and gives up after timeout.
+// Go regexps run in linear time, so this bounds the cost of huge rows rather than
+// backtracking; the abandoned match finishes in the background. Each match holds a
+// slot until it finishes, so at most cap(slots) matches run at once; waiting for a
+// slot counts against the timeout.
+func matchWithTimeout(re *regexp.Regexp, value string, timeout time.Duration, slots chan struct{}) (bool, error) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case slots <- struct{}{}:
+	case <-timer.C:
+		return false, errLogMatchTimeout
+	}
+
+	done := make(chan bool, 1)
+	go func() {
+		defer func() { <-slots }()
+		done <- re.MatchString(value)
+	}()
+
+	select {
+	case matched := <-done:
+		return matched, nil
+	case <-timer.C:
+		return false, errLogMatchTimeout
+	}
+}
+
+// matchLogRecord returns the searched fields of record that match.
+// timedOut is true if matching any field exceeded the regex timeout.
+func matchLogRecord(record *LogRecord, in string, match logMatcher) (fields []string, timedOut bool) {
+	candidates := []struct {
+		name  string
+		value string
+	}{
+		{LogSearchInContent, record.Content},
+		{LogSearchInPayload, string(record.Payload)},
+	}
+
+	for _, candidate := range candidates {
+		if in != LogSearchInBoth && in != candidate.name {
+			continue
+		}
+		matched, err := match(candidate.value)
+		if err != nil {
+			timedOut = true
+			continue
+		}
+		if matched {
+			fields = append(fields, candidate.name)
+		}
+	}
+	return fields, timedOut
+}
@@ -0,0 +1,181 @@
+package app_test
+
+import (
+	"bytes"
+	"context"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kgatilin/darwinflow-pub/internal/app"
+	"github.com/kgatilin/darwinflow-pub/internal/domain"
+)
+
+func newSearchTestService() *app.LogsService {
+	eventRepo := &MockEventRepository{
+		events: []*domain.Event{
+			domain.NewEvent("tool.result", "session-1", map[string]interface{}{"output": "panic: runtime error"}, "Bash ran tests"),
+			domain.NewEvent("chat.message.user", "session-1", map[string]interface{}{"message": "hello"}, "Why the PANIC?"),
+			domain.NewEvent("tool.invoked", "session-2", map[string]interface{}{"tool": "Read"}, "Read sqlite.go"),
+		},
+	}
+	return app.NewLogsService(eventRepo, eventRepo)
+}
+
+func TestLogsService_SearchLogs_Fields(t *testing.T) {
+	service := newSearchTestService()
+
+	tests := []struct {
+		name string
+		opts app.LogSearchOptions
+		want []string // "<content>:<fields>" per match
+	}{
+		{"payload substring", app.LogSearchOptions{Text: "panic", In: app.LogSearchInPayload},
+			[]string{"Bash ran tests:payload"}},
+		{"both is case-insensitive", app.LogSearchOptions{Text: "panic", In: app.LogSearchInBoth},
+			[]string{"Bash ran tests:payload", "Why the PANIC?:content"}},
+		{"content regex", app.LogSearchOptions{Text: `^Why the [A-Z]+\?$`, Regex: true},
+			[]string{"Why the PANIC?:content"}},
+		{"regex in both fields", app.LogSearchOptions{Text: `(?i)panic|tests`, In: app.LogSearchInBoth, Regex: true},
+			[]string{"Bash ran tests:content,payload", "Why the PANIC?:content"}},
+		{"session filter", app.LogSearchOptions{Text: "read", In: app.LogSearchInBoth, SessionID: "session-1"},
+			nil},
+		{"limit", app.LogSearchOptions{Text: "e", In: app.LogSearchInBoth, Limit: 1},
+			[]string{"Bash ran tests:content,payload"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := service.SearchLogs(context.Background(), tt.opts)
+			if err != nil {
+				t.Fatalf("SearchLogs() failed: %v", err)
+			}
+			var got []string
+			for _, match := range result.Matches {
+				got = append(got, match.Record.Content+":"+strings.Join(match.Fields, ","))
+			}
+			if strings.Join(got, "|") != strings.Join(tt.want, "|") {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestLogsService_SearchLogs_InvalidOptions(t *testing.T) {
+	service := newSearchTestService()
+
+	for name, opts := range map[string]app.LogSearchOptions{
+		"empty text":    {},
+		"invalid field": {Text: "x", In: "headers"},
+		"invalid regex": {Text: "(", Regex: true},
+	} {
+		if _, err := service.SearchLogs(context.Background(), opts); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
+
+func TestLogsService_SearchLogs_RegexTimeout(t *testing.T) {
+	eventRepo := &MockEventRepository{
+		events: []*domain.Event{
+			domain.NewEvent("tool.result", "session-1", map[string]interface{}{"output": "x"}, strings.Repeat("a", 5<<20)),
+		},
+	}
+	service := app.NewLogsService(eventRepo, eventRepo)
+
+	result, err := service.SearchLogs(context.Background(), app.LogSearchOptions{
+		Text:         `a+b`,
+		Regex:        true,
+		MatchTimeout: time.Nanosecond,
+	})
+	if err != nil {
+		t.Fatalf("SearchLogs() failed: %v", err)
+	}
+	if result.TimedOutRows != 1 {
+		t.Errorf("expected 1 timed out row, got %d", result.TimedOutRows)
+	}
+	if len(result.Matches) != 0 {
+		t.Errorf("expected no matches, got %d", len(result.Matches))
+	}
+}
+
+func TestLogsService_SearchLogs_RegexTimeoutBoundsGoroutines(t *testing.T) {
+	eventRepo := &MockEventRepository{}
+	for i := 0; i < 20; i++ {
+		eventRepo.events = append(eventRepo.events,
+			domain.NewEvent("tool.result", "session-1", map[string]interface{}{"output": "x"}, strings.Repeat("a", 1<<20)))
+	}
+	service := app.NewLogsService(eventRepo, eventRepo)
+
+	before := runtime.NumGoroutine()
+	result, err := service.SearchLogs(context.Background(), app.LogSearchOptions{
+		Text:         `a+b`,
+		Regex:        true,
+		MatchTimeout: time.Nanosecond,
+	})
+	if err != nil {
+		t.Fatalf("SearchLogs() failed: %v", err)
+	}
+	if result.TimedOutRows != 20 {
+		t.Errorf("expected 20 timed out rows, got %d", result.TimedOutRows)
+	}
+	// Abandoned matches keep running, but never more than a few at once
+	if running := runtime.NumGoroutine() - before; running > 4 {
+		t.Errorf("expected at most 4 abandoned matches running, got %d", running)
+	}
+}
+
+func TestLogsCommandHandler_SearchLogs(t *testing.T) {
+	out := &bytes.Buffer{}
+	handler := app.NewLogsCommandHandler(newSearchTestService(), out)
+	opts := app.LogSearchOptions{Text: "panic", In: app.LogSearchInBoth}
+	if err := handler.SearchLogs(context.Background(), opts, "text"); err != nil {
+		t.Fatalf("SearchLogs() failed: %v", err)
+	}
+
+	output := out.String()
+	if !strings.Contains(output, `Showing 2 logs matching text "panic" in both`) {
+		t.Errorf("expected search summary, got:\n%s", output)
+	}
+	if !strings.Contains(output, "Matched in: payload") || !strings.Contains(output, "Matched in: content") {
+		t.Errorf("expected matched field per result, got:\n%s", output)
+	}
+}
+
+func TestLogsCommandHandler_SearchLogs_NoMatches(t *testing.T) {
+	out := &bytes.Buffer{}
+	handler := app.NewLogsCommandHandler(&mockLogsService{}, out)
+
+	if err := handler.SearchLogs(context.Background(), app.LogSearchOptions{Text: "nothing", Regex: true}, "text"); err != nil {
+		t.Fatalf("SearchLogs() failed: %v", err)
+	}
+	if !strings.Contains(out.String(), `No logs found matching regex "nothing" in content.`) {
+		t.Errorf("expected no matches message, got: %s", out.String())
+	}
+
+	if err := handler.SearchLogs(context.Background(), app.LogSearchOptions{Text: "x"}, "json"); err == nil {
+		t.Error("expected error for invalid format")
+	}
+}
+
+func TestLogsCommandHandler_SearchLogs_WarningsKeepCSVClean(t *testing.T) {
+	out := &bytes.Buffer{}
+	errOut := &bytes.Buffer{}
+	handler := app.NewLogsCommandHandler(&mockLogsService{
+		searchLogsFunc: func(ctx context.Context, opts app.LogSearchOptions) (*app.LogSearchResult, error) {
+			return &app.LogSearchResult{Matches: []*app.LogSearchMatch{}, TimedOutRows: 2}, nil
+		},
+	}, out)
+	handler.SetErrorOutput(errOut)
+
+	if err := handler.SearchLogs(context.Background(), app.LogSearchOptions{Text: "a+", Regex: true}, "csv"); err != nil {
+		t.Fatalf("SearchLogs() failed: %v", err)
+	}
+	if !strings.Contains(errOut.String(), "Warning: skipped 2 row(s)") {
+		t.Errorf("expected timeout warning on the error output, got: %q", errOut.String())
+	}
+	if strings.Contains(out.String(), "Warning") {
+		t.Errorf("expected CSV output without warnings, got: %q", out.String())
+	}
+}
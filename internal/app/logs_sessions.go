@@ -0,0 +1,139 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/kgatilin/darwinflow-pub/internal/domain"
+)
+
+// SessionListOptions selects which sessions are listed
+type SessionListOptions struct {
+	Limit      int  // Maximum number of sessions (0 = all)
+	Unanalyzed bool // Only sessions without a stored analysis
+	JSON       bool // Output JSON instead of a table
+}
+
+// SessionListHandler lists logged sessions with per-session summaries
+type SessionListHandler struct {
+	analysisRepo domain.AnalysisRepository
+}
+
+// NewSessionListHandler creates a new session list handler
+func NewSessionListHandler(analysisRepo domain.AnalysisRepository) *SessionListHandler {
+	return &SessionListHandler{analysisRepo: analysisRepo}
+}
+
+// ListSessions returns session summaries ordered by most recent activity first
+func (h *SessionListHandler) ListSessions(ctx context.Context, opts SessionListOptions) ([]*domain.SessionSummary, error) {
+	var sessionIDs []string
+	if opts.Unanalyzed {
+		ids, err := h.analysisRepo.GetUnanalyzedSessionIDs(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get unanalyzed sessions: %w", err)
+		}
+		// Non-nil so that "no unanalyzed sessions" does not mean "all sessions"
+		sessionIDs = append([]string{}, ids...)
+	}
+
+	summaries, err := h.analysisRepo.GetSessionSummaries(ctx, sessionIDs, opts.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get session summaries: %w", err)
+	}
+	return summaries, nil
+}
+
+// List writes the selected sessions to out as a table or JSON
+func (h *SessionListHandler) List(ctx context.Context, opts SessionListOptions, out io.Writer) error {
+	summaries, err := h.ListSessions(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	if opts.JSON {
+		return FormatSessionSummariesAsJSON(out, summaries)
+	}
+
+	if len(summaries) == 0 {
+		if opts.Unanalyzed {
+			fmt.Fprintln(out, "No unanalyzed sessions found.")
+		} else {
+			fmt.Fprintln(out, "No sessions found.")
+		}
+		return nil
+	}
+
+	FormatSessionSummariesAsTable(out, summaries)
+	return nil
+}
+
+// sessionSummaryRecord is the JSON shape of a session summary
+type sessionSummaryRecord struct {
+	SessionID     string    `json:"session_id"`
+	FirstEvent    time.Time `json:"first_event"`
+	LastEvent     time.Time `json:"last_event"`
+	EventCount    int       `json:"event_count"`
+	DroppedCount  int       `json:"dropped_count"`
+	TotalEvents   int       `json:"total_events"`
+	AnalysisCount int       `json:"analysis_count"`
+	Analyzed      bool      `json:"analyzed"`
+}
+
+// FormatSessionSummariesAsJSON writes session summaries as a JSON document
+func FormatSessionSummariesAsJSON(w io.Writer, summaries []*domain.SessionSummary) error {
+	records := make([]sessionSummaryRecord, 0, len(summaries))
+	for _, s := range summaries {
+		records = append(records, sessionSummaryRecord{
+			SessionID:     s.SessionID,
+			FirstEvent:    s.FirstEvent,
+			LastEvent:     s.LastEvent,
+			EventCount:    s.EventCount,
+			DroppedCount:  s.DroppedCount,
+			TotalEvents:   s.TotalEvents(),
+			AnalysisCount: s.AnalysisCount,
+			Analyzed:      s.HasAnalysis(),
+		})
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(map[string]interface{}{"sessions": records}); err != nil {
+		return fmt.Errorf("failed to encode sessions: %w", err)
+	}
+	return nil
+}
+
+// FormatSessionSummariesAsTable writes session summaries as an aligned text table
+func FormatSessionSummariesAsTable(w io.Writer, summaries []*domain.SessionSummary) {
+	fmt.Fprintf(w, "Showing %d sessions (most recent first):\n\n", len(summaries))
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "SESSION ID\tFIRST EVENT\tLAST EVENT\tEVENTS\tANALYZED")
+	for _, s := range summaries {
+		analyzed := "no"
+		if s.HasAnalysis() {
+			analyzed = fmt.Sprintf("yes (%d)", s.AnalysisCount)
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n",
+			s.SessionID,
+			s.FirstEvent.Format("2006-01-02 15:04:05"),
+			s.LastEvent.Format("2006-01-02 15:04:05"),
+			formatSessionEventCount(s),
+			analyzed,
+		)
+	}
+	tw.Flush()
+}
+
+// formatSessionEventCount shows the session's total events, noting how many were
+// actually stored when sampling discarded some
+func formatSessionEventCount(s *domain.SessionSummary) string {
+	if s.DroppedCount == 0 {
+		return fmt.Sprintf("%d", s.EventCount)
+	}
+	return fmt.Sprintf("%d (%d stored)", s.TotalEvents(), s.EventCount)
+}
@@ -0,0 +1,84 @@
+package app_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kgatilin/darwinflow-pub/internal/app"
+	"github.com/kgatilin/darwinflow-pub/internal/domain"
+)
+
+func newSessionListRepo() *MockAnalysisRepository {
+	now := time.Date(2025, 6, 10, 12, 0, 0, 0, time.UTC)
+	repo := NewMockAnalysisRepository()
+	repo.SessionSummaries = []*domain.SessionSummary{
+		{SessionID: "session-b", FirstEvent: now.Add(-time.Hour), LastEvent: now, EventCount: 12, DroppedCount: 30},
+		{SessionID: "session-a", FirstEvent: now.Add(-48 * time.Hour), LastEvent: now.Add(-47 * time.Hour), EventCount: 5, AnalysisCount: 2},
+	}
+	repo.UnanalyzedIDs = []string{"session-b"}
+	return repo
+}
+
+func TestSessionListHandler_List_Table(t *testing.T) {
+	var buf bytes.Buffer
+	err := app.NewSessionListHandler(newSessionListRepo()).List(context.Background(), app.SessionListOptions{}, &buf)
+	if err != nil {
+		t.Fatalf("List() failed: %v", err)
+	}
+
+	out := buf.String()
+	if !strings.Contains(out, "Showing 2 sessions") {
+		t.Errorf("expected session count header, got:\n%s", out)
+	}
+	if strings.Index(out, "session-b") > strings.Index(out, "session-a") {
+		t.Errorf("expected sessions in repository (recency) order, got:\n%s", out)
+	}
+	if !strings.Contains(out, "42 (12 stored)") {
+		t.Errorf("expected sampled-out events in session-b total, got:\n%s", out)
+	}
+	if !strings.Contains(out, "yes (2)") {
+		t.Errorf("expected analyzed marker for session-a, got:\n%s", out)
+	}
+}
+
+func TestSessionListHandler_List_UnanalyzedJSON(t *testing.T) {
+	var buf bytes.Buffer
+	opts := app.SessionListOptions{Unanalyzed: true, JSON: true}
+	if err := app.NewSessionListHandler(newSessionListRepo()).List(context.Background(), opts, &buf); err != nil {
+		t.Fatalf("List() failed: %v", err)
+	}
+
+	var doc struct {
+		Sessions []struct {
+			SessionID  string `json:"session_id"`
+			EventCount int    `json:"event_count"`
+			Analyzed   bool   `json:"analyzed"`
+		} `json:"sessions"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatalf("output is not valid JSON: %v", err)
+	}
+	if len(doc.Sessions) != 1 || doc.Sessions[0].SessionID != "session-b" {
+		t.Fatalf("expected only session-b, got %+v", doc.Sessions)
+	}
+	if doc.Sessions[0].EventCount != 12 || doc.Sessions[0].Analyzed {
+		t.Errorf("unexpected summary: %+v", doc.Sessions[0])
+	}
+}
+
+func TestSessionListHandler_ListSessions_NoUnanalyzed(t *testing.T) {
+	repo := newSessionListRepo()
+	repo.UnanalyzedIDs = nil
+
+	summaries, err := app.NewSessionListHandler(repo).ListSessions(context.Background(), app.SessionListOptions{Unanalyzed: true})
+	if err != nil {
+		t.Fatalf("ListSessions() failed: %v", err)
+	}
+	if len(summaries) != 0 {
+		t.Errorf("expected no sessions when all are analyzed, got %d", len(summaries))
+	}
+}
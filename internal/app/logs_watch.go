@@ -0,0 +1,151 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/kgatilin/darwinflow-pub/internal/domain"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// Defaults of LogWatchOptions
+const (
+	DefaultLogWatchInterval = time.Second
+	DefaultLogWatchBuffer   = 100
+)
+
+// LogWatchOptions configures how 'dw logs watch' tails the event store
+type LogWatchOptions struct {
+	Since    time.Time     // Forward events stored at or after this time (zero = when Watch starts)
+	Interval time.Duration // Pause between polls that found no new events (0 = DefaultLogWatchInterval)
+	Buffer   int           // Events read ahead of the plugin; polling pauses while full (0 = DefaultLogWatchBuffer)
+}
+
+// LogWatchResult counts the events a watch delivered
+type LogWatchResult struct {
+	Forwarded int // Events the plugin handled
+	Failed    int // Events the plugin returned an error for
+}
+
+// LogWatchHandler tails the event store and forwards every new event to a plugin
+type LogWatchHandler struct {
+	repo    domain.EventRepository
+	handler pluginsdk.IEventHandler
+	errOut  io.Writer
+}
+
+// NewLogWatchHandler creates a watch forwarding events from repo to handler.
+// Failures of single events and polls are reported to errOut.
+func NewLogWatchHandler(repo domain.EventRepository, handler pluginsdk.IEventHandler, errOut io.Writer) *LogWatchHandler {
+	return &LogWatchHandler{repo: repo, handler: handler, errOut: errOut}
+}
+
+// Watch forwards new events in chronological order until ctx is cancelled, then
+// returns how many were delivered. Events go to the plugin one at a time; when it
+// falls behind, up to opts.Buffer events wait and polling pauses rather than
+// dropping events. A plugin error on one event is counted and reported, and the
+// stream continues.
+func (h *LogWatchHandler) Watch(ctx context.Context, opts LogWatchOptions) LogWatchResult {
+	if opts.Since.IsZero() {
+		opts.Since = time.Now()
+	}
+	if opts.Interval <= 0 {
+		opts.Interval = DefaultLogWatchInterval
+	}
+	if opts.Buffer <= 0 {
+		opts.Buffer = DefaultLogWatchBuffer
+	}
+
+	events := make(chan *domain.Event, opts.Buffer)
+	go h.poll(ctx, opts, events)
+
+	var result LogWatchResult
+	for event := range events {
+		if err := h.handler.HandleEvent(ctx, toPluginEvent(event)); err != nil {
+			if ctx.Err() != nil {
+				break
+			}
+			result.Failed++
+			fmt.Fprintf(h.errOut, "Error: plugin failed to handle event %s (%s): %v\n", event.ID, event.Type, err)
+			continue
+		}
+		result.Forwarded++
+	}
+	return result
+}
+
+// poll queries the events stored since the last one it passed on and sends them to
+// events, closing it once ctx is cancelled. Timestamps are stored with millisecond
+// precision, so the IDs already sent at the latest timestamp are remembered to avoid
+// sending them twice.
+func (h *LogWatchHandler) poll(ctx context.Context, opts LogWatchOptions, events chan<- *domain.Event) {
+	defer close(events)
+
+	cursor := opts.Since
+	sentAtCursor := make(map[string]bool)
+	for {
+		batch, err := h.repo.FindByQuery(ctx, pluginsdk.EventQuery{
+			StartTime:   &cursor,
+			OrderByTime: true,
+		})
+		if err != nil && ctx.Err() == nil {
+			fmt.Fprintf(h.errOut, "Error: failed to read new events: %v\n", err)
+		}
+
+		for _, event := range batch {
+			if sentAtCursor[event.ID] {
+				continue
+			}
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+			if event.Timestamp.UnixMilli() > cursor.UnixMilli() {
+				cursor = event.Timestamp
+				sentAtCursor = make(map[string]bool)
+			}
+			sentAtCursor[event.ID] = true
+		}
+
+		select {
+		case <-time.After(opts.Interval):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// toPluginEvent converts a stored event to the SDK event delivered to plugins
+func toPluginEvent(event *domain.Event) pluginsdk.Event {
+	payload := make(map[string]interface{})
+	if m, ok := event.Payload.(map[string]interface{}); ok {
+		payload = m
+	} else if event.Payload != nil {
+		data, _ := event.MarshalPayload()
+		_ = json.Unmarshal(data, &payload)
+	}
+
+	metadata := map[string]string{
+		"event_id":   event.ID,
+		"session_id": event.SessionID,
+	}
+	if event.WorkingDir != "" {
+		metadata["working_dir"] = event.WorkingDir
+	}
+	if event.GitBranch != "" {
+		metadata["git_branch"] = event.GitBranch
+	}
+
+	return pluginsdk.Event{
+		Type:      event.Type,
+		Source:    domain.ParseEventKind(event.Type).Source,
+		Timestamp: event.Timestamp,
+		Payload:   payload,
+		Metadata:  metadata,
+		Version:   event.Version,
+	}
+}
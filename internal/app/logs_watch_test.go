@@ -0,0 +1,164 @@
+package app_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kgatilin/darwinflow-pub/internal/app"
+	"github.com/kgatilin/darwinflow-pub/internal/domain"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// watchedEventRepository is an event store events can be added to while a watch polls it
+type watchedEventRepository struct {
+	MockEventRepository
+	mu     sync.Mutex
+	stored []*domain.Event
+}
+
+func (r *watchedEventRepository) add(events ...*domain.Event) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.stored = append(r.stored, events...)
+}
+
+func (r *watchedEventRepository) FindByQuery(ctx context.Context, query pluginsdk.EventQuery) ([]*domain.Event, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var result []*domain.Event
+	for _, event := range r.stored {
+		if query.StartTime == nil || event.Timestamp.UnixMilli() >= query.StartTime.UnixMilli() {
+			result = append(result, event)
+		}
+	}
+	return result, nil
+}
+
+// recordingEventHandler records the events it handles and fails the ones of type failType
+type recordingEventHandler struct {
+	mu       sync.Mutex
+	handled  []pluginsdk.Event
+	failType string
+	delay    time.Duration
+}
+
+func (h *recordingEventHandler) GetInfo() pluginsdk.PluginInfo {
+	return pluginsdk.PluginInfo{Name: "recorder"}
+}
+
+func (h *recordingEventHandler) GetCapabilities() []string {
+	return []string{"IEventHandler"}
+}
+
+func (h *recordingEventHandler) HandleEvent(ctx context.Context, event pluginsdk.Event) error {
+	time.Sleep(h.delay)
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.handled = append(h.handled, event)
+	if event.Type == h.failType {
+		return errors.New("cannot handle " + event.Type)
+	}
+	return nil
+}
+
+func (h *recordingEventHandler) count() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return len(h.handled)
+}
+
+func (h *recordingEventHandler) types() []string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	types := make([]string, len(h.handled))
+	for i, event := range h.handled {
+		types[i] = event.Type
+	}
+	return types
+}
+
+// waitFor polls cond until it holds or a second passes
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for the watch")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestLogWatchHandler_ForwardsNewEventsAndSurvivesFailures(t *testing.T) {
+	start := time.Now()
+	repo := &watchedEventRepository{}
+	repo.add(&domain.Event{ID: "old", Type: "claude.tool.invoked", Timestamp: start.Add(-time.Minute)})
+
+	handler := &recordingEventHandler{failType: "build.failed"}
+	var errOut bytes.Buffer
+	watcher := app.NewLogWatchHandler(repo, handler, &errOut)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan app.LogWatchResult)
+	go func() {
+		done <- watcher.Watch(ctx, app.LogWatchOptions{Since: start, Interval: 5 * time.Millisecond})
+	}()
+
+	// Events sharing a millisecond are forwarded once each
+	repo.add(
+		&domain.Event{ID: "e1", Type: "claude.tool.invoked", SessionID: "s1", Timestamp: start.Add(time.Second), Payload: map[string]interface{}{"tool": "Read"}},
+		&domain.Event{ID: "e2", Type: "build.failed", SessionID: "s1", Timestamp: start.Add(time.Second)},
+	)
+	waitFor(t, func() bool { return handler.count() == 2 })
+	repo.add(&domain.Event{ID: "e3", Type: "marker", SessionID: "s1", Timestamp: start.Add(2 * time.Second)})
+	waitFor(t, func() bool { return handler.count() == 3 })
+
+	cancel()
+	result := <-done
+
+	if result.Forwarded != 2 || result.Failed != 1 {
+		t.Errorf("result = %+v, want 2 forwarded and 1 failed", result)
+	}
+	if got := strings.Join(handler.types(), ","); got != "claude.tool.invoked,build.failed,marker" {
+		t.Errorf("handled types = %s", got)
+	}
+	if !strings.Contains(errOut.String(), "event e2 (build.failed)") {
+		t.Errorf("error output = %q, want the failed event reported", errOut.String())
+	}
+
+	first := handler.handled[0]
+	if first.Source != "claude" || first.Metadata["event_id"] != "e1" || first.Metadata["session_id"] != "s1" {
+		t.Errorf("first event = %+v, want source claude and event/session IDs in metadata", first)
+	}
+	if first.Payload["tool"] != "Read" {
+		t.Errorf("first payload = %v, want tool Read", first.Payload)
+	}
+}
+
+func TestLogWatchHandler_BuffersForSlowPlugin(t *testing.T) {
+	start := time.Now()
+	repo := &watchedEventRepository{}
+	for i := 0; i < 5; i++ {
+		repo.add(&domain.Event{ID: string(rune('a' + i)), Type: "marker", Timestamp: start.Add(time.Duration(i+1) * time.Millisecond)})
+	}
+
+	handler := &recordingEventHandler{delay: 10 * time.Millisecond}
+	watcher := app.NewLogWatchHandler(repo, handler, &bytes.Buffer{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan app.LogWatchResult)
+	go func() {
+		done <- watcher.Watch(ctx, app.LogWatchOptions{Since: start, Interval: time.Millisecond, Buffer: 1})
+	}()
+	waitFor(t, func() bool { return handler.count() == 5 })
+	cancel()
+
+	if result := <-done; result.Forwarded != 5 {
+		t.Errorf("Forwarded = %d, want 5 (no event dropped)", result.Forwarded)
+	}
+}
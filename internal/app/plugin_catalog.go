@@ -0,0 +1,129 @@
+package app
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// PluginCatalogSchemaVersion is the version of the plugin catalog JSON schema.
+// Bump it when fields are removed or change meaning; adding fields is backward compatible.
+const PluginCatalogSchemaVersion = 1
+
+// PluginCatalog is a machine-readable description of all plugins and their commands.
+// It is used to generate CLI documentation.
+type PluginCatalog struct {
+	SchemaVersion int                  `json:"schema_version"`
+	Plugins       []PluginCatalogEntry `json:"plugins"`
+	Errors        []PluginLoadError    `json:"errors"`
+}
+
+// PluginCatalogEntry describes a single plugin in the catalog
+type PluginCatalogEntry struct {
+	Name         string                `json:"name"`
+	Version      string                `json:"version"`
+	Description  string                `json:"description"`
+	IsCore       bool                  `json:"is_core"`
+	Capabilities []string              `json:"capabilities"`
+	Commands     []CommandCatalogEntry `json:"commands"`
+}
+
+// CommandCatalogEntry describes a single plugin command in the catalog
+type CommandCatalogEntry struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Usage       string `json:"usage"`
+	Help        string `json:"help"`
+}
+
+// PluginLoadError records a plugin that could not be loaded or introspected
+type PluginLoadError struct {
+	Plugin string `json:"plugin"`
+	Error  string `json:"error"`
+}
+
+// BuildPluginCatalog walks the plugin and command registries and builds the catalog.
+// loadErrors are plugins that failed before registration (e.g. external plugins that
+// could not start). A plugin that fails during introspection is reported in Errors
+// instead of aborting the whole catalog. Plugins and commands are sorted by name so
+// the output is stable.
+func BuildPluginCatalog(pluginRegistry *PluginRegistry, commandRegistry *CommandRegistry, loadErrors []PluginLoadError) *PluginCatalog {
+	catalog := &PluginCatalog{
+		SchemaVersion: PluginCatalogSchemaVersion,
+		Plugins:       []PluginCatalogEntry{},
+		Errors:        append([]PluginLoadError{}, loadErrors...),
+	}
+
+	for _, plugin := range pluginRegistry.GetAllPlugins() {
+		entry, err := buildPluginCatalogEntry(plugin, commandRegistry)
+		if err != nil {
+			catalog.Errors = append(catalog.Errors, PluginLoadError{
+				Plugin: safePluginName(plugin),
+				Error:  err.Error(),
+			})
+			continue
+		}
+		catalog.Plugins = append(catalog.Plugins, entry)
+	}
+
+	sort.Slice(catalog.Plugins, func(i, j int) bool {
+		return catalog.Plugins[i].Name < catalog.Plugins[j].Name
+	})
+	sort.SliceStable(catalog.Errors, func(i, j int) bool {
+		return catalog.Errors[i].Plugin < catalog.Errors[j].Plugin
+	})
+
+	return catalog
+}
+
+// buildPluginCatalogEntry introspects a single plugin.
+// Panics from misbehaving plugins (e.g. a crashed subprocess) are converted to errors.
+func buildPluginCatalogEntry(plugin pluginsdk.Plugin, commandRegistry *CommandRegistry) (entry PluginCatalogEntry, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("failed to introspect plugin: %v", r)
+		}
+	}()
+
+	info := plugin.GetInfo()
+	capabilities := append([]string{}, plugin.GetCapabilities()...)
+	sort.Strings(capabilities)
+
+	entry = PluginCatalogEntry{
+		Name:         info.Name,
+		Version:      info.Version,
+		Description:  info.Description,
+		IsCore:       info.IsCore,
+		Capabilities: capabilities,
+		Commands:     []CommandCatalogEntry{},
+	}
+
+	for _, cmd := range commandRegistry.GetCommandsForPlugin(info.Name) {
+		entry.Commands = append(entry.Commands, CommandCatalogEntry{
+			Name:        cmd.GetName(),
+			Description: cmd.GetDescription(),
+			Usage:       cmd.GetUsage(),
+			Help:        cmd.GetHelp(),
+		})
+	}
+	sort.Slice(entry.Commands, func(i, j int) bool {
+		return entry.Commands[i].Name < entry.Commands[j].Name
+	})
+
+	return entry, nil
+}
+
+// safePluginName returns the plugin name, or a placeholder if GetInfo panics
+func safePluginName(plugin pluginsdk.Plugin) (name string) {
+	defer func() {
+		if recover() != nil {
+			name = "unknown"
+		}
+	}()
+	name = plugin.GetInfo().Name
+	if name == "" {
+		name = "unknown"
+	}
+	return name
+}
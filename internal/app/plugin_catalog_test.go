@@ -0,0 +1,129 @@
+package app_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/kgatilin/darwinflow-pub/internal/app"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// panickingCommandPlugin simulates a plugin whose command listing fails (e.g. a crashed subprocess)
+type panickingCommandPlugin struct {
+	info pluginsdk.PluginInfo
+}
+
+func (m *panickingCommandPlugin) GetInfo() pluginsdk.PluginInfo {
+	return m.info
+}
+
+func (m *panickingCommandPlugin) GetCapabilities() []string {
+	return []string{"ICommandProvider"}
+}
+
+func (m *panickingCommandPlugin) GetCommands() []pluginsdk.Command {
+	panic("subprocess exited")
+}
+
+func TestBuildPluginCatalog(t *testing.T) {
+	logger := &app.NoOpLogger{}
+	pluginRegistry := app.NewPluginRegistry(logger)
+
+	pluginRegistry.RegisterPlugin(&mockCommandProviderPlugin{
+		info: pluginsdk.PluginInfo{Name: "zeta", Version: "2.0.0", Description: "Zeta plugin", IsCore: true},
+		commands: []pluginsdk.Command{
+			&mockCommand{name: "start", description: "Start", usage: "start"},
+			&mockCommand{name: "init", description: "Initialize", usage: "init"},
+		},
+	})
+	pluginRegistry.RegisterPlugin(&mockNonCommandPlugin{
+		info: pluginsdk.PluginInfo{Name: "alpha", Version: "1.0.0"},
+	})
+	pluginRegistry.RegisterPlugin(&panickingCommandPlugin{
+		info: pluginsdk.PluginInfo{Name: "broken", Version: "0.1.0"},
+	})
+
+	commandRegistry := app.NewCommandRegistry(pluginRegistry, logger)
+	loadErrors := []app.PluginLoadError{{Plugin: "missing", Error: "command not found"}}
+
+	catalog := app.BuildPluginCatalog(pluginRegistry, commandRegistry, loadErrors)
+
+	if catalog.SchemaVersion != app.PluginCatalogSchemaVersion {
+		t.Errorf("SchemaVersion = %d, want %d", catalog.SchemaVersion, app.PluginCatalogSchemaVersion)
+	}
+
+	// Plugins are sorted by name; the broken plugin is reported as an error
+	if len(catalog.Plugins) != 2 {
+		t.Fatalf("expected 2 plugins, got %d", len(catalog.Plugins))
+	}
+	if catalog.Plugins[0].Name != "alpha" || catalog.Plugins[1].Name != "zeta" {
+		t.Errorf("plugins not sorted by name: %s, %s", catalog.Plugins[0].Name, catalog.Plugins[1].Name)
+	}
+
+	zeta := catalog.Plugins[1]
+	if zeta.Version != "2.0.0" || zeta.Description != "Zeta plugin" || !zeta.IsCore {
+		t.Errorf("unexpected plugin info: %+v", zeta)
+	}
+	if len(zeta.Capabilities) != 1 || zeta.Capabilities[0] != "ICommandProvider" {
+		t.Errorf("unexpected capabilities: %v", zeta.Capabilities)
+	}
+	if len(zeta.Commands) != 2 || zeta.Commands[0].Name != "init" || zeta.Commands[1].Usage != "start" {
+		t.Errorf("unexpected commands: %+v", zeta.Commands)
+	}
+
+	if catalog.Plugins[0].Commands == nil {
+		t.Error("expected empty (non-nil) command list for plugin without commands")
+	}
+
+	if len(catalog.Errors) != 2 {
+		t.Fatalf("expected 2 errors, got %+v", catalog.Errors)
+	}
+	if catalog.Errors[0].Plugin != "broken" || catalog.Errors[1].Plugin != "missing" {
+		t.Errorf("unexpected errors: %+v", catalog.Errors)
+	}
+}
+
+func TestPluginCatalog_JSONSchema(t *testing.T) {
+	logger := &app.NoOpLogger{}
+	pluginRegistry := app.NewPluginRegistry(logger)
+	pluginRegistry.RegisterPlugin(&mockCommandProviderPlugin{
+		info: pluginsdk.PluginInfo{Name: "test-plugin", Version: "1.0.0"},
+		commands: []pluginsdk.Command{
+			&mockCommand{name: "init", description: "Initialize", usage: "init"},
+		},
+	})
+
+	catalog := app.BuildPluginCatalog(pluginRegistry, app.NewCommandRegistry(pluginRegistry, logger), nil)
+
+	data, err := json.Marshal(catalog)
+	if err != nil {
+		t.Fatalf("failed to marshal catalog: %v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("failed to unmarshal catalog: %v", err)
+	}
+
+	for _, field := range []string{"schema_version", "plugins", "errors"} {
+		if _, ok := decoded[field]; !ok {
+			t.Errorf("catalog JSON missing %q", field)
+		}
+	}
+	if errs, ok := decoded["errors"].([]interface{}); !ok || len(errs) != 0 {
+		t.Errorf("expected empty errors array, got %v", decoded["errors"])
+	}
+
+	plugin := decoded["plugins"].([]interface{})[0].(map[string]interface{})
+	for _, field := range []string{"name", "version", "description", "is_core", "capabilities", "commands"} {
+		if _, ok := plugin[field]; !ok {
+			t.Errorf("plugin JSON missing %q", field)
+		}
+	}
+	command := plugin["commands"].([]interface{})[0].(map[string]interface{})
+	for _, field := range []string{"name", "description", "usage", "help"} {
+		if _, ok := command[field]; !ok {
+			t.Errorf("command JSON missing %q", field)
+		}
+	}
+}
@@ -2,8 +2,11 @@ package app
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
+	"strings"
+	"time"
 
 	"github.com/kgatilin/darwinflow-pub/internal/domain"
 	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
@@ -29,23 +32,63 @@ type ProjectContext struct {
 	DBPath string
 }
 
+// GitInfoProvider resolves the git state of a directory for event enrichment.
+// Implementations must be best-effort: unknown values are returned as empty strings.
+type GitInfoProvider interface {
+	GitInfo(dir string) (branch, commit string)
+}
+
+// ContextOption configures optional behavior of plugin and command contexts
+type ContextOption func(*pluginContextAdapter)
+
+// WithGitInfo enables recording the git branch and commit of the working directory
+// on every emitted event
+func WithGitInfo(provider GitInfoProvider) ContextOption {
+	return func(p *pluginContextAdapter) {
+		p.gitInfo = provider
+	}
+}
+
+// WithEventSampling applies the configured per-type sampling rates to emitted events.
+// Dropped events are counted when the repository implements domain.SampleDropRecorder.
+func WithEventSampling(config domain.EventsConfig) ContextOption {
+	return func(p *pluginContextAdapter) {
+		p.sampling = config
+	}
+}
+
+// WithVerbosity sets the verbosity reported by command contexts (see pluginsdk.VerbosityProvider)
+func WithVerbosity(verbosity pluginsdk.Verbosity) ContextOption {
+	return func(p *pluginContextAdapter) {
+		p.verbosity = verbosity
+	}
+}
+
 // pluginContextAdapter adapts internal services to SDK PluginContext interface.
 // This allows plugins to access system capabilities without depending on internal types.
+// It also implements pluginsdk.EventReader.
 type pluginContextAdapter struct {
 	logger     Logger
 	dbPath     string
 	workingDir string
 	eventRepo  domain.EventRepository
+	gitInfo    GitInfoProvider
+	sampling   domain.EventsConfig
+	verbosity  pluginsdk.Verbosity
 }
 
 // NewPluginContext creates a new plugin context adapter
-func NewPluginContext(logger Logger, dbPath, workingDir string, eventRepo domain.EventRepository) pluginsdk.PluginContext {
-	return &pluginContextAdapter{
+func NewPluginContext(logger Logger, dbPath, workingDir string, eventRepo domain.EventRepository, opts ...ContextOption) pluginsdk.PluginContext {
+	p := &pluginContextAdapter{
 		logger:     logger,
 		dbPath:     dbPath,
 		workingDir: workingDir,
 		eventRepo:  eventRepo,
 	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
 }
 
 func (p *pluginContextAdapter) GetLogger() pluginsdk.Logger {
@@ -97,6 +140,20 @@ func (p *pluginContextAdapter) EmitEvent(ctx context.Context, event pluginsdk.Ev
 		domainEvent.Version = "1.0"
 	}
 
+	// Apply sampling before any further work; the decision is deterministic per event content
+	if rate := p.sampling.SampleRate(eventType); rate < 1 {
+		if !domain.KeepSampledEvent(sampleKey(sessionID, event), rate) {
+			return p.recordSampleDrop(ctx, sessionID, eventType)
+		}
+		domainEvent.Sampled = true
+	}
+
+	// Record where the event was captured; git lookup is best-effort
+	domainEvent.WorkingDir = p.workingDir
+	if p.gitInfo != nil && p.workingDir != "" {
+		domainEvent.GitBranch, domainEvent.GitCommit = p.gitInfo.GitInfo(p.workingDir)
+	}
+
 	// Save to repository
 	if err := p.eventRepo.Save(ctx, domainEvent); err != nil {
 		return fmt.Errorf("failed to save event: %w", err)
@@ -105,6 +162,73 @@ func (p *pluginContextAdapter) EmitEvent(ctx context.Context, event pluginsdk.Ev
 	return nil
 }
 
+// sampleKey identifies an event for sampling by its session, type, timestamp and payload.
+// The domain event ID is random, so it would give the same input a different decision per run.
+func sampleKey(sessionID string, event pluginsdk.Event) string {
+	// Map keys are marshaled in sorted order, so equal payloads give equal keys
+	payload, _ := json.Marshal(event.Payload)
+	return strings.Join([]string{sessionID, event.Type, event.Timestamp.UTC().Format(time.RFC3339Nano), string(payload)}, "\x00")
+}
+
+// recordSampleDrop counts an event discarded by sampling, if the repository supports it
+func (p *pluginContextAdapter) recordSampleDrop(ctx context.Context, sessionID, eventType string) error {
+	recorder, ok := p.eventRepo.(domain.SampleDropRecorder)
+	if !ok {
+		return nil
+	}
+	if err := recorder.RecordSampleDrop(ctx, sessionID, eventType); err != nil {
+		return fmt.Errorf("failed to record sampled-out event: %w", err)
+	}
+	return nil
+}
+
+// GetEvent implements pluginsdk.EventReader by looking the event up in the event store
+func (p *pluginContextAdapter) GetEvent(ctx context.Context, eventID string) (*pluginsdk.Event, error) {
+	events, err := p.eventRepo.FindByQuery(ctx, pluginsdk.EventQuery{EventIDs: []string{eventID}, Limit: 1})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load event: %w", err)
+	}
+	if len(events) == 0 {
+		return nil, fmt.Errorf("%w: event %s", pluginsdk.ErrNotFound, eventID)
+	}
+	return toSDKEvent(events[0])
+}
+
+// toSDKEvent converts a stored event back to the SDK shape, undoing the source/data/metadata
+// wrapping applied by EmitEvent. Payloads stored without that wrapping are passed through whole.
+func toSDKEvent(event *domain.Event) (*pluginsdk.Event, error) {
+	payloadJSON, err := event.MarshalPayload()
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal payload: %w", err)
+	}
+	var payload map[string]interface{}
+	if err := json.Unmarshal(payloadJSON, &payload); err != nil {
+		return nil, fmt.Errorf("failed to decode payload of event %s: %w", event.ID, err)
+	}
+
+	sdkEvent := &pluginsdk.Event{
+		Type:      event.Type,
+		Timestamp: event.Timestamp,
+		Payload:   payload,
+		Metadata:  map[string]string{},
+		Version:   event.Version,
+	}
+	if data, ok := payload["data"].(map[string]interface{}); ok {
+		sdkEvent.Payload = data
+		sdkEvent.Source, _ = payload["source"].(string)
+		if metadata, ok := payload["metadata"].(map[string]interface{}); ok {
+			for key, value := range metadata {
+				sdkEvent.Metadata[key] = fmt.Sprintf("%v", value)
+			}
+		}
+	}
+	if event.SessionID != "" {
+		sdkEvent.Metadata["session_id"] = event.SessionID
+	}
+
+	return sdkEvent, nil
+}
+
 // loggerAdapter adapts app.Logger to domain.Logger
 type loggerAdapter struct {
 	inner Logger
@@ -126,7 +250,8 @@ func (l *loggerAdapter) Error(format string, args ...interface{}) {
 	l.inner.Error(format, args...)
 }
 
-// commandContextAdapter adapts internal services to SDK CommandContext interface
+// commandContextAdapter adapts internal services to SDK CommandContext interface.
+// It also implements pluginsdk.VerbosityProvider.
 type commandContextAdapter struct {
 	pluginContextAdapter
 	output io.Writer
@@ -134,8 +259,8 @@ type commandContextAdapter struct {
 }
 
 // NewCommandContext creates a new command context adapter
-func NewCommandContext(logger Logger, dbPath, workingDir string, eventRepo interface{}, output io.Writer, input io.Reader) pluginsdk.CommandContext {
-	return &commandContextAdapter{
+func NewCommandContext(logger Logger, dbPath, workingDir string, eventRepo interface{}, output io.Writer, input io.Reader, opts ...ContextOption) pluginsdk.CommandContext {
+	c := &commandContextAdapter{
 		pluginContextAdapter: pluginContextAdapter{
 			logger:     logger,
 			dbPath:     dbPath,
@@ -145,6 +270,10 @@ func NewCommandContext(logger Logger, dbPath, workingDir string, eventRepo inter
 		output: output,
 		input:  input,
 	}
+	for _, opt := range opts {
+		opt(&c.pluginContextAdapter)
+	}
+	return c
 }
 
 func (c *commandContextAdapter) GetStdout() io.Writer {
@@ -155,5 +284,10 @@ func (c *commandContextAdapter) GetStdin() io.Reader {
 	return c.input
 }
 
+// GetVerbosity implements pluginsdk.VerbosityProvider
+func (c *commandContextAdapter) GetVerbosity() pluginsdk.Verbosity {
+	return c.verbosity
+}
+
 // Note: ToolContext removed - tools now use regular context
 // Tools are executed via the Tool interface which receives context.Context and args
@@ -3,6 +3,8 @@ package app_test
 import (
 	"bytes"
 	"context"
+	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -30,7 +32,18 @@ func (m *mockEventRepo) Save(ctx context.Context, event *domain.Event) error {
 }
 
 func (m *mockEventRepo) FindByQuery(ctx context.Context, query pluginsdk.EventQuery) ([]*domain.Event, error) {
-	return m.events, nil
+	if len(query.EventIDs) == 0 {
+		return m.events, nil
+	}
+	var matched []*domain.Event
+	for _, event := range m.events {
+		for _, id := range query.EventIDs {
+			if event.ID == id {
+				matched = append(matched, event)
+			}
+		}
+	}
+	return matched, nil
 }
 
 func (m *mockEventRepo) Close() error {
@@ -210,6 +223,30 @@ func TestCommandContext_GetStdin(t *testing.T) {
 	}
 }
 
+func TestCommandContext_Verbosity(t *testing.T) {
+	logger := &mockPluginContextLogger{}
+	eventRepo := &mockEventRepo{}
+	stdout := &bytes.Buffer{}
+
+	cmdCtx := app.NewCommandContext(logger, "/test/db", "/test/dir", eventRepo, stdout, &bytes.Buffer{})
+	if got := pluginsdk.GetVerbosity(cmdCtx); got != pluginsdk.VerbosityNormal {
+		t.Errorf("GetVerbosity() = %q, want normal", got)
+	}
+	if got := pluginsdk.InfoWriter(cmdCtx); got != stdout {
+		t.Error("InfoWriter() should return stdout by default")
+	}
+
+	quietCtx := app.NewCommandContext(logger, "/test/db", "/test/dir", eventRepo, stdout, &bytes.Buffer{},
+		app.WithVerbosity(pluginsdk.VerbosityQuiet))
+	if got := pluginsdk.GetVerbosity(quietCtx); got != pluginsdk.VerbosityQuiet {
+		t.Errorf("GetVerbosity() = %q, want quiet", got)
+	}
+	fmt.Fprint(pluginsdk.InfoWriter(quietCtx), "done")
+	if stdout.Len() != 0 {
+		t.Errorf("InfoWriter() should discard output when quiet, got %q", stdout.String())
+	}
+}
+
 func TestCommandContext_InheritsPluginContext(t *testing.T) {
 	logger := &mockPluginContextLogger{}
 	eventRepo := &mockEventRepo{}
@@ -334,3 +371,188 @@ func TestPluginContext_EmitEvent_ExplicitVersion(t *testing.T) {
 		t.Errorf("Event version = %q, want %q", stored.Version, "2.0")
 	}
 }
+
+// stubGitInfo is a GitInfoProvider returning fixed values and recording lookups
+type stubGitInfo struct {
+	branch  string
+	commit  string
+	lookups []string
+}
+
+func (s *stubGitInfo) GitInfo(dir string) (string, string) {
+	s.lookups = append(s.lookups, dir)
+	return s.branch, s.commit
+}
+
+func TestPluginContext_EmitEvent_CaptureContext(t *testing.T) {
+	logger := &mockPluginContextLogger{}
+	eventRepo := &mockEventRepo{}
+	git := &stubGitInfo{branch: "main", commit: "0123456789abcdef"}
+
+	pluginCtx := app.NewPluginContext(logger, "/test/db", "/test/dir", eventRepo, app.WithGitInfo(git))
+
+	if err := pluginCtx.EmitEvent(context.Background(), pluginsdk.Event{Type: "test.event", Source: "test-plugin"}); err != nil {
+		t.Fatalf("EmitEvent() error = %v", err)
+	}
+	if len(eventRepo.events) != 1 {
+		t.Fatalf("Expected 1 event stored, got %d", len(eventRepo.events))
+	}
+
+	stored := eventRepo.events[0]
+	if stored.WorkingDir != "/test/dir" {
+		t.Errorf("WorkingDir = %q, want %q", stored.WorkingDir, "/test/dir")
+	}
+	if stored.GitBranch != "main" || stored.GitCommit != "0123456789abcdef" {
+		t.Errorf("git context = %q/%q, want main/0123456789abcdef", stored.GitBranch, stored.GitCommit)
+	}
+	if len(git.lookups) != 1 || git.lookups[0] != "/test/dir" {
+		t.Errorf("GitInfo lookups = %v, want [/test/dir]", git.lookups)
+	}
+}
+
+func TestPluginContext_EmitEvent_WithoutGitInfo(t *testing.T) {
+	logger := &mockPluginContextLogger{}
+	eventRepo := &mockEventRepo{}
+
+	cmdCtx := app.NewCommandContext(logger, "/test/db", "/test/dir", eventRepo, &bytes.Buffer{}, &bytes.Buffer{})
+
+	if err := cmdCtx.EmitEvent(context.Background(), pluginsdk.Event{Type: "test.event", Source: "test-plugin"}); err != nil {
+		t.Fatalf("EmitEvent() error = %v", err)
+	}
+
+	stored := eventRepo.events[0]
+	if stored.WorkingDir != "/test/dir" {
+		t.Errorf("WorkingDir = %q, want %q", stored.WorkingDir, "/test/dir")
+	}
+	if stored.GitBranch != "" || stored.GitCommit != "" {
+		t.Errorf("git context = %q/%q, want empty without a provider", stored.GitBranch, stored.GitCommit)
+	}
+}
+
+// samplingEventRepo is a mockEventRepo that also counts sampled-out events
+type samplingEventRepo struct {
+	mockEventRepo
+	drops map[string]int
+}
+
+func (m *samplingEventRepo) RecordSampleDrop(ctx context.Context, sessionID, eventType string) error {
+	m.drops[sessionID+"/"+eventType]++
+	return nil
+}
+
+func TestPluginContext_EmitEvent_Sampling(t *testing.T) {
+	logger := &mockPluginContextLogger{}
+	eventRepo := &samplingEventRepo{drops: map[string]int{}}
+	sampling := domain.EventsConfig{Sample: map[string]float64{"tool.result": 0.5, "tool.invoked": 0}}
+
+	pluginCtx := app.NewPluginContext(logger, "/test/db", "/test/dir", eventRepo, app.WithEventSampling(sampling))
+
+	const perType = 200
+	for _, eventType := range []string{"chat.started", "tool.result", "tool.invoked"} {
+		for i := 0; i < perType; i++ {
+			event := pluginsdk.Event{Type: eventType, Source: "test-plugin", Payload: map[string]interface{}{"n": i}, Metadata: map[string]string{"session_id": "s1"}}
+			if err := pluginCtx.EmitEvent(context.Background(), event); err != nil {
+				t.Fatalf("EmitEvent() error = %v", err)
+			}
+		}
+	}
+
+	stored := map[string]int{}
+	for _, event := range eventRepo.events {
+		stored[event.Type]++
+		if event.Sampled != (event.Type == "tool.result") {
+			t.Errorf("Sampled = %v for %s", event.Sampled, event.Type)
+		}
+	}
+
+	if stored["chat.started"] != perType {
+		t.Errorf("Expected all %d chat.started events stored, got %d", perType, stored["chat.started"])
+	}
+	if stored["tool.invoked"] != 0 || eventRepo.drops["s1/tool.invoked"] != perType {
+		t.Errorf("Expected all tool.invoked events dropped, stored %d, dropped %d", stored["tool.invoked"], eventRepo.drops["s1/tool.invoked"])
+	}
+	if stored["tool.result"] == 0 || stored["tool.result"] == perType {
+		t.Errorf("Expected a sample of tool.result events, stored %d of %d", stored["tool.result"], perType)
+	}
+	if stored["tool.result"]+eventRepo.drops["s1/tool.result"] != perType {
+		t.Errorf("Stored + dropped tool.result = %d, want %d", stored["tool.result"]+eventRepo.drops["s1/tool.result"], perType)
+	}
+}
+
+func TestPluginContext_EmitEvent_SamplingIsDeterministic(t *testing.T) {
+	logger := &mockPluginContextLogger{}
+	eventRepo := &samplingEventRepo{drops: map[string]int{}}
+	sampling := domain.EventsConfig{Sample: map[string]float64{"tool.result": 0.5}}
+	pluginCtx := app.NewPluginContext(logger, "/test/db", "/test/dir", eventRepo, app.WithEventSampling(sampling))
+
+	// Each input is emitted twice, as when the same hook output is replayed
+	const inputs = 100
+	timestamp := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	for run := 0; run < 2; run++ {
+		for i := 0; i < inputs; i++ {
+			event := pluginsdk.Event{
+				Type:      "tool.result",
+				Source:    "test-plugin",
+				Timestamp: timestamp,
+				Payload:   map[string]interface{}{"n": i},
+				Metadata:  map[string]string{"session_id": "s1"},
+			}
+			if err := pluginCtx.EmitEvent(context.Background(), event); err != nil {
+				t.Fatalf("EmitEvent() error = %v", err)
+			}
+		}
+	}
+
+	stored := map[int]int{}
+	for _, event := range eventRepo.events {
+		n := event.Payload.(map[string]interface{})["data"].(map[string]interface{})["n"].(int)
+		stored[n]++
+	}
+	for n, count := range stored {
+		if count != 2 {
+			t.Errorf("input %v stored %d time(s), want the same decision for both runs", n, count)
+		}
+	}
+	if len(stored) == 0 || len(stored) == inputs {
+		t.Errorf("expected a sample of the inputs, kept %d of %d", len(stored), inputs)
+	}
+}
+
+func TestPluginContext_GetEvent(t *testing.T) {
+	repo := &mockEventRepo{}
+	ctx := app.NewPluginContext(&mockPluginContextLogger{}, "/tmp/test.db", "/tmp", repo)
+
+	err := ctx.EmitEvent(context.Background(), pluginsdk.Event{
+		Type:     "test.failed",
+		Source:   "test-plugin",
+		Payload:  map[string]interface{}{"error": "boom"},
+		Metadata: map[string]string{"session_id": "session-1", "env": "ci"},
+	})
+	if err != nil {
+		t.Fatalf("EmitEvent failed: %v", err)
+	}
+
+	reader, ok := ctx.(pluginsdk.EventReader)
+	if !ok {
+		t.Fatal("plugin context should implement pluginsdk.EventReader")
+	}
+
+	event, err := reader.GetEvent(context.Background(), repo.events[0].ID)
+	if err != nil {
+		t.Fatalf("GetEvent failed: %v", err)
+	}
+	if event.Type != "test.failed" || event.Source != "test-plugin" {
+		t.Errorf("got type %q source %q, want test.failed from test-plugin", event.Type, event.Source)
+	}
+	if event.Payload["error"] != "boom" {
+		t.Errorf("expected payload to be unwrapped, got %v", event.Payload)
+	}
+	if event.Metadata["session_id"] != "session-1" || event.Metadata["env"] != "ci" {
+		t.Errorf("unexpected metadata: %v", event.Metadata)
+	}
+
+	_, err = reader.GetEvent(context.Background(), "missing")
+	if !errors.Is(err, pluginsdk.ErrNotFound) {
+		t.Errorf("expected ErrNotFound for unknown event, got %v", err)
+	}
+}
@@ -0,0 +1,112 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+)
+
+// Plugin list output formats ('dw plugin list --format')
+const (
+	PluginListFormatText  = "text"
+	PluginListFormatJSON  = "json"
+	PluginListFormatTable = "table"
+)
+
+// PluginListEntry describes one plugin in 'dw plugin list --format json|table'.
+// Plugins that failed to load are listed with Error set; disabled plugins with
+// Enabled false.
+type PluginListEntry struct {
+	Name         string   `json:"name"`
+	Version      string   `json:"version"`
+	Description  string   `json:"description"`
+	IsCore       bool     `json:"is_core"`
+	Enabled      bool     `json:"enabled"`
+	Capabilities []string `json:"capabilities"`
+	Commands     []string `json:"commands"`
+	Error        string   `json:"error,omitempty"`
+}
+
+// BuildPluginList flattens a plugin catalog into list entries: registered plugins,
+// plugins that failed to load or introspect (with Error), and the plugins disabled in
+// plugins.yaml. Entries are sorted by name.
+func BuildPluginList(catalog *PluginCatalog, disabled []string) []PluginListEntry {
+	entries := []PluginListEntry{}
+	for _, plugin := range catalog.Plugins {
+		commands := make([]string, 0, len(plugin.Commands))
+		for _, cmd := range plugin.Commands {
+			commands = append(commands, cmd.Name)
+		}
+		entries = append(entries, PluginListEntry{
+			Name:         plugin.Name,
+			Version:      plugin.Version,
+			Description:  plugin.Description,
+			IsCore:       plugin.IsCore,
+			Enabled:      true,
+			Capabilities: plugin.Capabilities,
+			Commands:     commands,
+		})
+	}
+	for _, loadErr := range catalog.Errors {
+		entries = append(entries, PluginListEntry{
+			Name:         loadErr.Plugin,
+			Enabled:      true,
+			Capabilities: []string{},
+			Commands:     []string{},
+			Error:        loadErr.Error,
+		})
+	}
+	for _, name := range disabled {
+		entries = append(entries, PluginListEntry{
+			Name:         name,
+			Capabilities: []string{},
+			Commands:     []string{},
+		})
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Name < entries[j].Name
+	})
+	return entries
+}
+
+// FormatPluginListAsJSON writes the entries as an indented JSON array
+func FormatPluginListAsJSON(w io.Writer, entries []PluginListEntry) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(entries); err != nil {
+		return fmt.Errorf("failed to encode plugin list: %w", err)
+	}
+	return nil
+}
+
+// FormatPluginListAsTable writes the entries as aligned columns, one plugin per row.
+// The last column holds the description, or the error of a plugin that failed to load.
+func FormatPluginListAsTable(w io.Writer, entries []PluginListEntry) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "NAME\tVERSION\tTYPE\tSTATUS\tCOMMANDS\tDESCRIPTION")
+	for _, entry := range entries {
+		pluginType := "external"
+		if entry.IsCore {
+			pluginType = "core"
+		}
+
+		status, description := "enabled", entry.Description
+		switch {
+		case entry.Error != "":
+			status, description = "error", entry.Error
+		case !entry.Enabled:
+			status = "disabled"
+		}
+
+		version := entry.Version
+		if version == "" {
+			version = "-"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%d\t%s\n", entry.Name, version, pluginType, status, len(entry.Commands), strings.TrimSpace(description))
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,113 @@
+package app_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/kgatilin/darwinflow-pub/internal/app"
+)
+
+func newPluginListCatalog() *app.PluginCatalog {
+	return &app.PluginCatalog{
+		SchemaVersion: app.PluginCatalogSchemaVersion,
+		Plugins: []app.PluginCatalogEntry{
+			{
+				Name:         "zeta",
+				Version:      "2.0.0",
+				Description:  "Zeta plugin",
+				IsCore:       true,
+				Capabilities: []string{"ICommandProvider"},
+				Commands: []app.CommandCatalogEntry{
+					{Name: "init"},
+					{Name: "start"},
+				},
+			},
+		},
+		Errors: []app.PluginLoadError{{Plugin: "broken", Error: "command not found: ./broken"}},
+	}
+}
+
+func TestBuildPluginList(t *testing.T) {
+	entries := app.BuildPluginList(newPluginListCatalog(), []string{"alpha"})
+
+	if len(entries) != 3 {
+		t.Fatalf("expected 3 entries, got %+v", entries)
+	}
+	if entries[0].Name != "alpha" || entries[1].Name != "broken" || entries[2].Name != "zeta" {
+		t.Errorf("entries not sorted by name: %s, %s, %s", entries[0].Name, entries[1].Name, entries[2].Name)
+	}
+
+	if alpha := entries[0]; alpha.Enabled || alpha.Error != "" {
+		t.Errorf("disabled plugin should have enabled=false and no error: %+v", alpha)
+	}
+	if broken := entries[1]; !broken.Enabled || broken.Error != "command not found: ./broken" {
+		t.Errorf("failed plugin should be listed with its error: %+v", broken)
+	}
+	zeta := entries[2]
+	if !zeta.Enabled || !zeta.IsCore || zeta.Version != "2.0.0" {
+		t.Errorf("unexpected registered plugin entry: %+v", zeta)
+	}
+	if len(zeta.Commands) != 2 || zeta.Commands[0] != "init" || zeta.Commands[1] != "start" {
+		t.Errorf("expected command names [init start], got %v", zeta.Commands)
+	}
+}
+
+func TestFormatPluginListAsJSON(t *testing.T) {
+	entries := app.BuildPluginList(newPluginListCatalog(), nil)
+
+	var buf bytes.Buffer
+	if err := app.FormatPluginListAsJSON(&buf, entries); err != nil {
+		t.Fatalf("FormatPluginListAsJSON failed: %v", err)
+	}
+
+	var decoded []map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatalf("output is not valid JSON: %v\n%s", err, buf.String())
+	}
+	if len(decoded) != 2 {
+		t.Fatalf("expected 2 plugins, got %d", len(decoded))
+	}
+
+	broken, zeta := decoded[0], decoded[1]
+	if broken["error"] != "command not found: ./broken" {
+		t.Errorf("expected error field for failed plugin, got %v", broken)
+	}
+	if _, ok := zeta["error"]; ok {
+		t.Errorf("error field should be omitted for loaded plugins, got %v", zeta)
+	}
+	for _, field := range []string{"name", "version", "description", "is_core", "enabled", "capabilities", "commands"} {
+		if _, ok := zeta[field]; !ok {
+			t.Errorf("missing field %q in %v", field, zeta)
+		}
+	}
+}
+
+func TestFormatPluginListAsTable(t *testing.T) {
+	entries := app.BuildPluginList(newPluginListCatalog(), []string{"alpha"})
+
+	var buf bytes.Buffer
+	if err := app.FormatPluginListAsTable(&buf, entries); err != nil {
+		t.Fatalf("FormatPluginListAsTable failed: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+	if len(lines) != 4 {
+		t.Fatalf("expected header and 3 rows, got:\n%s", buf.String())
+	}
+
+	// Columns are aligned: every row starts its STATUS column at the same offset
+	statusCol := strings.Index(lines[0], "STATUS")
+	for _, want := range []struct{ line, status string }{{lines[1], "disabled"}, {lines[2], "error"}, {lines[3], "enabled"}} {
+		if got := strings.Index(want.line, want.status); got != statusCol {
+			t.Errorf("status %q at column %d, want %d:\n%s", want.status, got, statusCol, buf.String())
+		}
+	}
+	if !strings.Contains(lines[2], "command not found: ./broken") {
+		t.Errorf("failed plugin row should show its error: %q", lines[2])
+	}
+	if !strings.Contains(lines[3], "2.0.0") || !strings.Contains(lines[3], "core") {
+		t.Errorf("unexpected row for registered plugin: %q", lines[3])
+	}
+}
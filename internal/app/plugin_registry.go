@@ -3,6 +3,7 @@ package app
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 
 	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
@@ -12,11 +13,14 @@ import (
 // It uses SDK plugin interfaces directly.
 // Routing is capability-based: plugins declare capabilities, registry routes accordingly.
 type PluginRegistry struct {
-	plugins          map[string]pluginsdk.Plugin            // key: plugin name (uses SDK interface)
-	entityProviders  map[string]pluginsdk.IEntityProvider   // key: entity type, value: provider
-	commandProviders map[string]pluginsdk.ICommandProvider  // key: plugin name, value: provider
+	plugins          map[string]pluginsdk.Plugin           // key: plugin name (uses SDK interface)
+	entityProviders  map[string]pluginsdk.IEntityProvider  // key: entity type, value: provider
+	commandProviders map[string]pluginsdk.ICommandProvider // key: plugin name, value: provider
 	eventEmitters    []pluginsdk.IEventEmitter
-	entityUpdaters   map[string]pluginsdk.IEntityUpdater // key: entity type, value: updater
+	eventHandlers    map[string]pluginsdk.IEventHandler         // key: plugin name, value: handler
+	entityUpdaters   map[string]pluginsdk.IEntityUpdater        // key: entity type, value: updater
+	schemaProviders  map[string]pluginsdk.IEntitySchemaProvider // key: plugin name, value: provider
+	entityOwners     map[string]string                          // key: entity type, value: plugin name
 	logger           Logger
 	mu               sync.RWMutex
 }
@@ -28,14 +32,17 @@ func NewPluginRegistry(logger Logger) *PluginRegistry {
 		entityProviders:  make(map[string]pluginsdk.IEntityProvider),
 		commandProviders: make(map[string]pluginsdk.ICommandProvider),
 		eventEmitters:    make([]pluginsdk.IEventEmitter, 0),
+		eventHandlers:    make(map[string]pluginsdk.IEventHandler),
 		entityUpdaters:   make(map[string]pluginsdk.IEntityUpdater),
+		schemaProviders:  make(map[string]pluginsdk.IEntitySchemaProvider),
+		entityOwners:     make(map[string]string),
 		logger:           logger,
 	}
 }
 
 // RegisterPlugin registers a plugin with the system.
 // Accepts plugins implementing the SDK Plugin interface.
-// Returns error if plugin name already exists or entity type conflicts.
+// Returns error if plugin name already exists or entity type conflicts (see checkEntityTypes).
 // Uses capability-based routing to map plugins to their provided capabilities.
 func (r *PluginRegistry) RegisterPlugin(plugin pluginsdk.Plugin) error {
 	r.mu.Lock()
@@ -51,6 +58,11 @@ func (r *PluginRegistry) RegisterPlugin(plugin pluginsdk.Plugin) error {
 	// Get plugin capabilities
 	capabilities := plugin.GetCapabilities()
 
+	// Check entity types before routing anything, so a rejected plugin leaves nothing behind
+	if err := r.checkEntityTypes(plugin, info.Name, capabilities); err != nil {
+		return err
+	}
+
 	// Route based on capabilities
 	if contains(capabilities, "IEntityProvider") {
 		entityProvider, ok := plugin.(pluginsdk.IEntityProvider)
@@ -58,18 +70,10 @@ func (r *PluginRegistry) RegisterPlugin(plugin pluginsdk.Plugin) error {
 			return fmt.Errorf("plugin %s declares IEntityProvider capability but doesn't implement it", info.Name)
 		}
 
-		entityTypes := entityProvider.GetEntityTypes()
-		// Check for entity type conflicts
-		for _, et := range entityTypes {
-			if existingProvider, exists := r.entityProviders[et.Type]; exists {
-				existingInfo := existingProvider.(pluginsdk.Plugin).GetInfo()
-				return fmt.Errorf("entity type %s already provided by plugin %s", et.Type, existingInfo.Name)
-			}
-		}
-
 		// Map entity types to provider
-		for _, et := range entityTypes {
+		for _, et := range entityProvider.GetEntityTypes() {
 			r.entityProviders[et.Type] = entityProvider
+			r.entityOwners[et.Type] = info.Name
 			r.logger.Debug("  - Entity type: %s (capabilities: %v)", et.Type, et.Capabilities)
 		}
 	}
@@ -90,6 +94,14 @@ func (r *PluginRegistry) RegisterPlugin(plugin pluginsdk.Plugin) error {
 		r.eventEmitters = append(r.eventEmitters, eventEmitter)
 	}
 
+	if contains(capabilities, "IEventHandler") {
+		eventHandler, ok := plugin.(pluginsdk.IEventHandler)
+		if !ok {
+			return fmt.Errorf("plugin %s declares IEventHandler capability but doesn't implement it", info.Name)
+		}
+		r.eventHandlers[info.Name] = eventHandler
+	}
+
 	if contains(capabilities, "IEntityUpdater") {
 		entityUpdater, ok := plugin.(pluginsdk.IEntityUpdater)
 		if !ok {
@@ -100,9 +112,18 @@ func (r *PluginRegistry) RegisterPlugin(plugin pluginsdk.Plugin) error {
 		// Map entity types to updater
 		for _, et := range entityTypes {
 			r.entityUpdaters[et.Type] = entityUpdater
+			r.entityOwners[et.Type] = info.Name
 		}
 	}
 
+	if contains(capabilities, "IEntitySchemaProvider") {
+		schemaProvider, ok := plugin.(pluginsdk.IEntitySchemaProvider)
+		if !ok {
+			return fmt.Errorf("plugin %s declares IEntitySchemaProvider capability but doesn't implement it", info.Name)
+		}
+		r.schemaProviders[info.Name] = schemaProvider
+	}
+
 	// Register plugin
 	r.plugins[info.Name] = plugin
 	r.logger.Debug("Registered plugin: %s (version %s) with capabilities: %v", info.Name, info.Version, capabilities)
@@ -110,6 +131,32 @@ func (r *PluginRegistry) RegisterPlugin(plugin pluginsdk.Plugin) error {
 	return nil
 }
 
+// checkEntityTypes checks the entity types a plugin provides or updates. An entity type
+// belongs to exactly one plugin: a type another plugin already has is rejected with
+// pluginsdk.ErrAlreadyExists naming both plugins, so the later plugin is not registered
+// and queries never depend on which of two plugins answers first.
+func (r *PluginRegistry) checkEntityTypes(plugin pluginsdk.Plugin, pluginName string, capabilities []string) error {
+	var entityTypes []pluginsdk.EntityTypeInfo
+	if provider, ok := plugin.(pluginsdk.IEntityProvider); ok && contains(capabilities, "IEntityProvider") {
+		entityTypes = append(entityTypes, provider.GetEntityTypes()...)
+	}
+	if updater, ok := plugin.(pluginsdk.IEntityUpdater); ok && contains(capabilities, "IEntityUpdater") {
+		entityTypes = append(entityTypes, updater.GetEntityTypes()...)
+	}
+
+	for _, et := range entityTypes {
+		if strings.Contains(et.Type, EntityTypeSeparator) {
+			return fmt.Errorf("%w: entity type %q of plugin %s must not contain %q",
+				pluginsdk.ErrInvalidArgument, et.Type, pluginName, EntityTypeSeparator)
+		}
+		if owner, exists := r.entityOwners[et.Type]; exists {
+			return fmt.Errorf("%w: entity type %q of plugin %s is already provided by plugin %s; entity types must be unique across plugins",
+				pluginsdk.ErrAlreadyExists, et.Type, pluginName, owner)
+		}
+	}
+	return nil
+}
+
 // GetPlugin retrieves a plugin by name (returns SDK plugin)
 func (r *PluginRegistry) GetPlugin(name string) (pluginsdk.Plugin, error) {
 	r.mu.RLock()
@@ -123,12 +170,13 @@ func (r *PluginRegistry) GetPlugin(name string) (pluginsdk.Plugin, error) {
 	return plugin, nil
 }
 
-// GetPluginForEntityType retrieves the plugin that provides a given entity type
+// GetPluginForEntityType retrieves the plugin that provides a given entity type,
+// given as is or qualified with its plugin (see QualifiedEntityType)
 func (r *PluginRegistry) GetPluginForEntityType(entityType string) (pluginsdk.Plugin, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
-	provider, exists := r.entityProviders[entityType]
+	provider, exists := r.entityProviders[r.resolveEntityType(entityType)]
 	if !exists {
 		return nil, fmt.Errorf("no plugin found for entity type: %s", entityType)
 	}
@@ -186,11 +234,13 @@ func (r *PluginRegistry) Query(ctx context.Context, query pluginsdk.EntityQuery)
 
 	// If entity type is specified, route to specific provider
 	if query.EntityType != "" {
-		provider, exists := r.entityProviders[query.EntityType]
+		entityType := r.resolveEntityType(query.EntityType)
+		provider, exists := r.entityProviders[entityType]
 		if !exists {
 			return nil, fmt.Errorf("no provider for entity type: %s", query.EntityType)
 		}
 
+		query.EntityType = entityType
 		return provider.Query(ctx, query)
 	}
 
@@ -281,6 +331,51 @@ func (r *PluginRegistry) GetEventEmitters() []pluginsdk.IEventEmitter {
 	return emitters
 }
 
+// GetEventHandler retrieves the event handler of a plugin, i.e. a plugin that
+// declares the IEventHandler capability
+func (r *PluginRegistry) GetEventHandler(pluginName string) (pluginsdk.IEventHandler, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	handler, exists := r.eventHandlers[pluginName]
+	if !exists {
+		if _, registered := r.plugins[pluginName]; registered {
+			return nil, fmt.Errorf("plugin %s does not handle events (no IEventHandler capability)", pluginName)
+		}
+		return nil, fmt.Errorf("plugin not found: %s", pluginName)
+	}
+
+	return handler, nil
+}
+
+// GetEntitySchemaProvider retrieves the schema provider of a plugin, i.e. a plugin that
+// declares the IEntitySchemaProvider capability
+func (r *PluginRegistry) GetEntitySchemaProvider(pluginName string) (pluginsdk.IEntitySchemaProvider, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	provider, exists := r.schemaProviders[pluginName]
+	if !exists {
+		if _, registered := r.plugins[pluginName]; registered {
+			return nil, fmt.Errorf("plugin %s does not describe its entity types (no IEntitySchemaProvider capability)", pluginName)
+		}
+		return nil, fmt.Errorf("plugin not found: %s", pluginName)
+	}
+
+	return provider, nil
+}
+
+// resolveEntityType returns the registered entity type a type or a qualified type
+// ("plugin:type") refers to. A qualified type naming another plugin than the type's
+// owner is returned unchanged, which no plugin provides. Callers must hold r.mu.
+func (r *PluginRegistry) resolveEntityType(entityType string) string {
+	plugin, bare, qualified := strings.Cut(entityType, EntityTypeSeparator)
+	if !qualified || r.entityOwners[bare] != plugin {
+		return entityType
+	}
+	return bare
+}
+
 // contains checks if a string slice contains a specific string
 func contains(slice []string, item string) bool {
 	for _, s := range slice {
@@ -2,6 +2,8 @@ package app_test
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"testing"
 
 	"github.com/kgatilin/darwinflow-pub/internal/app"
@@ -186,6 +188,82 @@ func TestPluginRegistry_RegisterPlugin_EntityTypeConflict(t *testing.T) {
 	}
 }
 
+// TestPluginRegistry_RegisterPlugin_EntityTypeCollision tests that two plugins both
+// declaring "note" cannot be registered together: the later one is rejected as a whole
+func TestPluginRegistry_RegisterPlugin_EntityTypeCollision(t *testing.T) {
+	registry := app.NewPluginRegistry(&app.NoOpLogger{})
+
+	first := NewMockPlugin("notes-a", []pluginsdk.EntityTypeInfo{{Type: "note"}})
+	second := NewMockPlugin("notes-b", []pluginsdk.EntityTypeInfo{{Type: "memo"}, {Type: "note"}})
+	if err := registry.RegisterPlugin(first); err != nil {
+		t.Fatalf("Failed to register first plugin: %v", err)
+	}
+
+	err := registry.RegisterPlugin(second)
+	if !errors.Is(err, pluginsdk.ErrAlreadyExists) {
+		t.Fatalf("Expected ErrAlreadyExists for the colliding plugin, got %v", err)
+	}
+	for _, want := range []string{`"note"`, "notes-a", "notes-b"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("Expected error to mention %s, got: %v", want, err)
+		}
+	}
+
+	// Nothing of the rejected plugin is left behind, not even its other type
+	if _, err := registry.GetPlugin("notes-b"); err == nil {
+		t.Error("Expected the rejected plugin not to be registered")
+	}
+	if _, err := registry.GetPluginForEntityType("memo"); err == nil {
+		t.Error("Expected the rejected plugin's other entity types not to be routed")
+	}
+	if plugin, err := registry.GetPluginForEntityType("note"); err != nil || plugin.GetInfo().Name != "notes-a" {
+		t.Errorf("Expected note to stay with notes-a, got %v, %v", plugin, err)
+	}
+	if types := registry.GetAllEntityTypes(); len(types) != 1 {
+		t.Errorf("Expected only the first plugin's entity type, got %+v", types)
+	}
+
+	// Updating an entity type another plugin provides is a collision too
+	updater := NewMockPlugin("note-editor", []pluginsdk.EntityTypeInfo{{Type: "note"}})
+	updater.capabilities = []string{"IEntityUpdater"}
+	if err := registry.RegisterPlugin(updater); !errors.Is(err, pluginsdk.ErrAlreadyExists) {
+		t.Errorf("Expected ErrAlreadyExists for a plugin updating note, got %v", err)
+	}
+
+	// The separator of qualified types is reserved
+	invalid := NewMockPlugin("odd", []pluginsdk.EntityTypeInfo{{Type: "odd:type"}})
+	if err := registry.RegisterPlugin(invalid); !errors.Is(err, pluginsdk.ErrInvalidArgument) {
+		t.Errorf("Expected ErrInvalidArgument for an entity type containing ':', got %v", err)
+	}
+}
+
+// TestPluginRegistry_QualifiedEntityTypes tests looking up entity types by their
+// plugin-qualified name
+func TestPluginRegistry_QualifiedEntityTypes(t *testing.T) {
+	registry := app.NewPluginRegistry(&app.NoOpLogger{})
+	plugin := NewMockPlugin("notes", []pluginsdk.EntityTypeInfo{{Type: "note"}})
+	plugin.entities = []pluginsdk.IExtensible{NewMockEntity("note-1", "note", nil)}
+	if err := registry.RegisterPlugin(plugin); err != nil {
+		t.Fatalf("Failed to register plugin: %v", err)
+	}
+
+	if got, err := registry.GetPluginForEntityType("notes:note"); err != nil || got.GetInfo().Name != "notes" {
+		t.Errorf("Expected notes:note to resolve to plugin notes, got %v, %v", got, err)
+	}
+	if _, err := registry.GetPluginForEntityType("other:note"); err == nil {
+		t.Error("Expected a type qualified with the wrong plugin not to resolve")
+	}
+
+	results, err := registry.Query(context.Background(), pluginsdk.EntityQuery{EntityType: "notes:note"})
+	if err != nil || len(results) != 1 {
+		t.Errorf("Expected a qualified query to reach the plugin, got %d results, %v", len(results), err)
+	}
+
+	if display := registry.GetEntityTypeDisplay("notes:note"); display.Type != "note" || display.QualifiedType != "notes:note" {
+		t.Errorf("Expected the display of note qualified as notes:note, got %+v", display)
+	}
+}
+
 func TestPluginRegistry_GetPluginForEntityType(t *testing.T) {
 	logger := &app.NoOpLogger{}
 	registry := app.NewPluginRegistry(logger)
@@ -518,6 +596,34 @@ func TestPluginRegistry_EventEmitters(t *testing.T) {
 	}
 }
 
+func TestPluginRegistry_GetEventHandler(t *testing.T) {
+	logger := &app.NoOpLogger{}
+	registry := app.NewPluginRegistry(logger)
+
+	if err := registry.RegisterPlugin(&recordingEventHandler{}); err != nil {
+		t.Fatalf("Failed to register plugin: %v", err)
+	}
+	emitter := &MockEventEmitterPlugin{
+		name:         "event-plugin",
+		version:      "1.0.0",
+		capabilities: []string{"IEventEmitter"},
+	}
+	if err := registry.RegisterPlugin(emitter); err != nil {
+		t.Fatalf("Failed to register plugin: %v", err)
+	}
+
+	if handler, err := registry.GetEventHandler("recorder"); err != nil || handler == nil {
+		t.Errorf("GetEventHandler(recorder) = %v, %v; want the handler", handler, err)
+	}
+	// A plugin without the capability and an unknown plugin are both errors
+	if _, err := registry.GetEventHandler("event-plugin"); err == nil {
+		t.Error("Expected error for a plugin that does not handle events")
+	}
+	if _, err := registry.GetEventHandler("nonexistent"); err == nil {
+		t.Error("Expected error for an unknown plugin")
+	}
+}
+
 func TestPluginRegistry_MultipleCapabilities(t *testing.T) {
 	logger := &app.NoOpLogger{}
 	registry := app.NewPluginRegistry(logger)
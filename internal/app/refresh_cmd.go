@@ -2,10 +2,14 @@ package app
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"io"
+	"sort"
+	"strings"
 
 	"github.com/kgatilin/darwinflow-pub/internal/domain"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
 )
 
 // RefreshCommandHandler handles the refresh command logic.
@@ -18,6 +22,14 @@ type RefreshCommandHandler struct {
 	out          io.Writer
 }
 
+// HookInstaller installs the hooks that feed events into DarwinFlow.
+// claude_code.HookConfigManager implements it.
+type HookInstaller interface {
+	MissingDarwinFlowHooks() ([]string, error)
+	InstallDarwinFlowHooks() error
+	GetSettingsPath() string
+}
+
 // ConfigLoader interface for config loading
 type ConfigLoader interface {
 	LoadConfig(path string) (*domain.Config, error)
@@ -83,3 +95,165 @@ func (h *RefreshCommandHandler) Execute(ctx context.Context, dbPath string) erro
 
 	return nil
 }
+
+// PlanSchema reports what Execute and MigratePlugins would change without touching
+// the database or configuration
+func (h *RefreshCommandHandler) PlanSchema(ctx context.Context, dbPath string, plugins []pluginsdk.Plugin) error {
+	fmt.Fprintln(h.out, "Schema phase (dry run):")
+	fmt.Fprintf(h.out, "  Would update database schema: %s\n", dbPath)
+
+	if config, err := h.configLoader.LoadConfig(""); err != nil || config == nil {
+		fmt.Fprintln(h.out, "  Would create default configuration")
+	} else {
+		fmt.Fprintln(h.out, "  Configuration is valid, no changes")
+	}
+
+	migrators, err := orderSchemaMigrators(plugins)
+	if err != nil {
+		return err
+	}
+	for _, migrator := range migrators {
+		fmt.Fprintf(h.out, "  Would migrate plugin schema: %s\n", migrator.GetInfo().Name)
+	}
+
+	return nil
+}
+
+// RefreshHooks installs the hooks missing from the settings file, reporting each one.
+// With dryRun it only lists them. Hooks that are already present are left untouched.
+func (h *RefreshCommandHandler) RefreshHooks(hooks HookInstaller, dryRun bool) error {
+	if dryRun {
+		fmt.Fprintln(h.out, "Hooks phase (dry run):")
+	} else {
+		fmt.Fprintln(h.out, "Refreshing hooks...")
+	}
+
+	missing, err := hooks.MissingDarwinFlowHooks()
+	if err != nil {
+		return fmt.Errorf("error reading hooks from %s: %w", hooks.GetSettingsPath(), err)
+	}
+	if len(missing) == 0 {
+		fmt.Fprintf(h.out, "✓ Hooks are up to date: %s\n", hooks.GetSettingsPath())
+		return nil
+	}
+
+	if dryRun {
+		fmt.Fprintf(h.out, "  Would install %d hook(s) in %s:\n", len(missing), hooks.GetSettingsPath())
+		for _, hook := range missing {
+			fmt.Fprintf(h.out, "    + %s\n", hook)
+		}
+		return nil
+	}
+
+	if err := hooks.InstallDarwinFlowHooks(); err != nil {
+		return fmt.Errorf("error installing hooks: %w", err)
+	}
+	fmt.Fprintf(h.out, "✓ Installed %d hook(s) in %s:\n", len(missing), hooks.GetSettingsPath())
+	for _, hook := range missing {
+		fmt.Fprintf(h.out, "    + %s\n", hook)
+	}
+
+	return nil
+}
+
+// ReindexPayloads rebuilds the event payload index for events stored before a key
+// was added to the indexed set. Repositories without a payload index are skipped.
+func (h *RefreshCommandHandler) ReindexPayloads(ctx context.Context) error {
+	indexer, ok := h.repo.(domain.PayloadIndexer)
+	if !ok {
+		fmt.Fprintln(h.out, "Event repository does not maintain a payload index, skipping reindex")
+		return nil
+	}
+
+	fmt.Fprintln(h.out, "Reindexing event payloads...")
+	count, err := indexer.ReindexPayloads(ctx)
+	if err != nil {
+		return fmt.Errorf("error reindexing event payloads: %w", err)
+	}
+	fmt.Fprintf(h.out, "✓ Reindexed payloads of %d events\n", count)
+
+	return nil
+}
+
+// MigratePlugins runs the schema migrations of every plugin implementing
+// pluginsdk.ISchemaMigrator, dependencies first, and reports each migrated plugin.
+// It stops at the first failing plugin so that dependents never run against an
+// outdated schema.
+func (h *RefreshCommandHandler) MigratePlugins(ctx context.Context, db *sql.DB, plugins []pluginsdk.Plugin) error {
+	migrators, err := orderSchemaMigrators(plugins)
+	if err != nil {
+		return err
+	}
+	if len(migrators) == 0 {
+		return nil
+	}
+
+	fmt.Fprintln(h.out, "Migrating plugin schemas...")
+	for _, migrator := range migrators {
+		name := migrator.GetInfo().Name
+		if err := migrator.Migrate(ctx, db); err != nil {
+			return fmt.Errorf("error migrating plugin %s: %w", name, err)
+		}
+		fmt.Fprintf(h.out, "✓ Plugin schema migrated: %s\n", name)
+	}
+
+	return nil
+}
+
+// orderSchemaMigrators returns the schema migrators among plugins so that each comes
+// after the migrators it depends on. Independent plugins are ordered by name.
+func orderSchemaMigrators(plugins []pluginsdk.Plugin) ([]pluginsdk.ISchemaMigrator, error) {
+	byName := make(map[string]pluginsdk.ISchemaMigrator)
+	names := make([]string, 0)
+	for _, plugin := range plugins {
+		migrator, ok := plugin.(pluginsdk.ISchemaMigrator)
+		if !ok {
+			continue
+		}
+		name := migrator.GetInfo().Name
+		byName[name] = migrator
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	state := make(map[string]int, len(names))
+	ordered := make([]pluginsdk.ISchemaMigrator, 0, len(names))
+
+	var visit func(name string, path []string) error
+	visit = func(name string, path []string) error {
+		switch state[name] {
+		case visited:
+			return nil
+		case visiting:
+			return fmt.Errorf("plugin migration dependency cycle: %s", strings.Join(append(path, name), " -> "))
+		}
+		state[name] = visiting
+
+		deps := append([]string(nil), byName[name].MigrationDependencies()...)
+		sort.Strings(deps)
+		for _, dep := range deps {
+			if _, ok := byName[dep]; !ok {
+				continue
+			}
+			if err := visit(dep, append(path, name)); err != nil {
+				return err
+			}
+		}
+
+		state[name] = visited
+		ordered = append(ordered, byName[name])
+		return nil
+	}
+
+	for _, name := range names {
+		if err := visit(name, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	return ordered, nil
+}
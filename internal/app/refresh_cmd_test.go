@@ -3,6 +3,7 @@ package app_test
 import (
 	"bytes"
 	"context"
+	"database/sql"
 	"fmt"
 	"strings"
 	"testing"
@@ -162,3 +163,220 @@ func TestRefreshCommandHandler_Execute_WithPlugins(t *testing.T) {
 		t.Errorf("Output should suggest plugin-specific refresh, got: %s", output)
 	}
 }
+
+// mockIndexingEventRepository is a mock event repository with a payload index
+type mockIndexingEventRepository struct {
+	mockEventRepository
+	reindexed int
+}
+
+func (m *mockIndexingEventRepository) ReindexPayloads(ctx context.Context) (int, error) {
+	return m.reindexed, nil
+}
+
+func TestRefreshCommandHandler_ReindexPayloads(t *testing.T) {
+	ctx := context.Background()
+	out := &bytes.Buffer{}
+
+	handler := app.NewRefreshCommandHandler(&mockIndexingEventRepository{reindexed: 42}, &mockConfigLoader{}, &mockLogger{}, out)
+
+	if err := handler.ReindexPayloads(ctx); err != nil {
+		t.Fatalf("ReindexPayloads failed: %v", err)
+	}
+	if !strings.Contains(out.String(), "Reindexed payloads of 42 events") {
+		t.Errorf("Output should report reindexed events, got: %s", out.String())
+	}
+}
+
+func TestRefreshCommandHandler_ReindexPayloads_Unsupported(t *testing.T) {
+	ctx := context.Background()
+	out := &bytes.Buffer{}
+
+	handler := app.NewRefreshCommandHandler(&mockEventRepository{}, &mockConfigLoader{}, &mockLogger{}, out)
+
+	if err := handler.ReindexPayloads(ctx); err != nil {
+		t.Fatalf("ReindexPayloads failed: %v", err)
+	}
+	if !strings.Contains(out.String(), "skipping reindex") {
+		t.Errorf("Output should report skipped reindex, got: %s", out.String())
+	}
+}
+
+// migratingPlugin is a MockPlugin that also implements pluginsdk.ISchemaMigrator
+type migratingPlugin struct {
+	*MockPlugin
+	deps       []string
+	migrateErr error
+	migrated   *[]string
+}
+
+func (p *migratingPlugin) Migrate(ctx context.Context, db *sql.DB) error {
+	if p.migrateErr != nil {
+		return p.migrateErr
+	}
+	*p.migrated = append(*p.migrated, p.name)
+	return nil
+}
+
+func (p *migratingPlugin) MigrationDependencies() []string {
+	return p.deps
+}
+
+func TestRefreshCommandHandler_MigratePlugins_DependencyOrder(t *testing.T) {
+	var migrated []string
+	plugins := []pluginsdk.Plugin{
+		&migratingPlugin{MockPlugin: NewMockPlugin("reports", nil), deps: []string{"task-manager", "not-installed"}, migrated: &migrated},
+		NewMockPlugin("claude-code", nil),
+		&migratingPlugin{MockPlugin: NewMockPlugin("task-manager", nil), deps: []string{"base"}, migrated: &migrated},
+		&migratingPlugin{MockPlugin: NewMockPlugin("base", nil), migrated: &migrated},
+		&migratingPlugin{MockPlugin: NewMockPlugin("archive", nil), migrated: &migrated},
+	}
+	out := &bytes.Buffer{}
+	handler := app.NewRefreshCommandHandler(&mockEventRepository{}, &mockConfigLoader{}, &mockLogger{}, out)
+
+	if err := handler.MigratePlugins(context.Background(), nil, plugins); err != nil {
+		t.Fatalf("MigratePlugins failed: %v", err)
+	}
+
+	want := "archive,base,task-manager,reports"
+	if got := strings.Join(migrated, ","); got != want {
+		t.Errorf("migration order = %s, want %s", got, want)
+	}
+	for _, name := range []string{"archive", "base", "task-manager", "reports"} {
+		if !strings.Contains(out.String(), "Plugin schema migrated: "+name) {
+			t.Errorf("Output should report %s as migrated, got: %s", name, out.String())
+		}
+	}
+	if strings.Contains(out.String(), "claude-code") {
+		t.Errorf("Plugins without migrations should not be reported, got: %s", out.String())
+	}
+}
+
+func TestRefreshCommandHandler_MigratePlugins_Cycle(t *testing.T) {
+	var migrated []string
+	plugins := []pluginsdk.Plugin{
+		&migratingPlugin{MockPlugin: NewMockPlugin("a", nil), deps: []string{"b"}, migrated: &migrated},
+		&migratingPlugin{MockPlugin: NewMockPlugin("b", nil), deps: []string{"a"}, migrated: &migrated},
+	}
+	handler := app.NewRefreshCommandHandler(&mockEventRepository{}, &mockConfigLoader{}, &mockLogger{}, &bytes.Buffer{})
+
+	err := handler.MigratePlugins(context.Background(), nil, plugins)
+	if err == nil || !strings.Contains(err.Error(), "cycle") {
+		t.Fatalf("expected dependency cycle error, got %v", err)
+	}
+	if len(migrated) != 0 {
+		t.Errorf("no plugin should migrate when the order is invalid, got %v", migrated)
+	}
+}
+
+func TestRefreshCommandHandler_MigratePlugins_StopsOnError(t *testing.T) {
+	var migrated []string
+	plugins := []pluginsdk.Plugin{
+		&migratingPlugin{MockPlugin: NewMockPlugin("base", nil), migrateErr: fmt.Errorf("disk full"), migrated: &migrated},
+		&migratingPlugin{MockPlugin: NewMockPlugin("reports", nil), deps: []string{"base"}, migrated: &migrated},
+	}
+	handler := app.NewRefreshCommandHandler(&mockEventRepository{}, &mockConfigLoader{}, &mockLogger{}, &bytes.Buffer{})
+
+	err := handler.MigratePlugins(context.Background(), nil, plugins)
+	if err == nil || !strings.Contains(err.Error(), "base") {
+		t.Fatalf("expected error naming the failing plugin, got %v", err)
+	}
+	if len(migrated) != 0 {
+		t.Errorf("dependents must not migrate after a failure, got %v", migrated)
+	}
+}
+
+func TestRefreshCommandHandler_PlanSchema(t *testing.T) {
+	initialized := false
+	mockRepo := &mockEventRepository{
+		initializeFunc: func(ctx context.Context) error {
+			initialized = true
+			return nil
+		},
+	}
+	mockConfigLdr := &mockConfigLoader{
+		loadConfigFunc: func(path string) (*domain.Config, error) {
+			return nil, nil // Config doesn't exist
+		},
+		initializeDefaultConfigFunc: func(path string) (string, error) {
+			t.Error("PlanSchema must not create the config")
+			return "", nil
+		},
+	}
+	var migrated []string
+	plugins := []pluginsdk.Plugin{
+		&migratingPlugin{MockPlugin: NewMockPlugin("reports", nil), deps: []string{"base"}, migrated: &migrated},
+		&migratingPlugin{MockPlugin: NewMockPlugin("base", nil), migrated: &migrated},
+	}
+	out := &bytes.Buffer{}
+	handler := app.NewRefreshCommandHandler(mockRepo, mockConfigLdr, &mockLogger{}, out)
+
+	if err := handler.PlanSchema(context.Background(), "/test/db/path.db", plugins); err != nil {
+		t.Fatalf("PlanSchema failed: %v", err)
+	}
+	if initialized || len(migrated) != 0 {
+		t.Errorf("PlanSchema must not change anything (initialized=%v, migrated=%v)", initialized, migrated)
+	}
+
+	output := out.String()
+	for _, want := range []string{
+		"Would update database schema: /test/db/path.db",
+		"Would create default configuration",
+		"Would migrate plugin schema: base\n  Would migrate plugin schema: reports",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("Output should contain %q, got: %s", want, output)
+		}
+	}
+}
+
+// mockHookInstaller is a mock for testing the hooks phase
+type mockHookInstaller struct {
+	missing   []string
+	installed bool
+}
+
+func (m *mockHookInstaller) MissingDarwinFlowHooks() ([]string, error) {
+	return m.missing, nil
+}
+
+func (m *mockHookInstaller) InstallDarwinFlowHooks() error {
+	m.installed = true
+	return nil
+}
+
+func (m *mockHookInstaller) GetSettingsPath() string {
+	return ".claude/settings.json"
+}
+
+func TestRefreshCommandHandler_RefreshHooks(t *testing.T) {
+	tests := []struct {
+		name          string
+		missing       []string
+		dryRun        bool
+		wantInstalled bool
+		wantOutput    string
+	}{
+		{"up to date", nil, false, false, "Hooks are up to date"},
+		{"installs missing", []string{"Stop: dw claude emit-event"}, false, true, "Installed 1 hook(s) in .claude/settings.json:\n    + Stop: dw claude emit-event"},
+		{"dry run", []string{"Stop: dw claude emit-event"}, true, false, "Would install 1 hook(s)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hooks := &mockHookInstaller{missing: tt.missing}
+			out := &bytes.Buffer{}
+			handler := app.NewRefreshCommandHandler(&mockEventRepository{}, &mockConfigLoader{}, &mockLogger{}, out)
+
+			if err := handler.RefreshHooks(hooks, tt.dryRun); err != nil {
+				t.Fatalf("RefreshHooks failed: %v", err)
+			}
+			if hooks.installed != tt.wantInstalled {
+				t.Errorf("installed = %v, want %v", hooks.installed, tt.wantInstalled)
+			}
+			if !strings.Contains(out.String(), tt.wantOutput) {
+				t.Errorf("Output should contain %q, got: %s", tt.wantOutput, out.String())
+			}
+		})
+	}
+}
@@ -43,12 +43,16 @@ func (m *MockEventRepository) FindByQuery(ctx context.Context, query pluginsdk.E
 		return nil, m.queryError
 	}
 	sessionID := query.Metadata["session_id"]
-	if sessionID != "" {
+	if sessionID != "" || query.EventSource != "" || query.EventCategory != "" {
 		var result []*domain.Event
 		for _, e := range m.events {
-			if e.SessionID == sessionID {
-				result = append(result, e)
+			if sessionID != "" && e.SessionID != sessionID {
+				continue
 			}
+			if !domain.ParseEventKind(e.Type).Matches(query.EventSource, query.EventCategory) {
+				continue
+			}
+			result = append(result, e)
 		}
 		return result, nil
 	}
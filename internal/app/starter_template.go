@@ -0,0 +1,213 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// StarterTemplateNone selects no starter template: 'dw init' leaves the project empty
+const StarterTemplateNone = "none"
+
+// starterTemplatePlugin is the plugin whose commands seed a starter roadmap
+const starterTemplatePlugin = "task-manager"
+
+// StarterTemplate describes the example roadmap 'dw init --template' seeds into
+// a fresh project. Every seeded item is labelled as an example so it is easy to
+// recognize and delete.
+type StarterTemplate struct {
+	Name            string
+	Description     string
+	Vision          string
+	SuccessCriteria string
+	Tracks          []StarterTrack
+	Iteration       StarterIteration
+}
+
+// StarterTrack is an example track of a starter template
+type StarterTrack struct {
+	Title       string
+	Description string
+}
+
+// StarterIteration is the example first iteration of a starter template
+type StarterIteration struct {
+	Name        string
+	Goal        string
+	Deliverable string
+}
+
+var starterTemplates = []StarterTemplate{
+	{
+		Name:            "web-app",
+		Description:     "Web application with frontend and backend tracks",
+		Vision:          "[Example] Ship a web application that solves one problem well for its first users",
+		SuccessCriteria: "[Example] First users complete the core workflow without help",
+		Tracks: []StarterTrack{
+			{
+				Title:       "Example: Backend API",
+				Description: "Example track: endpoints, persistence and authentication. Replace or delete it.",
+			},
+			{
+				Title:       "Example: Frontend",
+				Description: "Example track: pages and components of the core workflow. Replace or delete it.",
+			},
+		},
+		Iteration: StarterIteration{
+			Name:        "Example: Walking skeleton",
+			Goal:        "Example goal: the core workflow works end to end, however roughly",
+			Deliverable: "Example deliverable: a deployed build users can click through",
+		},
+	},
+	{
+		Name:            "library",
+		Description:     "Reusable library with API and documentation tracks",
+		Vision:          "[Example] Publish a small, well-documented library others can depend on",
+		SuccessCriteria: "[Example] A first release is published and used by one other project",
+		Tracks: []StarterTrack{
+			{
+				Title:       "Example: Public API",
+				Description: "Example track: the types and functions users call. Replace or delete it.",
+			},
+			{
+				Title:       "Example: Documentation",
+				Description: "Example track: README, usage examples and API docs. Replace or delete it.",
+			},
+		},
+		Iteration: StarterIteration{
+			Name:        "Example: First release",
+			Goal:        "Example goal: the smallest useful API is stable and documented",
+			Deliverable: "Example deliverable: a tagged v0.1.0 release",
+		},
+	},
+}
+
+// StarterTemplates returns the available starter templates sorted by name
+func StarterTemplates() []StarterTemplate {
+	templates := make([]StarterTemplate, len(starterTemplates))
+	copy(templates, starterTemplates)
+	sort.Slice(templates, func(i, j int) bool { return templates[i].Name < templates[j].Name })
+	return templates
+}
+
+// FindStarterTemplate returns the starter template called name
+func FindStarterTemplate(name string) (StarterTemplate, error) {
+	for _, tmpl := range starterTemplates {
+		if tmpl.Name == name {
+			return tmpl, nil
+		}
+	}
+	names := []string{StarterTemplateNone}
+	for _, tmpl := range StarterTemplates() {
+		names = append(names, tmpl.Name)
+	}
+	return StarterTemplate{}, fmt.Errorf("%w: unknown template %q (available: %s)", pluginsdk.ErrInvalidArgument, name, strings.Join(names, ", "))
+}
+
+// FormatStarterTemplates writes the list printed by 'dw init --list-templates'
+func FormatStarterTemplates(out io.Writer) {
+	fmt.Fprintln(out, "Available templates:")
+	for _, tmpl := range StarterTemplates() {
+		fmt.Fprintf(out, "  %-10s %s\n", tmpl.Name, tmpl.Description)
+	}
+	fmt.Fprintf(out, "  %-10s %s\n", StarterTemplateNone, "Empty project (default)")
+}
+
+// CommandExecutor runs a plugin command. CommandRegistry implements it.
+type CommandExecutor interface {
+	ExecuteCommand(ctx context.Context, pluginName, commandName string, args []string, cmdCtx pluginsdk.CommandContext) error
+}
+
+// roadmapExists asks the task-manager plugin whether the project has a roadmap.
+// Only ErrNotFound means there is none; any other failure is returned. The
+// roadmap details the command prints are discarded.
+func roadmapExists(ctx context.Context, executor CommandExecutor, cmdCtx pluginsdk.CommandContext) (bool, error) {
+	err := executor.ExecuteCommand(ctx, starterTemplatePlugin, "roadmap show", nil, quietCommandContext{cmdCtx})
+	if errors.Is(err, pluginsdk.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to check for a roadmap (%s roadmap show): %w", starterTemplatePlugin, err)
+	}
+	return true, nil
+}
+
+// quietCommandContext discards the output of the command it is passed to
+type quietCommandContext struct {
+	pluginsdk.CommandContext
+}
+
+func (quietCommandContext) GetStdout() io.Writer {
+	return io.Discard
+}
+
+// StarterTemplateSeeder seeds a starter template through the task-manager
+// plugin's commands, so the framework never touches the plugin's storage
+type StarterTemplateSeeder struct {
+	executor CommandExecutor
+	out      io.Writer
+}
+
+// NewStarterTemplateSeeder creates a seeder reporting progress to out
+func NewStarterTemplateSeeder(executor CommandExecutor, out io.Writer) *StarterTemplateSeeder {
+	return &StarterTemplateSeeder{executor: executor, out: out}
+}
+
+// Seed creates the template's roadmap, tracks and first iteration. Plugin
+// command output goes to cmdCtx. A project that already has a roadmap is left
+// untouched, and a failure to check for one is returned before anything is
+// created; seeded reports whether anything was created.
+func (s *StarterTemplateSeeder) Seed(ctx context.Context, tmpl StarterTemplate, cmdCtx pluginsdk.CommandContext) (seeded bool, err error) {
+	exists, err := roadmapExists(ctx, s.executor, cmdCtx)
+	if err != nil {
+		return false, err
+	}
+	if exists {
+		fmt.Fprintf(s.out, "Roadmap already exists, template %s not applied\n", tmpl.Name)
+		return false, nil
+	}
+
+	if err := s.execute(ctx, cmdCtx, "roadmap init",
+		"--vision", tmpl.Vision,
+		"--success-criteria", tmpl.SuccessCriteria,
+	); err != nil {
+		return false, err
+	}
+	for i, track := range tmpl.Tracks {
+		if err := s.execute(ctx, cmdCtx, "track create",
+			"--title", track.Title,
+			"--description", track.Description,
+			"--rank", strconv.Itoa((i+1)*100),
+		); err != nil {
+			return true, err
+		}
+	}
+	if err := s.execute(ctx, cmdCtx, "iteration create",
+		"--name", tmpl.Iteration.Name,
+		"--goal", tmpl.Iteration.Goal,
+		"--deliverable", tmpl.Iteration.Deliverable,
+	); err != nil {
+		return true, err
+	}
+
+	fmt.Fprintf(s.out, "✓ Seeded starter roadmap from template %s (%d example tracks, 1 example iteration)\n", tmpl.Name, len(tmpl.Tracks))
+	fmt.Fprintln(s.out, "  Example items are labelled \"Example\". Remove them with:")
+	fmt.Fprintln(s.out, "    dw task-manager track list")
+	fmt.Fprintln(s.out, "    dw task-manager track delete <track-id> --force")
+	fmt.Fprintln(s.out, "    dw task-manager iteration delete <number>")
+	fmt.Fprintln(s.out, "  and replace the vision with 'dw task-manager roadmap update'")
+	return true, nil
+}
+
+func (s *StarterTemplateSeeder) execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, command string, args ...string) error {
+	if err := s.executor.ExecuteCommand(ctx, starterTemplatePlugin, command, args, cmdCtx); err != nil {
+		return fmt.Errorf("failed to seed template (%s %s): %w", starterTemplatePlugin, command, err)
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+package app_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/kgatilin/darwinflow-pub/internal/app"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// recordingExecutor records the plugin commands it is asked to run
+type recordingExecutor struct {
+	roadmapExists bool
+	showErr       error
+	failOn        string
+	commands      []string
+	args          [][]string
+}
+
+func (e *recordingExecutor) ExecuteCommand(ctx context.Context, pluginName, commandName string, args []string, cmdCtx pluginsdk.CommandContext) error {
+	if commandName == "roadmap show" {
+		if e.showErr != nil {
+			return e.showErr
+		}
+		if e.roadmapExists {
+			if cmdCtx != nil {
+				fmt.Fprintln(cmdCtx.GetStdout(), "Roadmap:")
+			}
+			return nil
+		}
+		return fmt.Errorf("failed to get roadmap: %w", pluginsdk.ErrNotFound)
+	}
+	if commandName == e.failOn {
+		return errors.New("boom")
+	}
+	e.commands = append(e.commands, pluginName+" "+commandName)
+	e.args = append(e.args, args)
+	return nil
+}
+
+func TestFindStarterTemplate(t *testing.T) {
+	for _, tmpl := range app.StarterTemplates() {
+		found, err := app.FindStarterTemplate(tmpl.Name)
+		if err != nil {
+			t.Fatalf("FindStarterTemplate(%q): %v", tmpl.Name, err)
+		}
+		if len(found.Tracks) == 0 || found.Vision == "" || found.Iteration.Name == "" {
+			t.Errorf("template %q is incomplete: %+v", tmpl.Name, found)
+		}
+		if !strings.Contains(found.Vision, "Example") {
+			t.Errorf("template %q vision is not marked as an example", tmpl.Name)
+		}
+		for _, track := range found.Tracks {
+			if !strings.HasPrefix(track.Title, "Example:") {
+				t.Errorf("template %q track %q is not marked as an example", tmpl.Name, track.Title)
+			}
+		}
+	}
+
+	_, err := app.FindStarterTemplate("game")
+	if !errors.Is(err, pluginsdk.ErrInvalidArgument) {
+		t.Fatalf("expected invalid argument error, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "web-app") {
+		t.Errorf("expected the error to list the templates, got %v", err)
+	}
+}
+
+func TestFormatStarterTemplates(t *testing.T) {
+	var out bytes.Buffer
+	app.FormatStarterTemplates(&out)
+	for _, name := range []string{"web-app", "library", "none"} {
+		if !strings.Contains(out.String(), name) {
+			t.Errorf("expected %q in template list, got:\n%s", name, out.String())
+		}
+	}
+}
+
+func TestStarterTemplateSeeder_Seed(t *testing.T) {
+	tmpl, _ := app.FindStarterTemplate("web-app")
+	executor := &recordingExecutor{}
+	var out bytes.Buffer
+
+	seeded, err := app.NewStarterTemplateSeeder(executor, &out).Seed(context.Background(), tmpl, nil)
+	if err != nil {
+		t.Fatalf("Seed: %v", err)
+	}
+	if !seeded {
+		t.Fatal("expected the template to be seeded")
+	}
+
+	expected := []string{
+		"task-manager roadmap init",
+		"task-manager track create",
+		"task-manager track create",
+		"task-manager iteration create",
+	}
+	if strings.Join(executor.commands, "|") != strings.Join(expected, "|") {
+		t.Fatalf("expected commands %v, got %v", expected, executor.commands)
+	}
+	if got := strings.Join(executor.args[1], " "); !strings.Contains(got, tmpl.Tracks[0].Title) || !strings.Contains(got, "--rank 100") {
+		t.Errorf("unexpected track create args: %v", executor.args[1])
+	}
+	if !strings.Contains(out.String(), "Seeded starter roadmap from template web-app") {
+		t.Errorf("expected a summary, got:\n%s", out.String())
+	}
+}
+
+func TestStarterTemplateSeeder_SkipsExistingRoadmap(t *testing.T) {
+	tmpl, _ := app.FindStarterTemplate("library")
+	executor := &recordingExecutor{roadmapExists: true}
+	var out bytes.Buffer
+
+	seeded, err := app.NewStarterTemplateSeeder(executor, &out).Seed(context.Background(), tmpl, nil)
+	if err != nil {
+		t.Fatalf("Seed: %v", err)
+	}
+	if seeded || len(executor.commands) != 0 {
+		t.Errorf("expected nothing to be seeded, ran %v", executor.commands)
+	}
+	if !strings.Contains(out.String(), "not applied") {
+		t.Errorf("expected a skip notice, got:\n%s", out.String())
+	}
+}
+
+func TestStarterTemplateSeeder_RoadmapCheckFailure(t *testing.T) {
+	tmpl, _ := app.FindStarterTemplate("web-app")
+	executor := &recordingExecutor{showErr: errors.New("database is locked")}
+
+	seeded, err := app.NewStarterTemplateSeeder(executor, &bytes.Buffer{}).Seed(context.Background(), tmpl, nil)
+	if err == nil || !strings.Contains(err.Error(), "database is locked") {
+		t.Fatalf("expected the check failure to be returned, got %v", err)
+	}
+	if seeded || len(executor.commands) != 0 {
+		t.Errorf("expected nothing to be seeded, ran %v", executor.commands)
+	}
+}
+
+func TestStarterTemplateSeeder_CommandFailure(t *testing.T) {
+	tmpl, _ := app.FindStarterTemplate("web-app")
+	executor := &recordingExecutor{failOn: "iteration create"}
+
+	_, err := app.NewStarterTemplateSeeder(executor, &bytes.Buffer{}).Seed(context.Background(), tmpl, nil)
+	if err == nil || !strings.Contains(err.Error(), "iteration create") {
+		t.Fatalf("expected the failing command in the error, got %v", err)
+	}
+}
@@ -18,6 +18,7 @@ type AnalysisViewerModel struct {
 	width    int
 	height   int
 	ready    bool
+	plain    bool
 }
 
 // NewAnalysisViewerModel creates a new analysis viewer
@@ -27,6 +28,11 @@ func NewAnalysisViewerModel(analysis *domain.Analysis) AnalysisViewerModel {
 	}
 }
 
+// SetPlain switches the view between the default and plain rendering modes
+func (m *AnalysisViewerModel) SetPlain(plain bool) {
+	m.plain = plain
+}
+
 // Init initializes the model
 func (m AnalysisViewerModel) Init() tea.Cmd {
 	return nil
@@ -107,10 +113,10 @@ func (m AnalysisViewerModel) headerView() string {
 	} else {
 		viewInfo = fmt.Sprintf("%s: %s", m.analysis.ViewType, m.analysis.ViewID)
 	}
-	breadcrumb := RenderBreadcrumb([]string{"Sessions", viewInfo, "Analysis"})
+	breadcrumb := RenderBreadcrumb([]string{"Sessions", viewInfo, "Analysis"}, m.plain)
 
 	// Title
-	title := PageTitleStyle.Render(fmt.Sprintf("Analysis: %s (%s)", viewInfo, m.analysis.PromptUsed))
+	title := RenderPageTitle(fmt.Sprintf("Analysis: %s (%s)", viewInfo, m.analysis.PromptUsed), m.plain)
 
 	return breadcrumb + "\n\n" + title
 }
@@ -122,7 +128,7 @@ func (m AnalysisViewerModel) footerView() string {
 	)
 
 	// Help hints
-	helpLine := RenderHelpLine(
+	helpLine := RenderHelpLine(m.plain,
 		RenderKeyHelp("j/k or ↑/↓", "scroll"),
 		RenderKeyHelp("g/G", "top/bottom"),
 		RenderKeyHelp("?", "help"),
@@ -131,7 +137,7 @@ func (m AnalysisViewerModel) footerView() string {
 
 	// Divider
 	dividerWidth := max(0, m.width-lipgloss.Width(scrollInfo)-2)
-	divider := RenderDivider(dividerWidth)
+	divider := RenderDivider(dividerWidth, m.plain)
 
 	return fmt.Sprintf("\n%s %s\n%s", divider, scrollInfo, helpLine)
 }
@@ -161,7 +167,7 @@ func (m AnalysisViewerModel) renderContent() string {
 
 	// Use glamour to render the markdown with dark style for better visibility
 	renderer, err := glamour.NewTermRenderer(
-		glamour.WithStandardStyle("dark"),
+		glamour.WithStandardStyle(MarkdownStyle(m.plain)),
 		glamour.WithWordWrap(m.width-4), // Account for padding
 	)
 
@@ -2,6 +2,7 @@ package tui
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -11,12 +12,16 @@ import (
 	"github.com/kgatilin/darwinflow-pub/internal/app"
 	"github.com/kgatilin/darwinflow-pub/internal/domain"
 	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk/tuiguard"
 )
 
 var (
 	spinnerStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("205"))
 )
 
+// sessionEntityType is the plugin entity type browsed by the session list
+const sessionEntityType = "session"
+
 // AppModel is the main orchestrator for the TUI
 type AppModel struct {
 	ctx             context.Context
@@ -53,6 +58,9 @@ type AppModel struct {
 
 	width  int
 	height int
+
+	// Accessibility mode: ASCII labels, no borders, ">" selection marker
+	plain bool
 }
 
 // NewAppModel creates a new TUI application model
@@ -81,6 +89,17 @@ func NewAppModel(
 	}
 }
 
+// SetPlain enables plain rendering (dw ui --plain). Views created afterwards
+// inherit the mode, so call it before the program starts.
+func (m *AppModel) SetPlain(plain bool) {
+	m.plain = plain
+	if plain {
+		m.spinner.Spinner = spinner.Line
+	} else {
+		m.spinner.Spinner = spinner.Dot
+	}
+}
+
 // Init initializes the application
 func (m *AppModel) Init() tea.Cmd {
 	subscribeCmd := m.subscribeToEvents()
@@ -205,6 +224,10 @@ func (m *AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		m.sessions = msg.Sessions
 		m.sessionList = NewSessionListModel(msg.Sessions)
+		m.sessionList.SetPlain(m.plain)
+		if m.pluginRegistry != nil {
+			m.sessionList.SetEntityType(m.pluginRegistry.GetEntityTypeDisplay(sessionEntityType))
+		}
 
 		// Sync the new event count to the session list view
 		m.sessionList.SetNewEventCount(m.newEventCount)
@@ -223,6 +246,7 @@ func (m *AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				if session.SessionID == m.selectedSession.SessionID {
 					m.selectedSession = session
 					m.sessionDetail = NewSessionDetailModel(session)
+					m.sessionDetail.SetPlain(m.plain)
 					m.currentView = ViewSessionDetail
 					// Send initial window size to the detail view and continue listening
 					if m.width > 0 && m.height > 0 {
@@ -253,6 +277,7 @@ func (m *AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case SelectedSessionMsg:
 		m.selectedSession = msg.Session
 		m.sessionDetail = NewSessionDetailModel(msg.Session)
+		m.sessionDetail.SetPlain(m.plain)
 		m.currentView = ViewSessionDetail
 		// Send initial window size to the newly created detail view
 		if m.width > 0 && m.height > 0 {
@@ -298,6 +323,7 @@ func (m *AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		// Use the most recent analysis
 		m.analysisViewer = NewAnalysisViewerModel(analyses[0])
+		m.analysisViewer.SetPlain(m.plain)
 		m.currentView = ViewAnalysisViewer
 		// Send initial window size to the viewer
 		if m.width > 0 && m.height > 0 {
@@ -309,13 +335,14 @@ func (m *AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case ViewLogMsg:
 		// Get the logs for this session
-		logs, err := m.logsService.ListRecentLogs(m.ctx, 0, 0, msg.SessionID, true)
+		logs, err := m.logsService.ListRecentLogs(m.ctx, 0, 0, app.LogFilter{SessionID: msg.SessionID}, true)
 		if err != nil || len(logs) == 0 {
 			m.previousView = m.currentView
 			m.err = fmt.Errorf("no logs found for session")
 			return m, nil
 		}
 		m.logViewer = NewLogViewerModel(msg.SessionID, logs)
+		m.logViewer.SetPlain(m.plain)
 		m.currentView = ViewLogViewer
 		// Send initial window size to the viewer
 		if m.width > 0 && m.height > 0 {
@@ -464,10 +491,10 @@ func (m *AppModel) renderErrorOverlay() string {
 	)
 
 	// Apply border and padding
-	errorBox := lipgloss.NewStyle().
+	errorBox := WithBorder(lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
 		BorderForeground(lipgloss.Color("196")).
-		Padding(1, 2).
+		Padding(1, 2), m.plain).
 		Render(errorContent)
 
 	// Center the error box on screen
@@ -485,7 +512,7 @@ func (m *AppModel) renderErrorOverlay() string {
 func (m *AppModel) loadSessions() tea.Msg {
 	// Query all sessions from the plugin registry
 	entities, err := m.pluginRegistry.Query(m.ctx, pluginsdk.EntityQuery{
-		EntityType: "session",
+		EntityType: sessionEntityType,
 	})
 	if err != nil {
 		return SessionsLoadedMsg{Error: err}
@@ -602,11 +629,20 @@ func Run(
 	logsService *app.LogsService,
 	config *domain.Config,
 	eventDispatcher *app.EventDispatcher,
+	plain bool,
+	crashDir string,
 ) error {
 	m := NewAppModel(ctx, pluginRegistry, analysisService, logsService, config, eventDispatcher)
-	p := tea.NewProgram(m, tea.WithAltScreen())
-
-	if _, err := p.Run(); err != nil {
+	m.SetPlain(plain)
+
+	// A panic restores the terminal and writes a crash log to crashDir instead of
+	// leaving the terminal in raw mode
+	err := tuiguard.RunProgram(m, crashDir, tea.WithAltScreen())
+	var crash *tuiguard.CrashError
+	if errors.As(err, &crash) {
+		return crash
+	}
+	if err != nil {
 		return fmt.Errorf("error running TUI: %w", err)
 	}
 
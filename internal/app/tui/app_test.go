@@ -2,6 +2,7 @@ package tui_test
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
@@ -965,3 +966,24 @@ func TestAppModel_ErrorOverlayRendering(t *testing.T) {
 		t.Error("Error overlay should render non-empty view")
 	}
 }
+
+func TestAppModel_PlainModeAppliesToViews(t *testing.T) {
+	model := tui.NewAppModel(context.Background(), nil, nil, nil, &domain.Config{}, nil)
+	model.SetPlain(true)
+
+	updated, _ := model.Update(tea.WindowSizeMsg{Width: 100, Height: 30})
+	model = updated.(*tui.AppModel)
+
+	sessions := []*tui.SessionInfo{
+		{SessionID: "session-1", ShortID: "sess-1", FirstEvent: time.Now()},
+	}
+	updated, _ = model.Update(tui.SessionsLoadedMsg{Sessions: sessions})
+	model = updated.(*tui.AppModel)
+	updated, _ = model.Update(tea.WindowSizeMsg{Width: 100, Height: 30})
+	model = updated.(*tui.AppModel)
+
+	view := model.View()
+	if !strings.Contains(view, "> "+tui.LabelUnanalyzed+" sess-1") {
+		t.Errorf("session list should render in plain mode, got:\n%s", view)
+	}
+}
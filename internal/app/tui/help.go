@@ -158,7 +158,7 @@ func getDefaultHelp() []HelpSection {
 }
 
 // RenderHelpOverlay renders the help content as a centered overlay
-func RenderHelpOverlay(view ViewState, width, height int) string {
+func RenderHelpOverlay(view ViewState, width, height int, plain bool) string {
 	sections := GetHelpForView(view)
 
 	// Calculate max width for help content
@@ -215,7 +215,7 @@ func RenderHelpOverlay(view ViewState, width, height int) string {
 	content.WriteString(footer)
 
 	// Apply box style
-	helpBox := InfoBoxStyle.
+	helpBox := WithBorder(InfoBoxStyle, plain).
 		Width(maxWidth).
 		Render(content.String())
 
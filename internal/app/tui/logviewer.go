@@ -7,28 +7,29 @@ import (
 
 	"github.com/charmbracelet/bubbles/textinput"
 	"github.com/charmbracelet/bubbles/viewport"
-	"github.com/charmbracelet/glamour"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/glamour"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/kgatilin/darwinflow-pub/internal/app"
 )
 
 // LogViewerModel displays the session log in markdown format
 type LogViewerModel struct {
-	sessionID        string
-	logMarkdown      string
-	logRecords       []*app.LogRecord
-	viewport         viewport.Model
-	width            int
-	height           int
-	ready            bool
-	searchMode       bool
-	searchInput      textinput.Model
-	searchQuery      string
-	matchLines       []int // Line numbers where matches are found
-	currentMatch     int   // Current match index
-	renderedContent  string
+	sessionID          string
+	logMarkdown        string
+	logRecords         []*app.LogRecord
+	viewport           viewport.Model
+	width              int
+	height             int
+	ready              bool
+	searchMode         bool
+	searchInput        textinput.Model
+	searchQuery        string
+	matchLines         []int // Line numbers where matches are found
+	currentMatch       int   // Current match index
+	renderedContent    string
 	highlightedContent string
+	plain              bool
 }
 
 // NewLogViewerModel creates a new log viewer
@@ -55,6 +56,11 @@ func NewLogViewerModel(sessionID string, logs []*app.LogRecord) LogViewerModel {
 	}
 }
 
+// SetPlain switches the view between the default and plain rendering modes
+func (m *LogViewerModel) SetPlain(plain bool) {
+	m.plain = plain
+}
+
 // Init initializes the model
 func (m LogViewerModel) Init() tea.Cmd {
 	return nil
@@ -329,10 +335,10 @@ func (m LogViewerModel) View() string {
 
 func (m LogViewerModel) searchPanelView() string {
 	// Search panel with border
-	searchStyle := lipgloss.NewStyle().
+	searchStyle := WithBorder(lipgloss.NewStyle().
 		BorderStyle(lipgloss.RoundedBorder()).
 		BorderForeground(lipgloss.Color("240")).
-		Padding(0, 1)
+		Padding(0, 1), m.plain)
 
 	matchInfo := ""
 	if len(m.matchLines) > 0 {
@@ -347,7 +353,7 @@ func (m LogViewerModel) searchPanelView() string {
 
 func (m LogViewerModel) headerView() string {
 	// Breadcrumb
-	breadcrumb := RenderBreadcrumb([]string{"Sessions", m.sessionID[:8], "Log"})
+	breadcrumb := RenderBreadcrumb([]string{"Sessions", m.sessionID[:8], "Log"}, m.plain)
 
 	// Title with search info
 	title := fmt.Sprintf("Session Log: %s", m.sessionID[:8])
@@ -356,7 +362,7 @@ func (m LogViewerModel) headerView() string {
 	} else if m.searchQuery != "" {
 		title += " (no matches)"
 	}
-	titleRendered := PageTitleStyle.Render(title)
+	titleRendered := RenderPageTitle(title, m.plain)
 
 	return breadcrumb + "\n\n" + titleRendered
 }
@@ -374,19 +380,19 @@ func (m LogViewerModel) footerView() string {
 		if len(m.matchLines) > 0 {
 			matchInfo = fmt.Sprintf(" (%d matches)", len(m.matchLines))
 		}
-		helpLine = RenderHelpLine(
+		helpLine = RenderHelpLine(m.plain,
 			RenderKeyHelp("Enter", "done"),
 			RenderKeyHelp("Esc", "cancel"),
 		) + HelpTextStyle.Render(matchInfo)
 	} else if m.searchQuery != "" {
-		helpLine = RenderHelpLine(
+		helpLine = RenderHelpLine(m.plain,
 			RenderKeyHelp("j/k", "scroll"),
 			RenderKeyHelp("n/N", "next/prev match"),
 			RenderKeyHelp("/", "edit search"),
 			RenderKeyHelp("Esc", "clear"),
 		)
 	} else {
-		helpLine = RenderHelpLine(
+		helpLine = RenderHelpLine(m.plain,
 			RenderKeyHelp("j/k or ↑/↓", "scroll"),
 			RenderKeyHelp("/", "search"),
 			RenderKeyHelp("?", "help"),
@@ -396,7 +402,7 @@ func (m LogViewerModel) footerView() string {
 
 	// Divider
 	dividerWidth := max(0, m.width-lipgloss.Width(scrollInfo)-2)
-	divider := RenderDivider(dividerWidth)
+	divider := RenderDivider(dividerWidth, m.plain)
 
 	return fmt.Sprintf("\n%s %s\n%s", divider, scrollInfo, helpLine)
 }
@@ -405,7 +411,7 @@ func (m LogViewerModel) footerView() string {
 func (m *LogViewerModel) renderAndSetContent() {
 	// Use glamour to render the markdown with dark style for better visibility
 	renderer, err := glamour.NewTermRenderer(
-		glamour.WithStandardStyle("dark"),
+		glamour.WithStandardStyle(MarkdownStyle(m.plain)),
 		glamour.WithWordWrap(m.width-4), // Account for padding
 	)
 
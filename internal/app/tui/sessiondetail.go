@@ -16,6 +16,7 @@ type SessionDetailModel struct {
 	width    int
 	height   int
 	ready    bool
+	plain    bool
 }
 
 // NewSessionDetailModel creates a new session detail model
@@ -25,6 +26,11 @@ func NewSessionDetailModel(session *SessionInfo) SessionDetailModel {
 	}
 }
 
+// SetPlain switches the view between the default and plain rendering modes
+func (m *SessionDetailModel) SetPlain(plain bool) {
+	m.plain = plain
+}
+
 // Init initializes the model
 func (m SessionDetailModel) Init() tea.Cmd {
 	return nil
@@ -129,10 +135,10 @@ func (m SessionDetailModel) View() string {
 
 func (m SessionDetailModel) headerView() string {
 	// Breadcrumb
-	breadcrumb := RenderBreadcrumb([]string{"Sessions", m.session.ShortID})
+	breadcrumb := RenderBreadcrumb([]string{"Sessions", m.session.ShortID}, m.plain)
 
 	// Title
-	title := PageTitleStyle.Render(fmt.Sprintf("Session Details: %s", m.session.ShortID))
+	title := RenderPageTitle(fmt.Sprintf("Session Details: %s", m.session.ShortID), m.plain)
 
 	return breadcrumb + "\n\n" + title
 }
@@ -161,11 +167,11 @@ func (m SessionDetailModel) footerView() string {
 		}
 	}
 
-	helpLine := RenderHelpLine(actions...)
+	helpLine := RenderHelpLine(m.plain, actions...)
 
 	// Divider
 	dividerWidth := max(0, m.width-lipgloss.Width(scrollInfo)-2)
-	divider := RenderDivider(dividerWidth)
+	divider := RenderDivider(dividerWidth, m.plain)
 
 	return fmt.Sprintf("\n%s %s\n%s", divider, scrollInfo, helpLine)
 }
@@ -193,11 +199,11 @@ func (m SessionDetailModel) renderContent() string {
 	b.WriteString(SectionTitleStyle.Render("Analysis Status") + "\n")
 	if m.session.HasAnalysis {
 		statusLine := SuccessStyle.Render(fmt.Sprintf("%s %d analysis/analyses found",
-			IconAnalyzed, m.session.AnalysisCount))
+			RenderIcon(IconAnalyzed, LabelAnalyzed, m.plain), m.session.AnalysisCount))
 		b.WriteString("  " + statusLine + "\n")
 
 		for i, analysis := range m.session.Analyses {
-			b.WriteString(fmt.Sprintf("\n  %s Analysis %d\n", IconInfo, i+1))
+			b.WriteString(fmt.Sprintf("\n  %s Analysis %d\n", RenderIcon(IconInfo, LabelInfo, m.plain), i+1))
 			b.WriteString(fmt.Sprintf("     View Type: %s\n", analysis.ViewType))
 			b.WriteString(fmt.Sprintf("     Prompt: %s\n", analysis.PromptUsed))
 			b.WriteString(fmt.Sprintf("     Model: %s\n", analysis.ModelUsed))
@@ -209,14 +215,14 @@ func (m SessionDetailModel) renderContent() string {
 				preview = preview[:300] + "..."
 			}
 			b.WriteString("\n     Preview:\n")
-			previewBox := BoxStyle.
+			previewBox := WithBorder(BoxStyle, m.plain).
 				MarginLeft(4).
 				Width(min(m.width-8, 80)).
 				Render(preview)
 			b.WriteString(previewBox + "\n")
 		}
 	} else {
-		statusLine := WarningStyle.Render(fmt.Sprintf("%s Not analyzed", IconUnanalyzed))
+		statusLine := WarningStyle.Render(fmt.Sprintf("%s Not analyzed", RenderIcon(IconUnanalyzed, LabelUnanalyzed, m.plain)))
 		b.WriteString("  " + statusLine + "\n")
 		b.WriteString("\n  " + HelpTextStyle.Render("Press 'a' to analyze this session") + "\n")
 	}
@@ -1,6 +1,7 @@
 package tui_test
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -982,3 +983,34 @@ func TestSessionDetailModel_View_AfterInit(t *testing.T) {
 		t.Error("View should return non-empty string after initialization")
 	}
 }
+
+func TestSessionDetailModel_PlainMode(t *testing.T) {
+	session := &tui.SessionInfo{
+		SessionID:     "test-session-plain",
+		ShortID:       "test-pla",
+		FirstEvent:    time.Now(),
+		LastEvent:     time.Now(),
+		EventCount:    3,
+		HasAnalysis:   true,
+		AnalysisCount: 1,
+		Analyses: []*domain.Analysis{
+			{ViewType: "session", Result: "Analysis preview text", Timestamp: time.Now()},
+		},
+	}
+
+	model := tui.NewSessionDetailModel(session)
+	model.SetPlain(true)
+	updated, _ := model.Update(tea.WindowSizeMsg{Width: 100, Height: 60})
+	view := updated.(tui.SessionDetailModel).View()
+
+	for _, want := range []string{tui.LabelAnalyzed + " 1 analysis/analyses found", tui.LabelInfo + " Analysis 1", "Analysis preview text"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("plain view should contain %q, got:\n%s", want, view)
+		}
+	}
+	for _, glyph := range []string{tui.IconAnalyzed, tui.IconInfo, "─", "╭", "•"} {
+		if strings.Contains(view, glyph) {
+			t.Errorf("plain view should not contain %q, got:\n%s", glyph, view)
+		}
+	}
+}
@@ -6,30 +6,48 @@ import (
 
 	"github.com/charmbracelet/bubbles/list"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/kgatilin/darwinflow-pub/internal/app"
 )
 
+// defaultSessionListTitle is the list title when the session entity type is unknown
+const defaultSessionListTitle = "DarwinFlow Sessions"
+
 // SessionItem implements list.Item for the Bubble Tea list component
 type SessionItem struct {
 	session *SessionInfo
+	plain   bool
+	icon    string // Entity type icon shown before the row; empty for none
 }
 
 func (i SessionItem) FilterValue() string { return i.session.SessionID }
 
 func (i SessionItem) Title() string {
-	statusIcon := IconUnanalyzed
+	statusIcon := RenderIcon(IconUnanalyzed, LabelUnanalyzed, i.plain)
 	statusStyle := WarningStyle
 
 	if i.session.HasAnalysis {
 		if i.session.AnalysisCount > 1 {
-			statusIcon = fmt.Sprintf("%s%d", IconMultiAnalysis, i.session.AnalysisCount)
+			if i.plain {
+				statusIcon = fmt.Sprintf("[%d analyses]", i.session.AnalysisCount)
+			} else {
+				statusIcon = fmt.Sprintf("%s%d", IconMultiAnalysis, i.session.AnalysisCount)
+			}
 			statusStyle = InfoStyle
 		} else {
-			statusIcon = IconAnalyzed
+			statusIcon = RenderIcon(IconAnalyzed, LabelAnalyzed, i.plain)
 			statusStyle = SuccessStyle
 		}
 	}
 
-	return fmt.Sprintf("%s %s | %s",
+	prefix := ""
+	if i.icon != "" && !i.plain {
+		prefix = i.icon + " "
+	}
+
+	return fmt.Sprintf("%s%s %s | %s",
+		prefix,
 		statusStyle.Render(statusIcon),
 		i.session.ShortID,
 		i.session.FirstEvent.Format("2006-01-02 15:04"),
@@ -51,7 +69,9 @@ type SessionListModel struct {
 	sessions      []*SessionInfo
 	width         int
 	height        int
-	newEventCount int // Number of unread events from dispatcher
+	newEventCount int                    // Number of unread events from dispatcher
+	plain         bool                   // Accessibility mode: text labels and ">" selection marker
+	entityType    *app.EntityTypeDisplay // Plugin-provided names and icon for sessions; nil if unknown
 }
 
 // NewSessionListModel creates a new session list model
@@ -63,8 +83,8 @@ func NewSessionListModel(sessions []*SessionInfo) SessionListModel {
 	}
 
 	// Create list with custom delegate
-	l := list.New(items, list.NewDefaultDelegate(), 0, 0)
-	l.Title = "DarwinFlow Sessions"
+	l := list.New(items, newSessionDelegate(false), 0, 0)
+	l.Title = defaultSessionListTitle
 	l.SetShowStatusBar(true)
 	l.SetFilteringEnabled(true)
 	l.Styles.Title = BaseTitleStyle.MarginLeft(2)
@@ -147,10 +167,10 @@ func (m SessionListModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 // View renders the view
 func (m SessionListModel) View() string {
 	// Build breadcrumb
-	breadcrumb := RenderBreadcrumb([]string{"Sessions"})
+	breadcrumb := RenderBreadcrumb([]string{"Sessions"}, m.plain)
 
 	// Build the title with event counter if there are new events
-	title := "DarwinFlow Sessions"
+	title := m.title()
 	if m.newEventCount > 0 {
 		title = fmt.Sprintf("%s %s",
 			title,
@@ -159,7 +179,7 @@ func (m SessionListModel) View() string {
 	m.list.Title = title
 
 	// Footer with help hints
-	helpHints := RenderHelpLine(
+	helpHints := RenderHelpLine(m.plain,
 		RenderKeyHelp("?", "help"),
 		RenderKeyHelp("Enter", "view"),
 		RenderKeyHelp("r", "refresh"),
@@ -170,6 +190,52 @@ func (m SessionListModel) View() string {
 	return "\n" + breadcrumb + "\n\n" + m.list.View() + "\n\n" + helpHints
 }
 
+// newSessionDelegate returns the list delegate. In plain mode the selected
+// item is marked with a leading ">" instead of colors.
+func newSessionDelegate(plain bool) list.DefaultDelegate {
+	d := list.NewDefaultDelegate()
+	if !plain {
+		return d
+	}
+
+	marker := lipgloss.Border{Left: ">"}
+	selected := lipgloss.NewStyle().Border(marker, false, false, false, true).PaddingLeft(1)
+	normal := lipgloss.NewStyle().PaddingLeft(2)
+	d.Styles.SelectedTitle = selected
+	d.Styles.SelectedDesc = selected
+	d.Styles.NormalTitle = normal
+	d.Styles.NormalDesc = normal
+	d.Styles.DimmedTitle = normal
+	d.Styles.DimmedDesc = normal
+	return d
+}
+
+// title returns the list header from the session entity type: its icon and plural
+// display name, with the icon left out in plain mode
+func (m SessionListModel) title() string {
+	if m.entityType == nil {
+		return defaultSessionListTitle
+	}
+	if m.plain {
+		return m.entityType.DisplayNamePlural
+	}
+	return m.entityType.Title()
+}
+
+// SetEntityType sets the plugin-provided display metadata of the session entity type,
+// used for the list header and row icons
+func (m *SessionListModel) SetEntityType(display app.EntityTypeDisplay) {
+	m.entityType = &display
+	m.UpdateSessions(m.sessions)
+}
+
+// SetPlain switches the list between the default and plain rendering modes
+func (m *SessionListModel) SetPlain(plain bool) {
+	m.plain = plain
+	m.list.SetDelegate(newSessionDelegate(plain))
+	m.UpdateSessions(m.sessions)
+}
+
 // SetNewEventCount updates the counter of unread events
 func (m *SessionListModel) SetNewEventCount(count int) {
 	m.newEventCount = count
@@ -186,9 +252,13 @@ func (m SessionListModel) GetSelectedSession() *SessionInfo {
 // UpdateSessions updates the session list
 func (m *SessionListModel) UpdateSessions(sessions []*SessionInfo) {
 	m.sessions = sessions
+	icon := ""
+	if m.entityType != nil {
+		icon = m.entityType.Icon
+	}
 	items := make([]list.Item, len(sessions))
 	for i, s := range sessions {
-		items[i] = SessionItem{session: s}
+		items[i] = SessionItem{session: s, plain: m.plain, icon: icon}
 	}
 	m.list.SetItems(items)
 }
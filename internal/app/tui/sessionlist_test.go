@@ -1,11 +1,15 @@
 package tui_test
 
 import (
+	"context"
+	"strings"
 	"testing"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kgatilin/darwinflow-pub/internal/app"
 	"github.com/kgatilin/darwinflow-pub/internal/app/tui"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
 )
 
 func TestNewSessionListModel(t *testing.T) {
@@ -427,3 +431,83 @@ func TestSessionListModel_MultipleSessionsWithDifferentStates(t *testing.T) {
 		t.Error("View should return non-empty string after navigation")
 	}
 }
+
+func TestSessionListModel_PlainMode(t *testing.T) {
+	sessions := []*tui.SessionInfo{
+		{
+			SessionID:     "session-analyzed",
+			ShortID:       "sess-one",
+			FirstEvent:    time.Now(),
+			HasAnalysis:   true,
+			AnalysisCount: 1,
+		},
+		{
+			SessionID:  "session-pending",
+			ShortID:    "sess-two",
+			FirstEvent: time.Now(),
+		},
+	}
+
+	model := tui.NewSessionListModel(sessions)
+	model.SetPlain(true)
+	updated, _ := model.Update(tea.WindowSizeMsg{Width: 100, Height: 30})
+	view := updated.(tui.SessionListModel).View()
+
+	if !strings.Contains(view, "> [analyzed] sess-one") {
+		t.Errorf("plain view should mark the selected session with '>' and a text label, got:\n%s", view)
+	}
+	if !strings.Contains(view, "  [not analyzed] sess-two") {
+		t.Errorf("plain view should label unanalyzed sessions, got:\n%s", view)
+	}
+	for _, glyph := range []string{tui.IconAnalyzed, tui.IconUnanalyzed, "│", "›"} {
+		if strings.Contains(view, glyph) {
+			t.Errorf("plain view should not contain %q, got:\n%s", glyph, view)
+		}
+	}
+}
+
+// iconPlugin is a stub entity provider advertising a custom icon for sessions
+type iconPlugin struct{}
+
+func (p *iconPlugin) GetInfo() pluginsdk.PluginInfo { return pluginsdk.PluginInfo{Name: "icon-plugin"} }
+func (p *iconPlugin) GetCapabilities() []string     { return []string{"IEntityProvider"} }
+func (p *iconPlugin) GetEntityTypes() []pluginsdk.EntityTypeInfo {
+	return []pluginsdk.EntityTypeInfo{
+		{Type: "session", DisplayName: "Recording", DisplayNamePlural: "Recordings", Icon: "🦊"},
+	}
+}
+func (p *iconPlugin) Query(ctx context.Context, query pluginsdk.EntityQuery) ([]pluginsdk.IExtensible, error) {
+	return nil, nil
+}
+func (p *iconPlugin) GetEntity(ctx context.Context, entityID string) (pluginsdk.IExtensible, error) {
+	return nil, pluginsdk.ErrNotFound
+}
+
+func TestSessionListModel_EntityTypeIconAndName(t *testing.T) {
+	registry := app.NewPluginRegistry(&app.NoOpLogger{})
+	if err := registry.RegisterPlugin(&iconPlugin{}); err != nil {
+		t.Fatalf("RegisterPlugin failed: %v", err)
+	}
+
+	sessions := []*tui.SessionInfo{
+		{SessionID: "session-1", ShortID: "sess-1", FirstEvent: time.Now(), LastEvent: time.Now(), EventCount: 1},
+	}
+	model := tui.NewSessionListModel(sessions)
+	model.SetEntityType(registry.GetEntityTypeDisplay("session"))
+	updatedModel, _ := model.Update(tea.WindowSizeMsg{Width: 100, Height: 50})
+	model = updatedModel.(tui.SessionListModel)
+
+	view := model.View()
+	if !strings.Contains(view, "🦊 Recordings") {
+		t.Errorf("expected header with plugin icon and plural name, got:\n%s", view)
+	}
+	if strings.Count(view, "🦊") < 2 {
+		t.Errorf("expected plugin icon on the session row too, got:\n%s", view)
+	}
+
+	model.SetPlain(true)
+	view = model.View()
+	if strings.Contains(view, "🦊") || !strings.Contains(view, "Recordings") {
+		t.Errorf("expected plain mode to keep the name and drop the icon, got:\n%s", view)
+	}
+}
@@ -134,6 +134,48 @@ const (
 	IconRefresh       = "↻"
 )
 
+// Plain-mode labels - ASCII replacements for the status icons.
+// Plain mode (dw ui --plain) is the accessibility mode for screen readers and
+// terminal recordings: icons become text labels, borders and rules use plain
+// characters, and the selected item is marked with ">" instead of a background.
+const (
+	LabelSuccess       = "[ok]"
+	LabelError         = "[error]"
+	LabelWarning       = "[warn]"
+	LabelInfo          = "[info]"
+	LabelAnalyzed      = "[analyzed]"
+	LabelUnanalyzed    = "[not analyzed]"
+	LabelMultiAnalysis = "[analyses]"
+	LabelLoading       = "[loading]"
+	LabelNew           = "[new]"
+	LabelRefresh       = "[refresh]"
+)
+
+// RenderIcon returns the icon, or its text label in plain mode
+func RenderIcon(icon, label string, plain bool) string {
+	if plain {
+		return label
+	}
+	return icon
+}
+
+// WithBorder returns style unchanged, or with its border replaced by blank space
+// in plain mode so that layout and padding are kept
+func WithBorder(style lipgloss.Style, plain bool) lipgloss.Style {
+	if plain {
+		return style.BorderStyle(lipgloss.HiddenBorder())
+	}
+	return style
+}
+
+// MarkdownStyle returns the glamour style used to render markdown content
+func MarkdownStyle(plain bool) string {
+	if plain {
+		return "ascii"
+	}
+	return "dark"
+}
+
 // Breadcrumb styles
 var (
 	BreadcrumbStyle = lipgloss.NewStyle().
@@ -143,23 +185,23 @@ var (
 				Foreground(ColorHighlight).
 				Bold(true)
 
-	BreadcrumbSeparator = lipgloss.NewStyle().
-				Foreground(ColorMuted).
-				Render(" › ")
+	BreadcrumbSeparatorStyle = lipgloss.NewStyle().
+					Foreground(ColorMuted)
 )
 
 // Helper functions
 
 // RenderBreadcrumb renders a breadcrumb navigation trail
-func RenderBreadcrumb(items []string) string {
+func RenderBreadcrumb(items []string, plain bool) string {
 	if len(items) == 0 {
 		return ""
 	}
 
+	separator := BreadcrumbSeparatorStyle.Render(RenderIcon(" › ", " > ", plain))
 	result := ""
 	for i, item := range items {
 		if i > 0 {
-			result += BreadcrumbSeparator
+			result += separator
 		}
 		if i == len(items)-1 {
 			// Last item (current) is highlighted
@@ -171,12 +213,17 @@ func RenderBreadcrumb(items []string) string {
 	return result
 }
 
+// RenderPageTitle renders a page title underlined with a rule (blank in plain mode)
+func RenderPageTitle(title string, plain bool) string {
+	return WithBorder(PageTitleStyle, plain).Render(title)
+}
+
 // RenderDivider renders a horizontal divider line
-func RenderDivider(width int) string {
+func RenderDivider(width int, plain bool) string {
 	if width < 0 {
 		width = 0
 	}
-	return DividerStyle.Render(lipgloss.NewStyle().Width(width).Render("─"))
+	return DividerStyle.Render(lipgloss.NewStyle().Width(width).Render(RenderIcon("─", "-", plain)))
 }
 
 // RenderKeyHelp renders a key-action pair for help text
@@ -185,11 +232,12 @@ func RenderKeyHelp(key, action string) string {
 }
 
 // RenderHelpLine renders a line of help text with multiple key-action pairs
-func RenderHelpLine(helps ...string) string {
+func RenderHelpLine(plain bool, helps ...string) string {
+	separator := HelpTextStyle.Render(RenderIcon(" • ", " | ", plain))
 	result := ""
 	for i, help := range helps {
 		if i > 0 {
-			result += HelpTextStyle.Render(" • ")
+			result += separator
 		}
 		result += help
 	}
@@ -197,16 +245,16 @@ func RenderHelpLine(helps ...string) string {
 }
 
 // FormatStatus returns a styled status indicator
-func FormatStatus(status string) string {
+func FormatStatus(status string, plain bool) string {
 	switch status {
 	case "success", "done", "analyzed", "complete":
-		return SuccessStyle.Render(IconSuccess + " " + status)
+		return SuccessStyle.Render(RenderIcon(IconSuccess, LabelSuccess, plain) + " " + status)
 	case "error", "failed":
-		return ErrorStyle.Render(IconError + " " + status)
+		return ErrorStyle.Render(RenderIcon(IconError, LabelError, plain) + " " + status)
 	case "warning", "unanalyzed":
-		return WarningStyle.Render(IconWarning + " " + status)
+		return WarningStyle.Render(RenderIcon(IconWarning, LabelWarning, plain) + " " + status)
 	case "loading", "in-progress":
-		return InfoStyle.Render(IconLoading + " " + status)
+		return InfoStyle.Render(RenderIcon(IconLoading, LabelLoading, plain) + " " + status)
 	default:
 		return NormalTextStyle.Render(status)
 	}
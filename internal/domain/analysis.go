@@ -18,6 +18,7 @@ type Analysis struct {
 	ModelUsed  string                 // LLM model used
 	PromptUsed string                 // Prompt name/template used
 	Metadata   map[string]interface{} // View-specific metadata (JSON in DB)
+	EventIDs   []string               // IDs of the events the analysis was computed from (nil when not recorded)
 }
 
 // NewAnalysis creates a new generic analysis
@@ -129,3 +130,20 @@ type ToolSuggestion struct {
 	Rationale   string
 	Examples    []string
 }
+
+// AnalysisCoverage selects events by whether their session has been analyzed
+type AnalysisCoverage string
+
+const (
+	AnalysisCoverageAll        AnalysisCoverage = ""           // Every event
+	AnalysisCoverageAnalyzed   AnalysisCoverage = "analyzed"   // Events of sessions with a matching analysis
+	AnalysisCoverageUnanalyzed AnalysisCoverage = "unanalyzed" // Events of sessions without a matching analysis
+)
+
+// AnalysisCoverageFilter selects events by the analyses of their session
+type AnalysisCoverageFilter struct {
+	Coverage AnalysisCoverage
+	// AnalysisType restricts the analyses that count. It matches exactly or as the
+	// last "_"-separated part ("summary" matches "session_summary"); empty = any type.
+	AnalysisType string
+}
@@ -0,0 +1,9 @@
+package domain
+
+// AnalysisDeleteResult reports what deleting analyses removed. An analysis is counted
+// once even when it is stored both as a session analysis and as a generic analysis.
+type AnalysisDeleteResult struct {
+	AnalysesDeleted   int // Analyses removed
+	EventLinksDeleted int // Links to the events the removed analyses were computed from
+	AnalysesKept      int // Analyses of the session left in place (the newest, with keepLatest)
+}
@@ -1,5 +1,7 @@
 package domain
 
+import "sort"
+
 // Config represents the DarwinFlow configuration
 type Config struct {
 	// Analysis contains analysis execution settings
@@ -11,6 +13,12 @@ type Config struct {
 	// Logging contains logging settings
 	Logging LoggingConfig `yaml:"logging" json:"logging"`
 
+	// Logs contains settings for the `dw logs` command
+	Logs LogsConfig `yaml:"logs" json:"logs"`
+
+	// Events contains settings for storing events
+	Events EventsConfig `yaml:"events,omitempty" json:"events,omitempty"`
+
 	// Prompts contains named prompts for different use cases
 	Prompts map[string]string `yaml:"prompts" json:"prompts"`
 }
@@ -61,6 +69,15 @@ type UIConfig struct {
 	// AutoRefreshInterval is the interval for auto-refreshing the session list
 	// Format: "30s", "1m", etc. Empty or "0" disables auto-refresh
 	AutoRefreshInterval string `yaml:"auto_refresh_interval" json:"auto_refresh_interval"`
+
+	// FirstRunWizard controls whether `dw ui` offers the first-run wizard when the
+	// project has no roadmap yet (unset = true)
+	FirstRunWizard *bool `yaml:"first_run_wizard,omitempty" json:"first_run_wizard,omitempty"`
+}
+
+// FirstRunWizardEnabled returns whether `dw ui` may start the first-run wizard
+func (c UIConfig) FirstRunWizardEnabled() bool {
+	return c.FirstRunWizard == nil || *c.FirstRunWizard
 }
 
 // LoggingConfig contains settings for file logging
@@ -83,6 +100,57 @@ type LoggingConfig struct {
 	FileLogLevel string `yaml:"file_log_level" json:"file_log_level"`
 }
 
+// DefaultLogsLimit is the number of events `dw logs` shows when neither the
+// config nor --limit says otherwise
+const DefaultLogsLimit = 20
+
+// DefaultLogsContentWidth is the number of characters of event content `dw logs`
+// shows before truncating it, when neither the config nor --full says otherwise
+const DefaultLogsContentWidth = 200
+
+// LogsConfig contains settings for the `dw logs` command
+type LogsConfig struct {
+	// DefaultLimit is the number of most recent events shown without --limit
+	// (0 = unlimited, unset = DefaultLogsLimit)
+	DefaultLimit *int `yaml:"default_limit,omitempty" json:"default_limit,omitempty"`
+
+	// ContentWidth is the number of characters of event content shown before it is
+	// truncated (0 = never truncate, unset = DefaultLogsContentWidth)
+	ContentWidth *int `yaml:"content_width,omitempty" json:"content_width,omitempty"`
+}
+
+// EffectiveDefaultLimit returns the configured default limit, or DefaultLogsLimit if unset
+func (c LogsConfig) EffectiveDefaultLimit() int {
+	if c.DefaultLimit == nil {
+		return DefaultLogsLimit
+	}
+	return *c.DefaultLimit
+}
+
+// EffectiveContentWidth returns the configured content width, or DefaultLogsContentWidth if unset
+func (c LogsConfig) EffectiveContentWidth() int {
+	if c.ContentWidth == nil {
+		return DefaultLogsContentWidth
+	}
+	return *c.ContentWidth
+}
+
+// EventsConfig contains settings for storing events
+type EventsConfig struct {
+	// Sample maps event types to the fraction of their events that is stored (0-1).
+	// Types not listed are always stored in full.
+	Sample map[string]float64 `yaml:"sample,omitempty" json:"sample,omitempty"`
+}
+
+// SampleRate returns the fraction of events of eventType to store (1 if not sampled)
+func (c EventsConfig) SampleRate(eventType string) float64 {
+	rate, ok := c.Sample[eventType]
+	if !ok {
+		return 1
+	}
+	return rate
+}
+
 // AllowedModels is the whitelist of valid model aliases and full names
 var AllowedModels = map[string]bool{
 	// Aliases (recommended)
@@ -105,6 +173,16 @@ func ValidateModel(model string) bool {
 	return AllowedModels[model]
 }
 
+// AllowedModelNames returns the allowed model aliases and names, sorted
+func AllowedModelNames() []string {
+	names := make([]string, 0, len(AllowedModels))
+	for name := range AllowedModels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
 // DefaultConfig returns the default configuration
 func DefaultConfig() *Config {
 	return &Config{
@@ -129,6 +207,10 @@ func DefaultConfig() *Config {
 			ConsoleLogLevel: "off",   // Clean output by default
 			FileLogLevel:    "error", // Only log errors by default
 		},
+		Logs: LogsConfig{
+			DefaultLimit: intPtr(DefaultLogsLimit),
+			ContentWidth: intPtr(DefaultLogsContentWidth),
+		},
 		Prompts: map[string]string{
 			"session_summary": DefaultSessionSummaryPrompt,
 			"tool_analysis":   DefaultToolAnalysisPrompt,
@@ -136,6 +218,10 @@ func DefaultConfig() *Config {
 	}
 }
 
+func intPtr(v int) *int {
+	return &v
+}
+
 // DefaultSessionSummaryPrompt is used for auto-triggered session summaries
 const DefaultSessionSummaryPrompt = `Analyze this Claude Code session and provide a concise summary.
 
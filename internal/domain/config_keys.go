@@ -0,0 +1,291 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Value types of config keys
+const (
+	ConfigTypeInt    = "int"
+	ConfigTypeFloat  = "float"
+	ConfigTypeBool   = "bool"
+	ConfigTypeString = "string"
+	ConfigTypeList   = "list"
+)
+
+// Sources of an effective config value
+const (
+	ConfigSourceDefault = "default" // Not set anywhere; the built-in default applies
+	ConfigSourceFile    = "config"  // Set in .darwinflow.yaml
+	ConfigSourceEnv     = "env"     // Overridden by a DW_* environment variable
+)
+
+// EventSampleKeyPrefix is the prefix of keys naming an event type's sampling rate
+const EventSampleKeyPrefix = "events.sample."
+
+// ConfigKey describes one dotted config key: its value type, a typed getter for
+// its effective value and, for keys accepted by `dw config set`, a setter
+// parsing a string value.
+type ConfigKey struct {
+	Name        string
+	Type        string
+	Description string
+	Get         func(config *Config) interface{}
+	Set         func(config *Config, value string) error // nil if the key is read-only
+}
+
+// Settable reports whether the key can be set with `dw config set` and overridden from the environment
+func (k ConfigKey) Settable() bool {
+	return k.Set != nil
+}
+
+// EnvVar returns the environment variable that overrides the key, e.g. DW_LOGS_DEFAULT_LIMIT.
+// Read-only keys and event sampling rates cannot be overridden and return "".
+func (k ConfigKey) EnvVar() string {
+	if !k.Settable() || strings.HasPrefix(k.Name, EventSampleKeyPrefix) {
+		return ""
+	}
+	return "DW_" + strings.ToUpper(strings.ReplaceAll(k.Name, ".", "_"))
+}
+
+// configKeys is the schema of the fixed config keys. Prompts are not listed:
+// their text is edited in the file and summarized by `dw config show`.
+var configKeys = []ConfigKey{
+	{
+		Name: "analysis.token_limit", Type: ConfigTypeInt,
+		Description: "Maximum tokens of analysis context",
+		Get:         func(c *Config) interface{} { return c.Analysis.TokenLimit },
+		Set: func(c *Config, value string) error {
+			limit, err := parseNonNegativeInt(value)
+			if err != nil {
+				return err
+			}
+			c.Analysis.TokenLimit = limit
+			return nil
+		},
+	},
+	{
+		Name: "analysis.model", Type: ConfigTypeString,
+		Description: "Claude model used for analysis",
+		Get:         func(c *Config) interface{} { return c.Analysis.Model },
+		Set: func(c *Config, value string) error {
+			if !ValidateModel(value) {
+				return fmt.Errorf("unknown model '%s'", value)
+			}
+			c.Analysis.Model = value
+			return nil
+		},
+	},
+	{
+		Name: "analysis.parallel_limit", Type: ConfigTypeInt,
+		Description: "Maximum parallel analysis executions",
+		Get:         func(c *Config) interface{} { return c.Analysis.ParallelLimit },
+		Set: func(c *Config, value string) error {
+			limit, err := parseNonNegativeInt(value)
+			if err != nil {
+				return err
+			}
+			c.Analysis.ParallelLimit = limit
+			return nil
+		},
+	},
+	{
+		Name: "analysis.enabled_prompts", Type: ConfigTypeList,
+		Description: "Prompts run during analysis",
+		Get:         func(c *Config) interface{} { return c.Analysis.EnabledPrompts },
+	},
+	{
+		Name: "analysis.auto_summary_enabled", Type: ConfigTypeBool,
+		Description: "Summarize sessions automatically when they end",
+		Get:         func(c *Config) interface{} { return c.Analysis.AutoSummaryEnabled },
+		Set: func(c *Config, value string) error {
+			enabled, err := strconv.ParseBool(value)
+			if err != nil {
+				return fmt.Errorf("'%s' is not a boolean (use true or false)", value)
+			}
+			c.Analysis.AutoSummaryEnabled = enabled
+			return nil
+		},
+	},
+	{
+		Name: "analysis.auto_summary_prompt", Type: ConfigTypeString,
+		Description: "Prompt used for automatic session summaries",
+		Get:         func(c *Config) interface{} { return c.Analysis.AutoSummaryPrompt },
+	},
+	{
+		Name: "analysis.claude_options.allowed_tools", Type: ConfigTypeList,
+		Description: "Tools Claude may use during analysis",
+		Get:         func(c *Config) interface{} { return c.Analysis.ClaudeOptions.AllowedTools },
+	},
+	{
+		Name: "analysis.claude_options.system_prompt_mode", Type: ConfigTypeString,
+		Description: "How prompts are passed to Claude (replace or append)",
+		Get:         func(c *Config) interface{} { return c.Analysis.ClaudeOptions.SystemPromptMode },
+	},
+	{
+		Name: "ui.default_output_dir", Type: ConfigTypeString,
+		Description: "Directory analysis markdown files are saved to",
+		Get:         func(c *Config) interface{} { return c.UI.DefaultOutputDir },
+	},
+	{
+		Name: "ui.filename_template", Type: ConfigTypeString,
+		Description: "Template of saved analysis filenames",
+		Get:         func(c *Config) interface{} { return c.UI.FilenameTemplate },
+	},
+	{
+		Name: "ui.auto_refresh_interval", Type: ConfigTypeString,
+		Description: "Session list refresh interval (empty = off)",
+		Get:         func(c *Config) interface{} { return c.UI.AutoRefreshInterval },
+	},
+	{
+		Name: "ui.first_run_wizard", Type: ConfigTypeBool,
+		Description: "Offer the setup wizard on dw ui while there is no roadmap",
+		Get:         func(c *Config) interface{} { return c.UI.FirstRunWizardEnabled() },
+		Set: func(c *Config, value string) error {
+			enabled, err := strconv.ParseBool(value)
+			if err != nil {
+				return fmt.Errorf("'%s' is not a boolean (use true or false)", value)
+			}
+			c.UI.FirstRunWizard = &enabled
+			return nil
+		},
+	},
+	{
+		Name: "logging.console_log_level", Type: ConfigTypeString,
+		Description: "Level of messages logged to the console",
+		Get:         func(c *Config) interface{} { return c.Logging.ConsoleLogLevel },
+	},
+	{
+		Name: "logging.file_log_level", Type: ConfigTypeString,
+		Description: "Level of messages logged to the log file",
+		Get:         func(c *Config) interface{} { return c.Logging.FileLogLevel },
+	},
+	{
+		Name: "logs.default_limit", Type: ConfigTypeInt,
+		Description: "Events shown by dw logs without --limit (0 = unlimited)",
+		Get:         func(c *Config) interface{} { return c.Logs.EffectiveDefaultLimit() },
+		Set: func(c *Config, value string) error {
+			limit, err := parseNonNegativeInt(value)
+			if err != nil {
+				return err
+			}
+			c.Logs.DefaultLimit = &limit
+			return nil
+		},
+	},
+	{
+		Name: "logs.content_width", Type: ConfigTypeInt,
+		Description: "Characters of event content shown by dw logs before truncating (0 = never truncate)",
+		Get:         func(c *Config) interface{} { return c.Logs.EffectiveContentWidth() },
+		Set: func(c *Config, value string) error {
+			width, err := parseNonNegativeInt(value)
+			if err != nil {
+				return err
+			}
+			c.Logs.ContentWidth = &width
+			return nil
+		},
+	},
+}
+
+// ConfigKeys returns the schema of every known key, sorted by name: the fixed keys
+// plus the sampling rate of each event type sampled in config
+func ConfigKeys(config *Config) []ConfigKey {
+	keys := append([]ConfigKey{}, configKeys...)
+	if config != nil {
+		for eventType := range config.Events.Sample {
+			keys = append(keys, eventSampleKey(eventType))
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].Name < keys[j].Name
+	})
+	return keys
+}
+
+// LookupConfigKey returns the schema of a key, including events.sample.<type> keys
+func LookupConfigKey(name string) (ConfigKey, bool) {
+	for _, key := range configKeys {
+		if key.Name == name {
+			return key, true
+		}
+	}
+	eventType := strings.TrimPrefix(name, EventSampleKeyPrefix)
+	if eventType == name || eventType == "" {
+		return ConfigKey{}, false
+	}
+	return eventSampleKey(eventType), true
+}
+
+// ConfigSetKeys returns the names of the keys accepted by `dw config set`, sorted
+func ConfigSetKeys() []string {
+	names := []string{EventSampleKeyPrefix + "<type>"}
+	for _, key := range configKeys {
+		if key.Settable() {
+			names = append(names, key.Name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ApplyConfigEnv overrides settable keys with the values of their DW_* environment
+// variables, read through lookupEnv. Invalid values are skipped and reported in
+// the returned error; the other overrides are still applied.
+func ApplyConfigEnv(config *Config, lookupEnv func(string) (string, bool)) error {
+	var errs []string
+	for _, key := range configKeys {
+		envVar := key.EnvVar()
+		if envVar == "" {
+			continue
+		}
+		value, ok := lookupEnv(envVar)
+		if !ok {
+			continue
+		}
+		if err := key.Set(config, value); err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", envVar, err))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid environment overrides: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// eventSampleKey returns the schema of the sampling rate key of eventType. Setting
+// a rate of 1 removes the entry so the type is stored in full again.
+func eventSampleKey(eventType string) ConfigKey {
+	return ConfigKey{
+		Name:        EventSampleKeyPrefix + eventType,
+		Type:        ConfigTypeFloat,
+		Description: fmt.Sprintf("Fraction of %s events stored", eventType),
+		Get:         func(c *Config) interface{} { return c.Events.SampleRate(eventType) },
+		Set: func(c *Config, value string) error {
+			rate, err := strconv.ParseFloat(value, 64)
+			if err != nil || rate < 0 || rate > 1 {
+				return fmt.Errorf("'%s' is not a rate between 0 and 1", value)
+			}
+			if rate == 1 {
+				delete(c.Events.Sample, eventType)
+				return nil
+			}
+			if c.Events.Sample == nil {
+				c.Events.Sample = make(map[string]float64)
+			}
+			c.Events.Sample[eventType] = rate
+			return nil
+		},
+	}
+}
+
+func parseNonNegativeInt(value string) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("'%s' is not a non-negative integer", value)
+	}
+	return n, nil
+}
@@ -0,0 +1,106 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kgatilin/darwinflow-pub/internal/domain"
+)
+
+func TestConfigKeys_DefaultsAndSampledTypes(t *testing.T) {
+	config := domain.DefaultConfig()
+	config.Events.Sample = map[string]float64{"tool.result": 0.1}
+
+	keys := domain.ConfigKeys(config)
+	values := map[string]interface{}{}
+	for i, key := range keys {
+		if i > 0 && keys[i-1].Name >= key.Name {
+			t.Errorf("Keys not sorted: %s before %s", keys[i-1].Name, key.Name)
+		}
+		values[key.Name] = key.Get(config)
+	}
+
+	if got := values["logs.default_limit"]; got != domain.DefaultLogsLimit {
+		t.Errorf("logs.default_limit = %v, want %d", got, domain.DefaultLogsLimit)
+	}
+	if got := values["analysis.auto_summary_enabled"]; got != false {
+		t.Errorf("analysis.auto_summary_enabled = %v, want false", got)
+	}
+	if got := values["events.sample.tool.result"]; got != 0.1 {
+		t.Errorf("events.sample.tool.result = %v, want 0.1", got)
+	}
+}
+
+func TestLookupConfigKey(t *testing.T) {
+	key, ok := domain.LookupConfigKey("events.sample.chat.message")
+	if !ok || key.Type != domain.ConfigTypeFloat || !key.Settable() {
+		t.Fatalf("Expected a settable float key for event sampling, got %+v (found %v)", key, ok)
+	}
+	if got := key.Get(domain.DefaultConfig()); got != 1.0 {
+		t.Errorf("Unsampled event type rate = %v, want 1", got)
+	}
+
+	if key, ok := domain.LookupConfigKey("ui.default_output_dir"); !ok || key.Settable() {
+		t.Errorf("Expected ui.default_output_dir to be a read-only key")
+	}
+	for _, name := range []string{"logs.colour", "events.sample.", "prompts"} {
+		if _, ok := domain.LookupConfigKey(name); ok {
+			t.Errorf("Expected %q to be unknown", name)
+		}
+	}
+}
+
+func TestConfigKey_EnvVar(t *testing.T) {
+	tests := map[string]string{
+		"logs.default_limit":        "DW_LOGS_DEFAULT_LIMIT",
+		"analysis.model":            "DW_ANALYSIS_MODEL",
+		"ui.default_output_dir":     "", // read-only
+		"events.sample.tool.result": "", // event types are not overridable
+	}
+	for name, want := range tests {
+		key, _ := domain.LookupConfigKey(name)
+		if got := key.EnvVar(); got != want {
+			t.Errorf("EnvVar(%s) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestApplyConfigEnv(t *testing.T) {
+	env := map[string]string{
+		"DW_LOGS_DEFAULT_LIMIT":            "5",
+		"DW_ANALYSIS_AUTO_SUMMARY_ENABLED": "true",
+		"DW_ANALYSIS_MODEL":                "gpt",
+	}
+	lookup := func(name string) (string, bool) {
+		value, ok := env[name]
+		return value, ok
+	}
+
+	config := domain.DefaultConfig()
+	err := domain.ApplyConfigEnv(config, lookup)
+	if err == nil || !strings.Contains(err.Error(), "DW_ANALYSIS_MODEL") {
+		t.Errorf("Expected an error naming the invalid override, got %v", err)
+	}
+	if got := config.Logs.EffectiveDefaultLimit(); got != 5 {
+		t.Errorf("logs default limit = %d, want 5", got)
+	}
+	if !config.Analysis.AutoSummaryEnabled {
+		t.Error("Expected auto summary to be enabled by the override")
+	}
+	if config.Analysis.Model != "sonnet" {
+		t.Errorf("Invalid model override should be ignored, got %s", config.Analysis.Model)
+	}
+}
+
+func TestConfigSetKeys(t *testing.T) {
+	keys := domain.ConfigSetKeys()
+	joined := strings.Join(keys, ",")
+	for _, want := range []string{"logs.default_limit", "analysis.model", "events.sample.<type>"} {
+		if !strings.Contains(joined, want) {
+			t.Errorf("ConfigSetKeys() = %v, missing %s", keys, want)
+		}
+	}
+	if strings.Contains(joined, "ui.default_output_dir") {
+		t.Errorf("ConfigSetKeys() should not list read-only keys: %v", keys)
+	}
+}
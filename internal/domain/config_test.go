@@ -272,3 +272,40 @@ func TestAnalysisConfig_CustomValues(t *testing.T) {
 		t.Error("Custom AutoSummaryEnabled not preserved")
 	}
 }
+
+func TestLogsConfig_EffectiveDefaultLimit(t *testing.T) {
+	if got := (domain.LogsConfig{}).EffectiveDefaultLimit(); got != domain.DefaultLogsLimit {
+		t.Errorf("unset limit: expected %d, got %d", domain.DefaultLogsLimit, got)
+	}
+
+	zero := 0
+	if got := (domain.LogsConfig{DefaultLimit: &zero}).EffectiveDefaultLimit(); got != 0 {
+		t.Errorf("explicit 0 should mean unlimited, got %d", got)
+	}
+
+	if got := domain.DefaultConfig().Logs.EffectiveDefaultLimit(); got != domain.DefaultLogsLimit {
+		t.Errorf("DefaultConfig: expected %d, got %d", domain.DefaultLogsLimit, got)
+	}
+}
+
+func TestLogsConfig_EffectiveContentWidth(t *testing.T) {
+	if got := (domain.LogsConfig{}).EffectiveContentWidth(); got != domain.DefaultLogsContentWidth {
+		t.Errorf("unset width: expected %d, got %d", domain.DefaultLogsContentWidth, got)
+	}
+
+	zero := 0
+	if got := (domain.LogsConfig{ContentWidth: &zero}).EffectiveContentWidth(); got != 0 {
+		t.Errorf("explicit 0 should mean never truncate, got %d", got)
+	}
+}
+
+func TestUIConfig_FirstRunWizardEnabled(t *testing.T) {
+	if !domain.DefaultConfig().UI.FirstRunWizardEnabled() {
+		t.Error("expected the wizard to be enabled by default")
+	}
+
+	disabled := false
+	if (domain.UIConfig{FirstRunWizard: &disabled}).FirstRunWizardEnabled() {
+		t.Error("expected first_run_wizard: false to disable the wizard")
+	}
+}
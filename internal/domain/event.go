@@ -21,6 +21,15 @@ type Event struct {
 	Payload   interface{} // Plugin-specific payload structure
 	Content   string      // Normalized text for full-text search
 	Version   string      // Schema version for event (default: "1.0")
+
+	// Capture context, filled in at save time when available
+	WorkingDir string // Directory the emitting command ran in
+	GitBranch  string // Current branch of WorkingDir's repository (empty if detached or not a repo)
+	GitCommit  string // HEAD commit of WorkingDir's repository (empty if not a repo)
+
+	// Sampled is true when the event's type is sampled at a rate below 1, so the
+	// stored event stands in for others of its type that were dropped
+	Sampled bool
 }
 
 // NewEvent creates a new event with generated ID and current timestamp (domain service)
@@ -40,3 +49,24 @@ func NewEvent(eventType string, sessionID string, payload interface{}, content s
 func (e *Event) MarshalPayload() ([]byte, error) {
 	return json.Marshal(e.Payload)
 }
+
+// SessionSummary aggregates the events of a single session
+type SessionSummary struct {
+	SessionID     string
+	FirstEvent    time.Time
+	LastEvent     time.Time
+	EventCount    int // Stored events
+	DroppedCount  int // Events discarded by sampling (not stored)
+	AnalysisCount int
+}
+
+// TotalEvents returns the number of events the session emitted, including those
+// discarded by sampling
+func (s *SessionSummary) TotalEvents() int {
+	return s.EventCount + s.DroppedCount
+}
+
+// HasAnalysis reports whether the session has at least one stored analysis
+func (s *SessionSummary) HasAnalysis() bool {
+	return s.AnalysisCount > 0
+}
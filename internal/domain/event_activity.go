@@ -0,0 +1,20 @@
+package domain
+
+import "time"
+
+// EventActivitySlot is the granularity at which event activity is counted. Every
+// time zone offset in use is a multiple of 15 minutes, so slots aggregate exactly
+// into local hours and days in any zone, across daylight saving changes.
+const EventActivitySlot = 15 * time.Minute
+
+// EventActivityFilter selects the events counted by CountEventsBySlot
+type EventActivityFilter struct {
+	Since time.Time // Only events at or after Since (zero = all)
+	Types []string  // Only events of these exact types (empty = all)
+}
+
+// EventSlotCount is the number of events in the EventActivitySlot starting at Start
+type EventSlotCount struct {
+	Start time.Time
+	Count int
+}
@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// DedupeKeyFields are the event fields that can make up a dedupe key
+var DedupeKeyFields = []string{"session", "type", "timestamp", "content", "payload"}
+
+// DefaultDedupeKey is the key used when none is configured: events with the same
+// session, type, timestamp and content are duplicates
+var DefaultDedupeKey = []string{"session", "type", "timestamp", "content"}
+
+// ParseDedupeKey parses a comma-separated list of dedupe key fields. An empty value
+// yields DefaultDedupeKey.
+func ParseDedupeKey(value string) ([]string, error) {
+	if strings.TrimSpace(value) == "" {
+		return append([]string(nil), DefaultDedupeKey...), nil
+	}
+
+	var key []string
+	seen := make(map[string]bool)
+	for _, field := range strings.Split(value, ",") {
+		field = strings.TrimSpace(field)
+		if !isDedupeKeyField(field) {
+			return nil, fmt.Errorf("%w: unknown dedupe key field %q (valid: %s)",
+				pluginsdk.ErrInvalidArgument, field, strings.Join(DedupeKeyFields, ", "))
+		}
+		if !seen[field] {
+			seen[field] = true
+			key = append(key, field)
+		}
+	}
+	return key, nil
+}
+
+func isDedupeKeyField(field string) bool {
+	for _, f := range DedupeKeyFields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+// DuplicateEventGroup is a set of events that agree on every dedupe key field.
+// KeepID is the earliest event of the group (lowest ID on equal timestamps);
+// DuplicateIDs are the others, which dedupe removes.
+type DuplicateEventGroup struct {
+	SessionID    string
+	EventType    string
+	Timestamp    time.Time
+	KeepID       string
+	DuplicateIDs []string
+}
@@ -0,0 +1,38 @@
+package domain_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/kgatilin/darwinflow-pub/internal/domain"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+func TestParseDedupeKey(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{"", "session,type,timestamp,content"},
+		{"type", "type"},
+		{"session, type,payload", "session,type,payload"},
+		{"type,type,content", "type,content"},
+	}
+	for _, tt := range tests {
+		key, err := domain.ParseDedupeKey(tt.value)
+		if err != nil {
+			t.Errorf("ParseDedupeKey(%q) failed: %v", tt.value, err)
+			continue
+		}
+		if got := strings.Join(key, ","); got != tt.want {
+			t.Errorf("ParseDedupeKey(%q) = %q, want %q", tt.value, got, tt.want)
+		}
+	}
+
+	for _, value := range []string{"colour", "session,", "type,id"} {
+		if _, err := domain.ParseDedupeKey(value); !errors.Is(err, pluginsdk.ErrInvalidArgument) {
+			t.Errorf("ParseDedupeKey(%q) error = %v, want ErrInvalidArgument", value, err)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// CoreEventCategories are the categories of events DarwinFlow captures itself. An
+// event type whose first segment is one of them ("tool.invoked", "chat.message.user")
+// has no source prefix.
+var CoreEventCategories = []string{"chat", "context", "error", "file", "session", "tool"}
+
+// EventKind is an event type decomposed into source, category and action:
+// "claude.tool.invoked" is source "claude", category "tool", action "invoked".
+// The action may span several segments ("claude.chat.message.user" has action
+// "message.user"); types starting with a core category have no source, and
+// single-segment types are a bare category.
+type EventKind struct {
+	Source   string
+	Category string
+	Action   string
+}
+
+// ParseEventKind decomposes a dotted event type into its kind
+func ParseEventKind(eventType string) EventKind {
+	segments := strings.Split(eventType, ".")
+	switch {
+	case len(segments) == 1:
+		return EventKind{Category: eventType}
+	case IsCoreEventCategory(segments[0]):
+		return EventKind{Category: segments[0], Action: strings.Join(segments[1:], ".")}
+	default:
+		return EventKind{Source: segments[0], Category: segments[1], Action: strings.Join(segments[2:], ".")}
+	}
+}
+
+// Matches reports whether the kind has the given source and category; an empty
+// source or category matches any
+func (k EventKind) Matches(source, category string) bool {
+	return (source == "" || k.Source == source) && (category == "" || k.Category == category)
+}
+
+// IsCoreEventCategory reports whether category is one of CoreEventCategories
+func IsCoreEventCategory(category string) bool {
+	for _, c := range CoreEventCategories {
+		if c == category {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateEventKindFilter checks a source and category filter: each must be a
+// single type segment, and a core category cannot be used as a source
+func ValidateEventKindFilter(source, category string) error {
+	for name, value := range map[string]string{"source": source, "category": category} {
+		if strings.Contains(value, ".") {
+			return fmt.Errorf("%w: event %s '%s' must be a single segment without dots", pluginsdk.ErrInvalidArgument, name, value)
+		}
+	}
+	if IsCoreEventCategory(source) {
+		return fmt.Errorf("%w: '%s' is an event category, not a source (use --category %s)", pluginsdk.ErrInvalidArgument, source, source)
+	}
+	return nil
+}
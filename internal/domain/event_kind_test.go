@@ -0,0 +1,56 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kgatilin/darwinflow-pub/internal/domain"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+func TestParseEventKind(t *testing.T) {
+	tests := []struct {
+		eventType string
+		want      domain.EventKind
+	}{
+		{"claude.tool.invoked", domain.EventKind{Source: "claude", Category: "tool", Action: "invoked"}},
+		{"claude.chat.message.user", domain.EventKind{Source: "claude", Category: "chat", Action: "message.user"}},
+		{"claude.error", domain.EventKind{Source: "claude", Category: "error"}},
+		{"tool.invoked", domain.EventKind{Category: "tool", Action: "invoked"}},
+		{"tool.result", domain.EventKind{Category: "tool", Action: "result"}},
+		{"chat.message.user", domain.EventKind{Category: "chat", Action: "message.user"}},
+		{"gmail.email_received", domain.EventKind{Source: "gmail", Category: "email_received"}},
+		{"marker", domain.EventKind{Category: "marker"}},
+	}
+
+	for _, tt := range tests {
+		if got := domain.ParseEventKind(tt.eventType); got != tt.want {
+			t.Errorf("ParseEventKind(%q) = %+v, want %+v", tt.eventType, got, tt.want)
+		}
+	}
+}
+
+func TestEventKind_Matches(t *testing.T) {
+	kind := domain.ParseEventKind("claude.tool.invoked")
+
+	if !kind.Matches("", "") || !kind.Matches("claude", "") || !kind.Matches("", "tool") || !kind.Matches("claude", "tool") {
+		t.Error("Expected claude.tool.invoked to match its source and category")
+	}
+	if kind.Matches("gmail", "") || kind.Matches("claude", "chat") {
+		t.Error("Expected claude.tool.invoked not to match another source or category")
+	}
+	if domain.ParseEventKind("chat.message.user").Matches("", "message") {
+		t.Error("An action segment is not a category")
+	}
+}
+
+func TestValidateEventKindFilter(t *testing.T) {
+	if err := domain.ValidateEventKindFilter("claude", "tool"); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	for _, filter := range [][2]string{{"tool", ""}, {"claude.tool", ""}, {"", "tool.invoked"}} {
+		if err := domain.ValidateEventKindFilter(filter[0], filter[1]); !errors.Is(err, pluginsdk.ErrInvalidArgument) {
+			t.Errorf("ValidateEventKindFilter(%q, %q) = %v, want ErrInvalidArgument", filter[0], filter[1], err)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// EventLabelCount is the number of stored events tagged with Label
+type EventLabelCount struct {
+	Label string
+	Count int
+}
+
+// NormalizeEventLabel returns label as it is stored: trimmed and lower-cased. Labels
+// are otherwise free-form, but must not be empty or contain commas, which separate
+// labels on the command line.
+func NormalizeEventLabel(label string) (string, error) {
+	normalized := strings.ToLower(strings.TrimSpace(label))
+	if normalized == "" {
+		return "", fmt.Errorf("%w: label must not be empty", pluginsdk.ErrInvalidArgument)
+	}
+	if strings.Contains(normalized, ",") {
+		return "", fmt.Errorf("%w: label %q must not contain a comma", pluginsdk.ErrInvalidArgument, label)
+	}
+	return normalized, nil
+}
+
+// NormalizeEventLabels normalizes each of labels (see NormalizeEventLabel) and returns
+// them sorted, without duplicates
+func NormalizeEventLabels(labels []string) ([]string, error) {
+	seen := make(map[string]bool, len(labels))
+	normalized := make([]string, 0, len(labels))
+	for _, label := range labels {
+		n, err := NormalizeEventLabel(label)
+		if err != nil {
+			return nil, err
+		}
+		if !seen[n] {
+			seen[n] = true
+			normalized = append(normalized, n)
+		}
+	}
+	sort.Strings(normalized)
+	return normalized, nil
+}
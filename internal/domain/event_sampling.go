@@ -0,0 +1,23 @@
+package domain
+
+import (
+	"hash/fnv"
+	"math"
+)
+
+// KeepSampledEvent decides whether an event is stored when its type is sampled at rate.
+// The decision depends only on key, which identifies the event by its content, so
+// re-running the same events keeps the same subset. Rates at or above 1 keep
+// everything; at or below 0, nothing.
+func KeepSampledEvent(key string, rate float64) bool {
+	if rate >= 1 {
+		return true
+	}
+	if rate <= 0 {
+		return false
+	}
+
+	h := fnv.New64a()
+	h.Write([]byte(key))
+	return float64(h.Sum64())/math.MaxUint64 < rate
+}
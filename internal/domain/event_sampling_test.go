@@ -0,0 +1,50 @@
+package domain_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kgatilin/darwinflow-pub/internal/domain"
+)
+
+func TestKeepSampledEvent_Bounds(t *testing.T) {
+	if !domain.KeepSampledEvent("any-id", 1) {
+		t.Error("Expected rate 1 to keep every event")
+	}
+	if domain.KeepSampledEvent("any-id", 0) {
+		t.Error("Expected rate 0 to drop every event")
+	}
+}
+
+func TestKeepSampledEvent_DeterministicAndProportional(t *testing.T) {
+	const total = 10000
+	kept := 0
+	for i := 0; i < total; i++ {
+		id := fmt.Sprintf("event-%d", i)
+		keep := domain.KeepSampledEvent(id, 0.25)
+		if keep != domain.KeepSampledEvent(id, 0.25) {
+			t.Fatalf("Decision for %s changed between calls", id)
+		}
+		if keep {
+			kept++
+		}
+	}
+
+	if kept < total*20/100 || kept > total*30/100 {
+		t.Errorf("Expected about 25%% of %d events kept, got %d", total, kept)
+	}
+}
+
+func TestEventsConfig_SampleRate(t *testing.T) {
+	config := domain.EventsConfig{Sample: map[string]float64{"tool.result": 0.1}}
+
+	if got := config.SampleRate("tool.result"); got != 0.1 {
+		t.Errorf("SampleRate(tool.result) = %v, want 0.1", got)
+	}
+	if got := config.SampleRate("chat.started"); got != 1 {
+		t.Errorf("SampleRate(chat.started) = %v, want 1 for unconfigured types", got)
+	}
+	if got := (domain.EventsConfig{}).SampleRate("tool.result"); got != 1 {
+		t.Errorf("SampleRate with no config = %v, want 1", got)
+	}
+}
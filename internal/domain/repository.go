@@ -25,6 +25,99 @@ type EventRepository interface {
 	Close() error
 }
 
+// PayloadIndexer is implemented by event repositories that keep a side index of
+// selected payload keys. ReindexPayloads rebuilds that index from stored events
+// and returns the number of events indexed.
+type PayloadIndexer interface {
+	ReindexPayloads(ctx context.Context) (int, error)
+}
+
+// SchemaChecker is implemented by event repositories that can verify their schema
+// without migrating it. CheckSchema returns the missing tables and columns
+// (empty if the schema is current).
+type SchemaChecker interface {
+	CheckSchema(ctx context.Context) ([]string, error)
+}
+
+// SampleDropRecorder is implemented by event repositories that keep per-session,
+// per-type counts of events discarded by sampling, so totals can be reconstructed.
+type SampleDropRecorder interface {
+	RecordSampleDrop(ctx context.Context, sessionID, eventType string) error
+}
+
+// EventDeduplicator is implemented by event repositories that can find and remove
+// duplicate events, i.e. events that agree on every field of key (see DedupeKeyFields).
+// DeleteDuplicateEvents keeps the earliest event of each group and returns the groups
+// it cleaned up.
+type EventDeduplicator interface {
+	FindDuplicateEvents(ctx context.Context, key []string) ([]*DuplicateEventGroup, error)
+	DeleteDuplicateEvents(ctx context.Context, key []string) ([]*DuplicateEventGroup, error)
+}
+
+// SessionMerger is implemented by event repositories that can merge split sessions.
+// MergeSessions reassigns the events and analyses of session from to session to in a
+// single transaction; with deleteSource, whatever could not be moved is removed so no
+// trace of from remains. Both sessions must have events (pluginsdk.ErrNotFound).
+type SessionMerger interface {
+	MergeSessions(ctx context.Context, from, to string, deleteSource bool) (*SessionMergeResult, error)
+}
+
+// AnalysisCoverageStreamer is implemented by event repositories that can select events
+// by the analyses of their session. StreamEventsByAnalysisCoverage passes the matching
+// events to fn in chronological order and returns how many it passed.
+type AnalysisCoverageStreamer interface {
+	StreamEventsByAnalysisCoverage(ctx context.Context, filter AnalysisCoverageFilter, fn func(*Event) error) (int, error)
+}
+
+// SessionDeleter is implemented by event repositories that can remove everything
+// recorded for a session. DeleteSession deletes the session's events, analyses, event
+// links, labels and sampling counters in a single transaction; with dryRun it only
+// counts them. A session with nothing recorded returns pluginsdk.ErrNotFound.
+type SessionDeleter interface {
+	DeleteSession(ctx context.Context, sessionID string, dryRun bool) (*SessionDeleteResult, error)
+}
+
+// EventActivityCounter is implemented by event repositories that can count events
+// over time. CountEventsBySlot returns the non-empty EventActivitySlot slots of the
+// selected events with their counts, oldest first.
+type EventActivityCounter interface {
+	CountEventsBySlot(ctx context.Context, filter EventActivityFilter) ([]EventSlotCount, error)
+}
+
+// EventLabeler is implemented by event repositories that can tag events with free-form
+// labels, stored as NormalizeEventLabels returns them. AddEventLabels and
+// RemoveEventLabels return pluginsdk.ErrNotFound for unknown events. EventLabels returns
+// the sorted labels of those eventIDs that have any; CountEventLabels returns every label
+// of a stored event with its number of events, by label.
+type EventLabeler interface {
+	AddEventLabels(ctx context.Context, eventID string, labels []string) error
+	RemoveEventLabels(ctx context.Context, eventID string, labels []string) error
+	EventLabels(ctx context.Context, eventIDs []string) (map[string][]string, error)
+	CountEventLabels(ctx context.Context) ([]EventLabelCount, error)
+}
+
+// EventBatchSaver is implemented by event repositories that can store many events in
+// a single transaction. SaveBatch stores either all events or, on error, none of them.
+type EventBatchSaver interface {
+	SaveBatch(ctx context.Context, events []*Event) error
+}
+
+// AnalysisEventFinder is implemented by analysis repositories that record the events
+// an analysis was computed from. FindAnalysisEvents returns the linked events that are
+// still stored, in chronological order.
+type AnalysisEventFinder interface {
+	FindAnalysisEvents(ctx context.Context, analysisID string) ([]*Event, error)
+}
+
+// AnalysisDeleter is implemented by analysis repositories that can delete analyses.
+// DeleteAnalysis removes one analysis and its event links (pluginsdk.ErrNotFound when
+// there is none); DeleteAnalysesBySessionID removes every analysis of a session, or all
+// but the newest with keepLatest. Each call runs in a single transaction.
+type AnalysisDeleter interface {
+	DeleteAnalysis(ctx context.Context, analysisID string) (*AnalysisDeleteResult, error)
+	DeleteAnalysesBySessionID(ctx context.Context, sessionID string, keepLatest bool) (*AnalysisDeleteResult, error)
+}
+
 // Note: EventQuery, QueryResult, and RawQueryExecutor are now defined in pkg/pluginsdk
 // to serve as the single source of truth. Import from pluginsdk to use them.
 
@@ -71,4 +164,7 @@ type AnalysisRepository interface {
 	GetUnanalyzedSessionIDs(ctx context.Context) ([]string, error)
 	GetAllAnalyses(ctx context.Context, limit int) ([]*SessionAnalysis, error)
 	GetAllSessionIDs(ctx context.Context, limit int) ([]string, error)
+	// GetSessionSummaries aggregates events per session, most recent first.
+	// A nil sessionIDs slice summarizes all sessions; limit <= 0 means no limit.
+	GetSessionSummaries(ctx context.Context, sessionIDs []string, limit int) ([]*SessionSummary, error)
 }
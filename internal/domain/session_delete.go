@@ -0,0 +1,18 @@
+package domain
+
+// SessionDeleteResult reports what deleting a session removed, or would remove in a
+// dry run. An analysis is counted once even when it is stored both as a session
+// analysis and as a generic analysis.
+type SessionDeleteResult struct {
+	EventsDeleted      int // Events of the session
+	AnalysesDeleted    int // Session analyses and session-view analyses
+	EventLinksDeleted  int // Links from the removed analyses to their source events
+	LabelsDeleted      int // Labels attached to the removed events
+	PayloadKeysDeleted int // Payload side index entries of the removed events
+	SampleDropsDeleted int // Per-type counters of events discarded by sampling
+}
+
+// Empty reports whether nothing was recorded for the session
+func (r *SessionDeleteResult) Empty() bool {
+	return r.EventsDeleted == 0 && r.AnalysesDeleted == 0 && r.SampleDropsDeleted == 0
+}
@@ -0,0 +1,23 @@
+package domain
+
+import "time"
+
+// SessionMergeResult reports what merging one session into another moved. Session
+// analyses of the source whose type and model the target already has cannot move;
+// they stay on the source unless it is deleted.
+type SessionMergeResult struct {
+	EventsMoved       int // Events reassigned to the target session
+	AnalysesMoved     int // Analyses (session_analyses and session-view analyses) reassigned
+	AnalysesKept      int // Source session analyses left behind because the target has the same type and model
+	AnalysesDeleted   int // Source session analyses deleted along with the source
+	EventLinksDeleted int // Event links of the deleted source analyses
+
+	SourceStart, SourceEnd time.Time // Timestamps of the first and last source event before the merge
+	TargetStart, TargetEnd time.Time // Timestamps of the first and last target event before the merge
+}
+
+// Overlaps reports whether the two sessions' event time ranges overlapped, i.e. the
+// merged session interleaves events that were recorded in parallel
+func (r *SessionMergeResult) Overlaps() bool {
+	return !r.SourceStart.After(r.TargetEnd) && !r.TargetStart.After(r.SourceEnd)
+}
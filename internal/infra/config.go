@@ -27,17 +27,26 @@ func NewConfigLoader(logger *Logger) *ConfigLoader {
 	}
 }
 
-// LoadConfig loads configuration from the specified directory
+// LoadConfig loads the effective configuration: the config file (see LoadFileConfig)
+// with DW_* environment overrides applied. Invalid overrides are logged and ignored.
+func (c *ConfigLoader) LoadConfig(configPath string) (*domain.Config, error) {
+	config, err := c.LoadFileConfig(configPath)
+	if err != nil {
+		return nil, err
+	}
+	if err := domain.ApplyConfigEnv(config, os.LookupEnv); err != nil && c.logger != nil {
+		c.logger.Warn("%v", err)
+	}
+	return config, nil
+}
+
+// LoadFileConfig loads configuration from the specified directory without environment overrides
 // If configPath is empty, it looks for .darwinflow.yaml in the current directory
 // Falls back to default config if file doesn't exist
-func (c *ConfigLoader) LoadConfig(configPath string) (*domain.Config, error) {
-	// Determine config file path
-	if configPath == "" {
-		cwd, err := os.Getwd()
-		if err != nil {
-			return nil, fmt.Errorf("failed to get current directory: %w", err)
-		}
-		configPath = filepath.Join(cwd, DefaultConfigFileName)
+func (c *ConfigLoader) LoadFileConfig(configPath string) (*domain.Config, error) {
+	configPath, err := resolveConfigPath(configPath)
+	if err != nil {
+		return nil, err
 	}
 
 	if c.logger != nil {
@@ -146,12 +155,9 @@ func ValidateModelAlias(model string) bool {
 
 // SaveConfig saves configuration to the specified path and returns the actual path used
 func (c *ConfigLoader) SaveConfig(config *domain.Config, configPath string) (string, error) {
-	if configPath == "" {
-		cwd, err := os.Getwd()
-		if err != nil {
-			return "", fmt.Errorf("failed to get current directory: %w", err)
-		}
-		configPath = filepath.Join(cwd, DefaultConfigFileName)
+	configPath, err := resolveConfigPath(configPath)
+	if err != nil {
+		return "", err
 	}
 
 	if c.logger != nil {
@@ -188,3 +194,55 @@ func (c *ConfigLoader) InitializeDefaultConfig(configPath string) (string, error
 	config := domain.DefaultConfig()
 	return c.SaveConfig(config, configPath)
 }
+
+// ConfigFileKeys returns the dotted keys set in the config file, e.g.
+// "logs.default_limit" or "events.sample.tool.result", and the file's path.
+// A missing file sets no keys.
+func (c *ConfigLoader) ConfigFileKeys(configPath string) (map[string]bool, string, error) {
+	configPath, err := resolveConfigPath(configPath)
+	if err != nil {
+		return nil, "", err
+	}
+
+	keys := make(map[string]bool)
+	data, err := os.ReadFile(configPath)
+	if os.IsNotExist(err) {
+		return keys, configPath, nil
+	}
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	var raw map[string]interface{}
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, "", fmt.Errorf("failed to parse config file: %w", err)
+	}
+	collectConfigKeys(raw, "", keys)
+	return keys, configPath, nil
+}
+
+// collectConfigKeys adds the dotted paths of the leaf values of node to keys
+func collectConfigKeys(node map[string]interface{}, prefix string, keys map[string]bool) {
+	for name, value := range node {
+		key := prefix + name
+		if child, ok := value.(map[string]interface{}); ok {
+			collectConfigKeys(child, key+".", keys)
+			continue
+		}
+		if value != nil {
+			keys[key] = true
+		}
+	}
+}
+
+// resolveConfigPath returns configPath, or .darwinflow.yaml in the current directory if it is empty
+func resolveConfigPath(configPath string) (string, error) {
+	if configPath != "" {
+		return configPath, nil
+	}
+	cwd, err := os.Getwd()
+	if err != nil {
+		return "", fmt.Errorf("failed to get current directory: %w", err)
+	}
+	return filepath.Join(cwd, DefaultConfigFileName), nil
+}
@@ -154,6 +154,77 @@ analysis:
 	}
 }
 
+func TestConfigLoader_LoadConfig_EnvOverrides(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.yaml")
+	if err := os.WriteFile(configPath, []byte("logs:\n  default_limit: 50\n"), 0644); err != nil {
+		t.Fatalf("Failed to write config file: %v", err)
+	}
+	t.Setenv("DW_LOGS_DEFAULT_LIMIT", "5")
+	t.Setenv("DW_ANALYSIS_PARALLEL_LIMIT", "many") // Invalid, ignored
+
+	loader := infra.NewConfigLoader(nil)
+
+	config, err := loader.LoadConfig(configPath)
+	if err != nil {
+		t.Fatalf("LoadConfig failed: %v", err)
+	}
+	if got := config.Logs.EffectiveDefaultLimit(); got != 5 {
+		t.Errorf("Expected env override 5, got %d", got)
+	}
+	if config.Analysis.ParallelLimit != 3 {
+		t.Errorf("Expected invalid override to be ignored, got %d", config.Analysis.ParallelLimit)
+	}
+
+	fileConfig, err := loader.LoadFileConfig(configPath)
+	if err != nil {
+		t.Fatalf("LoadFileConfig failed: %v", err)
+	}
+	if got := fileConfig.Logs.EffectiveDefaultLimit(); got != 50 {
+		t.Errorf("Expected file value 50 without overrides, got %d", got)
+	}
+}
+
+func TestConfigLoader_ConfigFileKeys(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.yaml")
+	configContent := `
+analysis:
+  model: opus
+  claude_options:
+    system_prompt_mode: append
+events:
+  sample:
+    tool.result: 0.1
+`
+	if err := os.WriteFile(configPath, []byte(configContent), 0644); err != nil {
+		t.Fatalf("Failed to write config file: %v", err)
+	}
+
+	loader := infra.NewConfigLoader(nil)
+
+	keys, path, err := loader.ConfigFileKeys(configPath)
+	if err != nil {
+		t.Fatalf("ConfigFileKeys failed: %v", err)
+	}
+	if path != configPath {
+		t.Errorf("Expected path %s, got %s", configPath, path)
+	}
+	for _, key := range []string{"analysis.model", "analysis.claude_options.system_prompt_mode", "events.sample.tool.result"} {
+		if !keys[key] {
+			t.Errorf("Expected %s in file keys %v", key, keys)
+		}
+	}
+	if keys["logs.default_limit"] || len(keys) != 3 {
+		t.Errorf("Expected only the keys set in the file, got %v", keys)
+	}
+
+	keys, _, err = loader.ConfigFileKeys(filepath.Join(tmpDir, "missing.yaml"))
+	if err != nil || len(keys) != 0 {
+		t.Errorf("Expected no keys for a missing file, got %v (err %v)", keys, err)
+	}
+}
+
 func TestConfigLoader_LoadConfig_AppliesDefaults(t *testing.T) {
 	tmpDir := t.TempDir()
 
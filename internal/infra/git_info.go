@@ -0,0 +1,78 @@
+package infra
+
+import (
+	"context"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+// gitLookupTimeout bounds each git invocation so a slow or hung repository
+// never delays saving an event.
+const gitLookupTimeout = 2 * time.Second
+
+// gitInfo is the cached result of a lookup for one directory
+type gitInfo struct {
+	branch string
+	commit string
+}
+
+// GitInfoLookup resolves the current branch and HEAD commit of a directory.
+// Lookups are best-effort: directories that are not repositories (or a missing
+// git binary) yield empty values. Results are cached per directory for the
+// lifetime of the process.
+type GitInfoLookup struct {
+	mu    sync.Mutex
+	cache map[string]gitInfo
+}
+
+// NewGitInfoLookup creates a new git lookup with an empty cache
+func NewGitInfoLookup() *GitInfoLookup {
+	return &GitInfoLookup{cache: make(map[string]gitInfo)}
+}
+
+// GitInfo returns the branch and commit for dir, or empty strings if unknown.
+// A detached HEAD reports an empty branch with the commit still set.
+func (g *GitInfoLookup) GitInfo(dir string) (branch, commit string) {
+	if dir == "" {
+		return "", ""
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if info, ok := g.cache[dir]; ok {
+		return info.branch, info.commit
+	}
+
+	info := lookupGitInfo(dir)
+	g.cache[dir] = info
+	return info.branch, info.commit
+}
+
+// lookupGitInfo runs git once to resolve both the branch name and HEAD commit
+func lookupGitInfo(dir string) gitInfo {
+	ctx, cancel := context.WithTimeout(context.Background(), gitLookupTimeout)
+	defer cancel()
+
+	// --abbrev-ref only applies to the revisions that follow it
+	out, err := exec.CommandContext(ctx, "git", "-C", dir, "rev-parse", "HEAD", "--abbrev-ref", "HEAD").Output()
+	if err != nil {
+		return gitInfo{}
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(out)), "\n")
+	if len(lines) != 2 {
+		return gitInfo{}
+	}
+
+	info := gitInfo{
+		commit: strings.TrimSpace(lines[0]),
+		branch: strings.TrimSpace(lines[1]),
+	}
+	if info.branch == "HEAD" {
+		info.branch = ""
+	}
+	return info
+}
@@ -0,0 +1,58 @@
+package infra_test
+
+import (
+	"os/exec"
+	"testing"
+
+	"github.com/kgatilin/darwinflow-pub/internal/infra"
+)
+
+func TestGitInfoLookup_NotARepository(t *testing.T) {
+	lookup := infra.NewGitInfoLookup()
+
+	branch, commit := lookup.GitInfo(t.TempDir())
+	if branch != "" || commit != "" {
+		t.Errorf("GitInfo() = %q, %q, want empty values outside a repository", branch, commit)
+	}
+}
+
+func TestGitInfoLookup_EmptyDir(t *testing.T) {
+	lookup := infra.NewGitInfoLookup()
+
+	branch, commit := lookup.GitInfo("")
+	if branch != "" || commit != "" {
+		t.Errorf("GitInfo(\"\") = %q, %q, want empty values", branch, commit)
+	}
+}
+
+func TestGitInfoLookup_Repository(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	dir := t.TempDir()
+	runGit := func(args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v failed: %v\n%s", args, err, out)
+		}
+	}
+	runGit("init", "-q", "-b", "feature")
+	runGit("-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "-q", "--allow-empty", "-m", "initial")
+
+	lookup := infra.NewGitInfoLookup()
+	branch, commit := lookup.GitInfo(dir)
+	if branch != "feature" {
+		t.Errorf("branch = %q, want %q", branch, "feature")
+	}
+	if len(commit) != 40 {
+		t.Errorf("commit = %q, want a full SHA", commit)
+	}
+
+	// Later commits are not observed: lookups are cached for the process
+	runGit("-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "-q", "--allow-empty", "-m", "second")
+	if _, cached := lookup.GitInfo(dir); cached != commit {
+		t.Errorf("cached commit = %q, want %q", cached, commit)
+	}
+}
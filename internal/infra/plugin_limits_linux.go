@@ -0,0 +1,35 @@
+//go:build linux
+
+package infra
+
+import (
+	"fmt"
+
+	"golang.org/x/sys/unix"
+)
+
+// ProcessLimitsSupported reports whether ProcessLimits are enforced on this platform
+const ProcessLimitsSupported = true
+
+// applyProcessLimits sets the limits of the running process pid with prlimit(2).
+// The process runs unlimited between its start and this call.
+func applyProcessLimits(pid int, limits ProcessLimits) error {
+	set := func(resource int, name string, value uint64) error {
+		if value == 0 {
+			return nil
+		}
+		rlimit := unix.Rlimit{Cur: value, Max: value}
+		if err := unix.Prlimit(pid, resource, &rlimit, nil); err != nil {
+			return fmt.Errorf("failed to set %s limit: %w", name, err)
+		}
+		return nil
+	}
+
+	if err := set(unix.RLIMIT_AS, "memory", uint64(limits.MemoryMB)<<20); err != nil {
+		return err
+	}
+	if err := set(unix.RLIMIT_CPU, "CPU time", uint64(limits.CPUSeconds)); err != nil {
+		return err
+	}
+	return set(unix.RLIMIT_NOFILE, "open files", uint64(limits.OpenFiles))
+}
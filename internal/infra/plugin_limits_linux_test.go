@@ -0,0 +1,32 @@
+//go:build linux
+
+package infra_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/kgatilin/darwinflow-pub/internal/infra"
+)
+
+// TestSubprocessPlugin_Limits tests that configured limits apply to the plugin process
+func TestSubprocessPlugin_Limits(t *testing.T) {
+	pluginPath := buildExternalPlugin(t)
+
+	plugin := infra.NewSubprocessPlugin(pluginPath)
+	plugin.SetProcessOptions(infra.ProcessOptions{
+		Limits: infra.ProcessLimits{CPUSeconds: 60, OpenFiles: 64},
+	})
+	if err := plugin.Initialize(context.Background(), t.TempDir(), nil); err != nil {
+		t.Fatalf("initialization failed: %v", err)
+	}
+	defer plugin.Shutdown()
+
+	limits := runTestCommand(t, plugin, "read", "/proc/self/limits")
+	for _, want := range []string{`Max cpu time\s+60\s+60\s`, `Max open files\s+64\s+64\s`, `Max address space\s+unlimited\s`} {
+		if !regexp.MustCompile(want).MatchString(limits) {
+			t.Errorf("expected limit %q in:\n%s", want, limits)
+		}
+	}
+}
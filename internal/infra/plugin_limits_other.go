@@ -0,0 +1,11 @@
+//go:build !linux
+
+package infra
+
+// ProcessLimitsSupported reports whether ProcessLimits are enforced on this platform
+const ProcessLimitsSupported = false
+
+// applyProcessLimits is a no-op: the platform has no way to limit another process
+func applyProcessLimits(pid int, limits ProcessLimits) error {
+	return nil
+}
@@ -5,6 +5,8 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"runtime"
+	"sort"
 
 	"gopkg.in/yaml.v3"
 
@@ -16,9 +18,11 @@ import (
 type PluginConfig struct {
 	Command        string            `yaml:"command"`
 	Args           []string          `yaml:"args"`
-	Env            map[string]string `yaml:"env"`
-	Enabled        *bool             `yaml:"enabled"`        // Pointer to distinguish between unset and false
-	Timeout        int               `yaml:"timeout"`        // seconds
+	Env            map[string]string `yaml:"env"`         // Set in the plugin's environment
+	InheritEnv     []string          `yaml:"inherit_env"` // Host variables passed through besides DefaultPluginEnv
+	Limits         ProcessLimits     `yaml:"limits"`
+	Enabled        *bool             `yaml:"enabled"` // Pointer to distinguish between unset and false
+	Timeout        int               `yaml:"timeout"` // seconds
 	RestartOnCrash bool              `yaml:"restart_on_crash"`
 }
 
@@ -45,9 +49,17 @@ type pluginsYAML struct {
 	Plugins map[string]PluginConfig `yaml:"plugins"`
 }
 
+// SkippedPlugin describes an enabled plugin from plugins.yaml that could not be loaded
+type SkippedPlugin struct {
+	Name   string
+	Reason string
+}
+
 // PluginLoader loads external plugins from a YAML configuration file.
 type PluginLoader struct {
-	logger *Logger
+	logger   *Logger
+	skipped  []SkippedPlugin
+	disabled []string
 }
 
 // NewPluginLoader creates a new plugin loader.
@@ -65,7 +77,7 @@ func NewPluginLoader(logger *Logger) *PluginLoader {
 // Behavior:
 // - Skips plugins with enabled=false
 // - Skips plugins where the command executable doesn't exist (logs warning)
-// - Resolves relative paths relative to the .darwinflow/ directory
+// - Resolves relative paths to absolute ones under the .darwinflow/ directory
 // - Returns all successfully loaded plugins
 // - Collects and returns warnings for skipped plugins
 //
@@ -98,12 +110,15 @@ func (l *PluginLoader) LoadFromConfig(configPath string) ([]pluginsdk.Plugin, er
 
 	// Load each plugin
 	var plugins []pluginsdk.Plugin
+	l.skipped = nil
+	l.disabled = nil
 	for name, pluginCfg := range config.Plugins {
 		// Skip disabled plugins
 		if !pluginCfg.IsEnabled() {
 			if l.logger != nil {
 				l.logger.Debug("Skipping disabled plugin: %s", name)
 			}
+			l.disabled = append(l.disabled, name)
 			continue
 		}
 
@@ -112,14 +127,24 @@ func (l *PluginLoader) LoadFromConfig(configPath string) ([]pluginsdk.Plugin, er
 			if l.logger != nil {
 				l.logger.Warn("Skipping plugin '%s': command is required", name)
 			}
+			l.skipped = append(l.skipped, SkippedPlugin{Name: name, Reason: "command is required"})
 			continue
 		}
 
 		// Resolve command path
 		cmdPath := pluginCfg.Command
 		if !filepath.IsAbs(cmdPath) {
-			// Relative path - resolve relative to config directory
-			cmdPath = filepath.Join(configDir, cmdPath)
+			// Relative path - resolve relative to config directory. It must end up
+			// absolute: the plugin starts in the project directory, not in dw's.
+			absPath, err := filepath.Abs(filepath.Join(configDir, cmdPath))
+			if err != nil {
+				if l.logger != nil {
+					l.logger.Warn("Skipping plugin '%s': %v", name, err)
+				}
+				l.skipped = append(l.skipped, SkippedPlugin{Name: name, Reason: err.Error()})
+				continue
+			}
+			cmdPath = absPath
 		}
 
 		// Check if command executable exists
@@ -127,6 +152,7 @@ func (l *PluginLoader) LoadFromConfig(configPath string) ([]pluginsdk.Plugin, er
 			if l.logger != nil {
 				l.logger.Warn("Skipping plugin '%s': %v", name, err)
 			}
+			l.skipped = append(l.skipped, SkippedPlugin{Name: name, Reason: err.Error()})
 			continue
 		}
 
@@ -142,6 +168,19 @@ func (l *PluginLoader) LoadFromConfig(configPath string) ([]pluginsdk.Plugin, er
 	return plugins, nil
 }
 
+// Skipped returns the enabled plugins skipped by the last LoadFromConfig call
+func (l *PluginLoader) Skipped() []SkippedPlugin {
+	return append([]SkippedPlugin(nil), l.skipped...)
+}
+
+// Disabled returns the names of the plugins with enabled=false in the last
+// LoadFromConfig call, sorted by name
+func (l *PluginLoader) Disabled() []string {
+	disabled := append([]string(nil), l.disabled...)
+	sort.Strings(disabled)
+	return disabled
+}
+
 // validateCommand checks if the command exists and is executable.
 func (l *PluginLoader) validateCommand(cmdPath string) error {
 	// First check if it's an absolute path that exists
@@ -178,15 +217,16 @@ func (l *PluginLoader) validateCommand(cmdPath string) error {
 
 // createSubprocessPlugin creates a SubprocessPlugin from the configuration.
 func (l *PluginLoader) createSubprocessPlugin(name, cmdPath string, cfg PluginConfig) pluginsdk.Plugin {
-	// Create subprocess plugin with command and args
+	// Create subprocess plugin with command and args. It runs in the project
+	// directory (set by Initialize) with a minimal environment.
 	plugin := NewSubprocessPlugin(cmdPath, cfg.Args...)
+	plugin.SetProcessOptions(ProcessOptions{
+		Env:    PluginEnvironment(cfg.InheritEnv, cfg.Env),
+		Limits: cfg.Limits,
+	})
 
-	// TODO: Future enhancement - set environment variables on the subprocess
-	// This would require extending SubprocessPlugin to accept env vars
-	// For now, we document this limitation
-
-	if l.logger != nil && len(cfg.Env) > 0 {
-		l.logger.Warn("Plugin '%s': environment variables not yet supported (will be added in future)", name)
+	if l.logger != nil && !cfg.Limits.IsZero() && !ProcessLimitsSupported {
+		l.logger.Warn("Plugin '%s': resource limits are not supported on %s and are ignored", name, runtime.GOOS)
 	}
 
 	// TODO: Future enhancement - implement timeout configuration
@@ -153,6 +153,14 @@ plugins:
 	if len(plugins) != 0 {
 		t.Errorf("Expected 0 plugins (disabled should be skipped), got %d", len(plugins))
 	}
+
+	// Disabled plugins are reported separately from plugins that failed to load
+	if disabled := loader.Disabled(); len(disabled) != 1 || disabled[0] != "test-plugin" {
+		t.Errorf("Expected Disabled() = [test-plugin], got %v", disabled)
+	}
+	if skipped := loader.Skipped(); len(skipped) != 0 {
+		t.Errorf("Expected no skipped plugins, got %v", skipped)
+	}
 }
 
 // TestPluginLoader_LoadFromConfig_RelativePath tests resolution of relative paths.
@@ -195,6 +203,42 @@ plugins:
 	}
 }
 
+// TestPluginLoader_LoadFromConfig_RelativeConfigPath tests that a command under a
+// relative config path resolves to an absolute path, since plugins don't start in
+// dw's working directory.
+func TestPluginLoader_LoadFromConfig_RelativeConfigPath(t *testing.T) {
+	tempDir := t.TempDir()
+	configDir := filepath.Join(tempDir, ".darwinflow")
+	if err := os.Mkdir(configDir, 0755); err != nil {
+		t.Fatalf("Failed to create config directory: %v", err)
+	}
+	createMockExecutable(t, filepath.Join(configDir, "my-plugin"))
+	configContent := "plugins:\n  my-plugin:\n    command: my-plugin\n    enabled: true\n"
+	if err := os.WriteFile(filepath.Join(configDir, "plugins.yaml"), []byte(configContent), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+
+	originalDir, _ := os.Getwd()
+	defer os.Chdir(originalDir)
+	os.Chdir(tempDir)
+
+	plugins, err := infra.NewPluginLoader(infra.NewDefaultLogger()).LoadFromConfig(filepath.Join(".darwinflow", "plugins.yaml"))
+	if err != nil {
+		t.Fatalf("LoadFromConfig failed: %v", err)
+	}
+	if len(plugins) != 1 {
+		t.Fatalf("Expected 1 plugin, got %d", len(plugins))
+	}
+
+	path := plugins[0].(*infra.SubprocessPlugin).ExecutablePath()
+	if !filepath.IsAbs(path) {
+		t.Errorf("Expected an absolute command path, got %q", path)
+	}
+	if _, err := os.Stat(path); err != nil {
+		t.Errorf("Expected command path %q to exist: %v", path, err)
+	}
+}
+
 // TestPluginLoader_LoadFromConfig_MultiplePlugins tests loading multiple plugins.
 func TestPluginLoader_LoadFromConfig_MultiplePlugins(t *testing.T) {
 	tempDir := t.TempDir()
@@ -272,6 +316,12 @@ plugins:
 	if len(plugins) != 1 {
 		t.Errorf("Expected 1 plugin (only valid enabled one), got %d", len(plugins))
 	}
+
+	// Only the enabled invalid plugin is reported as skipped
+	skipped := loader.Skipped()
+	if len(skipped) != 1 || skipped[0].Name != "invalid-plugin" {
+		t.Errorf("Expected invalid-plugin to be reported as skipped, got %+v", skipped)
+	}
 }
 
 // TestPluginLoader_LoadFromConfig_EmptyCommand tests handling of missing command field.
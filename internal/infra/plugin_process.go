@@ -0,0 +1,69 @@
+package infra
+
+import (
+	"os"
+	"sort"
+	"strings"
+)
+
+// DefaultPluginEnv lists the host environment variables every external plugin
+// receives. Everything else has to be passed explicitly with inherit_env or env
+// in plugins.yaml, so credentials in dw's environment don't leak into plugins.
+var DefaultPluginEnv = []string{
+	"PATH", "HOME", "USER", "LOGNAME", "SHELL",
+	"TMPDIR", "TMP", "TEMP",
+	"LANG", "LC_ALL", "LC_CTYPE", "TZ", "TERM",
+	"NO_COLOR",   // set by dw for --no-color and non-terminal output
+	"SYSTEMROOT", // required by most Windows programs
+}
+
+// ProcessLimits are OS resource limits for a plugin subprocess. Zero means no limit.
+// They are only enforced where ProcessLimitsSupported is true.
+type ProcessLimits struct {
+	MemoryMB   int `yaml:"memory_mb"`   // address space (RLIMIT_AS)
+	CPUSeconds int `yaml:"cpu_seconds"` // CPU time (RLIMIT_CPU)
+	OpenFiles  int `yaml:"open_files"`  // file descriptors (RLIMIT_NOFILE)
+}
+
+// IsZero reports whether no limit is set
+func (l ProcessLimits) IsZero() bool {
+	return l == ProcessLimits{}
+}
+
+// ProcessOptions controls how a plugin subprocess is started
+type ProcessOptions struct {
+	Dir    string        // Working directory; empty inherits dw's
+	Env    []string      // Environment as KEY=VALUE; nil inherits dw's whole environment
+	Limits ProcessLimits // Resource limits, applied right after the process starts (not before exec)
+}
+
+// PluginEnvironment builds a plugin's environment: the DefaultPluginEnv and inherit
+// variables set in dw's environment, overridden by env. Entries are sorted by name.
+func PluginEnvironment(inherit []string, env map[string]string) []string {
+	allowed := make(map[string]bool, len(DefaultPluginEnv)+len(inherit))
+	for _, name := range DefaultPluginEnv {
+		allowed[name] = true
+	}
+	for _, name := range inherit {
+		allowed[name] = true
+	}
+
+	vars := make(map[string]string)
+	for name := range allowed {
+		if value, ok := os.LookupEnv(name); ok {
+			vars[name] = value
+		}
+	}
+	for name, value := range env {
+		vars[name] = value
+	}
+
+	result := make([]string, 0, len(vars))
+	for name, value := range vars {
+		result = append(result, name+"="+value)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return strings.SplitN(result[i], "=", 2)[0] < strings.SplitN(result[j], "=", 2)[0]
+	})
+	return result
+}
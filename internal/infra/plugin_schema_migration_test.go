@@ -0,0 +1,134 @@
+package infra_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// These tests exercise pluginsdk.ApplySchemaMigrations against a real SQLite database.
+
+func notesMigrations(calls *int) []pluginsdk.SchemaMigration {
+	return []pluginsdk.SchemaMigration{
+		{
+			Version:     2,
+			Description: "add notes priority",
+			Up: func(ctx context.Context, tx *sql.Tx) error {
+				*calls++
+				_, err := tx.ExecContext(ctx, "ALTER TABLE notes ADD COLUMN priority INTEGER NOT NULL DEFAULT 0")
+				return err
+			},
+		},
+		{
+			Version:     1,
+			Description: "create notes",
+			Up: func(ctx context.Context, tx *sql.Tx) error {
+				*calls++
+				_, err := tx.ExecContext(ctx, "CREATE TABLE notes (id TEXT PRIMARY KEY)")
+				return err
+			},
+		},
+	}
+}
+
+func TestApplySchemaMigrations_Idempotent(t *testing.T) {
+	db, _ := setupTestDB(t)
+	defer db.Close()
+	ctx := context.Background()
+
+	calls := 0
+	applied, err := pluginsdk.ApplySchemaMigrations(ctx, db, "notes", notesMigrations(&calls))
+	if err != nil {
+		t.Fatalf("ApplySchemaMigrations failed: %v", err)
+	}
+	if !reflect.DeepEqual(applied, []int{1, 2}) {
+		t.Errorf("applied = %v, want [1 2] (in version order)", applied)
+	}
+
+	applied, err = pluginsdk.ApplySchemaMigrations(ctx, db, "notes", notesMigrations(&calls))
+	if err != nil {
+		t.Fatalf("second ApplySchemaMigrations failed: %v", err)
+	}
+	if len(applied) != 0 || calls != 2 {
+		t.Errorf("second run should apply nothing, got applied=%v calls=%d", applied, calls)
+	}
+
+	versions, err := pluginsdk.AppliedSchemaVersions(ctx, db, "notes")
+	if err != nil {
+		t.Fatalf("AppliedSchemaVersions failed: %v", err)
+	}
+	if !reflect.DeepEqual(versions, []int{1, 2}) {
+		t.Errorf("recorded versions = %v, want [1 2]", versions)
+	}
+
+	other, err := pluginsdk.AppliedSchemaVersions(ctx, db, "other-plugin")
+	if err != nil {
+		t.Fatalf("AppliedSchemaVersions failed: %v", err)
+	}
+	if len(other) != 0 {
+		t.Errorf("versions are tracked per plugin, got %v for other-plugin", other)
+	}
+}
+
+func TestApplySchemaMigrations_FailureRollsBack(t *testing.T) {
+	db, _ := setupTestDB(t)
+	defer db.Close()
+	ctx := context.Background()
+
+	migrations := []pluginsdk.SchemaMigration{
+		{Version: 1, Description: "create notes", Up: func(ctx context.Context, tx *sql.Tx) error {
+			_, err := tx.ExecContext(ctx, "CREATE TABLE notes (id TEXT PRIMARY KEY)")
+			return err
+		}},
+		{Version: 2, Description: "broken", Up: func(ctx context.Context, tx *sql.Tx) error {
+			if _, err := tx.ExecContext(ctx, "CREATE TABLE half_done (id TEXT)"); err != nil {
+				return err
+			}
+			return fmt.Errorf("boom")
+		}},
+	}
+
+	applied, err := pluginsdk.ApplySchemaMigrations(ctx, db, "notes", migrations)
+	if err == nil {
+		t.Fatal("expected migration 2 to fail")
+	}
+	if !reflect.DeepEqual(applied, []int{1}) {
+		t.Errorf("applied = %v, want [1]", applied)
+	}
+
+	var count int
+	if err := db.QueryRow("SELECT COUNT(*) FROM sqlite_master WHERE name = 'half_done'").Scan(&count); err != nil {
+		t.Fatalf("failed to inspect schema: %v", err)
+	}
+	if count != 0 {
+		t.Error("failed migration should be rolled back")
+	}
+
+	versions, _ := pluginsdk.AppliedSchemaVersions(ctx, db, "notes")
+	if !reflect.DeepEqual(versions, []int{1}) {
+		t.Errorf("recorded versions = %v, want [1]", versions)
+	}
+}
+
+func TestApplySchemaMigrations_InvalidVersions(t *testing.T) {
+	db, _ := setupTestDB(t)
+	defer db.Close()
+
+	noop := func(ctx context.Context, tx *sql.Tx) error { return nil }
+	tests := map[string][]pluginsdk.SchemaMigration{
+		"zero version": {{Version: 0, Up: noop}},
+		"duplicate":    {{Version: 1, Up: noop}, {Version: 1, Up: noop}},
+		"missing up":   {{Version: 1}},
+	}
+	for name, migrations := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := pluginsdk.ApplySchemaMigrations(context.Background(), db, "notes", migrations); err == nil {
+				t.Error("expected validation error")
+			}
+		})
+	}
+}
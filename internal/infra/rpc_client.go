@@ -26,6 +26,9 @@ type RPCClient struct {
 	// args are the command-line arguments to pass to the plugin
 	args []string
 
+	// options set the working directory, environment and limits of the subprocess
+	options ProcessOptions
+
 	// cmd is the subprocess handle
 	cmd *exec.Cmd
 
@@ -78,6 +81,12 @@ func NewRPCClient(executablePath string, args ...string) *RPCClient {
 	}
 }
 
+// SetProcessOptions sets how the subprocess is started. It has no effect once the
+// client is started.
+func (c *RPCClient) SetProcessOptions(options ProcessOptions) {
+	c.options = options
+}
+
 // Start starts the plugin subprocess and begins reading responses.
 // It returns an error if the subprocess fails to start.
 func (c *RPCClient) Start(ctx context.Context) error {
@@ -86,6 +95,8 @@ func (c *RPCClient) Start(ctx context.Context) error {
 
 	// Create subprocess
 	c.cmd = exec.CommandContext(c.ctx, c.executablePath, c.args...)
+	c.cmd.Dir = c.options.Dir
+	c.cmd.Env = c.options.Env
 
 	// Setup pipes
 	stdin, err := c.cmd.StdinPipe()
@@ -110,6 +121,11 @@ func (c *RPCClient) Start(ctx context.Context) error {
 	if err := c.cmd.Start(); err != nil {
 		return fmt.Errorf("failed to start plugin process: %w", err)
 	}
+	if err := applyProcessLimits(c.cmd.Process.Pid, c.options.Limits); err != nil {
+		c.cmd.Process.Kill()
+		c.cmd.Wait()
+		return err
+	}
 
 	// Start background goroutines
 	go c.readLoop()
@@ -213,7 +229,7 @@ func (c *RPCClient) Call(ctx context.Context, method string, params interface{})
 		select {
 		case resp := <-responseChan:
 			if resp.Error != nil {
-				return nil, fmt.Errorf("rpc error %d: %s", resp.Error.Code, resp.Error.Message)
+				return nil, rpcCallError(resp.Error)
 			}
 			return resp.Result, nil
 		case <-ctx.Done():
@@ -229,7 +245,7 @@ func (c *RPCClient) Call(ctx context.Context, method string, params interface{})
 		select {
 		case resp := <-responseChan:
 			if resp.Error != nil {
-				return nil, fmt.Errorf("rpc error %d: %s", resp.Error.Code, resp.Error.Message)
+				return nil, rpcCallError(resp.Error)
 			}
 			return resp.Result, nil
 		case <-timeoutChan:
@@ -394,3 +410,12 @@ func (c *RPCClient) getError() error {
 	defer c.errMu.RUnlock()
 	return c.err
 }
+
+// rpcCallError converts an RPC error response into an error. RPCErrorNotFound wraps
+// pluginsdk.ErrNotFound so callers can tell missing entities from failures.
+func rpcCallError(rpcErr *pluginsdk.RPCError) error {
+	if rpcErr.Code == pluginsdk.RPCErrorNotFound {
+		return fmt.Errorf("%w: rpc error %d: %s", pluginsdk.ErrNotFound, rpcErr.Code, rpcErr.Message)
+	}
+	return fmt.Errorf("rpc error %d: %s", rpcErr.Code, rpcErr.Message)
+}
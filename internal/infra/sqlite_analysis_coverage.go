@@ -0,0 +1,61 @@
+package infra
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kgatilin/darwinflow-pub/internal/domain"
+)
+
+// StreamEventsByAnalysisCoverage passes the events selected by filter to fn, oldest
+// first, joining each event's session to session_analyses. Events without a session
+// are only included when the coverage is AnalysisCoverageAll. Rows are read as they
+// are passed on, so large exports are not held in memory.
+// Implements domain.AnalysisCoverageStreamer.
+func (r *SQLiteEventRepository) StreamEventsByAnalysisCoverage(ctx context.Context, filter domain.AnalysisCoverageFilter, fn func(*domain.Event) error) (int, error) {
+	sqlQuery := `SELECT e.id, e.timestamp, e.event_type, e.session_id, e.payload, e.content, COALESCE(e.version, '1.0'),
+		       e.working_dir, e.git_branch, e.git_commit, e.sampled
+		FROM events e`
+	var args []interface{}
+
+	if filter.Coverage != domain.AnalysisCoverageAll {
+		analyzed := `SELECT 1 FROM session_analyses a WHERE a.session_id = e.session_id`
+		if filter.AnalysisType != "" {
+			analyzed += ` AND (a.analysis_type = ? OR a.analysis_type LIKE ? ESCAPE '\')`
+			args = append(args, filter.AnalysisType, "%\\_"+escapeLikePattern(filter.AnalysisType))
+		}
+
+		switch filter.Coverage {
+		case domain.AnalysisCoverageAnalyzed:
+			sqlQuery += " WHERE EXISTS (" + analyzed + ")"
+		case domain.AnalysisCoverageUnanalyzed:
+			sqlQuery += " WHERE e.session_id IS NOT NULL AND e.session_id != '' AND NOT EXISTS (" + analyzed + ")"
+		default:
+			return 0, fmt.Errorf("unknown analysis coverage %q", filter.Coverage)
+		}
+	}
+	sqlQuery += " ORDER BY e.timestamp ASC, e.session_id, e.id"
+
+	rows, err := r.db.QueryContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query events: %w", err)
+	}
+	defer rows.Close()
+
+	count := 0
+	for rows.Next() {
+		event, err := scanEvent(rows)
+		if err != nil {
+			return count, err
+		}
+		if err := fn(event); err != nil {
+			return count, err
+		}
+		count++
+	}
+
+	if err := rows.Err(); err != nil {
+		return count, fmt.Errorf("error iterating rows: %w", err)
+	}
+	return count, nil
+}
@@ -0,0 +1,84 @@
+package infra_test
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kgatilin/darwinflow-pub/internal/domain"
+	"github.com/kgatilin/darwinflow-pub/internal/infra"
+)
+
+func TestSQLiteEventRepository_StreamEventsByAnalysisCoverage(t *testing.T) {
+	store, err := infra.NewSQLiteEventRepository(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("NewSQLiteEventRepository failed: %v", err)
+	}
+	ctx := context.Background()
+	if err := store.Initialize(ctx); err != nil {
+		t.Fatalf("Initialize failed: %v", err)
+	}
+	defer store.Close()
+
+	base := time.UnixMilli(1700000000000)
+	save := func(id, sessionID string, offset time.Duration) {
+		t.Helper()
+		event := domain.NewEvent("tool.invoked", sessionID, map[string]string{"id": id}, id)
+		event.ID = id
+		event.Timestamp = base.Add(offset)
+		if err := store.Save(ctx, event); err != nil {
+			t.Fatalf("Save(%s) failed: %v", id, err)
+		}
+	}
+	analyze := func(sessionID, analysisType string) {
+		t.Helper()
+		analysis := domain.NewSessionAnalysisWithType(sessionID, "result", "model", "prompt", analysisType, "prompt")
+		if err := store.SaveAnalysis(ctx, analysis); err != nil {
+			t.Fatalf("SaveAnalysis(%s) failed: %v", sessionID, err)
+		}
+	}
+
+	// summarized has a summary, tooled only a tool analysis, bare and the
+	// session-less event none
+	save("sum-2", "summarized", 2*time.Second)
+	save("sum-1", "summarized", 0)
+	save("tool-1", "tooled", time.Second)
+	save("bare-1", "bare", 3*time.Second)
+	save("orphan-1", "", 4*time.Second)
+	analyze("summarized", "session_summary")
+	analyze("tooled", "tool_analysis")
+
+	tests := []struct {
+		name   string
+		filter domain.AnalysisCoverageFilter
+		want   string
+	}{
+		{"all", domain.AnalysisCoverageFilter{}, "sum-1,tool-1,sum-2,bare-1,orphan-1"},
+		{"analyzed", domain.AnalysisCoverageFilter{Coverage: domain.AnalysisCoverageAnalyzed}, "sum-1,tool-1,sum-2"},
+		{"unanalyzed", domain.AnalysisCoverageFilter{Coverage: domain.AnalysisCoverageUnanalyzed}, "bare-1"},
+		{"analyzed summary shorthand", domain.AnalysisCoverageFilter{Coverage: domain.AnalysisCoverageAnalyzed, AnalysisType: "summary"}, "sum-1,sum-2"},
+		{"analyzed full type", domain.AnalysisCoverageFilter{Coverage: domain.AnalysisCoverageAnalyzed, AnalysisType: "tool_analysis"}, "tool-1"},
+		{"unanalyzed summary", domain.AnalysisCoverageFilter{Coverage: domain.AnalysisCoverageUnanalyzed, AnalysisType: "summary"}, "tool-1,bare-1"},
+		{"no partial suffix match", domain.AnalysisCoverageFilter{Coverage: domain.AnalysisCoverageAnalyzed, AnalysisType: "mary"}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ids []string
+			count, err := store.StreamEventsByAnalysisCoverage(ctx, tt.filter, func(event *domain.Event) error {
+				ids = append(ids, event.ID)
+				return nil
+			})
+			if err != nil {
+				t.Fatalf("StreamEventsByAnalysisCoverage failed: %v", err)
+			}
+			if got := strings.Join(ids, ","); got != tt.want {
+				t.Errorf("got events %q, want %q", got, tt.want)
+			}
+			if count != len(ids) {
+				t.Errorf("count = %d, want %d", count, len(ids))
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package infra
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+
+	"github.com/kgatilin/darwinflow-pub/internal/domain"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// DeleteAnalysis removes an analysis from both analysis tables together with its event
+// links. Implements domain.AnalysisDeleter.
+func (r *SQLiteEventRepository) DeleteAnalysis(ctx context.Context, analysisID string) (*domain.AnalysisDeleteResult, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result := &domain.AnalysisDeleteResult{}
+	found, err := deleteAnalysisRows(ctx, tx, analysisID, result)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("%w: analysis %s", pluginsdk.ErrNotFound, analysisID)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return result, nil
+}
+
+// DeleteAnalysesBySessionID removes the session analyses and session-view analyses of a
+// session with their event links. With keepLatest the newest analysis is kept.
+// Implements domain.AnalysisDeleter.
+func (r *SQLiteEventRepository) DeleteAnalysesBySessionID(ctx context.Context, sessionID string, keepLatest bool) (*domain.AnalysisDeleteResult, error) {
+	if sessionID == "" {
+		return nil, fmt.Errorf("%w: session ID must not be empty", pluginsdk.ErrInvalidArgument)
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	ids, err := sessionAnalysisIDs(ctx, tx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &domain.AnalysisDeleteResult{}
+	if keepLatest && len(ids) > 0 {
+		ids = ids[1:]
+		result.AnalysesKept = 1
+	}
+	for _, id := range ids {
+		if _, err := deleteAnalysisRows(ctx, tx, id, result); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return result, nil
+}
+
+// sessionAnalysisIDs returns the IDs of a session's analyses from both tables, newest first
+func sessionAnalysisIDs(ctx context.Context, tx *sql.Tx, sessionID string) ([]string, error) {
+	rows, err := tx.QueryContext(ctx, `
+		SELECT id, analyzed_at FROM session_analyses WHERE session_id = ?
+		UNION ALL
+		SELECT id, timestamp FROM analyses WHERE view_id = ? AND view_type = 'session'
+	`, sessionID, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query session analyses: %w", err)
+	}
+	defer rows.Close()
+
+	// Both tables store a session analysis under the same ID; keep its latest timestamp
+	latest := make(map[string]int64)
+	for rows.Next() {
+		var id string
+		var ts int64
+		if err := rows.Scan(&id, &ts); err != nil {
+			return nil, fmt.Errorf("failed to scan analysis: %w", err)
+		}
+		if prev, ok := latest[id]; !ok || ts > prev {
+			latest[id] = ts
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	ids := make([]string, 0, len(latest))
+	for id := range latest {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		if latest[ids[i]] != latest[ids[j]] {
+			return latest[ids[i]] > latest[ids[j]]
+		}
+		return ids[i] < ids[j]
+	})
+	return ids, nil
+}
+
+// deleteAnalysisRows removes an analysis from both tables and its event links, adding
+// the removed rows to result. It reports whether the analysis existed.
+func deleteAnalysisRows(ctx context.Context, tx *sql.Tx, analysisID string, result *domain.AnalysisDeleteResult) (bool, error) {
+	generic, err := execCount(ctx, tx, "DELETE FROM analyses WHERE id = ?", analysisID)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete analysis %s: %w", analysisID, err)
+	}
+	session, err := execCount(ctx, tx, "DELETE FROM session_analyses WHERE id = ?", analysisID)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete session analysis %s: %w", analysisID, err)
+	}
+	links, err := execCount(ctx, tx, "DELETE FROM analysis_events WHERE analysis_id = ?", analysisID)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete event links of analysis %s: %w", analysisID, err)
+	}
+
+	if generic == 0 && session == 0 {
+		return false, nil
+	}
+	result.AnalysesDeleted++
+	result.EventLinksDeleted += links
+	return true, nil
+}
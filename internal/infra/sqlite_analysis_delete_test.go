@@ -0,0 +1,109 @@
+package infra_test
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kgatilin/darwinflow-pub/internal/domain"
+	"github.com/kgatilin/darwinflow-pub/internal/infra"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+func TestSQLiteEventRepository_DeleteAnalyses(t *testing.T) {
+	store, err := infra.NewSQLiteEventRepository(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("NewSQLiteEventRepository failed: %v", err)
+	}
+	ctx := context.Background()
+	if err := store.Initialize(ctx); err != nil {
+		t.Fatalf("Initialize failed: %v", err)
+	}
+	defer store.Close()
+
+	// Saves an analysis the way the analysis service does: a generic analysis with its
+	// event links, and a session analysis under the same ID
+	base := time.UnixMilli(1700000000000)
+	save := func(sessionID, model string, offset time.Duration) string {
+		t.Helper()
+		analysis := domain.NewAnalysis(sessionID, "session", "result", model, "tool_analysis")
+		analysis.Timestamp = base.Add(offset)
+		analysis.EventIDs = []string{sessionID + "-evt-1", sessionID + "-evt-2"}
+		if err := store.SaveGenericAnalysis(ctx, analysis); err != nil {
+			t.Fatalf("SaveGenericAnalysis failed: %v", err)
+		}
+		sessionAnalysis := domain.NewSessionAnalysisWithType(sessionID, "result", model, "p", "tool_analysis", "tool_analysis")
+		sessionAnalysis.ID = analysis.ID
+		sessionAnalysis.AnalyzedAt = analysis.Timestamp
+		if err := store.SaveAnalysis(ctx, sessionAnalysis); err != nil {
+			t.Fatalf("SaveAnalysis failed: %v", err)
+		}
+		return analysis.ID
+	}
+	oldest := save("rerun", "haiku", 0)
+	save("rerun", "sonnet", time.Minute)
+	newest := save("rerun", "opus", 2*time.Minute)
+	other := save("other", "sonnet", 0)
+
+	countAnalyses := func(sessionID string) int {
+		t.Helper()
+		analyses, err := store.GetAnalysesBySessionID(ctx, sessionID)
+		if err != nil {
+			t.Fatalf("GetAnalysesBySessionID failed: %v", err)
+		}
+		return len(analyses)
+	}
+
+	result, err := store.DeleteAnalysis(ctx, oldest)
+	if err != nil {
+		t.Fatalf("DeleteAnalysis failed: %v", err)
+	}
+	if result.AnalysesDeleted != 1 || result.EventLinksDeleted != 2 {
+		t.Errorf("result = %+v, want 1 analysis and 2 event links deleted", result)
+	}
+	if n := countAnalyses("rerun"); n != 2 {
+		t.Errorf("session has %d analyses after deleting one, want 2", n)
+	}
+	if found, _ := store.FindAnalysisById(ctx, oldest); found != nil {
+		t.Errorf("deleted analysis %s is still found", oldest)
+	}
+	if _, err := store.DeleteAnalysis(ctx, oldest); !errors.Is(err, pluginsdk.ErrNotFound) {
+		t.Errorf("deleting it again: err = %v, want ErrNotFound", err)
+	}
+
+	result, err = store.DeleteAnalysesBySessionID(ctx, "rerun", true)
+	if err != nil {
+		t.Fatalf("DeleteAnalysesBySessionID failed: %v", err)
+	}
+	if result.AnalysesDeleted != 1 || result.AnalysesKept != 1 {
+		t.Errorf("result = %+v, want 1 analysis deleted and 1 kept", result)
+	}
+	remaining, err := store.GetAnalysesBySessionID(ctx, "rerun")
+	if err != nil {
+		t.Fatalf("GetAnalysesBySessionID failed: %v", err)
+	}
+	if len(remaining) != 1 || remaining[0].ID != newest {
+		t.Errorf("expected only the newest analysis %s to remain, got %d", newest, len(remaining))
+	}
+
+	result, err = store.DeleteAnalysesBySessionID(ctx, "rerun", false)
+	if err != nil {
+		t.Fatalf("DeleteAnalysesBySessionID failed: %v", err)
+	}
+	if result.AnalysesDeleted != 1 || countAnalyses("rerun") != 0 {
+		t.Errorf("result = %+v, want the last analysis of the session deleted", result)
+	}
+	if generic, _ := store.FindAnalysisByViewID(ctx, "rerun"); len(generic) != 0 {
+		t.Errorf("expected no generic analyses left for the session, got %d", len(generic))
+	}
+
+	// The other session is untouched
+	if n := countAnalyses("other"); n != 1 {
+		t.Errorf("other session has %d analyses, want 1", n)
+	}
+	if found, err := store.FindAnalysisById(ctx, other); err != nil || found == nil || len(found.EventIDs) != 2 {
+		t.Errorf("other session's analysis or its event links were removed: %+v, %v", found, err)
+	}
+}
@@ -0,0 +1,88 @@
+package infra
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kgatilin/darwinflow-pub/internal/domain"
+)
+
+// analysisEventsSchema creates the link table recording which events fed an analysis.
+// Links are kept when their events are deleted, so an analysis still reports how many
+// events it was computed from.
+const analysisEventsSchema = `
+	CREATE TABLE IF NOT EXISTS analysis_events (
+		analysis_id TEXT NOT NULL,
+		event_id TEXT NOT NULL,
+		PRIMARY KEY (analysis_id, event_id)
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_analysis_events_event_id ON analysis_events(event_id);
+`
+
+// saveAnalysisEvents links analysisID to each of eventIDs
+func saveAnalysisEvents(ctx context.Context, exec execer, analysisID string, eventIDs []string) error {
+	for _, eventID := range eventIDs {
+		_, err := exec.ExecContext(ctx,
+			"INSERT OR IGNORE INTO analysis_events (analysis_id, event_id) VALUES (?, ?)",
+			analysisID, eventID)
+		if err != nil {
+			return fmt.Errorf("failed to link analysis to event %s: %w", eventID, err)
+		}
+	}
+	return nil
+}
+
+// getAnalysisEventIDs returns the IDs of the events linked to an analysis, or nil when
+// none were recorded
+func (r *SQLiteEventRepository) getAnalysisEventIDs(ctx context.Context, analysisID string) ([]string, error) {
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT event_id FROM analysis_events WHERE analysis_id = ? ORDER BY rowid", analysisID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query analysis events: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan analysis event: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+	return ids, nil
+}
+
+// FindAnalysisEvents returns the stored events linked to an analysis, oldest first.
+// Linked events that have since been deleted are left out.
+// Implements domain.AnalysisEventFinder.
+func (r *SQLiteEventRepository) FindAnalysisEvents(ctx context.Context, analysisID string) ([]*domain.Event, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT e.id, e.timestamp, e.event_type, e.session_id, e.payload, e.content, COALESCE(e.version, '1.0'),
+		       e.working_dir, e.git_branch, e.git_commit, e.sampled
+		FROM analysis_events ae
+		INNER JOIN events e ON e.id = ae.event_id
+		WHERE ae.analysis_id = ?
+		ORDER BY e.timestamp ASC, e.id`, analysisID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query analysis events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []*domain.Event
+	for rows.Next() {
+		event, err := scanEvent(rows)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+	return events, nil
+}
@@ -0,0 +1,84 @@
+package infra_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kgatilin/darwinflow-pub/internal/domain"
+	"github.com/kgatilin/darwinflow-pub/internal/infra"
+)
+
+func TestSQLiteEventRepository_AnalysisEventLinks(t *testing.T) {
+	store, err := infra.NewSQLiteEventRepository(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("NewSQLiteEventRepository failed: %v", err)
+	}
+	ctx := context.Background()
+	if err := store.Initialize(ctx); err != nil {
+		t.Fatalf("Initialize failed: %v", err)
+	}
+	defer store.Close()
+
+	base := time.UnixMilli(1700000000000)
+	for i, id := range []string{"ev-2", "ev-1", "ev-other"} {
+		event := domain.NewEvent("tool.invoked", "session-1", map[string]string{"id": id}, id)
+		event.ID = id
+		event.Timestamp = base.Add(time.Duration(2-i) * time.Second)
+		if err := store.Save(ctx, event); err != nil {
+			t.Fatalf("Save(%s) failed: %v", id, err)
+		}
+	}
+
+	linked := domain.NewAnalysis("session-1", "session", "result", "sonnet", "tool_analysis")
+	linked.EventIDs = []string{"ev-1", "ev-2", "ev-deleted"}
+	if err := store.SaveGenericAnalysis(ctx, linked); err != nil {
+		t.Fatalf("SaveGenericAnalysis failed: %v", err)
+	}
+	legacy := domain.NewAnalysis("session-1", "session", "result", "sonnet", "tool_analysis")
+	if err := store.SaveGenericAnalysis(ctx, legacy); err != nil {
+		t.Fatalf("SaveGenericAnalysis failed: %v", err)
+	}
+
+	t.Run("links are retrieved with the analysis", func(t *testing.T) {
+		got, err := store.FindAnalysisById(ctx, linked.ID)
+		if err != nil {
+			t.Fatalf("FindAnalysisById failed: %v", err)
+		}
+		if len(got.EventIDs) != 3 || got.EventIDs[0] != "ev-1" || got.EventIDs[1] != "ev-2" || got.EventIDs[2] != "ev-deleted" {
+			t.Errorf("EventIDs = %v, want [ev-1 ev-2 ev-deleted]", got.EventIDs)
+		}
+	})
+
+	t.Run("linked events that are still stored, oldest first", func(t *testing.T) {
+		events, err := store.FindAnalysisEvents(ctx, linked.ID)
+		if err != nil {
+			t.Fatalf("FindAnalysisEvents failed: %v", err)
+		}
+		if len(events) != 2 || events[0].ID != "ev-1" || events[1].ID != "ev-2" {
+			ids := make([]string, len(events))
+			for i, e := range events {
+				ids[i] = e.ID
+			}
+			t.Errorf("events = %v, want [ev-1 ev-2]", ids)
+		}
+	})
+
+	t.Run("analysis without links", func(t *testing.T) {
+		got, err := store.FindAnalysisById(ctx, legacy.ID)
+		if err != nil {
+			t.Fatalf("FindAnalysisById failed: %v", err)
+		}
+		if got.EventIDs != nil {
+			t.Errorf("EventIDs = %v, want nil", got.EventIDs)
+		}
+		events, err := store.FindAnalysisEvents(ctx, legacy.ID)
+		if err != nil {
+			t.Fatalf("FindAnalysisEvents failed: %v", err)
+		}
+		if len(events) != 0 {
+			t.Errorf("expected no events, got %d", len(events))
+		}
+	})
+}
@@ -0,0 +1,56 @@
+package infra
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kgatilin/darwinflow-pub/internal/domain"
+)
+
+// CountEventsBySlot counts the selected events per domain.EventActivitySlot in SQL,
+// grouping the millisecond timestamps by integer division so the idx_events_timestamp
+// index serves the range and no event rows leave the database. Slots are aligned to
+// the Unix epoch, i.e. to UTC quarter hours.
+// Implements domain.EventActivityCounter.
+func (r *SQLiteEventRepository) CountEventsBySlot(ctx context.Context, filter domain.EventActivityFilter) ([]domain.EventSlotCount, error) {
+	slotMs := domain.EventActivitySlot.Milliseconds()
+	sqlQuery := `SELECT timestamp / ? AS slot, COUNT(*) FROM events`
+	args := []interface{}{slotMs}
+
+	var conditions []string
+	if !filter.Since.IsZero() {
+		conditions = append(conditions, "timestamp >= ?")
+		args = append(args, filter.Since.UnixMilli())
+	}
+	if len(filter.Types) > 0 {
+		conditions = append(conditions, "event_type IN (?"+strings.Repeat(", ?", len(filter.Types)-1)+")")
+		for _, eventType := range filter.Types {
+			args = append(args, eventType)
+		}
+	}
+	if len(conditions) > 0 {
+		sqlQuery += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	sqlQuery += " GROUP BY slot ORDER BY slot"
+
+	rows, err := r.db.QueryContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count events: %w", err)
+	}
+	defer rows.Close()
+
+	var slots []domain.EventSlotCount
+	for rows.Next() {
+		var slot int64
+		var count int
+		if err := rows.Scan(&slot, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		slots = append(slots, domain.EventSlotCount{Start: millisecondsToTime(slot * slotMs), Count: count})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read event counts: %w", err)
+	}
+	return slots, nil
+}
@@ -0,0 +1,85 @@
+package infra_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kgatilin/darwinflow-pub/internal/domain"
+	"github.com/kgatilin/darwinflow-pub/internal/infra"
+)
+
+func TestSQLiteEventRepository_CountEventsBySlot(t *testing.T) {
+	store, err := infra.NewSQLiteEventRepository(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("NewSQLiteEventRepository failed: %v", err)
+	}
+	ctx := context.Background()
+	if err := store.Initialize(ctx); err != nil {
+		t.Fatalf("Initialize failed: %v", err)
+	}
+	defer store.Close()
+
+	// 2023-11-14 22:00:00 UTC, a quarter-hour boundary
+	base := time.UnixMilli(1700000000000).Truncate(time.Hour)
+	save := func(id, eventType string, ts time.Time) {
+		t.Helper()
+		event := domain.NewEvent(eventType, "s1", map[string]string{"id": id}, id)
+		event.ID = id
+		event.Timestamp = ts
+		if err := store.Save(ctx, event); err != nil {
+			t.Fatalf("Save(%s) failed: %v", id, err)
+		}
+	}
+	save("evt-1", "tool.invoked", base)
+	save("evt-2", "tool.invoked", base.Add(14*time.Minute+59*time.Second))
+	save("evt-3", "chat.message.user", base.Add(15*time.Minute))
+	save("evt-4", "tool.invoked", base.Add(2*time.Hour+time.Millisecond))
+
+	tests := []struct {
+		name   string
+		filter domain.EventActivityFilter
+		want   []domain.EventSlotCount
+	}{
+		{
+			name: "all events",
+			want: []domain.EventSlotCount{
+				{Start: base, Count: 2},
+				{Start: base.Add(15 * time.Minute), Count: 1},
+				{Start: base.Add(2 * time.Hour), Count: 1},
+			},
+		},
+		{
+			name:   "since",
+			filter: domain.EventActivityFilter{Since: base.Add(time.Minute)},
+			want: []domain.EventSlotCount{
+				{Start: base, Count: 1},
+				{Start: base.Add(15 * time.Minute), Count: 1},
+				{Start: base.Add(2 * time.Hour), Count: 1},
+			},
+		},
+		{
+			name:   "types",
+			filter: domain.EventActivityFilter{Types: []string{"chat.message.user", "missing"}},
+			want:   []domain.EventSlotCount{{Start: base.Add(15 * time.Minute), Count: 1}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := store.CountEventsBySlot(ctx, tt.filter)
+			if err != nil {
+				t.Fatalf("CountEventsBySlot failed: %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d slots, want %d: %+v", len(got), len(tt.want), got)
+			}
+			for i := range got {
+				if !got[i].Start.Equal(tt.want[i].Start) || got[i].Count != tt.want[i].Count {
+					t.Errorf("slot %d = %v x%d, want %v x%d", i, got[i].Start.UTC(), got[i].Count, tt.want[i].Start.UTC(), tt.want[i].Count)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package infra
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kgatilin/darwinflow-pub/internal/domain"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// dedupeKeyColumns maps dedupe key fields to events columns
+var dedupeKeyColumns = map[string]string{
+	"session":   "session_id",
+	"type":      "event_type",
+	"timestamp": "timestamp",
+	"content":   "content",
+	"payload":   "payload",
+}
+
+// queryer is satisfied by *sql.DB and *sql.Tx
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+}
+
+// FindDuplicateEvents returns the groups of events that agree on every field of key,
+// ordered by the time of their earliest event.
+// Implements domain.EventDeduplicator.
+func (r *SQLiteEventRepository) FindDuplicateEvents(ctx context.Context, key []string) ([]*domain.DuplicateEventGroup, error) {
+	return findDuplicateEvents(ctx, r.db, key)
+}
+
+// DeleteDuplicateEvents removes all but the earliest event of each duplicate group in
+// a single transaction and returns the groups that were cleaned up. Labels and analysis
+// links of removed events are moved to the kept event, so no label is lost and analyses
+// keep pointing at an existing event.
+// Implements domain.EventDeduplicator.
+func (r *SQLiteEventRepository) DeleteDuplicateEvents(ctx context.Context, key []string) ([]*domain.DuplicateEventGroup, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	groups, err := findDuplicateEvents(ctx, tx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, group := range groups {
+		for _, id := range group.DuplicateIDs {
+			if _, err := tx.ExecContext(ctx,
+				"INSERT OR IGNORE INTO event_labels (event_id, label) SELECT ?, label FROM event_labels WHERE event_id = ?",
+				group.KeepID, id); err != nil {
+				return nil, fmt.Errorf("failed to move labels of event %s: %w", id, err)
+			}
+			if _, err := tx.ExecContext(ctx, "DELETE FROM event_labels WHERE event_id = ?", id); err != nil {
+				return nil, fmt.Errorf("failed to delete labels of event %s: %w", id, err)
+			}
+			if _, err := tx.ExecContext(ctx,
+				"INSERT OR IGNORE INTO analysis_events (analysis_id, event_id) SELECT analysis_id, ? FROM analysis_events WHERE event_id = ?",
+				group.KeepID, id); err != nil {
+				return nil, fmt.Errorf("failed to move analysis links of event %s: %w", id, err)
+			}
+			if _, err := tx.ExecContext(ctx, "DELETE FROM analysis_events WHERE event_id = ?", id); err != nil {
+				return nil, fmt.Errorf("failed to delete analysis links of event %s: %w", id, err)
+			}
+			// The payload index is cleaned up by the events_payload_index_delete trigger
+			if _, err := tx.ExecContext(ctx, "DELETE FROM events WHERE id = ?", id); err != nil {
+				return nil, fmt.Errorf("failed to delete event %s: %w", id, err)
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return groups, nil
+}
+
+// findDuplicateEvents groups events by the key columns using window functions. Within
+// a group events are ordered by timestamp and ID, so the first one is kept.
+func findDuplicateEvents(ctx context.Context, q queryer, key []string) ([]*domain.DuplicateEventGroup, error) {
+	if len(key) == 0 {
+		return nil, fmt.Errorf("%w: dedupe key must not be empty", pluginsdk.ErrInvalidArgument)
+	}
+	columns := make([]string, 0, len(key))
+	for _, field := range key {
+		column, ok := dedupeKeyColumns[field]
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown dedupe key field %q", pluginsdk.ErrInvalidArgument, field)
+		}
+		columns = append(columns, column)
+	}
+	partition := strings.Join(columns, ", ")
+
+	query := fmt.Sprintf(`
+		SELECT id, session_id, event_type, timestamp, keep_id
+		FROM (
+			SELECT id, session_id, event_type, timestamp,
+				FIRST_VALUE(id) OVER w AS keep_id,
+				MIN(timestamp) OVER (PARTITION BY %[1]s) AS group_ts,
+				COUNT(*) OVER (PARTITION BY %[1]s) AS group_size
+			FROM events
+			WINDOW w AS (PARTITION BY %[1]s ORDER BY timestamp, id)
+		)
+		WHERE group_size > 1
+		ORDER BY group_ts, keep_id, timestamp, id
+	`, partition)
+
+	rows, err := q.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query duplicate events: %w", err)
+	}
+	defer rows.Close()
+
+	var groups []*domain.DuplicateEventGroup
+	var current *domain.DuplicateEventGroup
+	for rows.Next() {
+		var (
+			id, eventType, keepID string
+			sessionID             sql.NullString
+			timestamp             int64
+		)
+		if err := rows.Scan(&id, &sessionID, &eventType, &timestamp, &keepID); err != nil {
+			return nil, fmt.Errorf("failed to scan duplicate event: %w", err)
+		}
+		if id == keepID {
+			current = &domain.DuplicateEventGroup{
+				SessionID: sessionID.String,
+				EventType: eventType,
+				Timestamp: time.UnixMilli(timestamp),
+				KeepID:    keepID,
+			}
+			groups = append(groups, current)
+			continue
+		}
+		current.DuplicateIDs = append(current.DuplicateIDs, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating duplicate events: %w", err)
+	}
+
+	return groups, nil
+}
@@ -0,0 +1,229 @@
+package infra_test
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kgatilin/darwinflow-pub/internal/domain"
+	"github.com/kgatilin/darwinflow-pub/internal/infra"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+func TestSQLiteEventRepository_DeleteDuplicateEvents(t *testing.T) {
+	store, err := infra.NewSQLiteEventRepository(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("NewSQLiteEventRepository failed: %v", err)
+	}
+	ctx := context.Background()
+	if err := store.Initialize(ctx); err != nil {
+		t.Fatalf("Initialize failed: %v", err)
+	}
+	defer store.Close()
+
+	base := time.UnixMilli(1700000000000)
+	save := func(id, sessionID, eventType, content string, ts time.Time) {
+		t.Helper()
+		event := domain.NewEvent(eventType, sessionID, map[string]string{"content": content}, content)
+		event.ID = id
+		event.Timestamp = ts
+		if err := store.Save(ctx, event); err != nil {
+			t.Fatalf("Save(%s) failed: %v", id, err)
+		}
+	}
+
+	// Group 1: three copies of the same tool call, saved out of ID order
+	save("evt-c", "s1", "tool.invoked", "Read main.go", base)
+	save("evt-a", "s1", "tool.invoked", "Read main.go", base)
+	save("evt-b", "s1", "tool.invoked", "Read main.go", base)
+	// Group 2: two copies of a chat message
+	save("evt-e", "s2", "chat.message.user", "hello", base.Add(time.Second))
+	save("evt-d", "s2", "chat.message.user", "hello", base.Add(time.Second))
+	// Not duplicates: differ in session, content or timestamp
+	save("evt-f", "s2", "tool.invoked", "Read main.go", base)
+	save("evt-g", "s1", "tool.invoked", "Read other.go", base)
+	save("evt-h", "s1", "tool.invoked", "Read main.go", base.Add(time.Millisecond))
+
+	found, err := store.FindDuplicateEvents(ctx, domain.DefaultDedupeKey)
+	if err != nil {
+		t.Fatalf("FindDuplicateEvents failed: %v", err)
+	}
+	if len(found) != 2 {
+		t.Fatalf("Expected 2 duplicate groups, got %d", len(found))
+	}
+	if all, _ := store.FindByQuery(ctx, pluginsdk.EventQuery{}); len(all) != 8 {
+		t.Fatalf("FindDuplicateEvents must not delete events, %d left", len(all))
+	}
+
+	groups, err := store.DeleteDuplicateEvents(ctx, domain.DefaultDedupeKey)
+	if err != nil {
+		t.Fatalf("DeleteDuplicateEvents failed: %v", err)
+	}
+	if len(groups) != 2 {
+		t.Fatalf("Expected 2 duplicate groups, got %d", len(groups))
+	}
+	if groups[0].KeepID != "evt-a" || len(groups[0].DuplicateIDs) != 2 || groups[0].EventType != "tool.invoked" {
+		t.Errorf("Expected group 1 to keep evt-a and remove 2 events, got %+v", groups[0])
+	}
+	if groups[1].KeepID != "evt-d" || len(groups[1].DuplicateIDs) != 1 || groups[1].DuplicateIDs[0] != "evt-e" {
+		t.Errorf("Expected group 2 to keep evt-d and remove evt-e, got %+v", groups[1])
+	}
+
+	remaining, err := store.FindByQuery(ctx, pluginsdk.EventQuery{})
+	if err != nil {
+		t.Fatalf("FindByQuery failed: %v", err)
+	}
+	ids := make(map[string]bool)
+	for _, event := range remaining {
+		ids[event.ID] = true
+	}
+	for _, id := range []string{"evt-a", "evt-d", "evt-f", "evt-g", "evt-h"} {
+		if !ids[id] {
+			t.Errorf("Expected %s to survive", id)
+		}
+	}
+	if len(remaining) != 5 {
+		t.Errorf("Expected 5 events to remain, got %d", len(remaining))
+	}
+
+	again, err := store.DeleteDuplicateEvents(ctx, domain.DefaultDedupeKey)
+	if err != nil {
+		t.Fatalf("DeleteDuplicateEvents failed: %v", err)
+	}
+	if len(again) != 0 {
+		t.Errorf("Expected no duplicates after dedupe, got %d groups", len(again))
+	}
+
+	// A looser key groups events that only differ in timestamp; the earliest is kept
+	groups, err = store.DeleteDuplicateEvents(ctx, []string{"session", "type", "content"})
+	if err != nil {
+		t.Fatalf("DeleteDuplicateEvents failed: %v", err)
+	}
+	if len(groups) != 1 || groups[0].KeepID != "evt-a" || groups[0].DuplicateIDs[0] != "evt-h" {
+		t.Errorf("Expected evt-h to be removed as a later copy of evt-a, got %+v", groups)
+	}
+}
+
+func TestSQLiteEventRepository_DeleteDuplicateEvents_MovesLabels(t *testing.T) {
+	store, err := infra.NewSQLiteEventRepository(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("NewSQLiteEventRepository failed: %v", err)
+	}
+	ctx := context.Background()
+	if err := store.Initialize(ctx); err != nil {
+		t.Fatalf("Initialize failed: %v", err)
+	}
+	defer store.Close()
+
+	base := time.UnixMilli(1700000000000)
+	for _, id := range []string{"evt-a", "evt-b"} {
+		event := domain.NewEvent("tool.invoked", "s1", map[string]string{"content": "Read main.go"}, "Read main.go")
+		event.ID = id
+		event.Timestamp = base
+		if err := store.Save(ctx, event); err != nil {
+			t.Fatalf("Save(%s) failed: %v", id, err)
+		}
+	}
+	// Only the duplicate carries "slow"; both carry "review"
+	if err := store.AddEventLabels(ctx, "evt-a", []string{"review"}); err != nil {
+		t.Fatalf("AddEventLabels failed: %v", err)
+	}
+	if err := store.AddEventLabels(ctx, "evt-b", []string{"review", "slow"}); err != nil {
+		t.Fatalf("AddEventLabels failed: %v", err)
+	}
+
+	groups, err := store.DeleteDuplicateEvents(ctx, domain.DefaultDedupeKey)
+	if err != nil {
+		t.Fatalf("DeleteDuplicateEvents failed: %v", err)
+	}
+	if len(groups) != 1 || groups[0].KeepID != "evt-a" {
+		t.Fatalf("Expected evt-a to be kept, got %+v", groups)
+	}
+
+	labels, err := store.EventLabels(ctx, []string{"evt-a", "evt-b"})
+	if err != nil {
+		t.Fatalf("EventLabels failed: %v", err)
+	}
+	if got := strings.Join(labels["evt-a"], ","); got != "review,slow" {
+		t.Errorf("Expected evt-a to have review,slow, got %q", got)
+	}
+	if len(labels["evt-b"]) != 0 {
+		t.Errorf("Expected no labels left on the removed event, got %v", labels["evt-b"])
+	}
+	counts, err := store.CountEventLabels(ctx)
+	if err != nil {
+		t.Fatalf("CountEventLabels failed: %v", err)
+	}
+	for _, count := range counts {
+		if count.Count != 1 {
+			t.Errorf("Expected label %s once, got %d", count.Label, count.Count)
+		}
+	}
+}
+
+func TestSQLiteEventRepository_DeleteDuplicateEvents_MovesAnalysisLinks(t *testing.T) {
+	store, err := infra.NewSQLiteEventRepository(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("NewSQLiteEventRepository failed: %v", err)
+	}
+	ctx := context.Background()
+	if err := store.Initialize(ctx); err != nil {
+		t.Fatalf("Initialize failed: %v", err)
+	}
+	defer store.Close()
+
+	base := time.UnixMilli(1700000000000)
+	for _, id := range []string{"evt-a", "evt-b"} {
+		event := domain.NewEvent("tool.invoked", "s1", map[string]string{"content": "Read main.go"}, "Read main.go")
+		event.ID = id
+		event.Timestamp = base
+		if err := store.Save(ctx, event); err != nil {
+			t.Fatalf("Save(%s) failed: %v", id, err)
+		}
+	}
+	// One analysis saw only the duplicate, the other saw both copies
+	onlyDuplicate := domain.NewAnalysis("s1", "session", "result", "sonnet", "tool_analysis")
+	onlyDuplicate.EventIDs = []string{"evt-b"}
+	both := domain.NewAnalysis("s1", "session", "result", "sonnet", "tool_analysis")
+	both.EventIDs = []string{"evt-a", "evt-b"}
+	for _, analysis := range []*domain.Analysis{onlyDuplicate, both} {
+		if err := store.SaveGenericAnalysis(ctx, analysis); err != nil {
+			t.Fatalf("SaveGenericAnalysis failed: %v", err)
+		}
+	}
+
+	if _, err := store.DeleteDuplicateEvents(ctx, domain.DefaultDedupeKey); err != nil {
+		t.Fatalf("DeleteDuplicateEvents failed: %v", err)
+	}
+
+	for _, analysis := range []*domain.Analysis{onlyDuplicate, both} {
+		got, err := store.FindAnalysisById(ctx, analysis.ID)
+		if err != nil {
+			t.Fatalf("FindAnalysisById failed: %v", err)
+		}
+		if strings.Join(got.EventIDs, ",") != "evt-a" {
+			t.Errorf("Expected analysis linked to evt-a only, got %v", got.EventIDs)
+		}
+	}
+}
+
+func TestSQLiteEventRepository_FindDuplicateEvents_InvalidKey(t *testing.T) {
+	store, err := infra.NewSQLiteEventRepository(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("NewSQLiteEventRepository failed: %v", err)
+	}
+	ctx := context.Background()
+	if err := store.Initialize(ctx); err != nil {
+		t.Fatalf("Initialize failed: %v", err)
+	}
+	defer store.Close()
+
+	if _, err := store.FindDuplicateEvents(ctx, nil); err == nil {
+		t.Error("Expected error for empty key")
+	}
+	if _, err := store.FindDuplicateEvents(ctx, []string{"colour"}); err == nil {
+		t.Error("Expected error for unknown key field")
+	}
+}
@@ -0,0 +1,175 @@
+package infra
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/kgatilin/darwinflow-pub/internal/domain"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// eventLabelsSchema creates the table of labels attached to events with 'dw logs annotate'
+const eventLabelsSchema = `
+	CREATE TABLE IF NOT EXISTS event_labels (
+		event_id TEXT NOT NULL,
+		label TEXT NOT NULL,
+		PRIMARY KEY (event_id, label)
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_event_labels_label ON event_labels(label);
+`
+
+// labelCondition returns a WHERE condition matching events tagged with any of labels
+func labelCondition(labels []string) (string, []interface{}) {
+	placeholders := make([]string, len(labels))
+	args := make([]interface{}, len(labels))
+	for i, label := range labels {
+		placeholders[i] = "?"
+		args[i] = strings.ToLower(strings.TrimSpace(label))
+	}
+	return fmt.Sprintf("id IN (SELECT event_id FROM event_labels WHERE label IN (%s))", strings.Join(placeholders, ",")), args
+}
+
+// AddEventLabels tags an event with labels; labels it already has are kept once.
+// Implements domain.EventLabeler.
+func (r *SQLiteEventRepository) AddEventLabels(ctx context.Context, eventID string, labels []string) error {
+	labels, err := domain.NormalizeEventLabels(labels)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := requireEvent(ctx, tx, eventID); err != nil {
+		return err
+	}
+	for _, label := range labels {
+		if _, err := tx.ExecContext(ctx, "INSERT OR IGNORE INTO event_labels (event_id, label) VALUES (?, ?)", eventID, label); err != nil {
+			return fmt.Errorf("failed to add label %s: %w", label, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// RemoveEventLabels removes labels from an event; labels it does not have are ignored.
+// Implements domain.EventLabeler.
+func (r *SQLiteEventRepository) RemoveEventLabels(ctx context.Context, eventID string, labels []string) error {
+	labels, err := domain.NormalizeEventLabels(labels)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := requireEvent(ctx, tx, eventID); err != nil {
+		return err
+	}
+	for _, label := range labels {
+		if _, err := tx.ExecContext(ctx, "DELETE FROM event_labels WHERE event_id = ? AND label = ?", eventID, label); err != nil {
+			return fmt.Errorf("failed to remove label %s: %w", label, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// requireEvent returns pluginsdk.ErrNotFound when no event has eventID
+func requireEvent(ctx context.Context, tx *sql.Tx, eventID string) error {
+	var exists int
+	err := tx.QueryRowContext(ctx, "SELECT 1 FROM events WHERE id = ?", eventID).Scan(&exists)
+	if err == sql.ErrNoRows {
+		return fmt.Errorf("%w: event %s", pluginsdk.ErrNotFound, eventID)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to look up event %s: %w", eventID, err)
+	}
+	return nil
+}
+
+// EventLabels returns the sorted labels of those eventIDs that have any.
+// Long ID lists are queried in batches to stay within SQLite's parameter limit.
+// Implements domain.EventLabeler.
+func (r *SQLiteEventRepository) EventLabels(ctx context.Context, eventIDs []string) (map[string][]string, error) {
+	labels := make(map[string][]string)
+	for _, batch := range idBatches(eventIDs) {
+		placeholders := make([]string, len(batch))
+		args := make([]interface{}, len(batch))
+		for i, id := range batch {
+			placeholders[i] = "?"
+			args[i] = id
+		}
+		if err := r.queryEventLabels(ctx, labels, fmt.Sprintf(
+			"SELECT event_id, label FROM event_labels WHERE event_id IN (%s) ORDER BY event_id, label",
+			strings.Join(placeholders, ",")), args...); err != nil {
+			return nil, err
+		}
+	}
+	return labels, nil
+}
+
+// queryEventLabels adds the (event_id, label) rows returned by query to labels
+func (r *SQLiteEventRepository) queryEventLabels(ctx context.Context, labels map[string][]string, query string, args ...interface{}) error {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to query event labels: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var eventID, label string
+		if err := rows.Scan(&eventID, &label); err != nil {
+			return fmt.Errorf("failed to scan event label: %w", err)
+		}
+		labels[eventID] = append(labels[eventID], label)
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error iterating rows: %w", err)
+	}
+	return nil
+}
+
+// CountEventLabels returns every label with the number of stored events it tags, by
+// label. Labels of deleted events are not counted.
+// Implements domain.EventLabeler.
+func (r *SQLiteEventRepository) CountEventLabels(ctx context.Context) ([]domain.EventLabelCount, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT l.label, COUNT(*)
+		FROM event_labels l
+		INNER JOIN events e ON e.id = l.event_id
+		GROUP BY l.label
+		ORDER BY l.label`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count event labels: %w", err)
+	}
+	defer rows.Close()
+
+	counts := []domain.EventLabelCount{}
+	for rows.Next() {
+		var count domain.EventLabelCount
+		if err := rows.Scan(&count.Label, &count.Count); err != nil {
+			return nil, fmt.Errorf("failed to scan label count: %w", err)
+		}
+		counts = append(counts, count)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+	return counts, nil
+}
@@ -0,0 +1,142 @@
+package infra_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kgatilin/darwinflow-pub/internal/domain"
+	"github.com/kgatilin/darwinflow-pub/internal/infra"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+func TestSQLiteEventRepository_EventLabels(t *testing.T) {
+	store, err := infra.NewSQLiteEventRepository(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("NewSQLiteEventRepository failed: %v", err)
+	}
+	ctx := context.Background()
+	if err := store.Initialize(ctx); err != nil {
+		t.Fatalf("Initialize failed: %v", err)
+	}
+	defer store.Close()
+
+	base := time.UnixMilli(1700000000000)
+	for i, id := range []string{"ev-1", "ev-2", "ev-3"} {
+		event := domain.NewEvent("tool.invoked", "session-1", map[string]string{"id": id}, "content "+id)
+		event.ID = id
+		event.Timestamp = base.Add(time.Duration(i) * time.Second)
+		if err := store.Save(ctx, event); err != nil {
+			t.Fatalf("Save(%s) failed: %v", id, err)
+		}
+	}
+
+	if err := store.AddEventLabels(ctx, "ev-1", []string{"Bug", " slow ", "bug"}); err != nil {
+		t.Fatalf("AddEventLabels failed: %v", err)
+	}
+	if err := store.AddEventLabels(ctx, "ev-2", []string{"slow"}); err != nil {
+		t.Fatalf("AddEventLabels failed: %v", err)
+	}
+
+	t.Run("label filter returns only matching events", func(t *testing.T) {
+		tests := []struct {
+			labels []string
+			want   []string
+		}{
+			{[]string{"bug"}, []string{"ev-1"}},
+			{[]string{"SLOW"}, []string{"ev-1", "ev-2"}},
+			{[]string{"bug", "slow"}, []string{"ev-1", "ev-2"}},
+			{[]string{"missing"}, nil},
+		}
+		for _, tt := range tests {
+			events, err := store.FindByQuery(ctx, pluginsdk.EventQuery{Labels: tt.labels, OrderByTime: true})
+			if err != nil {
+				t.Fatalf("FindByQuery(%v) failed: %v", tt.labels, err)
+			}
+			var got []string
+			for _, event := range events {
+				got = append(got, event.ID)
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("FindByQuery(Labels: %v) = %v, want %v", tt.labels, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("label filter combines with full-text search", func(t *testing.T) {
+		events, err := store.FindByQuery(ctx, pluginsdk.EventQuery{Labels: []string{"slow"}, SearchText: "ev"})
+		if err != nil {
+			t.Fatalf("FindByQuery failed: %v", err)
+		}
+		if len(events) != 2 {
+			t.Errorf("got %d events, want 2", len(events))
+		}
+	})
+
+	t.Run("labels are normalized and sorted per event", func(t *testing.T) {
+		labels, err := store.EventLabels(ctx, []string{"ev-1", "ev-2", "ev-3"})
+		if err != nil {
+			t.Fatalf("EventLabels failed: %v", err)
+		}
+		if got := labels["ev-1"]; len(got) != 2 || got[0] != "bug" || got[1] != "slow" {
+			t.Errorf("labels of ev-1 = %v, want [bug slow]", got)
+		}
+		if _, ok := labels["ev-3"]; ok {
+			t.Errorf("ev-3 has no labels, got %v", labels["ev-3"])
+		}
+	})
+
+	t.Run("long ID lists are looked up in batches", func(t *testing.T) {
+		ids := []string{"ev-1"}
+		for i := 0; i < 40000; i++ {
+			ids = append(ids, fmt.Sprintf("ev-missing-%d", i))
+		}
+		ids = append(ids, "ev-2")
+		labels, err := store.EventLabels(ctx, ids)
+		if err != nil {
+			t.Fatalf("EventLabels failed: %v", err)
+		}
+		if len(labels) != 2 || len(labels["ev-1"]) != 2 || len(labels["ev-2"]) != 1 {
+			t.Errorf("expected the labels of ev-1 and ev-2 across batches, got %v", labels)
+		}
+	})
+
+	t.Run("labels are counted by label", func(t *testing.T) {
+		counts, err := store.CountEventLabels(ctx)
+		if err != nil {
+			t.Fatalf("CountEventLabels failed: %v", err)
+		}
+		want := []domain.EventLabelCount{{Label: "bug", Count: 1}, {Label: "slow", Count: 2}}
+		if len(counts) != len(want) || counts[0] != want[0] || counts[1] != want[1] {
+			t.Errorf("CountEventLabels() = %v, want %v", counts, want)
+		}
+	})
+
+	t.Run("removed labels no longer match", func(t *testing.T) {
+		if err := store.RemoveEventLabels(ctx, "ev-1", []string{"SLOW", "unknown"}); err != nil {
+			t.Fatalf("RemoveEventLabels failed: %v", err)
+		}
+		events, err := store.FindByQuery(ctx, pluginsdk.EventQuery{Labels: []string{"slow"}})
+		if err != nil {
+			t.Fatalf("FindByQuery failed: %v", err)
+		}
+		if len(events) != 1 || events[0].ID != "ev-2" {
+			t.Errorf("expected only ev-2 to be labeled slow, got %d events", len(events))
+		}
+	})
+
+	t.Run("unknown events and invalid labels are rejected", func(t *testing.T) {
+		if err := store.AddEventLabels(ctx, "ev-missing", []string{"bug"}); !errors.Is(err, pluginsdk.ErrNotFound) {
+			t.Errorf("AddEventLabels(ev-missing) error = %v, want ErrNotFound", err)
+		}
+		if err := store.RemoveEventLabels(ctx, "ev-missing", []string{"bug"}); !errors.Is(err, pluginsdk.ErrNotFound) {
+			t.Errorf("RemoveEventLabels(ev-missing) error = %v, want ErrNotFound", err)
+		}
+		if err := store.AddEventLabels(ctx, "ev-1", []string{" "}); !errors.Is(err, pluginsdk.ErrInvalidArgument) {
+			t.Errorf("AddEventLabels(blank) error = %v, want ErrInvalidArgument", err)
+		}
+	})
+}
@@ -0,0 +1,199 @@
+package infra
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DefaultIndexedPayloadKeys lists the payload keys indexed by default.
+// Events emitted through the plugin context wrap the plugin payload under "data",
+// so a key is looked up at the top level first and then inside "data".
+//
+// To index another key, add it here (or call SetIndexedPayloadKeys) and run
+// "dw refresh --reindex" so existing events are indexed too.
+var DefaultIndexedPayloadKeys = []string{"tool", "tool_name"}
+
+// payloadIndexSchema creates the side table that maps payload key/value pairs to events
+const payloadIndexSchema = `
+	CREATE TABLE IF NOT EXISTS event_payload_index (
+		event_id TEXT NOT NULL,
+		key TEXT NOT NULL,
+		value TEXT NOT NULL,
+		PRIMARY KEY (event_id, key)
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_event_payload_index_key_value ON event_payload_index(key, value);
+
+	CREATE TRIGGER IF NOT EXISTS events_payload_index_delete AFTER DELETE ON events BEGIN
+		DELETE FROM event_payload_index WHERE event_id = old.id;
+	END;
+`
+
+// execer is satisfied by both *sql.DB and *sql.Tx
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+// SetIndexedPayloadKeys replaces the set of payload keys maintained in the side index.
+// Only events saved afterwards are affected; call ReindexPayloads to rebuild the index
+// for existing events.
+func (r *SQLiteEventRepository) SetIndexedPayloadKeys(keys []string) {
+	r.indexedKeys = append([]string(nil), keys...)
+}
+
+// IndexedPayloadKeys returns the payload keys maintained in the side index
+func (r *SQLiteEventRepository) IndexedPayloadKeys() []string {
+	return append([]string(nil), r.indexedKeys...)
+}
+
+// isIndexedPayloadKey reports whether key is part of the indexed set
+func (r *SQLiteEventRepository) isIndexedPayloadKey(key string) bool {
+	for _, k := range r.indexedKeys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+// indexPayload writes side index rows for the indexed keys found in payloadJSON
+func (r *SQLiteEventRepository) indexPayload(ctx context.Context, db execer, eventID string, payloadJSON []byte) error {
+	for key, value := range extractPayloadValues(payloadJSON, r.indexedKeys) {
+		if _, err := db.ExecContext(ctx,
+			"INSERT OR REPLACE INTO event_payload_index (event_id, key, value) VALUES (?, ?, ?)",
+			eventID, key, value,
+		); err != nil {
+			return fmt.Errorf("failed to index payload key %q: %w", key, err)
+		}
+	}
+	return nil
+}
+
+// ReindexPayloads rebuilds the payload side index from all stored events.
+// Implements domain.PayloadIndexer.
+func (r *SQLiteEventRepository) ReindexPayloads(ctx context.Context) (int, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM event_payload_index"); err != nil {
+		return 0, fmt.Errorf("failed to clear payload index: %w", err)
+	}
+
+	rows, err := tx.QueryContext(ctx, "SELECT id, payload FROM events")
+	if err != nil {
+		return 0, fmt.Errorf("failed to query events: %w", err)
+	}
+
+	type storedPayload struct {
+		id      string
+		payload string
+	}
+	var payloads []storedPayload
+	for rows.Next() {
+		var p storedPayload
+		if err := rows.Scan(&p.id, &p.payload); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("failed to scan event: %w", err)
+		}
+		payloads = append(payloads, p)
+	}
+	if err := rows.Err(); err != nil {
+		rows.Close()
+		return 0, fmt.Errorf("error iterating events: %w", err)
+	}
+	rows.Close()
+
+	for _, p := range payloads {
+		if err := r.indexPayload(ctx, tx, p.id, []byte(p.payload)); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit payload index: %w", err)
+	}
+
+	return len(payloads), nil
+}
+
+// payloadFilterConditions builds WHERE conditions for query.PayloadFilters.
+// Indexed keys are resolved through the side index; other keys use json_extract.
+func (r *SQLiteEventRepository) payloadFilterConditions(filters map[string]string) ([]string, []interface{}) {
+	// Sort keys so generated SQL is deterministic
+	keys := make([]string, 0, len(filters))
+	for key := range filters {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var conditions []string
+	var args []interface{}
+	for _, key := range keys {
+		value := filters[key]
+		if r.isIndexedPayloadKey(key) {
+			conditions = append(conditions, "id IN (SELECT event_id FROM event_payload_index WHERE key = ? AND value = ?)")
+			args = append(args, key, value)
+			continue
+		}
+		path := "$." + jsonPathKey(key)
+		conditions = append(conditions, "CAST(COALESCE(json_extract(payload, ?), json_extract(payload, ?)) AS TEXT) = ?")
+		args = append(args, path, "$.data."+jsonPathKey(key), value)
+	}
+	return conditions, args
+}
+
+// jsonPathKey quotes a key for use as a JSON path member
+func jsonPathKey(key string) string {
+	return `"` + strings.ReplaceAll(key, `"`, `\"`) + `"`
+}
+
+// extractPayloadValues returns the scalar values of keys found in payloadJSON.
+// Each key is looked up at the top level first and then inside a "data" object.
+func extractPayloadValues(payloadJSON []byte, keys []string) map[string]string {
+	values := make(map[string]string)
+	if len(keys) == 0 {
+		return values
+	}
+
+	var payload map[string]interface{}
+	if err := json.Unmarshal(payloadJSON, &payload); err != nil {
+		return values
+	}
+	data, _ := payload["data"].(map[string]interface{})
+
+	for _, key := range keys {
+		raw, ok := payload[key]
+		if !ok && data != nil {
+			raw, ok = data[key]
+		}
+		if !ok {
+			continue
+		}
+		if value, ok := payloadScalarString(raw); ok {
+			values[key] = value
+		}
+	}
+	return values
+}
+
+// payloadScalarString converts a decoded JSON scalar to its string form
+func payloadScalarString(v interface{}) (string, bool) {
+	switch val := v.(type) {
+	case string:
+		return val, true
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64), true
+	case bool:
+		return strconv.FormatBool(val), true
+	default:
+		return "", false
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
@@ -16,8 +18,9 @@ import (
 
 // SQLiteEventRepository implements domain.EventRepository using SQLite
 type SQLiteEventRepository struct {
-	db   *sql.DB
-	path string
+	db          *sql.DB
+	path        string
+	indexedKeys []string // payload keys maintained in event_payload_index
 }
 
 // NewSQLiteEventRepository creates a new SQLite-backed event repository
@@ -34,11 +37,18 @@ func NewSQLiteEventRepository(dbPath string) (*SQLiteEventRepository, error) {
 	}
 
 	return &SQLiteEventRepository{
-		db:   db,
-		path: dbPath,
+		db:          db,
+		path:        dbPath,
+		indexedKeys: append([]string(nil), DefaultIndexedPayloadKeys...),
 	}, nil
 }
 
+// DB returns the underlying database connection, for plugins that keep their
+// tables in the framework database (see pluginsdk.ISchemaMigrator)
+func (r *SQLiteEventRepository) DB() *sql.DB {
+	return r.db
+}
+
 // Initialize initializes the database schema
 func (r *SQLiteEventRepository) Initialize(ctx context.Context) error {
 	// Step 1: Create base tables (minimal schema for old versions)
@@ -80,14 +90,22 @@ func (r *SQLiteEventRepository) Initialize(ctx context.Context) error {
 	migrationSQL3 := `ALTER TABLE events ADD COLUMN version TEXT DEFAULT '1.0';`
 	_, _ = r.db.ExecContext(ctx, migrationSQL3)
 
-	// Step 3: Clean up duplicate analyses (keep only the most recent one per session_id/analysis_type)
-	// This handles the case where old databases have multiple analyses with the same analysis_type
+	// Add capture context columns (working directory and git state at save time)
+	for _, column := range []string{"working_dir", "git_branch", "git_commit"} {
+		_, _ = r.db.ExecContext(ctx, fmt.Sprintf("ALTER TABLE events ADD COLUMN %s TEXT;", column))
+	}
+
+	// Add sampled flag (set when the event's type is stored at a sampling rate below 1)
+	_, _ = r.db.ExecContext(ctx, `ALTER TABLE events ADD COLUMN sampled INTEGER NOT NULL DEFAULT 0;`)
+
+	// Step 3: Clean up duplicate analyses (keep only the most recent one per session_id/analysis_type/model_used)
+	// This handles the case where old databases have multiple analyses with the same analysis_type and model
 	cleanupSQL := `
 		DELETE FROM session_analyses
 		WHERE id NOT IN (
 			SELECT id FROM (
 				SELECT id, session_id, analysis_type,
-				       ROW_NUMBER() OVER (PARTITION BY session_id, analysis_type ORDER BY analyzed_at DESC) as rn
+				       ROW_NUMBER() OVER (PARTITION BY session_id, analysis_type, COALESCE(model_used, '') ORDER BY analyzed_at DESC) as rn
 				FROM session_analyses
 			)
 			WHERE rn = 1
@@ -105,11 +123,17 @@ func (r *SQLiteEventRepository) Initialize(ctx context.Context) error {
 			INNER JOIN session_analyses a2
 			ON a1.session_id = a2.session_id
 			   AND a1.analysis_type = a2.analysis_type
+			   AND COALESCE(a1.model_used, '') = COALESCE(a2.model_used, '')
 			   AND a1.analyzed_at < a2.analyzed_at
 		);
 	`
 	_, _ = r.db.ExecContext(ctx, fallbackCleanup)
 
+	// Analyses without a model are stored with an empty model_used (the cleanup above
+	// treats both alike): NULLs never conflict in the unique index, so they would
+	// escape the upsert in SaveAnalysis
+	_, _ = r.db.ExecContext(ctx, `UPDATE session_analyses SET model_used = '' WHERE model_used IS NULL;`)
+
 	// Step 4: Create indexes (including those on new columns)
 	indexSchema := `
 		CREATE INDEX IF NOT EXISTS idx_events_timestamp ON events(timestamp);
@@ -117,6 +141,7 @@ func (r *SQLiteEventRepository) Initialize(ctx context.Context) error {
 		CREATE INDEX IF NOT EXISTS idx_events_timestamp_type ON events(timestamp, event_type);
 		CREATE INDEX IF NOT EXISTS idx_events_session_id ON events(session_id);
 		CREATE INDEX IF NOT EXISTS idx_events_timestamp_session ON events(timestamp, session_id);
+		CREATE INDEX IF NOT EXISTS idx_events_working_dir ON events(working_dir);
 
 		CREATE INDEX IF NOT EXISTS idx_analyses_session_id ON session_analyses(session_id);
 		CREATE INDEX IF NOT EXISTS idx_analyses_analyzed_at ON session_analyses(analyzed_at);
@@ -127,8 +152,12 @@ func (r *SQLiteEventRepository) Initialize(ctx context.Context) error {
 		return fmt.Errorf("failed to create indexes: %w", err)
 	}
 
-	// Step 5: Create unique index (after cleanup)
-	uniqueIndexSQL := `CREATE UNIQUE INDEX IF NOT EXISTS idx_analyses_session_type ON session_analyses(session_id, analysis_type);`
+	// Step 5: Create unique index (after cleanup). Analyses are unique per model, so a
+	// session can keep analyses of the same prompt from different models side by side.
+	uniqueIndexSQL := `
+		DROP INDEX IF EXISTS idx_analyses_session_type;
+		CREATE UNIQUE INDEX IF NOT EXISTS idx_analyses_session_type_model ON session_analyses(session_id, analysis_type, model_used);
+	`
 	_, err = r.db.ExecContext(ctx, uniqueIndexSQL)
 	if err != nil {
 		return fmt.Errorf("failed to create unique index: %w", err)
@@ -181,14 +210,103 @@ func (r *SQLiteEventRepository) Initialize(ctx context.Context) error {
 		return fmt.Errorf("failed to create bus_events table: %w", err)
 	}
 
-	// Step 7: Create analyses table (generic) and migrate from session_analyses if needed
+	// Step 6b: Create counters for events discarded by sampling
+	sampleDropsSchema := `
+		CREATE TABLE IF NOT EXISTS event_sample_drops (
+			session_id TEXT NOT NULL,
+			event_type TEXT NOT NULL,
+			dropped INTEGER NOT NULL DEFAULT 0,
+			PRIMARY KEY (session_id, event_type)
+		);
+	`
+
+	if _, err := r.db.ExecContext(ctx, sampleDropsSchema); err != nil {
+		return fmt.Errorf("failed to create event_sample_drops table: %w", err)
+	}
+
+	// Step 7: Create payload side index for filtering by payload keys
+	if _, err := r.db.ExecContext(ctx, payloadIndexSchema); err != nil {
+		return fmt.Errorf("failed to create payload index: %w", err)
+	}
+
+	// Step 8: Create analyses table (generic) and migrate from session_analyses if needed
 	if err := r.createAnalysesTableAndMigrate(ctx); err != nil {
 		return fmt.Errorf("failed to create analyses table: %w", err)
 	}
 
+	// Step 9: Create links from analyses to the events they were computed from
+	if _, err := r.db.ExecContext(ctx, analysisEventsSchema); err != nil {
+		return fmt.Errorf("failed to create analysis_events table: %w", err)
+	}
+
+	// Step 10: Create labels attached to events
+	if _, err := r.db.ExecContext(ctx, eventLabelsSchema); err != nil {
+		return fmt.Errorf("failed to create event_labels table: %w", err)
+	}
+
 	return nil
 }
 
+// requiredSchemaColumns lists the tables and columns created by Initialize that the
+// current code depends on. Used by CheckSchema to detect databases that need a refresh.
+var requiredSchemaColumns = map[string][]string{
+	"events":              {"id", "timestamp", "event_type", "session_id", "payload", "content", "version", "working_dir", "git_branch", "git_commit", "sampled"},
+	"session_analyses":    {"id", "session_id", "analyzed_at", "analysis_result", "analysis_type", "prompt_name"},
+	"analyses":            {"id", "view_id", "view_type", "timestamp", "result", "metadata"},
+	"analysis_events":     {"analysis_id", "event_id"},
+	"bus_events":          {"id", "type", "source", "timestamp"},
+	"event_payload_index": {"event_id", "key", "value"},
+	"event_sample_drops":  {"session_id", "event_type", "dropped"},
+	"event_labels":        {"event_id", "label"},
+}
+
+// CheckSchema reports tables and columns required by the current schema that are
+// missing from the database, as "table" or "table.column". It never modifies the schema.
+func (r *SQLiteEventRepository) CheckSchema(ctx context.Context) ([]string, error) {
+	tables := make([]string, 0, len(requiredSchemaColumns))
+	for table := range requiredSchemaColumns {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+
+	missing := []string{}
+	for _, table := range tables {
+		rows, err := r.db.QueryContext(ctx, fmt.Sprintf("PRAGMA table_info(%s)", table))
+		if err != nil {
+			return nil, fmt.Errorf("failed to inspect table %s: %w", table, err)
+		}
+
+		columns := make(map[string]bool)
+		for rows.Next() {
+			var cid, notNull, pk int
+			var name, colType string
+			var defaultValue sql.NullString
+			if err := rows.Scan(&cid, &name, &colType, &notNull, &defaultValue, &pk); err != nil {
+				rows.Close()
+				return nil, fmt.Errorf("failed to scan column info: %w", err)
+			}
+			columns[name] = true
+		}
+		err = rows.Err()
+		rows.Close()
+		if err != nil {
+			return nil, fmt.Errorf("error iterating rows: %w", err)
+		}
+
+		if len(columns) == 0 {
+			missing = append(missing, table)
+			continue
+		}
+		for _, column := range requiredSchemaColumns[table] {
+			if !columns[column] {
+				missing = append(missing, table+"."+column)
+			}
+		}
+	}
+
+	return missing, nil
+}
+
 // createAnalysesTableAndMigrate creates the generic analyses table and migrates data from session_analyses
 func (r *SQLiteEventRepository) createAnalysesTableAndMigrate(ctx context.Context) error {
 	// Check if analyses table already exists BEFORE creating it
@@ -390,17 +508,44 @@ func (r *SQLiteEventRepository) migrateSessionAnalysesToAnalyses(ctx context.Con
 
 // Save persists an event
 func (r *SQLiteEventRepository) Save(ctx context.Context, event *domain.Event) error {
+	return r.SaveBatch(ctx, []*domain.Event{event})
+}
+
+// SaveBatch persists events in a single transaction: either all of them are stored
+// or none is. Implements domain.EventBatchSaver.
+func (r *SQLiteEventRepository) SaveBatch(ctx context.Context, events []*domain.Event) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, event := range events {
+		if err := r.insertEvent(ctx, tx, event); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit event: %w", err)
+	}
+
+	return nil
+}
+
+// insertEvent stores an event and indexes its payload within tx
+func (r *SQLiteEventRepository) insertEvent(ctx context.Context, tx *sql.Tx, event *domain.Event) error {
 	payloadJSON, err := event.MarshalPayload()
 	if err != nil {
 		return fmt.Errorf("failed to marshal payload: %w", err)
 	}
 
 	query := `
-		INSERT INTO events (id, timestamp, event_type, session_id, payload, content, version)
-		VALUES (?, ?, ?, ?, ?, ?, ?)
+		INSERT INTO events (id, timestamp, event_type, session_id, payload, content, version, working_dir, git_branch, git_commit, sampled)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 	`
 
-	_, err = r.db.ExecContext(ctx, query,
+	_, err = tx.ExecContext(ctx, query,
 		event.ID,
 		event.Timestamp.UnixMilli(),
 		string(event.Type),
@@ -408,13 +553,17 @@ func (r *SQLiteEventRepository) Save(ctx context.Context, event *domain.Event) e
 		string(payloadJSON),
 		event.Content,
 		event.Version,
+		nullIfEmpty(event.WorkingDir),
+		nullIfEmpty(event.GitBranch),
+		nullIfEmpty(event.GitCommit),
+		event.Sampled,
 	)
 
 	if err != nil {
 		return fmt.Errorf("failed to store event: %w", err)
 	}
 
-	return nil
+	return r.indexPayload(ctx, tx, event.ID, payloadJSON)
 }
 
 // FindByQuery retrieves events based on query criteria
@@ -424,6 +573,15 @@ func (r *SQLiteEventRepository) FindByQuery(ctx context.Context, query pluginsdk
 	var args []interface{}
 
 	// Build WHERE clause
+	if len(query.EventIDs) > 0 {
+		placeholders := make([]string, len(query.EventIDs))
+		for i, id := range query.EventIDs {
+			placeholders[i] = "?"
+			args = append(args, id)
+		}
+		conditions = append(conditions, fmt.Sprintf("id IN (%s)", strings.Join(placeholders, ",")))
+	}
+
 	if query.StartTime != nil {
 		conditions = append(conditions, "timestamp >= ?")
 		args = append(args, query.StartTime.UnixMilli())
@@ -443,19 +601,53 @@ func (r *SQLiteEventRepository) FindByQuery(ctx context.Context, query pluginsdk
 		conditions = append(conditions, fmt.Sprintf("event_type IN (%s)", strings.Join(placeholders, ",")))
 	}
 
+	if query.EventSource != "" || query.EventCategory != "" {
+		if err := domain.ValidateEventKindFilter(query.EventSource, query.EventCategory); err != nil {
+			return nil, err
+		}
+		condition, kindArgs := eventKindCondition(query.EventSource, query.EventCategory)
+		conditions = append(conditions, condition)
+		args = append(args, kindArgs...)
+	}
+
 	// Map Metadata["session_id"] to session_id column
 	if sessionID, ok := query.Metadata["session_id"]; ok && sessionID != "" {
 		conditions = append(conditions, "session_id = ?")
 		args = append(args, sessionID)
 	}
 
+	// Match the directory itself or anything beneath it
+	if query.WorkingDir != "" {
+		dir := filepath.Clean(query.WorkingDir)
+		prefix := dir
+		if !strings.HasSuffix(prefix, string(filepath.Separator)) {
+			prefix += string(filepath.Separator)
+		}
+		// substr and length count characters, so the prefix length is taken in SQL
+		conditions = append(conditions, "(working_dir = ? OR substr(working_dir, 1, length(?)) = ?)")
+		args = append(args, dir, prefix, prefix)
+	}
+
+	if len(query.PayloadFilters) > 0 {
+		payloadConditions, payloadArgs := r.payloadFilterConditions(query.PayloadFilters)
+		conditions = append(conditions, payloadConditions...)
+		args = append(args, payloadArgs...)
+	}
+
+	if len(query.Labels) > 0 {
+		condition, labelArgs := labelCondition(query.Labels)
+		conditions = append(conditions, condition)
+		args = append(args, labelArgs...)
+	}
+
 	// Build SQL query
-	sqlQuery := "SELECT id, timestamp, event_type, session_id, payload, content, COALESCE(version, '1.0') as version FROM events"
+	sqlQuery := "SELECT id, timestamp, event_type, session_id, payload, content, COALESCE(version, '1.0') as version, working_dir, git_branch, git_commit, sampled FROM events"
 
 	if query.SearchText != "" {
 		// Try FTS search first, fall back to LIKE if FTS not available
 		ftsQuery := `
-			SELECT e.id, e.timestamp, e.event_type, e.session_id, e.payload, e.content, COALESCE(e.version, '1.0') as version
+			SELECT e.id, e.timestamp, e.event_type, e.session_id, e.payload, e.content, COALESCE(e.version, '1.0') as version,
+			       e.working_dir, e.git_branch, e.git_commit, e.sampled
 			FROM events e
 			JOIN events_fts fts ON fts.rowid = e.rowid
 			WHERE fts.content MATCH ?
@@ -509,31 +701,10 @@ func (r *SQLiteEventRepository) FindByQuery(ctx context.Context, query pluginsdk
 
 	var events []*domain.Event
 	for rows.Next() {
-		var id, eventType, payloadStr, content, version string
-		var sessionID sql.NullString
-		var timestampMs int64
-
-		if err := rows.Scan(&id, &timestampMs, &eventType, &sessionID, &payloadStr, &content, &version); err != nil {
-			return nil, fmt.Errorf("failed to scan row: %w", err)
-		}
-
-		// Reconstruct domain event
-		// Note: We unmarshal into json.RawMessage to preserve the original payload structure
-		var payload json.RawMessage
-		if err := json.Unmarshal([]byte(payloadStr), &payload); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal payload: %w", err)
-		}
-
-		event := &domain.Event{
-			ID:        id,
-			Timestamp: millisecondsToTime(timestampMs),
-			Type:      eventType,
-			SessionID: sessionID.String,
-			Payload:   payload,
-			Content:   content,
-			Version:   version,
+		event, err := scanEvent(rows)
+		if err != nil {
+			return nil, err
 		}
-
 		events = append(events, event)
 	}
 
@@ -544,6 +715,82 @@ func (r *SQLiteEventRepository) FindByQuery(ctx context.Context, query pluginsdk
 	return events, nil
 }
 
+// scanEvent reads an event from the current row, whose columns are id, timestamp,
+// event_type, session_id, payload, content, version, working_dir, git_branch,
+// git_commit and sampled, in this order
+func scanEvent(rows *sql.Rows) (*domain.Event, error) {
+	var id, eventType, payloadStr, content, version string
+	var sessionID, workingDir, gitBranch, gitCommit sql.NullString
+	var timestampMs int64
+	var sampled bool
+
+	if err := rows.Scan(&id, &timestampMs, &eventType, &sessionID, &payloadStr, &content, &version, &workingDir, &gitBranch, &gitCommit, &sampled); err != nil {
+		return nil, fmt.Errorf("failed to scan row: %w", err)
+	}
+
+	// Reconstruct domain event
+	// Note: We unmarshal into json.RawMessage to preserve the original payload structure
+	var payload json.RawMessage
+	if err := json.Unmarshal([]byte(payloadStr), &payload); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal payload of event %s: %w", id, err)
+	}
+
+	return &domain.Event{
+		ID:        id,
+		Timestamp: millisecondsToTime(timestampMs),
+		Type:      eventType,
+		SessionID: sessionID.String,
+		Payload:   payload,
+		Content:   content,
+		Version:   version,
+
+		WorkingDir: workingDir.String,
+		GitBranch:  gitBranch.String,
+		GitCommit:  gitCommit.String,
+		Sampled:    sampled,
+	}, nil
+}
+
+// eventKindCondition returns a WHERE condition matching event types by source and/or
+// category, following domain.ParseEventKind: the source is the first segment of a
+// type, and the category its second, unless the first segment is a core category.
+func eventKindCondition(source, category string) (string, []interface{}) {
+	if source != "" && category == "" {
+		return `event_type LIKE ? ESCAPE '\'`, []interface{}{escapeLikePattern(source) + ".%"}
+	}
+	if source != "" {
+		prefix := source + "." + category
+		return `(event_type = ? OR event_type LIKE ? ESCAPE '\')`, []interface{}{prefix, escapeLikePattern(prefix) + ".%"}
+	}
+
+	// A bare category, or a core category followed by the action
+	conditions := []string{"event_type = ?"}
+	args := []interface{}{category}
+	if domain.IsCoreEventCategory(category) {
+		conditions = append(conditions, `event_type LIKE ? ESCAPE '\'`)
+		args = append(args, escapeLikePattern(category)+".%")
+	}
+
+	// The second segment of a type with a source
+	placeholders := make([]string, len(domain.CoreEventCategories))
+	for i, core := range domain.CoreEventCategories {
+		placeholders[i] = "?"
+		args = append(args, core)
+	}
+	conditions = append(conditions, fmt.Sprintf(`(instr(event_type, '.') > 0
+		AND substr(event_type, 1, instr(event_type, '.') - 1) NOT IN (%s)
+		AND (substr(event_type, instr(event_type, '.') + 1) = ? OR substr(event_type, instr(event_type, '.') + 1) LIKE ? ESCAPE '\'))`,
+		strings.Join(placeholders, ",")))
+	args = append(args, category, escapeLikePattern(category)+".%")
+
+	return "(" + strings.Join(conditions, " OR ") + ")", args
+}
+
+// escapeLikePattern escapes the LIKE wildcards in s, using '\' as the escape character
+func escapeLikePattern(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
 // Close closes the database connection
 func (r *SQLiteEventRepository) Close() error {
 	return r.db.Close()
@@ -594,21 +841,25 @@ func (r *SQLiteEventRepository) ExecuteRawQuery(ctx context.Context, query strin
 	}, nil
 }
 
-// SaveAnalysis persists a session analysis
+// SaveAnalysis persists a session analysis. Re-analyzing a session with the same prompt
+// and model replaces the stored row, including its ID, so the ID keeps matching the
+// latest generic analysis. analysis.ID is set to the ID of the stored row.
 func (r *SQLiteEventRepository) SaveAnalysis(ctx context.Context, analysis *domain.SessionAnalysis) error {
 	query := `
 		INSERT INTO session_analyses (id, session_id, analyzed_at, analysis_result, model_used, prompt_used, patterns_summary, analysis_type, prompt_name)
 		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
-		ON CONFLICT(session_id, analysis_type) DO UPDATE SET
+		ON CONFLICT(session_id, analysis_type, model_used) DO UPDATE SET
+			id = excluded.id,
 			analyzed_at = excluded.analyzed_at,
 			analysis_result = excluded.analysis_result,
-			model_used = excluded.model_used,
 			prompt_used = excluded.prompt_used,
 			patterns_summary = excluded.patterns_summary,
 			prompt_name = excluded.prompt_name
+		RETURNING id
 	`
 
-	_, err := r.db.ExecContext(ctx, query,
+	var storedID string
+	err := r.db.QueryRowContext(ctx, query,
 		analysis.ID,
 		analysis.SessionID,
 		analysis.AnalyzedAt.UnixMilli(),
@@ -618,11 +869,12 @@ func (r *SQLiteEventRepository) SaveAnalysis(ctx context.Context, analysis *doma
 		analysis.PatternsSummary,
 		analysis.AnalysisType,
 		analysis.PromptName,
-	)
+	).Scan(&storedID)
 
 	if err != nil {
 		return fmt.Errorf("failed to store analysis: %w", err)
 	}
+	analysis.ID = storedID
 
 	return nil
 }
@@ -848,7 +1100,109 @@ func (r *SQLiteEventRepository) GetAllSessionIDs(ctx context.Context, limit int)
 	return sessionIDs, nil
 }
 
-// SaveGenericAnalysis persists a generic analysis
+// GetSessionSummaries aggregates events per session, ordered by most recent activity first.
+// A nil sessionIDs slice summarizes all sessions; an empty one returns no sessions.
+// Long ID lists are queried in batches to stay within SQLite's parameter limit.
+func (r *SQLiteEventRepository) GetSessionSummaries(ctx context.Context, sessionIDs []string, limit int) ([]*domain.SessionSummary, error) {
+	if sessionIDs == nil {
+		return r.querySessionSummaries(ctx, nil, limit)
+	}
+
+	summaries := []*domain.SessionSummary{}
+	for _, batch := range idBatches(sessionIDs) {
+		batchSummaries, err := r.querySessionSummaries(ctx, batch, 0)
+		if err != nil {
+			return nil, err
+		}
+		summaries = append(summaries, batchSummaries...)
+	}
+
+	// Each session is in one batch only, so merging keeps the counts exact
+	sort.SliceStable(summaries, func(i, j int) bool {
+		return summaries[i].LastEvent.After(summaries[j].LastEvent)
+	})
+	if limit > 0 && len(summaries) > limit {
+		summaries = summaries[:limit]
+	}
+	return summaries, nil
+}
+
+// querySessionSummaries runs the summary aggregation, restricted to sessionIDs unless nil
+func (r *SQLiteEventRepository) querySessionSummaries(ctx context.Context, sessionIDs []string, limit int) ([]*domain.SessionSummary, error) {
+	query := `
+		SELECT e.session_id, MIN(e.timestamp), MAX(e.timestamp), COUNT(*),
+		       COALESCE(d.dropped, 0), COALESCE(a.analysis_count, 0)
+		FROM events e
+		LEFT JOIN (
+			SELECT session_id, COUNT(*) AS analysis_count
+			FROM session_analyses
+			GROUP BY session_id
+		) a ON a.session_id = e.session_id
+		LEFT JOIN (
+			SELECT session_id, SUM(dropped) AS dropped
+			FROM event_sample_drops
+			GROUP BY session_id
+		) d ON d.session_id = e.session_id
+		WHERE e.session_id IS NOT NULL AND e.session_id != ''
+	`
+
+	var args []interface{}
+	if sessionIDs != nil {
+		placeholders := make([]string, len(sessionIDs))
+		for i, id := range sessionIDs {
+			placeholders[i] = "?"
+			args = append(args, id)
+		}
+		query += fmt.Sprintf(" AND e.session_id IN (%s)", strings.Join(placeholders, ", "))
+	}
+
+	query += " GROUP BY e.session_id ORDER BY MAX(e.timestamp) DESC"
+
+	if limit > 0 {
+		query += fmt.Sprintf(" LIMIT %d", limit)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get session summaries: %w", err)
+	}
+	defer rows.Close()
+
+	summaries := []*domain.SessionSummary{}
+	for rows.Next() {
+		var summary domain.SessionSummary
+		var firstMs, lastMs int64
+		if err := rows.Scan(&summary.SessionID, &firstMs, &lastMs, &summary.EventCount, &summary.DroppedCount, &summary.AnalysisCount); err != nil {
+			return nil, fmt.Errorf("failed to scan session summary: %w", err)
+		}
+		summary.FirstEvent = millisecondsToTime(firstMs)
+		summary.LastEvent = millisecondsToTime(lastMs)
+		summaries = append(summaries, &summary)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return summaries, nil
+}
+
+// RecordSampleDrop counts one event of eventType in sessionID that was discarded by sampling.
+// Implements domain.SampleDropRecorder.
+func (r *SQLiteEventRepository) RecordSampleDrop(ctx context.Context, sessionID, eventType string) error {
+	query := `
+		INSERT INTO event_sample_drops (session_id, event_type, dropped)
+		VALUES (?, ?, 1)
+		ON CONFLICT(session_id, event_type) DO UPDATE SET dropped = dropped + 1
+	`
+	if _, err := r.db.ExecContext(ctx, query, sessionID, eventType); err != nil {
+		return fmt.Errorf("failed to record sample drop: %w", err)
+	}
+	return nil
+}
+
+// SaveGenericAnalysis persists a generic analysis together with the links to its
+// source events (analysis.EventIDs)
 func (r *SQLiteEventRepository) SaveGenericAnalysis(ctx context.Context, analysis *domain.Analysis) error {
 	metadataJSON, err := analysis.MarshalMetadata()
 	if err != nil {
@@ -860,7 +1214,13 @@ func (r *SQLiteEventRepository) SaveGenericAnalysis(ctx context.Context, analysi
 		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
 	`
 
-	_, err = r.db.ExecContext(ctx, query,
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, query,
 		analysis.ID,
 		analysis.ViewID,
 		analysis.ViewType,
@@ -875,6 +1235,14 @@ func (r *SQLiteEventRepository) SaveGenericAnalysis(ctx context.Context, analysi
 		return fmt.Errorf("failed to store analysis: %w", err)
 	}
 
+	if err := saveAnalysisEvents(ctx, tx, analysis.ID, analysis.EventIDs); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit analysis: %w", err)
+	}
+
 	return nil
 }
 
@@ -914,7 +1282,7 @@ func (r *SQLiteEventRepository) FindAnalysisByViewType(ctx context.Context, view
 	return r.scanAnalyses(rows)
 }
 
-// FindAnalysisById retrieves a specific analysis by ID
+// FindAnalysisById retrieves a specific analysis by ID, including its source event IDs
 func (r *SQLiteEventRepository) FindAnalysisById(ctx context.Context, id string) (*domain.Analysis, error) {
 	query := `
 		SELECT id, view_id, view_type, timestamp, result, model_used, prompt_used, metadata
@@ -954,6 +1322,11 @@ func (r *SQLiteEventRepository) FindAnalysisById(ctx context.Context, id string)
 		}
 	}
 
+	analysis.EventIDs, err = r.getAnalysisEventIDs(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
 	return &analysis, nil
 }
 
@@ -1022,6 +1395,31 @@ func (r *SQLiteEventRepository) scanAnalyses(rows *sql.Rows) ([]*domain.Analysis
 	return analyses, nil
 }
 
+// maxIDsPerQuery bounds the IDs bound into one "IN (?, ...)" list. SQLite builds before
+// 3.32 accept at most 999 parameters per statement.
+const maxIDsPerQuery = 500
+
+// idBatches splits ids into consecutive batches of at most maxIDsPerQuery
+func idBatches(ids []string) [][]string {
+	var batches [][]string
+	for len(ids) > maxIDsPerQuery {
+		batches = append(batches, ids[:maxIDsPerQuery])
+		ids = ids[maxIDsPerQuery:]
+	}
+	if len(ids) > 0 {
+		batches = append(batches, ids)
+	}
+	return batches
+}
+
+// nullIfEmpty stores empty optional columns as NULL
+func nullIfEmpty(value string) interface{} {
+	if value == "" {
+		return nil
+	}
+	return value
+}
+
 // Helper function to convert milliseconds to time.Time
 func millisecondsToTime(ms int64) time.Time {
 	return time.Unix(0, ms*int64(time.Millisecond))
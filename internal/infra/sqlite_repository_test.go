@@ -4,7 +4,11 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"path/filepath"
+	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -440,6 +444,38 @@ func TestSQLiteEventRepository_FindByQuery(t *testing.T) {
 	}
 }
 
+func TestSQLiteEventRepository_SaveBatch(t *testing.T) {
+	store, err := infra.NewSQLiteEventRepository(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("NewSQLiteEventRepository failed: %v", err)
+	}
+	ctx := context.Background()
+	if err := store.Initialize(ctx); err != nil {
+		t.Fatalf("Initialize failed: %v", err)
+	}
+	defer store.Close()
+
+	first := domain.NewEvent("tool.invoked", "session-1", map[string]string{"tool": "Read"}, "read")
+	second := domain.NewEvent("tool.result", "session-1", map[string]string{"result": "ok"}, "ok")
+	if err := store.SaveBatch(ctx, []*domain.Event{first, second}); err != nil {
+		t.Fatalf("SaveBatch failed: %v", err)
+	}
+
+	// A batch with a duplicate ID fails as a whole
+	third := domain.NewEvent("tool.invoked", "session-1", map[string]string{"tool": "Edit"}, "edit")
+	if err := store.SaveBatch(ctx, []*domain.Event{third, first}); err == nil {
+		t.Fatal("SaveBatch with a duplicate event should fail")
+	}
+
+	events, err := store.FindByQuery(ctx, pluginsdk.EventQuery{Metadata: map[string]string{"session_id": "session-1"}})
+	if err != nil {
+		t.Fatalf("FindByQuery failed: %v", err)
+	}
+	if len(events) != 2 {
+		t.Errorf("expected the 2 events of the first batch, got %d", len(events))
+	}
+}
+
 func TestSQLiteEventRepository_GetAllSessionIDs(t *testing.T) {
 	tmpDir := t.TempDir()
 	dbPath := filepath.Join(tmpDir, "test.db")
@@ -484,6 +520,115 @@ func TestSQLiteEventRepository_GetAllSessionIDs(t *testing.T) {
 	}
 }
 
+func TestSQLiteEventRepository_GetSessionSummaries(t *testing.T) {
+	tmpDir := t.TempDir()
+	dbPath := filepath.Join(tmpDir, "test.db")
+
+	repo, err := infra.NewSQLiteEventRepository(dbPath)
+	if err != nil {
+		t.Fatalf("NewSQLiteEventRepository failed: %v", err)
+	}
+	defer repo.Close()
+
+	ctx := context.Background()
+	if err := repo.Initialize(ctx); err != nil {
+		t.Fatalf("Initialize failed: %v", err)
+	}
+
+	base := time.Date(2025, 6, 10, 12, 0, 0, 0, time.UTC)
+	events := []struct {
+		sessionID string
+		offset    time.Duration
+	}{
+		{"session-old", 0},
+		{"session-old", time.Minute},
+		{"session-new", time.Hour},
+		{"session-new", 2 * time.Hour},
+		{"session-new", 3 * time.Hour},
+		{"", 4 * time.Hour}, // events without a session are ignored
+	}
+	for _, e := range events {
+		event := domain.NewEvent("test.event", e.sessionID, map[string]interface{}{}, "test")
+		event.Timestamp = base.Add(e.offset)
+		if err := repo.Save(ctx, event); err != nil {
+			t.Fatalf("Save failed: %v", err)
+		}
+	}
+
+	for _, analysisType := range []string{"tool_analysis", "session_summary"} {
+		analysis := domain.NewSessionAnalysisWithType("session-old", "result", "claude", "prompt", analysisType, analysisType)
+		if err := repo.SaveAnalysis(ctx, analysis); err != nil {
+			t.Fatalf("SaveAnalysis failed: %v", err)
+		}
+	}
+
+	summaries, err := repo.GetSessionSummaries(ctx, nil, 0)
+	if err != nil {
+		t.Fatalf("GetSessionSummaries failed: %v", err)
+	}
+	if len(summaries) != 2 {
+		t.Fatalf("Expected 2 sessions, got %d", len(summaries))
+	}
+
+	newest, oldest := summaries[0], summaries[1]
+	if newest.SessionID != "session-new" || oldest.SessionID != "session-old" {
+		t.Errorf("Expected most recent session first, got %s, %s", newest.SessionID, oldest.SessionID)
+	}
+	if newest.EventCount != 3 || oldest.EventCount != 2 {
+		t.Errorf("Expected event counts 3 and 2, got %d and %d", newest.EventCount, oldest.EventCount)
+	}
+	if !newest.FirstEvent.Equal(base.Add(time.Hour)) || !newest.LastEvent.Equal(base.Add(3*time.Hour)) {
+		t.Errorf("Unexpected time range for session-new: %v - %v", newest.FirstEvent, newest.LastEvent)
+	}
+	if newest.HasAnalysis() {
+		t.Error("Expected session-new to have no analysis")
+	}
+	if oldest.AnalysisCount != 2 {
+		t.Errorf("Expected 2 analyses for session-old, got %d", oldest.AnalysisCount)
+	}
+
+	// Limit
+	summaries, err = repo.GetSessionSummaries(ctx, nil, 1)
+	if err != nil {
+		t.Fatalf("GetSessionSummaries with limit failed: %v", err)
+	}
+	if len(summaries) != 1 || summaries[0].SessionID != "session-new" {
+		t.Errorf("Expected only session-new with limit 1, got %d sessions", len(summaries))
+	}
+
+	// Restricted to session IDs
+	summaries, err = repo.GetSessionSummaries(ctx, []string{"session-old"}, 0)
+	if err != nil {
+		t.Fatalf("GetSessionSummaries with IDs failed: %v", err)
+	}
+	if len(summaries) != 1 || summaries[0].SessionID != "session-old" {
+		t.Errorf("Expected only session-old, got %d sessions", len(summaries))
+	}
+
+	// An empty, non-nil ID list selects nothing
+	summaries, err = repo.GetSessionSummaries(ctx, []string{}, 0)
+	if err != nil {
+		t.Fatalf("GetSessionSummaries with empty IDs failed: %v", err)
+	}
+	if len(summaries) != 0 {
+		t.Errorf("Expected no sessions for empty ID list, got %d", len(summaries))
+	}
+
+	// More IDs than SQLite binds in one statement: batched, merged and limited across batches
+	ids := []string{"session-old"}
+	for i := 0; i < 40000; i++ {
+		ids = append(ids, fmt.Sprintf("session-missing-%d", i))
+	}
+	ids = append(ids, "session-new")
+	summaries, err = repo.GetSessionSummaries(ctx, ids, 1)
+	if err != nil {
+		t.Fatalf("GetSessionSummaries with many IDs failed: %v", err)
+	}
+	if len(summaries) != 1 || summaries[0].SessionID != "session-new" {
+		t.Errorf("Expected only session-new across batches, got %d sessions", len(summaries))
+	}
+}
+
 func TestSQLiteEventRepository_GetAnalysesBySessionID(t *testing.T) {
 	tmpDir := t.TempDir()
 	dbPath := filepath.Join(tmpDir, "test.db")
@@ -539,6 +684,115 @@ func TestSQLiteEventRepository_GetAnalysesBySessionID(t *testing.T) {
 	}
 }
 
+func TestSQLiteEventRepository_SaveAnalysis_KeepsAnalysesPerModel(t *testing.T) {
+	tmpDir := t.TempDir()
+	dbPath := filepath.Join(tmpDir, "test.db")
+
+	store, err := infra.NewSQLiteEventRepository(dbPath)
+	if err != nil {
+		t.Fatalf("NewSQLiteEventRepository failed: %v", err)
+	}
+
+	ctx := context.Background()
+	if err := store.Initialize(ctx); err != nil {
+		t.Fatalf("Initialize failed: %v", err)
+	}
+	defer store.Close()
+
+	sessionID := "test-session-models"
+	save := func(result, model string) string {
+		analysis := domain.NewSessionAnalysisWithType(sessionID, result, model, "prompt", "tool_analysis", "tool_analysis")
+		if err := store.SaveAnalysis(ctx, analysis); err != nil {
+			t.Fatalf("SaveAnalysis failed: %v", err)
+		}
+		return analysis.ID
+	}
+
+	save("sonnet result", "sonnet")
+	save("opus result", "opus")
+	// Same prompt and model again replaces that model's analysis
+	opusID := save("opus result 2", "opus")
+
+	// Re-running Initialize must not clean up the per-model analyses
+	if err := store.Initialize(ctx); err != nil {
+		t.Fatalf("second Initialize failed: %v", err)
+	}
+
+	analyses, err := store.GetAnalysesBySessionID(ctx, sessionID)
+	if err != nil {
+		t.Fatalf("GetAnalysesBySessionID failed: %v", err)
+	}
+	if len(analyses) != 2 {
+		t.Fatalf("Expected 2 analyses (one per model), got %d", len(analyses))
+	}
+
+	results := map[string]string{}
+	for _, analysis := range analyses {
+		results[analysis.ModelUsed] = analysis.AnalysisResult
+		if analysis.ModelUsed == "opus" && analysis.ID != opusID {
+			t.Errorf("SaveAnalysis reported ID %s, stored row has %s", opusID, analysis.ID)
+		}
+	}
+	if results["sonnet"] != "sonnet result" || results["opus"] != "opus result 2" {
+		t.Errorf("Unexpected analyses per model: %v", results)
+	}
+}
+
+func TestSQLiteEventRepository_SaveAnalysis_UpsertsAnalysesWithoutModel(t *testing.T) {
+	tmpDir := t.TempDir()
+	dbPath := filepath.Join(tmpDir, "test.db")
+
+	store, err := infra.NewSQLiteEventRepository(dbPath)
+	if err != nil {
+		t.Fatalf("NewSQLiteEventRepository failed: %v", err)
+	}
+
+	ctx := context.Background()
+	if err := store.Initialize(ctx); err != nil {
+		t.Fatalf("Initialize failed: %v", err)
+	}
+	defer store.Close()
+
+	// Legacy rows without a model are not caught by the unique index
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		t.Fatalf("sql.Open failed: %v", err)
+	}
+	defer db.Close()
+	for i, analyzedAt := range []int64{1000, 2000} {
+		_, err := db.Exec(`INSERT INTO session_analyses (id, session_id, analyzed_at, analysis_result, model_used, analysis_type, prompt_name)
+			VALUES (?, 'legacy-session', ?, ?, NULL, 'tool_analysis', 'tool_analysis')`,
+			fmt.Sprintf("legacy-%d", i), analyzedAt, fmt.Sprintf("legacy result %d", i))
+		if err != nil {
+			t.Fatalf("failed to insert legacy analysis: %v", err)
+		}
+	}
+
+	if err := store.Initialize(ctx); err != nil {
+		t.Fatalf("second Initialize failed: %v", err)
+	}
+	analyses, err := store.GetAnalysesBySessionID(ctx, "legacy-session")
+	if err != nil {
+		t.Fatalf("GetAnalysesBySessionID failed: %v", err)
+	}
+	if len(analyses) != 1 || analyses[0].AnalysisResult != "legacy result 1" {
+		t.Fatalf("Expected only the newest legacy analysis to be kept, got %+v", analyses)
+	}
+
+	// Saving without a model again replaces it instead of adding a row
+	analysis := domain.NewSessionAnalysisWithType("legacy-session", "new result", "", "prompt", "tool_analysis", "tool_analysis")
+	if err := store.SaveAnalysis(ctx, analysis); err != nil {
+		t.Fatalf("SaveAnalysis failed: %v", err)
+	}
+	analyses, err = store.GetAnalysesBySessionID(ctx, "legacy-session")
+	if err != nil {
+		t.Fatalf("GetAnalysesBySessionID failed: %v", err)
+	}
+	if len(analyses) != 1 || analyses[0].AnalysisResult != "new result" {
+		t.Errorf("Expected the analysis without a model to be replaced, got %+v", analyses)
+	}
+}
+
 func TestSQLiteEventRepository_ExecuteRawQuery(t *testing.T) {
 	tmpDir := t.TempDir()
 	dbPath := filepath.Join(tmpDir, "test.db")
@@ -655,6 +909,143 @@ func TestSQLiteEventRepository_Save(t *testing.T) {
 	}
 }
 
+func TestSQLiteEventRepository_Save_CaptureContext(t *testing.T) {
+	tmpDir := t.TempDir()
+	dbPath := filepath.Join(tmpDir, "test.db")
+
+	store, err := infra.NewSQLiteEventRepository(dbPath)
+	if err != nil {
+		t.Fatalf("NewSQLiteEventRepository failed: %v", err)
+	}
+
+	ctx := context.Background()
+	if err := store.Initialize(ctx); err != nil {
+		t.Fatalf("Initialize failed: %v", err)
+	}
+	defer store.Close()
+
+	dirs := []string{"/work/repo", "/work/repo/sub", "/work/repo-other", ""}
+	for i, dir := range dirs {
+		event := domain.NewEvent("test.event", "capture-session", map[string]string{}, "test")
+		event.Timestamp = event.Timestamp.Add(time.Duration(i) * time.Millisecond)
+		event.WorkingDir = dir
+		if dir != "" {
+			event.GitBranch = "main"
+			event.GitCommit = "abc123"
+		}
+		if err := store.Save(ctx, event); err != nil {
+			t.Fatalf("Save failed: %v", err)
+		}
+	}
+
+	events, err := store.FindByQuery(ctx, pluginsdk.EventQuery{WorkingDir: "/work/repo/", OrderByTime: true})
+	if err != nil {
+		t.Fatalf("FindByQuery failed: %v", err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("Expected 2 events under /work/repo, got %d", len(events))
+	}
+	if events[0].WorkingDir != "/work/repo" || events[1].WorkingDir != "/work/repo/sub" {
+		t.Errorf("Unexpected working dirs: %q, %q", events[0].WorkingDir, events[1].WorkingDir)
+	}
+	if events[0].GitBranch != "main" || events[0].GitCommit != "abc123" {
+		t.Errorf("git context = %q/%q, want main/abc123", events[0].GitBranch, events[0].GitCommit)
+	}
+
+	all, err := store.FindByQuery(ctx, pluginsdk.EventQuery{Metadata: map[string]string{"session_id": "capture-session"}})
+	if err != nil {
+		t.Fatalf("FindByQuery failed: %v", err)
+	}
+	if len(all) != len(dirs) {
+		t.Errorf("Expected %d events without a working dir filter, got %d", len(dirs), len(all))
+	}
+}
+
+func TestSQLiteEventRepository_FindByQuery_MultibyteWorkingDir(t *testing.T) {
+	store, err := infra.NewSQLiteEventRepository(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("NewSQLiteEventRepository failed: %v", err)
+	}
+
+	ctx := context.Background()
+	if err := store.Initialize(ctx); err != nil {
+		t.Fatalf("Initialize failed: %v", err)
+	}
+	defer store.Close()
+
+	for i, dir := range []string{"/work/prøjekt", "/work/prøjekt/sub", "/work/prøjekt-other"} {
+		event := domain.NewEvent("test.event", "multibyte-session", map[string]string{}, "test")
+		event.Timestamp = event.Timestamp.Add(time.Duration(i) * time.Millisecond)
+		event.WorkingDir = dir
+		if err := store.Save(ctx, event); err != nil {
+			t.Fatalf("Save failed: %v", err)
+		}
+	}
+
+	// The prefix is longer in bytes than in characters
+	events, err := store.FindByQuery(ctx, pluginsdk.EventQuery{WorkingDir: "/work/prøjekt", OrderByTime: true})
+	if err != nil {
+		t.Fatalf("FindByQuery failed: %v", err)
+	}
+	if len(events) != 2 || events[0].WorkingDir != "/work/prøjekt" || events[1].WorkingDir != "/work/prøjekt/sub" {
+		var dirs []string
+		for _, event := range events {
+			dirs = append(dirs, event.WorkingDir)
+		}
+		t.Errorf("working dirs = %q, want the directory and its subdirectory", dirs)
+	}
+}
+
+func TestSQLiteEventRepository_SampledEventsAndDrops(t *testing.T) {
+	tmpDir := t.TempDir()
+	dbPath := filepath.Join(tmpDir, "test.db")
+
+	store, err := infra.NewSQLiteEventRepository(dbPath)
+	if err != nil {
+		t.Fatalf("NewSQLiteEventRepository failed: %v", err)
+	}
+
+	ctx := context.Background()
+	if err := store.Initialize(ctx); err != nil {
+		t.Fatalf("Initialize failed: %v", err)
+	}
+	defer store.Close()
+
+	kept := domain.NewEvent("tool.result", "sampled-session", map[string]string{}, "test")
+	kept.Sampled = true
+	if err := store.Save(ctx, kept); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	if err := store.Save(ctx, domain.NewEvent("chat.started", "sampled-session", map[string]string{}, "test")); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	for i := 0; i < 3; i++ {
+		if err := store.RecordSampleDrop(ctx, "sampled-session", "tool.result"); err != nil {
+			t.Fatalf("RecordSampleDrop failed: %v", err)
+		}
+	}
+
+	events, err := store.FindByQuery(ctx, pluginsdk.EventQuery{EventTypes: []string{"tool.result"}})
+	if err != nil {
+		t.Fatalf("FindByQuery failed: %v", err)
+	}
+	if len(events) != 1 || !events[0].Sampled {
+		t.Fatalf("Expected the stored tool.result event to be flagged as sampled, got %+v", events)
+	}
+
+	summaries, err := store.GetSessionSummaries(ctx, nil, 0)
+	if err != nil {
+		t.Fatalf("GetSessionSummaries failed: %v", err)
+	}
+	if len(summaries) != 1 {
+		t.Fatalf("Expected 1 session, got %d", len(summaries))
+	}
+	if summaries[0].EventCount != 2 || summaries[0].DroppedCount != 3 || summaries[0].TotalEvents() != 5 {
+		t.Errorf("Expected 2 stored + 3 dropped = 5 events, got %d + %d = %d",
+			summaries[0].EventCount, summaries[0].DroppedCount, summaries[0].TotalEvents())
+	}
+}
+
 func TestSQLiteEventRepository_FindByQuery_WithLimit(t *testing.T) {
 	tmpDir := t.TempDir()
 	dbPath := filepath.Join(tmpDir, "test.db")
@@ -759,6 +1150,125 @@ func TestSQLiteEventRepository_FindByQuery_WithEventTypes(t *testing.T) {
 	}
 }
 
+func TestSQLiteEventRepository_FindByQuery_WithEventKind(t *testing.T) {
+	tmpDir := t.TempDir()
+	dbPath := filepath.Join(tmpDir, "test.db")
+
+	store, err := infra.NewSQLiteEventRepository(dbPath)
+	if err != nil {
+		t.Fatalf("NewSQLiteEventRepository failed: %v", err)
+	}
+
+	ctx := context.Background()
+	if err := store.Initialize(ctx); err != nil {
+		t.Fatalf("Initialize failed: %v", err)
+	}
+	defer store.Close()
+
+	// The prefixed and unprefixed types used across the test suite
+	eventTypes := []string{
+		"tool.invoked", "tool.result", "chat.message.user", "chat.started",
+		"claude.tool.invoked", "claude.tool.result", "claude.chat.message.user", "claude.error",
+		"gmail.email_received", "gmail.tool.used", "gmailxtool.used", "marker",
+	}
+	for i, eventType := range eventTypes {
+		event := domain.NewEvent(eventType, "kind-session", map[string]string{}, eventType)
+		event.ID = fmt.Sprintf("evt-%02d", i)
+		if err := store.Save(ctx, event); err != nil {
+			t.Fatalf("Save failed: %v", err)
+		}
+	}
+
+	tests := []struct {
+		source   string
+		category string
+	}{
+		{"claude", ""},
+		{"", "tool"},
+		{"", "chat"},
+		{"claude", "tool"},
+		{"claude", "error"},
+		{"gmail", "email_received"},
+		{"", "email_received"},
+		{"", "message"}, // An action segment, not a category
+		{"", "marker"},
+		{"gmail_", ""}, // LIKE wildcards are matched literally
+	}
+	for _, tt := range tests {
+		t.Run(tt.source+"/"+tt.category, func(t *testing.T) {
+			events, err := store.FindByQuery(ctx, pluginsdk.EventQuery{EventSource: tt.source, EventCategory: tt.category})
+			if err != nil {
+				t.Fatalf("FindByQuery failed: %v", err)
+			}
+			var got []string
+			for _, event := range events {
+				got = append(got, event.Type)
+			}
+			var want []string
+			for _, eventType := range eventTypes {
+				if domain.ParseEventKind(eventType).Matches(tt.source, tt.category) {
+					want = append(want, eventType)
+				}
+			}
+			sort.Strings(got)
+			sort.Strings(want)
+			if strings.Join(got, ",") != strings.Join(want, ",") {
+				t.Errorf("Expected %v, got %v", want, got)
+			}
+		})
+	}
+
+	if _, err := store.FindByQuery(ctx, pluginsdk.EventQuery{EventSource: "tool"}); !errors.Is(err, pluginsdk.ErrInvalidArgument) {
+		t.Errorf("Expected ErrInvalidArgument for a category used as source, got %v", err)
+	}
+}
+
+func TestSQLiteEventRepository_FindByQuery_WithEventIDs(t *testing.T) {
+	tmpDir := t.TempDir()
+	dbPath := filepath.Join(tmpDir, "test.db")
+
+	store, err := infra.NewSQLiteEventRepository(dbPath)
+	if err != nil {
+		t.Fatalf("NewSQLiteEventRepository failed: %v", err)
+	}
+
+	ctx := context.Background()
+	if err := store.Initialize(ctx); err != nil {
+		t.Fatalf("Initialize failed: %v", err)
+	}
+	defer store.Close()
+
+	var ids []string
+	for _, eventType := range []string{"test.one", "test.two", "test.three"} {
+		event := domain.NewEvent(eventType, "id-session", map[string]interface{}{"type": eventType}, eventType)
+		if err := store.Save(ctx, event); err != nil {
+			t.Fatalf("Save failed: %v", err)
+		}
+		ids = append(ids, event.ID)
+	}
+
+	events, err := store.FindByQuery(ctx, pluginsdk.EventQuery{EventIDs: []string{ids[0], ids[2]}, OrderByTime: true})
+	if err != nil {
+		t.Fatalf("FindByQuery failed: %v", err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("Expected 2 events, got %d", len(events))
+	}
+	for _, event := range events {
+		if event.ID != ids[0] && event.ID != ids[2] {
+			t.Errorf("Unexpected event %s (%s)", event.ID, event.Type)
+		}
+	}
+
+	events, err = store.FindByQuery(ctx, pluginsdk.EventQuery{EventIDs: []string{"missing"}})
+	if err != nil {
+		t.Fatalf("FindByQuery failed: %v", err)
+	}
+	if len(events) != 0 {
+		t.Errorf("Expected no events for unknown ID, got %d", len(events))
+	}
+}
+
 func TestSQLiteEventRepository_FindByQuery_WithTimeRange(t *testing.T) {
 	tmpDir := t.TempDir()
 	dbPath := filepath.Join(tmpDir, "test.db")
@@ -2156,3 +2666,213 @@ func TestOldDatabaseMigration(t *testing.T) {
 		t.Errorf("Expected 1 analysis after second initialize, got %d (possible duplicate migration)", count)
 	}
 }
+
+func newPayloadTestRepository(t testing.TB) *infra.SQLiteEventRepository {
+	t.Helper()
+	store, err := infra.NewSQLiteEventRepository(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("NewSQLiteEventRepository failed: %v", err)
+	}
+	if err := store.Initialize(context.Background()); err != nil {
+		t.Fatalf("Initialize failed: %v", err)
+	}
+	t.Cleanup(func() { store.Close() })
+	return store
+}
+
+func TestSQLiteEventRepository_FindByQuery_PayloadFilters(t *testing.T) {
+	ctx := context.Background()
+	store := newPayloadTestRepository(t)
+
+	events := []*domain.Event{
+		domain.NewEvent("tool.invoked", "s1", map[string]interface{}{"tool": "Read", "file": "a.go"}, "read"),
+		domain.NewEvent("tool.invoked", "s1", map[string]interface{}{"tool": "Write", "file": "a.go"}, "write"),
+		// Plugin-emitted events wrap the payload under "data"
+		domain.NewEvent("tool.invoked", "s2", map[string]interface{}{"source": "claude-code", "data": map[string]interface{}{"tool": "Read", "file": "b.go"}}, "read"),
+	}
+	for _, e := range events {
+		if err := store.Save(ctx, e); err != nil {
+			t.Fatalf("Save failed: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		filters map[string]string
+		want    int
+	}{
+		{"indexed key", map[string]string{"tool": "Read"}, 2},
+		{"indexed key no match", map[string]string{"tool": "Bash"}, 0},
+		{"non-indexed key", map[string]string{"file": "a.go"}, 2},
+		{"indexed and non-indexed keys", map[string]string{"tool": "Read", "file": "b.go"}, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := store.FindByQuery(ctx, pluginsdk.EventQuery{PayloadFilters: tt.filters})
+			if err != nil {
+				t.Fatalf("FindByQuery failed: %v", err)
+			}
+			if len(got) != tt.want {
+				t.Errorf("Expected %d events, got %d", tt.want, len(got))
+			}
+		})
+	}
+
+	// Payload filters combine with other criteria
+	got, err := store.FindByQuery(ctx, pluginsdk.EventQuery{
+		PayloadFilters: map[string]string{"tool": "Read"},
+		Metadata:       map[string]string{"session_id": "s2"},
+	})
+	if err != nil {
+		t.Fatalf("FindByQuery failed: %v", err)
+	}
+	if len(got) != 1 || got[0].SessionID != "s2" {
+		t.Errorf("Expected 1 event from session s2, got %d", len(got))
+	}
+}
+
+func TestSQLiteEventRepository_ReindexPayloads(t *testing.T) {
+	ctx := context.Background()
+	store := newPayloadTestRepository(t)
+
+	// Events saved before "file" is indexed only have "tool" in the side index
+	for _, file := range []string{"a.go", "b.go", "a.go"} {
+		e := domain.NewEvent("tool.invoked", "s1", map[string]interface{}{"tool": "Read", "file": file}, "read")
+		if err := store.Save(ctx, e); err != nil {
+			t.Fatalf("Save failed: %v", err)
+		}
+	}
+
+	store.SetIndexedPayloadKeys([]string{"tool", "file"})
+	if keys := store.IndexedPayloadKeys(); len(keys) != 2 {
+		t.Fatalf("Expected 2 indexed keys, got %v", keys)
+	}
+
+	// Indexed lookup misses the events until they are reindexed
+	got, err := store.FindByQuery(ctx, pluginsdk.EventQuery{PayloadFilters: map[string]string{"file": "a.go"}})
+	if err != nil {
+		t.Fatalf("FindByQuery failed: %v", err)
+	}
+	if len(got) != 0 {
+		t.Errorf("Expected 0 events before reindex, got %d", len(got))
+	}
+
+	count, err := store.ReindexPayloads(ctx)
+	if err != nil {
+		t.Fatalf("ReindexPayloads failed: %v", err)
+	}
+	if count != 3 {
+		t.Errorf("Expected 3 reindexed events, got %d", count)
+	}
+
+	got, err = store.FindByQuery(ctx, pluginsdk.EventQuery{PayloadFilters: map[string]string{"file": "a.go"}})
+	if err != nil {
+		t.Fatalf("FindByQuery failed: %v", err)
+	}
+	if len(got) != 2 {
+		t.Errorf("Expected 2 events after reindex, got %d", len(got))
+	}
+}
+
+// BenchmarkFindByQuery_PayloadFilter compares a payload-filtered query answered
+// by JSON extraction (unindexed) with the same query answered by the side index.
+func BenchmarkFindByQuery_PayloadFilter(b *testing.B) {
+	ctx := context.Background()
+	store := newPayloadTestRepository(b)
+
+	tools := []string{"Read", "Write", "Edit", "Bash", "Grep", "Glob", "WebFetch", "Task"}
+	const eventCount = 20000
+	for i := 0; i < eventCount; i++ {
+		payload := map[string]interface{}{"tool": tools[i%len(tools)], "seq": i}
+		// Rare tool so the filtered result stays small relative to the table
+		if i%1000 == 0 {
+			payload["tool"] = "NotebookEdit"
+		}
+		if err := store.Save(ctx, domain.NewEvent("tool.invoked", "bench", payload, "bench")); err != nil {
+			b.Fatalf("Save failed: %v", err)
+		}
+	}
+
+	query := pluginsdk.EventQuery{PayloadFilters: map[string]string{"tool": "NotebookEdit"}}
+
+	b.Run("unindexed", func(b *testing.B) {
+		store.SetIndexedPayloadKeys(nil)
+		for i := 0; i < b.N; i++ {
+			if _, err := store.FindByQuery(ctx, query); err != nil {
+				b.Fatalf("FindByQuery failed: %v", err)
+			}
+		}
+	})
+
+	b.Run("indexed", func(b *testing.B) {
+		store.SetIndexedPayloadKeys(infra.DefaultIndexedPayloadKeys)
+		for i := 0; i < b.N; i++ {
+			if _, err := store.FindByQuery(ctx, query); err != nil {
+				b.Fatalf("FindByQuery failed: %v", err)
+			}
+		}
+	})
+}
+
+func TestSQLiteEventRepository_CheckSchema(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("initialized database is current", func(t *testing.T) {
+		dbPath := filepath.Join(t.TempDir(), "test.db")
+		store, err := infra.NewSQLiteEventRepository(dbPath)
+		if err != nil {
+			t.Fatalf("NewSQLiteEventRepository failed: %v", err)
+		}
+		defer store.Close()
+		if err := store.Initialize(ctx); err != nil {
+			t.Fatalf("Initialize failed: %v", err)
+		}
+
+		missing, err := store.CheckSchema(ctx)
+		if err != nil {
+			t.Fatalf("CheckSchema failed: %v", err)
+		}
+		if len(missing) != 0 {
+			t.Errorf("Expected no missing schema items, got %v", missing)
+		}
+	})
+
+	t.Run("outdated database reports missing tables and columns", func(t *testing.T) {
+		dbPath := filepath.Join(t.TempDir(), "old.db")
+		db, err := sql.Open("sqlite3", dbPath)
+		if err != nil {
+			t.Fatalf("sql.Open failed: %v", err)
+		}
+		if _, err := db.Exec(`CREATE TABLE events (id TEXT PRIMARY KEY, timestamp INTEGER, event_type TEXT, session_id TEXT, payload TEXT, content TEXT)`); err != nil {
+			t.Fatalf("Failed to create legacy table: %v", err)
+		}
+		db.Close()
+
+		store, err := infra.NewSQLiteEventRepository(dbPath)
+		if err != nil {
+			t.Fatalf("NewSQLiteEventRepository failed: %v", err)
+		}
+		defer store.Close()
+
+		missing, err := store.CheckSchema(ctx)
+		if err != nil {
+			t.Fatalf("CheckSchema failed: %v", err)
+		}
+
+		want := map[string]bool{"events.version": false, "session_analyses": false, "bus_events": false}
+		for _, item := range missing {
+			if _, ok := want[item]; ok {
+				want[item] = true
+			}
+			if item == "events" || item == "events.payload" {
+				t.Errorf("Did not expect %q to be reported missing", item)
+			}
+		}
+		for item, found := range want {
+			if !found {
+				t.Errorf("Expected %q in missing items, got %v", item, missing)
+			}
+		}
+	})
+}
@@ -0,0 +1,66 @@
+package infra
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kgatilin/darwinflow-pub/internal/domain"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// DeleteSession removes the events of a session together with their labels and payload
+// index entries, the session's analyses with their event links and its sampling drop
+// counts, in a single transaction. A dry run performs the same deletes and rolls them
+// back, so the counts are exact. Implements domain.SessionDeleter.
+func (r *SQLiteEventRepository) DeleteSession(ctx context.Context, sessionID string, dryRun bool) (*domain.SessionDeleteResult, error) {
+	if sessionID == "" {
+		return nil, fmt.Errorf("%w: session ID must not be empty", pluginsdk.ErrInvalidArgument)
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	ids, err := sessionAnalysisIDs(ctx, tx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	analyses := &domain.AnalysisDeleteResult{}
+	for _, id := range ids {
+		if _, err := deleteAnalysisRows(ctx, tx, id, analyses); err != nil {
+			return nil, err
+		}
+	}
+
+	result := &domain.SessionDeleteResult{
+		AnalysesDeleted:   analyses.AnalysesDeleted,
+		EventLinksDeleted: analyses.EventLinksDeleted,
+	}
+	const sessionEvents = "SELECT id FROM events WHERE session_id = ?"
+	if result.LabelsDeleted, err = execCount(ctx, tx, "DELETE FROM event_labels WHERE event_id IN ("+sessionEvents+")", sessionID); err != nil {
+		return nil, fmt.Errorf("failed to delete event labels: %w", err)
+	}
+	if result.PayloadKeysDeleted, err = execCount(ctx, tx, "DELETE FROM event_payload_index WHERE event_id IN ("+sessionEvents+")", sessionID); err != nil {
+		return nil, fmt.Errorf("failed to delete payload index: %w", err)
+	}
+	if result.EventsDeleted, err = execCount(ctx, tx, "DELETE FROM events WHERE session_id = ?", sessionID); err != nil {
+		return nil, fmt.Errorf("failed to delete events: %w", err)
+	}
+	if result.SampleDropsDeleted, err = execCount(ctx, tx, "DELETE FROM event_sample_drops WHERE session_id = ?", sessionID); err != nil {
+		return nil, fmt.Errorf("failed to delete sample drop counts: %w", err)
+	}
+
+	if result.Empty() {
+		return nil, fmt.Errorf("%w: nothing recorded for session %s", pluginsdk.ErrNotFound, sessionID)
+	}
+	if dryRun {
+		return result, nil
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return result, nil
+}
@@ -0,0 +1,123 @@
+package infra_test
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kgatilin/darwinflow-pub/internal/domain"
+	"github.com/kgatilin/darwinflow-pub/internal/infra"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+func TestSQLiteEventRepository_DeleteSession(t *testing.T) {
+	store, err := infra.NewSQLiteEventRepository(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("NewSQLiteEventRepository failed: %v", err)
+	}
+	ctx := context.Background()
+	store.SetIndexedPayloadKeys([]string{"tool"})
+	if err := store.Initialize(ctx); err != nil {
+		t.Fatalf("Initialize failed: %v", err)
+	}
+	defer store.Close()
+
+	// Seeds a session the way it is recorded: events with labels and indexed payloads,
+	// an analysis linked to its events and a sampling drop counter
+	base := time.UnixMilli(1700000000000)
+	seed := func(sessionID string) {
+		t.Helper()
+		var ids []string
+		for i := 0; i < 2; i++ {
+			event := domain.NewEvent("tool.invoked", sessionID, map[string]string{"tool": "Read"}, "read")
+			event.Timestamp = base.Add(time.Duration(i) * time.Second)
+			if err := store.Save(ctx, event); err != nil {
+				t.Fatalf("Save failed: %v", err)
+			}
+			ids = append(ids, event.ID)
+		}
+		if err := store.AddEventLabels(ctx, ids[0], []string{"test", "bug"}); err != nil {
+			t.Fatalf("AddEventLabels failed: %v", err)
+		}
+		analysis := domain.NewAnalysis(sessionID, "session", "result", "sonnet", "tool_analysis")
+		analysis.EventIDs = ids
+		if err := store.SaveGenericAnalysis(ctx, analysis); err != nil {
+			t.Fatalf("SaveGenericAnalysis failed: %v", err)
+		}
+		sessionAnalysis := domain.NewSessionAnalysisWithType(sessionID, "result", "sonnet", "p", "tool_analysis", "tool_analysis")
+		sessionAnalysis.ID = analysis.ID
+		if err := store.SaveAnalysis(ctx, sessionAnalysis); err != nil {
+			t.Fatalf("SaveAnalysis failed: %v", err)
+		}
+		if err := store.RecordSampleDrop(ctx, sessionID, "tool.invoked"); err != nil {
+			t.Fatalf("RecordSampleDrop failed: %v", err)
+		}
+	}
+	seed("test-session")
+	seed("kept-session")
+
+	countRows := func(query string, args ...interface{}) int {
+		t.Helper()
+		var n int
+		if err := store.DB().QueryRowContext(ctx, query, args...).Scan(&n); err != nil {
+			t.Fatalf("%s failed: %v", query, err)
+		}
+		return n
+	}
+	want := domain.SessionDeleteResult{
+		EventsDeleted:      2,
+		AnalysesDeleted:    1,
+		EventLinksDeleted:  2,
+		LabelsDeleted:      2,
+		PayloadKeysDeleted: 2,
+		SampleDropsDeleted: 1,
+	}
+
+	t.Run("dry run counts without deleting", func(t *testing.T) {
+		result, err := store.DeleteSession(ctx, "test-session", true)
+		if err != nil {
+			t.Fatalf("DeleteSession(dry run) failed: %v", err)
+		}
+		if *result != want {
+			t.Errorf("dry run result = %+v, want %+v", *result, want)
+		}
+		if n := countRows("SELECT COUNT(*) FROM events WHERE session_id = ?", "test-session"); n != 2 {
+			t.Errorf("dry run deleted events: %d left, want 2", n)
+		}
+	})
+
+	t.Run("cascades across related tables", func(t *testing.T) {
+		result, err := store.DeleteSession(ctx, "test-session", false)
+		if err != nil {
+			t.Fatalf("DeleteSession failed: %v", err)
+		}
+		if *result != want {
+			t.Errorf("result = %+v, want %+v", *result, want)
+		}
+		// Only the kept session's rows remain
+		for query, n := range map[string]int{
+			"SELECT COUNT(*) FROM events":              2,
+			"SELECT COUNT(*) FROM analyses":            1,
+			"SELECT COUNT(*) FROM session_analyses":    1,
+			"SELECT COUNT(*) FROM analysis_events":     2,
+			"SELECT COUNT(*) FROM event_labels":        2,
+			"SELECT COUNT(*) FROM event_payload_index": 2,
+			"SELECT COUNT(*) FROM event_sample_drops":  1,
+		} {
+			if got := countRows(query); got != n {
+				t.Errorf("%s = %d, want %d", query, got, n)
+			}
+		}
+		if got := countRows("SELECT COUNT(*) FROM events WHERE session_id = ?", "kept-session"); got != 2 {
+			t.Errorf("other session has %d events, want 2", got)
+		}
+	})
+
+	t.Run("unknown session", func(t *testing.T) {
+		if _, err := store.DeleteSession(ctx, "test-session", false); !errors.Is(err, pluginsdk.ErrNotFound) {
+			t.Errorf("deleting a deleted session: err = %v, want ErrNotFound", err)
+		}
+	})
+}
@@ -0,0 +1,148 @@
+package infra
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/kgatilin/darwinflow-pub/internal/domain"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// MergeSessions reassigns the events, analyses and sampling drop counts of session
+// from to session to in a single transaction. Session analyses the target already has
+// for the same type and model stay on the source, and are deleted with deleteSource
+// together with their event links.
+// Implements domain.SessionMerger.
+func (r *SQLiteEventRepository) MergeSessions(ctx context.Context, from, to string, deleteSource bool) (*domain.SessionMergeResult, error) {
+	if from == "" || to == "" {
+		return nil, fmt.Errorf("%w: session IDs must not be empty", pluginsdk.ErrInvalidArgument)
+	}
+	if from == to {
+		return nil, fmt.Errorf("%w: cannot merge session %s into itself", pluginsdk.ErrInvalidArgument, from)
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result := &domain.SessionMergeResult{}
+	if result.SourceStart, result.SourceEnd, err = sessionSpan(ctx, tx, from); err != nil {
+		return nil, err
+	}
+	if result.TargetStart, result.TargetEnd, err = sessionSpan(ctx, tx, to); err != nil {
+		return nil, err
+	}
+
+	moved, err := execCount(ctx, tx, "UPDATE events SET session_id = ? WHERE session_id = ?", to, from)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reassign events: %w", err)
+	}
+	result.EventsMoved = moved
+
+	// OR IGNORE skips rows that would violate the (session_id, analysis_type, model_used) index
+	moved, err = execCount(ctx, tx, "UPDATE OR IGNORE session_analyses SET session_id = ? WHERE session_id = ?", to, from)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reassign session analyses: %w", err)
+	}
+	result.AnalysesMoved = moved
+
+	// Generic rows stored under the ID of a session analysis left on the source stay with it
+	moved, err = execCount(ctx, tx, `
+		UPDATE analyses SET view_id = ? WHERE view_id = ? AND view_type = 'session'
+		AND id NOT IN (SELECT id FROM session_analyses WHERE session_id = ?)
+	`, to, from, from)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reassign analyses: %w", err)
+	}
+	result.AnalysesMoved += moved
+
+	if deleteSource {
+		// Removes the generic rows and event links stored under the same IDs as well
+		ids, err := keptSessionAnalysisIDs(ctx, tx, from)
+		if err != nil {
+			return nil, err
+		}
+		deleted := &domain.AnalysisDeleteResult{}
+		for _, id := range ids {
+			if _, err := deleteAnalysisRows(ctx, tx, id, deleted); err != nil {
+				return nil, err
+			}
+		}
+		result.AnalysesDeleted = deleted.AnalysesDeleted
+		result.EventLinksDeleted = deleted.EventLinksDeleted
+	} else if err := tx.QueryRowContext(ctx, "SELECT COUNT(*) FROM session_analyses WHERE session_id = ?", from).Scan(&result.AnalysesKept); err != nil {
+		return nil, fmt.Errorf("failed to count source session analyses: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO event_sample_drops (session_id, event_type, dropped)
+		SELECT ?, event_type, dropped FROM event_sample_drops WHERE session_id = ?
+		ON CONFLICT(session_id, event_type) DO UPDATE SET dropped = dropped + excluded.dropped
+	`, to, from)
+	if err != nil {
+		return nil, fmt.Errorf("failed to merge sample drop counts: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM event_sample_drops WHERE session_id = ?", from); err != nil {
+		return nil, fmt.Errorf("failed to delete source sample drop counts: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return result, nil
+}
+
+// keptSessionAnalysisIDs returns the IDs of the session analyses still on sessionID
+func keptSessionAnalysisIDs(ctx context.Context, tx *sql.Tx, sessionID string) ([]string, error) {
+	rows, err := tx.QueryContext(ctx, "SELECT id FROM session_analyses WHERE session_id = ? ORDER BY id", sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query source session analyses: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan session analysis: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+	return ids, nil
+}
+
+// sessionSpan returns the timestamps of the first and last event of a session, or
+// pluginsdk.ErrNotFound when it has none
+func sessionSpan(ctx context.Context, tx *sql.Tx, sessionID string) (time.Time, time.Time, error) {
+	var first, last sql.NullInt64
+	err := tx.QueryRowContext(ctx,
+		"SELECT MIN(timestamp), MAX(timestamp) FROM events WHERE session_id = ?", sessionID,
+	).Scan(&first, &last)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("failed to read session %s: %w", sessionID, err)
+	}
+	if !first.Valid {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: session %s has no events", pluginsdk.ErrNotFound, sessionID)
+	}
+	return time.UnixMilli(first.Int64), time.UnixMilli(last.Int64), nil
+}
+
+// execCount runs a statement and returns the number of rows it affected
+func execCount(ctx context.Context, tx *sql.Tx, query string, args ...interface{}) (int, error) {
+	res, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return int(n), nil
+}
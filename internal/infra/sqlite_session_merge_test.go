@@ -0,0 +1,110 @@
+package infra_test
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kgatilin/darwinflow-pub/internal/domain"
+	"github.com/kgatilin/darwinflow-pub/internal/infra"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+func TestSQLiteEventRepository_MergeSessions(t *testing.T) {
+	store, err := infra.NewSQLiteEventRepository(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("NewSQLiteEventRepository failed: %v", err)
+	}
+	ctx := context.Background()
+	if err := store.Initialize(ctx); err != nil {
+		t.Fatalf("Initialize failed: %v", err)
+	}
+	defer store.Close()
+
+	base := time.UnixMilli(1700000000000)
+	save := func(id, sessionID string, ts time.Time) {
+		t.Helper()
+		event := domain.NewEvent("tool.invoked", sessionID, map[string]string{"id": id}, id)
+		event.ID = id
+		event.Timestamp = ts
+		if err := store.Save(ctx, event); err != nil {
+			t.Fatalf("Save(%s) failed: %v", id, err)
+		}
+	}
+	save("evt-1", "before-restart", base)
+	save("evt-2", "before-restart", base.Add(time.Minute))
+	save("evt-3", "after-restart", base.Add(2*time.Minute))
+	save("evt-4", "other", base)
+
+	// One analysis moves; the other collides with the target's summary of the same model
+	analyses := []*domain.SessionAnalysis{
+		domain.NewSessionAnalysisWithType("before-restart", "tools", "sonnet", "p", "tool_analysis", "tools"),
+		domain.NewSessionAnalysisWithType("before-restart", "old summary", "sonnet", "p", "summary", "summary"),
+		domain.NewSessionAnalysisWithType("after-restart", "new summary", "sonnet", "p", "summary", "summary"),
+	}
+	for _, analysis := range analyses {
+		if err := store.SaveAnalysis(ctx, analysis); err != nil {
+			t.Fatalf("SaveAnalysis failed: %v", err)
+		}
+	}
+	// The colliding summary also has a generic row with event links, as the analysis service saves it
+	oldSummary := domain.NewAnalysis("before-restart", "session", "old summary", "sonnet", "summary")
+	oldSummary.ID = analyses[1].ID
+	oldSummary.EventIDs = []string{"evt-1", "evt-2"}
+	if err := store.SaveGenericAnalysis(ctx, oldSummary); err != nil {
+		t.Fatalf("SaveGenericAnalysis failed: %v", err)
+	}
+	if err := store.RecordSampleDrop(ctx, "before-restart", "tool.invoked"); err != nil {
+		t.Fatalf("RecordSampleDrop failed: %v", err)
+	}
+
+	if _, err := store.MergeSessions(ctx, "missing", "after-restart", false); !errors.Is(err, pluginsdk.ErrNotFound) {
+		t.Errorf("merging an unknown session: err = %v, want ErrNotFound", err)
+	}
+
+	result, err := store.MergeSessions(ctx, "before-restart", "after-restart", false)
+	if err != nil {
+		t.Fatalf("MergeSessions failed: %v", err)
+	}
+	if result.EventsMoved != 2 || result.AnalysesMoved != 1 || result.AnalysesKept != 1 {
+		t.Errorf("result = %+v, want 2 events and 1 analysis moved, 1 analysis kept", result)
+	}
+	if result.Overlaps() {
+		t.Errorf("sessions recorded one after the other must not overlap: %+v", result)
+	}
+
+	merged, err := store.FindByQuery(ctx, pluginsdk.EventQuery{
+		Metadata:    map[string]string{"session_id": "after-restart"},
+		OrderByTime: true,
+	})
+	if err != nil {
+		t.Fatalf("FindByQuery failed: %v", err)
+	}
+	if len(merged) != 3 || merged[0].ID != "evt-1" || merged[2].ID != "evt-3" {
+		t.Errorf("target session has %d events, want evt-1..evt-3 in order", len(merged))
+	}
+	if left, _ := store.FindByQuery(ctx, pluginsdk.EventQuery{Metadata: map[string]string{"session_id": "before-restart"}}); len(left) != 0 {
+		t.Errorf("source session still has %d events", len(left))
+	}
+	if targetAnalyses, _ := store.GetAnalysesBySessionID(ctx, "after-restart"); len(targetAnalyses) != 2 {
+		t.Errorf("target session has %d analyses, want 2", len(targetAnalyses))
+	}
+
+	// The kept analysis goes with --delete-source once the source has events again
+	save("evt-5", "before-restart", base.Add(90*time.Second))
+	result, err = store.MergeSessions(ctx, "before-restart", "after-restart", true)
+	if err != nil {
+		t.Fatalf("MergeSessions with deleteSource failed: %v", err)
+	}
+	if result.EventsMoved != 1 || result.AnalysesDeleted != 1 || result.EventLinksDeleted != 2 || !result.Overlaps() {
+		t.Errorf("result = %+v, want 1 event moved, 1 analysis with 2 event links deleted and an overlap", result)
+	}
+	if found, _ := store.FindAnalysisById(ctx, oldSummary.ID); found != nil {
+		t.Errorf("deleted analysis %s is still found", oldSummary.ID)
+	}
+	if sourceAnalyses, _ := store.GetAnalysesBySessionID(ctx, "before-restart"); len(sourceAnalyses) != 0 {
+		t.Errorf("source session still has %d analyses", len(sourceAnalyses))
+	}
+}
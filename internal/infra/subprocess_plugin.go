@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
 )
@@ -31,9 +32,20 @@ func NewSubprocessPlugin(executablePath string, args ...string) *SubprocessPlugi
 	}
 }
 
-// Initialize starts the subprocess and retrieves plugin metadata.
-// This must be called before using the plugin.
+// SetProcessOptions sets how the subprocess is started; call it before Initialize.
+// An empty options.Dir is replaced by the working directory given to Initialize.
+func (p *SubprocessPlugin) SetProcessOptions(options ProcessOptions) {
+	p.client.SetProcessOptions(options)
+}
+
+// Initialize starts the subprocess in workingDir (unless SetProcessOptions chose
+// another directory) and retrieves plugin metadata. workingDir is also passed to the
+// plugin in InitParams. This must be called before using the plugin.
 func (p *SubprocessPlugin) Initialize(ctx context.Context, workingDir string, config map[string]interface{}) error {
+	if p.client.options.Dir == "" {
+		p.client.options.Dir = workingDir
+	}
+
 	// Start subprocess
 	if err := p.client.Start(ctx); err != nil {
 		return fmt.Errorf("failed to start subprocess: %w", err)
@@ -100,6 +112,12 @@ func (p *SubprocessPlugin) GetInfo() pluginsdk.PluginInfo {
 	return p.info
 }
 
+// ExecutablePath returns the path of the plugin executable.
+// Useful to identify the plugin before Initialize has retrieved its name.
+func (p *SubprocessPlugin) ExecutablePath() string {
+	return p.client.executablePath
+}
+
 // GetCapabilities returns the list of capability interfaces this plugin implements.
 func (p *SubprocessPlugin) GetCapabilities() []string {
 	return p.capabilities
@@ -158,6 +176,22 @@ func (p *SubprocessPlugin) GetEntity(ctx context.Context, entityID string) (plug
 	return &subprocessEntity{data: raw}, nil
 }
 
+// GetEntitySchema retrieves the field definitions of an entity type (IEntitySchemaProvider).
+func (p *SubprocessPlugin) GetEntitySchema(entityType string) (*pluginsdk.EntitySchema, error) {
+	params := pluginsdk.GetEntitySchemaParams{EntityType: entityType}
+	result, err := p.client.Call(context.Background(), pluginsdk.RPCMethodGetEntitySchema, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var schema pluginsdk.EntitySchema
+	if err := json.Unmarshal(result, &schema); err != nil {
+		return nil, fmt.Errorf("failed to parse entity schema: %w", err)
+	}
+
+	return &schema, nil
+}
+
 // UpdateEntity updates an entity (IEntityUpdater).
 func (p *SubprocessPlugin) UpdateEntity(ctx context.Context, entityID string, fields map[string]interface{}) (pluginsdk.IExtensible, error) {
 	params := pluginsdk.UpdateEntityParams{
@@ -202,6 +236,22 @@ func (p *SubprocessPlugin) StopEventStream() error {
 	return err
 }
 
+// HandleEvent delivers a logged event to the plugin (IEventHandler).
+// The call returns once the plugin has processed the event.
+func (p *SubprocessPlugin) HandleEvent(ctx context.Context, event pluginsdk.Event) error {
+	params := pluginsdk.RPCEvent{
+		Event:     "event",
+		Type:      event.Type,
+		Source:    event.Source,
+		Timestamp: event.Timestamp.Format(time.RFC3339Nano),
+		Payload:   event.Payload,
+		Metadata:  event.Metadata,
+		Version:   event.Version,
+	}
+	_, err := p.client.Call(ctx, pluginsdk.RPCMethodHandleEvent, params)
+	return err
+}
+
 // loadCommands fetches command metadata from the subprocess.
 func (p *SubprocessPlugin) loadCommands(ctx context.Context) error {
 	result, err := p.client.Call(ctx, pluginsdk.RPCMethodGetCommands, nil)
@@ -265,16 +315,20 @@ func (c *subprocessCommand) Execute(ctx context.Context, cmdCtx pluginsdk.Comman
 	params := pluginsdk.ExecuteCommandParams{
 		CommandName: c.info.Name,
 		Args:        args,
+		Verbosity:   pluginsdk.GetVerbosity(cmdCtx),
 	}
 
 	result, err := c.plugin.client.Call(ctx, pluginsdk.RPCMethodExecuteCommand, params)
 	if err != nil {
-		return err
+		if ctx.Err() != nil {
+			return err
+		}
+		return fmt.Errorf("%w: %w", pluginsdk.ErrPluginFailure, err)
 	}
 
 	var cmdResult pluginsdk.ExecuteCommandResult
 	if err := json.Unmarshal(result, &cmdResult); err != nil {
-		return fmt.Errorf("failed to parse command result: %w", err)
+		return fmt.Errorf("%w: failed to parse command result: %w", pluginsdk.ErrPluginFailure, err)
 	}
 
 	// Write output to command context
@@ -284,12 +338,34 @@ func (c *subprocessCommand) Execute(ctx context.Context, cmdCtx pluginsdk.Comman
 
 	// Check exit code
 	if cmdResult.ExitCode != 0 {
-		return fmt.Errorf("command failed with exit code %d: %s", cmdResult.ExitCode, cmdResult.Error)
+		err := fmt.Errorf("command failed with exit code %d: %s", cmdResult.ExitCode, cmdResult.Error)
+		if sentinel := exitCodeError(cmdResult.ExitCode); sentinel != nil {
+			return fmt.Errorf("%w: %w", sentinel, err)
+		}
+		return err
 	}
 
 	return nil
 }
 
+// exitCodeError returns the standard error of a documented exit code reported by a
+// plugin command (see pluginsdk.ExitCode), so dw exits with the same code. Generic
+// failures (1) have none; undocumented codes count as plugin failures.
+func exitCodeError(code int) error {
+	switch code {
+	case pluginsdk.ExitError:
+		return nil
+	case pluginsdk.ExitUsage:
+		return pluginsdk.ErrInvalidArgument
+	case pluginsdk.ExitNotFound:
+		return pluginsdk.ErrNotFound
+	case pluginsdk.ExitConcurrentModification:
+		return pluginsdk.ErrConcurrentModification
+	default:
+		return pluginsdk.ErrPluginFailure
+	}
+}
+
 // subprocessEntity is an adapter for entities from external plugins.
 type subprocessEntity struct {
 	data map[string]interface{}
@@ -345,7 +421,9 @@ func (e *subprocessEntity) UnmarshalJSON(data []byte) error {
 var _ pluginsdk.Plugin = (*SubprocessPlugin)(nil)
 var _ pluginsdk.IEntityProvider = (*SubprocessPlugin)(nil)
 var _ pluginsdk.IEntityUpdater = (*SubprocessPlugin)(nil)
+var _ pluginsdk.IEntitySchemaProvider = (*SubprocessPlugin)(nil)
 var _ pluginsdk.ICommandProvider = (*SubprocessPlugin)(nil)
 var _ pluginsdk.IEventEmitter = (*SubprocessPlugin)(nil)
+var _ pluginsdk.IEventHandler = (*SubprocessPlugin)(nil)
 var _ pluginsdk.Command = (*subprocessCommand)(nil)
 var _ pluginsdk.IExtensible = (*subprocessEntity)(nil)
@@ -3,10 +3,12 @@ package infra_test
 import (
 	"bytes"
 	"context"
+	"errors"
 	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -168,6 +170,125 @@ func TestSubprocessPlugin_CommandProvider(t *testing.T) {
 	}
 }
 
+// TestSubprocessPlugin_CommandExitCodes tests that documented exit codes of plugin
+// commands map to the standard errors, so dw exits with the same code
+func TestSubprocessPlugin_CommandExitCodes(t *testing.T) {
+	pluginPath := buildExternalPlugin(t)
+
+	plugin := infra.NewSubprocessPlugin(pluginPath)
+	ctx := context.Background()
+	if err := plugin.Initialize(ctx, "/tmp", nil); err != nil {
+		t.Fatalf("initialization failed: %v", err)
+	}
+	defer plugin.Shutdown()
+
+	var testCmd pluginsdk.Command
+	for _, cmd := range plugin.GetCommands() {
+		if cmd.GetName() == "test" {
+			testCmd = cmd
+		}
+	}
+	if testCmd == nil {
+		t.Fatal("test command not found")
+	}
+
+	tests := []struct {
+		pluginCode string
+		want       int
+	}{
+		{"1", pluginsdk.ExitError},
+		{"2", pluginsdk.ExitUsage},
+		{"3", pluginsdk.ExitNotFound},
+		{"4", pluginsdk.ExitConcurrentModification},
+		{"5", pluginsdk.ExitPluginFailure},
+		{"42", pluginsdk.ExitPluginFailure},
+	}
+	for _, tt := range tests {
+		err := testCmd.Execute(ctx, &mockCommandContext{output: &bytes.Buffer{}}, []string{"exit", tt.pluginCode})
+		if err == nil {
+			t.Errorf("exit %s: expected an error", tt.pluginCode)
+			continue
+		}
+		if got := pluginsdk.ExitCode(err); got != tt.want {
+			t.Errorf("exit %s: ExitCode(%v) = %d, want %d", tt.pluginCode, err, got, tt.want)
+		}
+	}
+}
+
+// runTestCommand runs the external test plugin's "test" command with args and returns its output
+func runTestCommand(t *testing.T, plugin *infra.SubprocessPlugin, args ...string) string {
+	t.Helper()
+	for _, cmd := range plugin.GetCommands() {
+		if cmd.GetName() == "test" {
+			cmdCtx := &mockCommandContext{output: &bytes.Buffer{}}
+			if err := cmd.Execute(context.Background(), cmdCtx, args); err != nil {
+				t.Fatalf("test %v failed: %v", args, err)
+			}
+			return cmdCtx.output.String()
+		}
+	}
+	t.Fatal("test command not found")
+	return ""
+}
+
+// TestSubprocessPlugin_WorkingDir tests that the plugin runs in the working directory
+// given to Initialize and receives it in InitParams, not dw's current directory
+func TestSubprocessPlugin_WorkingDir(t *testing.T) {
+	pluginPath := buildExternalPlugin(t)
+	projectDir, err := filepath.EvalSymlinks(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	hostDir, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hostDir == projectDir {
+		t.Fatal("test needs a project directory other than the current one")
+	}
+
+	plugin := infra.NewSubprocessPlugin(pluginPath)
+	if err := plugin.Initialize(context.Background(), projectDir, nil); err != nil {
+		t.Fatalf("initialization failed: %v", err)
+	}
+	defer plugin.Shutdown()
+
+	want := projectDir + "\n" + projectDir + "\n"
+	if got := runTestCommand(t, plugin, "pwd"); got != want {
+		t.Errorf("plugin working directories = %q, want %q", got, want)
+	}
+}
+
+// TestSubprocessPlugin_Environment tests that a plugin only sees the environment
+// chosen with SetProcessOptions
+func TestSubprocessPlugin_Environment(t *testing.T) {
+	pluginPath := buildExternalPlugin(t)
+	t.Setenv("DW_TEST_SECRET", "hunter2")
+	t.Setenv("DW_TEST_SHARED", "shared")
+	t.Setenv("NO_COLOR", "1") // dw sets it for --no-color
+
+	plugin := infra.NewSubprocessPlugin(pluginPath)
+	plugin.SetProcessOptions(infra.ProcessOptions{
+		Env: infra.PluginEnvironment([]string{"DW_TEST_SHARED"}, map[string]string{"DW_TEST_SET": "set"}),
+	})
+	if err := plugin.Initialize(context.Background(), t.TempDir(), nil); err != nil {
+		t.Fatalf("initialization failed: %v", err)
+	}
+	defer plugin.Shutdown()
+
+	for name, want := range map[string]string{
+		"DW_TEST_SECRET": "false ",
+		"DW_TEST_SHARED": "true shared",
+		"DW_TEST_SET":    "true set",
+		"PATH":           "true " + os.Getenv("PATH"),
+		"NO_COLOR":       "true 1",
+	} {
+		if got := runTestCommand(t, plugin, "env", name); got != want {
+			t.Errorf("%s: got %q, want %q", name, got, want)
+		}
+	}
+}
+
 // TestSubprocessPlugin_EventEmitter tests event streaming.
 func TestSubprocessPlugin_EventEmitter(t *testing.T) {
 	pluginPath := buildExternalPlugin(t)
@@ -200,6 +321,59 @@ func TestSubprocessPlugin_EventEmitter(t *testing.T) {
 	}
 }
 
+// TestSubprocessPlugin_HandleEvent tests delivering events to the plugin.
+func TestSubprocessPlugin_HandleEvent(t *testing.T) {
+	pluginPath := buildExternalPlugin(t)
+
+	plugin := infra.NewSubprocessPlugin(pluginPath)
+	ctx := context.Background()
+
+	if err := plugin.Initialize(ctx, "/tmp", nil); err != nil {
+		t.Fatalf("initialization failed: %v", err)
+	}
+	defer plugin.Shutdown()
+
+	event := pluginsdk.Event{Type: "test.handled", Timestamp: time.Now(), Metadata: map[string]string{"event_id": "e1"}}
+	if err := plugin.HandleEvent(ctx, event); err != nil {
+		t.Fatalf("HandleEvent failed: %v", err)
+	}
+
+	// The plugin's error is returned, and the plugin keeps serving calls
+	event.Type = "test.rejected"
+	if err := plugin.HandleEvent(ctx, event); err == nil || !strings.Contains(err.Error(), "unexpected event test.rejected") {
+		t.Errorf("expected the plugin's error, got %v", err)
+	}
+	event.Type = "test.handled"
+	if err := plugin.HandleEvent(ctx, event); err != nil {
+		t.Errorf("HandleEvent after a failure: %v", err)
+	}
+}
+
+// TestSubprocessPlugin_GetEntitySchema tests fetching entity field definitions.
+func TestSubprocessPlugin_GetEntitySchema(t *testing.T) {
+	pluginPath := buildExternalPlugin(t)
+
+	plugin := infra.NewSubprocessPlugin(pluginPath)
+	ctx := context.Background()
+
+	if err := plugin.Initialize(ctx, "/tmp", nil); err != nil {
+		t.Fatalf("initialization failed: %v", err)
+	}
+	defer plugin.Shutdown()
+
+	schema, err := plugin.GetEntitySchema("note")
+	if err != nil {
+		t.Fatalf("GetEntitySchema failed: %v", err)
+	}
+	if schema.Type != "note" || len(schema.Fields) != 2 || !schema.Fields[0].Required || schema.Fields[1].Name != "title" {
+		t.Errorf("unexpected schema: %+v", schema)
+	}
+
+	if _, err := plugin.GetEntitySchema("ghost"); !errors.Is(err, pluginsdk.ErrNotFound) {
+		t.Errorf("expected ErrNotFound for unknown entity type, got %v", err)
+	}
+}
+
 // buildExternalPlugin creates a test external plugin executable.
 func buildExternalPlugin(t *testing.T) string {
 	t.Helper()
@@ -215,6 +389,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strconv"
 	"time"
 )
 
@@ -250,6 +425,9 @@ var entities = []map[string]interface{}{
 	{"id": "note-2", "type": "note", "title": "Second Note", "capabilities": []string{}},
 }
 
+// workingDir is the working_dir received in init
+var workingDir string
+
 func main() {
 	scanner := bufio.NewScanner(os.Stdin)
 	for scanner.Scan() {
@@ -263,6 +441,11 @@ func main() {
 
 		switch req.Method {
 		case "init":
+			var params struct {
+				WorkingDir string ` + "`json:\"working_dir\"`" + `
+			}
+			json.Unmarshal(req.Params, &params)
+			workingDir = params.WorkingDir
 			result = nil
 		case "get_info":
 			result = map[string]interface{}{
@@ -323,6 +506,33 @@ func main() {
 				},
 			}
 		case "execute_command":
+			// "exit <code>" fails with that exit code
+			var params struct {
+				Args []string ` + "`json:\"args\"`" + `
+			}
+			json.Unmarshal(req.Params, &params)
+			if len(params.Args) == 2 && params.Args[0] == "exit" {
+				code, _ := strconv.Atoi(params.Args[1])
+				result = map[string]interface{}{"exit_code": code, "output": "", "error": "failed on purpose"}
+				break
+			}
+			// "pwd" prints the process and init working directories, "env NAME" a
+			// variable and "read PATH" a file
+			if len(params.Args) == 1 && params.Args[0] == "pwd" {
+				cwd, _ := os.Getwd()
+				result = map[string]interface{}{"exit_code": 0, "output": cwd + "\n" + workingDir + "\n", "error": ""}
+				break
+			}
+			if len(params.Args) == 2 && params.Args[0] == "env" {
+				value, ok := os.LookupEnv(params.Args[1])
+				result = map[string]interface{}{"exit_code": 0, "output": fmt.Sprintf("%t %s", ok, value), "error": ""}
+				break
+			}
+			if len(params.Args) == 2 && params.Args[0] == "read" {
+				data, _ := os.ReadFile(params.Args[1])
+				result = map[string]interface{}{"exit_code": 0, "output": string(data), "error": ""}
+				break
+			}
 			result = map[string]interface{}{
 				"exit_code": 0,
 				"output":    "Command executed successfully\n",
@@ -341,6 +551,26 @@ func main() {
 			fmt.Fprintf(os.Stdout, "%s\n", string(data))
 		case "stop_event_stream":
 			result = nil
+		case "get_entity_schema":
+			var params map[string]string
+			json.Unmarshal(req.Params, &params)
+			if params["entity_type"] != "note" {
+				err = &RPCError{Code: -32000, Message: "unknown entity type"}
+				break
+			}
+			result = map[string]interface{}{
+				"type": "note",
+				"fields": []map[string]interface{}{
+					{"name": "id", "type": "string", "required": true},
+					{"name": "title", "type": "string"},
+				},
+			}
+		case "handle_event":
+			var event Event
+			json.Unmarshal(req.Params, &event)
+			if event.Type != "test.handled" {
+				err = &RPCError{Code: -32603, Message: "unexpected event " + event.Type}
+			}
 		default:
 			err = &RPCError{Code: -32601, Message: "method not found"}
 		}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 )
 
 // Claude Code trigger types (from Claude Code settings.json)
@@ -291,6 +292,51 @@ func (m *HookConfigManager) InstallDarwinFlowHooks() error {
 	return nil
 }
 
+// MissingDarwinFlowHooks returns the default DarwinFlow hooks that are not present in
+// the settings file, as "<trigger>: <command>" sorted by trigger. An empty result means
+// the hooks are installed and current.
+func (m *HookConfigManager) MissingDarwinFlowHooks() ([]string, error) {
+	settings, err := m.ReadSettings()
+	if err != nil {
+		return nil, err
+	}
+
+	defaults := DefaultDarwinFlowConfig()
+	triggers := make([]string, 0, len(defaults.Hooks))
+	for trigger := range defaults.Hooks {
+		triggers = append(triggers, trigger)
+	}
+	sort.Strings(triggers)
+
+	missing := []string{}
+	for _, trigger := range triggers {
+		for _, matcher := range defaults.Hooks[trigger] {
+			for _, hook := range matcher.Hooks {
+				if !hasHookCommand(settings.Hooks[trigger], matcher.Matcher, hook.Command) {
+					missing = append(missing, fmt.Sprintf("%s: %s", trigger, hook.Command))
+				}
+			}
+		}
+	}
+
+	return missing, nil
+}
+
+// hasHookCommand reports whether matchers contain command under the given matcher pattern
+func hasHookCommand(matchers []HookMatcher, pattern, command string) bool {
+	for _, matcher := range matchers {
+		if matcher.Matcher != pattern {
+			continue
+		}
+		for _, hook := range matcher.Hooks {
+			if hook.Command == command {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // GetSettingsPath returns the path to the settings file
 func (m *HookConfigManager) GetSettingsPath() string {
 	return m.settingsPath
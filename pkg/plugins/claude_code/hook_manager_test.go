@@ -428,3 +428,61 @@ func TestCopyFile_SourceNotExists(t *testing.T) {
 		t.Fatal("Settings file was not created")
 	}
 }
+
+func TestMissingDarwinFlowHooks(t *testing.T) {
+	tmpDir := t.TempDir()
+	oldCwd, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("Failed to get working directory: %v", err)
+	}
+	defer func() {
+		if err := os.Chdir(oldCwd); err != nil {
+			t.Logf("Warning: failed to restore working directory: %v", err)
+		}
+	}()
+
+	if err := os.Chdir(tmpDir); err != nil {
+		t.Fatalf("Failed to change directory: %v", err)
+	}
+
+	mgr, _ := claude_code.NewHookConfigManager()
+
+	// No settings file: every default hook is missing
+	missing, err := mgr.MissingDarwinFlowHooks()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(missing) != len(claude_code.DefaultDarwinFlowConfig().Hooks) {
+		t.Errorf("Expected all %d hooks missing, got %v", len(claude_code.DefaultDarwinFlowConfig().Hooks), missing)
+	}
+
+	if err := mgr.InstallDarwinFlowHooks(); err != nil {
+		t.Fatalf("Failed to install hooks: %v", err)
+	}
+
+	missing, err = mgr.MissingDarwinFlowHooks()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(missing) != 0 {
+		t.Errorf("Expected no missing hooks after install, got %v", missing)
+	}
+
+	// Remove one trigger to simulate outdated settings
+	settings, err := mgr.ReadSettings()
+	if err != nil {
+		t.Fatalf("Failed to read settings: %v", err)
+	}
+	delete(settings.Hooks, "SessionEnd")
+	if err := mgr.WriteSettings(settings); err != nil {
+		t.Fatalf("Failed to write settings: %v", err)
+	}
+
+	missing, err = mgr.MissingDarwinFlowHooks()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(missing) != 1 || missing[0] != "SessionEnd: dw claude auto-summary" {
+		t.Errorf("Expected only SessionEnd hook missing, got %v", missing)
+	}
+}
@@ -3,33 +3,44 @@ package application
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application/dto"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/repositories"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/services"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
 )
 
 // ACApplicationService handles all Acceptance Criteria operations
 type ACApplicationService struct {
 	acRepo            repositories.AcceptanceCriteriaRepository
 	taskRepo          repositories.TaskRepository
+	iterationRepo     repositories.IterationRepository
 	aggregateRepo     repositories.AggregateRepository
+	txRepo            domain.TransactionalRepository // makes multi-AC updates atomic
 	validationService *services.ValidationService
 }
 
-// NewACApplicationService creates a new AC service
+// NewACApplicationService creates a new AC service. txRepo is required: writes touching
+// several ACs go through its transactions.
 func NewACApplicationService(
 	acRepo repositories.AcceptanceCriteriaRepository,
 	taskRepo repositories.TaskRepository,
+	iterationRepo repositories.IterationRepository,
 	aggregateRepo repositories.AggregateRepository,
+	txRepo domain.TransactionalRepository,
 	validationService *services.ValidationService,
 ) *ACApplicationService {
 	return &ACApplicationService{
 		acRepo:            acRepo,
 		taskRepo:          taskRepo,
+		iterationRepo:     iterationRepo,
 		aggregateRepo:     aggregateRepo,
+		txRepo:            txRepo,
 		validationService: validationService,
 	}
 }
@@ -37,12 +48,15 @@ func NewACApplicationService(
 // CreateAC creates a new acceptance criterion
 func (s *ACApplicationService) CreateAC(ctx context.Context, input dto.CreateACDTO) (*entities.AcceptanceCriteriaEntity, error) {
 	// Generate AC ID
-	projectCode := s.aggregateRepo.GetProjectCode(ctx)
+	ids, err := newEntityIDs(ctx, s.aggregateRepo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate AC ID: %w", err)
+	}
 	nextNum, err := s.aggregateRepo.GetNextSequenceNumber(ctx, "ac")
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate AC ID: %w", err)
 	}
-	id := fmt.Sprintf("%s-ac-%d", projectCode, nextNum)
+	id := ids.ID("ac", nextNum)
 
 	// Validate AC ID
 	if err := s.validationService.ValidateNonEmpty("AC ID", id); err != nil {
@@ -60,14 +74,28 @@ func (s *ACApplicationService) CreateAC(ctx context.Context, input dto.CreateACD
 		return nil, fmt.Errorf("task not found: %w", err)
 	}
 
+	// Resolve verification type (default: manual)
+	verificationType := entities.VerificationTypeManual
+	if input.VerificationType != "" {
+		if !entities.IsValidVerificationType(input.VerificationType) {
+			return nil, fmt.Errorf("%w: invalid verification type %q (must be manual or automated)", pluginsdk.ErrInvalidArgument, input.VerificationType)
+		}
+		verificationType = entities.AcceptanceCriteriaVerificationType(input.VerificationType)
+	}
+
+	tags, err := normalizeACTags(input.Tags)
+	if err != nil {
+		return nil, err
+	}
+
 	now := time.Now().UTC()
 
-	// Create AC entity (default status: not-started, default type: manual)
+	// Create AC entity (default status: not-started)
 	ac := entities.NewAcceptanceCriteriaEntity(
 		id,
 		input.TaskID,
 		input.Description,
-		entities.VerificationTypeManual,
+		verificationType,
 		input.TestingInstructions,
 		now,
 		now,
@@ -78,9 +106,66 @@ func (s *ACApplicationService) CreateAC(ctx context.Context, input dto.CreateACD
 		return nil, fmt.Errorf("failed to save AC: %w", err)
 	}
 
+	for _, tag := range tags {
+		if err := s.acRepo.AddACTag(ctx, ac.ID, tag); err != nil {
+			return nil, fmt.Errorf("failed to tag AC: %w", err)
+		}
+	}
+
 	return ac, nil
 }
 
+// normalizeACTags validates tags and drops duplicates
+func normalizeACTags(tags []string) ([]string, error) {
+	var normalized []string
+	for _, tag := range tags {
+		t, err := entities.NormalizeACTag(tag)
+		if err != nil {
+			return nil, err
+		}
+		duplicate := false
+		for _, existing := range normalized {
+			if existing == t {
+				duplicate = true
+				break
+			}
+		}
+		if !duplicate {
+			normalized = append(normalized, t)
+		}
+	}
+	return normalized, nil
+}
+
+// AddACTag tags an acceptance criterion and returns the normalized tag
+func (s *ACApplicationService) AddACTag(ctx context.Context, acID, tag string) (string, error) {
+	normalized, err := entities.NormalizeACTag(tag)
+	if err != nil {
+		return "", err
+	}
+	if err := s.acRepo.AddACTag(ctx, acID, normalized); err != nil {
+		return "", fmt.Errorf("failed to tag AC: %w", err)
+	}
+	return normalized, nil
+}
+
+// RemoveACTag removes a tag from an acceptance criterion
+func (s *ACApplicationService) RemoveACTag(ctx context.Context, acID, tag string) error {
+	normalized, err := entities.NormalizeACTag(tag)
+	if err != nil {
+		return err
+	}
+	if err := s.acRepo.RemoveACTag(ctx, acID, normalized); err != nil {
+		return fmt.Errorf("failed to untag AC: %w", err)
+	}
+	return nil
+}
+
+// ListACTags returns the tags of an acceptance criterion
+func (s *ACApplicationService) ListACTags(ctx context.Context, acID string) ([]string, error) {
+	return s.acRepo.ListACTags(ctx, acID)
+}
+
 // UpdateAC updates an existing acceptance criterion
 func (s *ACApplicationService) UpdateAC(ctx context.Context, input dto.UpdateACDTO) (*entities.AcceptanceCriteriaEntity, error) {
 	// Fetch existing AC
@@ -112,7 +197,9 @@ func (s *ACApplicationService) UpdateAC(ctx context.Context, input dto.UpdateACD
 	return ac, nil
 }
 
-// VerifyAC marks an acceptance criterion as verified
+// VerifyAC marks an acceptance criterion as verified. With input.RequireInstructions
+// the criterion must have testing instructions, with input.RequireNotes the input must
+// carry notes on the evidence; otherwise it fails with ErrInvalidArgument.
 func (s *ACApplicationService) VerifyAC(ctx context.Context, input dto.VerifyACDTO) error {
 	// Fetch existing AC
 	ac, err := s.acRepo.GetAC(ctx, input.ID)
@@ -120,9 +207,21 @@ func (s *ACApplicationService) VerifyAC(ctx context.Context, input dto.VerifyACD
 		return fmt.Errorf("AC not found: %w", err)
 	}
 
+	notes := strings.TrimSpace(input.Notes)
+	if input.RequireInstructions && strings.TrimSpace(ac.TestingInstructions) == "" {
+		return fmt.Errorf("%w: AC %s has no testing instructions; document how it is tested with 'dw task-manager ac update %s --testing-instructions <steps>' before verifying it",
+			pluginsdk.ErrInvalidArgument, ac.ID, ac.ID)
+	}
+	if input.RequireNotes && notes == "" {
+		return fmt.Errorf("%w: verifying AC %s requires --notes describing the evidence", pluginsdk.ErrInvalidArgument, ac.ID)
+	}
+
 	// Update status to verified
 	ac.Status = entities.ACStatusVerified
 	ac.Notes = fmt.Sprintf("Verified by: %s at %s", input.VerifiedBy, input.VerifiedAt)
+	if notes != "" {
+		ac.Notes += "; evidence: " + notes
+	}
 	ac.UpdatedAt = time.Now().UTC()
 
 	// Persist updates
@@ -185,6 +284,250 @@ func (s *ACApplicationService) SkipAC(ctx context.Context, input dto.SkipACDTO)
 	return nil
 }
 
+// ResetACs returns the selected acceptance criteria to not_started, clearing their
+// notes unless KeepNotes is set. Criteria that are already not_started are left
+// untouched. Each affected task gets a note listing the reset criteria and their
+// previous status. Everything is saved in one transaction when the service has a
+// transactional repository. Returns the reset criteria and the number skipped.
+func (s *ACApplicationService) ResetACs(ctx context.Context, input dto.ResetACsDTO) ([]*entities.AcceptanceCriteriaEntity, int, error) {
+	selectors := 0
+	for _, set := range []bool{input.ID != "", input.TaskID != "", input.IterationNum != nil} {
+		if set {
+			selectors++
+		}
+	}
+	if selectors != 1 {
+		return nil, 0, fmt.Errorf("%w: exactly one of AC ID, task or iteration must be given", pluginsdk.ErrInvalidArgument)
+	}
+
+	var acs []*entities.AcceptanceCriteriaEntity
+	switch {
+	case input.ID != "":
+		ac, err := s.acRepo.GetAC(ctx, input.ID)
+		if err != nil {
+			return nil, 0, fmt.Errorf("AC not found: %w", err)
+		}
+		acs = []*entities.AcceptanceCriteriaEntity{ac}
+	case input.TaskID != "":
+		if _, err := s.taskRepo.GetTask(ctx, input.TaskID); err != nil {
+			return nil, 0, fmt.Errorf("task not found: %w", err)
+		}
+		list, err := s.acRepo.ListAC(ctx, input.TaskID)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to list ACs: %w", err)
+		}
+		acs = list
+	default:
+		if _, err := s.iterationRepo.GetIteration(ctx, *input.IterationNum); err != nil {
+			return nil, 0, fmt.Errorf("iteration not found: %w", err)
+		}
+		list, err := s.acRepo.ListACByIteration(ctx, *input.IterationNum)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to list ACs by iteration: %w", err)
+		}
+		acs = list
+	}
+
+	now := time.Now().UTC()
+	var reset []*entities.AcceptanceCriteriaEntity
+	skipped := 0
+	// Previous statuses per task, in AC order, for the audit note
+	var taskOrder []string
+	changes := make(map[string][]string)
+	for _, ac := range acs {
+		if ac.Status == entities.ACStatusNotStarted {
+			skipped++
+			continue
+		}
+
+		previous := ac.Status
+		ac.Status = entities.ACStatusNotStarted
+		if !input.KeepNotes {
+			ac.Notes = ""
+		}
+		ac.UpdatedAt = now
+		reset = append(reset, ac)
+
+		if _, seen := changes[ac.TaskID]; !seen {
+			taskOrder = append(taskOrder, ac.TaskID)
+		}
+		changes[ac.TaskID] = append(changes[ac.TaskID], fmt.Sprintf("%s (was %s)", ac.ID, previous))
+	}
+
+	var notes []*entities.TaskNoteEntity
+	for _, taskID := range taskOrder {
+		content := fmt.Sprintf("Reset acceptance criteria to %s: %s", entities.ACStatusNotStarted, strings.Join(changes[taskID], ", "))
+		note, err := entities.NewTaskNoteEntity(taskID, content, now)
+		if err != nil {
+			return nil, 0, err
+		}
+		notes = append(notes, note)
+	}
+
+	err := s.txRepo.WithTx(ctx, func(repo domain.RoadmapRepository) error {
+		for _, ac := range reset {
+			if err := repo.UpdateAC(ctx, ac); err != nil {
+				return fmt.Errorf("failed to reset AC %s: %w", ac.ID, err)
+			}
+		}
+		for _, note := range notes {
+			if err := repo.SaveTaskNote(ctx, note); err != nil {
+				return fmt.Errorf("failed to record AC reset note: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return reset, skipped, nil
+}
+
+// VerifyAutomatedACs marks every automated acceptance criterion of a track, or of a single
+// task, as automatically verified. Manual ACs and ACs that are already verified or skipped
+// are counted as skipped. Each AC is updated independently: a failed update is reported in
+// the result and does not stop the others.
+func (s *ACApplicationService) VerifyAutomatedACs(ctx context.Context, input dto.VerifyAutomatedACsDTO) (*dto.VerifyAutomatedACsResultDTO, error) {
+	if input.TrackID == "" && input.TaskID == "" {
+		return nil, fmt.Errorf("%w: a track or task must be given", pluginsdk.ErrInvalidArgument)
+	}
+
+	var tasks []*entities.TaskEntity
+	if input.TaskID != "" {
+		task, err := s.taskRepo.GetTask(ctx, input.TaskID)
+		if err != nil {
+			return nil, fmt.Errorf("task not found: %w", err)
+		}
+		if input.TrackID != "" && task.TrackID != input.TrackID {
+			return nil, fmt.Errorf("%w: task %s is in track %s, not %s", pluginsdk.ErrInvalidArgument, task.ID, task.TrackID, input.TrackID)
+		}
+		tasks = []*entities.TaskEntity{task}
+	} else {
+		list, err := s.taskRepo.ListTasks(ctx, entities.TaskFilters{TrackID: input.TrackID})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list tasks: %w", err)
+		}
+		tasks = list
+	}
+
+	now := time.Now().UTC()
+	result := &dto.VerifyAutomatedACsResultDTO{}
+	for _, task := range tasks {
+		acs, err := s.acRepo.ListAC(ctx, task.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list ACs for task %s: %w", task.ID, err)
+		}
+		for _, ac := range acs {
+			switch {
+			case ac.VerificationType != entities.VerificationTypeAutomated:
+				result.SkippedManual++
+				continue
+			case ac.IsVerified() || ac.IsSkipped():
+				result.SkippedTerminal++
+				continue
+			}
+
+			ac.Status = entities.ACStatusAutomaticallyVerified
+			ac.Notes = fmt.Sprintf("Verified by: auto at %s", now.Format(time.RFC3339))
+			ac.UpdatedAt = now
+			if err := s.acRepo.UpdateAC(ctx, ac); err != nil {
+				result.Failed = append(result.Failed, dto.ACUpdateErrorDTO{ID: ac.ID, Err: err})
+				continue
+			}
+			result.VerifiedIDs = append(result.VerifiedIDs, ac.ID)
+		}
+	}
+
+	return result, nil
+}
+
+// ReconcileTrackACs verifies the acceptance criteria tagged auto-on-complete of every
+// track (or of the given track) whose tasks are all done. Tracks without tasks are not
+// complete. ACs already verified or skipped are left alone. Each AC is updated
+// independently, and each task with verified ACs gets a note listing them.
+func (s *ACApplicationService) ReconcileTrackACs(ctx context.Context, input dto.ReconcileACsDTO) (*dto.ReconcileACsResultDTO, error) {
+	tasks, err := s.taskRepo.ListTasks(ctx, entities.TaskFilters{TrackID: input.TrackID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+
+	tasksByTrack := make(map[string][]*entities.TaskEntity)
+	var trackIDs []string
+	for _, task := range tasks {
+		if _, seen := tasksByTrack[task.TrackID]; !seen {
+			trackIDs = append(trackIDs, task.TrackID)
+		}
+		tasksByTrack[task.TrackID] = append(tasksByTrack[task.TrackID], task)
+	}
+	sort.Strings(trackIDs)
+
+	now := time.Now().UTC()
+	result := &dto.ReconcileACsResultDTO{}
+	if input.TrackID != "" && len(trackIDs) == 0 {
+		result.IncompleteTracks = 1
+	}
+	for _, trackID := range trackIDs {
+		trackTasks := tasksByTrack[trackID]
+		if !allTasksDone(trackTasks) {
+			result.IncompleteTracks++
+			continue
+		}
+
+		reconciled := dto.ReconciledTrackDTO{TrackID: trackID}
+		for _, task := range trackTasks {
+			acs, err := s.acRepo.ListTaggedAC(ctx, task.ID, entities.ACTagAutoOnComplete)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list ACs for task %s: %w", task.ID, err)
+			}
+
+			var verified []string
+			for _, ac := range acs {
+				if ac.IsVerified() || ac.IsSkipped() {
+					continue
+				}
+				ac.Status = entities.ACStatusAutomaticallyVerified
+				ac.Notes = fmt.Sprintf("Verified by: %s at %s (all tasks of track %s done)", entities.ACTagAutoOnComplete, now.Format(time.RFC3339), trackID)
+				ac.UpdatedAt = now
+				if err := s.acRepo.UpdateAC(ctx, ac); err != nil {
+					result.Failed = append(result.Failed, dto.ACUpdateErrorDTO{ID: ac.ID, Err: err})
+					continue
+				}
+				verified = append(verified, ac.ID)
+			}
+			if len(verified) == 0 {
+				continue
+			}
+
+			content := fmt.Sprintf("Auto-verified acceptance criteria on completion of track %s: %s", trackID, strings.Join(verified, ", "))
+			note, err := entities.NewTaskNoteEntity(task.ID, content, now)
+			if err != nil {
+				return nil, err
+			}
+			if err := s.taskRepo.SaveTaskNote(ctx, note); err != nil {
+				return nil, fmt.Errorf("failed to record AC reconciliation note: %w", err)
+			}
+			reconciled.VerifiedIDs = append(reconciled.VerifiedIDs, verified...)
+		}
+		result.Tracks = append(result.Tracks, reconciled)
+	}
+
+	return result, nil
+}
+
+// allTasksDone reports whether tasks is non-empty and every task is done
+func allTasksDone(tasks []*entities.TaskEntity) bool {
+	if len(tasks) == 0 {
+		return false
+	}
+	for _, task := range tasks {
+		if task.Status != string(entities.TaskStatusDone) {
+			return false
+		}
+	}
+	return true
+}
+
 // DeleteAC removes an acceptance criterion
 func (s *ACApplicationService) DeleteAC(ctx context.Context, acID string) error {
 	if err := s.acRepo.DeleteAC(ctx, acID); err != nil {
@@ -193,6 +536,12 @@ func (s *ACApplicationService) DeleteAC(ctx context.Context, acID string) error
 	return nil
 }
 
+// PlanACDeletion reports what deleting an acceptance criterion would remove,
+// without deleting anything
+func (s *ACApplicationService) PlanACDeletion(ctx context.Context, acID string) (*entities.DeletionPlan, error) {
+	return s.aggregateRepo.PlanDeletion(ctx, entities.DeletionTargetAC, acID)
+}
+
 // GetAC retrieves an acceptance criterion by ID
 func (s *ACApplicationService) GetAC(ctx context.Context, acID string) (*entities.AcceptanceCriteriaEntity, error) {
 	ac, err := s.acRepo.GetAC(ctx, acID)
@@ -228,3 +577,322 @@ func (s *ACApplicationService) ListFailedAC(ctx context.Context, filters entitie
 	}
 	return acs, nil
 }
+
+// ListACs returns the acceptance criteria matching the filters in a single query
+func (s *ACApplicationService) ListACs(ctx context.Context, filters entities.ACFilters) ([]*entities.AcceptanceCriteriaEntity, error) {
+	acs, err := s.acRepo.ListACs(ctx, filters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ACs: %w", err)
+	}
+	return acs, nil
+}
+
+// ACTree groups the acceptance criteria matching filters, and status when set, by track
+// and task. Tracks follow the order of tracks, tasks the task list order and ACs their
+// creation order; tasks and tracks without matching ACs are left out. Tasks and ACs are
+// loaded with one query each.
+func (s *ACApplicationService) ACTree(ctx context.Context, tracks []*entities.TrackEntity, filters entities.ACFilters, status entities.AcceptanceCriteriaStatus) (*dto.ACTreeDTO, error) {
+	acs, err := s.ListACs(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+	tasks, err := s.taskRepo.ListTasks(ctx, entities.TaskFilters{TrackID: filters.TrackID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+
+	acsByTask := make(map[string][]*entities.AcceptanceCriteriaEntity)
+	for _, ac := range acs {
+		if status != "" && ac.Status != status {
+			continue
+		}
+		acsByTask[ac.TaskID] = append(acsByTask[ac.TaskID], ac)
+	}
+
+	tree := &dto.ACTreeDTO{Tracks: []dto.ACTreeTrackDTO{}}
+	trackIndex := make(map[string]int)
+	for _, track := range tracks {
+		if filters.TrackID != "" && track.ID != filters.TrackID {
+			continue
+		}
+		trackIndex[track.ID] = len(tree.Tracks)
+		tree.Tracks = append(tree.Tracks, dto.ACTreeTrackDTO{ID: track.ID, Title: track.Title, Tasks: []dto.ACTreeTaskDTO{}})
+	}
+
+	for _, task := range tasks {
+		taskACs := acsByTask[task.ID]
+		if len(taskACs) == 0 {
+			continue
+		}
+		i, ok := trackIndex[task.TrackID]
+		if !ok {
+			// Track outside the given tracks; listed after them by ID only
+			i = len(tree.Tracks)
+			trackIndex[task.TrackID] = i
+			tree.Tracks = append(tree.Tracks, dto.ACTreeTrackDTO{ID: task.TrackID, Tasks: []dto.ACTreeTaskDTO{}})
+		}
+
+		node := dto.ACTreeTaskDTO{ID: task.ID, Title: task.Title, Status: task.Status, Total: len(taskACs)}
+		for _, ac := range taskACs {
+			if ac.IsVerified() {
+				node.Verified++
+			}
+			node.ACs = append(node.ACs, dto.ACTreeACDTO{
+				ID:               ac.ID,
+				Description:      ac.Description,
+				VerificationType: string(ac.VerificationType),
+				Status:           string(ac.Status),
+				Notes:            ac.Notes,
+			})
+		}
+
+		track := &tree.Tracks[i]
+		track.Tasks = append(track.Tasks, node)
+		track.Verified += node.Verified
+		track.Total += node.Total
+		tree.Verified += node.Verified
+		tree.Total += node.Total
+	}
+
+	// Drop tracks without matching ACs
+	kept := tree.Tracks[:0]
+	for _, track := range tree.Tracks {
+		if track.Total > 0 {
+			kept = append(kept, track)
+		}
+	}
+	tree.Tracks = kept
+	return tree, nil
+}
+
+// ACCoverage reports the tasks matching filters that have no acceptance criteria, and the
+// done tasks with criteria still open (neither verified nor skipped), from a single query.
+// Only the tasks of roadmapID's tracks are counted unless it is empty. Cancelled tasks are
+// left out of both lists and of the coverage percentage.
+func (s *ACApplicationService) ACCoverage(ctx context.Context, filters entities.ACFilters, roadmapID string) (*dto.ACCoverageDTO, error) {
+	tasks, err := s.acRepo.ListTaskACCoverage(ctx, filters, roadmapID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list AC coverage: %w", err)
+	}
+
+	report := &dto.ACCoverageDTO{Coverage: 100, WithoutACs: []dto.ACCoverageTaskDTO{}, DoneUnverified: []dto.ACCoverageTaskDTO{}}
+	for _, task := range tasks {
+		if task.Status == string(entities.TaskStatusCancelled) {
+			continue
+		}
+		report.Tasks++
+
+		item := dto.ACCoverageTaskDTO{
+			ID:         task.TaskID,
+			TrackID:    task.TrackID,
+			Title:      task.Title,
+			Status:     task.Status,
+			ACs:        task.ACs.Total,
+			Unverified: task.ACs.Total - task.ACs.Done,
+		}
+		switch {
+		case task.ACs.Total == 0:
+			report.WithoutACs = append(report.WithoutACs, item)
+		case task.Status == string(entities.TaskStatusDone) && item.Unverified > 0:
+			report.DoneUnverified = append(report.DoneUnverified, item)
+		}
+	}
+
+	report.TasksWithACs = report.Tasks - len(report.WithoutACs)
+	if report.Tasks > 0 {
+		report.Coverage = float64(report.TasksWithACs) * 100 / float64(report.Tasks)
+	}
+	return report, nil
+}
+
+// FailureReasons groups the failed acceptance criteria matching filters by the reason
+// recorded in their notes, most frequent reason first. Fuzzy also groups near-duplicates.
+func (s *ACApplicationService) FailureReasons(ctx context.Context, filters entities.ACFilters, fuzzy bool) ([]entities.FailureReasonGroup, error) {
+	acs, err := s.ListFailedAC(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+	return entities.GroupFailureReasons(acs, fuzzy), nil
+}
+
+// ============================================================================
+// AC Templates
+// ============================================================================
+
+// CreateACTemplate creates a named, reusable set of acceptance criteria
+func (s *ACApplicationService) CreateACTemplate(ctx context.Context, input dto.CreateACTemplateDTO) (*entities.ACTemplateEntity, error) {
+	items := make([]entities.ACTemplateItem, 0, len(input.Items))
+	for _, item := range input.Items {
+		items = append(items, entities.ACTemplateItem{
+			Description:      item.Description,
+			VerificationType: entities.AcceptanceCriteriaVerificationType(item.VerificationType),
+		})
+	}
+
+	now := time.Now().UTC()
+	template, err := entities.NewACTemplateEntity(input.Name, items, now, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.acRepo.SaveACTemplate(ctx, template); err != nil {
+		return nil, fmt.Errorf("failed to save AC template: %w", err)
+	}
+
+	return template, nil
+}
+
+// GetACTemplate retrieves an AC template by name
+func (s *ACApplicationService) GetACTemplate(ctx context.Context, name string) (*entities.ACTemplateEntity, error) {
+	return s.acRepo.GetACTemplate(ctx, name)
+}
+
+// ListACTemplates returns all AC templates
+func (s *ACApplicationService) ListACTemplates(ctx context.Context) ([]*entities.ACTemplateEntity, error) {
+	return s.acRepo.ListACTemplates(ctx)
+}
+
+// DeleteACTemplate deletes an AC template. ACs already created from it are kept.
+func (s *ACApplicationService) DeleteACTemplate(ctx context.Context, name string) error {
+	return s.acRepo.DeleteACTemplate(ctx, name)
+}
+
+// ApplyACTemplate creates all acceptance criteria of a template on a task.
+// Each AC receives a newly generated ID, and all of them are saved in one transaction
+// when the service has a transactional repository. Returns the created ACs in template order.
+func (s *ACApplicationService) ApplyACTemplate(ctx context.Context, name, taskID string) ([]*entities.AcceptanceCriteriaEntity, error) {
+	template, err := s.acRepo.GetACTemplate(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get AC template: %w", err)
+	}
+
+	// Verify task exists before creating anything
+	if _, err := s.taskRepo.GetTask(ctx, taskID); err != nil {
+		return nil, fmt.Errorf("task not found: %w", err)
+	}
+
+	// The sequence is derived from stored IDs, so number the batch locally
+	ids, err := newEntityIDs(ctx, s.aggregateRepo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate AC ID: %w", err)
+	}
+	nextNum, err := s.aggregateRepo.GetNextSequenceNumber(ctx, "ac")
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate AC ID: %w", err)
+	}
+	now := time.Now().UTC()
+
+	created := make([]*entities.AcceptanceCriteriaEntity, 0, len(template.Items))
+	for _, item := range template.Items {
+		verificationType := entities.VerificationTypeManual
+		if item.VerificationType != "" {
+			verificationType = item.VerificationType
+		}
+		created = append(created, entities.NewAcceptanceCriteriaEntity(
+			ids.ID("ac", nextNum),
+			taskID,
+			item.Description,
+			verificationType,
+			"",
+			now,
+			now,
+		))
+		nextNum++
+	}
+
+	err = s.txRepo.WithTx(ctx, func(repo domain.RoadmapRepository) error {
+		for _, ac := range created {
+			if err := repo.SaveAC(ctx, ac); err != nil {
+				return fmt.Errorf("failed to save AC: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// ============================================================================
+// Bulk Import
+// ============================================================================
+
+// ImportACs creates acceptance criteria for several tasks at once.
+// Every entry and referenced task is validated before anything is written, errors name
+// the offending entry (e.g. "task DW-task-3, ac[2]: missing description"), and all ACs
+// are saved in one transaction. Returns the created ACs in input order.
+func (s *ACApplicationService) ImportACs(ctx context.Context, input dto.ImportACsDTO) ([]*entities.AcceptanceCriteriaEntity, error) {
+	if len(input.Tasks) == 0 {
+		return nil, fmt.Errorf("%w: no acceptance criteria to import", pluginsdk.ErrInvalidArgument)
+	}
+
+	// Validate entries and tasks before generating IDs
+	for _, task := range input.Tasks {
+		if task.TaskID == "" {
+			return nil, fmt.Errorf("%w: task ID must not be empty", pluginsdk.ErrInvalidArgument)
+		}
+		if len(task.ACs) == 0 {
+			return nil, fmt.Errorf("%w: task %s: no acceptance criteria", pluginsdk.ErrInvalidArgument, task.TaskID)
+		}
+		for i, item := range task.ACs {
+			if strings.TrimSpace(item.Description) == "" {
+				return nil, fmt.Errorf("%w: task %s, ac[%d]: missing description", pluginsdk.ErrInvalidArgument, task.TaskID, i)
+			}
+			if item.VerificationType != "" && !entities.IsValidVerificationType(item.VerificationType) {
+				return nil, fmt.Errorf("%w: task %s, ac[%d]: invalid type %q (must be manual or automated)", pluginsdk.ErrInvalidArgument, task.TaskID, i, item.VerificationType)
+			}
+		}
+		if _, err := s.taskRepo.GetTask(ctx, task.TaskID); err != nil {
+			return nil, fmt.Errorf("task %s: %w", task.TaskID, err)
+		}
+	}
+
+	// The sequence is derived from stored IDs, so number the batch locally
+	ids, err := newEntityIDs(ctx, s.aggregateRepo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate AC ID: %w", err)
+	}
+	nextNum, err := s.aggregateRepo.GetNextSequenceNumber(ctx, "ac")
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate AC ID: %w", err)
+	}
+	now := time.Now().UTC()
+
+	var acs []*entities.AcceptanceCriteriaEntity
+	for _, task := range input.Tasks {
+		for _, item := range task.ACs {
+			verificationType := entities.VerificationTypeManual
+			if item.VerificationType != "" {
+				verificationType = entities.AcceptanceCriteriaVerificationType(item.VerificationType)
+			}
+
+			// ACs have no command field; keep the command with the testing instructions
+			instructions := item.TestingInstructions
+			if item.Command != "" {
+				if instructions != "" {
+					instructions += "\n\n"
+				}
+				instructions += "Command: " + item.Command
+			}
+
+			acs = append(acs, entities.NewAcceptanceCriteriaEntity(
+				ids.ID("ac", nextNum),
+				task.TaskID,
+				item.Description,
+				verificationType,
+				instructions,
+				now,
+				now,
+			))
+			nextNum++
+		}
+	}
+
+	if err := s.acRepo.SaveACs(ctx, acs); err != nil {
+		return nil, fmt.Errorf("failed to save ACs: %w", err)
+	}
+
+	return acs, nil
+}
@@ -2,6 +2,9 @@ package application_test
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -9,6 +12,7 @@ import (
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application/dto"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application/mocks"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/services"
 )
@@ -17,10 +21,16 @@ import (
 func setupACTestService(t *testing.T) (*application.ACApplicationService, context.Context, *mocks.MockAcceptanceCriteriaRepository, *mocks.MockTaskRepository, *mocks.MockAggregateRepository) {
 	mockACRepo := &mocks.MockAcceptanceCriteriaRepository{}
 	mockTaskRepo := &mocks.MockTaskRepository{}
+	mockIterationRepo := &mocks.MockIterationRepository{
+		GetIterationFunc: func(ctx context.Context, number int) (*entities.IterationEntity, error) {
+			return nil, fmt.Errorf("%w: iteration %d not found", pluginsdk.ErrNotFound, number)
+		},
+	}
 	mockAggregateRepo := &mocks.MockAggregateRepository{}
+	txRepo := &mocks.MockTransactionalRepository{ACs: mockACRepo, Tasks: mockTaskRepo, Iterations: mockIterationRepo, Aggregates: mockAggregateRepo}
 	validationService := services.NewValidationService()
 
-	service := application.NewACApplicationService(mockACRepo, mockTaskRepo, mockAggregateRepo, validationService)
+	service := application.NewACApplicationService(mockACRepo, mockTaskRepo, mockIterationRepo, mockAggregateRepo, txRepo, validationService)
 	ctx := context.Background()
 
 	return service, ctx, mockACRepo, mockTaskRepo, mockAggregateRepo
@@ -308,6 +318,66 @@ func TestACService_VerifyAC_Success(t *testing.T) {
 }
 
 // TestACService_VerifyAC_NotFound tests verifying non-existent AC
+func TestACService_VerifyAC_Requirements(t *testing.T) {
+	service, ctx, mockACRepo, _, _ := setupACTestService(t)
+
+	documented := createTestACEntity(t, "TM-ac-1", "TM-task-1")
+	undocumented := createTestACEntity(t, "TM-ac-2", "TM-task-1")
+	undocumented.TestingInstructions = "  "
+	stored := map[string]*entities.AcceptanceCriteriaEntity{documented.ID: documented, undocumented.ID: undocumented}
+
+	mockACRepo.GetACFunc = func(ctx context.Context, id string) (*entities.AcceptanceCriteriaEntity, error) {
+		if ac, ok := stored[id]; ok {
+			return ac, nil
+		}
+		return nil, pluginsdk.ErrNotFound
+	}
+	var updated *entities.AcceptanceCriteriaEntity
+	mockACRepo.UpdateACFunc = func(ctx context.Context, ac *entities.AcceptanceCriteriaEntity) error {
+		updated = ac
+		return nil
+	}
+
+	tests := []struct {
+		name    string
+		input   dto.VerifyACDTO
+		wantErr string
+	}{
+		{"instructions not required", dto.VerifyACDTO{ID: undocumented.ID}, ""},
+		{"missing instructions", dto.VerifyACDTO{ID: undocumented.ID, RequireInstructions: true}, "ac update TM-ac-2 --testing-instructions"},
+		{"documented", dto.VerifyACDTO{ID: documented.ID, RequireInstructions: true}, ""},
+		{"missing notes", dto.VerifyACDTO{ID: documented.ID, RequireInstructions: true, RequireNotes: true, Notes: " "}, "requires --notes"},
+		{"with notes", dto.VerifyACDTO{ID: documented.ID, RequireInstructions: true, RequireNotes: true, Notes: "screenshot in PR"}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updated = nil
+			tt.input.VerifiedBy = "test-user"
+			tt.input.VerifiedAt = "now"
+			err := service.VerifyAC(ctx, tt.input)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("VerifyAC() failed: %v", err)
+				}
+				if updated == nil || updated.Status != entities.ACStatusVerified {
+					t.Errorf("AC %s should be verified", tt.input.ID)
+				}
+				return
+			}
+			if !errors.Is(err, pluginsdk.ErrInvalidArgument) || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("VerifyAC() error = %v, want ErrInvalidArgument containing %q", err, tt.wantErr)
+			}
+			if updated != nil {
+				t.Errorf("a rejected verification must not update the AC")
+			}
+		})
+	}
+
+	if !strings.Contains(documented.Notes, "evidence: screenshot in PR") {
+		t.Errorf("notes should record the evidence, got %q", documented.Notes)
+	}
+}
+
 func TestACService_VerifyAC_NotFound(t *testing.T) {
 	service, ctx, mockACRepo, _, _ := setupACTestService(t)
 
@@ -730,3 +800,692 @@ func TestACService_ListFailedAC_Empty(t *testing.T) {
 		t.Fatalf("ListFailedAC() returned %d ACs, want 0", len(acs))
 	}
 }
+
+// TestACService_FailureReasons tests grouping failed ACs by reason with the given filters
+func TestACService_FailureReasons(t *testing.T) {
+	service, ctx, mockACRepo, _, _ := setupACTestService(t)
+
+	var got entities.ACFilters
+	mockACRepo.ListFailedACFunc = func(ctx context.Context, filters entities.ACFilters) ([]*entities.AcceptanceCriteriaEntity, error) {
+		got = filters
+		acs := make([]*entities.AcceptanceCriteriaEntity, 0, 3)
+		for i, notes := range []string{"Flaky on Safari", "Missing docs", "flaky on safari"} {
+			ac := createTestACEntity(t, fmt.Sprintf("TM-ac-%d", i+1), "TM-task-1")
+			ac.Status = entities.ACStatusFailed
+			ac.Notes = notes
+			acs = append(acs, ac)
+		}
+		return acs, nil
+	}
+
+	groups, err := service.FailureReasons(ctx, entities.ACFilters{TrackID: "TM-track-1"}, false)
+	if err != nil {
+		t.Fatalf("FailureReasons() failed: %v", err)
+	}
+
+	if got.TrackID != "TM-track-1" {
+		t.Errorf("expected filters to be passed through, got %+v", got)
+	}
+	if len(groups) != 2 || groups[0].Reason != "Flaky on Safari" || groups[0].Count != 2 {
+		t.Errorf("expected 'Flaky on Safari' x2 first, got %+v", groups)
+	}
+}
+
+func TestACService_ACTree(t *testing.T) {
+	service, ctx, mockACRepo, mockTaskRepo, _ := setupACTestService(t)
+	now := time.Now().UTC()
+
+	var gotFilters entities.ACFilters
+	mockACRepo.ListACsFunc = func(ctx context.Context, filters entities.ACFilters) ([]*entities.AcceptanceCriteriaEntity, error) {
+		gotFilters = filters
+		statuses := map[string]entities.AcceptanceCriteriaStatus{
+			"TM-ac-1": entities.ACStatusVerified,
+			"TM-ac-2": entities.ACStatusFailed,
+			"TM-ac-3": entities.ACStatusAutomaticallyVerified,
+			"TM-ac-4": entities.ACStatusNotStarted,
+		}
+		taskOf := map[string]string{"TM-ac-1": "TM-task-1", "TM-ac-2": "TM-task-1", "TM-ac-3": "TM-task-2", "TM-ac-4": "TM-task-3"}
+		var acs []*entities.AcceptanceCriteriaEntity
+		for _, id := range []string{"TM-ac-1", "TM-ac-2", "TM-ac-3", "TM-ac-4"} {
+			ac := createTestACEntity(t, id, taskOf[id])
+			ac.Status = statuses[id]
+			acs = append(acs, ac)
+		}
+		return acs, nil
+	}
+	mockTaskRepo.ListTasksFunc = func(ctx context.Context, filters entities.TaskFilters) ([]*entities.TaskEntity, error) {
+		var tasks []*entities.TaskEntity
+		for _, ids := range [][2]string{{"TM-task-1", "TM-track-1"}, {"TM-task-2", "TM-track-2"}, {"TM-task-3", "TM-track-2"}, {"TM-task-4", "TM-track-1"}} {
+			task, _ := entities.NewTaskEntity(ids[0], ids[1], "Task "+ids[0], "", "todo", 500, "", now, now)
+			tasks = append(tasks, task)
+		}
+		return tasks, nil
+	}
+	var tracks []*entities.TrackEntity
+	for _, id := range []string{"TM-track-2", "TM-track-1", "TM-track-3"} {
+		track, _ := entities.NewTrackEntity(id, "roadmap-1", "Track "+id, "", "not-started", 500, []string{}, now, now)
+		tracks = append(tracks, track)
+	}
+
+	iteration := 2
+	tree, err := service.ACTree(ctx, tracks, entities.ACFilters{IterationNum: &iteration}, "")
+	if err != nil {
+		t.Fatalf("ACTree() failed: %v", err)
+	}
+
+	if gotFilters.IterationNum == nil || *gotFilters.IterationNum != 2 {
+		t.Errorf("expected filters to be passed through, got %+v", gotFilters)
+	}
+	if tree.Verified != 2 || tree.Total != 4 {
+		t.Errorf("expected 2/4 verified, got %d/%d", tree.Verified, tree.Total)
+	}
+	// Tracks keep the given order; TM-track-3 has no ACs and TM-task-4 has none either
+	if len(tree.Tracks) != 2 || tree.Tracks[0].ID != "TM-track-2" || tree.Tracks[1].ID != "TM-track-1" {
+		t.Fatalf("expected tracks TM-track-2, TM-track-1, got %+v", tree.Tracks)
+	}
+	if track := tree.Tracks[0]; track.Verified != 1 || track.Total != 2 || len(track.Tasks) != 2 {
+		t.Errorf("expected TM-track-2 with 1/2 verified in 2 tasks, got %+v", track)
+	}
+	if track := tree.Tracks[1]; track.Verified != 1 || track.Total != 2 || len(track.Tasks) != 1 || len(track.Tasks[0].ACs) != 2 {
+		t.Errorf("expected TM-track-1 with 1/2 verified in 1 task, got %+v", track)
+	}
+
+	// A status filter keeps only matching ACs
+	tree, err = service.ACTree(ctx, tracks, entities.ACFilters{}, entities.ACStatusFailed)
+	if err != nil {
+		t.Fatalf("ACTree() failed: %v", err)
+	}
+	if tree.Total != 1 || len(tree.Tracks) != 1 || tree.Tracks[0].Tasks[0].ACs[0].ID != "TM-ac-2" {
+		t.Errorf("expected only TM-ac-2, got %+v", tree)
+	}
+}
+
+func TestACService_ACCoverage(t *testing.T) {
+	service, ctx, mockACRepo, _, _ := setupACTestService(t)
+
+	var gotRoadmapID string
+	mockACRepo.ListTaskACCoverageFunc = func(ctx context.Context, filters entities.ACFilters, roadmapID string) ([]*entities.TaskACCoverage, error) {
+		gotRoadmapID = roadmapID
+		return []*entities.TaskACCoverage{
+			{TaskID: "TM-task-1", Status: "done", ACs: entities.ACProgress{Total: 2, Done: 2}},
+			{TaskID: "TM-task-2", Status: "done", ACs: entities.ACProgress{Total: 3, Done: 1, Failed: 1}},
+			{TaskID: "TM-task-3", Status: "todo", ACs: entities.ACProgress{Total: 1}},
+			{TaskID: "TM-task-4", Status: "done"},
+			{TaskID: "TM-task-5", Status: "cancelled"},
+		}, nil
+	}
+
+	report, err := service.ACCoverage(ctx, entities.ACFilters{}, "roadmap-1")
+	if err != nil {
+		t.Fatalf("ACCoverage() failed: %v", err)
+	}
+	if gotRoadmapID != "roadmap-1" {
+		t.Errorf("expected tasks of roadmap-1 to be queried, got %q", gotRoadmapID)
+	}
+	// Cancelled tasks don't count
+	if report.Tasks != 4 || report.TasksWithACs != 3 || report.Coverage != 75 {
+		t.Errorf("expected 3/4 tasks with ACs (75%%), got %d/%d (%v%%)", report.TasksWithACs, report.Tasks, report.Coverage)
+	}
+	if len(report.WithoutACs) != 1 || report.WithoutACs[0].ID != "TM-task-4" {
+		t.Errorf("expected TM-task-4 without ACs, got %+v", report.WithoutACs)
+	}
+	// Open ACs of unfinished tasks are expected; only done tasks are reported
+	if len(report.DoneUnverified) != 1 || report.DoneUnverified[0].ID != "TM-task-2" || report.DoneUnverified[0].Unverified != 2 {
+		t.Errorf("expected TM-task-2 with 2 unverified ACs, got %+v", report.DoneUnverified)
+	}
+
+	mockACRepo.ListTaskACCoverageFunc = nil
+	report, err = service.ACCoverage(ctx, entities.ACFilters{}, "")
+	if err != nil || report.Tasks != 0 || report.Coverage != 100 {
+		t.Errorf("expected full coverage without tasks, got %+v, %v", report, err)
+	}
+}
+
+// ============================================================================
+// AC Template Tests
+// ============================================================================
+
+// TestACService_CreateACTemplate_DefaultsAndValidation tests template creation rules
+func TestACService_CreateACTemplate_DefaultsAndValidation(t *testing.T) {
+	service, ctx, mockACRepo, _, _ := setupACTestService(t)
+
+	var saved *entities.ACTemplateEntity
+	mockACRepo.SaveACTemplateFunc = func(ctx context.Context, template *entities.ACTemplateEntity) error {
+		saved = template
+		return nil
+	}
+
+	template, err := service.CreateACTemplate(ctx, dto.CreateACTemplateDTO{
+		Name: "dod",
+		Items: []dto.ACTemplateItemDTO{
+			{Description: "Has tests", VerificationType: "automated"},
+			{Description: "Docs updated"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateACTemplate() failed: %v", err)
+	}
+	if saved != template {
+		t.Error("expected template to be saved")
+	}
+	if template.Items[0].VerificationType != entities.VerificationTypeAutomated {
+		t.Errorf("Items[0].VerificationType = %q, want automated", template.Items[0].VerificationType)
+	}
+	if template.Items[1].VerificationType != entities.VerificationTypeManual {
+		t.Errorf("Items[1].VerificationType = %q, want manual", template.Items[1].VerificationType)
+	}
+
+	invalid := []dto.CreateACTemplateDTO{
+		{Name: "", Items: []dto.ACTemplateItemDTO{{Description: "x"}}},
+		{Name: "empty"},
+		{Name: "bad-type", Items: []dto.ACTemplateItemDTO{{Description: "x", VerificationType: "robot"}}},
+	}
+	for _, input := range invalid {
+		if _, err := service.CreateACTemplate(ctx, input); err == nil {
+			t.Errorf("CreateACTemplate(%q) should fail", input.Name)
+		}
+	}
+}
+
+// TestACService_ApplyACTemplate tests that applying a template creates one AC per entry
+func TestACService_ApplyACTemplate(t *testing.T) {
+	service, ctx, mockACRepo, mockTaskRepo, _ := setupACTestService(t)
+
+	task := createTestTaskEntityForAC(t, "TM-task-1")
+	mockTaskRepo.GetTaskFunc = func(ctx context.Context, id string) (*entities.TaskEntity, error) {
+		if id == "TM-task-1" {
+			return task, nil
+		}
+		return nil, pluginsdk.ErrNotFound
+	}
+
+	now := time.Now().UTC()
+	template, err := entities.NewACTemplateEntity("dod", []entities.ACTemplateItem{
+		{Description: "Has tests", VerificationType: entities.VerificationTypeAutomated},
+		{Description: "Reviewed"},
+	}, now, now)
+	if err != nil {
+		t.Fatalf("failed to create template: %v", err)
+	}
+	mockACRepo.GetACTemplateFunc = func(ctx context.Context, name string) (*entities.ACTemplateEntity, error) {
+		if name == "dod" {
+			return template, nil
+		}
+		return nil, pluginsdk.ErrNotFound
+	}
+
+	var savedACs []*entities.AcceptanceCriteriaEntity
+	mockACRepo.SaveACFunc = func(ctx context.Context, ac *entities.AcceptanceCriteriaEntity) error {
+		savedACs = append(savedACs, ac)
+		return nil
+	}
+
+	acs, err := service.ApplyACTemplate(ctx, "dod", "TM-task-1")
+	if err != nil {
+		t.Fatalf("ApplyACTemplate() failed: %v", err)
+	}
+	if len(acs) != 2 || len(savedACs) != 2 {
+		t.Fatalf("expected 2 ACs created, got %d (saved %d)", len(acs), len(savedACs))
+	}
+	if acs[0].VerificationType != entities.VerificationTypeAutomated {
+		t.Errorf("acs[0].VerificationType = %q, want automated", acs[0].VerificationType)
+	}
+	if acs[1].TaskID != "TM-task-1" || acs[1].Description != "Reviewed" {
+		t.Errorf("unexpected second AC: %+v", acs[1])
+	}
+
+	// Unknown task: nothing is created
+	savedACs = nil
+	if _, err := service.ApplyACTemplate(ctx, "dod", "TM-task-99"); err == nil {
+		t.Error("ApplyACTemplate() should fail for unknown task")
+	}
+	if len(savedACs) != 0 {
+		t.Errorf("expected no ACs saved for unknown task, got %d", len(savedACs))
+	}
+
+	// Unknown template
+	if _, err := service.ApplyACTemplate(ctx, "missing", "TM-task-1"); err == nil {
+		t.Error("ApplyACTemplate() should fail for unknown template")
+	}
+}
+
+// stagingACTxRepository is a TransactionalRepository that stages AC saves and only
+// commits them when the closure succeeds
+type stagingACTxRepository struct {
+	failOnSave int // 1-based save that fails, 0 for none
+	committed  []string
+}
+
+// stagedACRepository records SaveAC calls; other methods are not used by ApplyACTemplate
+type stagedACRepository struct {
+	domain.RoadmapRepository
+	parent *stagingACTxRepository
+	staged []string
+}
+
+func (r *stagedACRepository) SaveAC(ctx context.Context, ac *entities.AcceptanceCriteriaEntity) error {
+	if len(r.staged)+1 == r.parent.failOnSave {
+		return errors.New("disk I/O error")
+	}
+	r.staged = append(r.staged, ac.ID)
+	return nil
+}
+
+func (r *stagingACTxRepository) WithTx(ctx context.Context, fn func(domain.RoadmapRepository) error) error {
+	view := &stagedACRepository{parent: r}
+	if err := fn(view); err != nil {
+		return err
+	}
+	r.committed = append(r.committed, view.staged...)
+	return nil
+}
+
+// TestACService_ApplyACTemplate_Transactional tests that a template's ACs are saved in one
+// transaction and that a failure part way through leaves no AC created
+func TestACService_ApplyACTemplate_Transactional(t *testing.T) {
+	for _, failOnSave := range []int{0, 2} {
+		t.Run(fmt.Sprintf("fail on save %d", failOnSave), func(t *testing.T) {
+			mockACRepo := &mocks.MockAcceptanceCriteriaRepository{}
+			mockTaskRepo := &mocks.MockTaskRepository{}
+			txRepo := &stagingACTxRepository{failOnSave: failOnSave}
+			service := application.NewACApplicationService(mockACRepo, mockTaskRepo, &mocks.MockIterationRepository{},
+				&mocks.MockAggregateRepository{}, txRepo, services.NewValidationService())
+			ctx := context.Background()
+
+			mockTaskRepo.GetTaskFunc = func(ctx context.Context, id string) (*entities.TaskEntity, error) {
+				return createTestTaskEntityForAC(t, id), nil
+			}
+			now := time.Now().UTC()
+			template, err := entities.NewACTemplateEntity("dod", []entities.ACTemplateItem{
+				{Description: "Has tests"}, {Description: "Reviewed"}, {Description: "Documented"},
+			}, now, now)
+			if err != nil {
+				t.Fatalf("failed to create template: %v", err)
+			}
+			mockACRepo.GetACTemplateFunc = func(ctx context.Context, name string) (*entities.ACTemplateEntity, error) {
+				return template, nil
+			}
+			mockACRepo.SaveACFunc = func(ctx context.Context, ac *entities.AcceptanceCriteriaEntity) error {
+				t.Errorf("expected saves to go through the transaction, got direct save of %s", ac.ID)
+				return nil
+			}
+
+			acs, err := service.ApplyACTemplate(ctx, "dod", "TM-task-1")
+			if failOnSave == 0 {
+				if err != nil {
+					t.Fatalf("ApplyACTemplate() failed: %v", err)
+				}
+				if len(acs) != 3 || len(txRepo.committed) != 3 {
+					t.Fatalf("expected 3 ACs created and committed, got %d and %v", len(acs), txRepo.committed)
+				}
+				if acs[0].ID == acs[1].ID || acs[1].ID == acs[2].ID {
+					t.Errorf("expected distinct AC IDs, got %s, %s, %s", acs[0].ID, acs[1].ID, acs[2].ID)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("expected ApplyACTemplate() to fail")
+			}
+			if len(txRepo.committed) != 0 {
+				t.Errorf("expected no AC committed after a failed save, got %v", txRepo.committed)
+			}
+		})
+	}
+}
+
+// TestACService_ImportACs tests bulk import validation, ID generation and the single save
+func TestACService_ImportACs(t *testing.T) {
+	service, ctx, mockACRepo, mockTaskRepo, _ := setupACTestService(t)
+
+	mockTaskRepo.GetTaskFunc = func(ctx context.Context, id string) (*entities.TaskEntity, error) {
+		if id == "TM-task-1" || id == "TM-task-2" {
+			return createTestTaskEntityForAC(t, id), nil
+		}
+		return nil, pluginsdk.ErrNotFound
+	}
+
+	var saveCalls int
+	var savedACs []*entities.AcceptanceCriteriaEntity
+	mockACRepo.SaveACsFunc = func(ctx context.Context, acs []*entities.AcceptanceCriteriaEntity) error {
+		saveCalls++
+		savedACs = acs
+		return nil
+	}
+
+	acs, err := service.ImportACs(ctx, dto.ImportACsDTO{Tasks: []dto.ImportACTaskDTO{
+		{TaskID: "TM-task-1", ACs: []dto.ImportACItemDTO{
+			{Description: "Tests pass", VerificationType: "automated", TestingInstructions: "Run the suite", Command: "go test ./..."},
+			{Description: "Docs updated"},
+		}},
+		{TaskID: "TM-task-2", ACs: []dto.ImportACItemDTO{{Description: "Reviewed"}}},
+	}})
+	if err != nil {
+		t.Fatalf("ImportACs() failed: %v", err)
+	}
+	if saveCalls != 1 || len(savedACs) != 3 || len(acs) != 3 {
+		t.Fatalf("expected 3 ACs saved in one call, got %d ACs in %d calls", len(savedACs), saveCalls)
+	}
+	if acs[0].ID != "TM-ac-1" || acs[1].ID != "TM-ac-2" || acs[2].ID != "TM-ac-3" {
+		t.Errorf("expected sequential IDs TM-ac-1..3, got %s, %s, %s", acs[0].ID, acs[1].ID, acs[2].ID)
+	}
+	if acs[0].VerificationType != entities.VerificationTypeAutomated || acs[1].VerificationType != entities.VerificationTypeManual {
+		t.Errorf("unexpected verification types: %q, %q", acs[0].VerificationType, acs[1].VerificationType)
+	}
+	if acs[0].TestingInstructions != "Run the suite\n\nCommand: go test ./..." {
+		t.Errorf("expected command appended to testing instructions, got %q", acs[0].TestingInstructions)
+	}
+	if acs[2].TaskID != "TM-task-2" {
+		t.Errorf("acs[2].TaskID = %q, want TM-task-2", acs[2].TaskID)
+	}
+
+	// Invalid entries and unknown tasks abort before anything is saved
+	tests := []struct {
+		name    string
+		input   dto.ImportACsDTO
+		wantErr string
+	}{
+		{"missing description", dto.ImportACsDTO{Tasks: []dto.ImportACTaskDTO{
+			{TaskID: "TM-task-1", ACs: []dto.ImportACItemDTO{{Description: "ok"}, {Description: "ok"}, {Description: " "}}},
+		}}, "task TM-task-1, ac[2]: missing description"},
+		{"invalid type", dto.ImportACsDTO{Tasks: []dto.ImportACTaskDTO{
+			{TaskID: "TM-task-1", ACs: []dto.ImportACItemDTO{{Description: "ok", VerificationType: "robot"}}},
+		}}, "task TM-task-1, ac[0]: invalid type"},
+		{"unknown task", dto.ImportACsDTO{Tasks: []dto.ImportACTaskDTO{
+			{TaskID: "TM-task-1", ACs: []dto.ImportACItemDTO{{Description: "ok"}}},
+			{TaskID: "TM-task-99", ACs: []dto.ImportACItemDTO{{Description: "ok"}}},
+		}}, "task TM-task-99"},
+		{"empty", dto.ImportACsDTO{}, "no acceptance criteria"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			saveCalls = 0
+			_, err := service.ImportACs(ctx, tt.input)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+			if saveCalls != 0 {
+				t.Errorf("expected nothing saved, got %d save calls", saveCalls)
+			}
+		})
+	}
+}
+
+// TestACService_ResetACs tests bulk reset, skipping, note handling and the audit note
+func TestACService_ResetACs(t *testing.T) {
+	service, ctx, mockACRepo, mockTaskRepo, _ := setupACTestService(t)
+
+	verified := createTestACEntity(t, "TM-ac-1", "TM-task-1")
+	verified.Status = entities.ACStatusVerified
+	verified.Notes = "Verified by: user at now"
+	failed := createTestACEntity(t, "TM-ac-2", "TM-task-1")
+	failed.Status = entities.ACStatusFailed
+	failed.Notes = "Breaks on empty input"
+	untouched := createTestACEntity(t, "TM-ac-3", "TM-task-1")
+
+	mockTaskRepo.GetTaskFunc = func(ctx context.Context, id string) (*entities.TaskEntity, error) {
+		return createTestTaskEntityForAC(t, id), nil
+	}
+	mockACRepo.ListACFunc = func(ctx context.Context, taskID string) ([]*entities.AcceptanceCriteriaEntity, error) {
+		return []*entities.AcceptanceCriteriaEntity{verified, failed, untouched}, nil
+	}
+	var updated []string
+	mockACRepo.UpdateACFunc = func(ctx context.Context, ac *entities.AcceptanceCriteriaEntity) error {
+		updated = append(updated, ac.ID)
+		return nil
+	}
+	var notes []*entities.TaskNoteEntity
+	mockTaskRepo.SaveTaskNoteFunc = func(ctx context.Context, note *entities.TaskNoteEntity) error {
+		notes = append(notes, note)
+		return nil
+	}
+
+	reset, skipped, err := service.ResetACs(ctx, dto.ResetACsDTO{TaskID: "TM-task-1", KeepNotes: true})
+	if err != nil {
+		t.Fatalf("ResetACs() failed: %v", err)
+	}
+	if len(reset) != 2 || skipped != 1 {
+		t.Fatalf("expected 2 reset and 1 skipped, got %d and %d", len(reset), skipped)
+	}
+	if strings.Join(updated, ",") != "TM-ac-1,TM-ac-2" {
+		t.Errorf("expected only changed ACs updated, got %v", updated)
+	}
+	if verified.Status != entities.ACStatusNotStarted || failed.Status != entities.ACStatusNotStarted {
+		t.Errorf("expected ACs reset to not_started, got %s and %s", verified.Status, failed.Status)
+	}
+	if failed.Notes != "Breaks on empty input" {
+		t.Errorf("expected notes kept with KeepNotes, got %q", failed.Notes)
+	}
+	if len(notes) != 1 || notes[0].TaskID != "TM-task-1" ||
+		!strings.Contains(notes[0].Content, "TM-ac-1 (was verified)") || !strings.Contains(notes[0].Content, "TM-ac-2 (was failed)") {
+		t.Errorf("expected one task note recording the reset, got %+v", notes)
+	}
+
+	// Single AC without KeepNotes clears notes
+	skippedAC := createTestACEntity(t, "TM-ac-4", "TM-task-2")
+	skippedAC.Status = entities.ACStatusSkipped
+	skippedAC.Notes = "Not applicable"
+	mockACRepo.GetACFunc = func(ctx context.Context, id string) (*entities.AcceptanceCriteriaEntity, error) {
+		return skippedAC, nil
+	}
+	if _, _, err := service.ResetACs(ctx, dto.ResetACsDTO{ID: "TM-ac-4"}); err != nil {
+		t.Fatalf("ResetACs() failed: %v", err)
+	}
+	if skippedAC.Status != entities.ACStatusNotStarted || skippedAC.Notes != "" {
+		t.Errorf("expected not_started with cleared notes, got %s / %q", skippedAC.Status, skippedAC.Notes)
+	}
+
+	// Exactly one selector is required
+	iteration := 2
+	for _, input := range []dto.ResetACsDTO{{}, {ID: "TM-ac-1", IterationNum: &iteration}} {
+		if _, _, err := service.ResetACs(ctx, input); !errors.Is(err, pluginsdk.ErrInvalidArgument) {
+			t.Errorf("ResetACs(%+v) error = %v, want ErrInvalidArgument", input, err)
+		}
+	}
+
+	// Unknown iteration is reported as not found
+	missing := 99
+	if _, _, err := service.ResetACs(ctx, dto.ResetACsDTO{IterationNum: &missing}); !errors.Is(err, pluginsdk.ErrNotFound) {
+		t.Errorf("ResetACs(iteration %d) error = %v, want ErrNotFound", missing, err)
+	}
+}
+
+// TestACService_VerifyAutomatedACs tests bulk automated verification of a track's ACs
+func TestACService_VerifyAutomatedACs(t *testing.T) {
+	service, ctx, mockACRepo, mockTaskRepo, _ := setupACTestService(t)
+
+	automated := func(id, taskID string, status entities.AcceptanceCriteriaStatus) *entities.AcceptanceCriteriaEntity {
+		ac := createTestACEntity(t, id, taskID)
+		ac.VerificationType = entities.VerificationTypeAutomated
+		ac.Status = status
+		return ac
+	}
+	acsByTask := map[string][]*entities.AcceptanceCriteriaEntity{
+		"TM-task-1": {
+			automated("TM-ac-1", "TM-task-1", entities.ACStatusNotStarted),
+			automated("TM-ac-2", "TM-task-1", entities.ACStatusFailed),
+			createTestACEntity(t, "TM-ac-3", "TM-task-1"),
+		},
+		"TM-task-2": {
+			automated("TM-ac-4", "TM-task-2", entities.ACStatusVerified),
+			automated("TM-ac-5", "TM-task-2", entities.ACStatusSkipped),
+			automated("TM-ac-6", "TM-task-2", entities.ACStatusPendingHumanReview),
+		},
+	}
+
+	mockTaskRepo.ListTasksFunc = func(ctx context.Context, filters entities.TaskFilters) ([]*entities.TaskEntity, error) {
+		if filters.TrackID != "TM-track-1" {
+			t.Errorf("expected tasks of TM-track-1, got %q", filters.TrackID)
+		}
+		return []*entities.TaskEntity{createTestTaskEntityForAC(t, "TM-task-1"), createTestTaskEntityForAC(t, "TM-task-2")}, nil
+	}
+	mockTaskRepo.GetTaskFunc = func(ctx context.Context, id string) (*entities.TaskEntity, error) {
+		return createTestTaskEntityForAC(t, id), nil
+	}
+	mockACRepo.ListACFunc = func(ctx context.Context, taskID string) ([]*entities.AcceptanceCriteriaEntity, error) {
+		return acsByTask[taskID], nil
+	}
+	// One failing update must not stop the rest
+	mockACRepo.UpdateACFunc = func(ctx context.Context, ac *entities.AcceptanceCriteriaEntity) error {
+		if ac.ID == "TM-ac-2" {
+			return errors.New("database is locked")
+		}
+		return nil
+	}
+
+	result, err := service.VerifyAutomatedACs(ctx, dto.VerifyAutomatedACsDTO{TrackID: "TM-track-1"})
+	if err != nil {
+		t.Fatalf("VerifyAutomatedACs() failed: %v", err)
+	}
+	if strings.Join(result.VerifiedIDs, ",") != "TM-ac-1,TM-ac-6" {
+		t.Errorf("expected TM-ac-1 and TM-ac-6 verified, got %v", result.VerifiedIDs)
+	}
+	if result.SkippedManual != 1 || result.SkippedTerminal != 2 {
+		t.Errorf("expected 1 manual and 2 terminal skipped, got %d and %d", result.SkippedManual, result.SkippedTerminal)
+	}
+	if len(result.Failed) != 1 || result.Failed[0].ID != "TM-ac-2" {
+		t.Errorf("expected TM-ac-2 to be reported as failed, got %+v", result.Failed)
+	}
+	if status := acsByTask["TM-task-1"][0].Status; status != entities.ACStatusAutomaticallyVerified {
+		t.Errorf("expected automatically_verified, got %s", status)
+	}
+
+	// A task outside the given track is rejected
+	_, err = service.VerifyAutomatedACs(ctx, dto.VerifyAutomatedACsDTO{TrackID: "TM-track-9", TaskID: "TM-task-1"})
+	if !errors.Is(err, pluginsdk.ErrInvalidArgument) {
+		t.Errorf("expected ErrInvalidArgument for task outside track, got %v", err)
+	}
+
+	// A track or task is required
+	if _, err := service.VerifyAutomatedACs(ctx, dto.VerifyAutomatedACsDTO{}); !errors.Is(err, pluginsdk.ErrInvalidArgument) {
+		t.Errorf("expected ErrInvalidArgument without selector, got %v", err)
+	}
+}
+
+func TestACService_CreateAC_WithTags(t *testing.T) {
+	service, ctx, mockACRepo, mockTaskRepo, _ := setupACTestService(t)
+
+	mockTaskRepo.GetTaskFunc = func(ctx context.Context, id string) (*entities.TaskEntity, error) {
+		return createTestTaskEntityForAC(t, id), nil
+	}
+	var added []string
+	mockACRepo.AddACTagFunc = func(ctx context.Context, acID, tag string) error {
+		added = append(added, acID+":"+tag)
+		return nil
+	}
+
+	_, err := service.CreateAC(ctx, dto.CreateACDTO{
+		TaskID:      "TM-task-1",
+		Description: "Docs published",
+		Tags:        []string{" Auto-On-Complete", "auto-on-complete", "docs"},
+	})
+	if err != nil {
+		t.Fatalf("CreateAC() failed: %v", err)
+	}
+	if strings.Join(added, ",") != "TM-ac-1:auto-on-complete,TM-ac-1:docs" {
+		t.Errorf("expected normalized, deduplicated tags, got %v", added)
+	}
+
+	// An invalid tag is rejected before the AC is saved
+	mockACRepo.SaveACFunc = func(ctx context.Context, ac *entities.AcceptanceCriteriaEntity) error {
+		t.Error("AC must not be saved with an invalid tag")
+		return nil
+	}
+	_, err = service.CreateAC(ctx, dto.CreateACDTO{TaskID: "TM-task-1", Description: "Docs", Tags: []string{"not a tag"}})
+	if !errors.Is(err, pluginsdk.ErrInvalidArgument) {
+		t.Errorf("expected ErrInvalidArgument for invalid tag, got %v", err)
+	}
+}
+
+func TestACService_ReconcileTrackACs(t *testing.T) {
+	service, ctx, mockACRepo, mockTaskRepo, _ := setupACTestService(t)
+
+	task := func(id, trackID, status string) *entities.TaskEntity {
+		task := createTestTaskEntityForAC(t, id)
+		task.TrackID = trackID
+		task.Status = status
+		return task
+	}
+	tagged := func(id, taskID string, status entities.AcceptanceCriteriaStatus) *entities.AcceptanceCriteriaEntity {
+		ac := createTestACEntity(t, id, taskID)
+		ac.Status = status
+		return ac
+	}
+	taggedByTask := map[string][]*entities.AcceptanceCriteriaEntity{
+		"TM-task-1": {
+			tagged("TM-ac-1", "TM-task-1", entities.ACStatusNotStarted),
+			tagged("TM-ac-2", "TM-task-1", entities.ACStatusSkipped),
+		},
+		"TM-task-2": {tagged("TM-ac-3", "TM-task-2", entities.ACStatusFailed)},
+		"TM-task-3": {tagged("TM-ac-4", "TM-task-3", entities.ACStatusNotStarted)},
+	}
+
+	mockTaskRepo.ListTasksFunc = func(ctx context.Context, filters entities.TaskFilters) ([]*entities.TaskEntity, error) {
+		return []*entities.TaskEntity{
+			task("TM-task-3", "TM-track-2", "in-progress"),
+			task("TM-task-1", "TM-track-1", "done"),
+			task("TM-task-2", "TM-track-1", "done"),
+		}, nil
+	}
+	mockACRepo.ListTaggedACFunc = func(ctx context.Context, taskID, tag string) ([]*entities.AcceptanceCriteriaEntity, error) {
+		if tag != entities.ACTagAutoOnComplete {
+			t.Errorf("expected %s tag, got %s", entities.ACTagAutoOnComplete, tag)
+		}
+		return taggedByTask[taskID], nil
+	}
+	var notes []string
+	mockTaskRepo.SaveTaskNoteFunc = func(ctx context.Context, note *entities.TaskNoteEntity) error {
+		notes = append(notes, note.TaskID+": "+note.Content)
+		return nil
+	}
+
+	result, err := service.ReconcileTrackACs(ctx, dto.ReconcileACsDTO{})
+	if err != nil {
+		t.Fatalf("ReconcileTrackACs() failed: %v", err)
+	}
+	if len(result.Tracks) != 1 || result.Tracks[0].TrackID != "TM-track-1" {
+		t.Fatalf("expected only TM-track-1 to be complete, got %+v", result.Tracks)
+	}
+	if strings.Join(result.Tracks[0].VerifiedIDs, ",") != "TM-ac-1,TM-ac-3" {
+		t.Errorf("expected TM-ac-1 and TM-ac-3 verified, got %v", result.Tracks[0].VerifiedIDs)
+	}
+	if result.IncompleteTracks != 1 {
+		t.Errorf("expected 1 incomplete track, got %d", result.IncompleteTracks)
+	}
+	if status := taggedByTask["TM-task-1"][0].Status; status != entities.ACStatusAutomaticallyVerified {
+		t.Errorf("expected automatically_verified, got %s", status)
+	}
+	if status := taggedByTask["TM-task-3"][0].Status; status != entities.ACStatusNotStarted {
+		t.Errorf("expected AC of incomplete track to stay not_started, got %s", status)
+	}
+	if len(notes) != 2 || !strings.Contains(notes[0], "TM-task-1: Auto-verified") || !strings.Contains(notes[0], "TM-ac-1") {
+		t.Errorf("expected an audit note per task, got %v", notes)
+	}
+
+	// Reconciling again changes nothing
+	result, err = service.ReconcileTrackACs(ctx, dto.ReconcileACsDTO{})
+	if err != nil {
+		t.Fatalf("ReconcileTrackACs() failed: %v", err)
+	}
+	if len(result.Tracks) != 1 || len(result.Tracks[0].VerifiedIDs) != 0 {
+		t.Errorf("expected no changes on the second run, got %+v", result.Tracks)
+	}
+}
+
+func TestACService_ReconcileTrackACs_TrackWithoutTasks(t *testing.T) {
+	service, ctx, _, mockTaskRepo, _ := setupACTestService(t)
+
+	mockTaskRepo.ListTasksFunc = func(ctx context.Context, filters entities.TaskFilters) ([]*entities.TaskEntity, error) {
+		if filters.TrackID != "TM-track-9" {
+			t.Errorf("expected tasks of TM-track-9, got %q", filters.TrackID)
+		}
+		return nil, nil
+	}
+
+	result, err := service.ReconcileTrackACs(ctx, dto.ReconcileACsDTO{TrackID: "TM-track-9"})
+	if err != nil {
+		t.Fatalf("ReconcileTrackACs() failed: %v", err)
+	}
+	if len(result.Tracks) != 0 || result.IncompleteTracks != 1 {
+		t.Errorf("expected a track without tasks to be incomplete, got %+v", result)
+	}
+}
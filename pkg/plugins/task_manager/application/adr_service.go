@@ -38,12 +38,15 @@ func NewADRApplicationService(
 // CreateADR creates a new ADR
 func (s *ADRApplicationService) CreateADR(ctx context.Context, input dto.CreateADRDTO) (*entities.ADREntity, error) {
 	// Generate ADR ID
-	projectCode := s.aggregateRepo.GetProjectCode(ctx)
+	ids, err := newEntityIDs(ctx, s.aggregateRepo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate ADR ID: %w", err)
+	}
 	nextNum, err := s.aggregateRepo.GetNextSequenceNumber(ctx, "adr")
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate ADR ID: %w", err)
 	}
-	id := fmt.Sprintf("%s-adr-%d", projectCode, nextNum)
+	id := ids.ID("adr", nextNum)
 
 	// Validate ADR ID format
 	if err := s.validationService.ValidateNonEmpty("ADR ID", id); err != nil {
@@ -242,3 +245,36 @@ func (s *ADRApplicationService) GetADRsByTrack(ctx context.Context, trackID stri
 	}
 	return adrs, nil
 }
+
+// LinkTask records that a task implements an ADR. Both must exist and the task
+// must not be linked to the ADR yet.
+func (s *ADRApplicationService) LinkTask(ctx context.Context, adrID, taskID string) error {
+	if err := s.validationService.ValidateNonEmpty("ADR ID", adrID); err != nil {
+		return err
+	}
+	if err := s.validationService.ValidateNonEmpty("task ID", taskID); err != nil {
+		return err
+	}
+	if err := s.adrRepo.LinkADRToTask(ctx, adrID, taskID); err != nil {
+		return fmt.Errorf("failed to link task to ADR: %w", err)
+	}
+	return nil
+}
+
+// ListADRTasks returns the tasks linked to an ADR
+func (s *ADRApplicationService) ListADRTasks(ctx context.Context, adrID string) ([]*entities.TaskEntity, error) {
+	tasks, err := s.adrRepo.ListADRTasks(ctx, adrID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ADR tasks: %w", err)
+	}
+	return tasks, nil
+}
+
+// ListTaskADRs returns the ADRs a task is linked to
+func (s *ADRApplicationService) ListTaskADRs(ctx context.Context, taskID string) ([]*entities.ADREntity, error) {
+	adrs, err := s.adrRepo.ListTaskADRs(ctx, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list task ADRs: %w", err)
+	}
+	return adrs, nil
+}
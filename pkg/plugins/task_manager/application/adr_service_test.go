@@ -2,6 +2,8 @@ package application_test
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -565,3 +567,31 @@ func TestADRService_GetADRsByTrack_Success(t *testing.T) {
 		t.Errorf("adrs[0].TrackID = %q, want %q", adrs[0].TrackID, track.ID)
 	}
 }
+
+// TestADRService_LinkTask tests linking a task to an ADR
+func TestADRService_LinkTask(t *testing.T) {
+	service, ctx, mockADRRepo, _, _ := setupADRTestService(t)
+
+	var linked []string
+	mockADRRepo.LinkADRToTaskFunc = func(ctx context.Context, adrID, taskID string) error {
+		if taskID == "TM-task-2" {
+			return fmt.Errorf("%w: task %s is already linked to ADR %s", pluginsdk.ErrAlreadyExists, taskID, adrID)
+		}
+		linked = append(linked, adrID+"->"+taskID)
+		return nil
+	}
+
+	if err := service.LinkTask(ctx, "TM-adr-1", "TM-task-1"); err != nil {
+		t.Fatalf("LinkTask() failed: %v", err)
+	}
+	if len(linked) != 1 || linked[0] != "TM-adr-1->TM-task-1" {
+		t.Errorf("linked = %v, want [TM-adr-1->TM-task-1]", linked)
+	}
+
+	if err := service.LinkTask(ctx, "TM-adr-1", "TM-task-2"); !errors.Is(err, pluginsdk.ErrAlreadyExists) {
+		t.Errorf("LinkTask() duplicate error = %v, want ErrAlreadyExists", err)
+	}
+	if err := service.LinkTask(ctx, "TM-adr-1", ""); !errors.Is(err, pluginsdk.ErrInvalidArgument) {
+		t.Errorf("LinkTask() without task ID error = %v, want ErrInvalidArgument", err)
+	}
+}
@@ -0,0 +1,305 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/repositories"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// CloneRepositories are the repositories of one project database taking part in a clone
+type CloneRepositories struct {
+	Roadmap   repositories.RoadmapRepository
+	Track     repositories.TrackRepository
+	Task      repositories.TaskRepository
+	Iteration repositories.IterationRepository
+	AC        repositories.AcceptanceCriteriaRepository
+	Aggregate repositories.AggregateRepository
+}
+
+// CloneOptions selects what is copied besides the roadmap, tracks and iterations
+type CloneOptions struct {
+	WithTasks       bool // Copy tasks (with their ACs) and the iterations' task lists
+	WithACTemplates bool // Copy AC templates
+}
+
+// CloneIDMapping maps a source ID to the ID of its copy
+type CloneIDMapping struct {
+	Kind  string // roadmap, track, iteration, task or ac
+	OldID string
+	NewID string
+}
+
+// CloneResult reports what a clone copied
+type CloneResult struct {
+	RoadmapID    string
+	Criteria     int
+	Tracks       int
+	Dependencies int
+	Iterations   int
+	DoDItems     int
+	Tasks        int
+	ACs          int
+	ACTemplates  int
+	IDMappings   []CloneIDMapping // In copy order
+}
+
+// CloneApplicationService copies the structure of one project into another, so a new
+// project can start from a proven roadmap. Copies get the target's IDs, statuses reset
+// to their initial value and timestamps set to now.
+type CloneApplicationService struct{}
+
+// NewCloneApplicationService creates a new clone application service
+func NewCloneApplicationService() *CloneApplicationService {
+	return &CloneApplicationService{}
+}
+
+// IsProjectEmpty reports whether repos hold no roadmap, tracks, tasks or iterations
+func (s *CloneApplicationService) IsProjectEmpty(ctx context.Context, repos CloneRepositories) (bool, error) {
+	if _, err := repos.Roadmap.GetActiveRoadmap(ctx); err == nil {
+		return false, nil
+	} else if !errors.Is(err, pluginsdk.ErrNotFound) {
+		return false, fmt.Errorf("failed to check roadmap: %w", err)
+	}
+
+	tasks, err := repos.Task.ListTasks(ctx, entities.TaskFilters{})
+	if err != nil {
+		return false, fmt.Errorf("failed to check tasks: %w", err)
+	}
+	iterations, err := repos.Iteration.ListIterations(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to check iterations: %w", err)
+	}
+	return len(tasks) == 0 && len(iterations) == 0, nil
+}
+
+// Clone copies the active roadmap of source into target. The target is expected to be
+// empty; callers should run Clone inside a transaction on the target so a failure leaves
+// nothing behind.
+func (s *CloneApplicationService) Clone(ctx context.Context, source, target CloneRepositories, opts CloneOptions) (*CloneResult, error) {
+	roadmap, err := source.Roadmap.GetActiveRoadmap(ctx)
+	if err != nil {
+		if errors.Is(err, pluginsdk.ErrNotFound) {
+			return nil, fmt.Errorf("%w: source project has no roadmap", pluginsdk.ErrNotFound)
+		}
+		return nil, fmt.Errorf("failed to get source roadmap: %w", err)
+	}
+
+	now := time.Now().UTC()
+	result := &CloneResult{}
+
+	// Roadmap and its success criteria
+	newRoadmap, err := entities.NewRoadmapEntity(fmt.Sprintf("roadmap-%d", now.UnixNano()), roadmap.Vision, roadmap.SuccessCriteria, now, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create roadmap: %w", err)
+	}
+	if err := target.Roadmap.SaveRoadmap(ctx, newRoadmap); err != nil {
+		return nil, fmt.Errorf("failed to save roadmap: %w", err)
+	}
+	result.RoadmapID = newRoadmap.ID
+	result.IDMappings = append(result.IDMappings, CloneIDMapping{Kind: "roadmap", OldID: roadmap.ID, NewID: newRoadmap.ID})
+
+	criteria, err := source.Roadmap.ListRoadmapCriteria(ctx, roadmap.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list roadmap criteria: %w", err)
+	}
+	for _, criterion := range criteria {
+		copied, err := entities.NewRoadmapCriterionEntity(newRoadmap.ID, criterion.Text, now)
+		if err != nil {
+			return nil, fmt.Errorf("failed to copy roadmap criterion %d: %w", criterion.ID, err)
+		}
+		if err := target.Roadmap.SaveRoadmapCriterion(ctx, copied); err != nil {
+			return nil, fmt.Errorf("failed to save roadmap criterion: %w", err)
+		}
+		result.Criteria++
+	}
+
+	ids, err := newEntityIDs(ctx, target.Aggregate)
+	if err != nil {
+		return nil, err
+	}
+
+	// Tracks first, then their dependencies once every copy exists
+	tracks, err := source.Track.ListTracks(ctx, roadmap.ID, entities.TrackFilters{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tracks: %w", err)
+	}
+	sortByCreation(tracks, func(t *entities.TrackEntity) (time.Time, string) { return t.CreatedAt, t.ID })
+
+	nextTrack, err := target.Aggregate.GetNextSequenceNumber(ctx, "track")
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate track ID: %w", err)
+	}
+	trackIDs := make(map[string]string, len(tracks))
+	for _, track := range tracks {
+		newID := ids.ID("track", nextTrack)
+		nextTrack++
+		copied, err := entities.NewTrackEntity(newID, newRoadmap.ID, track.Title, track.Description,
+			string(entities.TrackStatusNotStarted), track.Rank, nil, now, now)
+		if err != nil {
+			return nil, fmt.Errorf("failed to copy track %s: %w", track.ID, err)
+		}
+		if err := target.Track.SaveTrack(ctx, copied); err != nil {
+			return nil, fmt.Errorf("failed to save track %s: %w", newID, err)
+		}
+		trackIDs[track.ID] = newID
+		result.Tracks++
+		result.IDMappings = append(result.IDMappings, CloneIDMapping{Kind: "track", OldID: track.ID, NewID: newID})
+	}
+	for _, track := range tracks {
+		dependencies, err := source.Track.GetTrackDependencies(ctx, track.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get dependencies of track %s: %w", track.ID, err)
+		}
+		for _, dependsOn := range dependencies {
+			newDependsOn, ok := trackIDs[dependsOn]
+			if !ok {
+				continue // Dependency on a track outside the roadmap
+			}
+			if err := target.Track.AddTrackDependency(ctx, trackIDs[track.ID], newDependsOn); err != nil {
+				return nil, fmt.Errorf("failed to copy dependency %s -> %s: %w", track.ID, dependsOn, err)
+			}
+			result.Dependencies++
+		}
+	}
+
+	// Tasks and their ACs
+	taskIDs := make(map[string]string)
+	if opts.WithTasks {
+		if err := s.cloneTasks(ctx, source, target, ids, trackIDs, taskIDs, now, result); err != nil {
+			return nil, err
+		}
+	}
+
+	// Iterations keep their numbers: the target has none yet
+	iterations, err := source.Iteration.ListIterations(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list iterations: %w", err)
+	}
+	for _, iteration := range iterations {
+		var members []string
+		for _, taskID := range iteration.TaskIDs {
+			if newID, ok := taskIDs[taskID]; ok {
+				members = append(members, newID)
+			}
+		}
+		copied, err := entities.NewIterationEntity(iteration.Number, iteration.Name, iteration.Goal, iteration.Deliverable,
+			members, string(entities.IterationStatusPlanned), iteration.Rank, time.Time{}, time.Time{}, now, now)
+		if err != nil {
+			return nil, fmt.Errorf("failed to copy iteration %d: %w", iteration.Number, err)
+		}
+		if err := target.Iteration.SaveIteration(ctx, copied); err != nil {
+			return nil, fmt.Errorf("failed to save iteration %d: %w", iteration.Number, err)
+		}
+		result.Iterations++
+		number := fmt.Sprintf("%d", iteration.Number)
+		result.IDMappings = append(result.IDMappings, CloneIDMapping{Kind: "iteration", OldID: number, NewID: number})
+
+		items, err := source.Iteration.ListIterationDoDItems(ctx, iteration.Number)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list definition of done of iteration %d: %w", iteration.Number, err)
+		}
+		for _, item := range items {
+			copiedItem, err := entities.NewIterationDoDItemEntity(iteration.Number, item.Text, now)
+			if err != nil {
+				return nil, fmt.Errorf("failed to copy definition of done item %d: %w", item.ID, err)
+			}
+			if err := target.Iteration.SaveIterationDoDItem(ctx, copiedItem); err != nil {
+				return nil, fmt.Errorf("failed to save definition of done item: %w", err)
+			}
+			result.DoDItems++
+		}
+	}
+	if opts.WithACTemplates {
+		templates, err := source.AC.ListACTemplates(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list AC templates: %w", err)
+		}
+		for _, template := range templates {
+			copied, err := entities.NewACTemplateEntity(template.Name, template.Items, now, now)
+			if err != nil {
+				return nil, fmt.Errorf("failed to copy AC template %s: %w", template.Name, err)
+			}
+			if err := target.AC.SaveACTemplate(ctx, copied); err != nil {
+				return nil, fmt.Errorf("failed to save AC template %s: %w", template.Name, err)
+			}
+			result.ACTemplates++
+		}
+	}
+
+	return result, nil
+}
+
+// cloneTasks copies the tasks of the cloned tracks as todo stubs, with their ACs reset
+// to not started. Branches and assignees belong to the source project and are dropped.
+func (s *CloneApplicationService) cloneTasks(ctx context.Context, source, target CloneRepositories, ids *entityIDs, trackIDs, taskIDs map[string]string, now time.Time, result *CloneResult) error {
+	tasks, err := source.Task.ListTasks(ctx, entities.TaskFilters{})
+	if err != nil {
+		return fmt.Errorf("failed to list tasks: %w", err)
+	}
+	sortByCreation(tasks, func(t *entities.TaskEntity) (time.Time, string) { return t.CreatedAt, t.ID })
+
+	nextTask, err := target.Aggregate.GetNextSequenceNumber(ctx, "task")
+	if err != nil {
+		return fmt.Errorf("failed to generate task ID: %w", err)
+	}
+	nextAC, err := target.Aggregate.GetNextSequenceNumber(ctx, "ac")
+	if err != nil {
+		return fmt.Errorf("failed to generate AC ID: %w", err)
+	}
+
+	for _, task := range tasks {
+		newTrackID, ok := trackIDs[task.TrackID]
+		if !ok {
+			continue // Task of a track outside the roadmap
+		}
+		newID := ids.ID("task", nextTask)
+		nextTask++
+		copied, err := entities.NewTaskEntity(newID, newTrackID, task.Title, task.Description,
+			string(entities.TaskStatusTodo), task.Rank, "", now, now)
+		if err != nil {
+			return fmt.Errorf("failed to copy task %s: %w", task.ID, err)
+		}
+		if err := target.Task.SaveTask(ctx, copied); err != nil {
+			return fmt.Errorf("failed to save task %s: %w", newID, err)
+		}
+		taskIDs[task.ID] = newID
+		result.Tasks++
+		result.IDMappings = append(result.IDMappings, CloneIDMapping{Kind: "task", OldID: task.ID, NewID: newID})
+
+		acs, err := source.AC.ListAC(ctx, task.ID)
+		if err != nil {
+			return fmt.Errorf("failed to list ACs of task %s: %w", task.ID, err)
+		}
+		sortByCreation(acs, func(ac *entities.AcceptanceCriteriaEntity) (time.Time, string) { return ac.CreatedAt, ac.ID })
+		for _, ac := range acs {
+			newACID := ids.ID("ac", nextAC)
+			nextAC++
+			copiedAC := entities.NewAcceptanceCriteriaEntity(newACID, newID, ac.Description, ac.VerificationType, ac.TestingInstructions, now, now)
+			if err := target.AC.SaveAC(ctx, copiedAC); err != nil {
+				return fmt.Errorf("failed to save AC %s: %w", newACID, err)
+			}
+			result.ACs++
+			result.IDMappings = append(result.IDMappings, CloneIDMapping{Kind: "ac", OldID: ac.ID, NewID: newACID})
+		}
+	}
+	return nil
+}
+
+// sortByCreation orders items by creation time, then ID, so copies are numbered in the
+// order the originals were created
+func sortByCreation[T any](items []T, key func(T) (time.Time, string)) {
+	sort.SliceStable(items, func(i, j int) bool {
+		ti, idi := key(items[i])
+		tj, idj := key(items[j])
+		if !ti.Equal(tj) {
+			return ti.Before(tj)
+		}
+		return idi < idj
+	})
+}
@@ -311,11 +311,15 @@ func (s *DocumentApplicationService) DeleteDocument(ctx context.Context, id stri
 	return nil
 }
 
-// isValidTrackIDFormat validates track ID format (TM-track-X)
+// isValidTrackIDFormat validates track ID format (TM-track-X or the project's ID format)
 func isValidTrackIDFormat(trackID string) bool {
 	pattern := `^[A-Z]+-track-[a-z0-9]+$`
 	regex := regexp.MustCompile(pattern)
-	return regex.MatchString(trackID)
+	if regex.MatchString(trackID) {
+		return true
+	}
+	entityType, ok := entities.FormattedIDEntityType(trackID)
+	return ok && entityType == "track"
 }
 
 // generateDocumentID generates a new document ID
@@ -5,6 +5,8 @@ type CreateACDTO struct {
 	TaskID              string
 	Description         string
 	TestingInstructions string
+	VerificationType    string   // Optional: "manual" (default) or "automated"
+	Tags                []string // Optional: tags such as "auto-on-complete"
 }
 
 // UpdateACDTO represents input for updating acceptance criteria
@@ -19,6 +21,12 @@ type VerifyACDTO struct {
 	ID         string
 	VerifiedBy string
 	VerifiedAt string
+	Notes      string // Optional: evidence the criterion was verified with
+
+	// RequireInstructions rejects criteria without testing instructions
+	RequireInstructions bool
+	// RequireNotes rejects verification without Notes
+	RequireNotes bool
 }
 
 // FailACDTO represents input for marking acceptance criteria as failed
@@ -33,9 +41,145 @@ type SkipACDTO struct {
 	Reason string
 }
 
+// ResetACsDTO represents input for resetting acceptance criteria to not_started.
+// Exactly one of ID, TaskID or IterationNum selects the criteria to reset.
+type ResetACsDTO struct {
+	ID           string
+	TaskID       string
+	IterationNum *int
+	KeepNotes    bool // Keep existing notes instead of clearing them
+}
+
+// VerifyAutomatedACsDTO represents input for marking the automated acceptance criteria
+// of a track (or of one of its tasks) as automatically verified, e.g. after a CI run.
+// At least one of TrackID or TaskID must be given.
+type VerifyAutomatedACsDTO struct {
+	TrackID string
+	TaskID  string // Optional: only this task's ACs
+}
+
+// VerifyAutomatedACsResultDTO reports the outcome of a bulk automated verification
+type VerifyAutomatedACsResultDTO struct {
+	VerifiedIDs     []string
+	SkippedManual   int                // ACs whose verification type is not automated
+	SkippedTerminal int                // ACs already verified or skipped
+	Failed          []ACUpdateErrorDTO // ACs whose update failed; the others were still updated
+}
+
+// ReconcileACsDTO represents input for verifying the auto-on-complete acceptance
+// criteria of tracks whose tasks are all done
+type ReconcileACsDTO struct {
+	TrackID string // Optional: only this track (default: every track)
+}
+
+// ReconcileACsResultDTO reports the outcome of a reconciliation
+type ReconcileACsResultDTO struct {
+	Tracks           []ReconciledTrackDTO // Tracks whose tasks are all done, in ID order
+	IncompleteTracks int                  // Tracks with tasks that are not done (or no tasks)
+	Failed           []ACUpdateErrorDTO   // ACs whose update failed; the others were still updated
+}
+
+// ReconciledTrackDTO lists the acceptance criteria verified for one complete track
+type ReconciledTrackDTO struct {
+	TrackID     string
+	VerifiedIDs []string // Empty if every tagged AC was already verified or skipped
+}
+
+// ACUpdateErrorDTO records why updating a single acceptance criterion failed
+type ACUpdateErrorDTO struct {
+	ID  string
+	Err error
+}
+
+// ACTreeDTO is the roadmap-wide acceptance criteria hierarchy (track → task → AC)
+// with verified/total rollups per level
+type ACTreeDTO struct {
+	Verified int
+	Total    int
+	Tracks   []ACTreeTrackDTO
+}
+
+// ACTreeTrackDTO is a track of the AC tree with the tasks that have matching ACs
+type ACTreeTrackDTO struct {
+	ID       string
+	Title    string
+	Verified int
+	Total    int
+	Tasks    []ACTreeTaskDTO
+}
+
+// ACTreeTaskDTO is a task of the AC tree with its matching ACs
+type ACTreeTaskDTO struct {
+	ID       string
+	Title    string
+	Status   string
+	Verified int
+	Total    int
+	ACs      []ACTreeACDTO
+}
+
+// ACTreeACDTO is an acceptance criterion of the AC tree
+type ACTreeACDTO struct {
+	ID               string
+	Description      string
+	VerificationType string
+	Status           string
+	Notes            string
+}
+
+// ACCoverageDTO reports which tasks have acceptance criteria. Cancelled tasks are not counted.
+type ACCoverageDTO struct {
+	Tasks          int                 // Tasks considered
+	TasksWithACs   int                 // Tasks with at least one AC
+	Coverage       float64             // TasksWithACs as a percentage of Tasks (100 without tasks)
+	WithoutACs     []ACCoverageTaskDTO // Tasks without any AC
+	DoneUnverified []ACCoverageTaskDTO // Done tasks with ACs that are neither verified nor skipped
+}
+
+// ACCoverageTaskDTO is a task listed by the AC coverage report
+type ACCoverageTaskDTO struct {
+	ID         string
+	TrackID    string
+	Title      string
+	Status     string
+	ACs        int
+	Unverified int
+}
+
 // ACFilters represents filters for listing acceptance criteria
 type ACFilters struct {
 	TaskID       *string
 	IterationNum *int
 	Status       []string
 }
+
+// ACTemplateItemDTO represents a single acceptance criterion within a template
+type ACTemplateItemDTO struct {
+	Description      string
+	VerificationType string // Optional: "manual" (default) or "automated"
+}
+
+// CreateACTemplateDTO represents input for creating a reusable AC template
+type CreateACTemplateDTO struct {
+	Name  string
+	Items []ACTemplateItemDTO
+}
+
+// ImportACItemDTO represents a single acceptance criterion in a bulk import
+type ImportACItemDTO struct {
+	Description         string
+	VerificationType    string // Optional: "manual" (default) or "automated"
+	TestingInstructions string
+	Command             string // Optional: command that verifies the criterion, appended to the testing instructions
+}
+
+// ImportACTaskDTO groups the imported acceptance criteria of one task
+type ImportACTaskDTO struct {
+	TaskID string
+	ACs    []ImportACItemDTO
+}
+
+// ImportACsDTO represents input for importing acceptance criteria for several tasks at once
+type ImportACsDTO struct {
+	Tasks []ImportACTaskDTO
+}
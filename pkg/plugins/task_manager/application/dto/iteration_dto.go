@@ -1,5 +1,7 @@
 package dto
 
+import "time"
+
 // CreateIterationDTO represents input for creating a new iteration
 type CreateIterationDTO struct {
 	Number      int
@@ -28,3 +30,70 @@ type CurrentIterationResult struct {
 	IsFallback   bool
 	FallbackMsg  string
 }
+
+// CreateIterationTemplateDTO represents input for creating an iteration template
+type CreateIterationTemplateDTO struct {
+	Name        string
+	GoalPattern string // May contain {n}, replaced with the iteration number
+	Deliverable string
+	DoDItems    []string // Definition of done items copied into new iterations; may contain {n}
+}
+
+// NewIterationFromTemplateDTO represents input for creating an iteration from a template
+type NewIterationFromTemplateDTO struct {
+	Template  string
+	Name      string // Optional: defaults to "<template> {n}"; may contain {n}
+	PullTasks int    // Number of top-ranked backlog tasks to add (0 = none)
+	RoadmapID string // Optional: pull only from the backlog of this roadmap
+}
+
+// IterationVelocityEntryDTO is the throughput of one completed iteration
+type IterationVelocityEntryDTO struct {
+	Number         int
+	Name           string
+	CompletedAt    time.Time
+	CompletedTasks int // Tasks in the iteration with status done
+	TotalTasks     int
+}
+
+// IterationVelocityDTO summarizes completed tasks per iteration over recent completed iterations.
+// Iterations are ordered oldest to newest.
+type IterationVelocityDTO struct {
+	Requested  int // Number of iterations asked for; Iterations may hold fewer
+	Iterations []IterationVelocityEntryDTO
+	Average    float64 // Mean completed tasks per iteration (0 when there are none)
+	Trend      string  // increasing, decreasing, steady, or insufficient-data
+}
+
+// CarryOverDTO represents input for moving the unfinished tasks of an iteration to another
+type CarryOverDTO struct {
+	From   int
+	To     int
+	Create bool // Create iteration To when it does not exist
+}
+
+// IterationTimelineTaskDTO is a task placed on the timeline of its iteration
+type IterationTimelineTaskDTO struct {
+	ID     string
+	Title  string
+	Status string
+}
+
+// IterationTimelineEntryDTO is an iteration placed on the timeline. Task status history is
+// not recorded, so its tasks span the whole iteration.
+type IterationTimelineEntryDTO struct {
+	Number      int
+	Name        string
+	Status      string
+	StartedAt   *time.Time // nil for unscheduled iterations
+	CompletedAt *time.Time // nil while the iteration is running
+	Tasks       []IterationTimelineTaskDTO
+}
+
+// IterationTimelineDTO places iterations and their tasks along a date axis.
+// Scheduled iterations are ordered by start time; Unscheduled holds the iterations
+// without a start date, in iteration order.
+type IterationTimelineDTO struct {
+	Scheduled   []IterationTimelineEntryDTO
+	Unscheduled []IterationTimelineEntryDTO
+}
@@ -4,6 +4,7 @@ package dto
 type CreateRoadmapDTO struct {
 	Vision          string
 	SuccessCriteria string
+	Additional      bool // Create alongside existing roadmaps and make it the active one
 }
 
 // UpdateRoadmapDTO represents input for updating a roadmap
@@ -20,6 +21,11 @@ type RoadmapOverviewDTO struct {
 	Tasks      []interface{}
 	Iterations []interface{}
 	ADRs       []interface{}
+
+	// Tasks pre-grouped by the repository, so presenters don't regroup Tasks
+	// per track or iteration. Left out of JSON output, which already has Tasks.
+	TasksByTrack     map[string][]interface{} `json:"-"`
+	TasksByIteration map[int][]interface{}    `json:"-"`
 }
 
 // RoadmapOverviewOptions represents options for retrieving roadmap overview
@@ -40,3 +46,14 @@ func (o *RoadmapOverviewOptions) ShouldShowSection(section string) bool {
 	}
 	return false
 }
+
+// RoadmapStatsDTO summarizes progress of the active roadmap
+type RoadmapStatsDTO struct {
+	RoadmapID      string
+	CriteriaMet    int
+	CriteriaTotal  int
+	TracksComplete int
+	TracksTotal    int
+	TasksDone      int
+	TasksTotal     int
+}
@@ -1,5 +1,7 @@
 package dto
 
+import "time"
+
 // CreateTaskDTO represents input for creating a new task
 type CreateTaskDTO struct {
 	TrackID     string
@@ -24,3 +26,96 @@ type TaskListFilters struct {
 	Status  []string
 	TrackID *string
 }
+
+// AssigneeWorkloadDTO counts the open tasks of one assignee by status.
+// An empty Assignee stands for the unassigned tasks.
+type AssigneeWorkloadDTO struct {
+	Assignee   string
+	Open       int
+	Todo       int
+	InProgress int
+	Review     int
+}
+
+// StaleTasksQuery selects the open tasks not updated for a while
+type StaleTasksQuery struct {
+	Days      int       // Minimum days since the last update
+	Assignee  string    // Optional: only tasks of this assignee
+	RoadmapID string    // Optional: only tasks of this roadmap's tracks
+	Now       time.Time // Reference time ages are measured from
+}
+
+// StaleTrackDTO groups the stale tasks of one track, oldest first
+type StaleTrackDTO struct {
+	TrackID    string
+	TrackTitle string
+	Tasks      []StaleTaskDTO
+}
+
+// StaleTaskDTO is an open task with the time since its last update
+type StaleTaskDTO struct {
+	ID        string
+	Title     string
+	Status    string
+	Assignee  string
+	UpdatedAt time.Time
+	Age       time.Duration
+}
+
+// StatusReportDTO summarizes a project: its tracks, tasks and acceptance criteria
+// counted by status, and the progress of the current iteration
+type StatusReportDTO struct {
+	Tracks    map[string]int
+	Tasks     map[string]int
+	ACs       map[string]int
+	Iteration *IterationStatusDTO // nil if no iteration is current
+}
+
+// IterationStatusDTO is the task progress of the current iteration
+type IterationStatusDTO struct {
+	Number    int
+	Name      string
+	TasksDone int
+	Tasks     int
+}
+
+// ReopenTaskDTO represents input for reopening a done task
+type ReopenTaskDTO struct {
+	ID     string
+	Status string // Optional: "todo" (default) or "in-progress"
+	Reason string // Optional: recorded as a task note
+}
+
+// CloneTaskDTO represents input for duplicating a task within its project
+type CloneTaskDTO struct {
+	SourceID      string
+	Title         string // Optional: defaults to the source task's title
+	TrackID       string // Optional: defaults to the source task's track
+	WithACs       bool   // Copy the acceptance criteria, reset to not_started
+	SameIteration bool   // Add the copy to the source task's open iterations
+}
+
+// Task bump directions
+const (
+	BumpTop    = "top"
+	BumpUp     = "up"
+	BumpDown   = "down"
+	BumpBottom = "bottom"
+)
+
+// BumpTaskDTO represents input for moving a task's rank relative to its peers
+type BumpTaskDTO struct {
+	ID           string
+	Direction    string // "top", "up", "down" or "bottom"
+	IterationNum *int   // Optional: rank among the iteration's tasks instead of the track's
+}
+
+// CreateTaskFromEventDTO represents input for capturing a logged event as a backlog task
+type CreateTaskFromEventDTO struct {
+	TrackID   string
+	EventID   string
+	EventType string
+	Timestamp time.Time
+	SessionID string                 // Optional
+	Payload   map[string]interface{} // Event payload; title and description are derived from it
+}
@@ -7,6 +7,8 @@ type CreateTrackDTO struct {
 	Description string
 	Status      string
 	Rank        int
+	// Dependencies lists the IDs of existing tracks the new track depends on
+	Dependencies []string
 }
 
 // UpdateTrackDTO represents input for updating a track
@@ -23,3 +25,26 @@ type UpdateTrackDTO struct {
 type TrackListFilters struct {
 	Status []string
 }
+
+// DependencyGraphNodeDTO is a track in the dependency graph
+type DependencyGraphNodeDTO struct {
+	ID      string
+	Title   string
+	Status  string
+	InCycle bool
+}
+
+// DependencyGraphEdgeDTO is a dependency: From depends on To
+type DependencyGraphEdgeDTO struct {
+	From    string
+	To      string
+	InCycle bool
+}
+
+// DependencyGraphDTO is the track dependency graph of a roadmap.
+// Nodes include tracks without dependencies so the graph is complete.
+type DependencyGraphDTO struct {
+	Nodes  []DependencyGraphNodeDTO
+	Edges  []DependencyGraphEdgeDTO
+	Cycles [][]string // Track IDs of each detected cycle
+}
@@ -0,0 +1,36 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/repositories"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// entityIDs generates the IDs of new tracks, tasks, ACs and ADRs from the project
+// code and the project's ID format (see entities.IDFormat)
+type entityIDs struct {
+	code   string
+	format *entities.IDFormat
+}
+
+// newEntityIDs loads the project code and ID format of the project behind repo
+func newEntityIDs(ctx context.Context, repo repositories.AggregateRepository) (*entityIDs, error) {
+	template, err := repo.GetProjectMetadata(ctx, entities.IDFormatMetadataKey)
+	if err != nil && !errors.Is(err, pluginsdk.ErrNotFound) {
+		return nil, fmt.Errorf("failed to get ID format: %w", err)
+	}
+	format, err := entities.ParseIDFormat(template)
+	if err != nil {
+		return nil, err
+	}
+	return &entityIDs{code: repo.GetProjectCode(ctx), format: format}, nil
+}
+
+// ID returns the ID of the number-th entity of entityType ("task", "track", "ac", "adr")
+func (g *entityIDs) ID(entityType string, number int) string {
+	return g.format.Format(g.code, entityType, number)
+}
@@ -4,10 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application/dto"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/repositories"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/services"
@@ -19,15 +23,18 @@ type IterationApplicationService struct {
 	iterationRepo     repositories.IterationRepository
 	taskRepo          repositories.TaskRepository
 	aggregateRepo     repositories.AggregateRepository
+	txRepo            domain.TransactionalRepository // makes carry-overs atomic
 	iterationService  *services.IterationService
 	validationService *services.ValidationService
 }
 
-// NewIterationApplicationService creates a new iteration application service.
+// NewIterationApplicationService creates a new iteration application service. txRepo is
+// required: carry-overs go through its transactions.
 func NewIterationApplicationService(
 	iterationRepo repositories.IterationRepository,
 	taskRepo repositories.TaskRepository,
 	aggregateRepo repositories.AggregateRepository,
+	txRepo domain.TransactionalRepository,
 	iterationService *services.IterationService,
 	validationService *services.ValidationService,
 ) *IterationApplicationService {
@@ -35,6 +42,7 @@ func NewIterationApplicationService(
 		iterationRepo:     iterationRepo,
 		taskRepo:          taskRepo,
 		aggregateRepo:     aggregateRepo,
+		txRepo:            txRepo,
 		iterationService:  iterationService,
 		validationService: validationService,
 	}
@@ -49,19 +57,10 @@ func NewIterationApplicationService(
 // Iteration number is auto-generated based on existing iterations.
 func (s *IterationApplicationService) CreateIteration(ctx context.Context, input dto.CreateIterationDTO) (*entities.IterationEntity, error) {
 	// Generate iteration number (max + 1)
-	iterations, err := s.iterationRepo.ListIterations(ctx)
+	nextNumber, err := s.nextIterationNumber(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate iteration number: %w", err)
-	}
-
-	// Find max iteration number
-	maxNumber := 0
-	for _, iter := range iterations {
-		if iter.Number > maxNumber {
-			maxNumber = iter.Number
-		}
+		return nil, err
 	}
-	nextNumber := maxNumber + 1
 
 	// Validate iteration number
 	if err := s.validationService.ValidateIterationNumber(nextNumber); err != nil {
@@ -182,6 +181,15 @@ func (s *IterationApplicationService) DeleteIteration(ctx context.Context, itera
 	return nil
 }
 
+// PlanIterationDeletion reports what deleting an iteration would remove and leave
+// orphaned, without deleting anything
+func (s *IterationApplicationService) PlanIterationDeletion(ctx context.Context, iterationNum int) (*entities.DeletionPlan, error) {
+	if err := s.validationService.ValidateIterationNumber(iterationNum); err != nil {
+		return nil, err
+	}
+	return s.aggregateRepo.PlanDeletion(ctx, entities.DeletionTargetIteration, strconv.Itoa(iterationNum))
+}
+
 // ============================================================================
 // Lifecycle Operations
 // ============================================================================
@@ -219,6 +227,7 @@ func (s *IterationApplicationService) StartIteration(ctx context.Context, iterat
 }
 
 // CompleteIteration transitions an iteration from "current" to "complete".
+// Use EnsureDoDComplete beforehand to also require the definition of done.
 func (s *IterationApplicationService) CompleteIteration(ctx context.Context, iterationNum int) error {
 	// Validate iteration number
 	if err := s.validationService.ValidateIterationNumber(iterationNum); err != nil {
@@ -331,6 +340,96 @@ func (s *IterationApplicationService) RemoveTask(ctx context.Context, iterationN
 	return nil
 }
 
+// CarryOver moves the non-done tasks of iteration From to iteration To, in a single
+// transaction when the service has a transactional repository. Done tasks stay in From
+// for history. To must not be complete; with Create, a missing To is created as a
+// planned iteration. Returns the target iteration, the moved tasks and whether To was created.
+func (s *IterationApplicationService) CarryOver(ctx context.Context, input dto.CarryOverDTO) (*entities.IterationEntity, []*entities.TaskEntity, bool, error) {
+	for _, number := range []int{input.From, input.To} {
+		if err := s.validationService.ValidateIterationNumber(number); err != nil {
+			return nil, nil, false, err
+		}
+	}
+	if input.From == input.To {
+		return nil, nil, false, fmt.Errorf("%w: cannot carry over iteration %d into itself", pluginsdk.ErrInvalidArgument, input.From)
+	}
+
+	if _, err := s.iterationRepo.GetIteration(ctx, input.From); err != nil {
+		return nil, nil, false, fmt.Errorf("failed to get iteration: %w", err)
+	}
+
+	created := false
+	target, err := s.iterationRepo.GetIteration(ctx, input.To)
+	switch {
+	case err == nil:
+		if target.Status == string(entities.IterationStatusComplete) {
+			return nil, nil, false, fmt.Errorf("%w: iteration %d is already complete", pluginsdk.ErrInvalidArgument, input.To)
+		}
+	case errors.Is(err, pluginsdk.ErrNotFound) && input.Create:
+		now := time.Now().UTC()
+		target, err = entities.NewIterationEntity(
+			input.To,
+			fmt.Sprintf("Iteration %d", input.To),
+			"",
+			"",
+			[]string{},
+			string(entities.IterationStatusPlanned),
+			500, // Default rank
+			time.Time{},
+			time.Time{},
+			now,
+			now,
+		)
+		if err != nil {
+			return nil, nil, false, fmt.Errorf("failed to create iteration entity: %w", err)
+		}
+		created = true
+	case errors.Is(err, pluginsdk.ErrNotFound):
+		return nil, nil, false, fmt.Errorf("%w: iteration %d not found (use --create to create it)", pluginsdk.ErrNotFound, input.To)
+	default:
+		return nil, nil, false, fmt.Errorf("failed to get iteration: %w", err)
+	}
+
+	tasks, err := s.iterationRepo.GetIterationTasks(ctx, input.From)
+	if err != nil {
+		return nil, nil, false, fmt.Errorf("failed to get iteration tasks: %w", err)
+	}
+	moved := []*entities.TaskEntity{}
+	for _, task := range tasks {
+		if task.Status != string(entities.TaskStatusDone) {
+			moved = append(moved, task)
+		}
+	}
+
+	err = s.txRepo.WithTx(ctx, func(repo domain.RoadmapRepository) error {
+		if created {
+			if err := repo.SaveIteration(ctx, target); err != nil {
+				return fmt.Errorf("failed to save iteration: %w", err)
+			}
+		}
+		for _, task := range moved {
+			if err := repo.RemoveTaskFromIteration(ctx, input.From, task.ID); err != nil {
+				return fmt.Errorf("failed to remove task %s from iteration %d: %w", task.ID, input.From, err)
+			}
+			// A task already planned into the target only leaves the source
+			if err := repo.AddTaskToIteration(ctx, input.To, task.ID); err != nil && !errors.Is(err, pluginsdk.ErrAlreadyExists) {
+				return fmt.Errorf("failed to add task %s to iteration %d: %w", task.ID, input.To, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	for _, task := range moved {
+		if !target.HasTask(task.ID) {
+			target.TaskIDs = append(target.TaskIDs, task.ID)
+		}
+	}
+	return target, moved, created, nil
+}
+
 // ============================================================================
 // Read Operations
 // ============================================================================
@@ -416,3 +515,376 @@ func (s *IterationApplicationService) GetIterationTasks(ctx context.Context, ite
 
 	return tasks, nil
 }
+
+// GetVelocity returns completed tasks per iteration for the last N completed iterations.
+// Task status history is not recorded, so a task counts as completed when it is in the
+// iteration's task set and currently done. Fewer than N iterations are returned if fewer exist.
+func (s *IterationApplicationService) GetVelocity(ctx context.Context, last int) (*dto.IterationVelocityDTO, error) {
+	if last <= 0 {
+		return nil, fmt.Errorf("%w: number of iterations must be positive", pluginsdk.ErrInvalidArgument)
+	}
+
+	iterations, err := s.iterationRepo.ListIterations(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list iterations: %w", err)
+	}
+
+	completed := make([]*entities.IterationEntity, 0, len(iterations))
+	for _, iter := range iterations {
+		if iter.Status == string(entities.IterationStatusComplete) && iter.CompletedAt != nil {
+			completed = append(completed, iter)
+		}
+	}
+	sort.SliceStable(completed, func(i, j int) bool {
+		return completed[i].CompletedAt.Before(*completed[j].CompletedAt)
+	})
+	if len(completed) > last {
+		completed = completed[len(completed)-last:]
+	}
+
+	velocity := &dto.IterationVelocityDTO{
+		Requested:  last,
+		Iterations: make([]dto.IterationVelocityEntryDTO, 0, len(completed)),
+	}
+	total := 0
+	for _, iter := range completed {
+		tasks, err := s.iterationRepo.GetIterationTasks(ctx, iter.Number)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get tasks for iteration %d: %w", iter.Number, err)
+		}
+		done := 0
+		for _, task := range tasks {
+			if task.Status == string(entities.TaskStatusDone) {
+				done++
+			}
+		}
+		total += done
+		velocity.Iterations = append(velocity.Iterations, dto.IterationVelocityEntryDTO{
+			Number:         iter.Number,
+			Name:           iter.Name,
+			CompletedAt:    *iter.CompletedAt,
+			CompletedTasks: done,
+			TotalTasks:     len(tasks),
+		})
+	}
+
+	if len(velocity.Iterations) > 0 {
+		velocity.Average = float64(total) / float64(len(velocity.Iterations))
+	}
+	velocity.Trend = velocityTrend(velocity.Iterations)
+
+	return velocity, nil
+}
+
+// GetTimeline returns all iterations with their tasks, placed by start and completion time.
+// Iterations that were never started have no start date and are returned as unscheduled.
+func (s *IterationApplicationService) GetTimeline(ctx context.Context) (*dto.IterationTimelineDTO, error) {
+	iterations, err := s.iterationRepo.ListIterations(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list iterations: %w", err)
+	}
+
+	timeline := &dto.IterationTimelineDTO{
+		Scheduled:   []dto.IterationTimelineEntryDTO{},
+		Unscheduled: []dto.IterationTimelineEntryDTO{},
+	}
+	for _, iter := range iterations {
+		tasks, err := s.iterationRepo.GetIterationTasks(ctx, iter.Number)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get tasks for iteration %d: %w", iter.Number, err)
+		}
+
+		entry := dto.IterationTimelineEntryDTO{
+			Number:      iter.Number,
+			Name:        iter.Name,
+			Status:      iter.Status,
+			StartedAt:   iter.StartedAt,
+			CompletedAt: iter.CompletedAt,
+			Tasks:       make([]dto.IterationTimelineTaskDTO, 0, len(tasks)),
+		}
+		for _, task := range tasks {
+			entry.Tasks = append(entry.Tasks, dto.IterationTimelineTaskDTO{
+				ID:     task.ID,
+				Title:  task.Title,
+				Status: task.Status,
+			})
+		}
+
+		if iter.StartedAt == nil {
+			timeline.Unscheduled = append(timeline.Unscheduled, entry)
+		} else {
+			timeline.Scheduled = append(timeline.Scheduled, entry)
+		}
+	}
+
+	sort.SliceStable(timeline.Scheduled, func(i, j int) bool {
+		return timeline.Scheduled[i].StartedAt.Before(*timeline.Scheduled[j].StartedAt)
+	})
+
+	return timeline, nil
+}
+
+// velocityTrend compares the mean of the newer half of iterations with the older half.
+// With an odd count the middle iteration is ignored.
+func velocityTrend(entries []dto.IterationVelocityEntryDTO) string {
+	if len(entries) < 2 {
+		return "insufficient-data"
+	}
+
+	half := len(entries) / 2
+	older, newer := 0, 0
+	for i := 0; i < half; i++ {
+		older += entries[i].CompletedTasks
+		newer += entries[len(entries)-half+i].CompletedTasks
+	}
+
+	switch {
+	case newer > older:
+		return "increasing"
+	case newer < older:
+		return "decreasing"
+	default:
+		return "steady"
+	}
+}
+
+// nextIterationNumber returns the number the next created iteration will receive (max + 1).
+func (s *IterationApplicationService) nextIterationNumber(ctx context.Context) (int, error) {
+	iterations, err := s.iterationRepo.ListIterations(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to generate iteration number: %w", err)
+	}
+
+	maxNumber := 0
+	for _, iter := range iterations {
+		if iter.Number > maxNumber {
+			maxNumber = iter.Number
+		}
+	}
+	return maxNumber + 1, nil
+}
+
+// ============================================================================
+// Iteration Templates
+// ============================================================================
+
+// CreateIterationTemplate creates a named template for recurring iterations.
+func (s *IterationApplicationService) CreateIterationTemplate(ctx context.Context, input dto.CreateIterationTemplateDTO) (*entities.IterationTemplateEntity, error) {
+	now := time.Now().UTC()
+	template, err := entities.NewIterationTemplateEntity(input.Name, input.GoalPattern, input.Deliverable, now, now)
+	if err != nil {
+		return nil, err
+	}
+	for _, text := range input.DoDItems {
+		text = strings.TrimSpace(text)
+		if text == "" {
+			return nil, fmt.Errorf("%w: definition of done item text must be non-empty", pluginsdk.ErrInvalidArgument)
+		}
+		template.DoDItems = append(template.DoDItems, text)
+	}
+
+	if err := s.iterationRepo.SaveIterationTemplate(ctx, template); err != nil {
+		return nil, fmt.Errorf("failed to save iteration template: %w", err)
+	}
+
+	return template, nil
+}
+
+// GetIterationTemplate retrieves an iteration template by name.
+func (s *IterationApplicationService) GetIterationTemplate(ctx context.Context, name string) (*entities.IterationTemplateEntity, error) {
+	return s.iterationRepo.GetIterationTemplate(ctx, name)
+}
+
+// ListIterationTemplates returns all iteration templates.
+func (s *IterationApplicationService) ListIterationTemplates(ctx context.Context) ([]*entities.IterationTemplateEntity, error) {
+	return s.iterationRepo.ListIterationTemplates(ctx)
+}
+
+// DeleteIterationTemplate deletes an iteration template. Iterations created from it are kept.
+func (s *IterationApplicationService) DeleteIterationTemplate(ctx context.Context, name string) error {
+	return s.iterationRepo.DeleteIterationTemplate(ctx, name)
+}
+
+// NewIterationFromTemplate creates the next iteration pre-filled from a template.
+// {n} in the goal pattern and name is replaced with the new iteration number.
+// If PullTasks > 0, the top-ranked backlog tasks (of input.RoadmapID, if set) are added
+// to the new iteration.
+// Returns the created iteration and the tasks pulled into it.
+func (s *IterationApplicationService) NewIterationFromTemplate(ctx context.Context, input dto.NewIterationFromTemplateDTO) (*entities.IterationEntity, []*entities.TaskEntity, error) {
+	if input.PullTasks < 0 {
+		return nil, nil, fmt.Errorf("%w: number of tasks to pull must not be negative", pluginsdk.ErrInvalidArgument)
+	}
+
+	template, err := s.iterationRepo.GetIterationTemplate(ctx, input.Template)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get iteration template: %w", err)
+	}
+
+	number, err := s.nextIterationNumber(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	namePattern := input.Name
+	if namePattern == "" {
+		namePattern = template.Name + " " + entities.IterationNumberPlaceholder
+	}
+
+	iteration, err := s.CreateIteration(ctx, dto.CreateIterationDTO{
+		Name:        entities.ExpandIterationPattern(namePattern, number),
+		Goal:        template.Goal(number),
+		Deliverable: entities.ExpandIterationPattern(template.Deliverable, number),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if _, err := s.copyDoDItems(ctx, iteration.Number, template); err != nil {
+		return iteration, nil, err
+	}
+
+	pulled := []*entities.TaskEntity{}
+	if input.PullTasks > 0 {
+		backlog, err := s.taskRepo.GetBacklogTasks(ctx, input.RoadmapID)
+		if err != nil {
+			return iteration, pulled, fmt.Errorf("failed to get backlog tasks: %w", err)
+		}
+		// Lower rank = higher priority; stable sort keeps creation order for equal ranks
+		sort.SliceStable(backlog, func(i, j int) bool {
+			return backlog[i].Rank < backlog[j].Rank
+		})
+		if len(backlog) > input.PullTasks {
+			backlog = backlog[:input.PullTasks]
+		}
+		for _, task := range backlog {
+			if err := s.iterationRepo.AddTaskToIteration(ctx, iteration.Number, task.ID); err != nil {
+				return iteration, pulled, fmt.Errorf("failed to add task %s to iteration: %w", task.ID, err)
+			}
+			iteration.TaskIDs = append(iteration.TaskIDs, task.ID)
+			pulled = append(pulled, task)
+		}
+	}
+
+	if err := s.iterationRepo.IncrementIterationTemplateUsage(ctx, template.Name); err != nil {
+		return iteration, pulled, fmt.Errorf("failed to update iteration template usage: %w", err)
+	}
+
+	return iteration, pulled, nil
+}
+
+// ============================================================================
+// Definition of Done
+// ============================================================================
+
+// AddDoDItem adds an unchecked item to an iteration's definition of done.
+func (s *IterationApplicationService) AddDoDItem(ctx context.Context, iterationNum int, text string) (*entities.IterationDoDItemEntity, error) {
+	item, err := entities.NewIterationDoDItemEntity(iterationNum, text, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.iterationRepo.SaveIterationDoDItem(ctx, item); err != nil {
+		return nil, fmt.Errorf("failed to save definition of done item: %w", err)
+	}
+
+	return item, nil
+}
+
+// CopyDoDFromTemplate adds the definition of done items of a template to an iteration,
+// with {n} replaced by the iteration number. Items whose text the iteration already
+// has are skipped, so copying twice is harmless. Returns the items that were added.
+func (s *IterationApplicationService) CopyDoDFromTemplate(ctx context.Context, iterationNum int, templateName string) ([]*entities.IterationDoDItemEntity, error) {
+	template, err := s.iterationRepo.GetIterationTemplate(ctx, templateName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get iteration template: %w", err)
+	}
+	if len(template.DoDItems) == 0 {
+		return nil, fmt.Errorf("%w: iteration template %s has no definition of done items", pluginsdk.ErrInvalidArgument, template.Name)
+	}
+
+	return s.copyDoDItems(ctx, iterationNum, template)
+}
+
+// copyDoDItems adds the template's definition of done items the iteration doesn't have yet.
+func (s *IterationApplicationService) copyDoDItems(ctx context.Context, iterationNum int, template *entities.IterationTemplateEntity) ([]*entities.IterationDoDItemEntity, error) {
+	added := []*entities.IterationDoDItemEntity{}
+	if len(template.DoDItems) == 0 {
+		return added, nil
+	}
+
+	existing, err := s.iterationRepo.ListIterationDoDItems(ctx, iterationNum)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list definition of done items: %w", err)
+	}
+	seen := make(map[string]bool, len(existing))
+	for _, item := range existing {
+		seen[item.Text] = true
+	}
+
+	for _, pattern := range template.DoDItems {
+		text := entities.ExpandIterationPattern(pattern, iterationNum)
+		if seen[text] {
+			continue
+		}
+		item, err := s.AddDoDItem(ctx, iterationNum, text)
+		if err != nil {
+			return added, err
+		}
+		seen[text] = true
+		added = append(added, item)
+	}
+
+	return added, nil
+}
+
+// ListDoDItems returns the definition of done items of an iteration in creation order.
+func (s *IterationApplicationService) ListDoDItems(ctx context.Context, iterationNum int) ([]*entities.IterationDoDItemEntity, error) {
+	if _, err := s.iterationRepo.GetIteration(ctx, iterationNum); err != nil {
+		return nil, fmt.Errorf("failed to get iteration: %w", err)
+	}
+	return s.iterationRepo.ListIterationDoDItems(ctx, iterationNum)
+}
+
+// SetDoDItemDone checks or unchecks a definition of done item.
+func (s *IterationApplicationService) SetDoDItemDone(ctx context.Context, id int, done bool) (*entities.IterationDoDItemEntity, error) {
+	item, err := s.iterationRepo.GetIterationDoDItem(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	if done {
+		item.Check(now)
+	} else {
+		item.Uncheck(now)
+	}
+
+	if err := s.iterationRepo.UpdateIterationDoDItem(ctx, item); err != nil {
+		return nil, fmt.Errorf("failed to update definition of done item: %w", err)
+	}
+
+	return item, nil
+}
+
+// EnsureDoDComplete returns ErrInvalidArgument listing the open items when the
+// iteration's definition of done is not fully checked. An empty definition of done
+// is complete.
+func (s *IterationApplicationService) EnsureDoDComplete(ctx context.Context, iterationNum int) error {
+	items, err := s.iterationRepo.ListIterationDoDItems(ctx, iterationNum)
+	if err != nil {
+		return fmt.Errorf("failed to list definition of done items: %w", err)
+	}
+
+	open := []string{}
+	for _, item := range items {
+		if !item.Done {
+			open = append(open, fmt.Sprintf("#%d %s", item.ID, item.Text))
+		}
+	}
+	if len(open) > 0 {
+		return fmt.Errorf("%w: iteration %d has %d unchecked definition of done item(s): %s",
+			pluginsdk.ErrInvalidArgument, iterationNum, len(open), strings.Join(open, "; "))
+	}
+
+	return nil
+}
@@ -2,6 +2,10 @@ package application_test
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -9,6 +13,7 @@ import (
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application/dto"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application/mocks"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/services"
 )
@@ -22,7 +27,8 @@ func setupIterationTestService(t *testing.T) (*application.IterationApplicationS
 	iterationService := services.NewIterationService()
 	validationService := services.NewValidationService()
 
-	service := application.NewIterationApplicationService(mockIterationRepo, mockTaskRepo, mockAggregateRepo, iterationService, validationService)
+	txRepo := &mocks.MockTransactionalRepository{Iterations: mockIterationRepo, Tasks: mockTaskRepo, Aggregates: mockAggregateRepo}
+	service := application.NewIterationApplicationService(mockIterationRepo, mockTaskRepo, mockAggregateRepo, txRepo, iterationService, validationService)
 	ctx := context.Background()
 
 	return service, ctx, mockIterationRepo, mockTaskRepo, mockAggregateRepo, iterationService
@@ -1207,3 +1213,616 @@ func TestIterationService_CreateIteration_NumberGenerationAfterDeleteAll(t *test
 		t.Errorf("Iteration number after delete all = %d, want 1", iteration.Number)
 	}
 }
+
+// ============================================================================
+// Iteration Template Tests
+// ============================================================================
+
+func TestIterationService_NewIterationFromTemplate_PullsTopRankedBacklog(t *testing.T) {
+	service, ctx, mockIterationRepo, mockTaskRepo, _, _ := setupIterationTestService(t)
+
+	now := time.Now().UTC()
+	template, err := entities.NewIterationTemplateEntity("weekly", "Sprint {n}: focus", "Release {n}", now, now)
+	if err != nil {
+		t.Fatalf("failed to create template: %v", err)
+	}
+
+	mockIterationRepo.GetIterationTemplateFunc = func(ctx context.Context, name string) (*entities.IterationTemplateEntity, error) {
+		return template, nil
+	}
+	mockIterationRepo.ListIterationsFunc = func(ctx context.Context) ([]*entities.IterationEntity, error) {
+		return []*entities.IterationEntity{createTestIterationEntity(t, 3, "complete")}, nil
+	}
+	mockIterationRepo.GetIterationFunc = func(ctx context.Context, number int) (*entities.IterationEntity, error) {
+		return nil, pluginsdk.ErrNotFound
+	}
+	mockIterationRepo.SaveIterationFunc = func(ctx context.Context, iteration *entities.IterationEntity) error {
+		return nil
+	}
+
+	backlog := []*entities.TaskEntity{}
+	for _, spec := range []struct {
+		id   string
+		rank int
+	}{{"TM-task-1", 300}, {"TM-task-2", 100}, {"TM-task-3", 200}} {
+		task := createTestTaskEntity(t, spec.id)
+		task.Rank = spec.rank
+		backlog = append(backlog, task)
+	}
+	mockTaskRepo.GetBacklogTasksFunc = func(ctx context.Context, roadmapID string) ([]*entities.TaskEntity, error) {
+		return backlog, nil
+	}
+
+	var added []string
+	mockIterationRepo.AddTaskToIterationFunc = func(ctx context.Context, iterationNum int, taskID string) error {
+		if iterationNum != 4 {
+			t.Errorf("expected tasks added to iteration 4, got %d", iterationNum)
+		}
+		added = append(added, taskID)
+		return nil
+	}
+	usageIncremented := false
+	mockIterationRepo.IncrementIterationTemplateUsageFunc = func(ctx context.Context, name string) error {
+		usageIncremented = name == "weekly"
+		return nil
+	}
+
+	iteration, pulled, err := service.NewIterationFromTemplate(ctx, dto.NewIterationFromTemplateDTO{
+		Template:  "weekly",
+		PullTasks: 2,
+	})
+	if err != nil {
+		t.Fatalf("NewIterationFromTemplate() failed: %v", err)
+	}
+
+	if iteration.Number != 4 || iteration.Name != "weekly 4" {
+		t.Errorf("expected iteration 4 named %q, got %d %q", "weekly 4", iteration.Number, iteration.Name)
+	}
+	if iteration.Goal != "Sprint 4: focus" || iteration.Deliverable != "Release 4" {
+		t.Errorf("expected placeholders to be substituted, got goal %q deliverable %q", iteration.Goal, iteration.Deliverable)
+	}
+	if len(pulled) != 2 || len(added) != 2 || added[0] != "TM-task-2" || added[1] != "TM-task-3" {
+		t.Errorf("expected top 2 ranked tasks [TM-task-2 TM-task-3], got %v", added)
+	}
+	if !usageIncremented {
+		t.Error("expected template usage count to be incremented")
+	}
+}
+
+func TestIterationService_NewIterationFromTemplate_CustomName(t *testing.T) {
+	service, ctx, mockIterationRepo, _, _, _ := setupIterationTestService(t)
+
+	now := time.Now().UTC()
+	template, err := entities.NewIterationTemplateEntity("weekly", "Goal", "", now, now)
+	if err != nil {
+		t.Fatalf("failed to create template: %v", err)
+	}
+	mockIterationRepo.GetIterationTemplateFunc = func(ctx context.Context, name string) (*entities.IterationTemplateEntity, error) {
+		return template, nil
+	}
+	mockIterationRepo.GetIterationFunc = func(ctx context.Context, number int) (*entities.IterationEntity, error) {
+		return nil, pluginsdk.ErrNotFound
+	}
+	mockIterationRepo.SaveIterationFunc = func(ctx context.Context, iteration *entities.IterationEntity) error {
+		return nil
+	}
+
+	iteration, pulled, err := service.NewIterationFromTemplate(ctx, dto.NewIterationFromTemplateDTO{
+		Template: "weekly",
+		Name:     "Week {n}",
+	})
+	if err != nil {
+		t.Fatalf("NewIterationFromTemplate() failed: %v", err)
+	}
+	if iteration.Name != "Week 1" {
+		t.Errorf("expected name %q, got %q", "Week 1", iteration.Name)
+	}
+	if len(pulled) != 0 {
+		t.Errorf("expected no pulled tasks, got %d", len(pulled))
+	}
+}
+
+func TestIterationService_NewIterationFromTemplate_Errors(t *testing.T) {
+	service, ctx, mockIterationRepo, _, _, _ := setupIterationTestService(t)
+
+	mockIterationRepo.GetIterationTemplateFunc = func(ctx context.Context, name string) (*entities.IterationTemplateEntity, error) {
+		return nil, pluginsdk.ErrNotFound
+	}
+	saved := false
+	mockIterationRepo.SaveIterationFunc = func(ctx context.Context, iteration *entities.IterationEntity) error {
+		saved = true
+		return nil
+	}
+
+	_, _, err := service.NewIterationFromTemplate(ctx, dto.NewIterationFromTemplateDTO{Template: "missing"})
+	if !errors.Is(err, pluginsdk.ErrNotFound) {
+		t.Errorf("expected ErrNotFound for missing template, got: %v", err)
+	}
+
+	_, _, err = service.NewIterationFromTemplate(ctx, dto.NewIterationFromTemplateDTO{Template: "weekly", PullTasks: -1})
+	if !errors.Is(err, pluginsdk.ErrInvalidArgument) {
+		t.Errorf("expected ErrInvalidArgument for negative pull count, got: %v", err)
+	}
+
+	if saved {
+		t.Error("expected no iteration to be created on error")
+	}
+}
+
+func TestIterationService_CreateIterationTemplate_Invalid(t *testing.T) {
+	service, ctx, _, _, _, _ := setupIterationTestService(t)
+
+	tests := []struct {
+		name  string
+		input dto.CreateIterationTemplateDTO
+	}{
+		{"empty name", dto.CreateIterationTemplateDTO{GoalPattern: "Sprint {n}"}},
+		{"name with whitespace", dto.CreateIterationTemplateDTO{Name: "weekly sprint", GoalPattern: "Sprint {n}"}},
+		{"empty goal", dto.CreateIterationTemplateDTO{Name: "weekly"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.CreateIterationTemplate(ctx, tt.input)
+			if !errors.Is(err, pluginsdk.ErrInvalidArgument) {
+				t.Errorf("expected ErrInvalidArgument, got: %v", err)
+			}
+		})
+	}
+}
+
+// ============================================================================
+// Definition of Done Tests
+// ============================================================================
+
+func TestIterationService_NewIterationFromTemplate_CopiesDoD(t *testing.T) {
+	service, ctx, mockIterationRepo, _, _, _ := setupIterationTestService(t)
+
+	now := time.Now().UTC()
+	template, err := entities.NewIterationTemplateEntity("weekly", "Goal", "", now, now)
+	if err != nil {
+		t.Fatalf("failed to create template: %v", err)
+	}
+	template.DoDItems = []string{"Demo recorded", "Changelog {n} written"}
+	mockIterationRepo.GetIterationTemplateFunc = func(ctx context.Context, name string) (*entities.IterationTemplateEntity, error) {
+		return template, nil
+	}
+	mockIterationRepo.GetIterationFunc = func(ctx context.Context, number int) (*entities.IterationEntity, error) {
+		return nil, pluginsdk.ErrNotFound
+	}
+	mockIterationRepo.SaveIterationFunc = func(ctx context.Context, iteration *entities.IterationEntity) error {
+		return nil
+	}
+	var saved []*entities.IterationDoDItemEntity
+	mockIterationRepo.SaveIterationDoDItemFunc = func(ctx context.Context, item *entities.IterationDoDItemEntity) error {
+		saved = append(saved, item)
+		return nil
+	}
+
+	iteration, _, err := service.NewIterationFromTemplate(ctx, dto.NewIterationFromTemplateDTO{Template: "weekly"})
+	if err != nil {
+		t.Fatalf("NewIterationFromTemplate() failed: %v", err)
+	}
+
+	if len(saved) != 2 {
+		t.Fatalf("expected 2 definition of done items, got %d", len(saved))
+	}
+	if saved[0].IterationNum != iteration.Number || saved[0].Text != "Demo recorded" || saved[0].Done {
+		t.Errorf("unexpected first item: %+v", saved[0])
+	}
+	if saved[1].Text != "Changelog 1 written" {
+		t.Errorf("expected {n} to be substituted, got %q", saved[1].Text)
+	}
+}
+
+func TestIterationService_CopyDoDFromTemplate_SkipsExisting(t *testing.T) {
+	service, ctx, mockIterationRepo, _, _, _ := setupIterationTestService(t)
+
+	now := time.Now().UTC()
+	template, _ := entities.NewIterationTemplateEntity("weekly", "Goal", "", now, now)
+	template.DoDItems = []string{"Demo recorded", "Changelog {n} written"}
+	mockIterationRepo.GetIterationTemplateFunc = func(ctx context.Context, name string) (*entities.IterationTemplateEntity, error) {
+		return template, nil
+	}
+	existing, _ := entities.NewIterationDoDItemEntity(2, "Demo recorded", now)
+	mockIterationRepo.ListIterationDoDItemsFunc = func(ctx context.Context, iterationNum int) ([]*entities.IterationDoDItemEntity, error) {
+		return []*entities.IterationDoDItemEntity{existing}, nil
+	}
+	mockIterationRepo.SaveIterationDoDItemFunc = func(ctx context.Context, item *entities.IterationDoDItemEntity) error {
+		return nil
+	}
+
+	added, err := service.CopyDoDFromTemplate(ctx, 2, "weekly")
+	if err != nil {
+		t.Fatalf("CopyDoDFromTemplate() failed: %v", err)
+	}
+	if len(added) != 1 || added[0].Text != "Changelog 2 written" {
+		t.Errorf("expected only the missing item to be added, got %+v", added)
+	}
+
+	template.DoDItems = nil
+	if _, err := service.CopyDoDFromTemplate(ctx, 2, "weekly"); !errors.Is(err, pluginsdk.ErrInvalidArgument) {
+		t.Errorf("expected ErrInvalidArgument for template without items, got: %v", err)
+	}
+}
+
+func TestIterationService_SetDoDItemDone(t *testing.T) {
+	service, ctx, mockIterationRepo, _, _, _ := setupIterationTestService(t)
+
+	item, _ := entities.NewIterationDoDItemEntity(1, "Demo recorded", time.Now().UTC())
+	item.ID = 7
+	mockIterationRepo.GetIterationDoDItemFunc = func(ctx context.Context, id int) (*entities.IterationDoDItemEntity, error) {
+		if id != 7 {
+			return nil, pluginsdk.ErrNotFound
+		}
+		return item, nil
+	}
+	updates := 0
+	mockIterationRepo.UpdateIterationDoDItemFunc = func(ctx context.Context, item *entities.IterationDoDItemEntity) error {
+		updates++
+		return nil
+	}
+
+	checked, err := service.SetDoDItemDone(ctx, 7, true)
+	if err != nil {
+		t.Fatalf("SetDoDItemDone() failed: %v", err)
+	}
+	if !checked.Done || checked.DoneAt == nil {
+		t.Error("expected item to be done with DoneAt set")
+	}
+
+	unchecked, err := service.SetDoDItemDone(ctx, 7, false)
+	if err != nil {
+		t.Fatalf("SetDoDItemDone() failed: %v", err)
+	}
+	if unchecked.Done || unchecked.DoneAt != nil {
+		t.Error("expected item to be unchecked with DoneAt cleared")
+	}
+	if updates != 2 {
+		t.Errorf("expected 2 updates, got %d", updates)
+	}
+
+	if _, err := service.SetDoDItemDone(ctx, 8, true); !errors.Is(err, pluginsdk.ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got: %v", err)
+	}
+}
+
+func TestIterationService_EnsureDoDComplete(t *testing.T) {
+	service, ctx, mockIterationRepo, _, _, _ := setupIterationTestService(t)
+
+	now := time.Now().UTC()
+	done, _ := entities.NewIterationDoDItemEntity(1, "Demo recorded", now)
+	done.Check(now)
+	open, _ := entities.NewIterationDoDItemEntity(1, "Changelog written", now)
+	open.ID = 2
+	items := []*entities.IterationDoDItemEntity{done, open}
+	mockIterationRepo.ListIterationDoDItemsFunc = func(ctx context.Context, iterationNum int) ([]*entities.IterationDoDItemEntity, error) {
+		return items, nil
+	}
+
+	err := service.EnsureDoDComplete(ctx, 1)
+	if !errors.Is(err, pluginsdk.ErrInvalidArgument) {
+		t.Fatalf("expected ErrInvalidArgument with an open item, got: %v", err)
+	}
+	if !strings.Contains(err.Error(), "#2 Changelog written") {
+		t.Errorf("expected error to name the open item, got: %v", err)
+	}
+
+	open.Check(now)
+	if err := service.EnsureDoDComplete(ctx, 1); err != nil {
+		t.Errorf("expected complete checklist to pass, got: %v", err)
+	}
+
+	items = nil
+	if err := service.EnsureDoDComplete(ctx, 1); err != nil {
+		t.Errorf("expected empty checklist to pass, got: %v", err)
+	}
+}
+
+// ============================================================================
+// GetVelocity Tests
+// ============================================================================
+
+func TestIterationService_GetVelocity(t *testing.T) {
+	service, ctx, mockIterationRepo, _, _, _ := setupIterationTestService(t)
+
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	completedIteration := func(number int, daysAfter int) *entities.IterationEntity {
+		iter := createTestIterationEntity(t, number, "complete")
+		completedAt := base.AddDate(0, 0, daysAfter)
+		iter.CompletedAt = &completedAt
+		return iter
+	}
+	tasksWithDone := func(done, total int) []*entities.TaskEntity {
+		tasks := make([]*entities.TaskEntity, 0, total)
+		for i := 0; i < total; i++ {
+			task := createTestTaskEntity(t, "TM-task-"+string(rune('a'+i)))
+			if i < done {
+				task.Status = "done"
+			}
+			tasks = append(tasks, task)
+		}
+		return tasks
+	}
+
+	// Iteration 3 completed before iteration 2; iteration 5 is still current
+	mockIterationRepo.ListIterationsFunc = func(ctx context.Context) ([]*entities.IterationEntity, error) {
+		return []*entities.IterationEntity{
+			completedIteration(1, 0),
+			completedIteration(2, 21),
+			completedIteration(3, 14),
+			completedIteration(4, 28),
+			createTestIterationEntity(t, 5, "current"),
+		}, nil
+	}
+	done := map[int][2]int{1: {1, 4}, 2: {4, 5}, 3: {2, 2}, 4: {5, 6}}
+	mockIterationRepo.GetIterationTasksFunc = func(ctx context.Context, iterationNum int) ([]*entities.TaskEntity, error) {
+		counts := done[iterationNum]
+		return tasksWithDone(counts[0], counts[1]), nil
+	}
+
+	velocity, err := service.GetVelocity(ctx, 3)
+	if err != nil {
+		t.Fatalf("GetVelocity() failed: %v", err)
+	}
+
+	var numbers []int
+	for _, entry := range velocity.Iterations {
+		numbers = append(numbers, entry.Number)
+	}
+	if len(numbers) != 3 || numbers[0] != 3 || numbers[1] != 2 || numbers[2] != 4 {
+		t.Fatalf("iterations = %v, want [3 2 4] (last 3 by completion time)", numbers)
+	}
+	if velocity.Iterations[1].CompletedTasks != 4 || velocity.Iterations[1].TotalTasks != 5 {
+		t.Errorf("iteration 2 = %d/%d, want 4/5", velocity.Iterations[1].CompletedTasks, velocity.Iterations[1].TotalTasks)
+	}
+	if velocity.Average != 11.0/3.0 {
+		t.Errorf("Average = %v, want %v", velocity.Average, 11.0/3.0)
+	}
+	if velocity.Trend != "increasing" {
+		t.Errorf("Trend = %q, want increasing", velocity.Trend)
+	}
+}
+
+func TestIterationService_GetVelocity_FewerThanRequested(t *testing.T) {
+	service, ctx, mockIterationRepo, _, _, _ := setupIterationTestService(t)
+
+	mockIterationRepo.ListIterationsFunc = func(ctx context.Context) ([]*entities.IterationEntity, error) {
+		return []*entities.IterationEntity{createTestIterationEntity(t, 1, "planned")}, nil
+	}
+
+	velocity, err := service.GetVelocity(ctx, 5)
+	if err != nil {
+		t.Fatalf("GetVelocity() failed: %v", err)
+	}
+	if len(velocity.Iterations) != 0 {
+		t.Errorf("expected no completed iterations, got %d", len(velocity.Iterations))
+	}
+	if velocity.Requested != 5 || velocity.Average != 0 || velocity.Trend != "insufficient-data" {
+		t.Errorf("unexpected velocity: %+v", velocity)
+	}
+}
+
+func TestIterationService_GetVelocity_InvalidLast(t *testing.T) {
+	service, ctx, _, _, _, _ := setupIterationTestService(t)
+
+	_, err := service.GetVelocity(ctx, 0)
+	if !errors.Is(err, pluginsdk.ErrInvalidArgument) {
+		t.Errorf("GetVelocity(0) error = %v, want ErrInvalidArgument", err)
+	}
+}
+
+func TestIterationService_GetTimeline(t *testing.T) {
+	service, ctx, mockIterationRepo, _, _, _ := setupIterationTestService(t)
+
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	startedIteration := func(number int, daysAfter int, status string) *entities.IterationEntity {
+		iter := createTestIterationEntity(t, number, status)
+		startedAt := base.AddDate(0, 0, daysAfter)
+		iter.StartedAt = &startedAt
+		return iter
+	}
+
+	// Iteration 2 started before iteration 1; iteration 3 was never started
+	mockIterationRepo.ListIterationsFunc = func(ctx context.Context) ([]*entities.IterationEntity, error) {
+		return []*entities.IterationEntity{
+			startedIteration(1, 14, "current"),
+			startedIteration(2, 0, "complete"),
+			createTestIterationEntity(t, 3, "planned"),
+		}, nil
+	}
+	mockIterationRepo.GetIterationTasksFunc = func(ctx context.Context, iterationNum int) ([]*entities.TaskEntity, error) {
+		if iterationNum == 1 {
+			task := createTestTaskEntity(t, "TM-task-1")
+			task.Status = "in-progress"
+			return []*entities.TaskEntity{task}, nil
+		}
+		return []*entities.TaskEntity{}, nil
+	}
+
+	timeline, err := service.GetTimeline(ctx)
+	if err != nil {
+		t.Fatalf("GetTimeline() failed: %v", err)
+	}
+
+	if len(timeline.Scheduled) != 2 || timeline.Scheduled[0].Number != 2 || timeline.Scheduled[1].Number != 1 {
+		t.Fatalf("scheduled = %+v, want iterations [2 1] by start time", timeline.Scheduled)
+	}
+	tasks := timeline.Scheduled[1].Tasks
+	if len(tasks) != 1 || tasks[0].ID != "TM-task-1" || tasks[0].Status != "in-progress" {
+		t.Errorf("unexpected tasks of iteration 1: %+v", tasks)
+	}
+	if len(timeline.Unscheduled) != 1 || timeline.Unscheduled[0].Number != 3 {
+		t.Errorf("unscheduled = %+v, want iteration 3", timeline.Unscheduled)
+	}
+}
+
+// ============================================================================
+// CarryOver Tests
+// ============================================================================
+
+// setupCarryOver configures iteration 3 (complete) with a done, a todo and an in-progress
+// task, and records the moves made through the iteration repository
+func setupCarryOver(t *testing.T, target *entities.IterationEntity) (*application.IterationApplicationService, context.Context, *mocks.MockIterationRepository, *[]string) {
+	service, ctx, mockIterationRepo, _, _, _ := setupIterationTestService(t)
+
+	mockIterationRepo.GetIterationFunc = func(ctx context.Context, number int) (*entities.IterationEntity, error) {
+		switch {
+		case number == 3:
+			return createTestIterationEntity(t, 3, "complete"), nil
+		case number == 4 && target != nil:
+			return target, nil
+		}
+		return nil, pluginsdk.ErrNotFound
+	}
+
+	tasks := []*entities.TaskEntity{}
+	for id, status := range map[string]string{"TM-task-1": "done", "TM-task-2": "todo", "TM-task-3": "in-progress"} {
+		task := createTestTaskEntity(t, id)
+		task.Status = status
+		tasks = append(tasks, task)
+	}
+	mockIterationRepo.GetIterationTasksFunc = func(ctx context.Context, iterationNum int) ([]*entities.TaskEntity, error) {
+		return tasks, nil
+	}
+
+	moves := []string{}
+	mockIterationRepo.RemoveTaskFromIterationFunc = func(ctx context.Context, iterationNum int, taskID string) error {
+		moves = append(moves, fmt.Sprintf("-%d %s", iterationNum, taskID))
+		return nil
+	}
+	mockIterationRepo.AddTaskToIterationFunc = func(ctx context.Context, iterationNum int, taskID string) error {
+		moves = append(moves, fmt.Sprintf("+%d %s", iterationNum, taskID))
+		return nil
+	}
+	return service, ctx, mockIterationRepo, &moves
+}
+
+func TestIterationService_CarryOver_MovesUnfinishedTasks(t *testing.T) {
+	service, ctx, _, moves := setupCarryOver(t, createTestIterationEntity(t, 4, "planned"))
+
+	target, moved, created, err := service.CarryOver(ctx, dto.CarryOverDTO{From: 3, To: 4})
+	if err != nil {
+		t.Fatalf("CarryOver() failed: %v", err)
+	}
+	if created {
+		t.Error("expected the existing iteration 4 to be used")
+	}
+
+	ids := []string{}
+	for _, task := range moved {
+		ids = append(ids, task.ID)
+	}
+	sort.Strings(ids)
+	if strings.Join(ids, ",") != "TM-task-2,TM-task-3" {
+		t.Errorf("expected the non-done tasks to move, got %v", ids)
+	}
+	if len(*moves) != 4 {
+		t.Errorf("expected a remove and an add per moved task, got %v", *moves)
+	}
+	for _, move := range *moves {
+		if strings.Contains(move, "TM-task-1") {
+			t.Errorf("expected the done task to stay in iteration 3, got %s", move)
+		}
+	}
+	if !target.HasTask("TM-task-2") || !target.HasTask("TM-task-3") {
+		t.Errorf("expected the target to list the moved tasks, got %v", target.TaskIDs)
+	}
+}
+
+func TestIterationService_CarryOver_CreatesTarget(t *testing.T) {
+	service, ctx, mockIterationRepo, _ := setupCarryOver(t, nil)
+
+	var saved *entities.IterationEntity
+	mockIterationRepo.SaveIterationFunc = func(ctx context.Context, iteration *entities.IterationEntity) error {
+		saved = iteration
+		return nil
+	}
+
+	if _, _, _, err := service.CarryOver(ctx, dto.CarryOverDTO{From: 3, To: 4}); !errors.Is(err, pluginsdk.ErrNotFound) {
+		t.Errorf("expected ErrNotFound without --create, got %v", err)
+	}
+
+	target, moved, created, err := service.CarryOver(ctx, dto.CarryOverDTO{From: 3, To: 4, Create: true})
+	if err != nil {
+		t.Fatalf("CarryOver() failed: %v", err)
+	}
+	if !created || saved == nil || saved.Number != 4 || saved.Status != "planned" {
+		t.Errorf("expected planned iteration 4 to be created, got created=%v saved=%+v", created, saved)
+	}
+	if target.Number != 4 || len(moved) != 2 {
+		t.Errorf("expected 2 tasks moved to iteration 4, got %d to %d", len(moved), target.Number)
+	}
+}
+
+func TestIterationService_CarryOver_Errors(t *testing.T) {
+	service, ctx, _, moves := setupCarryOver(t, createTestIterationEntity(t, 4, "complete"))
+
+	if _, _, _, err := service.CarryOver(ctx, dto.CarryOverDTO{From: 3, To: 4}); !errors.Is(err, pluginsdk.ErrInvalidArgument) {
+		t.Errorf("expected ErrInvalidArgument for a complete target, got %v", err)
+	}
+	if _, _, _, err := service.CarryOver(ctx, dto.CarryOverDTO{From: 3, To: 3}); !errors.Is(err, pluginsdk.ErrInvalidArgument) {
+		t.Errorf("expected ErrInvalidArgument for the same iteration, got %v", err)
+	}
+	if _, _, _, err := service.CarryOver(ctx, dto.CarryOverDTO{From: 7, To: 4}); !errors.Is(err, pluginsdk.ErrNotFound) {
+		t.Errorf("expected ErrNotFound for a missing source, got %v", err)
+	}
+	if len(*moves) != 0 {
+		t.Errorf("expected no task moved, got %v", *moves)
+	}
+}
+
+// movesTxRepository runs a carry-over against a view that fails the second add,
+// and reports whether the closure went through WithTx
+type movesTxRepository struct {
+	used bool
+}
+
+type failingMovesRepository struct {
+	domain.RoadmapRepository
+	adds int
+}
+
+func (r *failingMovesRepository) RemoveTaskFromIteration(ctx context.Context, iterationNum int, taskID string) error {
+	return nil
+}
+
+func (r *failingMovesRepository) AddTaskToIteration(ctx context.Context, iterationNum int, taskID string) error {
+	r.adds++
+	if r.adds == 2 {
+		return errors.New("disk I/O error")
+	}
+	return nil
+}
+
+func (r *movesTxRepository) WithTx(ctx context.Context, fn func(domain.RoadmapRepository) error) error {
+	r.used = true
+	return fn(&failingMovesRepository{})
+}
+
+func TestIterationService_CarryOver_Transactional(t *testing.T) {
+	mockIterationRepo := &mocks.MockIterationRepository{}
+	txRepo := &movesTxRepository{}
+	service := application.NewIterationApplicationService(mockIterationRepo, &mocks.MockTaskRepository{}, &mocks.MockAggregateRepository{},
+		txRepo, services.NewIterationService(), services.NewValidationService())
+	ctx := context.Background()
+
+	mockIterationRepo.GetIterationFunc = func(ctx context.Context, number int) (*entities.IterationEntity, error) {
+		return createTestIterationEntity(t, number, "planned"), nil
+	}
+	mockIterationRepo.GetIterationTasksFunc = func(ctx context.Context, iterationNum int) ([]*entities.TaskEntity, error) {
+		return []*entities.TaskEntity{createTestTaskEntity(t, "TM-task-1"), createTestTaskEntity(t, "TM-task-2")}, nil
+	}
+	mockIterationRepo.AddTaskToIterationFunc = func(ctx context.Context, iterationNum int, taskID string) error {
+		t.Error("expected moves to go through the transaction, not the plain repository")
+		return nil
+	}
+
+	_, _, _, err := service.CarryOver(ctx, dto.CarryOverDTO{From: 3, To: 4})
+	if !txRepo.used {
+		t.Error("expected the carry-over to run in a transaction")
+	}
+	if err == nil || !strings.Contains(err.Error(), "disk I/O error") {
+		t.Errorf("expected the failed move to abort the carry-over, got %v", err)
+	}
+}
@@ -11,6 +11,9 @@ type MockAcceptanceCriteriaRepository struct {
 	// SaveACFunc is called by SaveAC. If nil, returns nil.
 	SaveACFunc func(ctx context.Context, ac *entities.AcceptanceCriteriaEntity) error
 
+	// SaveACsFunc is called by SaveACs. If nil, returns nil.
+	SaveACsFunc func(ctx context.Context, acs []*entities.AcceptanceCriteriaEntity) error
+
 	// GetACFunc is called by GetAC. If nil, returns nil, nil.
 	GetACFunc func(ctx context.Context, id string) (*entities.AcceptanceCriteriaEntity, error)
 
@@ -31,6 +34,39 @@ type MockAcceptanceCriteriaRepository struct {
 
 	// ListFailedACFunc is called by ListFailedAC. If nil, returns empty slice, nil.
 	ListFailedACFunc func(ctx context.Context, filters entities.ACFilters) ([]*entities.AcceptanceCriteriaEntity, error)
+
+	// ListACsFunc is called by ListACs. If nil, returns empty slice, nil.
+	ListACsFunc func(ctx context.Context, filters entities.ACFilters) ([]*entities.AcceptanceCriteriaEntity, error)
+
+	// CountACProgressByTaskFunc is called by CountACProgressByTask. If nil, returns empty map, nil.
+	CountACProgressByTaskFunc func(ctx context.Context, taskIDs []string) (map[string]entities.ACProgress, error)
+
+	// ListTaskACCoverageFunc is called by ListTaskACCoverage. If nil, returns empty slice, nil.
+	ListTaskACCoverageFunc func(ctx context.Context, filters entities.ACFilters, roadmapID string) ([]*entities.TaskACCoverage, error)
+
+	// AddACTagFunc is called by AddACTag. If nil, returns nil.
+	AddACTagFunc func(ctx context.Context, acID, tag string) error
+
+	// RemoveACTagFunc is called by RemoveACTag. If nil, returns nil.
+	RemoveACTagFunc func(ctx context.Context, acID, tag string) error
+
+	// ListACTagsFunc is called by ListACTags. If nil, returns empty slice, nil.
+	ListACTagsFunc func(ctx context.Context, acID string) ([]string, error)
+
+	// ListTaggedACFunc is called by ListTaggedAC. If nil, returns empty slice, nil.
+	ListTaggedACFunc func(ctx context.Context, taskID, tag string) ([]*entities.AcceptanceCriteriaEntity, error)
+
+	// SaveACTemplateFunc is called by SaveACTemplate. If nil, returns nil.
+	SaveACTemplateFunc func(ctx context.Context, template *entities.ACTemplateEntity) error
+
+	// GetACTemplateFunc is called by GetACTemplate. If nil, returns nil, nil.
+	GetACTemplateFunc func(ctx context.Context, name string) (*entities.ACTemplateEntity, error)
+
+	// ListACTemplatesFunc is called by ListACTemplates. If nil, returns empty slice, nil.
+	ListACTemplatesFunc func(ctx context.Context) ([]*entities.ACTemplateEntity, error)
+
+	// DeleteACTemplateFunc is called by DeleteACTemplate. If nil, returns nil.
+	DeleteACTemplateFunc func(ctx context.Context, name string) error
 }
 
 // SaveAC implements repositories.AcceptanceCriteriaRepository.
@@ -41,6 +77,14 @@ func (m *MockAcceptanceCriteriaRepository) SaveAC(ctx context.Context, ac *entit
 	return nil
 }
 
+// SaveACs implements repositories.AcceptanceCriteriaRepository.
+func (m *MockAcceptanceCriteriaRepository) SaveACs(ctx context.Context, acs []*entities.AcceptanceCriteriaEntity) error {
+	if m.SaveACsFunc != nil {
+		return m.SaveACsFunc(ctx, acs)
+	}
+	return nil
+}
+
 // GetAC implements repositories.AcceptanceCriteriaRepository.
 func (m *MockAcceptanceCriteriaRepository) GetAC(ctx context.Context, id string) (*entities.AcceptanceCriteriaEntity, error) {
 	if m.GetACFunc != nil {
@@ -97,6 +141,94 @@ func (m *MockAcceptanceCriteriaRepository) ListFailedAC(ctx context.Context, fil
 	return []*entities.AcceptanceCriteriaEntity{}, nil
 }
 
+// ListACs implements repositories.AcceptanceCriteriaRepository.
+func (m *MockAcceptanceCriteriaRepository) ListACs(ctx context.Context, filters entities.ACFilters) ([]*entities.AcceptanceCriteriaEntity, error) {
+	if m.ListACsFunc != nil {
+		return m.ListACsFunc(ctx, filters)
+	}
+	return []*entities.AcceptanceCriteriaEntity{}, nil
+}
+
+// CountACProgressByTask implements repositories.AcceptanceCriteriaRepository.
+func (m *MockAcceptanceCriteriaRepository) CountACProgressByTask(ctx context.Context, taskIDs []string) (map[string]entities.ACProgress, error) {
+	if m.CountACProgressByTaskFunc != nil {
+		return m.CountACProgressByTaskFunc(ctx, taskIDs)
+	}
+	return map[string]entities.ACProgress{}, nil
+}
+
+// ListTaskACCoverage implements repositories.AcceptanceCriteriaRepository.
+func (m *MockAcceptanceCriteriaRepository) ListTaskACCoverage(ctx context.Context, filters entities.ACFilters, roadmapID string) ([]*entities.TaskACCoverage, error) {
+	if m.ListTaskACCoverageFunc != nil {
+		return m.ListTaskACCoverageFunc(ctx, filters, roadmapID)
+	}
+	return []*entities.TaskACCoverage{}, nil
+}
+
+// AddACTag implements repositories.AcceptanceCriteriaRepository.
+func (m *MockAcceptanceCriteriaRepository) AddACTag(ctx context.Context, acID, tag string) error {
+	if m.AddACTagFunc != nil {
+		return m.AddACTagFunc(ctx, acID, tag)
+	}
+	return nil
+}
+
+// RemoveACTag implements repositories.AcceptanceCriteriaRepository.
+func (m *MockAcceptanceCriteriaRepository) RemoveACTag(ctx context.Context, acID, tag string) error {
+	if m.RemoveACTagFunc != nil {
+		return m.RemoveACTagFunc(ctx, acID, tag)
+	}
+	return nil
+}
+
+// ListACTags implements repositories.AcceptanceCriteriaRepository.
+func (m *MockAcceptanceCriteriaRepository) ListACTags(ctx context.Context, acID string) ([]string, error) {
+	if m.ListACTagsFunc != nil {
+		return m.ListACTagsFunc(ctx, acID)
+	}
+	return []string{}, nil
+}
+
+// ListTaggedAC implements repositories.AcceptanceCriteriaRepository.
+func (m *MockAcceptanceCriteriaRepository) ListTaggedAC(ctx context.Context, taskID, tag string) ([]*entities.AcceptanceCriteriaEntity, error) {
+	if m.ListTaggedACFunc != nil {
+		return m.ListTaggedACFunc(ctx, taskID, tag)
+	}
+	return []*entities.AcceptanceCriteriaEntity{}, nil
+}
+
+// SaveACTemplate implements repositories.AcceptanceCriteriaRepository.
+func (m *MockAcceptanceCriteriaRepository) SaveACTemplate(ctx context.Context, template *entities.ACTemplateEntity) error {
+	if m.SaveACTemplateFunc != nil {
+		return m.SaveACTemplateFunc(ctx, template)
+	}
+	return nil
+}
+
+// GetACTemplate implements repositories.AcceptanceCriteriaRepository.
+func (m *MockAcceptanceCriteriaRepository) GetACTemplate(ctx context.Context, name string) (*entities.ACTemplateEntity, error) {
+	if m.GetACTemplateFunc != nil {
+		return m.GetACTemplateFunc(ctx, name)
+	}
+	return nil, nil
+}
+
+// ListACTemplates implements repositories.AcceptanceCriteriaRepository.
+func (m *MockAcceptanceCriteriaRepository) ListACTemplates(ctx context.Context) ([]*entities.ACTemplateEntity, error) {
+	if m.ListACTemplatesFunc != nil {
+		return m.ListACTemplatesFunc(ctx)
+	}
+	return []*entities.ACTemplateEntity{}, nil
+}
+
+// DeleteACTemplate implements repositories.AcceptanceCriteriaRepository.
+func (m *MockAcceptanceCriteriaRepository) DeleteACTemplate(ctx context.Context, name string) error {
+	if m.DeleteACTemplateFunc != nil {
+		return m.DeleteACTemplateFunc(ctx, name)
+	}
+	return nil
+}
+
 // Reset clears all configured behavior.
 func (m *MockAcceptanceCriteriaRepository) Reset() {
 	m.SaveACFunc = nil
@@ -107,6 +239,17 @@ func (m *MockAcceptanceCriteriaRepository) Reset() {
 	m.ListACByTaskFunc = nil
 	m.ListACByIterationFunc = nil
 	m.ListFailedACFunc = nil
+	m.ListACsFunc = nil
+	m.CountACProgressByTaskFunc = nil
+	m.ListTaskACCoverageFunc = nil
+	m.AddACTagFunc = nil
+	m.RemoveACTagFunc = nil
+	m.ListACTagsFunc = nil
+	m.ListTaggedACFunc = nil
+	m.SaveACTemplateFunc = nil
+	m.GetACTemplateFunc = nil
+	m.ListACTemplatesFunc = nil
+	m.DeleteACTemplateFunc = nil
 }
 
 // WithError configures the mock to return the specified error for all methods.
@@ -129,5 +272,28 @@ func (m *MockAcceptanceCriteriaRepository) WithError(err error) *MockAcceptanceC
 	m.ListFailedACFunc = func(ctx context.Context, filters entities.ACFilters) ([]*entities.AcceptanceCriteriaEntity, error) {
 		return nil, err
 	}
+	m.ListACsFunc = func(ctx context.Context, filters entities.ACFilters) ([]*entities.AcceptanceCriteriaEntity, error) {
+		return nil, err
+	}
+	m.CountACProgressByTaskFunc = func(ctx context.Context, taskIDs []string) (map[string]entities.ACProgress, error) {
+		return nil, err
+	}
+	m.ListTaskACCoverageFunc = func(ctx context.Context, filters entities.ACFilters, roadmapID string) ([]*entities.TaskACCoverage, error) {
+		return nil, err
+	}
+	m.AddACTagFunc = func(ctx context.Context, acID, tag string) error { return err }
+	m.RemoveACTagFunc = func(ctx context.Context, acID, tag string) error { return err }
+	m.ListACTagsFunc = func(ctx context.Context, acID string) ([]string, error) { return nil, err }
+	m.ListTaggedACFunc = func(ctx context.Context, taskID, tag string) ([]*entities.AcceptanceCriteriaEntity, error) {
+		return nil, err
+	}
+	m.SaveACTemplateFunc = func(ctx context.Context, template *entities.ACTemplateEntity) error { return err }
+	m.GetACTemplateFunc = func(ctx context.Context, name string) (*entities.ACTemplateEntity, error) {
+		return nil, err
+	}
+	m.ListACTemplatesFunc = func(ctx context.Context) ([]*entities.ACTemplateEntity, error) {
+		return nil, err
+	}
+	m.DeleteACTemplateFunc = func(ctx context.Context, name string) error { return err }
 	return m
 }
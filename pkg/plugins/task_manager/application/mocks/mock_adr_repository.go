@@ -28,6 +28,15 @@ type MockADRRepository struct {
 
 	// GetADRsByTrackFunc is called by GetADRsByTrack. If nil, returns empty slice, nil.
 	GetADRsByTrackFunc func(ctx context.Context, trackID string) ([]*entities.ADREntity, error)
+
+	// LinkADRToTaskFunc is called by LinkADRToTask. If nil, returns nil.
+	LinkADRToTaskFunc func(ctx context.Context, adrID, taskID string) error
+
+	// ListADRTasksFunc is called by ListADRTasks. If nil, returns empty slice, nil.
+	ListADRTasksFunc func(ctx context.Context, adrID string) ([]*entities.TaskEntity, error)
+
+	// ListTaskADRsFunc is called by ListTaskADRs. If nil, returns empty slice, nil.
+	ListTaskADRsFunc func(ctx context.Context, taskID string) ([]*entities.ADREntity, error)
 }
 
 // SaveADR implements repositories.ADRRepository.
@@ -86,6 +95,30 @@ func (m *MockADRRepository) GetADRsByTrack(ctx context.Context, trackID string)
 	return []*entities.ADREntity{}, nil
 }
 
+// LinkADRToTask implements repositories.ADRRepository.
+func (m *MockADRRepository) LinkADRToTask(ctx context.Context, adrID, taskID string) error {
+	if m.LinkADRToTaskFunc != nil {
+		return m.LinkADRToTaskFunc(ctx, adrID, taskID)
+	}
+	return nil
+}
+
+// ListADRTasks implements repositories.ADRRepository.
+func (m *MockADRRepository) ListADRTasks(ctx context.Context, adrID string) ([]*entities.TaskEntity, error) {
+	if m.ListADRTasksFunc != nil {
+		return m.ListADRTasksFunc(ctx, adrID)
+	}
+	return []*entities.TaskEntity{}, nil
+}
+
+// ListTaskADRs implements repositories.ADRRepository.
+func (m *MockADRRepository) ListTaskADRs(ctx context.Context, taskID string) ([]*entities.ADREntity, error) {
+	if m.ListTaskADRsFunc != nil {
+		return m.ListTaskADRsFunc(ctx, taskID)
+	}
+	return []*entities.ADREntity{}, nil
+}
+
 // Reset clears all configured behavior.
 func (m *MockADRRepository) Reset() {
 	m.SaveADRFunc = nil
@@ -95,6 +128,9 @@ func (m *MockADRRepository) Reset() {
 	m.SupersedeADRFunc = nil
 	m.DeprecateADRFunc = nil
 	m.GetADRsByTrackFunc = nil
+	m.LinkADRToTaskFunc = nil
+	m.ListADRTasksFunc = nil
+	m.ListTaskADRsFunc = nil
 }
 
 // WithError configures the mock to return the specified error for all methods.
@@ -108,5 +144,8 @@ func (m *MockADRRepository) WithError(err error) *MockADRRepository {
 	m.GetADRsByTrackFunc = func(ctx context.Context, trackID string) ([]*entities.ADREntity, error) {
 		return nil, err
 	}
+	m.LinkADRToTaskFunc = func(ctx context.Context, adrID, taskID string) error { return err }
+	m.ListADRTasksFunc = func(ctx context.Context, adrID string) ([]*entities.TaskEntity, error) { return nil, err }
+	m.ListTaskADRsFunc = func(ctx context.Context, taskID string) ([]*entities.ADREntity, error) { return nil, err }
 	return m
 }
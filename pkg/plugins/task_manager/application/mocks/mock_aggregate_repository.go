@@ -22,6 +22,15 @@ type MockAggregateRepository struct {
 
 	// GetNextSequenceNumberFunc is called by GetNextSequenceNumber. If nil, returns 1, nil.
 	GetNextSequenceNumberFunc func(ctx context.Context, entityType string) (int, error)
+
+	// SearchFunc is called by Search. If nil, returns empty slice, nil.
+	SearchFunc func(ctx context.Context, term string, entityTypes []string) ([]*entities.SearchResult, error)
+
+	// PlanDeletionFunc is called by PlanDeletion. If nil, returns an empty plan, nil.
+	PlanDeletionFunc func(ctx context.Context, entityType, id string) (*entities.DeletionPlan, error)
+
+	// CountByStatusFunc is called by CountByStatus. If nil, returns empty counts, nil.
+	CountByStatusFunc func(ctx context.Context) (*entities.StatusCounts, error)
 }
 
 // GetRoadmapWithTracks implements repositories.AggregateRepository.
@@ -64,6 +73,30 @@ func (m *MockAggregateRepository) GetNextSequenceNumber(ctx context.Context, ent
 	return 1, nil
 }
 
+// Search implements repositories.AggregateRepository.
+func (m *MockAggregateRepository) Search(ctx context.Context, term string, entityTypes []string) ([]*entities.SearchResult, error) {
+	if m.SearchFunc != nil {
+		return m.SearchFunc(ctx, term, entityTypes)
+	}
+	return []*entities.SearchResult{}, nil
+}
+
+// PlanDeletion implements repositories.AggregateRepository.
+func (m *MockAggregateRepository) PlanDeletion(ctx context.Context, entityType, id string) (*entities.DeletionPlan, error) {
+	if m.PlanDeletionFunc != nil {
+		return m.PlanDeletionFunc(ctx, entityType, id)
+	}
+	return &entities.DeletionPlan{TargetType: entityType, TargetID: id}, nil
+}
+
+// CountByStatus implements repositories.AggregateRepository.
+func (m *MockAggregateRepository) CountByStatus(ctx context.Context) (*entities.StatusCounts, error) {
+	if m.CountByStatusFunc != nil {
+		return m.CountByStatusFunc(ctx)
+	}
+	return &entities.StatusCounts{Tracks: map[string]int{}, Tasks: map[string]int{}, ACs: map[string]int{}}, nil
+}
+
 // Reset clears all configured behavior.
 func (m *MockAggregateRepository) Reset() {
 	m.GetRoadmapWithTracksFunc = nil
@@ -71,6 +104,9 @@ func (m *MockAggregateRepository) Reset() {
 	m.SetProjectMetadataFunc = nil
 	m.GetProjectCodeFunc = nil
 	m.GetNextSequenceNumberFunc = nil
+	m.SearchFunc = nil
+	m.PlanDeletionFunc = nil
+	m.CountByStatusFunc = nil
 }
 
 // WithError configures the mock to return the specified error for methods that can fail.
@@ -79,5 +115,10 @@ func (m *MockAggregateRepository) WithError(err error) *MockAggregateRepository
 	m.GetProjectMetadataFunc = func(ctx context.Context, key string) (string, error) { return "", err }
 	m.SetProjectMetadataFunc = func(ctx context.Context, key, value string) error { return err }
 	m.GetNextSequenceNumberFunc = func(ctx context.Context, entityType string) (int, error) { return 0, err }
+	m.SearchFunc = func(ctx context.Context, term string, entityTypes []string) ([]*entities.SearchResult, error) {
+		return nil, err
+	}
+	m.PlanDeletionFunc = func(ctx context.Context, entityType, id string) (*entities.DeletionPlan, error) { return nil, err }
+	m.CountByStatusFunc = func(ctx context.Context) (*entities.StatusCounts, error) { return nil, err }
 	return m
 }
@@ -23,6 +23,9 @@ type MockIterationRepository struct {
 	// ListIterationsFunc is called by ListIterations. If nil, returns empty slice, nil.
 	ListIterationsFunc func(ctx context.Context) ([]*entities.IterationEntity, error)
 
+	// ListIterationsByRoadmapFunc is called by ListIterationsByRoadmap. If nil, returns ListIterations.
+	ListIterationsByRoadmapFunc func(ctx context.Context, roadmapID string) ([]*entities.IterationEntity, error)
+
 	// UpdateIterationFunc is called by UpdateIteration. If nil, returns nil.
 	UpdateIterationFunc func(ctx context.Context, iteration *entities.IterationEntity) error
 
@@ -55,6 +58,33 @@ type MockIterationRepository struct {
 
 	// GetNextPlannedIterationFunc is called by GetNextPlannedIteration. If nil, returns nil, nil.
 	GetNextPlannedIterationFunc func(ctx context.Context) (*entities.IterationEntity, error)
+
+	// SaveIterationDoDItemFunc is called by SaveIterationDoDItem. If nil, returns nil.
+	SaveIterationDoDItemFunc func(ctx context.Context, item *entities.IterationDoDItemEntity) error
+
+	// GetIterationDoDItemFunc is called by GetIterationDoDItem. If nil, returns nil, nil.
+	GetIterationDoDItemFunc func(ctx context.Context, id int) (*entities.IterationDoDItemEntity, error)
+
+	// ListIterationDoDItemsFunc is called by ListIterationDoDItems. If nil, returns empty slice, nil.
+	ListIterationDoDItemsFunc func(ctx context.Context, iterationNum int) ([]*entities.IterationDoDItemEntity, error)
+
+	// UpdateIterationDoDItemFunc is called by UpdateIterationDoDItem. If nil, returns nil.
+	UpdateIterationDoDItemFunc func(ctx context.Context, item *entities.IterationDoDItemEntity) error
+
+	// SaveIterationTemplateFunc is called by SaveIterationTemplate. If nil, returns nil.
+	SaveIterationTemplateFunc func(ctx context.Context, template *entities.IterationTemplateEntity) error
+
+	// GetIterationTemplateFunc is called by GetIterationTemplate. If nil, returns nil, nil.
+	GetIterationTemplateFunc func(ctx context.Context, name string) (*entities.IterationTemplateEntity, error)
+
+	// ListIterationTemplatesFunc is called by ListIterationTemplates. If nil, returns empty slice, nil.
+	ListIterationTemplatesFunc func(ctx context.Context) ([]*entities.IterationTemplateEntity, error)
+
+	// DeleteIterationTemplateFunc is called by DeleteIterationTemplate. If nil, returns nil.
+	DeleteIterationTemplateFunc func(ctx context.Context, name string) error
+
+	// IncrementIterationTemplateUsageFunc is called by IncrementIterationTemplateUsage. If nil, returns nil.
+	IncrementIterationTemplateUsageFunc func(ctx context.Context, name string) error
 }
 
 // NewMockIterationRepository creates a new mock iteration repository with in-memory storage
@@ -114,6 +144,14 @@ func (m *MockIterationRepository) ListIterations(ctx context.Context) ([]*entiti
 	return result, nil
 }
 
+// ListIterationsByRoadmap implements repositories.IterationRepository.
+func (m *MockIterationRepository) ListIterationsByRoadmap(ctx context.Context, roadmapID string) ([]*entities.IterationEntity, error) {
+	if m.ListIterationsByRoadmapFunc != nil {
+		return m.ListIterationsByRoadmapFunc(ctx, roadmapID)
+	}
+	return m.ListIterations(ctx)
+}
+
 // UpdateIteration implements repositories.IterationRepository.
 func (m *MockIterationRepository) UpdateIteration(ctx context.Context, iteration *entities.IterationEntity) error {
 	if m.UpdateIterationFunc != nil {
@@ -208,12 +246,85 @@ func (m *MockIterationRepository) GetNextPlannedIteration(ctx context.Context) (
 	return nil, nil
 }
 
+// SaveIterationDoDItem implements repositories.IterationRepository.
+func (m *MockIterationRepository) SaveIterationDoDItem(ctx context.Context, item *entities.IterationDoDItemEntity) error {
+	if m.SaveIterationDoDItemFunc != nil {
+		return m.SaveIterationDoDItemFunc(ctx, item)
+	}
+	return nil
+}
+
+// GetIterationDoDItem implements repositories.IterationRepository.
+func (m *MockIterationRepository) GetIterationDoDItem(ctx context.Context, id int) (*entities.IterationDoDItemEntity, error) {
+	if m.GetIterationDoDItemFunc != nil {
+		return m.GetIterationDoDItemFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+// ListIterationDoDItems implements repositories.IterationRepository.
+func (m *MockIterationRepository) ListIterationDoDItems(ctx context.Context, iterationNum int) ([]*entities.IterationDoDItemEntity, error) {
+	if m.ListIterationDoDItemsFunc != nil {
+		return m.ListIterationDoDItemsFunc(ctx, iterationNum)
+	}
+	return []*entities.IterationDoDItemEntity{}, nil
+}
+
+// UpdateIterationDoDItem implements repositories.IterationRepository.
+func (m *MockIterationRepository) UpdateIterationDoDItem(ctx context.Context, item *entities.IterationDoDItemEntity) error {
+	if m.UpdateIterationDoDItemFunc != nil {
+		return m.UpdateIterationDoDItemFunc(ctx, item)
+	}
+	return nil
+}
+
+// SaveIterationTemplate implements repositories.IterationRepository.
+func (m *MockIterationRepository) SaveIterationTemplate(ctx context.Context, template *entities.IterationTemplateEntity) error {
+	if m.SaveIterationTemplateFunc != nil {
+		return m.SaveIterationTemplateFunc(ctx, template)
+	}
+	return nil
+}
+
+// GetIterationTemplate implements repositories.IterationRepository.
+func (m *MockIterationRepository) GetIterationTemplate(ctx context.Context, name string) (*entities.IterationTemplateEntity, error) {
+	if m.GetIterationTemplateFunc != nil {
+		return m.GetIterationTemplateFunc(ctx, name)
+	}
+	return nil, nil
+}
+
+// ListIterationTemplates implements repositories.IterationRepository.
+func (m *MockIterationRepository) ListIterationTemplates(ctx context.Context) ([]*entities.IterationTemplateEntity, error) {
+	if m.ListIterationTemplatesFunc != nil {
+		return m.ListIterationTemplatesFunc(ctx)
+	}
+	return []*entities.IterationTemplateEntity{}, nil
+}
+
+// DeleteIterationTemplate implements repositories.IterationRepository.
+func (m *MockIterationRepository) DeleteIterationTemplate(ctx context.Context, name string) error {
+	if m.DeleteIterationTemplateFunc != nil {
+		return m.DeleteIterationTemplateFunc(ctx, name)
+	}
+	return nil
+}
+
+// IncrementIterationTemplateUsage implements repositories.IterationRepository.
+func (m *MockIterationRepository) IncrementIterationTemplateUsage(ctx context.Context, name string) error {
+	if m.IncrementIterationTemplateUsageFunc != nil {
+		return m.IncrementIterationTemplateUsageFunc(ctx, name)
+	}
+	return nil
+}
+
 // Reset clears all configured behavior.
 func (m *MockIterationRepository) Reset() {
 	m.SaveIterationFunc = nil
 	m.GetIterationFunc = nil
 	m.GetCurrentIterationFunc = nil
 	m.ListIterationsFunc = nil
+	m.ListIterationsByRoadmapFunc = nil
 	m.UpdateIterationFunc = nil
 	m.DeleteIterationFunc = nil
 	m.AddTaskToIterationFunc = nil
@@ -224,6 +335,15 @@ func (m *MockIterationRepository) Reset() {
 	m.CompleteIterationFunc = nil
 	m.GetIterationByNumberFunc = nil
 	m.GetNextPlannedIterationFunc = nil
+	m.SaveIterationDoDItemFunc = nil
+	m.GetIterationDoDItemFunc = nil
+	m.ListIterationDoDItemsFunc = nil
+	m.UpdateIterationDoDItemFunc = nil
+	m.SaveIterationTemplateFunc = nil
+	m.GetIterationTemplateFunc = nil
+	m.ListIterationTemplatesFunc = nil
+	m.DeleteIterationTemplateFunc = nil
+	m.IncrementIterationTemplateUsageFunc = nil
 }
 
 // WithError configures the mock to return the specified error for all methods.
@@ -232,6 +352,9 @@ func (m *MockIterationRepository) WithError(err error) *MockIterationRepository
 	m.GetIterationFunc = func(ctx context.Context, number int) (*entities.IterationEntity, error) { return nil, err }
 	m.GetCurrentIterationFunc = func(ctx context.Context) (*entities.IterationEntity, error) { return nil, err }
 	m.ListIterationsFunc = func(ctx context.Context) ([]*entities.IterationEntity, error) { return nil, err }
+	m.ListIterationsByRoadmapFunc = func(ctx context.Context, roadmapID string) ([]*entities.IterationEntity, error) {
+		return nil, err
+	}
 	m.UpdateIterationFunc = func(ctx context.Context, iteration *entities.IterationEntity) error { return err }
 	m.DeleteIterationFunc = func(ctx context.Context, number int) error { return err }
 	m.AddTaskToIterationFunc = func(ctx context.Context, iterationNum int, taskID string) error { return err }
@@ -250,5 +373,22 @@ func (m *MockIterationRepository) WithError(err error) *MockIterationRepository
 	m.GetNextPlannedIterationFunc = func(ctx context.Context) (*entities.IterationEntity, error) {
 		return nil, err
 	}
+	m.SaveIterationDoDItemFunc = func(ctx context.Context, item *entities.IterationDoDItemEntity) error { return err }
+	m.GetIterationDoDItemFunc = func(ctx context.Context, id int) (*entities.IterationDoDItemEntity, error) {
+		return nil, err
+	}
+	m.ListIterationDoDItemsFunc = func(ctx context.Context, iterationNum int) ([]*entities.IterationDoDItemEntity, error) {
+		return nil, err
+	}
+	m.UpdateIterationDoDItemFunc = func(ctx context.Context, item *entities.IterationDoDItemEntity) error { return err }
+	m.SaveIterationTemplateFunc = func(ctx context.Context, template *entities.IterationTemplateEntity) error { return err }
+	m.GetIterationTemplateFunc = func(ctx context.Context, name string) (*entities.IterationTemplateEntity, error) {
+		return nil, err
+	}
+	m.ListIterationTemplatesFunc = func(ctx context.Context) ([]*entities.IterationTemplateEntity, error) {
+		return nil, err
+	}
+	m.DeleteIterationTemplateFunc = func(ctx context.Context, name string) error { return err }
+	m.IncrementIterationTemplateUsageFunc = func(ctx context.Context, name string) error { return err }
 	return m
 }
@@ -2,15 +2,18 @@ package mocks
 
 import (
 	"context"
+	"sort"
 
-	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
 )
 
 // MockRoadmapRepository is a mock implementation of repositories.RoadmapRepository for testing.
 type MockRoadmapRepository struct {
 	// In-memory storage for testing
 	roadmaps map[string]*entities.RoadmapEntity
+	criteria []*entities.RoadmapCriterionEntity
+	activeID string
 
 	// SaveRoadmapFunc is called by SaveRoadmap. If nil, uses default implementation.
 	SaveRoadmapFunc func(ctx context.Context, roadmap *entities.RoadmapEntity) error
@@ -21,8 +24,26 @@ type MockRoadmapRepository struct {
 	// GetActiveRoadmapFunc is called by GetActiveRoadmap. If nil, uses default implementation.
 	GetActiveRoadmapFunc func(ctx context.Context) (*entities.RoadmapEntity, error)
 
+	// ListRoadmapsFunc is called by ListRoadmaps. If nil, uses default implementation.
+	ListRoadmapsFunc func(ctx context.Context) ([]*entities.RoadmapEntity, error)
+
+	// SetActiveRoadmapFunc is called by SetActiveRoadmap. If nil, uses default implementation.
+	SetActiveRoadmapFunc func(ctx context.Context, id string) error
+
 	// UpdateRoadmapFunc is called by UpdateRoadmap. If nil, uses default implementation.
 	UpdateRoadmapFunc func(ctx context.Context, roadmap *entities.RoadmapEntity) error
+
+	// SaveRoadmapCriterionFunc is called by SaveRoadmapCriterion. If nil, uses default implementation.
+	SaveRoadmapCriterionFunc func(ctx context.Context, criterion *entities.RoadmapCriterionEntity) error
+
+	// GetRoadmapCriterionFunc is called by GetRoadmapCriterion. If nil, uses default implementation.
+	GetRoadmapCriterionFunc func(ctx context.Context, id int) (*entities.RoadmapCriterionEntity, error)
+
+	// ListRoadmapCriteriaFunc is called by ListRoadmapCriteria. If nil, uses default implementation.
+	ListRoadmapCriteriaFunc func(ctx context.Context, roadmapID string) ([]*entities.RoadmapCriterionEntity, error)
+
+	// UpdateRoadmapCriterionFunc is called by UpdateRoadmapCriterion. If nil, uses default implementation.
+	UpdateRoadmapCriterionFunc func(ctx context.Context, criterion *entities.RoadmapCriterionEntity) error
 }
 
 // NewMockRoadmapRepository creates a new mock roadmap repository with in-memory storage
@@ -63,11 +84,52 @@ func (m *MockRoadmapRepository) GetActiveRoadmap(ctx context.Context) (*entities
 	if m.GetActiveRoadmapFunc != nil {
 		return m.GetActiveRoadmapFunc(ctx)
 	}
-	// Default implementation: return the first roadmap (assuming only one)
-	for _, roadmap := range m.roadmaps {
+	// Default implementation: the activated roadmap, else the most recently created unarchived one
+	if roadmap, exists := m.roadmaps[m.activeID]; exists && !roadmap.IsArchived() {
 		return roadmap, nil
 	}
-	return nil, pluginsdk.ErrNotFound
+	var active *entities.RoadmapEntity
+	for _, roadmap := range m.roadmaps {
+		if !roadmap.IsArchived() && (active == nil || roadmap.CreatedAt.After(active.CreatedAt)) {
+			active = roadmap
+		}
+	}
+	if active == nil {
+		return nil, pluginsdk.ErrNotFound
+	}
+	return active, nil
+}
+
+// ListRoadmaps implements repositories.RoadmapRepository.
+func (m *MockRoadmapRepository) ListRoadmaps(ctx context.Context) ([]*entities.RoadmapEntity, error) {
+	if m.ListRoadmapsFunc != nil {
+		return m.ListRoadmapsFunc(ctx)
+	}
+	// Default implementation: all roadmaps in creation order
+	result := []*entities.RoadmapEntity{}
+	for _, roadmap := range m.roadmaps {
+		result = append(result, roadmap)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if !result[i].CreatedAt.Equal(result[j].CreatedAt) {
+			return result[i].CreatedAt.Before(result[j].CreatedAt)
+		}
+		return result[i].ID < result[j].ID
+	})
+	return result, nil
+}
+
+// SetActiveRoadmap implements repositories.RoadmapRepository.
+func (m *MockRoadmapRepository) SetActiveRoadmap(ctx context.Context, id string) error {
+	if m.SetActiveRoadmapFunc != nil {
+		return m.SetActiveRoadmapFunc(ctx, id)
+	}
+	// Default implementation: remember the ID
+	if _, exists := m.roadmaps[id]; !exists {
+		return pluginsdk.ErrNotFound
+	}
+	m.activeID = id
+	return nil
 }
 
 // UpdateRoadmap implements repositories.RoadmapRepository.
@@ -83,12 +145,76 @@ func (m *MockRoadmapRepository) UpdateRoadmap(ctx context.Context, roadmap *enti
 	return nil
 }
 
+// SaveRoadmapCriterion implements repositories.RoadmapRepository.
+func (m *MockRoadmapRepository) SaveRoadmapCriterion(ctx context.Context, criterion *entities.RoadmapCriterionEntity) error {
+	if m.SaveRoadmapCriterionFunc != nil {
+		return m.SaveRoadmapCriterionFunc(ctx, criterion)
+	}
+	// Default implementation: store in memory with sequential IDs
+	if _, exists := m.roadmaps[criterion.RoadmapID]; !exists {
+		return pluginsdk.ErrNotFound
+	}
+	criterion.ID = len(m.criteria) + 1
+	m.criteria = append(m.criteria, criterion)
+	return nil
+}
+
+// GetRoadmapCriterion implements repositories.RoadmapRepository.
+func (m *MockRoadmapRepository) GetRoadmapCriterion(ctx context.Context, id int) (*entities.RoadmapCriterionEntity, error) {
+	if m.GetRoadmapCriterionFunc != nil {
+		return m.GetRoadmapCriterionFunc(ctx, id)
+	}
+	// Default implementation: get from memory
+	for _, c := range m.criteria {
+		if c.ID == id {
+			return c, nil
+		}
+	}
+	return nil, pluginsdk.ErrNotFound
+}
+
+// ListRoadmapCriteria implements repositories.RoadmapRepository.
+func (m *MockRoadmapRepository) ListRoadmapCriteria(ctx context.Context, roadmapID string) ([]*entities.RoadmapCriterionEntity, error) {
+	if m.ListRoadmapCriteriaFunc != nil {
+		return m.ListRoadmapCriteriaFunc(ctx, roadmapID)
+	}
+	// Default implementation: filter from memory
+	result := []*entities.RoadmapCriterionEntity{}
+	for _, c := range m.criteria {
+		if c.RoadmapID == roadmapID {
+			result = append(result, c)
+		}
+	}
+	return result, nil
+}
+
+// UpdateRoadmapCriterion implements repositories.RoadmapRepository.
+func (m *MockRoadmapRepository) UpdateRoadmapCriterion(ctx context.Context, criterion *entities.RoadmapCriterionEntity) error {
+	if m.UpdateRoadmapCriterionFunc != nil {
+		return m.UpdateRoadmapCriterionFunc(ctx, criterion)
+	}
+	// Default implementation: update in memory
+	for i, c := range m.criteria {
+		if c.ID == criterion.ID {
+			m.criteria[i] = criterion
+			return nil
+		}
+	}
+	return pluginsdk.ErrNotFound
+}
+
 // Reset clears all configured behavior.
 func (m *MockRoadmapRepository) Reset() {
 	m.SaveRoadmapFunc = nil
 	m.GetRoadmapFunc = nil
 	m.GetActiveRoadmapFunc = nil
+	m.ListRoadmapsFunc = nil
+	m.SetActiveRoadmapFunc = nil
 	m.UpdateRoadmapFunc = nil
+	m.SaveRoadmapCriterionFunc = nil
+	m.GetRoadmapCriterionFunc = nil
+	m.ListRoadmapCriteriaFunc = nil
+	m.UpdateRoadmapCriterionFunc = nil
 }
 
 // WithError configures the mock to return the specified error for all methods.
@@ -96,6 +222,14 @@ func (m *MockRoadmapRepository) WithError(err error) *MockRoadmapRepository {
 	m.SaveRoadmapFunc = func(ctx context.Context, roadmap *entities.RoadmapEntity) error { return err }
 	m.GetRoadmapFunc = func(ctx context.Context, id string) (*entities.RoadmapEntity, error) { return nil, err }
 	m.GetActiveRoadmapFunc = func(ctx context.Context) (*entities.RoadmapEntity, error) { return nil, err }
+	m.ListRoadmapsFunc = func(ctx context.Context) ([]*entities.RoadmapEntity, error) { return nil, err }
+	m.SetActiveRoadmapFunc = func(ctx context.Context, id string) error { return err }
 	m.UpdateRoadmapFunc = func(ctx context.Context, roadmap *entities.RoadmapEntity) error { return err }
+	m.SaveRoadmapCriterionFunc = func(ctx context.Context, criterion *entities.RoadmapCriterionEntity) error { return err }
+	m.GetRoadmapCriterionFunc = func(ctx context.Context, id int) (*entities.RoadmapCriterionEntity, error) { return nil, err }
+	m.ListRoadmapCriteriaFunc = func(ctx context.Context, roadmapID string) ([]*entities.RoadmapCriterionEntity, error) {
+		return nil, err
+	}
+	m.UpdateRoadmapCriterionFunc = func(ctx context.Context, criterion *entities.RoadmapCriterionEntity) error { return err }
 	return m
 }
@@ -0,0 +1,30 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
+)
+
+// MockSyncRepository is a mock implementation of SyncRepository for testing
+type MockSyncRepository struct {
+	ExportChangesFunc func(ctx context.Context, since time.Time) (*entities.SyncChangeset, error)
+	ImportChangesFunc func(ctx context.Context, changeset *entities.SyncChangeset) (*entities.SyncImportResult, error)
+}
+
+// ExportChanges implements SyncRepository.ExportChanges
+func (m *MockSyncRepository) ExportChanges(ctx context.Context, since time.Time) (*entities.SyncChangeset, error) {
+	if m.ExportChangesFunc != nil {
+		return m.ExportChangesFunc(ctx, since)
+	}
+	return &entities.SyncChangeset{FormatVersion: entities.SyncChangesetFormatVersion, Since: since}, nil
+}
+
+// ImportChanges implements SyncRepository.ImportChanges
+func (m *MockSyncRepository) ImportChanges(ctx context.Context, changeset *entities.SyncChangeset) (*entities.SyncImportResult, error) {
+	if m.ImportChangesFunc != nil {
+		return m.ImportChangesFunc(ctx, changeset)
+	}
+	return &entities.SyncImportResult{}, nil
+}
@@ -30,10 +30,31 @@ type MockTaskRepository struct {
 	MoveTaskToTrackFunc func(ctx context.Context, taskID, newTrackID string) error
 
 	// GetBacklogTasksFunc is called by GetBacklogTasks. If nil, returns empty slice, nil.
-	GetBacklogTasksFunc func(ctx context.Context) ([]*entities.TaskEntity, error)
+	GetBacklogTasksFunc func(ctx context.Context, roadmapID string) ([]*entities.TaskEntity, error)
 
 	// GetIterationsForTaskFunc is called by GetIterationsForTask. If nil, returns empty slice, nil.
 	GetIterationsForTaskFunc func(ctx context.Context, taskID string) ([]*entities.IterationEntity, error)
+
+	// GetTasksByTrackFunc is called by GetTasksByTrack. If nil, groups the stored tasks of the tracks.
+	GetTasksByTrackFunc func(ctx context.Context, trackIDs []string) (map[string][]*entities.TaskEntity, error)
+
+	// GetTasksByIterationFunc is called by GetTasksByIteration. If nil, returns empty map, nil.
+	GetTasksByIterationFunc func(ctx context.Context) (map[int][]*entities.TaskEntity, error)
+
+	// SaveTaskNoteFunc is called by SaveTaskNote. If nil, returns nil.
+	SaveTaskNoteFunc func(ctx context.Context, note *entities.TaskNoteEntity) error
+
+	// ListTaskNotesFunc is called by ListTaskNotes. If nil, returns empty slice, nil.
+	ListTaskNotesFunc func(ctx context.Context, taskID string) ([]*entities.TaskNoteEntity, error)
+
+	// AddTaskGateFunc is called by AddTaskGate. If nil, returns nil.
+	AddTaskGateFunc func(ctx context.Context, taskID, acID string) error
+
+	// RemoveTaskGateFunc is called by RemoveTaskGate. If nil, returns nil.
+	RemoveTaskGateFunc func(ctx context.Context, taskID, acID string) error
+
+	// ListTaskGatesFunc is called by ListTaskGates. If nil, returns empty slice, nil.
+	ListTaskGatesFunc func(ctx context.Context, taskID string) ([]*entities.AcceptanceCriteriaEntity, error)
 }
 
 // NewMockTaskRepository creates a new mock task repository with in-memory storage
@@ -104,9 +125,9 @@ func (m *MockTaskRepository) MoveTaskToTrack(ctx context.Context, taskID, newTra
 }
 
 // GetBacklogTasks implements repositories.TaskRepository.
-func (m *MockTaskRepository) GetBacklogTasks(ctx context.Context) ([]*entities.TaskEntity, error) {
+func (m *MockTaskRepository) GetBacklogTasks(ctx context.Context, roadmapID string) ([]*entities.TaskEntity, error) {
 	if m.GetBacklogTasksFunc != nil {
-		return m.GetBacklogTasksFunc(ctx)
+		return m.GetBacklogTasksFunc(ctx, roadmapID)
 	}
 	return []*entities.TaskEntity{}, nil
 }
@@ -119,6 +140,73 @@ func (m *MockTaskRepository) GetIterationsForTask(ctx context.Context, taskID st
 	return []*entities.IterationEntity{}, nil
 }
 
+// GetTasksByTrack implements repositories.TaskRepository.
+func (m *MockTaskRepository) GetTasksByTrack(ctx context.Context, trackIDs []string) (map[string][]*entities.TaskEntity, error) {
+	if m.GetTasksByTrackFunc != nil {
+		return m.GetTasksByTrackFunc(ctx, trackIDs)
+	}
+	// Default implementation: group the tasks of the given tracks
+	wanted := make(map[string]bool, len(trackIDs))
+	for _, trackID := range trackIDs {
+		wanted[trackID] = true
+	}
+	result := make(map[string][]*entities.TaskEntity)
+	for _, task := range m.tasks {
+		if wanted[task.TrackID] {
+			result[task.TrackID] = append(result[task.TrackID], task)
+		}
+	}
+	return result, nil
+}
+
+// GetTasksByIteration implements repositories.TaskRepository.
+func (m *MockTaskRepository) GetTasksByIteration(ctx context.Context) (map[int][]*entities.TaskEntity, error) {
+	if m.GetTasksByIterationFunc != nil {
+		return m.GetTasksByIterationFunc(ctx)
+	}
+	return map[int][]*entities.TaskEntity{}, nil
+}
+
+// SaveTaskNote implements repositories.TaskRepository.
+func (m *MockTaskRepository) SaveTaskNote(ctx context.Context, note *entities.TaskNoteEntity) error {
+	if m.SaveTaskNoteFunc != nil {
+		return m.SaveTaskNoteFunc(ctx, note)
+	}
+	return nil
+}
+
+// ListTaskNotes implements repositories.TaskRepository.
+func (m *MockTaskRepository) ListTaskNotes(ctx context.Context, taskID string) ([]*entities.TaskNoteEntity, error) {
+	if m.ListTaskNotesFunc != nil {
+		return m.ListTaskNotesFunc(ctx, taskID)
+	}
+	return []*entities.TaskNoteEntity{}, nil
+}
+
+// AddTaskGate implements repositories.TaskRepository.
+func (m *MockTaskRepository) AddTaskGate(ctx context.Context, taskID, acID string) error {
+	if m.AddTaskGateFunc != nil {
+		return m.AddTaskGateFunc(ctx, taskID, acID)
+	}
+	return nil
+}
+
+// RemoveTaskGate implements repositories.TaskRepository.
+func (m *MockTaskRepository) RemoveTaskGate(ctx context.Context, taskID, acID string) error {
+	if m.RemoveTaskGateFunc != nil {
+		return m.RemoveTaskGateFunc(ctx, taskID, acID)
+	}
+	return nil
+}
+
+// ListTaskGates implements repositories.TaskRepository.
+func (m *MockTaskRepository) ListTaskGates(ctx context.Context, taskID string) ([]*entities.AcceptanceCriteriaEntity, error) {
+	if m.ListTaskGatesFunc != nil {
+		return m.ListTaskGatesFunc(ctx, taskID)
+	}
+	return []*entities.AcceptanceCriteriaEntity{}, nil
+}
+
 // Reset clears all configured behavior.
 func (m *MockTaskRepository) Reset() {
 	m.SaveTaskFunc = nil
@@ -129,6 +217,13 @@ func (m *MockTaskRepository) Reset() {
 	m.MoveTaskToTrackFunc = nil
 	m.GetBacklogTasksFunc = nil
 	m.GetIterationsForTaskFunc = nil
+	m.GetTasksByTrackFunc = nil
+	m.GetTasksByIterationFunc = nil
+	m.SaveTaskNoteFunc = nil
+	m.ListTaskNotesFunc = nil
+	m.AddTaskGateFunc = nil
+	m.RemoveTaskGateFunc = nil
+	m.ListTaskGatesFunc = nil
 }
 
 // WithError configures the mock to return the specified error for all methods.
@@ -141,9 +236,22 @@ func (m *MockTaskRepository) WithError(err error) *MockTaskRepository {
 	m.UpdateTaskFunc = func(ctx context.Context, task *entities.TaskEntity) error { return err }
 	m.DeleteTaskFunc = func(ctx context.Context, id string) error { return err }
 	m.MoveTaskToTrackFunc = func(ctx context.Context, taskID, newTrackID string) error { return err }
-	m.GetBacklogTasksFunc = func(ctx context.Context) ([]*entities.TaskEntity, error) { return nil, err }
+	m.GetBacklogTasksFunc = func(ctx context.Context, roadmapID string) ([]*entities.TaskEntity, error) { return nil, err }
 	m.GetIterationsForTaskFunc = func(ctx context.Context, taskID string) ([]*entities.IterationEntity, error) {
 		return nil, err
 	}
+	m.GetTasksByTrackFunc = func(ctx context.Context, trackIDs []string) (map[string][]*entities.TaskEntity, error) {
+		return nil, err
+	}
+	m.GetTasksByIterationFunc = func(ctx context.Context) (map[int][]*entities.TaskEntity, error) { return nil, err }
+	m.SaveTaskNoteFunc = func(ctx context.Context, note *entities.TaskNoteEntity) error { return err }
+	m.ListTaskNotesFunc = func(ctx context.Context, taskID string) ([]*entities.TaskNoteEntity, error) {
+		return nil, err
+	}
+	m.AddTaskGateFunc = func(ctx context.Context, taskID, acID string) error { return err }
+	m.RemoveTaskGateFunc = func(ctx context.Context, taskID, acID string) error { return err }
+	m.ListTaskGatesFunc = func(ctx context.Context, taskID string) ([]*entities.AcceptanceCriteriaEntity, error) {
+		return nil, err
+	}
 	return m
 }
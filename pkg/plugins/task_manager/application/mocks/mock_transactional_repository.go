@@ -0,0 +1,85 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
+)
+
+// MockTransactionalRepository is an in-memory implementation of domain.TransactionalRepository
+// for testing. WithTx runs fn against a view delegating to the given mocks, so a service writes
+// through the same mocks inside and outside a transaction. Nothing is rolled back when fn fails;
+// nil mocks are replaced by empty ones.
+type MockTransactionalRepository struct {
+	Roadmaps   *MockRoadmapRepository
+	Tracks     *MockTrackRepository
+	Tasks      *MockTaskRepository
+	Iterations *MockIterationRepository
+	ACs        *MockAcceptanceCriteriaRepository
+	ADRs       *MockADRRepository
+	Aggregates *MockAggregateRepository
+
+	// WithTxFunc is called by WithTx. If nil, fn runs against the mocks.
+	WithTxFunc func(ctx context.Context, fn func(domain.RoadmapRepository) error) error
+}
+
+// WithTx implements domain.TransactionalRepository.
+func (m *MockTransactionalRepository) WithTx(ctx context.Context, fn func(domain.RoadmapRepository) error) error {
+	if m.WithTxFunc != nil {
+		return m.WithTxFunc(ctx, fn)
+	}
+	view := &mockTxView{
+		MockRoadmapRepository:            m.Roadmaps,
+		MockTrackRepository:              m.Tracks,
+		MockTaskRepository:               m.Tasks,
+		MockIterationRepository:          m.Iterations,
+		MockAcceptanceCriteriaRepository: m.ACs,
+		MockADRRepository:                m.ADRs,
+		MockAggregateRepository:          m.Aggregates,
+	}
+	if view.MockRoadmapRepository == nil {
+		view.MockRoadmapRepository = NewMockRoadmapRepository()
+	}
+	if view.MockTrackRepository == nil {
+		view.MockTrackRepository = NewMockTrackRepository()
+	}
+	if view.MockTaskRepository == nil {
+		view.MockTaskRepository = NewMockTaskRepository()
+	}
+	if view.MockIterationRepository == nil {
+		view.MockIterationRepository = NewMockIterationRepository()
+	}
+	if view.MockAcceptanceCriteriaRepository == nil {
+		view.MockAcceptanceCriteriaRepository = &MockAcceptanceCriteriaRepository{}
+	}
+	if view.MockADRRepository == nil {
+		view.MockADRRepository = &MockADRRepository{}
+	}
+	if view.MockAggregateRepository == nil {
+		view.MockAggregateRepository = &MockAggregateRepository{}
+	}
+	return fn(view)
+}
+
+// mockTxView is the domain.RoadmapRepository a MockTransactionalRepository passes to fn
+type mockTxView struct {
+	*MockRoadmapRepository
+	*MockTrackRepository
+	*MockTaskRepository
+	*MockIterationRepository
+	*MockAcceptanceCriteriaRepository
+	*MockADRRepository
+	*MockAggregateRepository
+}
+
+// ListACByTrack implements domain.RoadmapRepository through the AC mock's ListACs.
+func (v *mockTxView) ListACByTrack(ctx context.Context, trackID string) ([]*entities.AcceptanceCriteriaEntity, error) {
+	return v.ListACs(ctx, entities.ACFilters{TrackID: trackID})
+}
+
+// Compile-time checks that the mock and its transaction view implement the domain interfaces
+var (
+	_ domain.TransactionalRepository = (*MockTransactionalRepository)(nil)
+	_ domain.RoadmapRepository       = (*mockTxView)(nil)
+)
@@ -4,10 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application/dto"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/repositories"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/services"
@@ -20,15 +22,18 @@ type RoadmapApplicationService struct {
 	trackRepo     repositories.TrackRepository
 	taskRepo      repositories.TaskRepository
 	iterationRepo repositories.IterationRepository
+	txRepo        domain.TransactionalRepository // makes activation atomic
 	validationSvc *services.ValidationService
 }
 
-// NewRoadmapApplicationService creates a new roadmap application service
+// NewRoadmapApplicationService creates a new roadmap application service. txRepo is
+// required: activating a roadmap goes through its transactions.
 func NewRoadmapApplicationService(
 	roadmapRepo repositories.RoadmapRepository,
 	trackRepo repositories.TrackRepository,
 	taskRepo repositories.TaskRepository,
 	iterationRepo repositories.IterationRepository,
+	txRepo domain.TransactionalRepository,
 	validationSvc *services.ValidationService,
 ) *RoadmapApplicationService {
 	return &RoadmapApplicationService{
@@ -36,6 +41,7 @@ func NewRoadmapApplicationService(
 		trackRepo:     trackRepo,
 		taskRepo:      taskRepo,
 		iterationRepo: iterationRepo,
+		txRepo:        txRepo,
 		validationSvc: validationSvc,
 	}
 }
@@ -50,13 +56,13 @@ func (s *RoadmapApplicationService) InitRoadmap(ctx context.Context, input dto.C
 		return nil, err
 	}
 
-	// Check if roadmap already exists
+	// Check if roadmap already exists; additional roadmaps must be asked for explicitly
 	existing, err := s.roadmapRepo.GetActiveRoadmap(ctx)
 	if err != nil && !errors.Is(err, pluginsdk.ErrNotFound) {
 		return nil, fmt.Errorf("failed to check existing roadmap: %w", err)
 	}
-	if existing != nil {
-		return nil, fmt.Errorf("roadmap already exists: %s - delete it first to create a new one", existing.ID)
+	if existing != nil && !input.Additional {
+		return nil, fmt.Errorf("%w: roadmap already exists: %s - use --additional to create another roadmap", pluginsdk.ErrAlreadyExists, existing.ID)
 	}
 
 	// Generate roadmap ID
@@ -74,6 +80,83 @@ func (s *RoadmapApplicationService) InitRoadmap(ctx context.Context, input dto.C
 		return nil, fmt.Errorf("failed to save roadmap: %w", err)
 	}
 
+	// An additional roadmap replaces the current one as the active roadmap
+	if existing != nil {
+		if err := s.roadmapRepo.SetActiveRoadmap(ctx, roadmap.ID); err != nil {
+			return nil, fmt.Errorf("failed to activate roadmap: %w", err)
+		}
+	}
+
+	return roadmap, nil
+}
+
+// ListRoadmaps returns all roadmaps, including archived ones, in creation order,
+// with the ID of the active roadmap ("" if there is none)
+func (s *RoadmapApplicationService) ListRoadmaps(ctx context.Context) ([]*entities.RoadmapEntity, string, error) {
+	roadmaps, err := s.roadmapRepo.ListRoadmaps(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to list roadmaps: %w", err)
+	}
+
+	active, err := s.roadmapRepo.GetActiveRoadmap(ctx)
+	if err != nil {
+		if errors.Is(err, pluginsdk.ErrNotFound) {
+			return roadmaps, "", nil
+		}
+		return nil, "", fmt.Errorf("failed to get active roadmap: %w", err)
+	}
+
+	return roadmaps, active.ID, nil
+}
+
+// ActivateRoadmap makes a roadmap the active one. An archived roadmap is restored first.
+func (s *RoadmapApplicationService) ActivateRoadmap(ctx context.Context, id string) (*entities.RoadmapEntity, error) {
+	roadmap, err := s.roadmapRepo.GetRoadmap(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	archived := roadmap.IsArchived()
+	if archived {
+		roadmap.Unarchive(time.Now().UTC())
+	}
+
+	// Restoring and activating are one change, so a failure leaves neither behind
+	err = s.txRepo.WithTx(ctx, func(repo domain.RoadmapRepository) error {
+		if archived {
+			if err := repo.UpdateRoadmap(ctx, roadmap); err != nil {
+				return fmt.Errorf("failed to restore roadmap: %w", err)
+			}
+		}
+		if err := repo.SetActiveRoadmap(ctx, roadmap.ID); err != nil {
+			return fmt.Errorf("failed to activate roadmap: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return roadmap, nil
+}
+
+// ArchiveRoadmap archives a roadmap, so it is no longer picked as the active roadmap.
+// Its tracks and history are kept. If it was active, the most recently created
+// unarchived roadmap becomes active.
+func (s *RoadmapApplicationService) ArchiveRoadmap(ctx context.Context, id string) (*entities.RoadmapEntity, error) {
+	roadmap, err := s.roadmapRepo.GetRoadmap(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := roadmap.Archive(time.Now().UTC()); err != nil {
+		return nil, err
+	}
+
+	if err := s.roadmapRepo.UpdateRoadmap(ctx, roadmap); err != nil {
+		return nil, fmt.Errorf("failed to archive roadmap: %w", err)
+	}
+
 	return roadmap, nil
 }
 
@@ -135,10 +218,19 @@ func (s *RoadmapApplicationService) GetFullOverview(ctx context.Context, options
 		return nil, fmt.Errorf("failed to list tracks: %w", err)
 	}
 
-	// Get all tasks
-	tasks, err := s.taskRepo.ListTasks(ctx, entities.TaskFilters{})
+	// Get the roadmap's tasks grouped by track, and all tasks grouped by iteration,
+	// with targeted queries
+	trackIDs := make([]string, len(tracks))
+	for i, t := range tracks {
+		trackIDs[i] = t.ID
+	}
+	tasksByTrack, err := s.taskRepo.GetTasksByTrack(ctx, trackIDs)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list tasks: %w", err)
+		return nil, fmt.Errorf("failed to list tasks by track: %w", err)
+	}
+	tasksByIteration, err := s.taskRepo.GetTasksByIteration(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks by iteration: %w", err)
 	}
 
 	// Get all iterations
@@ -153,9 +245,19 @@ func (s *RoadmapApplicationService) GetFullOverview(ctx context.Context, options
 		trackInterfaces[i] = t
 	}
 
-	taskInterfaces := make([]interface{}, len(tasks))
-	for i, t := range tasks {
-		taskInterfaces[i] = t
+	// Flat list of the roadmap's tasks in ID order
+	var tasks []*entities.TaskEntity
+	trackTaskInterfaces := make(map[string][]interface{}, len(tasksByTrack))
+	for trackID, trackTasks := range tasksByTrack {
+		tasks = append(tasks, trackTasks...)
+		trackTaskInterfaces[trackID] = tasksToInterfaces(trackTasks)
+	}
+	sort.Slice(tasks, func(i, j int) bool { return tasks[i].ID < tasks[j].ID })
+	taskInterfaces := tasksToInterfaces(tasks)
+
+	iterationTaskInterfaces := make(map[int][]interface{}, len(tasksByIteration))
+	for number, iterationTasks := range tasksByIteration {
+		iterationTaskInterfaces[number] = tasksToInterfaces(iterationTasks)
 	}
 
 	iterationInterfaces := make([]interface{}, len(iterations))
@@ -169,5 +271,147 @@ func (s *RoadmapApplicationService) GetFullOverview(ctx context.Context, options
 		Tasks:      taskInterfaces,
 		Iterations: iterationInterfaces,
 		ADRs:       []interface{}{}, // ADRs can be added later if needed
+
+		TasksByTrack:     trackTaskInterfaces,
+		TasksByIteration: iterationTaskInterfaces,
 	}, nil
 }
+
+// tasksToInterfaces converts tasks to the interface slices used by RoadmapOverviewDTO.
+func tasksToInterfaces(tasks []*entities.TaskEntity) []interface{} {
+	result := make([]interface{}, len(tasks))
+	for i, t := range tasks {
+		result[i] = t
+	}
+	return result
+}
+
+// AddCriterion adds a tracked success criterion to the active roadmap
+func (s *RoadmapApplicationService) AddCriterion(ctx context.Context, text string) (*entities.RoadmapCriterionEntity, error) {
+	if err := s.validationSvc.ValidateNonEmpty("text", text); err != nil {
+		return nil, err
+	}
+
+	roadmap, err := s.roadmapRepo.GetActiveRoadmap(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	criterion, err := entities.NewRoadmapCriterionEntity(roadmap.ID, text, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.roadmapRepo.SaveRoadmapCriterion(ctx, criterion); err != nil {
+		return nil, fmt.Errorf("failed to save criterion: %w", err)
+	}
+
+	return criterion, nil
+}
+
+// ListCriteria returns the tracked success criteria of the active roadmap
+func (s *RoadmapApplicationService) ListCriteria(ctx context.Context) ([]*entities.RoadmapCriterionEntity, error) {
+	roadmap, err := s.roadmapRepo.GetActiveRoadmap(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.roadmapRepo.ListRoadmapCriteria(ctx, roadmap.ID)
+}
+
+// MigrateCriteria converts the free-text success criteria of the active roadmap into
+// unchecked criterion rows, one per non-empty line. It only runs when the roadmap has
+// no tracked criteria yet and never modifies the free-text criteria.
+// Returns the created criteria (empty if nothing was migrated).
+func (s *RoadmapApplicationService) MigrateCriteria(ctx context.Context) ([]*entities.RoadmapCriterionEntity, error) {
+	roadmap, err := s.roadmapRepo.GetActiveRoadmap(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.roadmapRepo.ListRoadmapCriteria(ctx, roadmap.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list criteria: %w", err)
+	}
+	if len(existing) > 0 {
+		return []*entities.RoadmapCriterionEntity{}, nil
+	}
+
+	now := time.Now().UTC()
+	created := []*entities.RoadmapCriterionEntity{}
+	for _, text := range entities.SplitSuccessCriteria(roadmap.SuccessCriteria) {
+		criterion, err := entities.NewRoadmapCriterionEntity(roadmap.ID, text, now)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.roadmapRepo.SaveRoadmapCriterion(ctx, criterion); err != nil {
+			return nil, fmt.Errorf("failed to save criterion: %w", err)
+		}
+		created = append(created, criterion)
+	}
+
+	return created, nil
+}
+
+// SetCriterionMet checks or unchecks a tracked success criterion
+func (s *RoadmapApplicationService) SetCriterionMet(ctx context.Context, id int, met bool) (*entities.RoadmapCriterionEntity, error) {
+	criterion, err := s.roadmapRepo.GetRoadmapCriterion(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	if met {
+		criterion.Check(now)
+	} else {
+		criterion.Uncheck(now)
+	}
+
+	if err := s.roadmapRepo.UpdateRoadmapCriterion(ctx, criterion); err != nil {
+		return nil, fmt.Errorf("failed to update criterion: %w", err)
+	}
+
+	return criterion, nil
+}
+
+// GetStats computes success criteria, track and task progress for the active roadmap
+func (s *RoadmapApplicationService) GetStats(ctx context.Context) (*dto.RoadmapStatsDTO, error) {
+	roadmap, err := s.roadmapRepo.GetActiveRoadmap(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	criteria, err := s.roadmapRepo.ListRoadmapCriteria(ctx, roadmap.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list criteria: %w", err)
+	}
+
+	tracks, err := s.trackRepo.ListTracks(ctx, roadmap.ID, entities.TrackFilters{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tracks: %w", err)
+	}
+
+	tasks, err := s.taskRepo.ListTasks(ctx, entities.TaskFilters{RoadmapID: roadmap.ID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+
+	stats := &dto.RoadmapStatsDTO{
+		RoadmapID:   roadmap.ID,
+		TracksTotal: len(tracks),
+		TasksTotal:  len(tasks),
+	}
+	stats.CriteriaMet, stats.CriteriaTotal = entities.CountMetCriteria(criteria)
+	for _, track := range tracks {
+		if track.Status == string(entities.TrackStatusComplete) {
+			stats.TracksComplete++
+		}
+	}
+	for _, task := range tasks {
+		if task.Status == string(entities.TaskStatusDone) {
+			stats.TasksDone++
+		}
+	}
+
+	return stats, nil
+}
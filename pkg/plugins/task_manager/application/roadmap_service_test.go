@@ -32,6 +32,7 @@ func TestRoadmapApplicationService_InitRoadmap_Success(t *testing.T) {
 		mockTrackRepo,
 		mockTaskRepo,
 		mockIterationRepo,
+		&mocks.MockTransactionalRepository{Roadmaps: mockRoadmapRepo},
 		validationSvc,
 	)
 
@@ -75,6 +76,7 @@ func TestRoadmapApplicationService_InitRoadmap_EmptyVision(t *testing.T) {
 		mockTrackRepo,
 		mockTaskRepo,
 		mockIterationRepo,
+		&mocks.MockTransactionalRepository{Roadmaps: mockRoadmapRepo},
 		validationSvc,
 	)
 
@@ -109,6 +111,7 @@ func TestRoadmapApplicationService_InitRoadmap_EmptySuccessCriteria(t *testing.T
 		mockTrackRepo,
 		mockTaskRepo,
 		mockIterationRepo,
+		&mocks.MockTransactionalRepository{Roadmaps: mockRoadmapRepo},
 		validationSvc,
 	)
 
@@ -153,6 +156,7 @@ func TestRoadmapApplicationService_InitRoadmap_RoadmapAlreadyExists(t *testing.T
 		mockTrackRepo,
 		mockTaskRepo,
 		mockIterationRepo,
+		&mocks.MockTransactionalRepository{Roadmaps: mockRoadmapRepo},
 		validationSvc,
 	)
 
@@ -173,6 +177,108 @@ func TestRoadmapApplicationService_InitRoadmap_RoadmapAlreadyExists(t *testing.T
 	}
 }
 
+func TestRoadmapApplicationService_InitRoadmap_Additional(t *testing.T) {
+	ctx := context.Background()
+	mockRoadmapRepo := mocks.NewMockRoadmapRepository()
+	service := application.NewRoadmapApplicationService(
+		mockRoadmapRepo,
+		mocks.NewMockTrackRepository(),
+		mocks.NewMockTaskRepository(),
+		mocks.NewMockIterationRepository(),
+		&mocks.MockTransactionalRepository{Roadmaps: mockRoadmapRepo},
+		services.NewValidationService(),
+	)
+
+	// The existing roadmap is newer, so only the explicit activation makes v2 active
+	existing, _ := entities.NewRoadmapEntity("roadmap-v1", "Platform v1", "Ship v1", time.Now().Add(time.Hour), time.Now())
+	mockRoadmapRepo.SaveRoadmap(ctx, existing)
+
+	roadmap, err := service.InitRoadmap(ctx, dto.CreateRoadmapDTO{
+		Vision:          "Platform v2",
+		SuccessCriteria: "Ship v2",
+		Additional:      true,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	active, err := service.GetRoadmap(ctx)
+	if err != nil {
+		t.Fatalf("Expected active roadmap, got %v", err)
+	}
+	if active.ID != roadmap.ID {
+		t.Errorf("Expected the additional roadmap %s to be active, got %s", roadmap.ID, active.ID)
+	}
+	roadmaps, activeID, err := service.ListRoadmaps(ctx)
+	if err != nil {
+		t.Fatalf("Expected no error listing roadmaps, got %v", err)
+	}
+	if len(roadmaps) != 2 || activeID != roadmap.ID {
+		t.Errorf("Expected 2 roadmaps with %s active, got %d with %s active", roadmap.ID, len(roadmaps), activeID)
+	}
+}
+
+// ============================================================================
+// ActivateRoadmap / ArchiveRoadmap Tests
+// ============================================================================
+
+func TestRoadmapApplicationService_ActivateAndArchiveRoadmap(t *testing.T) {
+	ctx := context.Background()
+	mockRoadmapRepo := mocks.NewMockRoadmapRepository()
+	service := application.NewRoadmapApplicationService(
+		mockRoadmapRepo,
+		mocks.NewMockTrackRepository(),
+		mocks.NewMockTaskRepository(),
+		mocks.NewMockIterationRepository(),
+		&mocks.MockTransactionalRepository{Roadmaps: mockRoadmapRepo},
+		services.NewValidationService(),
+	)
+
+	base := time.Now().UTC()
+	v1, _ := entities.NewRoadmapEntity("roadmap-v1", "Platform v1", "Ship v1", base, base)
+	v2, _ := entities.NewRoadmapEntity("roadmap-v2", "Platform v2", "Ship v2", base.Add(time.Hour), base)
+	mockRoadmapRepo.SaveRoadmap(ctx, v1)
+	mockRoadmapRepo.SaveRoadmap(ctx, v2)
+
+	if _, err := service.ActivateRoadmap(ctx, "roadmap-missing"); !errors.Is(err, pluginsdk.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound activating an unknown roadmap, got %v", err)
+	}
+
+	if _, err := service.ActivateRoadmap(ctx, "roadmap-v1"); err != nil {
+		t.Fatalf("Expected no error activating roadmap-v1, got %v", err)
+	}
+	if active, _ := service.GetRoadmap(ctx); active.ID != "roadmap-v1" {
+		t.Errorf("Expected roadmap-v1 to be active, got %s", active.ID)
+	}
+
+	// Archiving the active roadmap hands over to the remaining one
+	archived, err := service.ArchiveRoadmap(ctx, "roadmap-v1")
+	if err != nil {
+		t.Fatalf("Expected no error archiving roadmap-v1, got %v", err)
+	}
+	if !archived.IsArchived() {
+		t.Error("Expected roadmap-v1 to be archived")
+	}
+	if active, _ := service.GetRoadmap(ctx); active.ID != "roadmap-v2" {
+		t.Errorf("Expected roadmap-v2 to be active after archiving, got %s", active.ID)
+	}
+	if _, err := service.ArchiveRoadmap(ctx, "roadmap-v1"); !errors.Is(err, pluginsdk.ErrInvalidArgument) {
+		t.Errorf("Expected ErrInvalidArgument archiving twice, got %v", err)
+	}
+
+	// Activating an archived roadmap restores it
+	restored, err := service.ActivateRoadmap(ctx, "roadmap-v1")
+	if err != nil {
+		t.Fatalf("Expected no error reactivating roadmap-v1, got %v", err)
+	}
+	if restored.IsArchived() {
+		t.Error("Expected roadmap-v1 to be restored")
+	}
+	if active, _ := service.GetRoadmap(ctx); active.ID != "roadmap-v1" {
+		t.Errorf("Expected roadmap-v1 to be active again, got %s", active.ID)
+	}
+}
+
 // ============================================================================
 // GetRoadmap Tests
 // ============================================================================
@@ -201,6 +307,7 @@ func TestRoadmapApplicationService_GetRoadmap_Success(t *testing.T) {
 		mockTrackRepo,
 		mockTaskRepo,
 		mockIterationRepo,
+		&mocks.MockTransactionalRepository{Roadmaps: mockRoadmapRepo},
 		validationSvc,
 	)
 
@@ -233,6 +340,7 @@ func TestRoadmapApplicationService_GetRoadmap_NotFound(t *testing.T) {
 		mockTrackRepo,
 		mockTaskRepo,
 		mockIterationRepo,
+		&mocks.MockTransactionalRepository{Roadmaps: mockRoadmapRepo},
 		validationSvc,
 	)
 
@@ -276,6 +384,7 @@ func TestRoadmapApplicationService_UpdateRoadmap_VisionOnly(t *testing.T) {
 		mockTrackRepo,
 		mockTaskRepo,
 		mockIterationRepo,
+		&mocks.MockTransactionalRepository{Roadmaps: mockRoadmapRepo},
 		validationSvc,
 	)
 
@@ -323,6 +432,7 @@ func TestRoadmapApplicationService_UpdateRoadmap_SuccessCriteriaOnly(t *testing.
 		mockTrackRepo,
 		mockTaskRepo,
 		mockIterationRepo,
+		&mocks.MockTransactionalRepository{Roadmaps: mockRoadmapRepo},
 		validationSvc,
 	)
 
@@ -370,6 +480,7 @@ func TestRoadmapApplicationService_UpdateRoadmap_BothFields(t *testing.T) {
 		mockTrackRepo,
 		mockTaskRepo,
 		mockIterationRepo,
+		&mocks.MockTransactionalRepository{Roadmaps: mockRoadmapRepo},
 		validationSvc,
 	)
 
@@ -409,6 +520,7 @@ func TestRoadmapApplicationService_UpdateRoadmap_NoFields(t *testing.T) {
 		mockTrackRepo,
 		mockTaskRepo,
 		mockIterationRepo,
+		&mocks.MockTransactionalRepository{Roadmaps: mockRoadmapRepo},
 		validationSvc,
 	)
 
@@ -447,6 +559,7 @@ func TestRoadmapApplicationService_UpdateRoadmap_EmptyVision(t *testing.T) {
 		mockTrackRepo,
 		mockTaskRepo,
 		mockIterationRepo,
+		&mocks.MockTransactionalRepository{Roadmaps: mockRoadmapRepo},
 		validationSvc,
 	)
 
@@ -481,6 +594,7 @@ func TestRoadmapApplicationService_UpdateRoadmap_NotFound(t *testing.T) {
 		mockTrackRepo,
 		mockTaskRepo,
 		mockIterationRepo,
+		&mocks.MockTransactionalRepository{Roadmaps: mockRoadmapRepo},
 		validationSvc,
 	)
 
@@ -575,6 +689,7 @@ func TestRoadmapApplicationService_GetFullOverview_Success(t *testing.T) {
 		mockTrackRepo,
 		mockTaskRepo,
 		mockIterationRepo,
+		&mocks.MockTransactionalRepository{Roadmaps: mockRoadmapRepo},
 		validationSvc,
 	)
 
@@ -621,6 +736,7 @@ func TestRoadmapApplicationService_GetFullOverview_RoadmapNotFound(t *testing.T)
 		mockTrackRepo,
 		mockTaskRepo,
 		mockIterationRepo,
+		&mocks.MockTransactionalRepository{Roadmaps: mockRoadmapRepo},
 		validationSvc,
 	)
 
@@ -665,6 +781,7 @@ func TestRoadmapApplicationService_GetFullOverview_EmptyData(t *testing.T) {
 		mockTrackRepo,
 		mockTaskRepo,
 		mockIterationRepo,
+		&mocks.MockTransactionalRepository{Roadmaps: mockRoadmapRepo},
 		validationSvc,
 	)
 
@@ -693,3 +810,130 @@ func TestRoadmapApplicationService_GetFullOverview_EmptyData(t *testing.T) {
 		t.Errorf("Expected 0 iterations, got %d", len(overview.Iterations))
 	}
 }
+
+// ============================================================================
+// Success Criteria Tests
+// ============================================================================
+
+func newRoadmapServiceWithRoadmap(t *testing.T, successCriteria string) *application.RoadmapApplicationService {
+	t.Helper()
+	mockRoadmapRepo := mocks.NewMockRoadmapRepository()
+	service := application.NewRoadmapApplicationService(
+		mockRoadmapRepo,
+		mocks.NewMockTrackRepository(),
+		mocks.NewMockTaskRepository(),
+		mocks.NewMockIterationRepository(),
+		&mocks.MockTransactionalRepository{Roadmaps: mockRoadmapRepo},
+		services.NewValidationService(),
+	)
+	if _, err := service.InitRoadmap(context.Background(), dto.CreateRoadmapDTO{
+		Vision:          "Build extensible framework",
+		SuccessCriteria: successCriteria,
+	}); err != nil {
+		t.Fatalf("failed to init roadmap: %v", err)
+	}
+	return service
+}
+
+func TestRoadmapApplicationService_AddCriterion_Success(t *testing.T) {
+	ctx := context.Background()
+	service := newRoadmapServiceWithRoadmap(t, "Support 10 plugins")
+
+	criterion, err := service.AddCriterion(ctx, "Zero violations")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if criterion.ID == 0 {
+		t.Error("Expected criterion ID to be assigned")
+	}
+	if criterion.Met {
+		t.Error("Expected new criterion to be unmet")
+	}
+}
+
+func TestRoadmapApplicationService_AddCriterion_EmptyText(t *testing.T) {
+	ctx := context.Background()
+	service := newRoadmapServiceWithRoadmap(t, "Support 10 plugins")
+
+	_, err := service.AddCriterion(ctx, "")
+	if !errors.Is(err, pluginsdk.ErrInvalidArgument) {
+		t.Errorf("Expected ErrInvalidArgument, got %v", err)
+	}
+}
+
+func TestRoadmapApplicationService_MigrateCriteria(t *testing.T) {
+	ctx := context.Background()
+	service := newRoadmapServiceWithRoadmap(t, "- Support 10 plugins\n\n* Zero violations\nShip v1")
+
+	migrated, err := service.MigrateCriteria(ctx)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(migrated) != 3 {
+		t.Fatalf("Expected 3 migrated criteria, got %d", len(migrated))
+	}
+	if migrated[0].Text != "Support 10 plugins" || migrated[1].Text != "Zero violations" || migrated[2].Text != "Ship v1" {
+		t.Errorf("Unexpected migrated texts: %q, %q, %q", migrated[0].Text, migrated[1].Text, migrated[2].Text)
+	}
+
+	// Second migration is a no-op once criteria exist
+	again, err := service.MigrateCriteria(ctx)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(again) != 0 {
+		t.Errorf("Expected no criteria on second migration, got %d", len(again))
+	}
+
+	all, err := service.ListCriteria(ctx)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(all) != 3 {
+		t.Errorf("Expected 3 criteria, got %d", len(all))
+	}
+}
+
+func TestRoadmapApplicationService_SetCriterionMet(t *testing.T) {
+	ctx := context.Background()
+	service := newRoadmapServiceWithRoadmap(t, "Support 10 plugins")
+
+	criterion, err := service.AddCriterion(ctx, "Support 10 plugins")
+	if err != nil {
+		t.Fatalf("failed to add criterion: %v", err)
+	}
+
+	checked, err := service.SetCriterionMet(ctx, criterion.ID, true)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !checked.Met || checked.MetAt == nil {
+		t.Error("Expected criterion to be met with MetAt set")
+	}
+
+	stats, err := service.GetStats(ctx)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if stats.CriteriaMet != 1 || stats.CriteriaTotal != 1 {
+		t.Errorf("Expected 1/1 criteria met, got %d/%d", stats.CriteriaMet, stats.CriteriaTotal)
+	}
+
+	unchecked, err := service.SetCriterionMet(ctx, criterion.ID, false)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if unchecked.Met || unchecked.MetAt != nil {
+		t.Error("Expected criterion to be unmet with MetAt cleared")
+	}
+}
+
+func TestRoadmapApplicationService_SetCriterionMet_NotFound(t *testing.T) {
+	ctx := context.Background()
+	service := newRoadmapServiceWithRoadmap(t, "Support 10 plugins")
+
+	_, err := service.SetCriterionMet(ctx, 42, true)
+	if !errors.Is(err, pluginsdk.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+}
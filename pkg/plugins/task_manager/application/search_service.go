@@ -0,0 +1,37 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/repositories"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// SearchApplicationService finds tasks, tracks, ADRs and acceptance criteria by text
+type SearchApplicationService struct {
+	aggregateRepo repositories.AggregateRepository
+}
+
+// NewSearchApplicationService creates a new search application service
+func NewSearchApplicationService(aggregateRepo repositories.AggregateRepository) *SearchApplicationService {
+	return &SearchApplicationService{aggregateRepo: aggregateRepo}
+}
+
+// Search returns the entities of the given types (all types if empty) containing term,
+// ranked by field relevance: title matches before description matches.
+func (s *SearchApplicationService) Search(ctx context.Context, term string, entityTypes []string) ([]*entities.SearchResult, error) {
+	term = strings.TrimSpace(term)
+	if term == "" {
+		return nil, fmt.Errorf("%w: search term is required", pluginsdk.ErrInvalidArgument)
+	}
+
+	types, err := entities.ParseSearchEntityTypes(strings.Join(entityTypes, ","))
+	if err != nil {
+		return nil, err
+	}
+
+	return s.aggregateRepo.Search(ctx, term, types)
+}
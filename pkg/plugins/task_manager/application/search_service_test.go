@@ -0,0 +1,68 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application/mocks"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+func TestSearchApplicationService_Search(t *testing.T) {
+	var gotTerm string
+	var gotTypes []string
+	repo := &mocks.MockAggregateRepository{
+		SearchFunc: func(ctx context.Context, term string, entityTypes []string) ([]*entities.SearchResult, error) {
+			gotTerm = term
+			gotTypes = entityTypes
+			return []*entities.SearchResult{{EntityType: entities.SearchEntityTask, EntityID: "DW-task-1"}}, nil
+		},
+	}
+	service := application.NewSearchApplicationService(repo)
+
+	results, err := service.Search(context.Background(), "  OAuth ", []string{"adr", "task", "adr"})
+	if err != nil {
+		t.Fatalf("Search failed: %v", err)
+	}
+	if len(results) != 1 {
+		t.Errorf("expected repository results to be returned, got %d", len(results))
+	}
+	if gotTerm != "OAuth" {
+		t.Errorf("expected trimmed term, got %q", gotTerm)
+	}
+	if want := []string{"adr", "task"}; !reflect.DeepEqual(gotTypes, want) {
+		t.Errorf("expected types %v, got %v", want, gotTypes)
+	}
+
+	if _, err := service.Search(context.Background(), "OAuth", nil); err != nil {
+		t.Fatalf("Search without types failed: %v", err)
+	}
+	if !reflect.DeepEqual(gotTypes, entities.SearchEntityTypes) {
+		t.Errorf("expected all types by default, got %v", gotTypes)
+	}
+}
+
+func TestSearchApplicationService_Search_InvalidInput(t *testing.T) {
+	service := application.NewSearchApplicationService(&mocks.MockAggregateRepository{})
+
+	tests := []struct {
+		name  string
+		term  string
+		types []string
+	}{
+		{name: "empty term", term: "   "},
+		{name: "unknown type", term: "OAuth", types: []string{"task", "epic"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.Search(context.Background(), tt.term, tt.types)
+			if !errors.Is(err, pluginsdk.ErrInvalidArgument) {
+				t.Errorf("expected ErrInvalidArgument, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/repositories"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// SyncApplicationService handles incremental export and import of project data
+// for replicating a project database to another machine.
+type SyncApplicationService struct {
+	syncRepo repositories.SyncRepository
+}
+
+// NewSyncApplicationService creates a new sync application service
+func NewSyncApplicationService(syncRepo repositories.SyncRepository) *SyncApplicationService {
+	return &SyncApplicationService{syncRepo: syncRepo}
+}
+
+// ExportChanges returns a changeset with every entity updated after since
+func (s *SyncApplicationService) ExportChanges(ctx context.Context, since time.Time) (*entities.SyncChangeset, error) {
+	return s.syncRepo.ExportChanges(ctx, since)
+}
+
+// ImportChanges validates a changeset and upserts it in a single transaction.
+// Nothing is written if any entity is invalid.
+func (s *SyncApplicationService) ImportChanges(ctx context.Context, changeset *entities.SyncChangeset) (*entities.SyncImportResult, error) {
+	if err := validateSyncChangeset(changeset); err != nil {
+		return nil, err
+	}
+	return s.syncRepo.ImportChanges(ctx, changeset)
+}
+
+// validateSyncChangeset checks keys and statuses so a malformed file fails before the import starts
+func validateSyncChangeset(changeset *entities.SyncChangeset) error {
+	if changeset == nil {
+		return fmt.Errorf("%w: changeset is empty", pluginsdk.ErrInvalidArgument)
+	}
+	if changeset.FormatVersion != entities.SyncChangesetFormatVersion {
+		return fmt.Errorf("%w: unsupported changeset format version %d (expected %d)", pluginsdk.ErrInvalidArgument, changeset.FormatVersion, entities.SyncChangesetFormatVersion)
+	}
+
+	for _, roadmap := range changeset.Roadmaps {
+		if roadmap.ID == "" {
+			return fmt.Errorf("%w: roadmap without ID in changeset", pluginsdk.ErrInvalidArgument)
+		}
+	}
+	for _, track := range changeset.Tracks {
+		if track.ID == "" {
+			return fmt.Errorf("%w: track without ID in changeset", pluginsdk.ErrInvalidArgument)
+		}
+		if !entities.IsValidTrackStatus(track.Status) {
+			return fmt.Errorf("%w: track %s has invalid status %q", pluginsdk.ErrInvalidArgument, track.ID, track.Status)
+		}
+	}
+	for _, task := range changeset.Tasks {
+		if task.ID == "" {
+			return fmt.Errorf("%w: task without ID in changeset", pluginsdk.ErrInvalidArgument)
+		}
+		if !entities.IsValidTaskStatus(task.Status) {
+			return fmt.Errorf("%w: task %s has invalid status %q", pluginsdk.ErrInvalidArgument, task.ID, task.Status)
+		}
+	}
+	for _, iteration := range changeset.Iterations {
+		if iteration.Number <= 0 {
+			return fmt.Errorf("%w: iteration number must be positive, got %d", pluginsdk.ErrInvalidArgument, iteration.Number)
+		}
+		if !entities.IsValidIterationStatus(iteration.Status) {
+			return fmt.Errorf("%w: iteration %d has invalid status %q", pluginsdk.ErrInvalidArgument, iteration.Number, iteration.Status)
+		}
+	}
+	for _, item := range changeset.IterationDoD {
+		if item.IterationNum <= 0 {
+			return fmt.Errorf("%w: definition of done item with iteration number %d in changeset", pluginsdk.ErrInvalidArgument, item.IterationNum)
+		}
+		if item.Text == "" {
+			return fmt.Errorf("%w: definition of done item of iteration %d has no text", pluginsdk.ErrInvalidArgument, item.IterationNum)
+		}
+	}
+	for _, ac := range changeset.AcceptanceCriteria {
+		if ac.ID == "" {
+			return fmt.Errorf("%w: acceptance criterion without ID in changeset", pluginsdk.ErrInvalidArgument)
+		}
+		if !entities.IsValidVerificationType(string(ac.VerificationType)) {
+			return fmt.Errorf("%w: acceptance criterion %s has invalid verification type %q", pluginsdk.ErrInvalidArgument, ac.ID, ac.VerificationType)
+		}
+		if !entities.IsValidACStatus(string(ac.Status)) {
+			return fmt.Errorf("%w: acceptance criterion %s has invalid status %q", pluginsdk.ErrInvalidArgument, ac.ID, ac.Status)
+		}
+	}
+	for acID, tags := range changeset.ACTags {
+		for _, tag := range tags {
+			if normalized, err := entities.NormalizeACTag(tag); err != nil || normalized != tag {
+				return fmt.Errorf("%w: acceptance criterion %s has invalid tag %q", pluginsdk.ErrInvalidArgument, acID, tag)
+			}
+		}
+	}
+	for _, adr := range changeset.ADRs {
+		if adr.ID == "" {
+			return fmt.Errorf("%w: ADR without ID in changeset", pluginsdk.ErrInvalidArgument)
+		}
+		if !entities.IsValidADRStatus(adr.Status) {
+			return fmt.Errorf("%w: ADR %s has invalid status %q", pluginsdk.ErrInvalidArgument, adr.ID, adr.Status)
+		}
+	}
+	for _, link := range changeset.ADRTaskLinks {
+		if link.ADRID == "" || link.TaskID == "" {
+			return fmt.Errorf("%w: ADR task link without ADR or task ID in changeset", pluginsdk.ErrInvalidArgument)
+		}
+	}
+	for _, gate := range changeset.TaskGates {
+		if gate.TaskID == "" || gate.ACID == "" {
+			return fmt.Errorf("%w: task gate without task or AC ID in changeset", pluginsdk.ErrInvalidArgument)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,121 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application/mocks"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+func TestSyncApplicationService_ExportChanges(t *testing.T) {
+	since := time.Date(2025, 1, 31, 18, 0, 0, 0, time.UTC)
+	var gotSince time.Time
+	repo := &mocks.MockSyncRepository{
+		ExportChangesFunc: func(ctx context.Context, s time.Time) (*entities.SyncChangeset, error) {
+			gotSince = s
+			return &entities.SyncChangeset{FormatVersion: entities.SyncChangesetFormatVersion}, nil
+		},
+	}
+
+	service := application.NewSyncApplicationService(repo)
+	if _, err := service.ExportChanges(context.Background(), since); err != nil {
+		t.Fatalf("ExportChanges failed: %v", err)
+	}
+	if !gotSince.Equal(since) {
+		t.Errorf("expected since %v to be passed to repository, got %v", since, gotSince)
+	}
+}
+
+func TestSyncApplicationService_ImportChanges(t *testing.T) {
+	now := time.Now().UTC()
+
+	tests := []struct {
+		name      string
+		changeset *entities.SyncChangeset
+		wantErr   bool
+	}{
+		{
+			name:      "nil changeset",
+			changeset: nil,
+			wantErr:   true,
+		},
+		{
+			name:      "unsupported format version",
+			changeset: &entities.SyncChangeset{FormatVersion: 99},
+			wantErr:   true,
+		},
+		{
+			name: "task with invalid status",
+			changeset: &entities.SyncChangeset{
+				FormatVersion: entities.SyncChangesetFormatVersion,
+				Tasks:         []*entities.TaskEntity{{ID: "DW-task-1", Status: "bogus", UpdatedAt: now}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "acceptance criterion with invalid status",
+			changeset: &entities.SyncChangeset{
+				FormatVersion: entities.SyncChangesetFormatVersion,
+				AcceptanceCriteria: []*entities.AcceptanceCriteriaEntity{
+					{ID: "DW-ac-1", VerificationType: entities.VerificationTypeManual, Status: "done", UpdatedAt: now},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "iteration without number",
+			changeset: &entities.SyncChangeset{
+				FormatVersion: entities.SyncChangesetFormatVersion,
+				Iterations:    []*entities.IterationEntity{{Status: "planned", UpdatedAt: now}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid changeset",
+			changeset: &entities.SyncChangeset{
+				FormatVersion: entities.SyncChangesetFormatVersion,
+				Tracks:        []*entities.TrackEntity{{ID: "DW-track-1", Status: "in-progress", UpdatedAt: now}},
+				Tasks:         []*entities.TaskEntity{{ID: "DW-task-1", Status: "todo", UpdatedAt: now}},
+				AcceptanceCriteria: []*entities.AcceptanceCriteriaEntity{
+					{ID: "DW-ac-1", VerificationType: entities.VerificationTypeManual, Status: entities.ACStatusNotStarted, UpdatedAt: now},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			imported := false
+			repo := &mocks.MockSyncRepository{
+				ImportChangesFunc: func(ctx context.Context, changeset *entities.SyncChangeset) (*entities.SyncImportResult, error) {
+					imported = true
+					return &entities.SyncImportResult{}, nil
+				},
+			}
+
+			service := application.NewSyncApplicationService(repo)
+			_, err := service.ImportChanges(context.Background(), tt.changeset)
+
+			if tt.wantErr {
+				if !errors.Is(err, pluginsdk.ErrInvalidArgument) {
+					t.Errorf("expected ErrInvalidArgument, got %v", err)
+				}
+				if imported {
+					t.Error("expected repository not to be called for an invalid changeset")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ImportChanges failed: %v", err)
+			}
+			if !imported {
+				t.Error("expected repository to be called")
+			}
+		})
+	}
+}
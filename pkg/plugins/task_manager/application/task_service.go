@@ -2,11 +2,16 @@ package application
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application/dto"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/repositories"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/services"
@@ -19,15 +24,20 @@ type TaskApplicationService struct {
 	trackRepo     repositories.TrackRepository
 	aggregateRepo repositories.AggregateRepository
 	acRepo        repositories.AcceptanceCriteriaRepository
+	iterationRepo repositories.IterationRepository
+	txRepo        domain.TransactionalRepository // makes multi-task updates atomic
 	validationSvc *services.ValidationService
 }
 
-// NewTaskApplicationService creates a new task application service
+// NewTaskApplicationService creates a new task application service. txRepo is required:
+// writes touching several tasks go through its transactions.
 func NewTaskApplicationService(
 	taskRepo repositories.TaskRepository,
 	trackRepo repositories.TrackRepository,
 	aggregateRepo repositories.AggregateRepository,
 	acRepo repositories.AcceptanceCriteriaRepository,
+	iterationRepo repositories.IterationRepository,
+	txRepo domain.TransactionalRepository,
 	validationSvc *services.ValidationService,
 ) *TaskApplicationService {
 	return &TaskApplicationService{
@@ -35,6 +45,8 @@ func NewTaskApplicationService(
 		trackRepo:     trackRepo,
 		aggregateRepo: aggregateRepo,
 		acRepo:        acRepo,
+		iterationRepo: iterationRepo,
+		txRepo:        txRepo,
 		validationSvc: validationSvc,
 	}
 }
@@ -42,12 +54,15 @@ func NewTaskApplicationService(
 // CreateTask creates a new task with validation
 func (s *TaskApplicationService) CreateTask(ctx context.Context, input dto.CreateTaskDTO) (*entities.TaskEntity, error) {
 	// Generate task ID
-	projectCode := s.aggregateRepo.GetProjectCode(ctx)
+	ids, err := newEntityIDs(ctx, s.aggregateRepo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate task ID: %w", err)
+	}
 	nextNum, err := s.aggregateRepo.GetNextSequenceNumber(ctx, "task")
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate task ID: %w", err)
 	}
-	id := fmt.Sprintf("%s-task-%d", projectCode, nextNum)
+	id := ids.ID("task", nextNum)
 
 	// Validate title is non-empty
 	if err := s.validationSvc.ValidateNonEmpty("title", input.Title); err != nil {
@@ -130,11 +145,22 @@ func (s *TaskApplicationService) UpdateTask(ctx context.Context, input dto.Updat
 				return nil, fmt.Errorf("failed to check acceptance criteria: %w", err)
 			}
 
+			// ACs tagged auto-on-complete are verified once the whole track is done,
+			// so they cannot block completing one of its tasks
+			autoACs, err := s.acRepo.ListTaggedAC(ctx, task.ID, entities.ACTagAutoOnComplete)
+			if err != nil {
+				return nil, fmt.Errorf("failed to check acceptance criteria: %w", err)
+			}
+			autoOnComplete := make(map[string]bool, len(autoACs))
+			for _, ac := range autoACs {
+				autoOnComplete[ac.ID] = true
+			}
+
 			// Check for unverified ACs (pending or failed)
 			var unverifiedIDs []string
 			for _, ac := range acs {
 				// ACs with "verified", "automatically_verified", or "skipped" status are satisfied
-				if !ac.IsVerified() && !ac.IsSkipped() {
+				if !ac.IsVerified() && !ac.IsSkipped() && !autoOnComplete[ac.ID] {
 					unverifiedIDs = append(unverifiedIDs, ac.ID)
 				}
 			}
@@ -191,6 +217,12 @@ func (s *TaskApplicationService) DeleteTask(ctx context.Context, taskID string)
 	return s.taskRepo.DeleteTask(ctx, taskID)
 }
 
+// PlanTaskDeletion reports what deleting a task would remove and leave orphaned,
+// without deleting anything
+func (s *TaskApplicationService) PlanTaskDeletion(ctx context.Context, taskID string) (*entities.DeletionPlan, error) {
+	return s.aggregateRepo.PlanDeletion(ctx, entities.DeletionTargetTask, taskID)
+}
+
 // MoveTask moves a task to a different track
 func (s *TaskApplicationService) MoveTask(ctx context.Context, taskID, newTrackID string) error {
 	// Verify task exists
@@ -209,6 +241,550 @@ func (s *TaskApplicationService) MoveTask(ctx context.Context, taskID, newTrackI
 	return s.taskRepo.MoveTaskToTrack(ctx, taskID, newTrackID)
 }
 
+// CloneTask copies a task's title, description and rank into a new task with a generated
+// ID, status todo and fresh timestamps, in the source's track unless another is given.
+// Branch, assignee, notes and gates are not copied. With WithACs the acceptance criteria
+// are copied as not_started, with their tags; with SameIteration the copy joins the source's iterations
+// that are not complete. Everything is saved in one transaction when the service has a
+// transactional repository. Returns the copy, its ACs and the iterations it was added to.
+func (s *TaskApplicationService) CloneTask(ctx context.Context, input dto.CloneTaskDTO) (*entities.TaskEntity, []*entities.AcceptanceCriteriaEntity, []*entities.IterationEntity, error) {
+	source, err := s.taskRepo.GetTask(ctx, input.SourceID)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("task not found: %w", err)
+	}
+
+	title := source.Title
+	if input.Title != "" {
+		if err := s.validationSvc.ValidateNonEmpty("title", input.Title); err != nil {
+			return nil, nil, nil, err
+		}
+		title = input.Title
+	}
+
+	trackID := source.TrackID
+	if input.TrackID != "" {
+		if _, err := s.trackRepo.GetTrack(ctx, input.TrackID); err != nil {
+			return nil, nil, nil, fmt.Errorf("track not found: %w", err)
+		}
+		trackID = input.TrackID
+	}
+
+	ids, err := newEntityIDs(ctx, s.aggregateRepo)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to generate task ID: %w", err)
+	}
+	nextTask, err := s.aggregateRepo.GetNextSequenceNumber(ctx, "task")
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to generate task ID: %w", err)
+	}
+
+	now := time.Now().UTC()
+	task, err := entities.NewTaskEntity(ids.ID("task", nextTask), trackID, title, source.Description,
+		string(entities.TaskStatusTodo), source.Rank, "", now, now)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	var acs []*entities.AcceptanceCriteriaEntity
+	acTags := make(map[string][]string) // copied AC ID -> tags
+	if input.WithACs {
+		sourceACs, err := s.acRepo.ListAC(ctx, source.ID)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to list acceptance criteria: %w", err)
+		}
+		if len(sourceACs) > 0 {
+			nextAC, err := s.aggregateRepo.GetNextSequenceNumber(ctx, "ac")
+			if err != nil {
+				return nil, nil, nil, fmt.Errorf("failed to generate AC ID: %w", err)
+			}
+			for _, ac := range sourceACs {
+				tags, err := s.acRepo.ListACTags(ctx, ac.ID)
+				if err != nil {
+					return nil, nil, nil, fmt.Errorf("failed to list tags of AC %s: %w", ac.ID, err)
+				}
+				copied := entities.NewAcceptanceCriteriaEntity(ids.ID("ac", nextAC), task.ID,
+					ac.Description, ac.VerificationType, ac.TestingInstructions, now, now)
+				acs = append(acs, copied)
+				acTags[copied.ID] = tags
+				nextAC++
+			}
+		}
+	}
+
+	var iterations []*entities.IterationEntity
+	if input.SameIteration {
+		sourceIterations, err := s.taskRepo.GetIterationsForTask(ctx, source.ID)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to load task iterations: %w", err)
+		}
+		for _, iteration := range sourceIterations {
+			// A new todo task would reopen a completed iteration's progress
+			if iteration.Status != string(entities.IterationStatusComplete) {
+				iterations = append(iterations, iteration)
+			}
+		}
+	}
+
+	err = s.txRepo.WithTx(ctx, func(repo domain.RoadmapRepository) error {
+		if err := repo.SaveTask(ctx, task); err != nil {
+			return err
+		}
+		for _, ac := range acs {
+			if err := repo.SaveAC(ctx, ac); err != nil {
+				return fmt.Errorf("failed to save AC %s: %w", ac.ID, err)
+			}
+			for _, tag := range acTags[ac.ID] {
+				if err := repo.AddACTag(ctx, ac.ID, tag); err != nil {
+					return fmt.Errorf("failed to tag AC %s: %w", ac.ID, err)
+				}
+			}
+		}
+		for _, iteration := range iterations {
+			if err := repo.AddTaskToIteration(ctx, iteration.Number, task.ID); err != nil {
+				return fmt.Errorf("failed to add task to iteration %d: %w", iteration.Number, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return task, acs, iterations, nil
+}
+
+// eventTitleKeys are the payload keys checked, in order, for a task title when
+// capturing an event as a task
+var eventTitleKeys = []string{"error", "message", "title", "summary", "description"}
+
+// maxEventTaskTitleLength bounds titles derived from event payloads
+const maxEventTaskTitleLength = 80
+
+// CreateTaskFromEvent creates a backlog (todo) task from a logged event. The title is taken
+// from the payload's error or message text, falling back to the event type, and the
+// description records the event and its payload. The originating event ID is kept in a task note.
+func (s *TaskApplicationService) CreateTaskFromEvent(ctx context.Context, input dto.CreateTaskFromEventDTO) (*entities.TaskEntity, error) {
+	if err := s.validationSvc.ValidateNonEmpty("event ID", input.EventID); err != nil {
+		return nil, err
+	}
+
+	task, err := s.CreateTask(ctx, dto.CreateTaskDTO{
+		TrackID:     input.TrackID,
+		Title:       eventTaskTitle(input),
+		Description: eventTaskDescription(input),
+		Status:      string(entities.TaskStatusTodo),
+		Rank:        500,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	content := fmt.Sprintf("Created from event %s (%s)", input.EventID, input.EventType)
+	if input.SessionID != "" {
+		content += fmt.Sprintf(" in session %s", input.SessionID)
+	}
+	note, err := entities.NewTaskNoteEntity(task.ID, content, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.taskRepo.SaveTaskNote(ctx, note); err != nil {
+		return nil, fmt.Errorf("failed to record source event note: %w", err)
+	}
+
+	return task, nil
+}
+
+// eventTaskTitle returns the first line of the first non-empty title-like payload value,
+// or a generic title naming the event type and time when the payload has none
+func eventTaskTitle(input dto.CreateTaskFromEventDTO) string {
+	for _, key := range eventTitleKeys {
+		text, _ := input.Payload[key].(string)
+		text, _, _ = strings.Cut(strings.TrimSpace(text), "\n")
+		text = strings.TrimSpace(text)
+		if text == "" {
+			continue
+		}
+		if runes := []rune(text); len(runes) > maxEventTaskTitleLength {
+			text = string(runes[:maxEventTaskTitleLength-3]) + "..."
+		}
+		return text
+	}
+	return fmt.Sprintf("Investigate %s event from %s", input.EventType, input.Timestamp.Local().Format("2006-01-02 15:04"))
+}
+
+// eventTaskDescription records where the event came from along with its full payload
+func eventTaskDescription(input dto.CreateTaskFromEventDTO) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Captured from event %s (%s) at %s.\n", input.EventID, input.EventType, input.Timestamp.Format(time.RFC3339))
+	if input.SessionID != "" {
+		fmt.Fprintf(&b, "Session: %s\n", input.SessionID)
+	}
+	if len(input.Payload) > 0 {
+		if payload, err := json.MarshalIndent(input.Payload, "", "  "); err == nil {
+			fmt.Fprintf(&b, "\nPayload:\n%s\n", payload)
+		}
+	}
+	return strings.TrimRight(b.String(), "\n")
+}
+
+// ReopenTask reverts a done task to todo (or in-progress) and records the reason as a task note.
+// It returns the reopened task together with any completed iterations that contain it,
+// since reopening the task changes their progress.
+func (s *TaskApplicationService) ReopenTask(ctx context.Context, input dto.ReopenTaskDTO) (*entities.TaskEntity, []*entities.IterationEntity, error) {
+	task, err := s.taskRepo.GetTask(ctx, input.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if task.Status != string(entities.TaskStatusDone) {
+		return nil, nil, fmt.Errorf("%w: task %s is %s, only done tasks can be reopened", pluginsdk.ErrInvalidArgument, task.ID, task.Status)
+	}
+
+	status := input.Status
+	if status == "" {
+		status = string(entities.TaskStatusTodo)
+	}
+	if status != string(entities.TaskStatusTodo) && status != string(entities.TaskStatusInProgress) {
+		return nil, nil, fmt.Errorf("%w: reopened task status must be todo or in-progress, got %s", pluginsdk.ErrInvalidArgument, status)
+	}
+
+	previousStatus := task.Status
+	if err := task.TransitionTo(status); err != nil {
+		return nil, nil, err
+	}
+
+	if err := s.taskRepo.UpdateTask(ctx, task); err != nil {
+		return nil, nil, err
+	}
+
+	content := fmt.Sprintf("Reopened (%s -> %s)", previousStatus, status)
+	if reason := strings.TrimSpace(input.Reason); reason != "" {
+		content += ": " + reason
+	}
+	note, err := entities.NewTaskNoteEntity(task.ID, content, time.Now().UTC())
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := s.taskRepo.SaveTaskNote(ctx, note); err != nil {
+		return nil, nil, fmt.Errorf("failed to record reopen note: %w", err)
+	}
+
+	iterations, err := s.taskRepo.GetIterationsForTask(ctx, task.ID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load task iterations: %w", err)
+	}
+	completed := []*entities.IterationEntity{}
+	for _, iteration := range iterations {
+		if iteration.Status == string(entities.IterationStatusComplete) {
+			completed = append(completed, iteration)
+		}
+	}
+
+	return task, completed, nil
+}
+
+// ListTaskNotes returns the notes recorded for a task in creation order
+func (s *TaskApplicationService) ListTaskNotes(ctx context.Context, taskID string) ([]*entities.TaskNoteEntity, error) {
+	return s.taskRepo.ListTaskNotes(ctx, taskID)
+}
+
+// GateTask blocks a task until an acceptance criterion, usually of a dependency task,
+// is verified. A task cannot be gated on its own ACs, and gates may not form a cycle
+// (task A waiting on an AC of task B while B waits, directly or not, on an AC of A).
+// Returns the gating AC.
+func (s *TaskApplicationService) GateTask(ctx context.Context, taskID, acID string) (*entities.AcceptanceCriteriaEntity, error) {
+	if _, err := s.taskRepo.GetTask(ctx, taskID); err != nil {
+		return nil, err
+	}
+	ac, err := s.acRepo.GetAC(ctx, acID)
+	if err != nil {
+		return nil, err
+	}
+	if ac.TaskID == taskID {
+		return nil, fmt.Errorf("%w: task %s cannot be gated on its own AC %s", pluginsdk.ErrInvalidArgument, taskID, acID)
+	}
+
+	// Walk the gates upstream of the AC's task; reaching taskID means a cycle
+	visited := map[string]bool{}
+	queue := []string{ac.TaskID}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if current == taskID {
+			return nil, fmt.Errorf("%w: gating %s on %s would create a cycle (task %s already waits on %s)",
+				pluginsdk.ErrInvalidArgument, taskID, acID, ac.TaskID, taskID)
+		}
+		if visited[current] {
+			continue
+		}
+		visited[current] = true
+
+		gates, err := s.taskRepo.ListTaskGates(ctx, current)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load task gates: %w", err)
+		}
+		for _, gate := range gates {
+			queue = append(queue, gate.TaskID)
+		}
+	}
+
+	if err := s.taskRepo.AddTaskGate(ctx, taskID, acID); err != nil {
+		return nil, err
+	}
+	return ac, nil
+}
+
+// UngateTask removes the gate of a task on an acceptance criterion
+func (s *TaskApplicationService) UngateTask(ctx context.Context, taskID, acID string) error {
+	return s.taskRepo.RemoveTaskGate(ctx, taskID, acID)
+}
+
+// ListTaskGates returns the acceptance criteria a task is gated on.
+// Use entities.PendingGates to find the ones still blocking it.
+func (s *TaskApplicationService) ListTaskGates(ctx context.Context, taskID string) ([]*entities.AcceptanceCriteriaEntity, error) {
+	return s.taskRepo.ListTaskGates(ctx, taskID)
+}
+
+// TraceBlockedChain returns the chain of links blocking a task: the dependency
+// tracks of its track and its gating ACs, each expanded down to what blocks it in
+// turn (a track's own dependencies, the task owning a gating AC). Satisfied links
+// are included but not expanded. Use RootBlockers on the result to find where the
+// chain is stuck.
+func (s *TaskApplicationService) TraceBlockedChain(ctx context.Context, taskID string) (*entities.BlockerLink, error) {
+	if _, err := s.taskRepo.GetTask(ctx, taskID); err != nil {
+		return nil, err
+	}
+	root := entities.BlockerRef{Kind: entities.BlockerKindTask, ID: taskID}
+	return services.NewDependencyService().TraceBlockers(ctx, root, s.lookupBlocker)
+}
+
+// lookupBlocker loads one link of a blocker chain and the links blocking it
+func (s *TaskApplicationService) lookupBlocker(ctx context.Context, ref entities.BlockerRef) (*entities.BlockerLink, []entities.BlockerRef, error) {
+	switch ref.Kind {
+	case entities.BlockerKindTask:
+		task, err := s.taskRepo.GetTask(ctx, ref.ID)
+		if err != nil {
+			return nil, nil, err
+		}
+		link := &entities.BlockerLink{
+			Kind:      ref.Kind,
+			ID:        task.ID,
+			Title:     task.Title,
+			Status:    task.Status,
+			Satisfied: task.Status == string(entities.TaskStatusDone) || task.Status == string(entities.TaskStatusCancelled),
+		}
+
+		var blockers []entities.BlockerRef
+		track, err := s.trackRepo.GetTrack(ctx, task.TrackID)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, depID := range track.Dependencies {
+			blockers = append(blockers, entities.BlockerRef{Kind: entities.BlockerKindTrack, ID: depID})
+		}
+		gates, err := s.taskRepo.ListTaskGates(ctx, task.ID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to load task gates: %w", err)
+		}
+		for _, ac := range gates {
+			blockers = append(blockers, entities.BlockerRef{Kind: entities.BlockerKindAC, ID: ac.ID})
+		}
+		return link, blockers, nil
+
+	case entities.BlockerKindTrack:
+		track, err := s.trackRepo.GetTrack(ctx, ref.ID)
+		if err != nil {
+			return nil, nil, err
+		}
+		tasks, err := s.taskRepo.ListTasks(ctx, entities.TaskFilters{TrackID: track.ID})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to list tasks: %w", err)
+		}
+		open := 0
+		for _, task := range tasks {
+			if task.Status != string(entities.TaskStatusDone) && task.Status != string(entities.TaskStatusCancelled) {
+				open++
+			}
+		}
+		link := &entities.BlockerLink{
+			Kind:      ref.Kind,
+			ID:        track.ID,
+			Title:     track.Title,
+			Status:    track.Status,
+			Detail:    fmt.Sprintf("%d of %d tasks open", open, len(tasks)),
+			Satisfied: track.Status == string(entities.TrackStatusComplete),
+		}
+		var blockers []entities.BlockerRef
+		for _, depID := range track.Dependencies {
+			blockers = append(blockers, entities.BlockerRef{Kind: entities.BlockerKindTrack, ID: depID})
+		}
+		return link, blockers, nil
+
+	case entities.BlockerKindAC:
+		ac, err := s.acRepo.GetAC(ctx, ref.ID)
+		if err != nil {
+			return nil, nil, err
+		}
+		link := &entities.BlockerLink{
+			Kind:      ref.Kind,
+			ID:        ac.ID,
+			Title:     ac.Description,
+			Status:    string(ac.Status),
+			Detail:    "of task " + ac.TaskID,
+			Satisfied: ac.IsVerified(),
+		}
+		return link, []entities.BlockerRef{{Kind: entities.BlockerKindTask, ID: ac.TaskID}}, nil
+	}
+	return nil, nil, fmt.Errorf("%w: unknown blocker kind %q", pluginsdk.ErrInvalidArgument, ref.Kind)
+}
+
+// AssignTask sets the assignee of a task. Assignees are free-form names; an empty
+// assignee unassigns the task.
+func (s *TaskApplicationService) AssignTask(ctx context.Context, taskID, assignee string) (*entities.TaskEntity, error) {
+	task, err := s.taskRepo.GetTask(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+	task.Assign(assignee)
+	if err := s.taskRepo.UpdateTask(ctx, task); err != nil {
+		return nil, fmt.Errorf("failed to update task: %w", err)
+	}
+	return task, nil
+}
+
+// GetStaleTasks lists the todo and in-progress tasks not updated in the last
+// query.Days days, grouped by track. Tasks are sorted oldest first, and tracks
+// by their oldest task.
+func (s *TaskApplicationService) GetStaleTasks(ctx context.Context, query dto.StaleTasksQuery) ([]dto.StaleTrackDTO, error) {
+	if query.Days < 1 {
+		return nil, fmt.Errorf("%w: days must be at least 1", pluginsdk.ErrInvalidArgument)
+	}
+	cutoff := query.Now.AddDate(0, 0, -query.Days)
+	tasks, err := s.taskRepo.ListTasks(ctx, entities.TaskFilters{
+		Status: []string{
+			string(entities.TaskStatusTodo),
+			string(entities.TaskStatusInProgress),
+		},
+		Assignee:      query.Assignee,
+		RoadmapID:     query.RoadmapID,
+		UpdatedBefore: &cutoff,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+
+	sort.SliceStable(tasks, func(i, j int) bool { return tasks[i].UpdatedAt.Before(tasks[j].UpdatedAt) })
+
+	var report []dto.StaleTrackDTO
+	index := make(map[string]int)
+	for _, task := range tasks {
+		i, ok := index[task.TrackID]
+		if !ok {
+			group := dto.StaleTrackDTO{TrackID: task.TrackID}
+			if track, err := s.trackRepo.GetTrack(ctx, task.TrackID); err == nil && track != nil {
+				group.TrackTitle = track.Title
+			}
+			i = len(report)
+			index[task.TrackID] = i
+			report = append(report, group)
+		}
+		report[i].Tasks = append(report[i].Tasks, dto.StaleTaskDTO{
+			ID:        task.ID,
+			Title:     task.Title,
+			Status:    task.Status,
+			Assignee:  task.Assignee,
+			UpdatedAt: task.UpdatedAt,
+			Age:       query.Now.Sub(task.UpdatedAt),
+		})
+	}
+	return report, nil
+}
+
+// GetWorkload tallies the open (not done) tasks per assignee, busiest first.
+// Unassigned tasks are counted under an empty assignee, listed last. Only the
+// tracks of roadmapID are counted unless it is empty.
+func (s *TaskApplicationService) GetWorkload(ctx context.Context, roadmapID string) ([]dto.AssigneeWorkloadDTO, error) {
+	tasks, err := s.taskRepo.ListTasks(ctx, entities.TaskFilters{
+		Status: []string{
+			string(entities.TaskStatusTodo),
+			string(entities.TaskStatusInProgress),
+			string(entities.TaskStatusReview),
+		},
+		RoadmapID: roadmapID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+
+	byAssignee := make(map[string]*dto.AssigneeWorkloadDTO)
+	for _, task := range tasks {
+		entry, ok := byAssignee[task.Assignee]
+		if !ok {
+			entry = &dto.AssigneeWorkloadDTO{Assignee: task.Assignee}
+			byAssignee[task.Assignee] = entry
+		}
+		switch task.Status {
+		case string(entities.TaskStatusTodo):
+			entry.Todo++
+		case string(entities.TaskStatusInProgress):
+			entry.InProgress++
+		case string(entities.TaskStatusReview):
+			entry.Review++
+		}
+		entry.Open = entry.Todo + entry.InProgress + entry.Review
+	}
+
+	workload := make([]dto.AssigneeWorkloadDTO, 0, len(byAssignee))
+	for _, entry := range byAssignee {
+		if entry.Open == 0 {
+			continue
+		}
+		workload = append(workload, *entry)
+	}
+	sort.Slice(workload, func(i, j int) bool {
+		a, b := workload[i], workload[j]
+		if (a.Assignee == "") != (b.Assignee == "") {
+			return b.Assignee == ""
+		}
+		if a.Open != b.Open {
+			return a.Open > b.Open
+		}
+		return a.Assignee < b.Assignee
+	})
+	return workload, nil
+}
+
+// GetStatusReport counts tracks, tasks and acceptance criteria by status and measures
+// the task progress of the current iteration, if any
+func (s *TaskApplicationService) GetStatusReport(ctx context.Context) (*dto.StatusReportDTO, error) {
+	counts, err := s.aggregateRepo.CountByStatus(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count by status: %w", err)
+	}
+	report := &dto.StatusReportDTO{Tracks: counts.Tracks, Tasks: counts.Tasks, ACs: counts.ACs}
+
+	iteration, err := s.iterationRepo.GetCurrentIteration(ctx)
+	if err != nil && !errors.Is(err, pluginsdk.ErrNotFound) {
+		return nil, fmt.Errorf("failed to get current iteration: %w", err)
+	}
+	if iteration == nil {
+		return report, nil
+	}
+
+	tasks, err := s.iterationRepo.GetIterationTasks(ctx, iteration.Number)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get iteration tasks: %w", err)
+	}
+	done, total := entities.IterationProgress(entities.ProgressBasisTasks, tasks, nil)
+	report.Iteration = &dto.IterationStatusDTO{
+		Number:    iteration.Number,
+		Name:      iteration.Name,
+		TasksDone: done,
+		Tasks:     total,
+	}
+	return report, nil
+}
+
 // GetTask retrieves a task by ID
 func (s *TaskApplicationService) GetTask(ctx context.Context, taskID string) (*entities.TaskEntity, error) {
 	return s.taskRepo.GetTask(ctx, taskID)
@@ -219,7 +795,158 @@ func (s *TaskApplicationService) ListTasks(ctx context.Context, filters entities
 	return s.taskRepo.ListTasks(ctx, filters)
 }
 
-// GetBacklogTasks returns all tasks with status "todo"
-func (s *TaskApplicationService) GetBacklogTasks(ctx context.Context) ([]*entities.TaskEntity, error) {
-	return s.taskRepo.GetBacklogTasks(ctx)
+// GetBacklogTasks returns the open tasks that are not in any iteration, limited to the
+// tracks of roadmapID unless it is empty
+func (s *TaskApplicationService) GetBacklogTasks(ctx context.Context, roadmapID string) ([]*entities.TaskEntity, error) {
+	return s.taskRepo.GetBacklogTasks(ctx, roadmapID)
+}
+
+// BumpTask moves a task's rank relative to its peers: the other tasks of its track, or of
+// the given iteration. "top" and "bottom" move it past the current minimum or maximum rank,
+// "up" and "down" swap it with its neighbour. When ranks collide or run out of the 1-1000
+// range, the peers' existing ranks are renormalized, as the iteration reorder does.
+// It returns the updated task, its new 1-based position and the number of peers.
+func (s *TaskApplicationService) BumpTask(ctx context.Context, input dto.BumpTaskDTO) (*entities.TaskEntity, int, int, error) {
+	switch input.Direction {
+	case dto.BumpTop, dto.BumpUp, dto.BumpDown, dto.BumpBottom:
+	default:
+		return nil, 0, 0, fmt.Errorf("%w: invalid bump direction %q (must be top, up, down or bottom)", pluginsdk.ErrInvalidArgument, input.Direction)
+	}
+
+	task, err := s.taskRepo.GetTask(ctx, input.ID)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	var peers []*entities.TaskEntity
+	if input.IterationNum != nil {
+		if _, err := s.iterationRepo.GetIteration(ctx, *input.IterationNum); err != nil {
+			return nil, 0, 0, err
+		}
+		peers, err = s.iterationRepo.GetIterationTasks(ctx, *input.IterationNum)
+	} else {
+		peers, err = s.taskRepo.ListTasks(ctx, entities.TaskFilters{TrackID: task.TrackID})
+	}
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("failed to load peer tasks: %w", err)
+	}
+
+	sortTasksByRank(peers)
+	index := -1
+	for i, peer := range peers {
+		if peer.ID == task.ID {
+			index = i
+			task = peer
+			break
+		}
+	}
+	if index < 0 {
+		return nil, 0, 0, fmt.Errorf("%w: task %s is not in iteration %d", pluginsdk.ErrInvalidArgument, task.ID, *input.IterationNum)
+	}
+
+	target := index
+	switch input.Direction {
+	case dto.BumpTop:
+		target = 0
+	case dto.BumpUp:
+		target = max(index-1, 0)
+	case dto.BumpDown:
+		target = min(index+1, len(peers)-1)
+	case dto.BumpBottom:
+		target = len(peers) - 1
+	}
+	if target == index {
+		return task, index + 1, len(peers), nil
+	}
+
+	order := make([]*entities.TaskEntity, 0, len(peers))
+	order = append(order, peers[:index]...)
+	order = append(order, peers[index+1:]...)
+	order = append(order[:target], append([]*entities.TaskEntity{task}, order[target:]...)...)
+
+	// Moving to an end only needs the task itself re-ranked while there is room
+	now := time.Now().UTC()
+	var changed []*entities.TaskEntity
+	switch {
+	case target == 0 && order[1].Rank > 1:
+		task.Rank = order[1].Rank - 1
+	case target == len(order)-1 && order[target-1].Rank < 1000:
+		task.Rank = order[target-1].Rank + 1
+	default:
+		ranks, err := renormalizeRanks(peers)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+		for i, peer := range order {
+			if peer == task || peer.Rank == ranks[i] {
+				continue
+			}
+			peer.Rank = ranks[i]
+			peer.UpdatedAt = now
+			changed = append(changed, peer)
+		}
+		task.Rank = ranks[target]
+	}
+	task.UpdatedAt = now
+	changed = append(changed, task)
+
+	// Renormalizing touches several peers; they must not be left half re-ranked
+	if err := s.updateTasks(ctx, changed); err != nil {
+		return nil, 0, 0, err
+	}
+
+	return task, target + 1, len(order), nil
+}
+
+// updateTasks persists several tasks in a single transaction
+func (s *TaskApplicationService) updateTasks(ctx context.Context, tasks []*entities.TaskEntity) error {
+	return s.txRepo.WithTx(ctx, func(repo domain.RoadmapRepository) error {
+		for _, task := range tasks {
+			if err := repo.UpdateTask(ctx, task); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// sortTasksByRank orders tasks by rank, breaking ties by creation time and then ID
+// so that positions are stable
+func sortTasksByRank(tasks []*entities.TaskEntity) {
+	sort.SliceStable(tasks, func(i, j int) bool {
+		if tasks[i].Rank != tasks[j].Rank {
+			return tasks[i].Rank < tasks[j].Rank
+		}
+		if !tasks[i].CreatedAt.Equal(tasks[j].CreatedAt) {
+			return tasks[i].CreatedAt.Before(tasks[j].CreatedAt)
+		}
+		return tasks[i].ID < tasks[j].ID
+	})
+}
+
+// renormalizeRanks returns the sorted ranks of the given tasks made strictly increasing
+// within 1-1000, so that assigning them in a new order makes rank order match it exactly.
+func renormalizeRanks(tasks []*entities.TaskEntity) ([]int, error) {
+	if len(tasks) > 1000 {
+		return nil, fmt.Errorf("%w: cannot rank %d tasks uniquely within 1-1000", pluginsdk.ErrInvalidArgument, len(tasks))
+	}
+
+	ranks := make([]int, len(tasks))
+	for i, task := range tasks {
+		ranks[i] = task.Rank
+	}
+	sort.Ints(ranks)
+
+	ranks[0] = max(ranks[0], 1)
+	for i := 1; i < len(ranks); i++ {
+		if ranks[i] <= ranks[i-1] {
+			ranks[i] = ranks[i-1] + 1
+		}
+	}
+	// Pull overflowing ranks back under the maximum
+	for i := len(ranks) - 1; i >= 0 && ranks[i] > 1000-(len(ranks)-1-i); i-- {
+		ranks[i] = 1000 - (len(ranks) - 1 - i)
+	}
+
+	return ranks, nil
 }
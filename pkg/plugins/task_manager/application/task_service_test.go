@@ -2,6 +2,9 @@ package application_test
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -9,6 +12,7 @@ import (
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application/dto"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application/mocks"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/services"
 )
@@ -19,9 +23,11 @@ func setupTaskTestService(t *testing.T) (*application.TaskApplicationService, co
 	mockTrackRepo := &mocks.MockTrackRepository{}
 	mockAggregateRepo := &mocks.MockAggregateRepository{}
 	mockACRepo := &mocks.MockAcceptanceCriteriaRepository{}
+	mockIterationRepo := &mocks.MockIterationRepository{}
+	txRepo := &mocks.MockTransactionalRepository{Tasks: mockTaskRepo, Tracks: mockTrackRepo, ACs: mockACRepo, Iterations: mockIterationRepo, Aggregates: mockAggregateRepo}
 	validationService := services.NewValidationService()
 
-	service := application.NewTaskApplicationService(mockTaskRepo, mockTrackRepo, mockAggregateRepo, mockACRepo, validationService)
+	service := application.NewTaskApplicationService(mockTaskRepo, mockTrackRepo, mockAggregateRepo, mockACRepo, mockIterationRepo, txRepo, validationService)
 	ctx := context.Background()
 
 	return service, ctx, mockTaskRepo, mockTrackRepo, mockAggregateRepo, mockACRepo
@@ -636,6 +642,220 @@ func TestTaskService_DeleteTask_NotFound(t *testing.T) {
 	}
 }
 
+// ============================================================================
+// ReopenTask Tests
+// ============================================================================
+
+// TestTaskService_ReopenTask_Success tests reopening a done task with a reason
+func TestTaskService_ReopenTask_Success(t *testing.T) {
+	service, ctx, mockTaskRepo, _, _, _ := setupTaskTestService(t)
+
+	now := time.Now().UTC()
+	task, _ := entities.NewTaskEntity("TM-task-1", "TM-track-1", "Test Task", "", "done", 100, "", now, now)
+	mockTaskRepo.GetTaskFunc = func(ctx context.Context, id string) (*entities.TaskEntity, error) {
+		return task, nil
+	}
+
+	var updated *entities.TaskEntity
+	mockTaskRepo.UpdateTaskFunc = func(ctx context.Context, task *entities.TaskEntity) error {
+		updated = task
+		return nil
+	}
+
+	var savedNote *entities.TaskNoteEntity
+	mockTaskRepo.SaveTaskNoteFunc = func(ctx context.Context, note *entities.TaskNoteEntity) error {
+		savedNote = note
+		return nil
+	}
+
+	mockTaskRepo.GetIterationsForTaskFunc = func(ctx context.Context, taskID string) ([]*entities.IterationEntity, error) {
+		return []*entities.IterationEntity{
+			{Number: 1, Name: "Done", Status: string(entities.IterationStatusComplete)},
+			{Number: 2, Name: "Current", Status: string(entities.IterationStatusCurrent)},
+		}, nil
+	}
+
+	reopened, completed, err := service.ReopenTask(ctx, dto.ReopenTaskDTO{ID: "TM-task-1", Reason: "Regression found"})
+	if err != nil {
+		t.Fatalf("ReopenTask() failed: %v", err)
+	}
+
+	if reopened.Status != string(entities.TaskStatusTodo) {
+		t.Errorf("expected status todo, got %s", reopened.Status)
+	}
+	if updated == nil || updated.Status != string(entities.TaskStatusTodo) {
+		t.Errorf("expected task to be persisted with status todo")
+	}
+	if savedNote == nil {
+		t.Fatal("expected reopen note to be saved")
+	}
+	if savedNote.Content != "Reopened (done -> todo): Regression found" {
+		t.Errorf("unexpected note content: %q", savedNote.Content)
+	}
+	if len(completed) != 1 || completed[0].Number != 1 {
+		t.Errorf("expected completed iteration 1 to be reported, got %v", completed)
+	}
+}
+
+// TestTaskService_ReopenTask_InProgress tests reopening directly to in-progress
+func TestTaskService_ReopenTask_InProgress(t *testing.T) {
+	service, ctx, mockTaskRepo, _, _, _ := setupTaskTestService(t)
+
+	now := time.Now().UTC()
+	task, _ := entities.NewTaskEntity("TM-task-1", "TM-track-1", "Test Task", "", "done", 100, "", now, now)
+	mockTaskRepo.GetTaskFunc = func(ctx context.Context, id string) (*entities.TaskEntity, error) {
+		return task, nil
+	}
+
+	reopened, completed, err := service.ReopenTask(ctx, dto.ReopenTaskDTO{ID: "TM-task-1", Status: "in-progress"})
+	if err != nil {
+		t.Fatalf("ReopenTask() failed: %v", err)
+	}
+	if reopened.Status != string(entities.TaskStatusInProgress) {
+		t.Errorf("expected status in-progress, got %s", reopened.Status)
+	}
+	if len(completed) != 0 {
+		t.Errorf("expected no completed iterations, got %d", len(completed))
+	}
+}
+
+// TestTaskService_ReopenTask_Invalid tests reopening rejected for non-done tasks and bad statuses
+func TestTaskService_ReopenTask_Invalid(t *testing.T) {
+	tests := []struct {
+		name       string
+		taskStatus string
+		input      dto.ReopenTaskDTO
+	}{
+		{"task not done", "in-progress", dto.ReopenTaskDTO{ID: "TM-task-1"}},
+		{"reopen to done", "done", dto.ReopenTaskDTO{ID: "TM-task-1", Status: "done"}},
+		{"reopen to review", "done", dto.ReopenTaskDTO{ID: "TM-task-1", Status: "review"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, ctx, mockTaskRepo, _, _, _ := setupTaskTestService(t)
+
+			now := time.Now().UTC()
+			task, _ := entities.NewTaskEntity("TM-task-1", "TM-track-1", "Test Task", "", tt.taskStatus, 100, "", now, now)
+			mockTaskRepo.GetTaskFunc = func(ctx context.Context, id string) (*entities.TaskEntity, error) {
+				return task, nil
+			}
+			mockTaskRepo.UpdateTaskFunc = func(ctx context.Context, task *entities.TaskEntity) error {
+				t.Error("UpdateTask should not be called")
+				return nil
+			}
+
+			_, _, err := service.ReopenTask(ctx, tt.input)
+			if !errors.Is(err, pluginsdk.ErrInvalidArgument) {
+				t.Errorf("expected ErrInvalidArgument, got: %v", err)
+			}
+		})
+	}
+}
+
+// ============================================================================
+// GateTask Tests
+// ============================================================================
+
+// setupGateTestService configures tasks TM-task-1..3 with one AC each (TM-ac-N on
+// TM-task-N) and in-memory gates
+func setupGateTestService(t *testing.T) (*application.TaskApplicationService, context.Context, map[string][]string) {
+	service, ctx, mockTaskRepo, _, _, mockACRepo := setupTaskTestService(t)
+
+	now := time.Now().UTC()
+	mockTaskRepo.GetTaskFunc = func(ctx context.Context, id string) (*entities.TaskEntity, error) {
+		switch id {
+		case "TM-task-1", "TM-task-2", "TM-task-3":
+			return entities.NewTaskEntity(id, "TM-track-1", "Task", "", "todo", 100, "", now, now)
+		}
+		return nil, pluginsdk.ErrNotFound
+	}
+	acs := map[string]*entities.AcceptanceCriteriaEntity{
+		"TM-ac-1": {ID: "TM-ac-1", TaskID: "TM-task-1", Status: entities.ACStatusNotStarted},
+		"TM-ac-2": {ID: "TM-ac-2", TaskID: "TM-task-2", Status: entities.ACStatusNotStarted},
+		"TM-ac-3": {ID: "TM-ac-3", TaskID: "TM-task-3", Status: entities.ACStatusVerified},
+	}
+	mockACRepo.GetACFunc = func(ctx context.Context, id string) (*entities.AcceptanceCriteriaEntity, error) {
+		if ac, ok := acs[id]; ok {
+			return ac, nil
+		}
+		return nil, pluginsdk.ErrNotFound
+	}
+
+	gates := map[string][]string{}
+	mockTaskRepo.AddTaskGateFunc = func(ctx context.Context, taskID, acID string) error {
+		gates[taskID] = append(gates[taskID], acID)
+		return nil
+	}
+	mockTaskRepo.ListTaskGatesFunc = func(ctx context.Context, taskID string) ([]*entities.AcceptanceCriteriaEntity, error) {
+		result := []*entities.AcceptanceCriteriaEntity{}
+		for _, acID := range gates[taskID] {
+			result = append(result, acs[acID])
+		}
+		return result, nil
+	}
+
+	return service, ctx, gates
+}
+
+// TestTaskService_GateTask_Success tests gating a task on another task's AC
+func TestTaskService_GateTask_Success(t *testing.T) {
+	service, ctx, gates := setupGateTestService(t)
+
+	ac, err := service.GateTask(ctx, "TM-task-2", "TM-ac-1")
+	if err != nil {
+		t.Fatalf("GateTask() failed: %v", err)
+	}
+	if ac.ID != "TM-ac-1" {
+		t.Errorf("expected gating AC TM-ac-1, got %s", ac.ID)
+	}
+	if len(gates["TM-task-2"]) != 1 || gates["TM-task-2"][0] != "TM-ac-1" {
+		t.Errorf("expected gate TM-task-2 -> TM-ac-1 to be saved, got %v", gates)
+	}
+
+	gating, err := service.ListTaskGates(ctx, "TM-task-2")
+	if err != nil {
+		t.Fatalf("ListTaskGates() failed: %v", err)
+	}
+	if label := entities.WaitingOnLabel(entities.PendingGates(gating)); label != "waiting on AC TM-ac-1" {
+		t.Errorf("unexpected waiting label: %q", label)
+	}
+}
+
+// TestTaskService_GateTask_Invalid tests rejected gates
+func TestTaskService_GateTask_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		gates   [][2]string // existing gates (task, AC)
+		taskID  string
+		acID    string
+		wantErr error
+	}{
+		{"unknown task", nil, "TM-task-9", "TM-ac-1", pluginsdk.ErrNotFound},
+		{"unknown AC", nil, "TM-task-1", "TM-ac-9", pluginsdk.ErrNotFound},
+		{"own AC", nil, "TM-task-1", "TM-ac-1", pluginsdk.ErrInvalidArgument},
+		{"direct cycle", [][2]string{{"TM-task-2", "TM-ac-1"}}, "TM-task-1", "TM-ac-2", pluginsdk.ErrInvalidArgument},
+		{"indirect cycle", [][2]string{{"TM-task-2", "TM-ac-1"}, {"TM-task-3", "TM-ac-2"}}, "TM-task-1", "TM-ac-3", pluginsdk.ErrInvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, ctx, gates := setupGateTestService(t)
+			for _, gate := range tt.gates {
+				gates[gate[0]] = append(gates[gate[0]], gate[1])
+			}
+
+			_, err := service.GateTask(ctx, tt.taskID, tt.acID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got: %v", tt.wantErr, err)
+			}
+			if len(gates[tt.taskID]) != 0 {
+				t.Errorf("expected no gate to be saved for %s, got %v", tt.taskID, gates[tt.taskID])
+			}
+		})
+	}
+}
+
 // ============================================================================
 // MoveTask Tests
 // ============================================================================
@@ -739,6 +959,634 @@ func TestTaskService_MoveTask_TrackNotFound(t *testing.T) {
 	}
 }
 
+// ============================================================================
+// CloneTask Tests
+// ============================================================================
+
+// TestTaskService_CloneTask_WithACs tests that cloned ACs get new IDs and a fresh not_started status
+func TestTaskService_CloneTask_WithACs(t *testing.T) {
+	service, ctx, mockTaskRepo, _, mockAggregateRepo, mockACRepo := setupTaskTestService(t)
+
+	created := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	source, _ := entities.NewTaskEntity("TM-task-1", "TM-track-1", "Export to CSV", "Write rows as CSV", "done", 250, "feat/csv", created, created)
+	mockTaskRepo.GetTaskFunc = func(ctx context.Context, id string) (*entities.TaskEntity, error) {
+		if id == source.ID {
+			return source, nil
+		}
+		return nil, pluginsdk.ErrNotFound
+	}
+	mockAggregateRepo.GetNextSequenceNumberFunc = func(ctx context.Context, entityType string) (int, error) {
+		return map[string]int{"task": 7, "ac": 20}[entityType], nil
+	}
+
+	verified := entities.NewAcceptanceCriteriaEntity("TM-ac-1", source.ID, "Header row is written", entities.VerificationTypeAutomated, "go test ./export", created, created)
+	verified.Status = entities.ACStatusVerified
+	verified.Notes = "checked"
+	failed := entities.NewAcceptanceCriteriaEntity("TM-ac-2", source.ID, "Quotes are escaped", entities.VerificationTypeManual, "", created, created)
+	failed.Status = entities.ACStatusFailed
+	mockACRepo.ListACFunc = func(ctx context.Context, taskID string) ([]*entities.AcceptanceCriteriaEntity, error) {
+		if taskID != source.ID {
+			t.Errorf("ListAC(%q), want source task", taskID)
+		}
+		return []*entities.AcceptanceCriteriaEntity{verified, failed}, nil
+	}
+	mockACRepo.ListACTagsFunc = func(ctx context.Context, acID string) ([]string, error) {
+		return map[string][]string{verified.ID: {"export", "regression"}}[acID], nil
+	}
+	savedTags := make(map[string][]string)
+	mockACRepo.AddACTagFunc = func(ctx context.Context, acID, tag string) error {
+		savedTags[acID] = append(savedTags[acID], tag)
+		return nil
+	}
+
+	var savedTask *entities.TaskEntity
+	mockTaskRepo.SaveTaskFunc = func(ctx context.Context, task *entities.TaskEntity) error {
+		savedTask = task
+		return nil
+	}
+	var savedACs []*entities.AcceptanceCriteriaEntity
+	mockACRepo.SaveACFunc = func(ctx context.Context, ac *entities.AcceptanceCriteriaEntity) error {
+		savedACs = append(savedACs, ac)
+		return nil
+	}
+
+	task, acs, iterations, err := service.CloneTask(ctx, dto.CloneTaskDTO{SourceID: source.ID, Title: "Export to TSV", WithACs: true})
+	if err != nil {
+		t.Fatalf("CloneTask() failed: %v", err)
+	}
+
+	if savedTask != task || task.ID != "TM-task-7" {
+		t.Fatalf("expected saved task TM-task-7, got %+v", savedTask)
+	}
+	if task.Title != "Export to TSV" || task.Description != source.Description || task.Rank != 250 || task.TrackID != source.TrackID {
+		t.Errorf("unexpected copy: %+v", task)
+	}
+	if task.Status != string(entities.TaskStatusTodo) || task.Branch != "" || !task.CreatedAt.After(created) {
+		t.Errorf("expected reset status, branch and timestamps, got %+v", task)
+	}
+	if len(iterations) != 0 {
+		t.Errorf("expected no iteration membership without SameIteration, got %d", len(iterations))
+	}
+
+	if len(acs) != 2 || len(savedACs) != 2 {
+		t.Fatalf("expected 2 cloned ACs, got %d (saved %d)", len(acs), len(savedACs))
+	}
+	for i, want := range []*entities.AcceptanceCriteriaEntity{verified, failed} {
+		ac := savedACs[i]
+		if ac.ID != fmt.Sprintf("TM-ac-%d", 20+i) || ac.TaskID != task.ID {
+			t.Errorf("AC %d: got ID %s on task %s", i, ac.ID, ac.TaskID)
+		}
+		if ac.Status != entities.ACStatusNotStarted || ac.Notes != "" {
+			t.Errorf("AC %s: expected fresh not_started status, got %s (notes %q)", ac.ID, ac.Status, ac.Notes)
+		}
+		if ac.Description != want.Description || ac.VerificationType != want.VerificationType || ac.TestingInstructions != want.TestingInstructions {
+			t.Errorf("AC %s: content not copied from %s", ac.ID, want.ID)
+		}
+	}
+	if got := savedTags["TM-ac-20"]; len(got) != 2 || got[0] != "export" || got[1] != "regression" || len(savedTags) != 1 {
+		t.Errorf("expected the tags of %s on TM-ac-20 only, got %v", verified.ID, savedTags)
+	}
+}
+
+// TestTaskService_CloneTask_SameIteration tests that the copy joins the source's open iterations only
+func TestTaskService_CloneTask_SameIteration(t *testing.T) {
+	mockTaskRepo := mocks.NewMockTaskRepository()
+	mockIterationRepo := mocks.NewMockIterationRepository()
+	service := application.NewTaskApplicationService(mockTaskRepo, &mocks.MockTrackRepository{}, &mocks.MockAggregateRepository{},
+		&mocks.MockAcceptanceCriteriaRepository{}, mockIterationRepo,
+		&mocks.MockTransactionalRepository{Tasks: mockTaskRepo, Iterations: mockIterationRepo}, services.NewValidationService())
+	ctx := context.Background()
+
+	now := time.Now().UTC()
+	source, _ := entities.NewTaskEntity("TM-task-1", "TM-track-1", "Test Task", "", "todo", 100, "", now, now)
+	mockTaskRepo.GetTaskFunc = func(ctx context.Context, id string) (*entities.TaskEntity, error) {
+		return source, nil
+	}
+	mockTaskRepo.GetIterationsForTaskFunc = func(ctx context.Context, taskID string) ([]*entities.IterationEntity, error) {
+		return []*entities.IterationEntity{
+			{Number: 1, Name: "Done", Status: string(entities.IterationStatusComplete)},
+			{Number: 2, Name: "Current", Status: string(entities.IterationStatusCurrent)},
+		}, nil
+	}
+	var added []int
+	mockIterationRepo.AddTaskToIterationFunc = func(ctx context.Context, iterationNum int, taskID string) error {
+		added = append(added, iterationNum)
+		return nil
+	}
+
+	_, _, iterations, err := service.CloneTask(ctx, dto.CloneTaskDTO{SourceID: source.ID, SameIteration: true})
+	if err != nil {
+		t.Fatalf("CloneTask() failed: %v", err)
+	}
+	if len(added) != 1 || added[0] != 2 || len(iterations) != 1 {
+		t.Errorf("expected copy added to iteration 2 only, got %v", added)
+	}
+}
+
+// TestTaskService_CloneTask_NotFound tests that a missing source task or target track is rejected
+func TestTaskService_CloneTask_NotFound(t *testing.T) {
+	service, ctx, mockTaskRepo, mockTrackRepo, _, _ := setupTaskTestService(t)
+
+	now := time.Now().UTC()
+	source, _ := entities.NewTaskEntity("TM-task-1", "TM-track-1", "Test Task", "", "todo", 100, "", now, now)
+	mockTaskRepo.GetTaskFunc = func(ctx context.Context, id string) (*entities.TaskEntity, error) {
+		if id == source.ID {
+			return source, nil
+		}
+		return nil, pluginsdk.ErrNotFound
+	}
+	mockTrackRepo.GetTrackFunc = func(ctx context.Context, id string) (*entities.TrackEntity, error) {
+		return nil, pluginsdk.ErrNotFound
+	}
+	mockTaskRepo.SaveTaskFunc = func(ctx context.Context, task *entities.TaskEntity) error {
+		t.Error("SaveTask should not be called")
+		return nil
+	}
+
+	if _, _, _, err := service.CloneTask(ctx, dto.CloneTaskDTO{SourceID: "TM-task-99"}); !errors.Is(err, pluginsdk.ErrNotFound) {
+		t.Errorf("missing source: expected ErrNotFound, got %v", err)
+	}
+	if _, _, _, err := service.CloneTask(ctx, dto.CloneTaskDTO{SourceID: source.ID, TrackID: "TM-track-99"}); !errors.Is(err, pluginsdk.ErrNotFound) {
+		t.Errorf("missing track: expected ErrNotFound, got %v", err)
+	}
+}
+
+// ============================================================================
+// BumpTask Tests
+// ============================================================================
+
+// setupBumpTestService creates a service whose track holds tasks with the given ranks,
+// named TM-task-1..n in order, and records every rank written back
+func setupBumpTestService(t *testing.T, ranks ...int) (*application.TaskApplicationService, context.Context, map[string]int) {
+	service, ctx, mockTaskRepo, _, _, _ := setupTaskTestService(t)
+
+	now := time.Now().UTC()
+	tasks := make([]*entities.TaskEntity, len(ranks))
+	for i, rank := range ranks {
+		task, err := entities.NewTaskEntity(fmt.Sprintf("TM-task-%d", i+1), "TM-track-1", "Task", "", "todo", rank, "", now, now)
+		if err != nil {
+			t.Fatalf("failed to create task: %v", err)
+		}
+		tasks[i] = task
+	}
+
+	mockTaskRepo.GetTaskFunc = func(ctx context.Context, id string) (*entities.TaskEntity, error) {
+		for _, task := range tasks {
+			if task.ID == id {
+				return task, nil
+			}
+		}
+		return nil, pluginsdk.ErrNotFound
+	}
+	mockTaskRepo.ListTasksFunc = func(ctx context.Context, filters entities.TaskFilters) ([]*entities.TaskEntity, error) {
+		if filters.TrackID != "TM-track-1" {
+			t.Errorf("expected peers of TM-track-1, got %q", filters.TrackID)
+		}
+		return append([]*entities.TaskEntity(nil), tasks...), nil
+	}
+	written := map[string]int{}
+	mockTaskRepo.UpdateTaskFunc = func(ctx context.Context, task *entities.TaskEntity) error {
+		written[task.ID] = task.Rank
+		return nil
+	}
+
+	return service, ctx, written
+}
+
+// TestTaskService_BumpTask tests moving a task among its track peers
+func TestTaskService_BumpTask(t *testing.T) {
+	tests := []struct {
+		name         string
+		ranks        []int
+		id           string
+		direction    string
+		wantPosition int
+		wantWritten  map[string]int
+	}{
+		{"top above minimum", []int{10, 20, 30}, "TM-task-3", "top", 1,
+			map[string]int{"TM-task-3": 9}},
+		{"bottom below maximum", []int{10, 20, 30}, "TM-task-1", "bottom", 3,
+			map[string]int{"TM-task-1": 31}},
+		{"up swaps with neighbour", []int{10, 20, 30}, "TM-task-3", "up", 2,
+			map[string]int{"TM-task-2": 30, "TM-task-3": 20}},
+		{"down swaps with neighbour", []int{10, 20, 30}, "TM-task-1", "down", 2,
+			map[string]int{"TM-task-1": 20, "TM-task-2": 10}},
+		{"already at top", []int{10, 20, 30}, "TM-task-1", "top", 1,
+			map[string]int{}},
+		{"already at bottom", []int{10, 20, 30}, "TM-task-3", "down", 3,
+			map[string]int{}},
+		{"collision is renormalized", []int{5, 5, 5}, "TM-task-3", "up", 2,
+			map[string]int{"TM-task-2": 7, "TM-task-3": 6}},
+		{"no room above rank 1", []int{1, 2, 3}, "TM-task-3", "top", 1,
+			map[string]int{"TM-task-1": 2, "TM-task-2": 3, "TM-task-3": 1}},
+		{"no room below rank 1000", []int{999, 1000, 1000}, "TM-task-1", "bottom", 3,
+			map[string]int{"TM-task-1": 1000, "TM-task-2": 998, "TM-task-3": 999}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, ctx, written := setupBumpTestService(t, tt.ranks...)
+
+			task, position, total, err := service.BumpTask(ctx, dto.BumpTaskDTO{ID: tt.id, Direction: tt.direction})
+			if err != nil {
+				t.Fatalf("BumpTask() failed: %v", err)
+			}
+			if task.ID != tt.id {
+				t.Errorf("expected task %s, got %s", tt.id, task.ID)
+			}
+			if position != tt.wantPosition || total != len(tt.ranks) {
+				t.Errorf("position = %d of %d, want %d of %d", position, total, tt.wantPosition, len(tt.ranks))
+			}
+			if len(written) != len(tt.wantWritten) {
+				t.Errorf("written ranks = %v, want %v", written, tt.wantWritten)
+			}
+			for id, rank := range tt.wantWritten {
+				if written[id] != rank {
+					t.Errorf("rank of %s = %d, want %d (written %v)", id, written[id], rank, written)
+				}
+			}
+		})
+	}
+}
+
+// TestTaskService_BumpTask_Invalid tests rejected directions and tasks outside the iteration
+func TestTaskService_BumpTask_Invalid(t *testing.T) {
+	service, ctx, _ := setupBumpTestService(t, 10, 20)
+
+	_, _, _, err := service.BumpTask(ctx, dto.BumpTaskDTO{ID: "TM-task-1", Direction: "sideways"})
+	if !errors.Is(err, pluginsdk.ErrInvalidArgument) {
+		t.Errorf("expected ErrInvalidArgument for bad direction, got %v", err)
+	}
+
+	iteration := 1
+	_, _, _, err = service.BumpTask(ctx, dto.BumpTaskDTO{ID: "TM-task-1", Direction: "up", IterationNum: &iteration})
+	if !errors.Is(err, pluginsdk.ErrInvalidArgument) {
+		t.Errorf("expected ErrInvalidArgument for task outside iteration, got %v", err)
+	}
+}
+
+// stagingTxRepository is a TransactionalRepository that stages task updates and only
+// commits them when the closure succeeds
+type stagingTxRepository struct {
+	failOnUpdate int // 1-based update that fails, 0 for none
+	committed    map[string]int
+}
+
+// stagedRoadmapRepository records UpdateTask calls; other methods are not used by BumpTask
+type stagedRoadmapRepository struct {
+	domain.RoadmapRepository
+	parent *stagingTxRepository
+	staged map[string]int
+}
+
+func (r *stagedRoadmapRepository) UpdateTask(ctx context.Context, task *entities.TaskEntity) error {
+	if len(r.staged)+1 == r.parent.failOnUpdate {
+		return errors.New("disk I/O error")
+	}
+	r.staged[task.ID] = task.Rank
+	return nil
+}
+
+func (r *stagingTxRepository) WithTx(ctx context.Context, fn func(domain.RoadmapRepository) error) error {
+	view := &stagedRoadmapRepository{parent: r, staged: map[string]int{}}
+	if err := fn(view); err != nil {
+		return err
+	}
+	for id, rank := range view.staged {
+		r.committed[id] = rank
+	}
+	return nil
+}
+
+// TestTaskService_BumpTask_Transactional tests that renormalized ranks are written in one
+// transaction and that a failure part way through leaves no rank changed
+func TestTaskService_BumpTask_Transactional(t *testing.T) {
+	for _, failOnUpdate := range []int{0, 2} {
+		t.Run(fmt.Sprintf("fail on update %d", failOnUpdate), func(t *testing.T) {
+			mockTaskRepo := &mocks.MockTaskRepository{}
+			txRepo := &stagingTxRepository{failOnUpdate: failOnUpdate, committed: map[string]int{}}
+			service := application.NewTaskApplicationService(mockTaskRepo, &mocks.MockTrackRepository{}, &mocks.MockAggregateRepository{},
+				&mocks.MockAcceptanceCriteriaRepository{}, &mocks.MockIterationRepository{}, txRepo, services.NewValidationService())
+			ctx := context.Background()
+
+			now := time.Now().UTC()
+			var tasks []*entities.TaskEntity
+			for i := 1; i <= 3; i++ {
+				task, _ := entities.NewTaskEntity(fmt.Sprintf("TM-task-%d", i), "TM-track-1", "Task", "", "todo", 5, "", now, now)
+				tasks = append(tasks, task)
+			}
+			mockTaskRepo.GetTaskFunc = func(ctx context.Context, id string) (*entities.TaskEntity, error) {
+				for _, task := range tasks {
+					if task.ID == id {
+						return task, nil
+					}
+				}
+				return nil, pluginsdk.ErrNotFound
+			}
+			mockTaskRepo.ListTasksFunc = func(ctx context.Context, filters entities.TaskFilters) ([]*entities.TaskEntity, error) {
+				return append([]*entities.TaskEntity(nil), tasks...), nil
+			}
+			mockTaskRepo.UpdateTaskFunc = func(ctx context.Context, task *entities.TaskEntity) error {
+				t.Errorf("expected updates to go through the transaction, got direct update of %s", task.ID)
+				return nil
+			}
+
+			_, _, _, err := service.BumpTask(ctx, dto.BumpTaskDTO{ID: "TM-task-3", Direction: dto.BumpUp})
+			if failOnUpdate == 0 {
+				if err != nil {
+					t.Fatalf("BumpTask failed: %v", err)
+				}
+				if len(txRepo.committed) != 2 {
+					t.Errorf("expected 2 committed rank changes, got %v", txRepo.committed)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("expected BumpTask to fail")
+			}
+			if len(txRepo.committed) != 0 {
+				t.Errorf("expected no committed rank changes after failure, got %v", txRepo.committed)
+			}
+		})
+	}
+}
+
+// ============================================================================
+// CreateTaskFromEvent Tests
+// ============================================================================
+
+// TestTaskService_CreateTaskFromEvent tests deriving a backlog task from an event
+func TestTaskService_CreateTaskFromEvent(t *testing.T) {
+	timestamp := time.Date(2025, 3, 14, 9, 30, 0, 0, time.UTC)
+	longMessage := strings.Repeat("x", 100)
+
+	tests := []struct {
+		name      string
+		payload   map[string]interface{}
+		wantTitle string
+	}{
+		{"error text", map[string]interface{}{"error": "  build failed\nstack trace", "message": "ignored"}, "build failed"},
+		{"message text", map[string]interface{}{"message": "disk full"}, "disk full"},
+		{"long text is shortened", map[string]interface{}{"error": longMessage}, longMessage[:77] + "..."},
+		{"no text", map[string]interface{}{"count": 3.0, "error": ""}, "Investigate build.failed event from " + timestamp.Local().Format("2006-01-02 15:04")},
+		{"empty payload", nil, "Investigate build.failed event from " + timestamp.Local().Format("2006-01-02 15:04")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, ctx, mockTaskRepo, mockTrackRepo, _, _ := setupTaskTestService(t)
+			track := createTestTrackForMock(t)
+			mockTrackRepo.GetTrackFunc = func(ctx context.Context, id string) (*entities.TrackEntity, error) {
+				return track, nil
+			}
+			mockTaskRepo.SaveTaskFunc = func(ctx context.Context, task *entities.TaskEntity) error {
+				return nil
+			}
+			var savedNote *entities.TaskNoteEntity
+			mockTaskRepo.SaveTaskNoteFunc = func(ctx context.Context, note *entities.TaskNoteEntity) error {
+				savedNote = note
+				return nil
+			}
+
+			task, err := service.CreateTaskFromEvent(ctx, dto.CreateTaskFromEventDTO{
+				TrackID:   track.ID,
+				EventID:   "evt-1",
+				EventType: "build.failed",
+				Timestamp: timestamp,
+				SessionID: "session-1",
+				Payload:   tt.payload,
+			})
+			if err != nil {
+				t.Fatalf("CreateTaskFromEvent() failed: %v", err)
+			}
+
+			if task.Title != tt.wantTitle {
+				t.Errorf("task.Title = %q, want %q", task.Title, tt.wantTitle)
+			}
+			if task.Status != string(entities.TaskStatusTodo) {
+				t.Errorf("task.Status = %q, want todo", task.Status)
+			}
+			if !strings.Contains(task.Description, "Captured from event evt-1 (build.failed) at 2025-03-14T09:30:00Z") {
+				t.Errorf("description should record the event, got %q", task.Description)
+			}
+			if savedNote == nil || savedNote.Content != "Created from event evt-1 (build.failed) in session session-1" {
+				t.Errorf("expected source event note, got %+v", savedNote)
+			}
+		})
+	}
+}
+
+// ============================================================================
+// AssignTask / GetWorkload Tests
+// ============================================================================
+
+// TestTaskService_AssignTask tests assigning and unassigning a task
+func TestTaskService_AssignTask(t *testing.T) {
+	service, ctx, mockTaskRepo, _, _, _ := setupTaskTestService(t)
+	track := createTestTrackForMock(t)
+
+	now := time.Now().UTC()
+	existingTask, _ := entities.NewTaskEntity("TM-task-1", track.ID, "Test Task", "", "todo", 100, "", now, now)
+	mockTaskRepo.GetTaskFunc = func(ctx context.Context, id string) (*entities.TaskEntity, error) {
+		if id == existingTask.ID {
+			return existingTask, nil
+		}
+		return nil, pluginsdk.ErrNotFound
+	}
+	var saved string
+	mockTaskRepo.UpdateTaskFunc = func(ctx context.Context, task *entities.TaskEntity) error {
+		saved = task.Assignee
+		return nil
+	}
+
+	task, err := service.AssignTask(ctx, "TM-task-1", "  alice ")
+	if err != nil {
+		t.Fatalf("AssignTask() failed: %v", err)
+	}
+	if task.Assignee != "alice" || saved != "alice" {
+		t.Errorf("expected assignee alice to be saved, got task=%q saved=%q", task.Assignee, saved)
+	}
+
+	task, err = service.AssignTask(ctx, "TM-task-1", "")
+	if err != nil {
+		t.Fatalf("AssignTask() unassign failed: %v", err)
+	}
+	if task.IsAssigned() || saved != "" {
+		t.Errorf("expected task to be unassigned, got task=%q saved=%q", task.Assignee, saved)
+	}
+
+	if _, err := service.AssignTask(ctx, "TM-task-9", "alice"); !errors.Is(err, pluginsdk.ErrNotFound) {
+		t.Errorf("expected ErrNotFound for unknown task, got %v", err)
+	}
+}
+
+// TestTaskService_GetWorkload tests tallying open tasks per assignee
+func TestTaskService_GetWorkload(t *testing.T) {
+	service, ctx, mockTaskRepo, _, _, _ := setupTaskTestService(t)
+	track := createTestTrackForMock(t)
+
+	now := time.Now().UTC()
+	newTask := func(id, status, assignee string) *entities.TaskEntity {
+		task, _ := entities.NewTaskEntity(id, track.ID, "Task "+id, "", status, 100, "", now, now)
+		task.Assignee = assignee
+		return task
+	}
+	tasks := []*entities.TaskEntity{
+		newTask("TM-task-1", "todo", "bob"),
+		newTask("TM-task-2", "in-progress", "alice"),
+		newTask("TM-task-3", "review", "alice"),
+		newTask("TM-task-4", "todo", ""),
+		newTask("TM-task-5", "done", "bob"),
+		newTask("TM-task-6", "todo", "carol"),
+		newTask("TM-task-7", "in-progress", "carol"),
+		newTask("TM-task-8", "done", "dave"),
+	}
+	var gotFilters entities.TaskFilters
+	mockTaskRepo.ListTasksFunc = func(ctx context.Context, filters entities.TaskFilters) ([]*entities.TaskEntity, error) {
+		gotFilters = filters
+		return tasks, nil
+	}
+
+	workload, err := service.GetWorkload(ctx, "roadmap-1")
+	if err != nil {
+		t.Fatalf("GetWorkload() failed: %v", err)
+	}
+	if len(gotFilters.Status) != 3 {
+		t.Errorf("expected open statuses to be queried, got %v", gotFilters.Status)
+	}
+	if gotFilters.RoadmapID != "roadmap-1" {
+		t.Errorf("expected tasks of roadmap-1 to be queried, got %q", gotFilters.RoadmapID)
+	}
+
+	want := []dto.AssigneeWorkloadDTO{
+		{Assignee: "alice", Open: 2, InProgress: 1, Review: 1},
+		{Assignee: "carol", Open: 2, Todo: 1, InProgress: 1},
+		{Assignee: "bob", Open: 1, Todo: 1},
+		{Assignee: "", Open: 1, Todo: 1},
+	}
+	if len(workload) != len(want) {
+		t.Fatalf("GetWorkload() returned %d entries, want %d: %+v", len(workload), len(want), workload)
+	}
+	for i := range want {
+		if workload[i] != want[i] {
+			t.Errorf("workload[%d] = %+v, want %+v", i, workload[i], want[i])
+		}
+	}
+}
+
+// TestTaskService_GetStatusReport tests counting by status and current iteration progress
+func TestTaskService_GetStatusReport(t *testing.T) {
+	ctx := context.Background()
+	mockAggregateRepo := &mocks.MockAggregateRepository{}
+	mockIterationRepo := &mocks.MockIterationRepository{}
+	service := application.NewTaskApplicationService(&mocks.MockTaskRepository{}, &mocks.MockTrackRepository{}, mockAggregateRepo, &mocks.MockAcceptanceCriteriaRepository{}, mockIterationRepo,
+		&mocks.MockTransactionalRepository{Iterations: mockIterationRepo, Aggregates: mockAggregateRepo}, services.NewValidationService())
+
+	mockAggregateRepo.CountByStatusFunc = func(ctx context.Context) (*entities.StatusCounts, error) {
+		return &entities.StatusCounts{
+			Tracks: map[string]int{"in-progress": 2},
+			Tasks:  map[string]int{"todo": 3, "done": 1},
+			ACs:    map[string]int{"verified": 4},
+		}, nil
+	}
+	mockIterationRepo.GetCurrentIterationFunc = func(ctx context.Context) (*entities.IterationEntity, error) {
+		return nil, fmt.Errorf("%w: no current iteration found", pluginsdk.ErrNotFound)
+	}
+
+	report, err := service.GetStatusReport(ctx)
+	if err != nil {
+		t.Fatalf("GetStatusReport() failed: %v", err)
+	}
+	if report.Tasks["todo"] != 3 || report.Tracks["in-progress"] != 2 || report.ACs["verified"] != 4 {
+		t.Errorf("expected the repository counts, got %+v", report)
+	}
+	if report.Iteration != nil {
+		t.Errorf("expected no iteration without a current one, got %+v", report.Iteration)
+	}
+
+	now := time.Now().UTC()
+	mockIterationRepo.GetCurrentIterationFunc = func(ctx context.Context) (*entities.IterationEntity, error) {
+		return &entities.IterationEntity{Number: 3, Name: "Sprint 3", Status: "current"}, nil
+	}
+	mockIterationRepo.GetIterationTasksFunc = func(ctx context.Context, iterationNum int) ([]*entities.TaskEntity, error) {
+		done, _ := entities.NewTaskEntity("TM-task-1", "TM-track-1", "Done", "", "done", 100, "", now, now)
+		todo, _ := entities.NewTaskEntity("TM-task-2", "TM-track-1", "Todo", "", "todo", 100, "", now, now)
+		return []*entities.TaskEntity{done, todo}, nil
+	}
+	report, err = service.GetStatusReport(ctx)
+	if err != nil {
+		t.Fatalf("GetStatusReport() failed: %v", err)
+	}
+	want := dto.IterationStatusDTO{Number: 3, Name: "Sprint 3", TasksDone: 1, Tasks: 2}
+	if report.Iteration == nil || *report.Iteration != want {
+		t.Errorf("Iteration = %+v, want %+v", report.Iteration, want)
+	}
+
+	mockAggregateRepo.CountByStatusFunc = func(ctx context.Context) (*entities.StatusCounts, error) {
+		return nil, errors.New("database is locked")
+	}
+	if _, err := service.GetStatusReport(ctx); err == nil {
+		t.Error("expected the repository error to be returned")
+	}
+}
+
+// TestTaskService_GetStaleTasks tests grouping old open tasks by track, oldest first
+func TestTaskService_GetStaleTasks(t *testing.T) {
+	service, ctx, mockTaskRepo, mockTrackRepo, _, _ := setupTaskTestService(t)
+	track := createTestTrackForMock(t)
+	mockTrackRepo.GetTrackFunc = func(ctx context.Context, id string) (*entities.TrackEntity, error) {
+		if id == track.ID {
+			return track, nil
+		}
+		return nil, pluginsdk.ErrNotFound
+	}
+
+	now := time.Date(2024, 6, 30, 12, 0, 0, 0, time.UTC)
+	newTask := func(id, trackID, status string, age time.Duration) *entities.TaskEntity {
+		task, _ := entities.NewTaskEntity(id, trackID, "Task "+id, "", status, 100, "", now.Add(-age), now.Add(-age))
+		return task
+	}
+	day := 24 * time.Hour
+	var gotFilters entities.TaskFilters
+	mockTaskRepo.ListTasksFunc = func(ctx context.Context, filters entities.TaskFilters) ([]*entities.TaskEntity, error) {
+		gotFilters = filters
+		return []*entities.TaskEntity{
+			newTask("TM-task-1", track.ID, "todo", 20*day),
+			newTask("TM-task-2", "TM-track-9", "in-progress", 40*day),
+			newTask("TM-task-3", track.ID, "in-progress", 30*day),
+		}, nil
+	}
+
+	report, err := service.GetStaleTasks(ctx, dto.StaleTasksQuery{Days: 14, Assignee: "alice", RoadmapID: "roadmap-1", Now: now})
+	if err != nil {
+		t.Fatalf("GetStaleTasks() failed: %v", err)
+	}
+	if gotFilters.UpdatedBefore == nil || !gotFilters.UpdatedBefore.Equal(now.AddDate(0, 0, -14)) {
+		t.Errorf("expected tasks updated before 14 days ago to be queried, got %v", gotFilters.UpdatedBefore)
+	}
+	if len(gotFilters.Status) != 2 || gotFilters.Assignee != "alice" || gotFilters.RoadmapID != "roadmap-1" {
+		t.Errorf("expected todo and in-progress tasks of alice in roadmap-1 to be queried, got %+v", gotFilters)
+	}
+
+	if len(report) != 2 {
+		t.Fatalf("GetStaleTasks() returned %d tracks, want 2: %+v", len(report), report)
+	}
+	if report[0].TrackID != "TM-track-9" || report[0].TrackTitle != "" || len(report[0].Tasks) != 1 {
+		t.Errorf("expected the track of the oldest task first, got %+v", report[0])
+	}
+	second := report[1]
+	if second.TrackTitle != track.Title || len(second.Tasks) != 2 || second.Tasks[0].ID != "TM-task-3" || second.Tasks[1].ID != "TM-task-1" {
+		t.Errorf("expected %s with TM-task-3 then TM-task-1, got %+v", track.ID, second)
+	}
+	if second.Tasks[0].Age != 30*day {
+		t.Errorf("expected an age of 30 days, got %v", second.Tasks[0].Age)
+	}
+
+	if _, err := service.GetStaleTasks(ctx, dto.StaleTasksQuery{Days: 0, Now: now}); !errors.Is(err, pluginsdk.ErrInvalidArgument) {
+		t.Errorf("expected ErrInvalidArgument for 0 days, got %v", err)
+	}
+}
+
 // ============================================================================
 // GetTask Tests
 // ============================================================================
@@ -883,12 +1731,12 @@ func TestTaskService_GetBacklogTasks_Success(t *testing.T) {
 	task1, _ := entities.NewTaskEntity("TM-task-1", track.ID, "Task 1", "", "todo", 100, "", now, now)
 	task3, _ := entities.NewTaskEntity("TM-task-3", track.ID, "Task 3", "", "todo", 300, "", now, now)
 
-	mockTaskRepo.GetBacklogTasksFunc = func(ctx context.Context) ([]*entities.TaskEntity, error) {
+	mockTaskRepo.GetBacklogTasksFunc = func(ctx context.Context, roadmapID string) ([]*entities.TaskEntity, error) {
 		return []*entities.TaskEntity{task1, task3}, nil
 	}
 
 	// Get backlog tasks (should return tasks not in any iteration and not done)
-	results, err := service.GetBacklogTasks(ctx)
+	results, err := service.GetBacklogTasks(ctx, "")
 	if err != nil {
 		t.Fatalf("GetBacklogTasks() failed: %v", err)
 	}
@@ -902,12 +1750,12 @@ func TestTaskService_GetBacklogTasks_Success(t *testing.T) {
 func TestTaskService_GetBacklogTasks_Empty(t *testing.T) {
 	service, ctx, mockTaskRepo, _, _, _ := setupTaskTestService(t)
 
-	mockTaskRepo.GetBacklogTasksFunc = func(ctx context.Context) ([]*entities.TaskEntity, error) {
+	mockTaskRepo.GetBacklogTasksFunc = func(ctx context.Context, roadmapID string) ([]*entities.TaskEntity, error) {
 		return []*entities.TaskEntity{}, nil
 	}
 
 	// Get backlog tasks from empty database
-	results, err := service.GetBacklogTasks(ctx)
+	results, err := service.GetBacklogTasks(ctx, "")
 	if err != nil {
 		t.Fatalf("GetBacklogTasks() failed: %v", err)
 	}
@@ -1012,6 +1860,40 @@ func TestTaskService_UpdateTask_CannotCompleteTodo_WithFailedACs(t *testing.T) {
 	}
 }
 
+// TestTaskService_UpdateTask_CanComplete_WithAutoOnCompleteACs tests that ACs tagged auto-on-complete don't block completion
+func TestTaskService_UpdateTask_CanComplete_WithAutoOnCompleteACs(t *testing.T) {
+	service, ctx, mockTaskRepo, _, _, mockACRepo := setupTaskTestService(t)
+
+	now := time.Now().UTC()
+	task, _ := entities.NewTaskEntity("TM-task-1", "TM-track-1", "Test Task", "Description", "in-progress", 100, "", now, now)
+	autoAC := entities.NewAcceptanceCriteriaEntity("TM-ac-1", task.ID, "Docs published", entities.VerificationTypeManual, "", now, now)
+
+	mockTaskRepo.GetTaskFunc = func(ctx context.Context, id string) (*entities.TaskEntity, error) {
+		if id == task.ID {
+			return task, nil
+		}
+		return nil, pluginsdk.ErrNotFound
+	}
+	mockACRepo.ListACFunc = func(ctx context.Context, taskID string) ([]*entities.AcceptanceCriteriaEntity, error) {
+		return []*entities.AcceptanceCriteriaEntity{autoAC}, nil
+	}
+	mockACRepo.ListTaggedACFunc = func(ctx context.Context, taskID, tag string) ([]*entities.AcceptanceCriteriaEntity, error) {
+		if tag == entities.ACTagAutoOnComplete {
+			return []*entities.AcceptanceCriteriaEntity{autoAC}, nil
+		}
+		return []*entities.AcceptanceCriteriaEntity{}, nil
+	}
+
+	doneStatus := "done"
+	updatedTask, err := service.UpdateTask(ctx, dto.UpdateTaskDTO{ID: task.ID, Status: &doneStatus})
+	if err != nil {
+		t.Fatalf("UpdateTask() should succeed with only auto-on-complete ACs pending, got error: %v", err)
+	}
+	if updatedTask.Status != "done" {
+		t.Errorf("task.Status = %q, want %q", updatedTask.Status, "done")
+	}
+}
+
 // TestTaskService_UpdateTask_CanCompleteTodo_WithAllVerifiedACs tests successful completion with all verified ACs
 func TestTaskService_UpdateTask_CanCompleteTodo_WithAllVerifiedACs(t *testing.T) {
 	service, ctx, mockTaskRepo, _, _, mockACRepo := setupTaskTestService(t)
@@ -1300,3 +2182,94 @@ func containsAt(s, substr string, start int) bool {
 	}
 	return false
 }
+
+// TestTaskService_TraceBlockedChain tests tracing track dependencies and gates down to the root blockers
+func TestTaskService_TraceBlockedChain(t *testing.T) {
+	service, ctx, mockTaskRepo, mockTrackRepo, _, mockACRepo := setupTaskTestService(t)
+
+	now := time.Now().UTC()
+	tracks := map[string]*entities.TrackEntity{
+		"TM-track-1": {ID: "TM-track-1", Title: "UI", Status: "in-progress", Dependencies: []string{"TM-track-2"}},
+		"TM-track-2": {ID: "TM-track-2", Title: "Backend", Status: "in-progress", Dependencies: []string{"TM-track-3"}},
+		"TM-track-3": {ID: "TM-track-3", Title: "Infra", Status: "complete"},
+	}
+	mockTrackRepo.GetTrackFunc = func(ctx context.Context, id string) (*entities.TrackEntity, error) {
+		if track, ok := tracks[id]; ok {
+			return track, nil
+		}
+		return nil, pluginsdk.ErrNotFound
+	}
+	tasks := map[string]*entities.TaskEntity{}
+	for _, task := range []struct{ id, track, status string }{
+		{"TM-task-1", "TM-track-1", "todo"},
+		{"TM-task-2", "TM-track-3", "in-progress"},
+		{"TM-task-3", "TM-track-2", "done"},
+		{"TM-task-4", "TM-track-2", "todo"},
+	} {
+		entity, err := entities.NewTaskEntity(task.id, task.track, "Task "+task.id, "", task.status, 100, "", now, now)
+		if err != nil {
+			t.Fatalf("failed to create task: %v", err)
+		}
+		tasks[task.id] = entity
+	}
+	mockTaskRepo.GetTaskFunc = func(ctx context.Context, id string) (*entities.TaskEntity, error) {
+		if task, ok := tasks[id]; ok {
+			return task, nil
+		}
+		return nil, pluginsdk.ErrNotFound
+	}
+	mockTaskRepo.ListTasksFunc = func(ctx context.Context, filters entities.TaskFilters) ([]*entities.TaskEntity, error) {
+		var result []*entities.TaskEntity
+		for _, id := range []string{"TM-task-1", "TM-task-2", "TM-task-3", "TM-task-4"} {
+			if tasks[id].TrackID == filters.TrackID {
+				result = append(result, tasks[id])
+			}
+		}
+		return result, nil
+	}
+	gate := &entities.AcceptanceCriteriaEntity{ID: "TM-ac-1", TaskID: "TM-task-2", Description: "API reviewed", Status: entities.ACStatusNotStarted}
+	mockACRepo.GetACFunc = func(ctx context.Context, id string) (*entities.AcceptanceCriteriaEntity, error) {
+		if id == gate.ID {
+			return gate, nil
+		}
+		return nil, pluginsdk.ErrNotFound
+	}
+	mockTaskRepo.ListTaskGatesFunc = func(ctx context.Context, taskID string) ([]*entities.AcceptanceCriteriaEntity, error) {
+		if taskID == "TM-task-1" {
+			return []*entities.AcceptanceCriteriaEntity{gate}, nil
+		}
+		return nil, nil
+	}
+
+	chain, err := service.TraceBlockedChain(ctx, "TM-task-1")
+	if err != nil {
+		t.Fatalf("TraceBlockedChain() failed: %v", err)
+	}
+
+	if !chain.IsBlocked() || len(chain.Blockers) != 2 {
+		t.Fatalf("expected TM-task-1 to be blocked by a track and a gate, got %+v", chain)
+	}
+	backend := chain.Blockers[0]
+	if backend.ID != "TM-track-2" || backend.Detail != "1 of 2 tasks open" {
+		t.Errorf("unexpected track link: %+v", backend)
+	}
+	if len(backend.Blockers) != 1 || !backend.Blockers[0].Satisfied {
+		t.Errorf("expected the complete dependency TM-track-3 to be satisfied, got %+v", backend.Blockers)
+	}
+	ac := chain.Blockers[1]
+	if ac.ID != "TM-ac-1" || len(ac.Blockers) != 1 || ac.Blockers[0].ID != "TM-task-2" {
+		t.Errorf("expected the gate to lead to its task, got %+v", ac)
+	}
+
+	var roots []string
+	for _, root := range chain.RootBlockers() {
+		roots = append(roots, root.ID)
+	}
+	if len(roots) != 2 || roots[0] != "TM-track-2" || roots[1] != "TM-task-2" {
+		t.Errorf("RootBlockers() = %v, want [TM-track-2 TM-task-2]", roots)
+	}
+
+	if _, err := service.TraceBlockedChain(ctx, "TM-task-9"); !errors.Is(err, pluginsdk.ErrNotFound) {
+		t.Errorf("expected ErrNotFound for an unknown task, got %v", err)
+	}
+}
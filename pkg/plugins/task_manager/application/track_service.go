@@ -3,6 +3,7 @@ package application
 import (
 	"context"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
@@ -39,12 +40,15 @@ func NewTrackApplicationService(
 // CreateTrack creates a new track with validation
 func (s *TrackApplicationService) CreateTrack(ctx context.Context, input dto.CreateTrackDTO) (*entities.TrackEntity, error) {
 	// Generate track ID
-	projectCode := s.aggregateRepo.GetProjectCode(ctx)
+	ids, err := newEntityIDs(ctx, s.aggregateRepo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate track ID: %w", err)
+	}
 	nextNum, err := s.aggregateRepo.GetNextSequenceNumber(ctx, "track")
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate track ID: %w", err)
 	}
-	id := fmt.Sprintf("%s-track-%d", projectCode, nextNum)
+	id := ids.ID("track", nextNum)
 
 	// Validate track ID format
 	if err := s.validationSvc.ValidateTrackID(id); err != nil {
@@ -67,6 +71,12 @@ func (s *TrackApplicationService) CreateTrack(ctx context.Context, input dto.Cre
 		return nil, fmt.Errorf("roadmap not found: %w", err)
 	}
 
+	// Verify dependencies exist and would not form a cycle
+	dependencies, err := s.validateNewTrackDependencies(ctx, id, input.Dependencies)
+	if err != nil {
+		return nil, err
+	}
+
 	// Set default status if not provided
 	status := input.Status
 	if status == "" {
@@ -82,7 +92,7 @@ func (s *TrackApplicationService) CreateTrack(ctx context.Context, input dto.Cre
 		input.Description,
 		status,
 		input.Rank,
-		[]string{}, // No dependencies initially
+		dependencies,
 		now,
 		now,
 	)
@@ -98,6 +108,38 @@ func (s *TrackApplicationService) CreateTrack(ctx context.Context, input dto.Cre
 	return track, nil
 }
 
+// validateNewTrackDependencies checks that every dependency of a track being created
+// exists and that the proposed edges would not form a cycle. Returns the deduplicated IDs.
+func (s *TrackApplicationService) validateNewTrackDependencies(ctx context.Context, trackID string, dependsOn []string) ([]string, error) {
+	dependencies := []string{}
+	seen := make(map[string]bool)
+	for _, depID := range dependsOn {
+		if depID == "" || seen[depID] {
+			continue
+		}
+		if _, err := s.trackRepo.GetTrack(ctx, depID); err != nil {
+			return nil, fmt.Errorf("dependency track %s not found: %w", depID, err)
+		}
+		seen[depID] = true
+		dependencies = append(dependencies, depID)
+	}
+	if len(dependencies) == 0 {
+		return dependencies, nil
+	}
+
+	getDependencies := func(ctx context.Context, id string) ([]string, error) {
+		if id == trackID {
+			return dependencies, nil
+		}
+		return s.trackRepo.GetTrackDependencies(ctx, id)
+	}
+	if err := services.NewDependencyService().ValidateNoCycles(ctx, trackID, getDependencies); err != nil {
+		return nil, fmt.Errorf("circular dependency detected: %w", err)
+	}
+
+	return dependencies, nil
+}
+
 // UpdateTrack updates an existing track
 func (s *TrackApplicationService) UpdateTrack(ctx context.Context, input dto.UpdateTrackDTO) (*entities.TrackEntity, error) {
 	// Fetch existing track
@@ -153,6 +195,12 @@ func (s *TrackApplicationService) DeleteTrack(ctx context.Context, trackID strin
 	return s.trackRepo.DeleteTrack(ctx, trackID)
 }
 
+// PlanTrackDeletion reports what deleting a track would remove and leave orphaned,
+// without deleting anything. Tasks of the track are not deleted or reparented.
+func (s *TrackApplicationService) PlanTrackDeletion(ctx context.Context, trackID string) (*entities.DeletionPlan, error) {
+	return s.aggregateRepo.PlanDeletion(ctx, entities.DeletionTargetTrack, trackID)
+}
+
 // GetTrack retrieves a track by ID
 func (s *TrackApplicationService) GetTrack(ctx context.Context, trackID string) (*entities.TrackEntity, error) {
 	return s.trackRepo.GetTrack(ctx, trackID)
@@ -223,6 +271,64 @@ func (s *TrackApplicationService) GetDependencies(ctx context.Context, trackID s
 	return s.trackRepo.GetTrackDependencies(ctx, trackID)
 }
 
+// GetDependencyGraph returns the dependency graph of all tracks in the active roadmap,
+// with any dependency cycles marked.
+func (s *TrackApplicationService) GetDependencyGraph(ctx context.Context) (*dto.DependencyGraphDTO, error) {
+	roadmap, err := s.roadmapRepo.GetActiveRoadmap(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active roadmap: %w", err)
+	}
+
+	tracks, err := s.trackRepo.ListTracks(ctx, roadmap.ID, entities.TrackFilters{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tracks: %w", err)
+	}
+
+	graph := make(map[string][]string, len(tracks))
+	for _, track := range tracks {
+		graph[track.ID] = track.Dependencies
+	}
+
+	cycles := services.NewDependencyService().FindCycles(graph)
+	cycleOf := make(map[string]int)
+	for i, cycle := range cycles {
+		for _, id := range cycle {
+			cycleOf[id] = i + 1
+		}
+	}
+
+	result := &dto.DependencyGraphDTO{
+		Nodes:  make([]dto.DependencyGraphNodeDTO, 0, len(tracks)),
+		Edges:  []dto.DependencyGraphEdgeDTO{},
+		Cycles: cycles,
+	}
+	for _, track := range tracks {
+		result.Nodes = append(result.Nodes, dto.DependencyGraphNodeDTO{
+			ID:      track.ID,
+			Title:   track.Title,
+			Status:  track.Status,
+			InCycle: cycleOf[track.ID] > 0,
+		})
+	}
+	for _, track := range tracks {
+		deps := append([]string{}, track.Dependencies...)
+		sort.Strings(deps)
+		for _, depID := range deps {
+			// Skip dependencies on tracks outside this roadmap
+			if _, ok := graph[depID]; !ok {
+				continue
+			}
+			result.Edges = append(result.Edges, dto.DependencyGraphEdgeDTO{
+				From:    track.ID,
+				To:      depID,
+				InCycle: cycleOf[track.ID] > 0 && cycleOf[track.ID] == cycleOf[depID],
+			})
+		}
+	}
+
+	return result, nil
+}
+
 // GetActiveRoadmap returns the active roadmap for the current project
 func (s *TrackApplicationService) GetActiveRoadmap(ctx context.Context) (*entities.RoadmapEntity, error) {
 	return s.roadmapRepo.GetActiveRoadmap(ctx)
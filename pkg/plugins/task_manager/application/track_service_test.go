@@ -2,6 +2,7 @@ package application_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -80,6 +81,111 @@ func TestTrackService_CreateTrack_Success(t *testing.T) {
 	}
 }
 
+// TestTrackService_CreateTrack_WithDependencies tests creating a track that depends on existing tracks
+func TestTrackService_CreateTrack_WithDependencies(t *testing.T) {
+	service, ctx, mockTrackRepo, mockRoadmapRepo, _ := setupTrackTestService(t)
+	roadmap := createTestRoadmap(t, "roadmap-1")
+
+	now := time.Now().UTC()
+	track5, _ := entities.NewTrackEntity("TM-track-5", roadmap.ID, "Track 5", "", "not-started", 100, []string{}, now, now)
+
+	mockRoadmapRepo.GetRoadmapFunc = func(ctx context.Context, id string) (*entities.RoadmapEntity, error) {
+		return roadmap, nil
+	}
+	mockTrackRepo.GetTrackFunc = func(ctx context.Context, id string) (*entities.TrackEntity, error) {
+		if id == track5.ID {
+			return track5, nil
+		}
+		return nil, pluginsdk.ErrNotFound
+	}
+	var saved *entities.TrackEntity
+	mockTrackRepo.SaveTrackFunc = func(ctx context.Context, track *entities.TrackEntity) error {
+		saved = track
+		return nil
+	}
+
+	track, err := service.CreateTrack(ctx, dto.CreateTrackDTO{
+		RoadmapID:    roadmap.ID,
+		Title:        "Dependent Track",
+		Rank:         200,
+		Dependencies: []string{"TM-track-5", "TM-track-5"},
+	})
+	if err != nil {
+		t.Fatalf("CreateTrack() failed: %v", err)
+	}
+	if len(track.Dependencies) != 1 || track.Dependencies[0] != "TM-track-5" {
+		t.Errorf("track.Dependencies = %v, want [TM-track-5]", track.Dependencies)
+	}
+	if saved == nil || len(saved.Dependencies) != 1 {
+		t.Errorf("saved track should include dependencies, got %+v", saved)
+	}
+}
+
+// TestTrackService_CreateTrack_UnknownDependency tests that unknown dependencies are rejected before saving
+func TestTrackService_CreateTrack_UnknownDependency(t *testing.T) {
+	service, ctx, mockTrackRepo, mockRoadmapRepo, _ := setupTrackTestService(t)
+	roadmap := createTestRoadmap(t, "roadmap-1")
+
+	mockRoadmapRepo.GetRoadmapFunc = func(ctx context.Context, id string) (*entities.RoadmapEntity, error) {
+		return roadmap, nil
+	}
+	mockTrackRepo.GetTrackFunc = func(ctx context.Context, id string) (*entities.TrackEntity, error) {
+		return nil, pluginsdk.ErrNotFound
+	}
+	mockTrackRepo.SaveTrackFunc = func(ctx context.Context, track *entities.TrackEntity) error {
+		t.Error("SaveTrack should not be called")
+		return nil
+	}
+
+	_, err := service.CreateTrack(ctx, dto.CreateTrackDTO{
+		RoadmapID:    roadmap.ID,
+		Title:        "Dependent Track",
+		Rank:         200,
+		Dependencies: []string{"TM-track-404"},
+	})
+	if !errors.Is(err, pluginsdk.ErrNotFound) {
+		t.Errorf("CreateTrack() error = %v, want ErrNotFound", err)
+	}
+}
+
+// TestTrackService_CreateTrack_DependencyCycle tests the cycle preflight against the proposed edges
+func TestTrackService_CreateTrack_DependencyCycle(t *testing.T) {
+	service, ctx, mockTrackRepo, mockRoadmapRepo, _ := setupTrackTestService(t)
+	roadmap := createTestRoadmap(t, "roadmap-1")
+
+	now := time.Now().UTC()
+	track2, _ := entities.NewTrackEntity("TM-track-2", roadmap.ID, "Track 2", "", "not-started", 100, []string{}, now, now)
+
+	mockRoadmapRepo.GetRoadmapFunc = func(ctx context.Context, id string) (*entities.RoadmapEntity, error) {
+		return roadmap, nil
+	}
+	mockTrackRepo.GetTrackFunc = func(ctx context.Context, id string) (*entities.TrackEntity, error) {
+		return track2, nil
+	}
+	// The new track gets the ID TM-track-1 from the mock sequence; TM-track-2 already
+	// depends on that ID, so the proposed edge TM-track-1 -> TM-track-2 closes a cycle
+	mockTrackRepo.GetTrackDependenciesFunc = func(ctx context.Context, trackID string) ([]string, error) {
+		if trackID == "TM-track-2" {
+			return []string{"TM-track-1"}, nil
+		}
+		return []string{}, nil
+	}
+	mockTrackRepo.SaveTrackFunc = func(ctx context.Context, track *entities.TrackEntity) error {
+		t.Error("SaveTrack should not be called")
+		return nil
+	}
+
+	_, err := service.CreateTrack(ctx, dto.CreateTrackDTO{
+		RoadmapID:    roadmap.ID,
+		Title:        "Cyclic Track",
+		Rank:         200,
+		Dependencies: []string{"TM-track-2"},
+	})
+	if !errors.Is(err, pluginsdk.ErrInvalidArgument) {
+		t.Errorf("CreateTrack() error = %v, want ErrInvalidArgument", err)
+	}
+}
+
 // TestTrackService_CreateTrack_InvalidID tests track creation with invalid ID
 // NOTE: This test is now obsolete because CreateTrackDTO no longer has an ID field.
 // The service auto-generates IDs internally, so there's no "invalid ID" scenario for create operations.
@@ -856,3 +962,59 @@ func TestTrackService_GetDependencies_Success(t *testing.T) {
 		t.Fatalf("GetDependencies() returned %d dependencies, want 2", len(deps))
 	}
 }
+
+// TestTrackService_GetDependencyGraph tests graph construction with isolated tracks and cycles
+func TestTrackService_GetDependencyGraph(t *testing.T) {
+	service, ctx, mockTrackRepo, mockRoadmapRepo, _ := setupTrackTestService(t)
+	roadmap := createTestRoadmap(t, "roadmap-1")
+	now := time.Now().UTC()
+
+	newTrack := func(id string, deps ...string) *entities.TrackEntity {
+		track, err := entities.NewTrackEntity(id, roadmap.ID, "Track "+id, "", "not-started", 100, deps, now, now)
+		if err != nil {
+			t.Fatalf("failed to create test track: %v", err)
+		}
+		return track
+	}
+
+	mockRoadmapRepo.GetActiveRoadmapFunc = func(ctx context.Context) (*entities.RoadmapEntity, error) {
+		return roadmap, nil
+	}
+	mockTrackRepo.ListTracksFunc = func(ctx context.Context, roadmapID string, filters entities.TrackFilters) ([]*entities.TrackEntity, error) {
+		return []*entities.TrackEntity{
+			newTrack("TM-track-1", "TM-track-2"),
+			newTrack("TM-track-2", "TM-track-1"),
+			newTrack("TM-track-3", "TM-track-1", "TM-track-other"),
+			newTrack("TM-track-4"),
+		}, nil
+	}
+
+	graph, err := service.GetDependencyGraph(ctx)
+	if err != nil {
+		t.Fatalf("GetDependencyGraph() failed: %v", err)
+	}
+
+	if len(graph.Nodes) != 4 {
+		t.Errorf("expected 4 nodes including the isolated track, got %d", len(graph.Nodes))
+	}
+	if len(graph.Cycles) != 1 || len(graph.Cycles[0]) != 2 {
+		t.Fatalf("expected one cycle of 2 tracks, got %v", graph.Cycles)
+	}
+
+	// Dependencies on tracks outside the roadmap are skipped
+	if len(graph.Edges) != 3 {
+		t.Fatalf("expected 3 edges, got %+v", graph.Edges)
+	}
+	for _, edge := range graph.Edges {
+		wantInCycle := edge.From != "TM-track-3"
+		if edge.InCycle != wantInCycle {
+			t.Errorf("edge %s -> %s: InCycle = %v, want %v", edge.From, edge.To, edge.InCycle, wantInCycle)
+		}
+	}
+	for _, node := range graph.Nodes {
+		wantInCycle := node.ID == "TM-track-1" || node.ID == "TM-track-2"
+		if node.InCycle != wantInCycle {
+			t.Errorf("node %s: InCycle = %v, want %v", node.ID, node.InCycle, wantInCycle)
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
 	"gopkg.in/yaml.v3"
 )
 
@@ -14,9 +15,64 @@ type ADRConfig struct {
 	EnforceOnTaskCompletion bool `yaml:"enforce_on_task_completion" json:"enforce_on_task_completion"`
 }
 
+// ACConfig holds configuration for acceptance criteria automation
+type ACConfig struct {
+	// AutoVerifyOnTrackComplete verifies a track's ACs tagged auto-on-complete as soon as
+	// the 'task update' command marks its last open task as done. Only that command
+	// triggers it; tasks marked done in the TUI are not reconciled. Off by default so
+	// that teams keep manual sign-off; 'dw task-manager reconcile' performs the same
+	// step on demand.
+	AutoVerifyOnTrackComplete bool `yaml:"auto_verify_on_track_complete" json:"auto_verify_on_track_complete"`
+
+	// RequireInstructions makes 'ac verify' reject criteria without testing
+	// instructions, so every verification can be reproduced. Off by default.
+	RequireInstructions bool `yaml:"require_instructions" json:"require_instructions"`
+
+	// RequireNotes makes 'ac verify' require --notes describing the evidence
+	// the criterion was verified with. Off by default.
+	RequireNotes bool `yaml:"require_notes" json:"require_notes"`
+}
+
+// IterationConfig holds configuration for iterations
+type IterationConfig struct {
+	// ProgressBasis selects what iteration progress is measured in: "tasks" (done tasks
+	// over all tasks, the default) or "acs" (verified acceptance criteria over all
+	// criteria of the iteration's tasks). Used by the TUI and 'iteration show'.
+	ProgressBasis string `yaml:"progress_basis" json:"progress_basis"`
+}
+
+// UserConfig identifies the person using this checkout
+type UserConfig struct {
+	// Name is matched against task assignees, e.g. by the TUI's "my tasks" filter.
+	// Assignees are free-form, so use the same spelling as in 'task assign'.
+	Name string `yaml:"name" json:"name"`
+}
+
+// StorageConfig holds configuration for the project databases
+type StorageConfig struct {
+	// WriteRetryAttempts is how often a write is attempted while SQLite reports the
+	// database as busy or locked, e.g. when an agent and a human write at the same time.
+	// 1 disables retries.
+	WriteRetryAttempts int `yaml:"write_retry_attempts" json:"write_retry_attempts"`
+}
+
+// TUIConfig holds configuration for the terminal UI
+type TUIConfig struct {
+	// Keys remaps TUI actions (up, down, select, back, reorder-up, reorder-down,
+	// refresh, quit) to lists of keys, e.g. up: [k, up]. Unmapped actions keep
+	// their default keys; invalid or conflicting entries fall back to the defaults
+	// with a warning when the TUI starts.
+	Keys map[string][]string `yaml:"keys" json:"keys"`
+}
+
 // Config holds all task-manager plugin configuration
 type Config struct {
-	ADR ADRConfig `yaml:"adr" json:"adr"`
+	ADR       ADRConfig       `yaml:"adr" json:"adr"`
+	AC        ACConfig        `yaml:"ac" json:"ac"`
+	Iteration IterationConfig `yaml:"iteration" json:"iteration"`
+	User      UserConfig      `yaml:"user" json:"user"`
+	Storage   StorageConfig   `yaml:"storage" json:"storage"`
+	TUI       TUIConfig       `yaml:"tui" json:"tui"`
 }
 
 // DefaultConfig returns the default configuration for the task-manager plugin
@@ -26,9 +82,27 @@ func DefaultConfig() *Config {
 			Required:                false,
 			EnforceOnTaskCompletion: false,
 		},
+		AC: ACConfig{
+			AutoVerifyOnTrackComplete: false,
+		},
+		Iteration: IterationConfig{
+			ProgressBasis: string(entities.ProgressBasisTasks),
+		},
+		Storage: StorageConfig{
+			WriteRetryAttempts: 5,
+		},
 	}
 }
 
+// IterationProgressBasis returns the configured iteration progress basis, tasks when unset
+func (c *Config) IterationProgressBasis() entities.ProgressBasis {
+	basis, err := entities.ParseProgressBasis(c.Iteration.ProgressBasis)
+	if err != nil {
+		return entities.ProgressBasisTasks
+	}
+	return basis
+}
+
 // LoadConfig loads configuration from file if it exists, otherwise returns default config
 // It searches for config in order:
 // 1. DW_CONFIG_PATH environment variable
@@ -126,11 +200,159 @@ func loadConfigFromFile(path string, cfg *Config) error {
 				cfg.ADR.EnforceOnTaskCompletion = enforce
 			}
 		}
+
+		// Apply AC config if present. "acceptance" is accepted as an alias of "ac"
+		for _, section := range []string{"ac", "acceptance"} {
+			acCfgRaw, ok := taskManagerCfg[section]
+			if !ok {
+				continue
+			}
+			var acCfg map[interface{}]interface{}
+			// Handle both interface{} and map types
+			switch v := acCfgRaw.(type) {
+			case map[interface{}]interface{}:
+				acCfg = v
+			case map[string]interface{}:
+				// Convert string keys to interface{} keys
+				acCfg = make(map[interface{}]interface{})
+				for k, v := range v {
+					acCfg[k] = v
+				}
+			default:
+				return nil
+			}
+
+			if autoVerify, ok := acCfg["auto_verify_on_track_complete"].(bool); ok {
+				cfg.AC.AutoVerifyOnTrackComplete = autoVerify
+			}
+			if requireInstructions, ok := acCfg["require_instructions"].(bool); ok {
+				cfg.AC.RequireInstructions = requireInstructions
+			}
+			if requireNotes, ok := acCfg["require_notes"].(bool); ok {
+				cfg.AC.RequireNotes = requireNotes
+			}
+		}
+
+		// Apply iteration config if present
+		if iterationCfgRaw, ok := taskManagerCfg["iteration"]; ok {
+			var iterationCfg map[interface{}]interface{}
+			// Handle both interface{} and map types
+			switch v := iterationCfgRaw.(type) {
+			case map[interface{}]interface{}:
+				iterationCfg = v
+			case map[string]interface{}:
+				// Convert string keys to interface{} keys
+				iterationCfg = make(map[interface{}]interface{})
+				for k, v := range v {
+					iterationCfg[k] = v
+				}
+			default:
+				return nil
+			}
+
+			if basis, ok := iterationCfg["progress_basis"].(string); ok {
+				if _, err := entities.ParseProgressBasis(basis); err != nil {
+					return fmt.Errorf("task_manager.iteration.progress_basis must be tasks or acs, got %q", basis)
+				}
+				cfg.Iteration.ProgressBasis = basis
+			}
+		}
+
+		// Apply user config if present
+		if userCfgRaw, ok := taskManagerCfg["user"]; ok {
+			var userCfg map[interface{}]interface{}
+			// Handle both interface{} and map types
+			switch v := userCfgRaw.(type) {
+			case map[interface{}]interface{}:
+				userCfg = v
+			case map[string]interface{}:
+				// Convert string keys to interface{} keys
+				userCfg = make(map[interface{}]interface{})
+				for k, v := range v {
+					userCfg[k] = v
+				}
+			default:
+				return nil
+			}
+
+			if name, ok := userCfg["name"].(string); ok {
+				cfg.User.Name = name
+			}
+		}
+
+		// Apply storage config if present
+		if storageCfgRaw, ok := taskManagerCfg["storage"]; ok {
+			var storageCfg map[interface{}]interface{}
+			// Handle both interface{} and map types
+			switch v := storageCfgRaw.(type) {
+			case map[interface{}]interface{}:
+				storageCfg = v
+			case map[string]interface{}:
+				// Convert string keys to interface{} keys
+				storageCfg = make(map[interface{}]interface{})
+				for k, v := range v {
+					storageCfg[k] = v
+				}
+			default:
+				return nil
+			}
+
+			if attempts, ok := storageCfg["write_retry_attempts"].(int); ok {
+				if attempts < 1 {
+					return fmt.Errorf("task_manager.storage.write_retry_attempts must be at least 1, got %d", attempts)
+				}
+				cfg.Storage.WriteRetryAttempts = attempts
+			}
+		}
+
+		// Apply TUI config if present
+		if tuiCfgRaw, ok := taskManagerCfg["tui"]; ok {
+			var tuiCfg map[interface{}]interface{}
+			// Handle both interface{} and map types
+			switch v := tuiCfgRaw.(type) {
+			case map[interface{}]interface{}:
+				tuiCfg = v
+			case map[string]interface{}:
+				// Convert string keys to interface{} keys
+				tuiCfg = make(map[interface{}]interface{})
+				for k, v := range v {
+					tuiCfg[k] = v
+				}
+			default:
+				return nil
+			}
+
+			if keys, ok := tuiCfg["keys"].(map[string]interface{}); ok {
+				cfg.TUI.Keys = parseKeyBindings(keys)
+			}
+		}
 	}
 
 	return nil
 }
 
+// parseKeyBindings reads the action-to-keys map of task_manager.tui.keys. An action
+// takes a single key or a list of keys; entries of any other shape become an empty
+// list, which the TUI reports and replaces with the action's default keys.
+func parseKeyBindings(raw map[string]interface{}) map[string][]string {
+	bindings := make(map[string][]string, len(raw))
+	for action, value := range raw {
+		var keys []string
+		switch v := value.(type) {
+		case string:
+			keys = []string{v}
+		case []interface{}:
+			for _, item := range v {
+				if k, ok := item.(string); ok {
+					keys = append(keys, k)
+				}
+			}
+		}
+		bindings[action] = keys
+	}
+	return bindings
+}
+
 // SaveConfig saves the configuration to a file
 func SaveConfig(path string, cfg *Config) error {
 	// Create parent directory if it doesn't exist
@@ -145,8 +367,33 @@ func SaveConfig(path string, cfg *Config) error {
 				"required":                  cfg.ADR.Required,
 				"enforce_on_task_completion": cfg.ADR.EnforceOnTaskCompletion,
 			},
+			"ac": map[string]interface{}{
+				"auto_verify_on_track_complete": cfg.AC.AutoVerifyOnTrackComplete,
+				"require_instructions":          cfg.AC.RequireInstructions,
+				"require_notes":                 cfg.AC.RequireNotes,
+			},
+			"user": map[string]interface{}{
+				"name": cfg.User.Name,
+			},
 		},
 	}
+	if cfg.Iteration.ProgressBasis != "" {
+		cfgMap["task_manager"].(map[string]interface{})["iteration"] = map[string]interface{}{
+			"progress_basis": cfg.Iteration.ProgressBasis,
+		}
+	}
+	// A zero Storage section means "unset"; writing it would fail validation on load
+	if cfg.Storage.WriteRetryAttempts > 0 {
+		cfgMap["task_manager"].(map[string]interface{})["storage"] = map[string]interface{}{
+			"write_retry_attempts": cfg.Storage.WriteRetryAttempts,
+		}
+	}
+
+	if len(cfg.TUI.Keys) > 0 {
+		cfgMap["task_manager"].(map[string]interface{})["tui"] = map[string]interface{}{
+			"keys": cfg.TUI.Keys,
+		}
+	}
 
 	data, err := yaml.Marshal(cfgMap)
 	if err != nil {
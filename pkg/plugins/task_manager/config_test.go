@@ -3,6 +3,7 @@ package task_manager_test
 import (
 	"os"
 	"path/filepath"
+	"reflect"
 	"testing"
 
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager"
@@ -17,6 +18,9 @@ func TestDefaultConfig(t *testing.T) {
 	if cfg.ADR.EnforceOnTaskCompletion {
 		t.Error("ADR.EnforceOnTaskCompletion should be false by default")
 	}
+	if cfg.AC.AutoVerifyOnTrackComplete {
+		t.Error("AC.AutoVerifyOnTrackComplete should be false by default")
+	}
 }
 
 func TestLoadConfigNoFile(t *testing.T) {
@@ -77,6 +81,11 @@ func TestSaveConfig(t *testing.T) {
 			Required:                false,
 			EnforceOnTaskCompletion: true,
 		},
+		AC: task_manager.ACConfig{
+			AutoVerifyOnTrackComplete: true,
+			RequireInstructions:       true,
+			RequireNotes:              true,
+		},
 	}
 
 	if err := task_manager.SaveConfig(configPath, cfg); err != nil {
@@ -100,6 +109,12 @@ func TestSaveConfig(t *testing.T) {
 	if !loadedCfg.ADR.EnforceOnTaskCompletion {
 		t.Error("ADR.EnforceOnTaskCompletion should be true")
 	}
+	if !loadedCfg.AC.AutoVerifyOnTrackComplete {
+		t.Error("AC.AutoVerifyOnTrackComplete should be true")
+	}
+	if !loadedCfg.AC.RequireInstructions || !loadedCfg.AC.RequireNotes {
+		t.Error("AC.RequireInstructions and AC.RequireNotes should be true")
+	}
 }
 
 func TestLoadConfigPartialOverride(t *testing.T) {
@@ -132,4 +147,153 @@ task_manager:
 	if cfg.ADR.EnforceOnTaskCompletion {
 		t.Error("ADR.EnforceOnTaskCompletion should stay false (default)")
 	}
+	if cfg.AC.AutoVerifyOnTrackComplete {
+		t.Error("AC.AutoVerifyOnTrackComplete should stay false (default)")
+	}
+	if cfg.AC.RequireInstructions || cfg.AC.RequireNotes {
+		t.Error("AC.RequireInstructions and AC.RequireNotes should stay false (default)")
+	}
+}
+
+func TestLoadConfigUserName(t *testing.T) {
+	tmpDir := t.TempDir()
+	configDir := filepath.Join(tmpDir, ".darwinflow")
+	if err := os.MkdirAll(configDir, 0755); err != nil {
+		t.Fatalf("failed to create config dir: %v", err)
+	}
+
+	configPath := filepath.Join(configDir, "config.yaml")
+	configContent := `
+task_manager:
+  user:
+    name: alice
+`
+	if err := os.WriteFile(configPath, []byte(configContent), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+
+	cfg, err := task_manager.LoadConfig(tmpDir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.User.Name != "alice" {
+		t.Errorf("User.Name = %q, want %q", cfg.User.Name, "alice")
+	}
+
+	// Round-trip through SaveConfig
+	if err := task_manager.SaveConfig(configPath, cfg); err != nil {
+		t.Fatalf("failed to save config: %v", err)
+	}
+	loadedCfg, err := task_manager.LoadConfig(tmpDir)
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+	if loadedCfg.User.Name != "alice" {
+		t.Errorf("User.Name after save = %q, want %q", loadedCfg.User.Name, "alice")
+	}
+}
+
+func TestLoadConfigStorage(t *testing.T) {
+	if got := task_manager.DefaultConfig().Storage.WriteRetryAttempts; got != 5 {
+		t.Errorf("default WriteRetryAttempts = %d, want 5", got)
+	}
+
+	tmpDir := t.TempDir()
+	configDir := filepath.Join(tmpDir, ".darwinflow")
+	if err := os.MkdirAll(configDir, 0755); err != nil {
+		t.Fatalf("failed to create config dir: %v", err)
+	}
+	configPath := filepath.Join(configDir, "config.yaml")
+
+	if err := os.WriteFile(configPath, []byte("task_manager:\n  storage:\n    write_retry_attempts: 8\n"), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+	cfg, err := task_manager.LoadConfig(tmpDir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.Storage.WriteRetryAttempts != 8 {
+		t.Errorf("WriteRetryAttempts = %d, want 8", cfg.Storage.WriteRetryAttempts)
+	}
+
+	if err := os.WriteFile(configPath, []byte("task_manager:\n  storage:\n    write_retry_attempts: 0\n"), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+	if _, err := task_manager.LoadConfig(tmpDir); err == nil {
+		t.Error("expected error for write_retry_attempts below 1")
+	}
+}
+
+func TestLoadConfigIterationProgressBasis(t *testing.T) {
+	if got := task_manager.DefaultConfig().Iteration.ProgressBasis; got != "tasks" {
+		t.Errorf("default ProgressBasis = %q, want tasks", got)
+	}
+
+	tmpDir := t.TempDir()
+	configDir := filepath.Join(tmpDir, ".darwinflow")
+	if err := os.MkdirAll(configDir, 0755); err != nil {
+		t.Fatalf("failed to create config dir: %v", err)
+	}
+	configPath := filepath.Join(configDir, "config.yaml")
+
+	if err := os.WriteFile(configPath, []byte("task_manager:\n  iteration:\n    progress_basis: acs\n"), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+	cfg, err := task_manager.LoadConfig(tmpDir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.Iteration.ProgressBasis != "acs" {
+		t.Errorf("ProgressBasis = %q, want acs", cfg.Iteration.ProgressBasis)
+	}
+
+	if err := os.WriteFile(configPath, []byte("task_manager:\n  iteration:\n    progress_basis: points\n"), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+	if _, err := task_manager.LoadConfig(tmpDir); err == nil {
+		t.Error("expected error for an unknown progress_basis")
+	}
+}
+
+func TestLoadConfigTUIKeys(t *testing.T) {
+	tmpDir := t.TempDir()
+	configDir := filepath.Join(tmpDir, ".darwinflow")
+	if err := os.MkdirAll(configDir, 0755); err != nil {
+		t.Fatalf("failed to create config dir: %v", err)
+	}
+	configPath := filepath.Join(configDir, "config.yaml")
+
+	configContent := `
+task_manager:
+  tui:
+    keys:
+      up: [w, up]
+      quit: x
+      back: 3
+`
+	if err := os.WriteFile(configPath, []byte(configContent), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+	cfg, err := task_manager.LoadConfig(tmpDir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string][]string{"up": {"w", "up"}, "quit": {"x"}, "back": nil}
+	if !reflect.DeepEqual(cfg.TUI.Keys, want) {
+		t.Errorf("TUI.Keys = %v, want %v", cfg.TUI.Keys, want)
+	}
+
+	// Round-trip through SaveConfig
+	cfg.TUI.Keys = map[string][]string{"up": {"w", "up"}}
+	if err := task_manager.SaveConfig(configPath, cfg); err != nil {
+		t.Fatalf("failed to save config: %v", err)
+	}
+	loadedCfg, err := task_manager.LoadConfig(tmpDir)
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+	if !reflect.DeepEqual(loadedCfg.TUI.Keys, cfg.TUI.Keys) {
+		t.Errorf("TUI.Keys after save = %v, want %v", loadedCfg.TUI.Keys, cfg.TUI.Keys)
+	}
 }
@@ -1,9 +1,32 @@
 package entities
 
 import (
+	"fmt"
+	"regexp"
+	"strings"
 	"time"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
 )
 
+// ACTagAutoOnComplete tags an acceptance criterion that is verified automatically once
+// every task of its track is done (see 'dw task-manager reconcile'). Tagged criteria do
+// not block their task from being marked done.
+const ACTagAutoOnComplete = "auto-on-complete"
+
+// acTagPattern matches valid AC tags: lowercase words separated by single dashes
+var acTagPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// NormalizeACTag lowercases and trims an AC tag and validates it.
+// Tags consist of letters and digits separated by dashes, e.g. "auto-on-complete".
+func NormalizeACTag(tag string) (string, error) {
+	normalized := strings.ToLower(strings.TrimSpace(tag))
+	if !acTagPattern.MatchString(normalized) {
+		return "", fmt.Errorf("%w: invalid AC tag '%s' (use letters and digits separated by dashes)", pluginsdk.ErrInvalidArgument, tag)
+	}
+	return normalized, nil
+}
+
 // AcceptanceCriteriaEntity represents a single acceptance criterion for a task
 type AcceptanceCriteriaEntity struct {
 	ID                  string                            `json:"id"`
@@ -85,3 +108,38 @@ func (ac *AcceptanceCriteriaEntity) StatusIndicator() string {
 		return "○"
 	}
 }
+
+// ACProgress summarizes how far a task's acceptance criteria are. Done counts the
+// criteria that no longer block the task (verified or skipped).
+type ACProgress struct {
+	Total  int
+	Done   int
+	Failed int
+}
+
+// TaskACCoverage is a task with the progress of its acceptance criteria; ACs.Total is
+// zero for a task without any
+type TaskACCoverage struct {
+	TaskID  string
+	TrackID string
+	Title   string
+	Status  string
+	ACs     ACProgress
+}
+
+// CountACProgress returns the AC progress of each task that has acceptance criteria, keyed by task ID
+func CountACProgress(acs []*AcceptanceCriteriaEntity) map[string]ACProgress {
+	progress := make(map[string]ACProgress)
+	for _, ac := range acs {
+		p := progress[ac.TaskID]
+		p.Total++
+		if ac.IsVerified() || ac.IsSkipped() {
+			p.Done++
+		}
+		if ac.IsFailed() {
+			p.Failed++
+		}
+		progress[ac.TaskID] = p
+	}
+	return progress
+}
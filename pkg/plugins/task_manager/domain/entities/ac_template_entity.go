@@ -0,0 +1,65 @@
+package entities
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// ACTemplateItem is a single acceptance criterion definition within a template
+type ACTemplateItem struct {
+	Description      string                             `json:"description"`
+	VerificationType AcceptanceCriteriaVerificationType `json:"verification_type"`
+}
+
+// ACTemplateEntity is a named, reusable set of acceptance criteria that can be
+// applied to tasks (e.g. "has tests", "docs updated", "reviewed").
+type ACTemplateEntity struct {
+	Name      string           `json:"name"`
+	Items     []ACTemplateItem `json:"items"`
+	CreatedAt time.Time        `json:"created_at"`
+	UpdatedAt time.Time        `json:"updated_at"`
+}
+
+// NewACTemplateEntity creates a new AC template with validation.
+// Items with an empty verification type default to manual verification.
+func NewACTemplateEntity(name string, items []ACTemplateItem, createdAt, updatedAt time.Time) (*ACTemplateEntity, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, fmt.Errorf("%w: template name must be non-empty", pluginsdk.ErrInvalidArgument)
+	}
+	if strings.ContainsAny(name, " \t\n") {
+		return nil, fmt.Errorf("%w: template name must not contain whitespace", pluginsdk.ErrInvalidArgument)
+	}
+	if len(items) == 0 {
+		return nil, fmt.Errorf("%w: template must contain at least one acceptance criterion", pluginsdk.ErrInvalidArgument)
+	}
+
+	normalized := make([]ACTemplateItem, 0, len(items))
+	for i, item := range items {
+		description := strings.TrimSpace(item.Description)
+		if description == "" {
+			return nil, fmt.Errorf("%w: template entry %d has an empty description", pluginsdk.ErrInvalidArgument, i+1)
+		}
+		verificationType := item.VerificationType
+		if verificationType == "" {
+			verificationType = VerificationTypeManual
+		}
+		if !IsValidVerificationType(string(verificationType)) {
+			return nil, fmt.Errorf("%w: invalid verification type %q for template entry %d (must be manual or automated)", pluginsdk.ErrInvalidArgument, verificationType, i+1)
+		}
+		normalized = append(normalized, ACTemplateItem{
+			Description:      description,
+			VerificationType: verificationType,
+		})
+	}
+
+	return &ACTemplateEntity{
+		Name:      name,
+		Items:     normalized,
+		CreatedAt: createdAt,
+		UpdatedAt: updatedAt,
+	}, nil
+}
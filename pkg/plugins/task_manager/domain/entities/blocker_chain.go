@@ -0,0 +1,86 @@
+package entities
+
+// A blocker chain explains why a task can't start: the task's track waits on
+// dependency tracks, and gating ACs wait on other tasks, which may in turn be
+// blocked. Walking these links down to the incomplete ones without incomplete
+// blockers of their own finds the root blockers.
+
+// BlockerKind is the kind of entity a blocker link refers to
+type BlockerKind string
+
+const (
+	BlockerKindTask  BlockerKind = "task"
+	BlockerKindTrack BlockerKind = "track"
+	BlockerKindAC    BlockerKind = "ac"
+)
+
+// BlockerRef identifies a link of a blocker chain
+type BlockerRef struct {
+	Kind BlockerKind
+	ID   string
+}
+
+// BlockerLink is one link of a blocker chain with the links blocking it
+type BlockerLink struct {
+	Kind      BlockerKind
+	ID        string
+	Title     string
+	Status    string
+	Detail    string // Extra context, e.g. "2 of 5 tasks open" or "of task DW-task-3"
+	Satisfied bool   // Done, complete or verified: the link no longer blocks anything
+	Cycle     bool   // The link is already on the path to the root; not expanded again
+	Repeated  bool   // The link is expanded elsewhere in the chain; not expanded again
+	Blockers  []*BlockerLink
+}
+
+// Ref returns the reference of the link
+func (l *BlockerLink) Ref() BlockerRef {
+	return BlockerRef{Kind: l.Kind, ID: l.ID}
+}
+
+// IsBlocked reports whether any direct blocker of the link is unsatisfied
+func (l *BlockerLink) IsBlocked() bool {
+	for _, blocker := range l.Blockers {
+		if !blocker.Satisfied {
+			return true
+		}
+	}
+	return false
+}
+
+// RootBlockers returns the unsatisfied links below l that have no unsatisfied
+// blockers themselves, each once, in depth-first order. Cycle and repeated
+// markers are skipped: a repeated link is reported where it is expanded, and a
+// cycle has no root.
+func (l *BlockerLink) RootBlockers() []*BlockerLink {
+	roots := []*BlockerLink{}
+	seen := map[BlockerRef]bool{}
+	var walk func(link *BlockerLink)
+	walk = func(link *BlockerLink) {
+		for _, blocker := range link.Blockers {
+			if blocker.Satisfied || blocker.Cycle || blocker.Repeated {
+				continue
+			}
+			if !blocker.IsBlocked() {
+				if !seen[blocker.Ref()] {
+					seen[blocker.Ref()] = true
+					roots = append(roots, blocker)
+				}
+				continue
+			}
+			walk(blocker)
+		}
+	}
+	walk(l)
+	return roots
+}
+
+// HasCycle reports whether the chain below l loops back on itself
+func (l *BlockerLink) HasCycle() bool {
+	for _, blocker := range l.Blockers {
+		if blocker.Cycle || blocker.HasCycle() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,59 @@
+package entities_test
+
+import (
+	"testing"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
+)
+
+func TestBlockerLink_RootBlockers(t *testing.T) {
+	// task-1 waits on track-2 (waiting on complete track-3) and on ac-2 of task-2,
+	// which waits on track-2 too
+	track3 := &entities.BlockerLink{Kind: entities.BlockerKindTrack, ID: "track-3", Satisfied: true}
+	track2 := &entities.BlockerLink{Kind: entities.BlockerKindTrack, ID: "track-2", Blockers: []*entities.BlockerLink{track3}}
+	task2 := &entities.BlockerLink{Kind: entities.BlockerKindTask, ID: "task-2", Blockers: []*entities.BlockerLink{
+		{Kind: entities.BlockerKindTrack, ID: "track-2", Repeated: true},
+		{Kind: entities.BlockerKindTask, ID: "task-4"},
+	}}
+	root := &entities.BlockerLink{Kind: entities.BlockerKindTask, ID: "task-1", Blockers: []*entities.BlockerLink{
+		track2,
+		{Kind: entities.BlockerKindAC, ID: "ac-2", Blockers: []*entities.BlockerLink{task2}},
+		{Kind: entities.BlockerKindAC, ID: "ac-3", Satisfied: true},
+	}}
+
+	if !root.IsBlocked() {
+		t.Error("expected task-1 to be blocked")
+	}
+	var ids []string
+	for _, link := range root.RootBlockers() {
+		ids = append(ids, link.ID)
+	}
+	if len(ids) != 2 || ids[0] != "track-2" || ids[1] != "task-4" {
+		t.Errorf("RootBlockers() = %v, want [track-2 task-4]", ids)
+	}
+	if root.HasCycle() {
+		t.Error("expected no cycle")
+	}
+}
+
+func TestBlockerLink_Cycle(t *testing.T) {
+	root := &entities.BlockerLink{Kind: entities.BlockerKindTrack, ID: "A", Blockers: []*entities.BlockerLink{
+		{Kind: entities.BlockerKindTrack, ID: "B", Blockers: []*entities.BlockerLink{
+			{Kind: entities.BlockerKindTrack, ID: "A", Cycle: true},
+		}},
+	}}
+
+	if got := root.RootBlockers(); len(got) != 0 {
+		t.Errorf("expected a cycle to have no root blockers, got %d", len(got))
+	}
+	if !root.HasCycle() {
+		t.Error("expected HasCycle() to find the cycle")
+	}
+
+	satisfied := &entities.BlockerLink{Kind: entities.BlockerKindTask, ID: "T", Blockers: []*entities.BlockerLink{
+		{Kind: entities.BlockerKindAC, ID: "ac-1", Satisfied: true},
+	}}
+	if satisfied.IsBlocked() || len(satisfied.RootBlockers()) != 0 {
+		t.Error("expected satisfied blockers not to block")
+	}
+}
@@ -0,0 +1,92 @@
+package entities
+
+import (
+	"fmt"
+	"io"
+)
+
+// Entity types a deletion can be planned for
+const (
+	DeletionTargetTrack     = "track"
+	DeletionTargetTask      = "task"
+	DeletionTargetIteration = "iteration"
+	DeletionTargetAC        = "ac"
+)
+
+// DeletionPlanItem counts the rows of one kind affected by a deletion
+type DeletionPlanItem struct {
+	Kind  string `json:"kind"` // Singular noun, e.g. "task" or "iteration association"
+	Count int    `json:"count"`
+}
+
+// DeletionPlan describes the blast radius of a delete without performing it.
+// Deleted lists what the delete removes, the target itself first. Orphaned lists
+// rows that stay behind still referencing the target: foreign keys are not enforced,
+// so nothing cascades beyond what the repository deletes explicitly.
+type DeletionPlan struct {
+	TargetType string             `json:"target_type"`
+	TargetID   string             `json:"target_id"`
+	Deleted    []DeletionPlanItem `json:"deleted"`
+	Orphaned   []DeletionPlanItem `json:"orphaned"`
+}
+
+// DeletedCount returns the number of rows of kind the delete removes
+func (p *DeletionPlan) DeletedCount(kind string) int {
+	return countPlanItems(p.Deleted, kind)
+}
+
+// OrphanedCount returns the number of rows of kind left behind by the delete
+func (p *DeletionPlan) OrphanedCount(kind string) int {
+	return countPlanItems(p.Orphaned, kind)
+}
+
+func countPlanItems(items []DeletionPlanItem, kind string) int {
+	for _, item := range items {
+		if item.Kind == kind {
+			return item.Count
+		}
+	}
+	return 0
+}
+
+// Write prints the plan as indented "N kind(s)" lines, skipping kinds with no rows
+func (p *DeletionPlan) Write(out io.Writer) {
+	fmt.Fprintf(out, "Plan: delete %s %s (nothing is changed)\n", p.TargetType, p.TargetID)
+	fmt.Fprintf(out, "  Would delete:\n")
+	for _, item := range p.Deleted {
+		if item.Count > 0 {
+			fmt.Fprintf(out, "    %s\n", pluralizeKind(item.Count, item.Kind))
+		}
+	}
+
+	orphaned := false
+	for _, item := range p.Orphaned {
+		if item.Count == 0 {
+			continue
+		}
+		if !orphaned {
+			fmt.Fprintf(out, "  Would orphan (kept, still referencing the deleted %s):\n", p.TargetType)
+			orphaned = true
+		}
+		fmt.Fprintf(out, "    %s\n", pluralizeKind(item.Count, item.Kind))
+	}
+	if !orphaned {
+		fmt.Fprintf(out, "  Nothing would be orphaned\n")
+	}
+}
+
+// pluralizeKind formats a count with its kind, e.g. "1 task" or "3 acceptance criteria"
+func pluralizeKind(count int, kind string) string {
+	if count == 1 {
+		return fmt.Sprintf("%d %s", count, kind)
+	}
+	switch kind {
+	case "acceptance criterion":
+		return fmt.Sprintf("%d acceptance criteria", count)
+	case "track dependency":
+		return fmt.Sprintf("%d track dependencies", count)
+	case "gate on its AC":
+		return fmt.Sprintf("%d gates on its ACs", count)
+	}
+	return fmt.Sprintf("%d %ss", count, kind)
+}
@@ -0,0 +1,62 @@
+package entities_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
+)
+
+func TestDeletionPlan_Write(t *testing.T) {
+	plan := &entities.DeletionPlan{
+		TargetType: entities.DeletionTargetTrack,
+		TargetID:   "TM-track-1",
+		Deleted:    []entities.DeletionPlanItem{{Kind: "track", Count: 1}},
+		Orphaned: []entities.DeletionPlanItem{
+			{Kind: "task", Count: 3},
+			{Kind: "acceptance criterion", Count: 2},
+			{Kind: "ADR", Count: 0},
+		},
+	}
+
+	var buf bytes.Buffer
+	plan.Write(&buf)
+	out := buf.String()
+
+	for _, want := range []string{
+		"Plan: delete track TM-track-1",
+		"    1 track\n",
+		"Would orphan",
+		"    3 tasks\n",
+		"    2 acceptance criteria\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "ADR") {
+		t.Errorf("expected zero counts to be skipped, got:\n%s", out)
+	}
+
+	if got := plan.OrphanedCount("task"); got != 3 {
+		t.Errorf("expected 3 orphaned tasks, got %d", got)
+	}
+	if got := plan.DeletedCount("task"); got != 0 {
+		t.Errorf("expected 0 deleted tasks, got %d", got)
+	}
+}
+
+func TestDeletionPlan_WriteNothingOrphaned(t *testing.T) {
+	plan := &entities.DeletionPlan{
+		TargetType: entities.DeletionTargetAC,
+		TargetID:   "TM-ac-1",
+		Deleted:    []entities.DeletionPlanItem{{Kind: "acceptance criterion", Count: 1}},
+	}
+
+	var buf bytes.Buffer
+	plan.Write(&buf)
+	if !strings.Contains(buf.String(), "Nothing would be orphaned") {
+		t.Errorf("expected nothing orphaned, got:\n%s", buf.String())
+	}
+}
@@ -0,0 +1,104 @@
+package entities
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// failureReasonSimilarity is the minimum word overlap (Jaccard index) for fuzzy
+// grouping to put two failure reasons in the same group
+const failureReasonSimilarity = 0.5
+
+// FailureReasonAC is a failed acceptance criterion exhibiting a failure reason
+type FailureReasonAC struct {
+	ID          string `json:"id"`
+	TaskID      string `json:"task_id"`
+	Description string `json:"description"`
+	Notes       string `json:"notes"` // The AC's own reason text, which may differ from the group's under fuzzy grouping
+}
+
+// FailureReasonGroup is a failure reason shared by one or more failed acceptance criteria
+type FailureReasonGroup struct {
+	Reason string            `json:"reason"` // Text of the first AC in the group; empty when no reason was recorded
+	Count  int               `json:"count"`
+	ACs    []FailureReasonAC `json:"acs"`
+}
+
+// GroupFailureReasons groups failed acceptance criteria by the reason in their Notes,
+// most frequent reason first (ties keep the order reasons first appear in).
+// Reasons are matched exactly, ignoring case, surrounding whitespace and trailing
+// punctuation. With fuzzy set, a reason also joins the first group whose reason
+// shares enough words with it, so near-duplicates like "Safari layout broken" and
+// "layout broken in Safari" are counted together.
+func GroupFailureReasons(acs []*AcceptanceCriteriaEntity, fuzzy bool) []FailureReasonGroup {
+	var groups []FailureReasonGroup
+	var keys []string
+	var words []map[string]bool
+	byKey := make(map[string]int)
+
+	for _, ac := range acs {
+		key := normalizeFailureReason(ac.Notes)
+		index, ok := byKey[key]
+		if !ok && fuzzy && key != "" {
+			acWords := failureReasonWords(key)
+			for i := range groups {
+				if keys[i] != "" && jaccard(acWords, words[i]) >= failureReasonSimilarity {
+					index, ok = i, true
+					break
+				}
+			}
+		}
+		if !ok {
+			index = len(groups)
+			byKey[key] = index
+			groups = append(groups, FailureReasonGroup{Reason: strings.TrimSpace(ac.Notes)})
+			keys = append(keys, key)
+			words = append(words, failureReasonWords(key))
+		}
+
+		groups[index].Count++
+		groups[index].ACs = append(groups[index].ACs, FailureReasonAC{
+			ID:          ac.ID,
+			TaskID:      ac.TaskID,
+			Description: ac.Description,
+			Notes:       ac.Notes,
+		})
+	}
+
+	sort.SliceStable(groups, func(i, j int) bool {
+		return groups[i].Count > groups[j].Count
+	})
+	return groups
+}
+
+// normalizeFailureReason lowercases a reason, collapses whitespace and drops trailing punctuation
+func normalizeFailureReason(reason string) string {
+	normalized := strings.ToLower(strings.Join(strings.Fields(reason), " "))
+	return strings.TrimRightFunc(normalized, unicode.IsPunct)
+}
+
+// failureReasonWords returns the set of words in a normalized reason
+func failureReasonWords(reason string) map[string]bool {
+	set := make(map[string]bool)
+	for _, word := range strings.FieldsFunc(reason, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		set[word] = true
+	}
+	return set
+}
+
+// jaccard returns the size of the intersection of two word sets over the size of their union
+func jaccard(a, b map[string]bool) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	shared := 0
+	for word := range a {
+		if b[word] {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(a)+len(b)-shared)
+}
@@ -0,0 +1,72 @@
+package entities_test
+
+import (
+	"testing"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
+)
+
+func failedAC(id, notes string) *entities.AcceptanceCriteriaEntity {
+	return &entities.AcceptanceCriteriaEntity{ID: id, TaskID: "TM-task-1", Status: entities.ACStatusFailed, Notes: notes}
+}
+
+func TestGroupFailureReasons_Exact(t *testing.T) {
+	acs := []*entities.AcceptanceCriteriaEntity{
+		failedAC("TM-ac-1", "Timeout in CI"),
+		failedAC("TM-ac-2", "Safari layout broken"),
+		failedAC("TM-ac-3", "  safari LAYOUT broken. "),
+		failedAC("TM-ac-4", "Layout broken in Safari"),
+		failedAC("TM-ac-5", ""),
+	}
+
+	groups := entities.GroupFailureReasons(acs, false)
+
+	if len(groups) != 4 {
+		t.Fatalf("expected 4 groups, got %d: %+v", len(groups), groups)
+	}
+	if groups[0].Reason != "Safari layout broken" || groups[0].Count != 2 {
+		t.Errorf("expected most frequent reason first, got %q x%d", groups[0].Reason, groups[0].Count)
+	}
+	if groups[0].ACs[1].ID != "TM-ac-3" || groups[0].ACs[1].Notes != "  safari LAYOUT broken. " {
+		t.Errorf("expected group ACs to keep their own notes, got %+v", groups[0].ACs[1])
+	}
+	// Ties keep first-appearance order
+	if groups[1].Reason != "Timeout in CI" || groups[2].Reason != "Layout broken in Safari" {
+		t.Errorf("expected ties in appearance order, got %q then %q", groups[1].Reason, groups[2].Reason)
+	}
+	if groups[3].Reason != "" || groups[3].Count != 1 {
+		t.Errorf("expected ACs without notes grouped under an empty reason, got %+v", groups[3])
+	}
+}
+
+func TestGroupFailureReasons_Fuzzy(t *testing.T) {
+	acs := []*entities.AcceptanceCriteriaEntity{
+		failedAC("TM-ac-1", "Safari layout broken on iPhone"),
+		failedAC("TM-ac-2", "Timeout in CI"),
+		failedAC("TM-ac-3", "Layout broken in Safari"),
+		failedAC("TM-ac-4", "CI timeout"),
+		failedAC("TM-ac-5", "Wrong currency symbol"),
+		failedAC("TM-ac-6", ""),
+		failedAC("TM-ac-7", ""),
+	}
+
+	groups := entities.GroupFailureReasons(acs, true)
+
+	want := []struct {
+		reason string
+		count  int
+	}{
+		{"Safari layout broken on iPhone", 2},
+		{"Timeout in CI", 2},
+		{"", 2},
+		{"Wrong currency symbol", 1},
+	}
+	if len(groups) != len(want) {
+		t.Fatalf("expected %d groups, got %d: %+v", len(want), len(groups), groups)
+	}
+	for i, w := range want {
+		if groups[i].Reason != w.reason || groups[i].Count != w.count {
+			t.Errorf("group %d: expected %q x%d, got %q x%d", i, w.reason, w.count, groups[i].Reason, groups[i].Count)
+		}
+	}
+}
@@ -0,0 +1,269 @@
+package entities
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// IDFormatMetadataKey is the project metadata key holding the ID format template
+const IDFormatMetadataKey = "id_format"
+
+// DefaultIDFormat is the ID format of projects that do not configure one (DW-task-1)
+const DefaultIDFormat = "{code}-{entity}-{number}"
+
+// idFormatEntityAbbreviations are the {abbr} values of the entity types with generated IDs
+var idFormatEntityAbbreviations = map[string]string{
+	"task":  "T",
+	"track": "TR",
+	"ac":    "AC",
+	"adr":   "ADR",
+}
+
+// idFormatPlaceholder matches {code}, {entity}, {abbr}, {number} and {number:N}
+var idFormatPlaceholder = regexp.MustCompile(`\{([A-Za-z]*)(?::(\d+))?\}`)
+
+// idFormatSeparators are the characters that may separate the placeholders of a format
+const idFormatSeparators = "-/.:"
+
+// IDFormat is a template for the IDs of tracks, tasks, ACs and ADRs. Placeholders:
+//
+//	{code}      project code (DW)
+//	{entity}    entity type (task, track, ac, adr)
+//	{abbr}      entity abbreviation (T, TR, AC, ADR)
+//	{number}    sequence number; {number:N} zero-pads it to N digits
+//
+// The default "{code}-{entity}-{number}" gives DW-task-1, "{code}/{abbr}/{number:3}" gives DW/T/001.
+// Placeholders are separated by one or more of - / . : so IDs split back into their parts.
+type IDFormat struct {
+	template string
+	parts    []idFormatPart
+	patterns map[string]*regexp.Regexp // ParseNumber pattern per entity type
+}
+
+// idFormatPart is a literal or a placeholder of a template
+type idFormatPart struct {
+	literal     string
+	placeholder string // "" for literals
+	width       int    // zero-pad width of {number:N}
+}
+
+// ParseIDFormat validates template and returns its format. An empty template is the
+// default format. The template needs exactly one number and an entity placeholder so
+// IDs of different entity types cannot collide.
+func ParseIDFormat(template string) (*IDFormat, error) {
+	if template == "" {
+		template = DefaultIDFormat
+	}
+
+	f := &IDFormat{template: template}
+	numbers, entityTypes := 0, 0
+	last := 0
+	for _, m := range idFormatPlaceholder.FindAllStringSubmatchIndex(template, -1) {
+		if literal := template[last:m[0]]; literal != "" || last > 0 {
+			if last == 0 || !isIDFormatSeparator(literal) {
+				return nil, fmt.Errorf("%w: invalid ID format %q: placeholders must be separated by -, /, . or : with nothing before or after them",
+					pluginsdk.ErrInvalidArgument, template)
+			}
+			f.parts = append(f.parts, idFormatPart{literal: literal})
+		}
+		last = m[1]
+
+		part := idFormatPart{placeholder: template[m[2]:m[3]]}
+		switch part.placeholder {
+		case "number":
+			numbers++
+			if m[4] >= 0 {
+				width, _ := strconv.Atoi(template[m[4]:m[5]])
+				if width < 1 || width > 9 {
+					return nil, fmt.Errorf("%w: invalid ID format %q: zero-pad width must be 1-9", pluginsdk.ErrInvalidArgument, template)
+				}
+				part.width = width
+			}
+		case "code", "entity", "abbr":
+			if m[4] >= 0 {
+				return nil, fmt.Errorf("%w: invalid ID format %q: only {number} takes a width", pluginsdk.ErrInvalidArgument, template)
+			}
+			if part.placeholder != "code" {
+				entityTypes++
+			}
+		default:
+			return nil, fmt.Errorf("%w: invalid ID format %q: unknown placeholder {%s} (use {code}, {entity}, {abbr}, {number} or {number:N})",
+				pluginsdk.ErrInvalidArgument, template, part.placeholder)
+		}
+		f.parts = append(f.parts, part)
+	}
+	if last < len(template) {
+		return nil, fmt.Errorf("%w: invalid ID format %q: placeholders must be separated by -, /, . or : with nothing before or after them",
+			pluginsdk.ErrInvalidArgument, template)
+	}
+	if numbers != 1 {
+		return nil, fmt.Errorf("%w: invalid ID format %q: needs exactly one {number}", pluginsdk.ErrInvalidArgument, template)
+	}
+	if entityTypes == 0 {
+		return nil, fmt.Errorf("%w: invalid ID format %q: needs {entity} or {abbr} to tell entity types apart", pluginsdk.ErrInvalidArgument, template)
+	}
+
+	f.patterns = make(map[string]*regexp.Regexp, len(idFormatEntityAbbreviations))
+	for entityType := range idFormatEntityAbbreviations {
+		f.patterns[entityType] = f.pattern(entityType)
+	}
+	return f, nil
+}
+
+// String returns the template of the format
+func (f *IDFormat) String() string {
+	return f.template
+}
+
+// IsDefault reports whether f is the default format
+func (f *IDFormat) IsDefault() bool {
+	return f.template == DefaultIDFormat
+}
+
+// Format returns the ID of the number-th entity of entityType in a project with code
+func (f *IDFormat) Format(code, entityType string, number int) string {
+	var sb strings.Builder
+	for _, part := range f.parts {
+		switch part.placeholder {
+		case "":
+			sb.WriteString(part.literal)
+		case "code":
+			sb.WriteString(code)
+		case "entity":
+			sb.WriteString(entityType)
+		case "abbr":
+			sb.WriteString(idFormatAbbreviation(entityType))
+		case "number":
+			fmt.Fprintf(&sb, "%0*d", part.width, number)
+		}
+	}
+	return sb.String()
+}
+
+// ParseNumber returns the sequence number of an ID of entityType generated by f. ok is
+// false when id does not have the format. The project code is not checked, so IDs
+// keep parsing after the code changes.
+func (f *IDFormat) ParseNumber(id, entityType string) (number int, ok bool) {
+	pattern, found := f.patterns[entityType]
+	if !found {
+		pattern = f.pattern(entityType)
+	}
+
+	m := pattern.FindStringSubmatch(id)
+	if m == nil {
+		return 0, false
+	}
+	number, err := strconv.Atoi(m[1])
+	if err != nil {
+		return 0, false
+	}
+	return number, true
+}
+
+// pattern returns the regular expression matching IDs of entityType, capturing the number
+func (f *IDFormat) pattern(entityType string) *regexp.Regexp {
+	var pattern strings.Builder
+	pattern.WriteString("^")
+	for _, part := range f.parts {
+		switch part.placeholder {
+		case "":
+			pattern.WriteString(regexp.QuoteMeta(part.literal))
+		case "code":
+			pattern.WriteString(`[A-Za-z0-9]+`)
+		case "entity":
+			pattern.WriteString(regexp.QuoteMeta(entityType))
+		case "abbr":
+			pattern.WriteString(regexp.QuoteMeta(idFormatAbbreviation(entityType)))
+		case "number":
+			// Numbers outgrow their zero-pad width
+			pattern.WriteString(`(\d+)`)
+		}
+	}
+	pattern.WriteString("$")
+	return regexp.MustCompile(pattern.String())
+}
+
+// FormattedIDEntityType returns the entity type of an ID generated by any valid ID format:
+// the ID splits at separators into upper-case code parts, digits and one entity type or
+// abbreviation. The entity type is found by name first, then by the last abbreviation,
+// since a project code may look like an abbreviation.
+func FormattedIDEntityType(id string) (entityType string, ok bool) {
+	entityType, _, ok = parseFormattedID(id)
+	return entityType, ok
+}
+
+// FormattedIDNumber returns the sequence number of an ID of entityType generated by any
+// valid ID format, e.g. of IDs created before the project's format changed
+func FormattedIDNumber(id, entityType string) (number int, ok bool) {
+	idType, number, ok := parseFormattedID(id)
+	if !ok || idType != entityType {
+		return 0, false
+	}
+	return number, true
+}
+
+// parseFormattedID splits id into its parts (see FormattedIDEntityType). With several
+// numeric parts, as with a numeric project code, the last one is the number.
+func parseFormattedID(id string) (entityType string, number int, ok bool) {
+	if id == "" || isIDFormatSeparator(id[:1]) || isIDFormatSeparator(id[len(id)-1:]) {
+		return "", 0, false
+	}
+	parts := strings.FieldsFunc(id, func(r rune) bool { return strings.ContainsRune(idFormatSeparators, r) })
+
+	hasNumber := false
+	for _, part := range parts {
+		switch {
+		case idFormatDigits.MatchString(part):
+			n, err := strconv.Atoi(part)
+			if err != nil {
+				return "", 0, false
+			}
+			number, hasNumber = n, true
+		case idFormatEntityAbbreviations[part] != "":
+			if entityType != "" {
+				return "", 0, false
+			}
+			entityType = part
+		case idFormatUpper.MatchString(part):
+			// Project code or abbreviation; resolved below
+		default:
+			return "", 0, false
+		}
+	}
+	if !hasNumber {
+		return "", 0, false
+	}
+	if entityType != "" {
+		return entityType, number, true
+	}
+	for i := len(parts) - 1; i >= 0; i-- {
+		for candidate, abbr := range idFormatEntityAbbreviations {
+			if parts[i] == abbr {
+				return candidate, number, true
+			}
+		}
+	}
+	return "", 0, false
+}
+
+var (
+	idFormatDigits = regexp.MustCompile(`^[0-9]+$`)
+	idFormatUpper  = regexp.MustCompile(`^[A-Z0-9]+$`)
+)
+
+// isIDFormatSeparator reports whether s is a non-empty run of separators
+func isIDFormatSeparator(s string) bool {
+	return s != "" && strings.Trim(s, idFormatSeparators) == ""
+}
+
+// idFormatAbbreviation returns the {abbr} value of entityType, its upper case for unknown types
+func idFormatAbbreviation(entityType string) string {
+	if abbr, ok := idFormatEntityAbbreviations[entityType]; ok {
+		return abbr
+	}
+	return strings.ToUpper(entityType)
+}
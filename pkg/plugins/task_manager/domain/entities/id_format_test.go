@@ -0,0 +1,113 @@
+package entities_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+func TestIDFormat_FormatAndParseRoundTrip(t *testing.T) {
+	tests := []struct {
+		template   string
+		entityType string
+		number     int
+		want       string
+	}{
+		{"", "task", 12, "DW-task-12"},
+		{entities.DefaultIDFormat, "track", 3, "DW-track-3"},
+		{"{code}/{abbr}/{number:3}", "task", 7, "DW/T/007"},
+		{"{code}/{abbr}/{number:3}", "track", 7, "DW/TR/007"},
+		{"{code}/{abbr}/{number:3}", "ac", 1234, "DW/AC/1234"},
+		{"{entity}.{number:5}", "adr", 42, "adr.00042"},
+		{"{code}:{number:2}-{abbr}", "task", 5, "DW:05-T"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			format, err := entities.ParseIDFormat(tt.template)
+			if err != nil {
+				t.Fatalf("ParseIDFormat(%q) failed: %v", tt.template, err)
+			}
+			id := format.Format("DW", tt.entityType, tt.number)
+			if id != tt.want {
+				t.Errorf("Format() = %q, want %q", id, tt.want)
+			}
+			number, ok := format.ParseNumber(id, tt.entityType)
+			if !ok || number != tt.number {
+				t.Errorf("ParseNumber(%q) = %d, %v, want %d", id, number, ok, tt.number)
+			}
+			if entityType, ok := entities.FormattedIDEntityType(id); !ok || entityType != tt.entityType {
+				t.Errorf("FormattedIDEntityType(%q) = %q, %v, want %q", id, entityType, ok, tt.entityType)
+			}
+			if number, ok := entities.FormattedIDNumber(id, tt.entityType); !ok || number != tt.number {
+				t.Errorf("FormattedIDNumber(%q) = %d, %v, want %d", id, number, ok, tt.number)
+			}
+		})
+	}
+}
+
+func TestIDFormat_ParseNumberRejectsOtherIDs(t *testing.T) {
+	format, err := entities.ParseIDFormat("{code}/{abbr}/{number:3}")
+	if err != nil {
+		t.Fatalf("ParseIDFormat failed: %v", err)
+	}
+
+	for _, id := range []string{"DW/TR/001", "DW-task-1", "DW/T/", "DW/T/001/x", "T/001"} {
+		if number, ok := format.ParseNumber(id, "task"); ok {
+			t.Errorf("ParseNumber(%q, task) = %d, want no match", id, number)
+		}
+	}
+
+	// The project code is not checked, so IDs survive a code change
+	if number, ok := format.ParseNumber("OLD/T/009", "task"); !ok || number != 9 {
+		t.Errorf("ParseNumber(OLD/T/009) = %d, %v, want 9", number, ok)
+	}
+}
+
+func TestParseIDFormat_Invalid(t *testing.T) {
+	for _, template := range []string{
+		"{code}-{entity}",                   // no number
+		"{code}-{number}",                   // no entity type
+		"{code}-{entity}-{number}-{number}", // two numbers
+		"{code}-{entity}-{num}",             // unknown placeholder
+		"{code}-{entity}-{number:0}",        // zero width
+		"{code:3}-{entity}-{number}",        // width on code
+		"{code}{entity}{number}",            // no separators
+		"ID-{code}-{entity}-{number}",       // literal prefix
+		"{code} {entity} {number}",          // whitespace
+		"{code}-{entity}-{number",           // unbalanced brace
+	} {
+		if _, err := entities.ParseIDFormat(template); !errors.Is(err, pluginsdk.ErrInvalidArgument) {
+			t.Errorf("ParseIDFormat(%q) error = %v, want ErrInvalidArgument", template, err)
+		}
+	}
+}
+
+func TestFormattedIDEntityType(t *testing.T) {
+	tests := []struct {
+		id         string
+		entityType string
+		ok         bool
+	}{
+		{"DW-task-1", "task", true},
+		{"PROD/TR/012", "track", true},
+		{"TR-T-1", "task", true}, // Project code TR with task abbreviation
+		{"ac.00003", "ac", true},
+		{"dw-track-1", "", false},
+		{"DW-track-", "", false},
+		{"-track-1", "", false},
+		{"DW_track_1", "", false},
+		{"DW-X-1", "", false},
+		{"DW-task-track-1", "", false},
+		{"DW-task", "", false},
+	}
+
+	for _, tt := range tests {
+		entityType, ok := entities.FormattedIDEntityType(tt.id)
+		if entityType != tt.entityType || ok != tt.ok {
+			t.Errorf("FormattedIDEntityType(%q) = %q, %v, want %q, %v", tt.id, entityType, ok, tt.entityType, tt.ok)
+		}
+	}
+}
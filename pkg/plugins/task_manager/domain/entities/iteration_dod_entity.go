@@ -0,0 +1,72 @@
+package entities
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// IterationDoDItemEntity is one item of an iteration's definition of done: an
+// iteration-level exit criterion (e.g. "demo recorded", "changelog written") that is
+// checked off independently of the acceptance criteria of the iteration's tasks.
+type IterationDoDItemEntity struct {
+	ID           int        `json:"id"`
+	IterationNum int        `json:"iteration_number"`
+	Text         string     `json:"text"`
+	Done         bool       `json:"done"`
+	DoneAt       *time.Time `json:"done_at,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
+// NewIterationDoDItemEntity creates a new, unchecked definition of done item.
+// The ID is assigned by the repository when the item is saved.
+func NewIterationDoDItemEntity(iterationNum int, text string, createdAt time.Time) (*IterationDoDItemEntity, error) {
+	if iterationNum <= 0 {
+		return nil, fmt.Errorf("%w: iteration number must be positive", pluginsdk.ErrInvalidArgument)
+	}
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil, fmt.Errorf("%w: definition of done item text must be non-empty", pluginsdk.ErrInvalidArgument)
+	}
+
+	return &IterationDoDItemEntity{
+		IterationNum: iterationNum,
+		Text:         text,
+		CreatedAt:    createdAt,
+		UpdatedAt:    createdAt,
+	}, nil
+}
+
+// Check marks the item as done at the given time.
+// Checking an already done item keeps the original DoneAt timestamp.
+func (i *IterationDoDItemEntity) Check(at time.Time) {
+	if i.Done {
+		return
+	}
+	i.Done = true
+	i.DoneAt = &at
+	i.UpdatedAt = at
+}
+
+// Uncheck marks the item as not done and clears DoneAt.
+func (i *IterationDoDItemEntity) Uncheck(at time.Time) {
+	if !i.Done {
+		return
+	}
+	i.Done = false
+	i.DoneAt = nil
+	i.UpdatedAt = at
+}
+
+// CountDoneDoDItems returns the number of done items and the total count.
+func CountDoneDoDItems(items []*IterationDoDItemEntity) (done, total int) {
+	for _, item := range items {
+		if item.Done {
+			done++
+		}
+	}
+	return done, len(items)
+}
@@ -1,10 +1,13 @@
 package entities_test
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
 )
 
 func TestNewIterationEntity(t *testing.T) {
@@ -45,64 +48,64 @@ func TestNewIterationEntity(t *testing.T) {
 			wantErr:     false,
 		},
 		{
-			name:         "number zero",
-			number:       0,
-			iterName:     "Sprint 0",
-			goal:         "Setup",
-			deliverable:  "Initial setup",
-			taskIDs:      []string{},
-			status:       "planned",
-			rank:         500,
-			wantErr:      true,
-			errContains:  "iteration number must be positive",
+			name:        "number zero",
+			number:      0,
+			iterName:    "Sprint 0",
+			goal:        "Setup",
+			deliverable: "Initial setup",
+			taskIDs:     []string{},
+			status:      "planned",
+			rank:        500,
+			wantErr:     true,
+			errContains: "iteration number must be positive",
 		},
 		{
-			name:         "number negative",
-			number:       -1,
-			iterName:     "Sprint -1",
-			goal:         "Back to the past",
-			deliverable:  "Time machine",
-			taskIDs:      []string{},
-			status:       "planned",
-			rank:         500,
-			wantErr:      true,
-			errContains:  "iteration number must be positive",
+			name:        "number negative",
+			number:      -1,
+			iterName:    "Sprint -1",
+			goal:        "Back to the past",
+			deliverable: "Time machine",
+			taskIDs:     []string{},
+			status:      "planned",
+			rank:        500,
+			wantErr:     true,
+			errContains: "iteration number must be positive",
 		},
 		{
-			name:         "invalid status",
-			number:       1,
-			iterName:     "Sprint 1",
-			goal:         "Foundation",
-			deliverable:  "Core framework",
-			taskIDs:      []string{},
-			status:       "invalid-status",
-			rank:         500,
-			wantErr:      true,
-			errContains:  "invalid iteration status",
+			name:        "invalid status",
+			number:      1,
+			iterName:    "Sprint 1",
+			goal:        "Foundation",
+			deliverable: "Core framework",
+			taskIDs:     []string{},
+			status:      "invalid-status",
+			rank:        500,
+			wantErr:     true,
+			errContains: "invalid iteration status",
 		},
 		{
-			name:         "rank too low",
-			number:       1,
-			iterName:     "Sprint 1",
-			goal:         "Foundation",
-			deliverable:  "Core framework",
-			taskIDs:      []string{},
-			status:       "planned",
-			rank:         0,
-			wantErr:      true,
-			errContains:  "invalid iteration rank",
+			name:        "rank too low",
+			number:      1,
+			iterName:    "Sprint 1",
+			goal:        "Foundation",
+			deliverable: "Core framework",
+			taskIDs:     []string{},
+			status:      "planned",
+			rank:        0,
+			wantErr:     true,
+			errContains: "invalid iteration rank",
 		},
 		{
-			name:         "rank too high",
-			number:       1,
-			iterName:     "Sprint 1",
-			goal:         "Foundation",
-			deliverable:  "Core framework",
-			taskIDs:      []string{},
-			status:       "planned",
-			rank:         1001,
-			wantErr:      true,
-			errContains:  "invalid iteration rank",
+			name:        "rank too high",
+			number:      1,
+			iterName:    "Sprint 1",
+			goal:        "Foundation",
+			deliverable: "Core framework",
+			taskIDs:     []string{},
+			status:      "planned",
+			rank:        1001,
+			wantErr:     true,
+			errContains: "invalid iteration rank",
 		},
 	}
 
@@ -421,3 +424,60 @@ func TestIterationEntity_GetAllFields(t *testing.T) {
 	}
 }
 
+func TestIterationProgress(t *testing.T) {
+	now := time.Now()
+	var tasks []*entities.TaskEntity
+	for _, s := range [][2]string{{"task-1", "done"}, {"task-2", "in-progress"}, {"task-3", "todo"}} {
+		task, err := entities.NewTaskEntity(s[0], "track-1", "Task", "", s[1], 500, "", now, now)
+		if err != nil {
+			t.Fatalf("NewTaskEntity() failed: %v", err)
+		}
+		tasks = append(tasks, task)
+	}
+
+	var acs []*entities.AcceptanceCriteriaEntity
+	for i, s := range []struct {
+		taskID string
+		status entities.AcceptanceCriteriaStatus
+	}{
+		{"task-1", entities.ACStatusVerified},
+		{"task-1", entities.ACStatusAutomaticallyVerified},
+		{"task-2", entities.ACStatusSkipped},
+		{"task-2", entities.ACStatusFailed},
+		{"task-3", entities.ACStatusNotStarted},
+		{"task-9", entities.ACStatusVerified}, // not in the iteration
+	} {
+		ac := entities.NewAcceptanceCriteriaEntity(fmt.Sprintf("ac-%d", i), s.taskID, "AC", entities.VerificationTypeManual, "", now, now)
+		ac.Status = s.status
+		acs = append(acs, ac)
+	}
+
+	if completed, total := entities.IterationProgress(entities.ProgressBasisTasks, tasks, acs); completed != 1 || total != 3 {
+		t.Errorf("IterationProgress(tasks) = %d/%d, want 1/3", completed, total)
+	}
+	if completed, total := entities.IterationProgress(entities.ProgressBasisACs, tasks, acs); completed != 2 || total != 5 {
+		t.Errorf("IterationProgress(acs) = %d/%d, want 2/5", completed, total)
+	}
+}
+
+func TestParseProgressBasis(t *testing.T) {
+	tests := []struct {
+		input string
+		want  entities.ProgressBasis
+		label string
+	}{
+		{"", entities.ProgressBasisTasks, "tasks"},
+		{"tasks", entities.ProgressBasisTasks, "tasks"},
+		{"acs", entities.ProgressBasisACs, "ACs"},
+	}
+	for _, tt := range tests {
+		got, err := entities.ParseProgressBasis(tt.input)
+		if err != nil || got != tt.want || got.Label() != tt.label {
+			t.Errorf("ParseProgressBasis(%q) = %q (%s), %v, want %q (%s)", tt.input, got, got.Label(), err, tt.want, tt.label)
+		}
+	}
+
+	if _, err := entities.ParseProgressBasis("points"); !errors.Is(err, pluginsdk.ErrInvalidArgument) {
+		t.Errorf("ParseProgressBasis(points) error = %v, want ErrInvalidArgument", err)
+	}
+}
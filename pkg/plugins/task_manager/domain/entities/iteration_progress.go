@@ -0,0 +1,67 @@
+package entities
+
+import (
+	"fmt"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// ProgressBasis selects what iteration progress is measured in
+type ProgressBasis string
+
+const (
+	// ProgressBasisTasks measures progress as done tasks over all tasks (the default)
+	ProgressBasisTasks ProgressBasis = "tasks"
+	// ProgressBasisACs measures progress as verified acceptance criteria over all criteria
+	// of the iteration's tasks
+	ProgressBasisACs ProgressBasis = "acs"
+)
+
+// ParseProgressBasis returns the progress basis named s; "" is the tasks basis
+func ParseProgressBasis(s string) (ProgressBasis, error) {
+	switch ProgressBasis(s) {
+	case "", ProgressBasisTasks:
+		return ProgressBasisTasks, nil
+	case ProgressBasisACs:
+		return ProgressBasisACs, nil
+	default:
+		return "", fmt.Errorf("%w: invalid progress basis %q (use tasks or acs)", pluginsdk.ErrInvalidArgument, s)
+	}
+}
+
+// Label returns the unit progress is displayed in, e.g. "Progress (ACs): 7/10"
+func (b ProgressBasis) Label() string {
+	if b == ProgressBasisACs {
+		return "ACs"
+	}
+	return "tasks"
+}
+
+// IterationProgress returns how much of an iteration is complete on the given basis:
+// done tasks over all tasks, or verified and automatically verified ACs over all ACs of
+// the iteration's tasks. acs may contain criteria of other tasks; they are not counted.
+func IterationProgress(basis ProgressBasis, tasks []*TaskEntity, acs []*AcceptanceCriteriaEntity) (completed, total int) {
+	if basis != ProgressBasisACs {
+		for _, task := range tasks {
+			if task.Status == string(TaskStatusDone) {
+				completed++
+			}
+		}
+		return completed, len(tasks)
+	}
+
+	inIteration := make(map[string]bool, len(tasks))
+	for _, task := range tasks {
+		inIteration[task.ID] = true
+	}
+	for _, ac := range acs {
+		if !inIteration[ac.TaskID] {
+			continue
+		}
+		total++
+		if ac.IsVerified() {
+			completed++
+		}
+	}
+	return completed, total
+}
@@ -0,0 +1,58 @@
+package entities
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// IterationNumberPlaceholder is substituted with the iteration number in template patterns
+const IterationNumberPlaceholder = "{n}"
+
+// IterationTemplateEntity is a named cadence (e.g. "weekly-sprint") used to create
+// recurring iterations with a pre-filled goal and deliverable.
+type IterationTemplateEntity struct {
+	Name        string    `json:"name"`
+	GoalPattern string    `json:"goal_pattern"` // May contain {n}, replaced with the iteration number
+	Deliverable string    `json:"deliverable"`
+	UsageCount  int       `json:"usage_count"`         // Number of iterations created from this template
+	DoDItems    []string  `json:"dod_items,omitempty"` // Definition of done copied into new iterations; may contain {n}
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// NewIterationTemplateEntity creates a new iteration template with validation.
+func NewIterationTemplateEntity(name, goalPattern, deliverable string, createdAt, updatedAt time.Time) (*IterationTemplateEntity, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, fmt.Errorf("%w: template name must be non-empty", pluginsdk.ErrInvalidArgument)
+	}
+	if strings.ContainsAny(name, " \t\n") {
+		return nil, fmt.Errorf("%w: template name must not contain whitespace", pluginsdk.ErrInvalidArgument)
+	}
+	goalPattern = strings.TrimSpace(goalPattern)
+	if goalPattern == "" {
+		return nil, fmt.Errorf("%w: goal pattern must be non-empty", pluginsdk.ErrInvalidArgument)
+	}
+
+	return &IterationTemplateEntity{
+		Name:        name,
+		GoalPattern: goalPattern,
+		Deliverable: strings.TrimSpace(deliverable),
+		CreatedAt:   createdAt,
+		UpdatedAt:   updatedAt,
+	}, nil
+}
+
+// Goal returns the goal pattern with {n} replaced by the iteration number
+func (t *IterationTemplateEntity) Goal(number int) string {
+	return ExpandIterationPattern(t.GoalPattern, number)
+}
+
+// ExpandIterationPattern replaces every {n} in pattern with the iteration number
+func ExpandIterationPattern(pattern string, number int) string {
+	return strings.ReplaceAll(pattern, IterationNumberPlaceholder, strconv.Itoa(number))
+}
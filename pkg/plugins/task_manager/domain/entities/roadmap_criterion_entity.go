@@ -0,0 +1,92 @@
+package entities
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// RoadmapCriterionEntity represents a single, trackable success criterion of a roadmap.
+// Criteria break the free-text SuccessCriteria of a roadmap into discrete items
+// that can be individually checked off.
+type RoadmapCriterionEntity struct {
+	ID        int        `json:"id"`
+	RoadmapID string     `json:"roadmap_id"`
+	Text      string     `json:"text"`
+	Met       bool       `json:"met"`
+	MetAt     *time.Time `json:"met_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+// NewRoadmapCriterionEntity creates a new, unmet roadmap criterion.
+// The ID is assigned by the repository when the criterion is saved.
+func NewRoadmapCriterionEntity(roadmapID, text string, createdAt time.Time) (*RoadmapCriterionEntity, error) {
+	if roadmapID == "" {
+		return nil, fmt.Errorf("%w: roadmap ID must be non-empty", pluginsdk.ErrInvalidArgument)
+	}
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil, fmt.Errorf("%w: criterion text must be non-empty", pluginsdk.ErrInvalidArgument)
+	}
+
+	return &RoadmapCriterionEntity{
+		RoadmapID: roadmapID,
+		Text:      text,
+		CreatedAt: createdAt,
+		UpdatedAt: createdAt,
+	}, nil
+}
+
+// Check marks the criterion as met at the given time.
+// Checking an already met criterion keeps the original MetAt timestamp.
+func (c *RoadmapCriterionEntity) Check(at time.Time) {
+	if c.Met {
+		return
+	}
+	c.Met = true
+	c.MetAt = &at
+	c.UpdatedAt = at
+}
+
+// Uncheck marks the criterion as not met and clears MetAt.
+func (c *RoadmapCriterionEntity) Uncheck(at time.Time) {
+	if !c.Met {
+		return
+	}
+	c.Met = false
+	c.MetAt = nil
+	c.UpdatedAt = at
+}
+
+// SplitSuccessCriteria splits a free-text success criteria blob into individual
+// criterion texts, one per non-empty line. Common list markers ("-", "*", "•")
+// are stripped from the beginning of each line.
+func SplitSuccessCriteria(blob string) []string {
+	var result []string
+	for _, line := range strings.Split(blob, "\n") {
+		line = strings.TrimSpace(line)
+		for _, marker := range []string{"- [ ]", "- [x]", "-", "*", "•"} {
+			if strings.HasPrefix(line, marker) {
+				line = strings.TrimSpace(strings.TrimPrefix(line, marker))
+				break
+			}
+		}
+		if line != "" {
+			result = append(result, line)
+		}
+	}
+	return result
+}
+
+// CountMetCriteria returns the number of met criteria and the total count.
+func CountMetCriteria(criteria []*RoadmapCriterionEntity) (met, total int) {
+	for _, c := range criteria {
+		if c.Met {
+			met++
+		}
+	}
+	return met, len(criteria)
+}
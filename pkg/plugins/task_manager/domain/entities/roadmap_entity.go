@@ -7,14 +7,18 @@ import (
 	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
 )
 
+// ActiveRoadmapMetadataKey is the project metadata key holding the ID of the active roadmap
+const ActiveRoadmapMetadataKey = "active_roadmap_id"
+
 // RoadmapEntity represents a roadmap and implements SDK capability interfaces.
 // It implements the IExtensible interface.
 type RoadmapEntity struct {
-	ID              string    `json:"id"`
-	Vision          string    `json:"vision"`
-	SuccessCriteria string    `json:"success_criteria"`
-	CreatedAt       time.Time `json:"created_at"`
-	UpdatedAt       time.Time `json:"updated_at"`
+	ID              string     `json:"id"`
+	Vision          string     `json:"vision"`
+	SuccessCriteria string     `json:"success_criteria"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+	ArchivedAt      *time.Time `json:"archived_at,omitempty"` // nil while the roadmap is not archived
 }
 
 // NewRoadmapEntity creates a new roadmap entity
@@ -35,6 +39,30 @@ func NewRoadmapEntity(id, vision, successCriteria string, createdAt, updatedAt t
 	}, nil
 }
 
+// IsArchived reports whether the roadmap has been archived
+func (r *RoadmapEntity) IsArchived() bool {
+	return r.ArchivedAt != nil
+}
+
+// Archive marks the roadmap as archived, so it is no longer picked as the active roadmap
+func (r *RoadmapEntity) Archive(now time.Time) error {
+	if r.IsArchived() {
+		return fmt.Errorf("%w: roadmap %s is already archived", pluginsdk.ErrInvalidArgument, r.ID)
+	}
+	r.ArchivedAt = &now
+	r.UpdatedAt = now
+	return nil
+}
+
+// Unarchive restores an archived roadmap; it does nothing if the roadmap is not archived
+func (r *RoadmapEntity) Unarchive(now time.Time) {
+	if !r.IsArchived() {
+		return
+	}
+	r.ArchivedAt = nil
+	r.UpdatedAt = now
+}
+
 // IExtensible implementation
 
 // GetID returns the unique identifier for this entity
@@ -66,5 +94,6 @@ func (r *RoadmapEntity) GetAllFields() map[string]interface{} {
 		"success_criteria": r.SuccessCriteria,
 		"created_at":       r.CreatedAt,
 		"updated_at":       r.UpdatedAt,
+		"archived_at":      r.ArchivedAt,
 	}
 }
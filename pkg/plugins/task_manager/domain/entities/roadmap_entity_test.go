@@ -1,6 +1,7 @@
 package entities_test
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -296,6 +297,34 @@ func TestRoadmapEntity_GetAllFields(t *testing.T) {
 	}
 }
 
+func TestRoadmapEntity_ArchiveAndUnarchive(t *testing.T) {
+	created := time.Date(2025, 10, 1, 9, 0, 0, 0, time.UTC)
+	roadmap, err := entities.NewRoadmapEntity("r1", "test vision", "test criteria", created, created)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if roadmap.IsArchived() {
+		t.Fatal("new roadmap should not be archived")
+	}
+
+	archived := created.Add(time.Hour)
+	if err := roadmap.Archive(archived); err != nil {
+		t.Fatalf("Archive() error = %v", err)
+	}
+	if !roadmap.IsArchived() || !roadmap.ArchivedAt.Equal(archived) || !roadmap.UpdatedAt.Equal(archived) {
+		t.Errorf("after Archive() ArchivedAt = %v, UpdatedAt = %v, want both %v", roadmap.ArchivedAt, roadmap.UpdatedAt, archived)
+	}
+	if err := roadmap.Archive(archived); !errors.Is(err, pluginsdk.ErrInvalidArgument) {
+		t.Errorf("Archive() of an archived roadmap error = %v, want ErrInvalidArgument", err)
+	}
+
+	restored := archived.Add(time.Hour)
+	roadmap.Unarchive(restored)
+	if roadmap.IsArchived() || !roadmap.UpdatedAt.Equal(restored) {
+		t.Errorf("after Unarchive() ArchivedAt = %v, UpdatedAt = %v, want nil and %v", roadmap.ArchivedAt, roadmap.UpdatedAt, restored)
+	}
+}
+
 func TestRoadmapEntity_GetAllFields_Independence(t *testing.T) {
 	now := time.Now()
 	roadmap, err := entities.NewRoadmapEntity("r1", "vision", "criteria", now, now)
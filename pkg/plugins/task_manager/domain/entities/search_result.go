@@ -0,0 +1,167 @@
+package entities
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// Entity types covered by search
+const (
+	SearchEntityTask  = "task"
+	SearchEntityTrack = "track"
+	SearchEntityADR   = "adr"
+	SearchEntityAC    = "ac"
+)
+
+// SearchEntityTypes lists the searchable entity types in the order results are grouped
+var SearchEntityTypes = []string{SearchEntityTask, SearchEntityTrack, SearchEntityADR, SearchEntityAC}
+
+// Field relevance of a search match: a match in an entity's title (or, for acceptance
+// criteria, their description, which serves as their title) outranks a match in its body.
+const (
+	SearchRelevanceBody  = 1
+	SearchRelevanceTitle = 2
+)
+
+// searchSnippetWidth is the number of characters of context shown around a match
+const searchSnippetWidth = 80
+
+// SearchResult is one entity matching a search term
+type SearchResult struct {
+	EntityType string `json:"entity_type"`
+	EntityID   string `json:"id"`
+	Title      string `json:"title"`
+	Field      string `json:"field"` // Most relevant field containing the term
+	Snippet    string `json:"snippet"`
+	Relevance  int    `json:"relevance"`
+}
+
+// SearchField is a named text field of an entity, with the relevance of a match in it
+type SearchField struct {
+	Name      string
+	Text      string
+	Relevance int
+}
+
+// NewSearchResult matches term case-insensitively against fields and returns a result
+// for the most relevant matching field (the first one on ties), or nil if no field
+// contains the term.
+func NewSearchResult(entityType, entityID, title, term string, fields []SearchField) *SearchResult {
+	var best *SearchField
+	for i := range fields {
+		field := &fields[i]
+		if !containsFold(field.Text, term) {
+			continue
+		}
+		if best == nil || field.Relevance > best.Relevance {
+			best = field
+		}
+	}
+	if best == nil {
+		return nil
+	}
+
+	return &SearchResult{
+		EntityType: entityType,
+		EntityID:   entityID,
+		Title:      title,
+		Field:      best.Name,
+		Snippet:    SearchSnippet(best.Text, term, searchSnippetWidth),
+		Relevance:  best.Relevance,
+	}
+}
+
+// ParseSearchEntityTypes parses a comma-separated list of entity types (e.g. "task,adr").
+// Duplicates are dropped; an empty list selects all SearchEntityTypes.
+func ParseSearchEntityTypes(value string) ([]string, error) {
+	var types []string
+	for _, part := range strings.Split(value, ",") {
+		entityType := strings.ToLower(strings.TrimSpace(part))
+		if entityType == "" {
+			continue
+		}
+		if !isSearchEntityType(entityType) {
+			return nil, fmt.Errorf("%w: unknown entity type '%s' (must be one of: %s)",
+				pluginsdk.ErrInvalidArgument, entityType, strings.Join(SearchEntityTypes, ", "))
+		}
+		if !containsString(types, entityType) {
+			types = append(types, entityType)
+		}
+	}
+	if len(types) == 0 {
+		return SearchEntityTypes, nil
+	}
+	return types, nil
+}
+
+// SearchSnippet returns the part of text around the first case-insensitive occurrence of
+// term, at most width characters long, on a single line. Ellipses mark cut-off text.
+func SearchSnippet(text, term string, width int) string {
+	runes := []rune(strings.Join(strings.Fields(text), " "))
+	if len(runes) <= width {
+		return string(runes)
+	}
+
+	start := 0
+	if index := indexFold(runes, []rune(term)); index >= 0 {
+		// Center the match in the window
+		start = index - (width-len([]rune(term)))/2
+		if start < 0 {
+			start = 0
+		}
+		if start+width > len(runes) {
+			start = len(runes) - width
+		}
+	}
+	end := start + width
+
+	snippet := string(runes[start:end])
+	if start > 0 {
+		snippet = "..." + snippet
+	}
+	if end < len(runes) {
+		snippet += "..."
+	}
+	return snippet
+}
+
+// containsFold reports whether term occurs in text, ignoring case
+func containsFold(text, term string) bool {
+	return indexFold([]rune(text), []rune(term)) >= 0
+}
+
+// indexFold returns the rune index of the first case-insensitive occurrence of term in text, or -1
+func indexFold(text, term []rune) int {
+	if len(term) == 0 {
+		return -1
+	}
+	for i := 0; i+len(term) <= len(text); i++ {
+		match := true
+		for j, r := range term {
+			if unicode.ToLower(text[i+j]) != unicode.ToLower(r) {
+				match = false
+				break
+			}
+		}
+		if match {
+			return i
+		}
+	}
+	return -1
+}
+
+func isSearchEntityType(entityType string) bool {
+	return containsString(SearchEntityTypes, entityType)
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
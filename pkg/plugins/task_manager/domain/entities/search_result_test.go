@@ -0,0 +1,95 @@
+package entities_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+func TestNewSearchResult(t *testing.T) {
+	fields := []entities.SearchField{
+		{Name: "title", Text: "Login page", Relevance: entities.SearchRelevanceTitle},
+		{Name: "context", Text: "Users sign in with OAuth", Relevance: entities.SearchRelevanceBody},
+		{Name: "decision", Text: "Use an OAuth library", Relevance: entities.SearchRelevanceBody},
+	}
+
+	result := entities.NewSearchResult(entities.SearchEntityADR, "DW-adr-1", "Login page", "oauth", fields)
+	if result == nil {
+		t.Fatal("expected a result")
+	}
+	if result.Field != "context" {
+		t.Errorf("expected the first matching field on a relevance tie, got %q", result.Field)
+	}
+	if result.Relevance != entities.SearchRelevanceBody {
+		t.Errorf("expected body relevance, got %d", result.Relevance)
+	}
+
+	result = entities.NewSearchResult(entities.SearchEntityADR, "DW-adr-1", "Login page", "LOGIN", fields)
+	if result == nil || result.Field != "title" || result.Relevance != entities.SearchRelevanceTitle {
+		t.Errorf("expected a title match, got %+v", result)
+	}
+
+	if result := entities.NewSearchResult(entities.SearchEntityADR, "DW-adr-1", "Login page", "kubernetes", fields); result != nil {
+		t.Errorf("expected no result, got %+v", result)
+	}
+}
+
+func TestParseSearchEntityTypes(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    []string
+		wantErr bool
+	}{
+		{"empty selects all", "", entities.SearchEntityTypes, false},
+		{"single", "adr", []string{"adr"}, false},
+		{"list with spaces and case", "Task, adr", []string{"task", "adr"}, false},
+		{"duplicates dropped", "ac,ac", []string{"ac"}, false},
+		{"unknown type", "task,epic", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := entities.ParseSearchEntityTypes(tt.value)
+			if tt.wantErr {
+				if !errors.Is(err, pluginsdk.ErrInvalidArgument) {
+					t.Errorf("expected ErrInvalidArgument, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestSearchSnippet(t *testing.T) {
+	tests := []struct {
+		name  string
+		text  string
+		term  string
+		width int
+		want  string
+	}{
+		{"short text kept whole", "Store tokens\nin the keychain", "tokens", 80, "Store tokens in the keychain"},
+		{"match at start", "OAuth tokens are stored encrypted", "oauth", 12, "OAuth tokens..."},
+		{"match in middle", "We decided that OAuth tokens are stored encrypted", "oauth", 15, "...that OAuth toke..."},
+		{"match at end", "Tokens are refreshed using OAuth", "oauth", 10, "...sing OAuth"},
+		{"no match", "Tokens are refreshed", "oauth", 6, "Tokens..."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := entities.SearchSnippet(tt.text, tt.term, tt.width); got != tt.want {
+				t.Errorf("SearchSnippet() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
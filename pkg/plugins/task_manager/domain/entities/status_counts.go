@@ -0,0 +1,9 @@
+package entities
+
+// StatusCounts tallies the tracks, tasks and acceptance criteria of a project by
+// status. A status no entity has is left out of its map.
+type StatusCounts struct {
+	Tracks map[string]int
+	Tasks  map[string]int
+	ACs    map[string]int
+}
@@ -0,0 +1,66 @@
+package entities
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// Allowed statuses in display order, used in validation errors and reports
+var (
+	TrackStatuses     = []string{"not-started", "in-progress", "complete", "blocked", "waiting"}
+	TaskStatuses      = []string{"todo", "in-progress", "review", "done", "cancelled"}
+	IterationStatuses = []string{"planned", "current", "complete"}
+	ADRStatuses       = []string{"proposed", "accepted", "deprecated", "superseded"}
+	ACStatuses        = []string{"not_started", "automatically_verified", "pending_human_review", "verified", "failed", "skipped"}
+)
+
+// ValidateTrackStatus returns an ErrInvalidArgument error when status is not a track status
+func ValidateTrackStatus(status string) error {
+	return validateStatus("track", status, IsValidTrackStatus, TrackStatuses)
+}
+
+// ValidateTaskStatus returns an ErrInvalidArgument error when status is not a task status
+func ValidateTaskStatus(status string) error {
+	return validateStatus("task", status, IsValidTaskStatus, TaskStatuses)
+}
+
+// ValidateIterationStatus returns an ErrInvalidArgument error when status is not an iteration status
+func ValidateIterationStatus(status string) error {
+	return validateStatus("iteration", status, IsValidIterationStatus, IterationStatuses)
+}
+
+// ValidateADRStatus returns an ErrInvalidArgument error when status is not an ADR status
+func ValidateADRStatus(status string) error {
+	return validateStatus("ADR", status, IsValidADRStatus, ADRStatuses)
+}
+
+// ValidateACStatus returns an ErrInvalidArgument error when status is not an AC status
+func ValidateACStatus(status string) error {
+	return validateStatus("AC", status, IsValidACStatus, ACStatuses)
+}
+
+func validateStatus(kind, status string, isValid func(string) bool, allowed []string) error {
+	if isValid(status) {
+		return nil
+	}
+	return fmt.Errorf("%w: invalid %s status %q (allowed: %s)", pluginsdk.ErrInvalidArgument, kind, status, strings.Join(allowed, ", "))
+}
+
+// NormalizeStatus maps a mistyped status such as "In_Progress" onto the allowed
+// status it differs from only in case, surrounding spaces or the use of '-'
+// versus '_'. ok is false when no allowed status matches.
+func NormalizeStatus(status string, allowed []string) (normalized string, ok bool) {
+	key := statusKey(status)
+	for _, candidate := range allowed {
+		if statusKey(candidate) == key {
+			return candidate, true
+		}
+	}
+	return "", false
+}
+
+func statusKey(status string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(status)), "_", "-")
+}
@@ -0,0 +1,90 @@
+package entities
+
+import "time"
+
+// SyncChangesetFormatVersion identifies the changeset JSON layout
+const SyncChangesetFormatVersion = 1
+
+// SyncChangeset is an incremental export of a project database: every roadmap, track,
+// task, iteration, iteration definition of done item, acceptance criterion and ADR
+// whose updated_at is after Since, and every ADR-task link and task AC gate created
+// after Since. Definition of done items are matched by iteration and creation time,
+// because their IDs are local to each database. ACTags holds the tags of the exported
+// acceptance criteria; changing a tag updates its criterion, so tags travel with it.
+// Deletions are not included because the task manager has no soft-delete. Roadmap
+// criteria, task notes and AC and iteration templates are not synced either; NotSynced
+// counts their rows changed after Since so export can warn about them. Project
+// metadata (schema version, active roadmap, ID format) stays local to each database.
+type SyncChangeset struct {
+	FormatVersion      int                         `json:"format_version"`
+	Since              time.Time                   `json:"since"`
+	ExportedAt         time.Time                   `json:"exported_at"`
+	Roadmaps           []*RoadmapEntity            `json:"roadmaps"`
+	Tracks             []*TrackEntity              `json:"tracks"`
+	Tasks              []*TaskEntity               `json:"tasks"`
+	Iterations         []*IterationEntity          `json:"iterations"`
+	IterationDoD       []*IterationDoDItemEntity   `json:"iteration_dod"`
+	AcceptanceCriteria []*AcceptanceCriteriaEntity `json:"acceptance_criteria"`
+	ACTags             map[string][]string         `json:"ac_tags"` // AC ID -> tags
+	ADRs               []*ADREntity                `json:"adrs"`
+	ADRTaskLinks       []*SyncADRTaskLink          `json:"adr_task_links"`
+	TaskGates          []*SyncTaskGate             `json:"task_gates"`
+	NotSynced          map[string]int              `json:"-"` // table -> rows changed after Since that are left out
+}
+
+// SyncADRTaskLink links an ADR to a task implementing it. Links are never changed
+// once created, so they are exported by creation time.
+type SyncADRTaskLink struct {
+	ADRID     string    `json:"adr_id"`
+	TaskID    string    `json:"task_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// SyncTaskGate blocks a task until an acceptance criterion is verified. Like ADR-task
+// links, gates are never changed once created.
+type SyncTaskGate struct {
+	TaskID    string    `json:"task_id"`
+	ACID      string    `json:"ac_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Count returns the total number of entities in the changeset
+func (c *SyncChangeset) Count() int {
+	return len(c.Roadmaps) + len(c.Tracks) + len(c.Tasks) + len(c.Iterations) + len(c.IterationDoD) + len(c.AcceptanceCriteria) + len(c.ADRs) + len(c.ADRTaskLinks) + len(c.TaskGates)
+}
+
+// SyncCounts reports what an import did with one entity type.
+// Unchanged entities have the same updated_at locally; Conflicts were changed
+// more recently locally and were left untouched.
+type SyncCounts struct {
+	Created   int `json:"created"`
+	Updated   int `json:"updated"`
+	Unchanged int `json:"unchanged"`
+	Conflicts int `json:"conflicts"`
+}
+
+// SyncImportResult reports the outcome of importing a changeset, per entity type
+type SyncImportResult struct {
+	Roadmaps           SyncCounts `json:"roadmaps"`
+	Tracks             SyncCounts `json:"tracks"`
+	Tasks              SyncCounts `json:"tasks"`
+	Iterations         SyncCounts `json:"iterations"`
+	IterationDoD       SyncCounts `json:"iteration_dod"`
+	AcceptanceCriteria SyncCounts `json:"acceptance_criteria"`
+	ADRs               SyncCounts `json:"adrs"`
+	ADRTaskLinks       SyncCounts `json:"adr_task_links"`
+	TaskGates          SyncCounts `json:"task_gates"`
+	ConflictIDs        []string   `json:"conflict_ids"` // IDs (or iteration numbers) skipped because the local copy is newer
+}
+
+// Total sums the counts across all entity types
+func (r *SyncImportResult) Total() SyncCounts {
+	var total SyncCounts
+	for _, c := range []SyncCounts{r.Roadmaps, r.Tracks, r.Tasks, r.Iterations, r.IterationDoD, r.AcceptanceCriteria, r.ADRs, r.ADRTaskLinks, r.TaskGates} {
+		total.Created += c.Created
+		total.Updated += c.Updated
+		total.Unchanged += c.Unchanged
+		total.Conflicts += c.Conflicts
+	}
+	return total
+}
@@ -3,6 +3,7 @@ package entities
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
@@ -15,9 +16,10 @@ type TaskEntity struct {
 	TrackID     string    `json:"track_id"` // Parent track ID
 	Title       string    `json:"title"`
 	Description string    `json:"description"`
-	Status      string    `json:"status"` // todo, in-progress, done
-	Rank        int       `json:"rank"`   // 1-1000 (lower = higher priority)
-	Branch      string    `json:"branch"` // Git branch name (optional)
+	Status      string    `json:"status"`   // todo, in-progress, done
+	Rank        int       `json:"rank"`     // 1-1000 (lower = higher priority)
+	Branch      string    `json:"branch"`   // Git branch name (optional)
+	Assignee    string    `json:"assignee"` // Free-form owner name (optional, empty = unassigned)
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
 }
@@ -52,6 +54,17 @@ func NewTaskEntity(id, trackID, title, description, status string, rank int, bra
 	}, nil
 }
 
+// Assign sets the task's assignee; an empty name unassigns the task
+func (t *TaskEntity) Assign(assignee string) {
+	t.Assignee = strings.TrimSpace(assignee)
+	t.UpdatedAt = time.Now()
+}
+
+// IsAssigned returns true if the task has an assignee
+func (t *TaskEntity) IsAssigned() bool {
+	return t.Assignee != ""
+}
+
 // TransitionTo validates and applies a state transition
 // Enforces state machine rules: done can be reopened to todo
 func (t *TaskEntity) TransitionTo(newStatus string) error {
@@ -99,6 +112,7 @@ func (t *TaskEntity) GetAllFields() map[string]interface{} {
 		"status":      t.Status,
 		"rank":        t.Rank,
 		"branch":      t.Branch,
+		"assignee":    t.Assignee,
 		"created_at":  t.CreatedAt,
 		"updated_at":  t.UpdatedAt,
 		"progress":    t.GetProgress(),
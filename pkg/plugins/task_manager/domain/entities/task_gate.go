@@ -0,0 +1,35 @@
+package entities
+
+import "strings"
+
+// A task gated on acceptance criteria is blocked until every gating AC is verified.
+// Gates are finer-grained than track dependencies: a task can wait on one AC of
+// another task (e.g. "API contract reviewed") instead of on a whole track.
+
+// PendingGates returns the gating ACs that are not verified yet, in order.
+// The gated task is blocked while any remain.
+func PendingGates(gates []*AcceptanceCriteriaEntity) []*AcceptanceCriteriaEntity {
+	pending := []*AcceptanceCriteriaEntity{}
+	for _, ac := range gates {
+		if !ac.IsVerified() {
+			pending = append(pending, ac)
+		}
+	}
+	return pending
+}
+
+// WaitingOnLabel describes what a blocked task waits for, e.g. "waiting on AC DW-ac-4"
+// or "waiting on ACs DW-ac-4, DW-ac-7". Returns "" when nothing is pending.
+func WaitingOnLabel(pending []*AcceptanceCriteriaEntity) string {
+	if len(pending) == 0 {
+		return ""
+	}
+	ids := make([]string, len(pending))
+	for i, ac := range pending {
+		ids[i] = ac.ID
+	}
+	if len(ids) == 1 {
+		return "waiting on AC " + ids[0]
+	}
+	return "waiting on ACs " + strings.Join(ids, ", ")
+}
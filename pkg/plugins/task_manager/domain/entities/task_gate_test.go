@@ -0,0 +1,55 @@
+package entities_test
+
+import (
+	"testing"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
+)
+
+func TestPendingGates(t *testing.T) {
+	gates := []*entities.AcceptanceCriteriaEntity{
+		{ID: "DW-ac-1", Status: entities.ACStatusVerified},
+		{ID: "DW-ac-2", Status: entities.ACStatusNotStarted},
+		{ID: "DW-ac-3", Status: entities.ACStatusAutomaticallyVerified},
+		{ID: "DW-ac-4", Status: entities.ACStatusFailed},
+		{ID: "DW-ac-5", Status: entities.ACStatusSkipped},
+	}
+
+	pending := entities.PendingGates(gates)
+	var ids []string
+	for _, ac := range pending {
+		ids = append(ids, ac.ID)
+	}
+	want := []string{"DW-ac-2", "DW-ac-4", "DW-ac-5"}
+	if len(ids) != len(want) {
+		t.Fatalf("PendingGates() = %v, want %v", ids, want)
+	}
+	for i := range want {
+		if ids[i] != want[i] {
+			t.Errorf("PendingGates()[%d] = %s, want %s", i, ids[i], want[i])
+		}
+	}
+
+	if got := entities.PendingGates(nil); got == nil || len(got) != 0 {
+		t.Errorf("PendingGates(nil) = %v, want empty slice", got)
+	}
+}
+
+func TestWaitingOnLabel(t *testing.T) {
+	tests := []struct {
+		name    string
+		pending []*entities.AcceptanceCriteriaEntity
+		want    string
+	}{
+		{"none", nil, ""},
+		{"one", []*entities.AcceptanceCriteriaEntity{{ID: "DW-ac-4"}}, "waiting on AC DW-ac-4"},
+		{"several", []*entities.AcceptanceCriteriaEntity{{ID: "DW-ac-4"}, {ID: "DW-ac-7"}}, "waiting on ACs DW-ac-4, DW-ac-7"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := entities.WaitingOnLabel(tt.pending); got != tt.want {
+				t.Errorf("WaitingOnLabel() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
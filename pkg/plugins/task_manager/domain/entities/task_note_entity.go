@@ -0,0 +1,36 @@
+package entities
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// TaskNoteEntity is a free-text note attached to a task.
+// Notes form an append-only audit trail, e.g. the reason a done task was reopened.
+type TaskNoteEntity struct {
+	ID        int       `json:"id"`
+	TaskID    string    `json:"task_id"`
+	Content   string    `json:"content"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NewTaskNoteEntity creates a new task note.
+// The ID is assigned by the repository when the note is saved.
+func NewTaskNoteEntity(taskID, content string, createdAt time.Time) (*TaskNoteEntity, error) {
+	if taskID == "" {
+		return nil, fmt.Errorf("%w: task ID must be non-empty", pluginsdk.ErrInvalidArgument)
+	}
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return nil, fmt.Errorf("%w: note content must be non-empty", pluginsdk.ErrInvalidArgument)
+	}
+
+	return &TaskNoteEntity{
+		TaskID:    taskID,
+		Content:   content,
+		CreatedAt: createdAt,
+	}, nil
+}
@@ -64,7 +64,8 @@ func NewTrackEntity(id, roadmapID, title, description, status string, rank int,
 }
 
 // isValidTrackID validates track ID format
-// Accepts both old format (track-<slug>) and new format (<CODE>-track-<number>)
+// Accepts both old format (track-<slug>) and new format (<CODE>-track-<number>),
+// and track IDs of a project's configured ID format (e.g. DW/TR/001)
 func isValidTrackID(id string) bool {
 	// New format: <CODE>-track-<number> (e.g., DW-track-1, PROD-track-123)
 	newPattern := `^[A-Z0-9]+-track-[0-9]+$`
@@ -76,7 +77,12 @@ func isValidTrackID(id string) bool {
 	// Old format: track-<slug> (for backward compatibility)
 	oldPattern := `^track-[a-z0-9]+(-[a-z0-9]+)*$`
 	oldRegex := regexp.MustCompile(oldPattern)
-	return oldRegex.MatchString(id)
+	if oldRegex.MatchString(id) {
+		return true
+	}
+
+	entityType, ok := FormattedIDEntityType(id)
+	return ok && entityType == "track"
 }
 
 // TransitionTo validates and applies a state transition
@@ -2,6 +2,7 @@ package entities
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
 )
@@ -118,6 +119,21 @@ const (
 	ACStatusSkipped AcceptanceCriteriaStatus = "skipped"
 )
 
+// validACStatuses defines valid AC status values
+var validACStatuses = map[string]bool{
+	string(ACStatusNotStarted):            true,
+	string(ACStatusAutomaticallyVerified): true,
+	string(ACStatusPendingHumanReview):    true,
+	string(ACStatusVerified):              true,
+	string(ACStatusFailed):                true,
+	string(ACStatusSkipped):               true,
+}
+
+// IsValidACStatus checks if an AC status is valid
+func IsValidACStatus(status string) bool {
+	return validACStatuses[status]
+}
+
 // AcceptanceCriteriaVerificationType indicates who should verify this AC
 type AcceptanceCriteriaVerificationType string
 
@@ -128,6 +144,17 @@ const (
 	VerificationTypeAutomated AcceptanceCriteriaVerificationType = "automated"
 )
 
+// validVerificationTypes defines valid AC verification type values
+var validVerificationTypes = map[string]bool{
+	string(VerificationTypeManual):    true,
+	string(VerificationTypeAutomated): true,
+}
+
+// IsValidVerificationType checks if a verification type is valid
+func IsValidVerificationType(verificationType string) bool {
+	return validVerificationTypes[verificationType]
+}
+
 // Filter types for queries
 
 // TrackFilters represents filter criteria for track queries
@@ -138,9 +165,14 @@ type TrackFilters struct {
 
 // TaskFilters represents filter criteria for task queries
 type TaskFilters struct {
-	TrackID  string   // Filter by parent track ID
-	Status   []string // Filter by status values (e.g., "todo", "in-progress", "review", "done")
-	Priority []string // Legacy - not used
+	TrackID       string     // Filter by parent track ID
+	RoadmapID     string     // Filter by the roadmap of the parent track
+	Status        []string   // Filter by status values (e.g., "todo", "in-progress", "review", "done")
+	Priority      []string   // Legacy - not used
+	Assignee      string     // Filter by assignee (exact match)
+	Unassigned    bool       // Only return tasks without an assignee
+	UpdatedBefore *time.Time // Only return tasks last updated before this time
+	ACTag         string     // Only return tasks with an acceptance criterion carrying this tag
 }
 
 // ACFilters represents filter criteria for acceptance criteria queries
@@ -1,9 +1,12 @@
 package entities_test
 
 import (
+	"errors"
+	"strings"
 	"testing"
 
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
 )
 
 func TestIsValidTrackStatus(t *testing.T) {
@@ -104,3 +107,55 @@ func TestIsValidADRStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateStatuses(t *testing.T) {
+	tests := []struct {
+		name     string
+		validate func(string) error
+		valid    string
+		invalid  string
+	}{
+		{"track", entities.ValidateTrackStatus, "not-started", "not_started"},
+		{"task", entities.ValidateTaskStatus, "in-progress", "in_progress"},
+		{"iteration", entities.ValidateIterationStatus, "current", "active"},
+		{"adr", entities.ValidateADRStatus, "accepted", "approved"},
+		{"ac", entities.ValidateACStatus, "not_started", "not-started"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.validate(tt.valid); err != nil {
+				t.Errorf("expected %q to be valid, got %v", tt.valid, err)
+			}
+			err := tt.validate(tt.invalid)
+			if !errors.Is(err, pluginsdk.ErrInvalidArgument) {
+				t.Fatalf("expected ErrInvalidArgument for %q, got %v", tt.invalid, err)
+			}
+			if !strings.Contains(err.Error(), tt.valid) {
+				t.Errorf("expected the error to list the allowed statuses, got %v", err)
+			}
+		})
+	}
+}
+
+func TestNormalizeStatus(t *testing.T) {
+	tests := []struct {
+		status  string
+		allowed []string
+		want    string
+		wantOK  bool
+	}{
+		{"in_progress", entities.TaskStatuses, "in-progress", true},
+		{" In-Progress ", entities.TaskStatuses, "in-progress", true},
+		{"DONE", entities.TaskStatuses, "done", true},
+		{"pending-human-review", entities.ACStatuses, "pending_human_review", true},
+		{"finished", entities.TaskStatuses, "", false},
+	}
+
+	for _, tt := range tests {
+		got, ok := entities.NormalizeStatus(tt.status, tt.allowed)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("NormalizeStatus(%q) = %q, %v; want %q, %v", tt.status, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
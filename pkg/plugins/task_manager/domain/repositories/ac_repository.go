@@ -13,6 +13,10 @@ type AcceptanceCriteriaRepository interface {
 	// Returns ErrNotFound if the task doesn't exist.
 	SaveAC(ctx context.Context, ac *entities.AcceptanceCriteriaEntity) error
 
+	// SaveACs persists several new acceptance criteria in a single transaction.
+	// Nothing is saved if any AC fails (ErrAlreadyExists, or ErrNotFound for a missing task).
+	SaveACs(ctx context.Context, acs []*entities.AcceptanceCriteriaEntity) error
+
 	// GetAC retrieves an acceptance criterion by its ID.
 	// Returns ErrNotFound if the AC doesn't exist.
 	GetAC(ctx context.Context, id string) (*entities.AcceptanceCriteriaEntity, error)
@@ -25,7 +29,7 @@ type AcceptanceCriteriaRepository interface {
 	// Returns ErrNotFound if the AC doesn't exist.
 	UpdateAC(ctx context.Context, ac *entities.AcceptanceCriteriaEntity) error
 
-	// DeleteAC removes an acceptance criterion from storage.
+	// DeleteAC removes an acceptance criterion and its tags from storage.
 	// Returns ErrNotFound if the AC doesn't exist.
 	DeleteAC(ctx context.Context, id string) error
 
@@ -40,4 +44,50 @@ type AcceptanceCriteriaRepository interface {
 	// Supports optional filtering by iteration, track, or task.
 	// Returns empty slice if no failed ACs match the filters.
 	ListFailedAC(ctx context.Context, filters entities.ACFilters) ([]*entities.AcceptanceCriteriaEntity, error)
+
+	// ListACs returns the acceptance criteria matching the filters in a single query.
+	// Empty filters return every AC of the roadmap.
+	ListACs(ctx context.Context, filters entities.ACFilters) ([]*entities.AcceptanceCriteriaEntity, error)
+
+	// CountACProgressByTask counts the acceptance criteria of each of the given tasks in a
+	// single grouped query. Tasks without ACs are left out of the map.
+	CountACProgressByTask(ctx context.Context, taskIDs []string) (map[string]entities.ACProgress, error)
+
+	// ListTaskACCoverage returns every task matching the filters with the progress of its
+	// acceptance criteria, including tasks without ACs, in a single query. Only the tasks
+	// of roadmapID's tracks are listed unless it is empty. Tasks are ordered by track,
+	// rank and creation time.
+	ListTaskACCoverage(ctx context.Context, filters entities.ACFilters, roadmapID string) ([]*entities.TaskACCoverage, error)
+
+	// AddACTag tags an acceptance criterion. Adding a tag the AC already has is a no-op.
+	// Returns ErrNotFound if the AC doesn't exist.
+	AddACTag(ctx context.Context, acID, tag string) error
+
+	// RemoveACTag removes a tag from an acceptance criterion.
+	// Returns ErrNotFound if the AC doesn't have the tag.
+	RemoveACTag(ctx context.Context, acID, tag string) error
+
+	// ListACTags returns the tags of an acceptance criterion in alphabetical order.
+	// Returns empty slice if the AC has no tags.
+	ListACTags(ctx context.Context, acID string) ([]string, error)
+
+	// ListTaggedAC returns the acceptance criteria of a task that have the given tag.
+	// Returns empty slice if none match.
+	ListTaggedAC(ctx context.Context, taskID, tag string) ([]*entities.AcceptanceCriteriaEntity, error)
+
+	// SaveACTemplate persists a new AC template.
+	// Returns ErrAlreadyExists if a template with the same name already exists.
+	SaveACTemplate(ctx context.Context, template *entities.ACTemplateEntity) error
+
+	// GetACTemplate retrieves an AC template by its name.
+	// Returns ErrNotFound if the template doesn't exist.
+	GetACTemplate(ctx context.Context, name string) (*entities.ACTemplateEntity, error)
+
+	// ListACTemplates returns all AC templates ordered by name.
+	// Returns empty slice if no templates exist.
+	ListACTemplates(ctx context.Context) ([]*entities.ACTemplateEntity, error)
+
+	// DeleteACTemplate removes an AC template from storage.
+	// Returns ErrNotFound if the template doesn't exist.
+	DeleteACTemplate(ctx context.Context, name string) error
 }
@@ -36,4 +36,17 @@ type ADRRepository interface {
 	// GetADRsByTrack returns all ADRs for a specific track.
 	// Returns empty slice if the track has no ADRs.
 	GetADRsByTrack(ctx context.Context, trackID string) ([]*entities.ADREntity, error)
+
+	// LinkADRToTask records that a task implements an ADR.
+	// Returns ErrNotFound if the ADR or the task doesn't exist.
+	// Returns ErrAlreadyExists if the task is already linked to the ADR.
+	LinkADRToTask(ctx context.Context, adrID, taskID string) error
+
+	// ListADRTasks returns the tasks linked to an ADR, in the order they were linked.
+	// Returns empty slice if no task is linked.
+	ListADRTasks(ctx context.Context, adrID string) ([]*entities.TaskEntity, error)
+
+	// ListTaskADRs returns the ADRs a task is linked to, in the order they were linked.
+	// Returns empty slice if the task is not linked to any ADR.
+	ListTaskADRs(ctx context.Context, taskID string) ([]*entities.ADREntity, error)
 }
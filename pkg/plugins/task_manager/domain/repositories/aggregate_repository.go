@@ -28,4 +28,20 @@ type AggregateRepository interface {
 	// GetNextSequenceNumber retrieves the next sequence number for an entity type.
 	// Entity types: "task", "track", "iter", "adr", "ac"
 	GetNextSequenceNumber(ctx context.Context, entityType string) (int, error)
+
+	// Search finds tasks, tracks, ADRs and acceptance criteria containing term
+	// (case-insensitive substring match), limited to the given entity types
+	// (entities.SearchEntityTypes if empty). Results are ranked by field relevance,
+	// then grouped in entities.SearchEntityTypes order and sorted by ID.
+	Search(ctx context.Context, term string, entityTypes []string) ([]*entities.SearchResult, error)
+
+	// PlanDeletion counts what deleting an entity (entities.DeletionTarget* type) would
+	// remove and what it would leave orphaned, without changing anything.
+	// Returns ErrNotFound if the entity doesn't exist.
+	// Returns ErrInvalidArgument for an unknown entity type.
+	PlanDeletion(ctx context.Context, entityType, id string) (*entities.DeletionPlan, error)
+
+	// CountByStatus counts the tracks, tasks and acceptance criteria of all roadmaps by
+	// status, with one grouped query per table.
+	CountByStatus(ctx context.Context) (*entities.StatusCounts, error)
 }
@@ -24,6 +24,11 @@ type IterationRepository interface {
 	// Returns empty slice if no iterations exist.
 	ListIterations(ctx context.Context) ([]*entities.IterationEntity, error)
 
+	// ListIterationsByRoadmap returns the iterations holding a task of a track of the
+	// roadmap, plus the iterations without tasks, ordered by rank.
+	// Returns empty slice if no iterations match.
+	ListIterationsByRoadmap(ctx context.Context, roadmapID string) ([]*entities.IterationEntity, error)
+
 	// UpdateIteration updates an existing iteration.
 	// Returns ErrNotFound if the iteration doesn't exist.
 	UpdateIteration(ctx context.Context, iteration *entities.IterationEntity) error
@@ -71,4 +76,40 @@ type IterationRepository interface {
 	// GetNextPlannedIteration returns the first planned iteration ordered by rank.
 	// Returns ErrNotFound if no planned iterations exist.
 	GetNextPlannedIteration(ctx context.Context) (*entities.IterationEntity, error)
+
+	// SaveIterationDoDItem persists a new definition of done item and assigns its ID.
+	// Returns ErrNotFound if the iteration doesn't exist.
+	SaveIterationDoDItem(ctx context.Context, item *entities.IterationDoDItemEntity) error
+
+	// GetIterationDoDItem retrieves a definition of done item by its ID.
+	// Returns ErrNotFound if the item doesn't exist.
+	GetIterationDoDItem(ctx context.Context, id int) (*entities.IterationDoDItemEntity, error)
+
+	// ListIterationDoDItems returns the definition of done items of an iteration in creation order.
+	// Returns empty slice if the iteration has no items.
+	ListIterationDoDItems(ctx context.Context, iterationNum int) ([]*entities.IterationDoDItemEntity, error)
+
+	// UpdateIterationDoDItem updates an existing definition of done item.
+	// Returns ErrNotFound if the item doesn't exist.
+	UpdateIterationDoDItem(ctx context.Context, item *entities.IterationDoDItemEntity) error
+
+	// SaveIterationTemplate persists a new iteration template, including its definition of done items.
+	// Returns ErrAlreadyExists if a template with the same name already exists.
+	SaveIterationTemplate(ctx context.Context, template *entities.IterationTemplateEntity) error
+
+	// GetIterationTemplate retrieves an iteration template by its name.
+	// Returns ErrNotFound if the template doesn't exist.
+	GetIterationTemplate(ctx context.Context, name string) (*entities.IterationTemplateEntity, error)
+
+	// ListIterationTemplates returns all iteration templates, ordered by name.
+	// Returns empty slice if no templates exist.
+	ListIterationTemplates(ctx context.Context) ([]*entities.IterationTemplateEntity, error)
+
+	// DeleteIterationTemplate removes an iteration template from storage.
+	// Returns ErrNotFound if the template doesn't exist.
+	DeleteIterationTemplate(ctx context.Context, name string) error
+
+	// IncrementIterationTemplateUsage increments the usage count of a template.
+	// Returns ErrNotFound if the template doesn't exist.
+	IncrementIterationTemplateUsage(ctx context.Context, name string) error
 }
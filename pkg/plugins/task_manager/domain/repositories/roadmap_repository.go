@@ -16,11 +16,36 @@ type RoadmapRepository interface {
 	// Returns ErrNotFound if the roadmap doesn't exist.
 	GetRoadmap(ctx context.Context, id string) (*entities.RoadmapEntity, error)
 
-	// GetActiveRoadmap retrieves the most recently created roadmap.
-	// Returns ErrNotFound if no roadmaps exist.
+	// GetActiveRoadmap retrieves the roadmap activated with SetActiveRoadmap, or the most
+	// recently created unarchived roadmap if none is activated or it has been archived.
+	// Returns ErrNotFound if no unarchived roadmaps exist.
 	GetActiveRoadmap(ctx context.Context) (*entities.RoadmapEntity, error)
 
+	// ListRoadmaps returns all roadmaps, including archived ones, in creation order.
+	// Returns an empty slice if no roadmaps exist.
+	ListRoadmaps(ctx context.Context) ([]*entities.RoadmapEntity, error)
+
+	// SetActiveRoadmap makes a roadmap the active one, stored in project metadata.
+	// Returns ErrNotFound if the roadmap doesn't exist.
+	SetActiveRoadmap(ctx context.Context, id string) error
+
 	// UpdateRoadmap updates an existing roadmap.
 	// Returns ErrNotFound if the roadmap doesn't exist.
 	UpdateRoadmap(ctx context.Context, roadmap *entities.RoadmapEntity) error
+
+	// SaveRoadmapCriterion persists a new success criterion and assigns its ID.
+	// Returns ErrNotFound if the referenced roadmap doesn't exist.
+	SaveRoadmapCriterion(ctx context.Context, criterion *entities.RoadmapCriterionEntity) error
+
+	// GetRoadmapCriterion retrieves a success criterion by its ID.
+	// Returns ErrNotFound if the criterion doesn't exist.
+	GetRoadmapCriterion(ctx context.Context, id int) (*entities.RoadmapCriterionEntity, error)
+
+	// ListRoadmapCriteria returns all success criteria of a roadmap in creation order.
+	// Returns an empty slice if the roadmap has no criteria.
+	ListRoadmapCriteria(ctx context.Context, roadmapID string) ([]*entities.RoadmapCriterionEntity, error)
+
+	// UpdateRoadmapCriterion updates an existing success criterion.
+	// Returns ErrNotFound if the criterion doesn't exist.
+	UpdateRoadmapCriterion(ctx context.Context, criterion *entities.RoadmapCriterionEntity) error
 }
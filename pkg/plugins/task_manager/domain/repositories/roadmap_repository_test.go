@@ -44,10 +44,34 @@ func (m *mockRoadmapRepository) GetActiveRoadmap(ctx context.Context) (*entities
 	return nil, nil
 }
 
+func (m *mockRoadmapRepository) ListRoadmaps(ctx context.Context) ([]*entities.RoadmapEntity, error) {
+	return nil, nil
+}
+
+func (m *mockRoadmapRepository) SetActiveRoadmap(ctx context.Context, id string) error {
+	return nil
+}
+
 func (m *mockRoadmapRepository) UpdateRoadmap(ctx context.Context, roadmap *entities.RoadmapEntity) error {
 	return nil
 }
 
+func (m *mockRoadmapRepository) SaveRoadmapCriterion(ctx context.Context, criterion *entities.RoadmapCriterionEntity) error {
+	return nil
+}
+
+func (m *mockRoadmapRepository) GetRoadmapCriterion(ctx context.Context, id int) (*entities.RoadmapCriterionEntity, error) {
+	return nil, nil
+}
+
+func (m *mockRoadmapRepository) ListRoadmapCriteria(ctx context.Context, roadmapID string) ([]*entities.RoadmapCriterionEntity, error) {
+	return nil, nil
+}
+
+func (m *mockRoadmapRepository) UpdateRoadmapCriterion(ctx context.Context, criterion *entities.RoadmapCriterionEntity) error {
+	return nil
+}
+
 type mockTrackRepository struct{}
 
 func (m *mockTrackRepository) SaveTrack(ctx context.Context, track *entities.TrackEntity) error {
@@ -116,7 +140,7 @@ func (m *mockTaskRepository) MoveTaskToTrack(ctx context.Context, taskID, newTra
 	return nil
 }
 
-func (m *mockTaskRepository) GetBacklogTasks(ctx context.Context) ([]*entities.TaskEntity, error) {
+func (m *mockTaskRepository) GetBacklogTasks(ctx context.Context, roadmapID string) ([]*entities.TaskEntity, error) {
 	return nil, nil
 }
 
@@ -124,6 +148,34 @@ func (m *mockTaskRepository) GetIterationsForTask(ctx context.Context, taskID st
 	return nil, nil
 }
 
+func (m *mockTaskRepository) GetTasksByTrack(ctx context.Context, trackIDs []string) (map[string][]*entities.TaskEntity, error) {
+	return nil, nil
+}
+
+func (m *mockTaskRepository) GetTasksByIteration(ctx context.Context) (map[int][]*entities.TaskEntity, error) {
+	return nil, nil
+}
+
+func (m *mockTaskRepository) SaveTaskNote(ctx context.Context, note *entities.TaskNoteEntity) error {
+	return nil
+}
+
+func (m *mockTaskRepository) ListTaskNotes(ctx context.Context, taskID string) ([]*entities.TaskNoteEntity, error) {
+	return nil, nil
+}
+
+func (m *mockTaskRepository) AddTaskGate(ctx context.Context, taskID, acID string) error {
+	return nil
+}
+
+func (m *mockTaskRepository) RemoveTaskGate(ctx context.Context, taskID, acID string) error {
+	return nil
+}
+
+func (m *mockTaskRepository) ListTaskGates(ctx context.Context, taskID string) ([]*entities.AcceptanceCriteriaEntity, error) {
+	return nil, nil
+}
+
 type mockIterationRepository struct{}
 
 func (m *mockIterationRepository) SaveIteration(ctx context.Context, iteration *entities.IterationEntity) error {
@@ -142,6 +194,10 @@ func (m *mockIterationRepository) ListIterations(ctx context.Context) ([]*entiti
 	return nil, nil
 }
 
+func (m *mockIterationRepository) ListIterationsByRoadmap(ctx context.Context, roadmapID string) ([]*entities.IterationEntity, error) {
+	return nil, nil
+}
+
 func (m *mockIterationRepository) UpdateIteration(ctx context.Context, iteration *entities.IterationEntity) error {
 	return nil
 }
@@ -186,6 +242,42 @@ func (m *mockIterationRepository) GetNextPlannedIteration(ctx context.Context) (
 	return nil, nil
 }
 
+func (m *mockIterationRepository) SaveIterationDoDItem(ctx context.Context, item *entities.IterationDoDItemEntity) error {
+	return nil
+}
+
+func (m *mockIterationRepository) GetIterationDoDItem(ctx context.Context, id int) (*entities.IterationDoDItemEntity, error) {
+	return nil, nil
+}
+
+func (m *mockIterationRepository) ListIterationDoDItems(ctx context.Context, iterationNum int) ([]*entities.IterationDoDItemEntity, error) {
+	return nil, nil
+}
+
+func (m *mockIterationRepository) UpdateIterationDoDItem(ctx context.Context, item *entities.IterationDoDItemEntity) error {
+	return nil
+}
+
+func (m *mockIterationRepository) SaveIterationTemplate(ctx context.Context, template *entities.IterationTemplateEntity) error {
+	return nil
+}
+
+func (m *mockIterationRepository) GetIterationTemplate(ctx context.Context, name string) (*entities.IterationTemplateEntity, error) {
+	return nil, nil
+}
+
+func (m *mockIterationRepository) ListIterationTemplates(ctx context.Context) ([]*entities.IterationTemplateEntity, error) {
+	return nil, nil
+}
+
+func (m *mockIterationRepository) DeleteIterationTemplate(ctx context.Context, name string) error {
+	return nil
+}
+
+func (m *mockIterationRepository) IncrementIterationTemplateUsage(ctx context.Context, name string) error {
+	return nil
+}
+
 type mockADRRepository struct{}
 
 func (m *mockADRRepository) SaveADR(ctx context.Context, adr *entities.ADREntity) error {
@@ -216,12 +308,28 @@ func (m *mockADRRepository) GetADRsByTrack(ctx context.Context, trackID string)
 	return nil, nil
 }
 
+func (m *mockADRRepository) LinkADRToTask(ctx context.Context, adrID, taskID string) error {
+	return nil
+}
+
+func (m *mockADRRepository) ListADRTasks(ctx context.Context, adrID string) ([]*entities.TaskEntity, error) {
+	return nil, nil
+}
+
+func (m *mockADRRepository) ListTaskADRs(ctx context.Context, taskID string) ([]*entities.ADREntity, error) {
+	return nil, nil
+}
+
 type mockACRepository struct{}
 
 func (m *mockACRepository) SaveAC(ctx context.Context, ac *entities.AcceptanceCriteriaEntity) error {
 	return nil
 }
 
+func (m *mockACRepository) SaveACs(ctx context.Context, acs []*entities.AcceptanceCriteriaEntity) error {
+	return nil
+}
+
 func (m *mockACRepository) GetAC(ctx context.Context, id string) (*entities.AcceptanceCriteriaEntity, error) {
 	return nil, nil
 }
@@ -250,6 +358,50 @@ func (m *mockACRepository) ListFailedAC(ctx context.Context, filters entities.AC
 	return nil, nil
 }
 
+func (m *mockACRepository) ListACs(ctx context.Context, filters entities.ACFilters) ([]*entities.AcceptanceCriteriaEntity, error) {
+	return nil, nil
+}
+
+func (m *mockACRepository) CountACProgressByTask(ctx context.Context, taskIDs []string) (map[string]entities.ACProgress, error) {
+	return nil, nil
+}
+
+func (m *mockACRepository) ListTaskACCoverage(ctx context.Context, filters entities.ACFilters, roadmapID string) ([]*entities.TaskACCoverage, error) {
+	return nil, nil
+}
+
+func (m *mockACRepository) AddACTag(ctx context.Context, acID, tag string) error {
+	return nil
+}
+
+func (m *mockACRepository) RemoveACTag(ctx context.Context, acID, tag string) error {
+	return nil
+}
+
+func (m *mockACRepository) ListACTags(ctx context.Context, acID string) ([]string, error) {
+	return nil, nil
+}
+
+func (m *mockACRepository) ListTaggedAC(ctx context.Context, taskID, tag string) ([]*entities.AcceptanceCriteriaEntity, error) {
+	return nil, nil
+}
+
+func (m *mockACRepository) SaveACTemplate(ctx context.Context, template *entities.ACTemplateEntity) error {
+	return nil
+}
+
+func (m *mockACRepository) GetACTemplate(ctx context.Context, name string) (*entities.ACTemplateEntity, error) {
+	return nil, nil
+}
+
+func (m *mockACRepository) ListACTemplates(ctx context.Context) ([]*entities.ACTemplateEntity, error) {
+	return nil, nil
+}
+
+func (m *mockACRepository) DeleteACTemplate(ctx context.Context, name string) error {
+	return nil
+}
+
 type mockDocumentRepository struct{}
 
 func (m *mockDocumentRepository) SaveDocument(ctx context.Context, doc *entities.DocumentEntity) error {
@@ -305,3 +457,15 @@ func (m *mockAggregateRepository) GetProjectCode(ctx context.Context) string {
 func (m *mockAggregateRepository) GetNextSequenceNumber(ctx context.Context, entityType string) (int, error) {
 	return 0, nil
 }
+
+func (m *mockAggregateRepository) Search(ctx context.Context, term string, entityTypes []string) ([]*entities.SearchResult, error) {
+	return nil, nil
+}
+
+func (m *mockAggregateRepository) PlanDeletion(ctx context.Context, entityType, id string) (*entities.DeletionPlan, error) {
+	return nil, nil
+}
+
+func (m *mockAggregateRepository) CountByStatus(ctx context.Context) (*entities.StatusCounts, error) {
+	return nil, nil
+}
@@ -0,0 +1,21 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
+)
+
+// SyncRepository defines the contract for incremental replication of a project database.
+type SyncRepository interface {
+	// ExportChanges returns every entity whose updated_at is after since.
+	// Iterations carry their task membership and tracks their dependencies.
+	ExportChanges(ctx context.Context, since time.Time) (*entities.SyncChangeset, error)
+
+	// ImportChanges upserts the changeset in a single transaction, matching entities by
+	// ID (iterations by number). An existing entity is only overwritten when the incoming
+	// updated_at is newer; a newer local copy is reported as a conflict and kept.
+	// Importing the same changeset twice leaves the database unchanged.
+	ImportChanges(ctx context.Context, changeset *entities.SyncChangeset) (*entities.SyncImportResult, error)
+}
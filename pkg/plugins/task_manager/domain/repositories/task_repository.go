@@ -34,12 +34,44 @@ type TaskRepository interface {
 	MoveTaskToTrack(ctx context.Context, taskID, newTrackID string) error
 
 	// GetBacklogTasks returns all tasks that are not in any iteration and not done.
+	// A non-empty roadmapID limits them to the tracks of that roadmap.
 	// Returns empty slice if there are no backlog tasks.
 	// Ordered by created_at ascending.
-	GetBacklogTasks(ctx context.Context) ([]*entities.TaskEntity, error)
+	GetBacklogTasks(ctx context.Context, roadmapID string) ([]*entities.TaskEntity, error)
 
 	// GetIterationsForTask returns all iterations that contain a specific task.
 	// Returns empty slice if the task is not in any iterations.
 	// Ordered by iteration number ascending.
 	GetIterationsForTask(ctx context.Context, taskID string) ([]*entities.IterationEntity, error)
+
+	// GetTasksByTrack returns the tasks of the given tracks grouped by track ID, each group
+	// ordered by task ID. Tracks without tasks have no entry. Returns empty map if none of
+	// the tracks has tasks.
+	GetTasksByTrack(ctx context.Context, trackIDs []string) (map[string][]*entities.TaskEntity, error)
+
+	// GetTasksByIteration returns the tasks of every iteration grouped by iteration number,
+	// each group ordered by task ID. Iterations without tasks have no entry.
+	// Returns empty map if no task is assigned to an iteration.
+	GetTasksByIteration(ctx context.Context) (map[int][]*entities.TaskEntity, error)
+
+	// SaveTaskNote persists a new note for a task and assigns its ID.
+	// Returns ErrNotFound if the task doesn't exist.
+	SaveTaskNote(ctx context.Context, note *entities.TaskNoteEntity) error
+
+	// ListTaskNotes returns all notes of a task in creation order.
+	// Returns empty slice if the task has no notes.
+	ListTaskNotes(ctx context.Context, taskID string) ([]*entities.TaskNoteEntity, error)
+
+	// AddTaskGate gates a task on an acceptance criterion: the task is blocked until
+	// the AC is verified. Adding a gate the task already has is a no-op.
+	// Returns ErrNotFound if the task or the AC doesn't exist.
+	AddTaskGate(ctx context.Context, taskID, acID string) error
+
+	// RemoveTaskGate removes the gate of a task on an acceptance criterion.
+	// Returns ErrNotFound if the task is not gated on the AC.
+	RemoveTaskGate(ctx context.Context, taskID, acID string) error
+
+	// ListTaskGates returns the acceptance criteria a task is gated on, in the order
+	// the gates were added. Returns empty slice if the task has no gates.
+	ListTaskGates(ctx context.Context, taskID string) ([]*entities.AcceptanceCriteriaEntity, error)
 }
@@ -26,7 +26,13 @@ type RoadmapRepository interface {
 	SaveRoadmap(ctx context.Context, roadmap *entities.RoadmapEntity) error
 	GetRoadmap(ctx context.Context, id string) (*entities.RoadmapEntity, error)
 	GetActiveRoadmap(ctx context.Context) (*entities.RoadmapEntity, error)
+	ListRoadmaps(ctx context.Context) ([]*entities.RoadmapEntity, error)
+	SetActiveRoadmap(ctx context.Context, id string) error
 	UpdateRoadmap(ctx context.Context, roadmap *entities.RoadmapEntity) error
+	SaveRoadmapCriterion(ctx context.Context, criterion *entities.RoadmapCriterionEntity) error
+	GetRoadmapCriterion(ctx context.Context, id int) (*entities.RoadmapCriterionEntity, error)
+	ListRoadmapCriteria(ctx context.Context, roadmapID string) ([]*entities.RoadmapCriterionEntity, error)
+	UpdateRoadmapCriterion(ctx context.Context, criterion *entities.RoadmapCriterionEntity) error
 
 	// Track operations
 	SaveTrack(ctx context.Context, track *entities.TrackEntity) error
@@ -49,16 +55,20 @@ type RoadmapRepository interface {
 	UpdateTask(ctx context.Context, task *entities.TaskEntity) error
 	DeleteTask(ctx context.Context, id string) error
 	MoveTaskToTrack(ctx context.Context, taskID, newTrackID string) error
-	GetBacklogTasks(ctx context.Context) ([]*entities.TaskEntity, error)
+	GetBacklogTasks(ctx context.Context, roadmapID string) ([]*entities.TaskEntity, error)
+	SaveTaskNote(ctx context.Context, note *entities.TaskNoteEntity) error
 	GetIterationsForTask(ctx context.Context, taskID string) ([]*entities.IterationEntity, error)
+	ListTaskGates(ctx context.Context, taskID string) ([]*entities.AcceptanceCriteriaEntity, error)
 
 	// Iteration operations
 	SaveIteration(ctx context.Context, iteration *entities.IterationEntity) error
 	GetIteration(ctx context.Context, number int) (*entities.IterationEntity, error)
 	GetCurrentIteration(ctx context.Context) (*entities.IterationEntity, error)
 	ListIterations(ctx context.Context) ([]*entities.IterationEntity, error)
+	ListIterationsByRoadmap(ctx context.Context, roadmapID string) ([]*entities.IterationEntity, error)
 	UpdateIteration(ctx context.Context, iteration *entities.IterationEntity) error
 	DeleteIteration(ctx context.Context, number int) error
+	ListIterationDoDItems(ctx context.Context, iterationNum int) ([]*entities.IterationDoDItemEntity, error)
 
 	// Iteration-task relationship operations
 	AddTaskToIteration(ctx context.Context, iterationNum int, taskID string) error
@@ -80,6 +90,8 @@ type RoadmapRepository interface {
 	SupersedeADR(ctx context.Context, adrID, supersededByID string) error
 	DeprecateADR(ctx context.Context, adrID string) error
 	GetADRsByTrack(ctx context.Context, trackID string) ([]*entities.ADREntity, error)
+	ListADRTasks(ctx context.Context, adrID string) ([]*entities.TaskEntity, error)
+	ListTaskADRs(ctx context.Context, taskID string) ([]*entities.ADREntity, error)
 
 	// Acceptance Criteria operations
 	SaveAC(ctx context.Context, ac *entities.AcceptanceCriteriaEntity) error
@@ -91,6 +103,10 @@ type RoadmapRepository interface {
 	ListACByTrack(ctx context.Context, trackID string) ([]*entities.AcceptanceCriteriaEntity, error)
 	ListACByIteration(ctx context.Context, iterationNum int) ([]*entities.AcceptanceCriteriaEntity, error)
 	ListFailedAC(ctx context.Context, filters entities.ACFilters) ([]*entities.AcceptanceCriteriaEntity, error)
+	ListACs(ctx context.Context, filters entities.ACFilters) ([]*entities.AcceptanceCriteriaEntity, error)
+	CountACProgressByTask(ctx context.Context, taskIDs []string) (map[string]entities.ACProgress, error)
+	ListTaskACCoverage(ctx context.Context, filters entities.ACFilters, roadmapID string) ([]*entities.TaskACCoverage, error)
+	AddACTag(ctx context.Context, acID, tag string) error
 
 	// Aggregate queries
 	GetRoadmapWithTracks(ctx context.Context, roadmapID string) (*entities.RoadmapEntity, error)
@@ -100,6 +116,16 @@ type RoadmapRepository interface {
 	GetNextSequenceNumber(ctx context.Context, entityType string) (int, error)
 }
 
+// TransactionalRepository is implemented by repositories that can run a multi-step
+// operation atomically. WithTx calls fn with a repository view bound to a single
+// transaction: every mutation made through it is committed when fn returns nil and
+// rolled back when fn returns an error.
+//
+// Implementation: infrastructure/persistence/transaction.go
+type TransactionalRepository interface {
+	WithTx(ctx context.Context, fn func(RoadmapRepository) error) error
+}
+
 // RoadmapRepositoryFactory is a function that creates a RoadmapRepository instance.
 // Used for dependency injection in commands.
 type RoadmapRepositoryFactory func() RoadmapRepository
@@ -3,7 +3,9 @@ package services
 import (
 	"context"
 	"fmt"
+	"sort"
 
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
 	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
 )
 
@@ -62,3 +64,127 @@ func (s *DependencyService) detectCycleDFS(
 	visited[trackID] = false
 	return nil
 }
+
+// BlockerLookup loads one link of a blocker chain, without its Blockers, and the
+// references of the links blocking it. Satisfied links need no blockers.
+type BlockerLookup func(ctx context.Context, ref entities.BlockerRef) (*entities.BlockerLink, []entities.BlockerRef, error)
+
+// TraceBlockers builds the blocker chain below root depth-first, like
+// detectCycleDFS. Only unsatisfied links are expanded. A link already on the
+// current path is marked Cycle and a link expanded elsewhere is marked Repeated;
+// neither is expanded again, so the walk ends even if the data contains cycles.
+func (s *DependencyService) TraceBlockers(ctx context.Context, root entities.BlockerRef, lookup BlockerLookup) (*entities.BlockerLink, error) {
+	// true = currently in the path, false = fully expanded
+	visited := make(map[entities.BlockerRef]bool)
+	return s.traceBlockersDFS(ctx, root, visited, lookup)
+}
+
+func (s *DependencyService) traceBlockersDFS(
+	ctx context.Context,
+	ref entities.BlockerRef,
+	visited map[entities.BlockerRef]bool,
+	lookup BlockerLookup,
+) (*entities.BlockerLink, error) {
+	link, blockers, err := lookup(ctx, ref)
+	if err != nil {
+		return nil, fmt.Errorf("failed to trace %s %s: %w", ref.Kind, ref.ID, err)
+	}
+
+	if onPath, seen := visited[ref]; seen {
+		link.Cycle = onPath
+		link.Repeated = !onPath
+		return link, nil
+	}
+	if link.Satisfied {
+		return link, nil
+	}
+
+	visited[ref] = true
+	for _, blockerRef := range blockers {
+		blocker, err := s.traceBlockersDFS(ctx, blockerRef, visited, lookup)
+		if err != nil {
+			return nil, err
+		}
+		link.Blockers = append(link.Blockers, blocker)
+	}
+	visited[ref] = false
+	return link, nil
+}
+
+// FindCycles returns every dependency cycle in the graph, where graph maps an ID to
+// the IDs it depends on. Each cycle is a strongly connected component with more than
+// one node (or a node depending on itself), with IDs sorted. Cycles are ordered by
+// their first ID so the result is stable.
+func (s *DependencyService) FindCycles(graph map[string][]string) [][]string {
+	// Tarjan's strongly connected components algorithm
+	index := 0
+	indices := make(map[string]int)
+	lowlinks := make(map[string]int)
+	onStack := make(map[string]bool)
+	var stack []string
+	var cycles [][]string
+
+	var strongConnect func(id string)
+	strongConnect = func(id string) {
+		indices[id] = index
+		lowlinks[id] = index
+		index++
+		stack = append(stack, id)
+		onStack[id] = true
+
+		for _, depID := range graph[id] {
+			if _, seen := indices[depID]; !seen {
+				strongConnect(depID)
+				lowlinks[id] = min(lowlinks[id], lowlinks[depID])
+			} else if onStack[depID] {
+				lowlinks[id] = min(lowlinks[id], indices[depID])
+			}
+		}
+
+		if lowlinks[id] != indices[id] {
+			return
+		}
+
+		var component []string
+		for {
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[top] = false
+			component = append(component, top)
+			if top == id {
+				break
+			}
+		}
+
+		if len(component) > 1 || dependsOnItself(graph, id) {
+			sort.Strings(component)
+			cycles = append(cycles, component)
+		}
+	}
+
+	ids := make([]string, 0, len(graph))
+	for id := range graph {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		if _, seen := indices[id]; !seen {
+			strongConnect(id)
+		}
+	}
+
+	sort.Slice(cycles, func(i, j int) bool {
+		return cycles[i][0] < cycles[j][0]
+	})
+	return cycles
+}
+
+// dependsOnItself reports whether id lists itself as a dependency
+func dependsOnItself(graph map[string][]string, id string) bool {
+	for _, depID := range graph[id] {
+		if depID == id {
+			return true
+		}
+	}
+	return false
+}
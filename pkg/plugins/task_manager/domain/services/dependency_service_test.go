@@ -2,8 +2,10 @@ package services_test
 
 import (
 	"context"
+	"reflect"
 	"testing"
 
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/services"
 )
 
@@ -122,3 +124,78 @@ func TestDependencyService_ValidateNoCycles(t *testing.T) {
 		}
 	})
 }
+
+func TestDependencyService_FindCycles(t *testing.T) {
+	service := services.NewDependencyService()
+
+	tests := []struct {
+		name  string
+		graph map[string][]string
+		want  [][]string
+	}{
+		{"no cycles", map[string][]string{"A": {"B", "C"}, "B": {"D"}, "C": {"D"}, "D": nil}, nil},
+		{"self dependency", map[string][]string{"A": {"A"}, "B": {"A"}}, [][]string{{"A"}}},
+		{
+			"two cycles and a tail",
+			map[string][]string{"A": {"B"}, "B": {"C"}, "C": {"A", "D"}, "D": nil, "X": {"Y"}, "Y": {"X"}},
+			[][]string{{"A", "B", "C"}, {"X", "Y"}},
+		},
+		{"dependency outside graph", map[string][]string{"A": {"missing"}}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := service.FindCycles(tt.graph)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FindCycles() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDependencyService_TraceBlockers(t *testing.T) {
+	ctx := context.Background()
+	service := services.NewDependencyService()
+
+	// A -> B -> C -> A is a cycle; A -> D and B -> D make D a diamond; E is satisfied
+	graph := map[string][]string{
+		"A": {"B", "D", "E"},
+		"B": {"C", "D"},
+		"C": {"A"},
+		"D": {},
+		"E": {"A"},
+	}
+	lookups := map[string]int{}
+	lookup := func(ctx context.Context, ref entities.BlockerRef) (*entities.BlockerLink, []entities.BlockerRef, error) {
+		lookups[ref.ID]++
+		link := &entities.BlockerLink{Kind: ref.Kind, ID: ref.ID, Satisfied: ref.ID == "E"}
+		var blockers []entities.BlockerRef
+		for _, id := range graph[ref.ID] {
+			blockers = append(blockers, entities.BlockerRef{Kind: entities.BlockerKindTrack, ID: id})
+		}
+		return link, blockers, nil
+	}
+
+	root, err := service.TraceBlockers(ctx, entities.BlockerRef{Kind: entities.BlockerKindTrack, ID: "A"}, lookup)
+	if err != nil {
+		t.Fatalf("TraceBlockers: %v", err)
+	}
+
+	b := root.Blockers[0]
+	if b.ID != "B" || len(b.Blockers) != 2 {
+		t.Fatalf("expected B to be expanded with 2 blockers, got %+v", b)
+	}
+	c := b.Blockers[0]
+	if len(c.Blockers) != 1 || !c.Blockers[0].Cycle {
+		t.Errorf("expected C's blocker A to be marked as a cycle, got %+v", c.Blockers)
+	}
+	if d := root.Blockers[1]; d.ID != "D" || !d.Repeated {
+		t.Errorf("expected the second D to be marked repeated, got %+v", d)
+	}
+	if e := root.Blockers[2]; !e.Satisfied || len(e.Blockers) != 0 {
+		t.Errorf("expected satisfied E not to be expanded, got %+v", e)
+	}
+	if lookups["E"] != 1 || lookups["D"] != 2 {
+		t.Errorf("unexpected lookups: %v", lookups)
+	}
+}
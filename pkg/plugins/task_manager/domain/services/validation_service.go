@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"regexp"
 
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
 	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
 )
 
@@ -16,7 +17,8 @@ func NewValidationService() *ValidationService {
 }
 
 // ValidateTrackID validates track ID format
-// Accepts both old format (track-<slug>) and new format (<CODE>-track-<number>)
+// Accepts both old format (track-<slug>) and new format (<CODE>-track-<number>),
+// and track IDs of a project's configured ID format (e.g. DW/TR/001)
 func (s *ValidationService) ValidateTrackID(id string) error {
 	// New format: <CODE>-track-<number> (e.g., DW-track-1, PROD-track-123)
 	newPattern := `^[A-Z0-9]+-track-[0-9]+$`
@@ -32,6 +34,10 @@ func (s *ValidationService) ValidateTrackID(id string) error {
 		return nil
 	}
 
+	if entityType, ok := entities.FormattedIDEntityType(id); ok && entityType == "track" {
+		return nil
+	}
+
 	return fmt.Errorf("%w: track ID must follow convention: track-<slug> or <CODE>-track-<number>", pluginsdk.ErrInvalidArgument)
 }
 
@@ -36,6 +36,11 @@ func TestValidationService_ValidateTrackID(t *testing.T) {
 		{"old format - single char", "track-a", false},
 		{"old format - multiple hyphens", "track-a-b-c", false},
 
+		// Configured ID formats
+		{"configured format - abbreviation", "DW/TR/001", false},
+		{"configured format - entity type", "DW.track.0042", false},
+		{"invalid - configured format of a task", "DW/T/001", true},
+
 		// Invalid formats
 		{"invalid - no prefix", "nottrack-1", true},
 		{"invalid - wrong separator new format", "DW_track_1", true},
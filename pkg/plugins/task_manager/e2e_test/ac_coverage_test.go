@@ -0,0 +1,163 @@
+package task_manager_e2e_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// ACCoverageTestSuite tests the report of tasks without acceptance criteria
+type ACCoverageTestSuite struct {
+	E2ETestSuite
+}
+
+func TestACCoverageSuite(t *testing.T) {
+	suite.Run(t, new(ACCoverageTestSuite))
+}
+
+// createTask creates a task in trackID and returns its ID
+func (s *ACCoverageTestSuite) createTask(trackID, title string) string {
+	output, err := s.run("task", "create", "--track", trackID, "--title", title, "--rank", "100")
+	s.requireSuccess(output, err, "failed to create task")
+	return s.parseID(output, "task")
+}
+
+// addAC adds an acceptance criterion to taskID and returns its ID
+func (s *ACCoverageTestSuite) addAC(taskID string) string {
+	output, err := s.run("ac", "add", taskID, "--description", "Works", "--testing-instructions", "Try it")
+	s.requireSuccess(output, err, "failed to add AC")
+	return s.parseID(output, "ac")
+}
+
+// TestCoverageReport tests coverage over a mix of tasks with and without ACs
+func (s *ACCoverageTestSuite) TestCoverageReport() {
+	output, err := s.run("track", "create", "--title", "Coverage Track", "--rank", "100")
+	s.requireSuccess(output, err, "failed to create track")
+	trackID := s.parseID(output, "track")
+	output, err = s.run("track", "create", "--title", "Other Track", "--rank", "200")
+	s.requireSuccess(output, err, "failed to create track")
+	otherTrackID := s.parseID(output, "track")
+
+	// Verified and done
+	covered := s.createTask(trackID, "Covered task")
+	output, err = s.run("ac", "verify", s.addAC(covered))
+	s.requireSuccess(output, err, "failed to verify AC")
+	output, err = s.run("task", "update", covered, "--status", "done")
+	s.requireSuccess(output, err, "failed to complete task")
+
+	// Done before an AC was added
+	doneOpen := s.createTask(trackID, "Done with open AC")
+	output, err = s.run("task", "update", doneOpen, "--status", "done")
+	s.requireSuccess(output, err, "failed to complete task")
+	s.addAC(doneOpen)
+
+	// No ACs; the cancelled one does not count
+	missing := s.createTask(trackID, "Task without ACs")
+	cancelled := s.createTask(trackID, "Cancelled task")
+	output, err = s.run("task", "update", cancelled, "--status", "cancelled")
+	s.requireSuccess(output, err, "failed to cancel task")
+	otherMissing := s.createTask(otherTrackID, "Other task without ACs")
+
+	output, err = s.run("ac", "coverage")
+	s.requireSuccess(output, err, "failed to report coverage")
+	s.Contains(output, "Coverage: 50.0% (2/4 tasks have acceptance criteria)")
+	s.Contains(output, "Tasks without acceptance criteria (2):")
+	s.Contains(output, missing+" [todo] Task without ACs")
+	s.Contains(output, otherMissing)
+	s.NotContains(output, cancelled)
+	s.Contains(output, "Done tasks with unverified acceptance criteria (1):")
+	s.Contains(output, doneOpen+" Done with open AC - 1/1 unverified")
+
+	// Track filter, as JSON
+	output, err = s.run("ac", "coverage", "--track", otherTrackID, "--json")
+	s.requireSuccess(output, err, "failed to report coverage as JSON")
+	var report struct {
+		Tasks      int     `json:"tasks"`
+		Coverage   float64 `json:"coverage"`
+		WithoutACs []struct {
+			ID string `json:"id"`
+		} `json:"without_acs"`
+		DoneUnverified []interface{} `json:"done_unverified"`
+	}
+	s.Require().NoError(json.Unmarshal([]byte(output), &report), output)
+	s.Equal(1, report.Tasks)
+	s.Equal(0.0, report.Coverage)
+	s.Require().Len(report.WithoutACs, 1)
+	s.Equal(otherMissing, report.WithoutACs[0].ID)
+	s.NotNil(report.DoneUnverified)
+
+	// Iteration filter
+	output, err = s.run("iteration", "create", "--name", "Sprint", "--goal", "Coverage", "--deliverable", "Report")
+	s.requireSuccess(output, err, "failed to create iteration")
+	output, err = s.run("iteration", "add-task", "1", covered)
+	s.requireSuccess(output, err, "failed to add task to iteration")
+	output, err = s.run("ac", "coverage", "--iteration", "1")
+	s.requireSuccess(output, err, "failed to report iteration coverage")
+	s.Contains(output, "AC Coverage (Iteration 1)")
+	s.Contains(output, "Coverage: 100.0% (1/1 tasks have acceptance criteria)")
+
+	// Unknown filters are not reported as empty, fully covered selections
+	output, err = s.run("ac", "coverage", "--track", "TM-track-999")
+	s.requireExitCode(output, err, 3, "an unknown track should not be found")
+	output, err = s.run("ac", "coverage", "--iteration", "99")
+	s.requireExitCode(output, err, 3, "an unknown iteration should not be found")
+}
+
+// TestFailUnder tests the exit status for CI gating
+func (s *ACCoverageTestSuite) TestFailUnder() {
+	output, err := s.run("track", "create", "--title", "Gate Track", "--rank", "100")
+	s.requireSuccess(output, err, "failed to create track")
+	trackID := s.parseID(output, "track")
+	s.addAC(s.createTask(trackID, "Covered task"))
+	s.createTask(trackID, "Task without ACs")
+
+	output, err = s.run("ac", "coverage", "--fail-under", "50")
+	s.requireSuccess(output, err, "coverage at the threshold should pass")
+
+	output, err = s.run("ac", "coverage", "--fail-under", "80", "--json")
+	s.requireExitCode(output, err, 1, "coverage below the threshold should fail")
+	s.Contains(output, `"coverage": 50`)
+	s.Contains(output, "AC coverage 50.0% is below 80%")
+
+	output, err = s.run("ac", "coverage", "--fail-under", "120")
+	s.requireExitCode(output, err, 2, "an invalid threshold should be rejected")
+}
+
+// ACCoverageRoadmapTestSuite tests coverage across roadmaps, in a project of its own
+// since it activates a new roadmap
+type ACCoverageRoadmapTestSuite struct {
+	E2ETestSuite
+}
+
+func TestACCoverageRoadmapSuite(t *testing.T) {
+	suite.Run(t, new(ACCoverageRoadmapTestSuite))
+}
+
+// TestActiveRoadmapScope tests that coverage counts only the active roadmap's tasks by default
+func (s *ACCoverageRoadmapTestSuite) TestActiveRoadmapScope() {
+	output, err := s.run("track", "create", "--title", "Old Track", "--rank", "100")
+	s.requireSuccess(output, err, "failed to create track")
+	output, err = s.run("task", "create", "--track", s.parseID(output, "track"), "--title", "Old plan task", "--rank", "100")
+	s.requireSuccess(output, err, "failed to create task")
+	oldTask := s.parseID(output, "task")
+
+	output, err = s.run("roadmap", "init", "--vision", "Plan v2", "--success-criteria", "Ship v2", "--additional")
+	s.requireSuccess(output, err, "failed to create additional roadmap")
+	output, err = s.run("track", "create", "--title", "New Track", "--rank", "100")
+	s.requireSuccess(output, err, "failed to create track")
+	output, err = s.run("task", "create", "--track", s.parseID(output, "track"), "--title", "New plan task", "--rank", "100")
+	s.requireSuccess(output, err, "failed to create task")
+	output, err = s.run("ac", "add", s.parseID(output, "task"), "--description", "Works", "--testing-instructions", "Try it")
+	s.requireSuccess(output, err, "failed to add AC")
+
+	output, err = s.run("ac", "coverage")
+	s.requireSuccess(output, err, "failed to report coverage")
+	s.Contains(output, "Coverage: 100.0% (1/1 tasks have acceptance criteria)")
+	s.NotContains(output, oldTask)
+
+	output, err = s.run("ac", "coverage", "--all-roadmaps")
+	s.requireSuccess(output, err, "failed to report coverage of all roadmaps")
+	s.Contains(output, "Coverage: 50.0% (1/2 tasks have acceptance criteria)")
+	s.Contains(output, oldTask)
+}
@@ -0,0 +1,112 @@
+package task_manager_e2e_test
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// ACEditTestSuite tests editing acceptance criteria through $EDITOR
+type ACEditTestSuite struct {
+	E2ETestSuite
+}
+
+func TestACEditSuite(t *testing.T) {
+	suite.Run(t, new(ACEditTestSuite))
+}
+
+// createAC creates a track, task and AC and returns the AC ID
+func (s *ACEditTestSuite) createAC() string {
+	trackOutput, err := s.run("track", "create", "--title", "Edit Track", "--rank", "100")
+	s.requireSuccess(trackOutput, err, "failed to create track")
+	trackID := s.parseID(trackOutput, "track")
+
+	taskOutput, err := s.run("task", "create", "--track", trackID, "--title", "Edit Task", "--rank", "100")
+	s.requireSuccess(taskOutput, err, "failed to create task")
+	taskID := s.parseID(taskOutput, "task")
+
+	acOutput, err := s.run("ac", "add", taskID, "--description", "Original description", "--testing-instructions", "Original steps")
+	s.requireSuccess(acOutput, err, "failed to add AC")
+	return s.parseID(acOutput, "ac")
+}
+
+// editorEnv writes a fake editor that replaces the edited file with content (and
+// exits with exitCode) and returns the EDITOR setting that runs it
+func (s *ACEditTestSuite) editorEnv(content string, exitCode int) []string {
+	dir := s.T().TempDir()
+	replacement := filepath.Join(dir, "replacement.txt")
+	s.Require().NoError(os.WriteFile(replacement, []byte(content), 0644))
+
+	script := filepath.Join(dir, "editor.sh")
+	body := "#!/bin/sh\ncat '" + replacement + "' > \"$1\"\nexit " + strconv.Itoa(exitCode) + "\n"
+	s.Require().NoError(os.WriteFile(script, []byte(body), 0755))
+	return []string{"EDITOR=" + script}
+}
+
+// TestEditSavesBothFields tests that the edited description and multi-line
+// testing instructions are saved
+func (s *ACEditTestSuite) TestEditSavesBothFields() {
+	acID := s.createAC()
+
+	env := s.editorEnv("=== Description ===\nUser can log in with SSO\n=== Testing Instructions ===\n1. Open /login\n2. Click SSO\n", 0)
+	editOutput, err := s.runWithEnv(env, "ac", "edit", acID)
+	s.requireSuccess(editOutput, err, "failed to edit AC")
+	s.Contains(editOutput, "Acceptance criterion updated")
+
+	showOutput, err := s.run("ac", "show", acID)
+	s.requireSuccess(showOutput, err, "failed to show AC")
+	s.Contains(showOutput, "User can log in with SSO")
+	s.Contains(showOutput, "1. Open /login\n")
+	s.Contains(showOutput, "2. Click SSO")
+	s.NotContains(showOutput, "Original")
+}
+
+// TestEditCancels tests that an empty save or a failing editor leaves the AC unchanged
+func (s *ACEditTestSuite) TestEditCancels() {
+	acID := s.createAC()
+
+	editOutput, err := s.runWithEnv(s.editorEnv("", 0), "ac", "edit", acID)
+	s.requireSuccess(editOutput, err, "empty save should cancel without error")
+	s.Contains(editOutput, "Edit cancelled")
+
+	editOutput, err = s.runWithEnv(s.editorEnv("=== Description ===\nDiscarded\n", 1), "ac", "edit", acID)
+	s.requireSuccess(editOutput, err, "failing editor should cancel without error")
+	s.Contains(editOutput, "Edit cancelled")
+
+	showOutput, err := s.run("ac", "show", acID)
+	s.requireSuccess(showOutput, err, "failed to show AC")
+	s.Contains(showOutput, "Original description")
+}
+
+// TestEditRejectsEmptyDescription tests that the description must stay non-empty
+func (s *ACEditTestSuite) TestEditRejectsEmptyDescription() {
+	acID := s.createAC()
+
+	env := s.editorEnv("=== Description ===\n\n=== Testing Instructions ===\nSteps only\n", 0)
+	editOutput, err := s.runWithEnv(env, "ac", "edit", acID)
+	s.requireError(err, "empty description should be rejected")
+	s.Contains(editOutput, "description cannot be empty")
+
+	showOutput, err := s.run("ac", "show", acID)
+	s.requireSuccess(showOutput, err, "failed to show AC")
+	s.Contains(showOutput, "Original description")
+}
+
+// TestEditFlagsWithoutEditor tests the flag fallback when $EDITOR is unset
+func (s *ACEditTestSuite) TestEditFlagsWithoutEditor() {
+	acID := s.createAC()
+
+	_, err := s.runWithEnv([]string{"EDITOR="}, "ac", "edit", acID)
+	s.requireError(err, "edit without $EDITOR or flags should fail")
+
+	editOutput, err := s.runWithEnv([]string{"EDITOR="}, "ac", "edit", acID, "--testing-instructions", "New steps")
+	s.requireSuccess(editOutput, err, "failed to edit AC with flags")
+
+	showOutput, err := s.run("ac", "show", acID)
+	s.requireSuccess(showOutput, err, "failed to show AC")
+	s.Contains(showOutput, "Original description")
+	s.Contains(showOutput, "New steps")
+}
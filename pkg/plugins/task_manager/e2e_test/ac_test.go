@@ -1,6 +1,10 @@
 package task_manager_e2e_test
 
 import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -41,6 +45,21 @@ func (s *ACTestSuite) TestACAddWithoutTask() {
 	s.NotEmpty(acOutput, "error message should be provided")
 }
 
+// TestACAddArgumentErrors tests that declared arguments are checked before the command runs
+func (s *ACTestSuite) TestACAddArgumentErrors() {
+	output, err := s.run("ac", "add", "TM-task-1")
+	s.requireError(err, "AC add without --description should fail")
+	s.Contains(output, "missing required flag --description")
+
+	output, err = s.run("ac", "add", "TM-task-1", "--description", "Test AC", "--priority", "high")
+	s.requireError(err, "AC add with an unknown flag should fail")
+	s.Contains(output, "unknown flag --priority")
+
+	output, err = s.run("ac", "failed", "--iteration", "three")
+	s.requireError(err, "AC failed with a non-numeric iteration should fail")
+	s.Contains(output, "flag --iteration expects an integer")
+}
+
 // TestACList tests listing acceptance criteria for a task
 func (s *ACTestSuite) TestACList() {
 	// Create track
@@ -468,3 +487,251 @@ func (s *ACTestSuite) TestACVerificationEnforcement_NoACs() {
 	s.requireSuccess(taskShowOutput, err, "failed to show task")
 	s.Contains(taskShowOutput, "done", "task should be in 'done' status")
 }
+
+// TestACApplyTemplate tests creating an AC template and applying it to a task
+func (s *ACTestSuite) TestACApplyTemplate() {
+	// Create template
+	templateOutput, err := s.run("ac", "template", "create", "e2e-dod",
+		"--ac", "Has tests", "--type", "automated",
+		"--ac", "Docs updated")
+	s.requireSuccess(templateOutput, err, "failed to create AC template")
+
+	// List templates shows criteria count
+	listOutput, err := s.run("ac", "template", "list")
+	s.requireSuccess(listOutput, err, "failed to list AC templates")
+	s.Contains(listOutput, "e2e-dod", "template should appear in list")
+	s.Contains(listOutput, "2 criteria", "template list should show criteria count")
+
+	// Create track and task
+	trackOutput, err := s.run("track", "create", "--title", "Template Track", "--rank", "100")
+	s.requireSuccess(trackOutput, err, "failed to create track")
+	trackID := s.parseID(trackOutput, "track")
+
+	taskOutput, err := s.run("task", "create", "--track", trackID, "--title", "Template Task", "--rank", "100")
+	s.requireSuccess(taskOutput, err, "failed to create task")
+	taskID := s.parseID(taskOutput, "task")
+
+	// Apply template
+	applyOutput, err := s.run("ac", "apply-template", "e2e-dod", "--task", taskID)
+	s.requireSuccess(applyOutput, err, "failed to apply AC template")
+	s.Contains(applyOutput, "(automated)", "automated entry should keep its verification type")
+
+	// ACs exist on the task
+	acListOutput, err := s.run("ac", "list", taskID)
+	s.requireSuccess(acListOutput, err, "failed to list acceptance criteria")
+	s.Contains(acListOutput, "Has tests")
+	s.Contains(acListOutput, "Docs updated")
+}
+
+// TestACReset tests resetting verified and failed ACs back to not_started
+func (s *ACTestSuite) TestACReset() {
+	trackOutput, err := s.run("track", "create", "--title", "Reset Track", "--rank", "100")
+	s.requireSuccess(trackOutput, err, "failed to create track")
+	trackID := s.parseID(trackOutput, "track")
+
+	taskOutput, err := s.run("task", "create", "--track", trackID, "--title", "Reset Task", "--rank", "100")
+	s.requireSuccess(taskOutput, err, "failed to create task")
+	taskID := s.parseID(taskOutput, "task")
+
+	var acIDs []string
+	for _, description := range []string{"Reset verified", "Reset failed", "Reset untouched"} {
+		acOutput, err := s.run("ac", "add", taskID, "--description", description)
+		s.requireSuccess(acOutput, err, "failed to add AC")
+		acIDs = append(acIDs, s.parseID(acOutput, "ac"))
+	}
+
+	verifyOutput, err := s.run("ac", "verify", acIDs[0])
+	s.requireSuccess(verifyOutput, err, "failed to verify AC")
+	failOutput, err := s.run("ac", "fail", acIDs[1], "--feedback", "Regressed")
+	s.requireSuccess(failOutput, err, "failed to fail AC")
+
+	// Bulk reset requires --force
+	_, err = s.run("ac", "reset", "--task", taskID)
+	s.Error(err, "bulk reset without --force should fail")
+
+	resetOutput, err := s.run("ac", "reset", "--task", taskID, "--force")
+	s.requireSuccess(resetOutput, err, "failed to reset ACs")
+	s.Contains(resetOutput, "Reset 2 acceptance criteria", "should report reset count")
+	s.Contains(resetOutput, "1 already not_started, skipped", "should report skipped ACs")
+
+	showOutput, err := s.run("ac", "show", acIDs[1])
+	s.requireSuccess(showOutput, err, "failed to show AC")
+	s.Contains(showOutput, "not_started", "failed AC should be reset")
+	s.NotContains(showOutput, "Regressed", "notes should be cleared without --keep-notes")
+
+	taskShowOutput, err := s.run("task", "show", taskID)
+	s.requireSuccess(taskShowOutput, err, "failed to show task")
+	s.Contains(taskShowOutput, "Reset acceptance criteria to not_started", "task should record the reset")
+
+	// Resetting again is a no-op
+	againOutput, err := s.run("ac", "reset", acIDs[0])
+	s.requireSuccess(againOutput, err, "failed to reset single AC")
+	s.Contains(againOutput, "Reset 0 acceptance criteria")
+}
+
+func (s *ACTestSuite) TestACVerifyAutoTrack() {
+	trackOutput, err := s.run("track", "create", "--title", "CI Track", "--rank", "100")
+	s.requireSuccess(trackOutput, err, "failed to create track")
+	trackID := s.parseID(trackOutput, "track")
+
+	taskOutput, err := s.run("task", "create", "--track", trackID, "--title", "CI Task", "--rank", "100")
+	s.requireSuccess(taskOutput, err, "failed to create task")
+	taskID := s.parseID(taskOutput, "task")
+
+	importFile := filepath.Join(s.T().TempDir(), "acs.yaml")
+	content := taskID + `:
+  - description: Unit tests pass
+    type: automated
+  - description: Lint is clean
+    type: automated
+  - description: UI looks right
+`
+	s.Require().NoError(os.WriteFile(importFile, []byte(content), 0644))
+	importOutput, err := s.run("ac", "import", "--file", importFile)
+	s.requireSuccess(importOutput, err, "failed to import ACs")
+
+	listOutput, err := s.run("ac", "list", taskID)
+	s.requireSuccess(listOutput, err, "failed to list ACs")
+	acIDs := regexp.MustCompile(`[A-Z]+-ac-\d+`).FindAllString(listOutput, -1)
+	s.Require().NotEmpty(acIDs, "should list imported ACs")
+
+	skipOutput, err := s.run("ac", "skip", acIDs[0], "--reason", "Covered elsewhere")
+	s.requireSuccess(skipOutput, err, "failed to skip AC")
+
+	verifyOutput, err := s.run("ac", "verify-auto", "--track", trackID)
+	s.requireSuccess(verifyOutput, err, "failed to verify track ACs")
+	s.Contains(verifyOutput, "Marked 1 automated acceptance criteria", "should verify the pending automated AC")
+	s.Contains(verifyOutput, "Skipped 2 (1 manual, 1 already verified or skipped)", "should report skipped ACs")
+
+	againOutput, err := s.run("ac", "verify-auto", "--track", trackID, "--task", taskID)
+	s.requireSuccess(againOutput, err, "failed to re-run verification")
+	s.Contains(againOutput, "Marked 0 automated acceptance criteria", "nothing left to verify")
+
+	_, err = s.run("ac", "verify-auto", acIDs[1], "--track", trackID)
+	s.requireError(err, "AC ID and --track together should fail")
+}
+
+// TestACQuiet tests that the global --quiet flag suppresses success messages
+func (s *ACTestSuite) TestACQuiet() {
+	trackOutput, err := s.run("track", "create", "--title", "Quiet Track", "--rank", "100")
+	s.requireSuccess(trackOutput, err, "failed to create track")
+	trackID := s.parseID(trackOutput, "track")
+
+	taskOutput, err := s.run("task", "create", "--track", trackID, "--title", "Quiet Task", "--rank", "100")
+	s.requireSuccess(taskOutput, err, "failed to create task")
+	taskID := s.parseID(taskOutput, "task")
+
+	// ac add prints only the new ID
+	acOutput, err := s.run("--quiet", "ac", "add", taskID, "--description", "Quiet AC", "--testing-instructions", "Check")
+	s.requireSuccess(acOutput, err, "failed to add acceptance criterion quietly")
+	acID := strings.TrimSpace(acOutput)
+	s.Contains(acID, "-ac-", "quiet ac add should print the AC ID only")
+	s.NotContains(acOutput, "successfully", "quiet ac add should not print success chatter")
+
+	// ac verify prints nothing
+	verifyOutput, err := s.run("ac", "verify", acID, "--quiet")
+	s.requireSuccess(verifyOutput, err, "failed to verify acceptance criterion quietly")
+	s.Empty(strings.TrimSpace(verifyOutput), "quiet ac verify should print nothing")
+
+	// Errors are still reported
+	_, err = s.run("--quiet", "ac", "verify", "TM-ac-missing")
+	s.requireError(err, "verifying an unknown AC should fail")
+
+	// Queries and JSON output are unaffected
+	listOutput, err := s.run("--quiet", "ac", "list", taskID)
+	s.requireSuccess(listOutput, err, "failed to list acceptance criteria")
+	s.Contains(listOutput, acID, "quiet ac list should still print the criteria")
+
+	jsonOutput, err := s.run("--quiet", "task", "list", "--track", trackID, "--format", "json")
+	s.requireSuccess(jsonOutput, err, "failed to list tasks as JSON")
+	s.Contains(jsonOutput, taskID, "--quiet should not suppress JSON output")
+
+	// --quiet and --verbose are mutually exclusive
+	_, err = s.run("--quiet", "--verbose", "ac", "list", taskID)
+	s.requireError(err, "--quiet with --verbose should fail")
+}
+
+// TestVerifyRequireInstructionsFlag tests --require-instructions as a flag without a value
+func (s *ACTestSuite) TestVerifyRequireInstructionsFlag() {
+	trackOutput, err := s.run("track", "create", "--title", "Require Track", "--rank", "100")
+	s.requireSuccess(trackOutput, err, "failed to create track")
+	trackID := s.parseID(trackOutput, "track")
+
+	taskOutput, err := s.run("task", "create", "--track", trackID, "--title", "Require Task", "--rank", "100")
+	s.requireSuccess(taskOutput, err, "failed to create task")
+	taskID := s.parseID(taskOutput, "task")
+
+	acOutput, err := s.run("ac", "add", taskID, "--description", "Undocumented")
+	s.requireSuccess(acOutput, err, "failed to add AC")
+	undocumented := s.parseID(acOutput, "ac")
+
+	output, err := s.run("ac", "verify", undocumented, "--require-instructions")
+	s.requireExitCode(output, err, 2, "an AC without testing instructions should not be verified")
+	s.Contains(output, "ac update "+undocumented+" --testing-instructions")
+
+	acOutput, err = s.run("ac", "add", taskID, "--description", "Documented", "--testing-instructions", "Run it")
+	s.requireSuccess(acOutput, err, "failed to add AC")
+	documented := s.parseID(acOutput, "ac")
+
+	// --notes after the flag is parsed as its own flag, not as the flag's value
+	output, err = s.run("ac", "verify", documented, "--require-instructions", "--notes", "Ran it")
+	s.requireSuccess(output, err, "failed to verify documented AC")
+	s.Contains(output, "Notes:  Ran it")
+
+	output, err = s.run("ac", "verify", undocumented)
+	s.requireSuccess(output, err, "without the flag or config the AC should be verified")
+}
+
+// ACVerifyRequirementsTestSuite tests 'ac verify' with testing instructions and notes required in config
+type ACVerifyRequirementsTestSuite struct {
+	E2ETestSuite
+}
+
+func TestACVerifyRequirementsSuite(t *testing.T) {
+	suite.Run(t, new(ACVerifyRequirementsTestSuite))
+}
+
+// SetupSuite enables require_instructions and require_notes in the suite's working directory,
+// using the acceptance: alias of the task_manager.ac section
+func (s *ACVerifyRequirementsTestSuite) SetupSuite() {
+	s.E2ETestSuite.SetupSuite()
+
+	configDir := filepath.Join(s.testWorkingDir, ".darwinflow")
+	s.Require().NoError(os.MkdirAll(configDir, 0755))
+	config := "task_manager:\n  acceptance:\n    require_instructions: true\n    require_notes: true\n"
+	s.Require().NoError(os.WriteFile(filepath.Join(configDir, "config.yaml"), []byte(config), 0644))
+}
+
+// TestVerifyRequiresInstructionsAndNotes tests the blocked and allowed verification paths
+func (s *ACVerifyRequirementsTestSuite) TestVerifyRequiresInstructionsAndNotes() {
+	trackOutput, err := s.run("track", "create", "--title", "Verify Track", "--rank", "100")
+	s.requireSuccess(trackOutput, err, "failed to create track")
+	trackID := s.parseID(trackOutput, "track")
+
+	taskOutput, err := s.run("task", "create", "--track", trackID, "--title", "Verify Task", "--rank", "100")
+	s.requireSuccess(taskOutput, err, "failed to create task")
+	taskID := s.parseID(taskOutput, "task")
+
+	acOutput, err := s.run("ac", "add", taskID, "--description", "Export works")
+	s.requireSuccess(acOutput, err, "failed to add AC")
+	acID := s.parseID(acOutput, "ac")
+
+	output, err := s.run("ac", "verify", acID, "--notes", "Exported a report")
+	s.requireExitCode(output, err, 2, "an AC without testing instructions should not be verified")
+	s.Contains(output, "ac update "+acID+" --testing-instructions")
+
+	output, err = s.run("ac", "update", acID, "--testing-instructions", "Run dw analyze export")
+	s.requireSuccess(output, err, "failed to add testing instructions")
+
+	output, err = s.run("ac", "verify", acID)
+	s.requireExitCode(output, err, 2, "verification without notes should be rejected")
+	s.Contains(output, "--notes")
+
+	output, err = s.run("ac", "verify", acID, "--notes", "Exported a report")
+	s.requireSuccess(output, err, "failed to verify documented AC with notes")
+
+	showOutput, err := s.run("ac", "show", acID)
+	s.requireSuccess(showOutput, err, "failed to show AC")
+	s.Contains(showOutput, "verified")
+	s.Contains(showOutput, "evidence: Exported a report")
+}
@@ -0,0 +1,190 @@
+package task_manager_e2e_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// CloneTestSuite tests cloning a project into another one
+type CloneTestSuite struct {
+	E2ETestSuite
+}
+
+// SetupSuite overrides E2ETestSuite.SetupSuite: each test creates its own projects
+func (s *CloneTestSuite) SetupSuite() {}
+
+// SetupTest gives each test its own working directory, so projects don't leak between tests
+func (s *CloneTestSuite) SetupTest() {
+	s.testWorkingDir = s.T().TempDir()
+}
+
+// TearDownTest runs after each test
+func (s *CloneTestSuite) TearDownTest() {}
+
+func TestCloneSuite(t *testing.T) {
+	suite.Run(t, new(CloneTestSuite))
+}
+
+// createSourceProject creates a project "src" (code SRC) holding a roadmap, two tracks
+// with a dependency, a started iteration with a task and AC, and an AC template
+func (s *CloneTestSuite) createSourceProject() {
+	output, err := s.run("project", "create", "src", "--code", "SRC")
+	s.requireSuccess(output, err, "failed to create source project")
+	output, err = s.run("project", "switch", "src")
+	s.requireSuccess(output, err, "failed to switch project")
+	output, err = s.run("roadmap", "init", "--vision", "Proven vision", "--success-criteria", "Users are happy")
+	s.requireSuccess(output, err, "failed to init roadmap")
+
+	output, err = s.run("track", "create", "--title", "Foundation", "--rank", "100")
+	s.requireSuccess(output, err, "failed to create track")
+	foundation := s.parseID(output, "track")
+	output, err = s.run("track", "create", "--title", "Features", "--rank", "200")
+	s.requireSuccess(output, err, "failed to create track")
+	features := s.parseID(output, "track")
+	output, err = s.run("track", "add-dependency", features, foundation)
+	s.requireSuccess(output, err, "failed to add dependency")
+
+	output, err = s.run("task", "create", "--track", foundation, "--title", "Set up CI", "--rank", "100")
+	s.requireSuccess(output, err, "failed to create task")
+	taskID := s.parseID(output, "task")
+	output, err = s.run("task", "update", taskID, "--status", "in-progress")
+	s.requireSuccess(output, err, "failed to start task")
+	output, err = s.run("ac", "add", taskID, "--description", "CI runs on every push", "--testing-instructions", "Push a branch")
+	s.requireSuccess(output, err, "failed to add AC")
+
+	output, err = s.run("iteration", "create", "--name", "Bootstrap", "--goal", "Get going", "--deliverable", "CI")
+	s.requireSuccess(output, err, "failed to create iteration")
+	number := s.parseIterationNumber(output)
+	output, err = s.run("iteration", "add-task", number, taskID)
+	s.requireSuccess(output, err, "failed to add task to iteration")
+	output, err = s.run("iteration", "start", number)
+	s.requireSuccess(output, err, "failed to start iteration")
+
+	output, err = s.run("ac", "template", "create", "reviewed", "--ac", "Code reviewed")
+	s.requireSuccess(output, err, "failed to create AC template")
+}
+
+// TestCloneStructure tests that a clone copies the roadmap, tracks, dependencies and
+// empty iterations with reset statuses, and leaves tasks and templates behind
+func (s *CloneTestSuite) TestCloneStructure() {
+	s.createSourceProject()
+
+	output, err := s.run("clone", "--from", "src", "--to", "dst")
+	s.requireSuccess(output, err, "failed to clone")
+	s.Contains(output, "Cloned project src into dst")
+	s.Contains(output, "Tracks:         2 (1 dependencies)")
+	s.Contains(output, "Iterations:     1")
+	s.Regexp(regexp.MustCompile(`track\s+SRC-track-1 -> DST-track-1`), output)
+	s.Regexp(regexp.MustCompile(`track\s+SRC-track-2 -> DST-track-2`), output)
+
+	output, err = s.run("project", "switch", "dst")
+	s.requireSuccess(output, err, "failed to switch project")
+
+	output, err = s.run("roadmap", "show")
+	s.requireSuccess(output, err, "failed to show roadmap")
+	s.Contains(output, "Proven vision")
+
+	output, err = s.run("track", "show", "DST-track-2")
+	s.requireSuccess(output, err, "failed to show track")
+	s.Contains(output, "Features")
+	s.Contains(output, "DST-track-1", "dependency should point at the cloned track")
+	s.Contains(output, "not-started")
+
+	output, err = s.run("iteration", "show", "1")
+	s.requireSuccess(output, err, "failed to show iteration")
+	s.Contains(output, "Bootstrap")
+	s.Contains(output, "planned")
+	s.NotContains(output, "task-1", "iterations are empty without --with-tasks")
+
+	output, err = s.run("task", "list")
+	s.requireSuccess(output, err, "failed to list tasks")
+	s.NotContains(output, "Set up CI")
+
+	output, err = s.run("ac", "template", "list")
+	s.requireSuccess(output, err, "failed to list AC templates")
+	s.NotContains(output, "reviewed")
+}
+
+// TestCloneWithTasksAndTemplates tests copying tasks as todo stubs with their ACs,
+// iteration task lists and AC templates
+func (s *CloneTestSuite) TestCloneWithTasksAndTemplates() {
+	s.createSourceProject()
+
+	output, err := s.run("clone", "--from", "src", "--to", "dst", "--code", "NEW", "--with-tasks", "--with-ac-templates")
+	s.requireSuccess(output, err, "failed to clone")
+	s.Contains(output, "Tasks:          1 (1 ACs)")
+	s.Contains(output, "AC templates:   1")
+	s.Regexp(regexp.MustCompile(`task\s+SRC-task-1 -> NEW-task-1`), output)
+	s.Regexp(regexp.MustCompile(`ac\s+SRC-ac-1 -> NEW-ac-1`), output)
+
+	output, err = s.run("project", "switch", "dst")
+	s.requireSuccess(output, err, "failed to switch project")
+
+	output, err = s.run("task", "show", "NEW-task-1")
+	s.requireSuccess(output, err, "failed to show task")
+	s.Contains(output, "Set up CI")
+	s.Contains(output, "todo")
+	s.Contains(output, "NEW-track-1")
+
+	output, err = s.run("ac", "list", "NEW-task-1")
+	s.requireSuccess(output, err, "failed to list ACs")
+	s.Contains(output, "CI runs on every push")
+	s.Contains(output, "0/1 verified")
+
+	output, err = s.run("iteration", "show", "1")
+	s.requireSuccess(output, err, "failed to show iteration")
+	s.Contains(output, "NEW-task-1")
+
+	output, err = s.run("ac", "template", "list")
+	s.requireSuccess(output, err, "failed to list AC templates")
+	s.Contains(output, "reviewed")
+}
+
+// TestCloneGuardsNonEmptyTarget tests that a non-empty target is only replaced with --force
+func (s *CloneTestSuite) TestCloneGuardsNonEmptyTarget() {
+	s.createSourceProject()
+
+	output, err := s.run("project", "create", "busy", "--code", "BUSY")
+	s.requireSuccess(output, err, "failed to create target project")
+	output, err = s.run("project", "switch", "busy")
+	s.requireSuccess(output, err, "failed to switch project")
+	output, err = s.run("roadmap", "init", "--vision", "Old vision", "--success-criteria", "Old")
+	s.requireSuccess(output, err, "failed to init roadmap")
+	output, err = s.run("track", "create", "--title", "Old track", "--rank", "100")
+	s.requireSuccess(output, err, "failed to create track")
+
+	output, err = s.run("clone", "--from", "src", "--to", "busy")
+	s.requireError(err, "clone into a non-empty project should fail")
+	s.Contains(output, "not empty")
+
+	output, err = s.run("roadmap", "show")
+	s.requireSuccess(output, err, "failed to show roadmap")
+	s.Contains(output, "Old vision", "a refused clone must not change the target")
+
+	output, err = s.run("clone", "--from", "src", "--to", "busy", "--force")
+	s.requireSuccess(output, err, "failed to clone with --force")
+	s.Contains(output, "previous content of busy was replaced")
+
+	output, err = s.run("roadmap", "show")
+	s.requireSuccess(output, err, "failed to show roadmap")
+	s.Contains(output, "Proven vision")
+	output, err = s.run("track", "list")
+	s.requireSuccess(output, err, "failed to list tracks")
+	s.NotContains(output, "Old track")
+	s.Contains(output, "BUSY-track-1")
+}
+
+// TestCloneErrors tests invalid clone invocations
+func (s *CloneTestSuite) TestCloneErrors() {
+	_, err := s.run("clone", "--from", "src")
+	s.requireError(err, "clone without --to should fail")
+
+	output, err := s.run("clone", "--from", "missing", "--to", "dst")
+	s.requireError(err, "clone from a missing project should fail")
+	s.Contains(output, "does not exist")
+
+	_, err = s.run("clone", "--from", "dst", "--to", "dst")
+	s.requireError(err, "clone into the same project should fail")
+}
@@ -80,11 +80,9 @@ func (s *E2ETestSuite) SetupSuite() {
 
 	// Create dedicated working directory for this test suite
 	// This ensures all CLI commands run from the same working directory,
-	// which is critical for .darwinflow/active-project.txt persistence
-	tmpDir := os.TempDir()
-	s.testWorkingDir = filepath.Join(tmpDir, "dw-e2e-wd-"+s.projectName)
-	err := os.MkdirAll(s.testWorkingDir, 0755)
-	s.Require().NoError(err, "failed to create test working directory")
+	// which is critical for .darwinflow/active-project.txt persistence.
+	// It is removed with everything the commands wrote once the suite finishes.
+	s.testWorkingDir = s.T().TempDir()
 
 	// Create project once for entire suite
 	cmdOutput, err := s.run("project", "create", s.projectName)
@@ -125,12 +123,38 @@ func (s *E2ETestSuite) run(args ...string) (string, error) {
 	// (.darwinflow/active-project.txt, project databases, etc.)
 	// Without this, each command invocation would use its own os.Getwd() which may vary
 	cmd.Env = append(os.Environ(), "DARWINFLOW_WORKING_DIR="+s.testWorkingDir)
+	// Run from it as well, so the events database is not created in the source tree
+	cmd.Dir = s.testWorkingDir
 
 	// Execute the command and capture output
 	output, err := cmd.CombinedOutput()
 	return string(output), err
 }
 
+// runWithInput executes a dw task-manager command with the given stdin and returns stdout/stderr combined
+func (s *E2ETestSuite) runWithInput(input string, args ...string) (string, error) {
+	fullArgs := append([]string{"task-manager"}, args...)
+	cmd := exec.Command(dwBinaryPath, fullArgs...)
+	cmd.Env = append(os.Environ(), "DARWINFLOW_WORKING_DIR="+s.testWorkingDir)
+	cmd.Dir = s.testWorkingDir
+	cmd.Stdin = strings.NewReader(input)
+
+	output, err := cmd.CombinedOutput()
+	return string(output), err
+}
+
+// runWithEnv executes a dw task-manager command with extra environment variables
+// (KEY=value) and returns stdout/stderr combined
+func (s *E2ETestSuite) runWithEnv(env []string, args ...string) (string, error) {
+	fullArgs := append([]string{"task-manager"}, args...)
+	cmd := exec.Command(dwBinaryPath, fullArgs...)
+	cmd.Env = append(append(os.Environ(), "DARWINFLOW_WORKING_DIR="+s.testWorkingDir), env...)
+	cmd.Dir = s.testWorkingDir
+
+	output, err := cmd.CombinedOutput()
+	return string(output), err
+}
+
 // requireSuccess asserts that a command executed successfully
 func (s *E2ETestSuite) requireSuccess(output string, err error, msg string, args ...interface{}) {
 	s.Require().NoError(err, append([]interface{}{msg, "\nOutput:\n", output}, args...)...)
@@ -141,6 +165,13 @@ func (s *E2ETestSuite) requireError(err error, msg string, args ...interface{})
 	s.Require().Error(err, append([]interface{}{msg}, args...)...)
 }
 
+// requireExitCode asserts that a command failed with the given exit code
+func (s *E2ETestSuite) requireExitCode(output string, err error, code int, msg string) {
+	var exitErr *exec.ExitError
+	s.Require().ErrorAs(err, &exitErr, "%s\nOutput:\n%s", msg, output)
+	s.Require().Equal(code, exitErr.ExitCode(), "%s\nOutput:\n%s", msg, output)
+}
+
 // parseID extracts an entity ID from command output
 // For example, extracts "ABC-track-1" from "ID: ABC-track-1" or "Created track: ABC-track-1"
 // The prefix parameter can be "-track-" or "track" (both formats are accepted)
@@ -1,6 +1,7 @@
 package task_manager_e2e_test
 
 import (
+	"encoding/json"
 	"strings"
 	"testing"
 
@@ -18,6 +19,21 @@ type IterationWorkflowTestSuite struct {
 	E2ETestSuite
 }
 
+// IterationVelocityTestSuite needs its own project so only its iterations are counted
+type IterationVelocityTestSuite struct {
+	E2ETestSuite
+}
+
+// IterationDoDTestSuite needs its own project because it starts and completes an iteration
+type IterationDoDTestSuite struct {
+	E2ETestSuite
+}
+
+// IterationTimelineTestSuite needs its own project so only its iterations are exported
+type IterationTimelineTestSuite struct {
+	E2ETestSuite
+}
+
 // TestIterationSuite runs the IterationTestSuite
 func TestIterationSuite(t *testing.T) {
 	suite.Run(t, new(IterationTestSuite))
@@ -28,6 +44,21 @@ func TestIterationWorkflowSuite(t *testing.T) {
 	suite.Run(t, new(IterationWorkflowTestSuite))
 }
 
+// TestIterationVelocitySuite runs the IterationVelocityTestSuite
+func TestIterationVelocitySuite(t *testing.T) {
+	suite.Run(t, new(IterationVelocityTestSuite))
+}
+
+// TestIterationDoDSuite runs the IterationDoDTestSuite
+func TestIterationDoDSuite(t *testing.T) {
+	suite.Run(t, new(IterationDoDTestSuite))
+}
+
+// TestIterationTimelineSuite runs the IterationTimelineTestSuite
+func TestIterationTimelineSuite(t *testing.T) {
+	suite.Run(t, new(IterationTimelineTestSuite))
+}
+
 // TestIterationCreate tests iteration creation with required flags
 func (s *IterationTestSuite) TestIterationCreate() {
 	output, err := s.run("iteration", "create",
@@ -53,6 +84,38 @@ func (s *IterationTestSuite) TestIterationCreateMissingFlags() {
 	s.Contains(output, "goal", "error should mention goal")
 }
 
+// TestIterationTemplate tests creating iterations from a recurring template
+func (s *IterationTestSuite) TestIterationTemplate() {
+	createOutput, err := s.run("iteration", "template", "create", "e2e-weekly",
+		"--goal", "Sprint {n}: ship backlog",
+		"--deliverable", "Release notes {n}")
+	s.requireSuccess(createOutput, err, "iteration template create should succeed")
+	s.Contains(createOutput, "Iteration template created successfully", "should confirm creation")
+
+	trackOutput, err := s.run("track", "create", "--title", "Template Track", "--rank", "100")
+	s.requireSuccess(trackOutput, err, "failed to create track")
+	trackID := s.parseID(trackOutput, "track")
+
+	taskOutput, err := s.run("task", "create", "--track", trackID, "--title", "Backlog Task", "--rank", "1")
+	s.requireSuccess(taskOutput, err, "failed to create task")
+
+	newOutput, err := s.run("iteration", "new", "--template", "e2e-weekly", "--pull", "1")
+	s.requireSuccess(newOutput, err, "iteration new should succeed")
+	number := s.parseIterationNumber(newOutput)
+	s.Contains(newOutput, "Name:        e2e-weekly "+number, "name should default to template name and number")
+	s.Contains(newOutput, "Sprint "+number+": ship backlog", "goal should substitute {n}")
+	s.Contains(newOutput, "Release notes "+number, "deliverable should substitute {n}")
+	s.Contains(newOutput, "Pulled 1 backlog task(s)", "should pull one backlog task")
+
+	listOutput, err := s.run("iteration", "template", "list")
+	s.requireSuccess(listOutput, err, "iteration template list should succeed")
+	s.Regexp(`e2e-weekly\s+used 1\s`, listOutput, "list should show usage count")
+
+	missingOutput, err := s.run("iteration", "new", "--template", "e2e-missing")
+	s.requireError(err, "iteration new with unknown template should fail")
+	s.Contains(missingOutput, "not found", "error should mention missing template")
+}
+
 // TestIterationCompleteWorkflow tests the complete iteration workflow with emphasis on state-dependent behavior
 // This test MUST run in a clean database to verify correct numbering and empty state checks
 func (s *IterationWorkflowTestSuite) TestIterationCompleteWorkflow() {
@@ -123,6 +186,8 @@ func (s *IterationWorkflowTestSuite) TestIterationCompleteWorkflow() {
 	s.NotContains(finalListOutput, "Second Iteration", "list should NOT contain deleted iteration 2")
 	s.Contains(finalListOutput, "Third Iteration", "list should contain iteration 3")
 	s.Contains(finalListOutput, "Fourth Iteration", "list should contain iteration 4")
+	s.Regexp(`Status\s+Rank\s+Tasks`, finalListOutput, "list should show the rank column")
+	s.NotContains(finalListOutput, "\x1b[", "piped list output should not be colored")
 
 	// Step 9: Test iteration lifecycle - start iteration 1
 	startOutput, err := s.run("iteration", "start", iter1)
@@ -346,3 +411,184 @@ func (s *IterationTestSuite) TestIterationUpdateOnlySpecifiedFields() {
 	s.Contains(showOutput, "Original Goal", "goal should remain unchanged")
 	s.Contains(showOutput, "Original Deliverable", "deliverable should remain unchanged")
 }
+
+// TestIterationVelocity tests velocity over completed iterations
+func (s *IterationVelocityTestSuite) TestIterationVelocity() {
+	emptyOutput, err := s.run("iteration", "velocity")
+	s.requireSuccess(emptyOutput, err, "iteration velocity should succeed without iterations")
+	s.Contains(emptyOutput, "No completed iterations yet", "should report no completed iterations")
+
+	trackOutput, err := s.run("track", "create", "--title", "Velocity Track", "--rank", "100")
+	s.requireSuccess(trackOutput, err, "failed to create track")
+	trackID := s.parseID(trackOutput, "track")
+
+	doneOutput, err := s.run("task", "create", "--track", trackID, "--title", "Done Task")
+	s.requireSuccess(doneOutput, err, "failed to create task")
+	doneTaskID := s.parseID(doneOutput, "task")
+
+	openOutput, err := s.run("task", "create", "--track", trackID, "--title", "Open Task")
+	s.requireSuccess(openOutput, err, "failed to create task")
+	openTaskID := s.parseID(openOutput, "task")
+
+	iterOutput, err := s.run("iteration", "create", "--name", "Velocity Sprint", "--goal", "Ship", "--deliverable", "Release")
+	s.requireSuccess(iterOutput, err, "failed to create iteration")
+	number := s.parseIterationNumber(iterOutput)
+
+	addOutput, err := s.run("iteration", "add-task", number, doneTaskID, openTaskID)
+	s.requireSuccess(addOutput, err, "failed to add tasks to iteration")
+
+	updateOutput, err := s.run("task", "update", doneTaskID, "--status", "done")
+	s.requireSuccess(updateOutput, err, "failed to mark task done")
+
+	startOutput, err := s.run("iteration", "start", number)
+	s.requireSuccess(startOutput, err, "failed to start iteration")
+	completeOutput, err := s.run("iteration", "complete", number)
+	s.requireSuccess(completeOutput, err, "failed to complete iteration")
+
+	chartOutput, err := s.run("iteration", "velocity", "--last", "3")
+	s.requireSuccess(chartOutput, err, "iteration velocity should succeed")
+	s.Contains(chartOutput, "1 completed iteration(s) (3 requested)", "should note fewer iterations than requested")
+	s.Regexp(`#`+number+`\s+Velocity Sprint\s+█+\s+1/2`, chartOutput, "should chart done/total tasks")
+	s.Contains(chartOutput, "Average: 1.0 tasks/iteration", "should show average")
+	s.Contains(chartOutput, "Trend: insufficient-data", "one iteration has no trend")
+
+	jsonOutput, err := s.run("iteration", "velocity", "--json")
+	s.requireSuccess(jsonOutput, err, "iteration velocity --json should succeed")
+	var report struct {
+		Requested  int     `json:"requested"`
+		Average    float64 `json:"average"`
+		Iterations []struct {
+			Name           string `json:"name"`
+			CompletedTasks int    `json:"completed_tasks"`
+			TotalTasks     int    `json:"total_tasks"`
+		} `json:"iterations"`
+	}
+	s.Require().NoError(json.Unmarshal([]byte(jsonOutput), &report), "output should be valid JSON: %s", jsonOutput)
+	s.Equal(5, report.Requested, "default should cover 5 iterations")
+	s.Require().Len(report.Iterations, 1)
+	s.Equal("Velocity Sprint", report.Iterations[0].Name)
+	s.Equal(1, report.Iterations[0].CompletedTasks)
+	s.Equal(2, report.Iterations[0].TotalTasks)
+
+	invalidOutput, err := s.run("iteration", "velocity", "--last", "0")
+	s.requireError(err, "iteration velocity --last 0 should fail")
+	s.Contains(invalidOutput, "--last must be a positive number", "error should explain --last")
+}
+
+// TestExportGantt tests the iteration timeline export
+func (s *IterationTimelineTestSuite) TestExportGantt() {
+	trackOutput, err := s.run("track", "create", "--title", "Timeline Track", "--rank", "100")
+	s.requireSuccess(trackOutput, err, "failed to create track")
+	trackID := s.parseID(trackOutput, "track")
+
+	taskOutput, err := s.run("task", "create", "--track", trackID, "--title", "Timeline Task")
+	s.requireSuccess(taskOutput, err, "failed to create task")
+	taskID := s.parseID(taskOutput, "task")
+
+	startedOutput, err := s.run("iteration", "create", "--name", "Started Sprint", "--goal", "Ship", "--deliverable", "Release")
+	s.requireSuccess(startedOutput, err, "failed to create iteration")
+	started := s.parseIterationNumber(startedOutput)
+
+	plannedOutput, err := s.run("iteration", "create", "--name", "Later Sprint", "--goal", "Plan", "--deliverable", "Plan")
+	s.requireSuccess(plannedOutput, err, "failed to create iteration")
+	planned := s.parseIterationNumber(plannedOutput)
+
+	addOutput, err := s.run("iteration", "add-task", started, taskID)
+	s.requireSuccess(addOutput, err, "failed to add task to iteration")
+	startOutput, err := s.run("iteration", "start", started)
+	s.requireSuccess(startOutput, err, "failed to start iteration")
+
+	mermaidOutput, err := s.run("export", "--gantt")
+	s.requireSuccess(mermaidOutput, err, "export --gantt should succeed")
+	s.Contains(mermaidOutput, "gantt", "should emit a Mermaid gantt diagram")
+	s.Contains(mermaidOutput, "section Iteration "+started+" - Started Sprint", "should have a section per started iteration")
+	s.Regexp(`Started Sprint :active, iter`+started+`, \d{4}-\d{2}-\d{2}, \d{4}-\d{2}-\d{2}`, mermaidOutput, "running iteration should be active")
+	s.Contains(mermaidOutput, taskID+" Timeline Task :", "should list the iteration's tasks")
+	s.Contains(mermaidOutput, "Iteration "+planned+" - Later Sprint (0 tasks) :milestone", "never started iteration should be unscheduled")
+
+	asciiOutput, err := s.run("export", "--gantt", "--format", "ascii")
+	s.requireSuccess(asciiOutput, err, "export --gantt --format ascii should succeed")
+	s.Regexp(`#`+started+` Started Sprint\s+\|=+\|`, asciiOutput, "running iteration should be drawn in progress")
+	s.Contains(asciiOutput, "Planned (unscheduled):", "should list unscheduled iterations")
+
+	missingOutput, err := s.run("export")
+	s.requireError(err, "export without --gantt should fail")
+	s.Contains(missingOutput, "use --gantt", "error should point to --gantt")
+
+	invalidOutput, err := s.run("export", "--gantt", "--format", "png")
+	s.requireError(err, "export with an unknown format should fail")
+	s.Contains(invalidOutput, "invalid format", "error should explain the format")
+}
+
+// TestIterationDoD tests the definition of done checklist and completion gating
+func (s *IterationDoDTestSuite) TestIterationDoD() {
+	templateOutput, err := s.run("iteration", "template", "create", "e2e-dod",
+		"--goal", "Sprint {n}",
+		"--dod", "Demo recorded",
+		"--dod", "Changelog {n} written")
+	s.requireSuccess(templateOutput, err, "iteration template create with --dod should succeed")
+
+	showTemplateOutput, err := s.run("iteration", "template", "show", "e2e-dod")
+	s.requireSuccess(showTemplateOutput, err, "iteration template show should succeed")
+	s.Contains(showTemplateOutput, "- Changelog {n} written", "template should list its definition of done")
+
+	newOutput, err := s.run("iteration", "new", "--template", "e2e-dod")
+	s.requireSuccess(newOutput, err, "iteration new should succeed")
+	number := s.parseIterationNumber(newOutput)
+	s.Contains(newOutput, "Copied 2 definition of done item(s)", "should copy template items")
+
+	addOutput, err := s.run("iteration", "dod", "add", number, "Retro held")
+	s.requireSuccess(addOutput, err, "iteration dod add should succeed")
+	retroID := parseDoDItemID(addOutput)
+	s.NotEmpty(retroID, "should extract item ID from add output")
+
+	copyOutput, err := s.run("iteration", "dod", "add", number, "--from-template", "e2e-dod")
+	s.requireSuccess(copyOutput, err, "copying the template again should succeed")
+	s.Contains(copyOutput, "Copied 0 definition of done item(s)", "existing items should not be duplicated")
+
+	listOutput, err := s.run("iteration", "dod", "list", number)
+	s.requireSuccess(listOutput, err, "iteration dod list should succeed")
+	s.Contains(listOutput, "Definition of Done: 0/3 done", "should summarize progress")
+	s.Contains(listOutput, "Changelog "+number+" written", "template items should substitute {n}")
+
+	startOutput, err := s.run("iteration", "start", number)
+	s.requireSuccess(startOutput, err, "failed to start iteration")
+
+	blockedOutput, err := s.run("iteration", "complete", number, "--require-dod")
+	s.requireError(err, "completing with unchecked items should fail with --require-dod")
+	s.Contains(blockedOutput, "unchecked definition of done", "error should explain the gate")
+
+	for _, line := range strings.Split(listOutput, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) >= 3 && fields[0] == "[" {
+			checkOutput, err := s.run("iteration", "dod", "check", fields[2])
+			s.requireSuccess(checkOutput, err, "iteration dod check should succeed")
+		}
+	}
+
+	uncheckOutput, err := s.run("iteration", "dod", "uncheck", retroID)
+	s.requireSuccess(uncheckOutput, err, "iteration dod uncheck should succeed")
+	s.Contains(uncheckOutput, "marked as not done", "should confirm uncheck")
+
+	showOutput, err := s.run("iteration", "show", number)
+	s.requireSuccess(showOutput, err, "iteration show should succeed")
+	s.Contains(showOutput, "Definition of Done: 2/3 done", "show should include the checklist")
+
+	checkOutput, err := s.run("iteration", "dod", "check", retroID)
+	s.requireSuccess(checkOutput, err, "iteration dod check should succeed")
+	s.Contains(checkOutput, "marked as done", "should confirm check")
+
+	completeOutput, err := s.run("iteration", "complete", number, "--require-dod")
+	s.requireSuccess(completeOutput, err, "completing with a checked definition of done should succeed")
+}
+
+// parseDoDItemID extracts the item ID from 'iteration dod add' output
+func parseDoDItemID(output string) string {
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 2 && fields[0] == "ID:" {
+			return fields[1]
+		}
+	}
+	return ""
+}
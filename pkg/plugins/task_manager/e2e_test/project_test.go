@@ -56,6 +56,44 @@ func (s *ProjectTestSuite) TestProjectCreateWithCustomCode() {
 	s.Contains(output, "CUST", "output should contain custom code")
 }
 
+// TestProjectIDFormat tests generating IDs in a configured format and changing it
+func (s *ProjectTestSuite) TestProjectIDFormat() {
+	output, err := s.run("project", "create", "padded", "--code", "PAD", "--id-format", "{code}/{abbr}/{number:3}")
+	s.NoError(err, "project create with --id-format should succeed\nOutput:\n%s", output)
+	s.Contains(output, "Examples: PAD/T/001, PAD/TR/001, PAD/AC/001", "output should show IDs in the format")
+
+	_, err = s.run("project", "create", "broken", "--id-format", "{code}-{number}")
+	s.Error(err, "project create with an invalid --id-format should fail")
+
+	output, err = s.run("project", "switch", "padded")
+	s.NoError(err, "project switch should succeed\nOutput:\n%s", output)
+	output, err = s.run("roadmap", "init", "--vision", "Vision", "--success-criteria", "Criteria")
+	s.NoError(err, "roadmap init should succeed\nOutput:\n%s", output)
+
+	output, err = s.run("track", "create", "--title", "Padded Track", "--rank", "100")
+	s.NoError(err, "track create should succeed\nOutput:\n%s", output)
+	s.Contains(output, "PAD/TR/001", "track ID should use the format")
+	output, err = s.run("task", "create", "--track", "PAD/TR/001", "--title", "Padded Task")
+	s.NoError(err, "task create should succeed\nOutput:\n%s", output)
+	s.Contains(output, "PAD/T/001", "task ID should use the format")
+
+	output, err = s.run("project", "id-format")
+	s.NoError(err, "project id-format should succeed\nOutput:\n%s", output)
+	s.Contains(output, "ID format: {code}/{abbr}/{number:3}", "should show the current format")
+
+	// Changing the format while IDs exist warns and keeps numbering
+	output, err = s.run("project", "id-format", "{code}-{entity}-{number}")
+	s.NoError(err, "project id-format should succeed\nOutput:\n%s", output)
+	s.Contains(output, "Warning: the project already has IDs", "should warn about existing IDs")
+	output, err = s.run("task", "create", "--track", "PAD/TR/001", "--title", "Second Task")
+	s.NoError(err, "task create should succeed\nOutput:\n%s", output)
+	s.Contains(output, "PAD-task-2", "numbering should continue in the new format")
+
+	output, err = s.run("project", "id-format", "{code}-{entity}-{number}")
+	s.NoError(err, "project id-format should succeed\nOutput:\n%s", output)
+	s.Contains(output, "ID format unchanged", "setting the same format should be a no-op")
+}
+
 // TestProjectCreateDuplicate tests that duplicate projects are rejected
 func (s *ProjectTestSuite) TestProjectCreateDuplicate() {
 	// Create first project
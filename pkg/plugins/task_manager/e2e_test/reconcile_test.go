@@ -0,0 +1,144 @@
+package task_manager_e2e_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// ReconcileTestSuite tests auto-on-complete acceptance criteria and the reconcile command
+type ReconcileTestSuite struct {
+	E2ETestSuite
+}
+
+func TestReconcileSuite(t *testing.T) {
+	suite.Run(t, new(ReconcileTestSuite))
+}
+
+// TestReconcileVerifiesCompletedTracks tests that reconcile verifies tagged ACs once all tasks of a track are done
+func (s *ReconcileTestSuite) TestReconcileVerifiesCompletedTracks() {
+	trackOutput, err := s.run("track", "create", "--title", "Docs Track", "--rank", "100")
+	s.requireSuccess(trackOutput, err, "failed to create track")
+	trackID := s.parseID(trackOutput, "track")
+
+	var taskIDs []string
+	for _, title := range []string{"Write docs", "Review docs"} {
+		taskOutput, err := s.run("task", "create", "--track", trackID, "--title", title, "--rank", "100")
+		s.requireSuccess(taskOutput, err, "failed to create task")
+		taskIDs = append(taskIDs, s.parseID(taskOutput, "task"))
+	}
+
+	acOutput, err := s.run("ac", "add", taskIDs[0], "--description", "Docs published", "--tag", "auto-on-complete")
+	s.requireSuccess(acOutput, err, "failed to add tagged AC")
+	s.Contains(acOutput, "auto-on-complete", "should print the AC's tags")
+	acID := s.parseID(acOutput, "ac")
+
+	// The tagged AC does not block completing its task
+	doneOutput, err := s.run("task", "update", taskIDs[0], "--status", "done")
+	s.requireSuccess(doneOutput, err, "auto-on-complete AC should not block task completion")
+
+	// The track still has an open task
+	reconcileOutput, err := s.run("reconcile", "--track", trackID)
+	s.requireSuccess(reconcileOutput, err, "failed to reconcile")
+	s.Contains(reconcileOutput, "Complete tracks: 0, incomplete: 1")
+	s.Contains(reconcileOutput, "No changes.")
+
+	doneOutput, err = s.run("task", "update", taskIDs[1], "--status", "done")
+	s.requireSuccess(doneOutput, err, "failed to complete last task")
+	s.NotContains(doneOutput, "auto-verified", "auto-verification is off by default")
+
+	reconcileOutput, err = s.run("reconcile", "--track", trackID)
+	s.requireSuccess(reconcileOutput, err, "failed to reconcile")
+	s.Contains(reconcileOutput, "Complete tracks: 1, incomplete: 0")
+	s.Contains(reconcileOutput, acID)
+
+	showOutput, err := s.run("ac", "show", acID)
+	s.requireSuccess(showOutput, err, "failed to show AC")
+	s.Contains(showOutput, "automatically_verified")
+	s.Contains(showOutput, "auto-on-complete")
+
+	taskShowOutput, err := s.run("task", "show", taskIDs[0])
+	s.requireSuccess(taskShowOutput, err, "failed to show task")
+	s.Contains(taskShowOutput, "Auto-verified acceptance criteria", "task should record the verification")
+
+	// Reconciling again is a no-op
+	againOutput, err := s.run("reconcile", "--track", trackID)
+	s.requireSuccess(againOutput, err, "failed to reconcile again")
+	s.Contains(againOutput, "No changes.")
+}
+
+// TestACTagAndUntag tests adding and removing AC tags
+func (s *ReconcileTestSuite) TestACTagAndUntag() {
+	trackOutput, err := s.run("track", "create", "--title", "Tag Track", "--rank", "100")
+	s.requireSuccess(trackOutput, err, "failed to create track")
+	trackID := s.parseID(trackOutput, "track")
+
+	taskOutput, err := s.run("task", "create", "--track", trackID, "--title", "Tag Task", "--rank", "100")
+	s.requireSuccess(taskOutput, err, "failed to create task")
+	taskID := s.parseID(taskOutput, "task")
+
+	acOutput, err := s.run("ac", "add", taskID, "--description", "Changelog updated")
+	s.requireSuccess(acOutput, err, "failed to add AC")
+	acID := s.parseID(acOutput, "ac")
+
+	tagOutput, err := s.run("ac", "tag", acID, "Auto-On-Complete")
+	s.requireSuccess(tagOutput, err, "failed to tag AC")
+	s.Contains(tagOutput, "auto-on-complete", "tag should be normalized")
+
+	_, err = s.run("ac", "tag", acID, "not a tag")
+	s.requireError(err, "invalid tag should be rejected")
+
+	untagOutput, err := s.run("ac", "untag", acID, "auto-on-complete")
+	s.requireSuccess(untagOutput, err, "failed to untag AC")
+
+	_, err = s.run("ac", "untag", acID, "auto-on-complete")
+	s.requireError(err, "removing a missing tag should fail")
+
+	// Untagged, the AC blocks completion again
+	_, err = s.run("task", "update", taskID, "--status", "done")
+	s.requireError(err, "untagged AC should block task completion")
+}
+
+// AutoVerifyTestSuite tests auto-verification on track completion enabled in config
+type AutoVerifyTestSuite struct {
+	E2ETestSuite
+}
+
+func TestAutoVerifySuite(t *testing.T) {
+	suite.Run(t, new(AutoVerifyTestSuite))
+}
+
+// SetupSuite enables task_manager.ac.auto_verify_on_track_complete in the suite's working directory
+func (s *AutoVerifyTestSuite) SetupSuite() {
+	s.E2ETestSuite.SetupSuite()
+
+	configDir := filepath.Join(s.testWorkingDir, ".darwinflow")
+	s.Require().NoError(os.MkdirAll(configDir, 0755))
+	config := "task_manager:\n  ac:\n    auto_verify_on_track_complete: true\n"
+	s.Require().NoError(os.WriteFile(filepath.Join(configDir, "config.yaml"), []byte(config), 0644))
+}
+
+// TestTaskUpdateAutoVerifiesTrack tests that completing a track's last task verifies its tagged ACs
+func (s *AutoVerifyTestSuite) TestTaskUpdateAutoVerifiesTrack() {
+	trackOutput, err := s.run("track", "create", "--title", "Auto Track", "--rank", "100")
+	s.requireSuccess(trackOutput, err, "failed to create track")
+	trackID := s.parseID(trackOutput, "track")
+
+	taskOutput, err := s.run("task", "create", "--track", trackID, "--title", "Only Task", "--rank", "100")
+	s.requireSuccess(taskOutput, err, "failed to create task")
+	taskID := s.parseID(taskOutput, "task")
+
+	acOutput, err := s.run("ac", "add", taskID, "--description", "Release notes written", "--tag", "auto-on-complete")
+	s.requireSuccess(acOutput, err, "failed to add tagged AC")
+	acID := s.parseID(acOutput, "ac")
+
+	doneOutput, err := s.run("task", "update", taskID, "--status", "done")
+	s.requireSuccess(doneOutput, err, "failed to complete task")
+	s.Contains(doneOutput, "Track "+trackID+" complete: auto-verified "+acID)
+
+	showOutput, err := s.run("ac", "show", acID)
+	s.requireSuccess(showOutput, err, "failed to show AC")
+	s.Contains(showOutput, "automatically_verified")
+}
@@ -0,0 +1,72 @@
+package task_manager_e2e_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// ReportStatusTestSuite tests the status report
+type ReportStatusTestSuite struct {
+	E2ETestSuite
+}
+
+func TestReportStatusSuite(t *testing.T) {
+	suite.Run(t, new(ReportStatusTestSuite))
+}
+
+// TestStatusReport tests counting by status and the current iteration's progress
+func (s *ReportStatusTestSuite) TestStatusReport() {
+	output, err := s.run("track", "create", "--title", "Status Track", "--rank", "100")
+	s.requireSuccess(output, err, "failed to create track")
+	trackID := s.parseID(output, "track")
+
+	var taskIDs []string
+	for _, title := range []string{"First", "Second", "Third"} {
+		output, err = s.run("task", "create", "--track", trackID, "--title", title)
+		s.requireSuccess(output, err, "failed to create task")
+		taskIDs = append(taskIDs, s.parseID(output, "task"))
+	}
+	output, err = s.run("task", "update", taskIDs[0], "--status", "done")
+	s.requireSuccess(output, err, "failed to complete task")
+	output, err = s.run("ac", "add", taskIDs[1], "--description", "Works", "--testing-instructions", "Try it")
+	s.requireSuccess(output, err, "failed to add AC")
+
+	output, err = s.run("report", "status")
+	s.requireSuccess(output, err, "failed to report status")
+	s.Regexp(`Tracks:\s+1\s+not-started 1`, output)
+	s.Regexp(`Tasks:\s+3\s+todo 2, done 1`, output)
+	s.Regexp(`ACs:\s+1\s+not_started 1`, output)
+	s.Contains(output, "No current iteration.")
+
+	output, err = s.run("iteration", "create", "--name", "Status Sprint", "--goal", "Finish", "--deliverable", "Done")
+	s.requireSuccess(output, err, "failed to create iteration")
+	number := s.parseIterationNumber(output)
+	for _, taskID := range taskIDs[:2] {
+		output, err = s.run("iteration", "add-task", number, taskID)
+		s.requireSuccess(output, err, "failed to add task to iteration")
+	}
+	output, err = s.run("iteration", "start", number)
+	s.requireSuccess(output, err, "failed to start iteration")
+
+	output, err = s.run("report", "status")
+	s.requireSuccess(output, err, "failed to report status")
+	s.Contains(output, "Iteration "+number+": Status Sprint")
+	s.Contains(output, "Progress (tasks): 1/2 (50%)")
+
+	output, err = s.run("report", "status", "--json")
+	s.requireSuccess(output, err, "failed to report status as JSON")
+	s.Contains(output, `"todo": 2`)
+	s.Contains(output, `"tasks_done": 1`)
+}
+
+// TestWatchRequiresTerminal tests that --watch is refused when output is not a terminal
+func (s *ReportStatusTestSuite) TestWatchRequiresTerminal() {
+	output, err := s.run("report", "status", "--watch")
+	s.requireExitCode(output, err, 2, "--watch without a terminal should be a usage error")
+	s.Contains(output, "--watch requires a terminal")
+
+	output, err = s.run("report", "status", "--watch", "--interval", "10ms")
+	s.requireExitCode(output, err, 2, "an interval below 1s should be rejected")
+	s.Contains(output, "--interval must be a duration of at least 1s")
+}
@@ -0,0 +1,158 @@
+package task_manager_e2e_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// RoadmapTestSuite tests managing several roadmaps in one project
+type RoadmapTestSuite struct {
+	E2ETestSuite
+}
+
+func TestRoadmapSuite(t *testing.T) {
+	suite.Run(t, new(RoadmapTestSuite))
+}
+
+// roadmapIDPattern matches generated roadmap IDs
+var roadmapIDPattern = regexp.MustCompile(`roadmap-\d+`)
+
+// activeRoadmapID returns the ID 'roadmap show' reports
+func (s *E2ETestSuite) activeRoadmapID() string {
+	output, err := s.run("roadmap", "show")
+	s.requireSuccess(output, err, "failed to show roadmap")
+	id := roadmapIDPattern.FindString(output)
+	s.Require().NotEmpty(id, "no roadmap ID in output:\n%s", output)
+	return id
+}
+
+// TestPivotBetweenRoadmaps tests creating, listing, activating and archiving roadmaps
+func (s *RoadmapTestSuite) TestPivotBetweenRoadmaps() {
+	v1 := s.activeRoadmapID()
+
+	output, err := s.run("roadmap", "init", "--vision", "Plan v2", "--success-criteria", "Ship v2")
+	s.requireError(err, "a second roadmap should need --additional")
+	s.Contains(output, "--additional")
+
+	output, err = s.run("roadmap", "init", "--vision", "Plan v2", "--success-criteria", "Ship v2", "--additional")
+	s.requireSuccess(output, err, "failed to create additional roadmap")
+	v2 := roadmapIDPattern.FindString(output)
+	s.Require().NotEmpty(v2, "no roadmap ID in output:\n%s", output)
+	s.Equal(v2, s.activeRoadmapID(), "the additional roadmap should become active")
+
+	listOutput, err := s.run("roadmap", "list")
+	s.requireSuccess(listOutput, err, "failed to list roadmaps")
+	s.Regexp(`\s+`+v1+`\s+E2E Test Suite Vision`, listOutput)
+	s.Regexp(`\* `+v2+`\s+active\s+Plan v2`, listOutput)
+
+	output, err = s.run("roadmap", "activate", "roadmap-missing")
+	s.requireExitCode(output, err, 3, "activating a missing roadmap should exit with not found")
+	s.Equal(v2, s.activeRoadmapID(), "a failed activation should keep the active roadmap")
+
+	output, err = s.run("roadmap", "activate", v1)
+	s.requireSuccess(output, err, "failed to activate roadmap")
+	s.Equal(v1, s.activeRoadmapID(), "the activated roadmap should stay active although it is older")
+
+	// Archiving the active roadmap hands over to the remaining one
+	output, err = s.run("roadmap", "archive", v1)
+	s.requireSuccess(output, err, "failed to archive roadmap")
+	s.Contains(output, "Active roadmap:  "+v2)
+	s.Equal(v2, s.activeRoadmapID())
+
+	listOutput, err = s.run("roadmap", "list")
+	s.requireSuccess(listOutput, err, "failed to list roadmaps")
+	s.Regexp(v1+`\s+archived`, listOutput)
+
+	// Activating an archived roadmap restores it
+	output, err = s.run("roadmap", "activate", v1)
+	s.requireSuccess(output, err, "failed to reactivate archived roadmap")
+	s.Equal(v1, s.activeRoadmapID())
+	listOutput, err = s.run("roadmap", "list")
+	s.requireSuccess(listOutput, err, "failed to list roadmaps")
+	s.NotContains(listOutput, "archived")
+}
+
+// RoadmapScopeTestSuite tests that listings follow the active roadmap
+type RoadmapScopeTestSuite struct {
+	E2ETestSuite
+}
+
+func TestRoadmapScopeSuite(t *testing.T) {
+	suite.Run(t, new(RoadmapScopeTestSuite))
+}
+
+// createTask creates a track with one backlog task in the active roadmap and returns both IDs
+func (s *RoadmapScopeTestSuite) createTask(title string) (string, string) {
+	output, err := s.run("track", "create", "--title", title+" Track", "--rank", "100")
+	s.requireSuccess(output, err, "failed to create track")
+	trackID := s.parseID(output, "track")
+	output, err = s.run("task", "create", "--track", trackID, "--title", title, "--rank", "100")
+	s.requireSuccess(output, err, "failed to create task")
+	return trackID, s.parseID(output, "task")
+}
+
+// TestListingsFollowActiveRoadmap tests task list, task backlog and --all-roadmaps after a pivot
+func (s *RoadmapScopeTestSuite) TestListingsFollowActiveRoadmap() {
+	v1 := s.activeRoadmapID()
+	v1Track, v1Task := s.createTask("Old plan task")
+
+	output, err := s.run("roadmap", "init", "--vision", "Plan v2", "--success-criteria", "Ship v2", "--additional")
+	s.requireSuccess(output, err, "failed to create additional roadmap")
+	_, v2Task := s.createTask("New plan task")
+
+	for _, args := range [][]string{{"task", "list"}, {"task", "backlog"}} {
+		output, err = s.run(args...)
+		s.requireSuccess(output, err, "failed to run %v", args)
+		s.Contains(output, v2Task, "%v should list the active roadmap's task", args)
+		s.NotContains(output, v1Task, "%v should hide the other roadmap's task", args)
+	}
+
+	output, err = s.run("task", "list", "--all-roadmaps")
+	s.requireSuccess(output, err, "failed to list all tasks")
+	s.Contains(output, v1Task)
+	s.Contains(output, v2Task)
+
+	output, err = s.run("task", "list", "--track", v1Track)
+	s.requireSuccess(output, err, "failed to list tasks of a track")
+	s.Contains(output, v1Task, "an explicit track should be listed whatever its roadmap")
+
+	output, err = s.run("roadmap", "activate", v1)
+	s.requireSuccess(output, err, "failed to activate roadmap")
+	output, err = s.run("task", "backlog")
+	s.requireSuccess(output, err, "failed to list backlog")
+	s.Contains(output, v1Task)
+	s.NotContains(output, v2Task)
+}
+
+// TestReportsFollowActiveRoadmap tests that roadmap stats and the workload report only count the active roadmap
+func (s *RoadmapScopeTestSuite) TestReportsFollowActiveRoadmap() {
+	v1 := s.activeRoadmapID()
+	_, v1Task := s.createTask("Old plan task")
+	output, err := s.run("task", "assign", v1Task, "scope-alice")
+	s.requireSuccess(output, err, "failed to assign task")
+
+	output, err = s.run("roadmap", "init", "--vision", "Plan v2", "--success-criteria", "Ship v2", "--additional")
+	s.requireSuccess(output, err, "failed to create additional roadmap")
+	_, v2Task := s.createTask("New plan task")
+	output, err = s.run("task", "assign", v2Task, "scope-bob")
+	s.requireSuccess(output, err, "failed to assign task")
+
+	output, err = s.run("roadmap", "stats")
+	s.requireSuccess(output, err, "failed to show roadmap stats")
+	s.Contains(output, "Tracks:            0/1 complete")
+	s.Contains(output, "Tasks:             0/1 done", "stats should only count the active roadmap's tasks")
+
+	output, err = s.run("report", "workload")
+	s.requireSuccess(output, err, "failed to report workload")
+	s.Contains(output, "scope-bob")
+	s.NotContains(output, "scope-alice", "the workload should hide the other roadmap's tasks")
+
+	output, err = s.run("roadmap", "activate", v1)
+	s.requireSuccess(output, err, "failed to activate roadmap")
+	output, err = s.run("report", "workload")
+	s.requireSuccess(output, err, "failed to report workload")
+	s.Contains(output, "scope-alice")
+	s.NotContains(output, "scope-bob")
+}
@@ -0,0 +1,76 @@
+package task_manager_e2e_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// SearchTestSuite tests unified search across entity types
+type SearchTestSuite struct {
+	E2ETestSuite
+}
+
+// TestSearchSuite runs the SearchTestSuite
+func TestSearchSuite(t *testing.T) {
+	suite.Run(t, new(SearchTestSuite))
+}
+
+// TestSearch tests grouped, ranked and filtered search results
+func (s *SearchTestSuite) TestSearch() {
+	trackOutput, err := s.run("track", "create", "--title", "Identity", "--description", "Sign in with Zephyrauth providers", "--rank", "100")
+	s.requireSuccess(trackOutput, err, "failed to create track")
+	trackID := s.parseID(trackOutput, "track")
+
+	taskOutput, err := s.run("task", "create", "--track", trackID, "--title", "Zephyrauth callback handler")
+	s.requireSuccess(taskOutput, err, "failed to create task")
+	taskID := s.parseID(taskOutput, "task")
+
+	adrOutput, err := s.run("adr", "create", trackID,
+		"--title", "Token storage",
+		"--context", "Tokens must survive restarts",
+		"--decision", "Keep Zephyrauth tokens in the OS keychain",
+		"--consequences", "Platform-specific code")
+	s.requireSuccess(adrOutput, err, "failed to create ADR")
+	adrID := s.parseID(adrOutput, "-adr-")
+
+	acOutput, err := s.run("ac", "add", taskID, "--description", "Callback rejects a forged state")
+	s.requireSuccess(acOutput, err, "failed to add acceptance criterion")
+
+	output, err := s.run("search", "zephyrauth")
+	s.requireSuccess(output, err, "search should succeed")
+	s.Contains(output, "3 match(es) for \"zephyrauth\"", "should count matches")
+	s.Regexp(`Tasks \(1\):\n\s+`+taskID+`\s+Zephyrauth callback handler`, output, "should list the task")
+	s.Regexp(`Tracks \(1\):\n\s+`+trackID+`\s+Identity\n\s+description: Sign in with Zephyrauth providers`, output, "should show the track snippet")
+	s.Regexp(`ADRs \(1\):\n\s+`+adrID+`\s+Token storage\n\s+decision: Keep Zephyrauth tokens`, output, "should show the ADR snippet")
+	s.NotContains(output, "Acceptance Criteria", "AC should not match")
+
+	scopedOutput, err := s.run("search", "zephyrauth", "--type", "adr,track")
+	s.requireSuccess(scopedOutput, err, "scoped search should succeed")
+	s.Contains(scopedOutput, "2 match(es)", "should only count ADRs and tracks")
+	s.NotContains(scopedOutput, taskID, "should not include tasks")
+
+	jsonOutput, err := s.run("search", "forged state", "--json")
+	s.requireSuccess(jsonOutput, err, "search --json should succeed")
+	var report struct {
+		Total   int `json:"total"`
+		Results []struct {
+			EntityType string `json:"entity_type"`
+			Field      string `json:"field"`
+		} `json:"results"`
+	}
+	s.Require().NoError(json.Unmarshal([]byte(jsonOutput), &report), "output should be valid JSON: %s", jsonOutput)
+	s.Equal(1, report.Total)
+	s.Require().Len(report.Results, 1)
+	s.Equal("ac", report.Results[0].EntityType)
+	s.Equal("description", report.Results[0].Field)
+
+	emptyOutput, err := s.run("search", "no-such-term-anywhere")
+	s.requireSuccess(emptyOutput, err, "search without matches should succeed")
+	s.Contains(emptyOutput, "No matches for \"no-such-term-anywhere\"", "should print a no matches line")
+
+	invalidOutput, err := s.run("search", "zephyrauth", "--type", "epic")
+	s.requireError(err, "search with an unknown type should fail")
+	s.Contains(invalidOutput, "unknown entity type 'epic'", "error should name the type")
+}
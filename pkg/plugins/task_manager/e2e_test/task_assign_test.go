@@ -0,0 +1,142 @@
+package task_manager_e2e_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// TaskAssignTestSuite tests task assignees, assignee filters and the workload report
+type TaskAssignTestSuite struct {
+	E2ETestSuite
+}
+
+func TestTaskAssignSuite(t *testing.T) {
+	suite.Run(t, new(TaskAssignTestSuite))
+}
+
+// createTasks creates a track with one task per title and returns the task IDs
+func (s *TaskAssignTestSuite) createTasks(titles ...string) []string {
+	trackOutput, err := s.run("track", "create", "--title", "Assign Track", "--rank", "100")
+	s.requireSuccess(trackOutput, err, "failed to create track")
+	trackID := s.parseID(trackOutput, "track")
+
+	var taskIDs []string
+	for _, title := range titles {
+		taskOutput, err := s.run("task", "create", "--track", trackID, "--title", title, "--rank", "100")
+		s.requireSuccess(taskOutput, err, "failed to create task")
+		taskIDs = append(taskIDs, s.parseID(taskOutput, "task"))
+	}
+	return taskIDs
+}
+
+// TestAssignAndFilter tests assigning tasks and filtering lists by assignee
+func (s *TaskAssignTestSuite) TestAssignAndFilter() {
+	taskIDs := s.createTasks("Write docs", "Fix login", "Triage bugs")
+
+	assignOutput, err := s.run("task", "assign", taskIDs[0], "alice")
+	s.requireSuccess(assignOutput, err, "failed to assign task")
+	s.Contains(assignOutput, "Assigned task "+taskIDs[0]+" to alice")
+	assignOutput, err = s.run("task", "assign", taskIDs[1], "bob")
+	s.requireSuccess(assignOutput, err, "failed to assign task")
+
+	showOutput, err := s.run("task", "show", taskIDs[0])
+	s.requireSuccess(showOutput, err, "failed to show task")
+	s.Contains(showOutput, "Assignee:    alice")
+	showOutput, err = s.run("task", "show", taskIDs[2])
+	s.requireSuccess(showOutput, err, "failed to show task")
+	s.Contains(showOutput, "Assignee:    (unassigned)")
+
+	listOutput, err := s.run("task", "list", "--assignee", "alice")
+	s.requireSuccess(listOutput, err, "failed to list tasks")
+	s.Contains(listOutput, taskIDs[0])
+	s.NotContains(listOutput, taskIDs[1])
+	s.NotContains(listOutput, taskIDs[2])
+
+	listOutput, err = s.run("task", "list", "--unassigned", "--format", "csv", "--columns", "id,assignee")
+	s.requireSuccess(listOutput, err, "failed to list unassigned tasks")
+	s.Contains(listOutput, taskIDs[2]+",\n")
+	s.NotContains(listOutput, taskIDs[0])
+	s.NotContains(listOutput, taskIDs[1])
+
+	backlogOutput, err := s.run("task", "backlog", "--assignee", "bob")
+	s.requireSuccess(backlogOutput, err, "failed to list backlog")
+	s.Contains(backlogOutput, taskIDs[1])
+	s.NotContains(backlogOutput, taskIDs[0])
+
+	iterOutput, err := s.run("iteration", "create", "--name", "Sprint", "--goal", "Ship", "--deliverable", "Assigned work")
+	s.requireSuccess(iterOutput, err, "failed to create iteration")
+	iterNum := s.parseIterationNumber(iterOutput)
+	for _, taskID := range taskIDs {
+		addOutput, err := s.run("iteration", "add-task", iterNum, taskID)
+		s.requireSuccess(addOutput, err, "failed to add task to iteration")
+	}
+
+	iterShow, err := s.run("iteration", "show", iterNum)
+	s.requireSuccess(iterShow, err, "failed to show iteration")
+	s.Contains(iterShow, taskIDs[0]+" (todo) @alice")
+	s.Contains(iterShow, taskIDs[2]+" (todo)\n")
+
+	iterShow, err = s.run("iteration", "show", iterNum, "--unassigned")
+	s.requireSuccess(iterShow, err, "failed to show iteration")
+	s.Contains(iterShow, "Unassigned tasks:")
+	s.Contains(iterShow, taskIDs[2])
+	s.NotContains(iterShow, taskIDs[0])
+
+	unassignOutput, err := s.run("task", "unassign", taskIDs[0])
+	s.requireSuccess(unassignOutput, err, "failed to unassign task")
+	listOutput, err = s.run("task", "list", "--assignee", "alice")
+	s.requireSuccess(listOutput, err, "failed to list tasks")
+	s.Contains(listOutput, "No tasks found")
+}
+
+// TestWorkloadReport tests tallying open tasks per assignee
+func (s *TaskAssignTestSuite) TestWorkloadReport() {
+	taskIDs := s.createTasks("One", "Two", "Three", "Four")
+
+	for i, assignee := range []string{"wl-alice", "wl-alice", "wl-bob", "wl-carol"} {
+		output, err := s.run("task", "assign", taskIDs[i], assignee)
+		s.requireSuccess(output, err, "failed to assign task")
+	}
+	updateOutput, err := s.run("task", "update", taskIDs[1], "--status", "in-progress")
+	s.requireSuccess(updateOutput, err, "failed to start task")
+	updateOutput, err = s.run("task", "update", taskIDs[2], "--status", "done")
+	s.requireSuccess(updateOutput, err, "failed to complete task")
+
+	reportOutput, err := s.run("report", "workload")
+	s.requireSuccess(reportOutput, err, "failed to report workload")
+	rows := make(map[string][]string)
+	var order []string
+	for _, line := range strings.Split(reportOutput, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 5 {
+			rows[fields[0]] = fields[1:]
+			order = append(order, fields[0])
+		}
+	}
+	s.Equal([]string{"2", "1", "1", "0"}, rows["wl-alice"], "report: %s", reportOutput)
+	s.Equal([]string{"1", "1", "0", "0"}, rows["wl-carol"], "report: %s", reportOutput)
+	s.NotContains(rows, "wl-bob", "done tasks should not be counted")
+	s.Contains(rows, "(unassigned)")
+	s.Equal("(unassigned)", order[len(order)-1], "unassigned tasks should be listed last")
+
+	jsonOutput, err := s.run("report", "workload", "--json")
+	s.requireSuccess(jsonOutput, err, "failed to report workload as JSON")
+	s.Contains(jsonOutput, `"assignee": "wl-alice"`)
+	s.Contains(jsonOutput, `"assignee": ""`)
+}
+
+// TestAssignErrors tests invalid assign invocations
+func (s *TaskAssignTestSuite) TestAssignErrors() {
+	taskIDs := s.createTasks("Only")
+
+	_, err := s.run("task", "assign", taskIDs[0])
+	s.requireError(err, "assign without an assignee should fail")
+
+	_, err = s.run("task", "assign", "TM-task-999", "alice")
+	s.requireError(err, "assigning an unknown task should fail")
+
+	_, err = s.run("task", "list", "--assignee", "alice", "--unassigned")
+	s.requireError(err, "--assignee and --unassigned should be exclusive")
+}
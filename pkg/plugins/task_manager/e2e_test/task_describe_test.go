@@ -0,0 +1,100 @@
+package task_manager_e2e_test
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// TaskDescribeTestSuite tests editing task descriptions through $EDITOR
+type TaskDescribeTestSuite struct {
+	E2ETestSuite
+}
+
+func TestTaskDescribeSuite(t *testing.T) {
+	suite.Run(t, new(TaskDescribeTestSuite))
+}
+
+// createTask creates a track and a task with description and returns the task ID
+func (s *TaskDescribeTestSuite) createTask(description string) string {
+	trackOutput, err := s.run("track", "create", "--title", "Describe Track", "--rank", "100")
+	s.requireSuccess(trackOutput, err, "failed to create track")
+	trackID := s.parseID(trackOutput, "track")
+
+	taskOutput, err := s.run("task", "create", "--track", trackID, "--title", "Describe Task", "--description", description, "--rank", "100")
+	s.requireSuccess(taskOutput, err, "failed to create task")
+	return s.parseID(taskOutput, "task")
+}
+
+// editorEnv writes a fake editor running command on the edited file ("$1") and
+// exiting with exitCode, and returns the EDITOR setting that runs it
+func (s *TaskDescribeTestSuite) editorEnv(command string, exitCode int) []string {
+	script := filepath.Join(s.T().TempDir(), "editor.sh")
+	body := "#!/bin/sh\n" + command + "\nexit " + strconv.Itoa(exitCode) + "\n"
+	s.Require().NoError(os.WriteFile(script, []byte(body), 0755))
+	return []string{"EDITOR=" + script}
+}
+
+// TestDescribeKeepsMarkdown tests that the saved Markdown replaces the description
+// verbatim, keeping what the editor left after the existing text
+func (s *TaskDescribeTestSuite) TestDescribeKeepsMarkdown() {
+	taskID := s.createTask("Original description")
+
+	env := s.editorEnv(`printf '\n## Notes\n- first\n- second\n\n`+"```"+`\ngo test ./...\n`+"```"+`\n\n\n' >> "$1"`, 0)
+	output, err := s.runWithEnv(env, "task", "describe", taskID)
+	s.requireSuccess(output, err, "failed to describe task")
+	s.Contains(output, "Description of "+taskID+" updated (9 line(s))")
+
+	showOutput, err := s.run("task", "show", taskID)
+	s.requireSuccess(showOutput, err, "failed to show task")
+	s.Contains(showOutput, "Description: Original description\n\n## Notes\n- first\n- second\n\n```\ngo test ./...\n```\n")
+	s.NotContains(showOutput, "<!--")
+}
+
+// TestDescribeCancels tests that an empty save or a failing editor leaves the task unchanged
+func (s *TaskDescribeTestSuite) TestDescribeCancels() {
+	taskID := s.createTask("Original description")
+
+	output, err := s.runWithEnv(s.editorEnv(`: > "$1"`, 0), "task", "describe", taskID)
+	s.requireSuccess(output, err, "empty save should cancel without error")
+	s.Contains(output, "Edit cancelled")
+
+	output, err = s.runWithEnv(s.editorEnv(`echo Discarded > "$1"`, 1), "task", "describe", taskID)
+	s.requireSuccess(output, err, "failing editor should cancel without error")
+	s.Contains(output, "Edit cancelled")
+
+	output, err = s.runWithEnv(s.editorEnv("true", 0), "task", "describe", taskID)
+	s.requireSuccess(output, err, "unchanged save should succeed")
+	s.Contains(output, "No changes")
+
+	showOutput, err := s.run("task", "show", taskID)
+	s.requireSuccess(showOutput, err, "failed to show task")
+	s.Contains(showOutput, "Description: Original description\n")
+}
+
+// TestDescribeFlagsWithoutEditor tests --append and --description when $EDITOR is unset
+func (s *TaskDescribeTestSuite) TestDescribeFlagsWithoutEditor() {
+	taskID := s.createTask("Original description")
+
+	output, err := s.runWithEnv([]string{"EDITOR="}, "task", "describe", taskID)
+	s.requireExitCode(output, err, 2, "describe without $EDITOR or flags should fail")
+
+	output, err = s.runWithEnv([]string{"EDITOR="}, "task", "describe", taskID, "--append", "- follow-up")
+	s.requireSuccess(output, err, "failed to append to description")
+	showOutput, err := s.run("task", "show", taskID)
+	s.requireSuccess(showOutput, err, "failed to show task")
+	s.Contains(showOutput, "Description: Original description\n\n- follow-up\n")
+
+	output, err = s.runWithEnv([]string{"EDITOR="}, "task", "describe", taskID, "--description", "# Replaced")
+	s.requireSuccess(output, err, "failed to replace description")
+	showOutput, err = s.run("task", "show", taskID)
+	s.requireSuccess(showOutput, err, "failed to show task")
+	s.Contains(showOutput, "Description: # Replaced\n")
+	s.NotContains(showOutput, "follow-up")
+
+	output, err = s.run("task", "describe", "DW-task-999", "--append", "text")
+	s.requireExitCode(output, err, 3, "describing a missing task should fail")
+}
@@ -0,0 +1,99 @@
+package task_manager_e2e_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// TaskGateTestSuite tests gating tasks on acceptance criteria
+type TaskGateTestSuite struct {
+	E2ETestSuite
+}
+
+func TestTaskGateSuite(t *testing.T) {
+	suite.Run(t, new(TaskGateTestSuite))
+}
+
+// TestGateBlocksTaskUntilACVerified tests that a gated task is reported as blocked until its gating AC is verified
+func (s *TaskGateTestSuite) TestGateBlocksTaskUntilACVerified() {
+	trackOutput, err := s.run("track", "create", "--title", "Gate Track", "--rank", "100")
+	s.requireSuccess(trackOutput, err, "failed to create track")
+	trackID := s.parseID(trackOutput, "track")
+
+	var taskIDs []string
+	for _, title := range []string{"Define API", "Build client"} {
+		taskOutput, err := s.run("task", "create", "--track", trackID, "--title", title, "--rank", "100")
+		s.requireSuccess(taskOutput, err, "failed to create task")
+		taskIDs = append(taskIDs, s.parseID(taskOutput, "task"))
+	}
+
+	acOutput, err := s.run("ac", "add", taskIDs[0], "--description", "API contract reviewed")
+	s.requireSuccess(acOutput, err, "failed to add AC")
+	acID := s.parseID(acOutput, "ac")
+
+	gateOutput, err := s.run("task", "gate", taskIDs[1], "--on-ac", acID)
+	s.requireSuccess(gateOutput, err, "failed to gate task")
+	s.Contains(gateOutput, "waiting on AC "+acID)
+
+	showOutput, err := s.run("task", "show", taskIDs[1])
+	s.requireSuccess(showOutput, err, "failed to show task")
+	s.Contains(showOutput, "Gated on:")
+	s.Contains(showOutput, "Blocked: waiting on AC "+acID)
+
+	readyOutput, err := s.run("task", "check-ready", taskIDs[1])
+	s.requireSuccess(readyOutput, err, "failed to check readiness")
+	s.Contains(readyOutput, "NOT READY (waiting on AC "+acID+")")
+
+	verifyOutput, err := s.run("ac", "verify", acID)
+	s.requireSuccess(verifyOutput, err, "failed to verify AC")
+
+	showOutput, err = s.run("task", "show", taskIDs[1])
+	s.requireSuccess(showOutput, err, "failed to show task")
+	s.NotContains(showOutput, "Blocked:")
+	s.Contains(showOutput, "All gating ACs verified")
+
+	readyOutput, err = s.run("task", "check-ready", taskIDs[1])
+	s.requireSuccess(readyOutput, err, "failed to check readiness")
+	s.Contains(readyOutput, "Status: READY")
+
+	ungateOutput, err := s.run("task", "ungate", taskIDs[1], "--on-ac", acID)
+	s.requireSuccess(ungateOutput, err, "failed to ungate task")
+	s.Contains(ungateOutput, "no longer gated")
+
+	showOutput, err = s.run("task", "show", taskIDs[1])
+	s.requireSuccess(showOutput, err, "failed to show task")
+	s.NotContains(showOutput, "Gated on:")
+}
+
+// TestGateRejectsInvalidGates tests gating on unknown or own ACs and gate cycles
+func (s *TaskGateTestSuite) TestGateRejectsInvalidGates() {
+	trackOutput, err := s.run("track", "create", "--title", "Gate Errors", "--rank", "100")
+	s.requireSuccess(trackOutput, err, "failed to create track")
+	trackID := s.parseID(trackOutput, "track")
+
+	var taskIDs, acIDs []string
+	for _, title := range []string{"First", "Second"} {
+		taskOutput, err := s.run("task", "create", "--track", trackID, "--title", title, "--rank", "100")
+		s.requireSuccess(taskOutput, err, "failed to create task")
+		taskID := s.parseID(taskOutput, "task")
+		taskIDs = append(taskIDs, taskID)
+
+		acOutput, err := s.run("ac", "add", taskID, "--description", title+" done")
+		s.requireSuccess(acOutput, err, "failed to add AC")
+		acIDs = append(acIDs, s.parseID(acOutput, "ac"))
+	}
+
+	_, err = s.run("task", "gate", taskIDs[0], "--on-ac", "TM-ac-999")
+	s.requireError(err, "gating on an unknown AC should fail")
+
+	_, err = s.run("task", "gate", taskIDs[0], "--on-ac", acIDs[0])
+	s.requireError(err, "gating a task on its own AC should fail")
+
+	gateOutput, err := s.run("task", "gate", taskIDs[1], "--on-ac", acIDs[0])
+	s.requireSuccess(gateOutput, err, "failed to gate task")
+
+	output, err := s.run("task", "gate", taskIDs[0], "--on-ac", acIDs[1])
+	s.requireError(err, "gate cycles should be rejected")
+	s.Contains(output, "cycle")
+}
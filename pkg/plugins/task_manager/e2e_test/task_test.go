@@ -1,6 +1,9 @@
 package task_manager_e2e_test
 
 import (
+	"encoding/json"
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -73,6 +76,57 @@ func (s *TaskTestSuite) TestTaskListByStatus() {
 	s.NotEmpty(listOutput, "task list output should not be empty")
 }
 
+// TestTaskListFormats tests columns, numeric ID sorting and the csv/json formats
+func (s *TaskTestSuite) TestTaskListFormats() {
+	trackOutput, err := s.run("track", "create", "--title", "Test Track", "--rank", "100")
+	s.requireSuccess(trackOutput, err, "failed to create track")
+	trackID := s.parseID(trackOutput, "track")
+
+	// Ten tasks so that task-10 would sort before task-2 lexicographically
+	taskIDs := []string{}
+	for i := 1; i <= 10; i++ {
+		taskOutput, err := s.run("task", "create", "--track", trackID, "--title", fmt.Sprintf("Task %d", i), "--rank", fmt.Sprintf("%d", 200-i))
+		s.requireSuccess(taskOutput, err, "failed to create task")
+		taskIDs = append(taskIDs, s.parseID(taskOutput, "task"))
+	}
+
+	// Default table is sorted by numeric ID
+	listOutput, err := s.run("task", "list", "--track", trackID, "--columns", "id,rank")
+	s.requireSuccess(listOutput, err, "failed to list tasks")
+	s.Less(strings.Index(listOutput, taskIDs[1]+" "), strings.Index(listOutput, taskIDs[9]+" "), "task 2 should be listed before task 10")
+	s.NotContains(listOutput, "Title", "only the selected columns should be shown")
+
+	// CSV sorted by rank, lowest first
+	csvOutput, err := s.run("task", "list", "--track", trackID, "--format", "csv", "--columns", "id,rank", "--sort", "rank")
+	s.requireSuccess(csvOutput, err, "failed to list tasks as CSV")
+	lines := strings.Split(strings.TrimSpace(csvOutput), "\n")
+	s.Require().Len(lines, 11, "CSV should have a header and one line per task")
+	s.Equal("id,rank", lines[0])
+	s.Equal(taskIDs[9]+",190", lines[1])
+
+	// JSON with a status filter
+	_, err = s.run("task", "update", taskIDs[4], "--status", "in-progress")
+	s.Require().NoError(err)
+	jsonOutput, err := s.run("task", "list", "--track", trackID, "--format", "json", "--columns", "id,status,rank", "--status", "in-progress")
+	s.requireSuccess(jsonOutput, err, "failed to list tasks as JSON")
+	var items []map[string]interface{}
+	s.Require().NoError(json.Unmarshal([]byte(jsonOutput), &items), "output should be valid JSON: %s", jsonOutput)
+	s.Require().Len(items, 1)
+	s.Equal(taskIDs[4], items[0]["id"])
+	s.Equal(float64(195), items[0]["rank"])
+
+	// Tag filter selects tasks through their acceptance criteria
+	acOutput, err := s.run("ac", "add", taskIDs[2], "--description", "Escapes input", "--tag", "security")
+	s.requireSuccess(acOutput, err, "failed to add tagged AC")
+	tagOutput, err := s.run("task", "list", "--track", trackID, "--format", "csv", "--columns", "id", "--tag", "Security")
+	s.requireSuccess(tagOutput, err, "failed to list tasks by tag")
+	s.Equal("id\n"+taskIDs[2], strings.TrimSpace(tagOutput))
+
+	// Unknown columns are rejected
+	_, err = s.run("task", "list", "--columns", "id,owner")
+	s.requireError(err, "unknown column should fail")
+}
+
 // TestTaskShow tests showing task details
 func (s *TaskTestSuite) TestTaskShow() {
 	// Create track
@@ -239,3 +293,85 @@ func (s *TaskTestSuite) TestTaskMultiple() {
 		s.Contains(listOutput, taskID, "task "+taskID+" should appear in list")
 	}
 }
+
+// TestTaskReopen tests reopening a done task and recording the reason
+func (s *TaskTestSuite) TestTaskReopen() {
+	trackOutput, err := s.run("track", "create", "--title", "Test Track", "--rank", "100")
+	s.requireSuccess(trackOutput, err, "failed to create track")
+	trackID := s.parseID(trackOutput, "track")
+
+	taskOutput, err := s.run("task", "create", "--track", trackID, "--title", "Test Task", "--rank", "100")
+	s.requireSuccess(taskOutput, err, "failed to create task")
+	taskID := s.parseID(taskOutput, "task")
+
+	// Reopening a task that is not done fails
+	reopenOutput, err := s.run("task", "reopen", taskID)
+	s.requireError(err, "reopening a todo task should fail")
+
+	updateOutput, err := s.run("task", "update", taskID, "--status", "done")
+	s.requireSuccess(updateOutput, err, "failed to mark task done")
+
+	reopenOutput, err = s.run("task", "reopen", taskID, "--reason", "Regression in CI")
+	s.requireSuccess(reopenOutput, err, "failed to reopen task")
+	s.Contains(reopenOutput, "todo", "reopened task should report new status")
+
+	showOutput, err := s.run("task", "show", taskID)
+	s.requireSuccess(showOutput, err, "failed to show task")
+	s.Contains(showOutput, "Reopened (done -> todo): Regression in CI", "reopen reason should be recorded as a note")
+}
+
+func (s *TaskTestSuite) TestTaskBump() {
+	trackOutput, err := s.run("track", "create", "--title", "Bump Track", "--rank", "100")
+	s.requireSuccess(trackOutput, err, "failed to create track")
+	trackID := s.parseID(trackOutput, "track")
+
+	var taskIDs []string
+	for _, rank := range []string{"10", "20", "20"} {
+		taskOutput, err := s.run("task", "create", "--track", trackID, "--title", "Bump Task "+rank, "--rank", rank)
+		s.requireSuccess(taskOutput, err, "failed to create task")
+		taskIDs = append(taskIDs, s.parseID(taskOutput, "task"))
+	}
+
+	// The lower tasks collide at rank 20, so moving down renormalizes them to 20 and 21
+	bumpOutput, err := s.run("task", "bump", taskIDs[0], "down")
+	s.requireSuccess(bumpOutput, err, "failed to bump task down")
+	s.Contains(bumpOutput, "position 2 of 3 in track "+trackID, "should report new position")
+	s.Contains(bumpOutput, "(rank 20)", "should take its neighbour's rank")
+
+	bumpOutput, err = s.run("task", "bump", taskIDs[0], "top")
+	s.requireSuccess(bumpOutput, err, "failed to bump task to top")
+	s.Contains(bumpOutput, "position 1 of 3", "should report new position")
+	s.Contains(bumpOutput, "(rank 9)", "top should rank above the current minimum")
+
+	bumpOutput, err = s.run("task", "bump", taskIDs[0], "bottom")
+	s.requireSuccess(bumpOutput, err, "failed to bump task to bottom")
+	s.Contains(bumpOutput, "position 3 of 3", "should report new position")
+	s.Contains(bumpOutput, "(rank 22)", "bottom should rank below the current maximum")
+
+	_, err = s.run("task", "bump", taskIDs[0], "sideways")
+	s.requireError(err, "invalid direction should fail")
+}
+
+// TestTaskExitCodes tests that failures exit with the documented exit codes
+func (s *TaskTestSuite) TestTaskExitCodes() {
+	trackOutput, err := s.run("track", "create", "--title", "Exit Code Track", "--rank", "100")
+	s.requireSuccess(trackOutput, err, "failed to create track")
+	trackID := s.parseID(trackOutput, "track")
+	taskOutput, err := s.run("task", "create", "--track", trackID, "--title", "Exit Code Task")
+	s.requireSuccess(taskOutput, err, "failed to create task")
+	taskID := s.parseID(taskOutput, "task")
+
+	output, err := s.run("ac", "verify", "nonexistent")
+	s.requireExitCode(output, err, 3, "verifying a missing AC should exit with not found")
+	output, err = s.run("task", "show", taskID+"999")
+	s.requireExitCode(output, err, 3, "showing a missing task should exit with not found")
+	output, err = s.run("task", "clone", taskID, "--track", trackID+"999")
+	s.requireExitCode(output, err, 3, "cloning into a missing track should exit with not found")
+
+	output, err = s.run("task", "move", taskID)
+	s.requireExitCode(output, err, 2, "a missing --track should exit with a usage error")
+	output, err = s.run("task", "create", "--track", trackID)
+	s.requireExitCode(output, err, 2, "a missing --title should exit with a usage error")
+	output, err = s.run("no-such-command")
+	s.requireExitCode(output, err, 2, "an unknown command should exit with a usage error")
+}
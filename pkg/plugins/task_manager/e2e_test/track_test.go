@@ -130,6 +130,38 @@ func (s *TrackTestSuite) TestTrackSetDependency() {
 	s.Contains(showOutput, trackID1, "dependency should be visible in track details")
 }
 
+// TestDependenciesGraph tests exporting the track dependency graph
+func (s *TrackTestSuite) TestDependenciesGraph() {
+	output1, err := s.run("track", "create", "--title", "Graph Base", "--description", "Base track")
+	s.requireSuccess(output1, err, "failed to create first track")
+	trackID1 := s.parseID(output1, "-track-")
+
+	output2, err := s.run("track", "create", "--title", "Graph Dependent", "--description", "Depends on base")
+	s.requireSuccess(output2, err, "failed to create second track")
+	trackID2 := s.parseID(output2, "-track-")
+
+	output3, err := s.run("track", "create", "--title", "Graph Isolated", "--description", "No dependencies")
+	s.requireSuccess(output3, err, "failed to create third track")
+	trackID3 := s.parseID(output3, "-track-")
+
+	depOutput, err := s.run("track", "add-dependency", trackID2, trackID1)
+	s.requireSuccess(depOutput, err, "failed to set track dependency")
+
+	dotOutput, err := s.run("dependencies", "graph")
+	s.requireSuccess(dotOutput, err, "dependencies graph should succeed")
+	s.Contains(dotOutput, "digraph dependencies {", "default format should be DOT")
+	s.Contains(dotOutput, `"`+trackID2+`" -> "`+trackID1+`";`, "DOT should contain the dependency edge")
+	s.Contains(dotOutput, `"`+trackID3+`" [label="`+trackID3+`\nGraph Isolated\n(not-started)"`, "isolated track should appear as a labeled node")
+
+	mermaidOutput, err := s.run("dependencies", "graph", "--format", "mermaid")
+	s.requireSuccess(mermaidOutput, err, "mermaid graph should succeed")
+	s.Contains(mermaidOutput, "graph LR", "should output a Mermaid flowchart")
+	s.Contains(mermaidOutput, trackID3+"<br/>Graph Isolated", "isolated track should appear in Mermaid output")
+
+	_, err = s.run("dependencies", "graph", "--format", "png")
+	s.requireError(err, "unknown format should fail")
+}
+
 // TestTrackRemoveDependency tests removing a dependency between tracks
 func (s *TrackTestSuite) TestTrackRemoveDependency() {
 	// Create two tracks
@@ -179,6 +211,53 @@ func (s *TrackTestSuite) TestCircularDependencyPrevention() {
 	s.Contains(circularOutput, "circular", "error message should mention circular dependency")
 }
 
+// TestTrackCreateWithDependencies tests declaring dependencies when creating a track
+func (s *TrackTestSuite) TestTrackCreateWithDependencies() {
+	output1, err := s.run("track", "create", "--title", "Storage", "--description", "Storage layer")
+	s.requireSuccess(output1, err, "failed to create first dependency")
+	depID1 := s.parseID(output1, "-track-")
+
+	output2, err := s.run("track", "create", "--title", "Auth", "--description", "Authentication")
+	s.requireSuccess(output2, err, "failed to create second dependency")
+	depID2 := s.parseID(output2, "-track-")
+
+	output, err := s.run("track", "create", "--title", "Accounts", "--depends-on", depID1+","+depID2)
+	s.requireSuccess(output, err, "failed to create track with dependencies")
+	s.Contains(output, "Depends on:  "+depID1+", "+depID2, "created track should report its dependencies")
+
+	trackID := s.parseID(output, "-track-")
+	showOutput, err := s.run("track", "show", trackID)
+	s.requireSuccess(showOutput, err, "failed to show track")
+	s.Contains(showOutput, depID1, "dependency should be stored")
+	s.Contains(showOutput, depID2, "dependency should be stored")
+}
+
+// TestTrackCreateWithUnknownDependency tests that unknown dependencies are rejected before saving
+func (s *TrackTestSuite) TestTrackCreateWithUnknownDependency() {
+	output, err := s.run("track", "create", "--title", "Orphan Track", "--depends-on", "NOPE-track-999")
+	s.requireError(err, "should reject unknown dependency")
+	s.Contains(output, "NOPE-track-999", "error should name the unknown dependency")
+
+	listOutput, err := s.run("track", "list")
+	s.requireSuccess(listOutput, err, "failed to list tracks")
+	s.NotContains(listOutput, "Orphan Track", "track should not be saved")
+}
+
+// TestTrackCreateInteractive tests entering the track fields and dependencies on stdin
+func (s *TrackTestSuite) TestTrackCreateInteractive() {
+	depOutput, err := s.run("track", "create", "--title", "Interactive Base", "--description", "Base track")
+	s.requireSuccess(depOutput, err, "failed to create dependency track")
+	depID := s.parseID(depOutput, "-track-")
+
+	input := "Interactive Track\nCreated from prompts\n42\n" + depID + "\n"
+	output, err := s.runWithInput(input, "track", "create", "--interactive")
+	s.requireSuccess(output, err, "failed to create track interactively")
+	s.Contains(output, "Existing tracks:", "existing tracks should be listed")
+	s.Contains(output, "Title:       Interactive Track", "title should come from stdin")
+	s.Contains(output, "Rank:        42", "rank should come from stdin")
+	s.Contains(output, "Depends on:  "+depID, "dependency should be reported")
+}
+
 // TestTrackListWithStatus tests listing tracks filtered by status
 func (s *TrackTestSuite) TestTrackListWithStatus() {
 	// Create a track
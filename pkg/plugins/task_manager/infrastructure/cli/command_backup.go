@@ -128,7 +128,7 @@ func (c *BackupCommand) Execute(ctx context.Context, cmdCtx pluginsdk.CommandCon
 
 // getDatabasePath returns the path to the database for the given project
 func (c *BackupCommand) getDatabasePath(projectName string) string {
-	return filepath.Join(c.Provider.GetWorkingDir(), ".darwinflow", "projects", projectName, "roadmap.db")
+	return c.Provider.GetProjectDatabasePath(projectName)
 }
 
 // getBackupDirectory returns the backup directory for the given project
@@ -286,7 +286,7 @@ Notes:
 func (c *RestoreCommand) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
 	// Parse arguments and flags
 	if len(args) == 0 {
-		return fmt.Errorf("backup file path is required")
+		return fmt.Errorf("%w: backup file path is required", pluginsdk.ErrInvalidArgument)
 	}
 	c.backupFile = args[0]
 
@@ -354,7 +354,7 @@ func (c *RestoreCommand) Execute(ctx context.Context, cmdCtx pluginsdk.CommandCo
 
 // getDatabasePath returns the path to the database for the given project
 func (c *RestoreCommand) getDatabasePath(projectName string) string {
-	return filepath.Join(c.Provider.GetWorkingDir(), ".darwinflow", "projects", projectName, "roadmap.db")
+	return c.Provider.GetProjectDatabasePath(projectName)
 }
 
 // validateDatabaseIntegrity validates the database using SQLite's PRAGMA integrity_check
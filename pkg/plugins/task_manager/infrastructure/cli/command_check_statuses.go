@@ -0,0 +1,129 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/infrastructure/persistence"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// ============================================================================
+// CheckStatusesCommand reports and repairs stored statuses outside the allowed values
+// ============================================================================
+
+type CheckStatusesCommand struct {
+	Provider PluginProvider
+	project  string
+	fix      bool
+}
+
+func (c *CheckStatusesCommand) GetName() string {
+	return "check-statuses"
+}
+
+func (c *CheckStatusesCommand) GetDescription() string {
+	return "Find tracks, tasks, iterations, ACs and ADRs with invalid statuses"
+}
+
+func (c *CheckStatusesCommand) GetUsage() string {
+	return "dw task-manager check-statuses [--fix] [--project <name>]"
+}
+
+func (c *CheckStatusesCommand) GetHelp() string {
+	return `Lists every track, task, iteration, acceptance criterion and ADR whose stored
+status is not one of the allowed values, e.g. a task saved as "in_progress"
+instead of "in-progress" before statuses were validated. Such rows show with
+a default icon in the TUI and are missing from status groups.
+
+Allowed statuses:
+  track       ` + strings.Join(entities.TrackStatuses, ", ") + `
+  task        ` + strings.Join(entities.TaskStatuses, ", ") + `
+  iteration   ` + strings.Join(entities.IterationStatuses, ", ") + `
+  ac          ` + strings.Join(entities.ACStatuses, ", ") + `
+  adr         ` + strings.Join(entities.ADRStatuses, ", ") + `
+
+With --fix, statuses that differ from an allowed one only in case, spaces or
+'-' versus '_' are rewritten to it. Other rows are reported for a manual
+update (e.g. 'dw task-manager task update <id> --status <status>').
+
+Flags:
+  --fix               Rewrite statuses that can be matched to an allowed value
+  --project <name>    Project name (optional, uses active project if not specified)
+
+Exits non-zero while invalid statuses remain.
+
+Examples:
+  dw task-manager check-statuses
+  dw task-manager check-statuses --fix`
+}
+
+func (c *CheckStatusesCommand) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	// Parse flags
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--project":
+			if i+1 < len(args) {
+				c.project = args[i+1]
+				i++
+			}
+		case "--fix":
+			c.fix = true
+		default:
+			return fmt.Errorf("%w: unknown argument %q", pluginsdk.ErrInvalidArgument, args[i])
+		}
+	}
+
+	projectName := c.project
+	if projectName == "" {
+		var err error
+		projectName, err = c.Provider.GetActiveProject()
+		if err != nil {
+			return fmt.Errorf("failed to get active project: %w", err)
+		}
+	}
+
+	db, err := c.Provider.GetProjectDatabase(projectName)
+	if err != nil {
+		return fmt.Errorf("failed to open project %s: %w", projectName, err)
+	}
+	defer db.Close()
+	repo := persistence.NewSQLiteRepositoryComposite(db, c.Provider.GetLogger())
+
+	invalid, err := repo.FindInvalidStatuses(ctx)
+	if err != nil {
+		return err
+	}
+
+	out := pluginsdk.InfoWriter(cmdCtx)
+	if len(invalid) == 0 {
+		fmt.Fprintf(out, "All statuses in project %s are valid\n", projectName)
+		return nil
+	}
+
+	remaining := 0
+	for _, row := range invalid {
+		suggestion, ok := entities.NormalizeStatus(row.Status, row.Allowed)
+		switch {
+		case ok && c.fix:
+			if err := repo.RepairStatus(ctx, row.Kind, row.ID, suggestion); err != nil {
+				return err
+			}
+			fmt.Fprintf(out, "  fixed      %-10s %-16s %q -> %q\n", row.Kind, row.ID, row.Status, suggestion)
+		case ok:
+			remaining++
+			fmt.Fprintf(out, "  invalid    %-10s %-16s %q (--fix sets %q)\n", row.Kind, row.ID, row.Status, suggestion)
+		default:
+			remaining++
+			fmt.Fprintf(out, "  invalid    %-10s %-16s %q (allowed: %s)\n", row.Kind, row.ID, row.Status, strings.Join(row.Allowed, ", "))
+		}
+	}
+
+	if remaining > 0 {
+		return fmt.Errorf("%d invalid status(es) remain in project %s", remaining, projectName)
+	}
+	fmt.Fprintf(out, "Fixed %d status(es) in project %s\n", len(invalid), projectName)
+	return nil
+}
@@ -0,0 +1,226 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/infrastructure/persistence"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// ============================================================================
+// CloneCommand copies a project's roadmap structure into another project
+// ============================================================================
+
+type CloneCommand struct {
+	Provider        PluginProvider
+	from            string
+	to              string
+	code            string
+	withTasks       bool
+	withACTemplates bool
+	force           bool
+}
+
+func (c *CloneCommand) GetName() string {
+	return "clone"
+}
+
+func (c *CloneCommand) GetDescription() string {
+	return "Copy a project's roadmap structure into another project"
+}
+
+func (c *CloneCommand) GetUsage() string {
+	return "dw task-manager clone --from <project> --to <project> [--with-tasks] [--with-ac-templates] [--code <code>] [--force]"
+}
+
+func (c *CloneCommand) GetHelp() string {
+	return `Starts a project from the structure of another one.
+
+Copies the source project's roadmap (vision, success criteria and criteria
+checklist), its tracks with their dependencies, and its iterations with their
+definition of done. Copies get new IDs in the target project, statuses reset
+to their initial value (not-started, todo, planned, not_started) and
+timestamps set to now. Iterations keep their numbers and are empty unless
+--with-tasks is given. A newly created target takes the source's ID format.
+
+ADRs, documents, task notes, gates and iteration templates are not copied.
+
+Flags:
+  --from <project>      Project to copy from (required)
+  --to <project>        Project to copy into; created when missing (required)
+  --with-tasks          Also copy tasks (as todo, without branch or assignee),
+                        their ACs and the iterations' task lists
+  --with-ac-templates   Also copy AC templates
+  --code <code>         Project code of a newly created target project
+                        (default: derived from the project name)
+  --force               Replace the content of a non-empty target project
+
+The copy runs in a single transaction: if anything fails, the target is left
+unchanged. The new IDs are printed next to the IDs they were copied from.
+
+Examples:
+  # Start a new product from the structure of an existing one
+  dw task-manager clone --from webapp --to mobile-app
+
+  # Include tasks, ACs and AC templates
+  dw task-manager clone --from webapp --to mobile-app --with-tasks --with-ac-templates
+
+  # Replace a scratch project
+  dw task-manager clone --from webapp --to scratch --force`
+}
+
+func (c *CloneCommand) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	// Parse flags
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--from":
+			if i+1 < len(args) {
+				c.from = args[i+1]
+				i++
+			}
+		case "--to":
+			if i+1 < len(args) {
+				c.to = args[i+1]
+				i++
+			}
+		case "--code":
+			if i+1 < len(args) {
+				c.code = args[i+1]
+				i++
+			}
+		case "--with-tasks":
+			c.withTasks = true
+		case "--with-ac-templates":
+			c.withACTemplates = true
+		case "--force":
+			c.force = true
+		default:
+			return fmt.Errorf("%w: unknown argument %q", pluginsdk.ErrInvalidArgument, args[i])
+		}
+	}
+
+	if c.from == "" || c.to == "" {
+		return fmt.Errorf("%w: --from and --to are required", pluginsdk.ErrInvalidArgument)
+	}
+	for _, name := range []string{c.from, c.to} {
+		if !projectNameRegex.MatchString(name) {
+			return fmt.Errorf("%w: invalid project name %q: must be alphanumeric with hyphens or underscores only", pluginsdk.ErrInvalidArgument, name)
+		}
+	}
+	if c.from == c.to {
+		return fmt.Errorf("%w: --from and --to must name different projects", pluginsdk.ErrInvalidArgument)
+	}
+	if c.code != "" && !regexp.MustCompile(`^[A-Z0-9]+$`).MatchString(c.code) {
+		return fmt.Errorf("%w: invalid project code: must be alphanumeric uppercase (e.g., DW, PROD, TEST)", pluginsdk.ErrInvalidArgument)
+	}
+
+	projectsDir := filepath.Join(c.Provider.GetWorkingDir(), ".darwinflow", "projects")
+	if _, err := os.Stat(filepath.Join(projectsDir, c.from, "roadmap.db")); os.IsNotExist(err) {
+		return fmt.Errorf("%w: project does not exist: %s", pluginsdk.ErrNotFound, c.from)
+	}
+	_, err := os.Stat(filepath.Join(projectsDir, c.to))
+	targetExists := err == nil
+	if targetExists && c.code != "" {
+		return fmt.Errorf("%w: --code only applies when the target project is created", pluginsdk.ErrInvalidArgument)
+	}
+
+	sourceDB, err := c.Provider.GetProjectDatabase(c.from)
+	if err != nil {
+		return fmt.Errorf("failed to open project %s: %w", c.from, err)
+	}
+	defer sourceDB.Close()
+	targetDB, err := c.Provider.GetProjectDatabase(c.to)
+	if err != nil {
+		return fmt.Errorf("failed to open project %s: %w", c.to, err)
+	}
+	defer targetDB.Close()
+
+	logger := c.Provider.GetLogger()
+	source := persistence.NewSQLiteRepositoryComposite(sourceDB, logger)
+	target := persistence.NewSQLiteRepositoryComposite(targetDB, logger)
+	service := application.NewCloneApplicationService()
+
+	empty, err := service.IsProjectEmpty(ctx, cloneRepositories(target))
+	if err != nil {
+		return err
+	}
+	if !empty && !c.force {
+		return fmt.Errorf("%w: project %s is not empty; pass --force to replace its content", pluginsdk.ErrAlreadyExists, c.to)
+	}
+
+	opts := application.CloneOptions{WithTasks: c.withTasks, WithACTemplates: c.withACTemplates}
+	var result *application.CloneResult
+	err = target.WithTx(ctx, func(repo domain.RoadmapRepository) error {
+		tx := repo.(*persistence.SQLiteRepositoryComposite)
+		if !empty {
+			if err := tx.ClearProjectData(ctx); err != nil {
+				return err
+			}
+		}
+		if !targetExists {
+			code := c.code
+			if code == "" {
+				code = generateDefaultProjectCode(c.to)
+			}
+			if err := tx.SetProjectMetadata(ctx, "project_code", code); err != nil {
+				return fmt.Errorf("failed to set project code: %w", err)
+			}
+			// The copies are numbered in the source's ID format
+			if format, err := source.GetProjectMetadata(ctx, entities.IDFormatMetadataKey); err == nil {
+				if err := tx.SetProjectMetadata(ctx, entities.IDFormatMetadataKey, format); err != nil {
+					return fmt.Errorf("failed to set ID format: %w", err)
+				}
+			}
+		}
+		var err error
+		result, err = service.Clone(ctx, cloneRepositories(source), cloneRepositories(tx), opts)
+		return err
+	})
+	if err != nil {
+		if !targetExists {
+			// Do not leave an empty project behind for a clone that never happened
+			os.RemoveAll(filepath.Join(projectsDir, c.to))
+		}
+		return fmt.Errorf("failed to clone %s into %s: %w", c.from, c.to, err)
+	}
+
+	out := pluginsdk.InfoWriter(cmdCtx)
+	fmt.Fprintf(out, "Cloned project %s into %s\n", c.from, c.to)
+	if !empty {
+		fmt.Fprintf(out, "  (previous content of %s was replaced)\n", c.to)
+	}
+	fmt.Fprintf(out, "  Roadmap:        %s (%d criteria)\n", result.RoadmapID, result.Criteria)
+	fmt.Fprintf(out, "  Tracks:         %d (%d dependencies)\n", result.Tracks, result.Dependencies)
+	fmt.Fprintf(out, "  Iterations:     %d (%d definition of done items)\n", result.Iterations, result.DoDItems)
+	if c.withTasks {
+		fmt.Fprintf(out, "  Tasks:          %d (%d ACs)\n", result.Tasks, result.ACs)
+	}
+	if c.withACTemplates {
+		fmt.Fprintf(out, "  AC templates:   %d\n", result.ACTemplates)
+	}
+
+	fmt.Fprintf(out, "\nID mapping:\n")
+	for _, mapping := range result.IDMappings {
+		fmt.Fprintf(out, "  %-10s %s -> %s\n", mapping.Kind, mapping.OldID, mapping.NewID)
+	}
+	return nil
+}
+
+// cloneRepositories selects the repositories a clone reads or writes from a composite
+func cloneRepositories(c *persistence.SQLiteRepositoryComposite) application.CloneRepositories {
+	return application.CloneRepositories{
+		Roadmap:   c.Roadmap,
+		Track:     c.Track,
+		Task:      c.Task,
+		Iteration: c.Iteration,
+		AC:        c.AC,
+		Aggregate: c.Aggregate,
+	}
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -9,6 +10,7 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
 	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
 )
 
@@ -23,6 +25,7 @@ type ProjectCreateCommand struct {
 	Provider    PluginProvider
 	projectName string
 	projectCode string
+	idFormat    string
 }
 
 func (c *ProjectCreateCommand) GetName() string {
@@ -34,7 +37,7 @@ func (c *ProjectCreateCommand) GetDescription() string {
 }
 
 func (c *ProjectCreateCommand) GetUsage() string {
-	return "dw task-manager project create <project-name> [--code <code>]"
+	return "dw task-manager project create <project-name> [--code <code>] [--id-format <template>]"
 }
 
 func (c *ProjectCreateCommand) GetHelp() string {
@@ -49,6 +52,9 @@ Arguments:
 Flags:
   --code <code>   Project code for human-readable IDs (optional, default: uppercase first 2-3 letters of project name)
                   Examples: DW, PROD, TEST
+  --id-format <template>
+                  ID format template (optional, default: {code}-{entity}-{number})
+                  See 'dw task-manager project id-format --help'
 
 Examples:
   # Create a test project with default code (TE)
@@ -60,6 +66,9 @@ Examples:
   # Create a product project with custom code
   dw task-manager project create my-product --code PROD
 
+  # Zero-padded IDs such as PROD/T/001
+  dw task-manager project create my-product --code PROD --id-format "{code}/{abbr}/{number:3}"
+
 Notes:
   - Project name must be alphanumeric with hyphens/underscores only
   - Project code is used for human-readable IDs (e.g., DW-task-1, PROD-track-5)
@@ -71,15 +80,19 @@ Notes:
 
 func (c *ProjectCreateCommand) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
 	if len(args) == 0 {
-		return fmt.Errorf("project name is required")
+		return fmt.Errorf("%w: project name is required", pluginsdk.ErrInvalidArgument)
 	}
 	c.projectName = args[0]
 
 	// Parse flags
 	for i := 1; i < len(args); i++ {
-		if args[i] == "--code" && i+1 < len(args) {
+		switch {
+		case args[i] == "--code" && i+1 < len(args):
 			c.projectCode = args[i+1]
 			i++
+		case args[i] == "--id-format" && i+1 < len(args):
+			c.idFormat = args[i+1]
+			i++
 		}
 	}
 
@@ -98,6 +111,12 @@ func (c *ProjectCreateCommand) Execute(ctx context.Context, cmdCtx pluginsdk.Com
 		return fmt.Errorf("invalid project code: must be alphanumeric uppercase (e.g., DW, PROD, TEST)")
 	}
 
+	// Validate ID format
+	idFormat, err := entities.ParseIDFormat(c.idFormat)
+	if err != nil {
+		return err
+	}
+
 	// Check if project already exists
 	projectDir := filepath.Join(c.Provider.GetWorkingDir(), ".darwinflow", "projects", c.projectName)
 	if _, err := os.Stat(projectDir); err == nil {
@@ -126,12 +145,17 @@ func (c *ProjectCreateCommand) Execute(ctx context.Context, cmdCtx pluginsdk.Com
 	if err := repo.SetProjectMetadata(ctx, "project_code", c.projectCode); err != nil {
 		return fmt.Errorf("failed to set project code: %w", err)
 	}
+	if !idFormat.IsDefault() {
+		if err := repo.SetProjectMetadata(ctx, entities.IDFormatMetadataKey, idFormat.String()); err != nil {
+			return fmt.Errorf("failed to set ID format: %w", err)
+		}
+	}
 
 	fmt.Fprintf(cmdCtx.GetStdout(), "Project created successfully: %s\n", c.projectName)
 	fmt.Fprintf(cmdCtx.GetStdout(), "Project code: %s\n", c.projectCode)
 	fmt.Fprintf(cmdCtx.GetStdout(), "Database: %s\n", filepath.Join(projectDir, "roadmap.db"))
-	fmt.Fprintf(cmdCtx.GetStdout(), "\nEntity IDs will use format: %s-<type>-<number>\n", c.projectCode)
-	fmt.Fprintf(cmdCtx.GetStdout(), "Examples: %s-task-1, %s-track-1, %s-iter-1\n", c.projectCode, c.projectCode, c.projectCode)
+	fmt.Fprintf(cmdCtx.GetStdout(), "\nEntity IDs will use format: %s\n", idFormat)
+	fmt.Fprintf(cmdCtx.GetStdout(), "Examples: %s\n", idFormatExamples(idFormat, c.projectCode))
 	fmt.Fprintf(cmdCtx.GetStdout(), "\nTo switch to this project, run:\n")
 	fmt.Fprintf(cmdCtx.GetStdout(), "  dw task-manager project switch %s\n", c.projectName)
 
@@ -165,6 +189,149 @@ func generateDefaultProjectCode(projectName string) string {
 	return "DW"
 }
 
+// idFormatExamples returns the first task, track and AC IDs of a project with format and code
+func idFormatExamples(format *entities.IDFormat, code string) string {
+	return fmt.Sprintf("%s, %s, %s", format.Format(code, "task", 1), format.Format(code, "track", 1), format.Format(code, "ac", 1))
+}
+
+// ============================================================================
+// ProjectIDFormatCommand shows or changes the ID format of a project
+// ============================================================================
+
+type ProjectIDFormatCommand struct {
+	Provider PluginProvider
+	project  string
+}
+
+func (c *ProjectIDFormatCommand) GetName() string {
+	return "project id-format"
+}
+
+func (c *ProjectIDFormatCommand) GetDescription() string {
+	return "Show or change the ID format of a project"
+}
+
+func (c *ProjectIDFormatCommand) GetUsage() string {
+	return "dw task-manager project id-format [<template>] [--project <name>]"
+}
+
+func (c *ProjectIDFormatCommand) GetHelp() string {
+	return `Shows the template new track, task, AC and ADR IDs are generated from, or
+changes it when a template is given.
+
+Placeholders:
+  {code}        Project code (DW)
+  {entity}      Entity type (task, track, ac, adr)
+  {abbr}        Entity abbreviation (T, TR, AC, ADR)
+  {number}      Sequence number
+  {number:N}    Sequence number zero-padded to N digits (1-9)
+
+A template needs one {number} and {entity} or {abbr}. Placeholders are separated
+by -, /, . or : and nothing may come before the first or after the last one.
+
+Arguments:
+  <template>         New ID format (optional; shows the current format without it)
+
+Flags:
+  --project <name>   Project name (optional, uses active project if not specified)
+
+Examples:
+  # Show the current format
+  dw task-manager project id-format
+
+  # DW/T/001, DW/TR/001, DW/AC/001
+  dw task-manager project id-format "{code}/{abbr}/{number:3}"
+
+  # Back to the default DW-task-1
+  dw task-manager project id-format "{code}-{entity}-{number}"
+
+Notes:
+  - Existing IDs are not renamed. Changing the format while IDs exist mixes
+    both styles, so a warning is printed; pick the format before the first
+    track is created
+  - Numbering continues from the highest number in either format`
+}
+
+func (c *ProjectIDFormatCommand) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	var template string
+	for i := 0; i < len(args); i++ {
+		switch {
+		case args[i] == "--project" && i+1 < len(args):
+			c.project = args[i+1]
+			i++
+		case strings.HasPrefix(args[i], "--"):
+			return fmt.Errorf("%w: unknown flag %q", pluginsdk.ErrInvalidArgument, args[i])
+		case template == "":
+			template = args[i]
+		default:
+			return fmt.Errorf("%w: unexpected argument %q", pluginsdk.ErrInvalidArgument, args[i])
+		}
+	}
+
+	projectName := c.project
+	if projectName == "" {
+		var err error
+		projectName, err = c.Provider.GetActiveProject()
+		if err != nil {
+			return fmt.Errorf("failed to get active project: %w", err)
+		}
+	}
+
+	repo, cleanup, err := c.Provider.GetRepositoryForProject(projectName)
+	if err != nil {
+		return fmt.Errorf("failed to get repository: %w", err)
+	}
+	defer cleanup()
+
+	current, err := repo.GetProjectMetadata(ctx, entities.IDFormatMetadataKey)
+	if err != nil && !errors.Is(err, pluginsdk.ErrNotFound) {
+		return fmt.Errorf("failed to get ID format: %w", err)
+	}
+	currentFormat, err := entities.ParseIDFormat(current)
+	if err != nil {
+		return err
+	}
+	code := repo.GetProjectCode(ctx)
+	out := cmdCtx.GetStdout()
+
+	if template == "" {
+		fmt.Fprintf(out, "ID format: %s\n", currentFormat)
+		fmt.Fprintf(out, "Examples: %s\n", idFormatExamples(currentFormat, code))
+		return nil
+	}
+
+	format, err := entities.ParseIDFormat(template)
+	if err != nil {
+		return err
+	}
+	if format.String() == currentFormat.String() {
+		fmt.Fprintf(out, "ID format unchanged: %s\n", format)
+		return nil
+	}
+
+	// Existing IDs keep their format, so changing it mid-project mixes styles
+	hasIDs := false
+	for _, entityType := range []string{"track", "task", "ac", "adr"} {
+		next, err := repo.GetNextSequenceNumber(ctx, entityType)
+		if err != nil {
+			return fmt.Errorf("failed to check existing IDs: %w", err)
+		}
+		hasIDs = hasIDs || next > 1
+	}
+
+	if err := repo.SetProjectMetadata(ctx, entities.IDFormatMetadataKey, format.String()); err != nil {
+		return fmt.Errorf("failed to set ID format: %w", err)
+	}
+
+	fmt.Fprintf(out, "ID format of project %s changed: %s -> %s\n", projectName, currentFormat, format)
+	fmt.Fprintf(out, "Examples: %s\n", idFormatExamples(format, code))
+	if hasIDs {
+		fmt.Fprintf(out, "\nWarning: the project already has IDs in the format %s; they are not renamed,\n", currentFormat)
+		fmt.Fprintf(out, "so old and new IDs will look different. Numbering continues from the existing IDs.\n")
+	}
+	return nil
+}
+
 // ============================================================================
 // ProjectListCommand lists all projects
 // ============================================================================
@@ -307,7 +474,7 @@ Notes:
 
 func (c *ProjectSwitchCommand) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
 	if len(args) == 0 {
-		return fmt.Errorf("project name is required")
+		return fmt.Errorf("%w: project name is required", pluginsdk.ErrInvalidArgument)
 	}
 	c.projectName = args[0]
 
@@ -428,7 +595,7 @@ Notes:
 
 func (c *ProjectDeleteCommand) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
 	if len(args) == 0 {
-		return fmt.Errorf("project name is required")
+		return fmt.Errorf("%w: project name is required", pluginsdk.ErrInvalidArgument)
 	}
 	c.projectName = args[0]
 
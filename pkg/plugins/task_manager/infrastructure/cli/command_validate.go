@@ -0,0 +1,195 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/services"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/infrastructure/persistence"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// ============================================================================
+// ValidateCommand checks the referential integrity of a whole project
+// ============================================================================
+
+type ValidateCommand struct {
+	Provider PluginProvider
+	project  string
+	fix      bool
+}
+
+func (c *ValidateCommand) GetName() string {
+	return "validate"
+}
+
+func (c *ValidateCommand) GetDescription() string {
+	return "Check the project for dangling references, invalid statuses and dependency cycles"
+}
+
+func (c *ValidateCommand) GetUsage() string {
+	return "dw task-manager validate [--fix] [--project <name>]"
+}
+
+func (c *ValidateCommand) GetHelp() string {
+	return `Scans the whole project for data that drifted out of shape:
+
+  - Dangling references: tracks of a missing roadmap, tasks of a missing track,
+    ACs of a missing task, ADRs of a missing track, and track dependencies,
+    iteration tasks, task gates, ADR task links and AC tags that point at a
+    deleted entity
+  - Statuses outside the allowed values (see 'dw task-manager check-statuses')
+  - Track dependency cycles
+
+Prints a clean bill of health when nothing is wrong.
+
+With --fix, in a single transaction:
+  - Dangling association rows (track dependencies, iteration tasks, task
+    gates, ADR task links, AC tags) are removed, as are notes of missing
+    tasks and DoD items of missing iterations
+  - Statuses that differ from an allowed one only in case, spaces or '-'
+    versus '_' are rewritten to it
+Tracks, tasks, ACs and ADRs with a dangling reference hold content and are
+only reported; move or delete them by hand. Cycles are broken with
+'dw task-manager track remove-dependency'.
+
+Flags:
+  --fix               Remove dangling associations and repair statuses
+  --project <name>    Project name (optional, uses active project if not specified)
+
+Exits non-zero while issues remain, so it can run in CI.
+
+Examples:
+  dw task-manager validate
+  dw task-manager validate --fix`
+}
+
+func (c *ValidateCommand) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	// Parse flags
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--project":
+			if i+1 < len(args) {
+				c.project = args[i+1]
+				i++
+			}
+		case "--fix":
+			c.fix = true
+		default:
+			return fmt.Errorf("%w: unknown argument %q", pluginsdk.ErrInvalidArgument, args[i])
+		}
+	}
+
+	projectName := c.project
+	if projectName == "" {
+		var err error
+		projectName, err = c.Provider.GetActiveProject()
+		if err != nil {
+			return fmt.Errorf("failed to get active project: %w", err)
+		}
+	}
+
+	db, err := c.Provider.GetProjectDatabase(projectName)
+	if err != nil {
+		return fmt.Errorf("failed to open project %s: %w", projectName, err)
+	}
+	defer db.Close()
+	repo := persistence.NewSQLiteRepositoryComposite(db, c.Provider.GetLogger())
+
+	dangling, err := repo.FindDanglingReferences(ctx)
+	if err != nil {
+		return err
+	}
+	invalid, err := repo.FindInvalidStatuses(ctx)
+	if err != nil {
+		return err
+	}
+	graph, err := repo.TrackDependencyGraph(ctx)
+	if err != nil {
+		return err
+	}
+	cycles := services.NewDependencyService().FindCycles(graph)
+
+	out := pluginsdk.InfoWriter(cmdCtx)
+	if len(dangling) == 0 && len(invalid) == 0 && len(cycles) == 0 {
+		fmt.Fprintf(out, "Project %s is healthy: no dangling references, invalid statuses or dependency cycles\n", projectName)
+		return nil
+	}
+
+	fixed := 0
+	if c.fix {
+		err := repo.WithTx(ctx, func(txRepo domain.RoadmapRepository) error {
+			tx := txRepo.(*persistence.SQLiteRepositoryComposite)
+			removed, err := tx.RemoveDanglingReferences(ctx)
+			if err != nil {
+				return err
+			}
+			fixed += removed
+			for _, row := range invalid {
+				if suggestion, ok := entities.NormalizeStatus(row.Status, row.Allowed); ok {
+					if err := tx.RepairStatus(ctx, row.Kind, row.ID, suggestion); err != nil {
+						return err
+					}
+					fixed++
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed to fix project %s: %w", projectName, err)
+		}
+	}
+
+	remaining := 0
+	if len(dangling) > 0 {
+		fmt.Fprintln(out, "Dangling references:")
+		for _, row := range dangling {
+			switch {
+			case row.Removable && c.fix:
+				fmt.Fprintf(out, "  removed    %-16s %-28s %s does not exist\n", row.Kind, row.ID, row.MissingRef)
+			case row.Removable:
+				remaining++
+				fmt.Fprintf(out, "  dangling   %-16s %-28s %s does not exist (--fix removes it)\n", row.Kind, row.ID, row.MissingRef)
+			default:
+				remaining++
+				fmt.Fprintf(out, "  dangling   %-16s %-28s %s does not exist\n", row.Kind, row.ID, row.MissingRef)
+			}
+		}
+	}
+
+	if len(invalid) > 0 {
+		fmt.Fprintln(out, "Invalid statuses:")
+		for _, row := range invalid {
+			suggestion, ok := entities.NormalizeStatus(row.Status, row.Allowed)
+			switch {
+			case ok && c.fix:
+				fmt.Fprintf(out, "  fixed      %-16s %-28s %q -> %q\n", row.Kind, row.ID, row.Status, suggestion)
+			case ok:
+				remaining++
+				fmt.Fprintf(out, "  invalid    %-16s %-28s %q (--fix sets %q)\n", row.Kind, row.ID, row.Status, suggestion)
+			default:
+				remaining++
+				fmt.Fprintf(out, "  invalid    %-16s %-28s %q (allowed: %s)\n", row.Kind, row.ID, row.Status, strings.Join(row.Allowed, ", "))
+			}
+		}
+	}
+
+	if len(cycles) > 0 {
+		fmt.Fprintln(out, "Dependency cycles:")
+		for _, cycle := range cycles {
+			remaining++
+			fmt.Fprintf(out, "  cycle      %s\n", strings.Join(cycle, ", "))
+		}
+	}
+
+	if fixed > 0 {
+		fmt.Fprintf(out, "Fixed %d issue(s) in project %s\n", fixed, projectName)
+	}
+	if remaining > 0 {
+		return fmt.Errorf("%d integrity issue(s) remain in project %s", remaining, projectName)
+	}
+	return nil
+}
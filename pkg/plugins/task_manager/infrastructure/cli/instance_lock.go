@@ -0,0 +1,99 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// InstanceLock is an advisory, pid-based lockfile that keeps a single mutating
+// TUI instance per project. A lock left behind by a crashed process is detected
+// (its pid is no longer running) and taken over. How a pid is checked depends on
+// the platform: see processAlive in instance_lock_unix.go and instance_lock_windows.go.
+type InstanceLock struct {
+	path string
+	pid  int
+}
+
+// InstanceLockedError is returned when another live process holds the lock
+type InstanceLockedError struct {
+	PID  int
+	Path string
+}
+
+func (e *InstanceLockedError) Error() string {
+	return fmt.Sprintf("another task-manager TUI is running (pid %d); use --read-only to view, or remove %s if that process is gone", e.PID, e.Path)
+}
+
+// AcquireInstanceLock creates the lockfile at path containing the current pid.
+// Returns *InstanceLockedError if the lock is held by another running process.
+func AcquireInstanceLock(path string) (*InstanceLock, error) {
+	pid := os.Getpid()
+
+	// Two attempts: the second runs after removing a stale lock
+	for attempt := 0; attempt < 2; attempt++ {
+		file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+		if err == nil {
+			_, writeErr := file.WriteString(strconv.Itoa(pid))
+			closeErr := file.Close()
+			if writeErr != nil || closeErr != nil {
+				os.Remove(path)
+				return nil, fmt.Errorf("failed to write lock file: %w", errors.Join(writeErr, closeErr))
+			}
+			return &InstanceLock{path: path, pid: pid}, nil
+		}
+		if !os.IsExist(err) {
+			return nil, fmt.Errorf("failed to create lock file: %w", err)
+		}
+
+		holder, readErr := readLockPID(path)
+		if readErr == nil && holder != pid && processAlive(holder) {
+			return nil, &InstanceLockedError{PID: holder, Path: path}
+		}
+
+		// Stale lock (dead pid, unreadable content, or our own pid): take it over
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return nil, fmt.Errorf("failed to remove stale lock file: %w", err)
+		}
+	}
+
+	return nil, fmt.Errorf("failed to acquire lock file %s", path)
+}
+
+// Release removes the lockfile if it is still owned by this process.
+// It is safe to call more than once.
+func (l *InstanceLock) Release() error {
+	if l == nil {
+		return nil
+	}
+	holder, err := readLockPID(l.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to read lock file: %w", err)
+	}
+	if holder != l.pid {
+		// Lock was taken over by another process; leave it alone
+		return nil
+	}
+	if err := os.Remove(l.path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove lock file: %w", err)
+	}
+	return nil
+}
+
+// readLockPID reads the pid stored in a lockfile
+func readLockPID(path string) (int, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	pid, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	if err != nil || pid <= 0 {
+		return 0, fmt.Errorf("invalid pid in lock file %s", path)
+	}
+	return pid, nil
+}
@@ -0,0 +1,119 @@
+package cli_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/infrastructure/cli"
+)
+
+func TestAcquireInstanceLock_AcquireAndRelease(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tui.lock")
+
+	lock, err := cli.AcquireInstanceLock(path)
+	if err != nil {
+		t.Fatalf("failed to acquire lock: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("expected lock file to exist: %v", err)
+	}
+	if string(data) != strconv.Itoa(os.Getpid()) {
+		t.Errorf("expected lock file to contain pid %d, got %q", os.Getpid(), string(data))
+	}
+
+	if err := lock.Release(); err != nil {
+		t.Fatalf("failed to release lock: %v", err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("expected lock file to be removed on release")
+	}
+
+	// Releasing twice is a no-op
+	if err := lock.Release(); err != nil {
+		t.Errorf("second release should succeed, got: %v", err)
+	}
+}
+
+func TestAcquireInstanceLock_HeldByRunningProcess(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tui.lock")
+
+	// The parent process (go test) is alive for the duration of the test
+	holder := os.Getppid()
+	if err := os.WriteFile(path, []byte(strconv.Itoa(holder)), 0644); err != nil {
+		t.Fatalf("failed to write lock file: %v", err)
+	}
+
+	_, err := cli.AcquireInstanceLock(path)
+	var lockedErr *cli.InstanceLockedError
+	if !errors.As(err, &lockedErr) {
+		t.Fatalf("expected InstanceLockedError, got: %v", err)
+	}
+	if lockedErr.PID != holder {
+		t.Errorf("expected holder pid %d, got %d", holder, lockedErr.PID)
+	}
+
+	// The other instance's lock must be left intact
+	data, _ := os.ReadFile(path)
+	if string(data) != strconv.Itoa(holder) {
+		t.Errorf("lock file should not be modified, got %q", string(data))
+	}
+}
+
+func TestAcquireInstanceLock_StaleLock(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{"dead pid", "999999999"},
+		{"garbage", "not-a-pid"},
+		{"empty", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "tui.lock")
+			if err := os.WriteFile(path, []byte(tt.content), 0644); err != nil {
+				t.Fatalf("failed to write lock file: %v", err)
+			}
+
+			lock, err := cli.AcquireInstanceLock(path)
+			if err != nil {
+				t.Fatalf("expected stale lock to be taken over, got: %v", err)
+			}
+			defer lock.Release()
+
+			data, _ := os.ReadFile(path)
+			if string(data) != strconv.Itoa(os.Getpid()) {
+				t.Errorf("expected lock file to contain our pid, got %q", string(data))
+			}
+		})
+	}
+}
+
+func TestInstanceLock_ReleaseLeavesForeignLock(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tui.lock")
+
+	lock, err := cli.AcquireInstanceLock(path)
+	if err != nil {
+		t.Fatalf("failed to acquire lock: %v", err)
+	}
+
+	// Simulate another process having taken over the lock
+	other := strconv.Itoa(os.Getppid())
+	if err := os.WriteFile(path, []byte(other), 0644); err != nil {
+		t.Fatalf("failed to overwrite lock file: %v", err)
+	}
+
+	if err := lock.Release(); err != nil {
+		t.Fatalf("failed to release lock: %v", err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil || string(data) != other {
+		t.Errorf("expected foreign lock to be kept, got %q (err %v)", string(data), err)
+	}
+}
@@ -0,0 +1,21 @@
+//go:build !windows
+
+package cli
+
+import (
+	"errors"
+	"os"
+	"syscall"
+)
+
+// processAlive reports whether a process with the given pid is running.
+// Signal 0 performs error checking only; EPERM means the process exists
+// but belongs to another user.
+func processAlive(pid int) bool {
+	process, err := os.FindProcess(pid)
+	if err != nil {
+		return false
+	}
+	err = process.Signal(syscall.Signal(0))
+	return err == nil || errors.Is(err, syscall.EPERM)
+}
@@ -0,0 +1,29 @@
+//go:build windows
+
+package cli
+
+import (
+	"errors"
+
+	"golang.org/x/sys/windows"
+)
+
+// stillActive is the exit code GetExitCodeProcess reports for a running process (STILL_ACTIVE)
+const stillActive = 259
+
+// processAlive reports whether a process with the given pid is running.
+// Windows has no signal 0, so the process is opened and its exit code checked;
+// access denied means the process exists but belongs to another user.
+func processAlive(pid int) bool {
+	handle, err := windows.OpenProcess(windows.PROCESS_QUERY_LIMITED_INFORMATION, false, uint32(pid))
+	if err != nil {
+		return errors.Is(err, windows.ERROR_ACCESS_DENIED)
+	}
+	defer windows.CloseHandle(handle)
+
+	var code uint32
+	if err := windows.GetExitCodeProcess(handle, &code); err != nil {
+		return false
+	}
+	return code == stillActive
+}
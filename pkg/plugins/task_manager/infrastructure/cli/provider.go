@@ -26,6 +26,9 @@ type PluginProvider interface {
 	// GetProjectDatabase returns a database connection for the specified project
 	GetProjectDatabase(projectName string) (*sql.DB, error)
 
+	// GetProjectDatabasePath returns the path of the specified project's database file
+	GetProjectDatabasePath(projectName string) string
+
 	// GetRepositoryForProject returns a repository for the specified project
 	// Returns the repository, a cleanup function, and an error
 	GetRepositoryForProject(projectName string) (domain.RoadmapRepository, func(), error)
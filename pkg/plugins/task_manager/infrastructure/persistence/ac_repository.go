@@ -3,8 +3,10 @@ package persistence
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/repositories"
@@ -16,15 +18,17 @@ var _ repositories.AcceptanceCriteriaRepository = (*SQLiteAcceptanceCriteriaRepo
 
 // SQLiteAcceptanceCriteriaRepository implements repositories.AcceptanceCriteriaRepository using SQLite as the backend.
 type SQLiteAcceptanceCriteriaRepository struct {
-	DB     *sql.DB
-	logger pluginsdk.Logger
+	DB          DBTX
+	logger      pluginsdk.Logger
+	retryPolicy WriteRetryPolicy
 }
 
 // NewSQLiteAcceptanceCriteriaRepository creates a new SQLite-backed repository.
-func NewSQLiteAcceptanceCriteriaRepository(db *sql.DB, logger pluginsdk.Logger) *SQLiteAcceptanceCriteriaRepository {
+func NewSQLiteAcceptanceCriteriaRepository(db DBTX, logger pluginsdk.Logger) *SQLiteAcceptanceCriteriaRepository {
 	return &SQLiteAcceptanceCriteriaRepository{
-		DB:     db,
-		logger: logger,
+		DB:          db,
+		logger:      logger,
+		retryPolicy: DefaultWriteRetryPolicy(),
 	}
 }
 
@@ -33,7 +37,18 @@ func NewSQLiteAcceptanceCriteriaRepository(db *sql.DB, logger pluginsdk.Logger)
 // ============================================================================
 
 // SaveAC persists a new acceptance criterion to storage.
+// Retried while the database is busy or locked.
 func (r *SQLiteAcceptanceCriteriaRepository) SaveAC(ctx context.Context, ac *entities.AcceptanceCriteriaEntity) error {
+	if err := entities.ValidateACStatus(string(ac.Status)); err != nil {
+		return err
+	}
+	return retryWrite(ctx, r.DB, r.retryPolicy, func() error {
+		return r.saveAC(ctx, ac)
+	})
+}
+
+// saveAC performs a single SaveAC attempt.
+func (r *SQLiteAcceptanceCriteriaRepository) saveAC(ctx context.Context, ac *entities.AcceptanceCriteriaEntity) error {
 	// Check if AC already exists
 	var exists int
 	err := r.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM acceptance_criteria WHERE id = ?", ac.ID).Scan(&exists)
@@ -66,6 +81,65 @@ func (r *SQLiteAcceptanceCriteriaRepository) SaveAC(ctx context.Context, ac *ent
 	return nil
 }
 
+// SaveACs persists several new acceptance criteria in a single transaction.
+// Retried while the database is busy or locked.
+func (r *SQLiteAcceptanceCriteriaRepository) SaveACs(ctx context.Context, acs []*entities.AcceptanceCriteriaEntity) error {
+	for _, ac := range acs {
+		if err := entities.ValidateACStatus(string(ac.Status)); err != nil {
+			return err
+		}
+	}
+	return retryWrite(ctx, r.DB, r.retryPolicy, func() error {
+		return r.saveACs(ctx, acs)
+	})
+}
+
+// saveACs performs a single SaveACs attempt.
+func (r *SQLiteAcceptanceCriteriaRepository) saveACs(ctx context.Context, acs []*entities.AcceptanceCriteriaEntity) error {
+	tx, err := beginTx(ctx, r.DB)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	checkedTasks := make(map[string]bool)
+	for _, ac := range acs {
+		var exists int
+		if err := tx.QueryRowContext(ctx, "SELECT COUNT(*) FROM acceptance_criteria WHERE id = ?", ac.ID).Scan(&exists); err != nil {
+			return fmt.Errorf("failed to check AC existence: %w", err)
+		}
+		if exists > 0 {
+			return fmt.Errorf("%w: AC %s already exists", pluginsdk.ErrAlreadyExists, ac.ID)
+		}
+
+		if !checkedTasks[ac.TaskID] {
+			var taskExists int
+			if err := tx.QueryRowContext(ctx, "SELECT COUNT(*) FROM tasks WHERE id = ?", ac.TaskID).Scan(&taskExists); err != nil {
+				return fmt.Errorf("failed to verify task: %w", err)
+			}
+			if taskExists == 0 {
+				return fmt.Errorf("%w: task %s not found", pluginsdk.ErrNotFound, ac.TaskID)
+			}
+			checkedTasks[ac.TaskID] = true
+		}
+
+		_, err = tx.ExecContext(
+			ctx,
+			"INSERT INTO acceptance_criteria (id, task_id, description, verification_type, status, notes, testing_instructions, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+			ac.ID, ac.TaskID, ac.Description, string(ac.VerificationType), string(ac.Status), ac.Notes, ac.TestingInstructions, ac.CreatedAt, ac.UpdatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to insert AC %s: %w", ac.ID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
 // GetAC retrieves an acceptance criterion by its ID.
 func (r *SQLiteAcceptanceCriteriaRepository) GetAC(ctx context.Context, id string) (*entities.AcceptanceCriteriaEntity, error) {
 	var ac entities.AcceptanceCriteriaEntity
@@ -125,7 +199,18 @@ func (r *SQLiteAcceptanceCriteriaRepository) ListAC(ctx context.Context, taskID
 }
 
 // UpdateAC updates an existing acceptance criterion.
+// Retried while the database is busy or locked.
 func (r *SQLiteAcceptanceCriteriaRepository) UpdateAC(ctx context.Context, ac *entities.AcceptanceCriteriaEntity) error {
+	if err := entities.ValidateACStatus(string(ac.Status)); err != nil {
+		return err
+	}
+	return retryWrite(ctx, r.DB, r.retryPolicy, func() error {
+		return r.updateAC(ctx, ac)
+	})
+}
+
+// updateAC performs a single UpdateAC attempt.
+func (r *SQLiteAcceptanceCriteriaRepository) updateAC(ctx context.Context, ac *entities.AcceptanceCriteriaEntity) error {
 	result, err := r.DB.ExecContext(
 		ctx,
 		"UPDATE acceptance_criteria SET task_id = ?, description = ?, verification_type = ?, status = ?, notes = ?, testing_instructions = ?, updated_at = ? WHERE id = ?",
@@ -147,9 +232,23 @@ func (r *SQLiteAcceptanceCriteriaRepository) UpdateAC(ctx context.Context, ac *e
 	return nil
 }
 
-// DeleteAC removes an acceptance criterion from storage.
+// DeleteAC removes an acceptance criterion and its tags from storage.
+// Retried while the database is busy or locked.
 func (r *SQLiteAcceptanceCriteriaRepository) DeleteAC(ctx context.Context, id string) error {
-	result, err := r.DB.ExecContext(ctx, "DELETE FROM acceptance_criteria WHERE id = ?", id)
+	return retryWrite(ctx, r.DB, r.retryPolicy, func() error {
+		return r.deleteAC(ctx, id)
+	})
+}
+
+// deleteAC performs a single DeleteAC attempt.
+func (r *SQLiteAcceptanceCriteriaRepository) deleteAC(ctx context.Context, id string) error {
+	tx, err := beginTx(ctx, r.DB)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, "DELETE FROM acceptance_criteria WHERE id = ?", id)
 	if err != nil {
 		return fmt.Errorf("failed to delete AC: %w", err)
 	}
@@ -163,6 +262,18 @@ func (r *SQLiteAcceptanceCriteriaRepository) DeleteAC(ctx context.Context, id st
 		return fmt.Errorf("%w: AC %s not found", pluginsdk.ErrNotFound, id)
 	}
 
+	// AC IDs are reused, so tags and task gates must not outlive their AC
+	if _, err := tx.ExecContext(ctx, "DELETE FROM ac_tags WHERE ac_id = ?", id); err != nil {
+		return fmt.Errorf("failed to delete AC tags: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM task_ac_gates WHERE ac_id = ?", id); err != nil {
+		return fmt.Errorf("failed to delete task gates: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
 	return nil
 }
 
@@ -211,6 +322,24 @@ func (r *SQLiteAcceptanceCriteriaRepository) ListACByIteration(ctx context.Conte
 
 // ListFailedAC returns all acceptance criteria with status "failed".
 func (r *SQLiteAcceptanceCriteriaRepository) ListFailedAC(ctx context.Context, filters entities.ACFilters) ([]*entities.AcceptanceCriteriaEntity, error) {
+	acs, err := r.listFilteredAC(ctx, filters, entities.ACStatusFailed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query failed ACs: %w", err)
+	}
+	return acs, nil
+}
+
+// ListACs returns the acceptance criteria matching the filters in a single query.
+func (r *SQLiteAcceptanceCriteriaRepository) ListACs(ctx context.Context, filters entities.ACFilters) ([]*entities.AcceptanceCriteriaEntity, error) {
+	acs, err := r.listFilteredAC(ctx, filters, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to query ACs: %w", err)
+	}
+	return acs, nil
+}
+
+// listFilteredAC queries the ACs matching the filters and, when status is set, that status
+func (r *SQLiteAcceptanceCriteriaRepository) listFilteredAC(ctx context.Context, filters entities.ACFilters, status entities.AcceptanceCriteriaStatus) ([]*entities.AcceptanceCriteriaEntity, error) {
 	query := `SELECT ac.id, ac.task_id, ac.description, ac.verification_type, ac.status, ac.notes, ac.testing_instructions, ac.created_at, ac.updated_at
 		      FROM acceptance_criteria ac`
 
@@ -218,9 +347,10 @@ func (r *SQLiteAcceptanceCriteriaRepository) ListFailedAC(ctx context.Context, f
 	var conditions []string
 	var args []interface{}
 
-	// Base condition: status = failed
-	conditions = append(conditions, "ac.status = ?")
-	args = append(args, string(entities.ACStatusFailed))
+	if status != "" {
+		conditions = append(conditions, "ac.status = ?")
+		args = append(args, string(status))
+	}
 
 	// Add iteration filter
 	if filters.IterationNum != nil {
@@ -253,7 +383,7 @@ func (r *SQLiteAcceptanceCriteriaRepository) ListFailedAC(ctx context.Context, f
 
 	rows, err := r.DB.QueryContext(ctx, query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query failed ACs: %w", err)
+		return nil, err
 	}
 	defer rows.Close()
 
@@ -277,3 +407,346 @@ func (r *SQLiteAcceptanceCriteriaRepository) ListFailedAC(ctx context.Context, f
 
 	return acs, nil
 }
+
+// maxIDsPerQuery bounds the IDs bound into one "IN (?, ...)" list. SQLite builds before
+// 3.32 accept at most 999 parameters per statement.
+const maxIDsPerQuery = 500
+
+// CountACProgressByTask counts the acceptance criteria of each of the given tasks with one
+// grouped query per batch of maxIDsPerQuery tasks. Tasks without ACs are left out of the map.
+func (r *SQLiteAcceptanceCriteriaRepository) CountACProgressByTask(ctx context.Context, taskIDs []string) (map[string]entities.ACProgress, error) {
+	progress := make(map[string]entities.ACProgress)
+	for start := 0; start < len(taskIDs); start += maxIDsPerQuery {
+		end := start + maxIDsPerQuery
+		if end > len(taskIDs) {
+			end = len(taskIDs)
+		}
+		if err := r.countACProgress(ctx, taskIDs[start:end], progress); err != nil {
+			return nil, err
+		}
+	}
+	return progress, nil
+}
+
+// countACProgress adds the AC counts of taskIDs to progress
+func (r *SQLiteAcceptanceCriteriaRepository) countACProgress(ctx context.Context, taskIDs []string, progress map[string]entities.ACProgress) error {
+	args := make([]interface{}, len(taskIDs))
+	for i, id := range taskIDs {
+		args[i] = id
+	}
+	query := fmt.Sprintf(
+		`SELECT task_id, COUNT(*),
+		        SUM(CASE WHEN status IN ('%s', '%s', '%s') THEN 1 ELSE 0 END),
+		        SUM(CASE WHEN status = '%s' THEN 1 ELSE 0 END)
+		 FROM acceptance_criteria
+		 WHERE task_id IN (%s)
+		 GROUP BY task_id`,
+		entities.ACStatusVerified, entities.ACStatusAutomaticallyVerified, entities.ACStatusSkipped,
+		entities.ACStatusFailed,
+		strings.TrimSuffix(strings.Repeat("?, ", len(taskIDs)), ", "),
+	)
+
+	rows, err := r.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to count ACs by task: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var taskID string
+		var p entities.ACProgress
+		if err := rows.Scan(&taskID, &p.Total, &p.Done, &p.Failed); err != nil {
+			return fmt.Errorf("failed to scan AC counts: %w", err)
+		}
+		progress[taskID] = p
+	}
+
+	if err = rows.Err(); err != nil {
+		return fmt.Errorf("error iterating AC counts: %w", err)
+	}
+
+	return nil
+}
+
+// ListTaskACCoverage returns every task matching the filters with the progress of its
+// acceptance criteria, limited to roadmapID's tracks unless it is empty. The LEFT JOIN
+// keeps tasks without ACs, with zero counts.
+func (r *SQLiteAcceptanceCriteriaRepository) ListTaskACCoverage(ctx context.Context, filters entities.ACFilters, roadmapID string) ([]*entities.TaskACCoverage, error) {
+	query := fmt.Sprintf(
+		`SELECT t.id, t.track_id, t.title, t.status, COUNT(ac.id),
+		        COALESCE(SUM(CASE WHEN ac.status IN ('%s', '%s', '%s') THEN 1 ELSE 0 END), 0),
+		        COALESCE(SUM(CASE WHEN ac.status = '%s' THEN 1 ELSE 0 END), 0)
+		 FROM tasks t
+		 LEFT JOIN acceptance_criteria ac ON ac.task_id = t.id`,
+		entities.ACStatusVerified, entities.ACStatusAutomaticallyVerified, entities.ACStatusSkipped,
+		entities.ACStatusFailed,
+	)
+
+	var conditions []string
+	var args []interface{}
+	if filters.IterationNum != nil {
+		query += " JOIN iteration_tasks it ON it.task_id = t.id"
+		conditions = append(conditions, "it.iteration_number = ?")
+		args = append(args, *filters.IterationNum)
+	}
+	if filters.TrackID != "" {
+		conditions = append(conditions, "t.track_id = ?")
+		args = append(args, filters.TrackID)
+	}
+	if filters.TaskID != "" {
+		conditions = append(conditions, "t.id = ?")
+		args = append(args, filters.TaskID)
+	}
+	if roadmapID != "" {
+		conditions = append(conditions, "t.track_id IN (SELECT id FROM tracks WHERE roadmap_id = ?)")
+		args = append(args, roadmapID)
+	}
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " GROUP BY t.id ORDER BY t.track_id, t.rank, t.created_at"
+
+	rows, err := r.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query AC coverage: %w", err)
+	}
+	defer rows.Close()
+
+	var coverage []*entities.TaskACCoverage
+	for rows.Next() {
+		var c entities.TaskACCoverage
+		if err := rows.Scan(&c.TaskID, &c.TrackID, &c.Title, &c.Status, &c.ACs.Total, &c.ACs.Done, &c.ACs.Failed); err != nil {
+			return nil, fmt.Errorf("failed to scan AC coverage: %w", err)
+		}
+		coverage = append(coverage, &c)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating AC coverage: %w", err)
+	}
+
+	return coverage, nil
+}
+
+// ============================================================================
+// AC Tag Operations
+// ============================================================================
+
+// AddACTag tags an acceptance criterion.
+func (r *SQLiteAcceptanceCriteriaRepository) AddACTag(ctx context.Context, acID, tag string) error {
+	var exists int
+	err := r.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM acceptance_criteria WHERE id = ?", acID).Scan(&exists)
+	if err != nil {
+		return fmt.Errorf("failed to check AC existence: %w", err)
+	}
+	if exists == 0 {
+		return fmt.Errorf("%w: AC %s not found", pluginsdk.ErrNotFound, acID)
+	}
+
+	result, err := r.DB.ExecContext(ctx, "INSERT OR IGNORE INTO ac_tags (ac_id, tag) VALUES (?, ?)", acID, tag)
+	if err != nil {
+		return fmt.Errorf("failed to add AC tag: %w", err)
+	}
+	if added, err := result.RowsAffected(); err == nil && added > 0 {
+		return r.touchAC(ctx, acID)
+	}
+	return nil
+}
+
+// RemoveACTag removes a tag from an acceptance criterion.
+func (r *SQLiteAcceptanceCriteriaRepository) RemoveACTag(ctx context.Context, acID, tag string) error {
+	result, err := r.DB.ExecContext(ctx, "DELETE FROM ac_tags WHERE ac_id = ? AND tag = ?", acID, tag)
+	if err != nil {
+		return fmt.Errorf("failed to remove AC tag: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("%w: AC %s has no tag %s", pluginsdk.ErrNotFound, acID, tag)
+	}
+
+	return r.touchAC(ctx, acID)
+}
+
+// touchAC bumps the updated_at of an acceptance criterion whose tags changed, so
+// that sync export picks up the new tags with the criterion.
+func (r *SQLiteAcceptanceCriteriaRepository) touchAC(ctx context.Context, acID string) error {
+	if _, err := r.DB.ExecContext(ctx, "UPDATE acceptance_criteria SET updated_at = ? WHERE id = ?", time.Now().UTC(), acID); err != nil {
+		return fmt.Errorf("failed to update AC timestamp: %w", err)
+	}
+	return nil
+}
+
+// ListACTags returns the tags of an acceptance criterion in alphabetical order.
+func (r *SQLiteAcceptanceCriteriaRepository) ListACTags(ctx context.Context, acID string) ([]string, error) {
+	rows, err := r.DB.QueryContext(ctx, "SELECT tag FROM ac_tags WHERE ac_id = ? ORDER BY tag", acID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query AC tags: %w", err)
+	}
+	defer rows.Close()
+
+	tags := []string{}
+	for rows.Next() {
+		var tag string
+		if err := rows.Scan(&tag); err != nil {
+			return nil, fmt.Errorf("failed to scan AC tag: %w", err)
+		}
+		tags = append(tags, tag)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating AC tags: %w", err)
+	}
+
+	return tags, nil
+}
+
+// ListTaggedAC returns the acceptance criteria of a task that have the given tag.
+func (r *SQLiteAcceptanceCriteriaRepository) ListTaggedAC(ctx context.Context, taskID, tag string) ([]*entities.AcceptanceCriteriaEntity, error) {
+	rows, err := r.DB.QueryContext(
+		ctx,
+		`SELECT ac.id, ac.task_id, ac.description, ac.verification_type, ac.status, ac.notes, ac.testing_instructions, ac.created_at, ac.updated_at
+		FROM acceptance_criteria ac
+		INNER JOIN ac_tags t ON t.ac_id = ac.id
+		WHERE ac.task_id = ? AND t.tag = ?
+		ORDER BY ac.created_at ASC`,
+		taskID, tag,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query tagged ACs: %w", err)
+	}
+	defer rows.Close()
+
+	acs := []*entities.AcceptanceCriteriaEntity{}
+	for rows.Next() {
+		var ac entities.AcceptanceCriteriaEntity
+		var testingInstructions sql.NullString
+		err := rows.Scan(&ac.ID, &ac.TaskID, &ac.Description, (*string)(&ac.VerificationType), (*string)(&ac.Status), &ac.Notes, &testingInstructions, &ac.CreatedAt, &ac.UpdatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan AC: %w", err)
+		}
+		if testingInstructions.Valid {
+			ac.TestingInstructions = testingInstructions.String
+		}
+		acs = append(acs, &ac)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating ACs: %w", err)
+	}
+
+	return acs, nil
+}
+
+// ============================================================================
+// AC Template Operations
+// ============================================================================
+
+// SaveACTemplate persists a new AC template.
+func (r *SQLiteAcceptanceCriteriaRepository) SaveACTemplate(ctx context.Context, template *entities.ACTemplateEntity) error {
+	var exists int
+	err := r.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM ac_templates WHERE name = ?", template.Name).Scan(&exists)
+	if err != nil {
+		return fmt.Errorf("failed to check AC template existence: %w", err)
+	}
+	if exists > 0 {
+		return fmt.Errorf("%w: AC template %s already exists", pluginsdk.ErrAlreadyExists, template.Name)
+	}
+
+	items, err := json.Marshal(template.Items)
+	if err != nil {
+		return fmt.Errorf("failed to encode AC template items: %w", err)
+	}
+
+	_, err = r.DB.ExecContext(
+		ctx,
+		"INSERT INTO ac_templates (name, items, created_at, updated_at) VALUES (?, ?, ?, ?)",
+		template.Name, string(items), template.CreatedAt, template.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert AC template: %w", err)
+	}
+
+	return nil
+}
+
+// GetACTemplate retrieves an AC template by its name.
+func (r *SQLiteAcceptanceCriteriaRepository) GetACTemplate(ctx context.Context, name string) (*entities.ACTemplateEntity, error) {
+	row := r.DB.QueryRowContext(
+		ctx,
+		"SELECT name, items, created_at, updated_at FROM ac_templates WHERE name = ?",
+		name,
+	)
+
+	template, err := scanACTemplate(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("%w: AC template %s not found", pluginsdk.ErrNotFound, name)
+		}
+		return nil, fmt.Errorf("failed to query AC template: %w", err)
+	}
+
+	return template, nil
+}
+
+// ListACTemplates returns all AC templates ordered by name.
+func (r *SQLiteAcceptanceCriteriaRepository) ListACTemplates(ctx context.Context) ([]*entities.ACTemplateEntity, error) {
+	rows, err := r.DB.QueryContext(ctx, "SELECT name, items, created_at, updated_at FROM ac_templates ORDER BY name ASC")
+	if err != nil {
+		return nil, fmt.Errorf("failed to query AC templates: %w", err)
+	}
+	defer rows.Close()
+
+	templates := []*entities.ACTemplateEntity{}
+	for rows.Next() {
+		template, err := scanACTemplate(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan AC template: %w", err)
+		}
+		templates = append(templates, template)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating AC templates: %w", err)
+	}
+
+	return templates, nil
+}
+
+// DeleteACTemplate removes an AC template from storage.
+func (r *SQLiteAcceptanceCriteriaRepository) DeleteACTemplate(ctx context.Context, name string) error {
+	result, err := r.DB.ExecContext(ctx, "DELETE FROM ac_templates WHERE name = ?", name)
+	if err != nil {
+		return fmt.Errorf("failed to delete AC template: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("%w: AC template %s not found", pluginsdk.ErrNotFound, name)
+	}
+
+	return nil
+}
+
+// scanACTemplate scans a single ac_templates row and decodes its items.
+func scanACTemplate(row rowScanner) (*entities.ACTemplateEntity, error) {
+	var template entities.ACTemplateEntity
+	var items string
+
+	if err := row.Scan(&template.Name, &items, &template.CreatedAt, &template.UpdatedAt); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal([]byte(items), &template.Items); err != nil {
+		return nil, fmt.Errorf("failed to decode AC template items: %w", err)
+	}
+
+	return &template, nil
+}
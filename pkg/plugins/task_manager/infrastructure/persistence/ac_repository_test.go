@@ -3,6 +3,7 @@ package persistence_test
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -65,6 +66,67 @@ func TestSaveAndGetAC(t *testing.T) {
 	}
 }
 
+func TestSaveACs(t *testing.T) {
+	db := createTestDB(t)
+	defer db.Close()
+
+	roadmapRepo := persistence.NewSQLiteRoadmapRepository(db, createTestLogger())
+	trackRepo := persistence.NewSQLiteTrackRepository(db, createTestLogger())
+	taskRepo := persistence.NewSQLiteTaskRepository(db, createTestLogger())
+	acRepo := persistence.NewSQLiteAcceptanceCriteriaRepository(db, createTestLogger())
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	// Setup
+	roadmap, _ := entities.NewRoadmapEntity("roadmap-1", "vision", "criteria", now, now)
+	roadmapRepo.SaveRoadmap(ctx, roadmap)
+
+	track, _ := entities.NewTrackEntity("track-1", "roadmap-1", "Track", "", "not-started", 200, []string{}, now, now)
+	trackRepo.SaveTrack(ctx, track)
+
+	for _, id := range []string{"task-1", "task-2"} {
+		task, _ := entities.NewTaskEntity(id, "track-1", "Task", "", "todo", 200, "", now, now)
+		taskRepo.SaveTask(ctx, task)
+	}
+
+	acs := []*entities.AcceptanceCriteriaEntity{
+		entities.NewAcceptanceCriteriaEntity("ac-1", "task-1", "First", entities.VerificationTypeManual, "", now, now),
+		entities.NewAcceptanceCriteriaEntity("ac-2", "task-1", "Second", entities.VerificationTypeAutomated, "", now, now),
+		entities.NewAcceptanceCriteriaEntity("ac-3", "task-2", "Third", entities.VerificationTypeManual, "", now, now),
+	}
+	if err := acRepo.SaveACs(ctx, acs); err != nil {
+		t.Fatalf("failed to save ACs: %v", err)
+	}
+
+	task1ACs, err := acRepo.ListAC(ctx, "task-1")
+	if err != nil {
+		t.Fatalf("failed to list ACs: %v", err)
+	}
+	if len(task1ACs) != 2 {
+		t.Errorf("expected 2 ACs for task-1, got %d", len(task1ACs))
+	}
+
+	// A missing task rolls back the whole batch
+	err = acRepo.SaveACs(ctx, []*entities.AcceptanceCriteriaEntity{
+		entities.NewAcceptanceCriteriaEntity("ac-4", "task-2", "Fourth", entities.VerificationTypeManual, "", now, now),
+		entities.NewAcceptanceCriteriaEntity("ac-5", "task-99", "Fifth", entities.VerificationTypeManual, "", now, now),
+	})
+	if !errors.Is(err, pluginsdk.ErrNotFound) {
+		t.Errorf("expected ErrNotFound for missing task, got %v", err)
+	}
+	if _, err := acRepo.GetAC(ctx, "ac-4"); !errors.Is(err, pluginsdk.ErrNotFound) {
+		t.Errorf("expected ac-4 to be rolled back, got %v", err)
+	}
+
+	// Duplicate IDs are rejected
+	err = acRepo.SaveACs(ctx, []*entities.AcceptanceCriteriaEntity{
+		entities.NewAcceptanceCriteriaEntity("ac-1", "task-1", "Again", entities.VerificationTypeManual, "", now, now),
+	})
+	if !errors.Is(err, pluginsdk.ErrAlreadyExists) {
+		t.Errorf("expected ErrAlreadyExists for duplicate AC, got %v", err)
+	}
+}
+
 func TestListAC(t *testing.T) {
 	db := createTestDB(t)
 	defer db.Close()
@@ -205,6 +267,56 @@ func TestListFailedAC(t *testing.T) {
 	}
 }
 
+func TestListACs(t *testing.T) {
+	db := createTestDB(t)
+	defer db.Close()
+
+	roadmapRepo := persistence.NewSQLiteRoadmapRepository(db, createTestLogger())
+	trackRepo := persistence.NewSQLiteTrackRepository(db, createTestLogger())
+	taskRepo := persistence.NewSQLiteTaskRepository(db, createTestLogger())
+	acRepo := persistence.NewSQLiteAcceptanceCriteriaRepository(db, createTestLogger())
+	ctx := context.Background()
+
+	// Setup: two tracks with a task each
+	roadmap, _ := entities.NewRoadmapEntity("roadmap-1", "vision", "criteria", time.Now().UTC(), time.Now().UTC())
+	roadmapRepo.SaveRoadmap(ctx, roadmap)
+	for _, id := range []string{"1", "2"} {
+		track, _ := entities.NewTrackEntity("track-"+id, "roadmap-1", "Track", "", "not-started", 200, []string{}, time.Now().UTC(), time.Now().UTC())
+		trackRepo.SaveTrack(ctx, track)
+		task, _ := entities.NewTaskEntity("task-"+id, "track-"+id, "Task", "", "todo", 200, "", time.Now().UTC(), time.Now().UTC())
+		taskRepo.SaveTask(ctx, task)
+	}
+
+	ac1 := entities.NewAcceptanceCriteriaEntity("ac-1", "task-1", "AC 1", entities.VerificationTypeManual, "", time.Now().UTC(), time.Now().UTC())
+	ac2 := entities.NewAcceptanceCriteriaEntity("ac-2", "task-2", "AC 2", entities.VerificationTypeManual, "", time.Now().UTC(), time.Now().UTC())
+	ac3 := entities.NewAcceptanceCriteriaEntity("ac-3", "task-2", "AC 3", entities.VerificationTypeManual, "", time.Now().UTC(), time.Now().UTC())
+	ac2.Status = entities.ACStatusFailed
+	acRepo.SaveACs(ctx, []*entities.AcceptanceCriteriaEntity{ac1, ac2, ac3})
+
+	// No filter: every AC of the roadmap, whatever its status
+	acs, err := acRepo.ListACs(ctx, entities.ACFilters{})
+	if err != nil {
+		t.Fatalf("failed to list ACs: %v", err)
+	}
+	if len(acs) != 3 {
+		t.Errorf("expected 3 ACs, got %d", len(acs))
+	}
+
+	// Track filter
+	acs, err = acRepo.ListACs(ctx, entities.ACFilters{TrackID: "track-2"})
+	if err != nil {
+		t.Fatalf("failed to list ACs by track: %v", err)
+	}
+	if len(acs) != 2 {
+		t.Errorf("expected 2 ACs for track-2, got %d", len(acs))
+	}
+	for _, ac := range acs {
+		if ac.TaskID != "task-2" {
+			t.Errorf("expected only ACs of task-2, got %s", ac.TaskID)
+		}
+	}
+}
+
 func TestListFailedACWithTaskFilter(t *testing.T) {
 	db := createTestDB(t)
 	defer db.Close()
@@ -429,6 +541,152 @@ func TestListACForIteration(t *testing.T) {
 	}
 }
 
+func TestCountACProgressByTask(t *testing.T) {
+	db := createTestDB(t)
+	defer db.Close()
+
+	roadmapRepo := persistence.NewSQLiteRoadmapRepository(db, createTestLogger())
+	trackRepo := persistence.NewSQLiteTrackRepository(db, createTestLogger())
+	taskRepo := persistence.NewSQLiteTaskRepository(db, createTestLogger())
+	acRepo := persistence.NewSQLiteAcceptanceCriteriaRepository(db, createTestLogger())
+	ctx := context.Background()
+
+	roadmap, _ := entities.NewRoadmapEntity("roadmap-1", "vision", "criteria", time.Now().UTC(), time.Now().UTC())
+	roadmapRepo.SaveRoadmap(ctx, roadmap)
+	track, _ := entities.NewTrackEntity("track-1", "roadmap-1", "Track", "", "not-started", 200, []string{}, time.Now().UTC(), time.Now().UTC())
+	trackRepo.SaveTrack(ctx, track)
+	for _, id := range []string{"task-1", "task-2", "task-3"} {
+		task, _ := entities.NewTaskEntity(id, "track-1", id, "", "todo", 200, "", time.Now().UTC(), time.Now().UTC())
+		taskRepo.SaveTask(ctx, task)
+	}
+
+	statuses := map[string]entities.AcceptanceCriteriaStatus{
+		"ac-1": entities.ACStatusVerified,
+		"ac-2": entities.ACStatusSkipped,
+		"ac-3": entities.ACStatusNotStarted,
+		"ac-4": entities.ACStatusFailed,
+	}
+	for id, taskID := range map[string]string{"ac-1": "task-1", "ac-2": "task-1", "ac-3": "task-1", "ac-4": "task-2"} {
+		ac := entities.NewAcceptanceCriteriaEntity(id, taskID, id, entities.VerificationTypeManual, "", time.Now().UTC(), time.Now().UTC())
+		ac.Status = statuses[id]
+		if err := acRepo.SaveAC(ctx, ac); err != nil {
+			t.Fatalf("failed to save AC %s: %v", id, err)
+		}
+	}
+
+	progress, err := acRepo.CountACProgressByTask(ctx, []string{"task-1", "task-2", "task-3"})
+	if err != nil {
+		t.Fatalf("CountACProgressByTask failed: %v", err)
+	}
+	if got := progress["task-1"]; got != (entities.ACProgress{Total: 3, Done: 2}) {
+		t.Errorf("task-1: expected 2/3 done, got %+v", got)
+	}
+	if got := progress["task-2"]; got != (entities.ACProgress{Total: 1, Failed: 1}) {
+		t.Errorf("task-2: expected 1 failed AC, got %+v", got)
+	}
+	if _, ok := progress["task-3"]; ok {
+		t.Error("task-3 has no ACs and should be left out")
+	}
+
+	// Only the requested tasks are counted
+	progress, _ = acRepo.CountACProgressByTask(ctx, []string{"task-2"})
+	if len(progress) != 1 {
+		t.Errorf("expected only task-2, got %+v", progress)
+	}
+	progress, err = acRepo.CountACProgressByTask(ctx, nil)
+	if err != nil || len(progress) != 0 {
+		t.Errorf("expected an empty map for no tasks, got %+v, %v", progress, err)
+	}
+
+	// More task IDs than SQLite binds in one statement are counted in batches
+	taskIDs := []string{"task-1"}
+	for i := 0; i < 40000; i++ {
+		taskIDs = append(taskIDs, fmt.Sprintf("task-missing-%d", i))
+	}
+	taskIDs = append(taskIDs, "task-2")
+	progress, err = acRepo.CountACProgressByTask(ctx, taskIDs)
+	if err != nil {
+		t.Fatalf("CountACProgressByTask with many tasks failed: %v", err)
+	}
+	if len(progress) != 2 || progress["task-1"].Total != 3 || progress["task-2"].Failed != 1 {
+		t.Errorf("expected counts for task-1 and task-2 across batches, got %+v", progress)
+	}
+}
+
+func TestListTaskACCoverage(t *testing.T) {
+	db := createTestDB(t)
+	defer db.Close()
+
+	roadmapRepo := persistence.NewSQLiteRoadmapRepository(db, createTestLogger())
+	trackRepo := persistence.NewSQLiteTrackRepository(db, createTestLogger())
+	taskRepo := persistence.NewSQLiteTaskRepository(db, createTestLogger())
+	acRepo := persistence.NewSQLiteAcceptanceCriteriaRepository(db, createTestLogger())
+	iterationRepo := persistence.NewSQLiteIterationRepository(db, createTestLogger(), acRepo)
+	ctx := context.Background()
+
+	roadmap, _ := entities.NewRoadmapEntity("roadmap-1", "vision", "criteria", time.Now().UTC(), time.Now().UTC())
+	roadmapRepo.SaveRoadmap(ctx, roadmap)
+	for _, id := range []string{"track-1", "track-2"} {
+		track, _ := entities.NewTrackEntity(id, "roadmap-1", id, "", "not-started", 200, []string{}, time.Now().UTC(), time.Now().UTC())
+		trackRepo.SaveTrack(ctx, track)
+	}
+	for id, trackID := range map[string]string{"task-1": "track-1", "task-2": "track-1", "task-3": "track-2"} {
+		task, _ := entities.NewTaskEntity(id, trackID, id, "", "done", 200, "", time.Now().UTC(), time.Now().UTC())
+		taskRepo.SaveTask(ctx, task)
+	}
+	iter1, _ := entities.NewIterationEntity(1, "Sprint 1", "Goal", "", []string{}, "planned", 500, time.Time{}, time.Time{}, time.Now().UTC(), time.Now().UTC())
+	iterationRepo.SaveIteration(ctx, iter1)
+	iterationRepo.AddTaskToIteration(ctx, 1, "task-2")
+	iterationRepo.AddTaskToIteration(ctx, 1, "task-3")
+
+	for id, status := range map[string]entities.AcceptanceCriteriaStatus{"ac-1": entities.ACStatusVerified, "ac-2": entities.ACStatusNotStarted} {
+		ac := entities.NewAcceptanceCriteriaEntity(id, "task-1", id, entities.VerificationTypeManual, "", time.Now().UTC(), time.Now().UTC())
+		ac.Status = status
+		if err := acRepo.SaveAC(ctx, ac); err != nil {
+			t.Fatalf("failed to save AC %s: %v", id, err)
+		}
+	}
+
+	coverage, err := acRepo.ListTaskACCoverage(ctx, entities.ACFilters{}, "")
+	if err != nil {
+		t.Fatalf("ListTaskACCoverage failed: %v", err)
+	}
+	if len(coverage) != 3 {
+		t.Fatalf("expected all 3 tasks, got %d", len(coverage))
+	}
+	byTask := make(map[string]*entities.TaskACCoverage)
+	for _, c := range coverage {
+		byTask[c.TaskID] = c
+	}
+	if got := byTask["task-1"]; got.ACs != (entities.ACProgress{Total: 2, Done: 1}) || got.TrackID != "track-1" || got.Status != "done" {
+		t.Errorf("task-1: expected 1/2 done ACs in track-1, got %+v", got)
+	}
+	if got := byTask["task-2"]; got.ACs != (entities.ACProgress{}) {
+		t.Errorf("task-2 has no ACs, got %+v", got.ACs)
+	}
+
+	// Filters narrow the tasks, not just the ACs
+	coverage, _ = acRepo.ListTaskACCoverage(ctx, entities.ACFilters{TrackID: "track-1"}, "")
+	if len(coverage) != 2 {
+		t.Errorf("expected the 2 tasks of track-1, got %d", len(coverage))
+	}
+	iterationNum := 1
+	coverage, _ = acRepo.ListTaskACCoverage(ctx, entities.ACFilters{IterationNum: &iterationNum, TrackID: "track-2"}, "")
+	if len(coverage) != 1 || coverage[0].TaskID != "task-3" {
+		t.Errorf("expected only task-3, got %+v", coverage)
+	}
+
+	// A roadmap keeps only the tasks of its tracks
+	coverage, _ = acRepo.ListTaskACCoverage(ctx, entities.ACFilters{}, "roadmap-1")
+	if len(coverage) != 3 {
+		t.Errorf("expected the 3 tasks of roadmap-1, got %d", len(coverage))
+	}
+	coverage, _ = acRepo.ListTaskACCoverage(ctx, entities.ACFilters{}, "roadmap-2")
+	if len(coverage) != 0 {
+		t.Errorf("expected no tasks in roadmap-2, got %+v", coverage)
+	}
+}
+
 func TestDeleteAC(t *testing.T) {
 	db := createTestDB(t)
 	defer db.Close()
@@ -463,3 +721,152 @@ func TestDeleteAC(t *testing.T) {
 		t.Errorf("expected ErrNotFound, got: %v", err)
 	}
 }
+
+// ============================================================================
+// AC Tag Tests
+// ============================================================================
+
+func TestACTags(t *testing.T) {
+	db := createTestDB(t)
+	defer db.Close()
+
+	roadmapRepo := persistence.NewSQLiteRoadmapRepository(db, createTestLogger())
+	trackRepo := persistence.NewSQLiteTrackRepository(db, createTestLogger())
+	taskRepo := persistence.NewSQLiteTaskRepository(db, createTestLogger())
+	acRepo := persistence.NewSQLiteAcceptanceCriteriaRepository(db, createTestLogger())
+	ctx := context.Background()
+
+	// Setup
+	roadmap, _ := entities.NewRoadmapEntity("roadmap-1", "vision", "criteria", time.Now().UTC(), time.Now().UTC())
+	roadmapRepo.SaveRoadmap(ctx, roadmap)
+
+	track, _ := entities.NewTrackEntity("track-1", "roadmap-1", "Track", "", "not-started", 200, []string{}, time.Now().UTC(), time.Now().UTC())
+	trackRepo.SaveTrack(ctx, track)
+
+	task, _ := entities.NewTaskEntity("task-1", "track-1", "Task", "", "todo", 200, "", time.Now().UTC(), time.Now().UTC())
+	taskRepo.SaveTask(ctx, task)
+
+	created := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	for _, id := range []string{"ac-1", "ac-2"} {
+		ac := entities.NewAcceptanceCriteriaEntity(id, "task-1", "AC "+id, entities.VerificationTypeManual, "", created, created)
+		if err := acRepo.SaveAC(ctx, ac); err != nil {
+			t.Fatalf("failed to save AC: %v", err)
+		}
+	}
+
+	// Add tags; adding twice is a no-op
+	for _, tag := range []string{entities.ACTagAutoOnComplete, "docs", entities.ACTagAutoOnComplete} {
+		if err := acRepo.AddACTag(ctx, "ac-2", tag); err != nil {
+			t.Fatalf("failed to add tag %s: %v", tag, err)
+		}
+	}
+	if err := acRepo.AddACTag(ctx, "ac-9", "docs"); !errors.Is(err, pluginsdk.ErrNotFound) {
+		t.Errorf("expected ErrNotFound for missing AC, got: %v", err)
+	}
+
+	// Tagging updates the criterion, so sync export picks up the new tags
+	tagged2, err := acRepo.GetAC(ctx, "ac-2")
+	if err != nil {
+		t.Fatalf("failed to get AC: %v", err)
+	}
+	if !tagged2.UpdatedAt.After(created) {
+		t.Errorf("expected tagging to update updated_at, got %v", tagged2.UpdatedAt)
+	}
+
+	tags, err := acRepo.ListACTags(ctx, "ac-2")
+	if err != nil {
+		t.Fatalf("failed to list tags: %v", err)
+	}
+	if len(tags) != 2 || tags[0] != entities.ACTagAutoOnComplete || tags[1] != "docs" {
+		t.Errorf("expected [auto-on-complete docs], got %v", tags)
+	}
+
+	tagged, err := acRepo.ListTaggedAC(ctx, "task-1", entities.ACTagAutoOnComplete)
+	if err != nil {
+		t.Fatalf("failed to list tagged ACs: %v", err)
+	}
+	if len(tagged) != 1 || tagged[0].ID != "ac-2" {
+		t.Errorf("expected only ac-2 to be tagged, got %v", tagged)
+	}
+
+	// Remove tags
+	if err := acRepo.RemoveACTag(ctx, "ac-2", "docs"); err != nil {
+		t.Fatalf("failed to remove tag: %v", err)
+	}
+	if err := acRepo.RemoveACTag(ctx, "ac-2", "docs"); !errors.Is(err, pluginsdk.ErrNotFound) {
+		t.Errorf("expected ErrNotFound when removing a missing tag, got: %v", err)
+	}
+
+	// Deleting the AC deletes its tags, so a new AC reusing the ID starts untagged
+	if err := acRepo.DeleteAC(ctx, "ac-2"); err != nil {
+		t.Fatalf("failed to delete AC: %v", err)
+	}
+	reused := entities.NewAcceptanceCriteriaEntity("ac-2", "task-1", "Reused", entities.VerificationTypeManual, "", time.Now().UTC(), time.Now().UTC())
+	if err := acRepo.SaveAC(ctx, reused); err != nil {
+		t.Fatalf("failed to save AC: %v", err)
+	}
+	tags, err = acRepo.ListACTags(ctx, "ac-2")
+	if err != nil {
+		t.Fatalf("failed to list tags: %v", err)
+	}
+	if len(tags) != 0 {
+		t.Errorf("expected reused AC ID to have no tags, got %v", tags)
+	}
+}
+
+// ============================================================================
+// AC Template Tests
+// ============================================================================
+
+func TestACTemplateLifecycle(t *testing.T) {
+	db := createTestDB(t)
+	defer db.Close()
+
+	acRepo := persistence.NewSQLiteAcceptanceCriteriaRepository(db, createTestLogger())
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	template, err := entities.NewACTemplateEntity("dod", []entities.ACTemplateItem{
+		{Description: "Has tests", VerificationType: entities.VerificationTypeAutomated},
+		{Description: "Docs updated"},
+	}, now, now)
+	if err != nil {
+		t.Fatalf("failed to create template entity: %v", err)
+	}
+
+	if err := acRepo.SaveACTemplate(ctx, template); err != nil {
+		t.Fatalf("failed to save template: %v", err)
+	}
+	if err := acRepo.SaveACTemplate(ctx, template); !errors.Is(err, pluginsdk.ErrAlreadyExists) {
+		t.Errorf("expected ErrAlreadyExists, got: %v", err)
+	}
+
+	retrieved, err := acRepo.GetACTemplate(ctx, "dod")
+	if err != nil {
+		t.Fatalf("failed to get template: %v", err)
+	}
+	if len(retrieved.Items) != 2 {
+		t.Fatalf("expected 2 items, got %d", len(retrieved.Items))
+	}
+	if retrieved.Items[0].VerificationType != entities.VerificationTypeAutomated || retrieved.Items[1].Description != "Docs updated" {
+		t.Errorf("unexpected items: %+v", retrieved.Items)
+	}
+
+	templates, err := acRepo.ListACTemplates(ctx)
+	if err != nil {
+		t.Fatalf("failed to list templates: %v", err)
+	}
+	if len(templates) != 1 {
+		t.Errorf("expected 1 template, got %d", len(templates))
+	}
+
+	if err := acRepo.DeleteACTemplate(ctx, "dod"); err != nil {
+		t.Fatalf("failed to delete template: %v", err)
+	}
+	if _, err := acRepo.GetACTemplate(ctx, "dod"); !errors.Is(err, pluginsdk.ErrNotFound) {
+		t.Errorf("expected ErrNotFound after delete, got: %v", err)
+	}
+	if err := acRepo.DeleteACTemplate(ctx, "dod"); !errors.Is(err, pluginsdk.ErrNotFound) {
+		t.Errorf("expected ErrNotFound deleting missing template, got: %v", err)
+	}
+}
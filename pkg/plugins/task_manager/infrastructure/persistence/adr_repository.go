@@ -16,12 +16,12 @@ var _ repositories.ADRRepository = (*SQLiteADRRepository)(nil)
 
 // SQLiteADRRepository implements repositories.ADRRepository using SQLite as the backend.
 type SQLiteADRRepository struct {
-	DB     *sql.DB
+	DB     DBTX
 	logger pluginsdk.Logger
 }
 
 // NewSQLiteADRRepository creates a new SQLite-backed repository.
-func NewSQLiteADRRepository(db *sql.DB, logger pluginsdk.Logger) *SQLiteADRRepository {
+func NewSQLiteADRRepository(db DBTX, logger pluginsdk.Logger) *SQLiteADRRepository {
 	return &SQLiteADRRepository{
 		DB:     db,
 		logger: logger,
@@ -34,6 +34,9 @@ func NewSQLiteADRRepository(db *sql.DB, logger pluginsdk.Logger) *SQLiteADRRepos
 
 // SaveADR persists a new ADR to storage.
 func (r *SQLiteADRRepository) SaveADR(ctx context.Context, adr *entities.ADREntity) error {
+	if err := entities.ValidateADRStatus(adr.Status); err != nil {
+		return err
+	}
 	// Check if ADR already exists
 	var exists int
 	err := r.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM adrs WHERE id = ?", adr.ID).Scan(&exists)
@@ -137,6 +140,9 @@ func (r *SQLiteADRRepository) ListADRs(ctx context.Context, trackID *string) ([]
 
 // UpdateADR updates an existing ADR.
 func (r *SQLiteADRRepository) UpdateADR(ctx context.Context, adr *entities.ADREntity) error {
+	if err := entities.ValidateADRStatus(adr.Status); err != nil {
+		return err
+	}
 	// Check if ADR exists
 	var exists int
 	err := r.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM adrs WHERE id = ?", adr.ID).Scan(&exists)
@@ -221,3 +227,125 @@ func (r *SQLiteADRRepository) DeprecateADR(ctx context.Context, adrID string) er
 func (r *SQLiteADRRepository) GetADRsByTrack(ctx context.Context, trackID string) ([]*entities.ADREntity, error) {
 	return r.ListADRs(ctx, &trackID)
 }
+
+// ============================================================================
+// ADR Task Link Operations
+// ============================================================================
+
+// LinkADRToTask records that a task implements an ADR.
+func (r *SQLiteADRRepository) LinkADRToTask(ctx context.Context, adrID, taskID string) error {
+	var exists int
+	err := r.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM adrs WHERE id = ?", adrID).Scan(&exists)
+	if err != nil {
+		return fmt.Errorf("failed to check ADR existence: %w", err)
+	}
+	if exists == 0 {
+		return fmt.Errorf("%w: ADR %s not found", pluginsdk.ErrNotFound, adrID)
+	}
+
+	err = r.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM tasks WHERE id = ?", taskID).Scan(&exists)
+	if err != nil {
+		return fmt.Errorf("failed to check task existence: %w", err)
+	}
+	if exists == 0 {
+		return fmt.Errorf("%w: task %s not found", pluginsdk.ErrNotFound, taskID)
+	}
+
+	err = r.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM adr_tasks WHERE adr_id = ? AND task_id = ?", adrID, taskID).Scan(&exists)
+	if err != nil {
+		return fmt.Errorf("failed to check ADR link existence: %w", err)
+	}
+	if exists > 0 {
+		return fmt.Errorf("%w: task %s is already linked to ADR %s", pluginsdk.ErrAlreadyExists, taskID, adrID)
+	}
+
+	_, err = r.DB.ExecContext(
+		ctx,
+		"INSERT INTO adr_tasks (adr_id, task_id, created_at) VALUES (?, ?, ?)",
+		adrID, taskID, time.Now().UTC(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to link task to ADR: %w", err)
+	}
+
+	return nil
+}
+
+// ListADRTasks returns the tasks linked to an ADR, in the order they were linked.
+func (r *SQLiteADRRepository) ListADRTasks(ctx context.Context, adrID string) ([]*entities.TaskEntity, error) {
+	rows, err := r.DB.QueryContext(
+		ctx,
+		`SELECT t.id, t.track_id, t.title, t.description, t.status, t.rank, t.branch, t.assignee, t.created_at, t.updated_at
+		FROM tasks t
+		INNER JOIN adr_tasks l ON l.task_id = t.id
+		WHERE l.adr_id = ?
+		ORDER BY l.rowid ASC`,
+		adrID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query ADR tasks: %w", err)
+	}
+	defer rows.Close()
+
+	tasks := []*entities.TaskEntity{}
+	for rows.Next() {
+		var task entities.TaskEntity
+		var branch, assignee sql.NullString
+		err := rows.Scan(&task.ID, &task.TrackID, &task.Title, &task.Description, &task.Status, &task.Rank, &branch, &assignee, &task.CreatedAt, &task.UpdatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan task: %w", err)
+		}
+		if branch.Valid {
+			task.Branch = branch.String
+		}
+		if assignee.Valid {
+			task.Assignee = assignee.String
+		}
+		tasks = append(tasks, &task)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating ADR tasks: %w", err)
+	}
+
+	return tasks, nil
+}
+
+// ListTaskADRs returns the ADRs a task is linked to, in the order they were linked.
+func (r *SQLiteADRRepository) ListTaskADRs(ctx context.Context, taskID string) ([]*entities.ADREntity, error) {
+	rows, err := r.DB.QueryContext(
+		ctx,
+		`SELECT a.id, a.track_id, a.title, a.status, a.context, a.decision, a.consequences, a.alternatives, a.created_at, a.updated_at, a.superseded_by
+		FROM adrs a
+		INNER JOIN adr_tasks l ON l.adr_id = a.id
+		WHERE l.task_id = ?
+		ORDER BY l.rowid ASC`,
+		taskID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query task ADRs: %w", err)
+	}
+	defer rows.Close()
+
+	adrs := []*entities.ADREntity{}
+	for rows.Next() {
+		var adr entities.ADREntity
+		var supersededBy sql.NullString
+		err := rows.Scan(
+			&adr.ID, &adr.TrackID, &adr.Title, &adr.Status, &adr.Context, &adr.Decision, &adr.Consequences, &adr.Alternatives, &adr.CreatedAt, &adr.UpdatedAt, &supersededBy,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan ADR: %w", err)
+		}
+		if supersededBy.Valid {
+			adr.SupersededBy = &supersededBy.String
+		}
+		adrs = append(adrs, &adr)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating task ADRs: %w", err)
+	}
+
+	return adrs, nil
+}
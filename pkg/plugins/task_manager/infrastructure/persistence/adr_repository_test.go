@@ -2,11 +2,13 @@ package persistence_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/infrastructure/persistence"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
 )
 
 // ============================================================================
@@ -212,3 +214,70 @@ func TestGetADRsByTrack(t *testing.T) {
 	}
 }
 
+func TestLinkADRToTask(t *testing.T) {
+	db := createTestDB(t)
+	defer db.Close()
+
+	roadmapRepo := persistence.NewSQLiteRoadmapRepository(db, createTestLogger())
+	trackRepo := persistence.NewSQLiteTrackRepository(db, createTestLogger())
+	taskRepo := persistence.NewSQLiteTaskRepository(db, createTestLogger())
+	adrRepo := persistence.NewSQLiteADRRepository(db, createTestLogger())
+	ctx := context.Background()
+
+	// Setup
+	roadmap, _ := entities.NewRoadmapEntity("roadmap-1", "vision", "criteria", time.Now().UTC(), time.Now().UTC())
+	roadmapRepo.SaveRoadmap(ctx, roadmap)
+	track, _ := entities.NewTrackEntity("track-1", "roadmap-1", "Track", "", "not-started", 200, []string{}, time.Now().UTC(), time.Now().UTC())
+	trackRepo.SaveTrack(ctx, track)
+	task1, _ := entities.NewTaskEntity("task-1", "track-1", "Task 1", "", "todo", 200, "", time.Now().UTC(), time.Now().UTC())
+	task2, _ := entities.NewTaskEntity("task-2", "track-1", "Task 2", "", "todo", 200, "", time.Now().UTC(), time.Now().UTC())
+	taskRepo.SaveTask(ctx, task1)
+	taskRepo.SaveTask(ctx, task2)
+	adr, _ := entities.NewADREntity("adr-1", "track-1", "ADR 1", "accepted", "context", "decision", "consequences", "", time.Now().UTC(), time.Now().UTC(), nil)
+	adrRepo.SaveADR(ctx, adr)
+
+	// Link both tasks
+	if err := adrRepo.LinkADRToTask(ctx, "adr-1", "task-2"); err != nil {
+		t.Fatalf("failed to link task-2: %v", err)
+	}
+	if err := adrRepo.LinkADRToTask(ctx, "adr-1", "task-1"); err != nil {
+		t.Fatalf("failed to link task-1: %v", err)
+	}
+
+	// Duplicate links and missing entities are rejected
+	if err := adrRepo.LinkADRToTask(ctx, "adr-1", "task-1"); !errors.Is(err, pluginsdk.ErrAlreadyExists) {
+		t.Errorf("expected ErrAlreadyExists for a duplicate link, got %v", err)
+	}
+	if err := adrRepo.LinkADRToTask(ctx, "adr-9", "task-1"); !errors.Is(err, pluginsdk.ErrNotFound) {
+		t.Errorf("expected ErrNotFound for a missing ADR, got %v", err)
+	}
+	if err := adrRepo.LinkADRToTask(ctx, "adr-1", "task-9"); !errors.Is(err, pluginsdk.ErrNotFound) {
+		t.Errorf("expected ErrNotFound for a missing task, got %v", err)
+	}
+
+	// Tasks are listed in link order
+	tasks, err := adrRepo.ListADRTasks(ctx, "adr-1")
+	if err != nil {
+		t.Fatalf("failed to list ADR tasks: %v", err)
+	}
+	if len(tasks) != 2 || tasks[0].ID != "task-2" || tasks[1].ID != "task-1" {
+		t.Errorf("expected tasks [task-2 task-1], got %v", tasks)
+	}
+
+	adrs, err := adrRepo.ListTaskADRs(ctx, "task-1")
+	if err != nil {
+		t.Fatalf("failed to list task ADRs: %v", err)
+	}
+	if len(adrs) != 1 || adrs[0].ID != "adr-1" {
+		t.Errorf("expected task-1 to be linked to adr-1, got %v", adrs)
+	}
+
+	// Deleting a task removes its links
+	if err := taskRepo.DeleteTask(ctx, "task-2"); err != nil {
+		t.Fatalf("failed to delete task: %v", err)
+	}
+	tasks, _ = adrRepo.ListADRTasks(ctx, "adr-1")
+	if len(tasks) != 1 || tasks[0].ID != "task-1" {
+		t.Errorf("expected only task-1 after deleting task-2, got %v", tasks)
+	}
+}
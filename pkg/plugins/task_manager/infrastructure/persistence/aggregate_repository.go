@@ -3,7 +3,9 @@ package persistence
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
@@ -16,12 +18,12 @@ var _ repositories.AggregateRepository = (*SQLiteAggregateRepository)(nil)
 
 // SQLiteAggregateRepository implements repositories.AggregateRepository using SQLite as the backend.
 type SQLiteAggregateRepository struct {
-	DB     *sql.DB
+	DB     DBTX
 	logger pluginsdk.Logger
 }
 
 // NewSQLiteAggregateRepository creates a new SQLite-backed repository.
-func NewSQLiteAggregateRepository(db *sql.DB, logger pluginsdk.Logger) *SQLiteAggregateRepository {
+func NewSQLiteAggregateRepository(db DBTX, logger pluginsdk.Logger) *SQLiteAggregateRepository {
 	return &SQLiteAggregateRepository{
 		DB:     db,
 		logger: logger,
@@ -35,12 +37,11 @@ func NewSQLiteAggregateRepository(db *sql.DB, logger pluginsdk.Logger) *SQLiteAg
 // GetRoadmapWithTracks retrieves a roadmap with all its tracks.
 func (r *SQLiteAggregateRepository) GetRoadmapWithTracks(ctx context.Context, roadmapID string) (*entities.RoadmapEntity, error) {
 	// Get roadmap
-	var roadmap entities.RoadmapEntity
-	err := r.DB.QueryRowContext(
+	roadmap, err := scanRoadmap(r.DB.QueryRowContext(
 		ctx,
-		"SELECT id, vision, success_criteria, created_at, updated_at FROM roadmaps WHERE id = ?",
+		"SELECT "+roadmapColumns+" FROM roadmaps WHERE id = ?",
 		roadmapID,
-	).Scan(&roadmap.ID, &roadmap.Vision, &roadmap.SuccessCriteria, &roadmap.CreatedAt, &roadmap.UpdatedAt)
+	))
 
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -76,7 +77,7 @@ func (r *SQLiteAggregateRepository) GetRoadmapWithTracks(ctx context.Context, ro
 		return nil, fmt.Errorf("error iterating tracks: %w", err)
 	}
 
-	return &roadmap, nil
+	return roadmap, nil
 }
 
 // ============================================================================
@@ -120,6 +121,16 @@ func (r *SQLiteAggregateRepository) GetProjectCode(ctx context.Context) string {
 	return code
 }
 
+// idFormat retrieves the project's ID format.
+// Returns the default format if not set.
+func (r *SQLiteAggregateRepository) idFormat(ctx context.Context) (*entities.IDFormat, error) {
+	template, err := r.GetProjectMetadata(ctx, entities.IDFormatMetadataKey)
+	if err != nil && !errors.Is(err, pluginsdk.ErrNotFound) {
+		return nil, err
+	}
+	return entities.ParseIDFormat(template)
+}
+
 // GetNextSequenceNumber retrieves the next sequence number for an entity type.
 // Entity types: "task", "track", "iter", "ac", "adr"
 func (r *SQLiteAggregateRepository) GetNextSequenceNumber(ctx context.Context, entityType string) (int, error) {
@@ -150,6 +161,11 @@ func (r *SQLiteAggregateRepository) GetNextSequenceNumber(ctx context.Context, e
 		return 0, fmt.Errorf("%w: invalid entity type: %s", pluginsdk.ErrInvalidArgument, entityType)
 	}
 
+	format, err := r.idFormat(ctx)
+	if err != nil {
+		return 0, err
+	}
+
 	// For tasks and tracks, we need to parse IDs
 	rows, err := r.DB.QueryContext(ctx, query)
 	if err != nil {
@@ -164,6 +180,19 @@ func (r *SQLiteAggregateRepository) GetNextSequenceNumber(ctx context.Context, e
 			return 0, fmt.Errorf("failed to scan ID: %w", err)
 		}
 
+		// Parse the numeric part with the project's ID format, or with any
+		// format for IDs created before the format changed
+		num, ok := format.ParseNumber(id, entityType)
+		if !ok {
+			num, ok = entities.FormattedIDNumber(id, entityType)
+		}
+		if ok {
+			if num > maxNum {
+				maxNum = num
+			}
+			continue
+		}
+
 		// Parse the numeric part from IDs like "DW-task-123" or "DW-track-5"
 		// Format: {CODE}-{entity}-{number}
 		// Split by "-" and parse the last part
@@ -183,3 +212,261 @@ func (r *SQLiteAggregateRepository) GetNextSequenceNumber(ctx context.Context, e
 
 	return maxNum + 1, nil
 }
+
+// ============================================================================
+// Search
+// ============================================================================
+
+// searchTable describes how one entity type is searched: a query selecting the ID,
+// the title and the searchable fields of rows matching a LIKE pattern
+type searchTable struct {
+	query  string
+	fields []searchColumn
+}
+
+// searchColumn is a searchable column and the relevance of a match in it
+type searchColumn struct {
+	name      string
+	relevance int
+}
+
+var searchTables = map[string]searchTable{
+	entities.SearchEntityTask: {
+		query: `SELECT id, title, title, description FROM tasks
+			WHERE title LIKE ? ESCAPE '\' OR description LIKE ? ESCAPE '\'`,
+		fields: []searchColumn{{"title", entities.SearchRelevanceTitle}, {"description", entities.SearchRelevanceBody}},
+	},
+	entities.SearchEntityTrack: {
+		query: `SELECT id, title, title, description FROM tracks
+			WHERE title LIKE ? ESCAPE '\' OR description LIKE ? ESCAPE '\'`,
+		fields: []searchColumn{{"title", entities.SearchRelevanceTitle}, {"description", entities.SearchRelevanceBody}},
+	},
+	entities.SearchEntityADR: {
+		query: `SELECT id, title, title, context, decision FROM adrs
+			WHERE title LIKE ? ESCAPE '\' OR context LIKE ? ESCAPE '\' OR decision LIKE ? ESCAPE '\'`,
+		fields: []searchColumn{{"title", entities.SearchRelevanceTitle}, {"context", entities.SearchRelevanceBody}, {"decision", entities.SearchRelevanceBody}},
+	},
+	entities.SearchEntityAC: {
+		// Acceptance criteria have no title; the description serves as one
+		query: `SELECT id, description, description FROM acceptance_criteria
+			WHERE description LIKE ? ESCAPE '\'`,
+		fields: []searchColumn{{"description", entities.SearchRelevanceTitle}},
+	},
+}
+
+// Search finds entities of the given types containing term, ranked by field relevance.
+func (r *SQLiteAggregateRepository) Search(ctx context.Context, term string, entityTypes []string) ([]*entities.SearchResult, error) {
+	if strings.TrimSpace(term) == "" {
+		return nil, fmt.Errorf("%w: search term must be non-empty", pluginsdk.ErrInvalidArgument)
+	}
+	if len(entityTypes) == 0 {
+		entityTypes = entities.SearchEntityTypes
+	}
+
+	results := []*entities.SearchResult{}
+	for _, entityType := range entityTypes {
+		table, ok := searchTables[entityType]
+		if !ok {
+			return nil, fmt.Errorf("%w: invalid entity type: %s", pluginsdk.ErrInvalidArgument, entityType)
+		}
+		matches, err := r.searchTable(ctx, entityType, table, term)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, matches...)
+	}
+
+	typeOrder := make(map[string]int, len(entities.SearchEntityTypes))
+	for i, entityType := range entities.SearchEntityTypes {
+		typeOrder[entityType] = i
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Relevance != results[j].Relevance {
+			return results[i].Relevance > results[j].Relevance
+		}
+		if results[i].EntityType != results[j].EntityType {
+			return typeOrder[results[i].EntityType] < typeOrder[results[j].EntityType]
+		}
+		return results[i].EntityID < results[j].EntityID
+	})
+	return results, nil
+}
+
+// searchTable runs the LIKE query of one entity type and converts matching rows to results
+func (r *SQLiteAggregateRepository) searchTable(ctx context.Context, entityType string, table searchTable, term string) ([]*entities.SearchResult, error) {
+	pattern := "%" + escapeLikePattern(term) + "%"
+	args := make([]interface{}, len(table.fields))
+	for i := range args {
+		args[i] = pattern
+	}
+
+	rows, err := r.DB.QueryContext(ctx, table.query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search %s: %w", entityType, err)
+	}
+	defer rows.Close()
+
+	var results []*entities.SearchResult
+	for rows.Next() {
+		var id, title string
+		values := make([]sql.NullString, len(table.fields))
+		dest := []interface{}{&id, &title}
+		for i := range values {
+			dest = append(dest, &values[i])
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, fmt.Errorf("failed to scan %s search result: %w", entityType, err)
+		}
+
+		fields := make([]entities.SearchField, len(table.fields))
+		for i, column := range table.fields {
+			fields[i] = entities.SearchField{Name: column.name, Text: values[i].String, Relevance: column.relevance}
+		}
+		// LIKE only folds ASCII case, so every row it returns also matches here
+		if result := entities.NewSearchResult(entityType, id, title, term, fields); result != nil {
+			results = append(results, result)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating %s search results: %w", entityType, err)
+	}
+	return results, nil
+}
+
+// escapeLikePattern escapes the LIKE wildcards in s, using '\' as the escape character
+func escapeLikePattern(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+// ============================================================================
+// Deletion Plans
+// ============================================================================
+
+// planCount is one COUNT(*) query of a deletion plan; ?1 is bound to the target ID
+type planCount struct {
+	kind  string
+	query string
+}
+
+// deletionPlans lists, per entity type, the rows its Delete* repository method removes
+// (the target first) and the rows it leaves behind. Keep them in sync with the deletes.
+var deletionPlans = map[string]struct {
+	deleted  []planCount
+	orphaned []planCount
+}{
+	entities.DeletionTargetTrack: {
+		deleted: []planCount{
+			{"track", "SELECT COUNT(*) FROM tracks WHERE id = ?1"},
+		},
+		orphaned: []planCount{
+			{"task", "SELECT COUNT(*) FROM tasks WHERE track_id = ?1"},
+			{"acceptance criterion", "SELECT COUNT(*) FROM acceptance_criteria WHERE task_id IN (SELECT id FROM tasks WHERE track_id = ?1)"},
+			{"ADR", "SELECT COUNT(*) FROM adrs WHERE track_id = ?1"},
+			{"document", "SELECT COUNT(*) FROM documents WHERE track_id = ?1"},
+			{"track dependency", "SELECT COUNT(*) FROM track_dependencies WHERE track_id = ?1 OR depends_on_id = ?1"},
+		},
+	},
+	entities.DeletionTargetTask: {
+		deleted: []planCount{
+			{"task", "SELECT COUNT(*) FROM tasks WHERE id = ?1"},
+			{"note", "SELECT COUNT(*) FROM task_notes WHERE task_id = ?1"},
+			{"gate", "SELECT COUNT(*) FROM task_ac_gates WHERE task_id = ?1"},
+			{"ADR link", "SELECT COUNT(*) FROM adr_tasks WHERE task_id = ?1"},
+		},
+		orphaned: []planCount{
+			{"acceptance criterion", "SELECT COUNT(*) FROM acceptance_criteria WHERE task_id = ?1"},
+			{"iteration association", "SELECT COUNT(*) FROM iteration_tasks WHERE task_id = ?1"},
+			{"gate on its AC", "SELECT COUNT(*) FROM task_ac_gates WHERE ac_id IN (SELECT id FROM acceptance_criteria WHERE task_id = ?1)"},
+		},
+	},
+	entities.DeletionTargetIteration: {
+		deleted: []planCount{
+			{"iteration", "SELECT COUNT(*) FROM iterations WHERE number = ?1"},
+			{"DoD item", "SELECT COUNT(*) FROM iteration_dod WHERE iteration_number = ?1"},
+		},
+		orphaned: []planCount{
+			{"task association", "SELECT COUNT(*) FROM iteration_tasks WHERE iteration_number = ?1"},
+			{"document", "SELECT COUNT(*) FROM documents WHERE iteration_number = ?1"},
+		},
+	},
+	entities.DeletionTargetAC: {
+		deleted: []planCount{
+			{"acceptance criterion", "SELECT COUNT(*) FROM acceptance_criteria WHERE id = ?1"},
+			{"tag", "SELECT COUNT(*) FROM ac_tags WHERE ac_id = ?1"},
+			{"gate", "SELECT COUNT(*) FROM task_ac_gates WHERE ac_id = ?1"},
+		},
+	},
+}
+
+// PlanDeletion counts what deleting an entity would remove and leave orphaned.
+func (r *SQLiteAggregateRepository) PlanDeletion(ctx context.Context, entityType, id string) (*entities.DeletionPlan, error) {
+	queries, ok := deletionPlans[entityType]
+	if !ok {
+		return nil, fmt.Errorf("%w: cannot plan the deletion of entity type: %s", pluginsdk.ErrInvalidArgument, entityType)
+	}
+
+	plan := &entities.DeletionPlan{TargetType: entityType, TargetID: id}
+	var err error
+	if plan.Deleted, err = r.countPlanItems(ctx, queries.deleted, id); err != nil {
+		return nil, err
+	}
+	if plan.Deleted[0].Count == 0 {
+		return nil, fmt.Errorf("%w: %s %s not found", pluginsdk.ErrNotFound, entityType, id)
+	}
+	if plan.Orphaned, err = r.countPlanItems(ctx, queries.orphaned, id); err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+// countPlanItems runs the count queries of a deletion plan for the target ID
+func (r *SQLiteAggregateRepository) countPlanItems(ctx context.Context, counts []planCount, id string) ([]entities.DeletionPlanItem, error) {
+	items := []entities.DeletionPlanItem{}
+	for _, count := range counts {
+		item := entities.DeletionPlanItem{Kind: count.kind}
+		if err := r.DB.QueryRowContext(ctx, count.query, id).Scan(&item.Count); err != nil {
+			return nil, fmt.Errorf("failed to count %s rows: %w", count.kind, err)
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// CountByStatus counts tracks, tasks and acceptance criteria by status.
+func (r *SQLiteAggregateRepository) CountByStatus(ctx context.Context) (*entities.StatusCounts, error) {
+	counts := &entities.StatusCounts{}
+	var err error
+	if counts.Tracks, err = r.countByStatus(ctx, "tracks"); err != nil {
+		return nil, err
+	}
+	if counts.Tasks, err = r.countByStatus(ctx, "tasks"); err != nil {
+		return nil, err
+	}
+	if counts.ACs, err = r.countByStatus(ctx, "acceptance_criteria"); err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
+
+// countByStatus counts the rows of table per status value
+func (r *SQLiteAggregateRepository) countByStatus(ctx context.Context, table string) (map[string]int, error) {
+	rows, err := r.DB.QueryContext(ctx, "SELECT status, COUNT(*) FROM "+table+" GROUP BY status")
+	if err != nil {
+		return nil, fmt.Errorf("failed to count %s by status: %w", table, err)
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int)
+	for rows.Next() {
+		var status string
+		var n int
+		if err := rows.Scan(&status, &n); err != nil {
+			return nil, fmt.Errorf("failed to scan %s count: %w", table, err)
+		}
+		counts[status] = n
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating %s counts: %w", table, err)
+	}
+	return counts, nil
+}
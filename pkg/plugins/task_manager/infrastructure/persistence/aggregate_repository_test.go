@@ -483,3 +483,389 @@ func TestGetNextSequenceNumber_InvalidEntityType(t *testing.T) {
 		t.Errorf("expected ErrInvalidArgument, got: %v", err)
 	}
 }
+
+func TestGetNextSequenceNumber_Task_CustomIDFormat(t *testing.T) {
+	db := createTestDB(t)
+	defer db.Close()
+
+	ctx := context.Background()
+	repo := persistence.NewSQLiteAggregateRepository(db, createTestLogger())
+	roadmapRepo := persistence.NewSQLiteRoadmapOnlyRepository(db, createTestLogger())
+	trackRepo := persistence.NewSQLiteTrackRepository(db, createTestLogger())
+	taskRepo := persistence.NewSQLiteTaskRepository(db, createTestLogger())
+
+	roadmap, _ := entities.NewRoadmapEntity("roadmap-1", "vision", "criteria", time.Now().UTC(), time.Now().UTC())
+	roadmapRepo.SaveRoadmap(ctx, roadmap)
+	track, _ := entities.NewTrackEntity("track-1", "roadmap-1", "Track", "desc", "not-started", 100, []string{}, time.Now().UTC(), time.Now().UTC())
+	trackRepo.SaveTrack(ctx, track)
+
+	// A task from before the format changed, and zero-padded tasks in the new format
+	for _, id := range []string{"DW-task-4", "DW/T/007", "DW/T/010"} {
+		task, _ := entities.NewTaskEntity(id, "track-1", "Task "+id, "", "todo", 100, "", time.Now().UTC(), time.Now().UTC())
+		if err := taskRepo.SaveTask(ctx, task); err != nil {
+			t.Fatalf("SaveTask(%s) failed: %v", id, err)
+		}
+	}
+	if err := repo.SetProjectMetadata(ctx, entities.IDFormatMetadataKey, "{code}/{abbr}/{number:3}"); err != nil {
+		t.Fatalf("SetProjectMetadata failed: %v", err)
+	}
+
+	seq, err := repo.GetNextSequenceNumber(ctx, "task")
+	if err != nil {
+		t.Fatalf("GetNextSequenceNumber failed: %v", err)
+	}
+	if seq != 11 {
+		t.Errorf("expected 11, got %d", seq)
+	}
+
+	// A later task in the old format still counts
+	task, _ := entities.NewTaskEntity("DW-task-15", "track-1", "Task 15", "", "todo", 100, "", time.Now().UTC(), time.Now().UTC())
+	taskRepo.SaveTask(ctx, task)
+	seq, err = repo.GetNextSequenceNumber(ctx, "task")
+	if err != nil {
+		t.Fatalf("GetNextSequenceNumber failed: %v", err)
+	}
+	if seq != 16 {
+		t.Errorf("expected 16, got %d", seq)
+	}
+
+	// Switching back to the default format keeps counting the padded IDs
+	task, _ = entities.NewTaskEntity("DW/T/020", "track-1", "Task 20", "", "todo", 100, "", time.Now().UTC(), time.Now().UTC())
+	taskRepo.SaveTask(ctx, task)
+	repo.SetProjectMetadata(ctx, entities.IDFormatMetadataKey, entities.DefaultIDFormat)
+	seq, err = repo.GetNextSequenceNumber(ctx, "task")
+	if err != nil {
+		t.Fatalf("GetNextSequenceNumber failed: %v", err)
+	}
+	if seq != 21 {
+		t.Errorf("expected 21, got %d", seq)
+	}
+}
+
+// ============================================================================
+// Search Tests
+// ============================================================================
+
+func setupSearchData(t *testing.T) (*persistence.SQLiteAggregateRepository, context.Context) {
+	t.Helper()
+	db := createTestDB(t)
+	t.Cleanup(func() { db.Close() })
+
+	ctx := context.Background()
+	logger := createTestLogger()
+	now := time.Now().UTC()
+
+	roadmap, _ := entities.NewRoadmapEntity("roadmap-1", "vision", "criteria", now, now)
+	if err := persistence.NewSQLiteRoadmapOnlyRepository(db, logger).SaveRoadmap(ctx, roadmap); err != nil {
+		t.Fatalf("SaveRoadmap failed: %v", err)
+	}
+
+	trackRepo := persistence.NewSQLiteTrackRepository(db, logger)
+	track, _ := entities.NewTrackEntity("DW-track-1", "roadmap-1", "Authentication", "Login via OAuth providers", "not-started", 100, []string{}, now, now)
+	if err := trackRepo.SaveTrack(ctx, track); err != nil {
+		t.Fatalf("SaveTrack failed: %v", err)
+	}
+
+	taskRepo := persistence.NewSQLiteTaskRepository(db, logger)
+	for _, task := range []struct{ id, title, description string }{
+		{"DW-task-1", "Refresh tokens", "Renew expired oauth tokens silently"},
+		{"DW-task-2", "OAuth callback handler", ""},
+		{"DW-task-3", "Write changelog", "Unrelated"},
+		{"DW-task-4", "Progress bar", "Show 100% when done"},
+	} {
+		entity, _ := entities.NewTaskEntity(task.id, "DW-track-1", task.title, task.description, "todo", 100, "", now, now)
+		if err := taskRepo.SaveTask(ctx, entity); err != nil {
+			t.Fatalf("SaveTask failed: %v", err)
+		}
+	}
+
+	adr, _ := entities.NewADREntity("DW-adr-1", "DW-track-1", "Token storage", "proposed", "Sessions need auth", "Store OAuth tokens in the keychain", "Safer", "", now, now, nil)
+	if err := persistence.NewSQLiteADRRepository(db, logger).SaveADR(ctx, adr); err != nil {
+		t.Fatalf("SaveADR failed: %v", err)
+	}
+
+	ac := entities.NewAcceptanceCriteriaEntity("DW-ac-1", "DW-task-2", "Callback rejects an invalid OAuth state", entities.VerificationTypeManual, "", now, now)
+	if err := persistence.NewSQLiteAcceptanceCriteriaRepository(db, logger).SaveAC(ctx, ac); err != nil {
+		t.Fatalf("SaveAC failed: %v", err)
+	}
+
+	return persistence.NewSQLiteAggregateRepository(db, logger), ctx
+}
+
+func TestSearch_AllTypesRankedByRelevance(t *testing.T) {
+	repo, ctx := setupSearchData(t)
+
+	results, err := repo.Search(ctx, "oauth", nil)
+	if err != nil {
+		t.Fatalf("Search failed: %v", err)
+	}
+
+	want := []struct{ entityType, id, field string }{
+		{entities.SearchEntityTask, "DW-task-2", "title"},
+		{entities.SearchEntityAC, "DW-ac-1", "description"},
+		{entities.SearchEntityTask, "DW-task-1", "description"},
+		{entities.SearchEntityTrack, "DW-track-1", "description"},
+		{entities.SearchEntityADR, "DW-adr-1", "decision"},
+	}
+	if len(results) != len(want) {
+		t.Fatalf("expected %d results, got %d: %+v", len(want), len(results), results)
+	}
+	for i, w := range want {
+		got := results[i]
+		if got.EntityType != w.entityType || got.EntityID != w.id || got.Field != w.field {
+			t.Errorf("result %d: expected %s %s (%s), got %s %s (%s)", i, w.entityType, w.id, w.field, got.EntityType, got.EntityID, got.Field)
+		}
+	}
+	if results[2].Title != "Refresh tokens" {
+		t.Errorf("expected result title to be the task title, got %q", results[2].Title)
+	}
+	if results[2].Snippet != "Renew expired oauth tokens silently" {
+		t.Errorf("expected snippet of the matching field, got %q", results[2].Snippet)
+	}
+}
+
+func TestSearch_FiltersByType(t *testing.T) {
+	repo, ctx := setupSearchData(t)
+
+	results, err := repo.Search(ctx, "OAuth", []string{entities.SearchEntityADR})
+	if err != nil {
+		t.Fatalf("Search failed: %v", err)
+	}
+	if len(results) != 1 || results[0].EntityID != "DW-adr-1" {
+		t.Errorf("expected only the ADR, got %+v", results)
+	}
+}
+
+func TestSearch_EscapesWildcards(t *testing.T) {
+	repo, ctx := setupSearchData(t)
+
+	results, err := repo.Search(ctx, "100%", nil)
+	if err != nil {
+		t.Fatalf("Search failed: %v", err)
+	}
+	if len(results) != 1 || results[0].EntityID != "DW-task-4" {
+		t.Errorf("expected only the task containing '100%%', got %+v", results)
+	}
+
+	results, err = repo.Search(ctx, "_", nil)
+	if err != nil {
+		t.Fatalf("Search failed: %v", err)
+	}
+	if len(results) != 0 {
+		t.Errorf("expected '_' to match literally, got %+v", results)
+	}
+}
+
+func TestSearch_NoMatches(t *testing.T) {
+	repo, ctx := setupSearchData(t)
+
+	results, err := repo.Search(ctx, "kubernetes", nil)
+	if err != nil {
+		t.Fatalf("Search failed: %v", err)
+	}
+	if len(results) != 0 {
+		t.Errorf("expected no results, got %+v", results)
+	}
+}
+
+func TestSearch_InvalidInput(t *testing.T) {
+	repo, ctx := setupSearchData(t)
+
+	if _, err := repo.Search(ctx, " ", nil); !errors.Is(err, pluginsdk.ErrInvalidArgument) {
+		t.Errorf("expected ErrInvalidArgument for empty term, got %v", err)
+	}
+	if _, err := repo.Search(ctx, "oauth", []string{"epic"}); !errors.Is(err, pluginsdk.ErrInvalidArgument) {
+		t.Errorf("expected ErrInvalidArgument for unknown type, got %v", err)
+	}
+}
+
+// ============================================================================
+// PlanDeletion Tests
+// ============================================================================
+
+// setupDeletionData extends setupStatusData with a second task gated on TM-ac-1,
+// a note, a tag, an ADR link, an iteration association and a DoD item
+func setupDeletionData(t *testing.T) (*persistence.SQLiteRepositoryComposite, context.Context) {
+	t.Helper()
+	repo, ctx := setupStatusData(t)
+	now := time.Now().UTC()
+
+	task2, _ := entities.NewTaskEntity("TM-task-2", "TM-track-1", "Gated task", "", "todo", 200, "", now, now)
+	note, _ := entities.NewTaskNoteEntity("TM-task-1", "note", now)
+	dod, _ := entities.NewIterationDoDItemEntity(1, "Shipped", now)
+
+	for _, err := range []error{
+		repo.SaveTask(ctx, task2),
+		repo.Task.SaveTaskNote(ctx, note),
+		repo.Task.AddTaskGate(ctx, "TM-task-2", "TM-ac-1"),
+		repo.AC.AddACTag(ctx, "TM-ac-1", "smoke"),
+		repo.ADR.LinkADRToTask(ctx, "TM-adr-1", "TM-task-1"),
+		repo.AddTaskToIteration(ctx, 1, "TM-task-1"),
+		repo.AddTaskToIteration(ctx, 1, "TM-task-2"),
+		repo.Iteration.SaveIterationDoDItem(ctx, dod),
+	} {
+		if err != nil {
+			t.Fatalf("failed to save test data: %v", err)
+		}
+	}
+	return repo, ctx
+}
+
+func countRows(t *testing.T, repo *persistence.SQLiteRepositoryComposite, query string, args ...interface{}) int {
+	t.Helper()
+	var n int
+	if err := repo.DB.QueryRow(query, args...).Scan(&n); err != nil {
+		t.Fatalf("count query failed: %v", err)
+	}
+	return n
+}
+
+func TestPlanDeletion_MatchesActualDeletion(t *testing.T) {
+	tests := []struct {
+		name       string
+		entityType string
+		id         string
+		del        func(context.Context, *persistence.SQLiteRepositoryComposite) error
+		// deleted and orphaned map each planned kind to a query counting its rows
+		deleted  map[string]string
+		orphaned map[string]string
+		want     map[string]int // planned counts
+	}{
+		{
+			name:       "task",
+			entityType: entities.DeletionTargetTask,
+			id:         "TM-task-1",
+			del: func(ctx context.Context, r *persistence.SQLiteRepositoryComposite) error {
+				return r.DeleteTask(ctx, "TM-task-1")
+			},
+			deleted: map[string]string{
+				"task":     "SELECT COUNT(*) FROM tasks WHERE id = 'TM-task-1'",
+				"note":     "SELECT COUNT(*) FROM task_notes WHERE task_id = 'TM-task-1'",
+				"ADR link": "SELECT COUNT(*) FROM adr_tasks WHERE task_id = 'TM-task-1'",
+			},
+			orphaned: map[string]string{
+				"acceptance criterion":  "SELECT COUNT(*) FROM acceptance_criteria WHERE task_id = 'TM-task-1'",
+				"iteration association": "SELECT COUNT(*) FROM iteration_tasks WHERE task_id = 'TM-task-1'",
+				"gate on its AC":        "SELECT COUNT(*) FROM task_ac_gates WHERE ac_id = 'TM-ac-1'",
+			},
+			want: map[string]int{"task": 1, "note": 1, "ADR link": 1, "acceptance criterion": 1, "iteration association": 1, "gate on its AC": 1},
+		},
+		{
+			name:       "track",
+			entityType: entities.DeletionTargetTrack,
+			id:         "TM-track-1",
+			del: func(ctx context.Context, r *persistence.SQLiteRepositoryComposite) error {
+				return r.DeleteTrack(ctx, "TM-track-1")
+			},
+			deleted: map[string]string{
+				"track": "SELECT COUNT(*) FROM tracks WHERE id = 'TM-track-1'",
+			},
+			orphaned: map[string]string{
+				"task": "SELECT COUNT(*) FROM tasks WHERE track_id = 'TM-track-1'",
+				"ADR":  "SELECT COUNT(*) FROM adrs WHERE track_id = 'TM-track-1'",
+			},
+			want: map[string]int{"track": 1, "task": 2, "acceptance criterion": 1, "ADR": 1},
+		},
+		{
+			name:       "iteration",
+			entityType: entities.DeletionTargetIteration,
+			id:         "1",
+			del: func(ctx context.Context, r *persistence.SQLiteRepositoryComposite) error {
+				return r.DeleteIteration(ctx, 1)
+			},
+			deleted: map[string]string{
+				"iteration": "SELECT COUNT(*) FROM iterations WHERE number = 1",
+				"DoD item":  "SELECT COUNT(*) FROM iteration_dod WHERE iteration_number = 1",
+			},
+			orphaned: map[string]string{
+				"task association": "SELECT COUNT(*) FROM iteration_tasks WHERE iteration_number = 1",
+			},
+			want: map[string]int{"iteration": 1, "DoD item": 1, "task association": 2},
+		},
+		{
+			name:       "ac",
+			entityType: entities.DeletionTargetAC,
+			id:         "TM-ac-1",
+			del: func(ctx context.Context, r *persistence.SQLiteRepositoryComposite) error {
+				return r.DeleteAC(ctx, "TM-ac-1")
+			},
+			deleted: map[string]string{
+				"acceptance criterion": "SELECT COUNT(*) FROM acceptance_criteria WHERE id = 'TM-ac-1'",
+				"tag":                  "SELECT COUNT(*) FROM ac_tags WHERE ac_id = 'TM-ac-1'",
+				"gate":                 "SELECT COUNT(*) FROM task_ac_gates WHERE ac_id = 'TM-ac-1'",
+			},
+			want: map[string]int{"acceptance criterion": 1, "tag": 1, "gate": 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, ctx := setupDeletionData(t)
+
+			plan, err := repo.Aggregate.PlanDeletion(ctx, tt.entityType, tt.id)
+			if err != nil {
+				t.Fatalf("PlanDeletion failed: %v", err)
+			}
+			for kind, want := range tt.want {
+				got := plan.DeletedCount(kind) + plan.OrphanedCount(kind)
+				if got != want {
+					t.Errorf("planned %s count: expected %d, got %d", kind, want, got)
+				}
+			}
+
+			if err := tt.del(ctx, repo); err != nil {
+				t.Fatalf("delete failed: %v", err)
+			}
+
+			// Everything planned as deleted is gone, everything planned as orphaned remains
+			for kind, query := range tt.deleted {
+				if plan.DeletedCount(kind) == 0 {
+					t.Errorf("expected %s in the deleted part of the plan", kind)
+				}
+				if n := countRows(t, repo, query); n != 0 {
+					t.Errorf("expected all %s rows deleted, %d remain", kind, n)
+				}
+			}
+			for kind, query := range tt.orphaned {
+				if n := countRows(t, repo, query); n != plan.OrphanedCount(kind) {
+					t.Errorf("expected %d orphaned %s rows, got %d", plan.OrphanedCount(kind), kind, n)
+				}
+			}
+		})
+	}
+}
+
+func TestPlanDeletion_Errors(t *testing.T) {
+	repo, ctx := setupDeletionData(t)
+
+	if _, err := repo.Aggregate.PlanDeletion(ctx, entities.DeletionTargetTask, "TM-task-99"); !errors.Is(err, pluginsdk.ErrNotFound) {
+		t.Errorf("expected ErrNotFound for missing task, got: %v", err)
+	}
+	if _, err := repo.Aggregate.PlanDeletion(ctx, "roadmap", "roadmap-1"); !errors.Is(err, pluginsdk.ErrInvalidArgument) {
+		t.Errorf("expected ErrInvalidArgument for unsupported type, got: %v", err)
+	}
+}
+
+func TestCountByStatus(t *testing.T) {
+	repo, ctx := setupDeletionData(t)
+	now := time.Now().UTC()
+
+	done, _ := entities.NewTaskEntity("TM-task-3", "TM-track-1", "Done task", "", "done", 300, "", now, now)
+	if err := repo.SaveTask(ctx, done); err != nil {
+		t.Fatalf("failed to save task: %v", err)
+	}
+
+	counts, err := repo.Aggregate.CountByStatus(ctx)
+	if err != nil {
+		t.Fatalf("CountByStatus failed: %v", err)
+	}
+	if len(counts.Tracks) != 1 || counts.Tracks["in-progress"] != 1 {
+		t.Errorf("expected 1 in-progress track, got %v", counts.Tracks)
+	}
+	if len(counts.Tasks) != 2 || counts.Tasks["todo"] != 2 || counts.Tasks["done"] != 1 {
+		t.Errorf("expected 2 todo and 1 done task, got %v", counts.Tasks)
+	}
+	if len(counts.ACs) != 1 || counts.ACs[string(entities.ACStatusNotStarted)] != 1 {
+		t.Errorf("expected 1 not started AC, got %v", counts.ACs)
+	}
+}
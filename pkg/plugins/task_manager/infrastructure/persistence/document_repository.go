@@ -16,11 +16,11 @@ var _ repositories.DocumentRepository = (*SQLiteDocumentRepository)(nil)
 
 // SQLiteDocumentRepository implements repositories.DocumentRepository using SQLite as the backend.
 type SQLiteDocumentRepository struct {
-	DB *sql.DB
+	DB DBTX
 }
 
 // NewSQLiteDocumentRepository creates a new SQLite-backed document repository.
-func NewSQLiteDocumentRepository(db *sql.DB) *SQLiteDocumentRepository {
+func NewSQLiteDocumentRepository(db DBTX) *SQLiteDocumentRepository {
 	return &SQLiteDocumentRepository{
 		DB: db,
 	}
@@ -1,10 +1,11 @@
 package persistence
 
 import (
+	"context"
+	"fmt"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/events"
-	"context"
 	"time"
 
 	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
@@ -21,6 +22,7 @@ type EventEmittingRepository struct {
 
 // Compile-time check that EventEmittingRepository implements domain.RoadmapRepository
 var _ domain.RoadmapRepository = (*EventEmittingRepository)(nil)
+var _ domain.TransactionalRepository = (*EventEmittingRepository)(nil)
 
 // NewEventEmittingRepository creates a new event-emitting repository decorator.
 // If eventBus is nil, events are not emitted but operations continue normally.
@@ -36,6 +38,17 @@ func NewEventEmittingRepository(
 	}
 }
 
+// WithTx runs fn in a transaction of the wrapped repository. Like services given the
+// composite directly, mutations made through the transaction emit no events.
+// Implements domain.TransactionalRepository.
+func (e *EventEmittingRepository) WithTx(ctx context.Context, fn func(domain.RoadmapRepository) error) error {
+	txRepo, ok := e.Repo.(domain.TransactionalRepository)
+	if !ok {
+		return fmt.Errorf("repository does not support transactions")
+	}
+	return txRepo.WithTx(ctx, fn)
+}
+
 // ============================================================================
 // Roadmap Operations
 // ============================================================================
@@ -55,11 +68,21 @@ func (e *EventEmittingRepository) GetRoadmap(ctx context.Context, id string) (*e
 	return e.Repo.GetRoadmap(ctx, id)
 }
 
-// GetActiveRoadmap retrieves the most recently created roadmap (read-only, no event).
+// GetActiveRoadmap retrieves the active roadmap (read-only, no event).
 func (e *EventEmittingRepository) GetActiveRoadmap(ctx context.Context) (*entities.RoadmapEntity, error) {
 	return e.Repo.GetActiveRoadmap(ctx)
 }
 
+// ListRoadmaps returns all roadmaps (read-only, no event).
+func (e *EventEmittingRepository) ListRoadmaps(ctx context.Context) ([]*entities.RoadmapEntity, error) {
+	return e.Repo.ListRoadmaps(ctx)
+}
+
+// SetActiveRoadmap makes a roadmap the active one (no event).
+func (e *EventEmittingRepository) SetActiveRoadmap(ctx context.Context, id string) error {
+	return e.Repo.SetActiveRoadmap(ctx, id)
+}
+
 // UpdateRoadmap updates an existing roadmap and emits events.EventRoadmapUpdated.
 func (e *EventEmittingRepository) UpdateRoadmap(ctx context.Context, roadmap *entities.RoadmapEntity) error {
 	if err := e.Repo.UpdateRoadmap(ctx, roadmap); err != nil {
@@ -70,6 +93,26 @@ func (e *EventEmittingRepository) UpdateRoadmap(ctx context.Context, roadmap *en
 	return nil
 }
 
+// SaveRoadmapCriterion persists a new success criterion (no event).
+func (e *EventEmittingRepository) SaveRoadmapCriterion(ctx context.Context, criterion *entities.RoadmapCriterionEntity) error {
+	return e.Repo.SaveRoadmapCriterion(ctx, criterion)
+}
+
+// GetRoadmapCriterion retrieves a success criterion by ID (read-only, no event).
+func (e *EventEmittingRepository) GetRoadmapCriterion(ctx context.Context, id int) (*entities.RoadmapCriterionEntity, error) {
+	return e.Repo.GetRoadmapCriterion(ctx, id)
+}
+
+// ListRoadmapCriteria returns all success criteria of a roadmap (read-only, no event).
+func (e *EventEmittingRepository) ListRoadmapCriteria(ctx context.Context, roadmapID string) ([]*entities.RoadmapCriterionEntity, error) {
+	return e.Repo.ListRoadmapCriteria(ctx, roadmapID)
+}
+
+// UpdateRoadmapCriterion updates an existing success criterion (no event).
+func (e *EventEmittingRepository) UpdateRoadmapCriterion(ctx context.Context, criterion *entities.RoadmapCriterionEntity) error {
+	return e.Repo.UpdateRoadmapCriterion(ctx, criterion)
+}
+
 // ============================================================================
 // Track Operations
 // ============================================================================
@@ -248,6 +291,11 @@ func (e *EventEmittingRepository) ListIterations(ctx context.Context) ([]*entiti
 	return e.Repo.ListIterations(ctx)
 }
 
+// ListIterationsByRoadmap returns the iterations of a roadmap (read-only, no event).
+func (e *EventEmittingRepository) ListIterationsByRoadmap(ctx context.Context, roadmapID string) ([]*entities.IterationEntity, error) {
+	return e.Repo.ListIterationsByRoadmap(ctx, roadmapID)
+}
+
 // UpdateIteration updates an existing iteration and emits events.EventIterationUpdated.
 func (e *EventEmittingRepository) UpdateIteration(ctx context.Context, iteration *entities.IterationEntity) error {
 	if err := e.Repo.UpdateIteration(ctx, iteration); err != nil {
@@ -263,6 +311,11 @@ func (e *EventEmittingRepository) DeleteIteration(ctx context.Context, number in
 	return e.Repo.DeleteIteration(ctx, number)
 }
 
+// ListIterationDoDItems returns the definition of done items of an iteration.
+func (e *EventEmittingRepository) ListIterationDoDItems(ctx context.Context, iterationNum int) ([]*entities.IterationDoDItemEntity, error) {
+	return e.Repo.ListIterationDoDItems(ctx, iterationNum)
+}
+
 // AddTaskToIteration adds a task to an iteration.
 func (e *EventEmittingRepository) AddTaskToIteration(ctx context.Context, iterationNum int, taskID string) error {
 	return e.Repo.AddTaskToIteration(ctx, iterationNum, taskID)
@@ -421,13 +474,13 @@ func (e *EventEmittingRepository) emitTrackCreatedEvent(ctx context.Context, tra
 	}
 
 	payload := map[string]interface{}{
-		"track_id":   track.ID,
-		"roadmap_id": track.RoadmapID,
-		"title":      track.Title,
+		"track_id":    track.ID,
+		"roadmap_id":  track.RoadmapID,
+		"title":       track.Title,
 		"description": track.Description,
-		"status":     track.Status,
-		"rank":       track.Rank,
-		"created_at": track.CreatedAt,
+		"status":      track.Status,
+		"rank":        track.Rank,
+		"created_at":  track.CreatedAt,
 	}
 
 	e.publishEvent(ctx, events.EventTrackCreated, payload)
@@ -440,13 +493,13 @@ func (e *EventEmittingRepository) emitTrackUpdatedEvent(ctx context.Context, tra
 	}
 
 	payload := map[string]interface{}{
-		"track_id":   track.ID,
-		"roadmap_id": track.RoadmapID,
-		"title":      track.Title,
+		"track_id":    track.ID,
+		"roadmap_id":  track.RoadmapID,
+		"title":       track.Title,
 		"description": track.Description,
-		"status":     track.Status,
-		"rank":       track.Rank,
-		"updated_at": track.UpdatedAt,
+		"status":      track.Status,
+		"rank":        track.Rank,
+		"updated_at":  track.UpdatedAt,
 	}
 
 	e.publishEvent(ctx, events.EventTrackUpdated, payload)
@@ -477,8 +530,8 @@ func (e *EventEmittingRepository) emitTrackCompletedEvent(ctx context.Context, t
 	}
 
 	payload := map[string]interface{}{
-		"track_id": track.ID,
-		"title":    track.Title,
+		"track_id":     track.ID,
+		"title":        track.Title,
 		"completed_at": time.Now(),
 	}
 
@@ -492,8 +545,8 @@ func (e *EventEmittingRepository) emitTrackBlockedEvent(ctx context.Context, tra
 	}
 
 	payload := map[string]interface{}{
-		"track_id": track.ID,
-		"title":    track.Title,
+		"track_id":   track.ID,
+		"title":      track.Title,
 		"blocked_at": time.Now(),
 	}
 
@@ -507,14 +560,14 @@ func (e *EventEmittingRepository) emitTaskCreatedEvent(ctx context.Context, task
 	}
 
 	payload := map[string]interface{}{
-		"task_id":   task.ID,
-		"track_id":  task.TrackID,
-		"title":     task.Title,
+		"task_id":     task.ID,
+		"track_id":    task.TrackID,
+		"title":       task.Title,
 		"description": task.Description,
-		"status":    task.Status,
-		"rank":      task.Rank,
-		"branch":    task.Branch,
-		"created_at": task.CreatedAt,
+		"status":      task.Status,
+		"rank":        task.Rank,
+		"branch":      task.Branch,
+		"created_at":  task.CreatedAt,
 	}
 
 	e.publishEvent(ctx, events.EventTaskCreated, payload)
@@ -527,14 +580,14 @@ func (e *EventEmittingRepository) emitTaskUpdatedEvent(ctx context.Context, task
 	}
 
 	payload := map[string]interface{}{
-		"task_id":   task.ID,
-		"track_id":  task.TrackID,
-		"title":     task.Title,
+		"task_id":     task.ID,
+		"track_id":    task.TrackID,
+		"title":       task.Title,
 		"description": task.Description,
-		"status":    task.Status,
-		"rank":      task.Rank,
-		"branch":    task.Branch,
-		"updated_at": task.UpdatedAt,
+		"status":      task.Status,
+		"rank":        task.Rank,
+		"branch":      task.Branch,
+		"updated_at":  task.UpdatedAt,
 	}
 
 	e.publishEvent(ctx, events.EventTaskUpdated, payload)
@@ -550,7 +603,7 @@ func (e *EventEmittingRepository) emitTaskStatusChangedEvent(
 	}
 
 	payload := map[string]interface{}{
-		"task_id":   taskID,
+		"task_id":    taskID,
 		"old_status": oldStatus,
 		"new_status": newStatus,
 	}
@@ -565,8 +618,8 @@ func (e *EventEmittingRepository) emitTaskCompletedEvent(ctx context.Context, ta
 	}
 
 	payload := map[string]interface{}{
-		"task_id": task.ID,
-		"title":    task.Title,
+		"task_id":      task.ID,
+		"title":        task.Title,
 		"completed_at": time.Now(),
 	}
 
@@ -701,12 +754,12 @@ func (e *EventEmittingRepository) emitACCreatedEvent(ctx context.Context, ac *en
 	}
 
 	payload := map[string]interface{}{
-		"id":                 ac.ID,
-		"task_id":            ac.TaskID,
-		"description":        ac.Description,
-		"verification_type":  string(ac.VerificationType),
-		"status":             string(ac.Status),
-		"created_at":         ac.CreatedAt,
+		"id":                ac.ID,
+		"task_id":           ac.TaskID,
+		"description":       ac.Description,
+		"verification_type": string(ac.VerificationType),
+		"status":            string(ac.Status),
+		"created_at":        ac.CreatedAt,
 	}
 
 	e.publishEvent(ctx, events.EventACCreated, payload)
@@ -734,13 +787,13 @@ func (e *EventEmittingRepository) emitACUpdatedEvent(ctx context.Context, ac *en
 	}
 
 	payload := map[string]interface{}{
-		"id":                 ac.ID,
-		"task_id":            ac.TaskID,
-		"description":        ac.Description,
-		"verification_type":  string(ac.VerificationType),
-		"status":             string(ac.Status),
-		"notes":              ac.Notes,
-		"updated_at":         ac.UpdatedAt,
+		"id":                ac.ID,
+		"task_id":           ac.TaskID,
+		"description":       ac.Description,
+		"verification_type": string(ac.VerificationType),
+		"status":            string(ac.Status),
+		"notes":             ac.Notes,
+		"updated_at":        ac.UpdatedAt,
 	}
 
 	e.publishEvent(ctx, statusEvent, payload)
@@ -835,8 +888,8 @@ func (e *EventEmittingRepository) SupersedeADR(ctx context.Context, adrID, super
 	}
 
 	e.publishEvent(ctx, events.EventADRSuperseded, map[string]interface{}{
-		"id":             adrID,
-		"superseded_by":  supersededByID,
+		"id":            adrID,
+		"superseded_by": supersededByID,
 	})
 	return nil
 }
@@ -858,6 +911,16 @@ func (e *EventEmittingRepository) GetADRsByTrack(ctx context.Context, trackID st
 	return e.Repo.GetADRsByTrack(ctx, trackID)
 }
 
+// ListADRTasks returns the tasks linked to an ADR (read-only, no event).
+func (e *EventEmittingRepository) ListADRTasks(ctx context.Context, adrID string) ([]*entities.TaskEntity, error) {
+	return e.Repo.ListADRTasks(ctx, adrID)
+}
+
+// ListTaskADRs returns the ADRs a task is linked to (read-only, no event).
+func (e *EventEmittingRepository) ListTaskADRs(ctx context.Context, taskID string) ([]*entities.ADREntity, error) {
+	return e.Repo.ListTaskADRs(ctx, taskID)
+}
+
 // ============================================================================
 // New Query Methods for LLM Agent Integration
 // ============================================================================
@@ -867,12 +930,42 @@ func (e *EventEmittingRepository) GetIterationsForTask(ctx context.Context, task
 	return e.Repo.GetIterationsForTask(ctx, taskID)
 }
 
+// ListTaskGates returns the acceptance criteria a task is gated on (read-only, no event).
+func (e *EventEmittingRepository) ListTaskGates(ctx context.Context, taskID string) ([]*entities.AcceptanceCriteriaEntity, error) {
+	return e.Repo.ListTaskGates(ctx, taskID)
+}
+
 // GetBacklogTasks returns all tasks that are not in any iteration and not done (read-only, no event).
-func (e *EventEmittingRepository) GetBacklogTasks(ctx context.Context) ([]*entities.TaskEntity, error) {
-	return e.Repo.GetBacklogTasks(ctx)
+func (e *EventEmittingRepository) GetBacklogTasks(ctx context.Context, roadmapID string) ([]*entities.TaskEntity, error) {
+	return e.Repo.GetBacklogTasks(ctx, roadmapID)
+}
+
+// SaveTaskNote persists a new note for a task (no event; notes have no events).
+func (e *EventEmittingRepository) SaveTaskNote(ctx context.Context, note *entities.TaskNoteEntity) error {
+	return e.Repo.SaveTaskNote(ctx, note)
 }
 
 // ListFailedAC returns all acceptance criteria with status "failed" (read-only, no event).
 func (e *EventEmittingRepository) ListFailedAC(ctx context.Context, filters entities.ACFilters) ([]*entities.AcceptanceCriteriaEntity, error) {
 	return e.Repo.ListFailedAC(ctx, filters)
 }
+
+// ListACs returns the acceptance criteria matching the filters (read-only, no event).
+func (e *EventEmittingRepository) ListACs(ctx context.Context, filters entities.ACFilters) ([]*entities.AcceptanceCriteriaEntity, error) {
+	return e.Repo.ListACs(ctx, filters)
+}
+
+// CountACProgressByTask counts the acceptance criteria of each of the given tasks (read-only, no event).
+func (e *EventEmittingRepository) CountACProgressByTask(ctx context.Context, taskIDs []string) (map[string]entities.ACProgress, error) {
+	return e.Repo.CountACProgressByTask(ctx, taskIDs)
+}
+
+// ListTaskACCoverage returns the tasks matching the filters with their AC progress (read-only, no event).
+func (e *EventEmittingRepository) ListTaskACCoverage(ctx context.Context, filters entities.ACFilters, roadmapID string) ([]*entities.TaskACCoverage, error) {
+	return e.Repo.ListTaskACCoverage(ctx, filters, roadmapID)
+}
+
+// AddACTag tags an acceptance criterion (no event; tags are not part of the AC events).
+func (e *EventEmittingRepository) AddACTag(ctx context.Context, acID, tag string) error {
+	return e.Repo.AddACTag(ctx, acID, tag)
+}
@@ -3,10 +3,12 @@ package persistence_test
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/events"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/infrastructure/persistence"
@@ -1181,3 +1183,35 @@ func TestEventEmittingRepository_DelegatesToUnderlyingRepository(t *testing.T) {
 		t.Errorf("expected vision 'vision', got '%s'", retrieved.Vision)
 	}
 }
+
+func TestEventEmittingRepository_WithTx_UsesWrappedTransaction(t *testing.T) {
+	db := createTestDB(t)
+	defer db.Close()
+
+	repo, _ := setupEventEmittingRepo(t, db)
+	ctx := context.Background()
+
+	roadmap, _ := entities.NewRoadmapEntity("roadmap-1", "vision", "criteria", time.Now().UTC(), time.Now().UTC())
+	rollback := errors.New("rollback")
+	err := repo.WithTx(ctx, func(tx domain.RoadmapRepository) error {
+		if err := tx.SaveRoadmap(ctx, roadmap); err != nil {
+			return err
+		}
+		return rollback
+	})
+	if !errors.Is(err, rollback) {
+		t.Fatalf("expected the closure error, got: %v", err)
+	}
+	if _, err := repo.GetRoadmap(ctx, "roadmap-1"); !errors.Is(err, pluginsdk.ErrNotFound) {
+		t.Errorf("expected the roadmap to be rolled back, got: %v", err)
+	}
+
+	if err := repo.WithTx(ctx, func(tx domain.RoadmapRepository) error {
+		return tx.SaveRoadmap(ctx, roadmap)
+	}); err != nil {
+		t.Fatalf("WithTx failed: %v", err)
+	}
+	if _, err := repo.GetRoadmap(ctx, "roadmap-1"); err != nil {
+		t.Errorf("expected the roadmap to be committed, got: %v", err)
+	}
+}
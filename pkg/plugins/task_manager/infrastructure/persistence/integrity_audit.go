@@ -0,0 +1,185 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+)
+
+// DanglingReference is a stored row that refers to an entity that no longer exists,
+// e.g. an iteration_tasks row left behind after its task was deleted while foreign
+// keys were not enforced
+type DanglingReference struct {
+	Kind       string // Kind of the row, e.g. "iteration task"
+	ID         string // Row ID; "<owner> -> <target>" for association rows
+	MissingRef string // Kind and ID of the missing entity, e.g. "task TM-task-9"
+	Removable  bool   // Association row that RemoveDanglingReferences deletes
+}
+
+// referenceCheck selects the rows of one kind with a dangling reference. query returns
+// the row ID and the missing entity; repair deletes those rows and is empty for rows
+// that hold content (tasks, ACs, ...) and are only reported.
+type referenceCheck struct {
+	kind   string
+	query  string
+	repair string
+}
+
+var referenceChecks = []referenceCheck{
+	{
+		kind:  "track",
+		query: "SELECT id, 'roadmap ' || roadmap_id FROM tracks WHERE roadmap_id NOT IN (SELECT id FROM roadmaps) ORDER BY id",
+	},
+	{
+		kind:  "task",
+		query: "SELECT id, 'track ' || track_id FROM tasks WHERE track_id NOT IN (SELECT id FROM tracks) ORDER BY id",
+	},
+	{
+		kind:  "ac",
+		query: "SELECT id, 'task ' || task_id FROM acceptance_criteria WHERE task_id NOT IN (SELECT id FROM tasks) ORDER BY id",
+	},
+	{
+		kind:  "adr",
+		query: "SELECT id, 'track ' || track_id FROM adrs WHERE track_id NOT IN (SELECT id FROM tracks) ORDER BY id",
+	},
+	{
+		kind: "track dependency",
+		query: `SELECT track_id || ' -> ' || depends_on_id,
+			CASE WHEN track_id NOT IN (SELECT id FROM tracks) THEN 'track ' || track_id ELSE 'track ' || depends_on_id END
+			FROM track_dependencies
+			WHERE track_id NOT IN (SELECT id FROM tracks) OR depends_on_id NOT IN (SELECT id FROM tracks)
+			ORDER BY track_id, depends_on_id`,
+		repair: "DELETE FROM track_dependencies WHERE track_id NOT IN (SELECT id FROM tracks) OR depends_on_id NOT IN (SELECT id FROM tracks)",
+	},
+	{
+		kind: "iteration task",
+		query: `SELECT CAST(iteration_number AS TEXT) || ' -> ' || task_id,
+			CASE WHEN iteration_number NOT IN (SELECT number FROM iterations) THEN 'iteration ' || iteration_number ELSE 'task ' || task_id END
+			FROM iteration_tasks
+			WHERE iteration_number NOT IN (SELECT number FROM iterations) OR task_id NOT IN (SELECT id FROM tasks)
+			ORDER BY iteration_number, task_id`,
+		repair: "DELETE FROM iteration_tasks WHERE iteration_number NOT IN (SELECT number FROM iterations) OR task_id NOT IN (SELECT id FROM tasks)",
+	},
+	{
+		kind: "task gate",
+		query: `SELECT task_id || ' -> ' || ac_id,
+			CASE WHEN task_id NOT IN (SELECT id FROM tasks) THEN 'task ' || task_id ELSE 'ac ' || ac_id END
+			FROM task_ac_gates
+			WHERE task_id NOT IN (SELECT id FROM tasks) OR ac_id NOT IN (SELECT id FROM acceptance_criteria)
+			ORDER BY task_id, ac_id`,
+		repair: "DELETE FROM task_ac_gates WHERE task_id NOT IN (SELECT id FROM tasks) OR ac_id NOT IN (SELECT id FROM acceptance_criteria)",
+	},
+	{
+		kind: "adr task",
+		query: `SELECT adr_id || ' -> ' || task_id,
+			CASE WHEN adr_id NOT IN (SELECT id FROM adrs) THEN 'adr ' || adr_id ELSE 'task ' || task_id END
+			FROM adr_tasks
+			WHERE adr_id NOT IN (SELECT id FROM adrs) OR task_id NOT IN (SELECT id FROM tasks)
+			ORDER BY adr_id, task_id`,
+		repair: "DELETE FROM adr_tasks WHERE adr_id NOT IN (SELECT id FROM adrs) OR task_id NOT IN (SELECT id FROM tasks)",
+	},
+	{
+		kind:   "ac tag",
+		query:  "SELECT ac_id || ' -> ' || tag, 'ac ' || ac_id FROM ac_tags WHERE ac_id NOT IN (SELECT id FROM acceptance_criteria) ORDER BY ac_id, tag",
+		repair: "DELETE FROM ac_tags WHERE ac_id NOT IN (SELECT id FROM acceptance_criteria)",
+	},
+	{
+		// Notes and DoD items have no command to move them; the cascade they missed deletes them
+		kind:   "task note",
+		query:  "SELECT CAST(id AS TEXT), 'task ' || task_id FROM task_notes WHERE task_id NOT IN (SELECT id FROM tasks) ORDER BY id",
+		repair: "DELETE FROM task_notes WHERE task_id NOT IN (SELECT id FROM tasks)",
+	},
+	{
+		kind:   "iteration dod item",
+		query:  "SELECT CAST(id AS TEXT), 'iteration ' || iteration_number FROM iteration_dod WHERE iteration_number NOT IN (SELECT number FROM iterations) ORDER BY id",
+		repair: "DELETE FROM iteration_dod WHERE iteration_number NOT IN (SELECT number FROM iterations)",
+	},
+}
+
+// FindDanglingReferences lists the tracks, tasks, ACs, ADRs, task notes, iteration DoD
+// items and association rows that refer to a missing roadmap, track, task, iteration, AC or ADR
+func (c *SQLiteRepositoryComposite) FindDanglingReferences(ctx context.Context) ([]DanglingReference, error) {
+	var dangling []DanglingReference
+	for _, check := range referenceChecks {
+		rows, err := c.conn().QueryContext(ctx, check.query)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check %s references: %w", check.kind, err)
+		}
+		for rows.Next() {
+			row := DanglingReference{Kind: check.kind, Removable: check.repair != ""}
+			if err := rows.Scan(&row.ID, &row.MissingRef); err != nil {
+				rows.Close()
+				return nil, fmt.Errorf("failed to scan %s reference: %w", check.kind, err)
+			}
+			dangling = append(dangling, row)
+		}
+		err = rows.Err()
+		rows.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to check %s references: %w", check.kind, err)
+		}
+	}
+	return dangling, nil
+}
+
+// RemoveDanglingReferences deletes the association rows (track dependencies, iteration
+// tasks, task gates, ADR task links and AC tags), task notes and iteration DoD items
+// that refer to a missing entity and
+// returns how many were removed. Run it through WithTx to combine it with other repairs.
+func (c *SQLiteRepositoryComposite) RemoveDanglingReferences(ctx context.Context) (int, error) {
+	removed := 0
+	for _, check := range referenceChecks {
+		if check.repair == "" {
+			continue
+		}
+		result, err := c.conn().ExecContext(ctx, check.repair)
+		if err != nil {
+			return removed, fmt.Errorf("failed to remove dangling %s rows: %w", check.kind, err)
+		}
+		n, _ := result.RowsAffected()
+		removed += int(n)
+	}
+	return removed, nil
+}
+
+// TrackDependencyGraph maps the ID of every track, across roadmaps, to the IDs of the
+// existing tracks it depends on
+func (c *SQLiteRepositoryComposite) TrackDependencyGraph(ctx context.Context) (map[string][]string, error) {
+	graph := make(map[string][]string)
+	rows, err := c.conn().QueryContext(ctx, "SELECT id FROM tracks")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tracks: %w", err)
+	}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan track: %w", err)
+		}
+		graph[id] = nil
+	}
+	err = rows.Err()
+	rows.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tracks: %w", err)
+	}
+
+	rows, err = c.conn().QueryContext(ctx,
+		`SELECT track_id, depends_on_id FROM track_dependencies
+		 WHERE track_id IN (SELECT id FROM tracks) AND depends_on_id IN (SELECT id FROM tracks)
+		 ORDER BY track_id, depends_on_id`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list track dependencies: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var trackID, dependsOnID string
+		if err := rows.Scan(&trackID, &dependsOnID); err != nil {
+			return nil, fmt.Errorf("failed to scan track dependency: %w", err)
+		}
+		graph[trackID] = append(graph[trackID], dependsOnID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list track dependencies: %w", err)
+	}
+	return graph, nil
+}
@@ -0,0 +1,102 @@
+package persistence_test
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFindDanglingReferences(t *testing.T) {
+	repo, ctx := setupStatusData(t)
+
+	dangling, err := repo.FindDanglingReferences(ctx)
+	if err != nil {
+		t.Fatalf("FindDanglingReferences failed: %v", err)
+	}
+	if len(dangling) != 0 {
+		t.Fatalf("expected no dangling references, got %+v", dangling)
+	}
+
+	// Rows left behind by deletes while foreign keys were not enforced
+	for _, stmt := range []string{
+		"INSERT INTO iteration_tasks (iteration_number, task_id) VALUES (1, 'TM-task-1')",
+		"INSERT INTO iteration_tasks (iteration_number, task_id) VALUES (1, 'TM-task-9')",
+		"INSERT INTO track_dependencies (track_id, depends_on_id) VALUES ('TM-track-1', 'TM-track-9')",
+		"INSERT INTO acceptance_criteria (id, task_id, description, verification_type, status, created_at, updated_at) VALUES ('TM-ac-9', 'TM-task-9', 'Orphan', 'manual', 'not-started', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)",
+		"INSERT INTO task_notes (id, task_id, content, created_at) VALUES (7, 'TM-task-9', 'Orphan note', CURRENT_TIMESTAMP)",
+		"INSERT INTO iteration_dod (id, iteration_number, text, created_at, updated_at) VALUES (4, 9, 'Orphan item', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)",
+	} {
+		if _, err := repo.DB.ExecContext(ctx, stmt); err != nil {
+			t.Fatalf("failed to insert dangling row: %v", err)
+		}
+	}
+
+	dangling, err = repo.FindDanglingReferences(ctx)
+	if err != nil {
+		t.Fatalf("FindDanglingReferences failed: %v", err)
+	}
+	if len(dangling) != 5 {
+		t.Fatalf("expected 5 dangling references, got %+v", dangling)
+	}
+	if dangling[0].Kind != "ac" || dangling[0].ID != "TM-ac-9" || dangling[0].MissingRef != "task TM-task-9" || dangling[0].Removable {
+		t.Errorf("unexpected AC row: %+v", dangling[0])
+	}
+	if dangling[1].Kind != "track dependency" || dangling[1].ID != "TM-track-1 -> TM-track-9" || dangling[1].MissingRef != "track TM-track-9" || !dangling[1].Removable {
+		t.Errorf("unexpected track dependency row: %+v", dangling[1])
+	}
+	if dangling[2].Kind != "iteration task" || dangling[2].ID != "1 -> TM-task-9" || dangling[2].MissingRef != "task TM-task-9" || !dangling[2].Removable {
+		t.Errorf("unexpected iteration task row: %+v", dangling[2])
+	}
+
+	if dangling[3].Kind != "task note" || dangling[3].ID != "7" || dangling[3].MissingRef != "task TM-task-9" || !dangling[3].Removable {
+		t.Errorf("unexpected task note row: %+v", dangling[3])
+	}
+	if dangling[4].Kind != "iteration dod item" || dangling[4].ID != "4" || dangling[4].MissingRef != "iteration 9" || !dangling[4].Removable {
+		t.Errorf("unexpected iteration DoD item row: %+v", dangling[4])
+	}
+
+	removed, err := repo.RemoveDanglingReferences(ctx)
+	if err != nil {
+		t.Fatalf("RemoveDanglingReferences failed: %v", err)
+	}
+	if removed != 4 {
+		t.Errorf("expected 4 removed rows, got %d", removed)
+	}
+
+	dangling, _ = repo.FindDanglingReferences(ctx)
+	if len(dangling) != 1 || dangling[0].Kind != "ac" {
+		t.Errorf("expected only the orphaned AC to remain, got %+v", dangling)
+	}
+	iteration, err := repo.GetIteration(ctx, 1)
+	if err != nil {
+		t.Fatalf("GetIteration failed: %v", err)
+	}
+	if !reflect.DeepEqual(iteration.TaskIDs, []string{"TM-task-1"}) {
+		t.Errorf("expected the valid iteration task to be kept, got %v", iteration.TaskIDs)
+	}
+}
+
+func TestTrackDependencyGraph(t *testing.T) {
+	repo, ctx := setupStatusData(t)
+	for _, stmt := range []string{
+		"INSERT INTO tracks (id, roadmap_id, title, description, status, rank, created_at, updated_at) VALUES ('TM-track-2', 'roadmap-1', 'Other', '', 'not-started', 200, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)",
+		"INSERT INTO track_dependencies (track_id, depends_on_id) VALUES ('TM-track-1', 'TM-track-2')",
+		"INSERT INTO track_dependencies (track_id, depends_on_id) VALUES ('TM-track-2', 'TM-track-1')",
+		"INSERT INTO track_dependencies (track_id, depends_on_id) VALUES ('TM-track-2', 'TM-track-9')",
+	} {
+		if _, err := repo.DB.ExecContext(ctx, stmt); err != nil {
+			t.Fatalf("failed to insert test row: %v", err)
+		}
+	}
+
+	graph, err := repo.TrackDependencyGraph(ctx)
+	if err != nil {
+		t.Fatalf("TrackDependencyGraph failed: %v", err)
+	}
+	expected := map[string][]string{
+		"TM-track-1": {"TM-track-2"},
+		"TM-track-2": {"TM-track-1"},
+	}
+	if !reflect.DeepEqual(graph, expected) {
+		t.Errorf("expected %v, got %v", expected, graph)
+	}
+}
@@ -19,17 +19,19 @@ var _ repositories.IterationRepository = (*SQLiteIterationRepository)(nil)
 
 // SQLiteIterationRepository implements repositories.IterationRepository using SQLite as the backend.
 type SQLiteIterationRepository struct {
-	DB           *sql.DB
+	DB           DBTX
 	logger       pluginsdk.Logger
 	ACRepository repositories.AcceptanceCriteriaRepository
+	retryPolicy  WriteRetryPolicy
 }
 
 // NewSQLiteIterationRepository creates a new SQLite-backed repository.
-func NewSQLiteIterationRepository(db *sql.DB, logger pluginsdk.Logger, acRepo repositories.AcceptanceCriteriaRepository) *SQLiteIterationRepository {
+func NewSQLiteIterationRepository(db DBTX, logger pluginsdk.Logger, acRepo repositories.AcceptanceCriteriaRepository) *SQLiteIterationRepository {
 	return &SQLiteIterationRepository{
 		DB:           db,
 		logger:       logger,
 		ACRepository: acRepo,
+		retryPolicy:  DefaultWriteRetryPolicy(),
 	}
 }
 
@@ -38,7 +40,18 @@ func NewSQLiteIterationRepository(db *sql.DB, logger pluginsdk.Logger, acRepo re
 // ============================================================================
 
 // SaveIteration persists a new iteration to storage.
+// Retried while the database is busy or locked.
 func (r *SQLiteIterationRepository) SaveIteration(ctx context.Context, iteration *entities.IterationEntity) error {
+	if err := entities.ValidateIterationStatus(iteration.Status); err != nil {
+		return err
+	}
+	return retryWrite(ctx, r.DB, r.retryPolicy, func() error {
+		return r.saveIteration(ctx, iteration)
+	})
+}
+
+// saveIteration performs a single SaveIteration attempt.
+func (r *SQLiteIterationRepository) saveIteration(ctx context.Context, iteration *entities.IterationEntity) error {
 	// Check if iteration already exists
 	var exists int
 	err := r.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM iterations WHERE number = ?", iteration.Number).Scan(&exists)
@@ -50,7 +63,7 @@ func (r *SQLiteIterationRepository) SaveIteration(ctx context.Context, iteration
 	}
 
 	// Start transaction for iteration and tasks
-	tx, err := r.DB.BeginTx(ctx, nil)
+	tx, err := beginTx(ctx, r.DB)
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
@@ -157,10 +170,31 @@ func (r *SQLiteIterationRepository) GetCurrentIteration(ctx context.Context) (*e
 
 // ListIterations returns all iterations, ordered by rank (then number).
 func (r *SQLiteIterationRepository) ListIterations(ctx context.Context) ([]*entities.IterationEntity, error) {
-	rows, err := r.DB.QueryContext(
-		ctx,
+	return r.queryIterations(ctx,
 		"SELECT number, name, goal, status, rank, deliverable, started_at, completed_at, created_at, updated_at FROM iterations ORDER BY rank, number",
 	)
+}
+
+// ListIterationsByRoadmap returns the iterations holding a task of a track of the roadmap,
+// and the iterations without tasks, ordered by rank (then number).
+func (r *SQLiteIterationRepository) ListIterationsByRoadmap(ctx context.Context, roadmapID string) ([]*entities.IterationEntity, error) {
+	return r.queryIterations(ctx,
+		`SELECT i.number, i.name, i.goal, i.status, i.rank, i.deliverable, i.started_at, i.completed_at, i.created_at, i.updated_at
+		 FROM iterations i
+		 WHERE NOT EXISTS (SELECT 1 FROM iteration_tasks it WHERE it.iteration_number = i.number)
+		    OR EXISTS (
+		        SELECT 1 FROM iteration_tasks it
+		        JOIN tasks t ON t.id = it.task_id
+		        JOIN tracks tr ON tr.id = t.track_id
+		        WHERE it.iteration_number = i.number AND tr.roadmap_id = ?)
+		 ORDER BY i.rank, i.number`,
+		roadmapID,
+	)
+}
+
+// queryIterations runs an iterations query and loads the task IDs of each iteration
+func (r *SQLiteIterationRepository) queryIterations(ctx context.Context, query string, args ...interface{}) ([]*entities.IterationEntity, error) {
+	rows, err := r.DB.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query iterations: %w", err)
 	}
@@ -201,9 +235,20 @@ func (r *SQLiteIterationRepository) ListIterations(ctx context.Context) ([]*enti
 }
 
 // UpdateIteration updates an existing iteration.
+// Retried while the database is busy or locked.
 func (r *SQLiteIterationRepository) UpdateIteration(ctx context.Context, iteration *entities.IterationEntity) error {
+	if err := entities.ValidateIterationStatus(iteration.Status); err != nil {
+		return err
+	}
+	return retryWrite(ctx, r.DB, r.retryPolicy, func() error {
+		return r.updateIteration(ctx, iteration)
+	})
+}
+
+// updateIteration performs a single UpdateIteration attempt.
+func (r *SQLiteIterationRepository) updateIteration(ctx context.Context, iteration *entities.IterationEntity) error {
 	// Start transaction for iteration and tasks update
-	tx, err := r.DB.BeginTx(ctx, nil)
+	tx, err := beginTx(ctx, r.DB)
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
@@ -255,7 +300,13 @@ func (r *SQLiteIterationRepository) UpdateIteration(ctx context.Context, iterati
 
 // DeleteIteration removes an iteration from storage.
 func (r *SQLiteIterationRepository) DeleteIteration(ctx context.Context, number int) error {
-	result, err := r.DB.ExecContext(ctx, "DELETE FROM iterations WHERE number = ?", number)
+	tx, err := beginTx(ctx, r.DB)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, "DELETE FROM iterations WHERE number = ?", number)
 	if err != nil {
 		return fmt.Errorf("failed to delete iteration: %w", err)
 	}
@@ -269,6 +320,15 @@ func (r *SQLiteIterationRepository) DeleteIteration(ctx context.Context, number
 		return fmt.Errorf("%w: iteration %d not found", pluginsdk.ErrNotFound, number)
 	}
 
+	// Iteration numbers are reused, so the definition of done must not outlive its iteration
+	if _, err := tx.ExecContext(ctx, "DELETE FROM iteration_dod WHERE iteration_number = ?", number); err != nil {
+		return fmt.Errorf("failed to delete iteration definition of done: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
 	return nil
 }
 
@@ -539,6 +599,321 @@ func (r *SQLiteIterationRepository) GetNextPlannedIteration(ctx context.Context)
 	return &iteration, nil
 }
 
+// ============================================================================
+// Iteration Template Operations
+// ============================================================================
+
+// SaveIterationTemplate persists a new iteration template.
+func (r *SQLiteIterationRepository) SaveIterationTemplate(ctx context.Context, template *entities.IterationTemplateEntity) error {
+	var exists int
+	err := r.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM iteration_templates WHERE name = ?", template.Name).Scan(&exists)
+	if err != nil {
+		return fmt.Errorf("failed to check iteration template existence: %w", err)
+	}
+	if exists > 0 {
+		return fmt.Errorf("%w: iteration template %s already exists", pluginsdk.ErrAlreadyExists, template.Name)
+	}
+
+	tx, err := beginTx(ctx, r.DB)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(
+		ctx,
+		"INSERT INTO iteration_templates (name, goal_pattern, deliverable, usage_count, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?)",
+		template.Name, template.GoalPattern, template.Deliverable, template.UsageCount, template.CreatedAt, template.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert iteration template: %w", err)
+	}
+
+	for i, text := range template.DoDItems {
+		_, err = tx.ExecContext(
+			ctx,
+			"INSERT INTO iteration_template_dod (template_name, position, text) VALUES (?, ?, ?)",
+			template.Name, i, text,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to insert iteration template definition of done: %w", err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// GetIterationTemplate retrieves an iteration template by its name.
+func (r *SQLiteIterationRepository) GetIterationTemplate(ctx context.Context, name string) (*entities.IterationTemplateEntity, error) {
+	row := r.DB.QueryRowContext(
+		ctx,
+		"SELECT name, goal_pattern, deliverable, usage_count, created_at, updated_at FROM iteration_templates WHERE name = ?",
+		name,
+	)
+
+	template, err := scanIterationTemplate(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("%w: iteration template %s not found", pluginsdk.ErrNotFound, name)
+		}
+		return nil, fmt.Errorf("failed to query iteration template: %w", err)
+	}
+
+	if template.DoDItems, err = r.getIterationTemplateDoD(ctx, template.Name); err != nil {
+		return nil, err
+	}
+
+	return template, nil
+}
+
+// ListIterationTemplates returns all iteration templates ordered by name.
+func (r *SQLiteIterationRepository) ListIterationTemplates(ctx context.Context) ([]*entities.IterationTemplateEntity, error) {
+	rows, err := r.DB.QueryContext(
+		ctx,
+		"SELECT name, goal_pattern, deliverable, usage_count, created_at, updated_at FROM iteration_templates ORDER BY name ASC",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query iteration templates: %w", err)
+	}
+	defer rows.Close()
+
+	templates := []*entities.IterationTemplateEntity{}
+	for rows.Next() {
+		template, err := scanIterationTemplate(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan iteration template: %w", err)
+		}
+		templates = append(templates, template)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating iteration templates: %w", err)
+	}
+	rows.Close()
+
+	for _, template := range templates {
+		if template.DoDItems, err = r.getIterationTemplateDoD(ctx, template.Name); err != nil {
+			return nil, err
+		}
+	}
+
+	return templates, nil
+}
+
+// DeleteIterationTemplate removes an iteration template from storage.
+func (r *SQLiteIterationRepository) DeleteIterationTemplate(ctx context.Context, name string) error {
+	tx, err := beginTx(ctx, r.DB)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, "DELETE FROM iteration_templates WHERE name = ?", name)
+	if err != nil {
+		return fmt.Errorf("failed to delete iteration template: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("%w: iteration template %s not found", pluginsdk.ErrNotFound, name)
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM iteration_template_dod WHERE template_name = ?", name); err != nil {
+		return fmt.Errorf("failed to delete iteration template definition of done: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// IncrementIterationTemplateUsage increments the usage count of an iteration template.
+func (r *SQLiteIterationRepository) IncrementIterationTemplateUsage(ctx context.Context, name string) error {
+	result, err := r.DB.ExecContext(
+		ctx,
+		"UPDATE iteration_templates SET usage_count = usage_count + 1, updated_at = ? WHERE name = ?",
+		time.Now().UTC(), name,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update iteration template usage: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("%w: iteration template %s not found", pluginsdk.ErrNotFound, name)
+	}
+
+	return nil
+}
+
+// scanIterationTemplate scans a single iteration_templates row.
+func scanIterationTemplate(row rowScanner) (*entities.IterationTemplateEntity, error) {
+	var template entities.IterationTemplateEntity
+	if err := row.Scan(
+		&template.Name, &template.GoalPattern, &template.Deliverable, &template.UsageCount,
+		&template.CreatedAt, &template.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	return &template, nil
+}
+
+// getIterationTemplateDoD returns the definition of done items of a template in order.
+func (r *SQLiteIterationRepository) getIterationTemplateDoD(ctx context.Context, name string) ([]string, error) {
+	rows, err := r.DB.QueryContext(
+		ctx,
+		"SELECT text FROM iteration_template_dod WHERE template_name = ? ORDER BY position ASC",
+		name,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query iteration template definition of done: %w", err)
+	}
+	defer rows.Close()
+
+	var items []string
+	for rows.Next() {
+		var text string
+		if err := rows.Scan(&text); err != nil {
+			return nil, fmt.Errorf("failed to scan iteration template definition of done: %w", err)
+		}
+		items = append(items, text)
+	}
+
+	return items, rows.Err()
+}
+
+// ============================================================================
+// Definition of Done Operations
+// ============================================================================
+
+// SaveIterationDoDItem persists a new definition of done item and assigns its ID.
+func (r *SQLiteIterationRepository) SaveIterationDoDItem(ctx context.Context, item *entities.IterationDoDItemEntity) error {
+	var iterExists int
+	err := r.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM iterations WHERE number = ?", item.IterationNum).Scan(&iterExists)
+	if err != nil {
+		return fmt.Errorf("failed to check iteration existence: %w", err)
+	}
+	if iterExists == 0 {
+		return fmt.Errorf("%w: iteration %d not found", pluginsdk.ErrNotFound, item.IterationNum)
+	}
+
+	result, err := r.DB.ExecContext(
+		ctx,
+		"INSERT INTO iteration_dod (iteration_number, text, done, done_at, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?)",
+		item.IterationNum, item.Text, item.Done, item.DoneAt, item.CreatedAt, item.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert definition of done item: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("failed to get definition of done item ID: %w", err)
+	}
+	item.ID = int(id)
+
+	return nil
+}
+
+// GetIterationDoDItem retrieves a definition of done item by its ID.
+func (r *SQLiteIterationRepository) GetIterationDoDItem(ctx context.Context, id int) (*entities.IterationDoDItemEntity, error) {
+	row := r.DB.QueryRowContext(
+		ctx,
+		"SELECT id, iteration_number, text, done, done_at, created_at, updated_at FROM iteration_dod WHERE id = ?",
+		id,
+	)
+
+	item, err := scanIterationDoDItem(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("%w: definition of done item %d not found", pluginsdk.ErrNotFound, id)
+		}
+		return nil, fmt.Errorf("failed to query definition of done item: %w", err)
+	}
+
+	return item, nil
+}
+
+// ListIterationDoDItems returns the definition of done items of an iteration in creation order.
+func (r *SQLiteIterationRepository) ListIterationDoDItems(ctx context.Context, iterationNum int) ([]*entities.IterationDoDItemEntity, error) {
+	rows, err := r.DB.QueryContext(
+		ctx,
+		"SELECT id, iteration_number, text, done, done_at, created_at, updated_at FROM iteration_dod WHERE iteration_number = ? ORDER BY id ASC",
+		iterationNum,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query definition of done items: %w", err)
+	}
+	defer rows.Close()
+
+	items := []*entities.IterationDoDItemEntity{}
+	for rows.Next() {
+		item, err := scanIterationDoDItem(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan definition of done item: %w", err)
+		}
+		items = append(items, item)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating definition of done items: %w", err)
+	}
+
+	return items, nil
+}
+
+// UpdateIterationDoDItem updates an existing definition of done item.
+func (r *SQLiteIterationRepository) UpdateIterationDoDItem(ctx context.Context, item *entities.IterationDoDItemEntity) error {
+	result, err := r.DB.ExecContext(
+		ctx,
+		"UPDATE iteration_dod SET text = ?, done = ?, done_at = ?, updated_at = ? WHERE id = ?",
+		item.Text, item.Done, item.DoneAt, item.UpdatedAt, item.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update definition of done item: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rows == 0 {
+		return fmt.Errorf("%w: definition of done item %d not found", pluginsdk.ErrNotFound, item.ID)
+	}
+
+	return nil
+}
+
+// scanIterationDoDItem scans a single iteration_dod row.
+func scanIterationDoDItem(row rowScanner) (*entities.IterationDoDItemEntity, error) {
+	var item entities.IterationDoDItemEntity
+	var doneAt sql.NullTime
+
+	if err := row.Scan(&item.ID, &item.IterationNum, &item.Text, &item.Done, &doneAt, &item.CreatedAt, &item.UpdatedAt); err != nil {
+		return nil, err
+	}
+	if doneAt.Valid {
+		t := doneAt.Time
+		item.DoneAt = &t
+	}
+	return &item, nil
+}
+
 // ============================================================================
 // Helper Methods
 // ============================================================================
@@ -574,13 +949,13 @@ func (r *SQLiteIterationRepository) getIterationTaskIDs(ctx context.Context, ite
 // getTask retrieves a task by its ID.
 func (r *SQLiteIterationRepository) getTask(ctx context.Context, id string) (*entities.TaskEntity, error) {
 	var task entities.TaskEntity
-	var branch sql.NullString
+	var branch, assignee sql.NullString
 
 	err := r.DB.QueryRowContext(
 		ctx,
-		"SELECT id, track_id, title, description, status, rank, branch, created_at, updated_at FROM tasks WHERE id = ?",
+		"SELECT id, track_id, title, description, status, rank, branch, assignee, created_at, updated_at FROM tasks WHERE id = ?",
 		id,
-	).Scan(&task.ID, &task.TrackID, &task.Title, &task.Description, &task.Status, &task.Rank, &branch, &task.CreatedAt, &task.UpdatedAt)
+	).Scan(&task.ID, &task.TrackID, &task.Title, &task.Description, &task.Status, &task.Rank, &branch, &assignee, &task.CreatedAt, &task.UpdatedAt)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -592,6 +967,9 @@ func (r *SQLiteIterationRepository) getTask(ctx context.Context, id string) (*en
 	if branch.Valid {
 		task.Branch = branch.String
 	}
+	if assignee.Valid {
+		task.Assignee = assignee.String
+	}
 
 	return &task, nil
 }
@@ -542,6 +542,166 @@ func TestCompleteIteration_AllowsWithSkippedACs(t *testing.T) {
 }
 
 // Helper to check if a string contains a substring
+func TestIterationTemplateLifecycle(t *testing.T) {
+	db := createTestDB(t)
+	defer db.Close()
+
+	repo := persistence.NewSQLiteIterationRepository(db, createTestLogger(), persistence.NewSQLiteAcceptanceCriteriaRepository(db, createTestLogger()))
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	template, err := entities.NewIterationTemplateEntity("weekly", "Sprint {n}", "Release {n}", now, now)
+	if err != nil {
+		t.Fatalf("failed to create template entity: %v", err)
+	}
+	template.DoDItems = []string{"Demo recorded", "Changelog {n} written"}
+
+	if err := repo.SaveIterationTemplate(ctx, template); err != nil {
+		t.Fatalf("failed to save template: %v", err)
+	}
+	if err := repo.SaveIterationTemplate(ctx, template); !errors.Is(err, pluginsdk.ErrAlreadyExists) {
+		t.Errorf("expected ErrAlreadyExists, got: %v", err)
+	}
+
+	if err := repo.IncrementIterationTemplateUsage(ctx, "weekly"); err != nil {
+		t.Fatalf("failed to increment usage: %v", err)
+	}
+	if err := repo.IncrementIterationTemplateUsage(ctx, "missing"); !errors.Is(err, pluginsdk.ErrNotFound) {
+		t.Errorf("expected ErrNotFound incrementing missing template, got: %v", err)
+	}
+
+	retrieved, err := repo.GetIterationTemplate(ctx, "weekly")
+	if err != nil {
+		t.Fatalf("failed to get template: %v", err)
+	}
+	if retrieved.GoalPattern != "Sprint {n}" || retrieved.Deliverable != "Release {n}" {
+		t.Errorf("unexpected template: %+v", retrieved)
+	}
+	if retrieved.UsageCount != 1 {
+		t.Errorf("expected usage count 1, got %d", retrieved.UsageCount)
+	}
+	if len(retrieved.DoDItems) != 2 || retrieved.DoDItems[0] != "Demo recorded" || retrieved.DoDItems[1] != "Changelog {n} written" {
+		t.Errorf("expected definition of done items in order, got %v", retrieved.DoDItems)
+	}
+
+	templates, err := repo.ListIterationTemplates(ctx)
+	if err != nil {
+		t.Fatalf("failed to list templates: %v", err)
+	}
+	if len(templates) != 1 {
+		t.Errorf("expected 1 template, got %d", len(templates))
+	} else if len(templates[0].DoDItems) != 2 {
+		t.Errorf("expected listed template to include 2 definition of done items, got %v", templates[0].DoDItems)
+	}
+
+	if err := repo.DeleteIterationTemplate(ctx, "weekly"); err != nil {
+		t.Fatalf("failed to delete template: %v", err)
+	}
+	if _, err := repo.GetIterationTemplate(ctx, "weekly"); !errors.Is(err, pluginsdk.ErrNotFound) {
+		t.Errorf("expected ErrNotFound after delete, got: %v", err)
+	}
+	if err := repo.DeleteIterationTemplate(ctx, "weekly"); !errors.Is(err, pluginsdk.ErrNotFound) {
+		t.Errorf("expected ErrNotFound deleting missing template, got: %v", err)
+	}
+
+	// A recreated template must not inherit the deleted template's items
+	recreated, _ := entities.NewIterationTemplateEntity("weekly", "Sprint {n}", "", now, now)
+	if err := repo.SaveIterationTemplate(ctx, recreated); err != nil {
+		t.Fatalf("failed to recreate template: %v", err)
+	}
+	retrieved, err = repo.GetIterationTemplate(ctx, "weekly")
+	if err != nil {
+		t.Fatalf("failed to get recreated template: %v", err)
+	}
+	if len(retrieved.DoDItems) != 0 {
+		t.Errorf("expected no definition of done items, got %v", retrieved.DoDItems)
+	}
+}
+
+func TestIterationDoDLifecycle(t *testing.T) {
+	db := createTestDB(t)
+	defer db.Close()
+
+	repo := persistence.NewSQLiteIterationRepository(db, createTestLogger(), persistence.NewSQLiteAcceptanceCriteriaRepository(db, createTestLogger()))
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	iteration, _ := entities.NewIterationEntity(1, "Sprint 1", "Goal", "", []string{}, "planned", 500, time.Time{}, time.Time{}, now, now)
+	if err := repo.SaveIteration(ctx, iteration); err != nil {
+		t.Fatalf("failed to save iteration: %v", err)
+	}
+
+	first, _ := entities.NewIterationDoDItemEntity(1, "Demo recorded", now)
+	second, _ := entities.NewIterationDoDItemEntity(1, "Changelog written", now)
+	for _, item := range []*entities.IterationDoDItemEntity{first, second} {
+		if err := repo.SaveIterationDoDItem(ctx, item); err != nil {
+			t.Fatalf("failed to save definition of done item: %v", err)
+		}
+	}
+	if first.ID == 0 || second.ID <= first.ID {
+		t.Errorf("expected increasing IDs, got %d and %d", first.ID, second.ID)
+	}
+
+	// Check the second item
+	second.Check(now)
+	if err := repo.UpdateIterationDoDItem(ctx, second); err != nil {
+		t.Fatalf("failed to update definition of done item: %v", err)
+	}
+
+	retrieved, err := repo.GetIterationDoDItem(ctx, second.ID)
+	if err != nil {
+		t.Fatalf("failed to get definition of done item: %v", err)
+	}
+	if !retrieved.Done || retrieved.DoneAt == nil {
+		t.Errorf("expected item to be done with done_at set")
+	}
+
+	items, err := repo.ListIterationDoDItems(ctx, 1)
+	if err != nil {
+		t.Fatalf("failed to list definition of done items: %v", err)
+	}
+	if len(items) != 2 {
+		t.Fatalf("expected 2 items, got %d", len(items))
+	}
+	if items[0].Text != "Demo recorded" || items[0].Done {
+		t.Errorf("unexpected first item: %+v", items[0])
+	}
+
+	// Iteration numbers are reused, so deleting the iteration must drop its checklist
+	if err := repo.DeleteIteration(ctx, 1); err != nil {
+		t.Fatalf("failed to delete iteration: %v", err)
+	}
+	items, err = repo.ListIterationDoDItems(ctx, 1)
+	if err != nil {
+		t.Fatalf("failed to list definition of done items: %v", err)
+	}
+	if len(items) != 0 {
+		t.Errorf("expected checklist to be deleted with the iteration, got %d items", len(items))
+	}
+}
+
+func TestIterationDoDErrors(t *testing.T) {
+	db := createTestDB(t)
+	defer db.Close()
+
+	repo := persistence.NewSQLiteIterationRepository(db, createTestLogger(), persistence.NewSQLiteAcceptanceCriteriaRepository(db, createTestLogger()))
+	ctx := context.Background()
+
+	orphan, _ := entities.NewIterationDoDItemEntity(42, "text", time.Now().UTC())
+	if err := repo.SaveIterationDoDItem(ctx, orphan); !errors.Is(err, pluginsdk.ErrNotFound) {
+		t.Errorf("expected ErrNotFound for missing iteration, got: %v", err)
+	}
+
+	if _, err := repo.GetIterationDoDItem(ctx, 99); !errors.Is(err, pluginsdk.ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got: %v", err)
+	}
+
+	orphan.ID = 99
+	if err := repo.UpdateIterationDoDItem(ctx, orphan); !errors.Is(err, pluginsdk.ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got: %v", err)
+	}
+}
+
 func contains(s, substr string) bool {
 	return len(s) >= len(substr) && (s == substr || len(s) > len(substr) && anySubstring(s, substr))
 }
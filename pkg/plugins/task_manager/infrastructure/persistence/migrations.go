@@ -13,7 +13,7 @@ import (
 
 const (
 	// SchemaVersion is the current database schema version
-	SchemaVersion = 8
+	SchemaVersion = 10
 )
 
 // SQL table creation statements
@@ -24,7 +24,8 @@ CREATE TABLE IF NOT EXISTS roadmaps (
     vision TEXT NOT NULL,
     success_criteria TEXT NOT NULL,
     created_at TIMESTAMP NOT NULL,
-    updated_at TIMESTAMP NOT NULL
+    updated_at TIMESTAMP NOT NULL,
+    archived_at TIMESTAMP
 )
 `
 
@@ -61,6 +62,7 @@ CREATE TABLE IF NOT EXISTS tasks (
     status TEXT NOT NULL,
     rank INTEGER NOT NULL DEFAULT 500,
     branch TEXT,
+    assignee TEXT,
     created_at TIMESTAMP NOT NULL,
     updated_at TIMESTAMP NOT NULL,
     FOREIGN KEY(track_id) REFERENCES tracks(id) ON DELETE CASCADE
@@ -137,6 +139,10 @@ CREATE INDEX IF NOT EXISTS idx_tasks_status ON tasks(status)
 
 	createTasksRankIndex = `
 CREATE INDEX IF NOT EXISTS idx_tasks_rank ON tasks(rank)
+`
+
+	createTasksAssigneeIndex = `
+CREATE INDEX IF NOT EXISTS idx_tasks_assignee ON tasks(assignee)
 `
 
 	createIterationsStatusIndex = `
@@ -215,6 +221,127 @@ CREATE INDEX IF NOT EXISTS idx_documents_iteration_number ON documents(iteration
 
 	createDocumentsTypeIndex = `
 CREATE INDEX IF NOT EXISTS idx_documents_type ON documents(type)
+`
+
+	createRoadmapCriteriaTable = `
+CREATE TABLE IF NOT EXISTS roadmap_criteria (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    roadmap_id TEXT NOT NULL,
+    text TEXT NOT NULL,
+    met INTEGER NOT NULL DEFAULT 0,
+    met_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    FOREIGN KEY(roadmap_id) REFERENCES roadmaps(id) ON DELETE CASCADE
+)
+`
+
+	createRoadmapCriteriaRoadmapIDIndex = `
+CREATE INDEX IF NOT EXISTS idx_roadmap_criteria_roadmap_id ON roadmap_criteria(roadmap_id)
+`
+
+	createACTemplatesTable = `
+CREATE TABLE IF NOT EXISTS ac_templates (
+    name TEXT PRIMARY KEY,
+    items TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL
+)
+`
+
+	createTaskNotesTable = `
+CREATE TABLE IF NOT EXISTS task_notes (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    task_id TEXT NOT NULL,
+    content TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    FOREIGN KEY(task_id) REFERENCES tasks(id) ON DELETE CASCADE
+)
+`
+
+	createTaskNotesTaskIDIndex = `
+CREATE INDEX IF NOT EXISTS idx_task_notes_task_id ON task_notes(task_id)
+`
+
+	createIterationTemplatesTable = `
+CREATE TABLE IF NOT EXISTS iteration_templates (
+    name TEXT PRIMARY KEY,
+    goal_pattern TEXT NOT NULL,
+    deliverable TEXT NOT NULL DEFAULT '',
+    usage_count INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL
+)
+`
+
+	createIterationTemplateDoDTable = `
+CREATE TABLE IF NOT EXISTS iteration_template_dod (
+    template_name TEXT NOT NULL,
+    position INTEGER NOT NULL,
+    text TEXT NOT NULL,
+    PRIMARY KEY (template_name, position),
+    FOREIGN KEY(template_name) REFERENCES iteration_templates(name) ON DELETE CASCADE
+)
+`
+
+	createIterationDoDTable = `
+CREATE TABLE IF NOT EXISTS iteration_dod (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    iteration_number INTEGER NOT NULL,
+    text TEXT NOT NULL,
+    done INTEGER NOT NULL DEFAULT 0,
+    done_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    FOREIGN KEY(iteration_number) REFERENCES iterations(number) ON DELETE CASCADE
+)
+`
+
+	createIterationDoDIterationIndex = `
+CREATE INDEX IF NOT EXISTS idx_iteration_dod_iteration ON iteration_dod(iteration_number)
+`
+
+	createACTagsTable = `
+CREATE TABLE IF NOT EXISTS ac_tags (
+    ac_id TEXT NOT NULL,
+    tag TEXT NOT NULL,
+    PRIMARY KEY (ac_id, tag),
+    FOREIGN KEY(ac_id) REFERENCES acceptance_criteria(id) ON DELETE CASCADE
+)
+`
+
+	createACTagsTagIndex = `
+CREATE INDEX IF NOT EXISTS idx_ac_tags_tag ON ac_tags(tag)
+`
+
+	createTaskACGatesTable = `
+CREATE TABLE IF NOT EXISTS task_ac_gates (
+    task_id TEXT NOT NULL,
+    ac_id TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (task_id, ac_id),
+    FOREIGN KEY(task_id) REFERENCES tasks(id) ON DELETE CASCADE,
+    FOREIGN KEY(ac_id) REFERENCES acceptance_criteria(id) ON DELETE CASCADE
+)
+`
+
+	createTaskACGatesACIndex = `
+CREATE INDEX IF NOT EXISTS idx_task_ac_gates_ac_id ON task_ac_gates(ac_id)
+`
+
+	createADRTasksTable = `
+CREATE TABLE IF NOT EXISTS adr_tasks (
+    adr_id TEXT NOT NULL,
+    task_id TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (adr_id, task_id),
+    FOREIGN KEY(adr_id) REFERENCES adrs(id) ON DELETE CASCADE,
+    FOREIGN KEY(task_id) REFERENCES tasks(id) ON DELETE CASCADE
+)
+`
+
+	createADRTasksTaskIndex = `
+CREATE INDEX IF NOT EXISTS idx_adr_tasks_task_id ON adr_tasks(task_id)
 `
 )
 
@@ -301,6 +428,22 @@ func InitSchema(db *sql.DB) error {
 		currentVersion = 8
 	}
 
+	// If we have version 8, run migration
+	if currentVersion == 8 {
+		if err := migrateV8ToV9(db); err != nil {
+			return fmt.Errorf("failed to migrate from v8 to v9: %w", err)
+		}
+		currentVersion = 9
+	}
+
+	// If we have version 9, run migration
+	if currentVersion == 9 {
+		if err := migrateV9ToV10(db); err != nil {
+			return fmt.Errorf("failed to migrate from v9 to v10: %w", err)
+		}
+		currentVersion = 10
+	}
+
 	statements := []string{
 		createRoadmapsTable,
 		createTracksTable,
@@ -312,12 +455,22 @@ func InitSchema(db *sql.DB) error {
 		createAcceptanceCriteriaTable,
 		createADRsTable,
 		createDocumentsTable,
+		createRoadmapCriteriaTable,
+		createACTemplatesTable,
+		createTaskNotesTable,
+		createIterationTemplatesTable,
+		createIterationTemplateDoDTable,
+		createIterationDoDTable,
+		createACTagsTable,
+		createTaskACGatesTable,
+		createADRTasksTable,
 		createTracksRoadmapIDIndex,
 		createTracksStatusIndex,
 		createTracksRankIndex,
 		createTasksTrackIDIndex,
 		createTasksStatusIndex,
 		createTasksRankIndex,
+		createTasksAssigneeIndex,
 		createIterationsStatusIndex,
 		createIterationsRankIndex,
 		createIterationTasksIterationIndex,
@@ -329,6 +482,12 @@ func InitSchema(db *sql.DB) error {
 		createDocumentsTrackIDIndex,
 		createDocumentsIterationNumberIndex,
 		createDocumentsTypeIndex,
+		createRoadmapCriteriaRoadmapIDIndex,
+		createTaskNotesTaskIDIndex,
+		createIterationDoDIterationIndex,
+		createACTagsTagIndex,
+		createTaskACGatesACIndex,
+		createADRTasksTaskIndex,
 	}
 
 	for _, stmt := range statements {
@@ -1130,3 +1289,71 @@ func migrateV7ToV8(db *sql.DB) error {
 	fmt.Println("✓ Migration to schema v8 complete! (Normalized iteration ranks)")
 	return nil
 }
+
+// migrateV8ToV9 adds the assignee column to tasks
+func migrateV8ToV9(db *sql.DB) error {
+	rows, err := db.Query("PRAGMA table_info(tasks)")
+	if err != nil {
+		return fmt.Errorf("failed to get tasks table info: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var cid int
+		var name, typ string
+		var notnull, pk int
+		var dfltValue sql.NullString
+		if err := rows.Scan(&cid, &name, &typ, &notnull, &dfltValue, &pk); err != nil {
+			return fmt.Errorf("failed to scan column info: %w", err)
+		}
+		if name == "assignee" {
+			// Already migrated
+			return nil
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed during column scan: %w", err)
+	}
+	rows.Close()
+
+	if _, err := db.Exec("ALTER TABLE tasks ADD COLUMN assignee TEXT"); err != nil {
+		return fmt.Errorf("failed to add assignee column: %w", err)
+	}
+
+	fmt.Println("✓ Migration to schema v9 complete! (Added task assignee)")
+	return nil
+}
+
+// migrateV9ToV10 adds the archived_at column to roadmaps
+func migrateV9ToV10(db *sql.DB) error {
+	rows, err := db.Query("PRAGMA table_info(roadmaps)")
+	if err != nil {
+		return fmt.Errorf("failed to get roadmaps table info: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var cid int
+		var name, typ string
+		var notnull, pk int
+		var dfltValue sql.NullString
+		if err := rows.Scan(&cid, &name, &typ, &notnull, &dfltValue, &pk); err != nil {
+			return fmt.Errorf("failed to scan column info: %w", err)
+		}
+		if name == "archived_at" {
+			// Already migrated
+			return nil
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed during column scan: %w", err)
+	}
+	rows.Close()
+
+	if _, err := db.Exec("ALTER TABLE roadmaps ADD COLUMN archived_at TIMESTAMP"); err != nil {
+		return fmt.Errorf("failed to add archived_at column: %w", err)
+	}
+
+	fmt.Println("✓ Migration to schema v10 complete! (Added roadmap archiving)")
+	return nil
+}
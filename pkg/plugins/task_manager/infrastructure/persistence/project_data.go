@@ -0,0 +1,41 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+)
+
+// projectDataTables lists the tables holding project content, children before parents.
+// project_metadata is not included: it keeps the project code and schema version.
+var projectDataTables = []string{
+	"ac_tags",
+	"task_ac_gates",
+	"task_notes",
+	"iteration_dod",
+	"iteration_tasks",
+	"acceptance_criteria",
+	"documents",
+	"adr_tasks",
+	"adrs",
+	"tasks",
+	"iterations",
+	"track_dependencies",
+	"tracks",
+	"roadmap_criteria",
+	"roadmaps",
+	"ac_templates",
+	"iteration_template_dod",
+	"iteration_templates",
+}
+
+// ClearProjectData deletes every roadmap, track, task, iteration, AC, ADR, document and
+// template of the project, keeping its metadata. Run it through WithTx to replace the
+// content atomically.
+func (c *SQLiteRepositoryComposite) ClearProjectData(ctx context.Context) error {
+	for _, table := range projectDataTables {
+		if _, err := c.conn().ExecContext(ctx, "DELETE FROM "+table); err != nil {
+			return fmt.Errorf("failed to clear %s: %w", table, err)
+		}
+	}
+	return nil
+}
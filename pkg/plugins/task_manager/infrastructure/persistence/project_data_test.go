@@ -0,0 +1,31 @@
+package persistence_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/infrastructure/persistence"
+)
+
+func TestClearProjectData_RemovesLinks(t *testing.T) {
+	db := createTestDB(t)
+	defer db.Close()
+	ctx := context.Background()
+
+	seedSyncSource(t, db, time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC))
+	if err := persistence.NewSQLiteRepositoryComposite(db, createTestLogger()).ClearProjectData(ctx); err != nil {
+		t.Fatalf("ClearProjectData failed: %v", err)
+	}
+
+	// Link rows would otherwise attach to entities reusing the cleared IDs
+	for _, table := range []string{"adr_tasks", "iteration_tasks", "track_dependencies", "adrs", "tasks", "tracks", "roadmaps"} {
+		var count int
+		if err := db.QueryRowContext(ctx, "SELECT COUNT(*) FROM "+table).Scan(&count); err != nil {
+			t.Fatalf("failed to count %s: %v", table, err)
+		}
+		if count != 0 {
+			t.Errorf("expected %s to be empty, found %d rows", table, count)
+		}
+	}
+}
@@ -0,0 +1,210 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// ReadOnlyRepository is a decorator that wraps a domain.RoadmapRepository and
+// rejects every mutating operation with pluginsdk.ErrReadOnly.
+// Read operations are delegated to the wrapped repository unchanged.
+// It is used by the TUI in --read-only mode.
+type ReadOnlyRepository struct {
+	domain.RoadmapRepository
+}
+
+// Compile-time check that ReadOnlyRepository implements domain.RoadmapRepository
+var _ domain.RoadmapRepository = (*ReadOnlyRepository)(nil)
+var _ domain.TransactionalRepository = (*ReadOnlyRepository)(nil)
+
+// NewReadOnlyRepository creates a new read-only repository decorator.
+func NewReadOnlyRepository(repo domain.RoadmapRepository) *ReadOnlyRepository {
+	return &ReadOnlyRepository{RoadmapRepository: repo}
+}
+
+// WithTx runs fn in a transaction of the wrapped repository, through a read-only view,
+// so mutations inside the transaction are rejected as well.
+// Implements domain.TransactionalRepository.
+func (r *ReadOnlyRepository) WithTx(ctx context.Context, fn func(domain.RoadmapRepository) error) error {
+	txRepo, ok := r.RoadmapRepository.(domain.TransactionalRepository)
+	if !ok {
+		return fmt.Errorf("repository does not support transactions")
+	}
+	return txRepo.WithTx(ctx, func(repo domain.RoadmapRepository) error {
+		return fn(NewReadOnlyRepository(repo))
+	})
+}
+
+// errReadOnly builds the error returned by all mutating operations
+func errReadOnly(operation string) error {
+	return fmt.Errorf("%w: %s is not allowed in read-only mode", pluginsdk.ErrReadOnly, operation)
+}
+
+// SaveRoadmap rejects the operation in read-only mode.
+func (r *ReadOnlyRepository) SaveRoadmap(ctx context.Context, roadmap *entities.RoadmapEntity) error {
+	return errReadOnly("SaveRoadmap")
+}
+
+// SetActiveRoadmap rejects the operation in read-only mode.
+func (r *ReadOnlyRepository) SetActiveRoadmap(ctx context.Context, id string) error {
+	return errReadOnly("SetActiveRoadmap")
+}
+
+// UpdateRoadmap rejects the operation in read-only mode.
+func (r *ReadOnlyRepository) UpdateRoadmap(ctx context.Context, roadmap *entities.RoadmapEntity) error {
+	return errReadOnly("UpdateRoadmap")
+}
+
+// SaveRoadmapCriterion rejects the operation in read-only mode.
+func (r *ReadOnlyRepository) SaveRoadmapCriterion(ctx context.Context, criterion *entities.RoadmapCriterionEntity) error {
+	return errReadOnly("SaveRoadmapCriterion")
+}
+
+// UpdateRoadmapCriterion rejects the operation in read-only mode.
+func (r *ReadOnlyRepository) UpdateRoadmapCriterion(ctx context.Context, criterion *entities.RoadmapCriterionEntity) error {
+	return errReadOnly("UpdateRoadmapCriterion")
+}
+
+// SaveTrack rejects the operation in read-only mode.
+func (r *ReadOnlyRepository) SaveTrack(ctx context.Context, track *entities.TrackEntity) error {
+	return errReadOnly("SaveTrack")
+}
+
+// UpdateTrack rejects the operation in read-only mode.
+func (r *ReadOnlyRepository) UpdateTrack(ctx context.Context, track *entities.TrackEntity) error {
+	return errReadOnly("UpdateTrack")
+}
+
+// DeleteTrack rejects the operation in read-only mode.
+func (r *ReadOnlyRepository) DeleteTrack(ctx context.Context, id string) error {
+	return errReadOnly("DeleteTrack")
+}
+
+// AddTrackDependency rejects the operation in read-only mode.
+func (r *ReadOnlyRepository) AddTrackDependency(ctx context.Context, trackID, dependsOnID string) error {
+	return errReadOnly("AddTrackDependency")
+}
+
+// RemoveTrackDependency rejects the operation in read-only mode.
+func (r *ReadOnlyRepository) RemoveTrackDependency(ctx context.Context, trackID, dependsOnID string) error {
+	return errReadOnly("RemoveTrackDependency")
+}
+
+// SaveTask rejects the operation in read-only mode.
+func (r *ReadOnlyRepository) SaveTask(ctx context.Context, task *entities.TaskEntity) error {
+	return errReadOnly("SaveTask")
+}
+
+// UpdateTask rejects the operation in read-only mode.
+func (r *ReadOnlyRepository) UpdateTask(ctx context.Context, task *entities.TaskEntity) error {
+	return errReadOnly("UpdateTask")
+}
+
+// DeleteTask rejects the operation in read-only mode.
+func (r *ReadOnlyRepository) DeleteTask(ctx context.Context, id string) error {
+	return errReadOnly("DeleteTask")
+}
+
+// MoveTaskToTrack rejects the operation in read-only mode.
+func (r *ReadOnlyRepository) MoveTaskToTrack(ctx context.Context, taskID, newTrackID string) error {
+	return errReadOnly("MoveTaskToTrack")
+}
+
+// SaveIteration rejects the operation in read-only mode.
+func (r *ReadOnlyRepository) SaveIteration(ctx context.Context, iteration *entities.IterationEntity) error {
+	return errReadOnly("SaveIteration")
+}
+
+// UpdateIteration rejects the operation in read-only mode.
+func (r *ReadOnlyRepository) UpdateIteration(ctx context.Context, iteration *entities.IterationEntity) error {
+	return errReadOnly("UpdateIteration")
+}
+
+// DeleteIteration rejects the operation in read-only mode.
+func (r *ReadOnlyRepository) DeleteIteration(ctx context.Context, number int) error {
+	return errReadOnly("DeleteIteration")
+}
+
+// AddTaskToIteration rejects the operation in read-only mode.
+func (r *ReadOnlyRepository) AddTaskToIteration(ctx context.Context, iterationNum int, taskID string) error {
+	return errReadOnly("AddTaskToIteration")
+}
+
+// RemoveTaskFromIteration rejects the operation in read-only mode.
+func (r *ReadOnlyRepository) RemoveTaskFromIteration(ctx context.Context, iterationNum int, taskID string) error {
+	return errReadOnly("RemoveTaskFromIteration")
+}
+
+// StartIteration rejects the operation in read-only mode.
+func (r *ReadOnlyRepository) StartIteration(ctx context.Context, iterationNumber int) error {
+	return errReadOnly("StartIteration")
+}
+
+// CompleteIteration rejects the operation in read-only mode.
+func (r *ReadOnlyRepository) CompleteIteration(ctx context.Context, iterationNumber int) error {
+	return errReadOnly("CompleteIteration")
+}
+
+// RevertIteration rejects the operation in read-only mode.
+func (r *ReadOnlyRepository) RevertIteration(ctx context.Context, iterationNumber int) error {
+	return errReadOnly("RevertIteration")
+}
+
+// SaveADR rejects the operation in read-only mode.
+func (r *ReadOnlyRepository) SaveADR(ctx context.Context, adr *entities.ADREntity) error {
+	return errReadOnly("SaveADR")
+}
+
+// UpdateADR rejects the operation in read-only mode.
+func (r *ReadOnlyRepository) UpdateADR(ctx context.Context, adr *entities.ADREntity) error {
+	return errReadOnly("UpdateADR")
+}
+
+// SupersedeADR rejects the operation in read-only mode.
+func (r *ReadOnlyRepository) SupersedeADR(ctx context.Context, adrID, supersededByID string) error {
+	return errReadOnly("SupersedeADR")
+}
+
+// DeprecateADR rejects the operation in read-only mode.
+func (r *ReadOnlyRepository) DeprecateADR(ctx context.Context, adrID string) error {
+	return errReadOnly("DeprecateADR")
+}
+
+// SaveAC rejects the operation in read-only mode.
+func (r *ReadOnlyRepository) SaveAC(ctx context.Context, ac *entities.AcceptanceCriteriaEntity) error {
+	return errReadOnly("SaveAC")
+}
+
+// UpdateAC rejects the operation in read-only mode.
+func (r *ReadOnlyRepository) UpdateAC(ctx context.Context, ac *entities.AcceptanceCriteriaEntity) error {
+	return errReadOnly("UpdateAC")
+}
+
+// DeleteAC rejects the operation in read-only mode.
+func (r *ReadOnlyRepository) DeleteAC(ctx context.Context, id string) error {
+	return errReadOnly("DeleteAC")
+}
+
+// SaveTaskNote rejects the operation in read-only mode.
+func (r *ReadOnlyRepository) SaveTaskNote(ctx context.Context, note *entities.TaskNoteEntity) error {
+	return errReadOnly("SaveTaskNote")
+}
+
+// AddACTag rejects the operation in read-only mode.
+func (r *ReadOnlyRepository) AddACTag(ctx context.Context, acID, tag string) error {
+	return errReadOnly("AddACTag")
+}
+
+// SetProjectMetadata rejects the operation in read-only mode.
+func (r *ReadOnlyRepository) SetProjectMetadata(ctx context.Context, key, value string) error {
+	return errReadOnly("SetProjectMetadata")
+}
+
+// GetNextSequenceNumber rejects the operation in read-only mode.
+func (r *ReadOnlyRepository) GetNextSequenceNumber(ctx context.Context, entityType string) (int, error) {
+	return 0, errReadOnly("GetNextSequenceNumber")
+}
@@ -0,0 +1,91 @@
+package persistence_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/infrastructure/persistence"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// ============================================================================
+// Read-Only Repository Tests
+// ============================================================================
+
+func TestReadOnlyRepository_RejectsWrites(t *testing.T) {
+	db := createTestDB(t)
+	defer db.Close()
+
+	ctx := context.Background()
+	composite := persistence.NewSQLiteRepositoryComposite(db, createTestLogger())
+
+	now := time.Now().UTC()
+	roadmap, _ := entities.NewRoadmapEntity("roadmap-1", "vision", "criteria", now, now)
+	if err := composite.SaveRoadmap(ctx, roadmap); err != nil {
+		t.Fatalf("failed to save roadmap: %v", err)
+	}
+	track, _ := entities.NewTrackEntity("track-1", "roadmap-1", "Track", "", "not-started", 200, []string{}, now, now)
+	if err := composite.SaveTrack(ctx, track); err != nil {
+		t.Fatalf("failed to save track: %v", err)
+	}
+	task, _ := entities.NewTaskEntity("task-1", "track-1", "Task", "", "todo", 200, "", now, now)
+	if err := composite.SaveTask(ctx, task); err != nil {
+		t.Fatalf("failed to save task: %v", err)
+	}
+
+	repo := persistence.NewReadOnlyRepository(composite)
+
+	// Reads are delegated
+	got, err := repo.GetTask(ctx, "task-1")
+	if err != nil {
+		t.Fatalf("GetTask should succeed in read-only mode: %v", err)
+	}
+
+	// Writes are rejected and leave storage untouched
+	got.Status = "done"
+	if err := repo.UpdateTask(ctx, got); !errors.Is(err, pluginsdk.ErrReadOnly) {
+		t.Errorf("expected ErrReadOnly from UpdateTask, got: %v", err)
+	}
+	if err := repo.StartIteration(ctx, 1); !errors.Is(err, pluginsdk.ErrReadOnly) {
+		t.Errorf("expected ErrReadOnly from StartIteration, got: %v", err)
+	}
+	if _, err := repo.GetNextSequenceNumber(ctx, "task"); !errors.Is(err, pluginsdk.ErrReadOnly) {
+		t.Errorf("expected ErrReadOnly from GetNextSequenceNumber, got: %v", err)
+	}
+	if err := repo.AddACTag(ctx, "ac-1", "smoke"); !errors.Is(err, pluginsdk.ErrReadOnly) {
+		t.Errorf("expected ErrReadOnly from AddACTag, got: %v", err)
+	}
+
+	stored, err := composite.GetTask(ctx, "task-1")
+	if err != nil {
+		t.Fatalf("failed to get task: %v", err)
+	}
+	if stored.Status != "todo" {
+		t.Errorf("expected stored status to stay todo, got %s", stored.Status)
+	}
+}
+
+func TestReadOnlyRepository_WithTxRejectsWrites(t *testing.T) {
+	db := createTestDB(t)
+	defer db.Close()
+
+	ctx := context.Background()
+	composite := persistence.NewSQLiteRepositoryComposite(db, createTestLogger())
+	repo := persistence.NewReadOnlyRepository(composite)
+
+	now := time.Now().UTC()
+	roadmap, _ := entities.NewRoadmapEntity("roadmap-1", "vision", "criteria", now, now)
+	err := repo.WithTx(ctx, func(tx domain.RoadmapRepository) error {
+		return tx.SaveRoadmap(ctx, roadmap)
+	})
+	if !errors.Is(err, pluginsdk.ErrReadOnly) {
+		t.Errorf("expected ErrReadOnly from a write inside WithTx, got: %v", err)
+	}
+	if _, err := composite.GetRoadmap(ctx, "roadmap-1"); !errors.Is(err, pluginsdk.ErrNotFound) {
+		t.Errorf("expected no roadmap to be saved, got: %v", err)
+	}
+}
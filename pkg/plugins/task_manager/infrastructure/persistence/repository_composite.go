@@ -25,37 +25,76 @@ type SQLiteRepositoryComposite struct {
 	AC        repositories.AcceptanceCriteriaRepository
 	Document  repositories.DocumentRepository
 	Aggregate repositories.AggregateRepository
+	Sync      repositories.SyncRepository
 
-	DB     *sql.DB
-	logger pluginsdk.Logger
+	DB          *sql.DB
+	tx          *sql.Tx // set when the composite is bound to a transaction by WithTx
+	logger      pluginsdk.Logger
+	retryPolicy WriteRetryPolicy
 }
 
-// NewSQLiteRepositoryComposite creates a composite repository with all focused repositories.
+// NewSQLiteRepositoryComposite creates a composite repository with all focused repositories,
+// retrying writes with DefaultWriteRetryPolicy.
 func NewSQLiteRepositoryComposite(db *sql.DB, logger pluginsdk.Logger) *SQLiteRepositoryComposite {
+	return newSQLiteRepositoryComposite(db, nil, logger, DefaultWriteRetryPolicy())
+}
+
+// NewSQLiteRepositoryCompositeWithRetryPolicy creates a composite repository whose writes
+// are retried as policy allows while the database is busy or locked.
+func NewSQLiteRepositoryCompositeWithRetryPolicy(db *sql.DB, logger pluginsdk.Logger, policy WriteRetryPolicy) *SQLiteRepositoryComposite {
+	return newSQLiteRepositoryComposite(db, nil, logger, policy)
+}
+
+// newSQLiteRepositoryComposite creates a composite whose focused repositories run on tx,
+// or on db when tx is nil.
+func newSQLiteRepositoryComposite(db *sql.DB, tx *sql.Tx, logger pluginsdk.Logger, policy WriteRetryPolicy) *SQLiteRepositoryComposite {
+	var conn DBTX = db
+	if tx != nil {
+		conn = tx
+	}
+
 	// Create AC repository first since iteration repository depends on it
-	acRepo := NewSQLiteAcceptanceCriteriaRepository(db, logger)
+	acRepo := NewSQLiteAcceptanceCriteriaRepository(conn, logger)
+	acRepo.retryPolicy = policy
+	trackRepo := NewSQLiteTrackRepository(conn, logger)
+	trackRepo.retryPolicy = policy
+	taskRepo := NewSQLiteTaskRepository(conn, logger)
+	taskRepo.retryPolicy = policy
+	iterationRepo := NewSQLiteIterationRepository(conn, logger, acRepo)
+	iterationRepo.retryPolicy = policy
 
 	return &SQLiteRepositoryComposite{
-		Roadmap:   NewSQLiteRoadmapOnlyRepository(db, logger),
-		Track:     NewSQLiteTrackRepository(db, logger),
-		Task:      NewSQLiteTaskRepository(db, logger),
-		Iteration: NewSQLiteIterationRepository(db, logger, acRepo),
-		ADR:       NewSQLiteADRRepository(db, logger),
-		AC:        acRepo,
-		Document:  NewSQLiteDocumentRepository(db),
-		Aggregate: NewSQLiteAggregateRepository(db, logger),
-		DB:        db,
-		logger:    logger,
+		Roadmap:     NewSQLiteRoadmapOnlyRepository(conn, logger),
+		Track:       trackRepo,
+		Task:        taskRepo,
+		Iteration:   iterationRepo,
+		ADR:         NewSQLiteADRRepository(conn, logger),
+		AC:          acRepo,
+		Document:    NewSQLiteDocumentRepository(conn),
+		Aggregate:   NewSQLiteAggregateRepository(conn, logger),
+		Sync:        NewSQLiteSyncRepository(conn, logger),
+		DB:          db,
+		tx:          tx,
+		logger:      logger,
+		retryPolicy: policy,
 	}
 }
 
+// conn returns the handle queries run on: the bound transaction, if any, or the database.
+func (c *SQLiteRepositoryComposite) conn() DBTX {
+	if c.tx != nil {
+		return c.tx
+	}
+	return c.DB
+}
+
 // GetDB returns the database connection (for migration command).
 func (c *SQLiteRepositoryComposite) GetDB() *sql.DB {
 	return c.DB
 }
 
 // ============================================================================
-// Roadmap operations (4 methods) - delegate to Roadmap repository
+// Roadmap operations (10 methods) - delegate to Roadmap repository
 // ============================================================================
 
 // SaveRoadmap persists a new roadmap to storage.
@@ -68,16 +107,46 @@ func (c *SQLiteRepositoryComposite) GetRoadmap(ctx context.Context, id string) (
 	return c.Roadmap.GetRoadmap(ctx, id)
 }
 
-// GetActiveRoadmap retrieves the most recently created roadmap.
+// GetActiveRoadmap retrieves the activated roadmap, or the most recently created unarchived one.
 func (c *SQLiteRepositoryComposite) GetActiveRoadmap(ctx context.Context) (*entities.RoadmapEntity, error) {
 	return c.Roadmap.GetActiveRoadmap(ctx)
 }
 
+// ListRoadmaps returns all roadmaps, including archived ones, in creation order.
+func (c *SQLiteRepositoryComposite) ListRoadmaps(ctx context.Context) ([]*entities.RoadmapEntity, error) {
+	return c.Roadmap.ListRoadmaps(ctx)
+}
+
+// SetActiveRoadmap makes a roadmap the active one.
+func (c *SQLiteRepositoryComposite) SetActiveRoadmap(ctx context.Context, id string) error {
+	return c.Roadmap.SetActiveRoadmap(ctx, id)
+}
+
 // UpdateRoadmap updates an existing roadmap.
 func (c *SQLiteRepositoryComposite) UpdateRoadmap(ctx context.Context, roadmap *entities.RoadmapEntity) error {
 	return c.Roadmap.UpdateRoadmap(ctx, roadmap)
 }
 
+// SaveRoadmapCriterion persists a new success criterion and assigns its ID.
+func (c *SQLiteRepositoryComposite) SaveRoadmapCriterion(ctx context.Context, criterion *entities.RoadmapCriterionEntity) error {
+	return c.Roadmap.SaveRoadmapCriterion(ctx, criterion)
+}
+
+// GetRoadmapCriterion retrieves a success criterion by its ID.
+func (c *SQLiteRepositoryComposite) GetRoadmapCriterion(ctx context.Context, id int) (*entities.RoadmapCriterionEntity, error) {
+	return c.Roadmap.GetRoadmapCriterion(ctx, id)
+}
+
+// ListRoadmapCriteria returns all success criteria of a roadmap in creation order.
+func (c *SQLiteRepositoryComposite) ListRoadmapCriteria(ctx context.Context, roadmapID string) ([]*entities.RoadmapCriterionEntity, error) {
+	return c.Roadmap.ListRoadmapCriteria(ctx, roadmapID)
+}
+
+// UpdateRoadmapCriterion updates an existing success criterion.
+func (c *SQLiteRepositoryComposite) UpdateRoadmapCriterion(ctx context.Context, criterion *entities.RoadmapCriterionEntity) error {
+	return c.Roadmap.UpdateRoadmapCriterion(ctx, criterion)
+}
+
 // ============================================================================
 // Track operations (10 methods) - delegate to Track repository
 // ============================================================================
@@ -166,9 +235,15 @@ func (c *SQLiteRepositoryComposite) MoveTaskToTrack(ctx context.Context, taskID,
 	return c.Task.MoveTaskToTrack(ctx, taskID, newTrackID)
 }
 
-// GetBacklogTasks returns all tasks that are not in any iteration and not done.
-func (c *SQLiteRepositoryComposite) GetBacklogTasks(ctx context.Context) ([]*entities.TaskEntity, error) {
-	return c.Task.GetBacklogTasks(ctx)
+// GetBacklogTasks returns all tasks that are not in any iteration and not done,
+// limited to a roadmap unless roadmapID is empty.
+func (c *SQLiteRepositoryComposite) GetBacklogTasks(ctx context.Context, roadmapID string) ([]*entities.TaskEntity, error) {
+	return c.Task.GetBacklogTasks(ctx, roadmapID)
+}
+
+// SaveTaskNote persists a new note for a task and assigns its ID.
+func (c *SQLiteRepositoryComposite) SaveTaskNote(ctx context.Context, note *entities.TaskNoteEntity) error {
+	return c.Task.SaveTaskNote(ctx, note)
 }
 
 // ============================================================================
@@ -195,6 +270,11 @@ func (c *SQLiteRepositoryComposite) ListIterations(ctx context.Context) ([]*enti
 	return c.Iteration.ListIterations(ctx)
 }
 
+// ListIterationsByRoadmap returns the iterations of a roadmap and those without tasks.
+func (c *SQLiteRepositoryComposite) ListIterationsByRoadmap(ctx context.Context, roadmapID string) ([]*entities.IterationEntity, error) {
+	return c.Iteration.ListIterationsByRoadmap(ctx, roadmapID)
+}
+
 // UpdateIteration updates an existing iteration.
 func (c *SQLiteRepositoryComposite) UpdateIteration(ctx context.Context, iteration *entities.IterationEntity) error {
 	return c.Iteration.UpdateIteration(ctx, iteration)
@@ -205,6 +285,11 @@ func (c *SQLiteRepositoryComposite) DeleteIteration(ctx context.Context, number
 	return c.Iteration.DeleteIteration(ctx, number)
 }
 
+// ListIterationDoDItems returns the definition of done items of an iteration.
+func (c *SQLiteRepositoryComposite) ListIterationDoDItems(ctx context.Context, iterationNum int) ([]*entities.IterationDoDItemEntity, error) {
+	return c.Iteration.ListIterationDoDItems(ctx, iterationNum)
+}
+
 // AddTaskToIteration adds a task to an iteration.
 func (c *SQLiteRepositoryComposite) AddTaskToIteration(ctx context.Context, iterationNum int, taskID string) error {
 	return c.Iteration.AddTaskToIteration(ctx, iterationNum, taskID)
@@ -251,8 +336,13 @@ func (c *SQLiteRepositoryComposite) GetIterationsForTask(ctx context.Context, ta
 	return c.Task.GetIterationsForTask(ctx, taskID)
 }
 
+// ListTaskGates returns the acceptance criteria a task is gated on.
+func (c *SQLiteRepositoryComposite) ListTaskGates(ctx context.Context, taskID string) ([]*entities.AcceptanceCriteriaEntity, error) {
+	return c.Task.ListTaskGates(ctx, taskID)
+}
+
 // ============================================================================
-// ADR operations (7 methods) - delegate to ADR repository
+// ADR operations (9 methods) - delegate to ADR repository
 // ============================================================================
 
 // SaveADR persists a new ADR to storage.
@@ -290,6 +380,16 @@ func (c *SQLiteRepositoryComposite) GetADRsByTrack(ctx context.Context, trackID
 	return c.ADR.GetADRsByTrack(ctx, trackID)
 }
 
+// ListADRTasks returns the tasks linked to an ADR.
+func (c *SQLiteRepositoryComposite) ListADRTasks(ctx context.Context, adrID string) ([]*entities.TaskEntity, error) {
+	return c.ADR.ListADRTasks(ctx, adrID)
+}
+
+// ListTaskADRs returns the ADRs a task is linked to.
+func (c *SQLiteRepositoryComposite) ListTaskADRs(ctx context.Context, taskID string) ([]*entities.ADREntity, error) {
+	return c.ADR.ListTaskADRs(ctx, taskID)
+}
+
 // ============================================================================
 // Acceptance Criteria operations (8 methods) - delegate to AC repository
 // ============================================================================
@@ -327,7 +427,7 @@ func (c *SQLiteRepositoryComposite) ListACByTask(ctx context.Context, taskID str
 // ListACByTrack returns all acceptance criteria for all tasks in a track.
 // NOTE: This is a cross-entity query not yet in focused repositories, implemented directly.
 func (c *SQLiteRepositoryComposite) ListACByTrack(ctx context.Context, trackID string) ([]*entities.AcceptanceCriteriaEntity, error) {
-	rows, err := c.DB.QueryContext(
+	rows, err := c.conn().QueryContext(
 		ctx,
 		`SELECT ac.id, ac.task_id, ac.description, ac.verification_type, ac.status, ac.notes, ac.testing_instructions, ac.created_at, ac.updated_at
 		 FROM acceptance_criteria ac
@@ -368,6 +468,26 @@ func (c *SQLiteRepositoryComposite) ListFailedAC(ctx context.Context, filters en
 	return c.AC.ListFailedAC(ctx, filters)
 }
 
+// ListACs returns the acceptance criteria matching the filters.
+func (c *SQLiteRepositoryComposite) ListACs(ctx context.Context, filters entities.ACFilters) ([]*entities.AcceptanceCriteriaEntity, error) {
+	return c.AC.ListACs(ctx, filters)
+}
+
+// CountACProgressByTask counts the acceptance criteria of each of the given tasks.
+func (c *SQLiteRepositoryComposite) CountACProgressByTask(ctx context.Context, taskIDs []string) (map[string]entities.ACProgress, error) {
+	return c.AC.CountACProgressByTask(ctx, taskIDs)
+}
+
+// ListTaskACCoverage returns the tasks matching the filters with their AC progress.
+func (c *SQLiteRepositoryComposite) ListTaskACCoverage(ctx context.Context, filters entities.ACFilters, roadmapID string) ([]*entities.TaskACCoverage, error) {
+	return c.AC.ListTaskACCoverage(ctx, filters, roadmapID)
+}
+
+// AddACTag tags an acceptance criterion.
+func (c *SQLiteRepositoryComposite) AddACTag(ctx context.Context, acID, tag string) error {
+	return c.AC.AddACTag(ctx, acID, tag)
+}
+
 // ============================================================================
 // Aggregate queries (2 methods) - delegate to Aggregate repository
 // ============================================================================
@@ -471,7 +471,7 @@ func TestComposite_GetBacklogTasks_DelegatesToTaskRepo(t *testing.T) {
 	task, _ := entities.NewTaskEntity("task-1", "track-1", "Task", "", "todo", 100, "", time.Now().UTC(), time.Now().UTC())
 	composite.SaveTask(ctx, task)
 
-	backlog, err := composite.GetBacklogTasks(ctx)
+	backlog, err := composite.GetBacklogTasks(ctx, "")
 	if err != nil {
 		t.Fatalf("GetBacklogTasks failed: %v", err)
 	}
@@ -0,0 +1,87 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+	"github.com/mattn/go-sqlite3"
+)
+
+// WriteRetryPolicy controls how repository writes are retried when SQLite reports the
+// database as busy or locked, e.g. while an agent and a human write to the same
+// roadmap.db at once.
+type WriteRetryPolicy struct {
+	MaxAttempts int           // Total attempts including the first; 1 disables retries
+	BaseDelay   time.Duration // Backoff before the second attempt, doubled for each retry
+	MaxDelay    time.Duration // Upper bound of a single backoff
+}
+
+// DefaultWriteRetryPolicy returns the policy repositories use unless one is injected
+// with NewSQLiteRepositoryCompositeWithRetryPolicy
+func DefaultWriteRetryPolicy() WriteRetryPolicy {
+	return WriteRetryPolicy{
+		MaxAttempts: 5,
+		BaseDelay:   25 * time.Millisecond,
+		MaxDelay:    time.Second,
+	}
+}
+
+// isBusyError reports whether err is SQLite's SQLITE_BUSY or SQLITE_LOCKED
+func isBusyError(err error) bool {
+	var sqliteErr sqlite3.Error
+	if errors.As(err, &sqliteErr) {
+		return sqliteErr.Code == sqlite3.ErrBusy || sqliteErr.Code == sqlite3.ErrLocked
+	}
+	return false
+}
+
+// markConcurrentModification wraps a busy or locked error in
+// pluginsdk.ErrConcurrentModification, so callers and dw's exit code can tell a lost
+// race from other failures; other errors are returned unchanged
+func markConcurrentModification(err error) error {
+	if isBusyError(err) {
+		return fmt.Errorf("%w: %w", pluginsdk.ErrConcurrentModification, err)
+	}
+	return err
+}
+
+// retryWrite runs the write operation op, retrying it with jittered exponential backoff
+// as policy allows while it fails with a busy or locked error. Attempts below 1 are
+// treated as 1. The last error is returned once the
+// attempts are exhausted, marked as pluginsdk.ErrConcurrentModification. Writes on a
+// transaction are not retried: SQLite may have rolled the transaction back, so only
+// its owner can safely start over.
+func retryWrite(ctx context.Context, conn DBTX, policy WriteRetryPolicy, op func() error) error {
+	if _, inTx := conn.(*sql.Tx); inTx {
+		return markConcurrentModification(op())
+	}
+
+	delay := policy.BaseDelay
+	for attempt := 1; ; attempt++ {
+		err := op()
+		if err == nil || attempt >= policy.MaxAttempts || !isBusyError(err) {
+			return markConcurrentModification(err)
+		}
+
+		// Full jitter in [delay/2, delay) keeps concurrent writers from retrying in lockstep
+		wait := delay / 2
+		if half := int64(delay - wait); half > 0 {
+			wait += time.Duration(rand.Int64N(half))
+		}
+		select {
+		case <-ctx.Done():
+			return markConcurrentModification(err)
+		case <-time.After(wait):
+		}
+
+		delay *= 2
+		if delay > policy.MaxDelay {
+			delay = policy.MaxDelay
+		}
+	}
+}
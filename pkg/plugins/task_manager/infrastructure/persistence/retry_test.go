@@ -0,0 +1,142 @@
+package persistence_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/infrastructure/persistence"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// setupLockedTaskDB creates a database holding one task and a second connection that
+// holds its write lock. The returned repository makes up to attempts write attempts,
+// and unlock releases the lock. The repository's connection has SQLite's busy timeout
+// disabled so that every write attempt made while the lock is held fails with
+// SQLITE_BUSY right away.
+func setupLockedTaskDB(t *testing.T, attempts int) (*persistence.SQLiteRepositoryComposite, *entities.TaskEntity, func()) {
+	t.Helper()
+	ctx := context.Background()
+	dbPath := filepath.Join(t.TempDir(), "test.db")
+
+	db, err := sql.Open("sqlite3", dbPath+"?_busy_timeout=0")
+	if err != nil {
+		t.Fatalf("failed to open test database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	if err := persistence.InitSchema(db); err != nil {
+		t.Fatalf("failed to initialize schema: %v", err)
+	}
+
+	repo := persistence.NewSQLiteRepositoryCompositeWithRetryPolicy(db, createTestLogger(), persistence.WriteRetryPolicy{
+		MaxAttempts: attempts,
+		BaseDelay:   5 * time.Millisecond,
+		MaxDelay:    20 * time.Millisecond,
+	})
+
+	roadmap, _ := entities.NewRoadmapEntity("roadmap-1", "vision", "criteria", time.Now().UTC(), time.Now().UTC())
+	repo.SaveRoadmap(ctx, roadmap)
+	track, _ := entities.NewTrackEntity("track-1", "roadmap-1", "Track", "", "not-started", 200, []string{}, time.Now().UTC(), time.Now().UTC())
+	repo.SaveTrack(ctx, track)
+	task, _ := entities.NewTaskEntity("task-1", "track-1", "Task", "", "todo", 200, "", time.Now().UTC(), time.Now().UTC())
+	if err := repo.SaveTask(ctx, task); err != nil {
+		t.Fatalf("failed to save task: %v", err)
+	}
+
+	lockerDB, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		t.Fatalf("failed to open locking connection: %v", err)
+	}
+	t.Cleanup(func() { lockerDB.Close() })
+	conn, err := lockerDB.Conn(ctx)
+	if err != nil {
+		t.Fatalf("failed to get locking connection: %v", err)
+	}
+	if _, err := conn.ExecContext(ctx, "BEGIN IMMEDIATE"); err != nil {
+		t.Fatalf("failed to lock database: %v", err)
+	}
+
+	unlock := func() {
+		conn.ExecContext(ctx, "COMMIT")
+		conn.Close()
+	}
+	t.Cleanup(unlock)
+	return repo, task, unlock
+}
+
+func TestWriteRetry_SucceedsOnceLockIsReleased(t *testing.T) {
+	taskRepo, task, unlock := setupLockedTaskDB(t, 100)
+
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		unlock()
+	}()
+
+	task.Title = "Updated while locked"
+	if err := taskRepo.UpdateTask(context.Background(), task); err != nil {
+		t.Fatalf("UpdateTask should succeed after the lock is released, got: %v", err)
+	}
+
+	retrieved, err := taskRepo.GetTask(context.Background(), "task-1")
+	if err != nil {
+		t.Fatalf("failed to get task: %v", err)
+	}
+	if retrieved.Title != "Updated while locked" {
+		t.Errorf("expected updated title, got %q", retrieved.Title)
+	}
+}
+
+func TestWriteRetry_SurfacesBusyErrorWhenExhausted(t *testing.T) {
+	taskRepo, task, _ := setupLockedTaskDB(t, 3)
+
+	start := time.Now()
+	task.Title = "Never written"
+	err := taskRepo.UpdateTask(context.Background(), task)
+	if err == nil {
+		t.Fatal("UpdateTask should fail while the database stays locked")
+	}
+	if !strings.Contains(err.Error(), "locked") && !strings.Contains(err.Error(), "busy") {
+		t.Errorf("expected a busy/locked error, got: %v", err)
+	}
+	if !errors.Is(err, pluginsdk.ErrConcurrentModification) {
+		t.Errorf("expected ErrConcurrentModification, got: %v", err)
+	}
+	// Two backoffs of at most 5ms and 10ms; anything near a second means the
+	// busy timeout kicked in or retries did not stop
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("retries took %v, expected them to stop after 3 attempts", elapsed)
+	}
+}
+
+func TestWriteRetry_ReadsAreNotBlocked(t *testing.T) {
+	taskRepo, _, _ := setupLockedTaskDB(t, 1)
+
+	// A write lock still allows readers, so reads never need to retry
+	if _, err := taskRepo.GetTask(context.Background(), "task-1"); err != nil {
+		t.Fatalf("GetTask should not be affected by a write lock, got: %v", err)
+	}
+}
+
+func TestWriteRetry_TrackWrites(t *testing.T) {
+	repo, _, unlock := setupLockedTaskDB(t, 100)
+	ctx := context.Background()
+
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		unlock()
+	}()
+
+	track, _ := entities.NewTrackEntity("track-2", "roadmap-1", "Second", "", "not-started", 300, []string{}, time.Now().UTC(), time.Now().UTC())
+	if err := repo.SaveTrack(ctx, track); err != nil {
+		t.Fatalf("SaveTrack should succeed after the lock is released, got: %v", err)
+	}
+	track.Title = "Second, renamed"
+	if err := repo.UpdateTrack(ctx, track); err != nil {
+		t.Fatalf("UpdateTrack failed: %v", err)
+	}
+}
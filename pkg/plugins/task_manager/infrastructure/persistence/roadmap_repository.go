@@ -3,6 +3,7 @@ package persistence
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
@@ -15,14 +16,14 @@ var _ repositories.RoadmapRepository = (*SQLiteRoadmapRepository)(nil)
 
 // SQLiteRoadmapRepository implements repositories.RoadmapRepository using SQLite as the backend.
 type SQLiteRoadmapRepository struct {
-	DB     *sql.DB
+	DB     DBTX
 	logger pluginsdk.Logger
 }
 
 // NewSQLiteRoadmapOnlyRepository creates a new SQLite-backed roadmap-focused repository.
 // This is internal to the persistence layer and not exported for general use.
 // Use NewSQLiteRepositoryComposite for the full repository interface.
-func NewSQLiteRoadmapOnlyRepository(db *sql.DB, logger pluginsdk.Logger) *SQLiteRoadmapRepository {
+func NewSQLiteRoadmapOnlyRepository(db DBTX, logger pluginsdk.Logger) *SQLiteRoadmapRepository {
 	return &SQLiteRoadmapRepository{
 		DB:     db,
 		logger: logger,
@@ -47,8 +48,8 @@ func (r *SQLiteRoadmapRepository) SaveRoadmap(ctx context.Context, roadmap *enti
 
 	_, err = r.DB.ExecContext(
 		ctx,
-		"INSERT INTO roadmaps (id, vision, success_criteria, created_at, updated_at) VALUES (?, ?, ?, ?, ?)",
-		roadmap.ID, roadmap.Vision, roadmap.SuccessCriteria, roadmap.CreatedAt, roadmap.UpdatedAt,
+		"INSERT INTO roadmaps (id, vision, success_criteria, created_at, updated_at, archived_at) VALUES (?, ?, ?, ?, ?, ?)",
+		roadmap.ID, roadmap.Vision, roadmap.SuccessCriteria, roadmap.CreatedAt, roadmap.UpdatedAt, roadmap.ArchivedAt,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to insert roadmap: %w", err)
@@ -57,15 +58,32 @@ func (r *SQLiteRoadmapRepository) SaveRoadmap(ctx context.Context, roadmap *enti
 	return nil
 }
 
-// GetRoadmap retrieves a roadmap by its ID.
-func (r *SQLiteRoadmapRepository) GetRoadmap(ctx context.Context, id string) (*entities.RoadmapEntity, error) {
+// roadmapColumns are the roadmaps columns read by scanRoadmap
+const roadmapColumns = "id, vision, success_criteria, created_at, updated_at, archived_at"
+
+// scanRoadmap scans a single roadmaps row selected with roadmapColumns.
+func scanRoadmap(row rowScanner) (*entities.RoadmapEntity, error) {
 	var roadmap entities.RoadmapEntity
+	var archivedAt sql.NullTime
+
+	if err := row.Scan(&roadmap.ID, &roadmap.Vision, &roadmap.SuccessCriteria, &roadmap.CreatedAt, &roadmap.UpdatedAt, &archivedAt); err != nil {
+		return nil, err
+	}
+	if archivedAt.Valid {
+		t := archivedAt.Time
+		roadmap.ArchivedAt = &t
+	}
+
+	return &roadmap, nil
+}
 
-	err := r.DB.QueryRowContext(
+// GetRoadmap retrieves a roadmap by its ID.
+func (r *SQLiteRoadmapRepository) GetRoadmap(ctx context.Context, id string) (*entities.RoadmapEntity, error) {
+	roadmap, err := scanRoadmap(r.DB.QueryRowContext(
 		ctx,
-		"SELECT id, vision, success_criteria, created_at, updated_at FROM roadmaps WHERE id = ?",
+		"SELECT "+roadmapColumns+" FROM roadmaps WHERE id = ?",
 		id,
-	).Scan(&roadmap.ID, &roadmap.Vision, &roadmap.SuccessCriteria, &roadmap.CreatedAt, &roadmap.UpdatedAt)
+	))
 
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -74,17 +92,31 @@ func (r *SQLiteRoadmapRepository) GetRoadmap(ctx context.Context, id string) (*e
 		return nil, fmt.Errorf("failed to query roadmap: %w", err)
 	}
 
-	return &roadmap, nil
+	return roadmap, nil
 }
 
-// GetActiveRoadmap retrieves the most recently created roadmap.
+// GetActiveRoadmap retrieves the roadmap activated with SetActiveRoadmap, falling back to
+// the most recently created unarchived roadmap when none is activated or it was archived.
 func (r *SQLiteRoadmapRepository) GetActiveRoadmap(ctx context.Context) (*entities.RoadmapEntity, error) {
-	var roadmap entities.RoadmapEntity
+	var activeID string
+	err := r.DB.QueryRowContext(ctx, "SELECT value FROM project_metadata WHERE key = ?", entities.ActiveRoadmapMetadataKey).Scan(&activeID)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, fmt.Errorf("failed to query active roadmap ID: %w", err)
+	}
+	if activeID != "" {
+		roadmap, err := r.GetRoadmap(ctx, activeID)
+		if err == nil && !roadmap.IsArchived() {
+			return roadmap, nil
+		}
+		if err != nil && !errors.Is(err, pluginsdk.ErrNotFound) {
+			return nil, err
+		}
+	}
 
-	err := r.DB.QueryRowContext(
+	roadmap, err := scanRoadmap(r.DB.QueryRowContext(
 		ctx,
-		"SELECT id, vision, success_criteria, created_at, updated_at FROM roadmaps ORDER BY created_at DESC LIMIT 1",
-	).Scan(&roadmap.ID, &roadmap.Vision, &roadmap.SuccessCriteria, &roadmap.CreatedAt, &roadmap.UpdatedAt)
+		"SELECT "+roadmapColumns+" FROM roadmaps WHERE archived_at IS NULL ORDER BY created_at DESC LIMIT 1",
+	))
 
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -93,15 +125,57 @@ func (r *SQLiteRoadmapRepository) GetActiveRoadmap(ctx context.Context) (*entiti
 		return nil, fmt.Errorf("failed to query active roadmap: %w", err)
 	}
 
-	return &roadmap, nil
+	return roadmap, nil
+}
+
+// ListRoadmaps returns all roadmaps, including archived ones, in creation order.
+func (r *SQLiteRoadmapRepository) ListRoadmaps(ctx context.Context) ([]*entities.RoadmapEntity, error) {
+	rows, err := r.DB.QueryContext(ctx, "SELECT "+roadmapColumns+" FROM roadmaps ORDER BY created_at ASC, id ASC")
+	if err != nil {
+		return nil, fmt.Errorf("failed to query roadmaps: %w", err)
+	}
+	defer rows.Close()
+
+	roadmaps := []*entities.RoadmapEntity{}
+	for rows.Next() {
+		roadmap, err := scanRoadmap(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan roadmap: %w", err)
+		}
+		roadmaps = append(roadmaps, roadmap)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating roadmaps: %w", err)
+	}
+
+	return roadmaps, nil
+}
+
+// SetActiveRoadmap stores the ID of the active roadmap in project metadata.
+func (r *SQLiteRoadmapRepository) SetActiveRoadmap(ctx context.Context, id string) error {
+	if _, err := r.GetRoadmap(ctx, id); err != nil {
+		return err
+	}
+
+	_, err := r.DB.ExecContext(
+		ctx,
+		"INSERT OR REPLACE INTO project_metadata (key, value) VALUES (?, ?)",
+		entities.ActiveRoadmapMetadataKey, id,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to set active roadmap: %w", err)
+	}
+
+	return nil
 }
 
 // UpdateRoadmap updates an existing roadmap.
 func (r *SQLiteRoadmapRepository) UpdateRoadmap(ctx context.Context, roadmap *entities.RoadmapEntity) error {
 	result, err := r.DB.ExecContext(
 		ctx,
-		"UPDATE roadmaps SET vision = ?, success_criteria = ?, updated_at = ? WHERE id = ?",
-		roadmap.Vision, roadmap.SuccessCriteria, roadmap.UpdatedAt, roadmap.ID,
+		"UPDATE roadmaps SET vision = ?, success_criteria = ?, updated_at = ?, archived_at = ? WHERE id = ?",
+		roadmap.Vision, roadmap.SuccessCriteria, roadmap.UpdatedAt, roadmap.ArchivedAt, roadmap.ID,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to update roadmap: %w", err)
@@ -118,3 +192,128 @@ func (r *SQLiteRoadmapRepository) UpdateRoadmap(ctx context.Context, roadmap *en
 
 	return nil
 }
+
+// ============================================================================
+// Roadmap Criteria Operations
+// ============================================================================
+
+// SaveRoadmapCriterion persists a new success criterion and assigns its ID.
+func (r *SQLiteRoadmapRepository) SaveRoadmapCriterion(ctx context.Context, criterion *entities.RoadmapCriterionEntity) error {
+	// Verify roadmap exists
+	var roadmapExists int
+	err := r.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM roadmaps WHERE id = ?", criterion.RoadmapID).Scan(&roadmapExists)
+	if err != nil {
+		return fmt.Errorf("failed to verify roadmap: %w", err)
+	}
+	if roadmapExists == 0 {
+		return fmt.Errorf("%w: roadmap %s not found", pluginsdk.ErrNotFound, criterion.RoadmapID)
+	}
+
+	result, err := r.DB.ExecContext(
+		ctx,
+		"INSERT INTO roadmap_criteria (roadmap_id, text, met, met_at, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?)",
+		criterion.RoadmapID, criterion.Text, criterion.Met, criterion.MetAt, criterion.CreatedAt, criterion.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert roadmap criterion: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("failed to get roadmap criterion ID: %w", err)
+	}
+	criterion.ID = int(id)
+
+	return nil
+}
+
+// GetRoadmapCriterion retrieves a success criterion by its ID.
+func (r *SQLiteRoadmapRepository) GetRoadmapCriterion(ctx context.Context, id int) (*entities.RoadmapCriterionEntity, error) {
+	row := r.DB.QueryRowContext(
+		ctx,
+		"SELECT id, roadmap_id, text, met, met_at, created_at, updated_at FROM roadmap_criteria WHERE id = ?",
+		id,
+	)
+
+	criterion, err := scanRoadmapCriterion(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("%w: roadmap criterion %d not found", pluginsdk.ErrNotFound, id)
+		}
+		return nil, fmt.Errorf("failed to query roadmap criterion: %w", err)
+	}
+
+	return criterion, nil
+}
+
+// ListRoadmapCriteria returns all success criteria of a roadmap in creation order.
+func (r *SQLiteRoadmapRepository) ListRoadmapCriteria(ctx context.Context, roadmapID string) ([]*entities.RoadmapCriterionEntity, error) {
+	rows, err := r.DB.QueryContext(
+		ctx,
+		"SELECT id, roadmap_id, text, met, met_at, created_at, updated_at FROM roadmap_criteria WHERE roadmap_id = ? ORDER BY id ASC",
+		roadmapID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query roadmap criteria: %w", err)
+	}
+	defer rows.Close()
+
+	criteria := []*entities.RoadmapCriterionEntity{}
+	for rows.Next() {
+		criterion, err := scanRoadmapCriterion(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan roadmap criterion: %w", err)
+		}
+		criteria = append(criteria, criterion)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating roadmap criteria: %w", err)
+	}
+
+	return criteria, nil
+}
+
+// UpdateRoadmapCriterion updates an existing success criterion.
+func (r *SQLiteRoadmapRepository) UpdateRoadmapCriterion(ctx context.Context, criterion *entities.RoadmapCriterionEntity) error {
+	result, err := r.DB.ExecContext(
+		ctx,
+		"UPDATE roadmap_criteria SET text = ?, met = ?, met_at = ?, updated_at = ? WHERE id = ?",
+		criterion.Text, criterion.Met, criterion.MetAt, criterion.UpdatedAt, criterion.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update roadmap criterion: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rows == 0 {
+		return fmt.Errorf("%w: roadmap criterion %d not found", pluginsdk.ErrNotFound, criterion.ID)
+	}
+
+	return nil
+}
+
+// rowScanner is satisfied by both *sql.Row and *sql.Rows.
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanRoadmapCriterion scans a single roadmap_criteria row.
+func scanRoadmapCriterion(row rowScanner) (*entities.RoadmapCriterionEntity, error) {
+	var criterion entities.RoadmapCriterionEntity
+	var metAt sql.NullTime
+
+	if err := row.Scan(&criterion.ID, &criterion.RoadmapID, &criterion.Text, &criterion.Met, &metAt, &criterion.CreatedAt, &criterion.UpdatedAt); err != nil {
+		return nil, err
+	}
+	if metAt.Valid {
+		t := metAt.Time
+		criterion.MetAt = &t
+	}
+
+	return &criterion, nil
+}
@@ -16,7 +16,7 @@ import (
 )
 
 // Helper to create a test database
-func createTestDB(t *testing.T) *sql.DB {
+func createTestDB(t testing.TB) *sql.DB {
 	dbPath := filepath.Join(t.TempDir(), "test.db")
 	db, err := sql.Open("sqlite3", dbPath)
 	if err != nil {
@@ -176,3 +176,170 @@ func TestGetActiveRoadmap(t *testing.T) {
 		t.Errorf("expected roadmap-2, got %s", active.ID)
 	}
 }
+
+func TestActivateAndArchiveRoadmaps(t *testing.T) {
+	db := createTestDB(t)
+	defer db.Close()
+
+	repo := persistence.NewSQLiteRoadmapRepository(db, createTestLogger())
+	ctx := context.Background()
+
+	base := time.Date(2025, 10, 1, 9, 0, 0, 0, time.UTC)
+	for i, id := range []string{"roadmap-1", "roadmap-2", "roadmap-3"} {
+		created := base.Add(time.Duration(i) * time.Hour)
+		roadmap, _ := entities.NewRoadmapEntity(id, "vision "+id, "criteria", created, created)
+		if err := repo.SaveRoadmap(ctx, roadmap); err != nil {
+			t.Fatalf("failed to save %s: %v", id, err)
+		}
+	}
+
+	activeID := func() string {
+		t.Helper()
+		active, err := repo.GetActiveRoadmap(ctx)
+		if err != nil {
+			t.Fatalf("failed to get active roadmap: %v", err)
+		}
+		return active.ID
+	}
+
+	roadmaps, err := repo.ListRoadmaps(ctx)
+	if err != nil {
+		t.Fatalf("failed to list roadmaps: %v", err)
+	}
+	if len(roadmaps) != 3 || roadmaps[0].ID != "roadmap-1" || roadmaps[2].ID != "roadmap-3" {
+		t.Fatalf("expected 3 roadmaps in creation order, got %d", len(roadmaps))
+	}
+
+	// The explicitly activated roadmap wins over the most recent one
+	if err := repo.SetActiveRoadmap(ctx, "roadmap-1"); err != nil {
+		t.Fatalf("failed to activate roadmap-1: %v", err)
+	}
+	if got := activeID(); got != "roadmap-1" {
+		t.Errorf("expected roadmap-1 to be active, got %s", got)
+	}
+
+	// Activating an unknown roadmap fails and keeps the active one
+	if err := repo.SetActiveRoadmap(ctx, "roadmap-missing"); !errors.Is(err, pluginsdk.ErrNotFound) {
+		t.Errorf("expected ErrNotFound activating an unknown roadmap, got %v", err)
+	}
+	if got := activeID(); got != "roadmap-1" {
+		t.Errorf("expected roadmap-1 to stay active, got %s", got)
+	}
+
+	// Archiving the active roadmap falls back to the most recent unarchived one
+	roadmap1, _ := repo.GetRoadmap(ctx, "roadmap-1")
+	roadmap1.Archive(base.Add(24 * time.Hour))
+	if err := repo.UpdateRoadmap(ctx, roadmap1); err != nil {
+		t.Fatalf("failed to archive roadmap-1: %v", err)
+	}
+	roadmap3, _ := repo.GetRoadmap(ctx, "roadmap-3")
+	roadmap3.Archive(base.Add(24 * time.Hour))
+	if err := repo.UpdateRoadmap(ctx, roadmap3); err != nil {
+		t.Fatalf("failed to archive roadmap-3: %v", err)
+	}
+	if got := activeID(); got != "roadmap-2" {
+		t.Errorf("expected roadmap-2 to be active after archiving, got %s", got)
+	}
+
+	archived, err := repo.GetRoadmap(ctx, "roadmap-3")
+	if err != nil {
+		t.Fatalf("failed to get archived roadmap: %v", err)
+	}
+	if archived.ArchivedAt == nil || !archived.ArchivedAt.Equal(base.Add(24*time.Hour)) {
+		t.Errorf("expected archived_at to be persisted, got %v", archived.ArchivedAt)
+	}
+
+	// Without unarchived roadmaps there is no active roadmap
+	roadmap2, _ := repo.GetRoadmap(ctx, "roadmap-2")
+	roadmap2.Archive(base.Add(24 * time.Hour))
+	if err := repo.UpdateRoadmap(ctx, roadmap2); err != nil {
+		t.Fatalf("failed to archive roadmap-2: %v", err)
+	}
+	if _, err := repo.GetActiveRoadmap(ctx); !errors.Is(err, pluginsdk.ErrNotFound) {
+		t.Errorf("expected ErrNotFound with all roadmaps archived, got %v", err)
+	}
+}
+
+// ============================================================================
+// Roadmap Criteria Tests
+// ============================================================================
+
+func TestRoadmapCriteriaLifecycle(t *testing.T) {
+	db := createTestDB(t)
+	defer db.Close()
+
+	repo := persistence.NewSQLiteRoadmapRepository(db, createTestLogger())
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	roadmap, _ := entities.NewRoadmapEntity("roadmap-1", "vision", "criteria", now, now)
+	if err := repo.SaveRoadmap(ctx, roadmap); err != nil {
+		t.Fatalf("failed to save roadmap: %v", err)
+	}
+
+	first, _ := entities.NewRoadmapCriterionEntity("roadmap-1", "Support 10 plugins", now)
+	second, _ := entities.NewRoadmapCriterionEntity("roadmap-1", "Zero violations", now)
+	for _, c := range []*entities.RoadmapCriterionEntity{first, second} {
+		if err := repo.SaveRoadmapCriterion(ctx, c); err != nil {
+			t.Fatalf("failed to save criterion: %v", err)
+		}
+	}
+	if first.ID == 0 || second.ID <= first.ID {
+		t.Errorf("expected increasing IDs, got %d and %d", first.ID, second.ID)
+	}
+
+	// Check the second criterion
+	second.Check(now)
+	if err := repo.UpdateRoadmapCriterion(ctx, second); err != nil {
+		t.Fatalf("failed to update criterion: %v", err)
+	}
+
+	retrieved, err := repo.GetRoadmapCriterion(ctx, second.ID)
+	if err != nil {
+		t.Fatalf("failed to get criterion: %v", err)
+	}
+	if !retrieved.Met || retrieved.MetAt == nil {
+		t.Errorf("expected criterion to be met with met_at set")
+	}
+
+	criteria, err := repo.ListRoadmapCriteria(ctx, "roadmap-1")
+	if err != nil {
+		t.Fatalf("failed to list criteria: %v", err)
+	}
+	if len(criteria) != 2 {
+		t.Fatalf("expected 2 criteria, got %d", len(criteria))
+	}
+	if criteria[0].Text != "Support 10 plugins" || criteria[0].Met {
+		t.Errorf("unexpected first criterion: %+v", criteria[0])
+	}
+}
+
+func TestRoadmapCriteriaErrors(t *testing.T) {
+	db := createTestDB(t)
+	defer db.Close()
+
+	repo := persistence.NewSQLiteRoadmapRepository(db, createTestLogger())
+	ctx := context.Background()
+
+	orphan, _ := entities.NewRoadmapCriterionEntity("missing", "text", time.Now().UTC())
+	if err := repo.SaveRoadmapCriterion(ctx, orphan); !errors.Is(err, pluginsdk.ErrNotFound) {
+		t.Errorf("expected ErrNotFound for missing roadmap, got: %v", err)
+	}
+
+	if _, err := repo.GetRoadmapCriterion(ctx, 99); !errors.Is(err, pluginsdk.ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got: %v", err)
+	}
+
+	orphan.ID = 99
+	if err := repo.UpdateRoadmapCriterion(ctx, orphan); !errors.Is(err, pluginsdk.ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got: %v", err)
+	}
+
+	criteria, err := repo.ListRoadmapCriteria(ctx, "missing")
+	if err != nil {
+		t.Fatalf("failed to list criteria: %v", err)
+	}
+	if len(criteria) != 0 {
+		t.Errorf("expected no criteria, got %d", len(criteria))
+	}
+}
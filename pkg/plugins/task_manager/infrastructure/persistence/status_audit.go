@@ -0,0 +1,112 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// InvalidStatus is a stored row whose status is outside the allowed values of its kind,
+// e.g. a task written as "in_progress" before repositories validated statuses
+type InvalidStatus struct {
+	Kind    string // track, task, iteration, ac or adr
+	ID      string // Row ID; the iteration number for iterations
+	Status  string
+	Allowed []string
+}
+
+// statusTable describes where the status of one kind of entity is stored
+type statusTable struct {
+	kind     string
+	table    string
+	idColumn string
+	allowed  []string
+}
+
+var statusTables = []statusTable{
+	{kind: "track", table: "tracks", idColumn: "id", allowed: entities.TrackStatuses},
+	{kind: "task", table: "tasks", idColumn: "id", allowed: entities.TaskStatuses},
+	{kind: "iteration", table: "iterations", idColumn: "number", allowed: entities.IterationStatuses},
+	{kind: "ac", table: "acceptance_criteria", idColumn: "id", allowed: entities.ACStatuses},
+	{kind: "adr", table: "adrs", idColumn: "id", allowed: entities.ADRStatuses},
+}
+
+func findStatusTable(kind string) (statusTable, bool) {
+	for _, t := range statusTables {
+		if t.kind == kind {
+			return t, true
+		}
+	}
+	return statusTable{}, false
+}
+
+// FindInvalidStatuses lists the tracks, tasks, iterations, ACs and ADRs whose stored
+// status is not one of the allowed values, in that order and by ID within a kind
+func (c *SQLiteRepositoryComposite) FindInvalidStatuses(ctx context.Context) ([]InvalidStatus, error) {
+	var invalid []InvalidStatus
+	for _, t := range statusTables {
+		placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(t.allowed)), ", ")
+		args := make([]interface{}, len(t.allowed))
+		for i, status := range t.allowed {
+			args[i] = status
+		}
+		query := fmt.Sprintf("SELECT CAST(%s AS TEXT), status FROM %s WHERE status NOT IN (%s) ORDER BY %s",
+			t.idColumn, t.table, placeholders, t.idColumn)
+		rows, err := c.conn().QueryContext(ctx, query, args...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check %s statuses: %w", t.kind, err)
+		}
+		for rows.Next() {
+			row := InvalidStatus{Kind: t.kind, Allowed: t.allowed}
+			if err := rows.Scan(&row.ID, &row.Status); err != nil {
+				rows.Close()
+				return nil, fmt.Errorf("failed to scan %s status: %w", t.kind, err)
+			}
+			invalid = append(invalid, row)
+		}
+		err = rows.Err()
+		rows.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to check %s statuses: %w", t.kind, err)
+		}
+	}
+	return invalid, nil
+}
+
+// RepairStatus overwrites the stored status of the entity identified by kind and id
+// without loading it, so rows that no longer pass validation can be fixed. The new
+// status must be allowed for the kind.
+func (c *SQLiteRepositoryComposite) RepairStatus(ctx context.Context, kind, id, status string) error {
+	t, ok := findStatusTable(kind)
+	if !ok {
+		return fmt.Errorf("%w: unknown entity kind %q", pluginsdk.ErrInvalidArgument, kind)
+	}
+	if !containsStatus(t.allowed, status) {
+		return fmt.Errorf("%w: invalid %s status %q (allowed: %s)", pluginsdk.ErrInvalidArgument, kind, status, strings.Join(t.allowed, ", "))
+	}
+
+	return retryWrite(ctx, c.conn(), c.retryPolicy, func() error {
+		query := fmt.Sprintf("UPDATE %s SET status = ?, updated_at = ? WHERE %s = ?", t.table, t.idColumn)
+		result, err := c.conn().ExecContext(ctx, query, status, time.Now().UTC(), id)
+		if err != nil {
+			return fmt.Errorf("failed to repair %s %s: %w", kind, id, err)
+		}
+		if n, _ := result.RowsAffected(); n == 0 {
+			return fmt.Errorf("%w: %s %s not found", pluginsdk.ErrNotFound, kind, id)
+		}
+		return nil
+	})
+}
+
+func containsStatus(allowed []string, status string) bool {
+	for _, candidate := range allowed {
+		if candidate == status {
+			return true
+		}
+	}
+	return false
+}
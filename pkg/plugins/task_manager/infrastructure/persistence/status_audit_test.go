@@ -0,0 +1,151 @@
+package persistence_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/infrastructure/persistence"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// setupStatusData saves a roadmap, track, task, iteration, AC and ADR with valid statuses
+func setupStatusData(t *testing.T) (*persistence.SQLiteRepositoryComposite, context.Context) {
+	t.Helper()
+	ctx := context.Background()
+	now := time.Now().UTC()
+	repo := persistence.NewSQLiteRepositoryComposite(createTestDB(t), createTestLogger())
+
+	roadmap, _ := entities.NewRoadmapEntity("roadmap-1", "vision", "criteria", now, now)
+	track, _ := entities.NewTrackEntity("TM-track-1", "roadmap-1", "Track", "", "in-progress", 100, []string{}, now, now)
+	task, _ := entities.NewTaskEntity("TM-task-1", "TM-track-1", "Task", "", "todo", 100, "", now, now)
+	iteration, _ := entities.NewIterationEntity(1, "Iteration", "goal", "deliverable", []string{}, "planned", 1, time.Time{}, time.Time{}, now, now)
+	ac := entities.NewAcceptanceCriteriaEntity("TM-ac-1", "TM-task-1", "Works", entities.VerificationTypeManual, "", now, now)
+	adr, _ := entities.NewADREntity("TM-adr-1", "TM-track-1", "Decision", "proposed", "context", "decision", "consequences", "", now, now, nil)
+
+	for _, err := range []error{
+		repo.SaveRoadmap(ctx, roadmap),
+		repo.SaveTrack(ctx, track),
+		repo.SaveTask(ctx, task),
+		repo.SaveIteration(ctx, iteration),
+		repo.SaveAC(ctx, ac),
+		repo.SaveADR(ctx, adr),
+	} {
+		if err != nil {
+			t.Fatalf("failed to save test data: %v", err)
+		}
+	}
+	return repo, ctx
+}
+
+func TestRepositories_RejectInvalidStatuses(t *testing.T) {
+	repo, ctx := setupStatusData(t)
+
+	track, _ := repo.GetTrack(ctx, "TM-track-1")
+	track.Status = "in_progress"
+	task, _ := repo.GetTask(ctx, "TM-task-1")
+	task.Status = "in_progress"
+	iteration, _ := repo.GetIteration(ctx, 1)
+	iteration.Status = "active"
+	ac, _ := repo.GetAC(ctx, "TM-ac-1")
+	ac.Status = "done"
+	adr, _ := repo.GetADR(ctx, "TM-adr-1")
+	adr.Status = "approved"
+
+	newTask := *task
+	newTask.ID = "TM-task-2"
+	newAC := *ac
+	newAC.ID = "TM-ac-2"
+
+	tests := []struct {
+		name string
+		err  error
+	}{
+		{"UpdateTrack", repo.UpdateTrack(ctx, track)},
+		{"SaveTask", repo.SaveTask(ctx, &newTask)},
+		{"UpdateTask", repo.UpdateTask(ctx, task)},
+		{"UpdateIteration", repo.UpdateIteration(ctx, iteration)},
+		{"SaveAC", repo.SaveAC(ctx, &newAC)},
+		{"SaveACs", repo.AC.SaveACs(ctx, []*entities.AcceptanceCriteriaEntity{&newAC})},
+		{"UpdateAC", repo.UpdateAC(ctx, ac)},
+		{"UpdateADR", repo.UpdateADR(ctx, adr)},
+	}
+	for _, tt := range tests {
+		if !errors.Is(tt.err, pluginsdk.ErrInvalidArgument) {
+			t.Errorf("%s: expected ErrInvalidArgument, got %v", tt.name, tt.err)
+		}
+	}
+
+	if stored, _ := repo.GetTask(ctx, "TM-task-1"); stored.Status != "todo" {
+		t.Errorf("expected the stored task status to stay todo, got %q", stored.Status)
+	}
+	if _, err := repo.GetTask(ctx, "TM-task-2"); !errors.Is(err, pluginsdk.ErrNotFound) {
+		t.Errorf("expected the task with an invalid status not to be saved, got %v", err)
+	}
+}
+
+func TestFindInvalidStatuses(t *testing.T) {
+	repo, ctx := setupStatusData(t)
+
+	invalid, err := repo.FindInvalidStatuses(ctx)
+	if err != nil {
+		t.Fatalf("FindInvalidStatuses failed: %v", err)
+	}
+	if len(invalid) != 0 {
+		t.Fatalf("expected no invalid statuses, got %+v", invalid)
+	}
+
+	// Rows written before statuses were validated
+	for _, stmt := range []string{
+		"UPDATE tasks SET status = 'in_progress' WHERE id = 'TM-task-1'",
+		"UPDATE iterations SET status = 'active' WHERE number = 1",
+	} {
+		if _, err := repo.DB.ExecContext(ctx, stmt); err != nil {
+			t.Fatalf("failed to corrupt status: %v", err)
+		}
+	}
+
+	invalid, err = repo.FindInvalidStatuses(ctx)
+	if err != nil {
+		t.Fatalf("FindInvalidStatuses failed: %v", err)
+	}
+	if len(invalid) != 2 {
+		t.Fatalf("expected 2 invalid statuses, got %+v", invalid)
+	}
+	if invalid[0].Kind != "task" || invalid[0].ID != "TM-task-1" || invalid[0].Status != "in_progress" {
+		t.Errorf("unexpected first row: %+v", invalid[0])
+	}
+	if invalid[1].Kind != "iteration" || invalid[1].ID != "1" || invalid[1].Status != "active" {
+		t.Errorf("unexpected second row: %+v", invalid[1])
+	}
+}
+
+func TestRepairStatus(t *testing.T) {
+	repo, ctx := setupStatusData(t)
+	if _, err := repo.DB.ExecContext(ctx, "UPDATE tasks SET status = 'in_progress' WHERE id = 'TM-task-1'"); err != nil {
+		t.Fatalf("failed to corrupt status: %v", err)
+	}
+
+	if err := repo.RepairStatus(ctx, "task", "TM-task-1", "in_progress"); !errors.Is(err, pluginsdk.ErrInvalidArgument) {
+		t.Errorf("expected an invalid replacement status to be rejected, got %v", err)
+	}
+	if err := repo.RepairStatus(ctx, "task", "TM-task-9", "done"); !errors.Is(err, pluginsdk.ErrNotFound) {
+		t.Errorf("expected ErrNotFound for a missing task, got %v", err)
+	}
+	if err := repo.RepairStatus(ctx, "task", "TM-task-1", "in-progress"); err != nil {
+		t.Fatalf("RepairStatus failed: %v", err)
+	}
+
+	task, err := repo.GetTask(ctx, "TM-task-1")
+	if err != nil {
+		t.Fatalf("failed to get task: %v", err)
+	}
+	if task.Status != "in-progress" {
+		t.Errorf("expected repaired status in-progress, got %q", task.Status)
+	}
+	if err := repo.RepairStatus(ctx, "iteration", "1", "current"); err != nil {
+		t.Fatalf("RepairStatus on iteration failed: %v", err)
+	}
+}
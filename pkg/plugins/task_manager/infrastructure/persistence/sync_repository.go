@@ -0,0 +1,717 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/repositories"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// Compile-time check that SQLiteSyncRepository implements repositories.SyncRepository
+var _ repositories.SyncRepository = (*SQLiteSyncRepository)(nil)
+
+// SQLiteSyncRepository implements repositories.SyncRepository using SQLite as the backend.
+type SQLiteSyncRepository struct {
+	DB     DBTX
+	logger pluginsdk.Logger
+}
+
+// NewSQLiteSyncRepository creates a new SQLite-backed sync repository.
+func NewSQLiteSyncRepository(db DBTX, logger pluginsdk.Logger) *SQLiteSyncRepository {
+	return &SQLiteSyncRepository{
+		DB:     db,
+		logger: logger,
+	}
+}
+
+// ============================================================================
+// Export
+// ============================================================================
+
+// ExportChanges returns every entity whose updated_at is after since.
+// updated_at is compared in Go because stored timestamps are not guaranteed to share
+// one text format, so a SQL string comparison would be unreliable.
+func (r *SQLiteSyncRepository) ExportChanges(ctx context.Context, since time.Time) (*entities.SyncChangeset, error) {
+	// A single transaction gives a consistent snapshot across tables
+	tx, err := beginTx(ctx, r.DB)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	changeset := &entities.SyncChangeset{
+		FormatVersion:      entities.SyncChangesetFormatVersion,
+		Since:              since,
+		ExportedAt:         time.Now().UTC(),
+		Roadmaps:           []*entities.RoadmapEntity{},
+		Tracks:             []*entities.TrackEntity{},
+		Tasks:              []*entities.TaskEntity{},
+		Iterations:         []*entities.IterationEntity{},
+		IterationDoD:       []*entities.IterationDoDItemEntity{},
+		AcceptanceCriteria: []*entities.AcceptanceCriteriaEntity{},
+		ACTags:             map[string][]string{},
+		ADRs:               []*entities.ADREntity{},
+		ADRTaskLinks:       []*entities.SyncADRTaskLink{},
+		TaskGates:          []*entities.SyncTaskGate{},
+	}
+
+	if err := exportRoadmaps(ctx, tx, since, changeset); err != nil {
+		return nil, err
+	}
+	if err := exportTracks(ctx, tx, since, changeset); err != nil {
+		return nil, err
+	}
+	if err := exportTasks(ctx, tx, since, changeset); err != nil {
+		return nil, err
+	}
+	if err := exportIterations(ctx, tx, since, changeset); err != nil {
+		return nil, err
+	}
+	if err := exportIterationDoD(ctx, tx, since, changeset); err != nil {
+		return nil, err
+	}
+	if err := exportAcceptanceCriteria(ctx, tx, since, changeset); err != nil {
+		return nil, err
+	}
+	if err := exportADRs(ctx, tx, since, changeset); err != nil {
+		return nil, err
+	}
+	if err := exportADRTaskLinks(ctx, tx, since, changeset); err != nil {
+		return nil, err
+	}
+	if err := exportTaskGates(ctx, tx, since, changeset); err != nil {
+		return nil, err
+	}
+	if err := countNotSynced(ctx, tx, since, changeset); err != nil {
+		return nil, err
+	}
+
+	return changeset, nil
+}
+
+// notSyncedTables lists the tables the changeset format does not carry, with the
+// column recording when a row last changed
+var notSyncedTables = []struct{ table, changedColumn string }{
+	{"roadmap_criteria", "updated_at"},
+	{"task_notes", "created_at"},
+	{"ac_templates", "updated_at"},
+	{"iteration_templates", "updated_at"},
+}
+
+// countNotSynced records how many rows of notSyncedTables changed after since
+func countNotSynced(ctx context.Context, tx DBTX, since time.Time, changeset *entities.SyncChangeset) error {
+	changeset.NotSynced = map[string]int{}
+	for _, t := range notSyncedTables {
+		rows, err := tx.QueryContext(ctx, "SELECT "+t.changedColumn+" FROM "+t.table)
+		if err != nil {
+			return fmt.Errorf("failed to query %s: %w", t.table, err)
+		}
+		count := 0
+		for rows.Next() {
+			var changed time.Time
+			if err := rows.Scan(&changed); err != nil {
+				rows.Close()
+				return fmt.Errorf("failed to scan %s: %w", t.table, err)
+			}
+			if changed.After(since) {
+				count++
+			}
+		}
+		err = rows.Err()
+		rows.Close()
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			changeset.NotSynced[t.table] = count
+		}
+	}
+	return nil
+}
+
+func exportRoadmaps(ctx context.Context, tx DBTX, since time.Time, changeset *entities.SyncChangeset) error {
+	rows, err := tx.QueryContext(ctx, "SELECT "+roadmapColumns+" FROM roadmaps ORDER BY id")
+	if err != nil {
+		return fmt.Errorf("failed to query roadmaps: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		roadmap, err := scanRoadmap(rows)
+		if err != nil {
+			return fmt.Errorf("failed to scan roadmap: %w", err)
+		}
+		if roadmap.UpdatedAt.After(since) {
+			changeset.Roadmaps = append(changeset.Roadmaps, roadmap)
+		}
+	}
+	return rows.Err()
+}
+
+func exportTracks(ctx context.Context, tx DBTX, since time.Time, changeset *entities.SyncChangeset) error {
+	rows, err := tx.QueryContext(ctx, "SELECT id, roadmap_id, title, description, status, rank, created_at, updated_at FROM tracks ORDER BY id")
+	if err != nil {
+		return fmt.Errorf("failed to query tracks: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var track entities.TrackEntity
+		var description sql.NullString
+		if err := rows.Scan(&track.ID, &track.RoadmapID, &track.Title, &description, &track.Status, &track.Rank, &track.CreatedAt, &track.UpdatedAt); err != nil {
+			return fmt.Errorf("failed to scan track: %w", err)
+		}
+		track.Description = description.String
+		track.Dependencies = []string{}
+		if track.UpdatedAt.After(since) {
+			changeset.Tracks = append(changeset.Tracks, &track)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	rows.Close()
+
+	if len(changeset.Tracks) == 0 {
+		return nil
+	}
+
+	depRows, err := tx.QueryContext(ctx, "SELECT track_id, depends_on_id FROM track_dependencies ORDER BY track_id, depends_on_id")
+	if err != nil {
+		return fmt.Errorf("failed to query track dependencies: %w", err)
+	}
+	defer depRows.Close()
+
+	deps := make(map[string][]string)
+	for depRows.Next() {
+		var trackID, dependsOnID string
+		if err := depRows.Scan(&trackID, &dependsOnID); err != nil {
+			return fmt.Errorf("failed to scan track dependency: %w", err)
+		}
+		deps[trackID] = append(deps[trackID], dependsOnID)
+	}
+	for _, track := range changeset.Tracks {
+		if d, ok := deps[track.ID]; ok {
+			track.Dependencies = d
+		}
+	}
+	return depRows.Err()
+}
+
+func exportTasks(ctx context.Context, tx DBTX, since time.Time, changeset *entities.SyncChangeset) error {
+	rows, err := tx.QueryContext(ctx, "SELECT id, track_id, title, description, status, rank, branch, assignee, created_at, updated_at FROM tasks ORDER BY id")
+	if err != nil {
+		return fmt.Errorf("failed to query tasks: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var task entities.TaskEntity
+		var description, branch, assignee sql.NullString
+		if err := rows.Scan(&task.ID, &task.TrackID, &task.Title, &description, &task.Status, &task.Rank, &branch, &assignee, &task.CreatedAt, &task.UpdatedAt); err != nil {
+			return fmt.Errorf("failed to scan task: %w", err)
+		}
+		task.Description = description.String
+		task.Branch = branch.String
+		task.Assignee = assignee.String
+		if task.UpdatedAt.After(since) {
+			changeset.Tasks = append(changeset.Tasks, &task)
+		}
+	}
+	return rows.Err()
+}
+
+func exportIterations(ctx context.Context, tx DBTX, since time.Time, changeset *entities.SyncChangeset) error {
+	rows, err := tx.QueryContext(ctx, "SELECT number, name, goal, status, rank, deliverable, started_at, completed_at, created_at, updated_at FROM iterations ORDER BY number")
+	if err != nil {
+		return fmt.Errorf("failed to query iterations: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var iteration entities.IterationEntity
+		var goal, deliverable sql.NullString
+		var startedAt, completedAt sql.NullTime
+		if err := rows.Scan(&iteration.Number, &iteration.Name, &goal, &iteration.Status, &iteration.Rank, &deliverable, &startedAt, &completedAt, &iteration.CreatedAt, &iteration.UpdatedAt); err != nil {
+			return fmt.Errorf("failed to scan iteration: %w", err)
+		}
+		iteration.Goal = goal.String
+		iteration.Deliverable = deliverable.String
+		if startedAt.Valid {
+			iteration.StartedAt = &startedAt.Time
+		}
+		if completedAt.Valid {
+			iteration.CompletedAt = &completedAt.Time
+		}
+		iteration.TaskIDs = []string{}
+		if iteration.UpdatedAt.After(since) {
+			changeset.Iterations = append(changeset.Iterations, &iteration)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	rows.Close()
+
+	if len(changeset.Iterations) == 0 {
+		return nil
+	}
+
+	memberRows, err := tx.QueryContext(ctx, "SELECT iteration_number, task_id FROM iteration_tasks ORDER BY iteration_number, task_id")
+	if err != nil {
+		return fmt.Errorf("failed to query iteration tasks: %w", err)
+	}
+	defer memberRows.Close()
+
+	members := make(map[int][]string)
+	for memberRows.Next() {
+		var number int
+		var taskID string
+		if err := memberRows.Scan(&number, &taskID); err != nil {
+			return fmt.Errorf("failed to scan iteration task: %w", err)
+		}
+		members[number] = append(members[number], taskID)
+	}
+	for _, iteration := range changeset.Iterations {
+		if ids, ok := members[iteration.Number]; ok {
+			iteration.TaskIDs = ids
+		}
+	}
+	return memberRows.Err()
+}
+
+func exportIterationDoD(ctx context.Context, tx DBTX, since time.Time, changeset *entities.SyncChangeset) error {
+	rows, err := tx.QueryContext(ctx, "SELECT id, iteration_number, text, done, done_at, created_at, updated_at FROM iteration_dod ORDER BY iteration_number, id")
+	if err != nil {
+		return fmt.Errorf("failed to query definition of done items: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		item, err := scanIterationDoDItem(rows)
+		if err != nil {
+			return fmt.Errorf("failed to scan definition of done item: %w", err)
+		}
+		if item.UpdatedAt.After(since) {
+			changeset.IterationDoD = append(changeset.IterationDoD, item)
+		}
+	}
+	return rows.Err()
+}
+
+func exportAcceptanceCriteria(ctx context.Context, tx DBTX, since time.Time, changeset *entities.SyncChangeset) error {
+	rows, err := tx.QueryContext(ctx, "SELECT id, task_id, description, verification_type, status, notes, testing_instructions, created_at, updated_at FROM acceptance_criteria ORDER BY id")
+	if err != nil {
+		return fmt.Errorf("failed to query acceptance criteria: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var ac entities.AcceptanceCriteriaEntity
+		var notes, testingInstructions sql.NullString
+		if err := rows.Scan(&ac.ID, &ac.TaskID, &ac.Description, (*string)(&ac.VerificationType), (*string)(&ac.Status), &notes, &testingInstructions, &ac.CreatedAt, &ac.UpdatedAt); err != nil {
+			return fmt.Errorf("failed to scan acceptance criterion: %w", err)
+		}
+		ac.Notes = notes.String
+		ac.TestingInstructions = testingInstructions.String
+		if ac.UpdatedAt.After(since) {
+			changeset.AcceptanceCriteria = append(changeset.AcceptanceCriteria, &ac)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	rows.Close()
+
+	if len(changeset.AcceptanceCriteria) == 0 {
+		return nil
+	}
+	exported := make(map[string]bool, len(changeset.AcceptanceCriteria))
+	for _, ac := range changeset.AcceptanceCriteria {
+		exported[ac.ID] = true
+	}
+
+	tagRows, err := tx.QueryContext(ctx, "SELECT ac_id, tag FROM ac_tags ORDER BY ac_id, tag")
+	if err != nil {
+		return fmt.Errorf("failed to query AC tags: %w", err)
+	}
+	defer tagRows.Close()
+
+	for tagRows.Next() {
+		var acID, tag string
+		if err := tagRows.Scan(&acID, &tag); err != nil {
+			return fmt.Errorf("failed to scan AC tag: %w", err)
+		}
+		if exported[acID] {
+			changeset.ACTags[acID] = append(changeset.ACTags[acID], tag)
+		}
+	}
+	return tagRows.Err()
+}
+
+func exportADRs(ctx context.Context, tx DBTX, since time.Time, changeset *entities.SyncChangeset) error {
+	rows, err := tx.QueryContext(ctx, "SELECT id, track_id, title, status, context, decision, consequences, alternatives, created_at, updated_at, superseded_by FROM adrs ORDER BY id")
+	if err != nil {
+		return fmt.Errorf("failed to query ADRs: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var adr entities.ADREntity
+		var alternatives, supersededBy sql.NullString
+		if err := rows.Scan(&adr.ID, &adr.TrackID, &adr.Title, &adr.Status, &adr.Context, &adr.Decision, &adr.Consequences, &alternatives, &adr.CreatedAt, &adr.UpdatedAt, &supersededBy); err != nil {
+			return fmt.Errorf("failed to scan ADR: %w", err)
+		}
+		adr.Alternatives = alternatives.String
+		if supersededBy.Valid {
+			adr.SupersededBy = &supersededBy.String
+		}
+		if adr.UpdatedAt.After(since) {
+			changeset.ADRs = append(changeset.ADRs, &adr)
+		}
+	}
+	return rows.Err()
+}
+
+func exportADRTaskLinks(ctx context.Context, tx DBTX, since time.Time, changeset *entities.SyncChangeset) error {
+	rows, err := tx.QueryContext(ctx, "SELECT adr_id, task_id, created_at FROM adr_tasks ORDER BY adr_id, task_id")
+	if err != nil {
+		return fmt.Errorf("failed to query ADR task links: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var link entities.SyncADRTaskLink
+		if err := rows.Scan(&link.ADRID, &link.TaskID, &link.CreatedAt); err != nil {
+			return fmt.Errorf("failed to scan ADR task link: %w", err)
+		}
+		if link.CreatedAt.After(since) {
+			changeset.ADRTaskLinks = append(changeset.ADRTaskLinks, &link)
+		}
+	}
+	return rows.Err()
+}
+
+func exportTaskGates(ctx context.Context, tx DBTX, since time.Time, changeset *entities.SyncChangeset) error {
+	rows, err := tx.QueryContext(ctx, "SELECT task_id, ac_id, created_at FROM task_ac_gates ORDER BY task_id, ac_id")
+	if err != nil {
+		return fmt.Errorf("failed to query task gates: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var gate entities.SyncTaskGate
+		if err := rows.Scan(&gate.TaskID, &gate.ACID, &gate.CreatedAt); err != nil {
+			return fmt.Errorf("failed to scan task gate: %w", err)
+		}
+		if gate.CreatedAt.After(since) {
+			changeset.TaskGates = append(changeset.TaskGates, &gate)
+		}
+	}
+	return rows.Err()
+}
+
+// ============================================================================
+// Import
+// ============================================================================
+
+// syncAction is what an import does with a single incoming entity
+type syncAction int
+
+const (
+	syncCreate syncAction = iota
+	syncUpdate
+	syncUnchanged
+	syncConflict
+)
+
+// ImportChanges upserts the changeset in a single transaction.
+// Any error rolls back the whole import.
+func (r *SQLiteSyncRepository) ImportChanges(ctx context.Context, changeset *entities.SyncChangeset) (*entities.SyncImportResult, error) {
+	tx, err := beginTx(ctx, r.DB)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result := &entities.SyncImportResult{ConflictIDs: []string{}}
+
+	for _, roadmap := range changeset.Roadmaps {
+		action, err := resolveSyncAction(ctx, tx, "roadmaps", "id", roadmap.ID, roadmap.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		switch action {
+		case syncCreate:
+			_, err = tx.ExecContext(ctx,
+				"INSERT INTO roadmaps (id, vision, success_criteria, created_at, updated_at, archived_at) VALUES (?, ?, ?, ?, ?, ?)",
+				roadmap.ID, roadmap.Vision, roadmap.SuccessCriteria, roadmap.CreatedAt, roadmap.UpdatedAt, roadmap.ArchivedAt)
+		case syncUpdate:
+			_, err = tx.ExecContext(ctx,
+				"UPDATE roadmaps SET vision = ?, success_criteria = ?, created_at = ?, updated_at = ?, archived_at = ? WHERE id = ?",
+				roadmap.Vision, roadmap.SuccessCriteria, roadmap.CreatedAt, roadmap.UpdatedAt, roadmap.ArchivedAt, roadmap.ID)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to import roadmap %s: %w", roadmap.ID, err)
+		}
+		recordSyncAction(&result.Roadmaps, result, action, roadmap.ID)
+	}
+
+	// Dependencies are written after all tracks so they may reference tracks later in the changeset
+	var tracksWithDeps []*entities.TrackEntity
+	for _, track := range changeset.Tracks {
+		action, err := resolveSyncAction(ctx, tx, "tracks", "id", track.ID, track.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		switch action {
+		case syncCreate:
+			_, err = tx.ExecContext(ctx,
+				"INSERT INTO tracks (id, roadmap_id, title, description, status, rank, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?)",
+				track.ID, track.RoadmapID, track.Title, track.Description, track.Status, track.Rank, track.CreatedAt, track.UpdatedAt)
+		case syncUpdate:
+			_, err = tx.ExecContext(ctx,
+				"UPDATE tracks SET roadmap_id = ?, title = ?, description = ?, status = ?, rank = ?, created_at = ?, updated_at = ? WHERE id = ?",
+				track.RoadmapID, track.Title, track.Description, track.Status, track.Rank, track.CreatedAt, track.UpdatedAt, track.ID)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to import track %s: %w", track.ID, err)
+		}
+		if action == syncCreate || action == syncUpdate {
+			tracksWithDeps = append(tracksWithDeps, track)
+		}
+		recordSyncAction(&result.Tracks, result, action, track.ID)
+	}
+	for _, track := range tracksWithDeps {
+		if _, err := tx.ExecContext(ctx, "DELETE FROM track_dependencies WHERE track_id = ?", track.ID); err != nil {
+			return nil, fmt.Errorf("failed to replace dependencies of track %s: %w", track.ID, err)
+		}
+		for _, depID := range track.Dependencies {
+			if _, err := tx.ExecContext(ctx, "INSERT INTO track_dependencies (track_id, depends_on_id) VALUES (?, ?)", track.ID, depID); err != nil {
+				return nil, fmt.Errorf("failed to import dependency %s -> %s: %w", track.ID, depID, err)
+			}
+		}
+	}
+
+	for _, task := range changeset.Tasks {
+		action, err := resolveSyncAction(ctx, tx, "tasks", "id", task.ID, task.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		switch action {
+		case syncCreate:
+			_, err = tx.ExecContext(ctx,
+				"INSERT INTO tasks (id, track_id, title, description, status, rank, branch, assignee, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+				task.ID, task.TrackID, task.Title, task.Description, task.Status, task.Rank, task.Branch, task.Assignee, task.CreatedAt, task.UpdatedAt)
+		case syncUpdate:
+			_, err = tx.ExecContext(ctx,
+				"UPDATE tasks SET track_id = ?, title = ?, description = ?, status = ?, rank = ?, branch = ?, assignee = ?, created_at = ?, updated_at = ? WHERE id = ?",
+				task.TrackID, task.Title, task.Description, task.Status, task.Rank, task.Branch, task.Assignee, task.CreatedAt, task.UpdatedAt, task.ID)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to import task %s: %w", task.ID, err)
+		}
+		recordSyncAction(&result.Tasks, result, action, task.ID)
+	}
+
+	for _, iteration := range changeset.Iterations {
+		label := fmt.Sprintf("iteration %d", iteration.Number)
+		action, err := resolveSyncAction(ctx, tx, "iterations", "number", iteration.Number, iteration.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		switch action {
+		case syncCreate:
+			_, err = tx.ExecContext(ctx,
+				"INSERT INTO iterations (number, name, goal, status, rank, deliverable, started_at, completed_at, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+				iteration.Number, iteration.Name, iteration.Goal, iteration.Status, iteration.Rank, iteration.Deliverable, iteration.StartedAt, iteration.CompletedAt, iteration.CreatedAt, iteration.UpdatedAt)
+		case syncUpdate:
+			_, err = tx.ExecContext(ctx,
+				"UPDATE iterations SET name = ?, goal = ?, status = ?, rank = ?, deliverable = ?, started_at = ?, completed_at = ?, created_at = ?, updated_at = ? WHERE number = ?",
+				iteration.Name, iteration.Goal, iteration.Status, iteration.Rank, iteration.Deliverable, iteration.StartedAt, iteration.CompletedAt, iteration.CreatedAt, iteration.UpdatedAt, iteration.Number)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to import %s: %w", label, err)
+		}
+		if action == syncCreate || action == syncUpdate {
+			if _, err := tx.ExecContext(ctx, "DELETE FROM iteration_tasks WHERE iteration_number = ?", iteration.Number); err != nil {
+				return nil, fmt.Errorf("failed to replace tasks of %s: %w", label, err)
+			}
+			for _, taskID := range iteration.TaskIDs {
+				if _, err := tx.ExecContext(ctx, "INSERT INTO iteration_tasks (iteration_number, task_id) VALUES (?, ?)", iteration.Number, taskID); err != nil {
+					return nil, fmt.Errorf("failed to import task %s of %s: %w", taskID, label, err)
+				}
+			}
+		}
+		recordSyncAction(&result.Iterations, result, action, label)
+	}
+
+	// DoD item IDs are local to each database; items are matched by iteration and creation time
+	for _, item := range changeset.IterationDoD {
+		label := fmt.Sprintf("iteration %d DoD item %q", item.IterationNum, item.Text)
+		var localID int
+		var local time.Time
+		err := tx.QueryRowContext(ctx, "SELECT id, updated_at FROM iteration_dod WHERE iteration_number = ? AND created_at = ?", item.IterationNum, item.CreatedAt).Scan(&localID, &local)
+		action := syncCreate
+		switch {
+		case err == sql.ErrNoRows:
+		case err != nil:
+			return nil, fmt.Errorf("failed to read %s: %w", label, err)
+		case item.UpdatedAt.After(local):
+			action = syncUpdate
+		case item.UpdatedAt.Equal(local):
+			action = syncUnchanged
+		default:
+			action = syncConflict
+		}
+		switch action {
+		case syncCreate:
+			_, err = tx.ExecContext(ctx,
+				"INSERT INTO iteration_dod (iteration_number, text, done, done_at, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?)",
+				item.IterationNum, item.Text, item.Done, item.DoneAt, item.CreatedAt, item.UpdatedAt)
+		case syncUpdate:
+			_, err = tx.ExecContext(ctx,
+				"UPDATE iteration_dod SET text = ?, done = ?, done_at = ?, updated_at = ? WHERE id = ?",
+				item.Text, item.Done, item.DoneAt, item.UpdatedAt, localID)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to import %s: %w", label, err)
+		}
+		recordSyncAction(&result.IterationDoD, result, action, label)
+	}
+
+	for _, ac := range changeset.AcceptanceCriteria {
+		action, err := resolveSyncAction(ctx, tx, "acceptance_criteria", "id", ac.ID, ac.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		switch action {
+		case syncCreate:
+			_, err = tx.ExecContext(ctx,
+				"INSERT INTO acceptance_criteria (id, task_id, description, verification_type, status, notes, testing_instructions, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+				ac.ID, ac.TaskID, ac.Description, string(ac.VerificationType), string(ac.Status), ac.Notes, ac.TestingInstructions, ac.CreatedAt, ac.UpdatedAt)
+		case syncUpdate:
+			_, err = tx.ExecContext(ctx,
+				"UPDATE acceptance_criteria SET task_id = ?, description = ?, verification_type = ?, status = ?, notes = ?, testing_instructions = ?, created_at = ?, updated_at = ? WHERE id = ?",
+				ac.TaskID, ac.Description, string(ac.VerificationType), string(ac.Status), ac.Notes, ac.TestingInstructions, ac.CreatedAt, ac.UpdatedAt, ac.ID)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to import acceptance criterion %s: %w", ac.ID, err)
+		}
+		// Changesets without an ac_tags section (older exports) leave local tags alone
+		if changeset.ACTags != nil && (action == syncCreate || action == syncUpdate) {
+			if _, err := tx.ExecContext(ctx, "DELETE FROM ac_tags WHERE ac_id = ?", ac.ID); err != nil {
+				return nil, fmt.Errorf("failed to replace tags of acceptance criterion %s: %w", ac.ID, err)
+			}
+			for _, tag := range changeset.ACTags[ac.ID] {
+				if _, err := tx.ExecContext(ctx, "INSERT INTO ac_tags (ac_id, tag) VALUES (?, ?)", ac.ID, tag); err != nil {
+					return nil, fmt.Errorf("failed to import tag %s of acceptance criterion %s: %w", tag, ac.ID, err)
+				}
+			}
+		}
+		recordSyncAction(&result.AcceptanceCriteria, result, action, ac.ID)
+	}
+
+	for _, adr := range changeset.ADRs {
+		action, err := resolveSyncAction(ctx, tx, "adrs", "id", adr.ID, adr.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		switch action {
+		case syncCreate:
+			_, err = tx.ExecContext(ctx,
+				"INSERT INTO adrs (id, track_id, title, status, context, decision, consequences, alternatives, created_at, updated_at, superseded_by) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+				adr.ID, adr.TrackID, adr.Title, adr.Status, adr.Context, adr.Decision, adr.Consequences, adr.Alternatives, adr.CreatedAt, adr.UpdatedAt, adr.SupersededBy)
+		case syncUpdate:
+			_, err = tx.ExecContext(ctx,
+				"UPDATE adrs SET track_id = ?, title = ?, status = ?, context = ?, decision = ?, consequences = ?, alternatives = ?, created_at = ?, updated_at = ?, superseded_by = ? WHERE id = ?",
+				adr.TrackID, adr.Title, adr.Status, adr.Context, adr.Decision, adr.Consequences, adr.Alternatives, adr.CreatedAt, adr.UpdatedAt, adr.SupersededBy, adr.ID)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to import ADR %s: %w", adr.ID, err)
+		}
+		recordSyncAction(&result.ADRs, result, action, adr.ID)
+	}
+
+	for _, link := range changeset.ADRTaskLinks {
+		action, err := importSyncLink(ctx, tx, "adr_tasks", "adr_id", "task_id", link.ADRID, link.TaskID, link.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to import ADR task link %s -> %s: %w", link.ADRID, link.TaskID, err)
+		}
+		recordSyncAction(&result.ADRTaskLinks, result, action, link.ADRID+" -> "+link.TaskID)
+	}
+
+	for _, gate := range changeset.TaskGates {
+		action, err := importSyncLink(ctx, tx, "task_ac_gates", "task_id", "ac_id", gate.TaskID, gate.ACID, gate.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to import gate of task %s on %s: %w", gate.TaskID, gate.ACID, err)
+		}
+		recordSyncAction(&result.TaskGates, result, action, gate.TaskID+" -> "+gate.ACID)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return result, nil
+}
+
+// importSyncLink creates a link row unless it already exists. Links are never
+// changed once created, so they are either created or unchanged.
+func importSyncLink(ctx context.Context, tx DBTX, table, fromColumn, toColumn, from, to string, createdAt time.Time) (syncAction, error) {
+	var exists int
+	query := fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE %s = ? AND %s = ?", table, fromColumn, toColumn)
+	if err := tx.QueryRowContext(ctx, query, from, to).Scan(&exists); err != nil {
+		return 0, err
+	}
+	if exists > 0 {
+		return syncUnchanged, nil
+	}
+	insert := fmt.Sprintf("INSERT INTO %s (%s, %s, created_at) VALUES (?, ?, ?)", table, fromColumn, toColumn)
+	if _, err := tx.ExecContext(ctx, insert, from, to, createdAt); err != nil {
+		return 0, err
+	}
+	return syncCreate, nil
+}
+
+// resolveSyncAction compares an incoming updated_at with the local row (optimistic concurrency):
+// missing rows are created, older local rows updated, equal ones left alone and newer ones
+// reported as conflicts.
+func resolveSyncAction(ctx context.Context, tx DBTX, table, keyColumn string, key interface{}, incoming time.Time) (syncAction, error) {
+	var local time.Time
+	err := tx.QueryRowContext(ctx, fmt.Sprintf("SELECT updated_at FROM %s WHERE %s = ?", table, keyColumn), key).Scan(&local)
+	if err == sql.ErrNoRows {
+		return syncCreate, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to read %s %v: %w", table, key, err)
+	}
+
+	switch {
+	case incoming.After(local):
+		return syncUpdate, nil
+	case incoming.Equal(local):
+		return syncUnchanged, nil
+	default:
+		return syncConflict, nil
+	}
+}
+
+// recordSyncAction updates the per-type counts and the conflict list
+func recordSyncAction(counts *entities.SyncCounts, result *entities.SyncImportResult, action syncAction, id string) {
+	switch action {
+	case syncCreate:
+		counts.Created++
+	case syncUpdate:
+		counts.Updated++
+	case syncUnchanged:
+		counts.Unchanged++
+	case syncConflict:
+		counts.Conflicts++
+		result.ConflictIDs = append(result.ConflictIDs, id)
+	}
+}
@@ -0,0 +1,322 @@
+package persistence_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/infrastructure/persistence"
+)
+
+// ============================================================================
+// Sync Tests
+// ============================================================================
+
+// seedSyncSource creates a roadmap with two dependent tracks, a task in an iteration
+// with a definition of done item, a tagged acceptance criterion gating a second task
+// and an ADR linked to the first task, all stamped with the given time.
+func seedSyncSource(t *testing.T, db *sql.DB, at time.Time) {
+	t.Helper()
+	ctx := context.Background()
+	repo := persistence.NewSQLiteRepositoryComposite(db, createTestLogger())
+
+	if err := repo.SaveRoadmap(ctx, &entities.RoadmapEntity{ID: "roadmap-1", Vision: "V", SuccessCriteria: "S", CreatedAt: at, UpdatedAt: at}); err != nil {
+		t.Fatalf("failed to save roadmap: %v", err)
+	}
+	if err := repo.SaveTrack(ctx, &entities.TrackEntity{ID: "TM-track-1", RoadmapID: "roadmap-1", Title: "Core", Status: "in-progress", Rank: 100, CreatedAt: at, UpdatedAt: at}); err != nil {
+		t.Fatalf("failed to save track: %v", err)
+	}
+	if err := repo.SaveTrack(ctx, &entities.TrackEntity{ID: "TM-track-2", RoadmapID: "roadmap-1", Title: "UI", Status: "not-started", Rank: 200, Dependencies: []string{"TM-track-1"}, CreatedAt: at, UpdatedAt: at}); err != nil {
+		t.Fatalf("failed to save track: %v", err)
+	}
+	if err := repo.SaveTask(ctx, &entities.TaskEntity{ID: "TM-task-1", TrackID: "TM-track-1", Title: "Schema", Status: "todo", Rank: 500, CreatedAt: at, UpdatedAt: at}); err != nil {
+		t.Fatalf("failed to save task: %v", err)
+	}
+	if err := repo.SaveIteration(ctx, &entities.IterationEntity{Number: 1, Name: "Sprint 1", Goal: "MVP", Status: "planned", Rank: 500, TaskIDs: []string{}, CreatedAt: at, UpdatedAt: at}); err != nil {
+		t.Fatalf("failed to save iteration: %v", err)
+	}
+	if err := repo.AddTaskToIteration(ctx, 1, "TM-task-1"); err != nil {
+		t.Fatalf("failed to add task to iteration: %v", err)
+	}
+	if err := repo.Iteration.SaveIterationDoDItem(ctx, &entities.IterationDoDItemEntity{IterationNum: 1, Text: "Demo recorded", CreatedAt: at, UpdatedAt: at}); err != nil {
+		t.Fatalf("failed to save DoD item: %v", err)
+	}
+	if err := repo.SaveAC(ctx, entities.NewAcceptanceCriteriaEntity("TM-ac-1", "TM-task-1", "Tables exist", entities.VerificationTypeManual, "", at, at)); err != nil {
+		t.Fatalf("failed to save AC: %v", err)
+	}
+	if _, err := db.ExecContext(ctx, "INSERT INTO ac_tags (ac_id, tag) VALUES (?, ?)", "TM-ac-1", "docs"); err != nil {
+		t.Fatalf("failed to tag AC: %v", err)
+	}
+	if err := repo.SaveADR(ctx, &entities.ADREntity{ID: "TM-adr-1", TrackID: "TM-track-1", Title: "Use SQLite", Status: "accepted", Context: "c", Decision: "d", Consequences: "q", CreatedAt: at, UpdatedAt: at}); err != nil {
+		t.Fatalf("failed to save ADR: %v", err)
+	}
+	if _, err := db.ExecContext(ctx, "INSERT INTO adr_tasks (adr_id, task_id, created_at) VALUES (?, ?, ?)", "TM-adr-1", "TM-task-1", at); err != nil {
+		t.Fatalf("failed to link ADR to task: %v", err)
+	}
+	if err := repo.SaveTask(ctx, &entities.TaskEntity{ID: "TM-task-2", TrackID: "TM-track-2", Title: "Screens", Status: "todo", Rank: 500, CreatedAt: at, UpdatedAt: at}); err != nil {
+		t.Fatalf("failed to save task: %v", err)
+	}
+	if _, err := db.ExecContext(ctx, "INSERT INTO task_ac_gates (task_id, ac_id, created_at) VALUES (?, ?, ?)", "TM-task-2", "TM-ac-1", at); err != nil {
+		t.Fatalf("failed to gate task: %v", err)
+	}
+}
+
+// roundTripChangeset simulates writing a changeset to disk and reading it on another machine
+func roundTripChangeset(t *testing.T, changeset *entities.SyncChangeset) *entities.SyncChangeset {
+	t.Helper()
+	data, err := json.Marshal(changeset)
+	if err != nil {
+		t.Fatalf("failed to marshal changeset: %v", err)
+	}
+	var decoded entities.SyncChangeset
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("failed to unmarshal changeset: %v", err)
+	}
+	return &decoded
+}
+
+func TestSyncExportChanges_FiltersByUpdatedAt(t *testing.T) {
+	db := createTestDB(t)
+	defer db.Close()
+	ctx := context.Background()
+
+	base := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	seedSyncSource(t, db, base)
+
+	// Touch one task after the cutoff
+	repo := persistence.NewSQLiteRepositoryComposite(db, createTestLogger())
+	task, err := repo.GetTask(ctx, "TM-task-1")
+	if err != nil {
+		t.Fatalf("failed to get task: %v", err)
+	}
+	task.Status = "in-progress"
+	task.UpdatedAt = base.Add(2 * time.Hour)
+	if err := repo.UpdateTask(ctx, task); err != nil {
+		t.Fatalf("failed to update task: %v", err)
+	}
+
+	syncRepo := persistence.NewSQLiteSyncRepository(db, createTestLogger())
+
+	full, err := syncRepo.ExportChanges(ctx, time.Time{})
+	if err != nil {
+		t.Fatalf("ExportChanges failed: %v", err)
+	}
+	if full.Count() != 11 {
+		t.Errorf("expected 11 entities in full export, got %d", full.Count())
+	}
+	if len(full.Tracks) != 2 || len(full.Tracks[1].Dependencies) != 1 || full.Tracks[1].Dependencies[0] != "TM-track-1" {
+		t.Errorf("expected track dependencies to be exported, got %+v", full.Tracks)
+	}
+	if len(full.Iterations) != 1 || len(full.Iterations[0].TaskIDs) != 1 {
+		t.Errorf("expected iteration membership to be exported, got %+v", full.Iterations)
+	}
+	if len(full.ADRTaskLinks) != 1 || full.ADRTaskLinks[0].ADRID != "TM-adr-1" || full.ADRTaskLinks[0].TaskID != "TM-task-1" {
+		t.Errorf("expected the ADR task link to be exported, got %+v", full.ADRTaskLinks)
+	}
+	if len(full.TaskGates) != 1 || full.TaskGates[0].TaskID != "TM-task-2" || full.TaskGates[0].ACID != "TM-ac-1" {
+		t.Errorf("expected the task gate to be exported, got %+v", full.TaskGates)
+	}
+	if tags := full.ACTags["TM-ac-1"]; len(tags) != 1 || tags[0] != "docs" {
+		t.Errorf("expected the AC tags to be exported, got %+v", full.ACTags)
+	}
+
+	delta, err := syncRepo.ExportChanges(ctx, base.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("ExportChanges failed: %v", err)
+	}
+	if delta.Count() != 1 || len(delta.Tasks) != 1 || delta.Tasks[0].Status != "in-progress" {
+		t.Errorf("expected only the updated task in delta, got %+v", delta)
+	}
+}
+
+func TestSyncExportChanges_CountsNotSyncedRows(t *testing.T) {
+	db := createTestDB(t)
+	defer db.Close()
+	ctx := context.Background()
+
+	base := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	seedSyncSource(t, db, base)
+	if _, err := db.ExecContext(ctx, "INSERT INTO roadmap_criteria (roadmap_id, text, met, created_at, updated_at) VALUES (?, ?, 0, ?, ?)", "roadmap-1", "Ship v1", base, base); err != nil {
+		t.Fatalf("failed to save criterion: %v", err)
+	}
+	for _, at := range []time.Time{base, base.Add(2 * time.Hour)} {
+		if _, err := db.ExecContext(ctx, "INSERT INTO task_notes (task_id, content, created_at) VALUES (?, ?, ?)", "TM-task-1", "note", at); err != nil {
+			t.Fatalf("failed to save note: %v", err)
+		}
+	}
+
+	syncRepo := persistence.NewSQLiteSyncRepository(db, createTestLogger())
+
+	full, err := syncRepo.ExportChanges(ctx, time.Time{})
+	if err != nil {
+		t.Fatalf("ExportChanges failed: %v", err)
+	}
+	if len(full.NotSynced) != 2 || full.NotSynced["roadmap_criteria"] != 1 || full.NotSynced["task_notes"] != 2 {
+		t.Errorf("expected 1 criterion and 2 notes not synced, got %v", full.NotSynced)
+	}
+
+	delta, err := syncRepo.ExportChanges(ctx, base.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("ExportChanges failed: %v", err)
+	}
+	if len(delta.NotSynced) != 1 || delta.NotSynced["task_notes"] != 1 {
+		t.Errorf("expected only the later note not synced, got %v", delta.NotSynced)
+	}
+}
+
+func TestSyncImportChanges_RoundTripIsIdempotent(t *testing.T) {
+	source := createTestDB(t)
+	defer source.Close()
+	target := createTestDB(t)
+	defer target.Close()
+	ctx := context.Background()
+
+	seedSyncSource(t, source, time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC))
+
+	changeset, err := persistence.NewSQLiteSyncRepository(source, createTestLogger()).ExportChanges(ctx, time.Time{})
+	if err != nil {
+		t.Fatalf("ExportChanges failed: %v", err)
+	}
+	changeset = roundTripChangeset(t, changeset)
+
+	targetSync := persistence.NewSQLiteSyncRepository(target, createTestLogger())
+	result, err := targetSync.ImportChanges(ctx, changeset)
+	if err != nil {
+		t.Fatalf("ImportChanges failed: %v", err)
+	}
+	if total := result.Total(); total.Created != 11 || total.Updated != 0 {
+		t.Errorf("expected 11 created, got %+v", total)
+	}
+
+	// Relationships are replicated
+	targetRepo := persistence.NewSQLiteRepositoryComposite(target, createTestLogger())
+	deps, err := targetRepo.GetTrackDependencies(ctx, "TM-track-2")
+	if err != nil || len(deps) != 1 || deps[0] != "TM-track-1" {
+		t.Errorf("expected dependency on TM-track-1, got %v (err %v)", deps, err)
+	}
+	iteration, err := targetRepo.GetIteration(ctx, 1)
+	if err != nil {
+		t.Fatalf("failed to get imported iteration: %v", err)
+	}
+	if len(iteration.TaskIDs) != 1 || iteration.TaskIDs[0] != "TM-task-1" {
+		t.Errorf("expected iteration to contain TM-task-1, got %v", iteration.TaskIDs)
+	}
+	linked, err := targetRepo.ListTaskADRs(ctx, "TM-task-1")
+	if err != nil || len(linked) != 1 || linked[0].ID != "TM-adr-1" {
+		t.Errorf("expected TM-task-1 to be linked to TM-adr-1, got %v (err %v)", linked, err)
+	}
+	gates, err := targetRepo.ListTaskGates(ctx, "TM-task-2")
+	if err != nil || len(gates) != 1 || gates[0].ID != "TM-ac-1" {
+		t.Errorf("expected TM-task-2 to be gated on TM-ac-1, got %v (err %v)", gates, err)
+	}
+	dod, err := targetRepo.ListIterationDoDItems(ctx, 1)
+	if err != nil || len(dod) != 1 || dod[0].Text != "Demo recorded" {
+		t.Errorf("expected the DoD item of iteration 1, got %v (err %v)", dod, err)
+	}
+	tags, err := targetRepo.AC.ListACTags(ctx, "TM-ac-1")
+	if err != nil || len(tags) != 1 || tags[0] != "docs" {
+		t.Errorf("expected TM-ac-1 to be tagged docs, got %v (err %v)", tags, err)
+	}
+
+	// Re-importing the same changeset changes nothing
+	result, err = targetSync.ImportChanges(ctx, changeset)
+	if err != nil {
+		t.Fatalf("second ImportChanges failed: %v", err)
+	}
+	if total := result.Total(); total.Unchanged != 11 || total.Created != 0 || total.Updated != 0 || total.Conflicts != 0 {
+		t.Errorf("expected re-import to be a no-op, got %+v", total)
+	}
+}
+
+func TestSyncImportChanges_UpdatesAndConflicts(t *testing.T) {
+	db := createTestDB(t)
+	defer db.Close()
+	ctx := context.Background()
+
+	base := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	seedSyncSource(t, db, base)
+	syncRepo := persistence.NewSQLiteSyncRepository(db, createTestLogger())
+	checkedAt := base.Add(time.Hour)
+
+	changeset := &entities.SyncChangeset{
+		FormatVersion: entities.SyncChangesetFormatVersion,
+		Tasks: []*entities.TaskEntity{
+			// Newer than local: applied
+			{ID: "TM-task-1", TrackID: "TM-track-1", Title: "Schema v2", Status: "done", Rank: 500, CreatedAt: base, UpdatedAt: base.Add(time.Hour)},
+		},
+		IterationDoD: []*entities.IterationDoDItemEntity{
+			// Matched by iteration and creation time, not by the source's ID
+			{ID: 42, IterationNum: 1, Text: "Demo recorded", Done: true, DoneAt: &checkedAt, CreatedAt: base, UpdatedAt: checkedAt},
+		},
+		Tracks: []*entities.TrackEntity{
+			// Older than local: conflict, local copy kept
+			{ID: "TM-track-1", RoadmapID: "roadmap-1", Title: "Stale", Status: "blocked", Rank: 100, CreatedAt: base, UpdatedAt: base.Add(-time.Hour)},
+		},
+	}
+
+	result, err := syncRepo.ImportChanges(ctx, changeset)
+	if err != nil {
+		t.Fatalf("ImportChanges failed: %v", err)
+	}
+	if result.IterationDoD.Updated != 1 {
+		t.Errorf("expected 1 DoD item updated, got %+v", result.IterationDoD)
+	}
+	if result.Tasks.Updated != 1 {
+		t.Errorf("expected 1 task updated, got %+v", result.Tasks)
+	}
+	if result.Tracks.Conflicts != 1 || len(result.ConflictIDs) != 1 || result.ConflictIDs[0] != "TM-track-1" {
+		t.Errorf("expected TM-track-1 conflict, got %+v / %v", result.Tracks, result.ConflictIDs)
+	}
+
+	repo := persistence.NewSQLiteRepositoryComposite(db, createTestLogger())
+	task, err := repo.GetTask(ctx, "TM-task-1")
+	if err != nil {
+		t.Fatalf("failed to get task: %v", err)
+	}
+	if task.Title != "Schema v2" || task.Status != "done" {
+		t.Errorf("expected task to be updated, got %q/%q", task.Title, task.Status)
+	}
+	track, err := repo.GetTrack(ctx, "TM-track-1")
+	if err != nil {
+		t.Fatalf("failed to get track: %v", err)
+	}
+	if track.Title != "Core" {
+		t.Errorf("expected conflicting track to keep local title, got %q", track.Title)
+	}
+	dod, err := repo.ListIterationDoDItems(ctx, 1)
+	if err != nil {
+		t.Fatalf("failed to list DoD items: %v", err)
+	}
+	if len(dod) != 1 || !dod[0].Done {
+		t.Errorf("expected the existing DoD item to be checked, got %+v", dod)
+	}
+}
+
+func TestSyncImportChanges_RollsBackOnError(t *testing.T) {
+	db := createTestDB(t)
+	defer db.Close()
+	ctx := context.Background()
+
+	now := time.Now().UTC()
+	changeset := &entities.SyncChangeset{
+		FormatVersion: entities.SyncChangesetFormatVersion,
+		Roadmaps:      []*entities.RoadmapEntity{{ID: "roadmap-1", Vision: "V", SuccessCriteria: "S", CreatedAt: now, UpdatedAt: now}},
+		// The duplicate task violates the iteration_tasks key after the roadmap was written
+		Iterations: []*entities.IterationEntity{{Number: 1, Name: "I", Status: "planned", Rank: 500, TaskIDs: []string{"TM-task-1", "TM-task-1"}, CreatedAt: now, UpdatedAt: now}},
+	}
+
+	if _, err := persistence.NewSQLiteSyncRepository(db, createTestLogger()).ImportChanges(ctx, changeset); err == nil {
+		t.Fatal("expected import to fail")
+	}
+
+	var count int
+	if err := db.QueryRowContext(ctx, "SELECT COUNT(*) FROM roadmaps").Scan(&count); err != nil {
+		t.Fatalf("failed to count roadmaps: %v", err)
+	}
+	if count != 0 {
+		t.Errorf("expected failed import to be rolled back, found %d roadmaps", count)
+	}
+}
@@ -16,15 +16,17 @@ var _ repositories.TaskRepository = (*SQLiteTaskRepository)(nil)
 
 // SQLiteTaskRepository implements repositories.TaskRepository using SQLite as the backend.
 type SQLiteTaskRepository struct {
-	DB     *sql.DB
-	logger pluginsdk.Logger
+	DB          DBTX
+	logger      pluginsdk.Logger
+	retryPolicy WriteRetryPolicy
 }
 
 // NewSQLiteTaskRepository creates a new SQLite-backed repository.
-func NewSQLiteTaskRepository(db *sql.DB, logger pluginsdk.Logger) *SQLiteTaskRepository {
+func NewSQLiteTaskRepository(db DBTX, logger pluginsdk.Logger) *SQLiteTaskRepository {
 	return &SQLiteTaskRepository{
-		DB:     db,
-		logger: logger,
+		DB:          db,
+		logger:      logger,
+		retryPolicy: DefaultWriteRetryPolicy(),
 	}
 }
 
@@ -33,7 +35,18 @@ func NewSQLiteTaskRepository(db *sql.DB, logger pluginsdk.Logger) *SQLiteTaskRep
 // ============================================================================
 
 // SaveTask persists a new task to storage.
+// Retried while the database is busy or locked.
 func (r *SQLiteTaskRepository) SaveTask(ctx context.Context, task *entities.TaskEntity) error {
+	if err := entities.ValidateTaskStatus(task.Status); err != nil {
+		return err
+	}
+	return retryWrite(ctx, r.DB, r.retryPolicy, func() error {
+		return r.saveTask(ctx, task)
+	})
+}
+
+// saveTask performs a single SaveTask attempt.
+func (r *SQLiteTaskRepository) saveTask(ctx context.Context, task *entities.TaskEntity) error {
 	// Check if task already exists
 	var exists int
 	err := r.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM tasks WHERE id = ?", task.ID).Scan(&exists)
@@ -56,8 +69,8 @@ func (r *SQLiteTaskRepository) SaveTask(ctx context.Context, task *entities.Task
 
 	_, err = r.DB.ExecContext(
 		ctx,
-		"INSERT INTO tasks (id, track_id, title, description, status, rank, branch, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)",
-		task.ID, task.TrackID, task.Title, task.Description, task.Status, task.Rank, task.Branch, task.CreatedAt, task.UpdatedAt,
+		"INSERT INTO tasks (id, track_id, title, description, status, rank, branch, assignee, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		task.ID, task.TrackID, task.Title, task.Description, task.Status, task.Rank, task.Branch, task.Assignee, task.CreatedAt, task.UpdatedAt,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to insert task: %w", err)
@@ -69,13 +82,13 @@ func (r *SQLiteTaskRepository) SaveTask(ctx context.Context, task *entities.Task
 // GetTask retrieves a task by its ID.
 func (r *SQLiteTaskRepository) GetTask(ctx context.Context, id string) (*entities.TaskEntity, error) {
 	var task entities.TaskEntity
-	var branch sql.NullString
+	var branch, assignee sql.NullString
 
 	err := r.DB.QueryRowContext(
 		ctx,
-		"SELECT id, track_id, title, description, status, rank, branch, created_at, updated_at FROM tasks WHERE id = ?",
+		"SELECT id, track_id, title, description, status, rank, branch, assignee, created_at, updated_at FROM tasks WHERE id = ?",
 		id,
-	).Scan(&task.ID, &task.TrackID, &task.Title, &task.Description, &task.Status, &task.Rank, &branch, &task.CreatedAt, &task.UpdatedAt)
+	).Scan(&task.ID, &task.TrackID, &task.Title, &task.Description, &task.Status, &task.Rank, &branch, &assignee, &task.CreatedAt, &task.UpdatedAt)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -87,13 +100,16 @@ func (r *SQLiteTaskRepository) GetTask(ctx context.Context, id string) (*entitie
 	if branch.Valid {
 		task.Branch = branch.String
 	}
+	if assignee.Valid {
+		task.Assignee = assignee.String
+	}
 
 	return &task, nil
 }
 
 // ListTasks returns all tasks matching the filters.
 func (r *SQLiteTaskRepository) ListTasks(ctx context.Context, filters entities.TaskFilters) ([]*entities.TaskEntity, error) {
-	query := "SELECT id, track_id, title, description, status, rank, branch, created_at, updated_at FROM tasks WHERE 1=1"
+	query := "SELECT id, track_id, title, description, status, rank, branch, assignee, created_at, updated_at FROM tasks WHERE 1=1"
 	args := []interface{}{}
 
 	// Add track filter if provided
@@ -101,6 +117,10 @@ func (r *SQLiteTaskRepository) ListTasks(ctx context.Context, filters entities.T
 		query += " AND track_id = ?"
 		args = append(args, filters.TrackID)
 	}
+	if filters.RoadmapID != "" {
+		query += " AND track_id IN (SELECT id FROM tracks WHERE roadmap_id = ?)"
+		args = append(args, filters.RoadmapID)
+	}
 
 	// Add status filter if provided
 	if len(filters.Status) > 0 {
@@ -128,6 +148,24 @@ func (r *SQLiteTaskRepository) ListTasks(ctx context.Context, filters entities.T
 		query += " AND rank IN (" + placeholders + ")"
 	}
 
+	// Add assignee filter if provided
+	if filters.Assignee != "" {
+		query += " AND assignee = ?"
+		args = append(args, filters.Assignee)
+	}
+	if filters.Unassigned {
+		query += " AND (assignee IS NULL OR assignee = '')"
+	}
+	if filters.UpdatedBefore != nil {
+		// julianday compares instants, whatever offset a timestamp was stored with
+		query += " AND julianday(updated_at) < julianday(?)"
+		args = append(args, filters.UpdatedBefore.UTC())
+	}
+	if filters.ACTag != "" {
+		query += " AND id IN (SELECT ac.task_id FROM acceptance_criteria ac INNER JOIN ac_tags t ON t.ac_id = ac.id WHERE t.tag = ?)"
+		args = append(args, filters.ACTag)
+	}
+
 	query += " ORDER BY id"
 
 	rows, err := r.DB.QueryContext(ctx, query, args...)
@@ -139,9 +177,9 @@ func (r *SQLiteTaskRepository) ListTasks(ctx context.Context, filters entities.T
 	var tasks []*entities.TaskEntity
 	for rows.Next() {
 		var task entities.TaskEntity
-		var branch sql.NullString
+		var branch, assignee sql.NullString
 
-		err := rows.Scan(&task.ID, &task.TrackID, &task.Title, &task.Description, &task.Status, &task.Rank, &branch, &task.CreatedAt, &task.UpdatedAt)
+		err := rows.Scan(&task.ID, &task.TrackID, &task.Title, &task.Description, &task.Status, &task.Rank, &branch, &assignee, &task.CreatedAt, &task.UpdatedAt)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan task: %w", err)
 		}
@@ -149,6 +187,9 @@ func (r *SQLiteTaskRepository) ListTasks(ctx context.Context, filters entities.T
 		if branch.Valid {
 			task.Branch = branch.String
 		}
+		if assignee.Valid {
+			task.Assignee = assignee.String
+		}
 
 		tasks = append(tasks, &task)
 	}
@@ -161,11 +202,22 @@ func (r *SQLiteTaskRepository) ListTasks(ctx context.Context, filters entities.T
 }
 
 // UpdateTask updates an existing task.
+// Retried while the database is busy or locked.
 func (r *SQLiteTaskRepository) UpdateTask(ctx context.Context, task *entities.TaskEntity) error {
+	if err := entities.ValidateTaskStatus(task.Status); err != nil {
+		return err
+	}
+	return retryWrite(ctx, r.DB, r.retryPolicy, func() error {
+		return r.updateTask(ctx, task)
+	})
+}
+
+// updateTask performs a single UpdateTask attempt.
+func (r *SQLiteTaskRepository) updateTask(ctx context.Context, task *entities.TaskEntity) error {
 	result, err := r.DB.ExecContext(
 		ctx,
-		"UPDATE tasks SET track_id = ?, title = ?, description = ?, status = ?, rank = ?, branch = ?, updated_at = ? WHERE id = ?",
-		task.TrackID, task.Title, task.Description, task.Status, task.Rank, task.Branch, task.UpdatedAt, task.ID,
+		"UPDATE tasks SET track_id = ?, title = ?, description = ?, status = ?, rank = ?, branch = ?, assignee = ?, updated_at = ? WHERE id = ?",
+		task.TrackID, task.Title, task.Description, task.Status, task.Rank, task.Branch, task.Assignee, task.UpdatedAt, task.ID,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to update task: %w", err)
@@ -199,6 +251,17 @@ func (r *SQLiteTaskRepository) DeleteTask(ctx context.Context, id string) error
 		return fmt.Errorf("%w: task %s not found", pluginsdk.ErrNotFound, id)
 	}
 
+	// Foreign keys are not enforced, so remove the task's notes, gates and ADR links explicitly
+	if _, err := r.DB.ExecContext(ctx, "DELETE FROM task_notes WHERE task_id = ?", id); err != nil {
+		return fmt.Errorf("failed to delete task notes: %w", err)
+	}
+	if _, err := r.DB.ExecContext(ctx, "DELETE FROM task_ac_gates WHERE task_id = ?", id); err != nil {
+		return fmt.Errorf("failed to delete task gates: %w", err)
+	}
+	if _, err := r.DB.ExecContext(ctx, "DELETE FROM adr_tasks WHERE task_id = ?", id); err != nil {
+		return fmt.Errorf("failed to delete ADR links: %w", err)
+	}
+
 	return nil
 }
 
@@ -226,15 +289,18 @@ func (r *SQLiteTaskRepository) MoveTaskToTrack(ctx context.Context, taskID, newT
 	return r.UpdateTask(ctx, task)
 }
 
-// GetBacklogTasks returns all tasks that are not in any iteration and not done.
-func (r *SQLiteTaskRepository) GetBacklogTasks(ctx context.Context) ([]*entities.TaskEntity, error) {
+// GetBacklogTasks returns all tasks that are not in any iteration and not done, limited
+// to the tracks of roadmapID unless it is empty.
+func (r *SQLiteTaskRepository) GetBacklogTasks(ctx context.Context, roadmapID string) ([]*entities.TaskEntity, error) {
 	rows, err := r.DB.QueryContext(
 		ctx,
-		`SELECT t.id, t.track_id, t.title, t.description, t.status, t.rank, t.branch, t.created_at, t.updated_at
+		`SELECT t.id, t.track_id, t.title, t.description, t.status, t.rank, t.branch, t.assignee, t.created_at, t.updated_at
 		 FROM tasks t
+		 JOIN tracks tr ON tr.id = t.track_id
 		 LEFT JOIN iteration_tasks it ON t.id = it.task_id
-		 WHERE it.task_id IS NULL AND t.status != 'done'
+		 WHERE it.task_id IS NULL AND t.status != 'done' AND (? = '' OR tr.roadmap_id = ?)
 		 ORDER BY t.created_at ASC`,
+		roadmapID, roadmapID,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query backlog tasks: %w", err)
@@ -244,9 +310,9 @@ func (r *SQLiteTaskRepository) GetBacklogTasks(ctx context.Context) ([]*entities
 	var tasks []*entities.TaskEntity
 	for rows.Next() {
 		var task entities.TaskEntity
-		var branch sql.NullString
+		var branch, assignee sql.NullString
 
-		err := rows.Scan(&task.ID, &task.TrackID, &task.Title, &task.Description, &task.Status, &task.Rank, &branch, &task.CreatedAt, &task.UpdatedAt)
+		err := rows.Scan(&task.ID, &task.TrackID, &task.Title, &task.Description, &task.Status, &task.Rank, &branch, &assignee, &task.CreatedAt, &task.UpdatedAt)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan task: %w", err)
 		}
@@ -254,6 +320,9 @@ func (r *SQLiteTaskRepository) GetBacklogTasks(ctx context.Context) ([]*entities
 		if branch.Valid {
 			task.Branch = branch.String
 		}
+		if assignee.Valid {
+			task.Assignee = assignee.String
+		}
 
 		tasks = append(tasks, &task)
 	}
@@ -315,6 +384,259 @@ func (r *SQLiteTaskRepository) GetIterationsForTask(ctx context.Context, taskID
 	return iterations, nil
 }
 
+// GetTasksByTrack returns the tasks of the given tracks grouped by track ID, with one
+// indexed query per track.
+func (r *SQLiteTaskRepository) GetTasksByTrack(ctx context.Context, trackIDs []string) (map[string][]*entities.TaskEntity, error) {
+	grouped := make(map[string][]*entities.TaskEntity)
+	for _, trackID := range trackIDs {
+		tasks, err := r.getTrackTasks(ctx, trackID)
+		if err != nil {
+			return nil, err
+		}
+		if len(tasks) > 0 {
+			grouped[trackID] = tasks
+		}
+	}
+	return grouped, nil
+}
+
+// getTrackTasks returns the tasks of a track ordered by ID
+func (r *SQLiteTaskRepository) getTrackTasks(ctx context.Context, trackID string) ([]*entities.TaskEntity, error) {
+	rows, err := r.DB.QueryContext(
+		ctx,
+		`SELECT id, track_id, title, description, status, rank, branch, assignee, created_at, updated_at
+		 FROM tasks
+		 WHERE track_id = ?
+		 ORDER BY id`,
+		trackID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query tasks of track %s: %w", trackID, err)
+	}
+	defer rows.Close()
+
+	var tasks []*entities.TaskEntity
+	for rows.Next() {
+		var task entities.TaskEntity
+		var branch, assignee sql.NullString
+
+		err := rows.Scan(&task.ID, &task.TrackID, &task.Title, &task.Description, &task.Status, &task.Rank, &branch, &assignee, &task.CreatedAt, &task.UpdatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan task: %w", err)
+		}
+
+		if branch.Valid {
+			task.Branch = branch.String
+		}
+		if assignee.Valid {
+			task.Assignee = assignee.String
+		}
+
+		tasks = append(tasks, &task)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating tasks: %w", err)
+	}
+
+	return tasks, nil
+}
+
+// GetTasksByIteration returns the tasks of every iteration grouped by iteration number,
+// joining iteration_tasks with tasks in a single query.
+func (r *SQLiteTaskRepository) GetTasksByIteration(ctx context.Context) (map[int][]*entities.TaskEntity, error) {
+	rows, err := r.DB.QueryContext(
+		ctx,
+		`SELECT it.iteration_number, t.id, t.track_id, t.title, t.description, t.status, t.rank, t.branch, t.assignee, t.created_at, t.updated_at
+		 FROM iteration_tasks it
+		 JOIN tasks t ON t.id = it.task_id
+		 ORDER BY it.iteration_number, it.task_id`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query tasks by iteration: %w", err)
+	}
+	defer rows.Close()
+
+	grouped := make(map[int][]*entities.TaskEntity)
+	for rows.Next() {
+		var iterationNum int
+		var task entities.TaskEntity
+		var branch, assignee sql.NullString
+
+		err := rows.Scan(&iterationNum, &task.ID, &task.TrackID, &task.Title, &task.Description, &task.Status, &task.Rank, &branch, &assignee, &task.CreatedAt, &task.UpdatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan task: %w", err)
+		}
+
+		if branch.Valid {
+			task.Branch = branch.String
+		}
+		if assignee.Valid {
+			task.Assignee = assignee.String
+		}
+
+		grouped[iterationNum] = append(grouped[iterationNum], &task)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating tasks: %w", err)
+	}
+
+	return grouped, nil
+}
+
+// ============================================================================
+// Task Note Operations
+// ============================================================================
+
+// SaveTaskNote persists a new note for a task and assigns its ID.
+func (r *SQLiteTaskRepository) SaveTaskNote(ctx context.Context, note *entities.TaskNoteEntity) error {
+	// Verify task exists
+	var taskExists int
+	err := r.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM tasks WHERE id = ?", note.TaskID).Scan(&taskExists)
+	if err != nil {
+		return fmt.Errorf("failed to verify task: %w", err)
+	}
+	if taskExists == 0 {
+		return fmt.Errorf("%w: task %s not found", pluginsdk.ErrNotFound, note.TaskID)
+	}
+
+	result, err := r.DB.ExecContext(
+		ctx,
+		"INSERT INTO task_notes (task_id, content, created_at) VALUES (?, ?, ?)",
+		note.TaskID, note.Content, note.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert task note: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("failed to get task note ID: %w", err)
+	}
+	note.ID = int(id)
+
+	return nil
+}
+
+// ListTaskNotes returns all notes of a task in creation order.
+func (r *SQLiteTaskRepository) ListTaskNotes(ctx context.Context, taskID string) ([]*entities.TaskNoteEntity, error) {
+	rows, err := r.DB.QueryContext(
+		ctx,
+		"SELECT id, task_id, content, created_at FROM task_notes WHERE task_id = ? ORDER BY id ASC",
+		taskID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query task notes: %w", err)
+	}
+	defer rows.Close()
+
+	notes := []*entities.TaskNoteEntity{}
+	for rows.Next() {
+		var note entities.TaskNoteEntity
+		if err := rows.Scan(&note.ID, &note.TaskID, &note.Content, &note.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan task note: %w", err)
+		}
+		notes = append(notes, &note)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating task notes: %w", err)
+	}
+
+	return notes, nil
+}
+
+// ============================================================================
+// Task Gate Operations
+// ============================================================================
+
+// AddTaskGate gates a task on an acceptance criterion.
+func (r *SQLiteTaskRepository) AddTaskGate(ctx context.Context, taskID, acID string) error {
+	var exists int
+	err := r.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM tasks WHERE id = ?", taskID).Scan(&exists)
+	if err != nil {
+		return fmt.Errorf("failed to verify task: %w", err)
+	}
+	if exists == 0 {
+		return fmt.Errorf("%w: task %s not found", pluginsdk.ErrNotFound, taskID)
+	}
+
+	err = r.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM acceptance_criteria WHERE id = ?", acID).Scan(&exists)
+	if err != nil {
+		return fmt.Errorf("failed to check AC existence: %w", err)
+	}
+	if exists == 0 {
+		return fmt.Errorf("%w: AC %s not found", pluginsdk.ErrNotFound, acID)
+	}
+
+	_, err = r.DB.ExecContext(
+		ctx,
+		"INSERT OR IGNORE INTO task_ac_gates (task_id, ac_id, created_at) VALUES (?, ?, ?)",
+		taskID, acID, time.Now().UTC(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to add task gate: %w", err)
+	}
+	return nil
+}
+
+// RemoveTaskGate removes the gate of a task on an acceptance criterion.
+func (r *SQLiteTaskRepository) RemoveTaskGate(ctx context.Context, taskID, acID string) error {
+	result, err := r.DB.ExecContext(ctx, "DELETE FROM task_ac_gates WHERE task_id = ? AND ac_id = ?", taskID, acID)
+	if err != nil {
+		return fmt.Errorf("failed to remove task gate: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("%w: task %s is not gated on AC %s", pluginsdk.ErrNotFound, taskID, acID)
+	}
+
+	return nil
+}
+
+// ListTaskGates returns the acceptance criteria a task is gated on, in the order the gates were added.
+func (r *SQLiteTaskRepository) ListTaskGates(ctx context.Context, taskID string) ([]*entities.AcceptanceCriteriaEntity, error) {
+	rows, err := r.DB.QueryContext(
+		ctx,
+		`SELECT ac.id, ac.task_id, ac.description, ac.verification_type, ac.status, ac.notes, ac.testing_instructions, ac.created_at, ac.updated_at
+		FROM acceptance_criteria ac
+		INNER JOIN task_ac_gates g ON g.ac_id = ac.id
+		WHERE g.task_id = ?
+		ORDER BY g.rowid ASC`,
+		taskID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query task gates: %w", err)
+	}
+	defer rows.Close()
+
+	acs := []*entities.AcceptanceCriteriaEntity{}
+	for rows.Next() {
+		var ac entities.AcceptanceCriteriaEntity
+		var testingInstructions sql.NullString
+		err := rows.Scan(&ac.ID, &ac.TaskID, &ac.Description, (*string)(&ac.VerificationType), (*string)(&ac.Status), &ac.Notes, &testingInstructions, &ac.CreatedAt, &ac.UpdatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan gating AC: %w", err)
+		}
+		if testingInstructions.Valid {
+			ac.TestingInstructions = testingInstructions.String
+		}
+		acs = append(acs, &ac)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating task gates: %w", err)
+	}
+
+	return acs, nil
+}
+
 // ============================================================================
 // Helper Methods
 // ============================================================================
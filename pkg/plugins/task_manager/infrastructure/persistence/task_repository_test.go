@@ -2,7 +2,11 @@ package persistence_test
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -192,7 +196,7 @@ func TestGetBacklogTasks(t *testing.T) {
 	iterationRepo.AddTaskToIteration(ctx, 1, "task-4")
 
 	// Get backlog tasks
-	backlog, err := taskRepo.GetBacklogTasks(ctx)
+	backlog, err := taskRepo.GetBacklogTasks(ctx, "")
 	if err != nil {
 		t.Fatalf("failed to get backlog tasks: %v", err)
 	}
@@ -219,6 +223,64 @@ func TestGetBacklogTasks(t *testing.T) {
 	}
 }
 
+func TestGetBacklogTasksByRoadmap(t *testing.T) {
+	db := createTestDB(t)
+	defer db.Close()
+
+	roadmapRepo := persistence.NewSQLiteRoadmapRepository(db, createTestLogger())
+	trackRepo := persistence.NewSQLiteTrackRepository(db, createTestLogger())
+	taskRepo := persistence.NewSQLiteTaskRepository(db, createTestLogger())
+	iterationRepo := persistence.NewSQLiteIterationRepository(db, createTestLogger(), persistence.NewSQLiteAcceptanceCriteriaRepository(db, createTestLogger()))
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	// One track with one task in each of two roadmaps, plus an iteration per roadmap
+	// and an empty one
+	for i, id := range []string{"1", "2"} {
+		roadmap, _ := entities.NewRoadmapEntity("roadmap-"+id, "vision", "criteria", now, now)
+		roadmapRepo.SaveRoadmap(ctx, roadmap)
+		track, _ := entities.NewTrackEntity("track-"+id, "roadmap-"+id, "Track", "", "not-started", 200, []string{}, now, now)
+		trackRepo.SaveTrack(ctx, track)
+		task, _ := entities.NewTaskEntity("task-"+id, "track-"+id, "Task", "", "todo", 200, "", now, now)
+		taskRepo.SaveTask(ctx, task)
+		planned, _ := entities.NewTaskEntity("planned-"+id, "track-"+id, "Planned", "", "todo", 200, "", now, now)
+		taskRepo.SaveTask(ctx, planned)
+		iteration, _ := entities.NewIterationEntity(i+1, "Sprint "+id, "Goal", "", []string{}, "planned", 500, time.Time{}, time.Time{}, now, now)
+		iterationRepo.SaveIteration(ctx, iteration)
+		iterationRepo.AddTaskToIteration(ctx, i+1, "planned-"+id)
+	}
+	empty, _ := entities.NewIterationEntity(3, "Empty", "Goal", "", []string{}, "planned", 500, time.Time{}, time.Time{}, now, now)
+	iterationRepo.SaveIteration(ctx, empty)
+
+	backlog, err := taskRepo.GetBacklogTasks(ctx, "roadmap-2")
+	if err != nil {
+		t.Fatalf("failed to get backlog tasks: %v", err)
+	}
+	if len(backlog) != 1 || backlog[0].ID != "task-2" {
+		t.Errorf("expected only task-2 in the backlog of roadmap-2, got %v", backlog)
+	}
+
+	tasks, err := taskRepo.ListTasks(ctx, entities.TaskFilters{RoadmapID: "roadmap-1"})
+	if err != nil {
+		t.Fatalf("failed to list tasks: %v", err)
+	}
+	if len(tasks) != 2 || tasks[0].ID != "planned-1" || tasks[1].ID != "task-1" {
+		t.Errorf("expected the tasks of roadmap-1, got %v", tasks)
+	}
+
+	iterations, err := iterationRepo.ListIterationsByRoadmap(ctx, "roadmap-2")
+	if err != nil {
+		t.Fatalf("failed to list iterations: %v", err)
+	}
+	var numbers []int
+	for _, iteration := range iterations {
+		numbers = append(numbers, iteration.Number)
+	}
+	if len(numbers) != 2 || numbers[0] != 2 || numbers[1] != 3 {
+		t.Errorf("expected iterations 2 and 3 (empty) for roadmap-2, got %v", numbers)
+	}
+}
+
 func TestGetBacklogTasksEmpty(t *testing.T) {
 	db := createTestDB(t)
 	defer db.Close()
@@ -240,7 +302,7 @@ func TestGetBacklogTasksEmpty(t *testing.T) {
 	taskRepo.SaveTask(ctx, task1)
 
 	// Get backlog tasks
-	backlog, err := taskRepo.GetBacklogTasks(ctx)
+	backlog, err := taskRepo.GetBacklogTasks(ctx, "")
 	if err != nil {
 		t.Fatalf("failed to get backlog tasks: %v", err)
 	}
@@ -319,3 +381,564 @@ func TestDeleteTask(t *testing.T) {
 		t.Errorf("expected ErrNotFound, got: %v", err)
 	}
 }
+
+// ============================================================================
+// Task Note Tests
+// ============================================================================
+
+func TestSaveAndListTaskNotes(t *testing.T) {
+	db := createTestDB(t)
+	defer db.Close()
+
+	roadmapRepo := persistence.NewSQLiteRoadmapRepository(db, createTestLogger())
+	trackRepo := persistence.NewSQLiteTrackRepository(db, createTestLogger())
+	taskRepo := persistence.NewSQLiteTaskRepository(db, createTestLogger())
+	ctx := context.Background()
+
+	// Setup
+	roadmap, _ := entities.NewRoadmapEntity("roadmap-1", "vision", "criteria", time.Now().UTC(), time.Now().UTC())
+	roadmapRepo.SaveRoadmap(ctx, roadmap)
+
+	track, _ := entities.NewTrackEntity("track-1", "roadmap-1", "Track", "", "not-started", 200, []string{}, time.Now().UTC(), time.Now().UTC())
+	trackRepo.SaveTrack(ctx, track)
+
+	task, _ := entities.NewTaskEntity("task-1", "track-1", "Task", "", "done", 200, "", time.Now().UTC(), time.Now().UTC())
+	taskRepo.SaveTask(ctx, task)
+
+	for _, content := range []string{"first", "second"} {
+		note, _ := entities.NewTaskNoteEntity("task-1", content, time.Now().UTC())
+		if err := taskRepo.SaveTaskNote(ctx, note); err != nil {
+			t.Fatalf("failed to save task note: %v", err)
+		}
+		if note.ID == 0 {
+			t.Errorf("expected note ID to be assigned")
+		}
+	}
+
+	notes, err := taskRepo.ListTaskNotes(ctx, "task-1")
+	if err != nil {
+		t.Fatalf("failed to list task notes: %v", err)
+	}
+	if len(notes) != 2 {
+		t.Fatalf("expected 2 notes, got %d", len(notes))
+	}
+	if notes[0].Content != "first" || notes[1].Content != "second" {
+		t.Errorf("expected notes in creation order, got %q, %q", notes[0].Content, notes[1].Content)
+	}
+
+	// Notes are removed with their task
+	if err := taskRepo.DeleteTask(ctx, "task-1"); err != nil {
+		t.Fatalf("failed to delete task: %v", err)
+	}
+	notes, err = taskRepo.ListTaskNotes(ctx, "task-1")
+	if err != nil {
+		t.Fatalf("failed to list task notes: %v", err)
+	}
+	if len(notes) != 0 {
+		t.Errorf("expected notes to be deleted with task, got %d", len(notes))
+	}
+}
+
+func TestSaveTaskNote_TaskNotFound(t *testing.T) {
+	db := createTestDB(t)
+	defer db.Close()
+
+	taskRepo := persistence.NewSQLiteTaskRepository(db, createTestLogger())
+	ctx := context.Background()
+
+	note, _ := entities.NewTaskNoteEntity("missing", "note", time.Now().UTC())
+	err := taskRepo.SaveTaskNote(ctx, note)
+	if !errors.Is(err, pluginsdk.ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got: %v", err)
+	}
+}
+
+// ============================================================================
+// Task Gate Tests
+// ============================================================================
+
+func TestTaskGates(t *testing.T) {
+	db := createTestDB(t)
+	defer db.Close()
+
+	roadmapRepo := persistence.NewSQLiteRoadmapRepository(db, createTestLogger())
+	trackRepo := persistence.NewSQLiteTrackRepository(db, createTestLogger())
+	taskRepo := persistence.NewSQLiteTaskRepository(db, createTestLogger())
+	acRepo := persistence.NewSQLiteAcceptanceCriteriaRepository(db, createTestLogger())
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	// Setup: task-2 will be gated on ACs of task-1
+	roadmap, _ := entities.NewRoadmapEntity("roadmap-1", "vision", "criteria", now, now)
+	roadmapRepo.SaveRoadmap(ctx, roadmap)
+	track, _ := entities.NewTrackEntity("track-1", "roadmap-1", "Track", "", "not-started", 200, []string{}, now, now)
+	trackRepo.SaveTrack(ctx, track)
+	for _, id := range []string{"task-1", "task-2"} {
+		task, _ := entities.NewTaskEntity(id, "track-1", "Task", "", "todo", 200, "", now, now)
+		taskRepo.SaveTask(ctx, task)
+	}
+	for _, id := range []string{"ac-1", "ac-2"} {
+		ac := entities.NewAcceptanceCriteriaEntity(id, "task-1", "Criterion "+id, entities.VerificationTypeManual, "", now, now)
+		if err := acRepo.SaveAC(ctx, ac); err != nil {
+			t.Fatalf("failed to save AC: %v", err)
+		}
+	}
+
+	for _, acID := range []string{"ac-2", "ac-1", "ac-2"} {
+		if err := taskRepo.AddTaskGate(ctx, "task-2", acID); err != nil {
+			t.Fatalf("AddTaskGate(%s) failed: %v", acID, err)
+		}
+	}
+
+	gates, err := taskRepo.ListTaskGates(ctx, "task-2")
+	if err != nil {
+		t.Fatalf("ListTaskGates failed: %v", err)
+	}
+	if len(gates) != 2 {
+		t.Fatalf("expected 2 gates (re-adding is a no-op), got %d", len(gates))
+	}
+	if gates[0].ID != "ac-2" || gates[1].ID != "ac-1" {
+		t.Errorf("expected gates in the order added, got %s, %s", gates[0].ID, gates[1].ID)
+	}
+	if gates[0].TaskID != "task-1" || gates[0].Description != "Criterion ac-2" {
+		t.Errorf("expected gating AC to be loaded in full, got %+v", gates[0])
+	}
+
+	// Unknown task or AC
+	if err := taskRepo.AddTaskGate(ctx, "missing", "ac-1"); !errors.Is(err, pluginsdk.ErrNotFound) {
+		t.Errorf("expected ErrNotFound for unknown task, got: %v", err)
+	}
+	if err := taskRepo.AddTaskGate(ctx, "task-2", "missing"); !errors.Is(err, pluginsdk.ErrNotFound) {
+		t.Errorf("expected ErrNotFound for unknown AC, got: %v", err)
+	}
+
+	// Remove
+	if err := taskRepo.RemoveTaskGate(ctx, "task-2", "ac-2"); err != nil {
+		t.Fatalf("RemoveTaskGate failed: %v", err)
+	}
+	if err := taskRepo.RemoveTaskGate(ctx, "task-2", "ac-2"); !errors.Is(err, pluginsdk.ErrNotFound) {
+		t.Errorf("expected ErrNotFound removing a missing gate, got: %v", err)
+	}
+
+	// Gates are removed with their AC, since AC IDs are reused
+	if err := acRepo.DeleteAC(ctx, "ac-1"); err != nil {
+		t.Fatalf("failed to delete AC: %v", err)
+	}
+	ac := entities.NewAcceptanceCriteriaEntity("ac-1", "task-1", "Reused ID", entities.VerificationTypeManual, "", now, now)
+	acRepo.SaveAC(ctx, ac)
+	gates, _ = taskRepo.ListTaskGates(ctx, "task-2")
+	if len(gates) != 0 {
+		t.Errorf("expected gates to be deleted with their AC, got %d", len(gates))
+	}
+
+	// ... and with their task
+	taskRepo.AddTaskGate(ctx, "task-2", "ac-1")
+	if err := taskRepo.DeleteTask(ctx, "task-2"); err != nil {
+		t.Fatalf("failed to delete task: %v", err)
+	}
+	var count int
+	db.QueryRow("SELECT COUNT(*) FROM task_ac_gates").Scan(&count)
+	if count != 0 {
+		t.Errorf("expected gates to be deleted with their task, got %d", count)
+	}
+}
+
+func TestGetTasksByTrackAndIteration(t *testing.T) {
+	db := createTestDB(t)
+	defer db.Close()
+
+	roadmapRepo := persistence.NewSQLiteRoadmapRepository(db, createTestLogger())
+	trackRepo := persistence.NewSQLiteTrackRepository(db, createTestLogger())
+	taskRepo := persistence.NewSQLiteTaskRepository(db, createTestLogger())
+	iterationRepo := persistence.NewSQLiteIterationRepository(db, createTestLogger(), persistence.NewSQLiteAcceptanceCriteriaRepository(db, createTestLogger()))
+	ctx := context.Background()
+
+	// Setup: two tracks, task-3 in iteration 1, task-1 and task-2 in iteration 2
+	roadmap, _ := entities.NewRoadmapEntity("roadmap-1", "vision", "criteria", time.Now().UTC(), time.Now().UTC())
+	roadmapRepo.SaveRoadmap(ctx, roadmap)
+
+	for _, id := range []string{"track-1", "track-2", "track-3"} {
+		track, _ := entities.NewTrackEntity(id, "roadmap-1", "Track", "", "not-started", 200, []string{}, time.Now().UTC(), time.Now().UTC())
+		trackRepo.SaveTrack(ctx, track)
+	}
+
+	for _, spec := range []struct{ id, trackID string }{
+		{"task-2", "track-1"}, {"task-1", "track-1"}, {"task-3", "track-2"}, {"task-4", "track-2"},
+	} {
+		task, _ := entities.NewTaskEntity(spec.id, spec.trackID, "Task", "", "todo", 200, "", time.Now().UTC(), time.Now().UTC())
+		taskRepo.SaveTask(ctx, task)
+	}
+
+	for _, number := range []int{1, 2, 3} {
+		iter, _ := entities.NewIterationEntity(number, "Sprint", "Goal", "", []string{}, "planned", 500, time.Time{}, time.Time{}, time.Now().UTC(), time.Now().UTC())
+		iterationRepo.SaveIteration(ctx, iter)
+	}
+	iterationRepo.AddTaskToIteration(ctx, 1, "task-3")
+	iterationRepo.AddTaskToIteration(ctx, 2, "task-2")
+	iterationRepo.AddTaskToIteration(ctx, 2, "task-1")
+
+	byTrack, err := taskRepo.GetTasksByTrack(ctx, []string{"track-1", "track-2", "track-3"})
+	if err != nil {
+		t.Fatalf("failed to get tasks by track: %v", err)
+	}
+	if len(byTrack) != 2 {
+		t.Fatalf("expected 2 track groups, got %d", len(byTrack))
+	}
+	if got := taskIDs(byTrack["track-1"]); got != "task-1,task-2" {
+		t.Errorf("expected track-1 tasks task-1,task-2, got %s", got)
+	}
+	if got := taskIDs(byTrack["track-2"]); got != "task-3,task-4" {
+		t.Errorf("expected track-2 tasks task-3,task-4, got %s", got)
+	}
+
+	// Only the requested tracks are loaded
+	byTrack, err = taskRepo.GetTasksByTrack(ctx, []string{"track-2"})
+	if err != nil {
+		t.Fatalf("failed to get tasks by track: %v", err)
+	}
+	if len(byTrack) != 1 || taskIDs(byTrack["track-2"]) != "task-3,task-4" {
+		t.Errorf("expected only track-2 tasks, got %v", byTrack)
+	}
+
+	byIteration, err := taskRepo.GetTasksByIteration(ctx)
+	if err != nil {
+		t.Fatalf("failed to get tasks by iteration: %v", err)
+	}
+	if len(byIteration) != 2 {
+		t.Fatalf("expected 2 iteration groups, got %d", len(byIteration))
+	}
+	if got := taskIDs(byIteration[1]); got != "task-3" {
+		t.Errorf("expected iteration 1 tasks task-3, got %s", got)
+	}
+	if got := taskIDs(byIteration[2]); got != "task-1,task-2" {
+		t.Errorf("expected iteration 2 tasks task-1,task-2, got %s", got)
+	}
+	if byIteration[2][0].TrackID != "track-1" {
+		t.Errorf("expected grouped tasks to be fully loaded, got track %q", byIteration[2][0].TrackID)
+	}
+}
+
+// taskIDs joins the IDs of tasks for compact assertions.
+func taskIDs(tasks []*entities.TaskEntity) string {
+	ids := make([]string, len(tasks))
+	for i, task := range tasks {
+		ids[i] = task.ID
+	}
+	return strings.Join(ids, ",")
+}
+
+// BenchmarkGroupTasks compares loading every task and regrouping it in Go per track
+// and iteration (as 'roadmap full' used to) with the pre-grouped targeted queries.
+func BenchmarkGroupTasks(b *testing.B) {
+	db := createTestDB(b)
+	defer db.Close()
+	ctx := context.Background()
+
+	const trackCount, tasksPerTrack, iterationCount = 50, 100, 40
+	now := time.Now().UTC()
+
+	roadmap, _ := entities.NewRoadmapEntity("roadmap-1", "vision", "criteria", now, now)
+	persistence.NewSQLiteRoadmapRepository(db, createTestLogger()).SaveRoadmap(ctx, roadmap)
+
+	// Seed in one transaction to keep setup fast
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		b.Fatalf("failed to begin transaction: %v", err)
+	}
+	acRepo := persistence.NewSQLiteAcceptanceCriteriaRepository(tx, createTestLogger())
+	seedTrackRepo := persistence.NewSQLiteTrackRepository(tx, createTestLogger())
+	seedTaskRepo := persistence.NewSQLiteTaskRepository(tx, createTestLogger())
+	seedIterationRepo := persistence.NewSQLiteIterationRepository(tx, createTestLogger(), acRepo)
+	for n := 1; n <= iterationCount; n++ {
+		iter, _ := entities.NewIterationEntity(n, fmt.Sprintf("Sprint %d", n), "Goal", "", []string{}, "planned", float64(n), time.Time{}, time.Time{}, now, now)
+		if err := seedIterationRepo.SaveIteration(ctx, iter); err != nil {
+			b.Fatalf("failed to save iteration: %v", err)
+		}
+	}
+	taskNum := 0
+	for tr := 1; tr <= trackCount; tr++ {
+		trackID := fmt.Sprintf("TM-track-%d", tr)
+		track, _ := entities.NewTrackEntity(trackID, "roadmap-1", "Track", "", "in-progress", tr, []string{}, now, now)
+		if err := seedTrackRepo.SaveTrack(ctx, track); err != nil {
+			b.Fatalf("failed to save track: %v", err)
+		}
+		for i := 0; i < tasksPerTrack; i++ {
+			taskNum++
+			taskID := fmt.Sprintf("TM-task-%d", taskNum)
+			task, _ := entities.NewTaskEntity(taskID, trackID, "Task", "Description", "todo", 500, "", now, now)
+			if err := seedTaskRepo.SaveTask(ctx, task); err != nil {
+				b.Fatalf("failed to save task: %v", err)
+			}
+			// Half of the tasks are planned into iterations, the rest stay in the backlog
+			if taskNum%2 == 0 {
+				if err := seedIterationRepo.AddTaskToIteration(ctx, taskNum%iterationCount+1, taskID); err != nil {
+					b.Fatalf("failed to add task to iteration: %v", err)
+				}
+			}
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		b.Fatalf("failed to commit seed data: %v", err)
+	}
+
+	trackRepo := persistence.NewSQLiteTrackRepository(db, createTestLogger())
+	taskRepo := persistence.NewSQLiteTaskRepository(db, createTestLogger())
+	iterationRepo := persistence.NewSQLiteIterationRepository(db, createTestLogger(), persistence.NewSQLiteAcceptanceCriteriaRepository(db, createTestLogger()))
+
+	b.Run("all-tasks", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			tracks, err := trackRepo.ListTracks(ctx, "roadmap-1", entities.TrackFilters{})
+			if err != nil {
+				b.Fatalf("ListTracks failed: %v", err)
+			}
+			tasks, err := taskRepo.ListTasks(ctx, entities.TaskFilters{})
+			if err != nil {
+				b.Fatalf("ListTasks failed: %v", err)
+			}
+			iterations, err := iterationRepo.ListIterations(ctx)
+			if err != nil {
+				b.Fatalf("ListIterations failed: %v", err)
+			}
+			grouped := 0
+			for _, track := range tracks {
+				for _, task := range tasks {
+					if task.TrackID == track.ID {
+						grouped++
+					}
+				}
+			}
+			for _, iter := range iterations {
+				taskMap := make(map[string]*entities.TaskEntity, len(tasks))
+				for _, task := range tasks {
+					taskMap[task.ID] = task
+				}
+				for _, id := range iter.TaskIDs {
+					if _, ok := taskMap[id]; ok {
+						grouped++
+					}
+				}
+			}
+			if grouped != trackCount*tasksPerTrack*3/2 {
+				b.Fatalf("unexpected grouped task count %d", grouped)
+			}
+		}
+	})
+
+	b.Run("targeted", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			tracks, err := trackRepo.ListTracks(ctx, "roadmap-1", entities.TrackFilters{})
+			if err != nil {
+				b.Fatalf("ListTracks failed: %v", err)
+			}
+			trackIDs := make([]string, len(tracks))
+			for i, track := range tracks {
+				trackIDs[i] = track.ID
+			}
+			byTrack, err := taskRepo.GetTasksByTrack(ctx, trackIDs)
+			if err != nil {
+				b.Fatalf("GetTasksByTrack failed: %v", err)
+			}
+			byIteration, err := taskRepo.GetTasksByIteration(ctx)
+			if err != nil {
+				b.Fatalf("GetTasksByIteration failed: %v", err)
+			}
+			iterations, err := iterationRepo.ListIterations(ctx)
+			if err != nil {
+				b.Fatalf("ListIterations failed: %v", err)
+			}
+			grouped := 0
+			for _, track := range tracks {
+				grouped += len(byTrack[track.ID])
+			}
+			for _, iter := range iterations {
+				grouped += len(byIteration[iter.Number])
+			}
+			if grouped != trackCount*tasksPerTrack*3/2 {
+				b.Fatalf("unexpected grouped task count %d", grouped)
+			}
+		}
+	})
+}
+
+func TestTaskAssignee(t *testing.T) {
+	db := createTestDB(t)
+	defer db.Close()
+
+	roadmapRepo := persistence.NewSQLiteRoadmapRepository(db, createTestLogger())
+	trackRepo := persistence.NewSQLiteTrackRepository(db, createTestLogger())
+	taskRepo := persistence.NewSQLiteTaskRepository(db, createTestLogger())
+	ctx := context.Background()
+
+	roadmap, _ := entities.NewRoadmapEntity("roadmap-1", "vision", "criteria", time.Now().UTC(), time.Now().UTC())
+	roadmapRepo.SaveRoadmap(ctx, roadmap)
+	track, _ := entities.NewTrackEntity("track-1", "roadmap-1", "Track", "", "not-started", 200, []string{}, time.Now().UTC(), time.Now().UTC())
+	trackRepo.SaveTrack(ctx, track)
+
+	assignees := map[string]string{"task-1": "alice", "task-2": "bob", "task-3": "alice", "task-4": ""}
+	for id, assignee := range assignees {
+		task, _ := entities.NewTaskEntity(id, "track-1", "Task "+id, "", "todo", 200, "", time.Now().UTC(), time.Now().UTC())
+		task.Assignee = assignee
+		if err := taskRepo.SaveTask(ctx, task); err != nil {
+			t.Fatalf("failed to save task: %v", err)
+		}
+	}
+
+	retrieved, err := taskRepo.GetTask(ctx, "task-1")
+	if err != nil {
+		t.Fatalf("failed to get task: %v", err)
+	}
+	if retrieved.Assignee != "alice" {
+		t.Errorf("expected assignee alice, got %q", retrieved.Assignee)
+	}
+
+	// Reassign through UpdateTask
+	retrieved.Assign("carol")
+	if err := taskRepo.UpdateTask(ctx, retrieved); err != nil {
+		t.Fatalf("failed to update task: %v", err)
+	}
+
+	alice, err := taskRepo.ListTasks(ctx, entities.TaskFilters{Assignee: "alice"})
+	if err != nil {
+		t.Fatalf("failed to list tasks: %v", err)
+	}
+	if len(alice) != 1 || alice[0].ID != "task-3" {
+		t.Errorf("expected only task-3 assigned to alice, got %v", taskIDs(alice))
+	}
+
+	unassigned, err := taskRepo.ListTasks(ctx, entities.TaskFilters{Unassigned: true})
+	if err != nil {
+		t.Fatalf("failed to list tasks: %v", err)
+	}
+	if len(unassigned) != 1 || unassigned[0].ID != "task-4" {
+		t.Errorf("expected only task-4 unassigned, got %v", taskIDs(unassigned))
+	}
+}
+
+func TestListTasks_UpdatedBefore(t *testing.T) {
+	db := createTestDB(t)
+	defer db.Close()
+
+	roadmapRepo := persistence.NewSQLiteRoadmapRepository(db, createTestLogger())
+	trackRepo := persistence.NewSQLiteTrackRepository(db, createTestLogger())
+	taskRepo := persistence.NewSQLiteTaskRepository(db, createTestLogger())
+	ctx := context.Background()
+
+	roadmap, _ := entities.NewRoadmapEntity("roadmap-1", "vision", "criteria", time.Now().UTC(), time.Now().UTC())
+	roadmapRepo.SaveRoadmap(ctx, roadmap)
+	track, _ := entities.NewTrackEntity("track-1", "roadmap-1", "Track", "", "not-started", 200, []string{}, time.Now().UTC(), time.Now().UTC())
+	trackRepo.SaveTrack(ctx, track)
+
+	cutoff := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	// task-2 is stored with a +05:00 offset: 16:00+05:00 is 11:00 UTC, before the cutoff
+	updated := map[string]time.Time{
+		"task-1": cutoff.Add(-48 * time.Hour),
+		"task-2": time.Date(2024, 6, 1, 16, 0, 0, 0, time.FixedZone("UTC+5", 5*3600)),
+		"task-3": cutoff.Add(time.Minute),
+	}
+	for id, at := range updated {
+		task, _ := entities.NewTaskEntity(id, "track-1", "Task "+id, "", "todo", 200, "", at, at)
+		if err := taskRepo.SaveTask(ctx, task); err != nil {
+			t.Fatalf("failed to save task: %v", err)
+		}
+	}
+
+	stale, err := taskRepo.ListTasks(ctx, entities.TaskFilters{UpdatedBefore: &cutoff})
+	if err != nil {
+		t.Fatalf("failed to list tasks: %v", err)
+	}
+	if got := taskIDs(stale); got != "task-1,task-2" {
+		t.Errorf("expected task-1 and task-2 updated before the cutoff, got %v", got)
+	}
+}
+
+func TestListTasks_ACTag(t *testing.T) {
+	db := createTestDB(t)
+	defer db.Close()
+
+	roadmapRepo := persistence.NewSQLiteRoadmapRepository(db, createTestLogger())
+	trackRepo := persistence.NewSQLiteTrackRepository(db, createTestLogger())
+	taskRepo := persistence.NewSQLiteTaskRepository(db, createTestLogger())
+	acRepo := persistence.NewSQLiteAcceptanceCriteriaRepository(db, createTestLogger())
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	roadmap, _ := entities.NewRoadmapEntity("roadmap-1", "vision", "criteria", now, now)
+	roadmapRepo.SaveRoadmap(ctx, roadmap)
+	track, _ := entities.NewTrackEntity("track-1", "roadmap-1", "Track", "", "not-started", 200, []string{}, now, now)
+	trackRepo.SaveTrack(ctx, track)
+	for _, id := range []string{"task-1", "task-2", "task-3"} {
+		task, _ := entities.NewTaskEntity(id, "track-1", "Task "+id, "", "todo", 200, "", now, now)
+		if err := taskRepo.SaveTask(ctx, task); err != nil {
+			t.Fatalf("failed to save task: %v", err)
+		}
+	}
+	// task-1 has two tagged ACs, task-2 an AC with another tag, task-3 none
+	tags := map[string]string{"ac-1": "security", "ac-2": "security", "ac-3": "docs"}
+	for _, ac := range []*entities.AcceptanceCriteriaEntity{
+		entities.NewAcceptanceCriteriaEntity("ac-1", "task-1", "Escapes input", entities.VerificationTypeManual, "", now, now),
+		entities.NewAcceptanceCriteriaEntity("ac-2", "task-1", "Checks auth", entities.VerificationTypeManual, "", now, now),
+		entities.NewAcceptanceCriteriaEntity("ac-3", "task-2", "Documented", entities.VerificationTypeManual, "", now, now),
+	} {
+		if err := acRepo.SaveAC(ctx, ac); err != nil {
+			t.Fatalf("failed to save AC: %v", err)
+		}
+		if err := acRepo.AddACTag(ctx, ac.ID, tags[ac.ID]); err != nil {
+			t.Fatalf("failed to tag AC: %v", err)
+		}
+	}
+
+	tagged, err := taskRepo.ListTasks(ctx, entities.TaskFilters{ACTag: "security"})
+	if err != nil {
+		t.Fatalf("failed to list tasks: %v", err)
+	}
+	if got := taskIDs(tagged); got != "task-1" {
+		t.Errorf("expected only task-1 listed once, got %v", got)
+	}
+}
+
+func TestInitSchema_MigratesV8TasksWithoutAssignee(t *testing.T) {
+	db, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("failed to open test database: %v", err)
+	}
+	defer db.Close()
+
+	// A v8 database: tasks without the assignee column
+	if err := persistence.InitSchema(db); err != nil {
+		t.Fatalf("failed to initialize schema: %v", err)
+	}
+	statements := []string{
+		"DROP INDEX idx_tasks_assignee",
+		"ALTER TABLE tasks DROP COLUMN assignee",
+		"UPDATE project_metadata SET value = '8' WHERE key = 'schema_version'",
+		"INSERT INTO roadmaps (id, vision, success_criteria, created_at, updated_at) VALUES ('roadmap-1', 'v', 's', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)",
+		"INSERT INTO tracks (id, roadmap_id, title, description, status, rank, created_at, updated_at) VALUES ('track-1', 'roadmap-1', 'Track', '', 'not-started', 100, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)",
+		"INSERT INTO tasks (id, track_id, title, description, status, rank, created_at, updated_at) VALUES ('task-1', 'track-1', 'Old task', '', 'todo', 100, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)",
+	}
+	for _, stmt := range statements {
+		if _, err := db.Exec(stmt); err != nil {
+			t.Fatalf("failed to set up v8 database (%s): %v", stmt, err)
+		}
+	}
+
+	if err := persistence.InitSchema(db); err != nil {
+		t.Fatalf("failed to migrate schema: %v", err)
+	}
+
+	taskRepo := persistence.NewSQLiteTaskRepository(db, createTestLogger())
+	task, err := taskRepo.GetTask(context.Background(), "task-1")
+	if err != nil {
+		t.Fatalf("failed to get migrated task: %v", err)
+	}
+	if task.Assignee != "" {
+		t.Errorf("expected migrated task to be unassigned, got %q", task.Assignee)
+	}
+
+	var version string
+	if err := db.QueryRow("SELECT value FROM project_metadata WHERE key = 'schema_version'").Scan(&version); err != nil {
+		t.Fatalf("failed to read schema version: %v", err)
+	}
+	if version != fmt.Sprint(persistence.SchemaVersion) {
+		t.Errorf("expected schema version %d, got %s", persistence.SchemaVersion, version)
+	}
+}
@@ -15,15 +15,17 @@ var _ repositories.TrackRepository = (*SQLiteTrackRepository)(nil)
 
 // SQLiteTrackRepository implements repositories.TrackRepository using SQLite as the backend.
 type SQLiteTrackRepository struct {
-	DB     *sql.DB
-	logger pluginsdk.Logger
+	DB          DBTX
+	logger      pluginsdk.Logger
+	retryPolicy WriteRetryPolicy
 }
 
 // NewSQLiteTrackRepository creates a new SQLite-backed repository.
-func NewSQLiteTrackRepository(db *sql.DB, logger pluginsdk.Logger) *SQLiteTrackRepository {
+func NewSQLiteTrackRepository(db DBTX, logger pluginsdk.Logger) *SQLiteTrackRepository {
 	return &SQLiteTrackRepository{
-		DB:     db,
-		logger: logger,
+		DB:          db,
+		logger:      logger,
+		retryPolicy: DefaultWriteRetryPolicy(),
 	}
 }
 
@@ -32,7 +34,18 @@ func NewSQLiteTrackRepository(db *sql.DB, logger pluginsdk.Logger) *SQLiteTrackR
 // ============================================================================
 
 // SaveTrack persists a new track to storage.
+// Retried while the database is busy or locked.
 func (r *SQLiteTrackRepository) SaveTrack(ctx context.Context, track *entities.TrackEntity) error {
+	if err := entities.ValidateTrackStatus(track.Status); err != nil {
+		return err
+	}
+	return retryWrite(ctx, r.DB, r.retryPolicy, func() error {
+		return r.saveTrack(ctx, track)
+	})
+}
+
+// saveTrack performs a single SaveTrack attempt.
+func (r *SQLiteTrackRepository) saveTrack(ctx context.Context, track *entities.TrackEntity) error {
 	// Check if track already exists
 	var exists int
 	err := r.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM tracks WHERE id = ?", track.ID).Scan(&exists)
@@ -54,7 +67,7 @@ func (r *SQLiteTrackRepository) SaveTrack(ctx context.Context, track *entities.T
 	}
 
 	// Start transaction for track and dependencies
-	tx, err := r.DB.BeginTx(ctx, nil)
+	tx, err := beginTx(ctx, r.DB)
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
@@ -182,8 +195,18 @@ func (r *SQLiteTrackRepository) ListTracks(ctx context.Context, roadmapID string
 
 // UpdateTrack updates an existing track.
 func (r *SQLiteTrackRepository) UpdateTrack(ctx context.Context, track *entities.TrackEntity) error {
+	if err := entities.ValidateTrackStatus(track.Status); err != nil {
+		return err
+	}
+	return retryWrite(ctx, r.DB, r.retryPolicy, func() error {
+		return r.updateTrack(ctx, track)
+	})
+}
+
+// updateTrack performs a single UpdateTrack attempt.
+func (r *SQLiteTrackRepository) updateTrack(ctx context.Context, track *entities.TrackEntity) error {
 	// Start transaction for track and dependencies update
-	tx, err := r.DB.BeginTx(ctx, nil)
+	tx, err := beginTx(ctx, r.DB)
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
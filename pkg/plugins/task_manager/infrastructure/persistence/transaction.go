@@ -0,0 +1,105 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain"
+)
+
+// Compile-time check that SQLiteRepositoryComposite supports transactions
+var _ domain.TransactionalRepository = (*SQLiteRepositoryComposite)(nil)
+
+// DBTX is the database handle used by the focused repositories.
+// It is satisfied by both *sql.DB and *sql.Tx, so a repository can be bound to a transaction.
+type DBTX interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// txConn is a transaction started by beginTx
+type txConn interface {
+	DBTX
+	Commit() error
+	Rollback() error
+}
+
+// savepointSeq makes savepoint names unique
+var savepointSeq atomic.Uint64
+
+// beginTx starts a transaction on conn. When conn is already a transaction, a savepoint
+// is used instead, so the work joins the outer transaction and only the outer commit
+// makes it durable.
+func beginTx(ctx context.Context, conn DBTX) (txConn, error) {
+	switch c := conn.(type) {
+	case *sql.DB:
+		return c.BeginTx(ctx, nil)
+	case *sql.Tx:
+		name := fmt.Sprintf("sp_%d", savepointSeq.Add(1))
+		if _, err := c.ExecContext(ctx, "SAVEPOINT "+name); err != nil {
+			return nil, err
+		}
+		return &savepoint{Tx: c, ctx: ctx, name: name}, nil
+	default:
+		return nil, fmt.Errorf("unsupported database handle %T", conn)
+	}
+}
+
+// savepoint is a nested transaction inside an outer *sql.Tx
+type savepoint struct {
+	*sql.Tx
+	ctx  context.Context
+	name string
+	done bool
+}
+
+// Commit releases the savepoint, keeping its changes in the outer transaction
+func (s *savepoint) Commit() error {
+	if s.done {
+		return sql.ErrTxDone
+	}
+	s.done = true
+	_, err := s.Tx.ExecContext(s.ctx, "RELEASE SAVEPOINT "+s.name)
+	return err
+}
+
+// Rollback undoes the changes made since the savepoint
+func (s *savepoint) Rollback() error {
+	if s.done {
+		return sql.ErrTxDone
+	}
+	s.done = true
+	if _, err := s.Tx.ExecContext(s.ctx, "ROLLBACK TO SAVEPOINT "+s.name); err != nil {
+		return err
+	}
+	_, err := s.Tx.ExecContext(s.ctx, "RELEASE SAVEPOINT "+s.name)
+	return err
+}
+
+// WithTx runs fn within a single database transaction. The repository passed to fn is
+// bound to that transaction: all of its mutations are committed together when fn returns
+// nil, and rolled back together when fn returns an error or panics.
+// Calling WithTx on a repository that is already bound to a transaction joins it.
+func (c *SQLiteRepositoryComposite) WithTx(ctx context.Context, fn func(domain.RoadmapRepository) error) error {
+	if c.tx != nil {
+		return fn(c)
+	}
+
+	tx, err := c.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", markConcurrentModification(err))
+	}
+	defer tx.Rollback()
+
+	if err := fn(newSQLiteRepositoryComposite(c.DB, tx, c.logger, c.retryPolicy)); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", markConcurrentModification(err))
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+package persistence_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/infrastructure/persistence"
+)
+
+// ============================================================================
+// Transaction Tests
+// ============================================================================
+
+func setupTxComposite(t *testing.T) (*persistence.SQLiteRepositoryComposite, context.Context) {
+	t.Helper()
+	db := createTestDB(t)
+	t.Cleanup(func() { db.Close() })
+
+	ctx := context.Background()
+	composite := persistence.NewSQLiteRepositoryComposite(db, createTestLogger())
+
+	roadmap, _ := entities.NewRoadmapEntity("roadmap-1", "vision", "criteria", time.Now().UTC(), time.Now().UTC())
+	if err := composite.SaveRoadmap(ctx, roadmap); err != nil {
+		t.Fatalf("SaveRoadmap failed: %v", err)
+	}
+	return composite, ctx
+}
+
+func TestWithTx_CommitsAllMutations(t *testing.T) {
+	composite, ctx := setupTxComposite(t)
+
+	err := composite.WithTx(ctx, func(repo domain.RoadmapRepository) error {
+		track, _ := entities.NewTrackEntity("track-1", "roadmap-1", "Track", "desc", "not-started", 100, []string{}, time.Now().UTC(), time.Now().UTC())
+		if err := repo.SaveTrack(ctx, track); err != nil {
+			return err
+		}
+		task, _ := entities.NewTaskEntity("task-1", "track-1", "Task", "desc", "todo", 100, "", time.Now().UTC(), time.Now().UTC())
+		return repo.SaveTask(ctx, task)
+	})
+	if err != nil {
+		t.Fatalf("WithTx failed: %v", err)
+	}
+
+	if _, err := composite.GetTrack(ctx, "track-1"); err != nil {
+		t.Errorf("expected track to be committed: %v", err)
+	}
+	if _, err := composite.GetTask(ctx, "task-1"); err != nil {
+		t.Errorf("expected task to be committed: %v", err)
+	}
+}
+
+func TestWithTx_RollsBackOnMidSequenceFailure(t *testing.T) {
+	composite, ctx := setupTxComposite(t)
+	failure := errors.New("step 3 failed")
+
+	err := composite.WithTx(ctx, func(repo domain.RoadmapRepository) error {
+		// SaveTrack opens its own transaction, which must join this one
+		track, _ := entities.NewTrackEntity("track-1", "roadmap-1", "Track", "desc", "not-started", 100, []string{}, time.Now().UTC(), time.Now().UTC())
+		if err := repo.SaveTrack(ctx, track); err != nil {
+			return err
+		}
+		task, _ := entities.NewTaskEntity("task-1", "track-1", "Task", "desc", "todo", 100, "", time.Now().UTC(), time.Now().UTC())
+		if err := repo.SaveTask(ctx, task); err != nil {
+			return err
+		}
+		// The mutations are visible inside the transaction
+		if _, err := repo.GetTask(ctx, "task-1"); err != nil {
+			t.Errorf("expected task to be visible within the transaction: %v", err)
+		}
+		return failure
+	})
+	if !errors.Is(err, failure) {
+		t.Fatalf("expected closure error, got %v", err)
+	}
+
+	if _, err := composite.GetTrack(ctx, "track-1"); err == nil {
+		t.Error("expected track to be rolled back")
+	}
+	if _, err := composite.GetTask(ctx, "task-1"); err == nil {
+		t.Error("expected task to be rolled back")
+	}
+}
+
+func TestWithTx_FailedNestedStepOnlyUndoesItself(t *testing.T) {
+	composite, ctx := setupTxComposite(t)
+
+	err := composite.WithTx(ctx, func(repo domain.RoadmapRepository) error {
+		track, _ := entities.NewTrackEntity("track-1", "roadmap-1", "Track", "desc", "not-started", 100, []string{}, time.Now().UTC(), time.Now().UTC())
+		if err := repo.SaveTrack(ctx, track); err != nil {
+			return err
+		}
+		// The track row is inserted before the duplicate dependency violates its key;
+		// the savepoint must undo that insert without aborting the outer transaction
+		broken, _ := entities.NewTrackEntity("track-2", "roadmap-1", "Broken", "desc", "not-started", 100, []string{"track-1", "track-1"}, time.Now().UTC(), time.Now().UTC())
+		if err := repo.SaveTrack(ctx, broken); err == nil {
+			t.Error("expected SaveTrack with a duplicate dependency to fail")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("WithTx failed: %v", err)
+	}
+
+	if _, err := composite.GetTrack(ctx, "track-1"); err != nil {
+		t.Errorf("expected track-1 to be committed: %v", err)
+	}
+	if _, err := composite.GetTrack(ctx, "track-2"); err == nil {
+		t.Error("expected the failed track-2 insert to be undone")
+	}
+}
+
+func TestWithTx_NestedCallJoinsOuterTransaction(t *testing.T) {
+	composite, ctx := setupTxComposite(t)
+	failure := errors.New("outer failed")
+
+	err := composite.WithTx(ctx, func(repo domain.RoadmapRepository) error {
+		inner, ok := repo.(domain.TransactionalRepository)
+		if !ok {
+			t.Fatal("expected transaction-bound repository to support WithTx")
+		}
+		err := inner.WithTx(ctx, func(repo domain.RoadmapRepository) error {
+			track, _ := entities.NewTrackEntity("track-1", "roadmap-1", "Track", "desc", "not-started", 100, []string{}, time.Now().UTC(), time.Now().UTC())
+			return repo.SaveTrack(ctx, track)
+		})
+		if err != nil {
+			return err
+		}
+		return failure
+	})
+	if !errors.Is(err, failure) {
+		t.Fatalf("expected closure error, got %v", err)
+	}
+
+	if _, err := composite.GetTrack(ctx, "track-1"); err == nil {
+		t.Error("expected nested mutation to be rolled back with the outer transaction")
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/services"
 	infracli "github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/infrastructure/cli"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/infrastructure/persistence"
+	presentationApi "github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/presentation/api"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/presentation/cli"
 	presentationTui "github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/presentation/tui"
 	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
@@ -24,6 +25,7 @@ var (
 	_ pluginsdk.IEntityProvider  = (*TaskManagerPlugin)(nil)
 	_ pluginsdk.ICommandProvider = (*TaskManagerPlugin)(nil)
 	_ pluginsdk.IEventEmitter    = (*TaskManagerPlugin)(nil)
+	_ pluginsdk.ISchemaMigrator  = (*TaskManagerPlugin)(nil)
 	_ infracli.PluginProvider    = (*TaskManagerPlugin)(nil) // Infrastructure CLI provider
 )
 
@@ -54,11 +56,18 @@ func NewTaskManagerPlugin(logger pluginsdk.Logger, workingDir string, eventBus i
 		}
 	}
 
+	// Load configuration
+	config, err := LoadConfig(workingDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load configuration: %w", err)
+	}
+
 	return &TaskManagerPlugin{
 		logger:     logger,
 		workingDir: workingDir,
 		tasksDir:   tasksDir,
 		eventBus:   eb,
+		config:     config,
 	}, nil
 }
 
@@ -86,8 +95,14 @@ func NewTaskManagerPluginWithDatabase(
 		}
 	}
 
+	// Load configuration
+	config, err := LoadConfig(workingDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load configuration: %w", err)
+	}
+
 	// Create base repository and wrap with event emission
-	baseRepository := persistence.NewSQLiteRoadmapRepository(db, logger)
+	baseRepository := persistence.NewSQLiteRepositoryCompositeWithRetryPolicy(db, logger, writeRetryPolicy(config))
 	var repository domain.RoadmapRepository = baseRepository
 
 	// Wrap with event-emitting decorator if eventBus is available
@@ -95,12 +110,6 @@ func NewTaskManagerPluginWithDatabase(
 		repository = persistence.NewEventEmittingRepository(baseRepository, eb, logger)
 	}
 
-	// Load configuration
-	config, err := LoadConfig(workingDir)
-	if err != nil {
-		return nil, fmt.Errorf("failed to load configuration: %w", err)
-	}
-
 	plugin := &TaskManagerPlugin{
 		logger:     logger,
 		workingDir: workingDir,
@@ -124,6 +133,13 @@ func (p *TaskManagerPlugin) GetRepository() domain.RoadmapRepository {
 	return p.repository
 }
 
+// writeRetryPolicy returns the repository write retry policy set by the storage section of cfg
+func writeRetryPolicy(cfg *Config) persistence.WriteRetryPolicy {
+	policy := persistence.DefaultWriteRetryPolicy()
+	policy.MaxAttempts = cfg.Storage.WriteRetryAttempts
+	return policy
+}
+
 // GetConfig returns the plugin configuration
 func (p *TaskManagerPlugin) GetConfig() *Config {
 	if p.config == nil {
@@ -144,7 +160,82 @@ func (p *TaskManagerPlugin) GetInfo() pluginsdk.PluginInfo {
 
 // GetCapabilities returns the capability interfaces this plugin implements (SDK interface)
 func (p *TaskManagerPlugin) GetCapabilities() []string {
-	return []string{"IEntityProvider", "ICommandProvider", "IEventEmitter"}
+	return []string{"IEntityProvider", "ICommandProvider", "IEventEmitter", "IHealthChecker", "ISchemaMigrator"}
+}
+
+// Migrate brings every project database up to the current schema version (SDK interface).
+// Task-manager keeps its tables in per-project databases, so the framework database is unused.
+// Projects without a database are skipped; InitSchema leaves up-to-date databases unchanged.
+func (p *TaskManagerPlugin) Migrate(ctx context.Context, _ *sql.DB) error {
+	projectsDir := filepath.Join(p.workingDir, ".darwinflow", "projects")
+	entries, err := os.ReadDir(projectsDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to read projects directory: %w", err)
+	}
+
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		dbPath := filepath.Join(projectsDir, entry.Name(), "roadmap.db")
+		if _, err := os.Stat(dbPath); err != nil {
+			continue
+		}
+
+		db, err := sql.Open("sqlite3", dbPath)
+		if err != nil {
+			return fmt.Errorf("failed to open project '%s' database: %w", entry.Name(), err)
+		}
+		err = persistence.InitSchema(db)
+		db.Close()
+		if err != nil {
+			return fmt.Errorf("failed to migrate project '%s': %w", entry.Name(), err)
+		}
+	}
+
+	return nil
+}
+
+// MigrationDependencies returns the plugins whose migrations must run first (SDK interface)
+func (p *TaskManagerPlugin) MigrationDependencies() []string {
+	return nil
+}
+
+// CheckHealth verifies that the active project resolves to a readable database (SDK interface).
+// It does not create missing project directories or migrate the schema.
+func (p *TaskManagerPlugin) CheckHealth(ctx context.Context) error {
+	projectName, err := p.getActiveProject()
+	if err != nil {
+		return err
+	}
+
+	dbPath := p.projectDatabasePath(projectName)
+	if _, err := os.Stat(dbPath); os.IsNotExist(err) {
+		// A fresh setup has no default project until the first task-manager command runs
+		if projectName == "default" {
+			return nil
+		}
+		return fmt.Errorf("active project '%s' not found; pick one from 'dw task-manager project list' and run 'dw task-manager project switch <name>'", projectName)
+	}
+
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		return fmt.Errorf("failed to open project '%s' database: %w", projectName, err)
+	}
+	defer db.Close()
+
+	var version int
+	if err := db.QueryRowContext(ctx, "SELECT CAST(value AS INTEGER) FROM project_metadata WHERE key = 'schema_version'").Scan(&version); err != nil {
+		return fmt.Errorf("project '%s' database is not readable (run any 'dw task-manager' command to migrate it): %w", projectName, err)
+	}
+	if version > persistence.SchemaVersion {
+		return fmt.Errorf("project '%s' database schema version %d is newer than supported version %d (upgrade dw)", projectName, version, persistence.SchemaVersion)
+	}
+
+	return nil
 }
 
 // GetEntityTypes returns the entity types this plugin provides (SDK interface)
@@ -236,6 +327,8 @@ func (p *TaskManagerPlugin) GetCommands() []pluginsdk.Command {
 		composite.Track,
 		composite.Aggregate,
 		composite.AC,
+		composite.Iteration,
+		composite,
 		validationSvc,
 	)
 
@@ -243,6 +336,7 @@ func (p *TaskManagerPlugin) GetCommands() []pluginsdk.Command {
 		composite.Iteration,
 		composite.Task,
 		composite.Aggregate,
+		composite,
 		iterationSvc,
 		validationSvc,
 	)
@@ -257,7 +351,9 @@ func (p *TaskManagerPlugin) GetCommands() []pluginsdk.Command {
 	acService := application.NewACApplicationService(
 		composite.AC,
 		composite.Task,
+		composite.Iteration,
 		composite.Aggregate,
+		composite,
 		validationSvc,
 	)
 
@@ -266,6 +362,7 @@ func (p *TaskManagerPlugin) GetCommands() []pluginsdk.Command {
 		composite.Track,
 		composite.Task,
 		composite.Iteration,
+		composite,
 		validationSvc,
 	)
 
@@ -275,6 +372,10 @@ func (p *TaskManagerPlugin) GetCommands() []pluginsdk.Command {
 		composite.Iteration,
 	)
 
+	syncService := application.NewSyncApplicationService(composite.Sync)
+
+	searchService := application.NewSearchApplicationService(composite.Aggregate)
+
 	return []pluginsdk.Command{
 		// Project commands (infrastructure layer)
 		&infracli.ProjectCreateCommand{Provider: p},
@@ -282,11 +383,23 @@ func (p *TaskManagerPlugin) GetCommands() []pluginsdk.Command {
 		&infracli.ProjectSwitchCommand{Provider: p},
 		&infracli.ProjectShowCommand{Provider: p},
 		&infracli.ProjectDeleteCommand{Provider: p},
+		&infracli.ProjectIDFormatCommand{Provider: p},
+		&infracli.CloneCommand{Provider: p},
+		&infracli.CheckStatusesCommand{Provider: p},
+		&infracli.ValidateCommand{Provider: p},
 		// Roadmap commands (migrated to CLI adapters)
 		&cli.RoadmapInitCommandAdapter{RoadmapService: roadmapService},
 		&cli.RoadmapShowCommandAdapter{RoadmapService: roadmapService},
 		&cli.RoadmapUpdateCommandAdapter{RoadmapService: roadmapService},
+		&cli.RoadmapListCommandAdapter{RoadmapService: roadmapService},
+		&cli.RoadmapActivateCommandAdapter{RoadmapService: roadmapService},
+		&cli.RoadmapArchiveCommandAdapter{RoadmapService: roadmapService},
 		&cli.RoadmapFullCommandAdapter{RoadmapService: roadmapService},
+		&cli.RoadmapStatsCommandAdapter{RoadmapService: roadmapService},
+		&cli.RoadmapCriteriaAddCommandAdapter{RoadmapService: roadmapService},
+		&cli.RoadmapCriteriaListCommandAdapter{RoadmapService: roadmapService},
+		&cli.RoadmapCriteriaCheckCommandAdapter{RoadmapService: roadmapService, Met: true},
+		&cli.RoadmapCriteriaCheckCommandAdapter{RoadmapService: roadmapService, Met: false},
 		// ========================================================================
 		// MIGRATED TO CLI ADAPTERS (using application layer services)
 		// ========================================================================
@@ -302,11 +415,19 @@ func (p *TaskManagerPlugin) GetCommands() []pluginsdk.Command {
 			TaskService: taskService,
 		},
 		&cli.TaskUpdateCommandAdapter{
+			TaskService:               taskService,
+			ACService:                 acService,
+			AutoVerifyOnTrackComplete: p.GetConfig().AC.AutoVerifyOnTrackComplete,
+		},
+		&cli.TaskDescribeCommandAdapter{
 			TaskService: taskService,
 		},
 		&cli.TaskDeleteCommandAdapter{
 			TaskService: taskService,
 		},
+		&cli.TaskReopenCommandAdapter{
+			TaskService: taskService,
+		},
 		// Iteration commands
 		&cli.IterationCreateCommandAdapter{
 			IterationService: iterationService,
@@ -320,6 +441,9 @@ func (p *TaskManagerPlugin) GetCommands() []pluginsdk.Command {
 		&cli.IterationCompleteCommandAdapter{
 			IterationService: iterationService,
 		},
+		&cli.IterationCarryOverCommandAdapter{
+			IterationService: iterationService,
+		},
 		&cli.IterationRevertCommandAdapter{
 			IterationService: iterationService,
 		},
@@ -327,12 +451,16 @@ func (p *TaskManagerPlugin) GetCommands() []pluginsdk.Command {
 			IterationService: iterationService,
 		},
 		&cli.IterationShowCommandAdapter{
-			IterationService:    iterationService,
-			DocumentService:     documentService,
+			IterationService: iterationService,
+			DocumentService:  documentService,
+			ACService:        acService,
+			ProgressBasis:    p.GetConfig().IterationProgressBasis(),
 		},
 		&cli.IterationCurrentCommandAdapter{
 			IterationService: iterationService,
 			DocumentService:  documentService,
+			ACService:        acService,
+			ProgressBasis:    p.GetConfig().IterationProgressBasis(),
 		},
 		&cli.IterationDeleteCommandAdapter{
 			IterationService: iterationService,
@@ -346,6 +474,33 @@ func (p *TaskManagerPlugin) GetCommands() []pluginsdk.Command {
 		&cli.IterationViewCommandAdapter{
 			IterationService: iterationService,
 		},
+		&cli.IterationNewCommandAdapter{
+			IterationService: iterationService,
+			TrackService:     trackService,
+		},
+		&cli.IterationTemplateCreateCommandAdapter{
+			IterationService: iterationService,
+		},
+		&cli.IterationTemplateListCommandAdapter{
+			IterationService: iterationService,
+		},
+		&cli.IterationTemplateShowCommandAdapter{
+			IterationService: iterationService,
+		},
+		&cli.IterationTemplateDeleteCommandAdapter{
+			IterationService: iterationService,
+		},
+		&cli.IterationVelocityCommandAdapter{
+			IterationService: iterationService,
+		},
+		&cli.IterationDoDAddCommandAdapter{
+			IterationService: iterationService,
+		},
+		&cli.IterationDoDListCommandAdapter{
+			IterationService: iterationService,
+		},
+		&cli.IterationDoDCheckCommandAdapter{IterationService: iterationService, Done: true},
+		&cli.IterationDoDCheckCommandAdapter{IterationService: iterationService, Done: false},
 		// ADR commands
 		&cli.ADRCreateCommandAdapter{
 			ADRService: adrService,
@@ -353,12 +508,23 @@ func (p *TaskManagerPlugin) GetCommands() []pluginsdk.Command {
 		&cli.ADRUpdateCommandAdapter{
 			ADRService: adrService,
 		},
+		&cli.ADRListCommandAdapter{
+			ADRService: adrService,
+		},
+		&cli.ADRShowCommandAdapter{
+			ADRService: adrService,
+		},
+		&cli.ADRLinkTaskCommandAdapter{
+			ADRService: adrService,
+		},
 		// AC commands
 		&cli.ACAddCommandAdapter{
 			ACService: acService,
 		},
 		&cli.ACVerifyCommandAdapter{
-			ACService: acService,
+			ACService:           acService,
+			RequireInstructions: p.GetConfig().AC.RequireInstructions,
+			RequireNotes:        p.GetConfig().AC.RequireNotes,
 		},
 		&cli.ACFailCommandAdapter{
 			ACService: acService,
@@ -367,7 +533,8 @@ func (p *TaskManagerPlugin) GetCommands() []pluginsdk.Command {
 			ACService: acService,
 		},
 		&cli.ACListCommandAdapter{
-			ACService: acService,
+			ACService:    acService,
+			TrackService: trackService,
 		},
 		&cli.ACShowCommandAdapter{
 			ACService: acService,
@@ -375,6 +542,9 @@ func (p *TaskManagerPlugin) GetCommands() []pluginsdk.Command {
 		&cli.ACUpdateCommandAdapter{
 			ACService: acService,
 		},
+		&cli.ACEditCommandAdapter{
+			ACService: acService,
+		},
 		&cli.ACDeleteCommandAdapter{
 			ACService: acService,
 		},
@@ -394,6 +564,40 @@ func (p *TaskManagerPlugin) GetCommands() []pluginsdk.Command {
 		&cli.ACFailedCommandAdapter{
 			ACService: acService,
 		},
+		&cli.ACWhyFailedCommandAdapter{
+			ACService: acService,
+		},
+		&cli.ACCoverageCommandAdapter{
+			ACService:        acService,
+			TrackService:     trackService,
+			IterationService: iterationService,
+		},
+		&cli.ACTemplateCreateCommandAdapter{
+			ACService: acService,
+		},
+		&cli.ACTemplateListCommandAdapter{
+			ACService: acService,
+		},
+		&cli.ACTemplateShowCommandAdapter{
+			ACService: acService,
+		},
+		&cli.ACTemplateDeleteCommandAdapter{
+			ACService: acService,
+		},
+		&cli.ACApplyTemplateCommandAdapter{
+			ACService: acService,
+		},
+		&cli.ACImportCommandAdapter{
+			ACService: acService,
+		},
+		&cli.ACResetCommandAdapter{
+			ACService: acService,
+		},
+		&cli.ACTagCommandAdapter{ACService: acService, Remove: false},
+		&cli.ACTagCommandAdapter{ACService: acService, Remove: true},
+		&cli.ReconcileCommandAdapter{
+			ACService: acService,
+		},
 		// Document commands
 		&cli.DocCreateCommandAdapter{
 			DocumentService: documentService,
@@ -419,21 +623,54 @@ func (p *TaskManagerPlugin) GetCommands() []pluginsdk.Command {
 		&cli.DocHelpCommandAdapter{},
 		// Task commands (query/list operations)
 		&cli.TaskListCommandAdapter{
-			TaskService: taskService,
+			TaskService:      taskService,
+			TrackService:     trackService,
+			IterationService: iterationService,
 		},
 		&cli.TaskShowCommandAdapter{
 			TaskService: taskService,
+			ADRService:  adrService,
 		},
 		&cli.TaskMoveCommandAdapter{
 			TaskService: taskService,
 		},
-		&cli.TaskBacklogCommandAdapter{
+		&cli.TaskCloneCommandAdapter{
+			TaskService: taskService,
+		},
+		&cli.TaskBumpCommandAdapter{
 			TaskService: taskService,
 		},
+		&cli.TaskFromEventCommandAdapter{
+			TaskService:  taskService,
+			TrackService: trackService,
+		},
+		&cli.TaskBacklogCommandAdapter{
+			TaskService:  taskService,
+			TrackService: trackService,
+		},
 		&cli.TaskCheckReadyCommandAdapter{
 			TaskService: taskService,
 			ACService:   acService,
 		},
+		&cli.TaskGateCommandAdapter{TaskService: taskService, Remove: false},
+		&cli.TaskGateCommandAdapter{TaskService: taskService, Remove: true},
+		&cli.TaskAssignCommandAdapter{TaskService: taskService, Remove: false},
+		&cli.TaskAssignCommandAdapter{TaskService: taskService, Remove: true},
+		// Report commands
+		&cli.ReportWorkloadCommandAdapter{
+			TaskService:  taskService,
+			TrackService: trackService,
+		},
+		&cli.ReportStatusCommandAdapter{
+			TaskService: taskService,
+		},
+		&cli.ReportStaleCommandAdapter{
+			TaskService:  taskService,
+			TrackService: trackService,
+		},
+		&cli.ReportBlockedChainCommandAdapter{
+			TaskService: taskService,
+		},
 		&cli.TaskMigrateCommandAdapter{},
 
 		// ========================================================================
@@ -456,12 +693,32 @@ func (p *TaskManagerPlugin) GetCommands() []pluginsdk.Command {
 		&cli.TrackRemoveDependencyCommandAdapter{
 			TrackService: trackService,
 		},
+		&cli.DependenciesGraphCommandAdapter{
+			TrackService: trackService,
+		},
+		// Sync commands
+		&cli.SyncExportCommandAdapter{
+			SyncService: syncService,
+		},
+		&cli.SyncImportCommandAdapter{
+			SyncService: syncService,
+		},
+		// Timeline export
+		&cli.ExportCommandAdapter{
+			IterationService: iterationService,
+		},
+		// Search command
+		&cli.SearchCommandAdapter{
+			SearchService: searchService,
+		},
 
 		// ========================================================================
 		// INFRASTRUCTURE COMMANDS (not migrated, appropriately structured)
 		// ========================================================================
 		// TUI commands (new MVP implementation)
-		&presentationTui.TUINewCommand{Plugin: p},
+		&presentationTui.TUINewCommand{Plugin: p, CurrentUser: p.GetConfig().User.Name, Keys: p.GetConfig().TUI.Keys, ProgressBasis: p.GetConfig().IterationProgressBasis()},
+		// HTTP API server (presentation layer)
+		&presentationApi.ServeCommand{Plugin: p},
 		// Prompt command (presentation layer)
 		&cli.PromptCommand{GetPrompt: cli.GetSystemPrompt},
 		// Backup commands (infrastructure layer)
@@ -481,6 +738,10 @@ func (p *TaskManagerPlugin) getCommandsWithoutServices() []pluginsdk.Command {
 		&infracli.ProjectSwitchCommand{Provider: p},
 		&infracli.ProjectShowCommand{Provider: p},
 		&infracli.ProjectDeleteCommand{Provider: p},
+		&infracli.ProjectIDFormatCommand{Provider: p},
+		&infracli.CloneCommand{Provider: p},
+		&infracli.CheckStatusesCommand{Provider: p},
+		&infracli.ValidateCommand{Provider: p},
 
 		// Note: CLI adapters that require services are omitted here (including roadmap commands)
 		// This function is only called when service initialization fails
@@ -490,7 +751,9 @@ func (p *TaskManagerPlugin) getCommandsWithoutServices() []pluginsdk.Command {
 		// INFRASTRUCTURE COMMANDS (not migrated, appropriately structured)
 		// ========================================================================
 		// TUI commands (new MVP implementation)
-		&presentationTui.TUINewCommand{Plugin: p},
+		&presentationTui.TUINewCommand{Plugin: p, CurrentUser: p.GetConfig().User.Name, Keys: p.GetConfig().TUI.Keys, ProgressBasis: p.GetConfig().IterationProgressBasis()},
+		// HTTP API server (presentation layer)
+		&presentationApi.ServeCommand{Plugin: p},
 		// Prompt command (presentation layer)
 		&cli.PromptCommand{GetPrompt: cli.GetSystemPrompt},
 		// Backup commands (infrastructure layer)
@@ -519,16 +782,20 @@ func (p *TaskManagerPlugin) StopEventStream() error {
 // Project Management Methods
 // ============================================================================
 
+// projectDatabasePath returns the path of the specified project's database file
+func (p *TaskManagerPlugin) projectDatabasePath(projectName string) string {
+	return filepath.Join(p.workingDir, ".darwinflow", "projects", projectName, "roadmap.db")
+}
+
 // getProjectDatabase returns a database connection for the specified project.
 // It creates the project directory and initializes the schema if needed.
 func (p *TaskManagerPlugin) getProjectDatabase(projectName string) (*sql.DB, error) {
 	// Get project-specific database path
-	projectDir := filepath.Join(p.workingDir, ".darwinflow", "projects", projectName)
-	if err := os.MkdirAll(projectDir, 0755); err != nil {
+	dbPath := p.projectDatabasePath(projectName)
+	if err := os.MkdirAll(filepath.Dir(dbPath), 0755); err != nil {
 		return nil, fmt.Errorf("failed to create project directory: %w", err)
 	}
 
-	dbPath := filepath.Join(projectDir, "roadmap.db")
 	db, err := sql.Open("sqlite3", dbPath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open database: %w", err)
@@ -650,7 +917,7 @@ func (p *TaskManagerPlugin) GetRepositoryForProject(projectName string) (domain.
 	}
 
 	// Create composite repository for this project (provides all focused repositories)
-	composite := persistence.NewSQLiteRepositoryComposite(db, p.logger)
+	composite := persistence.NewSQLiteRepositoryCompositeWithRetryPolicy(db, p.logger, writeRetryPolicy(p.GetConfig()))
 	var repo domain.RoadmapRepository = composite
 
 	// Wrap with event-emitting decorator if eventBus is available
@@ -694,3 +961,8 @@ func (p *TaskManagerPlugin) SetActiveProject(projectName string) error {
 func (p *TaskManagerPlugin) GetProjectDatabase(projectName string) (*sql.DB, error) {
 	return p.getProjectDatabase(projectName)
 }
+
+// GetProjectDatabasePath returns the path of the specified project's database file
+func (p *TaskManagerPlugin) GetProjectDatabasePath(projectName string) string {
+	return p.projectDatabasePath(projectName)
+}
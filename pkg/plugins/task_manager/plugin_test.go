@@ -3,15 +3,19 @@ package task_manager_test
 import (
 	"bytes"
 	"context"
+	"database/sql"
 	"io"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/infrastructure/persistence"
+	_ "github.com/mattn/go-sqlite3"
 )
 
 // MockLogger is a simple logger for testing
@@ -69,7 +73,7 @@ func TestGetCapabilities(t *testing.T) {
 	}
 
 	capabilities := plugin.GetCapabilities()
-	expected := []string{"IEntityProvider", "ICommandProvider", "IEventEmitter"}
+	expected := []string{"IEntityProvider", "ICommandProvider", "IEventEmitter", "IHealthChecker", "ISchemaMigrator"}
 
 	if len(capabilities) != len(expected) {
 		t.Errorf("expected %d capabilities, got %d", len(expected), len(capabilities))
@@ -87,6 +91,95 @@ func TestGetCapabilities(t *testing.T) {
 	}
 }
 
+// TestCheckHealth tests the active project health check
+func TestCheckHealth(t *testing.T) {
+	dir := t.TempDir()
+	logger := &MockLogger{}
+
+	plugin, err := task_manager.NewTaskManagerPlugin(logger, dir, nil)
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+
+	// Fresh setup: the default project does not exist yet and that is fine
+	if err := plugin.CheckHealth(context.Background()); err != nil {
+		t.Errorf("expected fresh setup to be healthy, got %v", err)
+	}
+
+	// An active project that does not exist is reported with a remediation
+	if err := os.MkdirAll(filepath.Join(dir, ".darwinflow"), 0755); err != nil {
+		t.Fatalf("failed to create .darwinflow: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, ".darwinflow", "active-project.txt"), []byte("missing"), 0644); err != nil {
+		t.Fatalf("failed to write active project: %v", err)
+	}
+
+	err = plugin.CheckHealth(context.Background())
+	if err == nil {
+		t.Fatal("expected error for missing active project")
+	}
+	if !strings.Contains(err.Error(), "active project 'missing' not found") || !strings.Contains(err.Error(), "project switch") {
+		t.Errorf("unexpected error message: %v", err)
+	}
+}
+
+// TestMigrate tests that Migrate brings every project database to the current schema
+func TestMigrate(t *testing.T) {
+	dir := t.TempDir()
+	logger := &MockLogger{}
+
+	plugin, err := task_manager.NewTaskManagerPlugin(logger, dir, nil)
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+
+	// No projects yet: nothing to migrate
+	if err := plugin.Migrate(context.Background(), nil); err != nil {
+		t.Fatalf("Migrate without projects failed: %v", err)
+	}
+
+	// Two projects with empty databases, and one project directory without a database
+	for _, project := range []string{"alpha", "beta"} {
+		projectDir := filepath.Join(dir, ".darwinflow", "projects", project)
+		if err := os.MkdirAll(projectDir, 0755); err != nil {
+			t.Fatalf("failed to create project dir: %v", err)
+		}
+		if err := os.WriteFile(filepath.Join(projectDir, "roadmap.db"), nil, 0644); err != nil {
+			t.Fatalf("failed to create project db: %v", err)
+		}
+	}
+	if err := os.MkdirAll(filepath.Join(dir, ".darwinflow", "projects", "empty"), 0755); err != nil {
+		t.Fatalf("failed to create project dir: %v", err)
+	}
+
+	// Running twice must be safe
+	for i := 0; i < 2; i++ {
+		if err := plugin.Migrate(context.Background(), nil); err != nil {
+			t.Fatalf("Migrate run %d failed: %v", i+1, err)
+		}
+	}
+
+	for _, project := range []string{"alpha", "beta"} {
+		db, err := sql.Open("sqlite3", filepath.Join(dir, ".darwinflow", "projects", project, "roadmap.db"))
+		if err != nil {
+			t.Fatalf("failed to open %s db: %v", project, err)
+		}
+		var version int
+		err = db.QueryRow("SELECT CAST(value AS INTEGER) FROM project_metadata WHERE key = 'schema_version'").Scan(&version)
+		db.Close()
+		if err != nil {
+			t.Fatalf("%s: failed to read schema version: %v", project, err)
+		}
+		if version != persistence.SchemaVersion {
+			t.Errorf("%s: schema version = %d, want %d", project, version, persistence.SchemaVersion)
+		}
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, ".darwinflow", "projects", "empty", "roadmap.db")); !os.IsNotExist(err) {
+		t.Error("Migrate should not create databases for projects without one")
+	}
+}
+
 // TestGetEntityTypes tests entity type info
 func TestGetEntityTypes(t *testing.T) {
 	dir := t.TempDir()
@@ -0,0 +1,117 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/infrastructure/cli"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/infrastructure/persistence"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// DefaultAddr is the address served when --addr is not given
+const DefaultAddr = "127.0.0.1:8080"
+
+// ServeCommand serves the roadmap of a project as a read-only JSON HTTP API
+type ServeCommand struct {
+	Plugin  cli.PluginProvider
+	project string
+	addr    string
+}
+
+func (c *ServeCommand) GetName() string {
+	return "serve"
+}
+
+func (c *ServeCommand) GetDescription() string {
+	return "Serve the roadmap as a read-only JSON HTTP API"
+}
+
+func (c *ServeCommand) GetHelp() string {
+	return `Usage: dw task-manager serve [--addr <host:port>] [--project <name>] [--read-only]
+
+Start an HTTP server exposing the project's roadmap as JSON, e.g. for a web
+dashboard. The server runs until interrupted (Ctrl+C), then finishes in-flight
+requests and exits.
+
+Endpoints (GET only):
+  /roadmap                       Active roadmap
+  /tracks?status=a,b             Tracks of the active roadmap
+  /tracks/{id}                   Track with its tasks
+  /tasks?track=ID&status=a,b     Tasks, optionally filtered
+  /iterations/{n}                Iteration with its tasks
+  /acs?task=ID                   Acceptance criteria of a task
+
+Errors are returned as {"error": "..."} with status 400 (bad request),
+404 (not found) or 500.
+
+Flags:
+  --addr <host:port>  Address to listen on (default: 127.0.0.1:8080; use :8080
+                      to listen on all interfaces)
+  --project <name>    Use specific project (overrides active project)
+  --read-only         Serve read-only (the default and currently the only mode;
+                      write endpoints will be enabled by a separate flag)
+
+Examples:
+  dw task-manager serve
+  dw task-manager serve --addr :8080
+  curl http://127.0.0.1:8080/tracks?status=in-progress
+`
+}
+
+func (c *ServeCommand) GetUsage() string {
+	return "serve [--addr <host:port>] [--project <name>] [--read-only]"
+}
+
+func (c *ServeCommand) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	c.addr = DefaultAddr
+
+	// Parse flags
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--addr":
+			if i+1 >= len(args) {
+				return fmt.Errorf("%w: --addr requires a value", pluginsdk.ErrInvalidArgument)
+			}
+			c.addr = args[i+1]
+			i++
+		case "--project":
+			if i+1 < len(args) {
+				c.project = args[i+1]
+				i++
+			}
+		case "--read-only":
+			// The default; accepted so scripts can state it explicitly
+		default:
+			return fmt.Errorf("%w: unknown flag %q", pluginsdk.ErrInvalidArgument, args[i])
+		}
+	}
+
+	repo, cleanup, err := c.Plugin.GetRepositoryForProject(c.project)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	listener, err := net.Listen("tcp", c.addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", c.addr, err)
+	}
+
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	fmt.Fprintf(cmdCtx.GetStdout(), "Serving task manager API (read-only) on http://%s\n", listener.Addr())
+	fmt.Fprintf(cmdCtx.GetStdout(), "Press Ctrl+C to stop\n")
+
+	if err := Serve(ctx, listener, NewHandler(persistence.NewReadOnlyRepository(repo))); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(cmdCtx.GetStdout(), "Server stopped\n")
+	return nil
+}
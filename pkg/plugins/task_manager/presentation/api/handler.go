@@ -0,0 +1,191 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// Repository is the part of the task-manager repository read by the API.
+// domain.RoadmapRepository satisfies it.
+type Repository interface {
+	GetActiveRoadmap(ctx context.Context) (*entities.RoadmapEntity, error)
+	ListTracks(ctx context.Context, roadmapID string, filters entities.TrackFilters) ([]*entities.TrackEntity, error)
+	GetTrack(ctx context.Context, id string) (*entities.TrackEntity, error)
+	ListTasks(ctx context.Context, filters entities.TaskFilters) ([]*entities.TaskEntity, error)
+	GetIteration(ctx context.Context, number int) (*entities.IterationEntity, error)
+	GetIterationTasks(ctx context.Context, iterationNum int) ([]*entities.TaskEntity, error)
+	ListAC(ctx context.Context, taskID string) ([]*entities.AcceptanceCriteriaEntity, error)
+}
+
+// TrackResponse is a track with its tasks, returned by GET /tracks/{id}
+type TrackResponse struct {
+	Track *entities.TrackEntity  `json:"track"`
+	Tasks []*entities.TaskEntity `json:"tasks"`
+}
+
+// IterationResponse is an iteration with its tasks, returned by GET /iterations/{n}
+type IterationResponse struct {
+	Iteration *entities.IterationEntity `json:"iteration"`
+	Tasks     []*entities.TaskEntity    `json:"tasks"`
+}
+
+// ErrorResponse is the body of every error response
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// NewHandler returns the read-only JSON API over repo:
+//
+//	GET /roadmap                       Active roadmap
+//	GET /tracks?status=a,b             Tracks of the active roadmap
+//	GET /tracks/{id}                   Track with its tasks
+//	GET /tasks?track=ID&status=a,b     Tasks
+//	GET /iterations/{n}                Iteration with its tasks
+//	GET /acs?task=ID                   Acceptance criteria of a task
+//
+// Other methods are rejected with 405 Method Not Allowed.
+func NewHandler(repo Repository) http.Handler {
+	h := &handler{repo: repo}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /roadmap", h.getRoadmap)
+	mux.HandleFunc("GET /tracks", h.listTracks)
+	mux.HandleFunc("GET /tracks/{id}", h.getTrack)
+	mux.HandleFunc("GET /tasks", h.listTasks)
+	mux.HandleFunc("GET /iterations/{n}", h.getIteration)
+	mux.HandleFunc("GET /acs", h.listACs)
+	return mux
+}
+
+type handler struct {
+	repo Repository
+}
+
+func (h *handler) getRoadmap(w http.ResponseWriter, r *http.Request) {
+	roadmap, err := h.repo.GetActiveRoadmap(r.Context())
+	if err != nil {
+		writeError(w, fmt.Errorf("active roadmap: %w", err))
+		return
+	}
+	writeJSON(w, http.StatusOK, roadmap)
+}
+
+func (h *handler) listTracks(w http.ResponseWriter, r *http.Request) {
+	roadmap, err := h.repo.GetActiveRoadmap(r.Context())
+	if err != nil {
+		writeError(w, fmt.Errorf("active roadmap: %w", err))
+		return
+	}
+	tracks, err := h.repo.ListTracks(r.Context(), roadmap.ID, entities.TrackFilters{Status: listParam(r, "status")})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, nonNil(tracks))
+}
+
+func (h *handler) getTrack(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	track, err := h.repo.GetTrack(r.Context(), id)
+	if err != nil {
+		writeError(w, fmt.Errorf("track %s: %w", id, err))
+		return
+	}
+	tasks, err := h.repo.ListTasks(r.Context(), entities.TaskFilters{TrackID: track.ID})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, TrackResponse{Track: track, Tasks: nonNil(tasks)})
+}
+
+func (h *handler) listTasks(w http.ResponseWriter, r *http.Request) {
+	filters := entities.TaskFilters{
+		TrackID: r.URL.Query().Get("track"),
+		Status:  listParam(r, "status"),
+	}
+	tasks, err := h.repo.ListTasks(r.Context(), filters)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, nonNil(tasks))
+}
+
+func (h *handler) getIteration(w http.ResponseWriter, r *http.Request) {
+	number, err := strconv.Atoi(r.PathValue("n"))
+	if err != nil || number <= 0 {
+		writeError(w, fmt.Errorf("%w: invalid iteration number %q", pluginsdk.ErrInvalidArgument, r.PathValue("n")))
+		return
+	}
+	iteration, err := h.repo.GetIteration(r.Context(), number)
+	if err != nil {
+		writeError(w, fmt.Errorf("iteration %d: %w", number, err))
+		return
+	}
+	tasks, err := h.repo.GetIterationTasks(r.Context(), number)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, IterationResponse{Iteration: iteration, Tasks: nonNil(tasks)})
+}
+
+func (h *handler) listACs(w http.ResponseWriter, r *http.Request) {
+	taskID := r.URL.Query().Get("task")
+	if taskID == "" {
+		writeError(w, fmt.Errorf("%w: the task query parameter is required", pluginsdk.ErrInvalidArgument))
+		return
+	}
+	acs, err := h.repo.ListAC(r.Context(), taskID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, nonNil(acs))
+}
+
+// listParam splits a comma-separated query parameter, dropping empty values
+func listParam(r *http.Request, name string) []string {
+	var values []string
+	for _, value := range strings.Split(r.URL.Query().Get(name), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// nonNil returns an empty slice for nil, so lists encode as [] rather than null
+func nonNil[T any](values []T) []T {
+	if values == nil {
+		return []T{}
+	}
+	return values
+}
+
+// writeError maps err to a status code: 404 for pluginsdk.ErrNotFound, 400 for
+// pluginsdk.ErrInvalidArgument and 500 otherwise
+func writeError(w http.ResponseWriter, err error) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, pluginsdk.ErrNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, pluginsdk.ErrInvalidArgument):
+		status = http.StatusBadRequest
+	}
+	writeJSON(w, status, ErrorResponse{Error: err.Error()})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,387 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/presentation/api"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// The API must run on the real repository
+var _ api.Repository = domain.RoadmapRepository(nil)
+
+// fakeRepository serves fixed entities; failWith makes every call fail
+type fakeRepository struct {
+	roadmap    *entities.RoadmapEntity
+	tracks     []*entities.TrackEntity
+	tasks      []*entities.TaskEntity
+	iterations []*entities.IterationEntity
+	acs        []*entities.AcceptanceCriteriaEntity
+	failWith   error
+}
+
+func (r *fakeRepository) GetActiveRoadmap(ctx context.Context) (*entities.RoadmapEntity, error) {
+	if r.failWith != nil {
+		return nil, r.failWith
+	}
+	if r.roadmap == nil {
+		return nil, pluginsdk.ErrNotFound
+	}
+	return r.roadmap, nil
+}
+
+func (r *fakeRepository) ListTracks(ctx context.Context, roadmapID string, filters entities.TrackFilters) ([]*entities.TrackEntity, error) {
+	if r.failWith != nil {
+		return nil, r.failWith
+	}
+	var tracks []*entities.TrackEntity
+	for _, track := range r.tracks {
+		if track.RoadmapID == roadmapID && matches(track.Status, filters.Status) {
+			tracks = append(tracks, track)
+		}
+	}
+	return tracks, nil
+}
+
+func (r *fakeRepository) GetTrack(ctx context.Context, id string) (*entities.TrackEntity, error) {
+	if r.failWith != nil {
+		return nil, r.failWith
+	}
+	for _, track := range r.tracks {
+		if track.ID == id {
+			return track, nil
+		}
+	}
+	return nil, pluginsdk.ErrNotFound
+}
+
+func (r *fakeRepository) ListTasks(ctx context.Context, filters entities.TaskFilters) ([]*entities.TaskEntity, error) {
+	if r.failWith != nil {
+		return nil, r.failWith
+	}
+	var tasks []*entities.TaskEntity
+	for _, task := range r.tasks {
+		if (filters.TrackID == "" || task.TrackID == filters.TrackID) && matches(task.Status, filters.Status) {
+			tasks = append(tasks, task)
+		}
+	}
+	return tasks, nil
+}
+
+func (r *fakeRepository) GetIteration(ctx context.Context, number int) (*entities.IterationEntity, error) {
+	if r.failWith != nil {
+		return nil, r.failWith
+	}
+	for _, iteration := range r.iterations {
+		if iteration.Number == number {
+			return iteration, nil
+		}
+	}
+	return nil, pluginsdk.ErrNotFound
+}
+
+func (r *fakeRepository) GetIterationTasks(ctx context.Context, iterationNum int) ([]*entities.TaskEntity, error) {
+	iteration, err := r.GetIteration(ctx, iterationNum)
+	if err != nil {
+		return nil, err
+	}
+	var tasks []*entities.TaskEntity
+	for _, task := range r.tasks {
+		for _, id := range iteration.TaskIDs {
+			if task.ID == id {
+				tasks = append(tasks, task)
+			}
+		}
+	}
+	return tasks, nil
+}
+
+func (r *fakeRepository) ListAC(ctx context.Context, taskID string) ([]*entities.AcceptanceCriteriaEntity, error) {
+	if r.failWith != nil {
+		return nil, r.failWith
+	}
+	var acs []*entities.AcceptanceCriteriaEntity
+	for _, ac := range r.acs {
+		if ac.TaskID == taskID {
+			acs = append(acs, ac)
+		}
+	}
+	return acs, nil
+}
+
+func matches(status string, statuses []string) bool {
+	if len(statuses) == 0 {
+		return true
+	}
+	for _, s := range statuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+func newFakeRepository() *fakeRepository {
+	return &fakeRepository{
+		roadmap: &entities.RoadmapEntity{ID: "roadmap-1", Vision: "Ship it"},
+		tracks: []*entities.TrackEntity{
+			{ID: "DW-track-1", RoadmapID: "roadmap-1", Title: "Core", Status: "in-progress"},
+			{ID: "DW-track-2", RoadmapID: "roadmap-1", Title: "Docs", Status: "not-started"},
+		},
+		tasks: []*entities.TaskEntity{
+			{ID: "DW-task-1", TrackID: "DW-track-1", Title: "Parser", Status: "done"},
+			{ID: "DW-task-2", TrackID: "DW-track-1", Title: "Lexer", Status: "todo"},
+			{ID: "DW-task-3", TrackID: "DW-track-2", Title: "README", Status: "todo"},
+		},
+		iterations: []*entities.IterationEntity{
+			{Number: 1, Name: "First", Status: "current", TaskIDs: []string{"DW-task-2"}},
+		},
+		acs: []*entities.AcceptanceCriteriaEntity{
+			{ID: "DW-ac-1", TaskID: "DW-task-1", Description: "Parses input"},
+		},
+	}
+}
+
+// get performs a request against the handler and returns the recorded response
+func get(t *testing.T, repo api.Repository, method, target string) *httptest.ResponseRecorder {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	api.NewHandler(repo).ServeHTTP(rec, httptest.NewRequest(method, target, nil))
+	return rec
+}
+
+func decode(t *testing.T, rec *httptest.ResponseRecorder, v interface{}) {
+	t.Helper()
+	if ct := rec.Header().Get("Content-Type"); ct != "application/json" {
+		t.Errorf("Content-Type = %q, want application/json", ct)
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), v); err != nil {
+		t.Fatalf("invalid JSON %q: %v", rec.Body.String(), err)
+	}
+}
+
+func ids[T any](values []T, id func(T) string) string {
+	var out []string
+	for _, v := range values {
+		out = append(out, id(v))
+	}
+	return strings.Join(out, ",")
+}
+
+func trackID(t *entities.TrackEntity) string { return t.ID }
+func taskID(t *entities.TaskEntity) string   { return t.ID }
+
+func TestHandler_Roadmap(t *testing.T) {
+	rec := get(t, newFakeRepository(), http.MethodGet, "/roadmap")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200: %s", rec.Code, rec.Body)
+	}
+	var roadmap entities.RoadmapEntity
+	decode(t, rec, &roadmap)
+	if roadmap.ID != "roadmap-1" || roadmap.Vision != "Ship it" {
+		t.Errorf("roadmap = %+v", roadmap)
+	}
+}
+
+func TestHandler_RoadmapNotFound(t *testing.T) {
+	repo := newFakeRepository()
+	repo.roadmap = nil
+	rec := get(t, repo, http.MethodGet, "/roadmap")
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("status = %d, want 404", rec.Code)
+	}
+	var body api.ErrorResponse
+	decode(t, rec, &body)
+	if !strings.Contains(body.Error, "active roadmap") {
+		t.Errorf("error = %q, want it to mention the active roadmap", body.Error)
+	}
+}
+
+func TestHandler_Tracks(t *testing.T) {
+	tests := []struct {
+		target string
+		want   string
+	}{
+		{"/tracks", "DW-track-1,DW-track-2"},
+		{"/tracks?status=not-started", "DW-track-2"},
+		{"/tracks?status=in-progress,not-started", "DW-track-1,DW-track-2"},
+		{"/tracks?status=blocked", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.target, func(t *testing.T) {
+			rec := get(t, newFakeRepository(), http.MethodGet, tt.target)
+			if rec.Code != http.StatusOK {
+				t.Fatalf("status = %d, want 200: %s", rec.Code, rec.Body)
+			}
+			var tracks []*entities.TrackEntity
+			decode(t, rec, &tracks)
+			if got := ids(tracks, trackID); got != tt.want {
+				t.Errorf("tracks = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHandler_EmptyListIsArray(t *testing.T) {
+	rec := get(t, newFakeRepository(), http.MethodGet, "/tasks?status=review")
+	if body := strings.TrimSpace(rec.Body.String()); body != "[]" {
+		t.Errorf("body = %q, want []", body)
+	}
+}
+
+func TestHandler_Track(t *testing.T) {
+	rec := get(t, newFakeRepository(), http.MethodGet, "/tracks/DW-track-1")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200: %s", rec.Code, rec.Body)
+	}
+	var resp api.TrackResponse
+	decode(t, rec, &resp)
+	if resp.Track == nil || resp.Track.ID != "DW-track-1" {
+		t.Errorf("track = %+v", resp.Track)
+	}
+	if got := ids(resp.Tasks, taskID); got != "DW-task-1,DW-task-2" {
+		t.Errorf("tasks = %q, want DW-task-1,DW-task-2", got)
+	}
+
+	rec = get(t, newFakeRepository(), http.MethodGet, "/tracks/DW-track-9")
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("unknown track: status = %d, want 404", rec.Code)
+	}
+}
+
+func TestHandler_Tasks(t *testing.T) {
+	tests := []struct {
+		target string
+		want   string
+	}{
+		{"/tasks", "DW-task-1,DW-task-2,DW-task-3"},
+		{"/tasks?track=DW-track-1", "DW-task-1,DW-task-2"},
+		{"/tasks?status=todo", "DW-task-2,DW-task-3"},
+		{"/tasks?track=DW-track-1&status=todo", "DW-task-2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.target, func(t *testing.T) {
+			rec := get(t, newFakeRepository(), http.MethodGet, tt.target)
+			if rec.Code != http.StatusOK {
+				t.Fatalf("status = %d, want 200: %s", rec.Code, rec.Body)
+			}
+			var tasks []*entities.TaskEntity
+			decode(t, rec, &tasks)
+			if got := ids(tasks, taskID); got != tt.want {
+				t.Errorf("tasks = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHandler_Iteration(t *testing.T) {
+	rec := get(t, newFakeRepository(), http.MethodGet, "/iterations/1")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200: %s", rec.Code, rec.Body)
+	}
+	var resp api.IterationResponse
+	decode(t, rec, &resp)
+	if resp.Iteration == nil || resp.Iteration.Number != 1 {
+		t.Errorf("iteration = %+v", resp.Iteration)
+	}
+	if got := ids(resp.Tasks, taskID); got != "DW-task-2" {
+		t.Errorf("tasks = %q, want DW-task-2", got)
+	}
+
+	tests := []struct {
+		target string
+		want   int
+	}{
+		{"/iterations/7", http.StatusNotFound},
+		{"/iterations/abc", http.StatusBadRequest},
+		{"/iterations/0", http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		if rec := get(t, newFakeRepository(), http.MethodGet, tt.target); rec.Code != tt.want {
+			t.Errorf("%s: status = %d, want %d", tt.target, rec.Code, tt.want)
+		}
+	}
+}
+
+func TestHandler_ACs(t *testing.T) {
+	rec := get(t, newFakeRepository(), http.MethodGet, "/acs?task=DW-task-1")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200: %s", rec.Code, rec.Body)
+	}
+	var acs []*entities.AcceptanceCriteriaEntity
+	decode(t, rec, &acs)
+	if len(acs) != 1 || acs[0].ID != "DW-ac-1" {
+		t.Errorf("acs = %+v, want DW-ac-1", acs)
+	}
+
+	rec = get(t, newFakeRepository(), http.MethodGet, "/acs")
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("missing task: status = %d, want 400", rec.Code)
+	}
+	var body api.ErrorResponse
+	decode(t, rec, &body)
+	if !strings.Contains(body.Error, "task") {
+		t.Errorf("error = %q, want it to name the task parameter", body.Error)
+	}
+}
+
+func TestHandler_RepositoryError(t *testing.T) {
+	repo := newFakeRepository()
+	repo.failWith = errors.New("database is locked")
+	for _, target := range []string{"/roadmap", "/tracks", "/tracks/DW-track-1", "/tasks", "/iterations/1", "/acs?task=DW-task-1"} {
+		rec := get(t, repo, http.MethodGet, target)
+		if rec.Code != http.StatusInternalServerError {
+			t.Errorf("%s: status = %d, want 500", target, rec.Code)
+		}
+	}
+}
+
+func TestHandler_RejectsWrites(t *testing.T) {
+	for _, method := range []string{http.MethodPost, http.MethodPut, http.MethodDelete} {
+		if rec := get(t, newFakeRepository(), method, "/tasks"); rec.Code != http.StatusMethodNotAllowed {
+			t.Errorf("%s /tasks: status = %d, want 405", method, rec.Code)
+		}
+	}
+}
+
+func TestServe_ShutsDownOnCancel(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- api.Serve(ctx, listener, api.NewHandler(newFakeRepository()))
+	}()
+
+	resp, err := http.Get(fmt.Sprintf("http://%s/roadmap", listener.Addr()))
+	if err != nil {
+		t.Fatalf("GET /roadmap: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("status = %d, want 200", resp.StatusCode)
+	}
+
+	cancel()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("Serve() = %v, want nil after cancel", err)
+		}
+	case <-time.After(api.ShutdownTimeout + time.Second):
+		t.Fatal("Serve() did not return after cancel")
+	}
+}
@@ -2,7 +2,11 @@ package cli
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"strconv"
+	"strings"
 
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application/dto"
@@ -15,13 +19,14 @@ import (
 // ============================================================================
 
 type ACAddCommandAdapter struct {
-	ACService    *application.ACApplicationService
+	ACService *application.ACApplicationService
 
 	// CLI flags
-	project              string
-	taskID               string
-	description          string
-	testingInstructions  string
+	project             string
+	taskID              string
+	description         string
+	testingInstructions string
+	tags                []string
 }
 
 func (c *ACAddCommandAdapter) GetName() string {
@@ -33,22 +38,31 @@ func (c *ACAddCommandAdapter) GetDescription() string {
 }
 
 func (c *ACAddCommandAdapter) GetUsage() string {
-	return "dw task-manager ac add <task-id> --description <desc> [--testing-instructions <instructions>]"
+	return "dw task-manager ac add <task-id> --description <desc> [--testing-instructions <instructions>] [--tag <tag>]"
 }
 
 func (c *ACAddCommandAdapter) GetHelp() string {
-	return `Adds an acceptance criterion to a task.
+	return `Adds an acceptance criterion to a task.`
+}
 
-Flags:
-  --description <desc>               Acceptance criterion description (required)
-  --testing-instructions <inst>     Step-by-step testing instructions (optional)
-  --project <name>                   Project name (optional)`
+func (c *ACAddCommandAdapter) GetArgSpec() pluginsdk.CommandArgSpec {
+	return pluginsdk.CommandArgSpec{
+		Positionals: []pluginsdk.PositionalSpec{
+			{Name: "task-id", Required: true, Description: "Task to add the criterion to"},
+		},
+		Flags: []pluginsdk.FlagSpec{
+			{Name: "description", Value: "desc", Required: true, Description: "Acceptance criterion description"},
+			{Name: "testing-instructions", Value: "inst", Description: "Step-by-step testing instructions"},
+			{Name: "tag", Value: "tag", Repeatable: true, Description: "Tag the criterion, e.g. auto-on-complete (verified by 'dw task-manager reconcile' once the track is done)"},
+			{Name: "project", Value: "name", Description: "Project name"},
+		},
+	}
 }
 
 func (c *ACAddCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
 	// Parse task ID
 	if len(args) == 0 {
-		return fmt.Errorf("task ID is required")
+		return fmt.Errorf("%w: task ID is required", pluginsdk.ErrInvalidArgument)
 	}
 	c.taskID = args[0]
 	args = args[1:]
@@ -71,20 +85,25 @@ func (c *ACAddCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.Comm
 				c.testingInstructions = args[i+1]
 				i++
 			}
+		case "--tag":
+			if i+1 < len(args) {
+				c.tags = append(c.tags, args[i+1])
+				i++
+			}
 		}
 	}
 
 	// Validate required flags
 	if c.description == "" {
-		return fmt.Errorf("--description is required")
+		return fmt.Errorf("%w: --description is required", pluginsdk.ErrInvalidArgument)
 	}
 
-
 	// Create DTO
 	input := dto.CreateACDTO{
 		TaskID:              c.taskID,
 		Description:         c.description,
 		TestingInstructions: c.testingInstructions,
+		Tags:                c.tags,
 	}
 
 	// Execute via application service
@@ -93,7 +112,11 @@ func (c *ACAddCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.Comm
 		return fmt.Errorf("failed to add acceptance criterion: %w", err)
 	}
 
-	// Format output
+	// Format output (with --quiet only the new ID, for scripts)
+	if pluginsdk.GetVerbosity(cmdCtx) == pluginsdk.VerbosityQuiet {
+		fmt.Fprintln(cmdCtx.GetStdout(), ac.ID)
+		return nil
+	}
 	out := cmdCtx.GetStdout()
 	fmt.Fprintf(out, "Acceptance criterion added successfully\n")
 	fmt.Fprintf(out, "  ID:          %s\n", ac.ID)
@@ -103,6 +126,13 @@ func (c *ACAddCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.Comm
 	if ac.TestingInstructions != "" {
 		fmt.Fprintf(out, "  Testing:     %s\n", ac.TestingInstructions)
 	}
+	if len(c.tags) > 0 {
+		tags, err := c.ACService.ListACTags(ctx, ac.ID)
+		if err != nil {
+			return fmt.Errorf("failed to list AC tags: %w", err)
+		}
+		fmt.Fprintf(out, "  Tags:        %s\n", strings.Join(tags, ", "))
+	}
 
 	return nil
 }
@@ -112,11 +142,18 @@ func (c *ACAddCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.Comm
 // ============================================================================
 
 type ACVerifyCommandAdapter struct {
-	ACService    *application.ACApplicationService
+	ACService *application.ACApplicationService
+
+	// Verification requirements from config (task_manager.ac.require_instructions
+	// and task_manager.ac.require_notes)
+	RequireInstructions bool
+	RequireNotes        bool
 
 	// CLI flags
-	project string
-	acID    string
+	project             string
+	acID                string
+	notes               string
+	requireInstructions bool
 }
 
 func (c *ACVerifyCommandAdapter) GetName() string {
@@ -128,20 +165,51 @@ func (c *ACVerifyCommandAdapter) GetDescription() string {
 }
 
 func (c *ACVerifyCommandAdapter) GetUsage() string {
-	return "dw task-manager ac verify <ac-id>"
+	return "dw task-manager ac verify <ac-id> [--notes <evidence>] [--require-instructions]"
 }
 
 func (c *ACVerifyCommandAdapter) GetHelp() string {
 	return `Marks an acceptance criterion as verified.
 
-Flags:
-  --project <name>    Project name (optional)`
+--notes records the evidence the criterion was verified with, e.g. a test run or
+a screenshot link. With --require-instructions, verification is rejected unless
+the criterion has testing instructions, so the check can be reproduced.
+
+Both can be enforced for every verification in .darwinflow/config.yaml:
+
+  task_manager:
+    ac:
+      require_instructions: true   # always behave as --require-instructions
+      require_notes: true          # reject verification without --notes
+
+The section may also be written as acceptance: (acceptance.require_instructions);
+ac: is the canonical name, matching the other task_manager.ac settings.
+
+Add missing instructions with:
+  dw task-manager ac update <ac-id> --testing-instructions <steps>
+
+Examples:
+  dw task-manager ac verify TM-ac-1
+  dw task-manager ac verify TM-ac-1 --notes "Ran go test ./..., all green"`
+}
+
+func (c *ACVerifyCommandAdapter) GetArgSpec() pluginsdk.CommandArgSpec {
+	return pluginsdk.CommandArgSpec{
+		Positionals: []pluginsdk.PositionalSpec{
+			{Name: "ac-id", Required: true, Description: "AC ID to verify"},
+		},
+		Flags: []pluginsdk.FlagSpec{
+			{Name: "notes", Value: "evidence", Description: "How the criterion was verified"},
+			{Name: "require-instructions", Type: pluginsdk.ArgTypeBool, Description: "Reject the AC if it has no testing instructions"},
+			{Name: "project", Value: "name", Description: "Project name"},
+		},
+	}
 }
 
 func (c *ACVerifyCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
 	// Parse AC ID
 	if len(args) == 0 {
-		return fmt.Errorf("acceptance criterion ID is required")
+		return fmt.Errorf("%w: acceptance criterion ID is required", pluginsdk.ErrInvalidArgument)
 	}
 	c.acID = args[0]
 	args = args[1:]
@@ -154,14 +222,24 @@ func (c *ACVerifyCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.C
 				c.project = args[i+1]
 				i++
 			}
+		case "--notes":
+			if i+1 < len(args) {
+				c.notes = args[i+1]
+				i++
+			}
+		case "--require-instructions":
+			c.requireInstructions = true
 		}
 	}
 
 	// Create DTO with verification metadata
 	input := dto.VerifyACDTO{
-		ID:         c.acID,
-		VerifiedBy: "user", // Could be enhanced to use actual user context
-		VerifiedAt: "now",  // Timestamp will be set by service
+		ID:                  c.acID,
+		VerifiedBy:          "user", // Could be enhanced to use actual user context
+		VerifiedAt:          "now",  // Timestamp will be set by service
+		Notes:               c.notes,
+		RequireInstructions: c.RequireInstructions || c.requireInstructions,
+		RequireNotes:        c.RequireNotes,
 	}
 
 	// Execute via application service
@@ -176,10 +254,13 @@ func (c *ACVerifyCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.C
 	}
 
 	// Format output
-	out := cmdCtx.GetStdout()
+	out := pluginsdk.InfoWriter(cmdCtx)
 	fmt.Fprintf(out, "Acceptance criterion verified successfully\n")
 	fmt.Fprintf(out, "  ID:     %s\n", ac.ID)
 	fmt.Fprintf(out, "  Status: %s\n", ac.Status)
+	if c.notes != "" {
+		fmt.Fprintf(out, "  Notes:  %s\n", c.notes)
+	}
 
 	return nil
 }
@@ -189,7 +270,7 @@ func (c *ACVerifyCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.C
 // ============================================================================
 
 type ACFailCommandAdapter struct {
-	ACService    *application.ACApplicationService
+	ACService *application.ACApplicationService
 
 	// CLI flags
 	project  string
@@ -210,17 +291,25 @@ func (c *ACFailCommandAdapter) GetUsage() string {
 }
 
 func (c *ACFailCommandAdapter) GetHelp() string {
-	return `Marks an acceptance criterion as failed with feedback.
+	return `Marks an acceptance criterion as failed with feedback.`
+}
 
-Flags:
-  --feedback <feedback>    Failure feedback (required)
-  --project <name>         Project name (optional)`
+func (c *ACFailCommandAdapter) GetArgSpec() pluginsdk.CommandArgSpec {
+	return pluginsdk.CommandArgSpec{
+		Positionals: []pluginsdk.PositionalSpec{
+			{Name: "ac-id", Required: true, Description: "AC ID to mark as failed"},
+		},
+		Flags: []pluginsdk.FlagSpec{
+			{Name: "feedback", Value: "feedback", Required: true, Description: "Failure feedback"},
+			{Name: "project", Value: "name", Description: "Project name"},
+		},
+	}
 }
 
 func (c *ACFailCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
 	// Parse AC ID
 	if len(args) == 0 {
-		return fmt.Errorf("acceptance criterion ID is required")
+		return fmt.Errorf("%w: acceptance criterion ID is required", pluginsdk.ErrInvalidArgument)
 	}
 	c.acID = args[0]
 	args = args[1:]
@@ -243,7 +332,7 @@ func (c *ACFailCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.Com
 
 	// Validate required flags
 	if c.feedback == "" {
-		return fmt.Errorf("--feedback is required")
+		return fmt.Errorf("%w: --feedback is required", pluginsdk.ErrInvalidArgument)
 	}
 
 	// Create DTO
@@ -264,7 +353,7 @@ func (c *ACFailCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.Com
 	}
 
 	// Format output
-	out := cmdCtx.GetStdout()
+	out := pluginsdk.InfoWriter(cmdCtx)
 	fmt.Fprintf(out, "Acceptance criterion marked as failed\n")
 	fmt.Fprintf(out, "  ID:       %s\n", ac.ID)
 	fmt.Fprintf(out, "  Status:   %s\n", ac.Status)
@@ -281,10 +370,16 @@ func (c *ACFailCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.Com
 
 type ACListCommandAdapter struct {
 	ACService    *application.ACApplicationService
+	TrackService *application.TrackApplicationService
 
 	// CLI flags
-	project string
-	taskID  string
+	project      string
+	taskID       string
+	tree         bool
+	trackID      string
+	iterationNum *int
+	status       string
+	json         bool
 }
 
 func (c *ACListCommandAdapter) GetName() string {
@@ -292,41 +387,74 @@ func (c *ACListCommandAdapter) GetName() string {
 }
 
 func (c *ACListCommandAdapter) GetDescription() string {
-	return "List acceptance criteria for a task"
+	return "List acceptance criteria for a task, or all of them as a tree"
 }
 
 func (c *ACListCommandAdapter) GetUsage() string {
-	return "dw task-manager ac list <task-id>"
+	return "dw task-manager ac list <task-id> | --tree [--track <id>] [--iteration <num>] [--status <status>] [--json]"
 }
 
 func (c *ACListCommandAdapter) GetHelp() string {
 	return `Lists all acceptance criteria for a task with their verification status.
 
+With --tree (or --all), lists the acceptance criteria of the whole roadmap
+grouped by track and task, with verified/total counts per track and task.
+Tracks and tasks without matching ACs are left out.
+
 Status indicators:
   ✓   Verified (manually or automatically)
   ⏸   Pending human review
   ○   Not started
   ✗   Failed
+  ⊘   Skipped
 
 Examples:
   # List ACs for a task
   dw task-manager ac list DW-task-123
 
+  # All ACs of the roadmap, by track and task
+  dw task-manager ac list --tree
+
+  # Failed ACs of iteration 3
+  dw task-manager ac list --tree --iteration 3 --status failed
+
+  # One track as JSON
+  dw task-manager ac list --tree --track DW-track-1 --json
+
 Notes:
   - Shows verification type and current status for each AC
   - Summary shows total and verified counts`
 }
 
-func (c *ACListCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
-	// Parse positional argument and flags
-	if len(args) == 0 {
-		return fmt.Errorf("<task-id> is required")
+func (c *ACListCommandAdapter) GetArgSpec() pluginsdk.CommandArgSpec {
+	return pluginsdk.CommandArgSpec{
+		Positionals: []pluginsdk.PositionalSpec{
+			{Name: "task-id", Description: "Task to list the criteria of (required without --tree)"},
+		},
+		Flags: []pluginsdk.FlagSpec{
+			{Name: "tree", Type: "bool", Description: "List the ACs of the whole roadmap as a tree"},
+			{Name: "all", Type: "bool", Description: "Same as --tree"},
+			{Name: "track", Value: "id", Description: "Only ACs of this track (tree only)"},
+			{Name: "iteration", Type: "int", Value: "num", Description: "Only ACs of tasks in this iteration (tree only)"},
+			{Name: "status", Value: "status", Choices: acTreeStatuses, Description: "Only ACs with this status (tree only)"},
+			{Name: "json", Type: "bool", Description: "Output the tree as JSON (tree only)"},
+			{Name: "project", Value: "name", Description: "Project name"},
+		},
 	}
+}
 
-	c.taskID = args[0]
-	args = args[1:]
+// acTreeStatuses are the values of ac list --status
+var acTreeStatuses = []string{
+	string(entities.ACStatusNotStarted),
+	string(entities.ACStatusPendingHumanReview),
+	string(entities.ACStatusVerified),
+	string(entities.ACStatusAutomaticallyVerified),
+	string(entities.ACStatusFailed),
+	string(entities.ACStatusSkipped),
+}
 
-	// Parse remaining flags
+func (c *ACListCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	// Parse positional argument and flags
 	for i := 0; i < len(args); i++ {
 		switch args[i] {
 		case "--project":
@@ -334,9 +462,46 @@ func (c *ACListCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.Com
 				c.project = args[i+1]
 				i++
 			}
+		case "--tree", "--all":
+			c.tree = true
+		case "--track":
+			if i+1 < len(args) {
+				c.trackID = args[i+1]
+				i++
+			}
+		case "--iteration":
+			if i+1 < len(args) {
+				iterNum, err := strconv.Atoi(args[i+1])
+				if err != nil {
+					return fmt.Errorf("%w: invalid iteration number: %s", pluginsdk.ErrInvalidArgument, args[i+1])
+				}
+				c.iterationNum = &iterNum
+				i++
+			}
+		case "--status":
+			if i+1 < len(args) {
+				c.status = args[i+1]
+				i++
+			}
+		case "--json":
+			c.json = true
+		default:
+			if c.taskID == "" && !strings.HasPrefix(args[i], "--") {
+				c.taskID = args[i]
+			}
 		}
 	}
 
+	if c.tree {
+		return c.executeTree(ctx, cmdCtx)
+	}
+	if c.taskID == "" {
+		return fmt.Errorf("%w: <task-id> is required (or use --tree to list all ACs)", pluginsdk.ErrInvalidArgument)
+	}
+	if c.trackID != "" || c.iterationNum != nil || c.status != "" || c.json {
+		return fmt.Errorf("%w: --track, --iteration, --status and --json require --tree", pluginsdk.ErrInvalidArgument)
+	}
+
 	// Get ACs for task via application service
 	acs, err := c.ACService.ListAC(ctx, c.taskID)
 	if err != nil {
@@ -377,6 +542,128 @@ func (c *ACListCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.Com
 	return nil
 }
 
+// executeTree lists the ACs of the roadmap grouped by track and task
+func (c *ACListCommandAdapter) executeTree(ctx context.Context, cmdCtx pluginsdk.CommandContext) error {
+	roadmap, err := c.TrackService.GetActiveRoadmap(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get active roadmap: %w", err)
+	}
+	tracks, err := c.TrackService.ListTracks(ctx, roadmap.ID, entities.TrackFilters{})
+	if err != nil {
+		return fmt.Errorf("failed to list tracks: %w", err)
+	}
+
+	filters := entities.ACFilters{
+		IterationNum: c.iterationNum,
+		TrackID:      c.trackID,
+		TaskID:       c.taskID,
+	}
+	tree, err := c.ACService.ACTree(ctx, tracks, filters, entities.AcceptanceCriteriaStatus(c.status))
+	if err != nil {
+		return fmt.Errorf("failed to list ACs: %w", err)
+	}
+
+	out := cmdCtx.GetStdout()
+	if c.json {
+		return writeACTreeJSON(out, tree)
+	}
+
+	if tree.Total == 0 {
+		fmt.Fprintf(out, "No acceptance criteria found%s\n", c.filterSuffix())
+		return nil
+	}
+
+	fmt.Fprintf(out, "Acceptance Criteria%s\n", c.filterSuffix())
+	fmt.Fprintf(out, "Summary: %d/%d verified\n", tree.Verified, tree.Total)
+	for _, track := range tree.Tracks {
+		title := track.Title
+		if title == "" {
+			title = track.ID
+		}
+		fmt.Fprintf(out, "\n%s (%s) - %d/%d verified\n", title, track.ID, track.Verified, track.Total)
+		for _, task := range track.Tasks {
+			fmt.Fprintf(out, "  %s (%s) [%s] - %d/%d verified\n", task.Title, task.ID, task.Status, task.Verified, task.Total)
+			for _, ac := range task.ACs {
+				status := entities.AcceptanceCriteriaStatus(ac.Status)
+				fmt.Fprintf(out, "    %s [%s] %s\n", c.getStatusIndicator(status), ac.ID, ac.Description)
+				if (status == entities.ACStatusFailed || status == entities.ACStatusSkipped) && ac.Notes != "" {
+					fmt.Fprintf(out, "      Reason: %s\n", ac.Notes)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// filterSuffix describes the active tree filters, e.g. " (Iteration 3) (Status: failed)"
+func (c *ACListCommandAdapter) filterSuffix() string {
+	suffix := ""
+	if c.iterationNum != nil {
+		suffix += fmt.Sprintf(" (Iteration %d)", *c.iterationNum)
+	}
+	if c.trackID != "" {
+		suffix += fmt.Sprintf(" (Track: %s)", c.trackID)
+	}
+	if c.taskID != "" {
+		suffix += fmt.Sprintf(" (Task: %s)", c.taskID)
+	}
+	if c.status != "" {
+		suffix += fmt.Sprintf(" (Status: %s)", c.status)
+	}
+	return suffix
+}
+
+// acTreeJSON is the --json representation of the AC tree; the nesting mirrors the text output
+type acTreeJSON struct {
+	Verified int               `json:"verified"`
+	Total    int               `json:"total"`
+	Tracks   []acTreeTrackJSON `json:"tracks"`
+}
+
+type acTreeTrackJSON struct {
+	ID       string           `json:"id"`
+	Title    string           `json:"title"`
+	Verified int              `json:"verified"`
+	Total    int              `json:"total"`
+	Tasks    []acTreeTaskJSON `json:"tasks"`
+}
+
+type acTreeTaskJSON struct {
+	ID       string         `json:"id"`
+	Title    string         `json:"title"`
+	Status   string         `json:"status"`
+	Verified int            `json:"verified"`
+	Total    int            `json:"total"`
+	ACs      []acTreeACJSON `json:"acceptance_criteria"`
+}
+
+type acTreeACJSON struct {
+	ID               string `json:"id"`
+	Description      string `json:"description"`
+	VerificationType string `json:"verification_type"`
+	Status           string `json:"status"`
+	Notes            string `json:"notes,omitempty"`
+}
+
+func writeACTreeJSON(out io.Writer, tree *dto.ACTreeDTO) error {
+	report := acTreeJSON{Verified: tree.Verified, Total: tree.Total, Tracks: []acTreeTrackJSON{}}
+	for _, track := range tree.Tracks {
+		trackJSON := acTreeTrackJSON{ID: track.ID, Title: track.Title, Verified: track.Verified, Total: track.Total, Tasks: []acTreeTaskJSON{}}
+		for _, task := range track.Tasks {
+			taskJSON := acTreeTaskJSON{ID: task.ID, Title: task.Title, Status: task.Status, Verified: task.Verified, Total: task.Total, ACs: []acTreeACJSON{}}
+			for _, ac := range task.ACs {
+				taskJSON.ACs = append(taskJSON.ACs, acTreeACJSON(ac))
+			}
+			trackJSON.Tasks = append(trackJSON.Tasks, taskJSON)
+		}
+		report.Tracks = append(report.Tracks, trackJSON)
+	}
+
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(report)
+}
+
 func (c *ACListCommandAdapter) getStatusIndicator(status entities.AcceptanceCriteriaStatus) string {
 	switch status {
 	case entities.ACStatusVerified, entities.ACStatusAutomaticallyVerified:
@@ -397,7 +684,7 @@ func (c *ACListCommandAdapter) getStatusIndicator(status entities.AcceptanceCrit
 // ============================================================================
 
 type ACShowCommandAdapter struct {
-	ACService    *application.ACApplicationService
+	ACService *application.ACApplicationService
 
 	// CLI flags
 	project string
@@ -420,18 +707,26 @@ func (c *ACShowCommandAdapter) GetHelp() string {
 	return `Shows detailed information about an acceptance criterion including
 description, verification type, status, and testing instructions.
 
-Flags:
-  <ac-id>  AC ID to show (required)
-
 Examples:
   # Show AC details
   dw task-manager ac show DW-ac-1`
 }
 
+func (c *ACShowCommandAdapter) GetArgSpec() pluginsdk.CommandArgSpec {
+	return pluginsdk.CommandArgSpec{
+		Positionals: []pluginsdk.PositionalSpec{
+			{Name: "ac-id", Required: true, Description: "AC ID to show"},
+		},
+		Flags: []pluginsdk.FlagSpec{
+			{Name: "project", Value: "name", Description: "Project name"},
+		},
+	}
+}
+
 func (c *ACShowCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
 	// Parse positional argument
 	if len(args) == 0 {
-		return fmt.Errorf("<ac-id> is required")
+		return fmt.Errorf("%w: <ac-id> is required", pluginsdk.ErrInvalidArgument)
 	}
 
 	c.acID = args[0]
@@ -465,6 +760,13 @@ func (c *ACShowCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.Com
 	fmt.Fprintf(out, "Verification Type:    %s\n", ac.VerificationType)
 	statusIcon := c.getStatusIndicator(ac.Status)
 	fmt.Fprintf(out, "Status:               %s %s\n", statusIcon, ac.Status)
+	tags, err := c.ACService.ListACTags(ctx, ac.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list AC tags: %w", err)
+	}
+	if len(tags) > 0 {
+		fmt.Fprintf(out, "Tags:                 %s\n", strings.Join(tags, ", "))
+	}
 
 	// Show testing instructions if present
 	if ac.TestingInstructions != "" {
@@ -489,6 +791,13 @@ func (c *ACShowCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.Com
 		fmt.Fprintf(out, "%s\n", ac.Notes)
 	}
 
+	// Show verification notes (who verified it and the evidence) if AC verified
+	if ac.Status == "verified" && ac.Notes != "" {
+		fmt.Fprintf(out, "\nVerification:\n")
+		fmt.Fprintf(out, "-------------\n")
+		fmt.Fprintf(out, "%s\n", ac.Notes)
+	}
+
 	// Show timestamps
 	fmt.Fprintf(out, "\nTimestamps:\n")
 	fmt.Fprintf(out, "-----------\n")
@@ -518,14 +827,14 @@ func (c *ACShowCommandAdapter) getStatusIndicator(status entities.AcceptanceCrit
 // ============================================================================
 
 type ACUpdateCommandAdapter struct {
-	ACService    *application.ACApplicationService
+	ACService *application.ACApplicationService
 
 	// CLI flags
-	project                       string
-	acID                          string
-	description                   string
-	testingInstructions           string
-	updateTestingInstructions     bool
+	project                   string
+	acID                      string
+	description               string
+	testingInstructions       string
+	updateTestingInstructions bool
 }
 
 func (c *ACUpdateCommandAdapter) GetName() string {
@@ -543,11 +852,6 @@ func (c *ACUpdateCommandAdapter) GetUsage() string {
 func (c *ACUpdateCommandAdapter) GetHelp() string {
 	return `Updates an acceptance criterion.
 
-Flags:
-  <ac-id>                      AC ID to update (required)
-  --description "..."          New description (optional)
-  --testing-instructions "..." New testing instructions (optional)
-
 At least one of --description or --testing-instructions must be provided.
 
 Examples:
@@ -565,10 +869,23 @@ Examples:
     --testing-instructions "New test steps"`
 }
 
+func (c *ACUpdateCommandAdapter) GetArgSpec() pluginsdk.CommandArgSpec {
+	return pluginsdk.CommandArgSpec{
+		Positionals: []pluginsdk.PositionalSpec{
+			{Name: "ac-id", Required: true, Description: "AC ID to update"},
+		},
+		Flags: []pluginsdk.FlagSpec{
+			{Name: "description", Value: "desc", Description: "New description"},
+			{Name: "testing-instructions", Value: "inst", Description: "New testing instructions"},
+			{Name: "project", Value: "name", Description: "Project name"},
+		},
+	}
+}
+
 func (c *ACUpdateCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
 	// Parse positional argument
 	if len(args) == 0 {
-		return fmt.Errorf("<ac-id> is required")
+		return fmt.Errorf("%w: <ac-id> is required", pluginsdk.ErrInvalidArgument)
 	}
 
 	c.acID = args[0]
@@ -621,7 +938,7 @@ func (c *ACUpdateCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.C
 	}
 
 	// Format output
-	out := cmdCtx.GetStdout()
+	out := pluginsdk.InfoWriter(cmdCtx)
 	fmt.Fprintf(out, "Acceptance criterion updated\n")
 	fmt.Fprintf(out, "  ID:          %s\n", ac.ID)
 	if c.description != "" {
@@ -643,12 +960,13 @@ func (c *ACUpdateCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.C
 // ============================================================================
 
 type ACDeleteCommandAdapter struct {
-	ACService    *application.ACApplicationService
+	ACService *application.ACApplicationService
 
 	// CLI flags
 	project string
 	acID    string
 	force   bool
+	plan    bool
 }
 
 func (c *ACDeleteCommandAdapter) GetName() string {
@@ -660,27 +978,40 @@ func (c *ACDeleteCommandAdapter) GetDescription() string {
 }
 
 func (c *ACDeleteCommandAdapter) GetUsage() string {
-	return "dw task-manager ac delete <ac-id> [--force]"
+	return "dw task-manager ac delete <ac-id> [--force | --plan]"
 }
 
 func (c *ACDeleteCommandAdapter) GetHelp() string {
 	return `Deletes an acceptance criterion.
 
-Requires the --force flag for safety.
-
-Flags:
-  <ac-id>     AC ID to delete (required)
-  --force     Required to confirm deletion
+Requires the --force flag for safety. --plan prints the tags and task gates
+that would be deleted with it, without deleting anything.
 
 Examples:
+  # Show what deleting an AC would remove
+  dw task-manager ac delete DW-ac-1 --plan
+
   # Delete an AC
   dw task-manager ac delete DW-ac-1 --force`
 }
 
+func (c *ACDeleteCommandAdapter) GetArgSpec() pluginsdk.CommandArgSpec {
+	return pluginsdk.CommandArgSpec{
+		Positionals: []pluginsdk.PositionalSpec{
+			{Name: "ac-id", Required: true, Description: "AC ID to delete"},
+		},
+		Flags: []pluginsdk.FlagSpec{
+			{Name: "force", Type: pluginsdk.ArgTypeBool, Description: "Required to confirm deletion"},
+			{Name: "plan", Type: pluginsdk.ArgTypeBool, Description: "Show what would be deleted, without deleting"},
+			{Name: "project", Value: "name", Description: "Project name"},
+		},
+	}
+}
+
 func (c *ACDeleteCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
 	// Parse positional argument
 	if len(args) == 0 {
-		return fmt.Errorf("<ac-id> is required")
+		return fmt.Errorf("%w: <ac-id> is required", pluginsdk.ErrInvalidArgument)
 	}
 
 	c.acID = args[0]
@@ -696,12 +1027,23 @@ func (c *ACDeleteCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.C
 			}
 		case "--force":
 			c.force = true
+		case "--plan":
+			c.plan = true
+		}
+	}
+
+	if c.plan {
+		plan, err := c.ACService.PlanACDeletion(ctx, c.acID)
+		if err != nil {
+			return fmt.Errorf("failed to plan AC deletion: %w", err)
 		}
+		plan.Write(cmdCtx.GetStdout())
+		return nil
 	}
 
 	// Validate --force flag
 	if !c.force {
-		return fmt.Errorf("--force flag is required to confirm deletion")
+		return fmt.Errorf("%w: --force flag is required to confirm deletion", pluginsdk.ErrInvalidArgument)
 	}
 
 	// Execute via application service
@@ -710,7 +1052,7 @@ func (c *ACDeleteCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.C
 	}
 
 	// Format output
-	out := cmdCtx.GetStdout()
+	out := pluginsdk.InfoWriter(cmdCtx)
 	fmt.Fprintf(out, "Acceptance criterion deleted\n")
 	fmt.Fprintf(out, "  ID: %s\n", c.acID)
 
@@ -722,11 +1064,13 @@ func (c *ACDeleteCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.C
 // ============================================================================
 
 type ACVerifyAutoCommandAdapter struct {
-	ACService    *application.ACApplicationService
+	ACService *application.ACApplicationService
 
 	// CLI flags
 	project string
 	acID    string
+	trackID string
+	taskID  string
 }
 
 func (c *ACVerifyAutoCommandAdapter) GetName() string {
@@ -734,11 +1078,11 @@ func (c *ACVerifyAutoCommandAdapter) GetName() string {
 }
 
 func (c *ACVerifyAutoCommandAdapter) GetDescription() string {
-	return "Mark an AC as automatically verified"
+	return "Mark an AC, or all automated ACs of a track, as automatically verified"
 }
 
 func (c *ACVerifyAutoCommandAdapter) GetUsage() string {
-	return "dw task-manager ac verify-auto <ac-id>"
+	return "dw task-manager ac verify-auto <ac-id> | --track <track-id> [--task <task-id>]"
 }
 
 func (c *ACVerifyAutoCommandAdapter) GetHelp() string {
@@ -747,22 +1091,40 @@ func (c *ACVerifyAutoCommandAdapter) GetHelp() string {
 Used by coding agents to indicate that automated verification
 (tests, linting, etc.) has passed for this AC.
 
-Flags:
-  <ac-id>  AC ID to mark as auto-verified (required)
+With --track (and optionally --task) instead of an AC ID, every automated
+AC of the track's tasks is set to automatically_verified in one call, e.g.
+when CI reports that the test suite passed. Manual ACs and ACs that are
+already verified or skipped are left alone and counted as skipped. Each AC
+is updated on its own, so one failed update does not stop the rest; the
+command exits with an error if any update failed.
 
 Examples:
   # Mark AC as auto-verified
-  dw task-manager ac verify-auto DW-ac-1`
+  dw task-manager ac verify-auto DW-ac-1
+
+  # Report a green CI run for a whole track
+  dw task-manager ac verify-auto --track DW-track-1`
 }
 
-func (c *ACVerifyAutoCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
-	// Parse positional argument
-	if len(args) == 0 {
-		return fmt.Errorf("<ac-id> is required")
+func (c *ACVerifyAutoCommandAdapter) GetArgSpec() pluginsdk.CommandArgSpec {
+	return pluginsdk.CommandArgSpec{
+		Positionals: []pluginsdk.PositionalSpec{
+			{Name: "ac-id", Description: "AC ID to mark as auto-verified (or use --track)"},
+		},
+		Flags: []pluginsdk.FlagSpec{
+			{Name: "track", Value: "track-id", Description: "Verify the automated ACs of all tasks in a track"},
+			{Name: "task", Value: "task-id", Description: "Only verify the automated ACs of this task"},
+			{Name: "project", Value: "name", Description: "Project name"},
+		},
 	}
+}
 
-	c.acID = args[0]
-	args = args[1:]
+func (c *ACVerifyAutoCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	// Parse optional positional argument
+	if len(args) > 0 && len(args[0]) > 0 && args[0][0] != '-' {
+		c.acID = args[0]
+		args = args[1:]
+	}
 
 	// Parse flags
 	for i := 0; i < len(args); i++ {
@@ -772,9 +1134,29 @@ func (c *ACVerifyAutoCommandAdapter) Execute(ctx context.Context, cmdCtx plugins
 				c.project = args[i+1]
 				i++
 			}
+		case "--track":
+			if i+1 < len(args) {
+				c.trackID = args[i+1]
+				i++
+			}
+		case "--task":
+			if i+1 < len(args) {
+				c.taskID = args[i+1]
+				i++
+			}
 		}
 	}
 
+	if c.acID != "" && (c.trackID != "" || c.taskID != "") {
+		return fmt.Errorf("%w: give either <ac-id> or --track/--task, not both", pluginsdk.ErrInvalidArgument)
+	}
+	if c.trackID != "" || c.taskID != "" {
+		return c.verifyAutomated(ctx, cmdCtx)
+	}
+	if c.acID == "" {
+		return fmt.Errorf("%w: <ac-id> or --track is required", pluginsdk.ErrInvalidArgument)
+	}
+
 	// Create DTO for verification
 	input := dto.VerifyACDTO{
 		ID:         c.acID,
@@ -794,7 +1176,7 @@ func (c *ACVerifyAutoCommandAdapter) Execute(ctx context.Context, cmdCtx plugins
 	}
 
 	// Format output
-	out := cmdCtx.GetStdout()
+	out := pluginsdk.InfoWriter(cmdCtx)
 	fmt.Fprintf(out, "Acceptance criterion verified (automatically)\n")
 	fmt.Fprintf(out, "  ID:     %s\n", ac.ID)
 	fmt.Fprintf(out, "  Status: %s\n", ac.Status)
@@ -802,12 +1184,44 @@ func (c *ACVerifyAutoCommandAdapter) Execute(ctx context.Context, cmdCtx plugins
 	return nil
 }
 
+// verifyAutomated marks the automated ACs of a track or task as automatically verified
+func (c *ACVerifyAutoCommandAdapter) verifyAutomated(ctx context.Context, cmdCtx pluginsdk.CommandContext) error {
+	result, err := c.ACService.VerifyAutomatedACs(ctx, dto.VerifyAutomatedACsDTO{
+		TrackID: c.trackID,
+		TaskID:  c.taskID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to verify ACs: %w", err)
+	}
+
+	out := pluginsdk.InfoWriter(cmdCtx)
+	fmt.Fprintf(out, "Marked %d automated acceptance criteria as automatically verified\n", len(result.VerifiedIDs))
+	if skipped := result.SkippedManual + result.SkippedTerminal; skipped > 0 {
+		fmt.Fprintf(out, "Skipped %d (%d manual, %d already verified or skipped)\n", skipped, result.SkippedManual, result.SkippedTerminal)
+	}
+	for _, id := range result.VerifiedIDs {
+		fmt.Fprintf(out, "  %s\n", id)
+	}
+
+	// Failures are reported even with --quiet
+	if len(result.Failed) > 0 {
+		out := cmdCtx.GetStdout()
+		fmt.Fprintf(out, "Failed to update %d:\n", len(result.Failed))
+		for _, failure := range result.Failed {
+			fmt.Fprintf(out, "  %s: %v\n", failure.ID, failure.Err)
+		}
+		return fmt.Errorf("%d acceptance criteria could not be verified", len(result.Failed))
+	}
+
+	return nil
+}
+
 // ============================================================================
 // ACRequestReviewCommandAdapter - Requests human review for an AC
 // ============================================================================
 
 type ACRequestReviewCommandAdapter struct {
-	ACService    *application.ACApplicationService
+	ACService *application.ACApplicationService
 
 	// CLI flags
 	project string
@@ -832,18 +1246,26 @@ func (c *ACRequestReviewCommandAdapter) GetHelp() string {
 Used by coding agents to indicate that this AC requires
 manual human verification before the task can be completed.
 
-Flags:
-  <ac-id>  AC ID to request review for (required)
-
 Examples:
   # Request human review
   dw task-manager ac request-review DW-ac-1`
 }
 
+func (c *ACRequestReviewCommandAdapter) GetArgSpec() pluginsdk.CommandArgSpec {
+	return pluginsdk.CommandArgSpec{
+		Positionals: []pluginsdk.PositionalSpec{
+			{Name: "ac-id", Required: true, Description: "AC ID to request review for"},
+		},
+		Flags: []pluginsdk.FlagSpec{
+			{Name: "project", Value: "name", Description: "Project name"},
+		},
+	}
+}
+
 func (c *ACRequestReviewCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
 	// Parse positional argument
 	if len(args) == 0 {
-		return fmt.Errorf("<ac-id> is required")
+		return fmt.Errorf("%w: <ac-id> is required", pluginsdk.ErrInvalidArgument)
 	}
 
 	c.acID = args[0]
@@ -889,7 +1311,7 @@ func (c *ACRequestReviewCommandAdapter) Execute(ctx context.Context, cmdCtx plug
 	}
 
 	// Format output
-	out := cmdCtx.GetStdout()
+	out := pluginsdk.InfoWriter(cmdCtx)
 	fmt.Fprintf(out, "Human review requested for AC\n")
 	fmt.Fprintf(out, "  ID:     %s\n", ac.ID)
 	fmt.Fprintf(out, "  Status: pending-review (requested)\n")
@@ -927,17 +1349,25 @@ func (c *ACSkipCommandAdapter) GetHelp() string {
 	return `Marks an acceptance criterion as skipped with a reason.
 
 Skipped ACs are treated as satisfied and do not block task completion.
-Use this for ACs that are no longer applicable or needed.
+Use this for ACs that are no longer applicable or needed.`
+}
 
-Flags:
-  --reason <reason>    Reason for skipping (required)
-  --project <name>     Project name (optional)`
+func (c *ACSkipCommandAdapter) GetArgSpec() pluginsdk.CommandArgSpec {
+	return pluginsdk.CommandArgSpec{
+		Positionals: []pluginsdk.PositionalSpec{
+			{Name: "ac-id", Required: true, Description: "AC ID to skip"},
+		},
+		Flags: []pluginsdk.FlagSpec{
+			{Name: "reason", Value: "reason", Required: true, Description: "Reason for skipping"},
+			{Name: "project", Value: "name", Description: "Project name"},
+		},
+	}
 }
 
 func (c *ACSkipCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
 	// Parse AC ID
 	if len(args) == 0 {
-		return fmt.Errorf("acceptance criterion ID is required")
+		return fmt.Errorf("%w: acceptance criterion ID is required", pluginsdk.ErrInvalidArgument)
 	}
 	c.acID = args[0]
 	args = args[1:]
@@ -960,7 +1390,7 @@ func (c *ACSkipCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.Com
 
 	// Validate required flags
 	if c.reason == "" {
-		return fmt.Errorf("--reason is required")
+		return fmt.Errorf("%w: --reason is required", pluginsdk.ErrInvalidArgument)
 	}
 
 	// Create DTO
@@ -981,7 +1411,7 @@ func (c *ACSkipCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.Com
 	}
 
 	// Format output
-	out := cmdCtx.GetStdout()
+	out := pluginsdk.InfoWriter(cmdCtx)
 	fmt.Fprintf(out, "Acceptance criterion skipped\n")
 	fmt.Fprintf(out, "  ID:     %s\n", ac.ID)
 	fmt.Fprintf(out, "  Status: %s\n", ac.Status)
@@ -997,7 +1427,7 @@ func (c *ACSkipCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.Com
 // ============================================================================
 
 type ACListIterationCommandAdapter struct {
-	ACService    *application.ACApplicationService
+	ACService *application.ACApplicationService
 
 	// CLI flags
 	project   string
@@ -1035,10 +1465,21 @@ Notes:
   - Summary shows overall verification progress`
 }
 
+func (c *ACListIterationCommandAdapter) GetArgSpec() pluginsdk.CommandArgSpec {
+	return pluginsdk.CommandArgSpec{
+		Positionals: []pluginsdk.PositionalSpec{
+			{Name: "iteration-number", Required: true, Description: "Iteration to list the criteria of"},
+		},
+		Flags: []pluginsdk.FlagSpec{
+			{Name: "project", Value: "name", Description: "Project name"},
+		},
+	}
+}
+
 func (c *ACListIterationCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
 	// Parse positional argument
 	if len(args) == 0 {
-		return fmt.Errorf("<iteration-number> is required")
+		return fmt.Errorf("%w: <iteration-number> is required", pluginsdk.ErrInvalidArgument)
 	}
 
 	// Parse iteration number
@@ -1137,8 +1578,8 @@ func (c *ACListIterationCommandAdapter) getStatusIndicator(status entities.Accep
 // ============================================================================
 
 type ACListTrackCommandAdapter struct {
-	ACService    *application.ACApplicationService
-	TaskService  *application.TaskApplicationService
+	ACService   *application.ACApplicationService
+	TaskService *application.TaskApplicationService
 
 	// CLI flags
 	project string
@@ -1176,10 +1617,21 @@ Notes:
   - Summary shows overall verification progress`
 }
 
+func (c *ACListTrackCommandAdapter) GetArgSpec() pluginsdk.CommandArgSpec {
+	return pluginsdk.CommandArgSpec{
+		Positionals: []pluginsdk.PositionalSpec{
+			{Name: "track-id", Required: true, Description: "Track to list the criteria of"},
+		},
+		Flags: []pluginsdk.FlagSpec{
+			{Name: "project", Value: "name", Description: "Project name"},
+		},
+	}
+}
+
 func (c *ACListTrackCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
 	// Parse positional argument
 	if len(args) == 0 {
-		return fmt.Errorf("<track-id> is required")
+		return fmt.Errorf("%w: <track-id> is required", pluginsdk.ErrInvalidArgument)
 	}
 
 	c.trackID = args[0]
@@ -1294,7 +1746,7 @@ func (c *ACListTrackCommandAdapter) getStatusIndicator(status entities.Acceptanc
 // ============================================================================
 
 type ACFailedCommandAdapter struct {
-	ACService    *application.ACApplicationService
+	ACService *application.ACApplicationService
 
 	// CLI flags
 	project      string
@@ -1320,12 +1772,6 @@ func (c *ACFailedCommandAdapter) GetHelp() string {
 
 Supports optional filtering by iteration, track, or task to narrow results.
 
-Flags:
-  --iteration <num>  Filter by iteration number (optional)
-  --track <id>       Filter by track ID (optional)
-  --task <id>        Filter by task ID (optional)
-  --project <name>   Use specific project (optional)
-
 Examples:
   # List all failed ACs
   dw task-manager ac failed
@@ -1343,6 +1789,17 @@ Output:
   Shows AC ID, task ID, description, and feedback (Notes field) for each failed AC.`
 }
 
+func (c *ACFailedCommandAdapter) GetArgSpec() pluginsdk.CommandArgSpec {
+	return pluginsdk.CommandArgSpec{
+		Flags: []pluginsdk.FlagSpec{
+			{Name: "iteration", Type: pluginsdk.ArgTypeInt, Value: "num", Description: "Filter by iteration number"},
+			{Name: "track", Value: "id", Description: "Filter by track ID"},
+			{Name: "task", Value: "id", Description: "Filter by task ID"},
+			{Name: "project", Value: "name", Description: "Project name"},
+		},
+	}
+}
+
 func (c *ACFailedCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
 	// Parse arguments
 	for i := 0; i < len(args); i++ {
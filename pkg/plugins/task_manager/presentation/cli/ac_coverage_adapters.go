@@ -0,0 +1,237 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application/dto"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// ============================================================================
+// ACCoverageCommandAdapter - Reports tasks without acceptance criteria
+// ============================================================================
+
+// ACCoverageCommandAdapter reports which tasks lack acceptance criteria
+type ACCoverageCommandAdapter struct {
+	ACService        *application.ACApplicationService
+	TrackService     *application.TrackApplicationService
+	IterationService *application.IterationApplicationService
+
+	// CLI flags
+	project      string
+	iterationNum *int
+	trackID      string
+	allRoadmaps  bool
+	failUnder    *float64
+	json         bool
+}
+
+func (c *ACCoverageCommandAdapter) GetName() string {
+	return "ac coverage"
+}
+
+func (c *ACCoverageCommandAdapter) GetDescription() string {
+	return "Report tasks without acceptance criteria"
+}
+
+func (c *ACCoverageCommandAdapter) GetUsage() string {
+	return "dw task-manager ac coverage [--iteration <num>] [--track <id> | --all-roadmaps] [--fail-under <percent>] [--json]"
+}
+
+func (c *ACCoverageCommandAdapter) GetHelp() string {
+	return `Lists the tasks that have no acceptance criteria, and the done tasks whose
+acceptance criteria are not all verified, with the share of tasks that have
+at least one AC. Tasks without ACs have no definition of done and can be
+marked done unchecked.
+
+Flags:
+  --iteration <num>       Only tasks in this iteration (optional)
+  --track <id>            Only tasks of this track (optional)
+  --all-roadmaps          Include the tasks of every roadmap, archived ones too
+  --fail-under <percent>  Exit with status 1 when coverage is below percent (0-100)
+  --json                  Output as JSON
+  --project <name>        Use specific project (optional)
+
+Examples:
+  # Coverage of the active roadmap
+  dw task-manager ac coverage
+
+  # Coverage of iteration 3
+  dw task-manager ac coverage --iteration 3
+
+  # Fail a CI job when fewer than 90% of a track's tasks have ACs
+  dw task-manager ac coverage --track DW-track-1 --fail-under 90 --json
+
+Notes:
+  - Without --track or --iteration, only the active roadmap's tasks count
+  - Cancelled tasks are not counted
+  - Skipped ACs count as verified
+  - Coverage is 100% when no tasks match`
+}
+
+func (c *ACCoverageCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	// Parse arguments
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--project":
+			if i+1 < len(args) {
+				c.project = args[i+1]
+				i++
+			}
+		case "--iteration":
+			if i+1 < len(args) {
+				iterNum, err := strconv.Atoi(args[i+1])
+				if err != nil {
+					return fmt.Errorf("%w: invalid iteration number: %s", pluginsdk.ErrInvalidArgument, args[i+1])
+				}
+				c.iterationNum = &iterNum
+				i++
+			}
+		case "--track":
+			if i+1 < len(args) {
+				c.trackID = args[i+1]
+				i++
+			}
+		case "--all-roadmaps":
+			c.allRoadmaps = true
+		case "--fail-under":
+			if i+1 >= len(args) {
+				return fmt.Errorf("%w: --fail-under requires a value", pluginsdk.ErrInvalidArgument)
+			}
+			percent, err := strconv.ParseFloat(args[i+1], 64)
+			if err != nil || percent < 0 || percent > 100 {
+				return fmt.Errorf("%w: --fail-under must be a percentage between 0 and 100, got '%s'", pluginsdk.ErrInvalidArgument, args[i+1])
+			}
+			c.failUnder = &percent
+			i++
+		case "--json":
+			c.json = true
+		}
+	}
+
+	if c.trackID != "" && c.allRoadmaps {
+		return fmt.Errorf("%w: --track and --all-roadmaps cannot be combined", pluginsdk.ErrInvalidArgument)
+	}
+
+	filters := entities.ACFilters{
+		IterationNum: c.iterationNum,
+		TrackID:      c.trackID,
+	}
+	// Unknown filters would otherwise report an empty, fully covered selection
+	if c.trackID != "" {
+		if _, err := c.TrackService.GetTrack(ctx, c.trackID); err != nil {
+			return err
+		}
+	}
+	if c.iterationNum != nil {
+		if _, err := c.IterationService.GetIteration(ctx, *c.iterationNum); err != nil {
+			return err
+		}
+	}
+	// An explicit track or iteration selects its tasks whatever roadmap they belong to
+	var roadmapID string
+	if c.trackID == "" && c.iterationNum == nil && !c.allRoadmaps {
+		var err error
+		roadmapID, err = activeRoadmapID(ctx, c.TrackService)
+		if err != nil {
+			return err
+		}
+	}
+	report, err := c.ACService.ACCoverage(ctx, filters, roadmapID)
+	if err != nil {
+		return fmt.Errorf("failed to compute AC coverage: %w", err)
+	}
+
+	out := cmdCtx.GetStdout()
+	if c.json {
+		if err := writeACCoverageJSON(out, report); err != nil {
+			return err
+		}
+	} else {
+		c.writeReport(out, report)
+	}
+
+	if c.failUnder != nil && report.Coverage < *c.failUnder {
+		return fmt.Errorf("AC coverage %.1f%% is below %g%%", report.Coverage, *c.failUnder)
+	}
+	return nil
+}
+
+// writeReport prints the coverage report as text
+func (c *ACCoverageCommandAdapter) writeReport(out io.Writer, report *dto.ACCoverageDTO) {
+	fmt.Fprintf(out, "AC Coverage%s\n", c.filterSuffix())
+	if report.Tasks == 0 {
+		fmt.Fprintf(out, "No tasks found\n")
+		return
+	}
+	fmt.Fprintf(out, "Coverage: %.1f%% (%d/%d tasks have acceptance criteria)\n", report.Coverage, report.TasksWithACs, report.Tasks)
+
+	if len(report.WithoutACs) > 0 {
+		fmt.Fprintf(out, "\nTasks without acceptance criteria (%d):\n", len(report.WithoutACs))
+		for _, task := range report.WithoutACs {
+			fmt.Fprintf(out, "  %s [%s] %s (track %s)\n", task.ID, task.Status, task.Title, task.TrackID)
+		}
+	}
+	if len(report.DoneUnverified) > 0 {
+		fmt.Fprintf(out, "\nDone tasks with unverified acceptance criteria (%d):\n", len(report.DoneUnverified))
+		for _, task := range report.DoneUnverified {
+			fmt.Fprintf(out, "  %s %s - %d/%d unverified\n", task.ID, task.Title, task.Unverified, task.ACs)
+		}
+	}
+}
+
+// filterSuffix describes the active filters, e.g. " (Iteration 3) (Track: TM-track-1)"
+func (c *ACCoverageCommandAdapter) filterSuffix() string {
+	suffix := ""
+	if c.iterationNum != nil {
+		suffix += fmt.Sprintf(" (Iteration %d)", *c.iterationNum)
+	}
+	if c.trackID != "" {
+		suffix += fmt.Sprintf(" (Track: %s)", c.trackID)
+	}
+	return suffix
+}
+
+// acCoverageJSON is the --json representation of the AC coverage report
+type acCoverageJSON struct {
+	Tasks          int                  `json:"tasks"`
+	TasksWithACs   int                  `json:"tasks_with_acs"`
+	Coverage       float64              `json:"coverage"`
+	WithoutACs     []acCoverageTaskJSON `json:"without_acs"`
+	DoneUnverified []acCoverageTaskJSON `json:"done_unverified"`
+}
+
+type acCoverageTaskJSON struct {
+	ID         string `json:"id"`
+	TrackID    string `json:"track_id"`
+	Title      string `json:"title"`
+	Status     string `json:"status"`
+	ACs        int    `json:"acceptance_criteria"`
+	Unverified int    `json:"unverified"`
+}
+
+func writeACCoverageJSON(out io.Writer, report *dto.ACCoverageDTO) error {
+	result := acCoverageJSON{
+		Tasks:          report.Tasks,
+		TasksWithACs:   report.TasksWithACs,
+		Coverage:       report.Coverage,
+		WithoutACs:     []acCoverageTaskJSON{},
+		DoneUnverified: []acCoverageTaskJSON{},
+	}
+	for _, task := range report.WithoutACs {
+		result.WithoutACs = append(result.WithoutACs, acCoverageTaskJSON(task))
+	}
+	for _, task := range report.DoneUnverified {
+		result.DoneUnverified = append(result.DoneUnverified, acCoverageTaskJSON(task))
+	}
+
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(result)
+}
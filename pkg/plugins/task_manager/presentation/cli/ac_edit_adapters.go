@@ -0,0 +1,258 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application/dto"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// Section markers of the file opened by 'ac edit'
+const (
+	acEditDescriptionMarker  = "=== Description ==="
+	acEditInstructionsMarker = "=== Testing Instructions ==="
+)
+
+// formatACForEdit renders an AC's description and testing instructions in the
+// delimited format parsed by parseACEdit
+func formatACForEdit(ac *entities.AcceptanceCriteriaEntity) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Editing %s. Lines starting with '#' above the first section are ignored.\n", ac.ID)
+	fmt.Fprintf(&b, "# Keep both section markers. Clear the file to cancel.\n")
+	fmt.Fprintf(&b, "%s\n%s\n", acEditDescriptionMarker, ac.Description)
+	fmt.Fprintf(&b, "%s\n%s\n", acEditInstructionsMarker, ac.TestingInstructions)
+	return b.String()
+}
+
+// parseACEdit extracts the description and testing instructions from an edited file.
+// cancelled is true when the file holds nothing but comments and whitespace.
+func parseACEdit(content string) (description, instructions string, cancelled bool, err error) {
+	var header, descLines, instrLines []string
+	section := &header
+	for _, line := range strings.Split(content, "\n") {
+		switch strings.TrimSpace(line) {
+		case acEditDescriptionMarker:
+			section = &descLines
+			continue
+		case acEditInstructionsMarker:
+			section = &instrLines
+			continue
+		}
+		if section == &header && strings.HasPrefix(strings.TrimSpace(line), "#") {
+			continue
+		}
+		*section = append(*section, line)
+	}
+
+	description = strings.TrimSpace(strings.Join(descLines, "\n"))
+	instructions = strings.TrimSpace(strings.Join(instrLines, "\n"))
+	if description == "" && instructions == "" && strings.TrimSpace(strings.Join(header, "\n")) == "" {
+		return "", "", true, nil
+	}
+	if !strings.Contains(content, acEditDescriptionMarker) {
+		return "", "", false, fmt.Errorf("%w: missing %q section", pluginsdk.ErrInvalidArgument, acEditDescriptionMarker)
+	}
+	if description == "" {
+		return "", "", false, fmt.Errorf("%w: description cannot be empty", pluginsdk.ErrInvalidArgument)
+	}
+	return description, instructions, false, nil
+}
+
+// runEditor opens path in the given editor command, which may carry arguments
+// (e.g. "code --wait"), attached to the terminal
+func runEditor(editor, path string) error {
+	parts := strings.Fields(editor)
+	cmd := exec.Command(parts[0], append(parts[1:], path)...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+// ============================================================================
+// ACEditCommandAdapter - Adapts CLI to UpdateAC use case via $EDITOR
+// ============================================================================
+
+// ACEditCommandAdapter edits an AC's description and testing instructions in $EDITOR
+type ACEditCommandAdapter struct {
+	ACService *application.ACApplicationService
+
+	// CLI flags
+	project             string
+	description         string
+	testingInstructions string
+	setDescription      bool
+	setInstructions     bool
+}
+
+func (c *ACEditCommandAdapter) GetName() string {
+	return "ac edit"
+}
+
+func (c *ACEditCommandAdapter) GetDescription() string {
+	return "Edit an acceptance criterion in $EDITOR"
+}
+
+func (c *ACEditCommandAdapter) GetUsage() string {
+	return "dw task-manager ac edit <ac-id> [--description \"...\"] [--testing-instructions \"...\"]"
+}
+
+func (c *ACEditCommandAdapter) GetHelp() string {
+	return `Opens $EDITOR with the AC's description and testing instructions, then
+saves both fields when the editor exits. The file looks like:
+
+  === Description ===
+  User can log in with SSO
+  === Testing Instructions ===
+  1. Open /login
+  2. Click "Sign in with SSO"
+
+Saving an empty file, or quitting the editor with an error (e.g. :cq in vim),
+cancels the edit. The description must not be empty.
+
+Flags:
+  <ac-id>                      AC ID to edit (required)
+  --description "..."          Set the description without opening an editor
+  --testing-instructions "..." Set the testing instructions without opening an editor
+  --project <name>             Project name (optional)
+
+The flags are required when $EDITOR is not set.
+
+Examples:
+  EDITOR=vim dw task-manager ac edit DW-ac-1
+  dw task-manager ac edit DW-ac-1 --testing-instructions "$(cat steps.md)"`
+}
+
+func (c *ACEditCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	// Parse positional argument
+	if len(args) == 0 {
+		return fmt.Errorf("%w: <ac-id> is required", pluginsdk.ErrInvalidArgument)
+	}
+	acID := args[0]
+	args = args[1:]
+
+	// Parse flags
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--project":
+			if i+1 < len(args) {
+				c.project = args[i+1]
+				i++
+			}
+		case "--description":
+			if i+1 < len(args) {
+				c.description = args[i+1]
+				c.setDescription = true
+				i++
+			}
+		case "--testing-instructions":
+			if i+1 < len(args) {
+				c.testingInstructions = args[i+1]
+				c.setInstructions = true
+				i++
+			}
+		}
+	}
+
+	out := pluginsdk.InfoWriter(cmdCtx)
+	input := dto.UpdateACDTO{ID: acID}
+
+	if c.setDescription || c.setInstructions {
+		if c.setDescription {
+			if strings.TrimSpace(c.description) == "" {
+				return fmt.Errorf("%w: description cannot be empty", pluginsdk.ErrInvalidArgument)
+			}
+			input.Description = &c.description
+		}
+		if c.setInstructions {
+			input.TestingInstructions = &c.testingInstructions
+		}
+	} else {
+		editor := strings.TrimSpace(os.Getenv("EDITOR"))
+		if editor == "" {
+			return fmt.Errorf("%w: $EDITOR is not set; set it or pass --description/--testing-instructions", pluginsdk.ErrInvalidArgument)
+		}
+
+		ac, err := c.ACService.GetAC(ctx, acID)
+		if err != nil {
+			return fmt.Errorf("failed to get AC: %w", err)
+		}
+
+		description, instructions, cancelled, err := editAC(editor, ac)
+		if err != nil {
+			return err
+		}
+		if cancelled {
+			fmt.Fprintf(out, "Edit cancelled, %s unchanged\n", acID)
+			return nil
+		}
+		if description == ac.Description && instructions == ac.TestingInstructions {
+			fmt.Fprintf(out, "No changes to %s\n", acID)
+			return nil
+		}
+		input.Description = &description
+		input.TestingInstructions = &instructions
+	}
+
+	ac, err := c.ACService.UpdateAC(ctx, input)
+	if err != nil {
+		return fmt.Errorf("failed to update AC: %w", err)
+	}
+
+	fmt.Fprintf(out, "Acceptance criterion updated\n")
+	fmt.Fprintf(out, "  ID:          %s\n", ac.ID)
+	fmt.Fprintf(out, "  Description: %s\n", ac.Description)
+	if ac.TestingInstructions != "" {
+		fmt.Fprintf(out, "  Testing Instructions:\n%s\n", indentLines(ac.TestingInstructions, "    "))
+	}
+	return nil
+}
+
+// editAC opens ac in editor through a temporary file and parses the result
+func editAC(editor string, ac *entities.AcceptanceCriteriaEntity) (description, instructions string, cancelled bool, err error) {
+	file, err := os.CreateTemp("", "dw-ac-edit-*.md")
+	if err != nil {
+		return "", "", false, fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	path := file.Name()
+	defer os.Remove(path)
+
+	_, err = file.WriteString(formatACForEdit(ac))
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", "", false, fmt.Errorf("failed to write temporary file: %w", err)
+	}
+
+	if err := runEditor(editor, path); err != nil {
+		// Quitting the editor with an error (vim's :cq) aborts, like git commit
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return "", "", true, nil
+		}
+		return "", "", false, fmt.Errorf("failed to run editor %q: %w", editor, err)
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", "", false, fmt.Errorf("failed to read edited file: %w", err)
+	}
+	return parseACEdit(string(content))
+}
+
+// indentLines prefixes every line of text with indent
+func indentLines(text, indent string) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		lines[i] = indent + line
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,210 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application/dto"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+	"gopkg.in/yaml.v3"
+)
+
+// acImportEntry is one acceptance criterion in an 'ac import' file
+type acImportEntry struct {
+	Description         string `yaml:"description" json:"description"`
+	Type                string `yaml:"type" json:"type"`
+	TestingInstructions string `yaml:"testing_instructions" json:"testing_instructions"`
+	Command             string `yaml:"command" json:"command"`
+}
+
+// ============================================================================
+// ACImportCommandAdapter - Adapts CLI to ImportACs use case
+// ============================================================================
+
+// ACImportCommandAdapter adapts ac import CLI command to application use case
+type ACImportCommandAdapter struct {
+	ACService *application.ACApplicationService
+
+	// CLI flags
+	project string
+	file    string
+	format  string
+}
+
+func (c *ACImportCommandAdapter) GetName() string {
+	return "ac import"
+}
+
+func (c *ACImportCommandAdapter) GetDescription() string {
+	return "Import acceptance criteria for several tasks from a YAML or JSON file"
+}
+
+func (c *ACImportCommandAdapter) GetUsage() string {
+	return "dw task-manager ac import --file <file|-> [--format yaml|json]"
+}
+
+func (c *ACImportCommandAdapter) GetHelp() string {
+	return `Creates acceptance criteria in bulk from a file that maps task IDs to
+lists of criteria.
+
+File format (YAML):
+  DW-task-3:
+    - description: Migration adds the sessions table
+      type: automated
+      command: go test ./internal/infra/...
+    - description: Dashboard shows session count
+      testing_instructions: |
+        1. Run dw ui
+        2. Check the header
+
+Fields:
+  description            What must be verified (required)
+  type                   manual (default) or automated
+  testing_instructions   Step-by-step testing guidance (optional)
+  command                Command that verifies the criterion (optional,
+                         stored at the end of the testing instructions)
+
+The JSON format has the same shape: {"DW-task-3": [{"description": "..."}]}.
+
+Every entry and referenced task is validated before anything is created,
+and all criteria are inserted in one transaction. A malformed entry aborts
+the import with its location, e.g. "task DW-task-3, ac[2]: missing
+description" (entries are numbered from 0).
+
+Flags:
+  --file <file>        File to import, or - to read from stdin (required)
+  --format <format>    yaml or json (default: from the file extension, else yaml)
+  --project <name>     Project name (optional)
+
+Examples:
+  dw task-manager ac import --file acs.yaml
+  dw task-manager ac import --file acs.json
+  cat acs.json | dw task-manager ac import --file - --format json`
+}
+
+func (c *ACImportCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	// Parse flags
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--project":
+			if i+1 < len(args) {
+				c.project = args[i+1]
+				i++
+			}
+		case "--file":
+			if i+1 < len(args) {
+				c.file = args[i+1]
+				i++
+			}
+		case "--format":
+			if i+1 < len(args) {
+				c.format = args[i+1]
+				i++
+			}
+		}
+	}
+
+	// Validate required flags
+	if c.file == "" {
+		return fmt.Errorf("%w: --file is required (use - for stdin)", pluginsdk.ErrInvalidArgument)
+	}
+
+	format := c.format
+	if format == "" {
+		format = "yaml"
+		if strings.EqualFold(filepath.Ext(c.file), ".json") {
+			format = "json"
+		}
+	}
+	if format != "yaml" && format != "json" {
+		return fmt.Errorf("%w: invalid --format '%s' (must be yaml or json)", pluginsdk.ErrInvalidArgument, format)
+	}
+
+	var data []byte
+	var err error
+	if c.file == "-" {
+		data, err = io.ReadAll(cmdCtx.GetStdin())
+	} else {
+		data, err = os.ReadFile(c.file)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read AC file: %w", err)
+	}
+
+	input, err := parseACImportFile(data, format)
+	if err != nil {
+		return err
+	}
+
+	acs, err := c.ACService.ImportACs(ctx, input)
+	if err != nil {
+		return fmt.Errorf("failed to import acceptance criteria: %w", err)
+	}
+
+	// Per-task summary, in input order
+	created := make(map[string][]string)
+	for _, ac := range acs {
+		created[ac.TaskID] = append(created[ac.TaskID], ac.ID)
+	}
+
+	out := pluginsdk.InfoWriter(cmdCtx)
+	fmt.Fprintf(out, "Imported %d acceptance criteria for %d tasks\n", len(acs), len(input.Tasks))
+	tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	for _, task := range input.Tasks {
+		ids := created[task.TaskID]
+		fmt.Fprintf(tw, "  %s\t%d criteria\t%s\n", task.TaskID, len(ids), strings.Join(ids, ", "))
+	}
+	tw.Flush()
+
+	return nil
+}
+
+// parseACImportFile decodes an import file into a DTO with tasks sorted by ID.
+// Unknown fields are rejected so that misspelled keys are not silently dropped.
+func parseACImportFile(data []byte, format string) (dto.ImportACsDTO, error) {
+	var entries map[string][]acImportEntry
+	if format == "json" {
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&entries); err != nil {
+			return dto.ImportACsDTO{}, fmt.Errorf("%w: AC file is not valid JSON: %v", pluginsdk.ErrInvalidArgument, err)
+		}
+	} else {
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+		if err := decoder.Decode(&entries); err != nil && err != io.EOF {
+			return dto.ImportACsDTO{}, fmt.Errorf("%w: AC file is not valid YAML: %v", pluginsdk.ErrInvalidArgument, err)
+		}
+	}
+
+	taskIDs := make([]string, 0, len(entries))
+	for taskID := range entries {
+		taskIDs = append(taskIDs, taskID)
+	}
+	sort.Strings(taskIDs)
+
+	input := dto.ImportACsDTO{Tasks: make([]dto.ImportACTaskDTO, 0, len(taskIDs))}
+	for _, taskID := range taskIDs {
+		task := dto.ImportACTaskDTO{TaskID: taskID}
+		for _, entry := range entries[taskID] {
+			task.ACs = append(task.ACs, dto.ImportACItemDTO{
+				Description:         entry.Description,
+				VerificationType:    entry.Type,
+				TestingInstructions: entry.TestingInstructions,
+				Command:             entry.Command,
+			})
+		}
+		input.Tasks = append(input.Tasks, task)
+	}
+
+	return input, nil
+}
@@ -0,0 +1,145 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application/dto"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// ============================================================================
+// ACResetCommandAdapter - Adapts CLI to ResetACs use case
+// ============================================================================
+
+// ACResetCommandAdapter returns acceptance criteria to not_started, one at a time or in bulk
+type ACResetCommandAdapter struct {
+	ACService *application.ACApplicationService
+
+	// CLI flags
+	project   string
+	acID      string
+	taskID    string
+	iteration string
+	keepNotes bool
+	force     bool
+}
+
+func (c *ACResetCommandAdapter) GetName() string {
+	return "ac reset"
+}
+
+func (c *ACResetCommandAdapter) GetDescription() string {
+	return "Reset acceptance criteria back to not_started"
+}
+
+func (c *ACResetCommandAdapter) GetUsage() string {
+	return "dw task-manager ac reset <ac-id> | --task <task-id> --force | --iteration <number> --force [--keep-notes]"
+}
+
+func (c *ACResetCommandAdapter) GetHelp() string {
+	return `Resets acceptance criteria to not_started, e.g. after a regression or a
+dependency change invalidates earlier verifications.
+
+Select a single criterion by ID, or every criterion of a task or iteration.
+The bulk forms require --force. Criteria that are already not_started are
+skipped. Notes (verification details, failure feedback, skip reasons) are
+cleared unless --keep-notes is given.
+
+Each affected task gets a note recording which criteria were reset and
+their previous status (shown under Notes by 'task show').
+
+Flags:
+  <ac-id>                  AC ID to reset
+  --task <task-id>         Reset all ACs of a task (requires --force)
+  --iteration <number>     Reset all ACs of an iteration's tasks (requires --force)
+  --keep-notes             Keep existing notes
+  --force                  Required to confirm a bulk reset
+  --project <name>         Project name (optional)
+
+Examples:
+  dw task-manager ac reset DW-ac-1
+  dw task-manager ac reset --task DW-task-3 --force
+  dw task-manager ac reset --iteration 4 --force --keep-notes`
+}
+
+func (c *ACResetCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	// Parse optional positional argument
+	if len(args) > 0 && len(args[0]) > 0 && args[0][0] != '-' {
+		c.acID = args[0]
+		args = args[1:]
+	}
+
+	// Parse flags
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--project":
+			if i+1 < len(args) {
+				c.project = args[i+1]
+				i++
+			}
+		case "--task":
+			if i+1 < len(args) {
+				c.taskID = args[i+1]
+				i++
+			}
+		case "--iteration":
+			if i+1 < len(args) {
+				c.iteration = args[i+1]
+				i++
+			}
+		case "--keep-notes":
+			c.keepNotes = true
+		case "--force":
+			c.force = true
+		}
+	}
+
+	input := dto.ResetACsDTO{
+		ID:        c.acID,
+		TaskID:    c.taskID,
+		KeepNotes: c.keepNotes,
+	}
+	if c.iteration != "" {
+		num, err := strconv.Atoi(c.iteration)
+		if err != nil {
+			return fmt.Errorf("%w: invalid iteration number: %s", pluginsdk.ErrInvalidArgument, c.iteration)
+		}
+		input.IterationNum = &num
+	}
+
+	// Validate selection
+	selectors := 0
+	for _, set := range []bool{c.acID != "", c.taskID != "", c.iteration != ""} {
+		if set {
+			selectors++
+		}
+	}
+	if selectors != 1 {
+		return fmt.Errorf("%w: specify exactly one of <ac-id>, --task or --iteration", pluginsdk.ErrInvalidArgument)
+	}
+	if c.acID == "" && !c.force {
+		return fmt.Errorf("%w: --force flag is required to reset acceptance criteria in bulk", pluginsdk.ErrInvalidArgument)
+	}
+
+	// Execute via application service
+	reset, skipped, err := c.ACService.ResetACs(ctx, input)
+	if err != nil {
+		return fmt.Errorf("failed to reset acceptance criteria: %w", err)
+	}
+
+	// Format output
+	out := pluginsdk.InfoWriter(cmdCtx)
+	fmt.Fprintf(out, "Reset %d acceptance criteria to not_started", len(reset))
+	if skipped > 0 {
+		fmt.Fprintf(out, " (%d already not_started, skipped)", skipped)
+	}
+	fmt.Fprintln(out)
+	for _, ac := range reset {
+		fmt.Fprintf(out, "  %s  %s\n", ac.ID, ac.Description)
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// ============================================================================
+// ACTagCommandAdapter - Adapts CLI to AddACTag / RemoveACTag use cases
+// ============================================================================
+
+// ACTagCommandAdapter adapts ac tag/untag CLI commands to application use cases.
+// Remove selects between "untag" (true) and "tag" (false).
+type ACTagCommandAdapter struct {
+	ACService *application.ACApplicationService
+	Remove    bool
+
+	// CLI flags (parsed from args)
+	project string
+}
+
+func (c *ACTagCommandAdapter) verb() string {
+	if c.Remove {
+		return "untag"
+	}
+	return "tag"
+}
+
+func (c *ACTagCommandAdapter) GetName() string {
+	return "ac " + c.verb()
+}
+
+func (c *ACTagCommandAdapter) GetDescription() string {
+	if c.Remove {
+		return "Remove a tag from an acceptance criterion"
+	}
+	return "Tag an acceptance criterion"
+}
+
+func (c *ACTagCommandAdapter) GetUsage() string {
+	return fmt.Sprintf("dw task-manager ac %s <ac-id> <tag>", c.verb())
+}
+
+func (c *ACTagCommandAdapter) GetHelp() string {
+	return fmt.Sprintf(`%s.
+
+Tags are lowercase words separated by dashes. Known tags:
+  auto-on-complete   Verified by 'dw task-manager reconcile' once every task of the
+                     track is done; does not block marking its task done
+
+Flags:
+  --project <name>    Project name (optional, uses active project if not specified)
+
+Examples:
+  dw task-manager ac %s DW-ac-4 auto-on-complete`, c.GetDescription(), c.verb())
+}
+
+func (c *ACTagCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	// Parse flags and positional arguments
+	var positional []string
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--project":
+			if i+1 < len(args) {
+				c.project = args[i+1]
+				i++
+			}
+		default:
+			positional = append(positional, args[i])
+		}
+	}
+	if len(positional) != 2 {
+		return fmt.Errorf("usage: %s", c.GetUsage())
+	}
+	acID, tag := positional[0], positional[1]
+
+	if c.Remove {
+		if err := c.ACService.RemoveACTag(ctx, acID, tag); err != nil {
+			return err
+		}
+	} else {
+		normalized, err := c.ACService.AddACTag(ctx, acID, tag)
+		if err != nil {
+			return err
+		}
+		tag = normalized
+	}
+
+	tags, err := c.ACService.ListACTags(ctx, acID)
+	if err != nil {
+		return fmt.Errorf("failed to list AC tags: %w", err)
+	}
+
+	out := pluginsdk.InfoWriter(cmdCtx)
+	if c.Remove {
+		fmt.Fprintf(out, "Removed tag %s from acceptance criterion %s\n", tag, acID)
+	} else {
+		fmt.Fprintf(out, "Tagged acceptance criterion %s with %s\n", acID, tag)
+	}
+	if len(tags) == 0 {
+		fmt.Fprintf(out, "  Tags: (none)\n")
+	} else {
+		fmt.Fprintf(out, "  Tags: %s\n", strings.Join(tags, ", "))
+	}
+
+	return nil
+}
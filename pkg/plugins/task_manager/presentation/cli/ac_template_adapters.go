@@ -0,0 +1,396 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application/dto"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// ============================================================================
+// ACTemplateCreateCommandAdapter - Adapts CLI to CreateACTemplate use case
+// ============================================================================
+
+// ACTemplateCreateCommandAdapter adapts ac template create CLI command to application use case
+type ACTemplateCreateCommandAdapter struct {
+	ACService *application.ACApplicationService
+
+	// CLI flags
+	project string
+	name    string
+	items   []dto.ACTemplateItemDTO
+}
+
+func (c *ACTemplateCreateCommandAdapter) GetName() string {
+	return "ac template create"
+}
+
+func (c *ACTemplateCreateCommandAdapter) GetDescription() string {
+	return "Create a reusable acceptance criteria template"
+}
+
+func (c *ACTemplateCreateCommandAdapter) GetUsage() string {
+	return "dw task-manager ac template create <name> --ac <desc> [--type automated] [--ac <desc> ...]"
+}
+
+func (c *ACTemplateCreateCommandAdapter) GetHelp() string {
+	return `Creates a named set of acceptance criteria that can be applied to any task
+with 'dw task-manager ac apply-template'.
+
+Flags:
+  --ac <desc>          Acceptance criterion description (repeatable, at least one required)
+  --type <type>        Verification type of the preceding --ac: manual (default) or automated
+  --project <name>     Project name (optional)
+
+Examples:
+  # Definition-of-done template
+  dw task-manager ac template create dod \
+    --ac "Has unit tests" --type automated \
+    --ac "Docs updated" \
+    --ac "Reviewed by a teammate"
+
+Notes:
+  - Template names must be unique and contain no whitespace
+  - --type applies to the --ac that precedes it`
+}
+
+func (c *ACTemplateCreateCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	// Parse template name
+	if len(args) == 0 {
+		return fmt.Errorf("%w: template name is required", pluginsdk.ErrInvalidArgument)
+	}
+	c.name = args[0]
+	args = args[1:]
+
+	// Parse flags
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--project":
+			if i+1 < len(args) {
+				c.project = args[i+1]
+				i++
+			}
+		case "--ac":
+			if i+1 < len(args) {
+				c.items = append(c.items, dto.ACTemplateItemDTO{Description: args[i+1]})
+				i++
+			}
+		case "--type":
+			if i+1 < len(args) {
+				if len(c.items) == 0 {
+					return fmt.Errorf("--type must follow an --ac flag")
+				}
+				c.items[len(c.items)-1].VerificationType = args[i+1]
+				i++
+			}
+		}
+	}
+
+	// Validate required flags
+	if len(c.items) == 0 {
+		return fmt.Errorf("%w: at least one --ac is required", pluginsdk.ErrInvalidArgument)
+	}
+
+	template, err := c.ACService.CreateACTemplate(ctx, dto.CreateACTemplateDTO{
+		Name:  c.name,
+		Items: c.items,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create AC template: %w", err)
+	}
+
+	out := pluginsdk.InfoWriter(cmdCtx)
+	fmt.Fprintf(out, "AC template created successfully\n")
+	fmt.Fprintf(out, "  Name:     %s\n", template.Name)
+	fmt.Fprintf(out, "  Criteria: %d\n", len(template.Items))
+	for i, item := range template.Items {
+		fmt.Fprintf(out, "    %d. %s (%s)\n", i+1, item.Description, item.VerificationType)
+	}
+
+	return nil
+}
+
+// ============================================================================
+// ACTemplateListCommandAdapter - Adapts CLI to ListACTemplates use case
+// ============================================================================
+
+// ACTemplateListCommandAdapter adapts ac template list CLI command to application use case
+type ACTemplateListCommandAdapter struct {
+	ACService *application.ACApplicationService
+
+	// CLI flags
+	project string
+}
+
+func (c *ACTemplateListCommandAdapter) GetName() string {
+	return "ac template list"
+}
+
+func (c *ACTemplateListCommandAdapter) GetDescription() string {
+	return "List acceptance criteria templates"
+}
+
+func (c *ACTemplateListCommandAdapter) GetUsage() string {
+	return "dw task-manager ac template list"
+}
+
+func (c *ACTemplateListCommandAdapter) GetHelp() string {
+	return `Lists all acceptance criteria templates with the number of criteria each contains.
+
+Flags:
+  --project <name>     Project name (optional)
+
+Examples:
+  dw task-manager ac template list`
+}
+
+func (c *ACTemplateListCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	// Parse flags
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--project":
+			if i+1 < len(args) {
+				c.project = args[i+1]
+				i++
+			}
+		}
+	}
+
+	templates, err := c.ACService.ListACTemplates(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list AC templates: %w", err)
+	}
+
+	out := cmdCtx.GetStdout()
+	if len(templates) == 0 {
+		fmt.Fprintf(out, "No AC templates found.\n")
+		fmt.Fprintf(out, "Run 'dw task-manager ac template create <name> --ac <desc>' to create one.\n")
+		return nil
+	}
+
+	fmt.Fprintf(out, "AC Templates:\n")
+	for _, template := range templates {
+		fmt.Fprintf(out, "  %-20s %d criteria\n", template.Name, len(template.Items))
+	}
+
+	return nil
+}
+
+// ============================================================================
+// ACTemplateShowCommandAdapter - Adapts CLI to GetACTemplate use case
+// ============================================================================
+
+// ACTemplateShowCommandAdapter adapts ac template show CLI command to application use case
+type ACTemplateShowCommandAdapter struct {
+	ACService *application.ACApplicationService
+
+	// CLI flags
+	project string
+	name    string
+}
+
+func (c *ACTemplateShowCommandAdapter) GetName() string {
+	return "ac template show"
+}
+
+func (c *ACTemplateShowCommandAdapter) GetDescription() string {
+	return "Show the criteria of an acceptance criteria template"
+}
+
+func (c *ACTemplateShowCommandAdapter) GetUsage() string {
+	return "dw task-manager ac template show <name>"
+}
+
+func (c *ACTemplateShowCommandAdapter) GetHelp() string {
+	return `Shows all acceptance criteria contained in a template.
+
+Flags:
+  --project <name>     Project name (optional)
+
+Examples:
+  dw task-manager ac template show dod`
+}
+
+func (c *ACTemplateShowCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	// Parse template name
+	if len(args) == 0 {
+		return fmt.Errorf("%w: template name is required", pluginsdk.ErrInvalidArgument)
+	}
+	c.name = args[0]
+	args = args[1:]
+
+	// Parse flags
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--project":
+			if i+1 < len(args) {
+				c.project = args[i+1]
+				i++
+			}
+		}
+	}
+
+	template, err := c.ACService.GetACTemplate(ctx, c.name)
+	if err != nil {
+		return fmt.Errorf("failed to get AC template: %w", err)
+	}
+
+	out := cmdCtx.GetStdout()
+	fmt.Fprintf(out, "AC Template: %s\n", template.Name)
+	fmt.Fprintf(out, "  Criteria: %d\n", len(template.Items))
+	for i, item := range template.Items {
+		fmt.Fprintf(out, "    %d. %s (%s)\n", i+1, item.Description, item.VerificationType)
+	}
+
+	return nil
+}
+
+// ============================================================================
+// ACTemplateDeleteCommandAdapter - Adapts CLI to DeleteACTemplate use case
+// ============================================================================
+
+// ACTemplateDeleteCommandAdapter adapts ac template delete CLI command to application use case
+type ACTemplateDeleteCommandAdapter struct {
+	ACService *application.ACApplicationService
+
+	// CLI flags
+	project string
+	name    string
+}
+
+func (c *ACTemplateDeleteCommandAdapter) GetName() string {
+	return "ac template delete"
+}
+
+func (c *ACTemplateDeleteCommandAdapter) GetDescription() string {
+	return "Delete an acceptance criteria template"
+}
+
+func (c *ACTemplateDeleteCommandAdapter) GetUsage() string {
+	return "dw task-manager ac template delete <name>"
+}
+
+func (c *ACTemplateDeleteCommandAdapter) GetHelp() string {
+	return `Deletes an acceptance criteria template.
+
+Acceptance criteria already created from the template are not affected.
+
+Flags:
+  --project <name>     Project name (optional)
+
+Examples:
+  dw task-manager ac template delete dod`
+}
+
+func (c *ACTemplateDeleteCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	// Parse template name
+	if len(args) == 0 {
+		return fmt.Errorf("%w: template name is required", pluginsdk.ErrInvalidArgument)
+	}
+	c.name = args[0]
+	args = args[1:]
+
+	// Parse flags
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--project":
+			if i+1 < len(args) {
+				c.project = args[i+1]
+				i++
+			}
+		}
+	}
+
+	if err := c.ACService.DeleteACTemplate(ctx, c.name); err != nil {
+		return fmt.Errorf("failed to delete AC template: %w", err)
+	}
+
+	out := pluginsdk.InfoWriter(cmdCtx)
+	fmt.Fprintf(out, "AC template %s deleted successfully\n", c.name)
+
+	return nil
+}
+
+// ============================================================================
+// ACApplyTemplateCommandAdapter - Adapts CLI to ApplyACTemplate use case
+// ============================================================================
+
+// ACApplyTemplateCommandAdapter adapts ac apply-template CLI command to application use case
+type ACApplyTemplateCommandAdapter struct {
+	ACService *application.ACApplicationService
+
+	// CLI flags
+	project string
+	name    string
+	taskID  string
+}
+
+func (c *ACApplyTemplateCommandAdapter) GetName() string {
+	return "ac apply-template"
+}
+
+func (c *ACApplyTemplateCommandAdapter) GetDescription() string {
+	return "Add all criteria of a template to a task"
+}
+
+func (c *ACApplyTemplateCommandAdapter) GetUsage() string {
+	return "dw task-manager ac apply-template <name> --task <task-id>"
+}
+
+func (c *ACApplyTemplateCommandAdapter) GetHelp() string {
+	return `Creates every acceptance criterion of a template on a task.
+
+Each criterion gets a newly generated AC ID and starts as not started.
+
+Flags:
+  --task <task-id>     Task to add the criteria to (required)
+  --project <name>     Project name (optional)
+
+Examples:
+  dw task-manager ac apply-template dod --task DW-task-42`
+}
+
+func (c *ACApplyTemplateCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	// Parse template name
+	if len(args) == 0 {
+		return fmt.Errorf("%w: template name is required", pluginsdk.ErrInvalidArgument)
+	}
+	c.name = args[0]
+	args = args[1:]
+
+	// Parse flags
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--project":
+			if i+1 < len(args) {
+				c.project = args[i+1]
+				i++
+			}
+		case "--task":
+			if i+1 < len(args) {
+				c.taskID = args[i+1]
+				i++
+			}
+		}
+	}
+
+	// Validate required flags
+	if c.taskID == "" {
+		return fmt.Errorf("%w: --task is required", pluginsdk.ErrInvalidArgument)
+	}
+
+	acs, err := c.ACService.ApplyACTemplate(ctx, c.name, c.taskID)
+	if err != nil {
+		return fmt.Errorf("failed to apply AC template: %w", err)
+	}
+
+	out := pluginsdk.InfoWriter(cmdCtx)
+	fmt.Fprintf(out, "Applied template %s to task %s (%d criteria)\n", c.name, c.taskID, len(acs))
+	for _, ac := range acs {
+		fmt.Fprintf(out, "  %s  %s (%s)\n", ac.ID, ac.Description, ac.VerificationType)
+	}
+
+	return nil
+}
@@ -0,0 +1,213 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// defaultWhyFailedTop is how many failure reasons why-failed shows without --top
+const defaultWhyFailedTop = 10
+
+// ============================================================================
+// ACWhyFailedCommandAdapter - Aggregates failure reasons of failed ACs
+// ============================================================================
+
+// ACWhyFailedCommandAdapter groups failed acceptance criteria by their failure reason
+type ACWhyFailedCommandAdapter struct {
+	ACService *application.ACApplicationService
+
+	// CLI flags
+	project      string
+	iterationNum *int
+	trackID      string
+	taskID       string
+	fuzzy        bool
+	top          int
+	json         bool
+}
+
+func (c *ACWhyFailedCommandAdapter) GetName() string {
+	return "ac why-failed"
+}
+
+func (c *ACWhyFailedCommandAdapter) GetDescription() string {
+	return "Aggregate failure reasons of failed acceptance criteria"
+}
+
+func (c *ACWhyFailedCommandAdapter) GetUsage() string {
+	return "dw task-manager ac why-failed [--iteration <num>] [--track <id>] [--task <id>] [--fuzzy] [--top <n>] [--json]"
+}
+
+func (c *ACWhyFailedCommandAdapter) GetHelp() string {
+	return `Groups failed acceptance criteria by the failure reason recorded in their
+notes and counts occurrences, so recurring failure themes stand out.
+Reasons are listed most frequent first, each with the ACs exhibiting it.
+
+Reasons are grouped when they are identical, ignoring case, surrounding
+whitespace and trailing punctuation. With --fuzzy, reasons sharing most of
+their words are grouped too (e.g. "Safari layout broken" and
+"Layout broken in Safari").
+
+Flags:
+  --iteration <num>  Only failed ACs in this iteration (optional)
+  --track <id>       Only failed ACs of this track (optional)
+  --task <id>        Only failed ACs of this task (optional)
+  --fuzzy            Also group near-duplicate reasons
+  --top <n>          Number of reasons to show (default: 10, 0 for all)
+  --json             Output as JSON
+  --project <name>   Use specific project (optional)
+
+Examples:
+  # Top failure reasons across the project
+  dw task-manager ac why-failed
+
+  # Group near-duplicates in iteration 3
+  dw task-manager ac why-failed --iteration 3 --fuzzy
+
+  # All reasons for a track, as JSON
+  dw task-manager ac why-failed --track TM-track-core --top 0 --json
+
+Notes:
+  - Failed ACs without notes are grouped under "(no reason recorded)"
+  - See 'ac failed' for the full list of failed ACs`
+}
+
+func (c *ACWhyFailedCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	// Parse arguments
+	c.top = defaultWhyFailedTop
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--project":
+			if i+1 < len(args) {
+				c.project = args[i+1]
+				i++
+			}
+		case "--iteration":
+			if i+1 < len(args) {
+				iterNum, err := strconv.Atoi(args[i+1])
+				if err != nil {
+					return fmt.Errorf("%w: invalid iteration number: %s", pluginsdk.ErrInvalidArgument, args[i+1])
+				}
+				c.iterationNum = &iterNum
+				i++
+			}
+		case "--track":
+			if i+1 < len(args) {
+				c.trackID = args[i+1]
+				i++
+			}
+		case "--task":
+			if i+1 < len(args) {
+				c.taskID = args[i+1]
+				i++
+			}
+		case "--fuzzy":
+			c.fuzzy = true
+		case "--top":
+			if i+1 >= len(args) {
+				return fmt.Errorf("%w: --top requires a value", pluginsdk.ErrInvalidArgument)
+			}
+			n, err := strconv.Atoi(args[i+1])
+			if err != nil || n < 0 {
+				return fmt.Errorf("%w: --top must be a non-negative number, got '%s'", pluginsdk.ErrInvalidArgument, args[i+1])
+			}
+			c.top = n
+			i++
+		case "--json":
+			c.json = true
+		}
+	}
+
+	filters := entities.ACFilters{
+		IterationNum: c.iterationNum,
+		TrackID:      c.trackID,
+		TaskID:       c.taskID,
+	}
+	groups, err := c.ACService.FailureReasons(ctx, filters, c.fuzzy)
+	if err != nil {
+		return fmt.Errorf("failed to aggregate failure reasons: %w", err)
+	}
+
+	total := 0
+	for _, group := range groups {
+		total += group.Count
+	}
+	shown := groups
+	if c.top > 0 && len(shown) > c.top {
+		shown = shown[:c.top]
+	}
+
+	out := cmdCtx.GetStdout()
+	if c.json {
+		return writeWhyFailedJSON(out, total, len(groups), c.fuzzy, shown)
+	}
+
+	if total == 0 {
+		fmt.Fprintf(out, "No failed acceptance criteria found%s\n", c.filterSuffix())
+		return nil
+	}
+
+	fmt.Fprintf(out, "Failure Reasons%s\n", c.filterSuffix())
+	fmt.Fprintf(out, "%d failed ACs, %d distinct reasons\n\n", total, len(groups))
+	for _, group := range shown {
+		reason := group.Reason
+		if reason == "" {
+			reason = "(no reason recorded)"
+		}
+		fmt.Fprintf(out, "%3d× %s\n", group.Count, reason)
+		for _, ac := range group.ACs {
+			fmt.Fprintf(out, "     ✗ [%s] Task: %s - %s\n", ac.ID, ac.TaskID, ac.Description)
+		}
+		fmt.Fprintf(out, "\n")
+	}
+	if hidden := len(groups) - len(shown); hidden > 0 {
+		fmt.Fprintf(out, "... and %d more reasons (use --top 0 to show all)\n", hidden)
+	}
+	return nil
+}
+
+// filterSuffix describes the active filters, e.g. " (Iteration 3) (Track: TM-track-1)"
+func (c *ACWhyFailedCommandAdapter) filterSuffix() string {
+	suffix := ""
+	if c.iterationNum != nil {
+		suffix += fmt.Sprintf(" (Iteration %d)", *c.iterationNum)
+	}
+	if c.trackID != "" {
+		suffix += fmt.Sprintf(" (Track: %s)", c.trackID)
+	}
+	if c.taskID != "" {
+		suffix += fmt.Sprintf(" (Task: %s)", c.taskID)
+	}
+	return suffix
+}
+
+// whyFailedJSON is the --json representation of the failure reasons report
+type whyFailedJSON struct {
+	TotalFailed     int                           `json:"total_failed"`
+	DistinctReasons int                           `json:"distinct_reasons"`
+	Fuzzy           bool                          `json:"fuzzy"`
+	Reasons         []entities.FailureReasonGroup `json:"reasons"`
+}
+
+func writeWhyFailedJSON(out io.Writer, total, distinct int, fuzzy bool, groups []entities.FailureReasonGroup) error {
+	report := whyFailedJSON{
+		TotalFailed:     total,
+		DistinctReasons: distinct,
+		Fuzzy:           fuzzy,
+		Reasons:         groups,
+	}
+	if report.Reasons == nil {
+		report.Reasons = []entities.FailureReasonGroup{}
+	}
+
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(report)
+}
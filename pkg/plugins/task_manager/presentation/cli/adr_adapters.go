@@ -6,6 +6,7 @@ import (
 
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application/dto"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
 	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
 )
 
@@ -53,7 +54,7 @@ Flags:
 func (c *ADRCreateCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
 	// Parse track ID
 	if len(args) == 0 {
-		return fmt.Errorf("track ID is required")
+		return fmt.Errorf("%w: track ID is required", pluginsdk.ErrInvalidArgument)
 	}
 	c.trackID = args[0]
 	args = args[1:]
@@ -96,16 +97,16 @@ func (c *ADRCreateCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.
 
 	// Validate required flags
 	if c.title == "" {
-		return fmt.Errorf("--title is required")
+		return fmt.Errorf("%w: --title is required", pluginsdk.ErrInvalidArgument)
 	}
 	if c.context == "" {
-		return fmt.Errorf("--context is required")
+		return fmt.Errorf("%w: --context is required", pluginsdk.ErrInvalidArgument)
 	}
 	if c.decision == "" {
-		return fmt.Errorf("--decision is required")
+		return fmt.Errorf("%w: --decision is required", pluginsdk.ErrInvalidArgument)
 	}
 	if c.consequences == "" {
-		return fmt.Errorf("--consequences is required")
+		return fmt.Errorf("%w: --consequences is required", pluginsdk.ErrInvalidArgument)
 	}
 
 
@@ -182,7 +183,7 @@ Flags:
 func (c *ADRUpdateCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
 	// Parse ADR ID
 	if len(args) == 0 {
-		return fmt.Errorf("ADR ID is required")
+		return fmt.Errorf("%w: ADR ID is required", pluginsdk.ErrInvalidArgument)
 	}
 	c.adrID = args[0]
 	args = args[1:]
@@ -277,6 +278,7 @@ type ADRListCommandAdapter struct {
 	// CLI flags
 	project string
 	trackID string
+	taskID  string
 }
 
 func (c *ADRListCommandAdapter) GetName() string {
@@ -288,14 +290,15 @@ func (c *ADRListCommandAdapter) GetDescription() string {
 }
 
 func (c *ADRListCommandAdapter) GetUsage() string {
-	return "dw task-manager adr list [--track <track-id>]"
+	return "dw task-manager adr list [--track <track-id>] [--task <task-id>]"
 }
 
 func (c *ADRListCommandAdapter) GetHelp() string {
-	return `Lists all ADRs or ADRs for a specific track.
+	return `Lists all ADRs, the ADRs of a track, or the ADRs linked to a task.
 
 Flags:
   --track <track-id>    Filter by track ID (optional)
+  --task <task-id>      Only ADRs linked to the task (optional, see 'adr link-task')
   --project <name>      Project name (optional)
 
 Examples:
@@ -303,7 +306,10 @@ Examples:
   dw task-manager adr list
 
   # List ADRs for a track
-  dw task-manager adr list --track TM-track-1`
+  dw task-manager adr list --track TM-track-1
+
+  # List ADRs implemented by a task
+  dw task-manager adr list --task TM-task-7`
 }
 
 func (c *ADRListCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
@@ -320,15 +326,29 @@ func (c *ADRListCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.Co
 				c.trackID = args[i+1]
 				i++
 			}
+		case "--task":
+			if i+1 < len(args) {
+				c.taskID = args[i+1]
+				i++
+			}
 		}
 	}
 
 	// List ADRs via application service
-	var trackIDPtr *string
-	if c.trackID != "" {
-		trackIDPtr = &c.trackID
+	var adrs []*entities.ADREntity
+	var err error
+	if c.taskID != "" {
+		adrs, err = c.ADRService.ListTaskADRs(ctx, c.taskID)
+		if err == nil && c.trackID != "" {
+			adrs = filterADRsByTrack(adrs, c.trackID)
+		}
+	} else {
+		var trackIDPtr *string
+		if c.trackID != "" {
+			trackIDPtr = &c.trackID
+		}
+		adrs, err = c.ADRService.ListADRs(ctx, trackIDPtr)
 	}
-	adrs, err := c.ADRService.ListADRs(ctx, trackIDPtr)
 	if err != nil {
 		return fmt.Errorf("failed to list ADRs: %w", err)
 	}
@@ -357,6 +377,17 @@ func (c *ADRListCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.Co
 	return nil
 }
 
+// filterADRsByTrack keeps the ADRs belonging to a track
+func filterADRsByTrack(adrs []*entities.ADREntity, trackID string) []*entities.ADREntity {
+	filtered := []*entities.ADREntity{}
+	for _, adr := range adrs {
+		if adr.TrackID == trackID {
+			filtered = append(filtered, adr)
+		}
+	}
+	return filtered
+}
+
 // ============================================================================
 // ADRShowCommandAdapter - Shows detailed ADR information
 // ============================================================================
@@ -395,7 +426,7 @@ Examples:
 func (c *ADRShowCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
 	// Parse ADR ID
 	if len(args) == 0 {
-		return fmt.Errorf("ADR ID is required")
+		return fmt.Errorf("%w: ADR ID is required", pluginsdk.ErrInvalidArgument)
 	}
 	c.adrID = args[0]
 	args = args[1:]
@@ -447,6 +478,18 @@ func (c *ADRShowCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.Co
 		fmt.Fprintf(out, "%s\n", adr.Alternatives)
 	}
 
+	tasks, err := c.ADRService.ListADRTasks(ctx, adr.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get ADR tasks: %w", err)
+	}
+	if len(tasks) > 0 {
+		fmt.Fprintf(out, "\nImplemented by tasks:\n")
+		fmt.Fprintf(out, "---------------------\n")
+		for _, task := range tasks {
+			fmt.Fprintf(out, "%s [%s] %s\n", task.ID, task.Status, task.Title)
+		}
+	}
+
 	fmt.Fprintf(out, "\nTimestamps:\n")
 	fmt.Fprintf(out, "-----------\n")
 	fmt.Fprintf(out, "Created: %s\n", adr.CreatedAt.Format("2006-01-02 15:04:05"))
@@ -497,7 +540,7 @@ Examples:
 func (c *ADRSupersedeCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
 	// Parse ADR ID
 	if len(args) == 0 {
-		return fmt.Errorf("ADR ID is required")
+		return fmt.Errorf("%w: ADR ID is required", pluginsdk.ErrInvalidArgument)
 	}
 	c.adrID = args[0]
 	args = args[1:]
@@ -520,7 +563,7 @@ func (c *ADRSupersedeCommandAdapter) Execute(ctx context.Context, cmdCtx plugins
 
 	// Validate required flag
 	if c.supersededByID == "" {
-		return fmt.Errorf("--superseded-by is required")
+		return fmt.Errorf("%w: --superseded-by is required", pluginsdk.ErrInvalidArgument)
 	}
 
 	// Execute via application service
@@ -577,7 +620,7 @@ Examples:
 func (c *ADRDeprecateCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
 	// Parse ADR ID
 	if len(args) == 0 {
-		return fmt.Errorf("ADR ID is required")
+		return fmt.Errorf("%w: ADR ID is required", pluginsdk.ErrInvalidArgument)
 	}
 	c.adrID = args[0]
 	args = args[1:]
@@ -605,3 +648,76 @@ func (c *ADRDeprecateCommandAdapter) Execute(ctx context.Context, cmdCtx plugins
 
 	return nil
 }
+
+// ============================================================================
+// ADRLinkTaskCommandAdapter - Links an ADR to a task implementing it
+// ============================================================================
+
+type ADRLinkTaskCommandAdapter struct {
+	ADRService *application.ADRApplicationService
+
+	// CLI flags
+	project string
+	adrID   string
+	taskID  string
+}
+
+func (c *ADRLinkTaskCommandAdapter) GetName() string {
+	return "adr link-task"
+}
+
+func (c *ADRLinkTaskCommandAdapter) GetDescription() string {
+	return "Link an ADR to a task that implements it"
+}
+
+func (c *ADRLinkTaskCommandAdapter) GetUsage() string {
+	return "dw task-manager adr link-task <adr-id> <task-id>"
+}
+
+func (c *ADRLinkTaskCommandAdapter) GetHelp() string {
+	return `Records that a task implements an Architecture Decision Record.
+
+The link is shown in both directions: 'adr show' lists the tasks implementing
+the ADR and 'task show' lists the ADRs relevant to the task. The ADR keeps its
+track. Linking the same task twice is an error.
+
+Flags:
+  --project <name>    Project name (optional)
+
+Examples:
+  # Link TM-adr-2 to the task implementing it
+  dw task-manager adr link-task TM-adr-2 TM-task-14`
+}
+
+func (c *ADRLinkTaskCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	// Parse ADR and task IDs
+	if len(args) < 2 {
+		return fmt.Errorf("%w: ADR ID and task ID are required", pluginsdk.ErrInvalidArgument)
+	}
+	c.adrID = args[0]
+	c.taskID = args[1]
+	args = args[2:]
+
+	// Parse flags
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--project":
+			if i+1 < len(args) {
+				c.project = args[i+1]
+				i++
+			}
+		}
+	}
+
+	// Execute via application service
+	if err := c.ADRService.LinkTask(ctx, c.adrID, c.taskID); err != nil {
+		return fmt.Errorf("failed to link task: %w", err)
+	}
+
+	// Format output
+	out := cmdCtx.GetStdout()
+	fmt.Fprintf(out, "Task linked to ADR successfully\n")
+	fmt.Fprintf(out, "  %s is implemented by %s\n", c.adrID, c.taskID)
+
+	return nil
+}
@@ -0,0 +1,236 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application/dto"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// dependencyStatusColors maps track status to node fill color
+var dependencyStatusColors = map[string]string{
+	"not-started": "#e0e0e0",
+	"in-progress": "#fff3b0",
+	"complete":    "#c8e6c9",
+	"blocked":     "#ffcdd2",
+	"waiting":     "#bbdefb",
+}
+
+// dependencyCycleColor is used for nodes and edges that are part of a cycle
+const dependencyCycleColor = "red"
+
+// ============================================================================
+// DependenciesGraphCommandAdapter - Exports the track dependency graph
+// ============================================================================
+
+// DependenciesGraphCommandAdapter exports the track dependency graph as Graphviz DOT or Mermaid
+type DependenciesGraphCommandAdapter struct {
+	TrackService *application.TrackApplicationService
+
+	// CLI flags
+	project         string
+	format          string
+	output          string
+	highlightCycles bool
+}
+
+func (c *DependenciesGraphCommandAdapter) GetName() string {
+	return "dependencies graph"
+}
+
+func (c *DependenciesGraphCommandAdapter) GetDescription() string {
+	return "Export the track dependency graph as DOT or Mermaid"
+}
+
+func (c *DependenciesGraphCommandAdapter) GetUsage() string {
+	return "dw task-manager dependencies graph [--format dot|mermaid] [--output <file>] [--highlight-cycles]"
+}
+
+func (c *DependenciesGraphCommandAdapter) GetHelp() string {
+	return `Exports the dependency graph of all tracks in the roadmap.
+
+Nodes are labeled with track ID, title and status and colored by status.
+Edges point from a track to the track it depends on. Tracks without
+dependencies are included so the graph is complete.
+
+Flags:
+  --format <format>     Output format (default: dot)
+                        Values: dot, mermaid
+  --output <file>       Write the graph to a file instead of stdout
+  --highlight-cycles    Draw tracks and dependencies that form a cycle in red
+  --project <name>      Project name (optional)
+
+Examples:
+  # Render with Graphviz
+  dw task-manager dependencies graph | dot -Tsvg > roadmap.svg
+
+  # Mermaid diagram for docs
+  dw task-manager dependencies graph --format mermaid --output docs/roadmap.mmd
+
+Notes:
+  - Status colors: not-started (gray), in-progress (yellow), complete (green),
+    blocked (light red), waiting (blue)
+  - Mermaid output has no code fence; wrap it in a mermaid block to embed it in Markdown`
+}
+
+func (c *DependenciesGraphCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	// Parse flags
+	c.format = "dot"
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--project":
+			if i+1 < len(args) {
+				c.project = args[i+1]
+				i++
+			}
+		case "--format":
+			if i+1 < len(args) {
+				c.format = args[i+1]
+				i++
+			}
+		case "--output":
+			if i+1 < len(args) {
+				c.output = args[i+1]
+				i++
+			}
+		case "--highlight-cycles":
+			c.highlightCycles = true
+		}
+	}
+
+	if c.format != "dot" && c.format != "mermaid" {
+		return fmt.Errorf("invalid format '%s'. Valid formats: dot, mermaid", c.format)
+	}
+
+	graph, err := c.TrackService.GetDependencyGraph(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to build dependency graph: %w", err)
+	}
+
+	var sb strings.Builder
+	if c.format == "mermaid" {
+		writeDependencyGraphMermaid(&sb, graph, c.highlightCycles)
+	} else {
+		writeDependencyGraphDOT(&sb, graph, c.highlightCycles)
+	}
+
+	out := cmdCtx.GetStdout()
+	if c.output == "" {
+		fmt.Fprint(out, sb.String())
+		return nil
+	}
+
+	dir := filepath.Dir(c.output)
+	if dir != "" && dir != "." {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("failed to create output directory: %w", err)
+		}
+	}
+	if err := os.WriteFile(c.output, []byte(sb.String()), 0644); err != nil {
+		return fmt.Errorf("failed to write dependency graph: %w", err)
+	}
+
+	fmt.Fprintf(out, "Dependency graph saved to: %s (%d tracks, %d dependencies)\n", c.output, len(graph.Nodes), len(graph.Edges))
+	if len(graph.Cycles) > 0 {
+		fmt.Fprintf(out, "Warning: %d dependency cycle(s) detected\n", len(graph.Cycles))
+	}
+
+	return nil
+}
+
+// writeDependencyGraphDOT renders the graph in Graphviz DOT format
+func writeDependencyGraphDOT(w io.Writer, graph *dto.DependencyGraphDTO, highlightCycles bool) {
+	fmt.Fprintln(w, "digraph dependencies {")
+	fmt.Fprintln(w, "  rankdir=LR;")
+	fmt.Fprintln(w, `  node [shape=box, style="rounded,filled", fontname="Helvetica"];`)
+
+	for _, node := range graph.Nodes {
+		label := dotEscape(node.ID) + `\n` + dotEscape(node.Title) + `\n(` + dotEscape(node.Status) + `)`
+		attrs := fmt.Sprintf(`label="%s", fillcolor="%s"`, label, dependencyStatusColor(node.Status))
+		if highlightCycles && node.InCycle {
+			attrs += fmt.Sprintf(`, color="%s", penwidth=2`, dependencyCycleColor)
+		}
+		fmt.Fprintf(w, "  \"%s\" [%s];\n", dotEscape(node.ID), attrs)
+	}
+
+	for _, edge := range graph.Edges {
+		attrs := ""
+		if highlightCycles && edge.InCycle {
+			attrs = fmt.Sprintf(` [color="%s", penwidth=2]`, dependencyCycleColor)
+		}
+		fmt.Fprintf(w, "  \"%s\" -> \"%s\"%s;\n", dotEscape(edge.From), dotEscape(edge.To), attrs)
+	}
+
+	fmt.Fprintln(w, "}")
+}
+
+// writeDependencyGraphMermaid renders the graph as a Mermaid flowchart
+func writeDependencyGraphMermaid(w io.Writer, graph *dto.DependencyGraphDTO, highlightCycles bool) {
+	fmt.Fprintln(w, "graph LR")
+
+	// Mermaid node IDs must be simple identifiers, so track IDs are mapped to n0, n1, ...
+	nodeIDs := make(map[string]string, len(graph.Nodes))
+	statuses := make(map[string][]string)
+	var cycleNodes []string
+	for i, node := range graph.Nodes {
+		id := fmt.Sprintf("n%d", i)
+		nodeIDs[node.ID] = id
+		label := mermaidEscape(node.ID) + "<br/>" + mermaidEscape(node.Title) + "<br/>(" + mermaidEscape(node.Status) + ")"
+		fmt.Fprintf(w, "  %s[\"%s\"]\n", id, label)
+		statuses[node.Status] = append(statuses[node.Status], id)
+		if node.InCycle {
+			cycleNodes = append(cycleNodes, id)
+		}
+	}
+
+	var cycleEdges []string
+	for i, edge := range graph.Edges {
+		fmt.Fprintf(w, "  %s --> %s\n", nodeIDs[edge.From], nodeIDs[edge.To])
+		if edge.InCycle {
+			cycleEdges = append(cycleEdges, fmt.Sprintf("%d", i))
+		}
+	}
+
+	// Status classes, in a fixed order for stable output
+	for _, status := range []string{"not-started", "in-progress", "complete", "blocked", "waiting"} {
+		ids := statuses[status]
+		if len(ids) == 0 {
+			continue
+		}
+		class := strings.ReplaceAll(status, "-", "")
+		fmt.Fprintf(w, "  classDef %s fill:%s,stroke:#555\n", class, dependencyStatusColor(status))
+		fmt.Fprintf(w, "  class %s %s\n", strings.Join(ids, ","), class)
+	}
+
+	if highlightCycles && len(cycleNodes) > 0 {
+		fmt.Fprintf(w, "  classDef cycle stroke:%s,stroke-width:3px\n", dependencyCycleColor)
+		fmt.Fprintf(w, "  class %s cycle\n", strings.Join(cycleNodes, ","))
+		if len(cycleEdges) > 0 {
+			fmt.Fprintf(w, "  linkStyle %s stroke:%s,stroke-width:2px\n", strings.Join(cycleEdges, ","), dependencyCycleColor)
+		}
+	}
+}
+
+// dependencyStatusColor returns the fill color for a track status
+func dependencyStatusColor(status string) string {
+	if color, ok := dependencyStatusColors[status]; ok {
+		return color
+	}
+	return "#ffffff"
+}
+
+// dotEscape escapes a value for use inside a double-quoted DOT string
+func dotEscape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", " ").Replace(s)
+}
+
+// mermaidEscape escapes a value for use inside a quoted Mermaid label
+func mermaidEscape(s string) string {
+	return strings.NewReplacer(`"`, "#quot;", "<", "#lt;", ">", "#gt;", "\n", " ").Replace(s)
+}
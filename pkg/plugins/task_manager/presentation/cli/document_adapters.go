@@ -142,10 +142,10 @@ func (c *DocCreateCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.
 
 	// Validate required flags
 	if c.title == "" {
-		return fmt.Errorf("--title is required")
+		return fmt.Errorf("%w: --title is required", pluginsdk.ErrInvalidArgument)
 	}
 	if c.docType == "" {
-		return fmt.Errorf("--type is required")
+		return fmt.Errorf("%w: --type is required", pluginsdk.ErrInvalidArgument)
 	}
 
 	// Validate XOR: content vs from-file
@@ -153,7 +153,7 @@ func (c *DocCreateCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.
 		return fmt.Errorf("--content and --from-file are mutually exclusive (provide one, not both)")
 	}
 	if c.content == "" && c.fromFile == "" {
-		return fmt.Errorf("either --content or --from-file is required")
+		return fmt.Errorf("%w: either --content or --from-file is required", pluginsdk.ErrInvalidArgument)
 	}
 
 	// Read file if provided
@@ -247,7 +247,7 @@ Examples:
 func (c *DocUpdateCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
 	// Parse doc ID from first arg
 	if len(args) == 0 {
-		return fmt.Errorf("document ID is required")
+		return fmt.Errorf("%w: document ID is required", pluginsdk.ErrInvalidArgument)
 	}
 	c.docID = args[0]
 
@@ -355,7 +355,7 @@ Examples:
 func (c *DocShowCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
 	// Parse doc ID from first arg
 	if len(args) == 0 {
-		return fmt.Errorf("document ID is required")
+		return fmt.Errorf("%w: document ID is required", pluginsdk.ErrInvalidArgument)
 	}
 	c.docID = args[0]
 
@@ -578,7 +578,7 @@ Examples:
 func (c *DocAttachCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
 	// Parse doc ID from first arg
 	if len(args) == 0 {
-		return fmt.Errorf("document ID is required")
+		return fmt.Errorf("%w: document ID is required", pluginsdk.ErrInvalidArgument)
 	}
 	c.docID = args[0]
 
@@ -610,7 +610,7 @@ func (c *DocAttachCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.
 
 	// Validate XOR: track vs iteration (one required)
 	if (c.track == nil || *c.track == "") && (c.iteration == nil || *c.iteration < 1) {
-		return fmt.Errorf("either --track or --iteration is required")
+		return fmt.Errorf("%w: either --track or --iteration is required", pluginsdk.ErrInvalidArgument)
 	}
 	if c.track != nil && *c.track != "" && c.iteration != nil && *c.iteration > 0 {
 		return fmt.Errorf("--track and --iteration are mutually exclusive")
@@ -670,7 +670,7 @@ Examples:
 func (c *DocDetachCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
 	// Parse doc ID from first arg
 	if len(args) == 0 {
-		return fmt.Errorf("document ID is required")
+		return fmt.Errorf("%w: document ID is required", pluginsdk.ErrInvalidArgument)
 	}
 	c.docID = args[0]
 
@@ -741,7 +741,7 @@ Examples:
 func (c *DocDeleteCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
 	// Parse doc ID from first arg
 	if len(args) == 0 {
-		return fmt.Errorf("document ID is required")
+		return fmt.Errorf("%w: document ID is required", pluginsdk.ErrInvalidArgument)
 	}
 	c.docID = args[0]
 
@@ -0,0 +1,355 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application/dto"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// ganttAxisWidth is the number of columns the ASCII timeline's date axis spans at most
+const ganttAxisWidth = 60
+
+// ganttLabelWidth is the width of the labels left of the ASCII timeline bars
+const ganttLabelWidth = 32
+
+// day is the resolution of the timeline
+const day = 24 * time.Hour
+
+// ============================================================================
+// ExportCommandAdapter - Exports the iteration timeline as a Gantt chart
+// ============================================================================
+
+// ExportCommandAdapter exports the iteration timeline as a Mermaid gantt diagram or ASCII timeline
+type ExportCommandAdapter struct {
+	IterationService *application.IterationApplicationService
+
+	// Now returns the current time, which ends the bars of running iterations (defaults to time.Now)
+	Now func() time.Time
+
+	// CLI flags
+	project string
+	gantt   bool
+	format  string
+	output  string
+}
+
+func (c *ExportCommandAdapter) GetName() string {
+	return "export"
+}
+
+func (c *ExportCommandAdapter) GetDescription() string {
+	return "Export the iteration timeline as a Gantt chart"
+}
+
+func (c *ExportCommandAdapter) GetUsage() string {
+	return "dw task-manager export --gantt [--format mermaid|ascii] [--output <file>]"
+}
+
+func (c *ExportCommandAdapter) GetHelp() string {
+	return `Exports a timeline of the iterations and their tasks for planning discussions.
+
+Iterations are placed along a date axis from the time they were started to the
+time they were completed; running iterations end today. Iterations that were
+never started are listed as planned/unscheduled after the scheduled ones.
+
+Flags:
+  --gantt               Export the iteration timeline (required)
+  --format <format>     Output format (default: mermaid)
+                        Values: mermaid, ascii
+  --output <file>       Write the timeline to a file instead of stdout
+  --project <name>      Project name (optional)
+
+Examples:
+  # Mermaid gantt diagram for docs
+  dw task-manager export --gantt --output docs/timeline.mmd
+
+  # Timeline in the terminal
+  dw task-manager export --gantt --format ascii
+
+Notes:
+  - Task status history is not recorded, so tasks span their whole iteration
+  - The ASCII axis is scaled to at most 60 columns; the header shows how many
+    days one column covers
+  - Mermaid output has no code fence; wrap it in a mermaid block to embed it in Markdown`
+}
+
+func (c *ExportCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	// Parse flags
+	c.format = "mermaid"
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--project":
+			if i+1 < len(args) {
+				c.project = args[i+1]
+				i++
+			}
+		case "--gantt":
+			c.gantt = true
+		case "--format":
+			if i+1 < len(args) {
+				c.format = args[i+1]
+				i++
+			}
+		case "--output":
+			if i+1 < len(args) {
+				c.output = args[i+1]
+				i++
+			}
+		}
+	}
+
+	if !c.gantt {
+		return fmt.Errorf("%w: nothing to export; use --gantt for the iteration timeline", pluginsdk.ErrInvalidArgument)
+	}
+	if c.format != "mermaid" && c.format != "ascii" {
+		return fmt.Errorf("invalid format '%s'. Valid formats: mermaid, ascii", c.format)
+	}
+
+	timeline, err := c.IterationService.GetTimeline(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to build timeline: %w", err)
+	}
+
+	now := time.Now()
+	if c.Now != nil {
+		now = c.Now()
+	}
+
+	var sb strings.Builder
+	if c.format == "ascii" {
+		writeGanttASCII(&sb, timeline, now)
+	} else {
+		writeGanttMermaid(&sb, timeline, now)
+	}
+
+	out := cmdCtx.GetStdout()
+	if c.output == "" {
+		fmt.Fprint(out, sb.String())
+		return nil
+	}
+
+	dir := filepath.Dir(c.output)
+	if dir != "" && dir != "." {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("failed to create output directory: %w", err)
+		}
+	}
+	if err := os.WriteFile(c.output, []byte(sb.String()), 0644); err != nil {
+		return fmt.Errorf("failed to write timeline: %w", err)
+	}
+
+	fmt.Fprintf(out, "Timeline saved to: %s (%d scheduled, %d unscheduled iterations)\n",
+		c.output, len(timeline.Scheduled), len(timeline.Unscheduled))
+	return nil
+}
+
+// ganttSpan returns the first and last day covered by an iteration: from its start day to
+// the day it was completed, or to now while it runs. end is exclusive.
+func ganttSpan(entry dto.IterationTimelineEntryDTO, now time.Time) (start, end time.Time) {
+	start = truncateDay(*entry.StartedAt)
+	last := now
+	if entry.CompletedAt != nil {
+		last = *entry.CompletedAt
+	}
+	end = truncateDay(last).Add(day)
+	if !end.After(start) {
+		end = start.Add(day)
+	}
+	return start, end
+}
+
+// ganttRange returns the range covered by all scheduled iterations, or the current day
+// when none is scheduled. end is exclusive.
+func ganttRange(timeline *dto.IterationTimelineDTO, now time.Time) (start, end time.Time) {
+	if len(timeline.Scheduled) == 0 {
+		start = truncateDay(now)
+		return start, start.Add(day)
+	}
+	for i, entry := range timeline.Scheduled {
+		s, e := ganttSpan(entry, now)
+		if i == 0 || s.Before(start) {
+			start = s
+		}
+		if i == 0 || e.After(end) {
+			end = e
+		}
+	}
+	return start, end
+}
+
+// truncateDay returns midnight of t's day in t's location
+func truncateDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
+
+// writeGanttMermaid renders the timeline as a Mermaid gantt diagram, one section per
+// scheduled iteration. Unscheduled iterations are milestones after the last scheduled one.
+func writeGanttMermaid(w io.Writer, timeline *dto.IterationTimelineDTO, now time.Time) {
+	start, end := ganttRange(timeline, now)
+
+	// Coarser axis labels for long timelines
+	axisFormat := "%b %d"
+	if end.Sub(start) > 180*day {
+		axisFormat = "%Y-%m"
+	}
+
+	fmt.Fprintln(w, "gantt")
+	fmt.Fprintln(w, "    title Iteration timeline")
+	fmt.Fprintln(w, "    dateFormat YYYY-MM-DD")
+	fmt.Fprintf(w, "    axisFormat %s\n", axisFormat)
+
+	for _, entry := range timeline.Scheduled {
+		s, e := ganttSpan(entry, now)
+		fmt.Fprintf(w, "    section Iteration %d - %s\n", entry.Number, ganttMermaidLabel(entry.Name))
+		fmt.Fprintf(w, "    %s :%siter%d, %s, %s\n",
+			ganttMermaidLabel(entry.Name), ganttMermaidTag(iterationGanttProgress(entry.Status)),
+			entry.Number, s.Format("2006-01-02"), e.Format("2006-01-02"))
+		for _, task := range entry.Tasks {
+			fmt.Fprintf(w, "    %s %s :%s%s, %s\n",
+				task.ID, ganttMermaidLabel(task.Title), ganttMermaidTag(taskGanttProgress(task.Status)),
+				s.Format("2006-01-02"), e.Format("2006-01-02"))
+		}
+	}
+
+	if len(timeline.Unscheduled) > 0 {
+		fmt.Fprintln(w, "    section Planned (unscheduled)")
+		for _, entry := range timeline.Unscheduled {
+			fmt.Fprintf(w, "    Iteration %d - %s (%d tasks) :milestone, iter%d, %s, 0d\n",
+				entry.Number, ganttMermaidLabel(entry.Name), len(entry.Tasks), entry.Number, end.Format("2006-01-02"))
+		}
+	}
+}
+
+// ganttProgress is how far an iteration or task on the timeline is
+type ganttProgress int
+
+const (
+	ganttNotStarted ganttProgress = iota
+	ganttActive
+	ganttDone
+)
+
+func iterationGanttProgress(status string) ganttProgress {
+	switch status {
+	case string(entities.IterationStatusComplete):
+		return ganttDone
+	case string(entities.IterationStatusCurrent):
+		return ganttActive
+	default:
+		return ganttNotStarted
+	}
+}
+
+func taskGanttProgress(status string) ganttProgress {
+	switch status {
+	case string(entities.TaskStatusDone):
+		return ganttDone
+	case string(entities.TaskStatusInProgress), string(entities.TaskStatusReview):
+		return ganttActive
+	default:
+		return ganttNotStarted
+	}
+}
+
+// ganttMermaidTag returns the Mermaid task tag for progress, followed by a comma
+func ganttMermaidTag(progress ganttProgress) string {
+	switch progress {
+	case ganttDone:
+		return "done, "
+	case ganttActive:
+		return "active, "
+	default:
+		return ""
+	}
+}
+
+// ganttMermaidLabel strips the characters Mermaid gantt uses as separators from a label
+func ganttMermaidLabel(s string) string {
+	return strings.NewReplacer(":", " -", ";", ",", "#", "", "\n", " ").Replace(s)
+}
+
+// ganttASCIIBar returns the bar character for progress
+func ganttASCIIBar(progress ganttProgress) string {
+	switch progress {
+	case ganttDone:
+		return "#"
+	case ganttActive:
+		return "="
+	default:
+		return "-"
+	}
+}
+
+// writeGanttASCII renders the timeline as text bars along a date axis. The axis is scaled
+// so that it fits ganttAxisWidth columns however many days the iterations span.
+func writeGanttASCII(w io.Writer, timeline *dto.IterationTimelineDTO, now time.Time) {
+	if len(timeline.Scheduled) == 0 && len(timeline.Unscheduled) == 0 {
+		fmt.Fprintln(w, "No iterations yet.")
+		return
+	}
+
+	if len(timeline.Scheduled) > 0 {
+		start, end := ganttRange(timeline, now)
+		days := int(end.Sub(start) / day)
+		daysPerColumn := (days + ganttAxisWidth - 1) / ganttAxisWidth
+		columns := (days + daysPerColumn - 1) / daysPerColumn
+		column := func(t time.Time) int {
+			return int(t.Sub(start)/day) / daysPerColumn
+		}
+		bar := func(s, e time.Time, char string) string {
+			from, to := column(s), column(e.Add(-day))
+			return strings.Repeat(" ", from) + strings.Repeat(char, to-from+1) + strings.Repeat(" ", columns-to-1)
+		}
+
+		fmt.Fprintf(w, "Iteration timeline %s to %s (1 column = %d day(s))\n\n",
+			start.Format("2006-01-02"), end.Add(-day).Format("2006-01-02"), daysPerColumn)
+
+		startLabel, endLabel := start.Format("2006-01-02"), end.Add(-day).Format("2006-01-02")
+		axis := startLabel
+		if gap := columns + 2 - len(startLabel) - len(endLabel); gap > 0 {
+			axis += strings.Repeat(" ", gap) + endLabel
+		}
+		fmt.Fprintf(w, "%-*s %s\n", ganttLabelWidth, "", axis)
+
+		for _, entry := range timeline.Scheduled {
+			s, e := ganttSpan(entry, now)
+			label := ganttASCIILabel(fmt.Sprintf("#%d %s", entry.Number, entry.Name))
+			fmt.Fprintf(w, "%-*s |%s|\n", ganttLabelWidth, label, bar(s, e, ganttASCIIBar(iterationGanttProgress(entry.Status))))
+			for _, task := range entry.Tasks {
+				label := ganttASCIILabel(fmt.Sprintf("  %s %s", task.ID, task.Title))
+				fmt.Fprintf(w, "%-*s |%s|\n", ganttLabelWidth, label, bar(s, e, ganttASCIIBar(taskGanttProgress(task.Status))))
+			}
+		}
+		fmt.Fprintln(w)
+		fmt.Fprintln(w, "Legend: # done  = in progress  - not started")
+	}
+
+	if len(timeline.Unscheduled) > 0 {
+		if len(timeline.Scheduled) > 0 {
+			fmt.Fprintln(w)
+		}
+		fmt.Fprintln(w, "Planned (unscheduled):")
+		for _, entry := range timeline.Unscheduled {
+			fmt.Fprintf(w, "  #%d %s (%d tasks)\n", entry.Number, entry.Name, len(entry.Tasks))
+		}
+	}
+}
+
+// ganttASCIILabel shortens a label to ganttLabelWidth
+func ganttASCIILabel(label string) string {
+	runes := []rune(label)
+	if len(runes) <= ganttLabelWidth {
+		return label
+	}
+	return string(runes[:ganttLabelWidth-3]) + "..."
+}
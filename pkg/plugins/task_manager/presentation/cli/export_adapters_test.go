@@ -0,0 +1,107 @@
+package cli_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application/mocks"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/services"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/presentation/cli"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// outputContext captures a command's stdout
+type outputContext struct {
+	pluginsdk.CommandContext
+	stdout bytes.Buffer
+}
+
+func (c *outputContext) GetStdout() io.Writer {
+	return &c.stdout
+}
+
+// runGanttExport exports the timeline of iterations at now with the given format
+func runGanttExport(t *testing.T, iterations []*entities.IterationEntity, now time.Time, format string) string {
+	t.Helper()
+	iterationRepo := &mocks.MockIterationRepository{
+		ListIterationsFunc: func(ctx context.Context) ([]*entities.IterationEntity, error) {
+			return iterations, nil
+		},
+		GetIterationTasksFunc: func(ctx context.Context, iterationNum int) ([]*entities.TaskEntity, error) {
+			return []*entities.TaskEntity{}, nil
+		},
+	}
+	service := application.NewIterationApplicationService(iterationRepo, &mocks.MockTaskRepository{}, &mocks.MockAggregateRepository{},
+		&mocks.MockTransactionalRepository{Iterations: iterationRepo}, services.NewIterationService(), services.NewValidationService())
+
+	cmd := &cli.ExportCommandAdapter{IterationService: service, Now: func() time.Time { return now }}
+	cmdCtx := &outputContext{}
+	if err := cmd.Execute(context.Background(), cmdCtx, []string{"--gantt", "--format", format}); err != nil {
+		t.Fatalf("export --gantt --format %s failed: %v", format, err)
+	}
+	return cmdCtx.stdout.String()
+}
+
+// timelineIteration creates an iteration started at start and, if end is non-zero, completed at end
+func timelineIteration(t *testing.T, number int, name, status string, start, end time.Time) *entities.IterationEntity {
+	t.Helper()
+	iter, err := entities.NewIterationEntity(number, name, "goal", "deliverable", []string{}, status, 100, start, end, start, start)
+	if err != nil {
+		t.Fatalf("failed to create iteration: %v", err)
+	}
+	return iter
+}
+
+func TestExportGantt_ASCIIScalesLongTimelines(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	iterations := []*entities.IterationEntity{
+		timelineIteration(t, 1, "First", "complete", base, base.AddDate(0, 0, 13)),
+		timelineIteration(t, 2, "Last", "current", base.AddDate(0, 0, 170), time.Time{}),
+	}
+
+	// 180 days from the first start to now fit 60 columns at 3 days each
+	output := runGanttExport(t, iterations, base.AddDate(0, 0, 179), "ascii")
+
+	if !strings.Contains(output, "2025-01-01 to 2025-06-29 (1 column = 3 day(s))") {
+		t.Errorf("expected a scaled axis header, got:\n%s", output)
+	}
+	// Iteration 1 covers days 0-13 (columns 0-4), iteration 2 days 170-179 (columns 56-59)
+	for _, want := range []string{
+		"|" + strings.Repeat("#", 5) + strings.Repeat(" ", 55) + "|",
+		"|" + strings.Repeat(" ", 56) + strings.Repeat("=", 4) + "|",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected bar %q in:\n%s", want, output)
+		}
+	}
+}
+
+func TestExportGantt_MermaidMarksProgress(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	iterations := []*entities.IterationEntity{
+		timelineIteration(t, 1, "Sprint: one", "complete", base, base.AddDate(0, 0, 13)),
+		timelineIteration(t, 2, "Sprint two", "current", base.AddDate(0, 0, 14), time.Time{}),
+		timelineIteration(t, 3, "Sprint three", "planned", time.Time{}, time.Time{}),
+	}
+
+	output := runGanttExport(t, iterations, base.AddDate(0, 0, 20), "mermaid")
+
+	for _, want := range []string{
+		"axisFormat %b %d",
+		"section Iteration 1 - Sprint - one",
+		"Sprint - one :done, iter1, 2025-01-01, 2025-01-15",
+		"Sprint two :active, iter2, 2025-01-15, 2025-01-22",
+		"section Planned (unscheduled)",
+		"Iteration 3 - Sprint three (0 tasks) :milestone, iter3, 2025-01-22, 0d",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected %q in:\n%s", want, output)
+		}
+	}
+}
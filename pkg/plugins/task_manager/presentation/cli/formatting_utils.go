@@ -1,5 +1,13 @@
 package cli
 
+import (
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/presentation/tui/components"
+)
+
 // GetStatusIcon returns the icon for a given status string
 // Used by CLI output formatting (roadmap full view, etc.)
 func GetStatusIcon(status string) string {
@@ -18,3 +26,84 @@ func GetStatusIcon(status string) string {
 		return "○"
 	}
 }
+
+// iterationStatusStyles colors iteration statuses in CLI tables using the TUI palette.
+// Planned iterations are left uncolored.
+var iterationStatusStyles = map[string]lipgloss.Style{
+	"current":  lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(components.ColorScheme.Accent)),
+	"complete": lipgloss.NewStyle().Foreground(lipgloss.Color(components.ColorScheme.Muted)),
+	"blocked":  lipgloss.NewStyle().Foreground(lipgloss.Color(components.ColorScheme.Failed)),
+}
+
+// ColorIterationStatus returns the status text colored for its state.
+// lipgloss drops the colors when output is not a terminal or NO_COLOR is set.
+func ColorIterationStatus(status string) string {
+	style, ok := iterationStatusStyles[status]
+	if !ok {
+		return status
+	}
+	return style.Render(status)
+}
+
+// padDisplay pads s with spaces to the given display width.
+// Unlike fmt's %-Ns it ignores ANSI color codes and counts wide characters as two columns.
+func padDisplay(s string, width int) string {
+	if gap := width - lipgloss.Width(s); gap > 0 {
+		return s + strings.Repeat(" ", gap)
+	}
+	return s
+}
+
+// truncateDisplay shortens s with "..." so that it fits in the given display width
+func truncateDisplay(s string, width int) string {
+	if lipgloss.Width(s) <= width {
+		return s
+	}
+	runes := []rune(s)
+	for len(runes) > 0 && lipgloss.Width(string(runes))+3 > width {
+		runes = runes[:len(runes)-1]
+	}
+	return string(runes) + "..."
+}
+
+// CompareEntityIDs orders IDs such as "DW-task-9" and "DW-task-10" by their numeric
+// suffix, so that 9 sorts before 10. IDs without a numeric suffix, or with different
+// prefixes, are compared as strings.
+func CompareEntityIDs(a, b string) int {
+	aPrefix, aNum, aOK := splitEntityID(a)
+	bPrefix, bNum, bOK := splitEntityID(b)
+	if aOK && bOK && aPrefix == bPrefix {
+		switch {
+		case aNum < bNum:
+			return -1
+		case aNum > bNum:
+			return 1
+		default:
+			return 0
+		}
+	}
+	return strings.Compare(a, b)
+}
+
+// splitEntityID splits "DW-task-12" into "DW-task-" and 12
+func splitEntityID(id string) (string, int, bool) {
+	i := strings.LastIndex(id, "-")
+	if i < 0 {
+		return "", 0, false
+	}
+	n, err := strconv.Atoi(id[i+1:])
+	if err != nil {
+		return "", 0, false
+	}
+	return id[:i+1], n, true
+}
+
+// containsString reports whether values contains s
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -3,6 +3,8 @@ package cli
 import (
 	"context"
 	"fmt"
+	"io"
+	"strconv"
 
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application/dto"
@@ -75,13 +77,13 @@ func (c *IterationCreateCommandAdapter) Execute(ctx context.Context, cmdCtx plug
 
 	// Validate required flags
 	if c.name == "" {
-		return fmt.Errorf("--name is required")
+		return fmt.Errorf("%w: --name is required", pluginsdk.ErrInvalidArgument)
 	}
 	if c.goal == "" {
-		return fmt.Errorf("--goal is required")
+		return fmt.Errorf("%w: --goal is required", pluginsdk.ErrInvalidArgument)
 	}
 	if c.deliverable == "" {
-		return fmt.Errorf("--deliverable is required")
+		return fmt.Errorf("%w: --deliverable is required", pluginsdk.ErrInvalidArgument)
 	}
 
 
@@ -150,7 +152,7 @@ Flags:
 func (c *IterationUpdateCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
 	// Parse iteration number
 	if len(args) == 0 {
-		return fmt.Errorf("iteration number is required")
+		return fmt.Errorf("%w: iteration number is required", pluginsdk.ErrInvalidArgument)
 	}
 	fmt.Sscanf(args[0], "%d", &c.number)
 	args = args[1:]
@@ -248,7 +250,7 @@ Only one iteration can be current at a time.`
 func (c *IterationStartCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
 	// Parse iteration number
 	if len(args) == 0 {
-		return fmt.Errorf("iteration number is required")
+		return fmt.Errorf("%w: iteration number is required", pluginsdk.ErrInvalidArgument)
 	}
 	fmt.Sscanf(args[0], "%d", &c.number)
 	args = args[1:]
@@ -291,8 +293,11 @@ type IterationCompleteCommandAdapter struct {
 	IterationService *application.IterationApplicationService
 
 	// CLI flags
-	project string
-	number  int
+	project    string
+	number     int
+	requireDoD bool
+	carryOver  int
+	create     bool
 }
 
 func (c *IterationCompleteCommandAdapter) GetName() string {
@@ -304,17 +309,31 @@ func (c *IterationCompleteCommandAdapter) GetDescription() string {
 }
 
 func (c *IterationCompleteCommandAdapter) GetUsage() string {
-	return "dw task-manager iteration complete <iteration-number>"
+	return "dw task-manager iteration complete <iteration-number> [--require-dod] [--carry-over <n> [--create]]"
 }
 
 func (c *IterationCompleteCommandAdapter) GetHelp() string {
-	return `Marks an iteration as complete.`
+	return `Marks an iteration as complete.
+
+With --carry-over, the tasks that are not done are then moved to the given
+iteration, as 'iteration carry-over' does.
+
+Flags:
+  --require-dod       Refuse to complete while definition of done items are unchecked
+  --carry-over <n>    Move the unfinished tasks to iteration n after completing
+  --create            With --carry-over, create iteration n if it does not exist
+  --project <name>    Project name (optional)
+
+Examples:
+  dw task-manager iteration complete 3
+  dw task-manager iteration complete 3 --require-dod
+  dw task-manager iteration complete 3 --carry-over 4 --create`
 }
 
 func (c *IterationCompleteCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
 	// Parse iteration number
 	if len(args) == 0 {
-		return fmt.Errorf("iteration number is required")
+		return fmt.Errorf("%w: iteration number is required", pluginsdk.ErrInvalidArgument)
 	}
 	fmt.Sscanf(args[0], "%d", &c.number)
 	args = args[1:]
@@ -327,6 +346,25 @@ func (c *IterationCompleteCommandAdapter) Execute(ctx context.Context, cmdCtx pl
 				c.project = args[i+1]
 				i++
 			}
+		case "--require-dod":
+			c.requireDoD = true
+		case "--carry-over":
+			if i+1 < len(args) {
+				number, err := strconv.Atoi(args[i+1])
+				if err != nil {
+					return fmt.Errorf("invalid --carry-over iteration number: %s", args[i+1])
+				}
+				c.carryOver = number
+				i++
+			}
+		case "--create":
+			c.create = true
+		}
+	}
+
+	if c.requireDoD {
+		if err := c.IterationService.EnsureDoDComplete(ctx, c.number); err != nil {
+			return fmt.Errorf("failed to complete iteration: %w", err)
 		}
 	}
 
@@ -346,9 +384,125 @@ func (c *IterationCompleteCommandAdapter) Execute(ctx context.Context, cmdCtx pl
 	fmt.Fprintf(out, "Iteration %d completed successfully\n", iteration.Number)
 	fmt.Fprintf(out, "  Status: %s\n", iteration.Status)
 
+	if c.carryOver == 0 {
+		return nil
+	}
+	target, moved, created, err := c.IterationService.CarryOver(ctx, dto.CarryOverDTO{From: c.number, To: c.carryOver, Create: c.create})
+	if err != nil {
+		return fmt.Errorf("iteration %d completed, but carry-over failed: %w", c.number, err)
+	}
+	fmt.Fprintln(out)
+	printCarryOver(out, c.number, target, moved, created)
+
+	return nil
+}
+
+// ============================================================================
+// IterationCarryOverCommandAdapter - Adapts CLI to CarryOver use case
+// ============================================================================
+
+type IterationCarryOverCommandAdapter struct {
+	IterationService *application.IterationApplicationService
+
+	// CLI flags
+	project string
+	from    int
+	to      int
+	create  bool
+}
+
+func (c *IterationCarryOverCommandAdapter) GetName() string {
+	return "iteration carry-over"
+}
+
+func (c *IterationCarryOverCommandAdapter) GetDescription() string {
+	return "Move the unfinished tasks of an iteration to another"
+}
+
+func (c *IterationCarryOverCommandAdapter) GetUsage() string {
+	return "dw task-manager iteration carry-over <iteration-number> --to <n> [--create]"
+}
+
+func (c *IterationCarryOverCommandAdapter) GetHelp() string {
+	return `Moves every task of an iteration that is not done to another iteration,
+in a single transaction. Done tasks stay in the source iteration for history.
+
+The target iteration must not be complete. Use 'iteration complete <n> --carry-over <m>'
+to complete an iteration and carry over its tasks in one step.
+
+Flags:
+  --to <n>            Target iteration number (required)
+  --create            Create the target iteration if it does not exist
+  --project <name>    Project name (optional)
+
+Examples:
+  dw task-manager iteration carry-over 3 --to 4
+  dw task-manager iteration carry-over 3 --to 4 --create`
+}
+
+func (c *IterationCarryOverCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	// Parse iteration number
+	if len(args) == 0 {
+		return fmt.Errorf("%w: iteration number is required", pluginsdk.ErrInvalidArgument)
+	}
+	from, err := strconv.Atoi(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid iteration number: %s", args[0])
+	}
+	c.from = from
+	args = args[1:]
+
+	// Parse flags
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--to":
+			if i+1 < len(args) {
+				to, err := strconv.Atoi(args[i+1])
+				if err != nil {
+					return fmt.Errorf("invalid --to iteration number: %s", args[i+1])
+				}
+				c.to = to
+				i++
+			}
+		case "--create":
+			c.create = true
+		case "--project":
+			if i+1 < len(args) {
+				c.project = args[i+1]
+				i++
+			}
+		}
+	}
+
+	if c.to == 0 {
+		return fmt.Errorf("%w: --to is required", pluginsdk.ErrInvalidArgument)
+	}
+
+	// Execute via application service
+	target, moved, created, err := c.IterationService.CarryOver(ctx, dto.CarryOverDTO{From: c.from, To: c.to, Create: c.create})
+	if err != nil {
+		return fmt.Errorf("failed to carry over iteration: %w", err)
+	}
+
+	printCarryOver(cmdCtx.GetStdout(), c.from, target, moved, created)
 	return nil
 }
 
+// printCarryOver reports the tasks a carry-over moved
+func printCarryOver(out io.Writer, from int, target *entities.IterationEntity, moved []*entities.TaskEntity, created bool) {
+	if created {
+		fmt.Fprintf(out, "Created iteration %d: %s\n", target.Number, target.Name)
+	}
+	if len(moved) == 0 {
+		fmt.Fprintf(out, "No unfinished tasks to carry over from iteration %d\n", from)
+		return
+	}
+	fmt.Fprintf(out, "Carried over %d task(s) from iteration %d to iteration %d:\n", len(moved), from, target.Number)
+	for _, task := range moved {
+		fmt.Fprintf(out, "  %s  %s (%s)\n", task.ID, task.Title, task.Status)
+	}
+}
+
 // ============================================================================
 // IterationRevertCommandAdapter - Adapts CLI to RevertIteration use case
 // ============================================================================
@@ -383,7 +537,7 @@ Clears the completion timestamp but preserves started timestamp.`
 func (c *IterationRevertCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
 	// Parse iteration number
 	if len(args) == 0 {
-		return fmt.Errorf("iteration number is required")
+		return fmt.Errorf("%w: iteration number is required", pluginsdk.ErrInvalidArgument)
 	}
 	fmt.Sscanf(args[0], "%d", &c.number)
 	args = args[1:]
@@ -441,14 +595,15 @@ func (a *IterationListCommandAdapter) GetUsage() string {
 func (a *IterationListCommandAdapter) GetHelp() string {
 	return `Lists all iterations in the project.
 
-Displays iteration number, name, goal, status, task count, and timestamps.
+Displays iteration number, name, goal, status, rank, and task count.
 
 Examples:
   dw task-manager iteration list
 
 Notes:
-  - Iterations are displayed in order by number
-  - Status values: planned, current, complete
+  - Iterations are displayed in order by rank (lowest first), then number
+  - Status values: planned, current (highlighted), complete (dimmed);
+    colors are off with --no-color, NO_COLOR, or when output is piped
   - Use 'iteration show <number>' for detailed information`
 }
 
@@ -467,27 +622,20 @@ func (a *IterationListCommandAdapter) Execute(ctx context.Context, cmdCtx plugin
 	}
 
 	// Display header
-	fmt.Fprintf(out, "%-3s %-30s %-20s %-10s %-5s\n",
-		"#", "Name", "Goal", "Status", "Tasks")
-	fmt.Fprintf(out, "%s %s %s %s %s\n",
-		"---", "------------------------------", "--------------------", "----------", "-----")
+	fmt.Fprintf(out, "%-3s %-30s %-20s %-10s %-6s %-5s\n",
+		"#", "Name", "Goal", "Status", "Rank", "Tasks")
+	fmt.Fprintf(out, "%s %s %s %s %s %s\n",
+		"---", "------------------------------", "--------------------", "----------", "------", "-----")
 
-	// Display iterations
+	// Display iterations (already ordered by rank); cells are padded by display
+	// width since the colored status carries invisible escape codes
 	for _, iter := range iterations {
-		name := iter.Name
-		if len(name) > 30 {
-			name = name[:27] + "..."
-		}
-		goal := iter.Goal
-		if len(goal) > 20 {
-			goal = goal[:17] + "..."
-		}
-
-		fmt.Fprintf(out, "%-3d %-30s %-20s %-10s %-5d\n",
+		fmt.Fprintf(out, "%-3d %s %s %s %-6s %-5d\n",
 			iter.Number,
-			name,
-			goal,
-			iter.Status,
+			padDisplay(truncateDisplay(iter.Name, 30), 30),
+			padDisplay(truncateDisplay(iter.Goal, 20), 20),
+			padDisplay(ColorIterationStatus(iter.Status), 10),
+			strconv.FormatFloat(iter.Rank, 'g', -1, 64),
 			len(iter.TaskIDs))
 	}
 
@@ -499,8 +647,10 @@ func (a *IterationListCommandAdapter) Execute(ctx context.Context, cmdCtx plugin
 // ============================================================================
 
 type IterationShowCommandAdapter struct {
-	IterationService    *application.IterationApplicationService
-	DocumentService     *application.DocumentApplicationService
+	IterationService *application.IterationApplicationService
+	DocumentService  *application.DocumentApplicationService
+	ACService        *application.ACApplicationService // Loads ACs for AC-based progress
+	ProgressBasis    entities.ProgressBasis            // Configured basis (task_manager.iteration.progress_basis)
 
 	// CLI flags
 	number int
@@ -515,19 +665,27 @@ func (a *IterationShowCommandAdapter) GetDescription() string {
 }
 
 func (a *IterationShowCommandAdapter) GetUsage() string {
-	return "dw task-manager iteration show <number>"
+	return "dw task-manager iteration show <number> [--assignee <who> | --unassigned] [--progress-basis tasks|acs]"
 }
 
 func (a *IterationShowCommandAdapter) GetHelp() string {
 	return `Displays detailed information about a specific iteration.
 
-Shows the iteration's properties, timestamps, and all associated tasks.
+Shows the iteration's properties, progress, timestamps, all associated tasks
+and the definition of done checklist.
 
 Arguments:
   <number>  Iteration number (required)
 
+Flags:
+  --assignee <who>          Only list tasks assigned to <who>
+  --unassigned              Only list tasks without an assignee
+  --progress-basis <basis>  Measure progress in done tasks (tasks) or verified
+                            ACs (acs); default: task_manager.iteration.progress_basis
+
 Examples:
   dw task-manager iteration show 1
+  dw task-manager iteration show 1 --assignee alice
 
 Notes:
   - Run 'dw task-manager iteration list' to see all iteration numbers
@@ -535,13 +693,22 @@ Notes:
 }
 
 func (a *IterationShowCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	basis, args, err := parseProgressBasisFlag(args, a.ProgressBasis)
+	if err != nil {
+		return err
+	}
+	assignee, unassigned, args, err := parseAssigneeFilterFlags(args)
+	if err != nil {
+		return err
+	}
+
 	// Parse iteration number
 	if len(args) == 0 {
-		return fmt.Errorf("iteration number is required")
+		return fmt.Errorf("%w: iteration number is required", pluginsdk.ErrInvalidArgument)
 	}
 
 	var number int
-	_, err := fmt.Sscanf(args[0], "%d", &number)
+	_, err = fmt.Sscanf(args[0], "%d", &number)
 	if err != nil {
 		return fmt.Errorf("invalid iteration number: %w", err)
 	}
@@ -569,16 +736,24 @@ func (a *IterationShowCommandAdapter) Execute(ctx context.Context, cmdCtx plugin
 		fmt.Fprintf(out, "  Deliverable: %s\n", iteration.Deliverable)
 	}
 	fmt.Fprintf(out, "  Tasks:       %d\n", len(tasks))
+	if err := printIterationProgress(ctx, out, a.ACService, basis, iteration.Number, tasks); err != nil {
+		return err
+	}
 	fmt.Fprintf(out, "  Rank:        %.2f\n", iteration.Rank)
 	fmt.Fprintf(out, "  Created:     %s\n", iteration.CreatedAt.Format("2006-01-02 15:04:05"))
 	fmt.Fprintf(out, "  Updated:     %s\n", iteration.UpdatedAt.Format("2006-01-02 15:04:05"))
 
 	// Display tasks if any
-	if len(tasks) > 0 {
-		fmt.Fprintf(out, "\nTasks:\n")
-		for _, task := range tasks {
-			fmt.Fprintf(out, "  - %s (%s)\n", task.ID, task.Status)
-		}
+	printIterationTasks(out, tasks, assignee, unassigned)
+
+	// Display definition of done if any
+	dodItems, err := a.IterationService.ListDoDItems(ctx, a.number)
+	if err != nil {
+		return fmt.Errorf("failed to get definition of done: %w", err)
+	}
+	if len(dodItems) > 0 {
+		fmt.Fprintf(out, "\n")
+		printDoDItems(out, dodItems)
 	}
 
 	// Display attached documents
@@ -623,6 +798,8 @@ func (a *IterationShowCommandAdapter) Execute(ctx context.Context, cmdCtx plugin
 type IterationCurrentCommandAdapter struct {
 	IterationService *application.IterationApplicationService
 	DocumentService  *application.DocumentApplicationService
+	ACService        *application.ACApplicationService // Loads ACs for AC-based progress
+	ProgressBasis    entities.ProgressBasis            // Configured basis (task_manager.iteration.progress_basis)
 }
 
 func (a *IterationCurrentCommandAdapter) GetName() string {
@@ -634,7 +811,7 @@ func (a *IterationCurrentCommandAdapter) GetDescription() string {
 }
 
 func (a *IterationCurrentCommandAdapter) GetUsage() string {
-	return "dw task-manager iteration current"
+	return "dw task-manager iteration current [--assignee <who> | --unassigned] [--progress-basis tasks|acs]"
 }
 
 func (a *IterationCurrentCommandAdapter) GetHelp() string {
@@ -642,8 +819,15 @@ func (a *IterationCurrentCommandAdapter) GetHelp() string {
 
 Only one iteration can be current at a time.
 
+Flags:
+  --assignee <who>          Only list tasks assigned to <who>
+  --unassigned              Only list tasks without an assignee
+  --progress-basis <basis>  Measure progress in done tasks (tasks) or verified
+                            ACs (acs); default: task_manager.iteration.progress_basis
+
 Examples:
   dw task-manager iteration current
+  dw task-manager iteration current --unassigned
 
 Notes:
   - Use 'iteration start <number>' to set a current iteration
@@ -651,6 +835,15 @@ Notes:
 }
 
 func (a *IterationCurrentCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	basis, args, err := parseProgressBasisFlag(args, a.ProgressBasis)
+	if err != nil {
+		return err
+	}
+	assignee, unassigned, _, err := parseAssigneeFilterFlags(args)
+	if err != nil {
+		return err
+	}
+
 	// Query application service
 	result, err := a.IterationService.GetCurrentIteration(ctx)
 	if err != nil {
@@ -694,14 +887,12 @@ func (a *IterationCurrentCommandAdapter) Execute(ctx context.Context, cmdCtx plu
 		fmt.Fprintf(out, "  Deliverable: %s\n", iteration.Deliverable)
 	}
 	fmt.Fprintf(out, "  Tasks:       %d\n", len(tasks))
+	if err := printIterationProgress(ctx, out, a.ACService, basis, iteration.Number, tasks); err != nil {
+		return err
+	}
 
 	// Display tasks if any
-	if len(tasks) > 0 {
-		fmt.Fprintf(out, "\nTasks:\n")
-		for _, task := range tasks {
-			fmt.Fprintf(out, "  - %s (%s)\n", task.ID, task.Status)
-		}
-	}
+	printIterationTasks(out, tasks, assignee, unassigned)
 
 	// Display attached documents
 	documents, err := a.DocumentService.ListDocuments(ctx, nil, &iteration.Number, nil)
@@ -746,6 +937,7 @@ type IterationDeleteCommandAdapter struct {
 
 	// CLI flags
 	number int
+	plan   bool
 }
 
 func (a *IterationDeleteCommandAdapter) GetName() string {
@@ -757,7 +949,7 @@ func (a *IterationDeleteCommandAdapter) GetDescription() string {
 }
 
 func (a *IterationDeleteCommandAdapter) GetUsage() string {
-	return "dw task-manager iteration delete <number>"
+	return "dw task-manager iteration delete <number> [--plan]"
 }
 
 func (a *IterationDeleteCommandAdapter) GetHelp() string {
@@ -766,7 +958,11 @@ func (a *IterationDeleteCommandAdapter) GetHelp() string {
 Arguments:
   <number>  Iteration number (required)
 
+Flags:
+  --plan    Show what would be deleted or orphaned, without deleting
+
 Examples:
+  dw task-manager iteration delete 1 --plan
   dw task-manager iteration delete 1
 
 Notes:
@@ -777,7 +973,7 @@ Notes:
 func (a *IterationDeleteCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
 	// Parse iteration number
 	if len(args) == 0 {
-		return fmt.Errorf("iteration number is required")
+		return fmt.Errorf("%w: iteration number is required", pluginsdk.ErrInvalidArgument)
 	}
 
 	var number int
@@ -787,6 +983,21 @@ func (a *IterationDeleteCommandAdapter) Execute(ctx context.Context, cmdCtx plug
 	}
 	a.number = number
 
+	for _, arg := range args[1:] {
+		if arg == "--plan" {
+			a.plan = true
+		}
+	}
+
+	if a.plan {
+		plan, err := a.IterationService.PlanIterationDeletion(ctx, a.number)
+		if err != nil {
+			return fmt.Errorf("failed to plan iteration deletion: %w", err)
+		}
+		plan.Write(cmdCtx.GetStdout())
+		return nil
+	}
+
 	// Execute via application service
 	if err := a.IterationService.DeleteIteration(ctx, a.number); err != nil {
 		return fmt.Errorf("failed to delete iteration: %w", err)
@@ -842,7 +1053,7 @@ Notes:
 func (a *IterationAddTaskCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
 	// Parse iteration number
 	if len(args) < 2 {
-		return fmt.Errorf("iteration number and at least one task ID are required")
+		return fmt.Errorf("%w: iteration number and at least one task ID are required", pluginsdk.ErrInvalidArgument)
 	}
 
 	var number int
@@ -925,7 +1136,7 @@ Notes:
 func (a *IterationRemoveTaskCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
 	// Parse iteration number
 	if len(args) < 2 {
-		return fmt.Errorf("iteration number and at least one task ID are required")
+		return fmt.Errorf("%w: iteration number and at least one task ID are required", pluginsdk.ErrInvalidArgument)
 	}
 
 	var number int
@@ -1002,7 +1213,7 @@ Notes:
 func (a *IterationViewCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
 	// Parse iteration number
 	if len(args) == 0 {
-		return fmt.Errorf("iteration number is required")
+		return fmt.Errorf("%w: iteration number is required", pluginsdk.ErrInvalidArgument)
 	}
 
 	var number int
@@ -1066,3 +1277,43 @@ func (a *IterationViewCommandAdapter) Execute(ctx context.Context, cmdCtx plugin
 
 	return nil
 }
+
+// parseProgressBasisFlag removes --progress-basis <tasks|acs> from args. Without the
+// flag the configured basis applies.
+func parseProgressBasisFlag(args []string, configured entities.ProgressBasis) (basis entities.ProgressBasis, rest []string, err error) {
+	basis = configured
+	for i := 0; i < len(args); i++ {
+		if args[i] != "--progress-basis" {
+			rest = append(rest, args[i])
+			continue
+		}
+		if i+1 >= len(args) {
+			return "", nil, fmt.Errorf("%w: --progress-basis requires a value (tasks or acs)", pluginsdk.ErrInvalidArgument)
+		}
+		if basis, err = entities.ParseProgressBasis(args[i+1]); err != nil {
+			return "", nil, err
+		}
+		i++
+	}
+	return basis, rest, nil
+}
+
+// printIterationProgress prints the iteration's progress on the given basis, e.g.
+// "Progress (ACs): 7/10 (70%)". ACs are only loaded for the AC basis.
+func printIterationProgress(ctx context.Context, out io.Writer, acService *application.ACApplicationService, basis entities.ProgressBasis, iterationNum int, tasks []*entities.TaskEntity) error {
+	var acs []*entities.AcceptanceCriteriaEntity
+	if basis == entities.ProgressBasisACs {
+		var err error
+		if acs, err = acService.ListACByIteration(ctx, iterationNum); err != nil {
+			return fmt.Errorf("failed to get iteration ACs: %w", err)
+		}
+	}
+
+	completed, total := entities.IterationProgress(basis, tasks, acs)
+	percent := 0.0
+	if total > 0 {
+		percent = float64(completed) / float64(total) * 100
+	}
+	fmt.Fprintf(out, "  Progress (%s): %d/%d (%.0f%%)\n", basis.Label(), completed, total, percent)
+	return nil
+}
@@ -0,0 +1,310 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// ============================================================================
+// IterationDoDAddCommandAdapter - Adapts CLI to AddDoDItem / CopyDoDFromTemplate use cases
+// ============================================================================
+
+// IterationDoDAddCommandAdapter adapts iteration dod add CLI command to application use case
+type IterationDoDAddCommandAdapter struct {
+	IterationService *application.IterationApplicationService
+
+	// CLI flags (parsed from args)
+	project      string
+	number       int
+	text         string
+	fromTemplate string
+}
+
+func (c *IterationDoDAddCommandAdapter) GetName() string {
+	return "iteration dod add"
+}
+
+func (c *IterationDoDAddCommandAdapter) GetDescription() string {
+	return "Add a definition of done item to an iteration"
+}
+
+func (c *IterationDoDAddCommandAdapter) GetUsage() string {
+	return "dw task-manager iteration dod add <iteration-number> <text> | --from-template <name>"
+}
+
+func (c *IterationDoDAddCommandAdapter) GetHelp() string {
+	return `Adds an item to an iteration's definition of done: an iteration-level exit
+criterion such as "demo recorded" or "changelog written", tracked separately
+from the acceptance criteria of the iteration's tasks.
+
+Flags:
+  --from-template <name>   Copy the definition of done items of an iteration template
+                           ({n} is replaced with the iteration number; items the
+                           iteration already has are skipped)
+  --project <name>         Project name (optional, uses active project if not specified)
+
+Examples:
+  dw task-manager iteration dod add 3 "Demo recorded"
+  dw task-manager iteration dod add 3 --from-template weekly
+
+Notes:
+  - New items start unchecked
+  - Use 'dw task-manager iteration complete <n> --require-dod' to enforce the checklist`
+}
+
+func (c *IterationDoDAddCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	// Parse iteration number
+	if len(args) == 0 {
+		return fmt.Errorf("%w: iteration number is required", pluginsdk.ErrInvalidArgument)
+	}
+	number, err := strconv.Atoi(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid iteration number %q: must be a number", args[0])
+	}
+	c.number = number
+	args = args[1:]
+
+	// Parse flags and positional text
+	var textParts []string
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--project":
+			if i+1 < len(args) {
+				c.project = args[i+1]
+				i++
+			}
+		case "--from-template":
+			if i+1 < len(args) {
+				c.fromTemplate = args[i+1]
+				i++
+			}
+		default:
+			textParts = append(textParts, args[i])
+		}
+	}
+	c.text = strings.Join(textParts, " ")
+
+	out := cmdCtx.GetStdout()
+
+	if c.fromTemplate != "" {
+		if c.text != "" {
+			return fmt.Errorf("%w: use either item text or --from-template, not both", pluginsdk.ErrInvalidArgument)
+		}
+		added, err := c.IterationService.CopyDoDFromTemplate(ctx, c.number, c.fromTemplate)
+		if err != nil {
+			return fmt.Errorf("failed to copy definition of done: %w", err)
+		}
+		fmt.Fprintf(out, "Copied %d definition of done item(s) from template %s to iteration %d\n", len(added), c.fromTemplate, c.number)
+		for _, item := range added {
+			fmt.Fprintf(out, "  %-3d %s\n", item.ID, item.Text)
+		}
+		return nil
+	}
+
+	if c.text == "" {
+		return fmt.Errorf("%w: item text or --from-template is required", pluginsdk.ErrInvalidArgument)
+	}
+
+	item, err := c.IterationService.AddDoDItem(ctx, c.number, c.text)
+	if err != nil {
+		return fmt.Errorf("failed to add definition of done item: %w", err)
+	}
+
+	fmt.Fprintf(out, "Definition of done item added successfully\n")
+	fmt.Fprintf(out, "  ID:        %d\n", item.ID)
+	fmt.Fprintf(out, "  Iteration: %d\n", item.IterationNum)
+	fmt.Fprintf(out, "  Text:      %s\n", item.Text)
+
+	return nil
+}
+
+// ============================================================================
+// IterationDoDListCommandAdapter - Adapts CLI to ListDoDItems use case
+// ============================================================================
+
+// IterationDoDListCommandAdapter adapts iteration dod list CLI command to application use case
+type IterationDoDListCommandAdapter struct {
+	IterationService *application.IterationApplicationService
+
+	// CLI flags (parsed from args)
+	project string
+	number  int
+}
+
+func (c *IterationDoDListCommandAdapter) GetName() string {
+	return "iteration dod list"
+}
+
+func (c *IterationDoDListCommandAdapter) GetDescription() string {
+	return "List the definition of done of an iteration"
+}
+
+func (c *IterationDoDListCommandAdapter) GetUsage() string {
+	return "dw task-manager iteration dod list <iteration-number>"
+}
+
+func (c *IterationDoDListCommandAdapter) GetHelp() string {
+	return `Lists the definition of done items of an iteration with their status.
+
+Flags:
+  --project <name>    Project name (optional, uses active project if not specified)
+
+Examples:
+  dw task-manager iteration dod list 3
+
+Output:
+  Definition of Done: 1/2 done
+    [x] 1   Demo recorded
+    [ ] 2   Changelog written`
+}
+
+func (c *IterationDoDListCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	// Parse iteration number
+	if len(args) == 0 {
+		return fmt.Errorf("%w: iteration number is required", pluginsdk.ErrInvalidArgument)
+	}
+	number, err := strconv.Atoi(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid iteration number %q: must be a number", args[0])
+	}
+	c.number = number
+	args = args[1:]
+
+	// Parse flags
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--project":
+			if i+1 < len(args) {
+				c.project = args[i+1]
+				i++
+			}
+		}
+	}
+
+	items, err := c.IterationService.ListDoDItems(ctx, c.number)
+	if err != nil {
+		return fmt.Errorf("failed to list definition of done: %w", err)
+	}
+
+	out := cmdCtx.GetStdout()
+	if len(items) == 0 {
+		fmt.Fprintf(out, "Iteration %d has no definition of done items.\n", c.number)
+		fmt.Fprintf(out, "Run 'dw task-manager iteration dod add %d <text>' to add one.\n", c.number)
+		return nil
+	}
+
+	printDoDItems(out, items)
+
+	return nil
+}
+
+// printDoDItems writes a definition of done checklist with its progress
+func printDoDItems(out io.Writer, items []*entities.IterationDoDItemEntity) {
+	done, total := entities.CountDoneDoDItems(items)
+	fmt.Fprintf(out, "Definition of Done: %d/%d done\n", done, total)
+	for _, item := range items {
+		mark := " "
+		if item.Done {
+			mark = "x"
+		}
+		fmt.Fprintf(out, "  [%s] %-3d %s\n", mark, item.ID, item.Text)
+	}
+}
+
+// ============================================================================
+// IterationDoDCheckCommandAdapter - Adapts CLI to SetDoDItemDone use case
+// ============================================================================
+
+// IterationDoDCheckCommandAdapter adapts iteration dod check/uncheck CLI commands to application use case.
+// Done selects between "check" (true) and "uncheck" (false).
+type IterationDoDCheckCommandAdapter struct {
+	IterationService *application.IterationApplicationService
+	Done             bool
+
+	// CLI flags (parsed from args)
+	project string
+}
+
+func (c *IterationDoDCheckCommandAdapter) verb() string {
+	if c.Done {
+		return "check"
+	}
+	return "uncheck"
+}
+
+func (c *IterationDoDCheckCommandAdapter) GetName() string {
+	return "iteration dod " + c.verb()
+}
+
+func (c *IterationDoDCheckCommandAdapter) GetDescription() string {
+	if c.Done {
+		return "Mark a definition of done item as done"
+	}
+	return "Mark a definition of done item as not done"
+}
+
+func (c *IterationDoDCheckCommandAdapter) GetUsage() string {
+	return fmt.Sprintf("dw task-manager iteration dod %s <id>", c.verb())
+}
+
+func (c *IterationDoDCheckCommandAdapter) GetHelp() string {
+	return fmt.Sprintf(`%s.
+
+Use 'dw task-manager iteration dod list <iteration-number>' to find item IDs.
+
+Flags:
+  --project <name>    Project name (optional, uses active project if not specified)
+
+Examples:
+  dw task-manager iteration dod %s 4`, c.GetDescription(), c.verb())
+}
+
+func (c *IterationDoDCheckCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	// Parse item ID
+	if len(args) == 0 {
+		return fmt.Errorf("%w: definition of done item ID is required", pluginsdk.ErrInvalidArgument)
+	}
+	id, err := strconv.Atoi(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid definition of done item ID %q: must be a number", args[0])
+	}
+	args = args[1:]
+
+	// Parse flags
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--project":
+			if i+1 < len(args) {
+				c.project = args[i+1]
+				i++
+			}
+		}
+	}
+
+	item, err := c.IterationService.SetDoDItemDone(ctx, id, c.Done)
+	if err != nil {
+		return fmt.Errorf("failed to %s definition of done item: %w", c.verb(), err)
+	}
+
+	out := cmdCtx.GetStdout()
+	if item.Done {
+		fmt.Fprintf(out, "Definition of done item %d marked as done\n", item.ID)
+		fmt.Fprintf(out, "  Iteration: %d\n", item.IterationNum)
+		fmt.Fprintf(out, "  Text:      %s\n", item.Text)
+		fmt.Fprintf(out, "  Done at:   %s\n", item.DoneAt.Format(time.RFC3339))
+	} else {
+		fmt.Fprintf(out, "Definition of done item %d marked as not done\n", item.ID)
+		fmt.Fprintf(out, "  Iteration: %d\n", item.IterationNum)
+		fmt.Fprintf(out, "  Text:      %s\n", item.Text)
+	}
+
+	return nil
+}
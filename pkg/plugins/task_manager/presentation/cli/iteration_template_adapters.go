@@ -0,0 +1,450 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application/dto"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// ============================================================================
+// IterationTemplateCreateCommandAdapter - Adapts CLI to CreateIterationTemplate use case
+// ============================================================================
+
+// IterationTemplateCreateCommandAdapter adapts iteration template create CLI command to application use case
+type IterationTemplateCreateCommandAdapter struct {
+	IterationService *application.IterationApplicationService
+
+	// CLI flags
+	project     string
+	name        string
+	goal        string
+	deliverable string
+	dodItems    []string
+}
+
+func (c *IterationTemplateCreateCommandAdapter) GetName() string {
+	return "iteration template create"
+}
+
+func (c *IterationTemplateCreateCommandAdapter) GetDescription() string {
+	return "Create a template for recurring iterations"
+}
+
+func (c *IterationTemplateCreateCommandAdapter) GetUsage() string {
+	return "dw task-manager iteration template create <name> --goal <pattern> [--deliverable <desc>] [--dod <item>]..."
+}
+
+func (c *IterationTemplateCreateCommandAdapter) GetHelp() string {
+	return `Creates a named iteration template for a recurring cadence (e.g. weekly sprints).
+Use 'dw task-manager iteration new --template <name>' to create iterations from it.
+
+Flags:
+  --goal <pattern>         Goal pattern (required); {n} is replaced with the iteration number
+  --deliverable <desc>     Default deliverable (optional); may also contain {n}
+  --dod <item>             Definition of done item copied into new iterations (repeatable); may contain {n}
+  --project <name>         Project name (optional)
+
+Examples:
+  dw task-manager iteration template create weekly \
+    --goal "Sprint {n}: ship the top backlog items" \
+    --deliverable "Release notes for sprint {n}" \
+    --dod "Demo recorded" --dod "Changelog for sprint {n} written"
+
+Notes:
+  - Template names must be unique and contain no whitespace`
+}
+
+func (c *IterationTemplateCreateCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	// Parse template name
+	if len(args) == 0 {
+		return fmt.Errorf("%w: template name is required", pluginsdk.ErrInvalidArgument)
+	}
+	c.name = args[0]
+	args = args[1:]
+
+	// Parse flags
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--project":
+			if i+1 < len(args) {
+				c.project = args[i+1]
+				i++
+			}
+		case "--goal":
+			if i+1 < len(args) {
+				c.goal = args[i+1]
+				i++
+			}
+		case "--deliverable":
+			if i+1 < len(args) {
+				c.deliverable = args[i+1]
+				i++
+			}
+		case "--dod":
+			if i+1 < len(args) {
+				c.dodItems = append(c.dodItems, args[i+1])
+				i++
+			}
+		}
+	}
+
+	// Validate required flags
+	if c.goal == "" {
+		return fmt.Errorf("%w: --goal is required", pluginsdk.ErrInvalidArgument)
+	}
+
+	template, err := c.IterationService.CreateIterationTemplate(ctx, dto.CreateIterationTemplateDTO{
+		Name:        c.name,
+		GoalPattern: c.goal,
+		Deliverable: c.deliverable,
+		DoDItems:    c.dodItems,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create iteration template: %w", err)
+	}
+
+	out := cmdCtx.GetStdout()
+	fmt.Fprintf(out, "Iteration template created successfully\n")
+	fmt.Fprintf(out, "  Name:        %s\n", template.Name)
+	fmt.Fprintf(out, "  Goal:        %s\n", template.GoalPattern)
+	fmt.Fprintf(out, "  Deliverable: %s\n", template.Deliverable)
+	if len(template.DoDItems) > 0 {
+		fmt.Fprintf(out, "  DoD items:   %d\n", len(template.DoDItems))
+	}
+
+	return nil
+}
+
+// ============================================================================
+// IterationTemplateListCommandAdapter - Adapts CLI to ListIterationTemplates use case
+// ============================================================================
+
+// IterationTemplateListCommandAdapter adapts iteration template list CLI command to application use case
+type IterationTemplateListCommandAdapter struct {
+	IterationService *application.IterationApplicationService
+
+	// CLI flags
+	project string
+}
+
+func (c *IterationTemplateListCommandAdapter) GetName() string {
+	return "iteration template list"
+}
+
+func (c *IterationTemplateListCommandAdapter) GetDescription() string {
+	return "List iteration templates"
+}
+
+func (c *IterationTemplateListCommandAdapter) GetUsage() string {
+	return "dw task-manager iteration template list"
+}
+
+func (c *IterationTemplateListCommandAdapter) GetHelp() string {
+	return `Lists all iteration templates with their goal pattern and how many
+iterations have been created from each.
+
+Flags:
+  --project <name>     Project name (optional)
+
+Examples:
+  dw task-manager iteration template list`
+}
+
+func (c *IterationTemplateListCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	// Parse flags
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--project":
+			if i+1 < len(args) {
+				c.project = args[i+1]
+				i++
+			}
+		}
+	}
+
+	templates, err := c.IterationService.ListIterationTemplates(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list iteration templates: %w", err)
+	}
+
+	out := cmdCtx.GetStdout()
+	if len(templates) == 0 {
+		fmt.Fprintf(out, "No iteration templates found.\n")
+		fmt.Fprintf(out, "Run 'dw task-manager iteration template create <name> --goal <pattern>' to create one.\n")
+		return nil
+	}
+
+	fmt.Fprintf(out, "Iteration Templates:\n")
+	for _, template := range templates {
+		fmt.Fprintf(out, "  %-20s used %-4d %s\n", template.Name, template.UsageCount, template.GoalPattern)
+	}
+
+	return nil
+}
+
+// ============================================================================
+// IterationTemplateShowCommandAdapter - Adapts CLI to GetIterationTemplate use case
+// ============================================================================
+
+// IterationTemplateShowCommandAdapter adapts iteration template show CLI command to application use case
+type IterationTemplateShowCommandAdapter struct {
+	IterationService *application.IterationApplicationService
+
+	// CLI flags
+	project string
+	name    string
+}
+
+func (c *IterationTemplateShowCommandAdapter) GetName() string {
+	return "iteration template show"
+}
+
+func (c *IterationTemplateShowCommandAdapter) GetDescription() string {
+	return "Show an iteration template"
+}
+
+func (c *IterationTemplateShowCommandAdapter) GetUsage() string {
+	return "dw task-manager iteration template show <name>"
+}
+
+func (c *IterationTemplateShowCommandAdapter) GetHelp() string {
+	return `Shows the goal pattern, default deliverable, definition of done items and
+usage count of an iteration template.
+
+Flags:
+  --project <name>     Project name (optional)
+
+Examples:
+  dw task-manager iteration template show weekly`
+}
+
+func (c *IterationTemplateShowCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	// Parse template name
+	if len(args) == 0 {
+		return fmt.Errorf("%w: template name is required", pluginsdk.ErrInvalidArgument)
+	}
+	c.name = args[0]
+	args = args[1:]
+
+	// Parse flags
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--project":
+			if i+1 < len(args) {
+				c.project = args[i+1]
+				i++
+			}
+		}
+	}
+
+	template, err := c.IterationService.GetIterationTemplate(ctx, c.name)
+	if err != nil {
+		return fmt.Errorf("failed to get iteration template: %w", err)
+	}
+
+	out := cmdCtx.GetStdout()
+	fmt.Fprintf(out, "Iteration Template: %s\n", template.Name)
+	fmt.Fprintf(out, "  Goal:        %s\n", template.GoalPattern)
+	fmt.Fprintf(out, "  Deliverable: %s\n", template.Deliverable)
+	fmt.Fprintf(out, "  Used:        %d time(s)\n", template.UsageCount)
+	if len(template.DoDItems) > 0 {
+		fmt.Fprintf(out, "\nDefinition of Done:\n")
+		for _, text := range template.DoDItems {
+			fmt.Fprintf(out, "  - %s\n", text)
+		}
+	}
+
+	return nil
+}
+
+// ============================================================================
+// IterationTemplateDeleteCommandAdapter - Adapts CLI to DeleteIterationTemplate use case
+// ============================================================================
+
+// IterationTemplateDeleteCommandAdapter adapts iteration template delete CLI command to application use case
+type IterationTemplateDeleteCommandAdapter struct {
+	IterationService *application.IterationApplicationService
+
+	// CLI flags
+	project string
+	name    string
+}
+
+func (c *IterationTemplateDeleteCommandAdapter) GetName() string {
+	return "iteration template delete"
+}
+
+func (c *IterationTemplateDeleteCommandAdapter) GetDescription() string {
+	return "Delete an iteration template"
+}
+
+func (c *IterationTemplateDeleteCommandAdapter) GetUsage() string {
+	return "dw task-manager iteration template delete <name>"
+}
+
+func (c *IterationTemplateDeleteCommandAdapter) GetHelp() string {
+	return `Deletes an iteration template.
+
+Iterations already created from the template are not affected.
+
+Flags:
+  --project <name>     Project name (optional)
+
+Examples:
+  dw task-manager iteration template delete weekly`
+}
+
+func (c *IterationTemplateDeleteCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	// Parse template name
+	if len(args) == 0 {
+		return fmt.Errorf("%w: template name is required", pluginsdk.ErrInvalidArgument)
+	}
+	c.name = args[0]
+	args = args[1:]
+
+	// Parse flags
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--project":
+			if i+1 < len(args) {
+				c.project = args[i+1]
+				i++
+			}
+		}
+	}
+
+	if err := c.IterationService.DeleteIterationTemplate(ctx, c.name); err != nil {
+		return fmt.Errorf("failed to delete iteration template: %w", err)
+	}
+
+	out := cmdCtx.GetStdout()
+	fmt.Fprintf(out, "Iteration template %s deleted successfully\n", c.name)
+
+	return nil
+}
+
+// ============================================================================
+// IterationNewCommandAdapter - Adapts CLI to NewIterationFromTemplate use case
+// ============================================================================
+
+// IterationNewCommandAdapter adapts iteration new CLI command to application use case
+type IterationNewCommandAdapter struct {
+	IterationService *application.IterationApplicationService
+	TrackService     *application.TrackApplicationService // resolves the active roadmap for --pull
+
+	// CLI flags
+	project  string
+	template string
+	name     string
+	pull     int
+}
+
+func (c *IterationNewCommandAdapter) GetName() string {
+	return "iteration new"
+}
+
+func (c *IterationNewCommandAdapter) GetDescription() string {
+	return "Create the next iteration from a template"
+}
+
+func (c *IterationNewCommandAdapter) GetUsage() string {
+	return "dw task-manager iteration new --template <name> [--name <name>] [--pull <N>]"
+}
+
+func (c *IterationNewCommandAdapter) GetHelp() string {
+	return `Creates the next iteration (auto-incremented number) pre-filled from an
+iteration template. {n} in the template's goal and deliverable is replaced
+with the new iteration number. The template's definition of done items are
+copied into the new iteration.
+
+Flags:
+  --template <name>    Iteration template to use (required)
+  --name <name>        Iteration name (optional, default "<template> {n}"); may contain {n}
+  --pull <N>           Add the top N backlog tasks of the active roadmap by rank (optional)
+  --project <name>     Project name (optional)
+
+Examples:
+  # Create the next weekly sprint
+  dw task-manager iteration new --template weekly
+
+  # Create it and pull the 5 highest-ranked backlog tasks into it
+  dw task-manager iteration new --template weekly --pull 5
+
+Notes:
+  - Backlog tasks are tasks that are not done and not in any iteration
+  - Lower rank means higher priority`
+}
+
+func (c *IterationNewCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	// Parse flags
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--project":
+			if i+1 < len(args) {
+				c.project = args[i+1]
+				i++
+			}
+		case "--template":
+			if i+1 < len(args) {
+				c.template = args[i+1]
+				i++
+			}
+		case "--name":
+			if i+1 < len(args) {
+				c.name = args[i+1]
+				i++
+			}
+		case "--pull":
+			if i+1 < len(args) {
+				pull, err := strconv.Atoi(args[i+1])
+				if err != nil || pull < 0 {
+					return fmt.Errorf("invalid --pull value: %s (must be a non-negative number)", args[i+1])
+				}
+				c.pull = pull
+				i++
+			}
+		}
+	}
+
+	// Validate required flags
+	if c.template == "" {
+		return fmt.Errorf("%w: --template is required", pluginsdk.ErrInvalidArgument)
+	}
+
+	roadmapID, err := activeRoadmapID(ctx, c.TrackService)
+	if err != nil {
+		return err
+	}
+	iteration, pulled, err := c.IterationService.NewIterationFromTemplate(ctx, dto.NewIterationFromTemplateDTO{
+		Template:  c.template,
+		Name:      c.name,
+		PullTasks: c.pull,
+		RoadmapID: roadmapID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create iteration from template: %w", err)
+	}
+
+	out := cmdCtx.GetStdout()
+	fmt.Fprintf(out, "Iteration created successfully from template %s\n", c.template)
+	fmt.Fprintf(out, "  Number:      %d\n", iteration.Number)
+	fmt.Fprintf(out, "  Name:        %s\n", iteration.Name)
+	fmt.Fprintf(out, "  Goal:        %s\n", iteration.Goal)
+	fmt.Fprintf(out, "  Deliverable: %s\n", iteration.Deliverable)
+	fmt.Fprintf(out, "  Status:      %s\n", iteration.Status)
+	if dodItems, err := c.IterationService.ListDoDItems(ctx, iteration.Number); err == nil && len(dodItems) > 0 {
+		fmt.Fprintf(out, "  Copied %d definition of done item(s)\n", len(dodItems))
+	}
+	if c.pull > 0 {
+		fmt.Fprintf(out, "  Pulled %d backlog task(s):\n", len(pulled))
+		for _, task := range pulled {
+			fmt.Fprintf(out, "    - %s: %s\n", task.ID, task.Title)
+		}
+	}
+
+	return nil
+}
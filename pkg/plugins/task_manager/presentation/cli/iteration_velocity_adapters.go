@@ -0,0 +1,187 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application/dto"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// defaultVelocityIterations is how many completed iterations velocity covers without --last
+const defaultVelocityIterations = 5
+
+// velocityBarWidth is the length of the longest bar in the velocity chart
+const velocityBarWidth = 40
+
+// ============================================================================
+// IterationVelocityCommandAdapter - Adapts CLI to GetVelocity query
+// ============================================================================
+
+// IterationVelocityCommandAdapter shows completed tasks per iteration over recent iterations
+type IterationVelocityCommandAdapter struct {
+	IterationService *application.IterationApplicationService
+
+	// CLI flags
+	project string
+	last    int
+	json    bool
+}
+
+func (a *IterationVelocityCommandAdapter) GetName() string {
+	return "iteration velocity"
+}
+
+func (a *IterationVelocityCommandAdapter) GetDescription() string {
+	return "Show completed tasks per iteration over recent iterations"
+}
+
+func (a *IterationVelocityCommandAdapter) GetUsage() string {
+	return "dw task-manager iteration velocity [--last <K>] [--json] [--project <name>]"
+}
+
+func (a *IterationVelocityCommandAdapter) GetHelp() string {
+	return `Shows velocity: the number of tasks completed in each of the last K
+completed iterations, as a bar chart with the average and trend.
+
+Flags:
+  --last <K>            Number of completed iterations to include (default: 5)
+  --json                Output as JSON
+  --project <name>      Project name (optional)
+
+Examples:
+  dw task-manager iteration velocity
+  dw task-manager iteration velocity --last 10
+  dw task-manager iteration velocity --json | jq '.average'
+
+Notes:
+  - Only complete iterations are included, ordered by completion time
+  - A task counts as completed if it belongs to the iteration and its status is done
+  - Trend compares the newer half of the iterations with the older half
+  - If fewer than K iterations are complete, all of them are shown`
+}
+
+func (a *IterationVelocityCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	// Parse flags
+	a.last = defaultVelocityIterations
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--project":
+			if i+1 < len(args) {
+				a.project = args[i+1]
+				i++
+			}
+		case "--last":
+			if i+1 >= len(args) {
+				return fmt.Errorf("%w: --last requires a value", pluginsdk.ErrInvalidArgument)
+			}
+			n, err := strconv.Atoi(args[i+1])
+			if err != nil || n <= 0 {
+				return fmt.Errorf("%w: --last must be a positive number, got '%s'", pluginsdk.ErrInvalidArgument, args[i+1])
+			}
+			a.last = n
+			i++
+		case "--json":
+			a.json = true
+		}
+	}
+
+	velocity, err := a.IterationService.GetVelocity(ctx, a.last)
+	if err != nil {
+		return fmt.Errorf("failed to compute velocity: %w", err)
+	}
+
+	out := cmdCtx.GetStdout()
+	if a.json {
+		return writeVelocityJSON(out, velocity)
+	}
+	writeVelocityChart(out, velocity)
+	return nil
+}
+
+// velocityJSON is the --json representation of an iteration velocity report
+type velocityJSON struct {
+	Requested  int                     `json:"requested"`
+	Average    float64                 `json:"average"`
+	Trend      string                  `json:"trend"`
+	Iterations []velocityIterationJSON `json:"iterations"`
+}
+
+type velocityIterationJSON struct {
+	Number         int    `json:"number"`
+	Name           string `json:"name"`
+	CompletedAt    string `json:"completed_at"`
+	CompletedTasks int    `json:"completed_tasks"`
+	TotalTasks     int    `json:"total_tasks"`
+}
+
+func writeVelocityJSON(out io.Writer, velocity *dto.IterationVelocityDTO) error {
+	report := velocityJSON{
+		Requested:  velocity.Requested,
+		Average:    velocity.Average,
+		Trend:      velocity.Trend,
+		Iterations: make([]velocityIterationJSON, 0, len(velocity.Iterations)),
+	}
+	for _, entry := range velocity.Iterations {
+		report.Iterations = append(report.Iterations, velocityIterationJSON{
+			Number:         entry.Number,
+			Name:           entry.Name,
+			CompletedAt:    entry.CompletedAt.Format("2006-01-02T15:04:05Z07:00"),
+			CompletedTasks: entry.CompletedTasks,
+			TotalTasks:     entry.TotalTasks,
+		})
+	}
+
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(report)
+}
+
+// writeVelocityChart renders one bar per iteration, scaled to the busiest iteration
+func writeVelocityChart(out io.Writer, velocity *dto.IterationVelocityDTO) {
+	if len(velocity.Iterations) == 0 {
+		fmt.Fprintf(out, "No completed iterations yet.\n")
+		return
+	}
+
+	if len(velocity.Iterations) < velocity.Requested {
+		fmt.Fprintf(out, "Velocity over %d completed iteration(s) (%d requested):\n\n", len(velocity.Iterations), velocity.Requested)
+	} else {
+		fmt.Fprintf(out, "Velocity over the last %d completed iteration(s):\n\n", len(velocity.Iterations))
+	}
+
+	maxDone := 0
+	for _, entry := range velocity.Iterations {
+		if entry.CompletedTasks > maxDone {
+			maxDone = entry.CompletedTasks
+		}
+	}
+
+	for _, entry := range velocity.Iterations {
+		width := 0
+		if maxDone > 0 {
+			width = entry.CompletedTasks * velocityBarWidth / maxDone
+		}
+		if width == 0 && entry.CompletedTasks > 0 {
+			width = 1
+		}
+		name := entry.Name
+		if len(name) > 20 {
+			name = name[:17] + "..."
+		}
+		fmt.Fprintf(out, "#%-3d %-20s %s %d/%d\n",
+			entry.Number,
+			name,
+			strings.Repeat("█", width)+strings.Repeat(" ", velocityBarWidth-width),
+			entry.CompletedTasks,
+			entry.TotalTasks)
+	}
+
+	fmt.Fprintf(out, "\nAverage: %.1f tasks/iteration\n", velocity.Average)
+	fmt.Fprintf(out, "Trend: %s\n", velocity.Trend)
+}
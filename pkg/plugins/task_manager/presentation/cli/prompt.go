@@ -88,6 +88,7 @@ Example:
 	dw task-manager iteration current          # CRITICAL: Always run first
 	dw task-manager task list --status todo    # Backlog
 	dw task-manager task show TM-task-X        # Task details + AC
+	dw task-manager report blocked-chain TM-task-X  # Why a task is blocked
 
 **Work on Tasks**:
 	dw task-manager task update TM-task-X --status in-progress
@@ -98,6 +99,7 @@ Example:
 	dw task-manager ac list TM-task-X
 	dw task-manager ac verify TM-ac-X         # USER ONLY
 	dw task-manager ac failed --iteration <current-iteration-num>  # Failed in current iteration
+	dw task-manager ac why-failed --fuzzy     # Recurring failure reasons
 
 **Create Work**:
 	dw task-manager track create --title "..." --priority high
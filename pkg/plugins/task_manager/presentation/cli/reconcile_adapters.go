@@ -0,0 +1,121 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application/dto"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// ============================================================================
+// ReconcileCommandAdapter - Adapts CLI to ReconcileTrackACs use case
+// ============================================================================
+
+// ReconcileCommandAdapter verifies the auto-on-complete ACs of tracks whose tasks are all done
+type ReconcileCommandAdapter struct {
+	ACService *application.ACApplicationService
+
+	// CLI flags (parsed from args)
+	project string
+	trackID string
+}
+
+func (c *ReconcileCommandAdapter) GetName() string {
+	return "reconcile"
+}
+
+func (c *ReconcileCommandAdapter) GetDescription() string {
+	return "Verify auto-on-complete acceptance criteria of completed tracks"
+}
+
+func (c *ReconcileCommandAdapter) GetUsage() string {
+	return "dw task-manager reconcile [--track <track-id>] [--project <name>]"
+}
+
+func (c *ReconcileCommandAdapter) GetHelp() string {
+	return `Finds tracks whose tasks are all done and marks their acceptance criteria
+tagged auto-on-complete as automatically verified. Each task with verified
+criteria gets a note listing them. Safe to run repeatedly (e.g. in CI): criteria
+that are already verified or skipped are left alone.
+
+Flags:
+  --track <track-id>    Only reconcile this track (default: every track)
+  --project <name>      Project name (optional, uses active project if not specified)
+
+Examples:
+  dw task-manager ac add DW-task-5 --description "Docs published" --tag auto-on-complete
+  dw task-manager reconcile
+  dw task-manager reconcile --track DW-track-2
+
+Notes:
+  - Tracks without tasks are never complete
+  - Set task_manager.ac.auto_verify_on_track_complete: true in .darwinflow/config.yaml
+    to reconcile automatically when 'task update' marks a track's last task done;
+    tasks completed in the TUI still need 'reconcile'
+  - Exits non-zero if any criterion could not be updated`
+}
+
+func (c *ReconcileCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	// Parse flags
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--project":
+			if i+1 < len(args) {
+				c.project = args[i+1]
+				i++
+			}
+		case "--track":
+			if i+1 >= len(args) {
+				return fmt.Errorf("%w: --track requires a value", pluginsdk.ErrInvalidArgument)
+			}
+			c.trackID = args[i+1]
+			i++
+		default:
+			return fmt.Errorf("%w: unexpected argument '%s'", pluginsdk.ErrInvalidArgument, args[i])
+		}
+	}
+
+	result, err := c.ACService.ReconcileTrackACs(ctx, dto.ReconcileACsDTO{TrackID: c.trackID})
+	if err != nil {
+		return fmt.Errorf("failed to reconcile acceptance criteria: %w", err)
+	}
+
+	out := cmdCtx.GetStdout()
+	verified := 0
+	for _, track := range result.Tracks {
+		verified += len(track.VerifiedIDs)
+	}
+
+	fmt.Fprintf(out, "Complete tracks: %d, incomplete: %d\n", len(result.Tracks), result.IncompleteTracks)
+	for _, track := range result.Tracks {
+		if len(track.VerifiedIDs) == 0 {
+			fmt.Fprintf(out, "  %s: nothing to verify\n", track.TrackID)
+			continue
+		}
+		fmt.Fprintf(out, "  %s: verified %d %s criteria\n", track.TrackID, len(track.VerifiedIDs), entities.ACTagAutoOnComplete)
+		for _, id := range track.VerifiedIDs {
+			fmt.Fprintf(out, "    %s\n", id)
+		}
+	}
+	if verified == 0 && len(result.Failed) == 0 {
+		fmt.Fprintf(out, "No changes.\n")
+	}
+
+	return writeReconcileFailures(out, result)
+}
+
+// writeReconcileFailures lists ACs whose update failed and returns an error if there were any
+func writeReconcileFailures(out io.Writer, result *dto.ReconcileACsResultDTO) error {
+	if len(result.Failed) == 0 {
+		return nil
+	}
+	fmt.Fprintf(out, "Failed to update %d:\n", len(result.Failed))
+	for _, failure := range result.Failed {
+		fmt.Fprintf(out, "  %s: %v\n", failure.ID, failure.Err)
+	}
+	return fmt.Errorf("%d acceptance criteria could not be verified", len(result.Failed))
+}
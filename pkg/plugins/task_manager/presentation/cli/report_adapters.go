@@ -0,0 +1,743 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application/dto"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// ============================================================================
+// ReportWorkloadCommandAdapter - Adapts CLI to GetWorkload query
+// ============================================================================
+
+// ReportWorkloadCommandAdapter tallies open tasks per assignee
+type ReportWorkloadCommandAdapter struct {
+	TaskService  *application.TaskApplicationService
+	TrackService *application.TrackApplicationService // Scopes the report to the active roadmap
+
+	// CLI flags
+	project string
+	json    bool
+}
+
+func (a *ReportWorkloadCommandAdapter) GetName() string {
+	return "report workload"
+}
+
+func (a *ReportWorkloadCommandAdapter) GetDescription() string {
+	return "Show open tasks per assignee"
+}
+
+func (a *ReportWorkloadCommandAdapter) GetUsage() string {
+	return "dw task-manager report workload [--json] [--project <name>]"
+}
+
+func (a *ReportWorkloadCommandAdapter) GetHelp() string {
+	return `Tallies open tasks (todo, in-progress and review) per assignee, busiest
+first. Unassigned tasks are counted in a separate "(unassigned)" row.
+
+Flags:
+  --json                Output as JSON
+  --project <name>      Project name (optional)
+
+Examples:
+  dw task-manager report workload
+  dw task-manager report workload --json | jq '.[] | select(.open > 5)'
+
+Notes:
+  - Assign tasks with 'task assign <task-id> <who>'
+  - Only tasks of the active roadmap are counted
+  - Done and cancelled tasks are not counted`
+}
+
+func (a *ReportWorkloadCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	// Parse flags
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--project":
+			if i+1 < len(args) {
+				a.project = args[i+1]
+				i++
+			}
+		case "--json":
+			a.json = true
+		}
+	}
+
+	roadmapID, err := activeRoadmapID(ctx, a.TrackService)
+	if err != nil {
+		return err
+	}
+	workload, err := a.TaskService.GetWorkload(ctx, roadmapID)
+	if err != nil {
+		return fmt.Errorf("failed to compute workload: %w", err)
+	}
+
+	out := cmdCtx.GetStdout()
+	if a.json {
+		return writeWorkloadJSON(out, workload)
+	}
+	writeWorkloadTable(out, workload)
+	return nil
+}
+
+// workloadJSON is the --json representation of one assignee's workload
+type workloadJSON struct {
+	Assignee   string `json:"assignee"` // Empty for unassigned tasks
+	Open       int    `json:"open"`
+	Todo       int    `json:"todo"`
+	InProgress int    `json:"in_progress"`
+	Review     int    `json:"review"`
+}
+
+func writeWorkloadJSON(out io.Writer, workload []dto.AssigneeWorkloadDTO) error {
+	report := make([]workloadJSON, 0, len(workload))
+	for _, entry := range workload {
+		report = append(report, workloadJSON{
+			Assignee:   entry.Assignee,
+			Open:       entry.Open,
+			Todo:       entry.Todo,
+			InProgress: entry.InProgress,
+			Review:     entry.Review,
+		})
+	}
+
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(report)
+}
+
+func writeWorkloadTable(out io.Writer, workload []dto.AssigneeWorkloadDTO) {
+	if len(workload) == 0 {
+		fmt.Fprintf(out, "No open tasks.\n")
+		return
+	}
+
+	tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	headers := []string{"Assignee", "Open", "Todo", "In progress", "Review"}
+	rules := make([]string, len(headers))
+	for i, header := range headers {
+		rules[i] = strings.Repeat("-", len(header))
+	}
+	fmt.Fprintln(tw, strings.Join(headers, "\t"))
+	fmt.Fprintln(tw, strings.Join(rules, "\t"))
+
+	total := 0
+	for _, entry := range workload {
+		name := entry.Assignee
+		if name == "" {
+			name = unassignedLabel
+		}
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%d\n", name, entry.Open, entry.Todo, entry.InProgress, entry.Review)
+		total += entry.Open
+	}
+	tw.Flush()
+
+	fmt.Fprintf(out, "\nTotal: %d open task(s)\n", total)
+}
+
+// ============================================================================
+// ReportStatusCommandAdapter - Adapts CLI to GetStatusReport query
+// ============================================================================
+
+// defaultStatusWatchInterval is how often 'report status --watch' redraws the report
+const defaultStatusWatchInterval = 10 * time.Second
+
+// Status orders used by 'report status'; statuses not listed follow alphabetically
+var (
+	trackStatusOrder = []string{
+		string(entities.TrackStatusNotStarted), string(entities.TrackStatusInProgress), string(entities.TrackStatusWaiting),
+		string(entities.TrackStatusBlocked), string(entities.TrackStatusComplete),
+	}
+	taskStatusOrder = []string{
+		string(entities.TaskStatusTodo), string(entities.TaskStatusInProgress), string(entities.TaskStatusReview),
+		string(entities.TaskStatusDone), string(entities.TaskStatusCancelled),
+	}
+	acStatusOrder = []string{
+		string(entities.ACStatusNotStarted), string(entities.ACStatusPendingHumanReview), string(entities.ACStatusFailed),
+		string(entities.ACStatusVerified), string(entities.ACStatusAutomaticallyVerified), string(entities.ACStatusSkipped),
+	}
+)
+
+// ReportStatusCommandAdapter summarizes the project by status, once or continuously
+type ReportStatusCommandAdapter struct {
+	TaskService *application.TaskApplicationService
+
+	// CLI flags
+	project  string
+	json     bool
+	watch    bool
+	interval time.Duration
+}
+
+func (a *ReportStatusCommandAdapter) GetName() string {
+	return "report status"
+}
+
+func (a *ReportStatusCommandAdapter) GetDescription() string {
+	return "Show tracks, tasks and ACs by status and the current iteration's progress"
+}
+
+func (a *ReportStatusCommandAdapter) GetUsage() string {
+	return "dw task-manager report status [--watch [--interval <duration>]] [--json] [--project <name>]"
+}
+
+func (a *ReportStatusCommandAdapter) GetHelp() string {
+	return `Counts the tracks, tasks and acceptance criteria of the project by status
+and shows how many tasks of the current iteration are done. The counts come
+from grouped SQL queries, so the report stays cheap on large projects.
+
+With --watch the report is redrawn in place every interval until Ctrl+C,
+e.g. for a dashboard on a spare screen. A refresh that fails (say, while
+another command holds the database lock) keeps the last report on screen
+with the error below it and is retried on the next tick.
+
+Flags:
+  --watch               Redraw the report periodically (requires a terminal)
+  --interval <duration> Time between redraws with --watch (default: 10s, minimum: 1s)
+  --json                Output as JSON (not with --watch)
+  --project <name>      Project name (optional)
+
+Examples:
+  dw task-manager report status
+  dw task-manager report status --watch --interval 30s
+  dw task-manager report status --json | jq '.tasks'
+
+Notes:
+  - Counts cover all roadmaps of the project
+  - Use 'report workload' and 'report stale' for per-assignee and per-task detail`
+}
+
+func (a *ReportStatusCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	a.interval = defaultStatusWatchInterval
+
+	// Parse flags
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--project":
+			if i+1 < len(args) {
+				a.project = args[i+1]
+				i++
+			}
+		case "--interval":
+			if i+1 < len(args) {
+				interval, err := time.ParseDuration(args[i+1])
+				if err != nil || interval < time.Second {
+					return fmt.Errorf("%w: --interval must be a duration of at least 1s, got %q", pluginsdk.ErrInvalidArgument, args[i+1])
+				}
+				a.interval = interval
+				i++
+			}
+		case "--watch":
+			a.watch = true
+		case "--json":
+			a.json = true
+		}
+	}
+
+	out := cmdCtx.GetStdout()
+	if !a.watch {
+		report, err := a.TaskService.GetStatusReport(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to compute status report: %w", err)
+		}
+		if a.json {
+			return writeStatusJSON(out, report)
+		}
+		writeStatusReport(out, report)
+		return nil
+	}
+
+	if a.json {
+		return fmt.Errorf("%w: --watch cannot be combined with --json", pluginsdk.ErrInvalidArgument)
+	}
+	if !isTerminal(out) {
+		return fmt.Errorf("%w: --watch requires a terminal; run 'report status' without --watch to print the report once", pluginsdk.ErrInvalidArgument)
+	}
+
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	return watchReport(ctx, out, a.interval, func(w io.Writer) error {
+		report, err := a.TaskService.GetStatusReport(ctx)
+		if err != nil {
+			return err
+		}
+		writeStatusReport(w, report)
+		return nil
+	})
+}
+
+// statusReportJSON is the --json representation of the status report
+type statusReportJSON struct {
+	Tracks    map[string]int       `json:"tracks"`
+	Tasks     map[string]int       `json:"tasks"`
+	ACs       map[string]int       `json:"acs"`
+	Iteration *iterationStatusJSON `json:"iteration"` // null if no iteration is current
+}
+
+type iterationStatusJSON struct {
+	Number    int    `json:"number"`
+	Name      string `json:"name"`
+	TasksDone int    `json:"tasks_done"`
+	Tasks     int    `json:"tasks"`
+}
+
+func writeStatusJSON(out io.Writer, report *dto.StatusReportDTO) error {
+	result := statusReportJSON{Tracks: report.Tracks, Tasks: report.Tasks, ACs: report.ACs}
+	if it := report.Iteration; it != nil {
+		result.Iteration = &iterationStatusJSON{Number: it.Number, Name: it.Name, TasksDone: it.TasksDone, Tasks: it.Tasks}
+	}
+
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(result)
+}
+
+func writeStatusReport(out io.Writer, report *dto.StatusReportDTO) {
+	tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "Tracks:\t%d\t%s\n", sumCounts(report.Tracks), formatStatusCounts(report.Tracks, trackStatusOrder))
+	fmt.Fprintf(tw, "Tasks:\t%d\t%s\n", sumCounts(report.Tasks), formatStatusCounts(report.Tasks, taskStatusOrder))
+	fmt.Fprintf(tw, "ACs:\t%d\t%s\n", sumCounts(report.ACs), formatStatusCounts(report.ACs, acStatusOrder))
+	tw.Flush()
+
+	it := report.Iteration
+	if it == nil {
+		fmt.Fprintf(out, "\nNo current iteration.\n")
+		return
+	}
+	fmt.Fprintf(out, "\nIteration %d: %s\n", it.Number, it.Name)
+	percent := 0.0
+	if it.Tasks > 0 {
+		percent = float64(it.TasksDone) / float64(it.Tasks) * 100
+	}
+	fmt.Fprintf(out, "  Progress (tasks): %d/%d (%.0f%%)\n", it.TasksDone, it.Tasks, percent)
+}
+
+// formatStatusCounts lists the non-zero counts as "status n", in order first and any
+// other status after them alphabetically
+func formatStatusCounts(counts map[string]int, order []string) string {
+	statuses := append([]string{}, order...)
+	var others []string
+	for status := range counts {
+		if !containsString(order, status) {
+			others = append(others, status)
+		}
+	}
+	sort.Strings(others)
+	statuses = append(statuses, others...)
+
+	var parts []string
+	for _, status := range statuses {
+		if counts[status] > 0 {
+			parts = append(parts, fmt.Sprintf("%s %d", status, counts[status]))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// sumCounts is the total of per-status counts
+func sumCounts(counts map[string]int) int {
+	total := 0
+	for _, n := range counts {
+		total += n
+	}
+	return total
+}
+
+// ============================================================================
+// ReportStaleCommandAdapter - Adapts CLI to GetStaleTasks query
+// ============================================================================
+
+// defaultStaleDays is the age after which 'report stale' lists an open task
+const defaultStaleDays = 14
+
+// ReportStaleCommandAdapter lists open tasks that have not been updated for a while
+type ReportStaleCommandAdapter struct {
+	TaskService  *application.TaskApplicationService
+	TrackService *application.TrackApplicationService // Scopes the report to the active roadmap
+
+	// CLI flags
+	project  string
+	days     int
+	assignee string
+	json     bool
+}
+
+func (a *ReportStaleCommandAdapter) GetName() string {
+	return "report stale"
+}
+
+func (a *ReportStaleCommandAdapter) GetDescription() string {
+	return "Show open tasks that have not been updated recently"
+}
+
+func (a *ReportStaleCommandAdapter) GetUsage() string {
+	return "dw task-manager report stale [--days <n>] [--assignee <who>] [--json] [--project <name>]"
+}
+
+func (a *ReportStaleCommandAdapter) GetHelp() string {
+	return `Lists todo and in-progress tasks whose last update is more than N days
+old, grouped by track and oldest first. Neglected tasks often hide a
+blocker; in-progress tasks are flagged with "!" since work that started
+long ago and never moved is the clearer warning sign.
+
+Flags:
+  --days <n>            Minimum days since the last update (default: 14)
+  --assignee <who>      Only tasks assigned to <who>
+  --json                Output as JSON (age in whole days)
+  --project <name>      Project name (optional)
+
+Examples:
+  dw task-manager report stale
+  dw task-manager report stale --days 30 --assignee alice
+  dw task-manager report stale --json | jq '[.[].tasks[]] | length'
+
+Notes:
+  - Any change to a task (status, title, rank, assignee...) resets its age
+  - Only tasks of the active roadmap are listed
+  - Review, done and cancelled tasks are not listed`
+}
+
+func (a *ReportStaleCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	a.days = defaultStaleDays
+
+	// Parse flags
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--project":
+			if i+1 < len(args) {
+				a.project = args[i+1]
+				i++
+			}
+		case "--days":
+			if i+1 < len(args) {
+				days, err := strconv.Atoi(args[i+1])
+				if err != nil || days < 1 {
+					return fmt.Errorf("%w: --days must be a positive number, got %q", pluginsdk.ErrInvalidArgument, args[i+1])
+				}
+				a.days = days
+				i++
+			}
+		case "--assignee":
+			if i+1 < len(args) {
+				a.assignee = strings.TrimSpace(args[i+1])
+				i++
+			}
+		case "--json":
+			a.json = true
+		}
+	}
+
+	roadmapID, err := activeRoadmapID(ctx, a.TrackService)
+	if err != nil {
+		return err
+	}
+	report, err := a.TaskService.GetStaleTasks(ctx, dto.StaleTasksQuery{
+		Days:      a.days,
+		Assignee:  a.assignee,
+		RoadmapID: roadmapID,
+		Now:       time.Now(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to find stale tasks: %w", err)
+	}
+
+	out := cmdCtx.GetStdout()
+	if a.json {
+		return writeStaleJSON(out, report)
+	}
+	writeStaleTable(out, report, a.days)
+	return nil
+}
+
+// staleTrackJSON is the --json representation of one track's stale tasks
+type staleTrackJSON struct {
+	TrackID    string          `json:"track_id"`
+	TrackTitle string          `json:"track_title"`
+	Tasks      []staleTaskJSON `json:"tasks"`
+}
+
+type staleTaskJSON struct {
+	ID        string    `json:"id"`
+	Title     string    `json:"title"`
+	Status    string    `json:"status"`
+	Assignee  string    `json:"assignee"`
+	UpdatedAt time.Time `json:"updated_at"`
+	AgeDays   int       `json:"age_days"`
+}
+
+func writeStaleJSON(out io.Writer, report []dto.StaleTrackDTO) error {
+	tracks := make([]staleTrackJSON, 0, len(report))
+	for _, group := range report {
+		track := staleTrackJSON{TrackID: group.TrackID, TrackTitle: group.TrackTitle, Tasks: []staleTaskJSON{}}
+		for _, task := range group.Tasks {
+			track.Tasks = append(track.Tasks, staleTaskJSON{
+				ID:        task.ID,
+				Title:     task.Title,
+				Status:    task.Status,
+				Assignee:  task.Assignee,
+				UpdatedAt: task.UpdatedAt,
+				AgeDays:   staleAgeDays(task.Age),
+			})
+		}
+		tracks = append(tracks, track)
+	}
+
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(tracks)
+}
+
+func writeStaleTable(out io.Writer, report []dto.StaleTrackDTO, days int) {
+	if len(report) == 0 {
+		fmt.Fprintf(out, "No open tasks older than %d day(s).\n", days)
+		return
+	}
+
+	total, inProgress := 0, 0
+	for _, group := range report {
+		for _, task := range group.Tasks {
+			total++
+			if task.Status == string(entities.TaskStatusInProgress) {
+				inProgress++
+			}
+		}
+	}
+	fmt.Fprintf(out, "%d task(s) not updated in %d+ days, %d in progress\n", total, days, inProgress)
+
+	for _, group := range report {
+		title := group.TrackTitle
+		if title == "" {
+			title = "(unknown track)"
+		}
+		fmt.Fprintf(out, "\n%s (%s)\n", title, group.TrackID)
+
+		tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		for _, task := range group.Tasks {
+			flag := " "
+			if task.Status == string(entities.TaskStatusInProgress) {
+				flag = "!"
+			}
+			assignee := task.Assignee
+			if assignee == "" {
+				assignee = unassignedLabel
+			}
+			fmt.Fprintf(tw, "  %s %s\t%s\t%dd\t%s\t%s\n", flag, task.ID, task.Status, staleAgeDays(task.Age), assignee, task.Title)
+		}
+		tw.Flush()
+	}
+}
+
+// staleAgeDays is an age in whole days
+func staleAgeDays(age time.Duration) int {
+	return int(age / (24 * time.Hour))
+}
+
+// ============================================================================
+// ReportBlockedChainCommandAdapter - Adapts CLI to TraceBlockedChain query
+// ============================================================================
+
+// ReportBlockedChainCommandAdapter traces the dependencies and gates blocking a task
+type ReportBlockedChainCommandAdapter struct {
+	TaskService *application.TaskApplicationService
+
+	// CLI flags
+	project string
+	taskID  string
+	json    bool
+}
+
+func (a *ReportBlockedChainCommandAdapter) GetName() string {
+	return "report blocked-chain"
+}
+
+func (a *ReportBlockedChainCommandAdapter) GetDescription() string {
+	return "Trace why a task is blocked down to the root blockers"
+}
+
+func (a *ReportBlockedChainCommandAdapter) GetUsage() string {
+	return "dw task-manager report blocked-chain <task-id> [--json] [--project <name>]"
+}
+
+func (a *ReportBlockedChainCommandAdapter) GetHelp() string {
+	return `Walks everything a task waits on and prints it as an indented tree:
+the dependency tracks of the task's track (and their own dependencies), and
+the task's gating ACs (and the tasks owning them, which may be blocked in turn).
+
+Each link shows its status and is marked ✓ when satisfied (task done, track
+complete, AC verified) or ✗ while it still blocks. Satisfied links are not
+expanded. The incomplete links with nothing incomplete below them are the
+root blockers: finish those first.
+
+Flags:
+  --json                Output the chain as JSON
+  --project <name>      Project name (optional)
+
+Examples:
+  dw task-manager report blocked-chain DW-task-42
+  dw task-manager report blocked-chain DW-task-42 --json | jq '.root_blockers'
+
+Notes:
+  - A link already expanded elsewhere is shown once more as "(see above)"
+  - A link that leads back to itself is marked "(cycle)" and not followed`
+}
+
+func (a *ReportBlockedChainCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	// Parse flags
+	a.taskID = ""
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--project":
+			if i+1 < len(args) {
+				a.project = args[i+1]
+				i++
+			}
+		case "--json":
+			a.json = true
+		default:
+			if a.taskID == "" && !strings.HasPrefix(args[i], "--") {
+				a.taskID = args[i]
+			}
+		}
+	}
+	if a.taskID == "" {
+		return fmt.Errorf("%w: task ID is required", pluginsdk.ErrInvalidArgument)
+	}
+
+	chain, err := a.TaskService.TraceBlockedChain(ctx, a.taskID)
+	if err != nil {
+		return fmt.Errorf("failed to trace blocked chain: %w", err)
+	}
+
+	out := cmdCtx.GetStdout()
+	if a.json {
+		return writeBlockedChainJSON(out, chain)
+	}
+	writeBlockedChainTree(out, chain)
+	return nil
+}
+
+// blockerLinkJSON is the --json representation of one link of a blocker chain
+type blockerLinkJSON struct {
+	Kind      string            `json:"kind"`
+	ID        string            `json:"id"`
+	Title     string            `json:"title"`
+	Status    string            `json:"status"`
+	Detail    string            `json:"detail,omitempty"`
+	Satisfied bool              `json:"satisfied"`
+	Cycle     bool              `json:"cycle,omitempty"`
+	Repeated  bool              `json:"repeated,omitempty"`
+	Blockers  []blockerLinkJSON `json:"blockers,omitempty"`
+}
+
+type blockedChainJSON struct {
+	Blocked      bool              `json:"blocked"`
+	RootBlockers []blockerLinkJSON `json:"root_blockers"`
+	Chain        blockerLinkJSON   `json:"chain"`
+}
+
+func toBlockerLinkJSON(link *entities.BlockerLink, withBlockers bool) blockerLinkJSON {
+	record := blockerLinkJSON{
+		Kind:      string(link.Kind),
+		ID:        link.ID,
+		Title:     link.Title,
+		Status:    link.Status,
+		Detail:    link.Detail,
+		Satisfied: link.Satisfied,
+		Cycle:     link.Cycle,
+		Repeated:  link.Repeated,
+	}
+	if withBlockers {
+		for _, blocker := range link.Blockers {
+			record.Blockers = append(record.Blockers, toBlockerLinkJSON(blocker, true))
+		}
+	}
+	return record
+}
+
+func writeBlockedChainJSON(out io.Writer, chain *entities.BlockerLink) error {
+	report := blockedChainJSON{
+		Blocked:      chain.IsBlocked(),
+		RootBlockers: []blockerLinkJSON{},
+		Chain:        toBlockerLinkJSON(chain, true),
+	}
+	for _, root := range chain.RootBlockers() {
+		report.RootBlockers = append(report.RootBlockers, toBlockerLinkJSON(root, false))
+	}
+
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(report)
+}
+
+func writeBlockedChainTree(out io.Writer, chain *entities.BlockerLink) {
+	roots := chain.RootBlockers()
+	isRoot := make(map[entities.BlockerRef]bool, len(roots))
+	for _, root := range roots {
+		isRoot[root.Ref()] = true
+	}
+
+	fmt.Fprintf(out, "%s %s [%s]\n", chain.ID, chain.Title, chain.Status)
+	switch {
+	case chain.Satisfied:
+		fmt.Fprintf(out, "\nTask is %s: nothing blocks it.\n", chain.Status)
+		return
+	case !chain.IsBlocked():
+		if len(chain.Blockers) == 0 {
+			fmt.Fprintf(out, "\nNot blocked: the task has no dependencies or gates.\n")
+		} else {
+			writeBlockerLinks(out, chain.Blockers, 1, isRoot)
+			fmt.Fprintf(out, "\nNot blocked: every dependency and gate is satisfied.\n")
+		}
+		return
+	}
+
+	writeBlockerLinks(out, chain.Blockers, 1, isRoot)
+
+	fmt.Fprintf(out, "\nRoot blockers:\n")
+	if len(roots) == 0 && chain.HasCycle() {
+		fmt.Fprintf(out, "  none: the chain is a dependency cycle, break it first\n")
+	}
+	for _, root := range roots {
+		fmt.Fprintf(out, "  %s %s %s [%s]\n", root.Kind, root.ID, root.Title, root.Status)
+	}
+}
+
+func writeBlockerLinks(out io.Writer, links []*entities.BlockerLink, depth int, isRoot map[entities.BlockerRef]bool) {
+	indent := strings.Repeat("  ", depth)
+	for _, link := range links {
+		mark := "✗"
+		if link.Satisfied {
+			mark = "✓"
+		}
+		line := fmt.Sprintf("%s%s %s %s %s [%s]", indent, mark, link.Kind, link.ID, link.Title, link.Status)
+		if link.Detail != "" {
+			line += " " + link.Detail
+		}
+		switch {
+		case link.Cycle:
+			line += " (cycle)"
+		case link.Repeated:
+			line += " (see above)"
+		case isRoot[link.Ref()]:
+			line += "  <- root blocker"
+		}
+		fmt.Fprintln(out, line)
+		writeBlockerLinks(out, link.Blockers, depth+1, isRoot)
+	}
+}
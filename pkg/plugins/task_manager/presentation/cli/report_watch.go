@@ -0,0 +1,60 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"golang.org/x/term"
+)
+
+// Terminal control sequences used by watchReport
+const (
+	clearScreen = "\033[H\033[2J"
+	hideCursor  = "\033[?25l"
+	showCursor  = "\033[?25h"
+)
+
+// isTerminal reports whether out writes to a terminal
+func isTerminal(out io.Writer) bool {
+	f, ok := out.(*os.File)
+	return ok && term.IsTerminal(int(f.Fd()))
+}
+
+// watchReport redraws the report written by render every interval until ctx is done.
+// Each frame is rendered off-screen first, so the screen only flickers once per tick.
+// A failed render does not stop the loop: the last good frame stays on screen with
+// the error below it until a later render succeeds.
+func watchReport(ctx context.Context, out io.Writer, interval time.Duration, render func(io.Writer) error) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	fmt.Fprint(out, hideCursor)
+	defer fmt.Fprint(out, showCursor)
+
+	var lastFrame []byte
+	for {
+		var frame bytes.Buffer
+		err := render(&frame)
+		now := time.Now().Format("15:04:05")
+
+		fmt.Fprint(out, clearScreen)
+		if err == nil {
+			lastFrame = frame.Bytes()
+			out.Write(lastFrame)
+			fmt.Fprintf(out, "\nUpdated %s, refreshing every %s (Ctrl+C to stop)\n", now, interval)
+		} else {
+			out.Write(lastFrame)
+			fmt.Fprintf(out, "\nRefresh failed at %s: %v (retrying in %s)\n", now, err, interval)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWatchReport_KeepsRunningAfterRenderErrors(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	renders := 0
+	render := func(w io.Writer) error {
+		renders++
+		switch renders {
+		case 2:
+			return errors.New("database is locked")
+		case 3:
+			cancel()
+		}
+		fmt.Fprintf(w, "frame %d\n", renders)
+		return nil
+	}
+
+	var out bytes.Buffer
+	if err := watchReport(ctx, &out, time.Millisecond, render); err != nil {
+		t.Fatalf("watchReport failed: %v", err)
+	}
+	if renders != 3 {
+		t.Fatalf("expected 3 renders, got %d", renders)
+	}
+
+	frames := strings.Split(out.String(), clearScreen)
+	if len(frames) != 4 {
+		t.Fatalf("expected the screen to be cleared 3 times, got %d:\n%q", len(frames)-1, out.String())
+	}
+	if !strings.HasPrefix(frames[0], hideCursor) || !strings.HasSuffix(out.String(), showCursor) {
+		t.Errorf("expected the cursor to be hidden and restored:\n%q", out.String())
+	}
+	failed := frames[2]
+	if !strings.Contains(failed, "frame 1") || !strings.Contains(failed, "Refresh failed at") || !strings.Contains(failed, "database is locked") {
+		t.Errorf("expected the last good frame with the error, got:\n%s", failed)
+	}
+	if last := frames[3]; !strings.Contains(last, "frame 3") || strings.Contains(last, "Refresh failed") {
+		t.Errorf("expected the error to clear once a render succeeds, got:\n%s", last)
+	}
+}
+
+func TestIsTerminal(t *testing.T) {
+	if isTerminal(&bytes.Buffer{}) {
+		t.Error("a buffer is not a terminal")
+	}
+}
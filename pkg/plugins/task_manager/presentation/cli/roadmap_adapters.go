@@ -25,6 +25,7 @@ type RoadmapInitCommandAdapter struct {
 	project         string
 	vision          string
 	successCriteria string
+	additional      bool
 }
 
 func (c *RoadmapInitCommandAdapter) GetName() string {
@@ -36,18 +37,21 @@ func (c *RoadmapInitCommandAdapter) GetDescription() string {
 }
 
 func (c *RoadmapInitCommandAdapter) GetUsage() string {
-	return "dw task-manager roadmap init --vision <vision> --success-criteria <criteria>"
+	return "dw task-manager roadmap init --vision <vision> --success-criteria <criteria> [--additional]"
 }
 
 func (c *RoadmapInitCommandAdapter) GetHelp() string {
 	return `Creates a new roadmap with a vision statement and success criteria.
 
-Only one active roadmap can exist at a time. If you need to replace the current
-roadmap, delete it first using 'dw task-manager roadmap delete'.
+Only one roadmap is active at a time. To pivot to a new plan while keeping the
+current one, pass --additional: the new roadmap is created next to the existing
+ones and becomes the active roadmap. Use 'dw task-manager roadmap list' and
+'dw task-manager roadmap activate' to switch between them.
 
 Flags:
   --vision <vision>              The vision statement for the roadmap (required)
   --success-criteria <criteria>  Success criteria for the roadmap (required)
+  --additional                   Create another roadmap if one already exists and activate it
   --project <name>               Project name (optional, uses active project if not specified)
 
 Examples:
@@ -61,6 +65,11 @@ Examples:
     --vision "Create unified productivity platform" \
     --success-criteria "100% test coverage, zero violations"
 
+  # Start a v2 plan next to the current roadmap
+  dw task-manager roadmap init --additional \
+    --vision "Platform v2" \
+    --success-criteria "Migrate all plugins"
+
 Notes:
   - Vision must be non-empty
   - Success criteria must be non-empty
@@ -86,21 +95,24 @@ func (c *RoadmapInitCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsd
 				c.successCriteria = args[i+1]
 				i++
 			}
+		case "--additional":
+			c.additional = true
 		}
 	}
 
 	// Validate required flags
 	if c.vision == "" {
-		return fmt.Errorf("--vision is required")
+		return fmt.Errorf("%w: --vision is required", pluginsdk.ErrInvalidArgument)
 	}
 	if c.successCriteria == "" {
-		return fmt.Errorf("--success-criteria is required")
+		return fmt.Errorf("%w: --success-criteria is required", pluginsdk.ErrInvalidArgument)
 	}
 
 	// Create DTO
 	input := dto.CreateRoadmapDTO{
 		Vision:          c.vision,
 		SuccessCriteria: c.successCriteria,
+		Additional:      c.additional,
 	}
 
 	// Execute via application service
@@ -369,6 +381,8 @@ func (c *RoadmapFullCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsd
 	// Parse flags
 	c.format = "markdown"
 	c.sections = nil
+	// The global --verbose flag is stripped by dw before the command sees its args
+	c.verbose = pluginsdk.GetVerbosity(cmdCtx) == pluginsdk.VerbosityVerbose
 
 	for i := 0; i < len(args); i++ {
 		switch args[i] {
@@ -460,7 +474,7 @@ func (c *RoadmapFullCommandAdapter) outputMarkdown(cmdCtx pluginsdk.CommandConte
 			}
 
 			// Get tasks for this track
-			trackTasks := filterTasksByTrack(tasks, track.ID)
+			trackTasks := c.convertToTasks(overview.TasksByTrack[track.ID])
 			if len(trackTasks) > 0 {
 				fmt.Fprintf(out, "**Progress**: %d/%d tasks complete\n", countCompleteTasks(trackTasks), len(trackTasks))
 				fmt.Fprintf(out, "**Tasks**:\n")
@@ -490,7 +504,7 @@ func (c *RoadmapFullCommandAdapter) outputMarkdown(cmdCtx pluginsdk.CommandConte
 			}
 
 			// Get tasks for this iteration
-			iterTasks := filterTasksByIDs(tasks, iter.TaskIDs)
+			iterTasks := c.convertToTasks(overview.TasksByIteration[iter.Number])
 			if len(iterTasks) > 0 {
 				fmt.Fprintf(out, "**Progress**: %d/%d tasks complete (%.0f%%)\n",
 					countCompleteTasks(iterTasks), len(iterTasks),
@@ -511,7 +525,7 @@ func (c *RoadmapFullCommandAdapter) outputMarkdown(cmdCtx pluginsdk.CommandConte
 
 	// Backlog section
 	if options.ShouldShowSection("backlog") {
-		backlogTasks := getBacklogTasks(tasks, iterations)
+		backlogTasks := getBacklogTasks(tasks, overview.TasksByIteration)
 		if len(backlogTasks) > 0 {
 			fmt.Fprintf(out, "## Backlog\n\n")
 			fmt.Fprintf(out, "%d tasks not assigned to any iteration:\n\n", len(backlogTasks))
@@ -559,30 +573,6 @@ func (c *RoadmapFullCommandAdapter) convertToIterations(iterations []interface{}
 // Helper Functions
 // ============================================================================
 
-func filterTasksByTrack(tasks []*entities.TaskEntity, trackID string) []*entities.TaskEntity {
-	var result []*entities.TaskEntity
-	for _, task := range tasks {
-		if task.TrackID == trackID {
-			result = append(result, task)
-		}
-	}
-	return result
-}
-
-func filterTasksByIDs(tasks []*entities.TaskEntity, taskIDs []string) []*entities.TaskEntity {
-	var result []*entities.TaskEntity
-	taskMap := make(map[string]*entities.TaskEntity)
-	for _, task := range tasks {
-		taskMap[task.ID] = task
-	}
-	for _, id := range taskIDs {
-		if task, ok := taskMap[id]; ok {
-			result = append(result, task)
-		}
-	}
-	return result
-}
-
 func countCompleteTasks(tasks []*entities.TaskEntity) int {
 	count := 0
 	for _, task := range tasks {
@@ -593,12 +583,12 @@ func countCompleteTasks(tasks []*entities.TaskEntity) int {
 	return count
 }
 
-func getBacklogTasks(tasks []*entities.TaskEntity, iterations []*entities.IterationEntity) []*entities.TaskEntity {
+func getBacklogTasks(tasks []*entities.TaskEntity, tasksByIteration map[int][]interface{}) []*entities.TaskEntity {
 	// Build set of all task IDs in iterations
 	inIteration := make(map[string]bool)
-	for _, iter := range iterations {
-		for _, taskID := range iter.TaskIDs {
-			inIteration[taskID] = true
+	for _, iterTasks := range tasksByIteration {
+		for _, t := range iterTasks {
+			inIteration[t.(*entities.TaskEntity).ID] = true
 		}
 	}
 
@@ -0,0 +1,356 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// ============================================================================
+// RoadmapCriteriaAddCommandAdapter - Adapts CLI to AddCriterion use case
+// ============================================================================
+
+// RoadmapCriteriaAddCommandAdapter adapts roadmap criteria add CLI command to application use case
+type RoadmapCriteriaAddCommandAdapter struct {
+	RoadmapService *application.RoadmapApplicationService
+
+	// CLI flags (parsed from args)
+	project string
+	text    string
+}
+
+func (c *RoadmapCriteriaAddCommandAdapter) GetName() string {
+	return "roadmap criteria add"
+}
+
+func (c *RoadmapCriteriaAddCommandAdapter) GetDescription() string {
+	return "Add a tracked success criterion to the roadmap"
+}
+
+func (c *RoadmapCriteriaAddCommandAdapter) GetUsage() string {
+	return "dw task-manager roadmap criteria add <text>"
+}
+
+func (c *RoadmapCriteriaAddCommandAdapter) GetHelp() string {
+	return `Adds a discrete success criterion to the active roadmap.
+
+Tracked criteria can be checked off individually and are summarized in
+'dw task-manager roadmap stats' and in the TUI dashboard header.
+
+Flags:
+  --project <name>    Project name (optional, uses active project if not specified)
+
+Examples:
+  dw task-manager roadmap criteria add "Support 10 plugins"
+  dw task-manager roadmap criteria add "Zero architecture violations"
+
+Notes:
+  - Criterion text must be non-empty
+  - New criteria start unchecked`
+}
+
+func (c *RoadmapCriteriaAddCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	// Parse flags and positional text
+	var textParts []string
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--project":
+			if i+1 < len(args) {
+				c.project = args[i+1]
+				i++
+			}
+		default:
+			textParts = append(textParts, args[i])
+		}
+	}
+	c.text = strings.Join(textParts, " ")
+
+	if c.text == "" {
+		return fmt.Errorf("%w: criterion text is required", pluginsdk.ErrInvalidArgument)
+	}
+
+	criterion, err := c.RoadmapService.AddCriterion(ctx, c.text)
+	if err != nil {
+		return fmt.Errorf("failed to add criterion: %w", err)
+	}
+
+	out := cmdCtx.GetStdout()
+	fmt.Fprintf(out, "Criterion added successfully\n")
+	fmt.Fprintf(out, "  ID:   %d\n", criterion.ID)
+	fmt.Fprintf(out, "  Text: %s\n", criterion.Text)
+
+	return nil
+}
+
+// ============================================================================
+// RoadmapCriteriaListCommandAdapter - Adapts CLI to ListCriteria use case
+// ============================================================================
+
+// RoadmapCriteriaListCommandAdapter adapts roadmap criteria list CLI command to application use case
+type RoadmapCriteriaListCommandAdapter struct {
+	RoadmapService *application.RoadmapApplicationService
+
+	// CLI flags (parsed from args)
+	project string
+	migrate bool
+}
+
+func (c *RoadmapCriteriaListCommandAdapter) GetName() string {
+	return "roadmap criteria list"
+}
+
+func (c *RoadmapCriteriaListCommandAdapter) GetDescription() string {
+	return "List tracked success criteria of the roadmap"
+}
+
+func (c *RoadmapCriteriaListCommandAdapter) GetUsage() string {
+	return "dw task-manager roadmap criteria list [--migrate]"
+}
+
+func (c *RoadmapCriteriaListCommandAdapter) GetHelp() string {
+	return `Lists the tracked success criteria of the active roadmap with their status.
+
+Roadmaps created before criteria tracking only have free-text success criteria.
+Use --migrate to split that text on newlines into unchecked criteria. Migration
+only happens when the roadmap has no tracked criteria yet, and the free-text
+criteria are left untouched.
+
+Flags:
+  --migrate           Import free-text success criteria as tracked criteria
+  --project <name>    Project name (optional, uses active project if not specified)
+
+Examples:
+  dw task-manager roadmap criteria list
+  dw task-manager roadmap criteria list --migrate
+
+Output:
+  Success Criteria: 1/2 met
+    [x] 1  Support 10 plugins
+    [ ] 2  Zero architecture violations`
+}
+
+func (c *RoadmapCriteriaListCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	// Parse flags
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--project":
+			if i+1 < len(args) {
+				c.project = args[i+1]
+				i++
+			}
+		case "--migrate":
+			c.migrate = true
+		}
+	}
+
+	out := cmdCtx.GetStdout()
+
+	if c.migrate {
+		migrated, err := c.RoadmapService.MigrateCriteria(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to migrate criteria: %w", err)
+		}
+		if len(migrated) > 0 {
+			fmt.Fprintf(out, "Migrated %d criteria from free-text success criteria\n\n", len(migrated))
+		}
+	}
+
+	criteria, err := c.RoadmapService.ListCriteria(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list criteria: %w", err)
+	}
+
+	if len(criteria) == 0 {
+		fmt.Fprintf(out, "No tracked success criteria.\n")
+		if !c.migrate {
+			fmt.Fprintf(out, "Run 'dw task-manager roadmap criteria list --migrate' to import the free-text criteria,\n")
+			fmt.Fprintf(out, "or 'dw task-manager roadmap criteria add <text>' to add one.\n")
+		}
+		return nil
+	}
+
+	met, total := entities.CountMetCriteria(criteria)
+	fmt.Fprintf(out, "Success Criteria: %d/%d met\n", met, total)
+	for _, criterion := range criteria {
+		mark := " "
+		if criterion.Met {
+			mark = "x"
+		}
+		fmt.Fprintf(out, "  [%s] %-3d %s\n", mark, criterion.ID, criterion.Text)
+	}
+
+	return nil
+}
+
+// ============================================================================
+// RoadmapCriteriaCheckCommandAdapter - Adapts CLI to SetCriterionMet use case
+// ============================================================================
+
+// RoadmapCriteriaCheckCommandAdapter adapts roadmap criteria check/uncheck CLI commands to application use case.
+// Met selects between "check" (true) and "uncheck" (false).
+type RoadmapCriteriaCheckCommandAdapter struct {
+	RoadmapService *application.RoadmapApplicationService
+	Met            bool
+
+	// CLI flags (parsed from args)
+	project string
+}
+
+func (c *RoadmapCriteriaCheckCommandAdapter) verb() string {
+	if c.Met {
+		return "check"
+	}
+	return "uncheck"
+}
+
+func (c *RoadmapCriteriaCheckCommandAdapter) GetName() string {
+	return "roadmap criteria " + c.verb()
+}
+
+func (c *RoadmapCriteriaCheckCommandAdapter) GetDescription() string {
+	if c.Met {
+		return "Mark a success criterion as met"
+	}
+	return "Mark a success criterion as not met"
+}
+
+func (c *RoadmapCriteriaCheckCommandAdapter) GetUsage() string {
+	return fmt.Sprintf("dw task-manager roadmap criteria %s <id>", c.verb())
+}
+
+func (c *RoadmapCriteriaCheckCommandAdapter) GetHelp() string {
+	return fmt.Sprintf(`%s.
+
+Use 'dw task-manager roadmap criteria list' to find criterion IDs.
+
+Flags:
+  --project <name>    Project name (optional, uses active project if not specified)
+
+Examples:
+  dw task-manager roadmap criteria %s 3`, c.GetDescription(), c.verb())
+}
+
+func (c *RoadmapCriteriaCheckCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	// Parse criterion ID
+	if len(args) == 0 {
+		return fmt.Errorf("%w: criterion ID is required", pluginsdk.ErrInvalidArgument)
+	}
+	id, err := strconv.Atoi(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid criterion ID %q: must be a number", args[0])
+	}
+	args = args[1:]
+
+	// Parse flags
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--project":
+			if i+1 < len(args) {
+				c.project = args[i+1]
+				i++
+			}
+		}
+	}
+
+	criterion, err := c.RoadmapService.SetCriterionMet(ctx, id, c.Met)
+	if err != nil {
+		return fmt.Errorf("failed to %s criterion: %w", c.verb(), err)
+	}
+
+	out := cmdCtx.GetStdout()
+	if criterion.Met {
+		fmt.Fprintf(out, "Criterion %d marked as met\n", criterion.ID)
+		fmt.Fprintf(out, "  Text:   %s\n", criterion.Text)
+		fmt.Fprintf(out, "  Met at: %s\n", criterion.MetAt.Format(time.RFC3339))
+	} else {
+		fmt.Fprintf(out, "Criterion %d marked as not met\n", criterion.ID)
+		fmt.Fprintf(out, "  Text:   %s\n", criterion.Text)
+	}
+
+	return nil
+}
+
+// ============================================================================
+// RoadmapStatsCommandAdapter - Adapts CLI to GetStats use case
+// ============================================================================
+
+// RoadmapStatsCommandAdapter adapts roadmap stats CLI command to application use case
+type RoadmapStatsCommandAdapter struct {
+	RoadmapService *application.RoadmapApplicationService
+
+	// CLI flags (parsed from args)
+	project string
+}
+
+func (c *RoadmapStatsCommandAdapter) GetName() string {
+	return "roadmap stats"
+}
+
+func (c *RoadmapStatsCommandAdapter) GetDescription() string {
+	return "Show roadmap progress against success criteria"
+}
+
+func (c *RoadmapStatsCommandAdapter) GetUsage() string {
+	return "dw task-manager roadmap stats"
+}
+
+func (c *RoadmapStatsCommandAdapter) GetHelp() string {
+	return `Shows progress of the active roadmap: met success criteria, completed
+tracks and done tasks.
+
+Flags:
+  --project <name>    Project name (optional, uses active project if not specified)
+
+Examples:
+  dw task-manager roadmap stats
+
+Output:
+  Roadmap: roadmap-1234567890
+    Success Criteria:  3/7 met (43%)
+    Tracks:            2/5 complete (40%)
+    Tasks:             12/30 done (40%)`
+}
+
+func (c *RoadmapStatsCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	// Parse flags
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--project":
+			if i+1 < len(args) {
+				c.project = args[i+1]
+				i++
+			}
+		}
+	}
+
+	stats, err := c.RoadmapService.GetStats(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get roadmap stats: %w", err)
+	}
+
+	out := cmdCtx.GetStdout()
+	fmt.Fprintf(out, "Roadmap: %s\n", stats.RoadmapID)
+	if stats.CriteriaTotal == 0 {
+		fmt.Fprintf(out, "  Success Criteria:  not tracked (see 'dw task-manager roadmap criteria list --migrate')\n")
+	} else {
+		fmt.Fprintf(out, "  Success Criteria:  %d/%d met (%s)\n", stats.CriteriaMet, stats.CriteriaTotal, formatPercent(stats.CriteriaMet, stats.CriteriaTotal))
+	}
+	fmt.Fprintf(out, "  Tracks:            %d/%d complete (%s)\n", stats.TracksComplete, stats.TracksTotal, formatPercent(stats.TracksComplete, stats.TracksTotal))
+	fmt.Fprintf(out, "  Tasks:             %d/%d done (%s)\n", stats.TasksDone, stats.TasksTotal, formatPercent(stats.TasksDone, stats.TasksTotal))
+
+	return nil
+}
+
+// formatPercent formats done/total as a whole-number percentage
+func formatPercent(done, total int) string {
+	if total == 0 {
+		return "0%"
+	}
+	return fmt.Sprintf("%.0f%%", float64(done)*100/float64(total))
+}
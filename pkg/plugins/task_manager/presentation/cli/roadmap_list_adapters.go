@@ -0,0 +1,287 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// activeRoadmapID returns the ID of the active roadmap, or "" when the project has no
+// roadmap yet or trackService is nil. Listings use it to hide other roadmaps' tasks.
+func activeRoadmapID(ctx context.Context, trackService *application.TrackApplicationService) (string, error) {
+	if trackService == nil {
+		return "", nil
+	}
+	roadmap, err := trackService.GetActiveRoadmap(ctx)
+	if errors.Is(err, pluginsdk.ErrNotFound) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get active roadmap: %w", err)
+	}
+	return roadmap.ID, nil
+}
+
+// roadmapListVisionWidth is the display width the vision is truncated to in 'roadmap list'
+const roadmapListVisionWidth = 60
+
+// ============================================================================
+// RoadmapListCommandAdapter - Adapts CLI to ListRoadmaps use case
+// ============================================================================
+
+// RoadmapListCommandAdapter adapts roadmap list CLI command to application use case
+type RoadmapListCommandAdapter struct {
+	RoadmapService *application.RoadmapApplicationService
+
+	// CLI flags (parsed from args)
+	project string
+}
+
+func (c *RoadmapListCommandAdapter) GetName() string {
+	return "roadmap list"
+}
+
+func (c *RoadmapListCommandAdapter) GetDescription() string {
+	return "List all roadmaps of the project"
+}
+
+func (c *RoadmapListCommandAdapter) GetUsage() string {
+	return "dw task-manager roadmap list"
+}
+
+func (c *RoadmapListCommandAdapter) GetHelp() string {
+	return `Lists all roadmaps of the project in creation order, including archived ones.
+The active roadmap, which all roadmap and track commands work on, is marked with *.
+
+Examples:
+  dw task-manager roadmap list
+
+Output:
+    ID                   STATUS    VISION
+    roadmap-1730368800   archived  Build extensible framework
+  * roadmap-1733047200   active    Platform v2`
+}
+
+func (c *RoadmapListCommandAdapter) GetArgSpec() pluginsdk.CommandArgSpec {
+	return pluginsdk.CommandArgSpec{
+		Flags: []pluginsdk.FlagSpec{
+			{Name: "project", Value: "name", Description: "Project name"},
+		},
+	}
+}
+
+func (c *RoadmapListCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	// Parse flags
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--project":
+			if i+1 < len(args) {
+				c.project = args[i+1]
+				i++
+			}
+		}
+	}
+
+	roadmaps, activeID, err := c.RoadmapService.ListRoadmaps(ctx)
+	if err != nil {
+		return err
+	}
+
+	out := cmdCtx.GetStdout()
+	if len(roadmaps) == 0 {
+		fmt.Fprintf(out, "No roadmaps found.\n")
+		fmt.Fprintf(out, "Run 'dw task-manager roadmap init' to create one.\n")
+		return nil
+	}
+
+	idWidth := len("ID")
+	for _, roadmap := range roadmaps {
+		if len(roadmap.ID) > idWidth {
+			idWidth = len(roadmap.ID)
+		}
+	}
+
+	fmt.Fprintf(out, "  %-*s  %-8s  %s\n", idWidth, "ID", "STATUS", "VISION")
+	for _, roadmap := range roadmaps {
+		marker := " "
+		status := ""
+		switch {
+		case roadmap.ID == activeID:
+			marker = "*"
+			status = "active"
+		case roadmap.IsArchived():
+			status = "archived"
+		}
+		fmt.Fprintf(out, "%s %-*s  %-8s  %s\n", marker, idWidth, roadmap.ID, status, truncateDisplay(roadmap.Vision, roadmapListVisionWidth))
+	}
+
+	return nil
+}
+
+// ============================================================================
+// RoadmapActivateCommandAdapter - Adapts CLI to ActivateRoadmap use case
+// ============================================================================
+
+// RoadmapActivateCommandAdapter adapts roadmap activate CLI command to application use case
+type RoadmapActivateCommandAdapter struct {
+	RoadmapService *application.RoadmapApplicationService
+
+	// CLI flags (parsed from args)
+	project   string
+	roadmapID string
+}
+
+func (c *RoadmapActivateCommandAdapter) GetName() string {
+	return "roadmap activate"
+}
+
+func (c *RoadmapActivateCommandAdapter) GetDescription() string {
+	return "Make a roadmap the active one"
+}
+
+func (c *RoadmapActivateCommandAdapter) GetUsage() string {
+	return "dw task-manager roadmap activate <roadmap-id>"
+}
+
+func (c *RoadmapActivateCommandAdapter) GetHelp() string {
+	return `Makes a roadmap the active one, so roadmap and track commands and the TUI
+work on it. The choice is stored in the project, so it holds until another
+roadmap is activated. Activating an archived roadmap restores it.
+
+Examples:
+  dw task-manager roadmap list
+  dw task-manager roadmap activate roadmap-1733047200`
+}
+
+func (c *RoadmapActivateCommandAdapter) GetArgSpec() pluginsdk.CommandArgSpec {
+	return pluginsdk.CommandArgSpec{
+		Positionals: []pluginsdk.PositionalSpec{
+			{Name: "roadmap-id", Required: true, Description: "Roadmap ID to activate"},
+		},
+		Flags: []pluginsdk.FlagSpec{
+			{Name: "project", Value: "name", Description: "Project name"},
+		},
+	}
+}
+
+func (c *RoadmapActivateCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	// Parse roadmap ID
+	if len(args) == 0 {
+		return fmt.Errorf("%w: roadmap ID is required", pluginsdk.ErrInvalidArgument)
+	}
+	c.roadmapID = args[0]
+	args = args[1:]
+
+	// Parse flags
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--project":
+			if i+1 < len(args) {
+				c.project = args[i+1]
+				i++
+			}
+		}
+	}
+
+	roadmap, err := c.RoadmapService.ActivateRoadmap(ctx, c.roadmapID)
+	if err != nil {
+		return fmt.Errorf("failed to activate roadmap: %w", err)
+	}
+
+	out := cmdCtx.GetStdout()
+	fmt.Fprintf(out, "Active roadmap: %s\n", roadmap.ID)
+	fmt.Fprintf(out, "Vision:         %s\n", roadmap.Vision)
+
+	return nil
+}
+
+// ============================================================================
+// RoadmapArchiveCommandAdapter - Adapts CLI to ArchiveRoadmap use case
+// ============================================================================
+
+// RoadmapArchiveCommandAdapter adapts roadmap archive CLI command to application use case
+type RoadmapArchiveCommandAdapter struct {
+	RoadmapService *application.RoadmapApplicationService
+
+	// CLI flags (parsed from args)
+	project   string
+	roadmapID string
+}
+
+func (c *RoadmapArchiveCommandAdapter) GetName() string {
+	return "roadmap archive"
+}
+
+func (c *RoadmapArchiveCommandAdapter) GetDescription() string {
+	return "Archive a roadmap"
+}
+
+func (c *RoadmapArchiveCommandAdapter) GetUsage() string {
+	return "dw task-manager roadmap archive <roadmap-id>"
+}
+
+func (c *RoadmapArchiveCommandAdapter) GetHelp() string {
+	return `Archives a roadmap that is no longer pursued. Its tracks, criteria and history
+are kept and it still shows in 'roadmap list', but it is never picked as the
+active roadmap. If the archived roadmap was active, the most recently created
+unarchived roadmap becomes active.
+
+Restore an archived roadmap with 'dw task-manager roadmap activate <roadmap-id>'.
+
+Examples:
+  dw task-manager roadmap archive roadmap-1730368800`
+}
+
+func (c *RoadmapArchiveCommandAdapter) GetArgSpec() pluginsdk.CommandArgSpec {
+	return pluginsdk.CommandArgSpec{
+		Positionals: []pluginsdk.PositionalSpec{
+			{Name: "roadmap-id", Required: true, Description: "Roadmap ID to archive"},
+		},
+		Flags: []pluginsdk.FlagSpec{
+			{Name: "project", Value: "name", Description: "Project name"},
+		},
+	}
+}
+
+func (c *RoadmapArchiveCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	// Parse roadmap ID
+	if len(args) == 0 {
+		return fmt.Errorf("%w: roadmap ID is required", pluginsdk.ErrInvalidArgument)
+	}
+	c.roadmapID = args[0]
+	args = args[1:]
+
+	// Parse flags
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--project":
+			if i+1 < len(args) {
+				c.project = args[i+1]
+				i++
+			}
+		}
+	}
+
+	roadmap, err := c.RoadmapService.ArchiveRoadmap(ctx, c.roadmapID)
+	if err != nil {
+		return fmt.Errorf("failed to archive roadmap: %w", err)
+	}
+
+	out := cmdCtx.GetStdout()
+	fmt.Fprintf(out, "Archived roadmap %s\n", roadmap.ID)
+
+	active, err := c.RoadmapService.GetRoadmap(ctx)
+	if err != nil {
+		if errors.Is(err, pluginsdk.ErrNotFound) {
+			fmt.Fprintf(out, "No active roadmap left. Run 'dw task-manager roadmap init' or 'roadmap activate' to pick one.\n")
+			return nil
+		}
+		return fmt.Errorf("failed to get active roadmap: %w", err)
+	}
+	fmt.Fprintf(out, "Active roadmap:  %s\n", active.ID)
+
+	return nil
+}
@@ -0,0 +1,162 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// searchGroupLabels are the group headers of the search output, by entity type
+var searchGroupLabels = map[string]string{
+	entities.SearchEntityTask:  "Tasks",
+	entities.SearchEntityTrack: "Tracks",
+	entities.SearchEntityADR:   "ADRs",
+	entities.SearchEntityAC:    "Acceptance Criteria",
+}
+
+// ============================================================================
+// SearchCommandAdapter - Adapts CLI to Search use case
+// ============================================================================
+
+// SearchCommandAdapter searches tasks, tracks, ADRs and acceptance criteria by text
+type SearchCommandAdapter struct {
+	SearchService *application.SearchApplicationService
+
+	// CLI flags (parsed from args)
+	project string
+	types   []string
+	json    bool
+}
+
+func (c *SearchCommandAdapter) GetName() string {
+	return "search"
+}
+
+func (c *SearchCommandAdapter) GetDescription() string {
+	return "Search tasks, tracks, ADRs and acceptance criteria"
+}
+
+func (c *SearchCommandAdapter) GetUsage() string {
+	return "dw task-manager search <term> [--type <types>] [--json] [--project <name>]"
+}
+
+func (c *SearchCommandAdapter) GetHelp() string {
+	return `Searches all entities for a term (case-insensitive substring match) and
+shows the matches grouped by entity type, with a snippet of the matching text.
+
+Searched fields:
+  task     title, description
+  track    title, description
+  adr      title, context, decision
+  ac       description
+
+Flags:
+  --type <types>        Comma-separated entity types to search (task, track, adr, ac)
+                        (default: all)
+  --json                Output as JSON
+  --project <name>      Project name (optional, uses active project if not specified)
+
+Examples:
+  dw task-manager search oauth
+  dw task-manager search "token refresh" --type task,adr
+  dw task-manager search oauth --json | jq '.results[].id'
+
+Notes:
+  - Multiple words are searched as one phrase
+  - Title matches rank above description matches (an AC's description is its title)`
+}
+
+func (c *SearchCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	// Parse flags and positional term
+	var termParts []string
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--project":
+			if i+1 < len(args) {
+				c.project = args[i+1]
+				i++
+			}
+		case "--type":
+			if i+1 >= len(args) {
+				return fmt.Errorf("%w: --type requires a value", pluginsdk.ErrInvalidArgument)
+			}
+			c.types = append(c.types, args[i+1])
+			i++
+		case "--json":
+			c.json = true
+		default:
+			termParts = append(termParts, args[i])
+		}
+	}
+	term := strings.TrimSpace(strings.Join(termParts, " "))
+	if term == "" {
+		return fmt.Errorf("%w: search term is required", pluginsdk.ErrInvalidArgument)
+	}
+
+	results, err := c.SearchService.Search(ctx, term, c.types)
+	if err != nil {
+		return fmt.Errorf("failed to search: %w", err)
+	}
+
+	out := cmdCtx.GetStdout()
+	if c.json {
+		return writeSearchJSON(out, term, results)
+	}
+	writeSearchResults(out, term, c.types, results)
+	return nil
+}
+
+// searchJSON is the --json representation of search results, ranked by relevance
+type searchJSON struct {
+	Term    string                   `json:"term"`
+	Total   int                      `json:"total"`
+	Results []*entities.SearchResult `json:"results"`
+}
+
+func writeSearchJSON(out io.Writer, term string, results []*entities.SearchResult) error {
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(searchJSON{Term: term, Total: len(results), Results: results})
+}
+
+// writeSearchResults prints results grouped by entity type, keeping their ranking within each group
+func writeSearchResults(out io.Writer, term string, types []string, results []*entities.SearchResult) {
+	if len(results) == 0 {
+		if len(types) > 0 {
+			fmt.Fprintf(out, "No matches for %q in %s. Drop --type to search all entity types.\n", term, strings.Join(types, ","))
+			return
+		}
+		fmt.Fprintf(out, "No matches for %q in tasks, tracks, ADRs or acceptance criteria.\n", term)
+		return
+	}
+
+	fmt.Fprintf(out, "%d match(es) for %q:\n", len(results), term)
+	for _, entityType := range entities.SearchEntityTypes {
+		var group []*entities.SearchResult
+		for _, result := range results {
+			if result.EntityType == entityType {
+				group = append(group, result)
+			}
+		}
+		if len(group) == 0 {
+			continue
+		}
+
+		fmt.Fprintf(out, "\n%s (%d):\n", searchGroupLabels[entityType], len(group))
+		for _, result := range group {
+			// For title matches the snippet is the title, cut around the match
+			if result.Relevance >= entities.SearchRelevanceTitle {
+				fmt.Fprintf(out, "  %-15s %s\n", result.EntityID, result.Snippet)
+				continue
+			}
+			fmt.Fprintf(out, "  %-15s %s\n", result.EntityID, truncateDisplay(result.Title, 80))
+			fmt.Fprintf(out, "  %-15s %s: %s\n", "", result.Field, result.Snippet)
+		}
+	}
+}
@@ -0,0 +1,298 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// syncTimestampLayouts are the accepted --since formats, most precise first
+var syncTimestampLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+// parseSyncTimestamp parses a --since value. Values without a zone are local time.
+func parseSyncTimestamp(value string) (time.Time, error) {
+	for _, layout := range syncTimestampLayouts {
+		if t, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%w: invalid --since timestamp '%s' (use RFC 3339, e.g. 2025-01-31T18:00:00Z, or YYYY-MM-DD)", pluginsdk.ErrInvalidArgument, value)
+}
+
+// ============================================================================
+// SyncExportCommandAdapter - Exports entities changed since a timestamp
+// ============================================================================
+
+// SyncExportCommandAdapter exports entities updated after a timestamp as a JSON changeset
+type SyncExportCommandAdapter struct {
+	SyncService *application.SyncApplicationService
+
+	// CLI flags
+	project string
+	since   string
+	output  string
+}
+
+func (c *SyncExportCommandAdapter) GetName() string {
+	return "sync export"
+}
+
+func (c *SyncExportCommandAdapter) GetDescription() string {
+	return "Export changes since a timestamp as a JSON changeset"
+}
+
+func (c *SyncExportCommandAdapter) GetUsage() string {
+	return "dw task-manager sync export [--since <timestamp>] [--output <file>]"
+}
+
+func (c *SyncExportCommandAdapter) GetHelp() string {
+	return `Exports every roadmap, track, task, iteration, definition of done item,
+acceptance criterion and ADR whose updated_at is after --since, and every
+ADR-task link and task gate created after --since, as a JSON changeset, for
+replicating a project database to another machine with 'sync import'.
+
+Flags:
+  --since <timestamp>   Only include entities updated after this time
+                        (RFC 3339 or YYYY-MM-DD; default: export everything)
+  --output <file>       Write the changeset to a file instead of stdout
+  --project <name>      Project name (optional)
+
+Examples:
+  # Initial full export
+  dw task-manager sync export --output roadmap-full.json
+
+  # Deltas since the last sync
+  dw task-manager sync export --since 2025-01-31T18:00:00Z --output delta.json
+
+Notes:
+  - The changeset records its export time in "exported_at"; use it as --since
+    for the next export
+  - Tracks include their dependencies, iterations their task membership and
+    acceptance criteria their tags
+  - Deletions are not exported (there is no soft-delete); delete on both machines
+  - Roadmap success criteria, task notes, AC templates and iteration templates
+    are not synced; export warns when some changed after --since
+  - Project settings (active roadmap, ID format) stay local to each database`
+}
+
+func (c *SyncExportCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	// Parse flags
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--project":
+			if i+1 < len(args) {
+				c.project = args[i+1]
+				i++
+			}
+		case "--since":
+			if i+1 < len(args) {
+				c.since = args[i+1]
+				i++
+			}
+		case "--output":
+			if i+1 < len(args) {
+				c.output = args[i+1]
+				i++
+			}
+		}
+	}
+
+	var since time.Time
+	if c.since != "" {
+		var err error
+		since, err = parseSyncTimestamp(c.since)
+		if err != nil {
+			return err
+		}
+	}
+
+	changeset, err := c.SyncService.ExportChanges(ctx, since)
+	if err != nil {
+		return fmt.Errorf("failed to export changes: %w", err)
+	}
+
+	data, err := json.MarshalIndent(changeset, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode changeset: %w", err)
+	}
+	data = append(data, '\n')
+
+	out := cmdCtx.GetStdout()
+	if c.output == "" {
+		// Keep stdout a valid changeset
+		writeNotSyncedWarning(os.Stderr, changeset.NotSynced)
+		_, err := out.Write(data)
+		return err
+	}
+
+	dir := filepath.Dir(c.output)
+	if dir != "" && dir != "." {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("failed to create output directory: %w", err)
+		}
+	}
+	if err := os.WriteFile(c.output, data, 0644); err != nil {
+		return fmt.Errorf("failed to write changeset: %w", err)
+	}
+
+	fmt.Fprintf(out, "Changeset saved to: %s (%d entities)\n", c.output, changeset.Count())
+	fmt.Fprintf(out, "Next export: --since %s\n", changeset.ExportedAt.Format(time.RFC3339Nano))
+	writeNotSyncedWarning(out, changeset.NotSynced)
+	return nil
+}
+
+// writeNotSyncedWarning lists the changed rows the changeset leaves out, by table
+func writeNotSyncedWarning(w io.Writer, notSynced map[string]int) {
+	if len(notSynced) == 0 {
+		return
+	}
+	tables := make([]string, 0, len(notSynced))
+	for table := range notSynced {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+	parts := make([]string, len(tables))
+	for i, table := range tables {
+		parts[i] = fmt.Sprintf("%s (%d)", table, notSynced[table])
+	}
+	fmt.Fprintf(w, "Warning: not synced, copy by hand: %s\n", strings.Join(parts, ", "))
+}
+
+// ============================================================================
+// SyncImportCommandAdapter - Upserts a changeset produced by sync export
+// ============================================================================
+
+// SyncImportCommandAdapter imports a JSON changeset produced by 'sync export'
+type SyncImportCommandAdapter struct {
+	SyncService *application.SyncApplicationService
+
+	// CLI flags
+	project string
+	input   string
+}
+
+func (c *SyncImportCommandAdapter) GetName() string {
+	return "sync import"
+}
+
+func (c *SyncImportCommandAdapter) GetDescription() string {
+	return "Import a changeset produced by sync export"
+}
+
+func (c *SyncImportCommandAdapter) GetUsage() string {
+	return "dw task-manager sync import <file|->"
+}
+
+func (c *SyncImportCommandAdapter) GetHelp() string {
+	return `Imports a changeset produced by 'sync export' into the current project.
+
+Entities are matched by ID (iterations by number): missing ones are created,
+existing ones are updated. The whole import runs in one transaction, so
+nothing is written if any entity fails.
+
+Optimistic concurrency: an existing entity is only overwritten when the
+incoming updated_at is newer. Entities with the same updated_at are left
+unchanged, so re-importing a changeset is a no-op. Entities changed more
+recently on this machine are kept and reported as conflicts.
+
+Arguments:
+  <file>             Changeset file, or - to read from stdin
+
+Flags:
+  --project <name>   Project name (optional)
+
+Examples:
+  dw task-manager sync import delta.json
+  ssh laptop dw task-manager sync export --since 2025-01-31 | dw task-manager sync import -`
+}
+
+func (c *SyncImportCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	// Parse flags
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--project":
+			if i+1 < len(args) {
+				c.project = args[i+1]
+				i++
+			}
+		default:
+			if c.input == "" && (args[i] == "-" || !strings.HasPrefix(args[i], "--")) {
+				c.input = args[i]
+			}
+		}
+	}
+
+	if c.input == "" {
+		return fmt.Errorf("changeset file is required (use - for stdin)\nUsage: %s", c.GetUsage())
+	}
+
+	var data []byte
+	var err error
+	if c.input == "-" {
+		data, err = io.ReadAll(cmdCtx.GetStdin())
+	} else {
+		data, err = os.ReadFile(c.input)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read changeset: %w", err)
+	}
+
+	var changeset entities.SyncChangeset
+	if err := json.Unmarshal(data, &changeset); err != nil {
+		return fmt.Errorf("%w: changeset is not valid JSON: %v", pluginsdk.ErrInvalidArgument, err)
+	}
+
+	result, err := c.SyncService.ImportChanges(ctx, &changeset)
+	if err != nil {
+		return fmt.Errorf("failed to import changeset: %w", err)
+	}
+
+	writeSyncImportResult(cmdCtx.GetStdout(), result)
+	return nil
+}
+
+// writeSyncImportResult prints per-type created/updated/unchanged/conflict counts
+func writeSyncImportResult(w io.Writer, result *entities.SyncImportResult) {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "ENTITY\tCREATED\tUPDATED\tUNCHANGED\tCONFLICTS")
+	rows := []struct {
+		name   string
+		counts entities.SyncCounts
+	}{
+		{"roadmaps", result.Roadmaps},
+		{"tracks", result.Tracks},
+		{"tasks", result.Tasks},
+		{"iterations", result.Iterations},
+		{"iteration dod items", result.IterationDoD},
+		{"acceptance criteria", result.AcceptanceCriteria},
+		{"adrs", result.ADRs},
+		{"adr task links", result.ADRTaskLinks},
+		{"task gates", result.TaskGates},
+	}
+	for _, row := range rows {
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%d\n", row.name, row.counts.Created, row.counts.Updated, row.counts.Unchanged, row.counts.Conflicts)
+	}
+	tw.Flush()
+
+	total := result.Total()
+	fmt.Fprintf(w, "\nImported: %d created, %d updated, %d unchanged\n", total.Created, total.Updated, total.Unchanged)
+	if total.Conflicts > 0 {
+		fmt.Fprintf(w, "Conflicts (kept local, newer than incoming): %s\n", strings.Join(result.ConflictIDs, ", "))
+	}
+}
@@ -17,7 +17,7 @@ import (
 // ============================================================================
 
 type TaskCreateCommandAdapter struct {
-	TaskService  *application.TaskApplicationService
+	TaskService *application.TaskApplicationService
 
 	// CLI flags
 	project     string
@@ -96,13 +96,12 @@ func (c *TaskCreateCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk
 
 	// Validate required flags
 	if c.trackID == "" {
-		return fmt.Errorf("--track is required")
+		return fmt.Errorf("%w: --track is required", pluginsdk.ErrInvalidArgument)
 	}
 	if c.title == "" {
-		return fmt.Errorf("--title is required")
+		return fmt.Errorf("%w: --title is required", pluginsdk.ErrInvalidArgument)
 	}
 
-
 	// Create DTO
 	input := dto.CreateTaskDTO{
 		TrackID:     c.trackID,
@@ -141,7 +140,12 @@ func (c *TaskCreateCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk
 // ============================================================================
 
 type TaskUpdateCommandAdapter struct {
-	TaskService  *application.TaskApplicationService
+	TaskService *application.TaskApplicationService
+	ACService   *application.ACApplicationService // Optional: needed when AutoVerifyOnTrackComplete is set
+
+	// AutoVerifyOnTrackComplete reconciles the task's track after it is marked done
+	// (task_manager.ac.auto_verify_on_track_complete in the config)
+	AutoVerifyOnTrackComplete bool
 
 	// CLI flags
 	project     string
@@ -174,13 +178,19 @@ Flags:
   --status <status>        New task status (todo, in-progress, review, done)
   --rank <rank>            New task rank (1-1000)
   --branch <branch>        Git branch name
-  --project <name>         Project name (optional)`
+  --project <name>         Project name (optional)
+
+Notes:
+  - Acceptance criteria tagged auto-on-complete do not block marking a task done
+  - With task_manager.ac.auto_verify_on_track_complete enabled in the config, marking
+    the last open task of a track done verifies the track's auto-on-complete criteria.
+    Only this command does so; run 'reconcile' after completing tasks in the TUI`
 }
 
 func (c *TaskUpdateCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
 	// Parse task ID
 	if len(args) == 0 {
-		return fmt.Errorf("task ID is required")
+		return fmt.Errorf("%w: task ID is required", pluginsdk.ErrInvalidArgument)
 	}
 	c.taskID = args[0]
 	args = args[1:]
@@ -264,6 +274,21 @@ func (c *TaskUpdateCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk
 		fmt.Fprintf(out, "  Branch:      %s\n", task.Branch)
 	}
 
+	if c.AutoVerifyOnTrackComplete && c.ACService != nil && c.status != nil && task.Status == string(entities.TaskStatusDone) {
+		result, err := c.ACService.ReconcileTrackACs(ctx, dto.ReconcileACsDTO{TrackID: task.TrackID})
+		if err != nil {
+			return fmt.Errorf("task updated, but failed to auto-verify track acceptance criteria: %w", err)
+		}
+		for _, track := range result.Tracks {
+			if len(track.VerifiedIDs) > 0 {
+				fmt.Fprintf(out, "\nTrack %s complete: auto-verified %s\n", track.TrackID, strings.Join(track.VerifiedIDs, ", "))
+			}
+		}
+		if err := writeReconcileFailures(out, result); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -272,12 +297,13 @@ func (c *TaskUpdateCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk
 // ============================================================================
 
 type TaskDeleteCommandAdapter struct {
-	TaskService  *application.TaskApplicationService
+	TaskService *application.TaskApplicationService
 
 	// CLI flags
 	project string
 	taskID  string
 	force   bool
+	plan    bool
 }
 
 func (c *TaskDeleteCommandAdapter) GetName() string {
@@ -289,7 +315,7 @@ func (c *TaskDeleteCommandAdapter) GetDescription() string {
 }
 
 func (c *TaskDeleteCommandAdapter) GetUsage() string {
-	return "dw task-manager task delete <task-id> [--force]"
+	return "dw task-manager task delete <task-id> [--force | --plan]"
 }
 
 func (c *TaskDeleteCommandAdapter) GetHelp() string {
@@ -297,13 +323,14 @@ func (c *TaskDeleteCommandAdapter) GetHelp() string {
 
 Flags:
   --force         Skip confirmation prompt
+  --plan          Show what would be deleted or orphaned, without deleting
   --project <name> Project name (optional)`
 }
 
 func (c *TaskDeleteCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
 	// Parse task ID
 	if len(args) == 0 {
-		return fmt.Errorf("task ID is required")
+		return fmt.Errorf("%w: task ID is required", pluginsdk.ErrInvalidArgument)
 	}
 	c.taskID = args[0]
 	args = args[1:]
@@ -318,9 +345,20 @@ func (c *TaskDeleteCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk
 			}
 		case "--force":
 			c.force = true
+		case "--plan":
+			c.plan = true
 		}
 	}
 
+	if c.plan {
+		plan, err := c.TaskService.PlanTaskDeletion(ctx, c.taskID)
+		if err != nil {
+			return fmt.Errorf("failed to plan task deletion: %w", err)
+		}
+		plan.Write(cmdCtx.GetStdout())
+		return nil
+	}
+
 	// Execute via application service
 	if err := c.TaskService.DeleteTask(ctx, c.taskID); err != nil {
 		return fmt.Errorf("failed to delete task: %w", err)
@@ -334,40 +372,60 @@ func (c *TaskDeleteCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk
 }
 
 // ============================================================================
-// TaskListCommandAdapter - Adapts CLI to ListTasksCommand use case
+// TaskReopenCommandAdapter - Adapts CLI to ReopenTask use case
 // ============================================================================
 
-type TaskListCommandAdapter struct {
-	TaskService  *application.TaskApplicationService
+type TaskReopenCommandAdapter struct {
+	TaskService *application.TaskApplicationService
 
 	// CLI flags
 	project string
-	trackID string
+	taskID  string
 	status  string
+	reason  string
 }
 
-func (c *TaskListCommandAdapter) GetName() string {
-	return "task list"
+func (c *TaskReopenCommandAdapter) GetName() string {
+	return "task reopen"
 }
 
-func (c *TaskListCommandAdapter) GetDescription() string {
-	return "List all tasks with optional filtering"
+func (c *TaskReopenCommandAdapter) GetDescription() string {
+	return "Reopen a done task"
 }
 
-func (c *TaskListCommandAdapter) GetUsage() string {
-	return "dw task-manager task list [--track <track-id>] [--status <status>] [--project <name>]"
+func (c *TaskReopenCommandAdapter) GetUsage() string {
+	return "dw task-manager task reopen <task-id> [--reason <text>] [--status <status>]"
 }
 
-func (c *TaskListCommandAdapter) GetHelp() string {
-	return `Lists all tasks with optional filtering by track or status.
+func (c *TaskReopenCommandAdapter) GetHelp() string {
+	return `Reopens a done task by setting its status back to todo (or in-progress).
+The transition and the reason are recorded as a task note, shown by "task show".
+
+Arguments:
+  <task-id>            Task ID to reopen
 
 Flags:
-  --track <track-id>    Filter by parent track ID
-  --status <status>     Filter by status (todo, in-progress, done)
-  --project <name>      Project name (optional)`
+  --reason <text>      Why the task is being reopened (recorded as a note)
+  --status <status>    Status to reopen to: todo (default) or in-progress
+  --project <name>     Project name (optional)
+
+Examples:
+  dw task-manager task reopen DW-task-12 --reason "Regression in CI"
+  dw task-manager task reopen DW-task-12 --status in-progress
+
+Notes:
+  - Only tasks with status done can be reopened
+  - Reopening a task in a completed iteration changes that iteration's progress`
 }
 
-func (c *TaskListCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+func (c *TaskReopenCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	// Parse task ID
+	if len(args) == 0 {
+		return fmt.Errorf("%w: task ID is required", pluginsdk.ErrInvalidArgument)
+	}
+	c.taskID = args[0]
+	args = args[1:]
+
 	// Parse flags
 	for i := 0; i < len(args); i++ {
 		switch args[i] {
@@ -376,9 +434,9 @@ func (c *TaskListCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.C
 				c.project = args[i+1]
 				i++
 			}
-		case "--track":
+		case "--reason":
 			if i+1 < len(args) {
-				c.trackID = args[i+1]
+				c.reason = args[i+1]
 				i++
 			}
 		case "--status":
@@ -389,42 +447,27 @@ func (c *TaskListCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.C
 		}
 	}
 
-	// Build filters
-	filters := entities.TaskFilters{
-		TrackID: c.trackID,
-	}
-	if c.status != "" {
-		filters.Status = []string{c.status}
-	}
-
 	// Execute via application service
-	tasks, err := c.TaskService.ListTasks(ctx, filters)
+	task, completedIterations, err := c.TaskService.ReopenTask(ctx, dto.ReopenTaskDTO{
+		ID:     c.taskID,
+		Status: c.status,
+		Reason: c.reason,
+	})
 	if err != nil {
-		return fmt.Errorf("failed to list tasks: %w", err)
+		return fmt.Errorf("failed to reopen task: %w", err)
 	}
 
 	// Format output
 	out := cmdCtx.GetStdout()
-	if len(tasks) == 0 {
-		fmt.Fprintf(out, "No tasks found\n")
-		return nil
+	fmt.Fprintf(out, "Task %s reopened\n", task.ID)
+	fmt.Fprintf(out, "  Status:      %s\n", task.Status)
+	if strings.TrimSpace(c.reason) != "" {
+		fmt.Fprintf(out, "  Reason:      %s\n", strings.TrimSpace(c.reason))
 	}
-
-	// Print header
-	fmt.Fprintf(out, "%-15s %-20s %-15s %-40s\n", "ID", "Track", "Status", "Title")
-	fmt.Fprintf(out, "%-15s %-20s %-15s %-40s\n", strings.Repeat("-", 15), strings.Repeat("-", 20), strings.Repeat("-", 15), strings.Repeat("-", 40))
-
-	// Print tasks
-	for _, task := range tasks {
-		fmt.Fprintf(out, "%-15s %-20s %-15s %-40s\n",
-			task.ID,
-			task.TrackID,
-			task.Status,
-			truncateString(task.Title, 40),
-		)
+	for _, iteration := range completedIterations {
+		fmt.Fprintf(out, "\nWarning: iteration %d (%s) is complete; its progress will change\n", iteration.Number, iteration.Name)
 	}
 
-	fmt.Fprintf(out, "\nTotal: %d task(s)\n", len(tasks))
 	return nil
 }
 
@@ -433,7 +476,8 @@ func (c *TaskListCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.C
 // ============================================================================
 
 type TaskShowCommandAdapter struct {
-	TaskService  *application.TaskApplicationService
+	TaskService *application.TaskApplicationService
+	ADRService  *application.ADRApplicationService // optional; lists the ADRs linked to the task
 
 	// CLI flags
 	project string
@@ -465,7 +509,7 @@ Flags:
 func (c *TaskShowCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
 	// Parse task ID
 	if len(args) == 0 {
-		return fmt.Errorf("task ID is required")
+		return fmt.Errorf("%w: task ID is required", pluginsdk.ErrInvalidArgument)
 	}
 	c.taskID = args[0]
 	args = args[1:]
@@ -500,9 +544,50 @@ func (c *TaskShowCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.C
 	if task.Branch != "" {
 		fmt.Fprintf(out, "  Branch:      %s\n", task.Branch)
 	}
+	fmt.Fprintf(out, "  Assignee:    %s\n", assigneeLabel(task))
 	fmt.Fprintf(out, "  Created:     %s\n", task.CreatedAt.Format("2006-01-02 15:04:05 UTC"))
 	fmt.Fprintf(out, "  Updated:     %s\n", task.UpdatedAt.Format("2006-01-02 15:04:05 UTC"))
 
+	gates, err := c.TaskService.ListTaskGates(ctx, task.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get task gates: %w", err)
+	}
+	if len(gates) > 0 {
+		fmt.Fprintf(out, "\nGated on:\n")
+		for _, ac := range gates {
+			fmt.Fprintf(out, "  %s %s (task %s): %s\n", ac.StatusIndicator(), ac.ID, ac.TaskID, truncateString(ac.Description, 60))
+		}
+		if pending := entities.PendingGates(gates); len(pending) > 0 && task.Status != string(entities.TaskStatusDone) {
+			fmt.Fprintf(out, "  Blocked: %s\n", entities.WaitingOnLabel(pending))
+		} else {
+			fmt.Fprintf(out, "  All gating ACs verified\n")
+		}
+	}
+
+	if c.ADRService != nil {
+		adrs, err := c.ADRService.ListTaskADRs(ctx, task.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get task ADRs: %w", err)
+		}
+		if len(adrs) > 0 {
+			fmt.Fprintf(out, "\nRelevant ADRs:\n")
+			for _, adr := range adrs {
+				fmt.Fprintf(out, "  %s [%s] %s\n", adr.ID, adr.Status, adr.Title)
+			}
+		}
+	}
+
+	notes, err := c.TaskService.ListTaskNotes(ctx, task.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get task notes: %w", err)
+	}
+	if len(notes) > 0 {
+		fmt.Fprintf(out, "\nNotes:\n")
+		for _, note := range notes {
+			fmt.Fprintf(out, "  [%s] %s\n", note.CreatedAt.Format("2006-01-02 15:04"), note.Content)
+		}
+	}
+
 	return nil
 }
 
@@ -511,7 +596,7 @@ func (c *TaskShowCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.C
 // ============================================================================
 
 type TaskMoveCommandAdapter struct {
-	TaskService  *application.TaskApplicationService
+	TaskService *application.TaskApplicationService
 
 	// CLI flags
 	project    string
@@ -545,7 +630,7 @@ Flags:
 func (c *TaskMoveCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
 	// Parse task ID
 	if len(args) == 0 {
-		return fmt.Errorf("task ID is required")
+		return fmt.Errorf("%w: task ID is required", pluginsdk.ErrInvalidArgument)
 	}
 	c.taskID = args[0]
 	args = args[1:]
@@ -568,7 +653,7 @@ func (c *TaskMoveCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.C
 
 	// Validate required flag
 	if c.newTrackID == "" {
-		return fmt.Errorf("--track is required")
+		return fmt.Errorf("%w: --track is required", pluginsdk.ErrInvalidArgument)
 	}
 
 	// Execute via application service
@@ -583,15 +668,367 @@ func (c *TaskMoveCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.C
 	return nil
 }
 
+// ============================================================================
+// TaskCloneCommandAdapter - Adapts CLI to CloneTask use case
+// ============================================================================
+
+// TaskCloneCommandAdapter duplicates a task within the project
+type TaskCloneCommandAdapter struct {
+	TaskService *application.TaskApplicationService
+
+	// CLI flags
+	project       string
+	taskID        string
+	title         string
+	trackID       string
+	withACs       bool
+	sameIteration bool
+}
+
+func (c *TaskCloneCommandAdapter) GetName() string {
+	return "task clone"
+}
+
+func (c *TaskCloneCommandAdapter) GetDescription() string {
+	return "Duplicate a task as the starting point for a similar one"
+}
+
+func (c *TaskCloneCommandAdapter) GetUsage() string {
+	return "dw task-manager task clone <task-id> [--title <title>] [--track <track-id>] [--with-acs] [--same-iteration] [--project <name>]"
+}
+
+func (c *TaskCloneCommandAdapter) GetHelp() string {
+	return `Creates a new task from an existing one. The copy gets a new ID, status todo
+and fresh timestamps, and keeps the source's title, description and rank.
+Branch, assignee, notes and gates are not copied.
+
+Arguments:
+  <task-id>            Task ID to copy
+
+Flags:
+  --title <title>      Title of the copy (default: the source's title)
+  --track <track-id>   Track of the copy (default: the source's track)
+  --with-acs           Also copy the acceptance criteria and their tags, reset to not_started
+  --same-iteration     Add the copy to the source's iterations that are not complete
+  --project <name>     Project name (optional)
+
+Examples:
+  dw task-manager task clone DW-task-12 --title "Add export to PDF"
+  dw task-manager task clone DW-task-12 --track DW-track-3 --with-acs --same-iteration
+
+For copying a whole project, see 'dw task-manager clone'.`
+}
+
+func (c *TaskCloneCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	// Parse task ID
+	if len(args) == 0 || strings.HasPrefix(args[0], "--") {
+		return fmt.Errorf("%w: task ID is required", pluginsdk.ErrInvalidArgument)
+	}
+	c.taskID = args[0]
+	args = args[1:]
+
+	// Parse flags
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--project":
+			if i+1 < len(args) {
+				c.project = args[i+1]
+				i++
+			}
+		case "--title":
+			if i+1 < len(args) {
+				c.title = args[i+1]
+				i++
+			}
+		case "--track":
+			if i+1 < len(args) {
+				c.trackID = args[i+1]
+				i++
+			}
+		case "--with-acs":
+			c.withACs = true
+		case "--same-iteration":
+			c.sameIteration = true
+		default:
+			return fmt.Errorf("%w: unknown argument %q", pluginsdk.ErrInvalidArgument, args[i])
+		}
+	}
+
+	// Execute via application service
+	task, acs, iterations, err := c.TaskService.CloneTask(ctx, dto.CloneTaskDTO{
+		SourceID:      c.taskID,
+		Title:         c.title,
+		TrackID:       c.trackID,
+		WithACs:       c.withACs,
+		SameIteration: c.sameIteration,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to clone task: %w", err)
+	}
+
+	// Format output
+	out := cmdCtx.GetStdout()
+	fmt.Fprintf(out, "Task %s cloned as %s\n", c.taskID, task.ID)
+	fmt.Fprintf(out, "  Track:       %s\n", task.TrackID)
+	fmt.Fprintf(out, "  Title:       %s\n", task.Title)
+	fmt.Fprintf(out, "  Status:      %s\n", task.Status)
+	fmt.Fprintf(out, "  Rank:        %d\n", task.Rank)
+	if c.withACs {
+		fmt.Fprintf(out, "  ACs:         %d copied\n", len(acs))
+	}
+	for _, iteration := range iterations {
+		fmt.Fprintf(out, "  Iteration:   %d (%s)\n", iteration.Number, iteration.Name)
+	}
+
+	return nil
+}
+
+// ============================================================================
+// TaskBumpCommandAdapter - Adapts CLI to BumpTask use case
+// ============================================================================
+
+// TaskBumpCommandAdapter moves a task's priority relative to its track or iteration peers
+type TaskBumpCommandAdapter struct {
+	TaskService *application.TaskApplicationService
+
+	// CLI flags
+	project   string
+	iteration string
+}
+
+func (c *TaskBumpCommandAdapter) GetName() string {
+	return "task bump"
+}
+
+func (c *TaskBumpCommandAdapter) GetDescription() string {
+	return "Move a task up or down in priority among its peers"
+}
+
+func (c *TaskBumpCommandAdapter) GetUsage() string {
+	return "dw task-manager task bump <task-id> up|top|down|bottom [--iteration <number>] [--project <name>]"
+}
+
+func (c *TaskBumpCommandAdapter) GetHelp() string {
+	return `Moves a task's rank relative to the other tasks in its track, or in an
+iteration with --iteration, without having to pick a rank number.
+
+Directions:
+  top      Rank above the current highest-priority task
+  up       Swap with the next higher-priority task
+  down     Swap with the next lower-priority task
+  bottom   Rank below the current lowest-priority task
+
+Peers are ordered by rank, ties broken by creation order. When ranks collide or
+there is no room left in the 1-1000 range, the peers' existing ranks are
+renormalized so the new order is unambiguous.
+
+Arguments:
+  <task-id>               Task ID to bump
+  up|top|down|bottom      Direction to move the task
+
+Flags:
+  --iteration <number>    Order among the iteration's tasks instead of the track's
+  --project <name>        Project name (optional)
+
+Examples:
+  dw task-manager task bump DW-task-3 top
+  dw task-manager task bump DW-task-3 down --iteration 4`
+}
+
+func (c *TaskBumpCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	// Parse task ID and direction
+	if len(args) < 2 {
+		return fmt.Errorf("%w: task ID and direction (up, top, down or bottom) are required", pluginsdk.ErrInvalidArgument)
+	}
+	input := dto.BumpTaskDTO{
+		ID:        args[0],
+		Direction: args[1],
+	}
+	args = args[2:]
+
+	// Parse flags
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--project":
+			if i+1 < len(args) {
+				c.project = args[i+1]
+				i++
+			}
+		case "--iteration":
+			if i+1 < len(args) {
+				c.iteration = args[i+1]
+				i++
+			}
+		}
+	}
+
+	scope := ""
+	if c.iteration != "" {
+		num, err := strconv.Atoi(c.iteration)
+		if err != nil {
+			return fmt.Errorf("%w: invalid iteration number: %s", pluginsdk.ErrInvalidArgument, c.iteration)
+		}
+		input.IterationNum = &num
+		scope = fmt.Sprintf("iteration %d", num)
+	}
+
+	// Execute via application service
+	task, position, total, err := c.TaskService.BumpTask(ctx, input)
+	if err != nil {
+		return fmt.Errorf("failed to bump task: %w", err)
+	}
+	if scope == "" {
+		scope = "track " + task.TrackID
+	}
+
+	// Format output
+	out := cmdCtx.GetStdout()
+	fmt.Fprintf(out, "Task %s is now at position %d of %d in %s (rank %d)\n", task.ID, position, total, scope, task.Rank)
+
+	return nil
+}
+
+// ============================================================================
+// TaskFromEventCommandAdapter - Adapts CLI to CreateTaskFromEvent use case
+// ============================================================================
+
+// TaskFromEventCommandAdapter captures a logged event (e.g. an error) as a backlog task
+type TaskFromEventCommandAdapter struct {
+	TaskService  *application.TaskApplicationService
+	TrackService *application.TrackApplicationService
+
+	// CLI flags
+	project string
+	trackID string
+}
+
+func (c *TaskFromEventCommandAdapter) GetName() string {
+	return "task from-event"
+}
+
+func (c *TaskFromEventCommandAdapter) GetDescription() string {
+	return "Create a backlog task from a logged event"
+}
+
+func (c *TaskFromEventCommandAdapter) GetUsage() string {
+	return "dw task-manager task from-event <event-id> [--track <track-id>] [--project <name>]"
+}
+
+func (c *TaskFromEventCommandAdapter) GetHelp() string {
+	return `Creates a todo task from an event in the event log, e.g. an error logged
+during a session. Event IDs are shown by 'dw logs'.
+
+The title is taken from the event payload's error, message, title, summary
+or description text (first line, shortened), or names the event type and
+time when the payload has none. The description records the event and its
+full payload, and a task note links the originating event ID.
+
+Arguments:
+  <event-id>          ID of the event to capture
+
+Flags:
+  --track <track-id>  Track for the new task (required unless the roadmap has exactly one track)
+  --project <name>    Project name (optional)
+
+Examples:
+  dw task-manager task from-event 3f0c2a9e-8d1b-4c57-9a61-2b7e5d0c4f18
+  dw task-manager task from-event 3f0c2a9e-8d1b-4c57-9a61-2b7e5d0c4f18 --track DW-track-2`
+}
+
+func (c *TaskFromEventCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	// Parse event ID
+	if len(args) == 0 || strings.HasPrefix(args[0], "-") {
+		return fmt.Errorf("%w: event ID is required", pluginsdk.ErrInvalidArgument)
+	}
+	eventID := args[0]
+	args = args[1:]
+
+	// Parse flags
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--project":
+			if i+1 < len(args) {
+				c.project = args[i+1]
+				i++
+			}
+		case "--track":
+			if i+1 < len(args) {
+				c.trackID = args[i+1]
+				i++
+			}
+		}
+	}
+
+	// Load the event from the framework's event store
+	reader, ok := cmdCtx.(pluginsdk.EventReader)
+	if !ok {
+		return fmt.Errorf("%w: the event log is not available to plugins in this context", pluginsdk.ErrNotImplemented)
+	}
+	event, err := reader.GetEvent(ctx, eventID)
+	if err != nil {
+		return fmt.Errorf("failed to load event: %w", err)
+	}
+
+	if c.trackID == "" {
+		trackID, err := c.defaultTrackID(ctx)
+		if err != nil {
+			return err
+		}
+		c.trackID = trackID
+	}
+
+	// Execute via application service
+	task, err := c.TaskService.CreateTaskFromEvent(ctx, dto.CreateTaskFromEventDTO{
+		TrackID:   c.trackID,
+		EventID:   eventID,
+		EventType: event.Type,
+		Timestamp: event.Timestamp,
+		SessionID: event.Metadata["session_id"],
+		Payload:   event.Payload,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create task: %w", err)
+	}
+
+	// Format output
+	out := cmdCtx.GetStdout()
+	fmt.Fprintf(out, "Task created from event %s\n", eventID)
+	fmt.Fprintf(out, "  ID:          %s\n", task.ID)
+	fmt.Fprintf(out, "  Track:       %s\n", task.TrackID)
+	fmt.Fprintf(out, "  Title:       %s\n", task.Title)
+	fmt.Fprintf(out, "  Status:      %s\n", task.Status)
+
+	return nil
+}
+
+// defaultTrackID returns the only track of the active roadmap, so --track can be omitted
+func (c *TaskFromEventCommandAdapter) defaultTrackID(ctx context.Context) (string, error) {
+	roadmap, err := c.TrackService.GetActiveRoadmap(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get active roadmap: %w (create one with 'dw task-manager roadmap init')", err)
+	}
+	tracks, err := c.TrackService.ListTracks(ctx, roadmap.ID, entities.TrackFilters{})
+	if err != nil {
+		return "", fmt.Errorf("failed to list tracks: %w", err)
+	}
+	if len(tracks) != 1 {
+		return "", fmt.Errorf("%w: --track is required (the roadmap has %d tracks)", pluginsdk.ErrInvalidArgument, len(tracks))
+	}
+	return tracks[0].ID, nil
+}
+
 // ============================================================================
 // TaskBacklogCommandAdapter - Adapts CLI to GetBacklogTasksCommand use case
 // ============================================================================
 
 type TaskBacklogCommandAdapter struct {
 	TaskService  *application.TaskApplicationService
+	TrackService *application.TrackApplicationService // resolves the active roadmap
 
 	// CLI flags
-	project string
+	project    string
+	assignee   string
+	unassigned bool
 }
 
 func (c *TaskBacklogCommandAdapter) GetName() string {
@@ -603,13 +1040,15 @@ func (c *TaskBacklogCommandAdapter) GetDescription() string {
 }
 
 func (c *TaskBacklogCommandAdapter) GetUsage() string {
-	return "dw task-manager task backlog [--project <name>]"
+	return "dw task-manager task backlog [--assignee <who> | --unassigned] [--project <name>]"
 }
 
 func (c *TaskBacklogCommandAdapter) GetHelp() string {
-	return `Lists all tasks with status "todo" (backlog items).
+	return `Lists all tasks with status "todo" (backlog items) of the active roadmap.
 
 Flags:
+  --assignee <who>   Only show tasks assigned to <who>
+  --unassigned       Only show tasks without an assignee
   --project <name>   Project name (optional)`
 }
 
@@ -622,14 +1061,29 @@ func (c *TaskBacklogCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsd
 				c.project = args[i+1]
 				i++
 			}
+		case "--assignee":
+			if i+1 < len(args) {
+				c.assignee = args[i+1]
+				i++
+			}
+		case "--unassigned":
+			c.unassigned = true
 		}
 	}
+	if c.assignee != "" && c.unassigned {
+		return fmt.Errorf("%w: --assignee and --unassigned cannot be combined", pluginsdk.ErrInvalidArgument)
+	}
 
 	// Execute via application service
-	tasks, err := c.TaskService.GetBacklogTasks(ctx)
+	roadmapID, err := activeRoadmapID(ctx, c.TrackService)
+	if err != nil {
+		return err
+	}
+	tasks, err := c.TaskService.GetBacklogTasks(ctx, roadmapID)
 	if err != nil {
 		return fmt.Errorf("failed to get backlog tasks: %w", err)
 	}
+	tasks = filterTasksByAssignee(tasks, c.assignee, c.unassigned)
 
 	// Format output
 	out := cmdCtx.GetStdout()
@@ -640,14 +1094,15 @@ func (c *TaskBacklogCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsd
 
 	// Print header
 	fmt.Fprintf(out, "Backlog Tasks\n")
-	fmt.Fprintf(out, "%-15s %-20s %-40s\n", "ID", "Track", "Title")
-	fmt.Fprintf(out, "%-15s %-20s %-40s\n", strings.Repeat("-", 15), strings.Repeat("-", 20), strings.Repeat("-", 40))
+	fmt.Fprintf(out, "%-15s %-20s %-15s %-40s\n", "ID", "Track", "Assignee", "Title")
+	fmt.Fprintf(out, "%-15s %-20s %-15s %-40s\n", strings.Repeat("-", 15), strings.Repeat("-", 20), strings.Repeat("-", 15), strings.Repeat("-", 40))
 
 	// Print tasks
 	for _, task := range tasks {
-		fmt.Fprintf(out, "%-15s %-20s %-40s\n",
+		fmt.Fprintf(out, "%-15s %-20s %-15s %-40s\n",
 			task.ID,
 			task.TrackID,
+			truncateString(assigneeLabel(task), 15),
 			truncateString(task.Title, 40),
 		)
 	}
@@ -661,8 +1116,8 @@ func (c *TaskBacklogCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsd
 // ============================================================================
 
 type TaskCheckReadyCommandAdapter struct {
-	TaskService  *application.TaskApplicationService
-	ACService    *application.ACApplicationService
+	TaskService *application.TaskApplicationService
+	ACService   *application.ACApplicationService
 
 	// CLI flags
 	project string
@@ -683,6 +1138,8 @@ func (c *TaskCheckReadyCommandAdapter) GetUsage() string {
 
 func (c *TaskCheckReadyCommandAdapter) GetHelp() string {
 	return `Checks if all acceptance criteria for a task are verified.
+A task gated on ACs of other tasks (see 'task gate') is not ready while any
+gating AC is unverified.
 
 Arguments:
   <task-id>          Task ID to check
@@ -694,7 +1151,7 @@ Flags:
 func (c *TaskCheckReadyCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
 	// Parse task ID
 	if len(args) == 0 {
-		return fmt.Errorf("task ID is required")
+		return fmt.Errorf("%w: task ID is required", pluginsdk.ErrInvalidArgument)
 	}
 	c.taskID = args[0]
 	args = args[1:]
@@ -722,14 +1179,28 @@ func (c *TaskCheckReadyCommandAdapter) Execute(ctx context.Context, cmdCtx plugi
 		return fmt.Errorf("failed to get acceptance criteria: %w", err)
 	}
 
+	// Gating ACs of other tasks must be verified before the task can start
+	gates, err := c.TaskService.ListTaskGates(ctx, c.taskID)
+	if err != nil {
+		return fmt.Errorf("failed to get task gates: %w", err)
+	}
+	pending := entities.PendingGates(gates)
+
 	// Format output
 	out := cmdCtx.GetStdout()
 	fmt.Fprintf(out, "Task: %s\n", task.Title)
 	fmt.Fprintf(out, "Task ID: %s\n", task.ID)
+	if len(gates) > 0 {
+		fmt.Fprintf(out, "Gates: %d/%d gating criteria verified\n", len(gates)-len(pending), len(gates))
+	}
 
 	if len(acs) == 0 {
 		fmt.Fprintf(out, "\nNo acceptance criteria defined\n")
-		fmt.Fprintf(out, "Status: READY (no criteria to verify)\n")
+		if len(pending) > 0 {
+			fmt.Fprintf(out, "Status: NOT READY (%s)\n", entities.WaitingOnLabel(pending))
+		} else {
+			fmt.Fprintf(out, "Status: READY (no criteria to verify)\n")
+		}
 		return nil
 	}
 
@@ -758,7 +1229,9 @@ func (c *TaskCheckReadyCommandAdapter) Execute(ctx context.Context, cmdCtx plugi
 
 	// Summary
 	fmt.Fprintf(out, "\nSummary: %d/%d criteria verified\n", verifiedCount, len(acs))
-	if allVerified {
+	if allVerified && len(pending) > 0 {
+		fmt.Fprintf(out, "Status: NOT READY (%s)\n", entities.WaitingOnLabel(pending))
+	} else if allVerified {
 		fmt.Fprintf(out, "Status: READY (all criteria verified)\n")
 	} else {
 		fmt.Fprintf(out, "Status: NOT READY (some criteria pending)\n")
@@ -0,0 +1,209 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// unassignedLabel marks tasks without an assignee in CLI output
+const unassignedLabel = "(unassigned)"
+
+// assigneeLabel returns the task's assignee, or unassignedLabel if it has none
+func assigneeLabel(task *entities.TaskEntity) string {
+	if task.Assignee == "" {
+		return unassignedLabel
+	}
+	return task.Assignee
+}
+
+// filterTasksByAssignee keeps the tasks assigned to assignee, or only the unassigned
+// tasks if unassigned is set. With neither, tasks are returned unchanged.
+func filterTasksByAssignee(tasks []*entities.TaskEntity, assignee string, unassigned bool) []*entities.TaskEntity {
+	if assignee == "" && !unassigned {
+		return tasks
+	}
+	var filtered []*entities.TaskEntity
+	for _, task := range tasks {
+		if unassigned && task.Assignee != "" {
+			continue
+		}
+		if assignee != "" && task.Assignee != assignee {
+			continue
+		}
+		filtered = append(filtered, task)
+	}
+	return filtered
+}
+
+// parseAssigneeFilterFlags extracts --assignee <who> and --unassigned from args,
+// returning the remaining arguments
+func parseAssigneeFilterFlags(args []string) (assignee string, unassigned bool, rest []string, err error) {
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--assignee":
+			if i+1 < len(args) {
+				assignee = args[i+1]
+				i++
+			}
+		case "--unassigned":
+			unassigned = true
+		default:
+			rest = append(rest, args[i])
+		}
+	}
+	if assignee != "" && unassigned {
+		return "", false, nil, fmt.Errorf("%w: --assignee and --unassigned cannot be combined", pluginsdk.ErrInvalidArgument)
+	}
+	return assignee, unassigned, rest, nil
+}
+
+// printIterationTasks lists an iteration's tasks with their assignees, keeping only
+// those matching the assignee filter
+func printIterationTasks(out io.Writer, tasks []*entities.TaskEntity, assignee string, unassigned bool) {
+	heading := "Tasks"
+	switch {
+	case assignee != "":
+		heading = fmt.Sprintf("Tasks assigned to %s", assignee)
+	case unassigned:
+		heading = "Unassigned tasks"
+	}
+	tasks = filterTasksByAssignee(tasks, assignee, unassigned)
+	if len(tasks) == 0 {
+		if assignee != "" || unassigned {
+			fmt.Fprintf(out, "\n%s: (none)\n", heading)
+		}
+		return
+	}
+
+	fmt.Fprintf(out, "\n%s:\n", heading)
+	for _, task := range tasks {
+		if task.Assignee != "" {
+			fmt.Fprintf(out, "  - %s (%s) @%s\n", task.ID, task.Status, task.Assignee)
+		} else {
+			fmt.Fprintf(out, "  - %s (%s)\n", task.ID, task.Status)
+		}
+	}
+}
+
+// ============================================================================
+// TaskAssignCommandAdapter - Adapts CLI to AssignTask use case
+// ============================================================================
+
+// TaskAssignCommandAdapter adapts task assign/unassign CLI commands to the AssignTask use case.
+// Remove selects between "unassign" (true) and "assign" (false).
+type TaskAssignCommandAdapter struct {
+	TaskService *application.TaskApplicationService
+	Remove      bool
+
+	// CLI flags (parsed from args)
+	project string
+}
+
+func (c *TaskAssignCommandAdapter) verb() string {
+	if c.Remove {
+		return "unassign"
+	}
+	return "assign"
+}
+
+func (c *TaskAssignCommandAdapter) GetName() string {
+	return "task " + c.verb()
+}
+
+func (c *TaskAssignCommandAdapter) GetDescription() string {
+	if c.Remove {
+		return "Remove the assignee of a task"
+	}
+	return "Assign a task to someone"
+}
+
+func (c *TaskAssignCommandAdapter) GetUsage() string {
+	if c.Remove {
+		return "dw task-manager task unassign <task-id> [--project <name>]"
+	}
+	return "dw task-manager task assign <task-id> <who> [--project <name>]"
+}
+
+func (c *TaskAssignCommandAdapter) GetHelp() string {
+	if c.Remove {
+		return `Removes the assignee of a task, returning it to the unassigned pool.
+
+Arguments:
+  <task-id>          Task to unassign
+
+Flags:
+  --project <name>   Project name (optional, uses active project if not specified)
+
+Examples:
+  dw task-manager task unassign DW-task-12`
+	}
+	return `Assigns a task to someone. Assignees are free-form names (there is no
+user list); use the same spelling everywhere so filters and reports match.
+Assigning an already assigned task replaces its assignee.
+
+Filter by assignee with 'task list --assignee <who>', 'task backlog' and
+'iteration show'; 'report workload' tallies open tasks per assignee.
+
+Arguments:
+  <task-id>          Task to assign
+  <who>              Assignee name
+
+Flags:
+  --project <name>   Project name (optional, uses active project if not specified)
+
+Examples:
+  dw task-manager task assign DW-task-12 alice
+  dw task-manager task assign DW-task-12 "Bob Smith"`
+}
+
+func (c *TaskAssignCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	// Parse flags and positional arguments
+	var positional []string
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--project":
+			if i+1 < len(args) {
+				c.project = args[i+1]
+				i++
+			}
+		default:
+			positional = append(positional, args[i])
+		}
+	}
+
+	wantArgs := 2
+	if c.Remove {
+		wantArgs = 1
+	}
+	if len(positional) != wantArgs {
+		return fmt.Errorf("%w: usage: %s", pluginsdk.ErrInvalidArgument, c.GetUsage())
+	}
+	taskID := positional[0]
+
+	assignee := ""
+	if !c.Remove {
+		assignee = strings.TrimSpace(positional[1])
+		if assignee == "" {
+			return fmt.Errorf("%w: assignee must be non-empty (use 'task unassign' to remove it)", pluginsdk.ErrInvalidArgument)
+		}
+	}
+
+	task, err := c.TaskService.AssignTask(ctx, taskID, assignee)
+	if err != nil {
+		return fmt.Errorf("failed to %s task: %w", c.verb(), err)
+	}
+
+	out := cmdCtx.GetStdout()
+	if c.Remove {
+		fmt.Fprintf(out, "Task %s is now unassigned\n", task.ID)
+	} else {
+		fmt.Fprintf(out, "Assigned task %s to %s\n", task.ID, task.Assignee)
+	}
+	return nil
+}
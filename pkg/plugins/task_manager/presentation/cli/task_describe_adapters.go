@@ -0,0 +1,210 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application/dto"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// taskDescribeHeader is the comment opening the file 'task describe' edits. It is an
+// HTML comment so that Markdown headers in the description can start with '#'.
+const taskDescribeHeader = "<!-- Editing the description of %s (Markdown). This comment is ignored; save an empty file to cancel. -->\n"
+
+// formatTaskDescriptionForEdit renders a task description for editing, after the header
+func formatTaskDescriptionForEdit(taskID, description string) string {
+	return fmt.Sprintf(taskDescribeHeader, taskID) + description + "\n"
+}
+
+// parseTaskDescriptionEdit returns the description of an edited file: everything but
+// the header comment, with surrounding blank lines and trailing whitespace removed.
+// cancelled is true when nothing else is left.
+func parseTaskDescriptionEdit(taskID, content string) (description string, cancelled bool) {
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+	content = strings.TrimPrefix(content, fmt.Sprintf(taskDescribeHeader, taskID))
+	description = strings.TrimRight(strings.TrimLeft(content, "\n"), " \t\n")
+	return description, description == ""
+}
+
+// appendTaskDescription adds text to description as a new paragraph
+func appendTaskDescription(description, text string) string {
+	description = strings.TrimRight(description, " \t\n")
+	if description == "" {
+		return text
+	}
+	return description + "\n\n" + text
+}
+
+// ============================================================================
+// TaskDescribeCommandAdapter - Adapts CLI to UpdateTask use case via $EDITOR
+// ============================================================================
+
+// TaskDescribeCommandAdapter edits a task's Markdown description in $EDITOR
+type TaskDescribeCommandAdapter struct {
+	TaskService *application.TaskApplicationService
+
+	// CLI flags
+	project        string
+	description    string
+	appendText     string
+	setDescription bool
+	setAppend      bool
+}
+
+func (c *TaskDescribeCommandAdapter) GetName() string {
+	return "task describe"
+}
+
+func (c *TaskDescribeCommandAdapter) GetDescription() string {
+	return "Edit a task's description in $EDITOR"
+}
+
+func (c *TaskDescribeCommandAdapter) GetUsage() string {
+	return "dw task-manager task describe <task-id> [--description \"...\"] [--append \"...\"]"
+}
+
+func (c *TaskDescribeCommandAdapter) GetHelp() string {
+	return `Opens $EDITOR with the task's description as a Markdown file and saves it
+when the editor exits. Everything below the leading comment is kept as
+written, including headers, lists and code blocks; only trailing blank lines
+are dropped. The TUI task view renders the description as Markdown.
+
+Saving an empty file, or quitting the editor with an error (e.g. :cq in vim),
+cancels the edit. To clear a description, pass --description "".
+
+Flags:
+  <task-id>              Task ID to describe (required)
+  --description "..."    Replace the description without opening an editor
+  --append "..."         Add a paragraph to the description without opening an editor
+  --project <name>       Project name (optional)
+
+One of the flags is required when $EDITOR is not set.
+
+Examples:
+  EDITOR=vim dw task-manager task describe DW-task-1
+  dw task-manager task describe DW-task-1 --append $'## Notes\n- Found while testing'
+  dw task-manager task describe DW-task-1 --description "$(cat spec.md)"`
+}
+
+func (c *TaskDescribeCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	// Parse positional argument
+	if len(args) == 0 || strings.HasPrefix(args[0], "--") {
+		return fmt.Errorf("%w: <task-id> is required", pluginsdk.ErrInvalidArgument)
+	}
+	taskID := args[0]
+	args = args[1:]
+
+	// Parse flags
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--project":
+			if i+1 < len(args) {
+				c.project = args[i+1]
+				i++
+			}
+		case "--description":
+			if i+1 < len(args) {
+				c.description = args[i+1]
+				c.setDescription = true
+				i++
+			}
+		case "--append":
+			if i+1 < len(args) {
+				c.appendText = args[i+1]
+				c.setAppend = true
+				i++
+			}
+		}
+	}
+	if c.setDescription && c.setAppend {
+		return fmt.Errorf("%w: --description and --append cannot be combined", pluginsdk.ErrInvalidArgument)
+	}
+	if c.setAppend && strings.TrimSpace(c.appendText) == "" {
+		return fmt.Errorf("%w: --append text cannot be empty", pluginsdk.ErrInvalidArgument)
+	}
+
+	out := pluginsdk.InfoWriter(cmdCtx)
+	description := c.description
+	if !c.setDescription {
+		editor := strings.TrimSpace(os.Getenv("EDITOR"))
+		if !c.setAppend && editor == "" {
+			return fmt.Errorf("%w: $EDITOR is not set; set it or pass --description/--append", pluginsdk.ErrInvalidArgument)
+		}
+
+		task, err := c.TaskService.GetTask(ctx, taskID)
+		if err != nil {
+			return fmt.Errorf("failed to get task: %w", err)
+		}
+
+		if c.setAppend {
+			description = appendTaskDescription(task.Description, c.appendText)
+		} else {
+			var cancelled bool
+			description, cancelled, err = editTaskDescription(editor, taskID, task.Description)
+			if err != nil {
+				return err
+			}
+			if cancelled {
+				fmt.Fprintf(out, "Edit cancelled, %s unchanged\n", taskID)
+				return nil
+			}
+		}
+		if description == task.Description {
+			fmt.Fprintf(out, "No changes to %s\n", taskID)
+			return nil
+		}
+	}
+
+	task, err := c.TaskService.UpdateTask(ctx, dto.UpdateTaskDTO{ID: taskID, Description: &description})
+	if err != nil {
+		return fmt.Errorf("failed to update task: %w", err)
+	}
+
+	lines := 0
+	if task.Description != "" {
+		lines = strings.Count(task.Description, "\n") + 1
+	}
+	fmt.Fprintf(out, "Description of %s updated (%d line(s))\n", task.ID, lines)
+	return nil
+}
+
+// editTaskDescription opens a task description in editor through a temporary Markdown
+// file and returns the saved description
+func editTaskDescription(editor, taskID, description string) (edited string, cancelled bool, err error) {
+	file, err := os.CreateTemp("", "dw-task-describe-*.md")
+	if err != nil {
+		return "", false, fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	path := file.Name()
+	defer os.Remove(path)
+
+	_, err = file.WriteString(formatTaskDescriptionForEdit(taskID, description))
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", false, fmt.Errorf("failed to write temporary file: %w", err)
+	}
+
+	if err := runEditor(editor, path); err != nil {
+		// Quitting the editor with an error (vim's :cq) aborts, like git commit
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return "", true, nil
+		}
+		return "", false, fmt.Errorf("failed to run editor %q: %w", editor, err)
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to read edited file: %w", err)
+	}
+	edited, cancelled = parseTaskDescriptionEdit(taskID, string(content))
+	return edited, cancelled, nil
+}
@@ -0,0 +1,121 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// ============================================================================
+// TaskGateCommandAdapter - Adapts CLI to GateTask / UngateTask use cases
+// ============================================================================
+
+// TaskGateCommandAdapter adapts task gate/ungate CLI commands to application use cases.
+// Remove selects between "ungate" (true) and "gate" (false).
+type TaskGateCommandAdapter struct {
+	TaskService *application.TaskApplicationService
+	Remove      bool
+
+	// CLI flags (parsed from args)
+	project string
+	acID    string
+}
+
+func (c *TaskGateCommandAdapter) verb() string {
+	if c.Remove {
+		return "ungate"
+	}
+	return "gate"
+}
+
+func (c *TaskGateCommandAdapter) GetName() string {
+	return "task " + c.verb()
+}
+
+func (c *TaskGateCommandAdapter) GetDescription() string {
+	if c.Remove {
+		return "Stop blocking a task on an acceptance criterion"
+	}
+	return "Block a task until an acceptance criterion is verified"
+}
+
+func (c *TaskGateCommandAdapter) GetUsage() string {
+	return fmt.Sprintf("dw task-manager task %s <task-id> --on-ac <ac-id> [--project <name>]", c.verb())
+}
+
+func (c *TaskGateCommandAdapter) GetHelp() string {
+	return fmt.Sprintf(`%s.
+
+A gated task is blocked until every AC it is gated on is verified: 'task show',
+'task check-ready' and the TUI report it as "waiting on AC <id>". Gates are
+finer-grained than track dependencies, e.g. a task can wait for one AC of a
+dependency task instead of the whole track.
+
+A task cannot be gated on its own ACs, and gates may not form a cycle.
+
+Arguments:
+  <task-id>           Task to %s
+
+Flags:
+  --on-ac <ac-id>     Gating acceptance criterion (required)
+  --project <name>    Project name (optional, uses active project if not specified)
+
+Examples:
+  dw task-manager task %s DW-task-12 --on-ac DW-ac-4`, c.GetDescription(), c.verb(), c.verb())
+}
+
+func (c *TaskGateCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	// Parse flags and positional arguments
+	var positional []string
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--project":
+			if i+1 < len(args) {
+				c.project = args[i+1]
+				i++
+			}
+		case "--on-ac":
+			if i+1 < len(args) {
+				c.acID = args[i+1]
+				i++
+			}
+		default:
+			positional = append(positional, args[i])
+		}
+	}
+	if len(positional) != 1 || c.acID == "" {
+		return fmt.Errorf("%w: usage: %s", pluginsdk.ErrInvalidArgument, c.GetUsage())
+	}
+	taskID := positional[0]
+
+	out := cmdCtx.GetStdout()
+	if c.Remove {
+		if err := c.TaskService.UngateTask(ctx, taskID, c.acID); err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "Task %s is no longer gated on AC %s\n", taskID, c.acID)
+	} else {
+		ac, err := c.TaskService.GateTask(ctx, taskID, c.acID)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "Gated task %s on AC %s (task %s, %s)\n", taskID, ac.ID, ac.TaskID, ac.Status)
+	}
+
+	gates, err := c.TaskService.ListTaskGates(ctx, taskID)
+	if err != nil {
+		return fmt.Errorf("failed to list task gates: %w", err)
+	}
+	if pending := entities.PendingGates(gates); len(pending) > 0 {
+		fmt.Fprintf(out, "  Blocked: %s\n", entities.WaitingOnLabel(pending))
+	} else if len(gates) > 0 {
+		fmt.Fprintf(out, "  Not blocked: all gating ACs verified\n")
+	} else {
+		fmt.Fprintf(out, "  Not blocked: no gates\n")
+	}
+
+	return nil
+}
@@ -0,0 +1,426 @@
+package cli
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// taskListDefaultColumns are shown when --columns is not given
+var taskListDefaultColumns = []string{"id", "track", "status", "title"}
+
+// taskListColumns lists the columns accepted by --columns and --sort, in help order
+var taskListColumns = []string{"id", "title", "status", "rank", "track", "iteration", "assignee"}
+
+// taskListRow is one task with the iterations it belongs to
+type taskListRow struct {
+	task       *entities.TaskEntity
+	iterations []int
+}
+
+// text renders a column as plain text; icons prefixes the status with its icon
+func (r taskListRow) text(column string, icons bool) string {
+	switch column {
+	case "id":
+		return r.task.ID
+	case "title":
+		return r.task.Title
+	case "status":
+		if icons {
+			return GetStatusIcon(r.task.Status) + " " + r.task.Status
+		}
+		return r.task.Status
+	case "rank":
+		return strconv.Itoa(r.task.Rank)
+	case "track":
+		return r.task.TrackID
+	case "assignee":
+		return r.task.Assignee
+	case "iteration":
+		numbers := make([]string, len(r.iterations))
+		for i, number := range r.iterations {
+			numbers[i] = strconv.Itoa(number)
+		}
+		return strings.Join(numbers, ",")
+	}
+	return ""
+}
+
+// value returns a column as a JSON value
+func (r taskListRow) value(column string) interface{} {
+	switch column {
+	case "rank":
+		return r.task.Rank
+	case "iteration":
+		if r.iterations == nil {
+			return []int{}
+		}
+		return r.iterations
+	}
+	return r.text(column, false)
+}
+
+// compareTaskListRows orders two rows by column; ties fall back to numeric ID order
+func compareTaskListRows(a, b taskListRow, column string) int {
+	switch column {
+	case "title":
+		if c := strings.Compare(strings.ToLower(a.task.Title), strings.ToLower(b.task.Title)); c != 0 {
+			return c
+		}
+	case "status", "track":
+		if c := strings.Compare(a.text(column, false), b.text(column, false)); c != 0 {
+			return c
+		}
+	case "assignee":
+		// Unassigned tasks sort last
+		switch {
+		case a.task.Assignee == "" && b.task.Assignee != "":
+			return 1
+		case a.task.Assignee != "" && b.task.Assignee == "":
+			return -1
+		}
+		if c := strings.Compare(a.task.Assignee, b.task.Assignee); c != 0 {
+			return c
+		}
+	case "rank":
+		if c := a.task.Rank - b.task.Rank; c != 0 {
+			return c
+		}
+	case "iteration":
+		// Tasks outside any iteration sort last
+		switch {
+		case len(a.iterations) == 0 && len(b.iterations) > 0:
+			return 1
+		case len(a.iterations) > 0 && len(b.iterations) == 0:
+			return -1
+		case len(a.iterations) > 0 && a.iterations[0] != b.iterations[0]:
+			return a.iterations[0] - b.iterations[0]
+		}
+	}
+	return CompareEntityIDs(a.task.ID, b.task.ID)
+}
+
+// ============================================================================
+// TaskListCommandAdapter - Adapts CLI to ListTasks use case
+// ============================================================================
+
+type TaskListCommandAdapter struct {
+	TaskService      *application.TaskApplicationService
+	TrackService     *application.TrackApplicationService // resolves the active roadmap
+	IterationService *application.IterationApplicationService
+
+	// CLI flags
+	project     string
+	trackID     string
+	allRoadmaps bool
+	status      string
+	assignee    string
+	unassigned  bool
+	tag         string
+	columns     string
+	sortBy      string
+	reverse     bool
+	format      string
+}
+
+func (c *TaskListCommandAdapter) GetName() string {
+	return "task list"
+}
+
+func (c *TaskListCommandAdapter) GetDescription() string {
+	return "List all tasks with optional filtering"
+}
+
+func (c *TaskListCommandAdapter) GetUsage() string {
+	return "dw task-manager task list [--track <track-id> | --all-roadmaps] [--status <status>] [--assignee <who> | --unassigned] [--tag <tag>] [--columns <list>] [--sort <column>] [--reverse] [--format table|csv|json] [--project <name>]"
+}
+
+func (c *TaskListCommandAdapter) GetHelp() string {
+	return `Lists the tasks of the active roadmap as a column-aligned table, CSV or JSON.
+
+Flags:
+  --track <track-id>    Filter by parent track ID (of any roadmap)
+  --all-roadmaps        Include the tasks of every roadmap, archived ones too
+  --status <status>     Filter by status (todo, in-progress, review, done);
+                        separate several with commas
+  --assignee <who>      Filter by assignee
+  --unassigned          Only show tasks without an assignee
+  --tag <tag>           Only show tasks with an acceptance criterion carrying
+                        this tag (tasks themselves have no tags)
+  --columns <list>      Comma-separated columns to show
+                        (id, title, status, rank, track, iteration, assignee;
+                        default: id,track,status,title)
+  --sort <column>       Sort by a column (default: id, numerically)
+  --reverse             Reverse the sort order
+  --format <format>     table (default), csv or json
+  --project <name>      Project name (optional)
+
+Notes:
+  - Status is prefixed with an icon in tables unless colors are off
+    (--no-color, NO_COLOR, or output piped to another program)
+  - The iteration column lists every iteration containing the task
+  - Unassigned tasks show "-" in the assignee column of tables
+  - Ties are broken by numeric task ID, so DW-task-9 comes before DW-task-10
+
+Examples:
+  dw task-manager task list --status todo,in-progress --sort rank
+  dw task-manager task list --columns id,iteration,title --sort iteration
+  dw task-manager task list --assignee alice --columns id,status,assignee,title
+  dw task-manager task list --tag security --status todo,in-progress
+  dw task-manager task list --format csv --columns id,status,rank > tasks.csv
+  dw task-manager task list --format json | jq '.[].id'`
+}
+
+func (c *TaskListCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
+	// Parse flags
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--project":
+			if i+1 < len(args) {
+				c.project = args[i+1]
+				i++
+			}
+		case "--track":
+			if i+1 < len(args) {
+				c.trackID = args[i+1]
+				i++
+			}
+		case "--status":
+			if i+1 < len(args) {
+				c.status = args[i+1]
+				i++
+			}
+		case "--columns":
+			if i+1 < len(args) {
+				c.columns = args[i+1]
+				i++
+			}
+		case "--sort":
+			if i+1 < len(args) {
+				c.sortBy = args[i+1]
+				i++
+			}
+		case "--assignee":
+			if i+1 < len(args) {
+				c.assignee = args[i+1]
+				i++
+			}
+		case "--tag":
+			if i+1 < len(args) {
+				c.tag = args[i+1]
+				i++
+			}
+		case "--unassigned":
+			c.unassigned = true
+		case "--all-roadmaps":
+			c.allRoadmaps = true
+		case "--reverse":
+			c.reverse = true
+		case "--format":
+			if i+1 < len(args) {
+				c.format = args[i+1]
+				i++
+			}
+		}
+	}
+
+	columns, err := parseTaskListColumns(c.columns)
+	if err != nil {
+		return err
+	}
+	sortBy := c.sortBy
+	if sortBy == "" {
+		sortBy = "id"
+	}
+	if !containsString(taskListColumns, sortBy) {
+		return fmt.Errorf("%w: invalid --sort '%s' (must be one of %s)", pluginsdk.ErrInvalidArgument, sortBy, strings.Join(taskListColumns, ", "))
+	}
+	format := c.format
+	if format == "" {
+		format = "table"
+	}
+	if format != "table" && format != "csv" && format != "json" {
+		return fmt.Errorf("%w: invalid --format '%s' (must be table, csv or json)", pluginsdk.ErrInvalidArgument, format)
+	}
+
+	if c.assignee != "" && c.unassigned {
+		return fmt.Errorf("%w: --assignee and --unassigned cannot be combined", pluginsdk.ErrInvalidArgument)
+	}
+
+	// Build filters
+	filters := entities.TaskFilters{
+		TrackID:    c.trackID,
+		Assignee:   c.assignee,
+		Unassigned: c.unassigned,
+	}
+	if c.tag != "" {
+		tag, err := entities.NormalizeACTag(c.tag)
+		if err != nil {
+			return err
+		}
+		filters.ACTag = tag
+	}
+	// An explicit track selects its tasks whatever roadmap it belongs to
+	if c.trackID == "" && !c.allRoadmaps {
+		roadmapID, err := activeRoadmapID(ctx, c.TrackService)
+		if err != nil {
+			return err
+		}
+		filters.RoadmapID = roadmapID
+	}
+	if c.status != "" {
+		for _, status := range strings.Split(c.status, ",") {
+			status = strings.TrimSpace(status)
+			if !entities.IsValidTaskStatus(status) {
+				return fmt.Errorf("%w: invalid --status '%s' (must be todo, in-progress, review or done)", pluginsdk.ErrInvalidArgument, status)
+			}
+			filters.Status = append(filters.Status, status)
+		}
+	}
+
+	// Execute via application service
+	tasks, err := c.TaskService.ListTasks(ctx, filters)
+	if err != nil {
+		return fmt.Errorf("failed to list tasks: %w", err)
+	}
+
+	rows := make([]taskListRow, len(tasks))
+	for i, task := range tasks {
+		rows[i] = taskListRow{task: task}
+	}
+
+	// Iteration membership is only loaded when it is shown or sorted on
+	if (containsString(columns, "iteration") || sortBy == "iteration") && c.IterationService != nil {
+		iterations, err := c.IterationService.ListIterations(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list iterations: %w", err)
+		}
+		byTask := make(map[string][]int)
+		for _, iteration := range iterations {
+			for _, taskID := range iteration.TaskIDs {
+				byTask[taskID] = append(byTask[taskID], iteration.Number)
+			}
+		}
+		for i := range rows {
+			rows[i].iterations = byTask[rows[i].task.ID]
+			sort.Ints(rows[i].iterations)
+		}
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool {
+		cmp := compareTaskListRows(rows[i], rows[j], sortBy)
+		if c.reverse {
+			return cmp > 0
+		}
+		return cmp < 0
+	})
+
+	// Format output
+	out := cmdCtx.GetStdout()
+	switch format {
+	case "csv":
+		return writeTaskListCSV(out, rows, columns)
+	case "json":
+		return writeTaskListJSON(out, rows, columns)
+	}
+
+	if len(rows) == 0 {
+		fmt.Fprintf(out, "No tasks found\n")
+		return nil
+	}
+
+	icons := os.Getenv("NO_COLOR") == "" && isTerminal(out)
+	tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	headers := make([]string, len(columns))
+	rules := make([]string, len(columns))
+	for i, column := range columns {
+		headers[i] = taskListHeader(column)
+		rules[i] = strings.Repeat("-", len(headers[i]))
+	}
+	fmt.Fprintln(tw, strings.Join(headers, "\t"))
+	fmt.Fprintln(tw, strings.Join(rules, "\t"))
+	for _, row := range rows {
+		cells := make([]string, len(columns))
+		for i, column := range columns {
+			cells[i] = row.text(column, icons)
+			if column == "assignee" && cells[i] == "" {
+				cells[i] = "-"
+			}
+		}
+		fmt.Fprintln(tw, strings.Join(cells, "\t"))
+	}
+	tw.Flush()
+
+	fmt.Fprintf(out, "\nTotal: %d task(s)\n", len(rows))
+	return nil
+}
+
+// taskListHeader returns the table header for a column
+func taskListHeader(column string) string {
+	if column == "id" {
+		return "ID"
+	}
+	return strings.ToUpper(column[:1]) + column[1:]
+}
+
+// parseTaskListColumns validates a --columns value, returning the default columns if empty
+func parseTaskListColumns(value string) ([]string, error) {
+	if value == "" {
+		return taskListDefaultColumns, nil
+	}
+	var columns []string
+	for _, column := range strings.Split(value, ",") {
+		column = strings.ToLower(strings.TrimSpace(column))
+		if !containsString(taskListColumns, column) {
+			return nil, fmt.Errorf("%w: unknown column '%s' (must be one of %s)", pluginsdk.ErrInvalidArgument, column, strings.Join(taskListColumns, ", "))
+		}
+		columns = append(columns, column)
+	}
+	return columns, nil
+}
+
+func writeTaskListCSV(out io.Writer, rows []taskListRow, columns []string) error {
+	w := csv.NewWriter(out)
+	if err := w.Write(columns); err != nil {
+		return fmt.Errorf("failed to write CSV: %w", err)
+	}
+	for _, row := range rows {
+		record := make([]string, len(columns))
+		for i, column := range columns {
+			record[i] = row.text(column, false)
+		}
+		if err := w.Write(record); err != nil {
+			return fmt.Errorf("failed to write CSV: %w", err)
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
+
+func writeTaskListJSON(out io.Writer, rows []taskListRow, columns []string) error {
+	items := make([]map[string]interface{}, len(rows))
+	for i, row := range rows {
+		item := make(map[string]interface{}, len(columns))
+		for _, column := range columns {
+			item[column] = row.value(column)
+		}
+		items[i] = item
+	}
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(items); err != nil {
+		return fmt.Errorf("failed to write JSON: %w", err)
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"bufio"
 	"context"
 	"fmt"
 	"strconv"
@@ -25,6 +26,8 @@ type TrackCreateCommandAdapter struct {
 	title       string
 	description string
 	rank        int
+	dependsOn   []string
+	interactive bool
 }
 
 func (c *TrackCreateCommandAdapter) GetName() string {
@@ -36,7 +39,7 @@ func (c *TrackCreateCommandAdapter) GetDescription() string {
 }
 
 func (c *TrackCreateCommandAdapter) GetUsage() string {
-	return "dw task-manager track create --title <title> [--description <desc>] [--rank <rank>]"
+	return "dw task-manager track create --title <title> [--description <desc>] [--rank <rank>] [--depends-on <id1,id2>] [--interactive]"
 }
 
 func (c *TrackCreateCommandAdapter) GetHelp() string {
@@ -51,6 +54,8 @@ Flags:
   --description <desc>     Track description (optional)
   --rank <rank>            Track rank (optional, default: 500)
                           Range: 1-1000 (lower = higher priority)
+  --depends-on <ids>       Comma-separated IDs of tracks this track depends on (optional)
+  --interactive            Prompt for title, description, rank and dependencies
   --project <name>         Project name (optional, uses active project if not specified)
 
 Examples:
@@ -63,11 +68,18 @@ Examples:
     --description "Implement extensible plugin architecture" \
     --rank 100
 
+  # Create a track that depends on existing tracks
+  dw task-manager track create --title "Plugin Marketplace" --depends-on DW-track-1,DW-track-2
+
+  # Pick dependencies from a list of existing tracks
+  dw task-manager track create --interactive
+
 Notes:
   - Track ID is auto-generated in format: <PROJECT_CODE>-track-<number> (e.g., DW-track-1)
   - An active roadmap must exist (create with 'dw task-manager roadmap init')
   - Initial status is automatically set to 'not-started'
-  - No dependencies are added initially (use track add-dependency)
+  - Dependencies are checked before saving: every track must exist and no cycle may form
+  - In interactive mode, flags given on the command line are used as defaults
   - Rank determines ordering: lower values appear first (1=highest, 1000=lowest)`
 }
 
@@ -100,14 +112,16 @@ func (c *TrackCreateCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsd
 				}
 				i++
 			}
+		case "--depends-on":
+			if i+1 < len(args) {
+				c.dependsOn = splitTrackIDs(args[i+1])
+				i++
+			}
+		case "--interactive":
+			c.interactive = true
 		}
 	}
 
-	// Validate required flags
-	if c.title == "" {
-		return fmt.Errorf("--title is required")
-	}
-
 	// Get active roadmap ID
 	// Note: The service requires roadmap ID to verify roadmap exists
 	roadmap, err := c.TrackService.GetActiveRoadmap(ctx)
@@ -115,13 +129,25 @@ func (c *TrackCreateCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsd
 		return fmt.Errorf("failed to get active roadmap: %w (create one with 'dw task-manager roadmap init')", err)
 	}
 
+	if c.interactive {
+		if err := c.promptInteractive(ctx, cmdCtx, roadmap.ID); err != nil {
+			return err
+		}
+	}
+
+	// Validate required flags
+	if c.title == "" {
+		return fmt.Errorf("%w: --title is required", pluginsdk.ErrInvalidArgument)
+	}
+
 	// Create DTO with roadmap ID
 	input := dto.CreateTrackDTO{
-		RoadmapID:   roadmap.ID,
-		Title:       c.title,
-		Description: c.description,
-		Status:      "not-started",
-		Rank:        c.rank,
+		RoadmapID:    roadmap.ID,
+		Title:        c.title,
+		Description:  c.description,
+		Status:       "not-started",
+		Rank:         c.rank,
+		Dependencies: c.dependsOn,
 	}
 
 	// Execute via application service
@@ -140,10 +166,92 @@ func (c *TrackCreateCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsd
 	if track.Description != "" {
 		fmt.Fprintf(out, "  Description: %s\n", track.Description)
 	}
+	if len(track.Dependencies) > 0 {
+		fmt.Fprintf(out, "  Depends on:  %s\n", strings.Join(track.Dependencies, ", "))
+	}
+
+	return nil
+}
+
+// promptInteractive asks for the track fields on stdin, using the parsed flags as
+// defaults, and lets the user pick dependencies from the existing tracks.
+func (c *TrackCreateCommandAdapter) promptInteractive(ctx context.Context, cmdCtx pluginsdk.CommandContext, roadmapID string) error {
+	out := cmdCtx.GetStdout()
+	scanner := bufio.NewScanner(cmdCtx.GetStdin())
+	ask := func(label, current string) string {
+		if current != "" {
+			fmt.Fprintf(out, "%s [%s]: ", label, current)
+		} else {
+			fmt.Fprintf(out, "%s: ", label)
+		}
+		if !scanner.Scan() {
+			return current
+		}
+		if answer := strings.TrimSpace(scanner.Text()); answer != "" {
+			return answer
+		}
+		return current
+	}
+
+	c.title = ask("Title", c.title)
+	c.description = ask("Description", c.description)
+	rankAnswer := ask("Rank (1-1000)", strconv.Itoa(c.rank))
+	rank, err := strconv.Atoi(rankAnswer)
+	if err != nil || rank < 1 || rank > 1000 {
+		return fmt.Errorf("invalid rank: must be between 1 and 1000")
+	}
+	c.rank = rank
 
+	tracks, err := c.TrackService.ListTracks(ctx, roadmapID, entities.TrackFilters{})
+	if err != nil {
+		return fmt.Errorf("failed to list tracks: %w", err)
+	}
+	if len(tracks) == 0 {
+		fmt.Fprintf(out, "No existing tracks to depend on\n")
+		return nil
+	}
+
+	selected := make(map[string]bool)
+	for _, id := range c.dependsOn {
+		selected[id] = true
+	}
+	fmt.Fprintf(out, "\nExisting tracks:\n")
+	for i, track := range tracks {
+		mark := " "
+		if selected[track.ID] {
+			mark = "x"
+		}
+		fmt.Fprintf(out, "  %2d. [%s] %s  %s\n", i+1, mark, track.ID, track.Title)
+	}
+
+	answer := ask("Depends on (numbers or IDs, comma-separated; empty keeps selection)", "")
+	if answer == "" {
+		return nil
+	}
+	c.dependsOn = nil
+	for _, item := range splitTrackIDs(answer) {
+		if n, err := strconv.Atoi(item); err == nil {
+			if n < 1 || n > len(tracks) {
+				return fmt.Errorf("invalid selection %d: must be between 1 and %d", n, len(tracks))
+			}
+			item = tracks[n-1].ID
+		}
+		c.dependsOn = append(c.dependsOn, item)
+	}
 	return nil
 }
 
+// splitTrackIDs splits a comma-separated list of track IDs, dropping empty entries
+func splitTrackIDs(value string) []string {
+	var ids []string
+	for _, id := range strings.Split(value, ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
 // ============================================================================
 // TrackUpdateCommandAdapter - Adapts CLI to UpdateTrackCommand use case
 // ============================================================================
@@ -202,7 +310,7 @@ Examples:
 func (c *TrackUpdateCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
 	// Parse track ID (first positional argument)
 	if len(args) == 0 {
-		return fmt.Errorf("track ID is required")
+		return fmt.Errorf("%w: track ID is required", pluginsdk.ErrInvalidArgument)
 	}
 	c.trackID = args[0]
 	args = args[1:]
@@ -438,7 +546,7 @@ Examples:
 func (c *TrackShowCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
 	// Parse track ID
 	if len(args) == 0 {
-		return fmt.Errorf("track ID is required")
+		return fmt.Errorf("%w: track ID is required", pluginsdk.ErrInvalidArgument)
 	}
 	c.trackID = args[0]
 	args = args[1:]
@@ -522,6 +630,7 @@ type TrackDeleteCommandAdapter struct {
 	project string
 	trackID string
 	force   bool
+	plan    bool
 }
 
 func (c *TrackDeleteCommandAdapter) GetName() string {
@@ -533,19 +642,25 @@ func (c *TrackDeleteCommandAdapter) GetDescription() string {
 }
 
 func (c *TrackDeleteCommandAdapter) GetUsage() string {
-	return "dw task-manager track delete <track-id> [--force]"
+	return "dw task-manager track delete <track-id> [--force | --plan]"
 }
 
 func (c *TrackDeleteCommandAdapter) GetHelp() string {
 	return `Deletes a track from the roadmap.
 
-Requires the --force flag for safety.
+Requires the --force flag for safety. Only the track itself is deleted: its
+tasks, their ACs, ADRs, documents and dependencies are kept and still refer
+to the deleted track. --plan counts them without deleting anything.
 
 Flags:
   --force             Required to confirm deletion
+  --plan              Show what would be deleted or orphaned, without deleting
   --project <name>    Project name (optional)
 
 Examples:
+  # Show the blast radius first
+  dw task-manager track delete TM-track-1 --plan
+
   # Delete a track
   dw task-manager track delete TM-track-1 --force`
 }
@@ -553,7 +668,7 @@ Examples:
 func (c *TrackDeleteCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
 	// Parse track ID
 	if len(args) == 0 {
-		return fmt.Errorf("track ID is required")
+		return fmt.Errorf("%w: track ID is required", pluginsdk.ErrInvalidArgument)
 	}
 	c.trackID = args[0]
 	args = args[1:]
@@ -568,12 +683,27 @@ func (c *TrackDeleteCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsd
 			}
 		case "--force":
 			c.force = true
+		case "--plan":
+			c.plan = true
 		}
 	}
 
+	if c.plan {
+		plan, err := c.TrackService.PlanTrackDeletion(ctx, c.trackID)
+		if err != nil {
+			return fmt.Errorf("failed to plan track deletion: %w", err)
+		}
+		out := cmdCtx.GetStdout()
+		plan.Write(out)
+		if n := plan.OrphanedCount("task"); n > 0 {
+			fmt.Fprintf(out, "  Tasks are neither deleted nor reparented: move them first with 'task move' to keep them on a track\n")
+		}
+		return nil
+	}
+
 	// Validate --force flag
 	if !c.force {
-		return fmt.Errorf("--force flag is required to confirm deletion")
+		return fmt.Errorf("%w: --force flag is required to confirm deletion", pluginsdk.ErrInvalidArgument)
 	}
 
 	// Execute via application service
@@ -631,7 +761,7 @@ Examples:
 func (c *TrackAddDependencyCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
 	// Parse track IDs
 	if len(args) < 2 {
-		return fmt.Errorf("both track-id and depends-on-id are required")
+		return fmt.Errorf("%w: both track-id and depends-on-id are required", pluginsdk.ErrInvalidArgument)
 	}
 	c.trackID = args[0]
 	c.dependsOnID = args[1]
@@ -700,7 +830,7 @@ Examples:
 func (c *TrackRemoveDependencyCommandAdapter) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
 	// Parse track IDs
 	if len(args) < 2 {
-		return fmt.Errorf("both track-id and depends-on-id are required")
+		return fmt.Errorf("%w: both track-id and depends-on-id are required", pluginsdk.ErrInvalidArgument)
 	}
 	c.trackID = args[0]
 	c.dependsOnID = args[1]
@@ -2,16 +2,36 @@ package tui
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/atotto/clipboard"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
-	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application/dto"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/presentation/tui/components"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/presentation/tui/presenters"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/presentation/tui/queries"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/presentation/tui/viewmodels"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
 )
 
+// flashDuration is how long status flashes (e.g. "Copied DW-task-12") stay visible
+const flashDuration = 2 * time.Second
+
+// statusLineHeight is the height kept free below the active view for a status flash and
+// the status bar, so neither pushes a full-height view off the top of the screen
+const statusLineHeight = 2
+
+// statusBarRefreshInterval is how often the status bar's "updated Ns ago" is redrawn
+const statusBarRefreshInterval = time.Second
+
 // ViewStateNew represents the current view in the new MVP TUI
 type ViewStateNew int
 
@@ -22,6 +42,7 @@ const (
 	ViewIterationDetailNew
 	ViewTaskDetailNew
 	ViewTrackDetailNew
+	ViewNoRoadmapNew
 )
 
 // AppModelNew is the root Bubble Tea model for the new MVP TUI
@@ -30,8 +51,10 @@ type AppModelNew struct {
 	repo        domain.RoadmapRepository
 	logger      pluginsdk.Logger
 	projectName string
+	dbPath      string // Shown in the status bar
 
 	currentView     ViewStateNew
+	startView       StartView // View to open in Init (roadmap list by default)
 	activePresenter presenters.Presenter
 	lastError       error
 
@@ -41,7 +64,40 @@ type AppModelNew struct {
 	currentTaskID          string
 	currentTrackID         string
 	currentActiveTab       presenters.IterationDetailTab // Track active tab for AC actions
-	dashboardSelectedIndex int                            // Dashboard selected index (for restoring focus on return)
+	dashboardSelectedIndex int                           // Dashboard selected index (for restoring focus on return)
+
+	// Bindings of the remappable actions (task_manager.tui.keys)
+	keymap components.KeyMap
+
+	// Clipboard support (y key)
+	writeClipboard func(string) error
+	flash          string // Status line shown below the active view
+	flashIsError   bool
+	flashSeq       int // Incremented per flash so stale clear ticks are ignored
+
+	// Go-to-ID prompt (: key)
+	gotoInput  textinput.Model
+	gotoActive bool
+
+	// "My tasks" filter (m key), keyed to the configured user name
+	currentUser string
+	myTasksOnly bool
+
+	// Unit of the iteration detail progress (task_manager.iteration.progress_basis)
+	progressBasis entities.ProgressBasis
+
+	// Track detail task order (s key), kept for the rest of the session
+	trackTaskOrder presenters.TrackTaskOrder
+
+	// Task bumps (K/J keys); nil in read-only mode
+	taskService *application.TaskApplicationService
+
+	// Scroll offsets of the detail views, keyed by scrollKey, kept for the rest of the session
+	scrollOffsets map[string]int
+
+	// Status bar below every view: when the active view last loaded its data
+	lastUpdate    time.Time
+	statusTicking bool // A statusBarTickMsg is scheduled
 
 	width  int
 	height int
@@ -60,27 +116,141 @@ func NewAppModelNew(
 		logger:      logger,
 		projectName: projectName,
 		currentView: ViewLoadingNew,
+		startView:   StartView{View: ViewRoadmapListNew},
+		keymap:      components.DefaultKeyMap(),
+
+		writeClipboard: clipboard.WriteAll,
+		gotoInput:      newGoToInput(),
+		scrollOffsets:  make(map[string]int),
 	}
 }
 
+// newGoToInput creates the text input of the go-to-ID prompt
+func newGoToInput() textinput.Model {
+	ti := textinput.New()
+	ti.Prompt = ":"
+	ti.Placeholder = "DW-task-12, DW-track-3, DW-ac-7 or iteration number"
+	ti.CharLimit = 64
+	return ti
+}
+
+// SetKeyMap makes the views bind the remappable actions as keymap does.
+// Must be called before the program starts.
+func (m *AppModelNew) SetKeyMap(keymap components.KeyMap) {
+	m.keymap = keymap
+}
+
+// SetStartView makes the TUI open directly in the given view.
+// Must be called before the program starts.
+func (m *AppModelNew) SetStartView(startView StartView) {
+	m.startView = startView
+}
+
+// SetCurrentUser sets the user name the "my tasks" filter matches task assignees against.
+// Must be called before the program starts.
+func (m *AppModelNew) SetCurrentUser(name string) {
+	m.currentUser = name
+}
+
+// SetProgressBasis sets what iteration progress is measured in (task_manager.iteration.progress_basis).
+// Must be called before the program starts.
+func (m *AppModelNew) SetProgressBasis(basis entities.ProgressBasis) {
+	m.progressBasis = basis
+}
+
+// SetDBPath sets the database path shown in the status bar.
+// Must be called before the program starts.
+func (m *AppModelNew) SetDBPath(path string) {
+	m.dbPath = path
+}
+
+// SetTaskService sets the service task bumps (K/J on backlog and track tasks) go through.
+// Without it bumps are rejected as in read-only mode. Must be called before the program starts.
+func (m *AppModelNew) SetTaskService(service *application.TaskApplicationService) {
+	m.taskService = service
+}
+
+// SetClipboardWriter replaces the system clipboard used by the copy ID shortcut.
+// Must be called before the program starts.
+func (m *AppModelNew) SetClipboardWriter(write func(string) error) {
+	m.writeClipboard = write
+}
+
 func (m *AppModelNew) Init() tea.Cmd {
-	loadingVM := viewmodels.NewLoadingViewModel("Loading dashboard...")
-	m.activePresenter = presenters.NewLoadingPresenter(loadingVM)
+	return m.openView(m.startView)
+}
+
+// openView shows the loading view and starts loading the given view
+func (m *AppModelNew) openView(view StartView) tea.Cmd {
+	var loadingMessage string
+	var load tea.Cmd
+
+	switch view.View {
+	case ViewIterationDetailNew:
+		m.currentIterationNumber = view.IterationNumber
+		loadingMessage = fmt.Sprintf("Loading iteration #%d...", view.IterationNumber)
+		load = m.loadIterationDetail(view.IterationNumber)
+	case ViewTrackDetailNew:
+		m.currentTrackID = view.TrackID
+		loadingMessage = fmt.Sprintf("Loading track %s...", view.TrackID)
+		load = m.loadTrackDetail(view.TrackID)
+	case ViewTaskDetailNew:
+		m.currentTaskID = view.TaskID
+		loadingMessage = fmt.Sprintf("Loading task %s...", view.TaskID)
+		load = m.loadTaskDetail(view.TaskID)
+	default:
+		loadingMessage = "Loading dashboard..."
+		load = m.loadRoadmapList()
+	}
+
+	m.currentView = ViewLoadingNew
+	loadingVM := viewmodels.NewLoadingViewModel(loadingMessage)
+	m.activePresenter = presenters.NewLoadingPresenter(loadingVM, m.keymap)
 
 	return tea.Batch(
 		m.activePresenter.Init(),
-		m.loadRoadmapList(),
+		load,
 	)
 }
 
 func (m *AppModelNew) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	// Any message may replace the active presenter, so note where the current view is scrolled
+	m.saveScrollOffset()
+
 	switch msg := msg.(type) {
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
+		if m.activePresenter != nil {
+			var cmd tea.Cmd
+			m.activePresenter, cmd = m.activePresenter.Update(tea.WindowSizeMsg{
+				Width:  msg.Width,
+				Height: max(1, msg.Height-statusLineHeight),
+			})
+			return m, cmd
+		}
 
 	case tea.KeyMsg:
-		if msg.String() == "q" || msg.String() == "ctrl+c" {
+		if m.gotoActive && msg.String() != "ctrl+c" {
+			return m, m.updateGoToPrompt(msg)
+		}
+		quit := components.NewQuitKey(m.keymap)
+		if capturer, ok := m.activePresenter.(presenters.TextInputCapturer); ok && capturer.CapturingTextInput() {
+			if key.Matches(msg, quit) && msg.String() != "ctrl+c" {
+				break
+			}
+		} else if msg.String() == ":" && m.currentView != ViewLoadingNew {
+			m.gotoActive = true
+			m.gotoInput.SetValue("")
+			return m, m.gotoInput.Focus()
+		}
+		if key.Matches(msg, quit) {
+			// Persist buffered edits (e.g. a reorder still settling) before exiting
+			if flusher, ok := m.activePresenter.(presenters.PendingChangesFlusher); ok {
+				if err := flusher.FlushPendingChanges(); err != nil && m.logger != nil {
+					m.logger.Warn("failed to save pending changes on quit", "error", err)
+				}
+			}
 			return m, tea.Quit
 		}
 
@@ -88,12 +258,32 @@ func (m *AppModelNew) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Transition to RoadmapListPresenter with loaded data
 		m.currentView = ViewRoadmapListNew
 		// Use the selected index from message if provided (non-nil)
+		var dashboard *presenters.RoadmapListPresenter
 		if msg.selectedIndex != nil {
-			m.activePresenter = presenters.NewRoadmapListPresenterWithSelection(msg.viewModel, m.repo, m.ctx, *msg.selectedIndex)
+			dashboard = presenters.NewRoadmapListPresenterWithSelection(msg.viewModel, m.repo, m.ctx, m.keymap, *msg.selectedIndex)
 		} else {
-			m.activePresenter = presenters.NewRoadmapListPresenter(msg.viewModel, m.repo, m.ctx)
+			dashboard = presenters.NewRoadmapListPresenter(msg.viewModel, m.repo, m.ctx, m.keymap)
 		}
-		return m, m.activePresenter.Init()
+		if m.myTasksOnly {
+			dashboard.FilterBacklogByAssignee(m.currentUser)
+		}
+		m.activePresenter = dashboard
+		return m, tea.Batch(m.activePresenter.Init(), m.markUpdated())
+
+	case noRoadmapMsg:
+		// Fresh project: show the empty state instead of an error
+		m.currentView = ViewNoRoadmapNew
+		m.activePresenter = presenters.NewNoRoadmapPresenter(viewmodels.NewNoRoadmapViewModel(m.projectName), m.repo, m.ctx, m.keymap)
+		return m, tea.Batch(m.activePresenter.Init(), m.markUpdated())
+
+	case presenters.RoadmapCreatedMsg:
+		m.currentView = ViewLoadingNew
+		loadingVM := viewmodels.NewLoadingViewModel("Loading dashboard...")
+		m.activePresenter = presenters.NewLoadingPresenter(loadingVM, m.keymap)
+		return m, tea.Batch(
+			m.activePresenter.Init(),
+			m.loadRoadmapList(),
+		)
 
 	case presenters.ErrorMsg:
 		m.lastError = msg.Err
@@ -106,7 +296,7 @@ func (m *AppModelNew) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		errorVM := viewmodels.NewErrorViewModel(msg.Err.Error())
 		errorVM.CanGoBack = true
 		errorVM.RetryAction = "Fix the issue and try again"
-		m.activePresenter = presenters.NewErrorPresenter(errorVM)
+		m.activePresenter = presenters.NewErrorPresenter(errorVM, m.keymap)
 		return m, m.activePresenter.Init()
 
 	case presenters.BackMsgNew:
@@ -115,7 +305,7 @@ func (m *AppModelNew) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			if m.previousView == ViewRoadmapListNew {
 				m.currentView = ViewLoadingNew
 				loadingVM := viewmodels.NewLoadingViewModel("Loading dashboard...")
-				m.activePresenter = presenters.NewLoadingPresenter(loadingVM)
+				m.activePresenter = presenters.NewLoadingPresenter(loadingVM, m.keymap)
 				return m, tea.Batch(
 					m.activePresenter.Init(),
 					m.loadRoadmapList(),
@@ -124,7 +314,7 @@ func (m *AppModelNew) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			if m.previousView == ViewIterationDetailNew && m.currentIterationNumber > 0 {
 				m.currentView = ViewLoadingNew
 				loadingVM := viewmodels.NewLoadingViewModel(fmt.Sprintf("Loading iteration #%d...", m.currentIterationNumber))
-				m.activePresenter = presenters.NewLoadingPresenter(loadingVM)
+				m.activePresenter = presenters.NewLoadingPresenter(loadingVM, m.keymap)
 				return m, tea.Batch(
 					m.activePresenter.Init(),
 					m.loadIterationDetail(m.currentIterationNumber),
@@ -133,7 +323,7 @@ func (m *AppModelNew) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			if m.previousView == ViewTrackDetailNew && m.currentTrackID != "" {
 				m.currentView = ViewLoadingNew
 				loadingVM := viewmodels.NewLoadingViewModel(fmt.Sprintf("Loading track %s...", m.currentTrackID))
-				m.activePresenter = presenters.NewLoadingPresenter(loadingVM)
+				m.activePresenter = presenters.NewLoadingPresenter(loadingVM, m.keymap)
 				return m, tea.Batch(
 					m.activePresenter.Init(),
 					m.loadTrackDetail(m.currentTrackID),
@@ -142,7 +332,7 @@ func (m *AppModelNew) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			if m.previousView == ViewTaskDetailNew && m.currentTaskID != "" {
 				m.currentView = ViewLoadingNew
 				loadingVM := viewmodels.NewLoadingViewModel(fmt.Sprintf("Loading task %s...", m.currentTaskID))
-				m.activePresenter = presenters.NewLoadingPresenter(loadingVM)
+				m.activePresenter = presenters.NewLoadingPresenter(loadingVM, m.keymap)
 				return m, tea.Batch(
 					m.activePresenter.Init(),
 					m.loadTaskDetail(m.currentTaskID),
@@ -151,7 +341,7 @@ func (m *AppModelNew) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			// Fallback: if no previous view tracked, go to dashboard
 			m.currentView = ViewLoadingNew
 			loadingVM := viewmodels.NewLoadingViewModel("Loading dashboard...")
-			m.activePresenter = presenters.NewLoadingPresenter(loadingVM)
+			m.activePresenter = presenters.NewLoadingPresenter(loadingVM, m.keymap)
 			return m, tea.Batch(
 				m.activePresenter.Init(),
 				m.loadRoadmapList(),
@@ -161,7 +351,7 @@ func (m *AppModelNew) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			// Go back to dashboard from track detail
 			m.currentView = ViewLoadingNew
 			loadingVM := viewmodels.NewLoadingViewModel("Loading dashboard...")
-			m.activePresenter = presenters.NewLoadingPresenter(loadingVM)
+			m.activePresenter = presenters.NewLoadingPresenter(loadingVM, m.keymap)
 			return m, tea.Batch(
 				m.activePresenter.Init(),
 				m.loadRoadmapListWithIndex(m.dashboardSelectedIndex),
@@ -172,7 +362,7 @@ func (m *AppModelNew) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			if m.previousView == ViewTrackDetailNew && m.currentTrackID != "" {
 				m.currentView = ViewLoadingNew
 				loadingVM := viewmodels.NewLoadingViewModel(fmt.Sprintf("Loading track %s...", m.currentTrackID))
-				m.activePresenter = presenters.NewLoadingPresenter(loadingVM)
+				m.activePresenter = presenters.NewLoadingPresenter(loadingVM, m.keymap)
 				return m, tea.Batch(
 					m.activePresenter.Init(),
 					m.loadTrackDetail(m.currentTrackID),
@@ -182,7 +372,7 @@ func (m *AppModelNew) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			if m.previousView == ViewIterationDetailNew && m.currentIterationNumber > 0 {
 				m.currentView = ViewLoadingNew
 				loadingVM := viewmodels.NewLoadingViewModel(fmt.Sprintf("Loading iteration #%d...", m.currentIterationNumber))
-				m.activePresenter = presenters.NewLoadingPresenter(loadingVM)
+				m.activePresenter = presenters.NewLoadingPresenter(loadingVM, m.keymap)
 				return m, tea.Batch(
 					m.activePresenter.Init(),
 					m.loadIterationDetail(m.currentIterationNumber),
@@ -191,7 +381,7 @@ func (m *AppModelNew) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			// Otherwise go back to dashboard (restore selection from backlog navigation)
 			m.currentView = ViewLoadingNew
 			loadingVM := viewmodels.NewLoadingViewModel("Loading dashboard...")
-			m.activePresenter = presenters.NewLoadingPresenter(loadingVM)
+			m.activePresenter = presenters.NewLoadingPresenter(loadingVM, m.keymap)
 			return m, tea.Batch(
 				m.activePresenter.Init(),
 				m.loadRoadmapListWithIndex(m.dashboardSelectedIndex),
@@ -201,7 +391,7 @@ func (m *AppModelNew) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			// Go back to dashboard (restore selection from iteration navigation)
 			m.currentView = ViewLoadingNew
 			loadingVM := viewmodels.NewLoadingViewModel("Loading dashboard...")
-			m.activePresenter = presenters.NewLoadingPresenter(loadingVM)
+			m.activePresenter = presenters.NewLoadingPresenter(loadingVM, m.keymap)
 			return m, tea.Batch(
 				m.activePresenter.Init(),
 				m.loadRoadmapListWithIndex(m.dashboardSelectedIndex),
@@ -215,7 +405,7 @@ func (m *AppModelNew) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.dashboardSelectedIndex = msg.SelectedIndex
 		m.currentView = ViewLoadingNew
 		loadingVM := viewmodels.NewLoadingViewModel(fmt.Sprintf("Loading iteration #%d...", msg.IterationNumber))
-		m.activePresenter = presenters.NewLoadingPresenter(loadingVM)
+		m.activePresenter = presenters.NewLoadingPresenter(loadingVM, m.keymap)
 		return m, tea.Batch(
 			m.activePresenter.Init(),
 			m.loadIterationDetail(msg.IterationNumber),
@@ -228,7 +418,7 @@ func (m *AppModelNew) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.dashboardSelectedIndex = msg.SelectedIndex
 		m.currentView = ViewLoadingNew
 		loadingVM := viewmodels.NewLoadingViewModel(fmt.Sprintf("Loading track %s...", msg.TrackID))
-		m.activePresenter = presenters.NewLoadingPresenter(loadingVM)
+		m.activePresenter = presenters.NewLoadingPresenter(loadingVM, m.keymap)
 		return m, tea.Batch(
 			m.activePresenter.Init(),
 			m.loadTrackDetail(msg.TrackID),
@@ -237,22 +427,30 @@ func (m *AppModelNew) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case trackDetailLoadedMsg:
 		// Transition to TrackDetailPresenter
 		m.currentView = ViewTrackDetailNew
+		var trackDetail *presenters.TrackDetailPresenter
 		if msg.selectedIndex != nil {
-			m.activePresenter = presenters.NewTrackDetailPresenterWithSelection(msg.viewModel, m.repo, m.ctx, *msg.selectedIndex)
+			trackDetail = presenters.NewTrackDetailPresenterWithSelection(msg.viewModel, m.repo, m.ctx, m.keymap, *msg.selectedIndex)
 		} else {
-			m.activePresenter = presenters.NewTrackDetailPresenter(msg.viewModel, m.repo, m.ctx)
+			trackDetail = presenters.NewTrackDetailPresenter(msg.viewModel, m.repo, m.ctx, m.keymap)
 		}
-		return m, m.activePresenter.Init()
+		trackDetail.SetTaskOrder(m.trackTaskOrder)
+		if msg.selectedTaskID != "" {
+			trackDetail.SelectTask(msg.selectedTaskID)
+		}
+		m.activePresenter = trackDetail
+		m.restoreScrollOffset()
+		return m, tea.Batch(m.activePresenter.Init(), m.markUpdated())
 
 	case iterationDetailLoadedMsg:
 		// Transition to IterationDetailPresenter with saved activeTab and optional selectedIndex
 		m.currentView = ViewIterationDetailNew
 		if msg.selectedIndex != nil {
-			m.activePresenter = presenters.NewIterationDetailPresenterWithSelection(msg.viewModel, m.repo, m.ctx, msg.activeTab, *msg.selectedIndex)
+			m.activePresenter = presenters.NewIterationDetailPresenterWithSelection(msg.viewModel, m.repo, m.ctx, m.keymap, msg.activeTab, *msg.selectedIndex)
 		} else {
-			m.activePresenter = presenters.NewIterationDetailPresenterWithTab(msg.viewModel, m.repo, m.ctx, msg.activeTab)
+			m.activePresenter = presenters.NewIterationDetailPresenterWithTab(msg.viewModel, m.repo, m.ctx, m.keymap, msg.activeTab)
 		}
-		return m, m.activePresenter.Init()
+		m.restoreScrollOffset()
+		return m, tea.Batch(m.activePresenter.Init(), m.markUpdated())
 
 	case presenters.TaskSelectedMsg:
 		// Load task detail
@@ -261,7 +459,7 @@ func (m *AppModelNew) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.dashboardSelectedIndex = msg.SelectedIndex
 		m.currentView = ViewLoadingNew
 		loadingVM := viewmodels.NewLoadingViewModel(fmt.Sprintf("Loading task %s...", msg.TaskID))
-		m.activePresenter = presenters.NewLoadingPresenter(loadingVM)
+		m.activePresenter = presenters.NewLoadingPresenter(loadingVM, m.keymap)
 		return m, tea.Batch(
 			m.activePresenter.Init(),
 			m.loadTaskDetail(msg.TaskID),
@@ -271,11 +469,12 @@ func (m *AppModelNew) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Transition to TaskDetailPresenter
 		m.currentView = ViewTaskDetailNew
 		if msg.selectedIndex != nil {
-			m.activePresenter = presenters.NewTaskDetailPresenterWithSelection(msg.viewModel, m.repo, m.ctx, *msg.selectedIndex)
+			m.activePresenter = presenters.NewTaskDetailPresenterWithSelection(msg.viewModel, m.repo, m.ctx, m.keymap, *msg.selectedIndex)
 		} else {
-			m.activePresenter = presenters.NewTaskDetailPresenter(msg.viewModel, m.repo, m.ctx)
+			m.activePresenter = presenters.NewTaskDetailPresenter(msg.viewModel, m.repo, m.ctx, m.keymap)
 		}
-		return m, m.activePresenter.Init()
+		m.restoreScrollOffset()
+		return m, tea.Batch(m.activePresenter.Init(), m.markUpdated())
 
 	case presenters.ACActionCompletedMsg:
 		// Save the active tab and reload current view after AC action
@@ -296,7 +495,19 @@ func (m *AppModelNew) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		return m, nil
 
+	case presenters.IterationUpdatedMsg:
+		// Reload iteration detail after editing name/goal/deliverable
+		m.currentActiveTab = msg.ActiveTab
+		if m.currentView == ViewIterationDetailNew && m.currentIterationNumber > 0 {
+			return m, m.loadIterationDetailWithTabAndSelection(m.currentIterationNumber, msg.ActiveTab, msg.SelectedIndex)
+		}
+		return m, nil
+
 	case presenters.ReorderCompletedMsg:
+		// Skip the reload while newer moves are still settling; their save triggers the reload
+		if flusher, ok := m.activePresenter.(presenters.PendingChangesFlusher); ok && flusher.HasPendingChanges() {
+			return m, nil
+		}
 		// Reload dashboard after iteration reordering, preserving selected iteration
 		selectedIterationNumber := msg.SelectedIterationNumber
 		return m, m.loadRoadmapListWithSelection(selectedIterationNumber)
@@ -304,6 +515,86 @@ func (m *AppModelNew) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case presenters.RefreshDashboardMsg:
 		// Reload dashboard data, preserving selected index
 		return m, m.loadRoadmapListWithIndex(msg.SelectedIndex)
+
+	case presenters.RoadmapSwitchedMsg:
+		// Tracks belong to the roadmap, so the selection starts over
+		return m, tea.Batch(
+			m.loadRoadmapListWithIndex(0),
+			m.showFlash("Switched to roadmap "+msg.RoadmapID, false),
+		)
+
+	case presenters.CopyIDMsg:
+		return m, m.copyToClipboard(msg.ID)
+
+	case presenters.MyTasksToggledMsg:
+		if m.currentUser == "" {
+			return m, m.showFlash("Set task_manager.user.name in .darwinflow/config.yaml to filter your tasks", true)
+		}
+		m.myTasksOnly = !m.myTasksOnly
+		assignee := ""
+		if m.myTasksOnly {
+			assignee = m.currentUser
+		}
+		if dashboard, ok := m.activePresenter.(*presenters.RoadmapListPresenter); ok {
+			dashboard.FilterBacklogByAssignee(assignee)
+		}
+		if m.myTasksOnly {
+			return m, m.showFlash("Showing tasks assigned to @"+m.currentUser, false)
+		}
+		return m, m.showFlash("Showing all backlog tasks", false)
+
+	case presenters.TrackTaskOrderToggledMsg:
+		if m.trackTaskOrder == presenters.TrackTaskOrderRank {
+			m.trackTaskOrder = presenters.TrackTaskOrderStatus
+		} else {
+			m.trackTaskOrder = presenters.TrackTaskOrderRank
+		}
+		if trackDetail, ok := m.activePresenter.(*presenters.TrackDetailPresenter); ok {
+			trackDetail.SetTaskOrder(m.trackTaskOrder)
+		}
+		return m, nil
+
+	case presenters.TaskBumpRequestedMsg:
+		return m, m.bumpTask(msg)
+
+	case taskBumpedMsg:
+		flash := m.showFlash(fmt.Sprintf("Moved %s to position %d of %d in %s", msg.taskID, msg.position, msg.total, msg.trackID), false)
+		switch m.currentView {
+		case ViewTrackDetailNew:
+			// Keep the bumped task selected wherever the new rank puts it
+			return m, tea.Batch(m.loadTrackDetailWithTask(m.currentTrackID, msg.taskID), flash)
+		case ViewRoadmapListNew:
+			return m, tea.Batch(m.loadRoadmapListWithIndex(msg.selectedIndex), flash)
+		}
+		return m, flash
+
+	case clipboardCopiedMsg:
+		// Without a clipboard (e.g. over SSH) show the ID so it can be copied by hand
+		if msg.err != nil {
+			return m, m.showFlash(fmt.Sprintf("%s (clipboard unavailable: %v)", msg.id, msg.err), true)
+		}
+		return m, m.showFlash("Copied "+msg.id, false)
+
+	case entityResolvedMsg:
+		// Going back from the opened view returns to the view the prompt was opened in
+		if m.currentView != ViewErrorNew {
+			m.previousView = m.currentView
+		}
+		return m, m.openView(msg.view)
+
+	case entityResolveFailedMsg:
+		// Stay in the current view; the flash explains why the ID could not be opened
+		return m, m.showFlash(fmt.Sprintf("Cannot open %s: %v", msg.id, msg.err), true)
+
+	case clearFlashMsg:
+		if msg.seq == m.flashSeq {
+			m.flash = ""
+		}
+		return m, nil
+
+	case statusBarTickMsg:
+		// Redraw the status bar's data age; View reads the clock
+		return m, m.tickStatusBar()
 	}
 
 	if m.activePresenter != nil {
@@ -316,17 +607,172 @@ func (m *AppModelNew) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 }
 
 func (m *AppModelNew) View() string {
-	if m.activePresenter != nil {
-		return m.activePresenter.View()
+	if m.activePresenter == nil {
+		return "\nInitializing...\n"
+	}
+	view := m.activePresenter.View()
+	if m.gotoActive {
+		view += "\n" + m.gotoInput.View() + "\n" +
+			components.Styles.MetadataStyle.Render("Press Enter to open or ESC to cancel")
+	}
+	if m.flash != "" {
+		style := components.Styles.ProgressStyle
+		if m.flashIsError {
+			style = components.Styles.ErrorMessageStyle
+		}
+		view += "\n" + style.Render(m.flash)
+	}
+	return view + "\n" + m.statusBar().Render(m.width, time.Now())
+}
+
+// statusBar describes the project, database and view shown, for the line below every view
+func (m *AppModelNew) statusBar() components.StatusBar {
+	bar := components.StatusBar{
+		Project:   m.projectName,
+		DBPath:    m.dbPath,
+		View:      m.viewName(),
+		UpdatedAt: m.lastUpdate,
+	}
+	if m.currentView == ViewLoadingNew {
+		bar.UpdatedAt = time.Time{}
+	}
+	return bar
+}
+
+// viewName names the current view in the status bar, e.g. "Task DW-task-3"
+func (m *AppModelNew) viewName() string {
+	switch m.currentView {
+	case ViewLoadingNew:
+		return "Loading"
+	case ViewErrorNew:
+		return "Error"
+	case ViewRoadmapListNew:
+		return "Dashboard"
+	case ViewIterationDetailNew:
+		return fmt.Sprintf("Iteration #%d", m.currentIterationNumber)
+	case ViewTaskDetailNew:
+		return "Task " + m.currentTaskID
+	case ViewTrackDetailNew:
+		return "Track " + m.currentTrackID
+	case ViewNoRoadmapNew:
+		return "No roadmap"
+	}
+	return ""
+}
+
+// markUpdated records that the active view just loaded its data and starts redrawing
+// the status bar's data age, unless it is redrawn already
+func (m *AppModelNew) markUpdated() tea.Cmd {
+	m.lastUpdate = time.Now()
+	if m.statusTicking {
+		return nil
+	}
+	m.statusTicking = true
+	return m.tickStatusBar()
+}
+
+// tickStatusBar schedules the next status bar redraw
+func (m *AppModelNew) tickStatusBar() tea.Cmd {
+	return tea.Tick(statusBarRefreshInterval, func(time.Time) tea.Msg {
+		return statusBarTickMsg{}
+	})
+}
+
+// scrollKey identifies the view currently shown for scroll memory, e.g. "task:DW-task-3".
+// Views without scroll memory return "".
+func (m *AppModelNew) scrollKey() string {
+	switch m.currentView {
+	case ViewTrackDetailNew:
+		return "track:" + m.currentTrackID
+	case ViewTaskDetailNew:
+		return "task:" + m.currentTaskID
+	case ViewIterationDetailNew:
+		return fmt.Sprintf("iteration:%d", m.currentIterationNumber)
+	}
+	return ""
+}
+
+// saveScrollOffset remembers how far the current detail view is scrolled
+func (m *AppModelNew) saveScrollOffset() {
+	positioner, ok := m.activePresenter.(presenters.ScrollPositioner)
+	if key := m.scrollKey(); ok && key != "" {
+		m.scrollOffsets[key] = positioner.ScrollOffset()
+	}
+}
+
+// restoreScrollOffset scrolls a newly shown detail view to where it was last left
+func (m *AppModelNew) restoreScrollOffset() {
+	positioner, ok := m.activePresenter.(presenters.ScrollPositioner)
+	if !ok {
+		return
+	}
+	if offset, seen := m.scrollOffsets[m.scrollKey()]; seen {
+		positioner.SetScrollOffset(offset)
+	}
+}
+
+// showFlash shows a status message below the active view and clears it after flashDuration
+func (m *AppModelNew) showFlash(text string, isError bool) tea.Cmd {
+	m.flash = text
+	m.flashIsError = isError
+	m.flashSeq++
+	seq := m.flashSeq
+	return tea.Tick(flashDuration, func(time.Time) tea.Msg {
+		return clearFlashMsg{seq: seq}
+	})
+}
+
+// updateGoToPrompt handles a key while the go-to-ID prompt is open:
+// Enter resolves the typed ID, ESC closes the prompt and other keys edit it
+func (m *AppModelNew) updateGoToPrompt(msg tea.KeyMsg) tea.Cmd {
+	switch msg.Type {
+	case tea.KeyEsc:
+		m.closeGoToPrompt()
+		return nil
+	case tea.KeyEnter:
+		id := strings.TrimSpace(m.gotoInput.Value())
+		m.closeGoToPrompt()
+		if id == "" {
+			return nil
+		}
+		return m.resolveEntityID(id)
+	}
+
+	var cmd tea.Cmd
+	m.gotoInput, cmd = m.gotoInput.Update(msg)
+	return cmd
+}
+
+func (m *AppModelNew) closeGoToPrompt() {
+	m.gotoActive = false
+	m.gotoInput.SetValue("")
+	m.gotoInput.Blur()
+}
+
+// resolveEntityID looks up the view for an ID typed in the go-to-ID prompt off the update loop
+func (m *AppModelNew) resolveEntityID(id string) tea.Cmd {
+	return func() tea.Msg {
+		view, err := ResolveEntityID(m.ctx, m.repo, id)
+		if err != nil {
+			return entityResolveFailedMsg{id: id, err: err}
+		}
+		return entityResolvedMsg{view: view}
+	}
+}
+
+// copyToClipboard writes an ID to the clipboard off the update loop
+func (m *AppModelNew) copyToClipboard(id string) tea.Cmd {
+	write := m.writeClipboard
+	return func() tea.Msg {
+		return clipboardCopiedMsg{id: id, err: write(id)}
 	}
-	return "\nInitializing...\n"
 }
 
 func (m *AppModelNew) loadRoadmapList() tea.Cmd {
 	return func() tea.Msg {
 		vm, err := queries.LoadRoadmapListData(m.ctx, m.repo)
 		if err != nil {
-			return presenters.ErrorMsg{Err: err}
+			return roadmapListErrorMsg(err)
 		}
 		return roadmapListLoadedMsg{viewModel: vm, selectedIndex: nil}
 	}
@@ -336,7 +782,7 @@ func (m *AppModelNew) loadRoadmapListWithSelection(iterationNumber int) tea.Cmd
 	return func() tea.Msg {
 		vm, err := queries.LoadRoadmapListData(m.ctx, m.repo)
 		if err != nil {
-			return presenters.ErrorMsg{Err: err}
+			return roadmapListErrorMsg(err)
 		}
 		// Find the index of the iteration in the reloaded view model
 		selectedIndex := m.findIterationIndex(vm, iterationNumber)
@@ -348,7 +794,7 @@ func (m *AppModelNew) loadRoadmapListWithIndex(index int) tea.Cmd {
 	return func() tea.Msg {
 		vm, err := queries.LoadRoadmapListData(m.ctx, m.repo)
 		if err != nil {
-			return presenters.ErrorMsg{Err: err}
+			return roadmapListErrorMsg(err)
 		}
 		// Clamp index to valid range
 		totalItems := len(vm.ActiveIterations) + len(vm.ActiveTracks) + len(vm.BacklogTasks)
@@ -362,6 +808,15 @@ func (m *AppModelNew) loadRoadmapListWithIndex(index int) tea.Cmd {
 	}
 }
 
+// roadmapListErrorMsg turns a dashboard load error into a message: a missing roadmap
+// shows the no-roadmap empty state, any other error the error view
+func roadmapListErrorMsg(err error) tea.Msg {
+	if errors.Is(err, pluginsdk.ErrNotFound) {
+		return noRoadmapMsg{}
+	}
+	return presenters.ErrorMsg{Err: err}
+}
+
 // findIterationIndex finds the index of an iteration by number in the view model
 func (m *AppModelNew) findIterationIndex(vm *viewmodels.RoadmapListViewModel, iterationNumber int) int {
 	for i, iter := range vm.ActiveIterations {
@@ -382,7 +837,7 @@ func (m *AppModelNew) loadIterationDetailWithTab(iterationNumber int, activeTab
 
 func (m *AppModelNew) loadIterationDetailWithTabAndSelection(iterationNumber int, activeTab presenters.IterationDetailTab, selectedIndex int) tea.Cmd {
 	return func() tea.Msg {
-		vm, err := queries.LoadIterationDetailData(m.ctx, m.repo, iterationNumber)
+		vm, err := queries.LoadIterationDetailData(m.ctx, m.repo, iterationNumber, m.progressBasis)
 		if err != nil {
 			return presenters.ErrorMsg{Err: err}
 		}
@@ -430,6 +885,31 @@ func (m *AppModelNew) loadTrackDetailWithSelection(trackID string, selectedIndex
 	}
 }
 
+// loadTrackDetailWithTask reloads track detail with the given task selected
+func (m *AppModelNew) loadTrackDetailWithTask(trackID, taskID string) tea.Cmd {
+	return func() tea.Msg {
+		vm, err := queries.LoadTrackDetailData(m.ctx, m.repo, trackID)
+		if err != nil {
+			return presenters.ErrorMsg{Err: err}
+		}
+		return trackDetailLoadedMsg{viewModel: vm, selectedTaskID: taskID}
+	}
+}
+
+// bumpTask moves a task past its neighbour in its track's rank order, as 'task bump' does
+func (m *AppModelNew) bumpTask(msg presenters.TaskBumpRequestedMsg) tea.Cmd {
+	return func() tea.Msg {
+		if m.taskService == nil {
+			return presenters.ErrorMsg{Err: fmt.Errorf("%w: task bump is not allowed in read-only mode", pluginsdk.ErrReadOnly)}
+		}
+		task, position, total, err := m.taskService.BumpTask(m.ctx, dto.BumpTaskDTO{ID: msg.TaskID, Direction: msg.Direction})
+		if err != nil {
+			return presenters.ErrorMsg{Err: fmt.Errorf("failed to bump task: %w", err)}
+		}
+		return taskBumpedMsg{taskID: task.ID, trackID: task.TrackID, position: position, total: total, selectedIndex: msg.SelectedIndex}
+	}
+}
+
 // Custom messages (app-local only)
 // Shared message types defined in presenters/messages.go:
 // - presenters.ErrorMsg
@@ -438,12 +918,19 @@ func (m *AppModelNew) loadTrackDetailWithSelection(trackID string, selectedIndex
 // - presenters.TaskSelectedMsg
 // - presenters.ACActionCompletedMsg
 // - presenters.ReorderCompletedMsg
+// - presenters.CopyIDMsg
+// - presenters.RoadmapCreatedMsg
+// - presenters.RoadmapSwitchedMsg
+// - presenters.TaskBumpRequestedMsg
 
 type roadmapListLoadedMsg struct {
 	viewModel     *viewmodels.RoadmapListViewModel
 	selectedIndex *int
 }
 
+// noRoadmapMsg is sent when the dashboard cannot load because the project has no roadmap
+type noRoadmapMsg struct{}
+
 type iterationDetailLoadedMsg struct {
 	viewModel     *viewmodels.IterationDetailViewModel
 	activeTab     presenters.IterationDetailTab
@@ -456,7 +943,37 @@ type taskDetailLoadedMsg struct {
 }
 
 type trackDetailLoadedMsg struct {
-	viewModel     *viewmodels.TrackDetailViewModel
-	selectedIndex *int // Optional: preserve selected index across reload
+	viewModel      *viewmodels.TrackDetailViewModel
+	selectedIndex  *int   // Optional: preserve selected index across reload
+	selectedTaskID string // Optional: select this task, e.g. after a bump moved it
 }
 
+// taskBumpedMsg reports a task's new position among its track's tasks
+type taskBumpedMsg struct {
+	taskID        string
+	trackID       string
+	position      int
+	total         int
+	selectedIndex int // Selected index of the view the bump was requested in
+}
+
+type clipboardCopiedMsg struct {
+	id  string
+	err error
+}
+
+type clearFlashMsg struct {
+	seq int
+}
+
+// statusBarTickMsg redraws the status bar so its data age stays current
+type statusBarTickMsg struct{}
+
+type entityResolvedMsg struct {
+	view StartView
+}
+
+type entityResolveFailedMsg struct {
+	id  string
+	err error
+}
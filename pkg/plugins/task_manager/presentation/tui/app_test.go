@@ -0,0 +1,447 @@
+package tui_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/services"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/infrastructure/persistence"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/presentation/tui"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/presentation/tui/presenters"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// copyID sends a CopyIDMsg through the app and feeds the clipboard result back
+func copyID(t *testing.T, app *tui.AppModelNew, id string) {
+	t.Helper()
+	_, cmd := app.Update(presenters.CopyIDMsg{ID: id})
+	if cmd == nil {
+		t.Fatal("expected a clipboard command")
+	}
+	app.Update(cmd())
+}
+
+func TestAppModelNew_CopyIDFlash(t *testing.T) {
+	app := tui.NewAppModelNew(context.Background(), nil, nil, "")
+	app.Init()
+
+	var copied string
+	app.SetClipboardWriter(func(text string) error {
+		copied = text
+		return nil
+	})
+
+	copyID(t, app, "DW-task-12")
+
+	if copied != "DW-task-12" {
+		t.Errorf("expected DW-task-12 on the clipboard, got %q", copied)
+	}
+	if !strings.Contains(app.View(), "Copied DW-task-12") {
+		t.Errorf("expected copied flash in view, got %q", app.View())
+	}
+}
+
+func TestAppModelNew_CopyIDWithoutClipboard(t *testing.T) {
+	app := tui.NewAppModelNew(context.Background(), nil, nil, "")
+	app.Init()
+	app.SetClipboardWriter(func(string) error {
+		return errors.New("no clipboard utility found")
+	})
+
+	copyID(t, app, "DW-task-12")
+
+	view := app.View()
+	if !strings.Contains(view, "DW-task-12") || !strings.Contains(view, "clipboard unavailable") {
+		t.Errorf("expected ID and clipboard note in view, got %q", view)
+	}
+}
+
+func TestAppModelNew_GoToPrompt(t *testing.T) {
+	app := tui.NewAppModelNew(context.Background(), nil, nil, "")
+	app.Init()
+	// Leave the loading view so the prompt can open
+	app.Update(presenters.ErrorMsg{Err: errors.New("no roadmap")})
+
+	app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(":")})
+	for _, r := range "q?" {
+		// Keys are typed into the prompt instead of acting as shortcuts
+		if _, cmd := app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}}); cmd != nil {
+			if _, quit := cmd().(tea.QuitMsg); quit {
+				t.Fatal("typing q in the prompt must not quit")
+			}
+		}
+	}
+	if !strings.Contains(app.View(), ":q?") {
+		t.Fatalf("expected prompt with typed text in view, got %q", app.View())
+	}
+
+	_, cmd := app.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("expected a resolve command")
+	}
+	app.Update(cmd())
+
+	view := app.View()
+	if !strings.Contains(view, "Cannot open q?") || !strings.Contains(view, "no roadmap") {
+		t.Errorf("expected error flash over the current view, got %q", view)
+	}
+	if strings.Contains(view, "Press Enter to open") {
+		t.Errorf("expected prompt to close after Enter, got %q", view)
+	}
+}
+
+func TestAppModelNew_GoToPromptEsc(t *testing.T) {
+	app := tui.NewAppModelNew(context.Background(), nil, nil, "")
+	app.Init()
+	app.Update(presenters.ErrorMsg{Err: errors.New("no roadmap")})
+
+	app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(":")})
+	if !strings.Contains(app.View(), "Press Enter to open") {
+		t.Fatalf("expected prompt in view, got %q", app.View())
+	}
+
+	app.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if strings.Contains(app.View(), "Press Enter to open") {
+		t.Errorf("expected ESC to close the prompt, got %q", app.View())
+	}
+}
+
+// newEmptyRepository creates a repository backed by a fresh database without a roadmap
+func newEmptyRepository(t *testing.T) (*persistence.SQLiteRepositoryComposite, *sql.DB) {
+	t.Helper()
+	db, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), "roadmap.db"))
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	if err := persistence.InitSchema(db); err != nil {
+		t.Fatalf("failed to initialize schema: %v", err)
+	}
+	return persistence.NewSQLiteRepositoryComposite(db, nil), db
+}
+
+// runCmd executes a command (and the commands of a batch) and feeds the resulting messages to the app
+func runCmd(app *tui.AppModelNew, cmd tea.Cmd) {
+	if cmd == nil {
+		return
+	}
+	msg := cmd()
+	if batch, ok := msg.(tea.BatchMsg); ok {
+		for _, c := range batch {
+			runCmd(app, c)
+		}
+		return
+	}
+	app.Update(msg)
+}
+
+func TestAppModelNew_NoRoadmapShowsEmptyState(t *testing.T) {
+	repo, _ := newEmptyRepository(t)
+	app := tui.NewAppModelNew(context.Background(), repo, nil, "demo")
+	runCmd(app, app.Init())
+
+	view := app.View()
+	if !strings.Contains(view, "No roadmap yet") || !strings.Contains(view, "dw task-manager roadmap init") {
+		t.Errorf("expected no-roadmap empty state, got %q", view)
+	}
+	if strings.Contains(view, "Error") {
+		t.Errorf("expected empty state instead of the error view, got %q", view)
+	}
+}
+
+func TestAppModelNew_NoRoadmapCreateInline(t *testing.T) {
+	repo, _ := newEmptyRepository(t)
+	app := tui.NewAppModelNew(context.Background(), repo, nil, "demo")
+	runCmd(app, app.Init())
+
+	app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("c")})
+	app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("Ship v1 quickly")})
+	app.Update(tea.KeyMsg{Type: tea.KeyTab})
+	app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("Users on v1")})
+	_, cmd := app.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("expected a create command")
+	}
+	runCmd(app, cmd)
+
+	roadmap, err := repo.GetActiveRoadmap(context.Background())
+	if err != nil {
+		t.Fatalf("expected roadmap to be created: %v", err)
+	}
+	if roadmap.Vision != "Ship v1 quickly" || roadmap.SuccessCriteria != "Users on v1" {
+		t.Errorf("unexpected roadmap %+v", roadmap)
+	}
+}
+
+func TestAppModelNew_NoRoadmapCreateInlineKeepsExistingRoadmap(t *testing.T) {
+	repo, _ := newEmptyRepository(t)
+	ctx := context.Background()
+	app := tui.NewAppModelNew(ctx, repo, nil, "demo")
+	runCmd(app, app.Init())
+
+	// Another process creates the roadmap while the form is open
+	now := time.Now().UTC()
+	existing, _ := entities.NewRoadmapEntity("roadmap-1", "vision", "criteria", now, now)
+	if err := repo.SaveRoadmap(ctx, existing); err != nil {
+		t.Fatalf("failed to save roadmap: %v", err)
+	}
+
+	app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("c")})
+	app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("Ship v1 quickly")})
+	app.Update(tea.KeyMsg{Type: tea.KeyTab})
+	app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("Users on v1")})
+	_, cmd := app.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("expected a create command")
+	}
+	runCmd(app, cmd)
+
+	if view := app.View(); !strings.Contains(view, "roadmap already exists") {
+		t.Errorf("expected the already-exists error, got %q", view)
+	}
+	roadmaps, err := repo.ListRoadmaps(ctx)
+	if err != nil {
+		t.Fatalf("ListRoadmaps failed: %v", err)
+	}
+	if len(roadmaps) != 1 {
+		t.Errorf("expected the existing roadmap only, got %d roadmaps", len(roadmaps))
+	}
+}
+
+func TestAppModelNew_DashboardLoadErrorShowsErrorView(t *testing.T) {
+	repo, db := newEmptyRepository(t)
+	db.Close()
+	app := tui.NewAppModelNew(context.Background(), repo, nil, "demo")
+	runCmd(app, app.Init())
+
+	view := app.View()
+	if !strings.Contains(view, "Error") || strings.Contains(view, "No roadmap yet") {
+		t.Errorf("expected error view for a real load error, got %q", view)
+	}
+}
+
+func TestAppModelNew_TrackTaskOrderRememberedForSession(t *testing.T) {
+	repo, _ := newEmptyRepository(t)
+	ctx := context.Background()
+	now := time.Now().UTC()
+	roadmap, _ := entities.NewRoadmapEntity("roadmap-1", "vision", "criteria", now, now)
+	if err := repo.SaveRoadmap(ctx, roadmap); err != nil {
+		t.Fatalf("failed to save roadmap: %v", err)
+	}
+	track, _ := entities.NewTrackEntity("TM-track-1", "roadmap-1", "Track", "", "in-progress", 100, []string{}, now, now)
+	if err := repo.SaveTrack(ctx, track); err != nil {
+		t.Fatalf("failed to save track: %v", err)
+	}
+	for _, spec := range []struct {
+		id   string
+		rank int
+	}{{"TM-task-1", 200}, {"TM-task-2", 100}} {
+		task, _ := entities.NewTaskEntity(spec.id, "TM-track-1", "Task "+spec.id, "", "todo", spec.rank, "", now, now)
+		if err := repo.SaveTask(ctx, task); err != nil {
+			t.Fatalf("failed to save task: %v", err)
+		}
+	}
+
+	app := tui.NewAppModelNew(ctx, repo, nil, "demo")
+	openTrack := func() {
+		_, cmd := app.Update(presenters.TrackSelectedMsg{TrackID: "TM-track-1"})
+		runCmd(app, cmd)
+	}
+
+	openTrack()
+	if view := app.View(); !strings.Contains(view, "TODO") || strings.Contains(view, "BY RANK") {
+		t.Fatalf("expected tasks grouped by status by default, got %q", view)
+	}
+
+	_, cmd := app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+	runCmd(app, cmd)
+	if view := app.View(); !strings.Contains(view, "BY RANK") {
+		t.Fatalf("expected rank order after s, got %q", view)
+	}
+
+	// Reopening the track keeps the chosen order
+	openTrack()
+	view := app.View()
+	if !strings.Contains(view, "BY RANK") {
+		t.Errorf("expected rank order to be remembered, got %q", view)
+	}
+	if strings.Index(view, "TM-task-2:") > strings.Index(view, "TM-task-1:") {
+		t.Errorf("expected TM-task-2 (rank 100) before TM-task-1 (rank 200), got %q", view)
+	}
+}
+
+func TestAppModelNew_ScrollOffsetRememberedForSession(t *testing.T) {
+	repo, _ := newEmptyRepository(t)
+	ctx := context.Background()
+	now := time.Now().UTC()
+	roadmap, _ := entities.NewRoadmapEntity("roadmap-1", "vision", "criteria", now, now)
+	if err := repo.SaveRoadmap(ctx, roadmap); err != nil {
+		t.Fatalf("failed to save roadmap: %v", err)
+	}
+	track, _ := entities.NewTrackEntity("TM-track-1", "roadmap-1", "Track", "", "in-progress", 100, []string{}, now, now)
+	if err := repo.SaveTrack(ctx, track); err != nil {
+		t.Fatalf("failed to save track: %v", err)
+	}
+	description := strings.Repeat("A long paragraph of task notes.\n", 40)
+	task, _ := entities.NewTaskEntity("TM-task-1", "TM-track-1", "Long task", description, "todo", 100, "", now, now)
+	if err := repo.SaveTask(ctx, task); err != nil {
+		t.Fatalf("failed to save task: %v", err)
+	}
+
+	app := tui.NewAppModelNew(ctx, repo, nil, "demo")
+	_, cmd := app.Update(presenters.TaskSelectedMsg{TaskID: "TM-task-1"})
+	runCmd(app, cmd)
+	app.Update(tea.WindowSizeMsg{Width: 80, Height: 20})
+
+	view := app.View()
+	if !strings.Contains(view, "Task: TM-task-1") {
+		t.Fatalf("expected the task header at the top, got %q", view)
+	}
+	if lines := strings.Count(view, "\n") + 1; lines > 19 {
+		t.Errorf("expected the view to fit the terminal above the status line, got %d lines", lines)
+	}
+
+	app.Update(tea.KeyMsg{Type: tea.KeyCtrlD})
+	app.Update(tea.KeyMsg{Type: tea.KeyCtrlD})
+	if view := app.View(); strings.Contains(view, "Task: TM-task-1") {
+		t.Fatalf("expected the header to be scrolled out of view, got %q", view)
+	}
+
+	// Leave the task and open it again: the scroll position is kept
+	_, cmd = app.Update(presenters.BackMsgNew{})
+	runCmd(app, cmd)
+	_, cmd = app.Update(presenters.TaskSelectedMsg{TaskID: "TM-task-1"})
+	runCmd(app, cmd)
+	app.Update(tea.WindowSizeMsg{Width: 80, Height: 20})
+	view = app.View()
+	if strings.Contains(view, "Task: TM-task-1") || !strings.Contains(view, "A long paragraph") {
+		t.Errorf("expected the task to reopen scrolled down, got %q", view)
+	}
+}
+
+func TestAppModelNew_StatusBar(t *testing.T) {
+	repo, _ := newEmptyRepository(t)
+	app := tui.NewAppModelNew(context.Background(), repo, nil, "demo")
+	app.SetDBPath("~/work/.darwinflow/projects/demo/roadmap.db")
+	app.Update(tea.WindowSizeMsg{Width: 200, Height: 40})
+	runCmd(app, app.Init())
+
+	view := app.View()
+	lines := strings.Split(view, "\n")
+	last := lines[len(lines)-1]
+	for _, want := range []string{"project: demo", "~/work/.darwinflow/projects/demo/roadmap.db", "No roadmap", "updated just now"} {
+		if !strings.Contains(last, want) {
+			t.Errorf("expected %q in the status bar line, got %q", want, last)
+		}
+	}
+
+	// The status bar stays below flashes
+	app.SetClipboardWriter(func(string) error { return nil })
+	copyID(t, app, "DW-task-1")
+	lines = strings.Split(app.View(), "\n")
+	if !strings.Contains(lines[len(lines)-2], "Copied DW-task-1") || !strings.Contains(lines[len(lines)-1], "project: demo") {
+		t.Errorf("expected the flash above the status bar, got %q", app.View())
+	}
+}
+
+// newBumpTestRepository creates a track with TM-task-1..3 ranked in ID order
+func newBumpTestRepository(t *testing.T) *persistence.SQLiteRepositoryComposite {
+	t.Helper()
+	repo, _ := newEmptyRepository(t)
+	ctx := context.Background()
+	now := time.Now().UTC()
+	roadmap, _ := entities.NewRoadmapEntity("roadmap-1", "vision", "criteria", now, now)
+	if err := repo.SaveRoadmap(ctx, roadmap); err != nil {
+		t.Fatalf("failed to save roadmap: %v", err)
+	}
+	track, _ := entities.NewTrackEntity("TM-track-1", "roadmap-1", "Track", "", "in-progress", 100, []string{}, now, now)
+	if err := repo.SaveTrack(ctx, track); err != nil {
+		t.Fatalf("failed to save track: %v", err)
+	}
+	for i, id := range []string{"TM-task-1", "TM-task-2", "TM-task-3"} {
+		task, _ := entities.NewTaskEntity(id, "TM-track-1", "Task "+id, "", "todo", (i+1)*100, "", now, now)
+		if err := repo.SaveTask(ctx, task); err != nil {
+			t.Fatalf("failed to save task: %v", err)
+		}
+	}
+	return repo
+}
+
+func TestAppModelNew_BumpTrackTask(t *testing.T) {
+	repo := newBumpTestRepository(t)
+	ctx := context.Background()
+	app := tui.NewAppModelNew(ctx, repo, nil, "demo")
+	app.SetTaskService(application.NewTaskApplicationService(repo.Task, repo.Track, repo.Aggregate, repo.AC, repo.Iteration, repo, services.NewValidationService()))
+
+	_, cmd := app.Update(presenters.TrackSelectedMsg{TrackID: "TM-track-1"})
+	runCmd(app, cmd)
+	_, cmd = app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+	runCmd(app, cmd)
+	app.Update(tea.KeyMsg{Type: tea.KeyDown})
+	app.Update(tea.KeyMsg{Type: tea.KeyDown})
+
+	// K asks for the bump; the app runs it and reports the new position
+	_, cmd = app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("K")})
+	if cmd == nil {
+		t.Fatal("expected K to request a bump")
+	}
+	_, cmd = app.Update(cmd())
+	_, cmd = app.Update(cmd())
+	if view := app.View(); !strings.Contains(view, "Moved TM-task-3 to position 2 of 3 in TM-track-1") {
+		t.Errorf("expected the new position in a flash, got %q", view)
+	}
+
+	task2, _ := repo.GetTask(ctx, "TM-task-2")
+	task3, _ := repo.GetTask(ctx, "TM-task-3")
+	if task3.Rank >= task2.Rank {
+		t.Errorf("expected TM-task-3 (rank %d) to rank before TM-task-2 (rank %d)", task3.Rank, task2.Rank)
+	}
+
+	// The reload keeps the bumped task selected at its new place
+	batch, ok := cmd().(tea.BatchMsg)
+	if !ok || len(batch) == 0 {
+		t.Fatalf("expected a reload batch, got %T", cmd())
+	}
+	app.Update(batch[0]())
+	view := app.View()
+	if strings.Index(view, "TM-task-3:") > strings.Index(view, "TM-task-2:") {
+		t.Errorf("expected TM-task-3 listed before TM-task-2, got %q", view)
+	}
+	_, cmd = app.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if msg, ok := cmd().(presenters.TaskSelectedMsg); !ok || msg.TaskID != "TM-task-3" {
+		t.Errorf("expected TM-task-3 to stay selected, got %+v", cmd())
+	}
+}
+
+func TestAppModelNew_BumpWithoutTaskServiceIsReadOnly(t *testing.T) {
+	repo := newBumpTestRepository(t)
+	app := tui.NewAppModelNew(context.Background(), persistence.NewReadOnlyRepository(repo), nil, "demo")
+
+	_, cmd := app.Update(presenters.TrackSelectedMsg{TrackID: "TM-track-1"})
+	runCmd(app, cmd)
+	_, cmd = app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("J")})
+	if cmd == nil {
+		t.Fatal("expected J to request a bump")
+	}
+	_, cmd = app.Update(cmd())
+	msg := cmd()
+	errMsg, ok := msg.(presenters.ErrorMsg)
+	if !ok || !errors.Is(errMsg.Err, pluginsdk.ErrReadOnly) {
+		t.Fatalf("expected a read-only error, got %+v", msg)
+	}
+
+	task, _ := repo.GetTask(context.Background(), "TM-task-1")
+	if task.Rank != 100 {
+		t.Errorf("expected TM-task-1 to keep rank 100, got %d", task.Rank)
+	}
+}
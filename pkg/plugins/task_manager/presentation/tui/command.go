@@ -2,11 +2,24 @@ package tui
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
-	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/services"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/infrastructure/cli"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/infrastructure/persistence"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/presentation/tui/components"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/presentation/tui/presenters"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk/tuiguard"
 )
 
 // PluginProvider is an alias for the infrastructure provider interface
@@ -14,8 +27,18 @@ type PluginProvider = cli.PluginProvider
 
 // TUINewCommand launches the new MVP TUI for task manager
 type TUINewCommand struct {
-	Plugin  PluginProvider
-	project string
+	Plugin      PluginProvider
+	CurrentUser string              // Configured user name (task_manager.user.name), used by the "my tasks" filter
+	Keys        map[string][]string // Configured key bindings (task_manager.tui.keys), by action
+	// ProgressBasis is what iteration progress is measured in (task_manager.iteration.progress_basis)
+	ProgressBasis entities.ProgressBasis
+
+	project  string
+	view     string
+	number   int
+	id       string
+	gotoID   string
+	readOnly bool
 }
 
 func (c *TUINewCommand) GetName() string {
@@ -27,7 +50,7 @@ func (c *TUINewCommand) GetDescription() string {
 }
 
 func (c *TUINewCommand) GetHelp() string {
-	return `Usage: dw task-manager tui-new [--project <name>]
+	return `Usage: dw task-manager tui-new [--project <name>] [--view <name> [--number <N> | --id <id>] | --goto <id>] [--read-only]
 
 Launch the new MVP terminal user interface with core navigation flow:
 - Dashboard: View all iterations
@@ -38,16 +61,52 @@ Navigation:
   j/k or ↑/↓     Navigate between items
   Enter          View details / drill down
   esc            Go back to previous view
+  :              Go to an ID (task, track, AC or iteration number)
   r              Refresh data
+  K/J            Dashboard iterations: reorder them. Backlog and track tasks:
+                 bump the task up/down its track, like 'task bump'
+  m              Dashboard: show only the backlog tasks assigned to you
+                 (set task_manager.user.name in .darwinflow/config.yaml)
   q              Quit
 
+Key bindings can be remapped in .darwinflow/config.yaml, e.g.:
+  task_manager:
+    tui:
+      keys:
+        up: [k, up]
+        down: [j, down]
+        quit: x
+Actions: up, down, select, back, reorder-up, reorder-down, refresh, quit.
+ctrl+c always quits. Unknown actions, empty lists, keys bound to two
+actions and keys a view uses for its own shortcuts fall back to the
+defaults with a warning; help footers show the keys in effect.
+
 Flags:
   --project <name>    Use specific project (overrides active project)
+  --view <name>       Start in a specific view instead of the roadmap list:
+                        roadmap, iterations   Roadmap list (default)
+                        iteration             Iteration detail (requires --number)
+                        track                 Track detail (requires --id)
+                        task                  Task detail (requires --id)
+  --number <N>        Iteration number for --view iteration
+  --id <id>           Track or task ID for --view track / --view task
+  --goto <id>         Start in the view of any ID: a task, track or AC (opens its
+                      task) ID, or an iteration number
+  --read-only         Open without taking the instance lock; all changes are rejected
+
+Only one TUI may modify a project at a time. A second instance refuses to start
+unless --read-only is given. A lock left by a crashed TUI is detected and replaced.
+
+Examples:
+  dw task-manager tui-new --view iteration --number 3
+  dw task-manager tui-new --view track --id DW-track-3
+  dw task-manager tui-new --goto DW-ac-17
+  dw task-manager tui-new --read-only
 `
 }
 
 func (c *TUINewCommand) GetUsage() string {
-	return "tui-new [--project <name>]"
+	return "tui-new [--project <name>] [--view <name> [--number <N> | --id <id>] | --goto <id>] [--read-only]"
 }
 
 func (c *TUINewCommand) Execute(ctx context.Context, cmdCtx pluginsdk.CommandContext, args []string) error {
@@ -59,9 +118,44 @@ func (c *TUINewCommand) Execute(ctx context.Context, cmdCtx pluginsdk.CommandCon
 				c.project = args[i+1]
 				i++
 			}
+		case "--view":
+			if i+1 < len(args) {
+				c.view = args[i+1]
+				i++
+			}
+		case "--number":
+			if i+1 < len(args) {
+				number, err := strconv.Atoi(args[i+1])
+				if err != nil {
+					return fmt.Errorf("%w: invalid --number %q", pluginsdk.ErrInvalidArgument, args[i+1])
+				}
+				c.number = number
+				i++
+			}
+		case "--id":
+			if i+1 < len(args) {
+				c.id = args[i+1]
+				i++
+			}
+		case "--goto":
+			if i+1 < len(args) {
+				c.gotoID = args[i+1]
+				i++
+			}
+		case "--read-only":
+			c.readOnly = true
 		}
 	}
 
+	// Validate view flags before touching the repository or the terminal
+	startView, err := ParseStartView(c.view, c.number, c.id)
+	if err != nil {
+		return err
+	}
+	if c.gotoID != "" && (c.view != "" || c.number != 0 || c.id != "") {
+		return fmt.Errorf("%w: use either --goto or --view, not both", pluginsdk.ErrInvalidArgument)
+	}
+
 	// Get repository for project
 	repo, cleanup, err := c.Plugin.GetRepositoryForProject(c.project)
 	if err != nil {
@@ -69,6 +163,13 @@ func (c *TUINewCommand) Execute(ctx context.Context, cmdCtx pluginsdk.CommandCon
 	}
 	defer cleanup()
 
+	if c.gotoID != "" {
+		startView, err = ResolveEntityID(ctx, repo, c.gotoID)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Determine project name for display
 	projectName := c.project
 	if projectName == "" {
@@ -78,15 +179,83 @@ func (c *TUINewCommand) Execute(ctx context.Context, cmdCtx pluginsdk.CommandCon
 		}
 	}
 
+	// Only one instance may modify the project at a time; read-only instances skip the lock
+	if c.readOnly {
+		repo = persistence.NewReadOnlyRepository(repo)
+	} else {
+		lockPath := filepath.Join(c.Plugin.GetWorkingDir(), ".darwinflow", "projects", projectName, "tui.lock")
+		lock, err := cli.AcquireInstanceLock(lockPath)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := lock.Release(); err != nil {
+				c.Plugin.GetLogger().Warn("failed to release TUI lock", "error", err)
+			}
+		}()
+	}
+
+	// Apply configured key bindings; warnings stay visible after the TUI exits
+	keymap, warnings := components.ResolveKeyMap(c.Keys, presenters.ViewKeys())
+	for _, warning := range warnings {
+		fmt.Fprintf(pluginsdk.InfoWriter(cmdCtx), "Warning: task_manager.tui.keys: %s\n", warning)
+	}
+
 	// Create the TUI app model
 	appModel := NewAppModelNew(ctx, repo, c.Plugin.GetLogger(), projectName)
+	appModel.SetStartView(startView)
+	appModel.SetKeyMap(keymap)
+	appModel.SetCurrentUser(c.CurrentUser)
+	appModel.SetProgressBasis(c.ProgressBasis)
+	appModel.SetDBPath(abbreviateHome(c.Plugin.GetProjectDatabasePath(projectName)))
+	if !c.readOnly {
+		appModel.SetTaskService(newTaskService(repo))
+	}
 
-	// Start the Bubble Tea program
-	p := tea.NewProgram(appModel, tea.WithAltScreen())
-
-	if _, err := p.Run(); err != nil {
+	// Start the Bubble Tea program. A panic restores the terminal and writes a crash
+	// log to .darwinflow/ instead of leaving the terminal in the alternate screen.
+	crashDir := filepath.Join(c.Plugin.GetWorkingDir(), ".darwinflow")
+	err = tuiguard.RunProgram(appModel, crashDir, tea.WithAltScreen())
+	var crash *tuiguard.CrashError
+	if errors.As(err, &crash) {
+		return crash
+	}
+	if err != nil {
 		return fmt.Errorf("TUI error: %w", err)
 	}
 
 	return nil
 }
+
+// newTaskService builds the service behind task bumps on the repository's composite, as the
+// plugin does for 'task bump'. It returns nil if repo is not backed by a composite.
+func newTaskService(repo domain.RoadmapRepository) *application.TaskApplicationService {
+	if eventRepo, ok := repo.(*persistence.EventEmittingRepository); ok {
+		repo = eventRepo.Repo
+	}
+	composite, ok := repo.(*persistence.SQLiteRepositoryComposite)
+	if !ok {
+		return nil
+	}
+	return application.NewTaskApplicationService(
+		composite.Task,
+		composite.Track,
+		composite.Aggregate,
+		composite.AC,
+		composite.Iteration,
+		composite,
+		services.NewValidationService(),
+	)
+}
+
+// abbreviateHome replaces the user's home directory at the start of path with ~
+func abbreviateHome(path string) string {
+	home, err := os.UserHomeDir()
+	if err != nil || home == "" {
+		return path
+	}
+	if path == home || strings.HasPrefix(path, home+string(filepath.Separator)) {
+		return "~" + strings.TrimPrefix(path, home)
+	}
+	return path
+}
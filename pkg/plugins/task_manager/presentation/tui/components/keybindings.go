@@ -4,20 +4,17 @@ import "github.com/charmbracelet/bubbles/key"
 
 // Common key binding factory functions for consistent key handling across presenters
 
-// NewQuitKey creates a quit key binding (q, ctrl+c)
-func NewQuitKey() key.Binding {
+// NewQuitKey creates a quit key binding (q by default; ctrl+c always quits)
+func NewQuitKey(keymap KeyMap) key.Binding {
 	return key.NewBinding(
-		key.WithKeys("q", "ctrl+c"),
-		key.WithHelp("q", "quit"),
+		key.WithKeys(keymap.bindingKeys(ActionQuit)...),
+		key.WithHelp(keyHelp(keymap.Keys(ActionQuit)), "quit"),
 	)
 }
 
-// NewBackKey creates a back key binding (esc)
-func NewBackKey() key.Binding {
-	return key.NewBinding(
-		key.WithKeys("esc"),
-		key.WithHelp("esc", "back"),
-	)
+// NewBackKey creates a back key binding (esc by default)
+func NewBackKey(keymap KeyMap) key.Binding {
+	return actionBinding(keymap, ActionBack, "back")
 }
 
 // NewHelpKey creates a help toggle key binding (?)
@@ -28,26 +25,81 @@ func NewHelpKey() key.Binding {
 	)
 }
 
-// NewUpKey creates an up navigation key binding (↑, k)
-func NewUpKey() key.Binding {
+// NewUpKey creates an up navigation key binding (↑, k by default)
+func NewUpKey(keymap KeyMap) key.Binding {
+	return actionBinding(keymap, ActionUp, "move up")
+}
+
+// NewDownKey creates a down navigation key binding (↓, j by default)
+func NewDownKey(keymap KeyMap) key.Binding {
+	return actionBinding(keymap, ActionDown, "move down")
+}
+
+// NewEnterKey creates an enter/select key binding
+func NewEnterKey(keymap KeyMap) key.Binding {
+	return actionBinding(keymap, ActionSelect, "select")
+}
+
+// NewReorderUpKey creates a key binding that moves the selected item up (K, shift+↑ by default)
+func NewReorderUpKey(keymap KeyMap) key.Binding {
+	return actionBinding(keymap, ActionReorderUp, "move up")
+}
+
+// NewReorderDownKey creates a key binding that moves the selected item down (J, shift+↓ by default)
+func NewReorderDownKey(keymap KeyMap) key.Binding {
+	return actionBinding(keymap, ActionReorderDown, "move down")
+}
+
+// NewRefreshKey creates a refresh key binding (r by default)
+func NewRefreshKey(keymap KeyMap) key.Binding {
+	return actionBinding(keymap, ActionRefresh, "refresh")
+}
+
+// NewCopyIDKey creates a key binding that copies the selected item's ID (y)
+func NewCopyIDKey() key.Binding {
+	return key.NewBinding(
+		key.WithKeys("y"),
+		key.WithHelp("y", "copy ID"),
+	)
+}
+
+// NewCopyViewIDKey creates a key binding that copies the ID of the entity a detail view shows (Y)
+func NewCopyViewIDKey() key.Binding {
 	return key.NewBinding(
-		key.WithKeys("up", "k"),
-		key.WithHelp("↑/k", "move up"),
+		key.WithKeys("Y"),
+		key.WithHelp("Y", "copy this view's ID"),
 	)
 }
 
-// NewDownKey creates a down navigation key binding (↓, j)
-func NewDownKey() key.Binding {
+// NewGoToKey creates a key binding that opens the go-to-ID prompt (:)
+func NewGoToKey() key.Binding {
 	return key.NewBinding(
-		key.WithKeys("down", "j"),
-		key.WithHelp("↓/j", "move down"),
+		key.WithKeys(":"),
+		key.WithHelp(":", "go to ID"),
 	)
 }
 
-// NewEnterKey creates an enter/select key binding
-func NewEnterKey() key.Binding {
+// NewScrollUpKey creates a key binding that scrolls a detail view's content up by half a screen (ctrl+u)
+func NewScrollUpKey() key.Binding {
+	return key.NewBinding(
+		key.WithKeys("ctrl+u"),
+		key.WithHelp("ctrl+u", "scroll up"),
+	)
+}
+
+// NewScrollDownKey creates a key binding that scrolls a detail view's content down by half a screen (ctrl+d)
+func NewScrollDownKey() key.Binding {
+	return key.NewBinding(
+		key.WithKeys("ctrl+d"),
+		key.WithHelp("ctrl+d", "scroll down"),
+	)
+}
+
+// actionBinding binds the keys keymap gives action
+func actionBinding(keymap KeyMap, action, desc string) key.Binding {
+	keys := keymap.Keys(action)
 	return key.NewBinding(
-		key.WithKeys("enter"),
-		key.WithHelp("enter", "select"),
+		key.WithKeys(keys...),
+		key.WithHelp(keyHelp(keys), desc),
 	)
 }
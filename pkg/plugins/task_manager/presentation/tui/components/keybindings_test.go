@@ -8,12 +8,12 @@ import (
 )
 
 func TestNewQuitKey(t *testing.T) {
-	k := components.NewQuitKey()
+	k := components.NewQuitKey(components.DefaultKeyMap())
 	verifyKeyHelp(t, k, "q", "quit")
 }
 
 func TestNewBackKey(t *testing.T) {
-	k := components.NewBackKey()
+	k := components.NewBackKey(components.DefaultKeyMap())
 	verifyKeyHelp(t, k, "esc", "back")
 }
 
@@ -23,20 +23,30 @@ func TestNewHelpKey(t *testing.T) {
 }
 
 func TestNewUpKey(t *testing.T) {
-	k := components.NewUpKey()
+	k := components.NewUpKey(components.DefaultKeyMap())
 	verifyKeyHelp(t, k, "↑/k", "move up")
 }
 
 func TestNewDownKey(t *testing.T) {
-	k := components.NewDownKey()
+	k := components.NewDownKey(components.DefaultKeyMap())
 	verifyKeyHelp(t, k, "↓/j", "move down")
 }
 
 func TestNewEnterKey(t *testing.T) {
-	k := components.NewEnterKey()
+	k := components.NewEnterKey(components.DefaultKeyMap())
 	verifyKeyHelp(t, k, "enter", "select")
 }
 
+func TestNewCopyIDKey(t *testing.T) {
+	k := components.NewCopyIDKey()
+	verifyKeyHelp(t, k, "y", "copy ID")
+}
+
+func TestNewCopyViewIDKey(t *testing.T) {
+	k := components.NewCopyViewIDKey()
+	verifyKeyHelp(t, k, "Y", "copy this view's ID")
+}
+
 // verifyKeyHelp is a helper to verify key binding help text
 func verifyKeyHelp(t *testing.T, k key.Binding, expectedKey, expectedDesc string) {
 	help := k.Help()
@@ -57,12 +67,12 @@ func TestKeyBindingsConsistency(t *testing.T) {
 		expectedKey string
 		expectedDesc string
 	}{
-		{"quit", components.NewQuitKey, "q", "quit"},
-		{"back", components.NewBackKey, "esc", "back"},
+		{"quit", defaultBinding(components.NewQuitKey), "q", "quit"},
+		{"back", defaultBinding(components.NewBackKey), "esc", "back"},
 		{"help", components.NewHelpKey, "?", "help"},
-		{"up", components.NewUpKey, "↑/k", "move up"},
-		{"down", components.NewDownKey, "↓/j", "move down"},
-		{"enter", components.NewEnterKey, "enter", "select"},
+		{"up", defaultBinding(components.NewUpKey), "↑/k", "move up"},
+		{"down", defaultBinding(components.NewDownKey), "↓/j", "move down"},
+		{"enter", defaultBinding(components.NewEnterKey), "enter", "select"},
 	}
 
 	for _, tc := range testCases {
@@ -83,12 +93,14 @@ func TestKeyBindingsConsistency(t *testing.T) {
 // TestKeyBindingsEnabled verifies that factory-created bindings are enabled by default
 func TestKeyBindingsEnabled(t *testing.T) {
 	factories := []func() key.Binding{
-		components.NewQuitKey,
-		components.NewBackKey,
+		defaultBinding(components.NewQuitKey),
+		defaultBinding(components.NewBackKey),
 		components.NewHelpKey,
-		components.NewUpKey,
-		components.NewDownKey,
-		components.NewEnterKey,
+		defaultBinding(components.NewUpKey),
+		defaultBinding(components.NewDownKey),
+		defaultBinding(components.NewEnterKey),
+		components.NewCopyIDKey,
+		components.NewCopyViewIDKey,
 	}
 
 	for i, factory := range factories {
@@ -98,3 +110,10 @@ func TestKeyBindingsEnabled(t *testing.T) {
 		}
 	}
 }
+
+// defaultBinding adapts a remappable binding factory to the default key map
+func defaultBinding(factory func(components.KeyMap) key.Binding) func() key.Binding {
+	return func() key.Binding {
+		return factory(components.DefaultKeyMap())
+	}
+}
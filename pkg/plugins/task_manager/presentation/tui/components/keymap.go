@@ -0,0 +1,183 @@
+package components
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Remappable TUI actions (keys of task_manager.tui.keys in .darwinflow/config.yaml)
+const (
+	ActionUp          = "up"
+	ActionDown        = "down"
+	ActionSelect      = "select"
+	ActionBack        = "back"
+	ActionReorderUp   = "reorder-up"
+	ActionReorderDown = "reorder-down"
+	ActionRefresh     = "refresh"
+	ActionQuit        = "quit"
+)
+
+// KeyActions lists the remappable actions in display order
+var KeyActions = []string{
+	ActionUp, ActionDown, ActionSelect, ActionBack,
+	ActionReorderUp, ActionReorderDown, ActionRefresh, ActionQuit,
+}
+
+// forceQuitKey always quits, whatever quit is mapped to
+const forceQuitKey = "ctrl+c"
+
+// KeyMap maps each remappable action to the keys that trigger it, as key strings
+// of Bubble Tea key messages ("k", "up", "shift+up", "enter", ...)
+type KeyMap map[string][]string
+
+// DefaultKeyMap returns the built-in bindings
+func DefaultKeyMap() KeyMap {
+	return KeyMap{
+		ActionUp:          {"up", "k"},
+		ActionDown:        {"down", "j"},
+		ActionSelect:      {"enter"},
+		ActionBack:        {"esc"},
+		ActionReorderUp:   {"K", "shift+up"},
+		ActionReorderDown: {"J", "shift+down"},
+		ActionRefresh:     {"r"},
+		ActionQuit:        {"q"},
+	}
+}
+
+// ViewKeys describes the keys of one view: the remappable actions it handles and
+// the fixed keys it reserves for its own shortcuts
+type ViewKeys struct {
+	View     string
+	Actions  []string
+	Reserved []string
+}
+
+// ResolveKeyMap applies configured overrides to the defaults. Unknown actions and
+// empty key lists are ignored, and overrides that bind one key to two actions, or
+// to a key one of views reserves while handling the action, fall back to their
+// defaults; each is reported as a warning.
+func ResolveKeyMap(overrides map[string][]string, views []ViewKeys) (KeyMap, []string) {
+	keymap := DefaultKeyMap()
+	defaults := DefaultKeyMap()
+	var warnings []string
+
+	actions := make([]string, 0, len(overrides))
+	for action := range overrides {
+		actions = append(actions, action)
+	}
+	sort.Strings(actions)
+
+	overridden := make(map[string]bool)
+	for _, action := range actions {
+		if _, ok := defaults[action]; !ok {
+			warnings = append(warnings, fmt.Sprintf("unknown key action %q ignored (actions: %s)", action, strings.Join(KeyActions, ", ")))
+			continue
+		}
+		var keys []string
+		for _, k := range overrides[action] {
+			if k = strings.TrimSpace(k); k != "" {
+				keys = append(keys, k)
+			}
+		}
+		if len(keys) == 0 {
+			warnings = append(warnings, fmt.Sprintf("no keys given for %q, using default %s", action, strings.Join(defaults[action], "/")))
+			continue
+		}
+		keymap[action] = keys
+		overridden[action] = true
+	}
+
+	// Defaults never conflict, so reverting the overrides involved in each conflict terminates
+	for {
+		if a, b, k, found := keymap.firstConflict(); found {
+			reverted := []string{}
+			for _, action := range []string{a, b} {
+				if overridden[action] {
+					keymap[action] = defaults[action]
+					overridden[action] = false
+					reverted = append(reverted, action)
+				}
+			}
+			warnings = append(warnings, fmt.Sprintf("key %q is bound to both %q and %q, using the default keys for %s", k, a, b, strings.Join(reverted, " and ")))
+			continue
+		}
+		if action, view, k, found := keymap.firstReservedConflict(views, overridden); found {
+			keymap[action] = defaults[action]
+			overridden[action] = false
+			warnings = append(warnings, fmt.Sprintf("key %q of %q is reserved by the %s view, using the default keys for %s", k, action, view, action))
+			continue
+		}
+		break
+	}
+
+	return keymap, warnings
+}
+
+// firstConflict finds a key bound to two actions, in KeyActions order
+func (m KeyMap) firstConflict() (first, second, conflictKey string, found bool) {
+	owner := make(map[string]string)
+	for _, action := range KeyActions {
+		for _, k := range m.bindingKeys(action) {
+			if other, ok := owner[k]; ok && other != action {
+				return other, action, k, true
+			}
+			owner[k] = action
+		}
+	}
+	return "", "", "", false
+}
+
+// firstReservedConflict finds an overridden action bound to a key reserved by a view that handles it
+func (m KeyMap) firstReservedConflict(views []ViewKeys, overridden map[string]bool) (action, view, conflictKey string, found bool) {
+	for _, v := range views {
+		reserved := make(map[string]bool, len(v.Reserved))
+		for _, k := range v.Reserved {
+			reserved[k] = true
+		}
+		for _, a := range v.Actions {
+			if !overridden[a] {
+				continue
+			}
+			for _, k := range m.bindingKeys(a) {
+				if reserved[k] {
+					return a, v.View, k, true
+				}
+			}
+		}
+	}
+	return "", "", "", false
+}
+
+// Keys returns the keys bound to action, or its default keys when m has none
+func (m KeyMap) Keys(action string) []string {
+	if keys := m[action]; len(keys) > 0 {
+		return keys
+	}
+	return DefaultKeyMap()[action]
+}
+
+// bindingKeys returns the keys that trigger action, including ctrl+c for quit
+func (m KeyMap) bindingKeys(action string) []string {
+	keys := m.Keys(action)
+	if action == ActionQuit {
+		return append(append([]string{}, keys...), forceQuitKey)
+	}
+	return keys
+}
+
+// arrowLabels are the help labels of arrow keys
+var arrowLabels = map[string]string{"up": "↑", "down": "↓", "left": "←", "right": "→"}
+
+// keyHelp renders keys for the help footer, e.g. "↑/k" or "K/shift+↑"
+func keyHelp(keys []string) string {
+	labels := make([]string, len(keys))
+	for i, k := range keys {
+		parts := strings.Split(k, "+")
+		if arrow, ok := arrowLabels[parts[len(parts)-1]]; ok {
+			parts[len(parts)-1] = arrow
+		}
+		labels[i] = strings.Join(parts, "+")
+	}
+	return strings.Join(labels, "/")
+}
@@ -0,0 +1,135 @@
+package components_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/presentation/tui/components"
+)
+
+func TestResolveKeyMap_Defaults(t *testing.T) {
+	keymap, warnings := components.ResolveKeyMap(nil, nil)
+	if len(warnings) != 0 {
+		t.Errorf("unexpected warnings: %v", warnings)
+	}
+	if !reflect.DeepEqual(keymap, components.DefaultKeyMap()) {
+		t.Errorf("keymap = %v, want the defaults", keymap)
+	}
+	for _, action := range components.KeyActions {
+		if len(keymap[action]) == 0 {
+			t.Errorf("action %q has no default keys", action)
+		}
+	}
+}
+
+func TestResolveKeyMap_Overrides(t *testing.T) {
+	keymap, warnings := components.ResolveKeyMap(map[string][]string{
+		"up":    {"w", " up "},
+		"quit":  {"x"},
+		"jump":  {"g"},
+		"back":  {},
+		"enter": nil,
+	}, nil)
+
+	if !reflect.DeepEqual(keymap[components.ActionUp], []string{"w", "up"}) {
+		t.Errorf("up = %v, want [w up]", keymap[components.ActionUp])
+	}
+	if !reflect.DeepEqual(keymap[components.ActionQuit], []string{"x"}) {
+		t.Errorf("quit = %v, want [x]", keymap[components.ActionQuit])
+	}
+	if !reflect.DeepEqual(keymap[components.ActionBack], []string{"esc"}) {
+		t.Errorf("back = %v, want the default after an empty list", keymap[components.ActionBack])
+	}
+	if !reflect.DeepEqual(keymap[components.ActionDown], []string{"down", "j"}) {
+		t.Errorf("down = %v, want the default", keymap[components.ActionDown])
+	}
+
+	joined := strings.Join(warnings, "\n")
+	for _, want := range []string{`unknown key action "jump"`, `unknown key action "enter"`, `no keys given for "back"`} {
+		if !strings.Contains(joined, want) {
+			t.Errorf("warnings %q do not mention %s", joined, want)
+		}
+	}
+}
+
+func TestResolveKeyMap_ConflictsFallBackToDefaults(t *testing.T) {
+	// up takes j, which down still uses by default
+	keymap, warnings := components.ResolveKeyMap(map[string][]string{"up": {"j"}, "refresh": {"g"}}, nil)
+	if !reflect.DeepEqual(keymap[components.ActionUp], []string{"up", "k"}) {
+		t.Errorf("up = %v, want the default after a conflict", keymap[components.ActionUp])
+	}
+	if !reflect.DeepEqual(keymap[components.ActionRefresh], []string{"g"}) {
+		t.Errorf("refresh = %v, want the unaffected override [g]", keymap[components.ActionRefresh])
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], `key "j" is bound to both`) {
+		t.Errorf("warnings = %v, want one conflict warning", warnings)
+	}
+
+	// Two overrides sharing a key both fall back; ctrl+c is reserved for quitting
+	keymap, warnings = components.ResolveKeyMap(map[string][]string{"select": {"l"}, "back": {"l"}, "refresh": {"ctrl+c"}}, nil)
+	if !reflect.DeepEqual(keymap[components.ActionSelect], []string{"enter"}) || !reflect.DeepEqual(keymap[components.ActionBack], []string{"esc"}) {
+		t.Errorf("select = %v, back = %v, want both defaults", keymap[components.ActionSelect], keymap[components.ActionBack])
+	}
+	if !reflect.DeepEqual(keymap[components.ActionRefresh], []string{"r"}) {
+		t.Errorf("refresh = %v, want the default instead of ctrl+c", keymap[components.ActionRefresh])
+	}
+	if len(warnings) != 2 {
+		t.Errorf("warnings = %v, want two conflict warnings", warnings)
+	}
+}
+
+func TestResolveKeyMap_ReservedViewKeysFallBackToDefaults(t *testing.T) {
+	views := []components.ViewKeys{
+		{View: "iteration detail", Actions: []string{components.ActionUp, components.ActionQuit}, Reserved: []string{"i", "r"}},
+		{View: "dashboard", Actions: []string{components.ActionRefresh}, Reserved: []string{"s"}},
+	}
+
+	// refresh may take r, which only a view without refresh reserves
+	keymap, warnings := components.ResolveKeyMap(map[string][]string{"up": {"i"}, "quit": {"x"}, "refresh": {"r", "g"}}, views)
+	if !reflect.DeepEqual(keymap[components.ActionUp], []string{"up", "k"}) {
+		t.Errorf("up = %v, want the default instead of the reserved i", keymap[components.ActionUp])
+	}
+	if !reflect.DeepEqual(keymap[components.ActionQuit], []string{"x"}) || !reflect.DeepEqual(keymap[components.ActionRefresh], []string{"r", "g"}) {
+		t.Errorf("quit = %v, refresh = %v, want the unaffected overrides", keymap[components.ActionQuit], keymap[components.ActionRefresh])
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], `key "i" of "up" is reserved by the iteration detail view`) {
+		t.Errorf("warnings = %v, want one reserved key warning", warnings)
+	}
+
+	keymap, warnings = components.ResolveKeyMap(map[string][]string{"refresh": {"s"}}, views)
+	if !reflect.DeepEqual(keymap[components.ActionRefresh], []string{"r"}) || len(warnings) != 1 {
+		t.Errorf("refresh = %v, warnings = %v, want the default and one warning", keymap[components.ActionRefresh], warnings)
+	}
+}
+
+func TestKeyMap_BindingsFollowConfiguredKeys(t *testing.T) {
+	keymap, _ := components.ResolveKeyMap(map[string][]string{
+		"up":         {"w", "up"},
+		"reorder-up": {"ctrl+up"},
+		"quit":       {"x"},
+	}, nil)
+
+	up := components.NewUpKey(keymap)
+	verifyKeyHelp(t, up, "w/↑", "move up")
+	if !key.Matches(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("w")}, up) {
+		t.Error("expected w to move up")
+	}
+	if key.Matches(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("k")}, up) {
+		t.Error("expected k to no longer move up")
+	}
+	verifyKeyHelp(t, components.NewReorderUpKey(keymap), "ctrl+↑", "move up")
+
+	quit := components.NewQuitKey(keymap)
+	verifyKeyHelp(t, quit, "x", "quit")
+	if !key.Matches(tea.KeyMsg{Type: tea.KeyCtrlC}, quit) {
+		t.Error("expected ctrl+c to still quit")
+	}
+
+	// Unchanged actions keep their defaults, as do all actions of a nil key map
+	verifyKeyHelp(t, components.NewDownKey(keymap), "↓/j", "move down")
+	verifyKeyHelp(t, components.NewRefreshKey(keymap), "r", "refresh")
+	verifyKeyHelp(t, components.NewUpKey(nil), "↑/k", "move up")
+}
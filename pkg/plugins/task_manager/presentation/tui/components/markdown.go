@@ -0,0 +1,90 @@
+package components
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	markdownHeading  = regexp.MustCompile(`^(#{1,6})\s+(.*)$`)
+	markdownBullet   = regexp.MustCompile(`^(\s*)[-*+]\s+(.*)$`)
+	markdownNumbered = regexp.MustCompile(`^(\s*)(\d+)[.)]\s+(.*)$`)
+	markdownCodeSpan = regexp.MustCompile("`([^`]+)`")
+	markdownBold     = regexp.MustCompile(`\*\*([^*]+)\*\*`)
+)
+
+// RenderMarkdown renders the basic Markdown used in task descriptions for the terminal:
+// # headers, - and 1. lists, fenced code blocks, `code` spans and **bold**. Text is
+// wrapped to width (no wrapping if width <= 0), list items with a hanging indent; code
+// blocks are never wrapped. Anything else is kept as plain text. An unterminated code
+// fence is an error, so callers can fall back to showing the raw text.
+func RenderMarkdown(text string, width int) (string, error) {
+	var out []string
+	inCode := false
+	fenceLine := 0
+	for i, line := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n") {
+		// Fences are dropped; the block is set off by its style and indent
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			inCode = !inCode
+			fenceLine = i + 1
+			continue
+		}
+		if inCode {
+			out = append(out, "  "+Styles.MarkdownCodeStyle.Render(line))
+			continue
+		}
+
+		if m := markdownHeading.FindStringSubmatch(line); m != nil {
+			out = append(out, wrapMarkdown(Styles.MarkdownHeadingStyle.Render(m[2]), width))
+		} else if m := markdownBullet.FindStringSubmatch(line); m != nil {
+			out = append(out, renderMarkdownItem(m[1]+"• ", m[2], width))
+		} else if m := markdownNumbered.FindStringSubmatch(line); m != nil {
+			out = append(out, renderMarkdownItem(m[1]+m[2]+". ", m[3], width))
+		} else {
+			out = append(out, wrapMarkdown(renderMarkdownInline(line), width))
+		}
+	}
+
+	if inCode {
+		return "", fmt.Errorf("unterminated code block opened on line %d", fenceLine)
+	}
+	return strings.Join(out, "\n"), nil
+}
+
+// renderMarkdownItem renders a list item with marker, indenting wrapped lines under its text
+func renderMarkdownItem(marker, text string, width int) string {
+	indent := lipgloss.Width(marker)
+	body := renderMarkdownInline(text)
+	if width > 0 {
+		body = wrapMarkdown(body, max(width-indent, 1))
+	}
+	return marker + strings.ReplaceAll(body, "\n", "\n"+strings.Repeat(" ", indent))
+}
+
+// renderMarkdownInline styles `code` spans and **bold** text
+func renderMarkdownInline(text string) string {
+	// Code spans first, so ** inside them stays literal
+	var spans []string
+	text = markdownCodeSpan.ReplaceAllStringFunc(text, func(s string) string {
+		spans = append(spans, Styles.MarkdownCodeStyle.Render(s[1:len(s)-1]))
+		return fmt.Sprintf("\x00%d\x00", len(spans)-1)
+	})
+	text = markdownBold.ReplaceAllStringFunc(text, func(s string) string {
+		return lipgloss.NewStyle().Bold(true).Render(s[2 : len(s)-2])
+	})
+	for i, span := range spans {
+		text = strings.Replace(text, fmt.Sprintf("\x00%d\x00", i), span, 1)
+	}
+	return text
+}
+
+// wrapMarkdown wraps styled text to width
+func wrapMarkdown(text string, width int) string {
+	if width <= 0 {
+		return text
+	}
+	return lipgloss.NewStyle().Width(width).Render(text)
+}
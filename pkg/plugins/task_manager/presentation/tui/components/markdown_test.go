@@ -0,0 +1,43 @@
+package components_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/presentation/tui/components"
+)
+
+func TestRenderMarkdown(t *testing.T) {
+	text := "# Goal\nUse `dw` and **bold** text.\n\n- first\n- a bullet long enough to wrap\n2. numbered\n\n```\nline with **stars**\n```"
+
+	rendered, err := components.RenderMarkdown(text, 20)
+	if err != nil {
+		t.Fatalf("RenderMarkdown failed: %v", err)
+	}
+
+	var lines []string
+	for _, line := range strings.Split(rendered, "\n") {
+		lines = append(lines, strings.TrimRight(line, " "))
+	}
+	want := []string{
+		"Goal",
+		"Use dw and bold",
+		"text.",
+		"",
+		"• first",
+		"• a bullet long",
+		"  enough to wrap",
+		"2. numbered",
+		"",
+		"  line with **stars**",
+	}
+	if got := strings.Join(lines, "\n"); got != strings.Join(want, "\n") {
+		t.Errorf("unexpected rendering:\n%s\nwant:\n%s", got, strings.Join(want, "\n"))
+	}
+}
+
+func TestRenderMarkdown_UnterminatedCodeBlock(t *testing.T) {
+	if _, err := components.RenderMarkdown("text\n```\ncode", 40); err == nil {
+		t.Error("expected an error for an unterminated code block")
+	}
+}
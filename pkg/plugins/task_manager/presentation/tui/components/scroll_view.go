@@ -0,0 +1,113 @@
+package components
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// ScrollView is the scrollable region of a detail view. The view's content scrolls
+// inside the height left over by its footer (help or an inline form), so the footer
+// stays pinned at the bottom however long the content is.
+type ScrollView struct {
+	viewport  viewport.Model
+	width     int
+	height    int  // Height shared by the region and the footer
+	focusLine int  // Content line last kept visible
+	keepTop   bool // Keep the offset on the next focus change: a view opens at its top or restored offset
+}
+
+// NewScrollView creates a scroll view with default dimensions until SetSize is called
+func NewScrollView() *ScrollView {
+	vp := viewport.New(80, 24)
+	vp.KeyMap = viewport.KeyMap{} // Presenters decide which keys scroll
+	return &ScrollView{
+		viewport:  vp,
+		width:     80,
+		height:    24,
+		focusLine: -1,
+		keepTop:   true,
+	}
+}
+
+// SetSize updates the space the region and footer share (call on WindowSizeMsg).
+// The region is recalculated on the next Render.
+func (s *ScrollView) SetSize(width, height int) {
+	if width < 1 {
+		width = 1
+	}
+	if height < 1 {
+		height = 1
+	}
+	s.width = width
+	s.height = height
+}
+
+// Render returns the visible part of content followed by footer. focusLine is the
+// content line of the selected item (-1 for none); whenever it changes after the
+// first render, the region scrolls just enough to show it.
+func (s *ScrollView) Render(content, footer string, focusLine int) string {
+	footerHeight := 0
+	if footer != "" {
+		footerHeight = lipgloss.Height(footer)
+	}
+	regionHeight := s.height - footerHeight
+	if regionHeight < 1 {
+		regionHeight = 1
+	}
+
+	s.viewport.Width = s.width
+	s.viewport.Height = regionHeight
+	s.viewport.SetContent(strings.TrimSuffix(content, "\n"))
+	s.viewport.SetYOffset(s.viewport.YOffset) // Clamp after a resize or shorter content
+
+	if focusLine != s.focusLine && !s.keepTop {
+		s.ensureVisible(focusLine)
+	}
+	s.focusLine = focusLine
+	s.keepTop = false
+
+	if footer == "" {
+		return s.viewport.View()
+	}
+	return s.viewport.View() + "\n" + footer
+}
+
+// ensureVisible scrolls the least amount needed to show line
+func (s *ScrollView) ensureVisible(line int) {
+	if line < 0 {
+		return
+	}
+	if line < s.viewport.YOffset {
+		s.viewport.SetYOffset(line)
+	} else if line >= s.viewport.YOffset+s.viewport.Height {
+		s.viewport.SetYOffset(line - s.viewport.Height + 1)
+	}
+}
+
+// ScrollUp scrolls the content up by half the region
+func (s *ScrollView) ScrollUp() {
+	s.viewport.HalfPageUp()
+}
+
+// ScrollDown scrolls the content down by half the region
+func (s *ScrollView) ScrollDown() {
+	s.viewport.HalfPageDown()
+}
+
+// Offset returns the index of the first visible content line
+func (s *ScrollView) Offset() int {
+	return s.viewport.YOffset
+}
+
+// SetOffset scrolls to offset, e.g. to restore the position a view had when it was
+// left. It is clamped to the content on the next Render, which keeps it even if the
+// selection would otherwise scroll the region.
+func (s *ScrollView) SetOffset(offset int) {
+	if offset < 0 {
+		offset = 0
+	}
+	s.viewport.YOffset = offset
+	s.keepTop = true
+}
@@ -0,0 +1,115 @@
+package components_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/presentation/tui/components"
+)
+
+// numberedLines returns "line 0\nline 1\n..." with n lines
+func numberedLines(n int) string {
+	lines := make([]string, n)
+	for i := range lines {
+		lines[i] = fmt.Sprintf("line %d", i)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// viewLines splits a rendered view into lines without trailing padding
+func viewLines(view string) []string {
+	lines := strings.Split(view, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " ")
+	}
+	return lines
+}
+
+func TestScrollView_PinsFooterBelowRegion(t *testing.T) {
+	sv := components.NewScrollView()
+	sv.SetSize(40, 6)
+
+	lines := viewLines(sv.Render(numberedLines(20), "help", -1))
+	if len(lines) != 6 {
+		t.Fatalf("expected 6 lines (5 content + footer), got %d: %q", len(lines), lines)
+	}
+	if lines[0] != "line 0" || lines[4] != "line 4" || lines[5] != "help" {
+		t.Errorf("unexpected view %q", lines)
+	}
+
+	// Short content is padded so the footer stays at the bottom
+	lines = viewLines(sv.Render(numberedLines(2), "help", -1))
+	if len(lines) != 6 || lines[5] != "help" {
+		t.Errorf("expected footer on the last line, got %q", lines)
+	}
+}
+
+func TestScrollView_FollowsFocusLine(t *testing.T) {
+	sv := components.NewScrollView()
+	sv.SetSize(40, 6)
+	content := numberedLines(20)
+
+	// A view opens at its top even when the selection starts further down
+	sv.Render(content, "help", 7)
+	if sv.Offset() != 0 {
+		t.Errorf("expected the first render at offset 0, got %d", sv.Offset())
+	}
+
+	sv.Render(content, "help", 12)
+	if sv.Offset() != 8 {
+		t.Errorf("expected offset 8 to show line 12 at the bottom, got %d", sv.Offset())
+	}
+
+	// An unchanged focus line leaves manual scrolling alone
+	sv.ScrollUp()
+	sv.Render(content, "help", 12)
+	if sv.Offset() != 6 {
+		t.Errorf("expected offset 6 after scrolling up, got %d", sv.Offset())
+	}
+
+	sv.Render(content, "help", 2)
+	if sv.Offset() != 2 {
+		t.Errorf("expected offset 2 to show line 2 at the top, got %d", sv.Offset())
+	}
+}
+
+func TestScrollView_ResizeRecalculatesRegion(t *testing.T) {
+	sv := components.NewScrollView()
+	sv.SetSize(40, 6)
+	content := numberedLines(20)
+	sv.Render(content, "help", -1)
+	for i := 0; i < 10; i++ {
+		sv.ScrollDown()
+	}
+	sv.Render(content, "help", -1)
+	if sv.Offset() != 15 {
+		t.Fatalf("expected offset 15 at the bottom, got %d", sv.Offset())
+	}
+
+	sv.SetSize(40, 11)
+	lines := viewLines(sv.Render(content, "help", -1))
+	if len(lines) != 11 || lines[9] != "line 19" {
+		t.Errorf("expected the taller region to end at the last line, got %q", lines)
+	}
+	if sv.Offset() != 10 {
+		t.Errorf("expected offset to be clamped to 10, got %d", sv.Offset())
+	}
+}
+
+func TestScrollView_RestoredOffsetWinsOverInitialFocus(t *testing.T) {
+	sv := components.NewScrollView()
+	sv.SetSize(40, 6)
+	sv.SetOffset(10)
+
+	lines := viewLines(sv.Render(numberedLines(20), "help", 0))
+	if sv.Offset() != 10 || lines[0] != "line 10" {
+		t.Errorf("expected the restored offset 10, got %d: %q", sv.Offset(), lines)
+	}
+
+	// Later selection changes scroll again
+	sv.Render(numberedLines(20), "help", 1)
+	if sv.Offset() != 1 {
+		t.Errorf("expected offset 1 after the selection moved, got %d", sv.Offset())
+	}
+}
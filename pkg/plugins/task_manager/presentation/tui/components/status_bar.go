@@ -0,0 +1,103 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// statusBarSeparator separates the parts of the status bar
+const statusBarSeparator = " │ "
+
+// minStatusBarPathWidth is the narrowest the DB path is shortened to before it is dropped
+const minStatusBarPathWidth = 16
+
+// StatusBar is the one-line context shown below every view: which project and
+// database the TUI is editing, the current view and how fresh its data is
+type StatusBar struct {
+	Project   string
+	DBPath    string
+	View      string
+	UpdatedAt time.Time // When the current view last loaded its data; zero while loading
+}
+
+// Render renders the status bar for a terminal width (0 = unknown, nothing is shortened)
+// at time now. When the bar is too wide, the DB path is shortened from the left, then
+// dropped, then the view name is dropped; the project is always shown.
+func (b StatusBar) Render(width int, now time.Time) string {
+	project := "project: " + b.Project
+	updated := ""
+	if !b.UpdatedAt.IsZero() {
+		updated = "updated " + FormatRelativeTime(now.Sub(b.UpdatedAt))
+	}
+	view := b.View
+	path := b.DBPath
+
+	if width > 0 {
+		fixed := statusBarWidth(project, "", view, updated)
+		if path != "" {
+			available := width - fixed - utf8.RuneCountInString(statusBarSeparator)
+			if available >= minStatusBarPathWidth {
+				path = ShortenPath(path, available)
+			} else {
+				path = ""
+			}
+		}
+		if statusBarWidth(project, path, view, updated) > width {
+			view = ""
+		}
+	}
+
+	line := Styles.SelectedStyle.Render(project)
+	for _, part := range []string{path, view, updated} {
+		if part != "" {
+			line += Styles.MetadataStyle.Render(statusBarSeparator + part)
+		}
+	}
+	return line
+}
+
+// statusBarWidth returns the width of the status bar with the given non-empty parts
+func statusBarWidth(parts ...string) int {
+	width := 0
+	for _, part := range parts {
+		if part == "" {
+			continue
+		}
+		if width > 0 {
+			width += utf8.RuneCountInString(statusBarSeparator)
+		}
+		width += utf8.RuneCountInString(part)
+	}
+	return width
+}
+
+// ShortenPath shortens path to at most width characters by replacing its beginning with
+// "…", keeping the end, which names the project directory and database file
+func ShortenPath(path string, width int) string {
+	runes := []rune(path)
+	if len(runes) <= width || width < 2 {
+		return path
+	}
+	tail := string(runes[len(runes)-(width-1):])
+	// Prefer cutting at a directory boundary so no directory name is split
+	if i := strings.Index(tail, "/"); i > 0 && i < len(tail)-1 {
+		tail = tail[i:]
+	}
+	return "…" + tail
+}
+
+// FormatRelativeTime formats how long ago something happened, e.g. "just now", "12s ago" or "3m ago"
+func FormatRelativeTime(elapsed time.Duration) string {
+	switch {
+	case elapsed < time.Second:
+		return "just now"
+	case elapsed < time.Minute:
+		return fmt.Sprintf("%ds ago", int(elapsed/time.Second))
+	case elapsed < time.Hour:
+		return fmt.Sprintf("%dm ago", int(elapsed/time.Minute))
+	default:
+		return fmt.Sprintf("%dh ago", int(elapsed/time.Hour))
+	}
+}
@@ -0,0 +1,63 @@
+package components_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/presentation/tui/components"
+)
+
+func TestStatusBar_Render(t *testing.T) {
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	bar := components.StatusBar{
+		Project:   "demo",
+		DBPath:    "~/src/app/.darwinflow/projects/demo/roadmap.db",
+		View:      "Task DW-task-3",
+		UpdatedAt: now.Add(-12 * time.Second),
+	}
+
+	full := bar.Render(0, now)
+	for _, want := range []string{"project: demo", bar.DBPath, "Task DW-task-3", "updated 12s ago"} {
+		if !strings.Contains(full, want) {
+			t.Errorf("expected %q in status bar, got %q", want, full)
+		}
+	}
+
+	narrow := bar.Render(80, now)
+	if width := lipgloss.Width(narrow); width > 80 {
+		t.Errorf("status bar is %d columns wide, want at most 80: %q", width, narrow)
+	}
+	if !strings.Contains(narrow, "…/projects/demo/roadmap.db") || !strings.Contains(narrow, "Task DW-task-3") {
+		t.Errorf("expected the DB path to be shortened from the left, got %q", narrow)
+	}
+
+	tiny := bar.Render(30, now)
+	if strings.Contains(tiny, "roadmap.db") || strings.Contains(tiny, "Task") || !strings.Contains(tiny, "project: demo") {
+		t.Errorf("expected only the project and data age on a tiny terminal, got %q", tiny)
+	}
+
+	bar.UpdatedAt = time.Time{}
+	if strings.Contains(bar.Render(0, now), "updated") {
+		t.Error("expected no data age before the view has loaded")
+	}
+}
+
+func TestFormatRelativeTime(t *testing.T) {
+	tests := []struct {
+		elapsed time.Duration
+		want    string
+	}{
+		{0, "just now"},
+		{999 * time.Millisecond, "just now"},
+		{45 * time.Second, "45s ago"},
+		{3*time.Minute + 59*time.Second, "3m ago"},
+		{26 * time.Hour, "26h ago"},
+	}
+	for _, tt := range tests {
+		if got := components.FormatRelativeTime(tt.elapsed); got != tt.want {
+			t.Errorf("FormatRelativeTime(%v) = %q, want %q", tt.elapsed, got, tt.want)
+		}
+	}
+}
@@ -110,6 +110,10 @@ var Styles = struct {
 	ProgressStyle lipgloss.Style // Green for progress
 	TestingStyle  lipgloss.Style // Muted + italic for testing instructions
 
+	// Markdown styles (task descriptions)
+	MarkdownHeadingStyle lipgloss.Style // Bold + accent for # headers
+	MarkdownCodeStyle    lipgloss.Style // Info blue for code spans and blocks
+
 	// Tab styles
 	TabStyle       lipgloss.Style // Bold for inactive tab
 	ActiveTabStyle lipgloss.Style // Bold + underline + accent for active tab
@@ -169,6 +173,13 @@ var Styles = struct {
 		Foreground(lipgloss.Color(ColorScheme.Muted)).
 		Italic(true),
 
+	MarkdownHeadingStyle: lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color(ColorScheme.Accent)),
+
+	MarkdownCodeStyle: lipgloss.NewStyle().
+		Foreground(lipgloss.Color(ColorScheme.Info)),
+
 	TabStyle: lipgloss.NewStyle().
 		Bold(true),
 
@@ -0,0 +1,79 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// EntityLookup is the part of the repository needed to resolve entity IDs
+type EntityLookup interface {
+	GetTask(ctx context.Context, id string) (*entities.TaskEntity, error)
+	GetTrack(ctx context.Context, id string) (*entities.TrackEntity, error)
+	GetAC(ctx context.Context, id string) (*entities.AcceptanceCriteriaEntity, error)
+	GetIteration(ctx context.Context, number int) (*entities.IterationEntity, error)
+}
+
+// entityIDPattern matches IDs such as DW-task-12 or legacy task-1699999999; the
+// project code prefix is optional and the kind selects the view
+var entityIDPattern = regexp.MustCompile(`^(?i:(?:[a-z0-9]+-)?(task|track|ac|adr)-\d+)$`)
+
+// iterationIDPattern matches iteration numbers, optionally written as #N
+var iterationIDPattern = regexp.MustCompile(`^#?(\d+)$`)
+
+// ResolveEntityID maps an entity ID typed by the user to the view that shows it:
+// task and track IDs open their detail views, an acceptance criterion opens the
+// detail view of its task and a number opens that iteration. The entity must
+// exist; unknown or unsupported IDs return an error.
+func ResolveEntityID(ctx context.Context, repo EntityLookup, id string) (StartView, error) {
+	id = strings.TrimSpace(id)
+
+	if match := iterationIDPattern.FindStringSubmatch(id); match != nil {
+		number, err := strconv.Atoi(match[1])
+		if err != nil || number <= 0 {
+			return StartView{}, fmt.Errorf("%w: invalid iteration number %q", pluginsdk.ErrInvalidArgument, id)
+		}
+		if _, err := repo.GetIteration(ctx, number); err != nil {
+			return StartView{}, fmt.Errorf("iteration %d: %w", number, err)
+		}
+		return StartView{View: ViewIterationDetailNew, IterationNumber: number}, nil
+	}
+
+	var kind string
+	if match := entityIDPattern.FindStringSubmatch(id); match != nil {
+		kind = strings.ToLower(match[1])
+	} else if entityType, ok := entities.FormattedIDEntityType(id); ok {
+		// IDs of a project's configured ID format, such as DW/T/001
+		kind = entityType
+	} else {
+		return StartView{}, fmt.Errorf("%w: unrecognized ID %q (expected a task, track or AC ID such as DW-task-12, or an iteration number)", pluginsdk.ErrInvalidArgument, id)
+	}
+
+	switch kind {
+	case "task":
+		task, err := repo.GetTask(ctx, id)
+		if err != nil {
+			return StartView{}, fmt.Errorf("task %s: %w", id, err)
+		}
+		return StartView{View: ViewTaskDetailNew, TaskID: task.ID}, nil
+	case "track":
+		track, err := repo.GetTrack(ctx, id)
+		if err != nil {
+			return StartView{}, fmt.Errorf("track %s: %w", id, err)
+		}
+		return StartView{View: ViewTrackDetailNew, TrackID: track.ID}, nil
+	case "ac":
+		ac, err := repo.GetAC(ctx, id)
+		if err != nil {
+			return StartView{}, fmt.Errorf("acceptance criterion %s: %w", id, err)
+		}
+		return StartView{View: ViewTaskDetailNew, TaskID: ac.TaskID}, nil
+	default:
+		return StartView{}, fmt.Errorf("%w: ADRs have no view in the TUI (use 'dw task-manager adr show %s')", pluginsdk.ErrInvalidArgument, id)
+	}
+}
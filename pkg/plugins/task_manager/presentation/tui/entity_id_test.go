@@ -0,0 +1,80 @@
+package tui_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/presentation/tui"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// fakeEntityLookup knows one entity of each kind
+type fakeEntityLookup struct{}
+
+func (fakeEntityLookup) GetTask(ctx context.Context, id string) (*entities.TaskEntity, error) {
+	if id != "DW-task-7" {
+		return nil, pluginsdk.ErrNotFound
+	}
+	return &entities.TaskEntity{ID: id, TrackID: "DW-track-3"}, nil
+}
+
+func (fakeEntityLookup) GetTrack(ctx context.Context, id string) (*entities.TrackEntity, error) {
+	if id != "DW-track-3" {
+		return nil, pluginsdk.ErrNotFound
+	}
+	return &entities.TrackEntity{ID: id}, nil
+}
+
+func (fakeEntityLookup) GetAC(ctx context.Context, id string) (*entities.AcceptanceCriteriaEntity, error) {
+	if id != "DW-ac-12" {
+		return nil, pluginsdk.ErrNotFound
+	}
+	return &entities.AcceptanceCriteriaEntity{ID: id, TaskID: "DW-task-7"}, nil
+}
+
+func (fakeEntityLookup) GetIteration(ctx context.Context, number int) (*entities.IterationEntity, error) {
+	if number != 4 {
+		return nil, pluginsdk.ErrNotFound
+	}
+	return &entities.IterationEntity{Number: number}, nil
+}
+
+func TestResolveEntityID(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      string
+		want    tui.StartView
+		wantErr error
+	}{
+		{name: "task", id: "DW-task-7", want: tui.StartView{View: tui.ViewTaskDetailNew, TaskID: "DW-task-7"}},
+		{name: "track", id: " DW-track-3 ", want: tui.StartView{View: tui.ViewTrackDetailNew, TrackID: "DW-track-3"}},
+		{name: "ac opens its task", id: "DW-ac-12", want: tui.StartView{View: tui.ViewTaskDetailNew, TaskID: "DW-task-7"}},
+		{name: "iteration", id: "4", want: tui.StartView{View: tui.ViewIterationDetailNew, IterationNumber: 4}},
+		{name: "iteration with hash", id: "#4", want: tui.StartView{View: tui.ViewIterationDetailNew, IterationNumber: 4}},
+		{name: "missing task", id: "DW-task-99", wantErr: pluginsdk.ErrNotFound},
+		{name: "missing iteration", id: "9", wantErr: pluginsdk.ErrNotFound},
+		{name: "iteration zero", id: "0", wantErr: pluginsdk.ErrInvalidArgument},
+		{name: "adr", id: "DW-adr-2", wantErr: pluginsdk.ErrInvalidArgument},
+		{name: "unrecognized", id: "hello", wantErr: pluginsdk.ErrInvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tui.ResolveEntityID(context.Background(), fakeEntityLookup{}, tt.id)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -9,5 +9,25 @@ type Presenter interface {
 	View() string
 }
 
+// PendingChangesFlusher is implemented by presenters that buffer edits in memory
+// before persisting them (e.g. debounced reordering). The app flushes them before quitting.
+type PendingChangesFlusher interface {
+	HasPendingChanges() bool
+	FlushPendingChanges() error
+}
+
+// TextInputCapturer is implemented by presenters with inline text inputs.
+// While CapturingTextInput is true, plain keys such as "q" are typed rather than handled as shortcuts.
+type TextInputCapturer interface {
+	CapturingTextInput() bool
+}
+
+// ScrollPositioner is implemented by presenters whose content scrolls. The app saves
+// the offset when navigating away and restores it when the same view is opened again.
+type ScrollPositioner interface {
+	ScrollOffset() int
+	SetScrollOffset(offset int)
+}
+
 // BackMsgNew is sent when the user wants to go back in the TUI
 type BackMsgNew struct{}
@@ -3,11 +3,16 @@ package presenters
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/lipgloss"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application/dto"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/presentation/tui/components"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/presentation/tui/viewmodels"
@@ -31,32 +36,27 @@ type RoadmapListKeyMap struct {
 	StartIteration  key.Binding // s - Start iteration (planned → current)
 	CompleteIter    key.Binding // c - Complete iteration (current → complete)
 	RevertIteration key.Binding // p - Revert iteration (complete → planned)
+	CopyID          key.Binding // y - Copy selected ID to clipboard
+	GoTo            key.Binding // : - Open an entity by ID (handled by the app)
+	MyTasks         key.Binding // m - Toggle the "my tasks" backlog filter (handled by the app)
+	SwitchRoadmap   key.Binding // R - Activate the next unarchived roadmap
 }
 
-// NewRoadmapListKeyMap creates default keybindings for dashboard
-func NewRoadmapListKeyMap() RoadmapListKeyMap {
+// NewRoadmapListKeyMap creates keybindings for dashboard, binding the remappable actions as keymap does
+func NewRoadmapListKeyMap(keymap components.KeyMap) RoadmapListKeyMap {
 	return RoadmapListKeyMap{
-		Up:    components.NewUpKey(),
-		Down:  components.NewDownKey(),
-		Enter: components.NewEnterKey(),
-		Quit:  components.NewQuitKey(),
-		Help:  components.NewHelpKey(),
-		Refresh: key.NewBinding(
-			key.WithKeys("r"),
-			key.WithHelp("r", "refresh"),
-		),
+		Up:      components.NewUpKey(keymap),
+		Down:    components.NewDownKey(keymap),
+		Enter:   components.NewEnterKey(keymap),
+		Quit:    components.NewQuitKey(keymap),
+		Help:    components.NewHelpKey(),
+		Refresh: components.NewRefreshKey(keymap),
 		Tab: key.NewBinding(
 			key.WithKeys("tab"),
 			key.WithHelp("tab", "switch section"),
 		),
-		MoveUp: key.NewBinding(
-			key.WithKeys("shift+up", "K"),
-			key.WithHelp("K/shift+↑", "move up"),
-		),
-		MoveDown: key.NewBinding(
-			key.WithKeys("shift+down", "J"),
-			key.WithHelp("J/shift+↓", "move down"),
-		),
+		MoveUp:   components.NewReorderUpKey(keymap),
+		MoveDown: components.NewReorderDownKey(keymap),
 		PageUp: key.NewBinding(
 			key.WithKeys("pgup", "b"),
 			key.WithHelp("pgup/b", "page up"),
@@ -77,6 +77,16 @@ func NewRoadmapListKeyMap() RoadmapListKeyMap {
 			key.WithKeys("p"),
 			key.WithHelp("p", "revert iteration"),
 		),
+		MyTasks: key.NewBinding(
+			key.WithKeys("m"),
+			key.WithHelp("m", "my tasks"),
+		),
+		SwitchRoadmap: key.NewBinding(
+			key.WithKeys("R"),
+			key.WithHelp("R", "switch roadmap"),
+		),
+		CopyID: components.NewCopyIDKey(),
+		GoTo:   components.NewGoToKey(),
 	}
 }
 
@@ -89,7 +99,7 @@ func (k RoadmapListKeyMap) ShortHelp() []key.Binding {
 func (k RoadmapListKeyMap) FullHelp() [][]key.Binding {
 	return [][]key.Binding{
 		{k.Up, k.Down, k.Enter},
-		{k.Tab, k.Refresh},
+		{k.Tab, k.Refresh, k.CopyID, k.GoTo, k.MyTasks, k.SwitchRoadmap},
 		{k.StartIteration, k.CompleteIter, k.RevertIteration},
 		{k.PageUp, k.PageDown},
 		{k.MoveUp, k.MoveDown},
@@ -136,19 +146,50 @@ type RoadmapListPresenter struct {
 	repo          domain.RoadmapRepository
 	ctx           context.Context
 	scrollHelper  *components.ScrollHelper
+
+	// "My tasks" filter: when backlogAssignee is set, the backlog only shows their tasks
+	backlogAssignee string
+	allBacklogTasks []*viewmodels.BacklogTaskViewModel
+
+	// Debounced reordering: moves update the in-memory order and are persisted once input settles
+	pendingReorder bool
+	lastReorderAt  time.Time
+	persistMu      sync.Mutex // Serializes background and flush-on-quit saves
+
+	// View is called after every message; the rendered dashboard is reused until
+	// the data (dataVersion) or the view state in dashboardRenderKey changes
+	dataVersion int
+	renderCache *dashboardRender
+}
+
+// dashboardRenderKey is everything the rendered dashboard depends on
+type dashboardRenderKey struct {
+	dataVersion   int
+	width         int
+	height        int
+	selectedIndex int
+	activeSection DashboardSection
+	showFullHelp  bool
+	start, end    int // Visible item range
+}
+
+// dashboardRender is a rendered dashboard and the state it was rendered for
+type dashboardRender struct {
+	key     dashboardRenderKey
+	content string
 }
 
 // NewRoadmapListPresenter creates a new dashboard presenter
-func NewRoadmapListPresenter(vm *viewmodels.RoadmapListViewModel, repo domain.RoadmapRepository, ctx context.Context) *RoadmapListPresenter {
-	return NewRoadmapListPresenterWithSelection(vm, repo, ctx, 0)
+func NewRoadmapListPresenter(vm *viewmodels.RoadmapListViewModel, repo domain.RoadmapRepository, ctx context.Context, keymap components.KeyMap) *RoadmapListPresenter {
+	return NewRoadmapListPresenterWithSelection(vm, repo, ctx, keymap, 0)
 }
 
 // NewRoadmapListPresenterWithSelection creates a new dashboard presenter with initial selection
-func NewRoadmapListPresenterWithSelection(vm *viewmodels.RoadmapListViewModel, repo domain.RoadmapRepository, ctx context.Context, selectedIndex int) *RoadmapListPresenter {
+func NewRoadmapListPresenterWithSelection(vm *viewmodels.RoadmapListViewModel, repo domain.RoadmapRepository, ctx context.Context, keymap components.KeyMap, selectedIndex int) *RoadmapListPresenter {
 	return &RoadmapListPresenter{
 		viewModel:     vm,
 		help:          components.NewHelp(),
-		keys:          NewRoadmapListKeyMap(),
+		keys:          NewRoadmapListKeyMap(keymap),
 		showFullHelp:  false,
 		selectedIndex: selectedIndex,
 		activeSection: SectionIterations, // Default to iterations section
@@ -157,7 +198,45 @@ func NewRoadmapListPresenterWithSelection(vm *viewmodels.RoadmapListViewModel, r
 		width:         80, // Default width until WindowSizeMsg arrives
 		height:        24,
 		scrollHelper:  components.NewScrollHelper(),
+
+		allBacklogTasks: vm.BacklogTasks,
+	}
+}
+
+// FilterBacklogByAssignee shows only the backlog tasks assigned to assignee.
+// An empty assignee shows the whole backlog again.
+func (p *RoadmapListPresenter) FilterBacklogByAssignee(assignee string) {
+	p.backlogAssignee = assignee
+	if assignee == "" {
+		p.viewModel.BacklogTasks = p.allBacklogTasks
+	} else {
+		var mine []*viewmodels.BacklogTaskViewModel
+		for _, task := range p.allBacklogTasks {
+			if task.Assignee == assignee {
+				mine = append(mine, task)
+			}
+		}
+		p.viewModel.BacklogTasks = mine
+	}
+	p.dataVersion++
+
+	// Keep the selection within the (possibly shorter) list
+	totalItems := getTotalItems(p.viewModel)
+	if p.selectedIndex >= totalItems {
+		p.selectedIndex = totalItems - 1
+	}
+	if p.selectedIndex < 0 {
+		p.selectedIndex = 0
+	}
+	p.scrollHelper.EnsureVisible(totalItems, p.selectedIndex)
+}
+
+// backlogTitle returns the backlog section header, naming the assignee when filtered
+func (p *RoadmapListPresenter) backlogTitle() string {
+	if p.backlogAssignee != "" {
+		return fmt.Sprintf("My Tasks (@%s)", p.backlogAssignee)
 	}
+	return "Backlog Tasks"
 }
 
 func (p *RoadmapListPresenter) Init() tea.Cmd {
@@ -186,7 +265,20 @@ func (p *RoadmapListPresenter) Update(msg tea.Msg) (Presenter, tea.Cmd) {
 		p.scrollHelper.SetViewportHeight(availableHeight)
 		p.scrollHelper.EnsureVisible(getTotalItems(p.viewModel), p.selectedIndex)
 
+	case reorderSettledMsg:
+		// Only the tick scheduled by the last move persists; earlier ticks see newer input
+		if p.pendingReorder && time.Since(p.lastReorderAt) >= reorderDebounce {
+			return p, p.persistReorder()
+		}
+
 	case tea.KeyMsg:
+		// Any key other than a move settles the reorder so it is saved before acting
+		if p.pendingReorder && !key.Matches(msg, p.keys.MoveUp, p.keys.MoveDown) {
+			if err := p.FlushPendingChanges(); err != nil {
+				return p, func() tea.Msg { return ErrorMsg{Err: err} }
+			}
+		}
+
 		switch {
 		case key.Matches(msg, p.keys.Quit):
 			return p, tea.Quit
@@ -261,12 +353,19 @@ func (p *RoadmapListPresenter) Update(msg tea.Msg) (Presenter, tea.Cmd) {
 		case key.Matches(msg, p.keys.MoveUp):
 			// Reorder iterations (move selected iteration up)
 			if p.selectedIndex > 0 && p.selectedIndex < len(p.viewModel.ActiveIterations) {
-				return p, p.reorderIterations(p.selectedIndex, p.selectedIndex-1)
+				return p, p.moveIteration(p.selectedIndex, p.selectedIndex-1)
+			}
+			// Bump a backlog task within its track
+			if task := p.selectedBacklogTask(); task != nil {
+				return p, p.bumpTask(task.ID, dto.BumpUp)
 			}
 		case key.Matches(msg, p.keys.MoveDown):
 			// Reorder iterations (move selected iteration down)
 			if p.selectedIndex < len(p.viewModel.ActiveIterations)-1 {
-				return p, p.reorderIterations(p.selectedIndex, p.selectedIndex+1)
+				return p, p.moveIteration(p.selectedIndex, p.selectedIndex+1)
+			}
+			if task := p.selectedBacklogTask(); task != nil {
+				return p, p.bumpTask(task.ID, dto.BumpDown)
 			}
 		case key.Matches(msg, p.keys.StartIteration):
 			// Start iteration (planned → current)
@@ -292,22 +391,96 @@ func (p *RoadmapListPresenter) Update(msg tea.Msg) (Presenter, tea.Cmd) {
 					return p, p.revertIteration(iter.Number)
 				}
 			}
+		case key.Matches(msg, p.keys.CopyID):
+			return p, copyID(p.selectedID())
+		case key.Matches(msg, p.keys.MyTasks):
+			return p, func() tea.Msg { return MyTasksToggledMsg{} }
+		case key.Matches(msg, p.keys.SwitchRoadmap):
+			if next := p.nextRoadmapID(); next != "" {
+				return p, p.switchRoadmap(next)
+			}
 		}
 	}
 
 	return p, nil
 }
 
+// selectedID returns the ID of the selected iteration (its number), track or backlog task
+func (p *RoadmapListPresenter) selectedID() string {
+	index := p.selectedIndex
+	if index < 0 {
+		return ""
+	}
+	if index < len(p.viewModel.ActiveIterations) {
+		return strconv.Itoa(p.viewModel.ActiveIterations[index].Number)
+	}
+	index -= len(p.viewModel.ActiveIterations)
+	if index < len(p.viewModel.ActiveTracks) {
+		return p.viewModel.ActiveTracks[index].ID
+	}
+	index -= len(p.viewModel.ActiveTracks)
+	if index < len(p.viewModel.BacklogTasks) {
+		return p.viewModel.BacklogTasks[index].ID
+	}
+	return ""
+}
+
+// selectedBacklogTask returns the selected backlog task, or nil if the selection is elsewhere
+func (p *RoadmapListPresenter) selectedBacklogTask() *viewmodels.BacklogTaskViewModel {
+	index := p.selectedIndex - len(p.viewModel.ActiveIterations) - len(p.viewModel.ActiveTracks)
+	if index < 0 || index >= len(p.viewModel.BacklogTasks) {
+		return nil
+	}
+	return p.viewModel.BacklogTasks[index]
+}
+
+// bumpTask asks the app to move a task past its track neighbour. The backlog stays in
+// creation order, so the selection is kept by index.
+func (p *RoadmapListPresenter) bumpTask(taskID, direction string) tea.Cmd {
+	selectedIndex := p.selectedIndex
+	return func() tea.Msg {
+		return TaskBumpRequestedMsg{TaskID: taskID, Direction: direction, SelectedIndex: selectedIndex}
+	}
+}
+
 // getTotalItems returns the total number of items across all sections
 func getTotalItems(vm *viewmodels.RoadmapListViewModel) int {
 	return len(vm.ActiveIterations) + len(vm.ActiveTracks) + len(vm.BacklogTasks)
 }
 
+// View returns the rendered dashboard, re-rendering only when the data or view state changed.
+// Rendering works on the preloaded view model and never queries the repository.
 func (p *RoadmapListPresenter) View() string {
+	start, end := p.scrollHelper.VisibleRange(getTotalItems(p.viewModel))
+	key := dashboardRenderKey{
+		dataVersion:   p.dataVersion,
+		width:         p.width,
+		height:        p.height,
+		selectedIndex: p.selectedIndex,
+		activeSection: p.activeSection,
+		showFullHelp:  p.showFullHelp,
+		start:         start,
+		end:           end,
+	}
+	if p.renderCache != nil && p.renderCache.key == key {
+		return p.renderCache.content
+	}
+
+	content := p.render()
+	p.renderCache = &dashboardRender{key: key, content: content}
+	return content
+}
+
+// render builds the dashboard content from the view model
+func (p *RoadmapListPresenter) render() string {
 	var b strings.Builder
 
-	// Title
+	// Title, with the active roadmap when there are several to switch between
 	b.WriteString(components.Styles.TitleStyle.Render("Dashboard"))
+	if position, count := p.roadmapPosition(); count > 1 {
+		b.WriteString(components.Styles.MetadataStyle.Render(
+			fmt.Sprintf("  roadmap %d/%d: %s (R to switch)", position, count, p.viewModel.RoadmapID)))
+	}
 	b.WriteString("\n\n")
 
 	// Roadmap vision and success criteria header
@@ -328,9 +501,18 @@ func (p *RoadmapListPresenter) View() string {
 		}
 		b.WriteString("\n")
 
-		b.WriteString(components.Styles.SectionStyle.Render("Success Criteria"))
-		b.WriteString("\n")
-		if p.viewModel.SuccessCriteria != "" {
+		if progress := p.viewModel.CriteriaProgress; progress != nil {
+			b.WriteString(components.Styles.SectionStyle.Render(
+				fmt.Sprintf("Success Criteria: %d/%d met", progress.Completed, progress.Total)))
+			b.WriteString("\n  ")
+			b.WriteString(renderProgressBar(progress.Percent, 30))
+			b.WriteString(fmt.Sprintf(" %.0f%%", progress.Percent*100))
+			b.WriteString("\n")
+		} else {
+			b.WriteString(components.Styles.SectionStyle.Render("Success Criteria"))
+			b.WriteString("\n")
+		}
+		if p.viewModel.CriteriaProgress == nil && p.viewModel.SuccessCriteria != "" {
 			// Use wordwrap + indent for proper ANSI-aware text wrapping (Bubble Tea best practice)
 			indentSize := 2
 			availableWidth := p.width - indentSize - 2 // Account for indent + right margin
@@ -450,9 +632,9 @@ func (p *RoadmapListPresenter) View() string {
 			// Only show section header if items in this section are visible
 			// Highlight header if this section is active
 			if p.activeSection == SectionBacklog {
-				b.WriteString(components.Styles.SelectedStyle.Render("Backlog Tasks"))
+				b.WriteString(components.Styles.SelectedStyle.Render(p.backlogTitle()))
 			} else {
-				b.WriteString(components.Styles.SectionStyle.Render("Backlog Tasks"))
+				b.WriteString(components.Styles.SectionStyle.Render(p.backlogTitle()))
 			}
 			b.WriteString("\n")
 		}
@@ -468,16 +650,16 @@ func (p *RoadmapListPresenter) View() string {
 
 			// Apply color to status
 			statusStyle := getStatusStyle(task.StatusColor)
-			statusText := statusStyle.Render(task.Status)
+			statusText := statusStyle.Render(task.Status) + acProgressSuffix(task.ACProgress, task.HasFailedACs)
 
 			var itemStyle string
 			if p.isSelected(currentItemIndex, "task") {
 				itemStyle = components.Styles.SelectedStyle.Render(
-					fmt.Sprintf("  %s: %s - %s",
-						task.ID, task.Title, statusText))
+					fmt.Sprintf("  %s: %s - %s%s",
+						task.ID, task.Title, statusText, assigneeSuffix(task.Assignee)))
 			} else {
-				itemStyle = fmt.Sprintf("  %s: %s - %s",
-					task.ID, task.Title, statusText)
+				itemStyle = fmt.Sprintf("  %s: %s - %s%s",
+					task.ID, task.Title, statusText, assigneeSuffix(task.Assignee))
 			}
 			b.WriteString(itemStyle)
 			b.WriteString("\n")
@@ -487,6 +669,11 @@ func (p *RoadmapListPresenter) View() string {
 		if currentItemIndex > start {
 			b.WriteString("\n")
 		}
+	} else if p.backlogAssignee != "" {
+		b.WriteString(components.Styles.SectionStyle.Render(p.backlogTitle()))
+		b.WriteString("\n")
+		b.WriteString(components.Styles.MetadataStyle.Render("  No open tasks assigned to you"))
+		b.WriteString("\n\n")
 	}
 
 	// Scroll indicators (optional but helpful)
@@ -512,113 +699,120 @@ func (p *RoadmapListPresenter) isSelected(index int, section string) bool {
 	return p.selectedIndex == index
 }
 
-// reorderIterations reorders iterations using fractional ranking
-func (p *RoadmapListPresenter) reorderIterations(fromIndex, toIndex int) tea.Cmd {
-	return func() tea.Msg {
-		if fromIndex < 0 || fromIndex >= len(p.viewModel.ActiveIterations) {
-			return nil
-		}
-		if toIndex < 0 || toIndex >= len(p.viewModel.ActiveIterations) {
-			return nil
-		}
+// reorderDebounce is how long reorder input must settle before the new order is persisted
+const reorderDebounce = 300 * time.Millisecond
 
-		// Get the iteration being moved
-		iterToMove := p.viewModel.ActiveIterations[fromIndex]
+// reorderSettledMsg is scheduled after each reorder keypress to check whether input has settled
+type reorderSettledMsg struct{}
 
-		// Fetch full iteration entity
-		iteration, err := p.repo.GetIteration(p.ctx, iterToMove.Number)
-		if err != nil {
-			return ErrorMsg{Err: err}
-		}
+// moveIteration moves an iteration in the in-memory order only.
+// Rapid moves are coalesced; the order is persisted once input settles (see reorderSettledMsg).
+func (p *RoadmapListPresenter) moveIteration(fromIndex, toIndex int) tea.Cmd {
+	iterations := p.viewModel.ActiveIterations
+	if fromIndex < 0 || fromIndex >= len(iterations) || toIndex < 0 || toIndex >= len(iterations) {
+		return nil
+	}
 
-		// Calculate new rank using fractional ranking
-		// This ensures stable ordering regardless of how many times we move
-		var newRank float64
+	// Copy instead of mutating the loaded view model
+	reordered := make([]*viewmodels.IterationCardViewModel, len(iterations))
+	copy(reordered, iterations)
+	reordered[fromIndex], reordered[toIndex] = reordered[toIndex], reordered[fromIndex]
+	vm := *p.viewModel
+	vm.ActiveIterations = reordered
+	p.viewModel = &vm
+	p.dataVersion++
+
+	// Selection follows the moved iteration
+	p.selectedIndex = toIndex
+	p.scrollHelper.EnsureVisible(getTotalItems(p.viewModel), p.selectedIndex)
+
+	p.pendingReorder = true
+	p.lastReorderAt = time.Now()
+
+	return tea.Tick(reorderDebounce, func(time.Time) tea.Msg {
+		return reorderSettledMsg{}
+	})
+}
 
-		if toIndex == 0 {
-			// Moving to first position: rank = first_item.rank - 1
-			firstIter, err := p.repo.GetIteration(p.ctx, p.viewModel.ActiveIterations[0].Number)
-			if err != nil {
-				return ErrorMsg{Err: err}
-			}
-			newRank = firstIter.Rank - 1
-		} else if toIndex == len(p.viewModel.ActiveIterations)-1 {
-			// Moving to last position: rank = last_item.rank + 1
-			lastIter, err := p.repo.GetIteration(p.ctx, p.viewModel.ActiveIterations[len(p.viewModel.ActiveIterations)-1].Number)
-			if err != nil {
-				return ErrorMsg{Err: err}
-			}
-			newRank = lastIter.Rank + 1
-		} else {
-			// Moving to middle position: rank = average of adjacent items
-			var prevRank, nextRank float64
-
-			if fromIndex < toIndex {
-				// Moving down: insert between toIndex and toIndex+1
-				targetIter, err := p.repo.GetIteration(p.ctx, p.viewModel.ActiveIterations[toIndex].Number)
-				if err != nil {
-					return ErrorMsg{Err: err}
-				}
-				prevRank = targetIter.Rank
+// HasPendingChanges reports whether a reorder is shown but not yet persisted
+func (p *RoadmapListPresenter) HasPendingChanges() bool {
+	return p.pendingReorder
+}
 
-				if toIndex+1 < len(p.viewModel.ActiveIterations) {
-					nextIter, err := p.repo.GetIteration(p.ctx, p.viewModel.ActiveIterations[toIndex+1].Number)
-					if err != nil {
-						return ErrorMsg{Err: err}
-					}
-					nextRank = nextIter.Rank
-				} else {
-					nextRank = prevRank + 2
-				}
-			} else {
-				// Moving up: insert between toIndex-1 and toIndex
-				targetIter, err := p.repo.GetIteration(p.ctx, p.viewModel.ActiveIterations[toIndex].Number)
-				if err != nil {
-					return ErrorMsg{Err: err}
-				}
-				nextRank = targetIter.Rank
+// FlushPendingChanges synchronously persists a pending reorder.
+// Called before leaving the dashboard (quit, navigation) so no reorder is lost.
+func (p *RoadmapListPresenter) FlushPendingChanges() error {
+	if !p.pendingReorder {
+		return nil
+	}
+	p.pendingReorder = false
+	return p.persistIterationOrder(p.iterationOrder())
+}
 
-				if toIndex > 0 {
-					prevIter, err := p.repo.GetIteration(p.ctx, p.viewModel.ActiveIterations[toIndex-1].Number)
-					if err != nil {
-						return ErrorMsg{Err: err}
-					}
-					prevRank = prevIter.Rank
-				} else {
-					prevRank = nextRank - 2
-				}
-			}
+// iterationOrder returns the iteration numbers in the currently visible order
+func (p *RoadmapListPresenter) iterationOrder() []int {
+	numbers := make([]int, len(p.viewModel.ActiveIterations))
+	for i, iter := range p.viewModel.ActiveIterations {
+		numbers[i] = iter.Number
+	}
+	return numbers
+}
 
-			// Calculate intermediate rank
-			if prevRank == nextRank {
-				// Collision detected (shouldn't happen after v8 migration)
-				const epsilon = 0.01
-				if fromIndex < toIndex {
-					// Moving down - insert after nextRank
-					newRank = nextRank + epsilon
-				} else {
-					// Moving up - insert before prevRank
-					newRank = prevRank - epsilon
-				}
-				// Note: Collisions shouldn't exist after v8 normalization
-			} else {
-				// No collision - use fractional ranking (average)
-				newRank = (prevRank + nextRank) / 2.0
-			}
+// persistReorder persists the visible order in the background and reloads the dashboard
+func (p *RoadmapListPresenter) persistReorder() tea.Cmd {
+	p.pendingReorder = false
+	numbers := p.iterationOrder()
+	selectedNumber := 0
+	if p.selectedIndex < len(numbers) {
+		selectedNumber = numbers[p.selectedIndex]
+	}
+
+	return func() tea.Msg {
+		if err := p.persistIterationOrder(numbers); err != nil {
+			return ErrorMsg{Err: err}
 		}
+		return ReorderCompletedMsg{SelectedIterationNumber: selectedNumber}
+	}
+}
 
-		// Update iteration rank
-		iteration.Rank = newRank
-		err = p.repo.UpdateIteration(p.ctx, iteration)
+// persistIterationOrder redistributes the existing ranks of the given iterations so that
+// rank order matches the given order. Only iterations whose rank changes are written.
+func (p *RoadmapListPresenter) persistIterationOrder(numbers []int) error {
+	p.persistMu.Lock()
+	defer p.persistMu.Unlock()
+
+	ranks := make([]float64, len(numbers))
+	for i, number := range numbers {
+		iteration, err := p.repo.GetIteration(p.ctx, number)
 		if err != nil {
-			return ErrorMsg{Err: err}
+			return err
 		}
+		ranks[i] = iteration.Rank
+	}
 
-		// Update selected index to follow the moved iteration
-		p.selectedIndex = toIndex
+	sort.Float64s(ranks)
+	// Ranks must be strictly increasing for the order to be unambiguous
+	for i := 1; i < len(ranks); i++ {
+		if ranks[i] <= ranks[i-1] {
+			ranks[i] = ranks[i-1] + 1
+		}
+	}
 
-		return ReorderCompletedMsg{SelectedIterationNumber: iterToMove.Number}
+	for i, number := range numbers {
+		iteration, err := p.repo.GetIteration(p.ctx, number)
+		if err != nil {
+			return err
+		}
+		if iteration.Rank == ranks[i] {
+			continue
+		}
+		iteration.Rank = ranks[i]
+		if err := p.repo.UpdateIteration(p.ctx, iteration); err != nil {
+			return err
+		}
 	}
+
+	return nil
 }
 
 // startIteration starts a planned iteration (planned → current)
@@ -660,6 +854,37 @@ func (p *RoadmapListPresenter) revertIteration(iterationNumber int) tea.Cmd {
 	}
 }
 
+// roadmapPosition returns the 1-based position of the shown roadmap among the
+// unarchived roadmaps, and their count
+func (p *RoadmapListPresenter) roadmapPosition() (int, int) {
+	for i, roadmap := range p.viewModel.Roadmaps {
+		if roadmap.Active {
+			return i + 1, len(p.viewModel.Roadmaps)
+		}
+	}
+	return 0, len(p.viewModel.Roadmaps)
+}
+
+// nextRoadmapID returns the ID of the unarchived roadmap after the shown one, wrapping
+// around, or "" if there is no other roadmap to switch to
+func (p *RoadmapListPresenter) nextRoadmapID() string {
+	position, count := p.roadmapPosition()
+	if count < 2 {
+		return ""
+	}
+	return p.viewModel.Roadmaps[position%count].ID
+}
+
+// switchRoadmap makes another roadmap the active one and reloads the dashboard for it
+func (p *RoadmapListPresenter) switchRoadmap(roadmapID string) tea.Cmd {
+	return func() tea.Msg {
+		if err := p.repo.SetActiveRoadmap(p.ctx, roadmapID); err != nil {
+			return ErrorMsg{Err: err}
+		}
+		return RoadmapSwitchedMsg{RoadmapID: roadmapID}
+	}
+}
+
 // cycleActiveSection cycles through sections: Iterations → Tracks → Backlog → Iterations
 // Updates activeSection and adjusts selectedIndex to first item in new section
 func (p *RoadmapListPresenter) cycleActiveSection() {
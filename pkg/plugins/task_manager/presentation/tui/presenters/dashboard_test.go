@@ -2,10 +2,17 @@ package presenters_test
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strings"
 	"testing"
 
 	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application/dto"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/presentation/tui/components"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/presentation/tui/presenters"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/presentation/tui/viewmodels"
 )
@@ -24,7 +31,7 @@ func TestRoadmapListPresenter_TabNavigation(t *testing.T) {
 		},
 	}
 
-	presenter := presenters.NewRoadmapListPresenter(vm, nil, context.Background())
+	presenter := presenters.NewRoadmapListPresenter(vm, nil, context.Background(), components.DefaultKeyMap())
 
 	// Simulate Tab key press
 	tabMsg := tea.KeyMsg{Type: tea.KeyTab}
@@ -57,7 +64,7 @@ func TestRoadmapListPresenter_TabNavigationCycle(t *testing.T) {
 		},
 	}
 
-	presenter := presenters.NewRoadmapListPresenter(vm, nil, context.Background())
+	presenter := presenters.NewRoadmapListPresenter(vm, nil, context.Background(), components.DefaultKeyMap())
 
 	// Press Tab 3 times to cycle through all sections
 	tabMsg := tea.KeyMsg{Type: tea.KeyTab}
@@ -80,7 +87,7 @@ func TestRoadmapListPresenter_RefreshKey(t *testing.T) {
 		},
 	}
 
-	presenter := presenters.NewRoadmapListPresenter(vm, nil, context.Background())
+	presenter := presenters.NewRoadmapListPresenter(vm, nil, context.Background(), components.DefaultKeyMap())
 
 	// Simulate 'r' key press (refresh)
 	rMsg := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'r'}}
@@ -99,7 +106,7 @@ func TestRoadmapListPresenter_RefreshKey(t *testing.T) {
 }
 
 func TestRoadmapListKeyMap_TabKeyBinding(t *testing.T) {
-	keys := presenters.NewRoadmapListKeyMap()
+	keys := presenters.NewRoadmapListKeyMap(components.DefaultKeyMap())
 
 	// Verify Tab key binding exists
 	if keys.Tab.Keys()[0] != "tab" {
@@ -121,7 +128,7 @@ func TestRoadmapListKeyMap_TabKeyBinding(t *testing.T) {
 }
 
 func TestRoadmapListKeyMap_RefreshKeyBinding(t *testing.T) {
-	keys := presenters.NewRoadmapListKeyMap()
+	keys := presenters.NewRoadmapListKeyMap(components.DefaultKeyMap())
 
 	// Verify Refresh key binding exists
 	if keys.Refresh.Keys()[0] != "r" {
@@ -150,7 +157,7 @@ func TestRefreshDashboardMsg_PreservesSelection(t *testing.T) {
 		},
 	}
 
-	presenter := presenters.NewRoadmapListPresenter(vm, nil, context.Background())
+	presenter := presenters.NewRoadmapListPresenter(vm, nil, context.Background(), components.DefaultKeyMap())
 
 	// Navigate to second item
 	downMsg := tea.KeyMsg{Type: tea.KeyDown}
@@ -188,7 +195,7 @@ func TestRoadmapListPresenter_EnterOnBacklogTask(t *testing.T) {
 		},
 	}
 
-	presenter := presenters.NewRoadmapListPresenter(vm, nil, context.Background())
+	presenter := presenters.NewRoadmapListPresenter(vm, nil, context.Background(), components.DefaultKeyMap())
 
 	// Navigate to first backlog task (index = 1 iteration + 1 track + 0 = 2)
 	downMsg := tea.KeyMsg{Type: tea.KeyDown}
@@ -217,6 +224,39 @@ func TestRoadmapListPresenter_EnterOnBacklogTask(t *testing.T) {
 	}
 }
 
+func TestRoadmapListPresenter_BumpBacklogTask(t *testing.T) {
+	vm := &viewmodels.RoadmapListViewModel{
+		ActiveIterations: []*viewmodels.IterationCardViewModel{
+			{Number: 1, Name: "Iteration 1", TaskCount: 3},
+		},
+		BacklogTasks: []*viewmodels.BacklogTaskViewModel{
+			{ID: "TM-task-1", Title: "Task 1", Status: "todo"},
+			{ID: "TM-task-2", Title: "Task 2", Status: "todo"},
+		},
+	}
+
+	// Index 2 is the second backlog task
+	presenter := presenters.NewRoadmapListPresenterWithSelection(vm, nil, context.Background(), components.DefaultKeyMap(), 2)
+
+	for _, tc := range []struct {
+		key       string
+		direction string
+	}{{"K", dto.BumpUp}, {"J", dto.BumpDown}} {
+		_, cmd := presenter.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(tc.key)})
+		if cmd == nil {
+			t.Fatalf("expected a command from %s on a backlog task", tc.key)
+		}
+		msg, ok := cmd().(presenters.TaskBumpRequestedMsg)
+		if !ok {
+			t.Fatalf("expected TaskBumpRequestedMsg from %s, got %T", tc.key, cmd())
+		}
+		want := presenters.TaskBumpRequestedMsg{TaskID: "TM-task-2", Direction: tc.direction, SelectedIndex: 2}
+		if msg != want {
+			t.Errorf("%s: got %+v, want %+v", tc.key, msg, want)
+		}
+	}
+}
+
 func TestRoadmapListPresenter_EnterOnSecondBacklogTask(t *testing.T) {
 	// Create test view model with items in all sections
 	vm := &viewmodels.RoadmapListViewModel{
@@ -235,7 +275,7 @@ func TestRoadmapListPresenter_EnterOnSecondBacklogTask(t *testing.T) {
 		},
 	}
 
-	presenter := presenters.NewRoadmapListPresenter(vm, nil, context.Background())
+	presenter := presenters.NewRoadmapListPresenter(vm, nil, context.Background(), components.DefaultKeyMap())
 
 	// Navigate to second backlog task (index = 2 iterations + 2 tracks + 1 = 5)
 	downMsg := tea.KeyMsg{Type: tea.KeyDown}
@@ -272,7 +312,7 @@ func TestRoadmapListPresenter_EnterOnIteration(t *testing.T) {
 		},
 	}
 
-	presenter := presenters.NewRoadmapListPresenter(vm, nil, context.Background())
+	presenter := presenters.NewRoadmapListPresenter(vm, nil, context.Background(), components.DefaultKeyMap())
 
 	// Press Enter on first iteration (index=0)
 	enterMsg := tea.KeyMsg{Type: tea.KeyEnter}
@@ -305,7 +345,7 @@ func TestRoadmapListPresenter_EnterOnTrack(t *testing.T) {
 		},
 	}
 
-	presenter := presenters.NewRoadmapListPresenter(vm, nil, context.Background())
+	presenter := presenters.NewRoadmapListPresenter(vm, nil, context.Background(), components.DefaultKeyMap())
 
 	// Navigate to track (index=1)
 	downMsg := tea.KeyMsg{Type: tea.KeyDown}
@@ -331,3 +371,292 @@ func TestRoadmapListPresenter_EnterOnTrack(t *testing.T) {
 		t.Errorf("Expected TrackID=TM-track-1, got %s", trackMsg.TrackID)
 	}
 }
+
+func TestRoadmapListPresenter_CopyIDKey(t *testing.T) {
+	vm := &viewmodels.RoadmapListViewModel{
+		ActiveIterations: []*viewmodels.IterationCardViewModel{
+			{Number: 4, Name: "Iteration 4", TaskCount: 3},
+		},
+		ActiveTracks: []*viewmodels.TrackCardViewModel{
+			{ID: "TM-track-1", Title: "Track 1", TaskCount: 2},
+		},
+		BacklogTasks: []*viewmodels.BacklogTaskViewModel{
+			{ID: "TM-task-12", Title: "Task 12"},
+		},
+	}
+
+	presenter := presenters.NewRoadmapListPresenter(vm, nil, context.Background(), components.DefaultKeyMap())
+	yMsg := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}}
+
+	// Iterations are identified by their number, tracks and tasks by ID
+	for _, want := range []string{"4", "TM-track-1", "TM-task-12"} {
+		_, cmd := presenter.Update(yMsg)
+		if cmd == nil {
+			t.Fatalf("Expected command from y key for %s, got nil", want)
+		}
+		copyMsg, ok := cmd().(presenters.CopyIDMsg)
+		if !ok {
+			t.Fatalf("Expected CopyIDMsg, got %T", cmd())
+		}
+		if copyMsg.ID != want {
+			t.Errorf("Expected ID %q, got %q", want, copyMsg.ID)
+		}
+		presenter.Update(tea.KeyMsg{Type: tea.KeyDown})
+	}
+}
+
+// activeRoadmapRepository is a minimal repository fake recording SetActiveRoadmap calls
+type activeRoadmapRepository struct {
+	domain.RoadmapRepository
+	activated []string
+}
+
+func (r *activeRoadmapRepository) SetActiveRoadmap(ctx context.Context, id string) error {
+	r.activated = append(r.activated, id)
+	return nil
+}
+
+func TestRoadmapListPresenter_SwitchRoadmapKey(t *testing.T) {
+	vm := &viewmodels.RoadmapListViewModel{
+		RoadmapID: "roadmap-3",
+		Roadmaps: []*viewmodels.RoadmapOptionViewModel{
+			{ID: "roadmap-1"},
+			{ID: "roadmap-3", Active: true},
+		},
+	}
+	repo := &activeRoadmapRepository{}
+	presenter := presenters.NewRoadmapListPresenter(vm, repo, context.Background(), components.DefaultKeyMap())
+
+	if view := presenter.View(); !strings.Contains(view, "roadmap 2/2: roadmap-3") {
+		t.Errorf("Expected the active roadmap in the title, got:\n%s", view)
+	}
+
+	// R wraps around to the first roadmap
+	_, cmd := presenter.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'R'}})
+	if cmd == nil {
+		t.Fatal("Expected command from R key, got nil")
+	}
+	switched, ok := cmd().(presenters.RoadmapSwitchedMsg)
+	if !ok {
+		t.Fatalf("Expected RoadmapSwitchedMsg, got %T", cmd())
+	}
+	if switched.RoadmapID != "roadmap-1" || len(repo.activated) != 1 || repo.activated[0] != "roadmap-1" {
+		t.Errorf("Expected roadmap-1 to be activated, got %q (activated %v)", switched.RoadmapID, repo.activated)
+	}
+
+	// With a single roadmap there is nothing to switch to
+	single := presenters.NewRoadmapListPresenter(&viewmodels.RoadmapListViewModel{
+		RoadmapID: "roadmap-1",
+		Roadmaps:  []*viewmodels.RoadmapOptionViewModel{{ID: "roadmap-1", Active: true}},
+	}, repo, context.Background(), components.DefaultKeyMap())
+	if _, cmd := single.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'R'}}); cmd != nil {
+		t.Error("Expected no command from R key with a single roadmap")
+	}
+	if view := single.View(); strings.Contains(view, "R to switch") {
+		t.Errorf("Expected no roadmap switcher with a single roadmap, got:\n%s", view)
+	}
+}
+
+// reorderRepository is a minimal repository fake for reorder tests.
+// Only GetIteration and UpdateIteration are implemented.
+type reorderRepository struct {
+	domain.RoadmapRepository
+	ranks   map[int]float64
+	updates int
+}
+
+func (r *reorderRepository) GetIteration(ctx context.Context, number int) (*entities.IterationEntity, error) {
+	return &entities.IterationEntity{Number: number, Rank: r.ranks[number]}, nil
+}
+
+func (r *reorderRepository) UpdateIteration(ctx context.Context, iteration *entities.IterationEntity) error {
+	r.ranks[iteration.Number] = iteration.Rank
+	r.updates++
+	return nil
+}
+
+// persistedOrder returns iteration numbers sorted by persisted rank
+func (r *reorderRepository) persistedOrder() []int {
+	numbers := make([]int, 0, len(r.ranks))
+	for number := range r.ranks {
+		numbers = append(numbers, number)
+	}
+	sort.Slice(numbers, func(i, j int) bool { return r.ranks[numbers[i]] < r.ranks[numbers[j]] })
+	return numbers
+}
+
+func newReorderTestPresenter(repo *reorderRepository) *presenters.RoadmapListPresenter {
+	vm := &viewmodels.RoadmapListViewModel{
+		ActiveIterations: []*viewmodels.IterationCardViewModel{
+			{Number: 1, Name: "Iteration 1"},
+			{Number: 2, Name: "Iteration 2"},
+			{Number: 3, Name: "Iteration 3"},
+			{Number: 4, Name: "Iteration 4"},
+		},
+	}
+	return presenters.NewRoadmapListPresenter(vm, repo, context.Background(), components.DefaultKeyMap())
+}
+
+func TestRoadmapListPresenter_ReorderIsDebounced(t *testing.T) {
+	repo := &reorderRepository{ranks: map[int]float64{1: 1, 2: 2, 3: 3, 4: 4}}
+	presenter := newReorderTestPresenter(repo)
+
+	// Hold J: move iteration 1 to the bottom
+	moveDown := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'J'}}
+	var cmd tea.Cmd
+	for i := 0; i < 3; i++ {
+		_, cmd = presenter.Update(moveDown)
+	}
+
+	if repo.updates != 0 {
+		t.Errorf("Expected no writes while input is settling, got %d", repo.updates)
+	}
+	if !presenter.HasPendingChanges() {
+		t.Error("Expected pending changes after moves")
+	}
+
+	// The last tick fires after input settles and persists the order
+	_, persistCmd := presenter.Update(cmd())
+	if persistCmd == nil {
+		t.Fatal("Expected persist command after input settled")
+	}
+	msg := persistCmd()
+	completed, ok := msg.(presenters.ReorderCompletedMsg)
+	if !ok {
+		t.Fatalf("Expected ReorderCompletedMsg, got %T", msg)
+	}
+	if completed.SelectedIterationNumber != 1 {
+		t.Errorf("Expected selection to follow iteration 1, got %d", completed.SelectedIterationNumber)
+	}
+
+	want := []int{2, 3, 4, 1}
+	got := repo.persistedOrder()
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("Expected persisted order %v, got %v", want, got)
+		}
+	}
+	// Every iteration shifted, so each rank is rewritten exactly once
+	if repo.updates != 4 {
+		t.Errorf("Expected 4 writes, got %d", repo.updates)
+	}
+	if presenter.HasPendingChanges() {
+		t.Error("Expected no pending changes after persisting")
+	}
+}
+
+func TestRoadmapListPresenter_QuitFlushesPendingReorder(t *testing.T) {
+	repo := &reorderRepository{ranks: map[int]float64{1: 1, 2: 2, 3: 3, 4: 4}}
+	presenter := newReorderTestPresenter(repo)
+
+	presenter.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'J'}})
+
+	// Quit before the debounce tick fires
+	presenter.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'q'}})
+
+	if presenter.HasPendingChanges() {
+		t.Error("Expected pending reorder to be flushed on quit")
+	}
+	want := []int{2, 1, 3, 4}
+	got := repo.persistedOrder()
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("Expected persisted order %v, got %v", want, got)
+		}
+	}
+	// Only the two swapped iterations are written
+	if repo.updates != 2 {
+		t.Errorf("Expected 2 writes, got %d", repo.updates)
+	}
+}
+
+func TestRoadmapListPresenter_FlushPendingChanges_NoPending(t *testing.T) {
+	repo := &reorderRepository{ranks: map[int]float64{1: 1, 2: 2, 3: 3, 4: 4}}
+	presenter := newReorderTestPresenter(repo)
+
+	if err := presenter.FlushPendingChanges(); err != nil {
+		t.Fatalf("FlushPendingChanges failed: %v", err)
+	}
+	if repo.updates != 0 {
+		t.Errorf("Expected no writes without pending changes, got %d", repo.updates)
+	}
+}
+
+// noQueryRepository fails the test on any repository call: rendering must only use the view model
+type noQueryRepository struct {
+	domain.RoadmapRepository
+}
+
+// largeRoadmapViewModel builds a dashboard with many iterations, tracks and backlog tasks
+func largeRoadmapViewModel() *viewmodels.RoadmapListViewModel {
+	vm := &viewmodels.RoadmapListViewModel{Vision: "Ship the roadmap", SuccessCriteria: "Everything done"}
+	for n := 1; n <= 20; n++ {
+		vm.ActiveIterations = append(vm.ActiveIterations, &viewmodels.IterationCardViewModel{Number: n, Name: fmt.Sprintf("Sprint %d", n), Status: "planned"})
+	}
+	for n := 1; n <= 50; n++ {
+		vm.ActiveTracks = append(vm.ActiveTracks, &viewmodels.TrackCardViewModel{ID: fmt.Sprintf("TM-track-%d", n), Title: "Track", Status: "in-progress"})
+	}
+	for n := 1; n <= 2000; n++ {
+		vm.BacklogTasks = append(vm.BacklogTasks, &viewmodels.BacklogTaskViewModel{
+			ID: fmt.Sprintf("TM-task-%d", n), Title: "Task", Status: "todo", TrackID: fmt.Sprintf("TM-track-%d", n%50+1),
+		})
+	}
+	return vm
+}
+
+func TestRoadmapListPresenter_ViewIsCached(t *testing.T) {
+	presenter := presenters.NewRoadmapListPresenter(largeRoadmapViewModel(), &noQueryRepository{}, context.Background(), components.DefaultKeyMap())
+	presenter.Update(tea.WindowSizeMsg{Width: 100, Height: 30})
+
+	first := presenter.View()
+	if again := presenter.View(); again != first {
+		t.Error("expected an unchanged dashboard to render the same content")
+	}
+
+	// Scrolling, window size and data changes invalidate the cache
+	for i := 0; i < 40; i++ {
+		presenter.Update(tea.KeyMsg{Type: tea.KeyDown})
+	}
+	scrolled := presenter.View()
+	if scrolled == first {
+		t.Error("expected scrolling to re-render")
+	}
+
+	presenter.Update(tea.WindowSizeMsg{Width: 100, Height: 60})
+	if presenter.View() == scrolled {
+		t.Error("expected resizing to re-render")
+	}
+
+	presenter.FilterBacklogByAssignee("alice")
+	if view := presenter.View(); !strings.Contains(view, "No open tasks assigned to you") {
+		t.Errorf("expected filtering the backlog to re-render, got:\n%s", view)
+	}
+}
+
+// BenchmarkRoadmapListPresenter_View compares re-rendering a large dashboard on every
+// frame with serving unchanged frames from the render cache. Neither queries the repository.
+func BenchmarkRoadmapListPresenter_View(b *testing.B) {
+	newPresenter := func() *presenters.RoadmapListPresenter {
+		presenter := presenters.NewRoadmapListPresenter(largeRoadmapViewModel(), &noQueryRepository{}, context.Background(), components.DefaultKeyMap())
+		presenter.Update(tea.WindowSizeMsg{Width: 120, Height: 60})
+		return presenter
+	}
+
+	b.Run("rerender", func(b *testing.B) {
+		presenter := newPresenter()
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			// Toggling help changes the render key, forcing a full render each frame
+			presenter.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'?'}})
+			_ = presenter.View()
+		}
+	})
+
+	b.Run("cached", func(b *testing.B) {
+		presenter := newPresenter()
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = presenter.View()
+		}
+	})
+}
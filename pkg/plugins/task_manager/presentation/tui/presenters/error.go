@@ -20,12 +20,12 @@ type ErrorPresenter struct {
 }
 
 // NewErrorPresenter creates a new error presenter
-func NewErrorPresenter(vm *viewmodels.ErrorViewModel) *ErrorPresenter {
+func NewErrorPresenter(vm *viewmodels.ErrorViewModel, keymap components.KeyMap) *ErrorPresenter {
 	return &ErrorPresenter{
 		viewModel: vm,
 		help:      components.NewHelp(),
-		quitKey:   components.NewQuitKey(),
-		backKey:   components.NewBackKey(),
+		quitKey:   components.NewQuitKey(keymap),
+		backKey:   components.NewBackKey(keymap),
 		width:     80, // default width
 	}
 }
@@ -1,6 +1,9 @@
 package presenters
 
 import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/presentation/tui/components"
 )
@@ -28,3 +31,48 @@ func getStatusStyle(colorName string) lipgloss.Style {
 		return lipgloss.NewStyle()
 	}
 }
+
+// assigneeSuffix returns " @who" for an assigned task, or "" if it is unassigned
+func assigneeSuffix(assignee string) string {
+	if assignee == "" {
+		return ""
+	}
+	return " " + components.Styles.MetadataStyle.Render("@"+assignee)
+}
+
+// acProgressSuffix returns " [2/3 ✓]" for a task with ACs, followed by a ✗ marker if any
+// of them failed, or "" if the task has no ACs
+func acProgressSuffix(progress string, failed bool) string {
+	if progress == "" {
+		return ""
+	}
+	suffix := " " + components.Styles.MetadataStyle.Render("["+progress+"]")
+	if failed {
+		suffix += " " + getStatusStyle("error").Render("✗")
+	}
+	return suffix
+}
+
+// renderProgressBar renders a fixed-width text progress bar for a ratio between 0 and 1
+func renderProgressBar(percent float64, width int) string {
+	if percent < 0 {
+		percent = 0
+	}
+	if percent > 1 {
+		percent = 1
+	}
+	filled := int(percent*float64(width) + 0.5)
+	bar := components.Styles.ProgressStyle.Render(strings.Repeat("█", filled))
+	return bar + components.Styles.MetadataStyle.Render(strings.Repeat("░", width-filled))
+}
+
+// copyID returns a command asking the app to copy an ID to the clipboard.
+// Returns nil when there is nothing selected to copy.
+func copyID(id string) tea.Cmd {
+	if id == "" {
+		return nil
+	}
+	return func() tea.Msg {
+		return CopyIDMsg{ID: id}
+	}
+}
@@ -3,6 +3,7 @@ package presenters
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -37,21 +38,28 @@ type IterationDetailKeyMap struct {
 	Fail     key.Binding // f - fail AC
 	PageUp   key.Binding // pgup/b - page up
 	PageDown key.Binding // pgdn - page down
+	// Content scrolling
+	ScrollUp   key.Binding // ctrl+u - scroll the content up
+	ScrollDown key.Binding // ctrl+d - scroll the content down
 	// Task state transitions
 	InProgress key.Binding // i - todo → in-progress
 	Review     key.Binding // r - in-progress → review
 	Done       key.Binding // d - review → done (with AC verification)
 	Reopen     key.Binding // o - done → todo
+	Edit       key.Binding // e - edit iteration name/goal/deliverable
+	CopyID     key.Binding // y - copy selected task/AC ID
+	CopyViewID key.Binding // Y - copy iteration number
+	GoTo       key.Binding // : - open an entity by ID (handled by the app)
 }
 
-// NewIterationDetailKeyMap creates default keybindings for iteration detail
-func NewIterationDetailKeyMap() IterationDetailKeyMap {
+// NewIterationDetailKeyMap creates keybindings for iteration detail, binding the remappable actions as keymap does
+func NewIterationDetailKeyMap(keymap components.KeyMap) IterationDetailKeyMap {
 	return IterationDetailKeyMap{
-		Up:    components.NewUpKey(),
-		Down:  components.NewDownKey(),
-		Enter: components.NewEnterKey(),
-		Quit:  components.NewQuitKey(),
-		Back:  components.NewBackKey(),
+		Up:    components.NewUpKey(keymap),
+		Down:  components.NewDownKey(keymap),
+		Enter: components.NewEnterKey(keymap),
+		Quit:  components.NewQuitKey(keymap),
+		Back:  components.NewBackKey(keymap),
 		Help:  components.NewHelpKey(),
 		Tab: key.NewBinding(
 			key.WithKeys("tab"),
@@ -93,13 +101,22 @@ func NewIterationDetailKeyMap() IterationDetailKeyMap {
 			key.WithKeys("o"),
 			key.WithHelp("o", "reopen"),
 		),
+		Edit: key.NewBinding(
+			key.WithKeys("e"),
+			key.WithHelp("e", "edit iteration"),
+		),
+		ScrollUp:   components.NewScrollUpKey(),
+		ScrollDown: components.NewScrollDownKey(),
+		CopyID:     components.NewCopyIDKey(),
+		CopyViewID: components.NewCopyViewIDKey(),
+		GoTo:       components.NewGoToKey(),
 	}
 }
 
 // ShortHelp returns keybindings based on active tab
 func (k IterationDetailKeyMap) ShortHelp(activeTab IterationDetailTab) []key.Binding {
 	if activeTab == IterationDetailTabTasks {
-		return []key.Binding{k.Up, k.Down, k.Enter, k.InProgress, k.Review, k.Done, k.Tab, k.Back, k.Quit}
+		return []key.Binding{k.Up, k.Down, k.Enter, k.InProgress, k.Review, k.Done, k.Edit, k.Tab, k.Back, k.Quit}
 	}
 	// ACs view
 	return []key.Binding{k.Up, k.Down, k.Enter, k.Verify, k.Skip, k.Fail, k.Tab, k.Back, k.Quit}
@@ -111,16 +128,20 @@ func (k IterationDetailKeyMap) FullHelp(activeTab IterationDetailTab) [][]key.Bi
 		return [][]key.Binding{
 			{k.Up, k.Down, k.Enter},
 			{k.PageUp, k.PageDown},
+			{k.ScrollUp, k.ScrollDown},
 			{k.InProgress, k.Review, k.Done, k.Reopen},
-			{k.Tab, k.Back, k.Help, k.Quit},
+			{k.CopyID, k.CopyViewID, k.GoTo},
+			{k.Edit, k.Tab, k.Back, k.Help, k.Quit},
 		}
 	}
 	// ACs view
 	return [][]key.Binding{
 		{k.Up, k.Down, k.Enter},
 		{k.PageUp, k.PageDown},
+		{k.ScrollUp, k.ScrollDown},
 		{k.Verify, k.Skip, k.Fail},
-		{k.Tab, k.Back, k.Help, k.Quit},
+		{k.CopyID, k.CopyViewID, k.GoTo},
+		{k.Edit, k.Tab, k.Back, k.Help, k.Quit},
 	}
 }
 
@@ -137,34 +158,38 @@ type IterationDetailPresenter struct {
 	repo            domain.RoadmapRepository
 	ctx             context.Context
 	acListComponent *ACListComponent
+	editForm        *IterationEditFormComponent
 
 	// Scrolling support
 	scrollHelperTasks *components.ScrollHelper          // For tasks tab (single-line)
 	scrollHelperACs   *components.ScrollHelperMultiline // For ACs tab (multi-line with expansion)
 	terminalHeight    int
+	scrollView        *components.ScrollView // Whole content above the help footer
+	focusLine         int                    // Content line of the selected task or AC, set by View
 }
 
-func NewIterationDetailPresenter(vm *viewmodels.IterationDetailViewModel, repo domain.RoadmapRepository, ctx context.Context) *IterationDetailPresenter {
-	return NewIterationDetailPresenterWithTab(vm, repo, ctx, IterationDetailTabTasks)
+func NewIterationDetailPresenter(vm *viewmodels.IterationDetailViewModel, repo domain.RoadmapRepository, ctx context.Context, keymap components.KeyMap) *IterationDetailPresenter {
+	return NewIterationDetailPresenterWithTab(vm, repo, ctx, keymap, IterationDetailTabTasks)
 }
 
 // NewIterationDetailPresenterWithTab creates a new iteration detail presenter with a specific active tab
-func NewIterationDetailPresenterWithTab(vm *viewmodels.IterationDetailViewModel, repo domain.RoadmapRepository, ctx context.Context, activeTab IterationDetailTab) *IterationDetailPresenter {
-	return NewIterationDetailPresenterWithSelection(vm, repo, ctx, activeTab, 0)
+func NewIterationDetailPresenterWithTab(vm *viewmodels.IterationDetailViewModel, repo domain.RoadmapRepository, ctx context.Context, keymap components.KeyMap, activeTab IterationDetailTab) *IterationDetailPresenter {
+	return NewIterationDetailPresenterWithSelection(vm, repo, ctx, keymap, activeTab, 0)
 }
 
 // NewIterationDetailPresenterWithSelection creates a new iteration detail presenter with a specific active tab and selected index
-func NewIterationDetailPresenterWithSelection(vm *viewmodels.IterationDetailViewModel, repo domain.RoadmapRepository, ctx context.Context, activeTab IterationDetailTab, selectedIndex int) *IterationDetailPresenter {
-	return &IterationDetailPresenter{
+func NewIterationDetailPresenterWithSelection(vm *viewmodels.IterationDetailViewModel, repo domain.RoadmapRepository, ctx context.Context, keymap components.KeyMap, activeTab IterationDetailTab, selectedIndex int) *IterationDetailPresenter {
+	p := &IterationDetailPresenter{
 		viewModel:       vm,
 		help:            components.NewHelp(),
-		keys:            NewIterationDetailKeyMap(),
+		keys:            NewIterationDetailKeyMap(keymap),
 		showFullHelp:    false,
 		activeTab:       activeTab,
 		selectedIndex:   selectedIndex,
 		repo:            repo,
 		ctx:             ctx,
 		acListComponent: NewACListComponent(repo, ctx, true), // enableExpand=true (same behavior as task detail)
+		editForm:        NewIterationEditFormComponent(),
 		width:           80,                                    // Default width until WindowSizeMsg arrives
 		height:          24,
 
@@ -172,11 +197,21 @@ func NewIterationDetailPresenterWithSelection(vm *viewmodels.IterationDetailView
 		scrollHelperTasks: components.NewScrollHelper(),
 		scrollHelperACs:   components.NewScrollHelperMultiline(),
 		terminalHeight:    24,
+		scrollView:        components.NewScrollView(),
 	}
+	// The list may have shrunk since the index was captured (e.g. a reload after
+	// an AC was deleted elsewhere), so keep the selection within bounds
+	p.clampSelectedIndex()
+	return p
 }
 
 
 
+// CapturingTextInput reports whether the edit form or AC feedback input is open
+func (p *IterationDetailPresenter) CapturingTextInput() bool {
+	return p.editForm.IsActive() || p.acListComponent.IsFeedbackActive()
+}
+
 func (p *IterationDetailPresenter) Init() tea.Cmd {
 	// Request terminal size immediately to get actual dimensions
 	return tea.WindowSize()
@@ -189,10 +224,12 @@ func (p *IterationDetailPresenter) Update(msg tea.Msg) (Presenter, tea.Cmd) {
 		p.height = msg.Height
 		p.terminalHeight = msg.Height
 		p.help.SetWidth(msg.Width)
+		p.scrollView.SetSize(msg.Width, msg.Height)
 
 		// Calculate available viewport height for scrolling
 		// Account for: title (1) + metadata (4-5) + progress (1) + tab headers (2) + help (2)
-		headerHeight := 11
+		// + definition of done checklist (if any)
+		headerHeight := 11 + p.dodHeight()
 		footerHeight := 2 // Help text
 		availableHeight := msg.Height - headerHeight - footerHeight
 		if availableHeight < 5 {
@@ -212,6 +249,16 @@ func (p *IterationDetailPresenter) Update(msg tea.Msg) (Presenter, tea.Cmd) {
 		}
 
 	case tea.KeyMsg:
+		// Edit form captures all keys while open
+		if handled, submit, cmd := p.editForm.Update(msg); handled {
+			if submit {
+				name, goal, deliverable := p.editForm.Values()
+				p.editForm.Cancel()
+				return p, p.updateIterationDetails(name, goal, deliverable, p.activeTab, p.selectedIndex)
+			}
+			return p, cmd
+		}
+
 		// Component handles feedback input if active
 		if handled, cmd := p.acListComponent.UpdateFeedback(msg); handled {
 			// Check if Enter was pressed (submit)
@@ -234,6 +281,8 @@ func (p *IterationDetailPresenter) Update(msg tea.Msg) (Presenter, tea.Cmd) {
 			return p, func() tea.Msg { return BackMsgNew{} }
 		case key.Matches(msg, p.keys.Help):
 			p.showFullHelp = !p.showFullHelp
+		case key.Matches(msg, p.keys.Edit):
+			return p, p.editForm.Start(p.viewModel.Name, p.viewModel.Goal, p.viewModel.Deliverable)
 		case key.Matches(msg, p.keys.Tab):
 			// Switch between tasks and ACs
 			if p.activeTab == IterationDetailTabTasks {
@@ -280,6 +329,10 @@ func (p *IterationDetailPresenter) Update(msg tea.Msg) (Presenter, tea.Cmd) {
 				newIndex := p.scrollHelperTasks.PageDown(totalTasks, p.selectedIndex)
 				p.selectedIndex = newIndex
 			}
+		case key.Matches(msg, p.keys.ScrollUp):
+			p.scrollView.ScrollUp()
+		case key.Matches(msg, p.keys.ScrollDown):
+			p.scrollView.ScrollDown()
 		case key.Matches(msg, p.keys.Enter):
 			if p.activeTab == IterationDetailTabTasks {
 				// Navigate to task detail
@@ -291,20 +344,12 @@ func (p *IterationDetailPresenter) Update(msg tea.Msg) (Presenter, tea.Cmd) {
 				}
 			} else if p.activeTab == IterationDetailTabACs {
 				// Expand/collapse AC testing instructions (same as TaskDetail)
-				acID := p.getSelectedACID()
-				if acID != "" {
-					// Find and toggle the AC in viewModel
-					for _, group := range p.viewModel.TaskACs {
-						for _, ac := range group.ACs {
-							if ac.ID == acID {
-								ac.IsExpanded = !ac.IsExpanded
-								// Recalculate line counts with new expansion state
-								lineCounts := p.calculateACLineCounts()
-								p.scrollHelperACs.EnsureVisibleMultiline(lineCounts, p.selectedIndex)
-								return p, nil
-							}
-						}
-					}
+				if ac := p.getSelectedAC(); ac != nil {
+					ac.IsExpanded = !ac.IsExpanded
+					// Recalculate line counts with new expansion state
+					lineCounts := p.calculateACLineCounts()
+					p.scrollHelperACs.EnsureVisibleMultiline(lineCounts, p.selectedIndex)
+					return p, nil
 				}
 			}
 		case key.Matches(msg, p.keys.Verify):
@@ -356,14 +401,31 @@ func (p *IterationDetailPresenter) Update(msg tea.Msg) (Presenter, tea.Cmd) {
 					return p, p.transitionTaskStatus(task.ID, "todo", p.activeTab, p.selectedIndex)
 				}
 			}
+		case key.Matches(msg, p.keys.CopyID):
+			if p.activeTab == IterationDetailTabTasks {
+				return p, copyID(p.getSelectedTaskID())
+			}
+			return p, copyID(p.getSelectedACID())
+		case key.Matches(msg, p.keys.CopyViewID):
+			return p, copyID(strconv.Itoa(p.viewModel.Number))
 		}
 	}
 
 	return p, nil
 }
 
+// dodHeight returns the number of lines the definition of done checklist takes in the header
+func (p *IterationDetailPresenter) dodHeight() int {
+	if p.viewModel.DoDProgress == nil {
+		return 0
+	}
+	// Section title + progress bar + items + blank line
+	return 3 + len(p.viewModel.DoDItems)
+}
+
 func (p *IterationDetailPresenter) View() string {
 	var b strings.Builder
+	p.focusLine = -1
 
 	// Title
 	b.WriteString(components.Styles.TitleStyle.Render(fmt.Sprintf("Iteration #%d: %s", p.viewModel.Number, p.viewModel.Name)))
@@ -403,13 +465,33 @@ func (p *IterationDetailPresenter) View() string {
 	b.WriteString("\n")
 
 	// Progress bar
-	progressText := fmt.Sprintf("Progress: %d/%d tasks (%.0f%%)",
+	progressText := fmt.Sprintf("Progress (%s): %d/%d (%.0f%%)",
+		p.viewModel.ProgressLabel,
 		p.viewModel.Progress.Completed,
 		p.viewModel.Progress.Total,
 		p.viewModel.Progress.Percent*100)
 	b.WriteString(components.Styles.ProgressStyle.Render(progressText))
 	b.WriteString("\n\n")
 
+	// Definition of done checklist
+	if progress := p.viewModel.DoDProgress; progress != nil {
+		b.WriteString(components.Styles.SectionStyle.Render(
+			fmt.Sprintf("Definition of Done: %d/%d done", progress.Completed, progress.Total)))
+		b.WriteString("\n  ")
+		b.WriteString(renderProgressBar(progress.Percent, 30))
+		b.WriteString(fmt.Sprintf(" %.0f%%", progress.Percent*100))
+		b.WriteString("\n")
+		for _, item := range p.viewModel.DoDItems {
+			mark := " "
+			if item.Done {
+				mark = "x"
+			}
+			b.WriteString(components.Styles.MetadataStyle.Render(fmt.Sprintf("  [%s] %s", mark, item.Text)))
+			b.WriteString("\n")
+		}
+		b.WriteString("\n")
+	}
+
 	// Tab headers
 	if p.activeTab == IterationDetailTabTasks {
 		b.WriteString(components.Styles.ActiveTabStyle.Render("Tasks"))
@@ -429,38 +511,69 @@ func (p *IterationDetailPresenter) View() string {
 		p.renderACsView(&b)
 	}
 
-	// Feedback input component renders inline at bottom if active
-	feedbackView := p.acListComponent.ViewFeedback(p.width)
-	if feedbackView != "" {
-		b.WriteString(feedbackView)
-		return b.String()
+	// The edit form or feedback input renders inline at the bottom if active, otherwise
+	// help; either stays pinned below the scrolling content
+	footer := p.editForm.View(p.width)
+	if footer == "" {
+		footer = p.acListComponent.ViewFeedback(p.width)
 	}
-
-	// Help view
-	b.WriteString("\n")
-	if p.showFullHelp {
-		b.WriteString(p.help.FullHelpView(p.keys.FullHelp(p.activeTab)))
-	} else {
-		b.WriteString(p.help.ShortHelpView(p.keys.ShortHelp(p.activeTab)))
+	if footer == "" {
+		b.WriteString("\n")
+		if p.showFullHelp {
+			footer = p.help.FullHelpView(p.keys.FullHelp(p.activeTab))
+		} else {
+			footer = p.help.ShortHelpView(p.keys.ShortHelp(p.activeTab))
+		}
 	}
 
-	return b.String()
+	return p.scrollView.Render(b.String(), footer, p.focusLine)
+}
+
+// ScrollOffset returns the first visible line of the content
+func (p *IterationDetailPresenter) ScrollOffset() int {
+	return p.scrollView.Offset()
+}
+
+// SetScrollOffset scrolls the content to offset, e.g. when returning to the view
+func (p *IterationDetailPresenter) SetScrollOffset(offset int) {
+	p.scrollView.SetOffset(offset)
+}
+
+// displayedAC is an AC of the ACs tab together with the task it is listed under
+type displayedAC struct {
+	ac   *viewmodels.IterationACViewModel
+	task *viewmodels.TaskRowViewModel
+}
+
+// displayedACs returns the ACs of the ACs tab in display order. selectedIndex on the
+// ACs tab indexes this slice, so rendering, navigation and the AC actions must all
+// go through it. Tasks without ACs contribute no entries.
+func (p *IterationDetailPresenter) displayedACs() []displayedAC {
+	acs := make([]displayedAC, 0)
+	for _, group := range p.viewModel.TaskACs {
+		if group == nil || group.Task == nil {
+			continue
+		}
+		for _, ac := range group.ACs {
+			acs = append(acs, displayedAC{ac: ac, task: group.Task})
+		}
+	}
+	return acs
 }
 
 // calculateACLineCounts returns the line count for each AC based on expansion state
 // Collapsed AC = 1 line, Expanded AC = header + testing instruction lines
 func (p *IterationDetailPresenter) calculateACLineCounts() []int {
-	lineCounts := make([]int, 0)
-	for _, group := range p.viewModel.TaskACs {
-		for _, ac := range group.ACs {
-			if ac.IsExpanded && ac.TestingInstructions != "" {
-				// Count lines in testing instructions + header + spacing
-				lines := strings.Count(ac.TestingInstructions, "\n") + 3 // +3 for header, content, spacing
-				lineCounts = append(lineCounts, lines)
-			} else {
-				// Collapsed AC is 1 line
-				lineCounts = append(lineCounts, 1)
-			}
+	acs := p.displayedACs()
+	lineCounts := make([]int, 0, len(acs))
+	for _, item := range acs {
+		if item.ac.IsExpanded && item.ac.TestingInstructions != "" {
+			// Count lines in testing instructions + header + spacing
+			lines := strings.Count(item.ac.TestingInstructions, "\n") + 3 // +3 for header, content, spacing
+			lineCounts = append(lineCounts, lines)
+		} else {
+			// Collapsed AC is 1 line
+			lineCounts = append(lineCounts, 1)
 		}
 	}
 	return lineCounts
@@ -514,13 +627,18 @@ func (p *IterationDetailPresenter) renderTasksView(b *strings.Builder) {
 			b.WriteString("\n")
 		}
 
-		// Render task with colored status
+		// Render task with colored status; blocked tasks name the ACs they wait on
 		statusText := getStatusStyle(item.task.StatusColor).Render(item.task.Status)
+		if item.task.WaitingOn != "" {
+			statusText += " " + getStatusStyle("error").Render("("+item.task.WaitingOn+")")
+		}
+		statusText += acProgressSuffix(item.task.ACProgress, item.task.HasFailedACs)
 		var output string
 		if i == p.selectedIndex {
-			output = components.Styles.SelectedStyle.Render(fmt.Sprintf("  %s: %s - %s", item.task.ID, item.task.Title, statusText))
+			p.focusLine = strings.Count(b.String(), "\n")
+			output = components.Styles.SelectedStyle.Render(fmt.Sprintf("  %s: %s - %s%s", item.task.ID, item.task.Title, statusText, assigneeSuffix(item.task.Assignee)))
 		} else {
-			output = fmt.Sprintf("  %s: %s - %s", item.task.ID, item.task.Title, statusText)
+			output = fmt.Sprintf("  %s: %s - %s%s", item.task.ID, item.task.Title, statusText, assigneeSuffix(item.task.Assignee))
 		}
 		b.WriteString(output)
 		b.WriteString("\n")
@@ -534,31 +652,7 @@ func (p *IterationDetailPresenter) renderTasksView(b *strings.Builder) {
 }
 
 func (p *IterationDetailPresenter) renderACsView(b *strings.Builder) {
-	if len(p.viewModel.TaskACs) == 0 {
-		b.WriteString(components.Styles.MetadataStyle.Render("No acceptance criteria"))
-		return
-	}
-
-	// Build flat AC list with task context
-	type acItem struct {
-		ac             *viewmodels.IterationACViewModel
-		taskID         string
-		taskTitle      string
-		isFirstInGroup bool
-	}
-	allACs := make([]acItem, 0)
-
-	for _, group := range p.viewModel.TaskACs {
-		for i, ac := range group.ACs {
-			allACs = append(allACs, acItem{
-				ac:             ac,
-				taskID:         group.Task.ID,
-				taskTitle:      group.Task.Title,
-				isFirstInGroup: i == 0,
-			})
-		}
-	}
-
+	allACs := p.displayedACs()
 	if len(allACs) == 0 {
 		b.WriteString(components.Styles.MetadataStyle.Render("No acceptance criteria"))
 		return
@@ -576,15 +670,14 @@ func (p *IterationDetailPresenter) renderACsView(b *strings.Builder) {
 
 	// Render visible ACs: group by task and use ACListComponent for AC rendering
 	currentTaskID := ""
-	acIndex := 0 // Track index within the visible AC section (not global)
 
 	for i := firstItem; i <= lastItem && i < len(allACs); i++ {
 		item := allACs[i]
 
 		// Render task header if new task group
-		if item.taskID != currentTaskID {
-			currentTaskID = item.taskID
-			b.WriteString(components.Styles.SectionStyle.Render(fmt.Sprintf("Task: %s - %s", item.taskID, item.taskTitle)))
+		if item.task.ID != currentTaskID {
+			currentTaskID = item.task.ID
+			b.WriteString(components.Styles.SectionStyle.Render(fmt.Sprintf("Task: %s - %s", item.task.ID, item.task.Title)))
 			b.WriteString("\n")
 		}
 
@@ -603,6 +696,7 @@ func (p *IterationDetailPresenter) renderACsView(b *strings.Builder) {
 			isSelected := i == p.selectedIndex
 			selectedIndexInList := -1
 			if isSelected {
+				p.focusLine = strings.Count(b.String(), "\n")
 				selectedIndexInList = 0
 			}
 			p.acListComponent.RenderACList(b, singleACList, selectedIndexInList, p.width)
@@ -615,8 +709,6 @@ func (p *IterationDetailPresenter) renderACsView(b *strings.Builder) {
 				}
 			}
 		}
-
-		acIndex++
 	}
 
 	// Scroll indicator (below)
@@ -626,6 +718,16 @@ func (p *IterationDetailPresenter) renderACsView(b *strings.Builder) {
 	}
 }
 
+// clampSelectedIndex keeps selectedIndex within the items of the active tab
+func (p *IterationDetailPresenter) clampSelectedIndex() {
+	if maxIndex := p.getMaxIndex(); p.selectedIndex > maxIndex {
+		p.selectedIndex = maxIndex
+	}
+	if p.selectedIndex < 0 {
+		p.selectedIndex = 0
+	}
+}
+
 func (p *IterationDetailPresenter) getMaxIndex() int {
 	if p.activeTab == IterationDetailTabTasks {
 		return len(p.viewModel.TODOTasks) +
@@ -633,12 +735,7 @@ func (p *IterationDetailPresenter) getMaxIndex() int {
 			len(p.viewModel.ReviewTasks) +
 			len(p.viewModel.DoneTasks) - 1
 	}
-	// ACs view - count total ACs across all task groups
-	totalACs := 0
-	for _, group := range p.viewModel.TaskACs {
-		totalACs += len(group.ACs)
-	}
-	return totalACs - 1
+	return len(p.displayedACs()) - 1
 }
 
 // getSelectedTaskID returns the task ID of the currently selected task
@@ -648,6 +745,9 @@ func (p *IterationDetailPresenter) getSelectedTaskID() string {
 	}
 
 	index := p.selectedIndex
+	if index < 0 {
+		return ""
+	}
 	todoLen := len(p.viewModel.TODOTasks)
 	inProgressLen := len(p.viewModel.InProgressTasks)
 	reviewLen := len(p.viewModel.ReviewTasks)
@@ -681,6 +781,9 @@ func (p *IterationDetailPresenter) getSelectedTask() *viewmodels.TaskRowViewMode
 	}
 
 	index := p.selectedIndex
+	if index < 0 {
+		return nil
+	}
 	todoLen := len(p.viewModel.TODOTasks)
 	inProgressLen := len(p.viewModel.InProgressTasks)
 	reviewLen := len(p.viewModel.ReviewTasks)
@@ -707,21 +810,23 @@ func (p *IterationDetailPresenter) getSelectedTask() *viewmodels.TaskRowViewMode
 	return nil
 }
 
-// getSelectedACID returns the AC ID of the currently selected AC from grouped ACs
-func (p *IterationDetailPresenter) getSelectedACID() string {
+// getSelectedAC returns the AC highlighted on the ACs tab, or nil
+func (p *IterationDetailPresenter) getSelectedAC() *viewmodels.IterationACViewModel {
 	if p.activeTab != IterationDetailTabACs {
-		return ""
+		return nil
 	}
-
-	// Navigate through grouped ACs to find the AC at selectedIndex
-	index := p.selectedIndex
-	for _, group := range p.viewModel.TaskACs {
-		if index < len(group.ACs) {
-			return group.ACs[index].ID
-		}
-		index -= len(group.ACs)
+	acs := p.displayedACs()
+	if p.selectedIndex < 0 || p.selectedIndex >= len(acs) {
+		return nil
 	}
+	return acs[p.selectedIndex].ac
+}
 
+// getSelectedACID returns the ID of the AC highlighted on the ACs tab
+func (p *IterationDetailPresenter) getSelectedACID() string {
+	if ac := p.getSelectedAC(); ac != nil {
+		return ac.ID
+	}
 	return ""
 }
 
@@ -748,6 +853,29 @@ func (p *IterationDetailPresenter) transitionTaskStatus(taskID, newStatus string
 	}
 }
 
+// updateIterationDetails saves the edited name, goal and deliverable.
+// The stored iteration is reloaded first so task associations, rank and status are kept.
+func (p *IterationDetailPresenter) updateIterationDetails(name, goal, deliverable string, activeTab IterationDetailTab, currentSelectedIndex int) tea.Cmd {
+	number := p.viewModel.Number
+	return func() tea.Msg {
+		iteration, err := p.repo.GetIteration(p.ctx, number)
+		if err != nil {
+			return ErrorMsg{Err: fmt.Errorf("failed to get iteration: %w", err)}
+		}
+
+		iteration.Name = name
+		iteration.Goal = goal
+		iteration.Deliverable = deliverable
+		iteration.UpdatedAt = time.Now()
+
+		if err := p.repo.UpdateIteration(p.ctx, iteration); err != nil {
+			return ErrorMsg{Err: fmt.Errorf("failed to update iteration: %w", err)}
+		}
+
+		return IterationUpdatedMsg{ActiveTab: activeTab, SelectedIndex: currentSelectedIndex}
+	}
+}
+
 // transitionTaskToDone transitions a task to done status with AC verification check
 func (p *IterationDetailPresenter) transitionTaskToDone(taskID string, activeTab IterationDetailTab, currentSelectedIndex int) tea.Cmd {
 	return func() tea.Msg {
@@ -2,16 +2,20 @@ package presenters_test
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/presentation/tui/components"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/presentation/tui/presenters"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/presentation/tui/viewmodels"
 )
 
 func TestIterationDetailKeyMap_TaskTransitionKeysExist(t *testing.T) {
-	keys := presenters.NewIterationDetailKeyMap()
+	keys := presenters.NewIterationDetailKeyMap(components.DefaultKeyMap())
 
 	// Verify InProgress key binding
 	if keys.InProgress.Keys()[0] != "i" {
@@ -35,7 +39,7 @@ func TestIterationDetailKeyMap_TaskTransitionKeysExist(t *testing.T) {
 }
 
 func TestIterationDetailKeyMap_ShortHelpContextAware(t *testing.T) {
-	keys := presenters.NewIterationDetailKeyMap()
+	keys := presenters.NewIterationDetailKeyMap(components.DefaultKeyMap())
 
 	// Tasks tab should show task transition keys
 	tasksHelp := keys.ShortHelp(presenters.IterationDetailTabTasks)
@@ -88,7 +92,7 @@ func TestIterationDetailKeyMap_ShortHelpContextAware(t *testing.T) {
 }
 
 func TestIterationDetailKeyMap_FullHelpContextAware(t *testing.T) {
-	keys := presenters.NewIterationDetailKeyMap()
+	keys := presenters.NewIterationDetailKeyMap(components.DefaultKeyMap())
 
 	// Tasks tab full help should include task transitions
 	tasksFullHelp := keys.FullHelp(presenters.IterationDetailTabTasks)
@@ -145,7 +149,7 @@ func TestIterationDetailPresenter_TaskTransitionOnlyOnTasksTab(t *testing.T) {
 		},
 	}
 
-	presenter := presenters.NewIterationDetailPresenter(vm, nil, context.Background())
+	presenter := presenters.NewIterationDetailPresenter(vm, nil, context.Background(), components.DefaultKeyMap())
 
 	// Press 'i' on Tasks tab - should trigger transition
 	iMsg := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'i'}}
@@ -166,3 +170,213 @@ func TestIterationDetailPresenter_TaskTransitionOnlyOnTasksTab(t *testing.T) {
 	// On ACs tab, 'i' key should not do anything related to task transitions
 	_ = cmdAfterTab // Should be nil or unrelated to task transition
 }
+
+// editIterationRepository is a minimal repository fake for iteration edit tests.
+// Only GetIteration and UpdateIteration are implemented.
+type editIterationRepository struct {
+	domain.RoadmapRepository
+	iteration *entities.IterationEntity
+	updated   *entities.IterationEntity
+}
+
+func (r *editIterationRepository) GetIteration(ctx context.Context, number int) (*entities.IterationEntity, error) {
+	copied := *r.iteration
+	return &copied, nil
+}
+
+func (r *editIterationRepository) UpdateIteration(ctx context.Context, iteration *entities.IterationEntity) error {
+	r.updated = iteration
+	return nil
+}
+
+func newEditTestPresenter(repo *editIterationRepository) *presenters.IterationDetailPresenter {
+	vm := viewmodels.NewIterationDetailViewModel(1, "Sprint 1", "Shp the MVP", "Demo", "current")
+	return presenters.NewIterationDetailPresenter(vm, repo, context.Background(), components.DefaultKeyMap())
+}
+
+func typeText(p presenters.Presenter, text string) presenters.Presenter {
+	for _, r := range text {
+		p, _ = p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	return p
+}
+
+func TestIterationDetailPresenter_EditSavesFieldsAndKeepsTasks(t *testing.T) {
+	repo := &editIterationRepository{iteration: &entities.IterationEntity{
+		Number: 1, Name: "Sprint 1", Goal: "Shp the MVP", Deliverable: "Demo",
+		TaskIDs: []string{"TM-task-1", "TM-task-2"}, Status: "current", Rank: 3,
+	}}
+	var p presenters.Presenter = newEditTestPresenter(repo)
+
+	p, _ = p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}})
+	if !strings.Contains(p.View(), "Edit Iteration") {
+		t.Fatalf("expected edit form after pressing e, got:\n%s", p.View())
+	}
+	if !p.(*presenters.IterationDetailPresenter).CapturingTextInput() {
+		t.Error("expected presenter to capture text input while editing")
+	}
+
+	// Move to the goal field, clear it and type a corrected goal
+	p, _ = p.Update(tea.KeyMsg{Type: tea.KeyTab})
+	for range "Shp the MVP" {
+		p, _ = p.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	}
+	p = typeText(p, "Ship the MVP")
+
+	_, cmd := p.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("expected save command on Enter")
+	}
+	msg := cmd()
+	if _, ok := msg.(presenters.IterationUpdatedMsg); !ok {
+		t.Fatalf("expected IterationUpdatedMsg, got %T", msg)
+	}
+
+	if repo.updated == nil {
+		t.Fatal("expected UpdateIteration to be called")
+	}
+	if repo.updated.Goal != "Ship the MVP" || repo.updated.Name != "Sprint 1" || repo.updated.Deliverable != "Demo" {
+		t.Errorf("unexpected saved fields: name=%q goal=%q deliverable=%q", repo.updated.Name, repo.updated.Goal, repo.updated.Deliverable)
+	}
+	if len(repo.updated.TaskIDs) != 2 || repo.updated.Rank != 3 || repo.updated.Status != "current" {
+		t.Errorf("expected task associations, rank and status to be preserved, got %+v", repo.updated)
+	}
+}
+
+func TestIterationDetailPresenter_EditEscapeCancels(t *testing.T) {
+	repo := &editIterationRepository{iteration: &entities.IterationEntity{Number: 1, Name: "Sprint 1"}}
+	var p presenters.Presenter = newEditTestPresenter(repo)
+
+	p, _ = p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}})
+	p = typeText(p, "xyz")
+	p, cmd := p.Update(tea.KeyMsg{Type: tea.KeyEsc})
+
+	if cmd != nil {
+		t.Error("expected no command when cancelling the edit")
+	}
+	if strings.Contains(p.View(), "Edit Iteration") {
+		t.Error("expected edit form to close on Escape")
+	}
+	if repo.updated != nil {
+		t.Error("expected no save on Escape")
+	}
+}
+
+func TestIterationDetailPresenter_EditRejectsEmptyName(t *testing.T) {
+	repo := &editIterationRepository{iteration: &entities.IterationEntity{Number: 1, Name: "Sprint 1"}}
+	var p presenters.Presenter = newEditTestPresenter(repo)
+
+	p, _ = p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}})
+	for range "Sprint 1" {
+		p, _ = p.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	}
+	p, cmd := p.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	if cmd != nil {
+		t.Error("expected no save command for an empty name")
+	}
+	if !strings.Contains(p.View(), "Name must not be empty") {
+		t.Errorf("expected validation error in view, got:\n%s", p.View())
+	}
+}
+
+// TestIterationDetailPresenter_SelectionOutOfBounds tests that a stale or negative
+// selection (e.g. after a reload shrank the list) never indexes outside the task groups
+func TestIterationDetailPresenter_SelectionOutOfBounds(t *testing.T) {
+	vm := viewmodels.NewIterationDetailViewModel(1, "Test Iteration", "", "", "current")
+	vm.TODOTasks = []*viewmodels.TaskRowViewModel{{ID: "TM-task-1", Title: "Task 1", Status: "todo"}}
+	vm.InProgressTasks = []*viewmodels.TaskRowViewModel{{ID: "TM-task-2", Title: "Task 2", Status: "in-progress"}}
+	vm.TaskACs = []*viewmodels.TaskACGroupViewModel{{
+		Task: &viewmodels.TaskRowViewModel{ID: "TM-task-1", Title: "Task 1"},
+		ACs:  []*viewmodels.IterationACViewModel{{ID: "TM-ac-1", Description: "AC 1", Status: "not_started"}},
+	}}
+
+	for _, tab := range []presenters.IterationDetailTab{presenters.IterationDetailTabTasks, presenters.IterationDetailTabACs} {
+		for _, index := range []int{-1, 5} {
+			presenter := presenters.NewIterationDetailPresenterWithSelection(vm, nil, context.Background(), components.DefaultKeyMap(), tab, index)
+			_ = presenter.View()
+			// Keys acting on the selected task or AC must not panic
+			for _, r := range "iy" {
+				presenter.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+			}
+		}
+	}
+
+	// PageDown on an empty list moves the selection to -1
+	empty := presenters.NewIterationDetailPresenter(viewmodels.NewIterationDetailViewModel(2, "Empty", "", "", "planned"), nil, context.Background(), components.DefaultKeyMap())
+	empty.Update(tea.KeyMsg{Type: tea.KeyPgDown})
+	for _, r := range "iy" {
+		empty.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	_ = empty.View()
+}
+
+// verifyACRepository is a repository fake recording which ACs were verified
+type verifyACRepository struct {
+	domain.RoadmapRepository
+	updated []string
+}
+
+func (r *verifyACRepository) GetAC(ctx context.Context, id string) (*entities.AcceptanceCriteriaEntity, error) {
+	return &entities.AcceptanceCriteriaEntity{ID: id, Status: entities.ACStatusNotStarted}, nil
+}
+
+func (r *verifyACRepository) UpdateAC(ctx context.Context, ac *entities.AcceptanceCriteriaEntity) error {
+	r.updated = append(r.updated, ac.ID)
+	return nil
+}
+
+// TestIterationDetailPresenter_ACSelectionSkipsTasksWithoutACs tests that the highlighted
+// AC and the AC acted on stay the same when tasks without ACs sit between AC groups
+func TestIterationDetailPresenter_ACSelectionSkipsTasksWithoutACs(t *testing.T) {
+	vm := viewmodels.NewIterationDetailViewModel(1, "Test Iteration", "", "", "current")
+	group := func(taskID string, acIDs ...string) *viewmodels.TaskACGroupViewModel {
+		g := &viewmodels.TaskACGroupViewModel{Task: &viewmodels.TaskRowViewModel{ID: taskID, Title: "Title " + taskID}}
+		for _, id := range acIDs {
+			g.ACs = append(g.ACs, &viewmodels.IterationACViewModel{ID: id, Description: "Desc " + id, Status: "not_started"})
+		}
+		return g
+	}
+	vm.TaskACs = []*viewmodels.TaskACGroupViewModel{
+		group("TM-task-1"),
+		group("TM-task-2", "TM-ac-1"),
+		group("TM-task-3"),
+		group("TM-task-4", "TM-ac-2", "TM-ac-3"),
+		group("TM-task-5"),
+	}
+
+	repo := &verifyACRepository{}
+	var p presenters.Presenter = presenters.NewIterationDetailPresenterWithTab(vm, repo, context.Background(), components.DefaultKeyMap(), presenters.IterationDetailTabACs)
+
+	// Move to the second AC and past the end; the selection must stop at the last AC
+	for i := 0; i < 5; i++ {
+		p, _ = p.Update(tea.KeyMsg{Type: tea.KeyDown})
+	}
+	p, _ = p.Update(tea.KeyMsg{Type: tea.KeyUp})
+
+	view := p.View()
+	if strings.Contains(view, "TM-task-1") || strings.Contains(view, "TM-task-3") || strings.Contains(view, "TM-task-5") {
+		t.Errorf("tasks without ACs should not be listed on the ACs tab:\n%s", view)
+	}
+
+	_, cmd := p.Update(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+	if cmd == nil {
+		t.Fatal("expected verify command on space")
+	}
+	cmd()
+	if len(repo.updated) != 1 || repo.updated[0] != "TM-ac-2" {
+		t.Errorf("expected TM-ac-2 to be verified, got %v", repo.updated)
+	}
+
+	// Failing goes through the feedback input and must hit the same AC
+	p, _ = p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'f'}})
+	p = typeText(p, "broken")
+	_, cmd = p.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("expected fail command on Enter")
+	}
+	cmd()
+	if len(repo.updated) != 2 || repo.updated[1] != "TM-ac-2" {
+		t.Errorf("expected TM-ac-2 to be failed, got %v", repo.updated)
+	}
+}
@@ -0,0 +1,161 @@
+package presenters
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/presentation/tui/components"
+)
+
+// Iteration edit form fields, in focus order
+const (
+	iterationEditFieldName = iota
+	iterationEditFieldGoal
+	iterationEditFieldDeliverable
+	iterationEditFieldCount
+)
+
+// iterationEditFieldLabels are rendered before each input
+var iterationEditFieldLabels = [iterationEditFieldCount]string{"Name", "Goal", "Deliverable"}
+
+// IterationEditFormComponent edits an iteration's name, goal and deliverable inline.
+// It follows the same pattern as FeedbackInputComponent: the presenter forwards
+// key messages while the form is active and performs the save on submit.
+type IterationEditFormComponent struct {
+	active  bool
+	inputs  [iterationEditFieldCount]textinput.Model
+	focused int
+	err     string
+}
+
+// NewIterationEditFormComponent creates a new iteration edit form
+func NewIterationEditFormComponent() *IterationEditFormComponent {
+	c := &IterationEditFormComponent{}
+	for i := range c.inputs {
+		ti := textinput.New()
+		ti.CharLimit = 500
+		c.inputs[i] = ti
+	}
+	c.inputs[iterationEditFieldName].CharLimit = 200
+	c.inputs[iterationEditFieldName].Placeholder = "Iteration name"
+	c.inputs[iterationEditFieldGoal].Placeholder = "Iteration goal"
+	c.inputs[iterationEditFieldDeliverable].Placeholder = "Expected deliverable (optional)"
+	return c
+}
+
+// Start opens the form pre-populated with the current values and focuses the name field
+func (c *IterationEditFormComponent) Start(name, goal, deliverable string) tea.Cmd {
+	c.active = true
+	c.err = ""
+	c.inputs[iterationEditFieldName].SetValue(name)
+	c.inputs[iterationEditFieldGoal].SetValue(goal)
+	c.inputs[iterationEditFieldDeliverable].SetValue(deliverable)
+	c.focus(iterationEditFieldName)
+	return textinput.Blink
+}
+
+// Cancel closes the form without saving
+func (c *IterationEditFormComponent) Cancel() {
+	c.active = false
+	c.err = ""
+	for i := range c.inputs {
+		c.inputs[i].SetValue("")
+		c.inputs[i].Blur()
+	}
+}
+
+// Values returns the trimmed name, goal and deliverable
+func (c *IterationEditFormComponent) Values() (name, goal, deliverable string) {
+	return strings.TrimSpace(c.inputs[iterationEditFieldName].Value()),
+		strings.TrimSpace(c.inputs[iterationEditFieldGoal].Value()),
+		strings.TrimSpace(c.inputs[iterationEditFieldDeliverable].Value())
+}
+
+// Update handles keyboard input while the form is active.
+// Returns handled=false if the form is not active. submit is true when Enter was
+// pressed with a valid form; the caller should then read Values() and save.
+func (c *IterationEditFormComponent) Update(msg tea.Msg) (handled bool, submit bool, cmd tea.Cmd) {
+	if !c.active {
+		return false, false, nil
+	}
+
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return false, false, nil
+	}
+
+	switch keyMsg.Type {
+	case tea.KeyEsc:
+		c.Cancel()
+		return true, false, nil
+	case tea.KeyEnter:
+		name, _, _ := c.Values()
+		if name == "" {
+			c.err = "Name must not be empty"
+			c.focus(iterationEditFieldName)
+			return true, false, nil
+		}
+		return true, true, nil
+	case tea.KeyTab, tea.KeyDown:
+		c.focus((c.focused + 1) % iterationEditFieldCount)
+		return true, false, nil
+	case tea.KeyShiftTab, tea.KeyUp:
+		c.focus((c.focused + iterationEditFieldCount - 1) % iterationEditFieldCount)
+		return true, false, nil
+	default:
+		c.err = ""
+		c.inputs[c.focused], cmd = c.inputs[c.focused].Update(keyMsg)
+		return true, false, cmd
+	}
+}
+
+// View renders the form inline at the bottom of the view.
+// Returns empty string if the form is not active.
+func (c *IterationEditFormComponent) View(width int) string {
+	if !c.active {
+		return ""
+	}
+
+	inputWidth := width - 16 // Account for label column
+	if inputWidth < 20 {
+		inputWidth = 20
+	}
+
+	var b strings.Builder
+	b.WriteString("\n\n")
+	b.WriteString(components.Styles.SectionStyle.Render("Edit Iteration"))
+	b.WriteString("\n")
+	for i := range c.inputs {
+		c.inputs[i].Width = inputWidth
+		label := iterationEditFieldLabels[i] + ":"
+		b.WriteString(components.Styles.MetadataStyle.Render(label + strings.Repeat(" ", 14-len(label))))
+		b.WriteString(c.inputs[i].View())
+		b.WriteString("\n")
+	}
+	if c.err != "" {
+		b.WriteString(components.Styles.ErrorMessageStyle.Render(c.err))
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+	b.WriteString(components.Styles.MetadataStyle.Render("Tab/↑↓ to switch fields, Enter to save, ESC to cancel"))
+
+	return b.String()
+}
+
+// IsActive returns whether the form is currently open
+func (c *IterationEditFormComponent) IsActive() bool {
+	return c.active
+}
+
+// focus moves keyboard focus to the given field
+func (c *IterationEditFormComponent) focus(field int) {
+	c.focused = field
+	for i := range c.inputs {
+		if i == field {
+			c.inputs[i].Focus()
+		} else {
+			c.inputs[i].Blur()
+		}
+	}
+}
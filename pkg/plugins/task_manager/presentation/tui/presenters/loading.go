@@ -1,6 +1,7 @@
 package presenters
 
 import (
+	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/presentation/tui/components"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/presentation/tui/viewmodels"
@@ -10,13 +11,15 @@ import (
 type LoadingPresenter struct {
 	viewModel *viewmodels.LoadingViewModel
 	spinner   components.Spinner
+	quitKey   key.Binding
 }
 
 // NewLoadingPresenter creates a new loading presenter
-func NewLoadingPresenter(vm *viewmodels.LoadingViewModel) *LoadingPresenter {
+func NewLoadingPresenter(vm *viewmodels.LoadingViewModel, keymap components.KeyMap) *LoadingPresenter {
 	return &LoadingPresenter{
 		viewModel: vm,
 		spinner:   components.NewSpinner(),
+		quitKey:   components.NewQuitKey(keymap),
 	}
 }
 
@@ -30,7 +33,7 @@ func (p *LoadingPresenter) Init() tea.Cmd {
 func (p *LoadingPresenter) Update(msg tea.Msg) (Presenter, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
-		if msg.String() == "q" || msg.String() == "ctrl+c" {
+		if key.Matches(msg, p.quitKey) {
 			return p, tea.Quit
 		}
 	case interface{}: // Handles all Bubble Tea messages including spinner.TickMsg
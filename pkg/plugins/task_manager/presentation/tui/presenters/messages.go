@@ -37,6 +37,12 @@ type TaskTransitionCompletedMsg struct {
 	SelectedIndex int                // Preserve selected index across reload
 }
 
+// IterationUpdatedMsg is sent after iteration details are edited
+type IterationUpdatedMsg struct {
+	ActiveTab     IterationDetailTab // Preserve active tab (Tasks=0, ACs=1)
+	SelectedIndex int                // Preserve selected index across reload
+}
+
 // ReorderCompletedMsg is sent after iterations are successfully reordered
 type ReorderCompletedMsg struct {
 	SelectedIterationNumber int
@@ -47,6 +53,35 @@ type RefreshDashboardMsg struct {
 	SelectedIndex int // Preserve selected index across reload
 }
 
+// RoadmapCreatedMsg is sent after a roadmap was created from the no-roadmap empty state
+type RoadmapCreatedMsg struct {
+	RoadmapID string
+}
+
+// RoadmapSwitchedMsg is sent after the dashboard activated another roadmap (R key)
+type RoadmapSwitchedMsg struct {
+	RoadmapID string
+}
+
+// CopyIDMsg is sent when a user asks to copy an entity ID to the clipboard (y key)
+type CopyIDMsg struct {
+	ID string
+}
+
+// MyTasksToggledMsg is sent when a user toggles the "my tasks" backlog filter (m key)
+type MyTasksToggledMsg struct{}
+
+// TrackTaskOrderToggledMsg is sent when a user toggles the track detail task order (s key)
+type TrackTaskOrderToggledMsg struct{}
+
+// TaskBumpRequestedMsg is sent when a user moves a task past its track neighbour (K/J keys).
+// The app bumps the task like 'task bump' does and reloads the view.
+type TaskBumpRequestedMsg struct {
+	TaskID        string
+	Direction     string // dto.BumpUp or dto.BumpDown
+	SelectedIndex int    // Selected index of the view (for restoring focus on reload)
+}
+
 // Ensure these are valid Bubble Tea messages
 var (
 	_ tea.Msg = IterationSelectedMsg{}
@@ -55,6 +90,13 @@ var (
 	_ tea.Msg = ErrorMsg{}
 	_ tea.Msg = ACActionCompletedMsg{}
 	_ tea.Msg = TaskTransitionCompletedMsg{}
+	_ tea.Msg = IterationUpdatedMsg{}
 	_ tea.Msg = ReorderCompletedMsg{}
 	_ tea.Msg = RefreshDashboardMsg{}
+	_ tea.Msg = RoadmapCreatedMsg{}
+	_ tea.Msg = RoadmapSwitchedMsg{}
+	_ tea.Msg = CopyIDMsg{}
+	_ tea.Msg = MyTasksToggledMsg{}
+	_ tea.Msg = TrackTaskOrderToggledMsg{}
+	_ tea.Msg = TaskBumpRequestedMsg{}
 )
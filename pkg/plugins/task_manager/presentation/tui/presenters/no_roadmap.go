@@ -0,0 +1,138 @@
+package presenters
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application/dto"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/services"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/presentation/tui/components"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/presentation/tui/viewmodels"
+	"github.com/muesli/reflow/wordwrap"
+)
+
+// NoRoadmapPresenter presents the empty state of a project without a roadmap.
+// The roadmap can be created inline (c key) instead of leaving the TUI.
+type NoRoadmapPresenter struct {
+	viewModel  *viewmodels.NoRoadmapViewModel
+	roadmaps   *application.RoadmapApplicationService
+	ctx        context.Context
+	createForm *RoadmapCreateFormComponent
+	help       components.Help
+	createKey  key.Binding
+	quitKey    key.Binding
+	width      int
+}
+
+// NewNoRoadmapPresenter creates a new no-roadmap presenter
+func NewNoRoadmapPresenter(vm *viewmodels.NoRoadmapViewModel, repo domain.RoadmapRepository, ctx context.Context, keymap components.KeyMap) *NoRoadmapPresenter {
+	// The roadmap is created like 'roadmap init' does, with the same validation.
+	// InitRoadmap only uses the roadmap repository. The repositories the TUI runs on
+	// (composite, event-emitting and read-only) all support transactions.
+	txRepo, _ := repo.(domain.TransactionalRepository)
+	roadmaps := application.NewRoadmapApplicationService(repo, repo, nil, nil, txRepo, services.NewValidationService())
+	return &NoRoadmapPresenter{
+		viewModel:  vm,
+		roadmaps:   roadmaps,
+		ctx:        ctx,
+		createForm: NewRoadmapCreateFormComponent(),
+		help:       components.NewHelp(),
+		createKey:  newCreateRoadmapKey(),
+		quitKey:    components.NewQuitKey(keymap),
+		width:      80, // default width
+	}
+}
+
+// newCreateRoadmapKey returns the binding that opens the roadmap creation form (c)
+func newCreateRoadmapKey() key.Binding {
+	return key.NewBinding(
+		key.WithKeys("c"),
+		key.WithHelp("c", "create roadmap"),
+	)
+}
+
+func (p *NoRoadmapPresenter) Init() tea.Cmd {
+	return nil
+}
+
+// CapturingTextInput reports whether the create form is open (implements TextInputCapturer)
+func (p *NoRoadmapPresenter) CapturingTextInput() bool {
+	return p.createForm.IsActive()
+}
+
+func (p *NoRoadmapPresenter) Update(msg tea.Msg) (Presenter, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		p.width = msg.Width
+	case tea.KeyMsg:
+		// Create form captures all keys while open
+		if handled, submit, cmd := p.createForm.Update(msg); handled {
+			if submit {
+				vision, successCriteria := p.createForm.Values()
+				p.createForm.Cancel()
+				return p, p.createRoadmap(vision, successCriteria)
+			}
+			return p, cmd
+		}
+
+		switch {
+		case key.Matches(msg, p.quitKey):
+			return p, tea.Quit
+		case key.Matches(msg, p.createKey):
+			return p, p.createForm.Start()
+		}
+	}
+	return p, nil
+}
+
+func (p *NoRoadmapPresenter) View() string {
+	var b strings.Builder
+
+	availableWidth := p.width - 4
+	if availableWidth < 40 {
+		availableWidth = 40
+	}
+
+	b.WriteString("\n")
+	b.WriteString(components.Styles.TitleStyle.Render("No roadmap yet"))
+	b.WriteString("\n\n")
+
+	intro := "This project has no roadmap. A roadmap holds the vision, tracks, tasks and iterations shown on the dashboard."
+	if p.viewModel.ProjectName != "" {
+		intro = fmt.Sprintf("Project %q has no roadmap yet. A roadmap holds the vision, tracks, tasks and iterations shown on the dashboard.", p.viewModel.ProjectName)
+	}
+	b.WriteString(wordwrap.String(intro, availableWidth))
+	b.WriteString("\n\n")
+	b.WriteString(wordwrap.String("Press c to create one here, or run:", availableWidth))
+	b.WriteString("\n  ")
+	b.WriteString(components.Styles.MetadataStyle.Render(p.viewModel.CreateCommand))
+	b.WriteString("\n")
+
+	if formView := p.createForm.View(p.width); formView != "" {
+		b.WriteString(formView)
+		b.WriteString("\n")
+		return b.String()
+	}
+
+	b.WriteString("\n")
+	b.WriteString(p.help.ShortHelpView([]key.Binding{p.createKey, p.quitKey}))
+	b.WriteString("\n")
+
+	return b.String()
+}
+
+// createRoadmap creates the project's roadmap and asks the app to load the dashboard
+func (p *NoRoadmapPresenter) createRoadmap(vision, successCriteria string) tea.Cmd {
+	return func() tea.Msg {
+		roadmap, err := p.roadmaps.InitRoadmap(p.ctx, dto.CreateRoadmapDTO{Vision: vision, SuccessCriteria: successCriteria})
+		if err != nil {
+			return ErrorMsg{Err: fmt.Errorf("failed to create roadmap: %w", err)}
+		}
+		return RoadmapCreatedMsg{RoadmapID: roadmap.ID}
+	}
+}
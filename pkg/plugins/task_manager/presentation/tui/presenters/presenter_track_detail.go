@@ -3,10 +3,12 @@ package presenters
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/application/dto"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/presentation/tui/components"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/presentation/tui/viewmodels"
@@ -16,25 +18,43 @@ import (
 
 // TrackDetailKeyMap defines keybindings for track detail view
 type TrackDetailKeyMap struct {
-	Up       key.Binding
-	Down     key.Binding
-	Enter    key.Binding
-	Quit     key.Binding
-	Back     key.Binding
-	Help     key.Binding
-	PageUp   key.Binding
-	PageDown key.Binding
+	Up         key.Binding
+	Down       key.Binding
+	Enter      key.Binding
+	Quit       key.Binding
+	Back       key.Binding
+	MoveUp     key.Binding // K or shift+up - bump the selected task up its track
+	MoveDown   key.Binding // J or shift+down - bump the selected task down its track
+	Help       key.Binding
+	PageUp     key.Binding
+	PageDown   key.Binding
+	ScrollUp   key.Binding // ctrl+u - scroll the content up
+	ScrollDown key.Binding // ctrl+d - scroll the content down
+	CopyID     key.Binding // y - copy selected task ID
+	CopyViewID key.Binding // Y - copy track ID
+	GoTo       key.Binding // : - open an entity by ID (handled by the app)
+	ToggleSort key.Binding // s - toggle between status groups and rank order
 }
 
-// NewTrackDetailKeyMap creates default keybindings for track detail
-func NewTrackDetailKeyMap() TrackDetailKeyMap {
+// TrackTaskOrder is how the track detail view orders its tasks
+type TrackTaskOrder int
+
+const (
+	TrackTaskOrderStatus TrackTaskOrder = iota // Grouped by status: TODO, IN PROGRESS, DONE
+	TrackTaskOrderRank                         // One list by rank (priority), then ID
+)
+
+// NewTrackDetailKeyMap creates keybindings for track detail, binding the remappable actions as keymap does
+func NewTrackDetailKeyMap(keymap components.KeyMap) TrackDetailKeyMap {
 	return TrackDetailKeyMap{
-		Up:    components.NewUpKey(),
-		Down:  components.NewDownKey(),
-		Enter: components.NewEnterKey(),
-		Quit:  components.NewQuitKey(),
-		Back:  components.NewBackKey(),
-		Help:  components.NewHelpKey(),
+		Up:       components.NewUpKey(keymap),
+		Down:     components.NewDownKey(keymap),
+		Enter:    components.NewEnterKey(keymap),
+		Quit:     components.NewQuitKey(keymap),
+		Back:     components.NewBackKey(keymap),
+		MoveUp:   components.NewReorderUpKey(keymap),
+		MoveDown: components.NewReorderDownKey(keymap),
+		Help:     components.NewHelpKey(),
 		PageUp: key.NewBinding(
 			key.WithKeys("pgup", "b"),
 			key.WithHelp("pgup/b", "page up"),
@@ -43,19 +63,40 @@ func NewTrackDetailKeyMap() TrackDetailKeyMap {
 			key.WithKeys("pgdn"),
 			key.WithHelp("pgdn", "page down"),
 		),
+		ScrollUp:   components.NewScrollUpKey(),
+		ScrollDown: components.NewScrollDownKey(),
+		CopyID:     components.NewCopyIDKey(),
+		CopyViewID: components.NewCopyViewIDKey(),
+		GoTo:       components.NewGoToKey(),
+		ToggleSort: newTrackSortKey(TrackTaskOrderStatus),
+	}
+}
+
+// newTrackSortKey returns the s binding, whose help names the order it switches to
+func newTrackSortKey(current TrackTaskOrder) key.Binding {
+	help := "sort by rank"
+	if current == TrackTaskOrderRank {
+		help = "group by status"
 	}
+	return key.NewBinding(
+		key.WithKeys("s"),
+		key.WithHelp("s", help),
+	)
 }
 
 // ShortHelp returns keybindings for short help
 func (k TrackDetailKeyMap) ShortHelp() []key.Binding {
-	return []key.Binding{k.Up, k.Down, k.Enter, k.Back, k.Quit}
+	return []key.Binding{k.Up, k.Down, k.Enter, k.ToggleSort, k.Back, k.Quit}
 }
 
 // FullHelp returns all keybindings for full help
 func (k TrackDetailKeyMap) FullHelp() [][]key.Binding {
 	return [][]key.Binding{
 		{k.Up, k.Down, k.Enter},
-		{k.PageUp, k.PageDown},
+		{k.PageUp, k.PageDown, k.ToggleSort},
+		{k.MoveUp, k.MoveDown},
+		{k.ScrollUp, k.ScrollDown},
+		{k.CopyID, k.CopyViewID, k.GoTo},
 		{k.Back, k.Help, k.Quit},
 	}
 }
@@ -67,25 +108,28 @@ type TrackDetailPresenter struct {
 	keys           TrackDetailKeyMap
 	showFullHelp   bool
 	selectedIndex  int
+	taskOrder      TrackTaskOrder
 	width          int
 	height         int
 	repo           domain.RoadmapRepository
 	ctx            context.Context
 	scrollHelper   *components.ScrollHelper
 	terminalHeight int
+	scrollView     *components.ScrollView // Whole content above the help footer
+	focusLine      int                    // Content line of the selected task, set by View
 }
 
 // NewTrackDetailPresenter creates a new track detail presenter
-func NewTrackDetailPresenter(vm *viewmodels.TrackDetailViewModel, repo domain.RoadmapRepository, ctx context.Context) *TrackDetailPresenter {
-	return NewTrackDetailPresenterWithSelection(vm, repo, ctx, 0)
+func NewTrackDetailPresenter(vm *viewmodels.TrackDetailViewModel, repo domain.RoadmapRepository, ctx context.Context, keymap components.KeyMap) *TrackDetailPresenter {
+	return NewTrackDetailPresenterWithSelection(vm, repo, ctx, keymap, 0)
 }
 
 // NewTrackDetailPresenterWithSelection creates a new track detail presenter with a specific selected index
-func NewTrackDetailPresenterWithSelection(vm *viewmodels.TrackDetailViewModel, repo domain.RoadmapRepository, ctx context.Context, selectedIndex int) *TrackDetailPresenter {
+func NewTrackDetailPresenterWithSelection(vm *viewmodels.TrackDetailViewModel, repo domain.RoadmapRepository, ctx context.Context, keymap components.KeyMap, selectedIndex int) *TrackDetailPresenter {
 	return &TrackDetailPresenter{
 		viewModel:      vm,
 		help:           components.NewHelp(),
-		keys:           NewTrackDetailKeyMap(),
+		keys:           NewTrackDetailKeyMap(keymap),
 		showFullHelp:   false,
 		selectedIndex:  selectedIndex,
 		repo:           repo,
@@ -94,6 +138,7 @@ func NewTrackDetailPresenterWithSelection(vm *viewmodels.TrackDetailViewModel, r
 		height:         24,
 		scrollHelper:   components.NewScrollHelper(),
 		terminalHeight: 24,
+		scrollView:     components.NewScrollView(),
 	}
 }
 
@@ -109,6 +154,7 @@ func (p *TrackDetailPresenter) Update(msg tea.Msg) (Presenter, tea.Cmd) {
 		p.height = msg.Height
 		p.terminalHeight = msg.Height
 		p.help.SetWidth(msg.Width)
+		p.scrollView.SetSize(msg.Width, msg.Height)
 
 		// Calculate available viewport height for scrolling
 		// Account for: title (1) + metadata (5-7 lines) + progress (1) + section headers + help (2)
@@ -122,7 +168,7 @@ func (p *TrackDetailPresenter) Update(msg tea.Msg) (Presenter, tea.Cmd) {
 		p.scrollHelper.SetViewportHeight(availableHeight)
 
 		// Ensure current selection is visible with new viewport height
-		totalTasks := len(p.viewModel.TODOTasks) + len(p.viewModel.InProgressTasks) + len(p.viewModel.DoneTasks)
+		totalTasks := len(p.displayedTasks())
 		p.scrollHelper.EnsureVisible(totalTasks, p.selectedIndex)
 
 	case tea.KeyMsg:
@@ -136,24 +182,28 @@ func (p *TrackDetailPresenter) Update(msg tea.Msg) (Presenter, tea.Cmd) {
 		case key.Matches(msg, p.keys.Up):
 			if p.selectedIndex > 0 {
 				p.selectedIndex--
-				totalTasks := len(p.viewModel.TODOTasks) + len(p.viewModel.InProgressTasks) + len(p.viewModel.DoneTasks)
+				totalTasks := len(p.displayedTasks())
 				p.scrollHelper.EnsureVisible(totalTasks, p.selectedIndex)
 			}
 		case key.Matches(msg, p.keys.Down):
 			maxIndex := p.getMaxIndex()
 			if p.selectedIndex < maxIndex {
 				p.selectedIndex++
-				totalTasks := len(p.viewModel.TODOTasks) + len(p.viewModel.InProgressTasks) + len(p.viewModel.DoneTasks)
+				totalTasks := len(p.displayedTasks())
 				p.scrollHelper.EnsureVisible(totalTasks, p.selectedIndex)
 			}
 		case key.Matches(msg, p.keys.PageUp):
-			totalTasks := len(p.viewModel.TODOTasks) + len(p.viewModel.InProgressTasks) + len(p.viewModel.DoneTasks)
+			totalTasks := len(p.displayedTasks())
 			newIndex := p.scrollHelper.PageUp(totalTasks)
 			p.selectedIndex = newIndex
 		case key.Matches(msg, p.keys.PageDown):
-			totalTasks := len(p.viewModel.TODOTasks) + len(p.viewModel.InProgressTasks) + len(p.viewModel.DoneTasks)
+			totalTasks := len(p.displayedTasks())
 			newIndex := p.scrollHelper.PageDown(totalTasks, p.selectedIndex)
 			p.selectedIndex = newIndex
+		case key.Matches(msg, p.keys.ScrollUp):
+			p.scrollView.ScrollUp()
+		case key.Matches(msg, p.keys.ScrollDown):
+			p.scrollView.ScrollDown()
 		case key.Matches(msg, p.keys.Enter):
 			// Navigate to task detail
 			taskID := p.getSelectedTaskID()
@@ -162,6 +212,17 @@ func (p *TrackDetailPresenter) Update(msg tea.Msg) (Presenter, tea.Cmd) {
 					return TaskSelectedMsg{TaskID: taskID}
 				}
 			}
+		case key.Matches(msg, p.keys.CopyID):
+			return p, copyID(p.getSelectedTaskID())
+		case key.Matches(msg, p.keys.CopyViewID):
+			return p, copyID(p.viewModel.ID)
+		case key.Matches(msg, p.keys.ToggleSort):
+			// The app flips the order and remembers it for the rest of the session
+			return p, func() tea.Msg { return TrackTaskOrderToggledMsg{} }
+		case key.Matches(msg, p.keys.MoveUp):
+			return p, p.bumpTask(dto.BumpUp)
+		case key.Matches(msg, p.keys.MoveDown):
+			return p, p.bumpTask(dto.BumpDown)
 		}
 	}
 
@@ -170,6 +231,7 @@ func (p *TrackDetailPresenter) Update(msg tea.Msg) (Presenter, tea.Cmd) {
 
 func (p *TrackDetailPresenter) View() string {
 	var b strings.Builder
+	p.focusLine = -1
 
 	// Title
 	b.WriteString(components.Styles.TitleStyle.Render(fmt.Sprintf("Track: %s", p.viewModel.Title)))
@@ -220,36 +282,107 @@ func (p *TrackDetailPresenter) View() string {
 
 	// Render tasks
 	p.renderTasksView(&b)
-
-	// Help view
 	b.WriteString("\n")
+
+	// Help view, pinned below the scrolling content
+	var footer string
 	if p.showFullHelp {
-		b.WriteString(p.help.FullHelpView(p.keys.FullHelp()))
+		footer = p.help.FullHelpView(p.keys.FullHelp())
 	} else {
-		b.WriteString(p.help.ShortHelpView(p.keys.ShortHelp()))
+		footer = p.help.ShortHelpView(p.keys.ShortHelp())
 	}
 
-	return b.String()
+	return p.scrollView.Render(b.String(), footer, p.focusLine)
 }
 
-func (p *TrackDetailPresenter) renderTasksView(b *strings.Builder) {
-	// Build flat task list with section info
-	type taskItem struct {
-		task        *viewmodels.TrackDetailTaskViewModel
-		section     string
-		sectionName string
-	}
-	allTasks := make([]taskItem, 0)
+// ScrollOffset returns the first visible line of the content
+func (p *TrackDetailPresenter) ScrollOffset() int {
+	return p.scrollView.Offset()
+}
 
+// SetScrollOffset scrolls the content to offset, e.g. when returning to the view
+func (p *TrackDetailPresenter) SetScrollOffset(offset int) {
+	p.scrollView.SetOffset(offset)
+}
+
+// trackTaskItem is a task as displayed, with the status section it is listed under
+type trackTaskItem struct {
+	task        *viewmodels.TrackDetailTaskViewModel
+	section     string
+	sectionName string
+}
+
+// displayedTasks returns the tasks in display order. selectedIndex indexes this list.
+func (p *TrackDetailPresenter) displayedTasks() []trackTaskItem {
+	items := make([]trackTaskItem, 0)
 	for _, task := range p.viewModel.TODOTasks {
-		allTasks = append(allTasks, taskItem{task: task, section: "todo", sectionName: "TODO"})
+		items = append(items, trackTaskItem{task: task, section: "todo", sectionName: "TODO"})
 	}
 	for _, task := range p.viewModel.InProgressTasks {
-		allTasks = append(allTasks, taskItem{task: task, section: "in-progress", sectionName: "IN PROGRESS"})
+		items = append(items, trackTaskItem{task: task, section: "in-progress", sectionName: "IN PROGRESS"})
 	}
 	for _, task := range p.viewModel.DoneTasks {
-		allTasks = append(allTasks, taskItem{task: task, section: "done", sectionName: "DONE"})
+		items = append(items, trackTaskItem{task: task, section: "done", sectionName: "DONE"})
+	}
+
+	if p.taskOrder == TrackTaskOrderRank {
+		sort.SliceStable(items, func(i, j int) bool {
+			if items[i].task.Rank != items[j].task.Rank {
+				return items[i].task.Rank < items[j].task.Rank
+			}
+			return items[i].task.ID < items[j].task.ID
+		})
+		for i := range items {
+			items[i].section = "rank"
+			items[i].sectionName = "BY RANK"
+		}
+	}
+	return items
+}
+
+// TaskOrder returns how the tasks are currently ordered
+func (p *TrackDetailPresenter) TaskOrder() TrackTaskOrder {
+	return p.taskOrder
+}
+
+// SetTaskOrder reorders the task list, keeping the selected task selected
+func (p *TrackDetailPresenter) SetTaskOrder(order TrackTaskOrder) {
+	if order == p.taskOrder {
+		return
+	}
+	selectedID := p.getSelectedTaskID()
+	p.taskOrder = order
+	p.keys.ToggleSort = newTrackSortKey(order)
+	p.SelectTask(selectedID)
+}
+
+// SelectTask selects the task with the given ID, e.g. after a bump moved it.
+// The selection is kept if the track has no such task.
+func (p *TrackDetailPresenter) SelectTask(taskID string) {
+	tasks := p.displayedTasks()
+	for i, item := range tasks {
+		if item.task.ID == taskID {
+			p.selectedIndex = i
+			break
+		}
+	}
+	p.scrollHelper.EnsureVisible(len(tasks), p.selectedIndex)
+}
+
+// bumpTask asks the app to move the selected task past its neighbour in the track's rank order
+func (p *TrackDetailPresenter) bumpTask(direction string) tea.Cmd {
+	taskID := p.getSelectedTaskID()
+	if taskID == "" {
+		return nil
+	}
+	selectedIndex := p.selectedIndex
+	return func() tea.Msg {
+		return TaskBumpRequestedMsg{TaskID: taskID, Direction: direction, SelectedIndex: selectedIndex}
 	}
+}
+
+func (p *TrackDetailPresenter) renderTasksView(b *strings.Builder) {
+	allTasks := p.displayedTasks()
 
 	if len(allTasks) == 0 {
 		b.WriteString(components.Styles.MetadataStyle.Render("No tasks in this track"))
@@ -277,12 +410,19 @@ func (p *TrackDetailPresenter) renderTasksView(b *strings.Builder) {
 			b.WriteString("\n")
 		}
 
+		// Without status sections, the icon shows each task's status
+		line := fmt.Sprintf("  %s: %s%s", item.task.ID, item.task.Title, assigneeSuffix(item.task.Assignee))
+		if p.taskOrder == TrackTaskOrderRank {
+			line = fmt.Sprintf("  %s %s: %s%s", item.task.Icon, item.task.ID, item.task.Title, assigneeSuffix(item.task.Assignee))
+		}
+
 		// Render task
 		var output string
 		if i == p.selectedIndex {
-			output = components.Styles.SelectedStyle.Render(fmt.Sprintf("  %s: %s", item.task.ID, item.task.Title))
+			p.focusLine = strings.Count(b.String(), "\n")
+			output = components.Styles.SelectedStyle.Render(line)
 		} else {
-			output = fmt.Sprintf("  %s: %s", item.task.ID, item.task.Title)
+			output = line
 		}
 		b.WriteString(output)
 		b.WriteString("\n")
@@ -296,30 +436,14 @@ func (p *TrackDetailPresenter) renderTasksView(b *strings.Builder) {
 }
 
 func (p *TrackDetailPresenter) getMaxIndex() int {
-	return len(p.viewModel.TODOTasks) +
-		len(p.viewModel.InProgressTasks) +
-		len(p.viewModel.DoneTasks) - 1
+	return len(p.displayedTasks()) - 1
 }
 
 // getSelectedTaskID returns the task ID of the currently selected task
 func (p *TrackDetailPresenter) getSelectedTaskID() string {
-	index := p.selectedIndex
-	todoLen := len(p.viewModel.TODOTasks)
-	inProgressLen := len(p.viewModel.InProgressTasks)
-
-	if index < todoLen {
-		return p.viewModel.TODOTasks[index].ID
+	tasks := p.displayedTasks()
+	if p.selectedIndex < 0 || p.selectedIndex >= len(tasks) {
+		return ""
 	}
-	index -= todoLen
-
-	if index < inProgressLen {
-		return p.viewModel.InProgressTasks[index].ID
-	}
-	index -= inProgressLen
-
-	if index < len(p.viewModel.DoneTasks) {
-		return p.viewModel.DoneTasks[index].ID
-	}
-
-	return ""
+	return tasks[p.selectedIndex].task.ID
 }
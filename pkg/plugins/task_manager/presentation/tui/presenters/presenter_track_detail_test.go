@@ -0,0 +1,92 @@
+package presenters_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/presentation/tui/components"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/presentation/tui/presenters"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/presentation/tui/viewmodels"
+)
+
+func newTrackDetailTestViewModel() *viewmodels.TrackDetailViewModel {
+	vm := viewmodels.NewTrackDetailViewModel("TM-track-1", "Track", "", "in-progress", "In Progress", 100, nil, nil)
+	task := func(id string, rank int) *viewmodels.TrackDetailTaskViewModel {
+		return &viewmodels.TrackDetailTaskViewModel{ID: id, Title: "Task " + id, Rank: rank}
+	}
+	vm.TODOTasks = []*viewmodels.TrackDetailTaskViewModel{task("TM-task-1", 300), task("TM-task-2", 100)}
+	vm.InProgressTasks = []*viewmodels.TrackDetailTaskViewModel{task("TM-task-3", 75)}
+	vm.DoneTasks = []*viewmodels.TrackDetailTaskViewModel{task("TM-task-4", 50)}
+	vm.Progress = viewmodels.NewProgressViewModel(1, 4)
+	return vm
+}
+
+// selectedTaskID reads the selected task through Enter, which opens it
+func selectedTaskID(t *testing.T, p presenters.Presenter) string {
+	t.Helper()
+	_, cmd := p.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("expected Enter to open the selected task")
+	}
+	msg, ok := cmd().(presenters.TaskSelectedMsg)
+	if !ok {
+		t.Fatalf("expected TaskSelectedMsg, got %T", cmd())
+	}
+	return msg.TaskID
+}
+
+func TestTrackDetailPresenter_ToggleTaskOrderKeepsSelection(t *testing.T) {
+	// Index 2 in status order is the in-progress TM-task-3
+	p := presenters.NewTrackDetailPresenterWithSelection(newTrackDetailTestViewModel(), nil, context.Background(), components.DefaultKeyMap(), 2)
+	if got := selectedTaskID(t, p); got != "TM-task-3" {
+		t.Fatalf("expected TM-task-3 selected in status order, got %s", got)
+	}
+
+	// s asks the app to toggle, so the choice outlives this presenter
+	_, cmd := p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'s'}})
+	if cmd == nil {
+		t.Fatal("expected s to return a command")
+	}
+	if _, ok := cmd().(presenters.TrackTaskOrderToggledMsg); !ok {
+		t.Fatalf("expected TrackTaskOrderToggledMsg, got %T", cmd())
+	}
+
+	p.SetTaskOrder(presenters.TrackTaskOrderRank)
+	if got := selectedTaskID(t, p); got != "TM-task-3" {
+		t.Errorf("expected TM-task-3 to stay selected in rank order, got %s", got)
+	}
+
+	view := p.View()
+	if !strings.Contains(view, "BY RANK") {
+		t.Errorf("expected rank header in view:\n%s", view)
+	}
+	order := []string{"TM-task-4", "TM-task-3", "TM-task-2", "TM-task-1"}
+	last := -1
+	for _, id := range order {
+		idx := strings.Index(view, id+":")
+		if idx <= last {
+			t.Errorf("expected tasks in rank order %v, got view:\n%s", order, view)
+			break
+		}
+		last = idx
+	}
+	if !strings.Contains(view, "group by status") {
+		t.Errorf("expected help line to advertise the toggle back, got:\n%s", view)
+	}
+
+	// Moving down in rank order follows the new order
+	p.Update(tea.KeyMsg{Type: tea.KeyDown})
+	if got := selectedTaskID(t, p); got != "TM-task-2" {
+		t.Errorf("expected TM-task-2 after moving down in rank order, got %s", got)
+	}
+
+	p.SetTaskOrder(presenters.TrackTaskOrderStatus)
+	if got := selectedTaskID(t, p); got != "TM-task-2" {
+		t.Errorf("expected TM-task-2 to stay selected in status order, got %s", got)
+	}
+	if view := p.View(); !strings.Contains(view, "IN PROGRESS") || !strings.Contains(view, "sort by rank") {
+		t.Errorf("expected status sections and rank toggle help, got:\n%s", view)
+	}
+}
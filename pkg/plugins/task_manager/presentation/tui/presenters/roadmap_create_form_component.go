@@ -0,0 +1,166 @@
+package presenters
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/presentation/tui/components"
+)
+
+// Roadmap create form fields, in focus order
+const (
+	roadmapCreateFieldVision = iota
+	roadmapCreateFieldSuccessCriteria
+	roadmapCreateFieldCount
+)
+
+// roadmapCreateFieldLabels are rendered before each input
+var roadmapCreateFieldLabels = [roadmapCreateFieldCount]string{"Vision", "Success"}
+
+// RoadmapCreateFormComponent collects the vision and success criteria of a new roadmap.
+// It follows the same pattern as IterationEditFormComponent: the presenter forwards
+// key messages while the form is active and performs the save on submit.
+type RoadmapCreateFormComponent struct {
+	active  bool
+	inputs  [roadmapCreateFieldCount]textinput.Model
+	focused int
+	err     string
+}
+
+// NewRoadmapCreateFormComponent creates a new roadmap create form
+func NewRoadmapCreateFormComponent() *RoadmapCreateFormComponent {
+	c := &RoadmapCreateFormComponent{}
+	for i := range c.inputs {
+		ti := textinput.New()
+		ti.CharLimit = 1000
+		c.inputs[i] = ti
+	}
+	c.inputs[roadmapCreateFieldVision].Placeholder = "What the project should achieve"
+	c.inputs[roadmapCreateFieldSuccessCriteria].Placeholder = "How you will know it succeeded"
+	return c
+}
+
+// Start opens the form empty and focuses the vision field
+func (c *RoadmapCreateFormComponent) Start() tea.Cmd {
+	c.active = true
+	c.err = ""
+	for i := range c.inputs {
+		c.inputs[i].SetValue("")
+	}
+	c.focus(roadmapCreateFieldVision)
+	return textinput.Blink
+}
+
+// Cancel closes the form without saving
+func (c *RoadmapCreateFormComponent) Cancel() {
+	c.active = false
+	c.err = ""
+	for i := range c.inputs {
+		c.inputs[i].SetValue("")
+		c.inputs[i].Blur()
+	}
+}
+
+// Values returns the trimmed vision and success criteria
+func (c *RoadmapCreateFormComponent) Values() (vision, successCriteria string) {
+	return strings.TrimSpace(c.inputs[roadmapCreateFieldVision].Value()),
+		strings.TrimSpace(c.inputs[roadmapCreateFieldSuccessCriteria].Value())
+}
+
+// Update handles keyboard input while the form is active.
+// Returns handled=false if the form is not active. submit is true when Enter was
+// pressed with both fields filled in; the caller should then read Values() and save.
+func (c *RoadmapCreateFormComponent) Update(msg tea.Msg) (handled bool, submit bool, cmd tea.Cmd) {
+	if !c.active {
+		return false, false, nil
+	}
+
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return false, false, nil
+	}
+
+	switch keyMsg.Type {
+	case tea.KeyEsc:
+		c.Cancel()
+		return true, false, nil
+	case tea.KeyEnter:
+		vision, successCriteria := c.Values()
+		if vision == "" {
+			c.err = "Vision must not be empty"
+			c.focus(roadmapCreateFieldVision)
+			return true, false, nil
+		}
+		if successCriteria == "" {
+			// Enter on the vision field moves on to the next field
+			if c.focused == roadmapCreateFieldVision {
+				c.focus(roadmapCreateFieldSuccessCriteria)
+				return true, false, nil
+			}
+			c.err = "Success criteria must not be empty"
+			return true, false, nil
+		}
+		return true, true, nil
+	case tea.KeyTab, tea.KeyDown:
+		c.focus((c.focused + 1) % roadmapCreateFieldCount)
+		return true, false, nil
+	case tea.KeyShiftTab, tea.KeyUp:
+		c.focus((c.focused + roadmapCreateFieldCount - 1) % roadmapCreateFieldCount)
+		return true, false, nil
+	default:
+		c.err = ""
+		c.inputs[c.focused], cmd = c.inputs[c.focused].Update(keyMsg)
+		return true, false, cmd
+	}
+}
+
+// View renders the form inline at the bottom of the view.
+// Returns empty string if the form is not active.
+func (c *RoadmapCreateFormComponent) View(width int) string {
+	if !c.active {
+		return ""
+	}
+
+	inputWidth := width - 16 // Account for label column
+	if inputWidth < 20 {
+		inputWidth = 20
+	}
+
+	var b strings.Builder
+	b.WriteString("\n\n")
+	b.WriteString(components.Styles.SectionStyle.Render("Create Roadmap"))
+	b.WriteString("\n")
+	for i := range c.inputs {
+		c.inputs[i].Width = inputWidth
+		label := roadmapCreateFieldLabels[i] + ":"
+		b.WriteString(components.Styles.MetadataStyle.Render(label + strings.Repeat(" ", 14-len(label))))
+		b.WriteString(c.inputs[i].View())
+		b.WriteString("\n")
+	}
+	if c.err != "" {
+		b.WriteString(components.Styles.ErrorMessageStyle.Render(c.err))
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+	b.WriteString(components.Styles.MetadataStyle.Render("Tab/↑↓ to switch fields, Enter to create, ESC to cancel"))
+
+	return b.String()
+}
+
+// IsActive returns whether the form is currently open
+func (c *RoadmapCreateFormComponent) IsActive() bool {
+	return c.active
+}
+
+// focus moves keyboard focus to the given field
+func (c *RoadmapCreateFormComponent) focus(field int) {
+	c.focused = field
+	for i := range c.inputs {
+		if i == field {
+			c.inputs[i].Focus()
+		} else {
+			c.inputs[i].Blur()
+		}
+	}
+}
@@ -15,27 +15,32 @@ import (
 
 // TaskDetailKeyMap defines keybindings for task detail view
 type TaskDetailKeyMap struct {
-	Up       key.Binding
-	Down     key.Binding
-	Enter    key.Binding // Expand/collapse AC testing instructions
-	Quit     key.Binding
-	Back     key.Binding
-	Help     key.Binding
-	Verify   key.Binding // Space - verify AC
-	Skip     key.Binding // s - skip AC
-	Fail     key.Binding // f - fail AC with feedback
-	PageUp   key.Binding // pgup/b - page up
-	PageDown key.Binding // pgdn - page down
+	Up         key.Binding
+	Down       key.Binding
+	Enter      key.Binding // Expand/collapse AC testing instructions
+	Quit       key.Binding
+	Back       key.Binding
+	Help       key.Binding
+	Verify     key.Binding // Space - verify AC
+	Skip       key.Binding // s - skip AC
+	Fail       key.Binding // f - fail AC with feedback
+	PageUp     key.Binding // pgup/b - page up
+	PageDown   key.Binding // pgdn - page down
+	ScrollUp   key.Binding // ctrl+u - scroll the content up
+	ScrollDown key.Binding // ctrl+d - scroll the content down
+	CopyID     key.Binding // y - copy selected AC ID (task ID when there are no ACs)
+	CopyViewID key.Binding // Y - copy task ID
+	GoTo       key.Binding // : - open an entity by ID (handled by the app)
 }
 
-// NewTaskDetailKeyMap creates default keybindings for task detail
-func NewTaskDetailKeyMap() TaskDetailKeyMap {
+// NewTaskDetailKeyMap creates keybindings for task detail, binding the remappable actions as keymap does
+func NewTaskDetailKeyMap(keymap components.KeyMap) TaskDetailKeyMap {
 	return TaskDetailKeyMap{
-		Up:    components.NewUpKey(),
-		Down:  components.NewDownKey(),
-		Enter: components.NewEnterKey(), // Note: Also used for expand/collapse AC testing instructions
-		Quit:  components.NewQuitKey(),
-		Back:  components.NewBackKey(),
+		Up:    components.NewUpKey(keymap),
+		Down:  components.NewDownKey(keymap),
+		Enter: components.NewEnterKey(keymap), // Note: Also used for expand/collapse AC testing instructions
+		Quit:  components.NewQuitKey(keymap),
+		Back:  components.NewBackKey(keymap),
 		Help:  components.NewHelpKey(),
 		Verify: key.NewBinding(
 			key.WithKeys(" "),
@@ -57,6 +62,11 @@ func NewTaskDetailKeyMap() TaskDetailKeyMap {
 			key.WithKeys("pgdn"),
 			key.WithHelp("pgdn", "page down"),
 		),
+		ScrollUp:   components.NewScrollUpKey(),
+		ScrollDown: components.NewScrollDownKey(),
+		CopyID:     components.NewCopyIDKey(),
+		CopyViewID: components.NewCopyViewIDKey(),
+		GoTo:       components.NewGoToKey(),
 	}
 }
 
@@ -70,7 +80,9 @@ func (k TaskDetailKeyMap) FullHelp() [][]key.Binding {
 	return [][]key.Binding{
 		{k.Up, k.Down, k.Enter},
 		{k.PageUp, k.PageDown},
+		{k.ScrollUp, k.ScrollDown},
 		{k.Verify, k.Skip, k.Fail},
+		{k.CopyID, k.CopyViewID, k.GoTo},
 		{k.Back, k.Help, k.Quit},
 	}
 }
@@ -89,21 +101,23 @@ type TaskDetailPresenter struct {
 	acListComponent *ACListComponent
 
 	// Scrolling support
-	scrollHelperACs  *components.ScrollHelperMultiline // For ACs (multi-line with expansion)
-	terminalHeight   int
+	scrollHelperACs *components.ScrollHelperMultiline // For ACs (multi-line with expansion)
+	terminalHeight  int
+	scrollView      *components.ScrollView // Whole content above the help footer
+	focusLine       int                    // Content line of the selected AC, set by View
 }
 
 // NewTaskDetailPresenter creates a new task detail presenter
-func NewTaskDetailPresenter(vm *viewmodels.TaskDetailViewModel, repo domain.RoadmapRepository, ctx context.Context) *TaskDetailPresenter {
-	return NewTaskDetailPresenterWithSelection(vm, repo, ctx, 0)
+func NewTaskDetailPresenter(vm *viewmodels.TaskDetailViewModel, repo domain.RoadmapRepository, ctx context.Context, keymap components.KeyMap) *TaskDetailPresenter {
+	return NewTaskDetailPresenterWithSelection(vm, repo, ctx, keymap, 0)
 }
 
 // NewTaskDetailPresenterWithSelection creates a new task detail presenter with a specific selected index
-func NewTaskDetailPresenterWithSelection(vm *viewmodels.TaskDetailViewModel, repo domain.RoadmapRepository, ctx context.Context, selectedIndex int) *TaskDetailPresenter {
+func NewTaskDetailPresenterWithSelection(vm *viewmodels.TaskDetailViewModel, repo domain.RoadmapRepository, ctx context.Context, keymap components.KeyMap, selectedIndex int) *TaskDetailPresenter {
 	return &TaskDetailPresenter{
 		viewModel:       vm,
 		help:            components.NewHelp(),
-		keys:            NewTaskDetailKeyMap(),
+		keys:            NewTaskDetailKeyMap(keymap),
 		showFullHelp:    false,
 		selectedIndex:   selectedIndex,
 		repo:            repo,
@@ -113,8 +127,9 @@ func NewTaskDetailPresenterWithSelection(vm *viewmodels.TaskDetailViewModel, rep
 		height:          24,
 
 		// Scrolling support
-		scrollHelperACs:  components.NewScrollHelperMultiline(),
-		terminalHeight:   24,
+		scrollHelperACs: components.NewScrollHelperMultiline(),
+		terminalHeight:  24,
+		scrollView:      components.NewScrollView(),
 	}
 }
 
@@ -130,6 +145,7 @@ func (p *TaskDetailPresenter) Update(msg tea.Msg) (Presenter, tea.Cmd) {
 		p.height = msg.Height
 		p.terminalHeight = msg.Height
 		p.help.SetWidth(msg.Width)
+		p.scrollView.SetSize(msg.Width, msg.Height)
 
 		// Calculate available viewport height
 		headerHeight := 12  // Task header, description, track info, iteration membership
@@ -208,6 +224,10 @@ func (p *TaskDetailPresenter) Update(msg tea.Msg) (Presenter, tea.Cmd) {
 				lineCounts := p.calculateACLineCounts()
 				p.scrollHelperACs.EnsureVisibleMultiline(lineCounts, p.selectedIndex)
 			}
+		case key.Matches(msg, p.keys.ScrollUp):
+			p.scrollView.ScrollUp()
+		case key.Matches(msg, p.keys.ScrollDown):
+			p.scrollView.ScrollDown()
 		case key.Matches(msg, p.keys.Enter):
 			// Expand/collapse AC testing instructions
 			if p.selectedIndex >= 0 && p.selectedIndex < len(p.viewModel.AcceptanceCriteria) {
@@ -235,6 +255,13 @@ func (p *TaskDetailPresenter) Update(msg tea.Msg) (Presenter, tea.Cmd) {
 				acID := p.viewModel.AcceptanceCriteria[p.selectedIndex].ID
 				return p, p.acListComponent.StartFeedback(acID)
 			}
+		case key.Matches(msg, p.keys.CopyID):
+			if p.selectedIndex >= 0 && p.selectedIndex < len(p.viewModel.AcceptanceCriteria) {
+				return p, copyID(p.viewModel.AcceptanceCriteria[p.selectedIndex].ID)
+			}
+			return p, copyID(p.viewModel.ID)
+		case key.Matches(msg, p.keys.CopyViewID):
+			return p, copyID(p.viewModel.ID)
 		}
 	}
 
@@ -243,6 +270,7 @@ func (p *TaskDetailPresenter) Update(msg tea.Msg) (Presenter, tea.Cmd) {
 
 func (p *TaskDetailPresenter) View() string {
 	var b strings.Builder
+	p.focusLine = -1
 
 	// Calculate available width (leave some margin)
 	availableWidth := p.width - 4
@@ -265,6 +293,21 @@ func (p *TaskDetailPresenter) View() string {
 	b.WriteString(components.Styles.MetadataStyle.Render(statusText))
 	b.WriteString("\n")
 
+	if p.viewModel.WaitingOn != "" {
+		blockedText := lipgloss.NewStyle().Width(availableWidth).Render(
+			fmt.Sprintf("Blocked: %s", getStatusStyle("error").Render(p.viewModel.WaitingOn)))
+		b.WriteString(components.Styles.MetadataStyle.Render(blockedText))
+		b.WriteString("\n")
+	}
+
+	assignee := p.viewModel.Assignee
+	if assignee == "" {
+		assignee = "(unassigned)"
+	}
+	assigneeText := lipgloss.NewStyle().Width(availableWidth).Render(fmt.Sprintf("Assignee: %s", assignee))
+	b.WriteString(components.Styles.MetadataStyle.Render(assigneeText))
+	b.WriteString("\n")
+
 	if p.viewModel.Branch != "" {
 		branchText := lipgloss.NewStyle().Width(availableWidth).Render(fmt.Sprintf("Branch: %s", p.viewModel.Branch))
 		b.WriteString(components.Styles.MetadataStyle.Render(branchText))
@@ -304,11 +347,27 @@ func (p *TaskDetailPresenter) View() string {
 		b.WriteString("\n")
 	}
 
-	// Description with width wrapping
+	// Linked ADRs with width wrapping
+	if len(p.viewModel.RelevantADRs) > 0 {
+		b.WriteString(components.Styles.SectionStyle.Render("Relevant ADRs"))
+		b.WriteString("\n")
+		for _, adr := range p.viewModel.RelevantADRs {
+			adrText := lipgloss.NewStyle().Width(availableWidth).Render(
+				fmt.Sprintf("  %s: %s (%s)", adr.ID, adr.Title, adr.Status))
+			b.WriteString(adrText)
+			b.WriteString("\n")
+		}
+		b.WriteString("\n")
+	}
+
+	// Description as Markdown, or as plain wrapped text if it does not render
 	if p.viewModel.Description != "" {
 		b.WriteString(components.Styles.SectionStyle.Render("Description"))
 		b.WriteString("\n")
-		descText := lipgloss.NewStyle().Width(availableWidth).Render(p.viewModel.Description)
+		descText, err := components.RenderMarkdown(p.viewModel.Description, availableWidth)
+		if err != nil {
+			descText = lipgloss.NewStyle().Width(availableWidth).Render(p.viewModel.Description)
+		}
 		b.WriteString(descText)
 		b.WriteString("\n\n")
 	}
@@ -324,22 +383,29 @@ func (p *TaskDetailPresenter) View() string {
 		p.renderACsWithComponent(&b, availableWidth)
 	}
 
-	// Feedback input component renders inline at bottom if active
-	feedbackView := p.acListComponent.ViewFeedback(p.width)
-	if feedbackView != "" {
-		b.WriteString(feedbackView)
-		return b.String()
+	// Feedback input component renders inline at the bottom if active, otherwise help;
+	// either stays pinned below the scrolling content
+	footer := p.acListComponent.ViewFeedback(p.width)
+	if footer == "" {
+		b.WriteString("\n")
+		if p.showFullHelp {
+			footer = p.help.FullHelpView(p.keys.FullHelp())
+		} else {
+			footer = p.help.ShortHelpView(p.keys.ShortHelp())
+		}
 	}
 
-	// Help view
-	b.WriteString("\n")
-	if p.showFullHelp {
-		b.WriteString(p.help.FullHelpView(p.keys.FullHelp()))
-	} else {
-		b.WriteString(p.help.ShortHelpView(p.keys.ShortHelp()))
-	}
+	return p.scrollView.Render(b.String(), footer, p.focusLine)
+}
+
+// ScrollOffset returns the first visible line of the content
+func (p *TaskDetailPresenter) ScrollOffset() int {
+	return p.scrollView.Offset()
+}
 
-	return b.String()
+// SetScrollOffset scrolls the content to offset, e.g. when returning to the view
+func (p *TaskDetailPresenter) SetScrollOffset(offset int) {
+	p.scrollView.SetOffset(offset)
 }
 
 // calculateACLineCounts returns line counts for each AC (collapsed = 1, expanded = N)
@@ -389,8 +455,15 @@ func (p *TaskDetailPresenter) renderACsWithComponent(b *strings.Builder, availab
 	// Wrap ACs to ACViewModel interface
 	wrappedACs := WrapACDetailViewModels(visibleACs)
 
-	// Use ACListComponent to render the ACs
-	p.acListComponent.RenderACList(b, wrappedACs, adjustedSelectedIndex, availableWidth)
+	// Use ACListComponent to render the ACs one by one, noting where the selected one starts
+	for i, ac := range wrappedACs {
+		selectedInList := -1
+		if i == adjustedSelectedIndex {
+			p.focusLine = strings.Count(b.String(), "\n")
+			selectedInList = 0
+		}
+		p.acListComponent.RenderACList(b, []ACViewModel{ac}, selectedInList, availableWidth)
+	}
 
 	// Scroll indicator (below)
 	if lastItem < len(acs)-1 {
@@ -0,0 +1,78 @@
+package presenters
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/presentation/tui/components"
+)
+
+// ViewKeys lists, per view, the remappable actions it handles and its fixed keys,
+// so configured key overrides can be checked against them (see components.ResolveKeyMap).
+// The go-to-ID key is handled by the app in every view.
+func ViewKeys() []components.ViewKeys {
+	defaults := components.DefaultKeyMap()
+	goTo := components.NewGoToKey()
+
+	dashboard := NewRoadmapListKeyMap(defaults)
+	taskDetail := NewTaskDetailKeyMap(defaults)
+	trackDetail := NewTrackDetailKeyMap(defaults)
+	iterationDetail := NewIterationDetailKeyMap(defaults)
+	detailActions := []string{components.ActionUp, components.ActionDown, components.ActionSelect, components.ActionBack, components.ActionQuit}
+
+	return []components.ViewKeys{
+		{
+			View: "dashboard",
+			Actions: []string{
+				components.ActionUp, components.ActionDown, components.ActionSelect, components.ActionRefresh,
+				components.ActionReorderUp, components.ActionReorderDown, components.ActionQuit,
+			},
+			Reserved: fixedKeys(dashboard.Help, dashboard.Tab, dashboard.PageUp, dashboard.PageDown,
+				dashboard.StartIteration, dashboard.CompleteIter, dashboard.RevertIteration,
+				dashboard.CopyID, dashboard.GoTo, dashboard.MyTasks, dashboard.SwitchRoadmap),
+		},
+		{
+			View:    "task detail",
+			Actions: detailActions,
+			Reserved: fixedKeys(taskDetail.Help, taskDetail.Verify, taskDetail.Skip, taskDetail.Fail,
+				taskDetail.PageUp, taskDetail.PageDown, taskDetail.ScrollUp, taskDetail.ScrollDown,
+				taskDetail.CopyID, taskDetail.CopyViewID, taskDetail.GoTo),
+		},
+		{
+			View: "track detail",
+			Actions: []string{
+				components.ActionUp, components.ActionDown, components.ActionSelect,
+				components.ActionReorderUp, components.ActionReorderDown, components.ActionBack, components.ActionQuit,
+			},
+			Reserved: fixedKeys(trackDetail.Help, trackDetail.PageUp, trackDetail.PageDown,
+				trackDetail.ScrollUp, trackDetail.ScrollDown, trackDetail.CopyID, trackDetail.CopyViewID,
+				trackDetail.GoTo, trackDetail.ToggleSort),
+		},
+		{
+			View:    "iteration detail",
+			Actions: detailActions,
+			Reserved: fixedKeys(iterationDetail.Help, iterationDetail.Tab, iterationDetail.Verify,
+				iterationDetail.Skip, iterationDetail.Fail, iterationDetail.PageUp, iterationDetail.PageDown,
+				iterationDetail.ScrollUp, iterationDetail.ScrollDown, iterationDetail.InProgress,
+				iterationDetail.Review, iterationDetail.Done, iterationDetail.Reopen, iterationDetail.Edit,
+				iterationDetail.CopyID, iterationDetail.CopyViewID, iterationDetail.GoTo),
+		},
+		{
+			View:     "error",
+			Actions:  []string{components.ActionBack, components.ActionQuit},
+			Reserved: fixedKeys(goTo),
+		},
+		{
+			View:     "no roadmap",
+			Actions:  []string{components.ActionQuit},
+			Reserved: fixedKeys(newCreateRoadmapKey(), goTo),
+		},
+	}
+}
+
+// fixedKeys collects the keys of bindings
+func fixedKeys(bindings ...key.Binding) []string {
+	var keys []string
+	for _, b := range bindings {
+		keys = append(keys, b.Keys()...)
+	}
+	return keys
+}
@@ -0,0 +1,41 @@
+package presenters_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/presentation/tui/components"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/presentation/tui/presenters"
+)
+
+func TestViewKeys_DefaultsDoNotConflict(t *testing.T) {
+	keymap, warnings := components.ResolveKeyMap(nil, presenters.ViewKeys())
+	if len(warnings) != 0 {
+		t.Errorf("unexpected warnings for the default keys: %v", warnings)
+	}
+	if !reflect.DeepEqual(keymap, components.DefaultKeyMap()) {
+		t.Errorf("keymap = %v, want the defaults", keymap)
+	}
+}
+
+func TestViewKeys_OverridesOfViewShortcutsFallBack(t *testing.T) {
+	// i, d and e are iteration detail shortcuts; s is the dashboard's start iteration key
+	for _, override := range []map[string][]string{
+		{"up": {"i"}},
+		{"down": {"d"}},
+		{"select": {"e"}},
+		{"quit": {":"}},
+		{"reorder-up": {"s"}},
+	} {
+		keymap, warnings := components.ResolveKeyMap(override, presenters.ViewKeys())
+		if !reflect.DeepEqual(keymap, components.DefaultKeyMap()) || len(warnings) != 1 {
+			t.Errorf("override %v: keymap = %v, warnings = %v, want the defaults and one warning", override, keymap, warnings)
+		}
+	}
+
+	// r is only reserved where refresh is not handled
+	keymap, warnings := components.ResolveKeyMap(map[string][]string{"refresh": {"r", "f5"}}, presenters.ViewKeys())
+	if len(warnings) != 0 || !reflect.DeepEqual(keymap[components.ActionRefresh], []string{"r", "f5"}) {
+		t.Errorf("refresh = %v, warnings = %v, want the override kept", keymap[components.ActionRefresh], warnings)
+	}
+}
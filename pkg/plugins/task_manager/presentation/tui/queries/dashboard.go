@@ -13,24 +13,27 @@ import (
 // Returns filtered and transformed view model ready for presentation.
 //
 // Pre-loads:
-// - All iterations
 // - Active roadmap
+// - Iterations of the roadmap (holding its tasks, or no tasks yet)
 // - All tracks for the roadmap
-// - All backlog tasks (not in any iteration)
+// - Backlog tasks of the roadmap (not in any iteration)
+// - AC counts of the backlog tasks (one grouped query)
+// - Tracked success criteria of the roadmap
+// - All roadmaps, to switch between them
 //
 // Eliminates N+1 queries by loading all related data upfront.
 func LoadRoadmapListData(
 	ctx context.Context,
 	repo domain.RoadmapRepository,
 ) (*viewmodels.RoadmapListViewModel, error) {
-	// Fetch all iterations
-	iterations, err := repo.ListIterations(ctx)
+	// Fetch active roadmap
+	roadmap, err := repo.GetActiveRoadmap(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	// Fetch active roadmap
-	roadmap, err := repo.GetActiveRoadmap(ctx)
+	// Fetch the roadmap's iterations
+	iterations, err := repo.ListIterationsByRoadmap(ctx, roadmap.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -41,14 +44,39 @@ func LoadRoadmapListData(
 		return nil, err
 	}
 
-	// Fetch backlog tasks (not in any iteration)
-	backlogTasks, err := repo.GetBacklogTasks(ctx)
+	// Fetch backlog tasks of the roadmap (not in any iteration)
+	backlogTasks, err := repo.GetBacklogTasks(ctx, roadmap.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch AC counts of all backlog tasks at once
+	taskIDs := make([]string, len(backlogTasks))
+	for i, task := range backlogTasks {
+		taskIDs[i] = task.ID
+	}
+	acProgress, err := repo.CountACProgressByTask(ctx, taskIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch tracked success criteria
+	criteria, err := repo.ListRoadmapCriteria(ctx, roadmap.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch all roadmaps for switching
+	roadmaps, err := repo.ListRoadmaps(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	// Transform to view model with filtering
 	vm := transformers.TransformToRoadmapListViewModel(roadmap, iterations, tracks, backlogTasks)
+	transformers.ApplyRoadmapCriteria(vm, criteria)
+	transformers.ApplyRoadmapOptions(vm, roadmaps)
+	transformers.ApplyBacklogACProgress(vm, acProgress)
 
 	return vm, nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain"
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/presentation/tui/transformers"
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/presentation/tui/viewmodels"
 )
@@ -15,12 +16,15 @@ import (
 // - Iteration entity
 // - All tasks in the iteration
 // - All acceptance criteria for all tasks in the iteration
+// - Acceptance criteria gating the iteration's open tasks
 //
-// Eliminates N+1 queries by loading all related data upfront.
+// Eliminates N+1 queries by loading all related data upfront. Progress is computed on
+// the given basis (done tasks or verified ACs).
 func LoadIterationDetailData(
 	ctx context.Context,
 	repo domain.RoadmapRepository,
 	iterationNumber int,
+	basis entities.ProgressBasis,
 ) (*viewmodels.IterationDetailViewModel, error) {
 	// Fetch iteration
 	iteration, err := repo.GetIteration(ctx, iterationNumber)
@@ -40,8 +44,32 @@ func LoadIterationDetailData(
 		return nil, err
 	}
 
+	// Fetch definition of done checklist
+	dodItems, err := repo.ListIterationDoDItems(ctx, iterationNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch the ACs gating open tasks; done tasks are never blocked
+	gates := make(map[string][]*entities.AcceptanceCriteriaEntity)
+	for _, task := range tasks {
+		if task.Status == string(entities.TaskStatusDone) {
+			continue
+		}
+		taskGates, err := repo.ListTaskGates(ctx, task.ID)
+		if err != nil {
+			return nil, err
+		}
+		if len(taskGates) > 0 {
+			gates[task.ID] = taskGates
+		}
+	}
+
 	// Transform to view model
 	vm := transformers.TransformToIterationDetailViewModel(iteration, tasks, acs)
+	transformers.ApplyIterationProgressBasis(vm, basis, tasks, acs)
+	transformers.ApplyIterationDoD(vm, dodItems)
+	transformers.ApplyIterationTaskGates(vm, gates)
 
 	return vm, nil
 }
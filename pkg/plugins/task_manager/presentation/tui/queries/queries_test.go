@@ -11,31 +11,38 @@ import (
 
 // MockRepository is a mock implementation for testing queries.
 type MockRepository struct {
-	iterations          []*entities.IterationEntity
-	activeRoadmap       *entities.RoadmapEntity
-	tracks              []*entities.TrackEntity
-	backlogTasks        []*entities.TaskEntity
-	iteration           *entities.IterationEntity
-	iterationTasks      []*entities.TaskEntity
-	acsByIteration      []*entities.AcceptanceCriteriaEntity
-	task                *entities.TaskEntity
-	acsByTask           []*entities.AcceptanceCriteriaEntity
-	track               *entities.TrackEntity
-	iterationsForTask   []*entities.IterationEntity
-	tasksForTrack       []*entities.TaskEntity
-	dependencyTracks    map[string]*entities.TrackEntity
-	listTracksErr       error
-	listIterationsErr   error
-	getActiveRoadmapErr error
-	getBacklogTasksErr  error
-	getIterationErr     error
-	getIterationTasksErr error
-	listACByIterationErr error
-	getTaskErr          error
-	listACErr           error
-	getTrackErr         error
+	iterations              []*entities.IterationEntity
+	activeRoadmap           *entities.RoadmapEntity
+	roadmaps                []*entities.RoadmapEntity
+	tracks                  []*entities.TrackEntity
+	backlogTasks            []*entities.TaskEntity
+	iteration               *entities.IterationEntity
+	iterationTasks          []*entities.TaskEntity
+	acsByIteration          []*entities.AcceptanceCriteriaEntity
+	task                    *entities.TaskEntity
+	acsByTask               []*entities.AcceptanceCriteriaEntity
+	track                   *entities.TrackEntity
+	iterationsForTask       []*entities.IterationEntity
+	tasksForTrack           []*entities.TaskEntity
+	dependencyTracks        map[string]*entities.TrackEntity
+	roadmapCriteria         []*entities.RoadmapCriterionEntity
+	dodItems                []*entities.IterationDoDItemEntity
+	listTracksErr           error
+	listIterationsErr       error
+	getActiveRoadmapErr     error
+	getBacklogTasksErr      error
+	getIterationErr         error
+	getIterationTasksErr    error
+	listACByIterationErr    error
+	getTaskErr              error
+	listACErr               error
+	getTrackErr             error
 	getIterationsForTaskErr error
-	listTasksErr        error
+	listTasksErr            error
+	taskGates               map[string][]*entities.AcceptanceCriteriaEntity
+	taskADRs                map[string][]*entities.ADREntity
+	acProgress              map[string]entities.ACProgress
+	acProgressCalls         int
 }
 
 // ListIterations returns all iterations.
@@ -46,6 +53,11 @@ func (m *MockRepository) ListIterations(ctx context.Context) ([]*entities.Iterat
 	return m.iterations, nil
 }
 
+// ListIterationsByRoadmap returns all iterations.
+func (m *MockRepository) ListIterationsByRoadmap(ctx context.Context, roadmapID string) ([]*entities.IterationEntity, error) {
+	return m.ListIterations(ctx)
+}
+
 // GetActiveRoadmap returns the active roadmap.
 func (m *MockRepository) GetActiveRoadmap(ctx context.Context) (*entities.RoadmapEntity, error) {
 	if m.getActiveRoadmapErr != nil {
@@ -54,6 +66,11 @@ func (m *MockRepository) GetActiveRoadmap(ctx context.Context) (*entities.Roadma
 	return m.activeRoadmap, nil
 }
 
+// ListRoadmaps returns all roadmaps.
+func (m *MockRepository) ListRoadmaps(ctx context.Context) ([]*entities.RoadmapEntity, error) {
+	return m.roadmaps, nil
+}
+
 // ListTracks returns all tracks.
 func (m *MockRepository) ListTracks(ctx context.Context, roadmapID string, filters entities.TrackFilters) ([]*entities.TrackEntity, error) {
 	if m.listTracksErr != nil {
@@ -63,13 +80,18 @@ func (m *MockRepository) ListTracks(ctx context.Context, roadmapID string, filte
 }
 
 // GetBacklogTasks returns backlog tasks.
-func (m *MockRepository) GetBacklogTasks(ctx context.Context) ([]*entities.TaskEntity, error) {
+func (m *MockRepository) GetBacklogTasks(ctx context.Context, roadmapID string) ([]*entities.TaskEntity, error) {
 	if m.getBacklogTasksErr != nil {
 		return nil, m.getBacklogTasksErr
 	}
 	return m.backlogTasks, nil
 }
 
+// SaveTaskNote saves a task note.
+func (m *MockRepository) SaveTaskNote(ctx context.Context, note *entities.TaskNoteEntity) error {
+	return nil
+}
+
 // GetIteration returns an iteration.
 func (m *MockRepository) GetIteration(ctx context.Context, number int) (*entities.IterationEntity, error) {
 	if m.getIterationErr != nil {
@@ -132,6 +154,11 @@ func (m *MockRepository) GetIterationsForTask(ctx context.Context, taskID string
 	return m.iterationsForTask, nil
 }
 
+// ListRoadmapCriteria returns tracked success criteria.
+func (m *MockRepository) ListRoadmapCriteria(ctx context.Context, roadmapID string) ([]*entities.RoadmapCriterionEntity, error) {
+	return m.roadmapCriteria, nil
+}
+
 // TestLoadRoadmapListDataSuccess verifies that LoadRoadmapListData successfully loads and transforms data.
 func TestLoadRoadmapListDataSuccess(t *testing.T) {
 	ctx := context.Background()
@@ -167,10 +194,12 @@ func TestLoadRoadmapListDataSuccess(t *testing.T) {
 	}
 
 	repo := &MockRepository{
-		activeRoadmap:  roadmap,
-		iterations:     iterations,
-		tracks:         tracks,
-		backlogTasks:   tasks,
+		activeRoadmap: roadmap,
+		roadmaps:      []*entities.RoadmapEntity{roadmap, {ID: "roadmap-2", Vision: "Other vision"}},
+		iterations:    iterations,
+		tracks:        tracks,
+		backlogTasks:  tasks,
+		acProgress:    map[string]entities.ACProgress{"task-1": {Total: 2, Done: 1}},
 	}
 
 	vm, err := queries.LoadRoadmapListData(ctx, repo)
@@ -189,6 +218,19 @@ func TestLoadRoadmapListDataSuccess(t *testing.T) {
 	if vm.Vision != "Test vision" {
 		t.Errorf("Expected Vision 'Test vision', got %q", vm.Vision)
 	}
+
+	// All roadmaps are offered for switching, with the loaded one marked active
+	if len(vm.Roadmaps) != 2 || !vm.Roadmaps[0].Active || vm.Roadmaps[1].Active {
+		t.Errorf("Expected roadmap-1 active among 2 roadmap options, got %+v", vm.Roadmaps)
+	}
+
+	// AC counts of all backlog tasks come from one grouped query
+	if repo.acProgressCalls != 1 {
+		t.Errorf("Expected 1 AC count query, got %d", repo.acProgressCalls)
+	}
+	if len(vm.BacklogTasks) != 1 || vm.BacklogTasks[0].ACProgress != "1/2 ✓" {
+		t.Errorf("Expected backlog task with AC progress 1/2 ✓, got %+v", vm.BacklogTasks)
+	}
 }
 
 // TestLoadRoadmapListDataGetActiveRoadmapError verifies error handling when GetActiveRoadmap fails.
@@ -246,7 +288,7 @@ func TestLoadIterationDetailDataSuccess(t *testing.T) {
 		acsByIteration: acs,
 	}
 
-	vm, err := queries.LoadIterationDetailData(ctx, repo, 1)
+	vm, err := queries.LoadIterationDetailData(ctx, repo, 1, entities.ProgressBasisTasks)
 	if err != nil {
 		t.Fatalf("LoadIterationDetailData failed: %v", err)
 	}
@@ -268,7 +310,7 @@ func TestLoadIterationDetailDataGetIterationError(t *testing.T) {
 		getIterationErr: errors.New("iteration not found"),
 	}
 
-	vm, err := queries.LoadIterationDetailData(ctx, repo, 1)
+	vm, err := queries.LoadIterationDetailData(ctx, repo, 1, entities.ProgressBasisTasks)
 	if err == nil {
 		t.Fatal("Expected error but got nil")
 	}
@@ -334,6 +376,93 @@ func TestLoadTaskDetailDataSuccess(t *testing.T) {
 	}
 }
 
+// TestLoadIterationDetailDataTaskGates verifies that open tasks gated on unverified ACs are marked as waiting.
+func TestLoadIterationDetailDataTaskGates(t *testing.T) {
+	ctx := context.Background()
+
+	repo := &MockRepository{
+		iteration: &entities.IterationEntity{Number: 1, Name: "Iteration 1", Status: "current"},
+		iterationTasks: []*entities.TaskEntity{
+			{ID: "task-1", Title: "Gated", TrackID: "track-1", Status: "todo"},
+			{ID: "task-2", Title: "Open gate", TrackID: "track-1", Status: "todo"},
+			{ID: "task-3", Title: "Done", TrackID: "track-1", Status: "done"},
+		},
+		taskGates: map[string][]*entities.AcceptanceCriteriaEntity{
+			"task-1": {{ID: "ac-9", TaskID: "task-0", Status: entities.ACStatusNotStarted}},
+			"task-2": {{ID: "ac-8", TaskID: "task-0", Status: entities.ACStatusVerified}},
+			"task-3": {{ID: "ac-9", TaskID: "task-0", Status: entities.ACStatusNotStarted}},
+		},
+	}
+
+	vm, err := queries.LoadIterationDetailData(ctx, repo, 1, entities.ProgressBasisTasks)
+	if err != nil {
+		t.Fatalf("LoadIterationDetailData failed: %v", err)
+	}
+
+	if len(vm.TODOTasks) != 2 || len(vm.DoneTasks) != 1 {
+		t.Fatalf("Expected 2 todo and 1 done task, got %d and %d", len(vm.TODOTasks), len(vm.DoneTasks))
+	}
+	if got := vm.TODOTasks[0].WaitingOn; got != "waiting on AC ac-9" {
+		t.Errorf("Expected task-1 to wait on ac-9, got %q", got)
+	}
+	if got := vm.TODOTasks[1].WaitingOn; got != "" {
+		t.Errorf("Expected task-2 not to be blocked, got %q", got)
+	}
+	if got := vm.DoneTasks[0].WaitingOn; got != "" {
+		t.Errorf("Expected done task not to be blocked, got %q", got)
+	}
+}
+
+// TestLoadTaskDetailDataTaskGates verifies that a task gated on an unverified AC is marked as waiting.
+func TestLoadTaskDetailDataTaskGates(t *testing.T) {
+	ctx := context.Background()
+
+	repo := &MockRepository{
+		task:  &entities.TaskEntity{ID: "task-1", Title: "Task 1", TrackID: "track-1", Status: "todo"},
+		track: &entities.TrackEntity{ID: "track-1", Title: "Track 1"},
+		taskGates: map[string][]*entities.AcceptanceCriteriaEntity{
+			"task-1": {
+				{ID: "ac-4", TaskID: "task-0", Status: entities.ACStatusFailed},
+				{ID: "ac-5", TaskID: "task-0", Status: entities.ACStatusVerified},
+			},
+		},
+	}
+
+	vm, err := queries.LoadTaskDetailData(ctx, repo, "task-1")
+	if err != nil {
+		t.Fatalf("LoadTaskDetailData failed: %v", err)
+	}
+
+	if vm.WaitingOn != "waiting on AC ac-4" {
+		t.Fatalf("Expected task to wait on ac-4, got %q", vm.WaitingOn)
+	}
+}
+
+// TestLoadTaskDetailDataRelevantADRs verifies that the ADRs linked to a task are listed.
+func TestLoadTaskDetailDataRelevantADRs(t *testing.T) {
+	ctx := context.Background()
+
+	repo := &MockRepository{
+		task:  &entities.TaskEntity{ID: "task-1", Title: "Task 1", TrackID: "track-1", Status: "todo"},
+		track: &entities.TrackEntity{ID: "track-1", Title: "Track 1"},
+		taskADRs: map[string][]*entities.ADREntity{
+			"task-1": {{ID: "adr-2", TrackID: "track-1", Title: "Use SQLite", Status: "accepted"}},
+		},
+	}
+
+	vm, err := queries.LoadTaskDetailData(ctx, repo, "task-1")
+	if err != nil {
+		t.Fatalf("LoadTaskDetailData failed: %v", err)
+	}
+
+	if len(vm.RelevantADRs) != 1 {
+		t.Fatalf("Expected 1 relevant ADR, got %d", len(vm.RelevantADRs))
+	}
+	if adr := vm.RelevantADRs[0]; adr.ID != "adr-2" || adr.Title != "Use SQLite" || adr.Status != "accepted" {
+		t.Errorf("Unexpected relevant ADR: %+v", adr)
+	}
+}
+
 // TestLoadTaskDetailDataGetTaskError verifies error handling when GetTask fails.
 func TestLoadTaskDetailDataGetTaskError(t *testing.T) {
 	ctx := context.Background()
@@ -370,6 +499,22 @@ func (m *MockRepository) UpdateRoadmap(ctx context.Context, roadmap *entities.Ro
 	return nil
 }
 
+func (m *MockRepository) SetActiveRoadmap(ctx context.Context, id string) error {
+	return nil
+}
+
+func (m *MockRepository) SaveRoadmapCriterion(ctx context.Context, criterion *entities.RoadmapCriterionEntity) error {
+	return nil
+}
+
+func (m *MockRepository) GetRoadmapCriterion(ctx context.Context, id int) (*entities.RoadmapCriterionEntity, error) {
+	return nil, nil
+}
+
+func (m *MockRepository) UpdateRoadmapCriterion(ctx context.Context, criterion *entities.RoadmapCriterionEntity) error {
+	return nil
+}
+
 func (m *MockRepository) SaveTrack(ctx context.Context, track *entities.TrackEntity) error {
 	return nil
 }
@@ -441,6 +586,14 @@ func (m *MockRepository) DeleteIteration(ctx context.Context, number int) error
 	return nil
 }
 
+func (m *MockRepository) ListIterationDoDItems(ctx context.Context, iterationNum int) ([]*entities.IterationDoDItemEntity, error) {
+	return m.dodItems, nil
+}
+
+func (m *MockRepository) ListTaskGates(ctx context.Context, taskID string) ([]*entities.AcceptanceCriteriaEntity, error) {
+	return m.taskGates[taskID], nil
+}
+
 func (m *MockRepository) AddTaskToIteration(ctx context.Context, iterationNum int, taskID string) error {
 	return nil
 }
@@ -497,6 +650,14 @@ func (m *MockRepository) GetADRsByTrack(ctx context.Context, trackID string) ([]
 	return nil, nil
 }
 
+func (m *MockRepository) ListADRTasks(ctx context.Context, adrID string) ([]*entities.TaskEntity, error) {
+	return nil, nil
+}
+
+func (m *MockRepository) ListTaskADRs(ctx context.Context, taskID string) ([]*entities.ADREntity, error) {
+	return m.taskADRs[taskID], nil
+}
+
 func (m *MockRepository) SaveAC(ctx context.Context, ac *entities.AcceptanceCriteriaEntity) error {
 	return nil
 }
@@ -525,6 +686,30 @@ func (m *MockRepository) ListFailedAC(ctx context.Context, filters entities.ACFi
 	return nil, nil
 }
 
+func (m *MockRepository) ListACs(ctx context.Context, filters entities.ACFilters) ([]*entities.AcceptanceCriteriaEntity, error) {
+	return nil, nil
+}
+
+// CountACProgressByTask returns the configured AC progress of the given tasks and counts the calls.
+func (m *MockRepository) CountACProgressByTask(ctx context.Context, taskIDs []string) (map[string]entities.ACProgress, error) {
+	m.acProgressCalls++
+	progress := make(map[string]entities.ACProgress)
+	for _, id := range taskIDs {
+		if p, ok := m.acProgress[id]; ok {
+			progress[id] = p
+		}
+	}
+	return progress, nil
+}
+
+func (m *MockRepository) ListTaskACCoverage(ctx context.Context, filters entities.ACFilters, roadmapID string) ([]*entities.TaskACCoverage, error) {
+	return nil, nil
+}
+
+func (m *MockRepository) AddACTag(ctx context.Context, acID, tag string) error {
+	return nil
+}
+
 func (m *MockRepository) GetRoadmapWithTracks(ctx context.Context, roadmapID string) (*entities.RoadmapEntity, error) {
 	return nil, nil
 }
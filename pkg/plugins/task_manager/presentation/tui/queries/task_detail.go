@@ -16,6 +16,8 @@ import (
 // - All acceptance criteria for the task
 // - Track entity that owns the task
 // - All iterations the task belongs to
+// - Acceptance criteria gating the task
+// - ADRs linked to the task
 //
 // Eliminates N+1 queries by loading all related data upfront.
 func LoadTaskDetailData(
@@ -47,8 +49,22 @@ func LoadTaskDetailData(
 		return nil, err
 	}
 
+	// Fetch the ACs gating the task
+	gates, err := repo.ListTaskGates(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch the ADRs linked to the task
+	adrs, err := repo.ListTaskADRs(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Transform to view model
 	vm := transformers.TransformToTaskDetailViewModel(task, acs, track, iterations)
+	transformers.ApplyTaskGates(vm, gates)
+	transformers.ApplyTaskADRs(vm, adrs)
 
 	return vm, nil
 }
@@ -0,0 +1,72 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+// StartView selects the view the TUI opens in instead of the roadmap list
+type StartView struct {
+	View            ViewStateNew
+	IterationNumber int    // Required for ViewIterationDetailNew
+	TrackID         string // Required for ViewTrackDetailNew
+	TaskID          string // Required for ViewTaskDetailNew
+}
+
+// startViewNames maps --view values to views
+var startViewNames = map[string]ViewStateNew{
+	"roadmap":    ViewRoadmapListNew,
+	"iterations": ViewRoadmapListNew,
+	"iteration":  ViewIterationDetailNew,
+	"track":      ViewTrackDetailNew,
+	"task":       ViewTaskDetailNew,
+}
+
+// ParseStartView validates the --view, --number and --id flags and returns the start view.
+// An empty view name selects the roadmap list. Flags that do not apply to the selected
+// view are rejected so the TUI never launches in an ambiguous state.
+func ParseStartView(view string, number int, id string) (StartView, error) {
+	if view == "" {
+		if number != 0 {
+			return StartView{}, fmt.Errorf("%w: --number requires --view iteration", pluginsdk.ErrInvalidArgument)
+		}
+		if id != "" {
+			return StartView{}, fmt.Errorf("%w: --id requires --view track or --view task", pluginsdk.ErrInvalidArgument)
+		}
+		return StartView{View: ViewRoadmapListNew}, nil
+	}
+
+	state, ok := startViewNames[strings.ToLower(view)]
+	if !ok {
+		return StartView{}, fmt.Errorf("%w: unknown view %q (must be roadmap, iterations, iteration, track, or task)", pluginsdk.ErrInvalidArgument, view)
+	}
+
+	switch state {
+	case ViewIterationDetailNew:
+		if id != "" {
+			return StartView{}, fmt.Errorf("%w: --view iteration takes --number, not --id", pluginsdk.ErrInvalidArgument)
+		}
+		if number <= 0 {
+			return StartView{}, fmt.Errorf("%w: --view iteration requires --number <N>", pluginsdk.ErrInvalidArgument)
+		}
+		return StartView{View: state, IterationNumber: number}, nil
+	case ViewTrackDetailNew, ViewTaskDetailNew:
+		if number != 0 {
+			return StartView{}, fmt.Errorf("%w: --view %s takes --id, not --number", pluginsdk.ErrInvalidArgument, view)
+		}
+		if id == "" {
+			return StartView{}, fmt.Errorf("%w: --view %s requires --id <id>", pluginsdk.ErrInvalidArgument, view)
+		}
+		if state == ViewTrackDetailNew {
+			return StartView{View: state, TrackID: id}, nil
+		}
+		return StartView{View: state, TaskID: id}, nil
+	default:
+		if number != 0 || id != "" {
+			return StartView{}, fmt.Errorf("%w: --view %s takes no --number or --id", pluginsdk.ErrInvalidArgument, view)
+		}
+		return StartView{View: state}, nil
+	}
+}
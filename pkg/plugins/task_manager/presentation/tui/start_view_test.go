@@ -0,0 +1,52 @@
+package tui_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/presentation/tui"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+func TestParseStartView(t *testing.T) {
+	tests := []struct {
+		name    string
+		view    string
+		number  int
+		id      string
+		want    tui.StartView
+		wantErr bool
+	}{
+		{name: "default", want: tui.StartView{View: tui.ViewRoadmapListNew}},
+		{name: "iterations", view: "iterations", want: tui.StartView{View: tui.ViewRoadmapListNew}},
+		{name: "iteration", view: "iteration", number: 3, want: tui.StartView{View: tui.ViewIterationDetailNew, IterationNumber: 3}},
+		{name: "track", view: "track", id: "DW-track-3", want: tui.StartView{View: tui.ViewTrackDetailNew, TrackID: "DW-track-3"}},
+		{name: "task", view: "task", id: "DW-task-7", want: tui.StartView{View: tui.ViewTaskDetailNew, TaskID: "DW-task-7"}},
+		{name: "unknown view", view: "sessions", wantErr: true},
+		{name: "number without view", number: 3, wantErr: true},
+		{name: "id without view", id: "DW-track-3", wantErr: true},
+		{name: "iteration without number", view: "iteration", wantErr: true},
+		{name: "iteration with id", view: "iteration", number: 1, id: "DW-track-3", wantErr: true},
+		{name: "track without id", view: "track", wantErr: true},
+		{name: "track with number", view: "track", number: 2, id: "DW-track-3", wantErr: true},
+		{name: "roadmap with number", view: "roadmap", number: 2, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tui.ParseStartView(tt.view, tt.number, tt.id)
+			if tt.wantErr {
+				if !errors.Is(err, pluginsdk.ErrInvalidArgument) {
+					t.Errorf("expected ErrInvalidArgument, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseStartView() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
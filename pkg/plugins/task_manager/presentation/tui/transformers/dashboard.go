@@ -20,6 +20,7 @@ func TransformToRoadmapListViewModel(
 
 	// Include roadmap vision and success criteria
 	if roadmap != nil {
+		vm.RoadmapID = roadmap.ID
 		vm.Vision = roadmap.Vision
 		vm.SuccessCriteria = roadmap.SuccessCriteria
 	}
@@ -76,6 +77,7 @@ func TransformToRoadmapListViewModel(
 				Status:      task.Status,
 				TrackID:     task.TrackID,
 				Description: task.Description,
+				Assignee:    task.Assignee,
 				// Pre-computed display fields
 				StatusLabel: GetTaskStatusLabel(task.Status),
 				StatusColor: GetTaskColor(task.Status),
@@ -87,6 +89,58 @@ func TransformToRoadmapListViewModel(
 	return vm
 }
 
+// ApplyRoadmapCriteria adds tracked success criteria and their progress to the dashboard view model.
+// Leaves CriteriaProgress nil when there are no criteria so the free-text criteria are shown instead.
+func ApplyRoadmapCriteria(vm *viewmodels.RoadmapListViewModel, criteria []*entities.RoadmapCriterionEntity) {
+	if vm == nil || len(criteria) == 0 {
+		return
+	}
+
+	for _, c := range criteria {
+		vm.Criteria = append(vm.Criteria, &viewmodels.RoadmapCriterionViewModel{
+			ID:   c.ID,
+			Text: c.Text,
+			Met:  c.Met,
+		})
+	}
+
+	met, total := entities.CountMetCriteria(criteria)
+	vm.CriteriaProgress = viewmodels.NewProgressViewModel(met, total)
+}
+
+// ApplyRoadmapOptions adds the unarchived roadmaps the dashboard can switch between,
+// in creation order, marking the one shown (vm.RoadmapID) as active
+func ApplyRoadmapOptions(vm *viewmodels.RoadmapListViewModel, roadmaps []*entities.RoadmapEntity) {
+	if vm == nil {
+		return
+	}
+
+	for _, roadmap := range roadmaps {
+		if roadmap.IsArchived() {
+			continue
+		}
+		vm.Roadmaps = append(vm.Roadmaps, &viewmodels.RoadmapOptionViewModel{
+			ID:     roadmap.ID,
+			Vision: roadmap.Vision,
+			Active: roadmap.ID == vm.RoadmapID,
+		})
+	}
+}
+
+// ApplyBacklogACProgress adds the AC progress of each backlog task to the dashboard view model.
+// progress maps task IDs to their AC counts; tasks without ACs are left without progress.
+func ApplyBacklogACProgress(vm *viewmodels.RoadmapListViewModel, progress map[string]entities.ACProgress) {
+	if vm == nil {
+		return
+	}
+
+	for _, task := range vm.BacklogTasks {
+		p := progress[task.ID]
+		task.ACProgress = FormatACProgress(p)
+		task.HasFailedACs = p.Failed > 0
+	}
+}
+
 // FilterActiveIterations returns iterations with status != "complete"
 func FilterActiveIterations(iterations []*entities.IterationEntity) []*entities.IterationEntity {
 	active := []*entities.IterationEntity{}
@@ -365,3 +365,76 @@ func TestTransformToRoadmapListViewModel_RoadmapNil(t *testing.T) {
 		t.Errorf("expected 1 iteration, got %d", len(vm.ActiveIterations))
 	}
 }
+
+// TestApplyRoadmapCriteria verifies that tracked criteria populate the progress summary
+func TestApplyRoadmapCriteria(t *testing.T) {
+	now := time.Now()
+	vm := transformers.TransformToRoadmapListViewModel(nil, nil, nil, nil)
+
+	transformers.ApplyRoadmapCriteria(vm, nil)
+	if vm.CriteriaProgress != nil {
+		t.Fatal("expected nil criteria progress without criteria")
+	}
+
+	met, _ := entities.NewRoadmapCriterionEntity("roadmap-1", "Support 10 plugins", now)
+	met.Check(now)
+	unmet, _ := entities.NewRoadmapCriterionEntity("roadmap-1", "Zero violations", now)
+
+	transformers.ApplyRoadmapCriteria(vm, []*entities.RoadmapCriterionEntity{met, unmet})
+
+	if vm.CriteriaProgress == nil {
+		t.Fatal("expected criteria progress to be set")
+	}
+	if vm.CriteriaProgress.Completed != 1 || vm.CriteriaProgress.Total != 2 {
+		t.Errorf("expected 1/2 met, got %d/%d", vm.CriteriaProgress.Completed, vm.CriteriaProgress.Total)
+	}
+	if len(vm.Criteria) != 2 || !vm.Criteria[0].Met || vm.Criteria[1].Met {
+		t.Errorf("unexpected criteria view models: %+v", vm.Criteria)
+	}
+}
+
+func TestApplyRoadmapOptions(t *testing.T) {
+	now := time.Now()
+	active := &entities.RoadmapEntity{ID: "roadmap-2", Vision: "Platform v2", CreatedAt: now, UpdatedAt: now}
+	vm := transformers.TransformToRoadmapListViewModel(active, nil, nil, nil)
+
+	archivedAt := now
+	transformers.ApplyRoadmapOptions(vm, []*entities.RoadmapEntity{
+		{ID: "roadmap-0", Vision: "Abandoned plan", ArchivedAt: &archivedAt},
+		{ID: "roadmap-1", Vision: "Platform v1"},
+		active,
+	})
+
+	if vm.RoadmapID != "roadmap-2" {
+		t.Errorf("RoadmapID = %q, want roadmap-2", vm.RoadmapID)
+	}
+	if len(vm.Roadmaps) != 2 {
+		t.Fatalf("expected 2 unarchived roadmaps, got %d", len(vm.Roadmaps))
+	}
+	if vm.Roadmaps[0].ID != "roadmap-1" || vm.Roadmaps[0].Active {
+		t.Errorf("unexpected first roadmap option: %+v", vm.Roadmaps[0])
+	}
+	if vm.Roadmaps[1].ID != "roadmap-2" || !vm.Roadmaps[1].Active {
+		t.Errorf("unexpected second roadmap option: %+v", vm.Roadmaps[1])
+	}
+}
+
+func TestApplyBacklogACProgress(t *testing.T) {
+	now := time.Now()
+	tasks := []*entities.TaskEntity{
+		{ID: "TM-task-1", TrackID: "TM-track-1", Title: "Task 1", Status: "todo", CreatedAt: now, UpdatedAt: now},
+		{ID: "TM-task-2", TrackID: "TM-track-1", Title: "Task 2", Status: "todo", CreatedAt: now, UpdatedAt: now},
+	}
+	vm := transformers.TransformToRoadmapListViewModel(nil, nil, nil, tasks)
+
+	transformers.ApplyBacklogACProgress(vm, map[string]entities.ACProgress{
+		"TM-task-1": {Total: 3, Done: 2, Failed: 1},
+	})
+
+	if vm.BacklogTasks[0].ACProgress != "2/3 ✓" || !vm.BacklogTasks[0].HasFailedACs {
+		t.Errorf("expected 2/3 ✓ with failed ACs, got %q (failed=%v)", vm.BacklogTasks[0].ACProgress, vm.BacklogTasks[0].HasFailedACs)
+	}
+	if vm.BacklogTasks[1].ACProgress != "" || vm.BacklogTasks[1].HasFailedACs {
+		t.Errorf("expected no AC progress for a task without ACs, got %q", vm.BacklogTasks[1].ACProgress)
+	}
+}
@@ -1,6 +1,8 @@
 package transformers
 
 import (
+	"fmt"
+
 	"github.com/kgatilin/darwinflow-pub/pkg/plugins/task_manager/domain/entities"
 )
 
@@ -206,3 +208,11 @@ func GetACStatusLabel(status entities.AcceptanceCriteriaStatus) string {
 		return string(status)
 	}
 }
+
+// FormatACProgress returns a task's AC progress as "done/total ✓", or "" if it has no ACs
+func FormatACProgress(progress entities.ACProgress) string {
+	if progress.Total == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%d/%d ✓", progress.Done, progress.Total)
+}
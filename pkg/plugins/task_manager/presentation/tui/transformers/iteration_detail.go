@@ -33,6 +33,7 @@ func TransformToIterationDetailViewModel(
 	}
 
 	// Group tasks by status and create task map
+	acProgress := entities.CountACProgress(acs)
 	taskMap := make(map[string]*viewmodels.TaskRowViewModel)
 	for _, task := range tasks {
 		taskRow := &viewmodels.TaskRowViewModel{
@@ -40,10 +41,13 @@ func TransformToIterationDetailViewModel(
 			Title:       task.Title,
 			Status:      task.Status,
 			Description: task.Description,
+			Assignee:    task.Assignee,
 			// Pre-computed display fields
-			StatusLabel: GetTaskStatusLabel(task.Status),
-			StatusColor: GetTaskColor(task.Status),
-			Icon:        GetTaskIcon(task.Status),
+			StatusLabel:  GetTaskStatusLabel(task.Status),
+			StatusColor:  GetTaskColor(task.Status),
+			Icon:         GetTaskIcon(task.Status),
+			ACProgress:   FormatACProgress(acProgress[task.ID]),
+			HasFailedACs: acProgress[task.ID].Failed > 0,
 		}
 
 		// Store in map for AC grouping
@@ -94,9 +98,50 @@ func TransformToIterationDetailViewModel(
 	}
 
 	// Calculate progress (done tasks / total tasks)
-	totalTasks := len(tasks)
-	doneTasks := len(vm.DoneTasks)
-	vm.Progress = viewmodels.NewProgressViewModel(doneTasks, totalTasks)
+	ApplyIterationProgressBasis(vm, entities.ProgressBasisTasks, tasks, acs)
 
 	return vm
 }
+
+// ApplyIterationProgressBasis computes the iteration's progress on the given basis (done
+// tasks or verified ACs) and labels it with the basis
+func ApplyIterationProgressBasis(vm *viewmodels.IterationDetailViewModel, basis entities.ProgressBasis, tasks []*entities.TaskEntity, acs []*entities.AcceptanceCriteriaEntity) {
+	if vm == nil {
+		return
+	}
+	completed, total := entities.IterationProgress(basis, tasks, acs)
+	vm.Progress = viewmodels.NewProgressViewModel(completed, total)
+	vm.ProgressLabel = basis.Label()
+}
+
+// ApplyIterationDoD adds the iteration's definition of done checklist and its progress to the view model
+func ApplyIterationDoD(vm *viewmodels.IterationDetailViewModel, items []*entities.IterationDoDItemEntity) {
+	if vm == nil || len(items) == 0 {
+		return
+	}
+
+	for _, item := range items {
+		vm.DoDItems = append(vm.DoDItems, &viewmodels.IterationDoDItemViewModel{
+			ID:   item.ID,
+			Text: item.Text,
+			Done: item.Done,
+		})
+	}
+
+	done, total := entities.CountDoneDoDItems(items)
+	vm.DoDProgress = viewmodels.NewProgressViewModel(done, total)
+}
+
+// ApplyIterationTaskGates marks the iteration's open tasks as blocked while any AC they
+// are gated on is unverified. gates maps task IDs to their gating ACs.
+func ApplyIterationTaskGates(vm *viewmodels.IterationDetailViewModel, gates map[string][]*entities.AcceptanceCriteriaEntity) {
+	if vm == nil || len(gates) == 0 {
+		return
+	}
+
+	for _, rows := range [][]*viewmodels.TaskRowViewModel{vm.TODOTasks, vm.InProgressTasks, vm.ReviewTasks} {
+		for _, row := range rows {
+			row.WaitingOn = entities.WaitingOnLabel(entities.PendingGates(gates[row.ID]))
+		}
+	}
+}
@@ -359,3 +359,89 @@ func TestTransformToIterationDetailViewModel_TaskWithoutACs(t *testing.T) {
 		t.Errorf("expected 1 AC in group, got %d", len(vm.TaskACs[0].ACs))
 	}
 }
+
+func TestTransformToIterationDetailViewModel_TaskACProgress(t *testing.T) {
+	now := time.Now()
+	iteration, err := entities.NewIterationEntity(1, "Sprint 1", "Goal", "Deliverable", []string{"TM-task-1", "TM-task-2"}, "current", 100, time.Time{}, time.Time{}, now, now)
+	if err != nil {
+		t.Fatalf("failed to create iteration: %v", err)
+	}
+	tasks := []*entities.TaskEntity{
+		mustCreateTask("TM-task-1", "TM-track-1", "Task 1", "", "in-progress", 100, "", now, now),
+		mustCreateTask("TM-task-2", "TM-track-1", "Task 2", "", "todo", 200, "", now, now),
+	}
+	verified := entities.NewAcceptanceCriteriaEntity("TM-ac-1", "TM-task-1", "AC 1", entities.VerificationTypeManual, "", now, now)
+	verified.Status = entities.ACStatusVerified
+	failed := entities.NewAcceptanceCriteriaEntity("TM-ac-2", "TM-task-1", "AC 2", entities.VerificationTypeManual, "", now, now)
+	failed.Status = entities.ACStatusFailed
+
+	vm := transformers.TransformToIterationDetailViewModel(iteration, tasks, []*entities.AcceptanceCriteriaEntity{verified, failed})
+
+	row := vm.InProgressTasks[0]
+	if row.ACProgress != "1/2 ✓" || !row.HasFailedACs {
+		t.Errorf("expected 1/2 ✓ with failed ACs, got %q (failed=%v)", row.ACProgress, row.HasFailedACs)
+	}
+	if row := vm.TODOTasks[0]; row.ACProgress != "" || row.HasFailedACs {
+		t.Errorf("expected no AC progress for a task without ACs, got %q", row.ACProgress)
+	}
+}
+
+// TestApplyIterationProgressBasis verifies that progress can be measured in verified ACs
+func TestApplyIterationProgressBasis(t *testing.T) {
+	now := time.Now()
+	iteration, err := entities.NewIterationEntity(1, "Sprint 1", "Goal", "", []string{"TM-task-1", "TM-task-2"}, "current", 100, now, time.Time{}, now, now)
+	if err != nil {
+		t.Fatalf("failed to create iteration: %v", err)
+	}
+	tasks := []*entities.TaskEntity{
+		mustCreateTask("TM-task-1", "TM-track-1", "Task 1", "", "done", 100, "", now, now),
+		mustCreateTask("TM-task-2", "TM-track-1", "Task 2", "", "todo", 200, "", now, now),
+	}
+	acs := []*entities.AcceptanceCriteriaEntity{
+		entities.NewAcceptanceCriteriaEntity("TM-ac-1", "TM-task-2", "AC 1", entities.VerificationTypeManual, "", now, now),
+		entities.NewAcceptanceCriteriaEntity("TM-ac-2", "TM-task-2", "AC 2", entities.VerificationTypeManual, "", now, now),
+		entities.NewAcceptanceCriteriaEntity("TM-ac-3", "TM-task-2", "AC 3", entities.VerificationTypeManual, "", now, now),
+	}
+	acs[0].Status = entities.ACStatusAutomaticallyVerified
+
+	vm := transformers.TransformToIterationDetailViewModel(iteration, tasks, acs)
+	if vm.ProgressLabel != "tasks" || vm.Progress.Completed != 1 || vm.Progress.Total != 2 {
+		t.Errorf("expected task progress 1/2, got %s %d/%d", vm.ProgressLabel, vm.Progress.Completed, vm.Progress.Total)
+	}
+
+	transformers.ApplyIterationProgressBasis(vm, entities.ProgressBasisACs, tasks, acs)
+	if vm.ProgressLabel != "ACs" || vm.Progress.Completed != 1 || vm.Progress.Total != 3 {
+		t.Errorf("expected AC progress 1/3, got %s %d/%d", vm.ProgressLabel, vm.Progress.Completed, vm.Progress.Total)
+	}
+}
+
+// TestApplyIterationDoD verifies that the definition of done populates the checklist progress
+func TestApplyIterationDoD(t *testing.T) {
+	now := time.Now()
+	iteration, err := entities.NewIterationEntity(1, "Sprint 1", "Goal", "", []string{}, "current", 100, now, time.Time{}, now, now)
+	if err != nil {
+		t.Fatalf("failed to create iteration: %v", err)
+	}
+	vm := transformers.TransformToIterationDetailViewModel(iteration, nil, nil)
+
+	transformers.ApplyIterationDoD(vm, nil)
+	if vm.DoDProgress != nil {
+		t.Fatal("expected nil definition of done progress without items")
+	}
+
+	done, _ := entities.NewIterationDoDItemEntity(1, "Demo recorded", now)
+	done.Check(now)
+	open, _ := entities.NewIterationDoDItemEntity(1, "Changelog written", now)
+
+	transformers.ApplyIterationDoD(vm, []*entities.IterationDoDItemEntity{done, open})
+
+	if vm.DoDProgress == nil {
+		t.Fatal("expected definition of done progress to be set")
+	}
+	if vm.DoDProgress.Completed != 1 || vm.DoDProgress.Total != 2 {
+		t.Errorf("expected 1/2 done, got %d/%d", vm.DoDProgress.Completed, vm.DoDProgress.Total)
+	}
+	if len(vm.DoDItems) != 2 || !vm.DoDItems[0].Done || vm.DoDItems[1].Done {
+		t.Errorf("unexpected definition of done view models: %+v", vm.DoDItems)
+	}
+}
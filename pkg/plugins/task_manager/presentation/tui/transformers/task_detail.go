@@ -19,6 +19,7 @@ func TransformToTaskDetailViewModel(
 		task.Status,
 		task.Branch,
 	)
+	vm.Assignee = task.Assignee
 
 	// Pre-compute display fields for task
 	vm.StatusLabel = GetTaskStatusLabel(task.Status)
@@ -76,3 +77,26 @@ func TransformToTaskDetailViewModel(
 
 	return vm
 }
+
+// ApplyTaskGates marks the task as blocked while any AC it is gated on is unverified.
+// Done tasks are never shown as blocked.
+func ApplyTaskGates(vm *viewmodels.TaskDetailViewModel, gates []*entities.AcceptanceCriteriaEntity) {
+	if vm == nil || vm.Status == string(entities.TaskStatusDone) {
+		return
+	}
+	vm.WaitingOn = entities.WaitingOnLabel(entities.PendingGates(gates))
+}
+
+// ApplyTaskADRs lists the ADRs linked to the task.
+func ApplyTaskADRs(vm *viewmodels.TaskDetailViewModel, adrs []*entities.ADREntity) {
+	if vm == nil {
+		return
+	}
+	for _, adr := range adrs {
+		vm.RelevantADRs = append(vm.RelevantADRs, &viewmodels.ADRRefViewModel{
+			ID:     adr.ID,
+			Title:  adr.Title,
+			Status: adr.Status,
+		})
+	}
+}
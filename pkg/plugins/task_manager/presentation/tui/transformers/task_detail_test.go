@@ -230,3 +230,36 @@ func TestTransformToTaskDetailViewModel_TimestampFormat(t *testing.T) {
 		t.Errorf("expected UpdatedAt %q, got %q", expectedUpdatedAt, vm.UpdatedAt)
 	}
 }
+
+func TestApplyTaskGates(t *testing.T) {
+	now := time.Now()
+	gates := []*entities.AcceptanceCriteriaEntity{
+		{ID: "TM-ac-4", TaskID: "TM-task-2", Status: entities.ACStatusNotStarted},
+		{ID: "TM-ac-5", TaskID: "TM-task-2", Status: entities.ACStatusVerified},
+	}
+
+	tests := []struct {
+		name   string
+		status string
+		gates  []*entities.AcceptanceCriteriaEntity
+		want   string
+	}{
+		{"todo task with pending gate", "todo", gates, "waiting on AC TM-ac-4"},
+		{"in-progress task with pending gate", "in-progress", gates, "waiting on AC TM-ac-4"},
+		{"done task is never blocked", "done", gates, ""},
+		{"all gates verified", "todo", gates[1:], ""},
+		{"no gates", "todo", nil, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := mustCreateTask("TM-task-1", "TM-track-1", "Test Task", "", tt.status, 100, "", now, now)
+			vm := transformers.TransformToTaskDetailViewModel(task, nil, nil, nil)
+
+			transformers.ApplyTaskGates(vm, tt.gates)
+
+			if vm.WaitingOn != tt.want {
+				t.Errorf("WaitingOn = %q, want %q", vm.WaitingOn, tt.want)
+			}
+		})
+	}
+}
@@ -52,6 +52,8 @@ func TransformToTrackDetailViewModel(
 			Title:       task.Title,
 			Status:      task.Status,
 			Description: task.Description,
+			Assignee:    task.Assignee,
+			Rank:        task.Rank,
 			// Pre-computed display fields
 			StatusLabel: GetTaskStatusLabel(task.Status),
 			StatusColor: GetTaskColor(task.Status),
@@ -35,16 +35,37 @@ type BacklogTaskViewModel struct {
 	Status      string
 	TrackID     string
 	Description string
+	Assignee    string // Empty when unassigned
 	// Display fields (pre-computed by transformer)
-	StatusLabel string // Human-readable status label
-	StatusColor string // Color name for status styling
-	Icon        string // Status icon
+	StatusLabel  string // Human-readable status label
+	StatusColor  string // Color name for status styling
+	Icon         string // Status icon
+	ACProgress   string // e.g. "2/3 ✓"; empty when the task has no ACs
+	HasFailedACs bool   // True if any of the task's ACs failed verification
+}
+
+// RoadmapCriterionViewModel represents a single tracked success criterion
+type RoadmapCriterionViewModel struct {
+	ID   int
+	Text string
+	Met  bool
+}
+
+// RoadmapOptionViewModel represents an unarchived roadmap the dashboard can switch to
+type RoadmapOptionViewModel struct {
+	ID     string
+	Vision string
+	Active bool
 }
 
 // RoadmapListViewModel represents the dashboard view with filtered data
 type RoadmapListViewModel struct {
+	RoadmapID        string
+	Roadmaps         []*RoadmapOptionViewModel // Unarchived roadmaps in creation order; empty until ApplyRoadmapOptions
 	Vision           string
 	SuccessCriteria  string
+	Criteria         []*RoadmapCriterionViewModel
+	CriteriaProgress *ProgressViewModel // nil when the roadmap has no tracked criteria
 	ActiveIterations []*IterationCardViewModel
 	ActiveTracks     []*TrackCardViewModel
 	BacklogTasks     []*BacklogTaskViewModel
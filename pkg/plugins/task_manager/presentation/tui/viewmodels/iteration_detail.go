@@ -26,10 +26,14 @@ type TaskRowViewModel struct {
 	Title       string
 	Status      string
 	Description string
+	Assignee    string // Empty when unassigned
+	WaitingOn   string // e.g. "waiting on AC DW-ac-4" while gating ACs are unverified; empty otherwise
 	// Display fields (pre-computed by transformer)
-	StatusLabel string // Human-readable status label
-	StatusColor string // Color name for status styling
-	Icon        string // Status icon
+	StatusLabel  string // Human-readable status label
+	StatusColor  string // Color name for status styling
+	Icon         string // Status icon
+	ACProgress   string // e.g. "2/3 ✓"; empty when the task has no ACs
+	HasFailedACs bool   // True if any of the task's ACs failed verification
 }
 
 // IterationACViewModel represents an AC row with skipped status support
@@ -47,6 +51,13 @@ type IterationACViewModel struct {
 	IsFailed    bool   // True if status is "failed" (for highlighting)
 }
 
+// IterationDoDItemViewModel represents a definition of done item in the iteration detail view
+type IterationDoDItemViewModel struct {
+	ID   int
+	Text string
+	Done bool
+}
+
 // TaskACGroupViewModel represents a task with its ACs grouped together
 type TaskACGroupViewModel struct {
 	Task *TaskRowViewModel
@@ -76,7 +87,12 @@ type IterationDetailViewModel struct {
 	TaskACs []*TaskACGroupViewModel
 
 	// Progress tracking
-	Progress *ProgressViewModel
+	Progress      *ProgressViewModel
+	ProgressLabel string // Unit progress is measured in: "tasks" or "ACs"
+
+	// Definition of done checklist
+	DoDItems    []*IterationDoDItemViewModel
+	DoDProgress *ProgressViewModel // nil when the iteration has no definition of done
 
 	// Display fields (pre-computed by transformer)
 	StatusLabel string // Human-readable status label
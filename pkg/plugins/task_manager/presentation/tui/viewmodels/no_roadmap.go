@@ -0,0 +1,18 @@
+package viewmodels
+
+// NoRoadmapCreateCommand is the CLI command suggested by the no-roadmap empty state
+const NoRoadmapCreateCommand = "dw task-manager roadmap init"
+
+// NoRoadmapViewModel represents the empty state shown when the project has no roadmap yet
+type NoRoadmapViewModel struct {
+	ProjectName   string
+	CreateCommand string
+}
+
+// NewNoRoadmapViewModel creates the no-roadmap empty state for a project
+func NewNoRoadmapViewModel(projectName string) *NoRoadmapViewModel {
+	return &NoRoadmapViewModel{
+		ProjectName:   projectName,
+		CreateCommand: NoRoadmapCreateCommand,
+	}
+}
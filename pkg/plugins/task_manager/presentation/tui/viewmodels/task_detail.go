@@ -38,6 +38,13 @@ type IterationMembershipViewModel struct {
 	Icon        string // Status icon
 }
 
+// ADRRefViewModel represents an ADR linked to a task
+type ADRRefViewModel struct {
+	ID     string
+	Title  string
+	Status string
+}
+
 // TaskDetailViewModel represents the task detail view with expandable ACs
 type TaskDetailViewModel struct {
 	// Task metadata
@@ -46,6 +53,7 @@ type TaskDetailViewModel struct {
 	Description string
 	Status      string
 	Branch      string
+	Assignee    string // Empty when unassigned
 	CreatedAt   string
 	UpdatedAt   string
 
@@ -55,6 +63,13 @@ type TaskDetailViewModel struct {
 	// Iteration membership
 	Iterations []*IterationMembershipViewModel
 
+	// ADRs linked to the task (see 'adr link-task')
+	RelevantADRs []*ADRRefViewModel
+
+	// WaitingOn describes the unverified ACs gating the task, e.g. "waiting on AC DW-ac-4".
+	// Empty when the task is not blocked.
+	WaitingOn string
+
 	// Acceptance criteria with expandable testing instructions
 	AcceptanceCriteria []*ACDetailViewModel
 
@@ -6,6 +6,8 @@ type TrackDetailTaskViewModel struct {
 	Title       string
 	Status      string
 	Description string
+	Assignee    string // Empty when unassigned
+	Rank        int    // Priority within the track (lower first)
 	// Display fields (pre-computed by transformer)
 	StatusLabel string // Human-readable status label
 	StatusColor string // Color name for status styling
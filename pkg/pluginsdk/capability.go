@@ -1,13 +1,18 @@
 package pluginsdk
 
-import "context"
+import (
+	"context"
+	"database/sql"
+)
 
 // IEntityProvider is a plugin capability for providing queryable entities.
 // Plugins that implement this can be queried for entities via the framework's registry.
 type IEntityProvider interface {
 	Plugin
 
-	// GetEntityTypes returns metadata about all entity types this plugin provides
+	// GetEntityTypes returns metadata about all entity types this plugin provides.
+	// Type names must be unique across plugins and must not contain ":": the host
+	// refuses to load a plugin declaring a type another plugin already provides.
 	GetEntityTypes() []EntityTypeInfo
 
 	// Query returns entities matching the given query criteria
@@ -27,6 +32,18 @@ type IEntityUpdater interface {
 	UpdateEntity(ctx context.Context, entityID string, fields map[string]interface{}) (IExtensible, error)
 }
 
+// IEntitySchemaProvider is a plugin capability for describing the fields of entity types.
+// `dw plugin schema` renders the descriptions as JSON Schema, so external consumers can
+// validate QueryEntities results and generate types for them.
+type IEntitySchemaProvider interface {
+	IEntityProvider
+
+	// GetEntitySchema returns the field definitions of one of the plugin's entity types.
+	// Unknown types return an error wrapping ErrNotFound; external plugins report them
+	// with an RPCErrorNotFound error.
+	GetEntitySchema(entityType string) (*EntitySchema, error)
+}
+
 // ICommandProvider is a plugin capability for providing CLI commands.
 // Plugins that implement this can register commands accessible via `dw project <command>`.
 type ICommandProvider interface {
@@ -66,6 +83,49 @@ type IEventEmitter interface {
 	StopEventStream() error
 }
 
+// IEventHandler is a plugin capability for reacting to logged events.
+// `dw logs watch --plugin <name>` forwards every newly stored event to the plugin,
+// letting an external process react to activity in real time (e.g. a notifier).
+//
+// Events are delivered one at a time: the next event is only sent once HandleEvent
+// returns, so a slow handler holds the stream back instead of losing events.
+// The stored event's ID is passed in Metadata["event_id"].
+type IEventHandler interface {
+	Plugin
+
+	// HandleEvent processes one event. A returned error is reported by the
+	// framework and does not stop the stream.
+	HandleEvent(ctx context.Context, event Event) error
+}
+
+// IHealthChecker is a plugin capability for reporting plugin health.
+// `dw doctor` pings every plugin; plugins that implement this can report problems
+// with their own setup (missing data directories, unreadable configuration, ...).
+type IHealthChecker interface {
+	Plugin
+
+	// CheckHealth returns nil if the plugin is ready to use, or an error describing
+	// what is wrong and how to fix it. It must not modify any state.
+	CheckHealth(ctx context.Context) error
+}
+
+// ISchemaMigrator is a plugin capability for migrating plugin-owned database tables.
+// `dw refresh` calls Migrate on every registered plugin that implements it, after the
+// core schema has been updated, so plugins can evolve their schema on upgrade.
+type ISchemaMigrator interface {
+	Plugin
+
+	// Migrate brings the plugin's schema up to date. db is the framework database;
+	// plugins that keep their own databases may migrate those instead.
+	// Migrate must be idempotent: running it on an up-to-date schema changes nothing.
+	// ApplySchemaMigrations records applied versions to make this easy.
+	Migrate(ctx context.Context, db *sql.DB) error
+
+	// MigrationDependencies returns the names of plugins whose migrations must run
+	// before this plugin's. Names of plugins that are not registered are ignored.
+	MigrationDependencies() []string
+}
+
 // EntityTypeInfo describes an entity type provided by a plugin
 type EntityTypeInfo struct {
 	// Type is the unique identifier for this entity type (e.g., "session", "task")
@@ -114,4 +174,26 @@ type EntityQuery struct {
 	// SortDesc indicates whether to sort in descending order.
 	// False means ascending order.
 	SortDesc bool
+
+	// Fields optionally projects the returned entities to the named fields;
+	// "id" and "type" are always included. Empty means all fields.
+	// Plugins may ignore the projection and return full entities, so callers
+	// must tolerate fields they did not ask for.
+	Fields []string
+}
+
+// ProjectFields returns the entries of data named in fields plus "id" and
+// "type", for plugins honoring EntityQuery.Fields. An empty fields list
+// returns data unchanged.
+func ProjectFields(data map[string]interface{}, fields []string) map[string]interface{} {
+	if len(fields) == 0 {
+		return data
+	}
+	projected := make(map[string]interface{}, len(fields)+2)
+	for _, name := range append([]string{"id", "type"}, fields...) {
+		if value, ok := data[name]; ok {
+			projected[name] = value
+		}
+	}
+	return projected
 }
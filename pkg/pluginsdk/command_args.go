@@ -0,0 +1,240 @@
+package pluginsdk
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+// Value types a command flag can declare
+const (
+	ArgTypeString = "string"
+	ArgTypeInt    = "int"
+	ArgTypeBool   = "bool" // Takes no value: present means true
+)
+
+// FlagSpec declares one "--name value" flag of a command
+type FlagSpec struct {
+	// Name is the flag name without dashes (e.g., "description")
+	Name string
+
+	// Type is one of the ArgType constants (default ArgTypeString)
+	Type string
+
+	// Value is the placeholder shown in usage (e.g., "desc"); defaults to "value" or "n"
+	Value string
+
+	// Required flags must be given
+	Required bool
+
+	// Repeatable flags may be given more than once; others at most once
+	Repeatable bool
+
+	// Choices, if set, are the only accepted values
+	Choices []string
+
+	// Description is shown in the generated help
+	Description string
+}
+
+// PositionalSpec declares one positional argument of a command
+type PositionalSpec struct {
+	// Name is shown in usage and errors as <name> (e.g., "task-id")
+	Name string
+
+	// Required positionals must be given; optional ones must come after all required ones
+	Required bool
+
+	// Variadic marks the last positional as taking all remaining positional arguments
+	Variadic bool
+
+	// Description is shown in the generated help
+	Description string
+}
+
+// CommandArgSpec declares the arguments a command accepts. Positionals are the
+// arguments that are neither flags nor flag values, in order.
+type CommandArgSpec struct {
+	Positionals []PositionalSpec
+	Flags       []FlagSpec
+}
+
+// ArgSpecProvider is implemented by commands that declare their arguments. The
+// framework then rejects invalid arguments with uniform ErrInvalidArgument errors
+// before Execute is called, and generates the argument and flag sections of the
+// command's help (and its usage line if GetUsage returns ""). Commands that don't
+// implement it receive their arguments unchecked.
+type ArgSpecProvider interface {
+	GetArgSpec() CommandArgSpec
+}
+
+// Validate checks args against the spec: unknown flags, missing flag values,
+// non-integer values of int flags, values outside Choices, repeated flags,
+// missing required flags and positionals, and extra positionals.
+func (s CommandArgSpec) Validate(args []string) error {
+	flags := make(map[string]FlagSpec, len(s.Flags))
+	for _, flag := range s.Flags {
+		flags[flag.Name] = flag
+	}
+
+	seen := make(map[string]bool)
+	var positionals []string
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if !strings.HasPrefix(arg, "--") || arg == "--" {
+			positionals = append(positionals, arg)
+			continue
+		}
+
+		name := strings.TrimPrefix(arg, "--")
+		flag, ok := flags[name]
+		if !ok {
+			return fmt.Errorf("%w: unknown flag %s", ErrInvalidArgument, arg)
+		}
+		if seen[name] && !flag.Repeatable {
+			return fmt.Errorf("%w: flag %s given more than once", ErrInvalidArgument, arg)
+		}
+		seen[name] = true
+		if flag.Type == ArgTypeBool {
+			continue
+		}
+
+		if i+1 >= len(args) {
+			return fmt.Errorf("%w: flag %s requires a value", ErrInvalidArgument, arg)
+		}
+		i++
+		if err := flag.validateValue(args[i]); err != nil {
+			return err
+		}
+	}
+
+	var missing []string
+	for _, flag := range s.Flags {
+		if flag.Required && !seen[flag.Name] {
+			missing = append(missing, "--"+flag.Name)
+		}
+	}
+	if len(missing) == 1 {
+		return fmt.Errorf("%w: missing required flag %s", ErrInvalidArgument, missing[0])
+	}
+	if len(missing) > 1 {
+		return fmt.Errorf("%w: missing required flags %s", ErrInvalidArgument, strings.Join(missing, ", "))
+	}
+
+	for i, positional := range s.Positionals {
+		if i >= len(positionals) {
+			if positional.Required {
+				return fmt.Errorf("%w: missing required argument <%s>", ErrInvalidArgument, positional.Name)
+			}
+			break
+		}
+		if positional.Variadic {
+			return nil
+		}
+	}
+	if len(positionals) > len(s.Positionals) {
+		return fmt.Errorf("%w: unexpected argument '%s'", ErrInvalidArgument, positionals[len(s.Positionals)])
+	}
+	return nil
+}
+
+// validateValue checks one value of the flag
+func (f FlagSpec) validateValue(value string) error {
+	if f.Type == ArgTypeInt {
+		if _, err := strconv.Atoi(value); err != nil {
+			return fmt.Errorf("%w: flag --%s expects an integer, got '%s'", ErrInvalidArgument, f.Name, value)
+		}
+	}
+	if len(f.Choices) == 0 {
+		return nil
+	}
+	for _, choice := range f.Choices {
+		if value == choice {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: invalid value '%s' for --%s (valid: %s)", ErrInvalidArgument, value, f.Name, strings.Join(f.Choices, ", "))
+}
+
+// Usage formats a usage line for command from the spec, e.g.
+// "dw task-manager ac add <task-id> --description <desc> [--tag <tag>]..."
+func (s CommandArgSpec) Usage(command string) string {
+	parts := []string{command}
+	for _, positional := range s.Positionals {
+		part := "<" + positional.Name + ">"
+		if positional.Variadic {
+			part += "..."
+		}
+		if !positional.Required {
+			part = "[" + part + "]"
+		}
+		parts = append(parts, part)
+	}
+	for _, flag := range s.Flags {
+		part := flag.usage()
+		if !flag.Required {
+			part = "[" + part + "]"
+		}
+		if flag.Repeatable {
+			part += "..."
+		}
+		parts = append(parts, part)
+	}
+	return strings.Join(parts, " ")
+}
+
+// WriteHelp writes the "Arguments:" and "Flags:" help sections of the spec, one
+// aligned line per positional and flag. Sections without entries are omitted.
+func (s CommandArgSpec) WriteHelp(out io.Writer) {
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	if len(s.Positionals) > 0 {
+		fmt.Fprintln(w, "Arguments:")
+		for _, positional := range s.Positionals {
+			notes := ""
+			if positional.Required {
+				notes = " (required)"
+			}
+			fmt.Fprintf(w, "  <%s>\t%s%s\n", positional.Name, positional.Description, notes)
+		}
+		fmt.Fprintln(w)
+	}
+	if len(s.Flags) > 0 {
+		fmt.Fprintln(w, "Flags:")
+		for _, flag := range s.Flags {
+			var notes []string
+			if flag.Required {
+				notes = append(notes, "required")
+			}
+			if flag.Repeatable {
+				notes = append(notes, "repeatable")
+			}
+			if len(flag.Choices) > 0 {
+				notes = append(notes, "one of: "+strings.Join(flag.Choices, ", "))
+			}
+			description := flag.Description
+			if len(notes) > 0 {
+				description += " (" + strings.Join(notes, "; ") + ")"
+			}
+			fmt.Fprintf(w, "  %s\t%s\n", flag.usage(), description)
+		}
+		fmt.Fprintln(w)
+	}
+	w.Flush()
+}
+
+// usage formats the flag with its value placeholder, e.g. "--rank <n>"
+func (f FlagSpec) usage() string {
+	if f.Type == ArgTypeBool {
+		return "--" + f.Name
+	}
+	value := f.Value
+	if value == "" {
+		value = "value"
+		if f.Type == ArgTypeInt {
+			value = "n"
+		}
+	}
+	return "--" + f.Name + " <" + value + ">"
+}
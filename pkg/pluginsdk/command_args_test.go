@@ -0,0 +1,105 @@
+package pluginsdk_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+func testArgSpec() pluginsdk.CommandArgSpec {
+	return pluginsdk.CommandArgSpec{
+		Positionals: []pluginsdk.PositionalSpec{
+			{Name: "task-id", Required: true, Description: "Task"},
+			{Name: "note", Description: "Optional note"},
+		},
+		Flags: []pluginsdk.FlagSpec{
+			{Name: "description", Value: "desc", Required: true, Description: "Description"},
+			{Name: "rank", Type: pluginsdk.ArgTypeInt, Description: "Rank"},
+			{Name: "status", Choices: []string{"todo", "done"}, Description: "Status"},
+			{Name: "tag", Repeatable: true, Description: "Tag"},
+			{Name: "force", Type: pluginsdk.ArgTypeBool, Description: "Force"},
+		},
+	}
+}
+
+func TestCommandArgSpec_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{name: "minimal", args: []string{"T-1", "--description", "d"}},
+		{name: "everything", args: []string{"--force", "T-1", "--description", "--d", "--rank", "-2", "--status", "done", "--tag", "a", "--tag", "b", "note"}},
+		{name: "missing flag", args: []string{"T-1"}, wantErr: "missing required flag --description"},
+		{name: "missing positional", args: []string{"--description", "d"}, wantErr: "missing required argument <task-id>"},
+		{name: "flag without value", args: []string{"T-1", "--description"}, wantErr: "flag --description requires a value"},
+		{name: "not an integer", args: []string{"T-1", "--description", "d", "--rank", "high"}, wantErr: "flag --rank expects an integer, got 'high'"},
+		{name: "invalid choice", args: []string{"T-1", "--description", "d", "--status", "open"}, wantErr: "invalid value 'open' for --status (valid: todo, done)"},
+		{name: "unknown flag", args: []string{"T-1", "--description", "d", "--title", "x"}, wantErr: "unknown flag --title"},
+		{name: "repeated flag", args: []string{"T-1", "--description", "d", "--description", "e"}, wantErr: "flag --description given more than once"},
+		{name: "extra positional", args: []string{"T-1", "note", "more", "--description", "d"}, wantErr: "unexpected argument 'more'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := testArgSpec().Validate(tt.args)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Validate: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+			if !errors.Is(err, pluginsdk.ErrInvalidArgument) {
+				t.Errorf("expected ErrInvalidArgument, got %v", err)
+			}
+		})
+	}
+}
+
+func TestCommandArgSpec_ValidateVariadic(t *testing.T) {
+	spec := pluginsdk.CommandArgSpec{
+		Positionals: []pluginsdk.PositionalSpec{{Name: "id", Required: true, Variadic: true}},
+	}
+	if err := spec.Validate([]string{"a", "b", "c"}); err != nil {
+		t.Errorf("expected a variadic positional to take every argument, got %v", err)
+	}
+	if err := spec.Validate(nil); err == nil {
+		t.Error("expected a required variadic positional to need one argument")
+	}
+}
+
+func TestCommandArgSpec_UsageAndHelp(t *testing.T) {
+	spec := testArgSpec()
+
+	usage := spec.Usage("dw tasks add")
+	want := "dw tasks add <task-id> [<note>] --description <desc> [--rank <n>] [--status <value>] [--tag <value>]... [--force]"
+	if usage != want {
+		t.Errorf("Usage = %q, want %q", usage, want)
+	}
+
+	var help strings.Builder
+	spec.WriteHelp(&help)
+	for _, want := range []string{
+		"Arguments:\n  <task-id>",
+		"Task (required)",
+		"Flags:\n  --description <desc>",
+		"Description (required)",
+		"Status (one of: todo, done)",
+		"Tag (repeatable)",
+	} {
+		if !strings.Contains(help.String(), want) {
+			t.Errorf("expected help to contain %q, got:\n%s", want, help.String())
+		}
+	}
+
+	var empty strings.Builder
+	pluginsdk.CommandArgSpec{}.WriteHelp(&empty)
+	if empty.Len() != 0 {
+		t.Errorf("expected no help sections for an empty spec, got %q", empty.String())
+	}
+}
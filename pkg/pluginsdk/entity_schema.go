@@ -0,0 +1,107 @@
+package pluginsdk
+
+// Field types an entity schema can declare
+const (
+	FieldTypeString   = "string"
+	FieldTypeInteger  = "integer"
+	FieldTypeNumber   = "number"
+	FieldTypeBoolean  = "boolean"
+	FieldTypeDateTime = "datetime" // RFC 3339 timestamp string
+	FieldTypeArray    = "array"
+	FieldTypeObject   = "object"
+)
+
+// JSONSchemaDialect is the JSON Schema version of the documents produced by EntitySchema.JSONSchema
+const JSONSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// EntityField describes one field of an entity type, as returned by GetAllFields
+// and in QueryEntities results.
+type EntityField struct {
+	// Name is the field key (e.g., "title", "created_at")
+	Name string `json:"name"`
+
+	// Type is one of the FieldType constants
+	Type string `json:"type"`
+
+	// Items is the element type of an array field (a FieldType constant).
+	// Empty means the elements are not described.
+	Items string `json:"items,omitempty"`
+
+	// Required reports whether every entity of the type has the field
+	Required bool `json:"required,omitempty"`
+
+	// Description is a human-readable description of the field
+	Description string `json:"description,omitempty"`
+}
+
+// EntitySchema describes the fields of an entity type.
+// It is the contract of the get_entity_schema RPC method; JSONSchema renders the
+// same contract as a JSON Schema document for external consumers.
+type EntitySchema struct {
+	// Type is the entity type the schema describes (e.g., "note")
+	Type string `json:"type"`
+
+	// Description is a human-readable description of the entity type
+	Description string `json:"description,omitempty"`
+
+	// Fields lists the entity's fields in display order
+	Fields []EntityField `json:"fields"`
+}
+
+// JSONSchema returns the schema as a JSON Schema (draft 2020-12) object schema.
+// Fields not listed in the schema are allowed, because plugins may return more
+// than they describe. Results of a query with EntityQuery.Fields set are projected
+// and may lack required fields, so only validate unprojected results.
+func (s EntitySchema) JSONSchema() map[string]interface{} {
+	properties := make(map[string]interface{}, len(s.Fields))
+	required := []string{}
+	for _, field := range s.Fields {
+		properties[field.Name] = field.jsonSchema()
+		if field.Required {
+			required = append(required, field.Name)
+		}
+	}
+
+	schema := map[string]interface{}{
+		"$schema":              JSONSchemaDialect,
+		"title":                s.Type,
+		"type":                 "object",
+		"properties":           properties,
+		"required":             required,
+		"additionalProperties": true,
+	}
+	if s.Description != "" {
+		schema["description"] = s.Description
+	}
+	return schema
+}
+
+// jsonSchema returns the JSON Schema of a single field
+func (f EntityField) jsonSchema() map[string]interface{} {
+	schema := map[string]interface{}{"type": jsonSchemaType(f.Type)}
+	switch f.Type {
+	case FieldTypeDateTime:
+		schema["format"] = "date-time"
+	case FieldTypeArray:
+		if f.Items != "" {
+			items := EntityField{Type: f.Items}
+			schema["items"] = items.jsonSchema()
+		}
+	}
+	if f.Description != "" {
+		schema["description"] = f.Description
+	}
+	return schema
+}
+
+// jsonSchemaType maps a field type to its JSON Schema type; unknown types
+// are left unconstrained rather than rejected
+func jsonSchemaType(fieldType string) interface{} {
+	switch fieldType {
+	case FieldTypeString, FieldTypeDateTime:
+		return "string"
+	case FieldTypeInteger, FieldTypeNumber, FieldTypeBoolean, FieldTypeArray, FieldTypeObject:
+		return fieldType
+	}
+	return []string{"string", "number", "integer", "boolean", "array", "object", "null"}
+}
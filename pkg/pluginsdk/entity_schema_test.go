@@ -0,0 +1,58 @@
+package pluginsdk_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+func TestEntitySchema_JSONSchema(t *testing.T) {
+	schema := pluginsdk.EntitySchema{
+		Type:        "note",
+		Description: "A note",
+		Fields: []pluginsdk.EntityField{
+			{Name: "id", Type: pluginsdk.FieldTypeString, Required: true},
+			{Name: "created_at", Type: pluginsdk.FieldTypeDateTime, Required: true},
+			{Name: "tags", Type: pluginsdk.FieldTypeArray, Items: pluginsdk.FieldTypeString, Description: "Labels"},
+			{Name: "extra", Type: "blob"},
+		},
+	}
+
+	// Round-trip through JSON, as consumers of `dw plugin schema` see it
+	data, err := json.Marshal(schema.JSONSchema())
+	if err != nil {
+		t.Fatalf("failed to marshal schema: %v", err)
+	}
+	var doc map[string]interface{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("failed to unmarshal schema: %v", err)
+	}
+
+	if doc["$schema"] != pluginsdk.JSONSchemaDialect || doc["type"] != "object" || doc["title"] != "note" || doc["description"] != "A note" {
+		t.Errorf("unexpected schema header: %v", doc)
+	}
+	if !reflect.DeepEqual(doc["required"], []interface{}{"id", "created_at"}) {
+		t.Errorf("required = %v, want [id created_at]", doc["required"])
+	}
+
+	properties := doc["properties"].(map[string]interface{})
+	want := map[string]interface{}{
+		"id":         map[string]interface{}{"type": "string"},
+		"created_at": map[string]interface{}{"type": "string", "format": "date-time"},
+		"tags": map[string]interface{}{
+			"type":        "array",
+			"items":       map[string]interface{}{"type": "string"},
+			"description": "Labels",
+		},
+	}
+	for name, prop := range want {
+		if !reflect.DeepEqual(properties[name], prop) {
+			t.Errorf("property %s = %v, want %v", name, properties[name], prop)
+		}
+	}
+	if _, ok := properties["extra"].(map[string]interface{})["type"].([]interface{}); !ok {
+		t.Errorf("expected an unknown field type to be unconstrained, got %v", properties["extra"])
+	}
+}
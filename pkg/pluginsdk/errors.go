@@ -11,4 +11,12 @@ var (
 	ErrNotImplemented   = errors.New("not implemented")
 	ErrInternal         = errors.New("internal error")
 	ErrReadOnly         = errors.New("entity is read-only")
+
+	// ErrConcurrentModification means a write lost to another writer, e.g. the
+	// database stayed locked by another process; retrying later may succeed
+	ErrConcurrentModification = errors.New("concurrent modification")
+
+	// ErrPluginFailure means a plugin could not run a command: it crashed, failed
+	// to start or answered with a malformed response
+	ErrPluginFailure = errors.New("plugin failure")
 )
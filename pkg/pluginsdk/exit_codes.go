@@ -0,0 +1,35 @@
+package pluginsdk
+
+import "errors"
+
+// Exit codes of dw. Scripts can branch on them; they are documented in 'dw help'.
+const (
+	ExitOK                     = 0 // Success
+	ExitError                  = 1 // Any other error
+	ExitUsage                  = 2 // Invalid usage: unknown command, missing or invalid arguments
+	ExitNotFound               = 3 // A referenced entity does not exist
+	ExitConcurrentModification = 4 // Lost to a concurrent writer; retrying may succeed
+	ExitPluginFailure          = 5 // A plugin crashed or failed to run the command
+)
+
+// ExitCode returns the exit code for err based on the standard errors it wraps:
+// ErrInvalidArgument and ErrAlreadyExists exit with ExitUsage, ErrNotFound with
+// ExitNotFound, ErrConcurrentModification with ExitConcurrentModification and
+// ErrPluginFailure with ExitPluginFailure. Other errors exit with ExitError and
+// nil with ExitOK.
+func ExitCode(err error) int {
+	switch {
+	case err == nil:
+		return ExitOK
+	case errors.Is(err, ErrConcurrentModification):
+		return ExitConcurrentModification
+	case errors.Is(err, ErrNotFound):
+		return ExitNotFound
+	case errors.Is(err, ErrInvalidArgument), errors.Is(err, ErrAlreadyExists):
+		return ExitUsage
+	case errors.Is(err, ErrPluginFailure):
+		return ExitPluginFailure
+	default:
+		return ExitError
+	}
+}
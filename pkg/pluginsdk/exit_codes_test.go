@@ -0,0 +1,36 @@
+package pluginsdk_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk"
+)
+
+func TestExitCode(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"success", nil, pluginsdk.ExitOK},
+		{"generic error", errors.New("disk full"), pluginsdk.ExitError},
+		{"missing flag", fmt.Errorf("%w: --track is required", pluginsdk.ErrInvalidArgument), pluginsdk.ExitUsage},
+		{"duplicate name", fmt.Errorf("%w: project test", pluginsdk.ErrAlreadyExists), pluginsdk.ExitUsage},
+		{"unknown AC", fmt.Errorf("failed to verify AC: %w", fmt.Errorf("%w: AC DW-ac-9", pluginsdk.ErrNotFound)), pluginsdk.ExitNotFound},
+		{"database locked", fmt.Errorf("failed to update task: %w", pluginsdk.ErrConcurrentModification), pluginsdk.ExitConcurrentModification},
+		{"plugin crashed", fmt.Errorf("%w: rpc client stopped", pluginsdk.ErrPluginFailure), pluginsdk.ExitPluginFailure},
+		// A lost race is reported as such even when the failed write names a missing entity
+		{"locked and not found", fmt.Errorf("%w: %w", pluginsdk.ErrConcurrentModification, pluginsdk.ErrNotFound), pluginsdk.ExitConcurrentModification},
+		{"read-only", pluginsdk.ErrReadOnly, pluginsdk.ExitError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := pluginsdk.ExitCode(tt.err); got != tt.want {
+				t.Errorf("ExitCode(%v) = %d, want %d", tt.err, got, tt.want)
+			}
+		})
+	}
+}
@@ -61,6 +61,55 @@ type CommandContext interface {
 	GetStdin() io.Reader
 }
 
+// EventReader gives plugins read access to events in the framework's event store.
+// It is optional: plugins should type-assert their PluginContext (or CommandContext)
+// against it and degrade gracefully when the framework does not provide it.
+type EventReader interface {
+	// GetEvent returns the stored event with the given ID.
+	// Returns an error wrapping ErrNotFound if no such event exists.
+	GetEvent(ctx context.Context, eventID string) (*Event, error)
+}
+
+// Verbosity controls how much human-oriented output a command writes. It is set by
+// the global --quiet and --verbose flags. Errors and machine output (e.g. --json)
+// are written regardless of verbosity.
+type Verbosity string
+
+const (
+	// VerbosityNormal is the default: commands print their usual output
+	VerbosityNormal Verbosity = ""
+	// VerbosityQuiet suppresses success messages and other human-oriented chatter
+	VerbosityQuiet Verbosity = "quiet"
+	// VerbosityVerbose asks commands for extra diagnostics
+	VerbosityVerbose Verbosity = "verbose"
+)
+
+// VerbosityProvider is implemented by command contexts that carry the global
+// --quiet/--verbose setting. It is optional: use GetVerbosity, which falls back to
+// VerbosityNormal when the framework does not provide it.
+type VerbosityProvider interface {
+	// GetVerbosity returns the requested verbosity
+	GetVerbosity() Verbosity
+}
+
+// GetVerbosity returns the verbosity of cmdCtx, or VerbosityNormal if it does not
+// implement VerbosityProvider
+func GetVerbosity(cmdCtx CommandContext) Verbosity {
+	if provider, ok := cmdCtx.(VerbosityProvider); ok {
+		return provider.GetVerbosity()
+	}
+	return VerbosityNormal
+}
+
+// InfoWriter returns the stream for success messages and other human-oriented
+// chatter: the command's stdout, or io.Discard when --quiet is set
+func InfoWriter(cmdCtx CommandContext) io.Writer {
+	if GetVerbosity(cmdCtx) == VerbosityQuiet {
+		return io.Discard
+	}
+	return cmdCtx.GetStdout()
+}
+
 // Logger is the interface for plugin logging.
 // The framework provides an implementation that plugins use to log messages.
 type Logger interface {
@@ -25,6 +25,9 @@ type EventRepository interface {
 // EventQuery defines query parameters for retrieving events.
 // Plugins use this to filter and search events from the repository.
 type EventQuery struct {
+	// EventIDs filters by event ID
+	EventIDs []string
+
 	// Time range for filtering events
 	StartTime *time.Time
 	EndTime   *time.Time
@@ -32,9 +35,27 @@ type EventQuery struct {
 	// EventTypes filters by event type strings (e.g., "claude.tool.invoked")
 	EventTypes []string
 
+	// EventSource and EventCategory filter by the parts of dotted event types, e.g.
+	// source "claude" and category "tool" match "claude.tool.invoked". A category
+	// alone also matches unprefixed core types such as "tool.result".
+	EventSource   string
+	EventCategory string
+
 	// Metadata filters events by metadata key-value pairs (e.g., session_id)
 	Metadata map[string]string
 
+	// PayloadFilters filters events by top-level payload values (e.g., "tool": "Read").
+	// Keys in the repository's indexed set use an index lookup; other keys fall back
+	// to JSON extraction and scan the table.
+	PayloadFilters map[string]string
+
+	// WorkingDir filters events captured in this directory or any of its subdirectories
+	WorkingDir string
+
+	// Labels filters events tagged with any of these labels (see 'dw logs annotate').
+	// Labels are stored lower-case, so matching ignores case.
+	Labels []string
+
 	// SearchText enables full-text search on event content
 	SearchText string
 
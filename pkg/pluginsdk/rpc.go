@@ -72,6 +72,10 @@ const (
 	RPCErrorMethodNotFound = -32601
 	RPCErrorInvalidParams  = -32602
 	RPCErrorInternal       = -32603
+
+	// RPCErrorNotFound (server-defined) reports a missing entity or entity type.
+	// dw turns it into an error wrapping ErrNotFound.
+	RPCErrorNotFound = -32000
 )
 
 // RPCEvent represents an event emitted by the plugin to the main process.
@@ -131,7 +135,7 @@ const (
 	RPCMethodGetEntityTypes = "get_entity_types"
 
 	// RPCMethodQueryEntities queries entities.
-	// Request params: EntityQuery
+	// Request params: EntityQuery (Fields optionally limits the keys of each result)
 	// Response result: []map[string]interface{} (serialized IExtensible entities)
 	RPCMethodQueryEntities = "query_entities"
 
@@ -140,6 +144,13 @@ const (
 	// Response result: map[string]interface{} (serialized IExtensible entity)
 	RPCMethodGetEntity = "get_entity"
 
+	// IEntitySchemaProvider methods
+
+	// RPCMethodGetEntitySchema returns the field definitions of an entity type.
+	// Request params: GetEntitySchemaParams { EntityType string }
+	// Response result: EntitySchema; unknown types return an RPCErrorNotFound error
+	RPCMethodGetEntitySchema = "get_entity_schema"
+
 	// IEntityUpdater methods
 
 	// RPCMethodUpdateEntity updates an entity's fields.
@@ -155,7 +166,7 @@ const (
 	RPCMethodGetCommands = "get_commands"
 
 	// RPCMethodExecuteCommand executes a command.
-	// Request params: ExecuteCommandParams { CommandName string, Args []string }
+	// Request params: ExecuteCommandParams { CommandName string, Args []string, Verbosity string }
 	// Response result: ExecuteCommandResult { ExitCode int, Output string, Error string }
 	RPCMethodExecuteCommand = "execute_command"
 
@@ -171,6 +182,13 @@ const (
 	// Request params: (none)
 	// Response result: (none)
 	RPCMethodStopEventStream = "stop_event_stream"
+
+	// IEventHandler methods
+
+	// RPCMethodHandleEvent delivers one logged event to the plugin.
+	// Request params: RPCEvent (event_id in metadata)
+	// Response result: (none); an RPC error marks the event as failed
+	RPCMethodHandleEvent = "handle_event"
 )
 
 // RPC Parameter Types
@@ -179,7 +197,8 @@ const (
 
 // InitParams contains initialization parameters for the plugin.
 type InitParams struct {
-	// WorkingDir is the current working directory
+	// WorkingDir is the DarwinFlow project directory. dw also starts external
+	// plugins in it, so it is the plugin's current directory as well.
 	WorkingDir string `json:"working_dir"`
 
 	// Config contains plugin-specific configuration
@@ -192,6 +211,12 @@ type GetEntityParams struct {
 	EntityID string `json:"entity_id"`
 }
 
+// GetEntitySchemaParams contains parameters for get_entity_schema method.
+type GetEntitySchemaParams struct {
+	// EntityType is the entity type to describe
+	EntityType string `json:"entity_type"`
+}
+
 // UpdateEntityParams contains parameters for update_entity method.
 type UpdateEntityParams struct {
 	// EntityID is the ID of the entity to update
@@ -208,6 +233,9 @@ type ExecuteCommandParams struct {
 
 	// Args are the command arguments
 	Args []string `json:"args"`
+
+	// Verbosity is the global --quiet/--verbose setting (empty = normal)
+	Verbosity Verbosity `json:"verbosity,omitempty"`
 }
 
 // CommandInfo contains metadata about a command (serializable version of Command interface).
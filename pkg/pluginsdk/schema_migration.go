@@ -0,0 +1,131 @@
+package pluginsdk
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// SchemaMigrationsTable records which schema migrations each plugin has applied
+const SchemaMigrationsTable = "plugin_schema_migrations"
+
+// SchemaMigration is one versioned change to a plugin-owned schema
+type SchemaMigration struct {
+	// Version identifies the migration within its plugin; must be positive and unique
+	Version int
+
+	// Description is a short human-readable summary (e.g., "add priority column")
+	Description string
+
+	// Up applies the migration inside the transaction that records its version
+	Up func(ctx context.Context, tx *sql.Tx) error
+}
+
+// ApplySchemaMigrations runs the migrations of the named plugin that are not yet
+// recorded in db, in ascending version order. Each migration runs in its own
+// transaction together with the record of its version, so a failed migration
+// leaves no trace and is retried on the next run. Returns the versions applied.
+func ApplySchemaMigrations(ctx context.Context, db *sql.DB, plugin string, migrations []SchemaMigration) ([]int, error) {
+	sorted := make([]SchemaMigration, len(migrations))
+	copy(sorted, migrations)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Version < sorted[j].Version })
+	for i, migration := range sorted {
+		if migration.Version <= 0 {
+			return nil, fmt.Errorf("%w: plugin %s: migration version must be positive, got %d", ErrInvalidArgument, plugin, migration.Version)
+		}
+		if i > 0 && sorted[i-1].Version == migration.Version {
+			return nil, fmt.Errorf("%w: plugin %s: duplicate migration version %d", ErrInvalidArgument, plugin, migration.Version)
+		}
+		if migration.Up == nil {
+			return nil, fmt.Errorf("%w: plugin %s: migration %d has no Up function", ErrInvalidArgument, plugin, migration.Version)
+		}
+	}
+
+	applied, err := AppliedSchemaVersions(ctx, db, plugin)
+	if err != nil {
+		return nil, err
+	}
+	done := make(map[int]bool, len(applied))
+	for _, version := range applied {
+		done[version] = true
+	}
+
+	var versions []int
+	for _, migration := range sorted {
+		if done[migration.Version] {
+			continue
+		}
+		if err := applySchemaMigration(ctx, db, plugin, migration); err != nil {
+			return versions, err
+		}
+		versions = append(versions, migration.Version)
+	}
+
+	return versions, nil
+}
+
+// AppliedSchemaVersions returns the migration versions recorded for the named plugin,
+// in ascending order. It creates the tracking table if it does not exist.
+func AppliedSchemaVersions(ctx context.Context, db *sql.DB, plugin string) ([]int, error) {
+	if err := ensureSchemaMigrationsTable(ctx, db); err != nil {
+		return nil, err
+	}
+
+	rows, err := db.QueryContext(ctx, "SELECT version FROM "+SchemaMigrationsTable+" WHERE plugin = ? ORDER BY version", plugin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read applied migrations for plugin %s: %w", plugin, err)
+	}
+	defer rows.Close()
+
+	var versions []int
+	for rows.Next() {
+		var version int
+		if err := rows.Scan(&version); err != nil {
+			return nil, fmt.Errorf("failed to read applied migrations for plugin %s: %w", plugin, err)
+		}
+		versions = append(versions, version)
+	}
+	return versions, rows.Err()
+}
+
+func ensureSchemaMigrationsTable(ctx context.Context, db *sql.DB) error {
+	_, err := db.ExecContext(ctx, `
+		CREATE TABLE IF NOT EXISTS `+SchemaMigrationsTable+` (
+			plugin TEXT NOT NULL,
+			version INTEGER NOT NULL,
+			description TEXT NOT NULL DEFAULT '',
+			applied_at INTEGER NOT NULL,
+			PRIMARY KEY (plugin, version)
+		)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create %s table: %w", SchemaMigrationsTable, err)
+	}
+	return nil
+}
+
+func applySchemaMigration(ctx context.Context, db *sql.DB, plugin string, migration SchemaMigration) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("plugin %s: failed to start migration %d: %w", plugin, migration.Version, err)
+	}
+	defer tx.Rollback()
+
+	if err := migration.Up(ctx, tx); err != nil {
+		return fmt.Errorf("plugin %s: migration %d (%s) failed: %w", plugin, migration.Version, migration.Description, err)
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"INSERT INTO "+SchemaMigrationsTable+" (plugin, version, description, applied_at) VALUES (?, ?, ?, ?)",
+		plugin, migration.Version, migration.Description, time.Now().UnixMilli())
+	if err != nil {
+		return fmt.Errorf("plugin %s: failed to record migration %d: %w", plugin, migration.Version, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("plugin %s: failed to commit migration %d: %w", plugin, migration.Version, err)
+	}
+	return nil
+}
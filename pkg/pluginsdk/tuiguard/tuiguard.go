@@ -0,0 +1,157 @@
+// Package tuiguard runs Bubble Tea programs so that a panic restores the terminal
+// and leaves a crash log instead of a terminal stuck in raw mode and the alternate
+// screen. It is shared by the dw UI and the plugin TUIs.
+package tuiguard
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// CrashError is returned by RunProgram when the UI panicked. The terminal has been
+// restored and the panic with its stack trace written to LogPath.
+type CrashError struct {
+	LogPath string
+	Panic   interface{}
+}
+
+func (e *CrashError) Error() string {
+	if e.LogPath == "" {
+		return fmt.Sprintf("DarwinFlow UI crashed: %v", e.Panic)
+	}
+	return fmt.Sprintf("DarwinFlow UI crashed; details in %s", e.LogPath)
+}
+
+// crashReport is a recovered panic with the stack of the goroutine that panicked
+type crashReport struct {
+	value interface{}
+	stack []byte
+}
+
+// crashMsg carries a panic recovered in a command back to the update loop
+type crashMsg struct {
+	report *crashReport
+}
+
+// crashGuard wraps the root model and recovers panics in Init, Update, View and the
+// commands they return. A recovered panic quits the program normally, so bubbletea
+// restores the terminal (raw mode, alternate screen, cursor) before Run returns.
+type crashGuard struct {
+	model tea.Model
+	crash *crashReport
+	quit  func() // Asks the program to quit from outside the update loop
+}
+
+func newCrashGuard(model tea.Model) *crashGuard {
+	return &crashGuard{model: model}
+}
+
+func (g *crashGuard) Init() (cmd tea.Cmd) {
+	defer g.recoverPanic(&cmd)
+	return guardCmd(g.model.Init())
+}
+
+func (g *crashGuard) Update(msg tea.Msg) (model tea.Model, cmd tea.Cmd) {
+	if crash, ok := msg.(crashMsg); ok {
+		g.crash = crash.report
+		return g, tea.Quit
+	}
+
+	model = g
+	defer g.recoverPanic(&cmd)
+	g.model, cmd = g.model.Update(msg)
+	return g, guardCmd(cmd)
+}
+
+func (g *crashGuard) View() (view string) {
+	if g.crash != nil {
+		return ""
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			g.crash = &crashReport{value: r, stack: debug.Stack()}
+			view = ""
+			// View cannot return a command, so quit asynchronously
+			if g.quit != nil {
+				g.quit()
+			}
+		}
+	}()
+	return g.model.View()
+}
+
+// recoverPanic records a panic and replaces the returned command with tea.Quit
+func (g *crashGuard) recoverPanic(cmd *tea.Cmd) {
+	if r := recover(); r != nil {
+		g.crash = &crashReport{value: r, stack: debug.Stack()}
+		*cmd = tea.Quit
+	}
+}
+
+// guardCmd makes cmd report a panic as a crashMsg instead of killing the program.
+// The commands of a batch are guarded individually.
+func guardCmd(cmd tea.Cmd) tea.Cmd {
+	if cmd == nil {
+		return nil
+	}
+	return func() (msg tea.Msg) {
+		defer func() {
+			if r := recover(); r != nil {
+				msg = crashMsg{report: &crashReport{value: r, stack: debug.Stack()}}
+			}
+		}()
+		msg = cmd()
+		if batch, ok := msg.(tea.BatchMsg); ok {
+			guarded := make(tea.BatchMsg, len(batch))
+			for i, c := range batch {
+				guarded[i] = guardCmd(c)
+			}
+			return guarded
+		}
+		return msg
+	}
+}
+
+// RunProgram runs model in a bubbletea program created with opts. If the model
+// panics, the program is shut down cleanly so the terminal is restored, a crash
+// log is written to crashDir and a *CrashError is returned.
+func RunProgram(model tea.Model, crashDir string, opts ...tea.ProgramOption) error {
+	guard := newCrashGuard(model)
+	program := tea.NewProgram(guard, opts...)
+	guard.quit = func() { go program.Quit() }
+	_, err := program.Run()
+
+	if guard.crash == nil {
+		if errors.Is(err, tea.ErrProgramPanic) {
+			// A panic outside the guard (e.g. in a command sequence); bubbletea has
+			// restored the terminal and printed the stack already
+			return &CrashError{Panic: err}
+		}
+		return err
+	}
+
+	crash := &CrashError{Panic: guard.crash.value}
+	if logPath, logErr := writeCrashLog(crashDir, guard.crash, time.Now()); logErr == nil {
+		crash.LogPath = logPath
+	}
+	return crash
+}
+
+// writeCrashLog writes a crash report to crash-<timestamp>.log in dir and returns its path
+func writeCrashLog(dir string, report *crashReport, now time.Time) (string, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, fmt.Sprintf("crash-%s.log", now.Format("20060102-150405")))
+	content := fmt.Sprintf("DarwinFlow UI crash at %s\n\npanic: %v\n\n%s", now.Format(time.RFC3339), report.value, report.stack)
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		return "", err
+	}
+	return path, nil
+}
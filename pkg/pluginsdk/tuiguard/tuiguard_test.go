@@ -0,0 +1,102 @@
+package tuiguard_test
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kgatilin/darwinflow-pub/pkg/pluginsdk/tuiguard"
+)
+
+// Escape sequences bubbletea writes when it restores the terminal on exit
+const (
+	exitAltScreen = "\x1b[?1049l"
+	showCursor    = "\x1b[?25h"
+)
+
+// panickingModel panics in the phase named by panicIn
+type panickingModel struct {
+	panicIn string
+}
+
+type panicNowMsg struct{}
+
+func (m panickingModel) Init() tea.Cmd {
+	switch m.panicIn {
+	case "init":
+		panic("init failed")
+	case "cmd":
+		return tea.Batch(nil, func() tea.Msg { panic("command failed") })
+	}
+	return func() tea.Msg { return panicNowMsg{} }
+}
+
+func (m panickingModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if _, ok := msg.(panicNowMsg); ok && m.panicIn == "update" {
+		var items []string
+		_ = items[3] // index out of range, like a selection past a reloaded list
+	}
+	return m, nil
+}
+
+func (m panickingModel) View() string {
+	if m.panicIn == "view" {
+		panic("render failed")
+	}
+	return "ok"
+}
+
+func TestRunProgram_RecoversPanics(t *testing.T) {
+	for _, phase := range []string{"init", "update", "view", "cmd"} {
+		t.Run(phase, func(t *testing.T) {
+			crashDir := t.TempDir()
+			var out bytes.Buffer
+
+			err := tuiguard.RunProgram(panickingModel{panicIn: phase}, crashDir,
+				tea.WithInput(&bytes.Buffer{}), tea.WithOutput(&out), tea.WithAltScreen())
+
+			var crash *tuiguard.CrashError
+			if !errors.As(err, &crash) {
+				t.Fatalf("expected *CrashError, got %v", err)
+			}
+			if !strings.Contains(crash.Error(), "DarwinFlow UI crashed; details in "+crashDir) {
+				t.Errorf("unexpected message: %s", crash.Error())
+			}
+
+			// The program shut down normally, restoring the terminal
+			if !strings.Contains(out.String(), exitAltScreen) || !strings.HasSuffix(out.String(), showCursor) {
+				t.Errorf("expected terminal restore sequences in output, got %q", out.String())
+			}
+
+			log, err := os.ReadFile(crash.LogPath)
+			if err != nil {
+				t.Fatalf("failed to read crash log: %v", err)
+			}
+			if !strings.Contains(string(log), "panic: ") || !strings.Contains(string(log), "goroutine") {
+				t.Errorf("expected panic and stack trace in crash log, got:\n%s", log)
+			}
+		})
+	}
+}
+
+func TestRunProgram_NoPanic(t *testing.T) {
+	crashDir := t.TempDir()
+	err := tuiguard.RunProgram(quitModel{}, crashDir, tea.WithInput(&bytes.Buffer{}), tea.WithOutput(&bytes.Buffer{}))
+	if err != nil {
+		t.Fatalf("expected clean exit, got %v", err)
+	}
+	entries, _ := os.ReadDir(crashDir)
+	if len(entries) != 0 {
+		t.Errorf("expected no crash log, found %d files", len(entries))
+	}
+}
+
+// quitModel exits immediately
+type quitModel struct{}
+
+func (quitModel) Init() tea.Cmd                       { return tea.Quit }
+func (quitModel) Update(tea.Msg) (tea.Model, tea.Cmd) { return quitModel{}, nil }
+func (quitModel) View() string                        { return "" }